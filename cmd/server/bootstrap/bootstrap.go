@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/neko-dream/api/internal/application/analysis_scheduler"
 	"github.com/neko-dream/api/internal/application/event_processor"
 	"github.com/neko-dream/api/internal/infrastructure/config"
 	"github.com/neko-dream/api/internal/infrastructure/di"
@@ -14,11 +15,13 @@ import (
 
 // Bootstrap アプリケーションの起動を管理する
 type Bootstrap struct {
-	container      *dig.Container
-	config         *config.Config
-	migrator       *db.Migrator
-	eventProcessor *event_processor.EventProcessor
-	cancelFunc     context.CancelFunc
+	container         *dig.Container
+	config            *config.Config
+	migrator          *db.Migrator
+	eventProcessor    *event_processor.EventProcessor
+	analysisScheduler *analysis_scheduler.AnalysisScheduler
+	analysisJobWorker *analysis_scheduler.AnalysisJobWorker
+	cancelFunc        context.CancelFunc
 }
 
 // New 新しいBootstrapインスタンスを作成する
@@ -38,11 +41,23 @@ func New(container *dig.Container) (*Bootstrap, error) {
 		return nil, fmt.Errorf("failed to invoke event processor: %w", err)
 	}
 
+	analysisScheduler, err := di.InvokeWithError[*analysis_scheduler.AnalysisScheduler](container)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke analysis scheduler: %w", err)
+	}
+
+	analysisJobWorker, err := di.InvokeWithError[*analysis_scheduler.AnalysisJobWorker](container)
+	if err != nil {
+		return nil, fmt.Errorf("failed to invoke analysis job worker: %w", err)
+	}
+
 	return &Bootstrap{
-		container:      container,
-		config:         config,
-		migrator:       migrator,
-		eventProcessor: eventProcessor,
+		container:         container,
+		config:            config,
+		migrator:          migrator,
+		eventProcessor:    eventProcessor,
+		analysisScheduler: analysisScheduler,
+		analysisJobWorker: analysisJobWorker,
 	}, nil
 }
 
@@ -56,6 +71,8 @@ func (b *Bootstrap) Run() error {
 	}
 
 	b.startEventProcessor(ctx)
+	b.startAnalysisScheduler(ctx)
+	b.startAnalysisJobWorker(ctx)
 
 	return b.startHTTPServer()
 }
@@ -72,10 +89,26 @@ func (b *Bootstrap) startEventProcessor(ctx context.Context) {
 	}()
 }
 
+// startAnalysisScheduler 分析の再計算スケジューラーを起動する
+func (b *Bootstrap) startAnalysisScheduler(ctx context.Context) {
+	go func() {
+		log.Println("Starting analysis scheduler...")
+		b.analysisScheduler.Start(ctx)
+	}()
+}
+
+// startAnalysisJobWorker レポート・ワードクラウドの生成ジョブのワーカーを起動する
+func (b *Bootstrap) startAnalysisJobWorker(ctx context.Context) {
+	go func() {
+		log.Println("Starting analysis job worker...")
+		b.analysisJobWorker.Start(ctx)
+	}()
+}
+
 // Shutdown アプリケーションを適切にシャットダウンする
 func (b *Bootstrap) Shutdown() {
 	if b.cancelFunc != nil {
-		log.Println("Shutting down event processor...")
+		log.Println("Shutting down event processor and analysis workers...")
 		b.cancelFunc()
 	}
 }
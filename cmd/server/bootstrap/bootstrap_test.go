@@ -22,6 +22,8 @@ func TestNew_ShouldCreateBootstrapInstance(t *testing.T) {
 	assert.NotNil(t, boot.config)
 	assert.NotNil(t, boot.migrator)
 	assert.NotNil(t, boot.eventProcessor)
+	assert.NotNil(t, boot.analysisScheduler)
+	assert.NotNil(t, boot.analysisJobWorker)
 }
 
 func TestBootstrap_Run_ShouldReturnErrorWhenMigrationFails(t *testing.T) {
@@ -69,6 +69,7 @@ func (b *Bootstrap) setupAPIHandler() http.Handler {
 		srv,
 		middleware.Instrument("kotohiro-api", routeFinder, tracerProvider),
 		middleware.SetContextCookieKey(b.container),
+		middleware.SetContextAPIKey(b.container, routeFinder, handler.RequiredAPIKeyScope),
 		middleware.Labeler(routeFinder),
 	))
 }
@@ -0,0 +1,244 @@
+package analysis_scheduler
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/infrastructure/config"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	"go.opentelemetry.io/otel"
+)
+
+// AnalysisScheduler 投票・意見が溜まったセッションの分析を再計算する
+type AnalysisScheduler struct {
+	scheduleRepository analysis.AnalysisScheduleRepository
+	analysisService    analysis.AnalysisService
+	analysisRepository analysis.AnalysisRepository
+	jobService         analysis.AnalysisJobService
+	dbManager          *db.DBManager
+	logger             *slog.Logger
+	policy             analysis.RecomputePolicy
+	interval           time.Duration
+	batchSize          int
+}
+
+// NewRecomputePolicy 分析を再計算する条件を設定から作る
+func NewRecomputePolicy(cfg *config.Config) analysis.RecomputePolicy {
+	return analysis.RecomputePolicy{
+		VoteThreshold: cfg.AnalysisRecomputeVoteThreshold,
+		MaxDelay:      time.Duration(cfg.AnalysisRecomputeMaxDelay) * time.Second,
+		RunTimeout:    time.Duration(cfg.AnalysisRecomputeRunTimeout) * time.Second,
+	}
+}
+
+func NewAnalysisScheduler(
+	scheduleRepository analysis.AnalysisScheduleRepository,
+	analysisService analysis.AnalysisService,
+	analysisRepository analysis.AnalysisRepository,
+	jobService analysis.AnalysisJobService,
+	dbManager *db.DBManager,
+	policy analysis.RecomputePolicy,
+) *AnalysisScheduler {
+	return &AnalysisScheduler{
+		scheduleRepository: scheduleRepository,
+		analysisService:    analysisService,
+		analysisRepository: analysisRepository,
+		jobService:         jobService,
+		dbManager:          dbManager,
+		logger:             slog.Default(),
+		policy:             policy,
+		interval:           10 * time.Second,
+		batchSize:          20,
+	}
+}
+
+func (s *AnalysisScheduler) WithInterval(interval time.Duration) *AnalysisScheduler {
+	s.interval = interval
+	return s
+}
+
+func (s *AnalysisScheduler) WithBatchSize(batchSize int) *AnalysisScheduler {
+	s.batchSize = batchSize
+	return s
+}
+
+func (s *AnalysisScheduler) WithPolicy(policy analysis.RecomputePolicy) *AnalysisScheduler {
+	s.policy = policy
+	return s
+}
+
+func (s *AnalysisScheduler) WithLogger(logger *slog.Logger) *AnalysisScheduler {
+	s.logger = logger
+	return s
+}
+
+func (s *AnalysisScheduler) Start(ctx context.Context) {
+	ctx, span := otel.Tracer("analysis_scheduler").Start(ctx, "AnalysisScheduler.Start")
+	defer span.End()
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	s.logger.Info("分析スケジューラーを開始しました",
+		slog.Duration("interval", s.interval),
+		slog.Int("vote_threshold", s.policy.VoteThreshold),
+		slog.Duration("max_delay", s.policy.MaxDelay),
+	)
+
+	s.runDue(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.logger.Info("分析スケジューラーを停止します")
+			return
+		case <-ticker.C:
+			s.runDue(ctx)
+		}
+	}
+}
+
+// runDue 再計算の条件を満たしたセッションの分析を実行し、終わるまで待つ
+func (s *AnalysisScheduler) runDue(ctx context.Context) {
+	ctx, span := otel.Tracer("analysis_scheduler").Start(ctx, "AnalysisScheduler.runDue")
+	defer span.End()
+
+	talkSessionIDs, err := s.claimDue(ctx)
+	if err != nil {
+		s.logger.Error("分析スケジュールの取得に失敗しました",
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+	if len(talkSessionIDs) == 0 {
+		return
+	}
+
+	s.logger.Info("分析を再計算します",
+		slog.Int("count", len(talkSessionIDs)),
+	)
+
+	var wg sync.WaitGroup
+	for _, talkSessionID := range talkSessionIDs {
+		wg.Add(1)
+		go func(talkSessionID shared.UUID[talksession.TalkSession]) {
+			defer wg.Done()
+			// 失敗はrunの中で記録しているため、ここでは扱わない
+			_ = s.run(ctx, talkSessionID)
+		}(talkSessionID)
+	}
+	wg.Wait()
+}
+
+// claimDue 再計算の条件を満たしたスケジュールを実行中にする。
+// 行ロックの間に実行中にするため、複数のサーバーで同じセッションを同時に分析しない
+func (s *AnalysisScheduler) claimDue(ctx context.Context) ([]shared.UUID[talksession.TalkSession], error) {
+	ctx, span := otel.Tracer("analysis_scheduler").Start(ctx, "AnalysisScheduler.claimDue")
+	defer span.End()
+
+	var talkSessionIDs []shared.UUID[talksession.TalkSession]
+	if err := s.dbManager.ExecTx(ctx, func(ctx context.Context) error {
+		talkSessionIDs = nil
+
+		now := clock.Now(ctx)
+		schedules, err := s.scheduleRepository.FindDueForUpdate(ctx, now, s.policy, s.batchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, schedule := range schedules {
+			if !schedule.IsDue(now, s.policy) {
+				continue
+			}
+			if err := s.scheduleRepository.Start(ctx, schedule.TalkSessionID, now); err != nil {
+				return err
+			}
+			talkSessionIDs = append(talkSessionIDs, schedule.TalkSessionID)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return talkSessionIDs, nil
+}
+
+// RunNow 再計算の条件を待たずにセッションの分析を実行し、終わるまで待つ。
+// スケジューラーと同じ行ロックで実行中にするため、実行中の分析がある場合はAnalysisAlreadyRunningを返す
+func (s *AnalysisScheduler) RunNow(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession]) error {
+	ctx, span := otel.Tracer("analysis_scheduler").Start(ctx, "AnalysisScheduler.RunNow")
+	defer span.End()
+
+	if err := s.dbManager.ExecTx(ctx, func(ctx context.Context) error {
+		now := clock.Now(ctx)
+		schedule, err := s.scheduleRepository.FindByTalkSessionIDForUpdate(ctx, talkSessionID, now)
+		if err != nil {
+			return err
+		}
+		if schedule.IsRunning(now, s.policy) {
+			return messages.AnalysisAlreadyRunning
+		}
+		return s.scheduleRepository.Start(ctx, talkSessionID, now)
+	}); err != nil {
+		return err
+	}
+
+	return s.run(ctx, talkSessionID)
+}
+
+// run セッションの分析を実行し、必要ならレポートの再生成を登録する
+func (s *AnalysisScheduler) run(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession]) error {
+	ctx, span := otel.Tracer("analysis_scheduler").Start(ctx, "AnalysisScheduler.run")
+	defer span.End()
+
+	runCtx, cancel := context.WithTimeout(ctx, s.policy.RunTimeout)
+	defer cancel()
+
+	if err := s.recompute(runCtx, talkSessionID); err != nil {
+		s.logger.Error("分析の再計算に失敗しました",
+			slog.String("talk_session_id", talkSessionID.String()),
+			slog.String("error", err.Error()),
+		)
+		if failErr := s.scheduleRepository.Fail(ctx, talkSessionID, clock.Now(ctx), err.Error()); failErr != nil {
+			s.logger.Error("分析の失敗の記録に失敗しました",
+				slog.String("talk_session_id", talkSessionID.String()),
+				slog.String("error", failErr.Error()),
+			)
+		}
+		return err
+	}
+
+	if err := s.scheduleRepository.Complete(ctx, talkSessionID, clock.Now(ctx)); err != nil {
+		s.logger.Error("分析の完了の記録に失敗しました",
+			slog.String("talk_session_id", talkSessionID.String()),
+			slog.String("error", err.Error()),
+		)
+	}
+	return nil
+}
+
+func (s *AnalysisScheduler) recompute(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession]) error {
+	if err := s.analysisService.StartAnalysis(ctx, talkSessionID); err != nil {
+		return err
+	}
+
+	// レポートが古くなっていれば再生成する。生成には時間がかかるのでジョブとして実行する
+	report, err := s.analysisRepository.FindByTalkSessionID(ctx, talkSessionID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+	if report == nil || !report.ShouldReGenerateReport() {
+		return nil
+	}
+	_, err = s.jobService.Enqueue(ctx, talkSessionID, analysis.AnalysisJobTypeReport)
+	return err
+}
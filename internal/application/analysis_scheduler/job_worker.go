@@ -0,0 +1,217 @@
+package analysis_scheduler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	"go.opentelemetry.io/otel"
+)
+
+// AnalysisJobWorker 登録されたレポート・ワードクラウドの生成ジョブを実行する
+type AnalysisJobWorker struct {
+	jobRepository     analysis.AnalysisJobRepository
+	analysisService   analysis.AnalysisService
+	reportVersionRepo analysis.ReportVersionRepository
+	dbManager         *db.DBManager
+	logger            *slog.Logger
+	interval          time.Duration
+	batchSize         int
+}
+
+func NewAnalysisJobWorker(
+	jobRepository analysis.AnalysisJobRepository,
+	analysisService analysis.AnalysisService,
+	reportVersionRepo analysis.ReportVersionRepository,
+	dbManager *db.DBManager,
+) *AnalysisJobWorker {
+	return &AnalysisJobWorker{
+		jobRepository:     jobRepository,
+		analysisService:   analysisService,
+		reportVersionRepo: reportVersionRepo,
+		dbManager:         dbManager,
+		logger:            slog.Default(),
+		interval:          5 * time.Second,
+		batchSize:         10,
+	}
+}
+
+func (w *AnalysisJobWorker) WithInterval(interval time.Duration) *AnalysisJobWorker {
+	w.interval = interval
+	return w
+}
+
+func (w *AnalysisJobWorker) WithBatchSize(batchSize int) *AnalysisJobWorker {
+	w.batchSize = batchSize
+	return w
+}
+
+func (w *AnalysisJobWorker) WithLogger(logger *slog.Logger) *AnalysisJobWorker {
+	w.logger = logger
+	return w
+}
+
+func (w *AnalysisJobWorker) Start(ctx context.Context) {
+	ctx, span := otel.Tracer("analysis_scheduler").Start(ctx, "AnalysisJobWorker.Start")
+	defer span.End()
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	w.logger.Info("分析ジョブのワーカーを開始しました",
+		slog.Duration("interval", w.interval),
+		slog.Int("batch_size", w.batchSize),
+	)
+
+	w.runJobs(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("分析ジョブのワーカーを停止します")
+			return
+		case <-ticker.C:
+			w.runJobs(ctx)
+		}
+	}
+}
+
+// runJobs 実行できるジョブを実行し、終わるまで待つ
+func (w *AnalysisJobWorker) runJobs(ctx context.Context) {
+	ctx, span := otel.Tracer("analysis_scheduler").Start(ctx, "AnalysisJobWorker.runJobs")
+	defer span.End()
+
+	jobs, err := w.claim(ctx)
+	if err != nil {
+		w.logger.Error("分析ジョブの取得に失敗しました",
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+	if len(jobs) == 0 {
+		return
+	}
+
+	w.logger.Info("分析ジョブを実行します",
+		slog.Int("count", len(jobs)),
+	)
+
+	var wg sync.WaitGroup
+	for _, job := range jobs {
+		wg.Add(1)
+		go func(job *analysis.AnalysisJob) {
+			defer wg.Done()
+			w.run(ctx, job)
+		}(job)
+	}
+	wg.Wait()
+}
+
+// claim 実行できるジョブを実行中にする。
+// 行ロックの間に実行中にするため、複数のサーバーで同じジョブを実行しない
+func (w *AnalysisJobWorker) claim(ctx context.Context) ([]*analysis.AnalysisJob, error) {
+	ctx, span := otel.Tracer("analysis_scheduler").Start(ctx, "AnalysisJobWorker.claim")
+	defer span.End()
+
+	var started []*analysis.AnalysisJob
+	if err := w.dbManager.ExecTx(ctx, func(ctx context.Context) error {
+		started = nil
+
+		now := clock.Now(ctx)
+		jobs, err := w.jobRepository.FindRunnableForUpdate(ctx, now, w.batchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, job := range jobs {
+			// 止まったまま再実行の上限に達したジョブは失敗として保存する
+			run := job.Start(now)
+			if err := w.jobRepository.Update(ctx, job); err != nil {
+				return err
+			}
+			if run {
+				started = append(started, job)
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return started, nil
+}
+
+// run ジョブを実行し、結果を保存する。失敗した場合は上限まで再実行する
+func (w *AnalysisJobWorker) run(ctx context.Context, job *analysis.AnalysisJob) {
+	ctx, span := otel.Tracer("analysis_scheduler").Start(ctx, "AnalysisJobWorker.run")
+	defer span.End()
+
+	runCtx, cancel := context.WithTimeout(ctx, analysis.AnalysisJobRunTimeout)
+	defer cancel()
+
+	published, err := w.execute(runCtx, job)
+	if err != nil {
+		w.logger.Error("分析ジョブに失敗しました",
+			slog.String("job_id", job.JobID().String()),
+			slog.String("job_type", string(job.JobType())),
+			slog.Int("attempts", job.Attempts()),
+			slog.String("error", err.Error()),
+		)
+		job.Fail(clock.Now(ctx), err.Error())
+	} else {
+		job.Succeed(clock.Now(ctx), published)
+	}
+
+	// ジョブの結果と通知のイベントを同じトランザクションで保存し、片方だけが残らないようにする
+	if err := w.dbManager.ExecTx(ctx, func(ctx context.Context) error {
+		return w.jobRepository.Update(ctx, job)
+	}); err != nil {
+		w.logger.Error("分析ジョブの結果の保存に失敗しました",
+			slog.String("job_id", job.JobID().String()),
+			slog.String("error", err.Error()),
+		)
+	}
+}
+
+// execute ジョブを実行する。レポートの場合は公開するバージョンが変わったかを返す
+func (w *AnalysisJobWorker) execute(ctx context.Context, job *analysis.AnalysisJob) (bool, error) {
+	switch job.JobType() {
+	case analysis.AnalysisJobTypeReport:
+		before, err := w.publishedVersionID(ctx, job)
+		if err != nil {
+			return false, err
+		}
+		if err := w.analysisService.GenerateReport(ctx, job.TalkSessionID()); err != nil {
+			return false, err
+		}
+		after, err := w.publishedVersionID(ctx, job)
+		if err != nil {
+			return false, err
+		}
+		// 内容が変わらなかった場合や、管理者がバージョンを固定している場合は通知しない
+		return after != nil && (before == nil || *before != *after), nil
+	case analysis.AnalysisJobTypeWordCloud:
+		_, err := w.analysisService.GenerateImage(ctx, job.TalkSessionID())
+		return false, err
+	default:
+		return false, fmt.Errorf("未対応のジョブの種類: %s", job.JobType())
+	}
+}
+
+// publishedVersionID 公開しているレポートのバージョン。公開していない場合はnil
+func (w *AnalysisJobWorker) publishedVersionID(ctx context.Context, job *analysis.AnalysisJob) (*shared.UUID[analysis.ReportVersion], error) {
+	publication, err := w.reportVersionRepo.FindPublication(ctx, job.TalkSessionID())
+	if err != nil {
+		return nil, err
+	}
+	if publication == nil {
+		return nil, nil
+	}
+	return &publication.ReportVersionID, nil
+}
@@ -0,0 +1,59 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/event"
+	"github.com/neko-dream/api/internal/domain/model/opinion"
+	"github.com/neko-dream/api/internal/domain/model/vote"
+	"go.opentelemetry.io/otel"
+)
+
+// AnalysisActivityHandler 投票・意見の投稿を分析の再計算スケジュールに積む
+type AnalysisActivityHandler struct {
+	analysisScheduleRepository analysis.AnalysisScheduleRepository
+}
+
+func NewAnalysisActivityHandler(
+	analysisScheduleRepository analysis.AnalysisScheduleRepository,
+) *AnalysisActivityHandler {
+	return &AnalysisActivityHandler{
+		analysisScheduleRepository: analysisScheduleRepository,
+	}
+}
+
+// CanHandle このハンドラーがイベントを処理できるかチェック
+func (h *AnalysisActivityHandler) CanHandle(eventType event.EventType) bool {
+	return eventType == vote.EventTypeVoteChanged ||
+		eventType == opinion.EventTypeOpinionSubmitted
+}
+
+// Handle セッションの分析を待っている投票数・意見数を加算する
+func (h *AnalysisActivityHandler) Handle(ctx context.Context, storedEvent event.StoredEvent) error {
+	ctx, span := otel.Tracer("handlers").Start(ctx, "AnalysisActivityHandler.Handle")
+	defer span.End()
+
+	switch storedEvent.EventType {
+	case vote.EventTypeVoteChanged:
+		var evt vote.VoteChangedEvent
+		if err := json.Unmarshal(storedEvent.EventData, &evt); err != nil {
+			return fmt.Errorf("イベントのデシリアライズに失敗しました: %w", err)
+		}
+		return h.analysisScheduleRepository.RecordActivity(ctx, evt.TalkSessionID, 1, 0, storedEvent.OccurredAt)
+	case opinion.EventTypeOpinionSubmitted:
+		var evt opinion.OpinionSubmittedEvent
+		if err := json.Unmarshal(storedEvent.EventData, &evt); err != nil {
+			return fmt.Errorf("イベントのデシリアライズに失敗しました: %w", err)
+		}
+		return h.analysisScheduleRepository.RecordActivity(ctx, evt.TalkSessionID, 0, 1, storedEvent.OccurredAt)
+	default:
+		return fmt.Errorf("未対応のイベントタイプ: %s", storedEvent.EventType)
+	}
+}
+
+func (h *AnalysisActivityHandler) Priority() int {
+	return 100
+}
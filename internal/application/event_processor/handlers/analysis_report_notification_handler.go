@@ -0,0 +1,104 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/event"
+	"github.com/neko-dream/api/internal/domain/model/notification"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"go.opentelemetry.io/otel"
+)
+
+// AnalysisReportPushNotificationHandler 新しい分析レポートができたことをセッションの参加者に通知する
+// 同じセッションの通知はanalysis.ReportNotificationIntervalに1回までにする
+type AnalysisReportPushNotificationHandler struct {
+	pushNotificationSender  notification.PushNotificationSender
+	talkSessionRepository   talksession.TalkSessionRepository
+	reportVersionRepository analysis.ReportVersionRepository
+	logger                  *slog.Logger
+}
+
+func NewAnalysisReportPushNotificationHandler(
+	pushNotificationSender notification.PushNotificationSender,
+	talkSessionRepository talksession.TalkSessionRepository,
+	reportVersionRepository analysis.ReportVersionRepository,
+) *AnalysisReportPushNotificationHandler {
+	return &AnalysisReportPushNotificationHandler{
+		pushNotificationSender:  pushNotificationSender,
+		talkSessionRepository:   talkSessionRepository,
+		reportVersionRepository: reportVersionRepository,
+		logger:                  slog.Default(),
+	}
+}
+
+// CanHandle このハンドラーがイベントを処理できるかチェック
+func (h *AnalysisReportPushNotificationHandler) CanHandle(eventType event.EventType) bool {
+	return eventType == analysis.EventTypeAnalysisReportGenerated
+}
+
+func (h *AnalysisReportPushNotificationHandler) Handle(ctx context.Context, storedEvent event.StoredEvent) error {
+	ctx, span := otel.Tracer("handlers").Start(ctx, "AnalysisReportPushNotificationHandler.Handle")
+	defer span.End()
+
+	var evt analysis.AnalysisReportGeneratedEvent
+	if err := json.Unmarshal(storedEvent.EventData, &evt); err != nil {
+		return fmt.Errorf("イベントのデシリアライズに失敗しました: %w", err)
+	}
+
+	session, err := h.talkSessionRepository.FindByID(ctx, evt.TalkSessionID)
+	if err != nil {
+		return fmt.Errorf("セッションの取得に失敗しました: %w", err)
+	}
+	if session == nil {
+		return fmt.Errorf("セッションが見つかりません: %s", evt.TalkSessionID.String())
+	}
+	// レポートを非公開にしているセッションは通知しない
+	if session.HideReport() {
+		return nil
+	}
+
+	claimed, err := h.reportVersionRepository.ClaimNotification(ctx, evt.TalkSessionID, clock.Now(ctx), analysis.ReportNotificationInterval)
+	if err != nil {
+		return fmt.Errorf("通知日時の記録に失敗しました: %w", err)
+	}
+	if !claimed {
+		h.logger.Info("直近に通知しているため通知しません",
+			slog.String("session_id", evt.TalkSessionID.String()),
+		)
+		return nil
+	}
+
+	participantIDs, err := h.talkSessionRepository.GetParticipantIDs(ctx, evt.TalkSessionID)
+	if err != nil {
+		return fmt.Errorf("参加者の取得に失敗しました: %w", err)
+	}
+	if len(participantIDs) == 0 {
+		h.logger.Info("参加者がいません",
+			slog.String("session_id", evt.TalkSessionID.String()),
+		)
+		return nil
+	}
+
+	notifications := make([]*notification.PushNotification, 0, len(participantIDs))
+	for _, userID := range participantIDs {
+		notif := notification.NewPushNotification(
+			userID,
+			notification.PushNotificationTypeAnalysisReportReady,
+			"新しいレポートができました",
+			fmt.Sprintf("「%s」の分析レポートが更新されました", session.Theme()),
+		)
+		notif.AddData("talk_session_id", evt.TalkSessionID.String())
+		notif.AddData("action", "open_analysis_report")
+		notifications = append(notifications, notif)
+	}
+	return h.pushNotificationSender.SendBatch(ctx, notifications)
+}
+
+func (h *AnalysisReportPushNotificationHandler) Priority() int {
+	return 100
+}
@@ -0,0 +1,125 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/neko-dream/api/internal/domain/model/event"
+	"github.com/neko-dream/api/internal/domain/model/notification"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/ownership"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel"
+)
+
+// OwnershipTransferPushNotificationHandler オーナー権限の譲渡依頼を関係するユーザーに通知する
+type OwnershipTransferPushNotificationHandler struct {
+	pushNotificationSender notification.PushNotificationSender
+	talkSessionRepository  talksession.TalkSessionRepository
+	organizationRepository organization.OrganizationRepository
+}
+
+func NewOwnershipTransferPushNotificationHandler(
+	pushNotificationSender notification.PushNotificationSender,
+	talkSessionRepository talksession.TalkSessionRepository,
+	organizationRepository organization.OrganizationRepository,
+) *OwnershipTransferPushNotificationHandler {
+	return &OwnershipTransferPushNotificationHandler{
+		pushNotificationSender: pushNotificationSender,
+		talkSessionRepository:  talkSessionRepository,
+		organizationRepository: organizationRepository,
+	}
+}
+
+// CanHandle このハンドラーがイベントを処理できるかチェック
+func (h *OwnershipTransferPushNotificationHandler) CanHandle(eventType event.EventType) bool {
+	return eventType == ownership.EventTypeOwnershipTransferRequested ||
+		eventType == ownership.EventTypeOwnershipTransferAccepted ||
+		eventType == ownership.EventTypeOwnershipTransferDeclined
+}
+
+// Handle 依頼時は譲渡先に、承諾・辞退時は依頼者と譲渡元に通知する
+func (h *OwnershipTransferPushNotificationHandler) Handle(ctx context.Context, storedEvent event.StoredEvent) error {
+	ctx, span := otel.Tracer("handlers").Start(ctx, "OwnershipTransferPushNotificationHandler.Handle")
+	defer span.End()
+
+	var evt ownership.OwnershipTransferEvent
+	if err := json.Unmarshal(storedEvent.EventData, &evt); err != nil {
+		return fmt.Errorf("イベントのデシリアライズに失敗しました: %w", err)
+	}
+
+	targetName, err := h.targetName(ctx, evt)
+	if err != nil {
+		return err
+	}
+
+	var (
+		recipients []shared.UUID[user.User]
+		title      string
+		body       string
+	)
+	switch storedEvent.EventType {
+	case ownership.EventTypeOwnershipTransferRequested:
+		recipients = []shared.UUID[user.User]{evt.ToUserID}
+		title = "オーナー権限の譲渡依頼が届きました"
+		body = fmt.Sprintf("「%s」のオーナー権限の譲渡を依頼されました", targetName)
+	case ownership.EventTypeOwnershipTransferAccepted:
+		recipients = lo.Uniq([]shared.UUID[user.User]{evt.RequestedBy, evt.FromUserID})
+		title = "オーナー権限の譲渡が承諾されました"
+		body = fmt.Sprintf("「%s」のオーナー権限が譲渡されました", targetName)
+	case ownership.EventTypeOwnershipTransferDeclined:
+		recipients = lo.Uniq([]shared.UUID[user.User]{evt.RequestedBy, evt.FromUserID})
+		title = "オーナー権限の譲渡が辞退されました"
+		body = fmt.Sprintf("「%s」のオーナー権限の譲渡依頼が辞退されました", targetName)
+	default:
+		return fmt.Errorf("未対応のイベントタイプ: %s", storedEvent.EventType)
+	}
+
+	notifications := make([]*notification.PushNotification, 0, len(recipients))
+	for _, userID := range recipients {
+		notif := notification.NewPushNotification(
+			userID,
+			notification.PushNotificationTypeOwnershipTransfer,
+			title,
+			body,
+		)
+		notif.AddData("transfer_id", evt.TransferID.String())
+		notif.AddData("action", "open_ownership_transfer")
+		notifications = append(notifications, notif)
+	}
+	return h.pushNotificationSender.SendBatch(ctx, notifications)
+}
+
+func (h *OwnershipTransferPushNotificationHandler) Priority() int {
+	return 100
+}
+
+// targetName 通知に表示するセッションのテーマか組織名を取得する
+func (h *OwnershipTransferPushNotificationHandler) targetName(ctx context.Context, evt ownership.OwnershipTransferEvent) (string, error) {
+	switch evt.TargetType {
+	case ownership.TransferTargetTalkSession:
+		if evt.TalkSessionID == nil {
+			return "", fmt.Errorf("セッションIDがありません: %s", evt.TransferID.String())
+		}
+		session, err := h.talkSessionRepository.FindByID(ctx, *evt.TalkSessionID)
+		if err != nil {
+			return "", fmt.Errorf("セッションの取得に失敗しました: %w", err)
+		}
+		return session.Theme(), nil
+	case ownership.TransferTargetOrganization:
+		if evt.OrganizationID == nil {
+			return "", fmt.Errorf("組織IDがありません: %s", evt.TransferID.String())
+		}
+		org, err := h.organizationRepository.FindByID(ctx, *evt.OrganizationID)
+		if err != nil {
+			return "", fmt.Errorf("組織の取得に失敗しました: %w", err)
+		}
+		return org.Name, nil
+	default:
+		return "", fmt.Errorf("未対応の譲渡対象: %s", evt.TargetType)
+	}
+}
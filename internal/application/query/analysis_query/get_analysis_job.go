@@ -0,0 +1,25 @@
+package analysis_query
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/application/query/dto"
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+)
+
+type (
+	GetAnalysisJobQuery interface {
+		Execute(context.Context, GetAnalysisJobInput) (*GetAnalysisJobOutput, error)
+	}
+
+	GetAnalysisJobInput struct {
+		TalkSessionID shared.UUID[talksession.TalkSession]
+		JobID         shared.UUID[analysis.AnalysisJob]
+	}
+
+	GetAnalysisJobOutput struct {
+		Job dto.AnalysisJob
+	}
+)
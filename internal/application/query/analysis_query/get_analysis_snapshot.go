@@ -0,0 +1,29 @@
+package analysis_query
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/application/query/dto"
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+)
+
+type (
+	GetAnalysisSnapshotQuery interface {
+		Execute(context.Context, GetAnalysisSnapshotInput) (*GetAnalysisSnapshotOutput, error)
+	}
+
+	GetAnalysisSnapshotInput struct {
+		TalkSessionID      shared.UUID[talksession.TalkSession]
+		AnalysisSnapshotID shared.UUID[analysis.AnalysisSnapshot]
+	}
+
+	GetAnalysisSnapshotOutput struct {
+		Snapshot dto.AnalysisSnapshot
+		// Positions スナップショット時点の全てのポジション情報
+		Positions []dto.UserPosition
+		// GroupOpinions スナップショット時点のグループごとの代表意見
+		GroupOpinions []dto.OpinionGroup
+	}
+)
@@ -0,0 +1,29 @@
+package analysis_query
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/application/query/dto"
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+)
+
+type (
+	GetAnalysisSnapshotDiffQuery interface {
+		Execute(context.Context, GetAnalysisSnapshotDiffInput) (*GetAnalysisSnapshotDiffOutput, error)
+	}
+
+	GetAnalysisSnapshotDiffInput struct {
+		TalkSessionID shared.UUID[talksession.TalkSession]
+		From          shared.UUID[analysis.AnalysisSnapshot]
+		To            shared.UUID[analysis.AnalysisSnapshot]
+	}
+
+	GetAnalysisSnapshotDiffOutput struct {
+		From dto.AnalysisSnapshot
+		To   dto.AnalysisSnapshot
+		// Moves グループが変わったユーザー
+		Moves []dto.GroupMove
+	}
+)
@@ -0,0 +1,24 @@
+package analysis_query
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/application/query/dto"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+)
+
+type (
+	GetAnalysisSnapshotsQuery interface {
+		Execute(context.Context, GetAnalysisSnapshotsInput) (*GetAnalysisSnapshotsOutput, error)
+	}
+
+	GetAnalysisSnapshotsInput struct {
+		TalkSessionID shared.UUID[talksession.TalkSession]
+	}
+
+	GetAnalysisSnapshotsOutput struct {
+		// Snapshots 古い順のスナップショット
+		Snapshots []dto.AnalysisSnapshot
+	}
+)
@@ -0,0 +1,23 @@
+package analysis_query
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/application/query/dto"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+)
+
+type (
+	GetAnalysisStatusQuery interface {
+		Execute(context.Context, GetAnalysisStatusInput) (*GetAnalysisStatusOutput, error)
+	}
+
+	GetAnalysisStatusInput struct {
+		TalkSessionID shared.UUID[talksession.TalkSession]
+	}
+
+	GetAnalysisStatusOutput struct {
+		Status dto.AnalysisStatus
+	}
+)
@@ -0,0 +1,26 @@
+package analysis_query
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/application/query/dto"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+)
+
+type (
+	GetConsensusQuery interface {
+		Execute(context.Context, GetConsensusInput) (*GetConsensusOutput, error)
+	}
+
+	GetConsensusInput struct {
+		TalkSessionID shared.UUID[talksession.TalkSession]
+	}
+
+	GetConsensusOutput struct {
+		// Consensus 全てのグループが賛成している順の意見
+		Consensus []dto.OpinionConsensus
+		// Divisive グループで賛否が分かれている順の意見。グループが1つ以下の場合は空
+		Divisive []dto.OpinionConsensus
+	}
+)
@@ -0,0 +1,33 @@
+package analysis_query
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/application/query/dto"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/user"
+)
+
+type (
+	GetMyPositionQuery interface {
+		Execute(context.Context, GetMyPositionInput) (*GetMyPositionOutput, error)
+	}
+
+	GetMyPositionInput struct {
+		TalkSessionID shared.UUID[talksession.TalkSession]
+		UserID        shared.UUID[user.User]
+	}
+
+	GetMyPositionOutput struct {
+		Position dto.UserPosition
+		// Distances 各グループの中心までの距離。近い順
+		Distances []dto.GroupDistance
+		// AgreeWithGroup 自分の投票がグループと最も一致している意見
+		AgreeWithGroup []dto.OpinionAlignment
+		// DisagreeWithGroup 自分の投票がグループと最も食い違っている意見
+		DisagreeWithGroup []dto.OpinionAlignment
+		// Suggestions まだ投票していない、同じグループのメンバーが賛成している意見
+		Suggestions []dto.OpinionSuggestion
+	}
+)
@@ -3,6 +3,8 @@ package analysis_query
 import (
 	"context"
 
+	"github.com/neko-dream/api/internal/application/query/dto"
+	"github.com/neko-dream/api/internal/domain/model/analysis"
 	"github.com/neko-dream/api/internal/domain/model/shared"
 	"github.com/neko-dream/api/internal/domain/model/talksession"
 )
@@ -18,5 +20,11 @@ type (
 
 	GetReportOutput struct {
 		Report *string
+		// ReportID フィードバックの対象となる公開中のバージョン。バージョンがない場合はnil
+		ReportID *shared.UUID[analysis.AnalysisReport]
+		// Sections 構造化されたレポート。分析APIで生成した場合はnil
+		Sections *analysis.ReportSections
+		// ImportantOpinions グループごとの重要だと印を付けられた意見。グループID順
+		ImportantOpinions []dto.GroupImportantOpinions
 	}
 )
@@ -0,0 +1,28 @@
+package analysis_query
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/application/query/dto"
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+)
+
+type (
+	GetReportVersionDiffQuery interface {
+		Execute(context.Context, GetReportVersionDiffInput) (*GetReportVersionDiffOutput, error)
+	}
+
+	GetReportVersionDiffInput struct {
+		TalkSessionID shared.UUID[talksession.TalkSession]
+		From          shared.UUID[analysis.ReportVersion]
+		To            shared.UUID[analysis.ReportVersion]
+	}
+
+	GetReportVersionDiffOutput struct {
+		From  dto.ReportVersion
+		To    dto.ReportVersion
+		Lines []analysis.ReportDiffLine
+	}
+)
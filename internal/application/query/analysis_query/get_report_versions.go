@@ -0,0 +1,24 @@
+package analysis_query
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/application/query/dto"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+)
+
+type (
+	GetReportVersionsQuery interface {
+		Execute(context.Context, GetReportVersionsInput) (*GetReportVersionsOutput, error)
+	}
+
+	GetReportVersionsInput struct {
+		TalkSessionID shared.UUID[talksession.TalkSession]
+	}
+
+	GetReportVersionsOutput struct {
+		// Versions 新しい順
+		Versions []dto.ReportVersion
+	}
+)
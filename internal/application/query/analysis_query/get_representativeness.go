@@ -0,0 +1,26 @@
+package analysis_query
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+)
+
+type (
+	GetRepresentativenessQuery interface {
+		Execute(context.Context, GetRepresentativenessInput) (*GetRepresentativenessOutput, error)
+	}
+
+	GetRepresentativenessInput struct {
+		OrganizationID shared.UUID[organization.Organization]
+		TalkSessionID  shared.UUID[talksession.TalkSession]
+	}
+
+	GetRepresentativenessOutput struct {
+		// Dimensions 組織が母集団の構成を登録した属性ごとの比較。属性順
+		Dimensions []analysis.Representativeness
+	}
+)
@@ -0,0 +1,23 @@
+package analysis_query
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+)
+
+type (
+	GetTermFrequenciesQuery interface {
+		Execute(context.Context, GetTermFrequenciesInput) (*GetTermFrequenciesOutput, error)
+	}
+
+	GetTermFrequenciesInput struct {
+		TalkSessionID shared.UUID[talksession.TalkSession]
+	}
+
+	GetTermFrequenciesOutput struct {
+		TermFrequencies analysis.TermFrequencies
+	}
+)
@@ -0,0 +1,51 @@
+package analysis_query
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/neko-dream/api/internal/application/query/dto"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/samber/lo"
+)
+
+type (
+	GetTopicsQuery interface {
+		Execute(context.Context, GetTopicsInput) (*GetTopicsOutput, error)
+	}
+
+	GetTopicsInput struct {
+		TalkSessionID shared.UUID[talksession.TalkSession]
+		// OpinionLimit トピックごとに返す意見の数
+		OpinionLimit *int
+		// OpinionOffset トピックごとの意見の開始位置
+		OpinionOffset *int
+	}
+
+	GetTopicsOutput struct {
+		// Topics 意見が多い順のトピック
+		Topics []dto.Topic
+		// UnassignedOpinionCount 内容を表す単語がなく、どのトピックにも入らなかった意見の数
+		UnassignedOpinionCount int
+	}
+)
+
+func (i *GetTopicsInput) Validate() error {
+	var err error
+
+	if i.OpinionLimit == nil {
+		i.OpinionLimit = lo.ToPtr(10)
+	} else if *i.OpinionLimit <= 0 || *i.OpinionLimit > 100 {
+		err = errors.Join(err, fmt.Errorf("OpinionLimitは1から100の間で指定してください"))
+	}
+
+	if i.OpinionOffset == nil {
+		i.OpinionOffset = lo.ToPtr(0)
+	} else if *i.OpinionOffset < 0 {
+		err = errors.Join(err, fmt.Errorf("OpinionOffsetは0以上の値を指定してください"))
+	}
+
+	return err
+}
@@ -0,0 +1,32 @@
+package analysis_query
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/application/query/dto"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+)
+
+type (
+	GetVoteShiftsQuery interface {
+		Execute(context.Context, GetVoteShiftsInput) (*GetVoteShiftsOutput, error)
+	}
+
+	GetVoteShiftsInput struct {
+		TalkSessionID shared.UUID[talksession.TalkSession]
+	}
+
+	GetVoteShiftsOutput struct {
+		// VoterCount 投票したユーザー数
+		VoterCount int
+		// ChangeCount 投票が変更された回数
+		ChangeCount int
+		// ChangedUserCount 投票を変更したユーザー数
+		ChangedUserCount int
+		// Shifts セッション全体の投票の変化。意見ごとの変化を合計したもの
+		Shifts []dto.VoteShift
+		// Opinions 投票が変更された意見ごとの変化
+		Opinions []dto.OpinionVoteShift
+	}
+)
@@ -2,6 +2,7 @@ package dto
 
 import (
 	"context"
+	"time"
 
 	"github.com/neko-dream/api/internal/domain/model/analysis"
 	"github.com/neko-dream/api/internal/presentation/oas"
@@ -31,6 +32,23 @@ type OpinionGroup struct {
 	GroupName string
 	GroupID   int
 	Opinions  []OpinionWithRepresentative
+	// ImportantOpinions グループのメンバーが重要だと印を付けた数が多い順の意見
+	ImportantOpinions []ImportantOpinion
+}
+
+// GroupImportantOpinions グループのメンバーが重要だと印を付けた数が多い順の意見
+type GroupImportantOpinions struct {
+	GroupName string
+	GroupID   int
+	Opinions  []ImportantOpinion
+}
+
+// ImportantOpinion グループのメンバーが重要だと印を付けた意見
+type ImportantOpinion struct {
+	Opinion
+	User
+	GroupID        int
+	ImportantCount int
 }
 
 type OpinionGroupRatio struct {
@@ -39,6 +57,15 @@ type OpinionGroupRatio struct {
 	AgreeCount    int
 	DisagreeCount int
 	PassCount     int
+	// Weighted 事後層化の重みを付けた投票の割合。重みを指定しない場合はnil
+	Weighted *WeightedVoteRates
+}
+
+type WeightedVoteRates struct {
+	Dimension    string
+	AgreeRate    float64
+	DisagreeRate float64
+	PassRate     float64
 }
 
 func (u *UserPosition) ToResponse() oas.UserGroupPosition {
@@ -55,11 +82,389 @@ func (u *UserPosition) ToResponse() oas.UserGroupPosition {
 }
 
 func (o *OpinionGroupRatio) ToResponse() oas.OpinionGroupRatio {
-	return oas.OpinionGroupRatio{
+	res := oas.OpinionGroupRatio{
 		GroupName:     o.GroupName,
 		GroupID:       o.GroupID,
 		AgreeCount:    o.AgreeCount,
 		DisagreeCount: o.DisagreeCount,
 		PassCount:     o.PassCount,
 	}
+	if o.Weighted != nil {
+		res.Weighted = oas.NewOptWeightedVoteRates(oas.WeightedVoteRates{
+			Dimension:    oas.DemographicDimension(o.Weighted.Dimension),
+			AgreeRate:    o.Weighted.AgreeRate,
+			DisagreeRate: o.Weighted.DisagreeRate,
+			PassRate:     o.Weighted.PassRate,
+		})
+	}
+	return res
+}
+
+// VoteShift 最初の投票から最後の投票への変化
+type VoteShift struct {
+	From string
+	To   string
+	// UserCount 変化したユーザー数。セッション全体では意見ごとの人数の合計
+	UserCount int
+	// AfterRepliesUserCount 返信に投票してから変化したユーザー数
+	AfterRepliesUserCount int
+}
+
+// OpinionVoteShift 意見ごとの投票の変化
+type OpinionVoteShift struct {
+	OpinionID        string
+	ChangeCount      int
+	ChangedUserCount int
+	Shifts           []VoteShift
+}
+
+func (v *VoteShift) ToResponse() oas.VoteShift {
+	return oas.VoteShift{
+		From:                  oas.VoteShiftFrom(v.From),
+		To:                    oas.VoteShiftTo(v.To),
+		UserCount:             v.UserCount,
+		AfterRepliesUserCount: v.AfterRepliesUserCount,
+	}
+}
+
+func (o *OpinionVoteShift) ToResponse() oas.OpinionVoteShift {
+	shifts := make([]oas.VoteShift, 0, len(o.Shifts))
+	for _, shift := range o.Shifts {
+		shifts = append(shifts, shift.ToResponse())
+	}
+	return oas.OpinionVoteShift{
+		OpinionID:        o.OpinionID,
+		ChangeCount:      o.ChangeCount,
+		ChangedUserCount: o.ChangedUserCount,
+		Shifts:           shifts,
+	}
+}
+
+func (i *ImportantOpinion) ToResponse() oas.ImportantOpinion {
+	return oas.ImportantOpinion{
+		Opinion: i.Opinion.ToResponse(),
+		User: oas.User{
+			DisplayID:   i.User.DisplayID,
+			DisplayName: i.User.DisplayName,
+			IconURL:     utils.ToOptNil[oas.OptNilString](i.User.IconURL),
+		},
+		ImportantCount: i.ImportantCount,
+	}
+}
+
+func (g *GroupImportantOpinions) ToResponse() oas.GroupImportantOpinions {
+	opinions := make([]oas.ImportantOpinion, 0, len(g.Opinions))
+	for _, op := range g.Opinions {
+		opinions = append(opinions, op.ToResponse())
+	}
+	return oas.GroupImportantOpinions{
+		GroupName: g.GroupName,
+		GroupID:   g.GroupID,
+		Opinions:  opinions,
+	}
+}
+
+// AnalysisSnapshot ある時点の分析結果
+type AnalysisSnapshot struct {
+	AnalysisSnapshotID string
+	UserCount          int
+	GroupCount         int
+	CreatedAt          time.Time
+}
+
+// GroupMove スナップショット間でのユーザーのグループの移動
+// 前のスナップショットで分類されていない場合はFromGroupIDがnil、後のスナップショットで分類されていない場合はToGroupIDがnil
+type GroupMove struct {
+	User
+	FromGroupID *int
+	ToGroupID   *int
+}
+
+func (a *AnalysisSnapshot) ToResponse() oas.AnalysisSnapshot {
+	return oas.AnalysisSnapshot{
+		SnapshotID: a.AnalysisSnapshotID,
+		UserCount:  a.UserCount,
+		GroupCount: a.GroupCount,
+		CreatedAt:  a.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func (g *GroupMove) ToResponse() oas.GroupMove {
+	move := oas.GroupMove{
+		User: oas.User{
+			DisplayID:   g.User.DisplayID,
+			DisplayName: g.User.DisplayName,
+			IconURL:     utils.ToOptNil[oas.OptNilString](g.User.IconURL),
+		},
+	}
+	if g.FromGroupID != nil {
+		move.FromGroupID = oas.NewOptInt(*g.FromGroupID)
+		move.FromGroupName = oas.NewOptString(analysis.NewGroupIDFromInt(*g.FromGroupID).String())
+	}
+	if g.ToGroupID != nil {
+		move.ToGroupID = oas.NewOptInt(*g.ToGroupID)
+		move.ToGroupName = oas.NewOptString(analysis.NewGroupIDFromInt(*g.ToGroupID).String())
+	}
+	return move
+}
+
+// OpinionConsensus 意見ごとのグループ間の合意度と対立度
+type OpinionConsensus struct {
+	Opinion
+	User
+	Consensus    float64
+	Divisiveness float64
+	Groups       []GroupAgreement
+}
+
+// GroupAgreement グループごとの意見への投票数と賛成確率
+type GroupAgreement struct {
+	GroupName        string
+	GroupID          int
+	AgreeCount       int
+	DisagreeCount    int
+	PassCount        int
+	AgreeProbability float64
+}
+
+func (o *OpinionConsensus) ToResponse() oas.OpinionConsensus {
+	groups := make([]oas.GroupAgreement, 0, len(o.Groups))
+	for _, group := range o.Groups {
+		groups = append(groups, group.ToResponse())
+	}
+	return oas.OpinionConsensus{
+		Opinion: o.Opinion.ToResponse(),
+		User: oas.User{
+			DisplayID:   o.User.DisplayID,
+			DisplayName: o.User.DisplayName,
+			IconURL:     utils.ToOptNil[oas.OptNilString](o.User.IconURL),
+		},
+		Consensus:    o.Consensus,
+		Divisiveness: o.Divisiveness,
+		Groups:       groups,
+	}
+}
+
+// AnalysisStatus 分析の再計算の状況
+type AnalysisStatus struct {
+	Status              analysis.RecomputeStatus
+	PendingVoteCount    int
+	PendingOpinionCount int
+	PendingSince        *time.Time
+	NextRunAt           *time.Time
+	RunningSince        *time.Time
+	LastCompletedAt     *time.Time
+	LastFailedAt        *time.Time
+}
+
+func (a *AnalysisStatus) ToResponse() oas.AnalysisStatus {
+	return oas.AnalysisStatus{
+		Status:              oas.AnalysisStatusStatus(a.Status),
+		PendingVoteCount:    a.PendingVoteCount,
+		PendingOpinionCount: a.PendingOpinionCount,
+		PendingSince:        toOptTime(a.PendingSince),
+		NextRunAt:           toOptTime(a.NextRunAt),
+		RunningSince:        toOptTime(a.RunningSince),
+		LastCompletedAt:     toOptTime(a.LastCompletedAt),
+		LastFailedAt:        toOptTime(a.LastFailedAt),
+	}
+}
+
+// AnalysisJob レポート・ワードクラウドの生成ジョブ
+type AnalysisJob struct {
+	JobID       string
+	Type        analysis.AnalysisJobType
+	Status      analysis.AnalysisJobStatus
+	Attempts    int
+	MaxAttempts int
+	NextRunAt   *time.Time
+	StartedAt   *time.Time
+	FinishedAt  *time.Time
+	CreatedAt   time.Time
+}
+
+func (a *AnalysisJob) ToResponse() oas.AnalysisJob {
+	return oas.AnalysisJob{
+		JobID:       a.JobID,
+		Type:        oas.AnalysisJobType(a.Type),
+		Status:      oas.AnalysisJobStatus(a.Status),
+		Attempts:    a.Attempts,
+		MaxAttempts: a.MaxAttempts,
+		NextRunAt:   toOptTime(a.NextRunAt),
+		StartedAt:   toOptTime(a.StartedAt),
+		FinishedAt:  toOptTime(a.FinishedAt),
+		CreatedAt:   a.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func toOptTime(t *time.Time) oas.OptString {
+	if t == nil {
+		return oas.OptString{}
+	}
+	return oas.NewOptString(t.Format(time.RFC3339))
+}
+
+// ReportVersion 生成されたレポートのバージョンとフィードバックの件数
+type ReportVersion struct {
+	VersionID string
+	Version   int
+	Generator string
+	Model     *string
+	CreatedAt time.Time
+	GoodCount int
+	BadCount  int
+	// Published 公開しているかどうか
+	Published bool
+	// Pinned 公開するバージョンとして固定されているかどうか
+	Pinned bool
+}
+
+func (v *ReportVersion) ToResponse() oas.ReportVersionForManage {
+	res := oas.ReportVersionForManage{
+		VersionID: v.VersionID,
+		Version:   int32(v.Version),
+		Generator: v.Generator,
+		CreatedAt: v.CreatedAt,
+		GoodCount: int32(v.GoodCount),
+		BadCount:  int32(v.BadCount),
+		Published: v.Published,
+		Pinned:    v.Pinned,
+	}
+	if v.Model != nil {
+		res.Model = oas.NewOptString(*v.Model)
+	}
+	return res
+}
+
+// Topic 本文の単語が似ている意見のまとまりと、意見への投票数の合計
+type Topic struct {
+	TopicID int
+	// Terms トピックで重要な順の単語
+	Terms         []string
+	AgreeCount    int
+	DisagreeCount int
+	PassCount     int
+	// Groups グループごとの投票数の合計。グループID順
+	Groups []GroupAgreement
+	// OpinionCount トピックに属する意見の数
+	OpinionCount int
+	// Opinions 投票が多い順の意見のうち、指定された範囲
+	Opinions []TopicOpinion
+}
+
+// TopicOpinion トピックに属する意見と投票数
+type TopicOpinion struct {
+	Opinion
+	User
+	AgreeCount    int
+	DisagreeCount int
+	PassCount     int
+}
+
+func (t *Topic) ToResponse() oas.Topic {
+	groups := make([]oas.GroupAgreement, 0, len(t.Groups))
+	for _, group := range t.Groups {
+		groups = append(groups, group.ToResponse())
+	}
+	opinions := make([]oas.TopicOpinion, 0, len(t.Opinions))
+	for _, op := range t.Opinions {
+		opinions = append(opinions, oas.TopicOpinion{
+			Opinion: op.Opinion.ToResponse(),
+			User: oas.User{
+				DisplayID:   op.User.DisplayID,
+				DisplayName: op.User.DisplayName,
+				IconURL:     utils.ToOptNil[oas.OptNilString](op.User.IconURL),
+			},
+			AgreeCount:    op.AgreeCount,
+			DisagreeCount: op.DisagreeCount,
+			PassCount:     op.PassCount,
+		})
+	}
+	return oas.Topic{
+		TopicID:       t.TopicID,
+		Terms:         t.Terms,
+		OpinionCount:  t.OpinionCount,
+		AgreeCount:    t.AgreeCount,
+		DisagreeCount: t.DisagreeCount,
+		PassCount:     t.PassCount,
+		Groups:        groups,
+		Opinions:      opinions,
+	}
+}
+
+// GroupDistance 自分の位置からグループの中心までの距離
+type GroupDistance struct {
+	GroupName   string
+	GroupID     int
+	MemberCount int
+	CentroidX   float64
+	CentroidY   float64
+	Distance    float64
+}
+
+// OpinionAlignment 自分の投票とグループの投票の一致度
+type OpinionAlignment struct {
+	Opinion
+	User
+	// MyVoteType 自分の投票。agree か disagree
+	MyVoteType string
+	// Group 自分の投票を除いたグループの投票数
+	Group GroupAgreement
+	// Alignment グループが自分と同じ投票をする確率
+	Alignment float64
+}
+
+// OpinionSuggestion まだ投票していない、同じグループのメンバーが賛成している意見
+type OpinionSuggestion struct {
+	Opinion
+	User
+	Group GroupAgreement
+}
+
+func (g *GroupDistance) ToResponse() oas.GroupDistance {
+	return oas.GroupDistance{
+		GroupName:   g.GroupName,
+		GroupID:     g.GroupID,
+		MemberCount: g.MemberCount,
+		CentroidX:   g.CentroidX,
+		CentroidY:   g.CentroidY,
+		Distance:    g.Distance,
+	}
+}
+
+func (o *OpinionAlignment) ToResponse() oas.OpinionAlignment {
+	return oas.OpinionAlignment{
+		Opinion: o.Opinion.ToResponse(),
+		User: oas.User{
+			DisplayID:   o.User.DisplayID,
+			DisplayName: o.User.DisplayName,
+			IconURL:     utils.ToOptNil[oas.OptNilString](o.User.IconURL),
+		},
+		MyVoteType: oas.OpinionAlignmentMyVoteType(o.MyVoteType),
+		Group:      o.Group.ToResponse(),
+		Alignment:  o.Alignment,
+	}
+}
+
+func (o *OpinionSuggestion) ToResponse() oas.OpinionSuggestion {
+	return oas.OpinionSuggestion{
+		Opinion: o.Opinion.ToResponse(),
+		User: oas.User{
+			DisplayID:   o.User.DisplayID,
+			DisplayName: o.User.DisplayName,
+			IconURL:     utils.ToOptNil[oas.OptNilString](o.User.IconURL),
+		},
+		Group: o.Group.ToResponse(),
+	}
+}
+
+func (g *GroupAgreement) ToResponse() oas.GroupAgreement {
+	return oas.GroupAgreement{
+		GroupName:        g.GroupName,
+		GroupID:          g.GroupID,
+		AgreeCount:       g.AgreeCount,
+		DisagreeCount:    g.DisagreeCount,
+		PassCount:        g.PassCount,
+		AgreeProbability: g.AgreeProbability,
+	}
 }
@@ -1,6 +1,7 @@
 package dto
 
 import (
+	"database/sql"
 	"time"
 
 	"github.com/neko-dream/api/internal/domain/model/opinion"
@@ -25,6 +26,10 @@ type Opinion struct {
 	PictureURL      *string
 	ReferenceURL    *string
 	IsDeleted       bool
+	// EditedAt 投稿者が最後に編集した日時
+	EditedAt sql.NullTime
+	// DeletedAt 投稿者が削除した日時
+	DeletedAt sql.NullTime
 }
 
 type SwipeOpinion struct {
@@ -77,6 +82,28 @@ func (s *Opinion) Mask(reports []model.OpinionReport) {
 	s.IsDeleted = true
 }
 
+// MaskDeleted 投稿者が削除した意見の内容を置き換える
+func (s *SwipeOpinion) MaskDeleted() {
+	if !s.Opinion.DeletedAt.Valid {
+		return
+	}
+	s.User = User{}
+	s.ParentVoteType = 0
+	s.Opinion.MaskDeleted()
+}
+
+func (s *Opinion) MaskDeleted() {
+	if !s.DeletedAt.Valid {
+		return
+	}
+	s.UserID = shared.UUID[user.User](shared.NilUUID)
+	s.Content = "この意見は投稿者により削除されました。"
+	s.PictureURL = nil
+	s.ReferenceURL = nil
+	s.Title = nil
+	s.IsDeleted = true
+}
+
 type OpinionWithRepresentative struct {
 	Opinion
 	User
@@ -95,6 +122,14 @@ func (o *OpinionWithRepresentative) Mask(reports []model.OpinionReport) {
 	o.Opinion.Mask(reports)
 }
 
+func (o *OpinionWithRepresentative) MaskDeleted() {
+	if !o.Opinion.DeletedAt.Valid {
+		return
+	}
+	o.User = User{}
+	o.Opinion.MaskDeleted()
+}
+
 type RepresentativeOpinion struct {
 	TalkSessionID shared.UUID[talksession.TalkSession]
 	OpinionID     shared.UUID[opinion.Opinion]
@@ -104,6 +139,36 @@ type RepresentativeOpinion struct {
 	PassCount     int
 }
 
+// OpinionRevision 意見の版。現在の内容の場合、VoteCountとReplacedAtはnil
+type OpinionRevision struct {
+	Revision     int
+	Title        *string
+	Content      string
+	ReferenceURL *string
+	VoteCount    *int
+	VotesReset   bool
+	CreatedAt    time.Time
+	ReplacedAt   *time.Time
+}
+
+func (r *OpinionRevision) ToResponse() oas.OpinionRevision {
+	res := oas.OpinionRevision{
+		Revision:     int32(r.Revision),
+		Title:        utils.ToOptNil[oas.OptNilString](r.Title),
+		Content:      r.Content,
+		ReferenceURL: utils.ToOptNil[oas.OptNilString](r.ReferenceURL),
+		VotesReset:   r.VotesReset,
+		CreatedAt:    r.CreatedAt.Format(time.RFC3339),
+	}
+	if r.VoteCount != nil {
+		res.VoteCount = oas.NewOptNilInt32(int32(*r.VoteCount))
+	}
+	if r.ReplacedAt != nil {
+		res.ReplacedAt = oas.NewOptNilString(r.ReplacedAt.Format(time.RFC3339))
+	}
+	return res
+}
+
 type ReportReason struct {
 	ReasonID int
 	Reason   string
@@ -118,6 +183,11 @@ func (o *Opinion) ToResponse() oas.Opinion {
 		}
 	}
 
+	var editedAt oas.OptNilString
+	if o.EditedAt.Valid {
+		editedAt = oas.NewOptNilString(o.EditedAt.Time.Format(time.RFC3339))
+	}
+
 	return oas.Opinion{
 		ID:           o.OpinionID.String(),
 		Title:        utils.ToOpt[oas.OptString](o.Title),
@@ -127,5 +197,6 @@ func (o *Opinion) ToResponse() oas.Opinion {
 		ReferenceURL: utils.ToOpt[oas.OptString](o.ReferenceURL),
 		PostedAt:     o.CreatedAt.Format(time.RFC3339),
 		IsDeleted:    o.IsDeleted,
+		EditedAt:     editedAt,
 	}
 }
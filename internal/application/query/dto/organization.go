@@ -14,6 +14,7 @@ type Organization struct {
 	Code             string    `json:"code"`              // 組織コード
 	IconURL          *string   `json:"icon_url"`          // 組織アイコンURL
 	OrganizationType int       `json:"organization_type"` // 組織の種類
+	ParentID         *string   `json:"parent_id"`         // 親組織ID
 	CreatedAt        time.Time `json:"created_at"`        // 組織の作成日時
 	UpdatedAt        time.Time `json:"updated_at"`        // 組織の更新日時
 }
@@ -24,6 +25,7 @@ type OrganizationUser struct {
 	UserID             string `json:"user_id"`              // ユーザーID
 	Role               int    `json:"role"`                 // 組織のユーザーのロール
 	RoleName           string `json:"role_name"`            // 組織のユーザーのロール名
+	Inherited          bool   `json:"inherited"`            // 親組織から継承したロールか
 }
 
 func (ou *OrganizationUser) SetRoleName(role int) {
@@ -45,6 +47,7 @@ func (o *OrganizationResponse) ToResponse() oas.Organization {
 		Role:     o.OrganizationUser.Role,
 		RoleName: o.OrganizationUser.RoleName,
 		IconURL:  utils.ToOptNil[oas.OptNilString](o.Organization.IconURL),
+		ParentID: utils.ToOptNil[oas.OptNilString](o.Organization.ParentID),
 	}
 }
 
@@ -4,14 +4,21 @@ import (
 	"context"
 
 	"github.com/neko-dream/api/internal/application/query/dto"
+	"github.com/neko-dream/api/internal/domain/model/analysis"
 	"github.com/neko-dream/api/internal/domain/model/opinion"
+	"github.com/neko-dream/api/internal/domain/model/organization"
 	"github.com/neko-dream/api/internal/domain/model/shared"
 )
 
 type GetOpinionGroupRatioQuery interface {
 	Execute(ctx context.Context, input GetOpinionGroupRatioInput) ([]dto.OpinionGroupRatio, error)
+	// FindOrganizationID 意見のセッションを作成した組織。組織のセッションでなければnilを返す
+	FindOrganizationID(ctx context.Context, opinionID shared.UUID[opinion.Opinion]) (*shared.UUID[organization.Organization], error)
 }
 
 type GetOpinionGroupRatioInput struct {
 	OpinionID shared.UUID[opinion.Opinion]
+	// WeightBy 指定した属性で、セッションの組織が登録した母集団の構成に合わせて重みを付けた割合も求める
+	// 参加者の属性を使うため、呼び出し元でセッションの組織のメンバーであることを確認する
+	WeightBy *analysis.DemographicDimension
 }
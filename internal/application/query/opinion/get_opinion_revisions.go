@@ -0,0 +1,29 @@
+package opinion_query
+
+import (
+	"context"
+	"time"
+
+	"github.com/neko-dream/api/internal/application/query/dto"
+	"github.com/neko-dream/api/internal/domain/model/opinion"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+)
+
+type (
+	// GetOpinionRevisionsQuery 意見の編集履歴を取得する。投稿者とモデレーターのみ取得できる
+	GetOpinionRevisionsQuery interface {
+		Execute(context.Context, GetOpinionRevisionsInput) (*GetOpinionRevisionsOutput, error)
+	}
+
+	GetOpinionRevisionsInput struct {
+		OpinionID shared.UUID[opinion.Opinion]
+		UserID    shared.UUID[user.User]
+	}
+
+	GetOpinionRevisionsOutput struct {
+		DeletedAt *time.Time
+		// Revisions 新しい順。先頭は現在の内容
+		Revisions []dto.OpinionRevision
+	}
+)
@@ -0,0 +1,152 @@
+package organization_query
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+// auditLogCSVHeader 監査ログCSVのヘッダー
+var auditLogCSVHeader = []string{"日時", "操作者ID", "操作", "対象の種類", "対象ID", "項目", "変更前", "変更後"}
+
+type ExportOrganizationAuditLogsQuery interface {
+	Execute(ctx context.Context, input ExportOrganizationAuditLogsInput) (*ExportOrganizationAuditLogsOutput, error)
+}
+
+type ExportOrganizationAuditLogsInput struct {
+	OrganizationID shared.UUID[organization.Organization]
+	// Filter Limit、Offset、Beforeは無視し、条件に一致するすべての監査ログを出力する
+	Filter organization.AuditLogFilter
+}
+
+type ExportOrganizationAuditLogsOutput struct {
+	CSV []byte
+}
+
+type exportOrganizationAuditLogsQuery struct {
+	auditLogRepository organization.OrganizationAuditLogRepository
+}
+
+func NewExportOrganizationAuditLogsQuery(
+	auditLogRepository organization.OrganizationAuditLogRepository,
+) ExportOrganizationAuditLogsQuery {
+	return &exportOrganizationAuditLogsQuery{
+		auditLogRepository: auditLogRepository,
+	}
+}
+
+func (q *exportOrganizationAuditLogsQuery) Execute(ctx context.Context, input ExportOrganizationAuditLogsInput) (*ExportOrganizationAuditLogsOutput, error) {
+	ctx, span := otel.Tracer("query").Start(ctx, "exportOrganizationAuditLogsQuery.Execute")
+	defer span.End()
+
+	var buf bytes.Buffer
+	// Excelで文字化けしないようBOMを付与する
+	buf.WriteString("\ufeff")
+	w := csv.NewWriter(&buf)
+	if err := w.Write(auditLogCSVHeader); err != nil {
+		return nil, messages.OrganizationInternalServerError
+	}
+
+	filter := input.Filter
+	filter.Limit = organization.AuditLogMaxLimit
+	filter.Offset = 0
+	filter.Before = nil
+	// エクスポート中に追加された監査ログでページがずれないよう、最後に出力した監査ログを基準に次のページを取得する
+	for {
+		auditLogs, err := q.auditLogRepository.FindByOrganizationID(ctx, input.OrganizationID, filter)
+		if err != nil {
+			utils.HandleError(ctx, err, "OrganizationAuditLogRepository.FindByOrganizationID")
+			return nil, messages.OrganizationInternalServerError
+		}
+		for _, auditLog := range auditLogs {
+			if err := w.WriteAll(auditLogCSVRecords(auditLog)); err != nil {
+				utils.HandleError(ctx, err, "csv.Writer.WriteAll")
+				return nil, messages.OrganizationInternalServerError
+			}
+		}
+		if len(auditLogs) < filter.Limit {
+			break
+		}
+		last := auditLogs[len(auditLogs)-1]
+		filter.Before = &organization.AuditLogCursor{
+			CreatedAt:  last.CreatedAt(),
+			AuditLogID: last.AuditLogID(),
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		utils.HandleError(ctx, err, "csv.Writer.Flush")
+		return nil, messages.OrganizationInternalServerError
+	}
+
+	return &ExportOrganizationAuditLogsOutput{
+		CSV: buf.Bytes(),
+	}, nil
+}
+
+// auditLogCSVRecords 変更項目ごとに1行ずつ出力する。変更項目がない場合も操作自体を1行で出力する
+func auditLogCSVRecords(auditLog *organization.OrganizationAuditLog) [][]string {
+	base := []string{
+		auditLog.CreatedAt().Format(time.RFC3339),
+		auditLog.ActorID().String(),
+		string(auditLog.Action()),
+		string(auditLog.TargetType()),
+		escapeCSVFormula(auditLog.TargetID()),
+	}
+
+	changes := auditLog.Changes()
+	if len(changes) == 0 {
+		return [][]string{append(base, "", "", "")}
+	}
+
+	fields := make([]string, 0, len(changes))
+	for field := range changes {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	records := make([][]string, 0, len(fields))
+	for _, field := range fields {
+		change := changes[field]
+		record := append([]string{}, base...)
+		records = append(records, append(record,
+			escapeCSVFormula(field),
+			escapeCSVFormula(auditValueString(change.Before)),
+			escapeCSVFormula(auditValueString(change.After)),
+		))
+	}
+	return records
+}
+
+func auditValueString(v any) string {
+	if v == nil {
+		return ""
+	}
+	if s, ok := v.(string); ok {
+		return s
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+// escapeCSVFormula 表計算ソフトで数式として解釈されないよう、数式の開始文字で始まる値の先頭に'を付ける
+func escapeCSVFormula(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
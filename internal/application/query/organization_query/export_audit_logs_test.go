@@ -0,0 +1,132 @@
+package organization_query
+
+import (
+	"context"
+	"encoding/csv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEscapeCSVFormula(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{value: "=HYPERLINK(\"https://example.com\")", want: "'=HYPERLINK(\"https://example.com\")"},
+		{value: "+1", want: "'+1"},
+		{value: "-1+2", want: "'-1+2"},
+		{value: "@SUM(A1)", want: "'@SUM(A1)"},
+		{value: "\t=1", want: "'\t=1"},
+		{value: "\r=1", want: "'\r=1"},
+		{value: "組織名", want: "組織名"},
+		{value: "a=b", want: "a=b"},
+		{value: "", want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			assert.Equal(t, tt.want, escapeCSVFormula(tt.value))
+		})
+	}
+}
+
+func TestAuditLogCSVRecords_EscapesFormula(t *testing.T) {
+	auditLog := organization.ReconstructOrganizationAuditLog(
+		shared.NewUUID[organization.OrganizationAuditLog](),
+		shared.NewUUID[organization.Organization](),
+		shared.NewUUID[user.User](),
+		organization.AuditActionOrganizationUpdated,
+		organization.AuditTargetOrganization,
+		"=cmd|' /C calc'!A0",
+		organization.AuditChanges{
+			"name": {Before: "組織", After: "=HYPERLINK(\"https://example.com\",\"組織\")"},
+		},
+		time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+	)
+
+	records := auditLogCSVRecords(auditLog)
+	require.Len(t, records, 1)
+	assert.Equal(t, "'=cmd|' /C calc'!A0", records[0][4])
+	assert.Equal(t, "name", records[0][5])
+	assert.Equal(t, "組織", records[0][6])
+	assert.Equal(t, "'=HYPERLINK(\"https://example.com\",\"組織\")", records[0][7])
+}
+
+// fakeAuditLogRepository 新しい順に並んだ監査ログを返す。取得のたびにonFindを呼び出す
+type fakeAuditLogRepository struct {
+	organization.OrganizationAuditLogRepository
+	auditLogs []*organization.OrganizationAuditLog
+	onFind    func(r *fakeAuditLogRepository)
+}
+
+func (r *fakeAuditLogRepository) FindByOrganizationID(_ context.Context, _ shared.UUID[organization.Organization], filter organization.AuditLogFilter) ([]*organization.OrganizationAuditLog, error) {
+	var matched []*organization.OrganizationAuditLog
+	for _, auditLog := range r.auditLogs {
+		if filter.Before != nil && !auditLog.CreatedAt().Before(filter.Before.CreatedAt) {
+			continue
+		}
+		matched = append(matched, auditLog)
+	}
+	if filter.Offset < len(matched) {
+		matched = matched[filter.Offset:]
+	} else {
+		matched = nil
+	}
+	if len(matched) > filter.Limit {
+		matched = matched[:filter.Limit]
+	}
+	if r.onFind != nil {
+		r.onFind(r)
+	}
+	return matched, nil
+}
+
+func TestExportOrganizationAuditLogsQuery_NewLogsDuringExport(t *testing.T) {
+	organizationID := shared.NewUUID[organization.Organization]()
+	base := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	newAuditLog := func(createdAt time.Time) *organization.OrganizationAuditLog {
+		return organization.ReconstructOrganizationAuditLog(
+			shared.NewUUID[organization.OrganizationAuditLog](),
+			organizationID,
+			shared.NewUUID[user.User](),
+			organization.AuditActionOrganizationUpdated,
+			organization.AuditTargetOrganization,
+			organizationID.String(),
+			nil,
+			createdAt,
+		)
+	}
+
+	total := organization.AuditLogMaxLimit + 1
+	repo := &fakeAuditLogRepository{}
+	for i := range total {
+		repo.auditLogs = append(repo.auditLogs, newAuditLog(base.Add(-time.Duration(i)*time.Second)))
+	}
+	// 1ページ目の取得直後に新しい監査ログが追加される
+	repo.onFind = func(r *fakeAuditLogRepository) {
+		r.auditLogs = append([]*organization.OrganizationAuditLog{newAuditLog(base.Add(time.Hour))}, r.auditLogs...)
+		r.onFind = nil
+	}
+
+	out, err := NewExportOrganizationAuditLogsQuery(repo).Execute(context.Background(), ExportOrganizationAuditLogsInput{
+		OrganizationID: organizationID,
+	})
+	require.NoError(t, err)
+
+	records, err := csv.NewReader(strings.NewReader(strings.TrimPrefix(string(out.CSV), "\ufeff"))).ReadAll()
+	require.NoError(t, err)
+	// ヘッダー + エクスポート開始時点の監査ログが重複なく出力される
+	require.Len(t, records, total+1)
+	seen := make(map[string]struct{}, total)
+	for _, record := range records[1:] {
+		_, dup := seen[record[0]]
+		assert.False(t, dup, "duplicated row: %s", record[0])
+		seen[record[0]] = struct{}{}
+	}
+}
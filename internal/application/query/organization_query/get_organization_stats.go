@@ -0,0 +1,152 @@
+package organization_query
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+)
+
+// StatsBucket 集計の単位
+type StatsBucket string
+
+const (
+	StatsBucketDay   StatsBucket = "day"
+	StatsBucketWeek  StatsBucket = "week"
+	StatsBucketMonth StatsBucket = "month"
+)
+
+// StatsMaxBuckets 一度に取得できる集計単位の上限
+const StatsMaxBuckets = 400
+
+// Truncate tを集計単位の開始日に丸める。週は月曜日始まり
+func (b StatsBucket) Truncate(t time.Time) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	switch b {
+	case StatsBucketWeek:
+		offset := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -offset)
+	case StatsBucketMonth:
+		return time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return day
+	}
+}
+
+// Next 次の集計単位の開始日
+func (b StatsBucket) Next(t time.Time) time.Time {
+	switch b {
+	case StatsBucketWeek:
+		return t.AddDate(0, 0, 7)
+	case StatsBucketMonth:
+		return t.AddDate(0, 1, 0)
+	default:
+		return t.AddDate(0, 0, 1)
+	}
+}
+
+// defaultRange 期間を指定しなかった場合に遡る単位の数
+func (b StatsBucket) defaultRange() int {
+	switch b {
+	case StatsBucketWeek, StatsBucketMonth:
+		return 12
+	default:
+		return 30
+	}
+}
+
+type GetOrganizationStatsQuery interface {
+	Execute(context.Context, GetOrganizationStatsInput) (*GetOrganizationStatsOutput, error)
+}
+
+type GetOrganizationStatsInput struct {
+	OrganizationID shared.UUID[organization.Organization]
+	// IncludeDescendants 子孫の組織の集計も含めるか
+	IncludeDescendants bool
+	Bucket             StatsBucket
+	// Since 集計開始日（この日を含む）
+	Since *time.Time
+	// Until 集計終了日（この日を含む）
+	Until *time.Time
+	// Now 期間を省略した場合の基準日
+	Now time.Time
+}
+
+// Validate 集計単位と期間を検証し、省略された値を補う
+// Since・Untilは集計単位の開始日に丸められ、Untilは次の単位の開始日（この日を含まない）になる
+func (i *GetOrganizationStatsInput) Validate() error {
+	switch i.Bucket {
+	case "":
+		i.Bucket = StatsBucketDay
+	case StatsBucketDay, StatsBucketWeek, StatsBucketMonth:
+	default:
+		return fmt.Errorf("無効な集計単位です。: %s", i.Bucket)
+	}
+
+	until := i.Now
+	if i.Until != nil {
+		until = *i.Until
+	}
+	until = i.Bucket.Next(i.Bucket.Truncate(until))
+
+	var since time.Time
+	if i.Since != nil {
+		since = i.Bucket.Truncate(*i.Since)
+	} else {
+		since = until
+		for range i.Bucket.defaultRange() {
+			since = i.Bucket.Truncate(since.AddDate(0, 0, -1))
+		}
+	}
+
+	if !since.Before(until) {
+		return errors.New("集計開始日は集計終了日以前を指定してください")
+	}
+	if len(i.BucketDates(since, until)) > StatsMaxBuckets {
+		return fmt.Errorf("集計期間が長すぎます。集計単位は%d件以内にしてください", StatsMaxBuckets)
+	}
+
+	i.Since = &since
+	i.Until = &until
+	return nil
+}
+
+// BucketDates since以上until未満の集計単位の開始日を返す
+func (i *GetOrganizationStatsInput) BucketDates(since, until time.Time) []time.Time {
+	var dates []time.Time
+	for d := i.Bucket.Truncate(since); d.Before(until); d = i.Bucket.Next(d) {
+		dates = append(dates, d)
+		if len(dates) > StatsMaxBuckets {
+			break
+		}
+	}
+	return dates
+}
+
+// OrganizationStatsCounts 集計値
+type OrganizationStatsCounts struct {
+	TalkSessionCount    int
+	ParticipantCount    int
+	OpinionCount        int
+	VoteCount           int
+	ConsentCount        int
+	ReportFeedbackCount int
+}
+
+type OrganizationStatsPeriod struct {
+	// Date 集計単位の開始日
+	Date time.Time
+	OrganizationStatsCounts
+}
+
+type GetOrganizationStatsOutput struct {
+	Bucket StatsBucket
+	Since  time.Time
+	// Until 集計期間の終端（この日を含まない）
+	Until   time.Time
+	Totals  OrganizationStatsCounts
+	Periods []OrganizationStatsPeriod
+}
@@ -0,0 +1,113 @@
+package organization_query
+
+import (
+	"testing"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestGetOrganizationStatsInput_Validate(t *testing.T) {
+	// 2025-06-18は水曜日
+	now := time.Date(2025, 6, 18, 15, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		input     GetOrganizationStatsInput
+		wantSince time.Time
+		wantUntil time.Time
+		wantCount int
+		wantErr   bool
+	}{
+		{
+			name:      "省略した場合は日単位で直近30日を集計する",
+			input:     GetOrganizationStatsInput{Now: now},
+			wantSince: date(2025, 5, 20),
+			wantUntil: date(2025, 6, 19),
+			wantCount: 30,
+		},
+		{
+			name:      "週単位は月曜日始まりで直近12週を集計する",
+			input:     GetOrganizationStatsInput{Bucket: StatsBucketWeek, Now: now},
+			wantSince: date(2025, 3, 31),
+			wantUntil: date(2025, 6, 23),
+			wantCount: 12,
+		},
+		{
+			name:      "月単位は直近12ヶ月を集計する",
+			input:     GetOrganizationStatsInput{Bucket: StatsBucketMonth, Now: now},
+			wantSince: date(2024, 7, 1),
+			wantUntil: date(2025, 7, 1),
+			wantCount: 12,
+		},
+		{
+			name: "指定した期間は集計単位の境界に丸める",
+			input: GetOrganizationStatsInput{
+				Bucket: StatsBucketMonth,
+				Since:  lo.ToPtr(date(2025, 1, 15)),
+				Until:  lo.ToPtr(date(2025, 3, 3)),
+				Now:    now,
+			},
+			wantSince: date(2025, 1, 1),
+			wantUntil: date(2025, 4, 1),
+			wantCount: 3,
+		},
+		{
+			name: "開始日と終了日が同じ日でも1日分を集計する",
+			input: GetOrganizationStatsInput{
+				Since: lo.ToPtr(date(2025, 6, 1)),
+				Until: lo.ToPtr(date(2025, 6, 1)),
+				Now:   now,
+			},
+			wantSince: date(2025, 6, 1),
+			wantUntil: date(2025, 6, 2),
+			wantCount: 1,
+		},
+		{
+			name: "開始日が終了日より後の場合はエラー",
+			input: GetOrganizationStatsInput{
+				Since: lo.ToPtr(date(2025, 6, 10)),
+				Until: lo.ToPtr(date(2025, 6, 1)),
+				Now:   now,
+			},
+			wantErr: true,
+		},
+		{
+			name: "集計単位が上限を超える場合はエラー",
+			input: GetOrganizationStatsInput{
+				Since: lo.ToPtr(date(2023, 1, 1)),
+				Now:   now,
+			},
+			wantErr: true,
+		},
+		{
+			name:    "未定義の集計単位はエラー",
+			input:   GetOrganizationStatsInput{Bucket: StatsBucket("year"), Now: now},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := tt.input
+			err := input.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSince, *input.Since)
+			assert.Equal(t, tt.wantUntil, *input.Until)
+
+			dates := input.BucketDates(*input.Since, *input.Until)
+			assert.Len(t, dates, tt.wantCount)
+			assert.Equal(t, tt.wantSince, dates[0])
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package organization_query
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type ListOrganizationAPIKeysQuery interface {
+	Execute(ctx context.Context, input ListOrganizationAPIKeysInput) (*ListOrganizationAPIKeysOutput, error)
+}
+
+type ListOrganizationAPIKeysInput struct {
+	OrganizationID shared.UUID[organization.Organization]
+}
+
+type ListOrganizationAPIKeysOutput struct {
+	APIKeys []*organization.OrganizationAPIKey
+}
+
+type listOrganizationAPIKeysQuery struct {
+	apiKeyRepository organization.OrganizationAPIKeyRepository
+}
+
+func NewListOrganizationAPIKeysQuery(
+	apiKeyRepository organization.OrganizationAPIKeyRepository,
+) ListOrganizationAPIKeysQuery {
+	return &listOrganizationAPIKeysQuery{
+		apiKeyRepository: apiKeyRepository,
+	}
+}
+
+func (q *listOrganizationAPIKeysQuery) Execute(ctx context.Context, input ListOrganizationAPIKeysInput) (*ListOrganizationAPIKeysOutput, error) {
+	ctx, span := otel.Tracer("query").Start(ctx, "listOrganizationAPIKeysQuery.Execute")
+	defer span.End()
+
+	keys, err := q.apiKeyRepository.FindByOrganizationID(ctx, input.OrganizationID)
+	if err != nil {
+		utils.HandleError(ctx, err, "OrganizationAPIKeyRepository.FindByOrganizationID")
+		return nil, messages.OrganizationInternalServerError
+	}
+
+	return &ListOrganizationAPIKeysOutput{
+		APIKeys: keys,
+	}, nil
+}
@@ -0,0 +1,69 @@
+package organization_query
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type ListOrganizationAuditLogsQuery interface {
+	Execute(ctx context.Context, input ListOrganizationAuditLogsInput) (*ListOrganizationAuditLogsOutput, error)
+}
+
+type ListOrganizationAuditLogsInput struct {
+	OrganizationID shared.UUID[organization.Organization]
+	Filter         organization.AuditLogFilter
+}
+
+type ListOrganizationAuditLogsOutput struct {
+	AuditLogs  []*organization.OrganizationAuditLog
+	TotalCount int
+}
+
+type listOrganizationAuditLogsQuery struct {
+	auditLogRepository organization.OrganizationAuditLogRepository
+}
+
+func NewListOrganizationAuditLogsQuery(
+	auditLogRepository organization.OrganizationAuditLogRepository,
+) ListOrganizationAuditLogsQuery {
+	return &listOrganizationAuditLogsQuery{
+		auditLogRepository: auditLogRepository,
+	}
+}
+
+func (q *listOrganizationAuditLogsQuery) Execute(ctx context.Context, input ListOrganizationAuditLogsInput) (*ListOrganizationAuditLogsOutput, error) {
+	ctx, span := otel.Tracer("query").Start(ctx, "listOrganizationAuditLogsQuery.Execute")
+	defer span.End()
+
+	filter := input.Filter
+	if filter.Limit <= 0 {
+		filter.Limit = organization.AuditLogDefaultLimit
+	}
+	if filter.Limit > organization.AuditLogMaxLimit {
+		filter.Limit = organization.AuditLogMaxLimit
+	}
+	if filter.Offset < 0 {
+		filter.Offset = 0
+	}
+
+	auditLogs, err := q.auditLogRepository.FindByOrganizationID(ctx, input.OrganizationID, filter)
+	if err != nil {
+		utils.HandleError(ctx, err, "OrganizationAuditLogRepository.FindByOrganizationID")
+		return nil, messages.OrganizationInternalServerError
+	}
+	totalCount, err := q.auditLogRepository.CountByOrganizationID(ctx, input.OrganizationID, filter)
+	if err != nil {
+		utils.HandleError(ctx, err, "OrganizationAuditLogRepository.CountByOrganizationID")
+		return nil, messages.OrganizationInternalServerError
+	}
+
+	return &ListOrganizationAuditLogsOutput{
+		AuditLogs:  auditLogs,
+		TotalCount: totalCount,
+	}, nil
+}
@@ -0,0 +1,50 @@
+package organization_query
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type ListOrganizationInvitationsQuery interface {
+	Execute(ctx context.Context, input ListOrganizationInvitationsInput) (*ListOrganizationInvitationsOutput, error)
+}
+
+type ListOrganizationInvitationsInput struct {
+	OrganizationID shared.UUID[organization.Organization]
+}
+
+type ListOrganizationInvitationsOutput struct {
+	Invitations []*organization.OrganizationInvitation
+}
+
+type listOrganizationInvitationsQuery struct {
+	invitationRepository organization.OrganizationInvitationRepository
+}
+
+func NewListOrganizationInvitationsQuery(
+	invitationRepository organization.OrganizationInvitationRepository,
+) ListOrganizationInvitationsQuery {
+	return &listOrganizationInvitationsQuery{
+		invitationRepository: invitationRepository,
+	}
+}
+
+func (q *listOrganizationInvitationsQuery) Execute(ctx context.Context, input ListOrganizationInvitationsInput) (*ListOrganizationInvitationsOutput, error) {
+	ctx, span := otel.Tracer("query").Start(ctx, "listOrganizationInvitationsQuery.Execute")
+	defer span.End()
+
+	invitations, err := q.invitationRepository.FindByOrganizationID(ctx, input.OrganizationID)
+	if err != nil {
+		utils.HandleError(ctx, err, "OrganizationInvitationRepository.FindByOrganizationID")
+		return nil, messages.OrganizationInternalServerError
+	}
+
+	return &ListOrganizationInvitationsOutput{
+		Invitations: invitations,
+	}, nil
+}
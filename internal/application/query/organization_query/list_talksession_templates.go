@@ -0,0 +1,51 @@
+package organization_query
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession/talksession_template"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type ListTalkSessionTemplatesQuery interface {
+	Execute(ctx context.Context, input ListTalkSessionTemplatesInput) (*ListTalkSessionTemplatesOutput, error)
+}
+
+type ListTalkSessionTemplatesInput struct {
+	OrganizationID shared.UUID[organization.Organization]
+}
+
+type ListTalkSessionTemplatesOutput struct {
+	Templates []*talksession_template.TalkSessionTemplate
+}
+
+type listTalkSessionTemplatesQuery struct {
+	templateRepository talksession_template.TalkSessionTemplateRepository
+}
+
+func NewListTalkSessionTemplatesQuery(
+	templateRepository talksession_template.TalkSessionTemplateRepository,
+) ListTalkSessionTemplatesQuery {
+	return &listTalkSessionTemplatesQuery{
+		templateRepository: templateRepository,
+	}
+}
+
+func (q *listTalkSessionTemplatesQuery) Execute(ctx context.Context, input ListTalkSessionTemplatesInput) (*ListTalkSessionTemplatesOutput, error) {
+	ctx, span := otel.Tracer("query").Start(ctx, "listTalkSessionTemplatesQuery.Execute")
+	defer span.End()
+
+	templates, err := q.templateRepository.FindByOrganizationID(ctx, input.OrganizationID)
+	if err != nil {
+		utils.HandleError(ctx, err, "TalkSessionTemplateRepository.FindByOrganizationID")
+		return nil, messages.OrganizationInternalServerError
+	}
+
+	return &ListTalkSessionTemplatesOutput{
+		Templates: templates,
+	}, nil
+}
@@ -0,0 +1,55 @@
+package ownership_query
+
+import (
+	"context"
+	"time"
+
+	"github.com/neko-dream/api/internal/application/query/dto"
+	"github.com/neko-dream/api/internal/domain/model/ownership"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/user"
+)
+
+type (
+	// GetOwnershipTransferQuery 譲渡依頼を取得する。譲渡元・譲渡先・依頼者のみ取得できる
+	GetOwnershipTransferQuery interface {
+		Execute(context.Context, GetOwnershipTransferInput) (*OwnershipTransfer, error)
+	}
+
+	GetOwnershipTransferInput struct {
+		UserID     shared.UUID[user.User]
+		TransferID shared.UUID[ownership.OwnershipTransfer]
+	}
+
+	// ListOwnershipTransfersQuery ログインユーザーが関係する譲渡依頼を新しい順に取得する
+	ListOwnershipTransfersQuery interface {
+		Execute(context.Context, ListOwnershipTransfersInput) (*ListOwnershipTransfersOutput, error)
+	}
+
+	ListOwnershipTransfersInput struct {
+		UserID shared.UUID[user.User]
+	}
+
+	ListOwnershipTransfersOutput struct {
+		// Received 自分が譲渡先の依頼
+		Received []OwnershipTransfer
+		// Sent 自分が譲渡元か依頼者の依頼
+		Sent []OwnershipTransfer
+	}
+
+	OwnershipTransfer struct {
+		TransferID       shared.UUID[ownership.OwnershipTransfer]
+		TargetType       ownership.TransferTargetType
+		TalkSessionID    *shared.UUID[talksession.TalkSession]
+		OrganizationCode *string
+		// TargetName セッションのテーマか組織名
+		TargetName  string
+		FromUser    dto.User
+		ToUser      dto.User
+		Status      ownership.TransferStatus
+		ExpiresAt   time.Time
+		RespondedAt *time.Time
+		CreatedAt   time.Time
+	}
+)
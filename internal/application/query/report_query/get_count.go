@@ -7,6 +7,7 @@ import (
 	"github.com/neko-dream/api/internal/domain/model/opinion"
 	"github.com/neko-dream/api/internal/domain/model/shared"
 	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/talksession/talksession_collaborator"
 	"github.com/neko-dream/api/internal/domain/model/user"
 	"go.opentelemetry.io/otel"
 )
@@ -26,17 +27,20 @@ type GetCountOutput struct {
 }
 
 type getCountQueryInteractor struct {
-	reportRep      opinion.ReportRepository
-	talkSessionRep talksession.TalkSessionRepository
+	reportRep         opinion.ReportRepository
+	talkSessionRep    talksession.TalkSessionRepository
+	permissionService talksession_collaborator.TalkSessionPermissionService
 }
 
 func NewGetCountQueryInteractor(
 	reportRepository opinion.ReportRepository,
 	talkSessionRepository talksession.TalkSessionRepository,
+	permissionService talksession_collaborator.TalkSessionPermissionService,
 ) GetCountQuery {
 	return &getCountQueryInteractor{
-		reportRep:      reportRepository,
-		talkSessionRep: talkSessionRepository,
+		reportRep:         reportRepository,
+		talkSessionRep:    talkSessionRepository,
+		permissionService: permissionService,
 	}
 }
 
@@ -51,8 +55,12 @@ func (g *getCountQueryInteractor) Execute(ctx context.Context, input GetCountInp
 		return nil, err
 	}
 
-	// talkSessionのオーナーと一致するか確認
-	if talkSession.OwnerUserID() != input.UserID {
+	// talkSessionのオーナーか、通報に対応できる共同管理者か確認
+	canModerate, err := g.permissionService.HasPermission(ctx, talkSession, input.UserID, talksession_collaborator.PermissionModerate)
+	if err != nil {
+		return nil, err
+	}
+	if !canModerate {
 		return nil, messages.TalkSessionNotFound
 	}
 
@@ -0,0 +1,56 @@
+package talksession
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/neko-dream/api/internal/application/query/dto"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/samber/lo"
+)
+
+type (
+	// BrowseOrganizationTalkSessionsQuery 組織（および子孫の組織）のトークセッション一覧
+	BrowseOrganizationTalkSessionsQuery interface {
+		Execute(context.Context, BrowseOrganizationTalkSessionsInput) (*BrowseOrganizationTalkSessionsOutput, error)
+	}
+
+	BrowseOrganizationTalkSessionsInput struct {
+		OrganizationID shared.UUID[organization.Organization]
+		// IncludeDescendants 子孫の組織のセッションも含めるか
+		IncludeDescendants bool
+		Limit              *int
+		Offset             *int
+		Status             Status
+		Theme              *string
+	}
+
+	BrowseOrganizationTalkSessionsOutput struct {
+		TalkSessions []dto.TalkSessionWithDetail
+		TotalCount   int32
+	}
+)
+
+func (h *BrowseOrganizationTalkSessionsInput) Validate() error {
+	var err error
+
+	if h.Status != "" && h.Status != StatusOpen && h.Status != StatusClosed {
+		err = errors.Join(err, fmt.Errorf("無効なステータスです。: %s", h.Status))
+	}
+
+	if h.Limit == nil {
+		h.Limit = lo.ToPtr(10)
+	} else if *h.Limit <= 0 || *h.Limit > 100 {
+		err = errors.Join(err, fmt.Errorf("Limitは1から100の間で指定してください"))
+	}
+
+	if h.Offset == nil {
+		h.Offset = lo.ToPtr(0)
+	} else if *h.Offset < 0 {
+		err = errors.Join(err, fmt.Errorf("Offsetは0以上の値を指定してください"))
+	}
+
+	return err
+}
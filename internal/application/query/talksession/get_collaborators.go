@@ -0,0 +1,34 @@
+package talksession
+
+import (
+	"context"
+	"time"
+
+	"github.com/neko-dream/api/internal/application/query/dto"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/user"
+)
+
+type (
+	// GetCollaboratorsQuery セッションの共同管理者一覧を取得する。オーナーと共同管理者のみ取得できる
+	GetCollaboratorsQuery interface {
+		Execute(context.Context, GetCollaboratorsInput) (*GetCollaboratorsOutput, error)
+	}
+
+	GetCollaboratorsInput struct {
+		TalkSessionID shared.UUID[talksession.TalkSession]
+		UserID        shared.UUID[user.User]
+	}
+
+	Collaborator struct {
+		User      dto.User
+		Role      string
+		CreatedAt time.Time
+	}
+
+	GetCollaboratorsOutput struct {
+		Owner         dto.User
+		Collaborators []Collaborator
+	}
+)
@@ -3,14 +3,18 @@ package auth_usecase
 import (
 	"context"
 	"regexp"
+	"time"
 
 	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/auth/login_attempt"
 	password_auth "github.com/neko-dream/api/internal/domain/model/auth/password"
 	"github.com/neko-dream/api/internal/domain/model/clock"
 	"github.com/neko-dream/api/internal/domain/model/session"
 	"github.com/neko-dream/api/internal/domain/model/shared"
 	"github.com/neko-dream/api/internal/domain/model/user"
 	"github.com/neko-dream/api/internal/infrastructure/config"
+	"github.com/neko-dream/api/internal/infrastructure/email"
+	email_template "github.com/neko-dream/api/internal/infrastructure/email/template"
 	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
 	"github.com/neko-dream/api/pkg/hash"
 	"github.com/neko-dream/api/pkg/utils"
@@ -24,6 +28,8 @@ type PasswordLogin interface {
 type PasswordLoginInput struct {
 	IDorEmail string
 	Password  string
+	IPAddress *string
+	UserAgent *string
 }
 
 type PasswordLoginOutput struct {
@@ -38,6 +44,8 @@ type passwordLoginInteractor struct {
 	*config.Config
 	password_auth.PasswordAuthManager
 	session.TokenManager
+	login_attempt.LoginThrottle
+	email.EmailSender
 }
 
 func NewPasswordLogin(
@@ -48,6 +56,8 @@ func NewPasswordLogin(
 	config *config.Config,
 	passwordAuthManager password_auth.PasswordAuthManager,
 	tokenManager session.TokenManager,
+	loginThrottle login_attempt.LoginThrottle,
+	emailSender email.EmailSender,
 ) PasswordLogin {
 	return &passwordLoginInteractor{
 		UserRepository:      userRep,
@@ -57,6 +67,8 @@ func NewPasswordLogin(
 		Config:              config,
 		PasswordAuthManager: passwordAuthManager,
 		TokenManager:        tokenManager,
+		LoginThrottle:       loginThrottle,
+		EmailSender:         emailSender,
 	}
 }
 
@@ -71,43 +83,48 @@ func (p *passwordLoginInteractor) Execute(ctx context.Context, input PasswordLog
 	ctx, span := otel.Tracer("auth_command").Start(ctx, "passwordLoginInteractor.Execute")
 	defer span.End()
 
-	var tokenRes string
-	if err := p.ExecTx(ctx, func(ctx context.Context) error {
-		var usr *user.User
-		// IDorEmailがメールアドレスの場合は、ユーザーをメールアドレスで取得
-		if IsEmail(input.IDorEmail) {
-			emailHash, err := hash.HashEmail(input.IDorEmail, p.Config.HASH_PEPPER)
-			if err != nil {
-				utils.HandleError(ctx, err, "failed to hash email")
-				return messages.InvalidPasswordOrEmailError
-			}
-			foundUser, err := p.UserRepository.FindBySubject(ctx, user.UserSubject(emailHash))
-			if err != nil {
-				return messages.InvalidPasswordOrEmailError
-			}
-			usr = foundUser
-		} else {
-			// DisplayIDからユーザーを取得
-			foundUser, err := p.UserRepository.FindByDisplayID(ctx, input.IDorEmail)
-			if err != nil {
-				return messages.InvalidPasswordOrEmailError
-			}
-			usr = foundUser
-		}
-		if usr == nil {
-			return messages.InvalidPasswordOrEmailError
+	source := login_attempt.AttemptSource{
+		IPAddress: input.IPAddress,
+		UserAgent: input.UserAgent,
+	}
+
+	// 同一IPからの失敗が続いている場合はユーザーの検索前に弾く
+	if err := p.LoginThrottle.CheckIP(ctx, source); err != nil {
+		return nil, err
+	}
+
+	usr := p.findUser(ctx, input.IDorEmail)
+	if usr == nil {
+		if _, err := p.LoginThrottle.RecordFailure(ctx, nil, source); err != nil {
+			utils.HandleError(ctx, err, "LoginThrottle.RecordFailure")
 		}
+		return nil, messages.InvalidPasswordOrEmailError
+	}
 
-		// ユーザーからユーザーパスワードを取得
-		userPassword, err := p.PasswordAuthManager.VerifyPassword(ctx, usr.UserID(), input.Password)
+	// ロック中はパスワードの検証を行わない
+	if err := p.LoginThrottle.CheckAccount(ctx, usr.UserID(), source); err != nil {
+		return nil, err
+	}
+
+	// ユーザーからユーザーパスワードを取得
+	userPassword, err := p.PasswordAuthManager.VerifyPassword(ctx, usr.UserID(), input.Password)
+	// パスワードが一致しない場合はエラーを返す
+	if err != nil || !userPassword {
+		userID := usr.UserID()
+		lockedUntil, err := p.LoginThrottle.RecordFailure(ctx, &userID, source)
 		if err != nil {
-			return messages.InvalidPasswordOrEmailError
+			utils.HandleError(ctx, err, "LoginThrottle.RecordFailure")
+			return nil, messages.InvalidPasswordOrEmailError
 		}
-		// パスワードが一致しない場合はエラーを返す
-		if !userPassword {
-			return messages.InvalidPasswordOrEmailError
+		if lockedUntil != nil {
+			p.notifyLocked(ctx, usr, *lockedUntil)
+			return nil, messages.AccountLockedError
 		}
+		return nil, messages.InvalidPasswordOrEmailError
+	}
 
+	var tokenRes string
+	if err := p.ExecTx(ctx, func(ctx context.Context) error {
 		if err := p.SessionService.DeactivateUserSessions(ctx, usr.UserID()); err != nil {
 			utils.HandleError(ctx, err, "failed to deactivate user sessions")
 			return err
@@ -138,8 +155,56 @@ func (p *passwordLoginInteractor) Execute(ctx context.Context, input PasswordLog
 		return nil, err
 	}
 
+	if err := p.LoginThrottle.RecordSuccess(ctx, usr.UserID(), source); err != nil {
+		utils.HandleError(ctx, err, "LoginThrottle.RecordSuccess")
+	}
+
 	// トークンを生成
 	return &PasswordLoginOutput{
 		Token: tokenRes,
 	}, nil
 }
+
+// findUser IDorEmailがメールアドレスの場合はメールアドレス、それ以外はDisplayIDでユーザーを取得する
+func (p *passwordLoginInteractor) findUser(ctx context.Context, idOrEmail string) *user.User {
+	ctx, span := otel.Tracer("auth_command").Start(ctx, "passwordLoginInteractor.findUser")
+	defer span.End()
+
+	if IsEmail(idOrEmail) {
+		emailHash, err := hash.HashEmail(idOrEmail, p.Config.HASH_PEPPER)
+		if err != nil {
+			utils.HandleError(ctx, err, "failed to hash email")
+			return nil
+		}
+		foundUser, err := p.UserRepository.FindBySubject(ctx, user.UserSubject(emailHash))
+		if err != nil {
+			return nil
+		}
+		return foundUser
+	}
+
+	// DisplayIDからユーザーを取得
+	foundUser, err := p.UserRepository.FindByDisplayID(ctx, idOrEmail)
+	if err != nil {
+		return nil
+	}
+	return foundUser
+}
+
+// notifyLocked アカウントのロックをメールで通知する。送信に失敗してもログイン処理には影響させない
+func (p *passwordLoginInteractor) notifyLocked(ctx context.Context, usr *user.User, lockedUntil time.Time) {
+	ctx, span := otel.Tracer("auth_command").Start(ctx, "passwordLoginInteractor.notifyLocked")
+	defer span.End()
+
+	if usr.Email() == nil || *usr.Email() == "" {
+		return
+	}
+
+	if err := p.EmailSender.Send(ctx, *usr.Email(), email_template.AccountLockedEmailTemplate, map[string]any{
+		"Title":       "【ことひろ】アカウントを一時的にロックしました",
+		"CompanyLogo": "https://github.com/neko-dream/api/raw/develop/docs/public/assets/icon.png",
+		"LockedUntil": lockedUntil.In(time.FixedZone("Asia/Tokyo", 9*60*60)).Format("2006年01月02日 15:04"),
+	}); err != nil {
+		utils.HandleError(ctx, err, "EmailSender.Send")
+	}
+}
@@ -0,0 +1,136 @@
+package manage_usecase
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type PublishReportVersionCommand interface {
+	Execute(ctx context.Context, input PublishReportVersionInput) (*PublishReportVersionOutput, error)
+}
+
+type PublishReportVersionInput struct {
+	UserID shared.UUID[user.User]
+	// OrganizationID 操作者がログインしている組織
+	OrganizationID shared.UUID[organization.Organization]
+	TalkSessionID  shared.UUID[talksession.TalkSession]
+	// VersionID 公開して固定するバージョン。nilの場合は固定を解除し、最新のバージョンを公開する
+	VersionID *shared.UUID[analysis.ReportVersion]
+}
+
+type PublishReportVersionOutput struct {
+	Publication *analysis.ReportPublication
+	// Version 公開したバージョン
+	Version *analysis.ReportVersion
+}
+
+type publishReportVersionInteractor struct {
+	talkSessionRepository   talksession.TalkSessionRepository
+	reportVersionRepository analysis.ReportVersionRepository
+	auditLogRepository      organization.OrganizationAuditLogRepository
+	*db.DBManager
+}
+
+func NewPublishReportVersionInteractor(
+	talkSessionRepository talksession.TalkSessionRepository,
+	reportVersionRepository analysis.ReportVersionRepository,
+	auditLogRepository organization.OrganizationAuditLogRepository,
+	dbManager *db.DBManager,
+) PublishReportVersionCommand {
+	return &publishReportVersionInteractor{
+		talkSessionRepository:   talkSessionRepository,
+		reportVersionRepository: reportVersionRepository,
+		auditLogRepository:      auditLogRepository,
+		DBManager:               dbManager,
+	}
+}
+
+func (i *publishReportVersionInteractor) Execute(ctx context.Context, input PublishReportVersionInput) (*PublishReportVersionOutput, error) {
+	ctx, span := otel.Tracer("manage_command").Start(ctx, "publishReportVersionInteractor.Execute")
+	defer span.End()
+
+	var output *PublishReportVersionOutput
+	err := i.ExecTx(ctx, func(ctx context.Context) error {
+		talkSession, err := i.talkSessionRepository.FindByID(ctx, input.TalkSessionID)
+		if err != nil {
+			utils.HandleError(ctx, err, "TalkSessionRepository.FindByID")
+			return messages.TalkSessionNotFound
+		}
+		if talkSession == nil {
+			return messages.TalkSessionNotFound
+		}
+
+		latest, err := i.reportVersionRepository.FindLatest(ctx, input.TalkSessionID)
+		if err != nil {
+			utils.HandleError(ctx, err, "ReportVersionRepository.FindLatest")
+			return messages.InternalServerError
+		}
+		if latest == nil {
+			return messages.ReportVersionNotFound
+		}
+		publication, err := i.reportVersionRepository.FindPublication(ctx, input.TalkSessionID)
+		if err != nil {
+			utils.HandleError(ctx, err, "ReportVersionRepository.FindPublication")
+			return messages.InternalServerError
+		}
+		if publication == nil {
+			publication = analysis.NewReportPublication(latest, clock.Now(ctx))
+		}
+		beforeVersionID, beforePinned := publication.ReportVersionID.String(), publication.Pinned
+
+		version := latest
+		if input.VersionID != nil {
+			version, err = i.reportVersionRepository.FindByID(ctx, *input.VersionID)
+			if err != nil {
+				utils.HandleError(ctx, err, "ReportVersionRepository.FindByID")
+				return messages.InternalServerError
+			}
+			if version == nil {
+				return messages.ReportVersionNotFound
+			}
+			if err := publication.Pin(version, input.UserID, clock.Now(ctx)); err != nil {
+				return err
+			}
+		} else {
+			publication.Unpin(latest, input.UserID, clock.Now(ctx))
+		}
+
+		if err := i.reportVersionRepository.SavePublication(ctx, publication); err != nil {
+			utils.HandleError(ctx, err, "ReportVersionRepository.SavePublication")
+			return messages.InternalServerError
+		}
+
+		// 組織のセッションはその組織、個人のセッションは操作者の組織の監査ログに残す
+		orgID := input.OrganizationID
+		if talkSession.OrganizationID() != nil {
+			orgID = *talkSession.OrganizationID()
+		}
+		auditLog := organization.NewOrganizationAuditLog(orgID, input.UserID, organization.AuditActionReportVersionPublished, organization.AuditTargetTalkSession, input.TalkSessionID.String(), clock.Now(ctx))
+		auditLog.RecordChange("report_version_id", beforeVersionID, publication.ReportVersionID.String())
+		auditLog.RecordChange("pinned", beforePinned, publication.Pinned)
+		if err := i.auditLogRepository.Create(ctx, auditLog); err != nil {
+			utils.HandleError(ctx, err, "OrganizationAuditLogRepository.Create")
+			return messages.InternalServerError
+		}
+
+		output = &PublishReportVersionOutput{
+			Publication: publication,
+			Version:     version,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return output, nil
+}
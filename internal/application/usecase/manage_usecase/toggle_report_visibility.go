@@ -0,0 +1,81 @@
+package manage_usecase
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type ToggleReportVisibilityCommand interface {
+	Execute(ctx context.Context, input ToggleReportVisibilityInput) error
+}
+
+type ToggleReportVisibilityInput struct {
+	UserID shared.UUID[user.User]
+	// OrganizationID 操作者がログインしている組織
+	OrganizationID shared.UUID[organization.Organization]
+	TalkSessionID  shared.UUID[talksession.TalkSession]
+	Hidden         bool
+}
+
+type toggleReportVisibilityInteractor struct {
+	talkSessionRepository talksession.TalkSessionRepository
+	auditLogRepository    organization.OrganizationAuditLogRepository
+	*db.DBManager
+}
+
+func NewToggleReportVisibilityInteractor(
+	talkSessionRepository talksession.TalkSessionRepository,
+	auditLogRepository organization.OrganizationAuditLogRepository,
+	dbManager *db.DBManager,
+) ToggleReportVisibilityCommand {
+	return &toggleReportVisibilityInteractor{
+		talkSessionRepository: talkSessionRepository,
+		auditLogRepository:    auditLogRepository,
+		DBManager:             dbManager,
+	}
+}
+
+func (i *toggleReportVisibilityInteractor) Execute(ctx context.Context, input ToggleReportVisibilityInput) error {
+	ctx, span := otel.Tracer("manage_command").Start(ctx, "toggleReportVisibilityInteractor.Execute")
+	defer span.End()
+
+	return i.ExecTx(ctx, func(ctx context.Context) error {
+		talkSession, err := i.talkSessionRepository.FindByID(ctx, input.TalkSessionID)
+		if err != nil {
+			utils.HandleError(ctx, err, "TalkSessionRepository.FindByID")
+			return messages.TalkSessionNotFound
+		}
+		if talkSession == nil {
+			return messages.TalkSessionNotFound
+		}
+
+		before := talkSession.HideReport()
+		talkSession.SetReportVisibility(input.Hidden)
+		if err := i.talkSessionRepository.Update(ctx, talkSession); err != nil {
+			utils.HandleError(ctx, err, "TalkSessionRepository.Update")
+			return messages.TalkSessionUpdateFailed
+		}
+
+		// 組織のセッションはその組織、個人のセッションは操作者の組織の監査ログに残す
+		orgID := input.OrganizationID
+		if talkSession.OrganizationID() != nil {
+			orgID = *talkSession.OrganizationID()
+		}
+		auditLog := organization.NewOrganizationAuditLog(orgID, input.UserID, organization.AuditActionReportVisibilityToggled, organization.AuditTargetTalkSession, input.TalkSessionID.String(), clock.Now(ctx))
+		auditLog.RecordChange("hide_report", before, input.Hidden)
+		if err := i.auditLogRepository.Create(ctx, auditLog); err != nil {
+			utils.HandleError(ctx, err, "OrganizationAuditLogRepository.Create")
+			return messages.TalkSessionUpdateFailed
+		}
+		return nil
+	})
+}
@@ -0,0 +1,65 @@
+package opinion_usecase
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/opinion"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type (
+	DeleteOpinion interface {
+		Execute(context.Context, DeleteOpinionInput) error
+	}
+
+	DeleteOpinionInput struct {
+		OpinionID shared.UUID[opinion.Opinion]
+		UserID    shared.UUID[user.User]
+	}
+
+	deleteOpinionHandler struct {
+		opinion.OpinionRepository
+		*db.DBManager
+	}
+)
+
+func NewDeleteOpinionHandler(
+	opinionRepository opinion.OpinionRepository,
+	dbManager *db.DBManager,
+) DeleteOpinion {
+	return &deleteOpinionHandler{
+		OpinionRepository: opinionRepository,
+		DBManager:         dbManager,
+	}
+}
+
+// Execute 投稿者が意見を削除する
+// 内容と投票は残し、表示する際に削除された旨に置き換える
+func (h *deleteOpinionHandler) Execute(ctx context.Context, input DeleteOpinionInput) error {
+	ctx, span := otel.Tracer("opinion_command").Start(ctx, "deleteOpinionHandler.Execute")
+	defer span.End()
+
+	return h.ExecTx(ctx, func(ctx context.Context) error {
+		op, err := h.OpinionRepository.FindByID(ctx, input.OpinionID)
+		if err != nil {
+			utils.HandleError(ctx, err, "OpinionRepository.FindByID")
+			return messages.OpinionNotFound
+		}
+
+		if err := op.Delete(input.UserID, clock.Now(ctx)); err != nil {
+			return err
+		}
+
+		if err := h.OpinionRepository.Update(ctx, *op); err != nil {
+			utils.HandleError(ctx, err, "OpinionRepository.Update")
+			return messages.OpinionUpdateFailed
+		}
+		return nil
+	})
+}
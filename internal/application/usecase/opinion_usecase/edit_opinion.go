@@ -0,0 +1,146 @@
+package opinion_usecase
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/neko-dream/api/internal/application/query/dto"
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/opinion"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/internal/domain/model/vote"
+	"github.com/neko-dream/api/internal/infrastructure/config"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	"github.com/neko-dream/api/pkg/utils"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel"
+)
+
+type (
+	EditOpinion interface {
+		Execute(context.Context, EditOpinionInput) (*EditOpinionOutput, error)
+	}
+
+	EditOpinionInput struct {
+		OpinionID    shared.UUID[opinion.Opinion]
+		UserID       shared.UUID[user.User]
+		Title        *string
+		Content      string
+		ReferenceURL *string
+	}
+
+	EditOpinionOutput struct {
+		Opinion dto.Opinion
+		// VotesReset 内容が大きく変わったため、投稿者以外の投票をリセットしたか
+		VotesReset bool
+	}
+
+	editOpinionHandler struct {
+		opinion.OpinionRepository
+		opinion.OpinionRevisionRepository
+		vote.VoteRepository
+		vote.VoteChangeRepository
+		policy opinion.EditPolicy
+		*db.DBManager
+	}
+)
+
+func NewEditOpinionHandler(
+	opinionRepository opinion.OpinionRepository,
+	opinionRevisionRepository opinion.OpinionRevisionRepository,
+	voteRepository vote.VoteRepository,
+	voteChangeRepository vote.VoteChangeRepository,
+	cfg *config.Config,
+	dbManager *db.DBManager,
+) EditOpinion {
+	return &editOpinionHandler{
+		OpinionRepository:         opinionRepository,
+		OpinionRevisionRepository: opinionRevisionRepository,
+		VoteRepository:            voteRepository,
+		VoteChangeRepository:      voteChangeRepository,
+		policy: opinion.EditPolicy{
+			GracePeriod: time.Duration(cfg.OpinionEditGracePeriod) * time.Minute,
+			MaxVotes:    cfg.OpinionEditMaxVotes,
+		},
+		DBManager: dbManager,
+	}
+}
+
+// Execute 投稿者が意見を編集する
+// 編集前の内容は履歴として残し、内容が大きく変わった場合は編集前の内容に対する投票をリセットする
+func (h *editOpinionHandler) Execute(ctx context.Context, input EditOpinionInput) (*EditOpinionOutput, error) {
+	ctx, span := otel.Tracer("opinion_command").Start(ctx, "editOpinionHandler.Execute")
+	defer span.End()
+
+	var (
+		op       *opinion.Opinion
+		revision *opinion.OpinionRevision
+	)
+	if err := h.ExecTx(ctx, func(ctx context.Context) error {
+		var err error
+		op, err = h.OpinionRepository.FindByID(ctx, input.OpinionID)
+		if err != nil {
+			utils.HandleError(ctx, err, "OpinionRepository.FindByID")
+			return messages.OpinionNotFound
+		}
+
+		// 投稿者自身の投票は数えない
+		votes, err := h.VoteRepository.FindByOpinionID(ctx, input.OpinionID)
+		if err != nil {
+			utils.HandleError(ctx, err, "VoteRepository.FindByOpinionID")
+			return messages.OpinionUpdateFailed
+		}
+		otherVotes := lo.Filter(votes, func(v vote.Vote, _ int) bool {
+			return v.UserID != op.UserID()
+		})
+
+		revision, err = op.Edit(input.UserID, input.Title, input.Content, input.ReferenceURL, len(otherVotes), h.policy, clock.Now(ctx))
+		if err != nil {
+			return err
+		}
+
+		if err := h.OpinionRevisionRepository.Create(ctx, *revision); err != nil {
+			utils.HandleError(ctx, err, "OpinionRevisionRepository.Create")
+			return messages.OpinionUpdateFailed
+		}
+		if err := h.OpinionRepository.Update(ctx, *op); err != nil {
+			utils.HandleError(ctx, err, "OpinionRepository.Update")
+			return messages.OpinionUpdateFailed
+		}
+
+		// 編集前の内容への投票は、編集後の内容に賛成・反対したとはいえないためリセットする
+		if revision.VotesReset() {
+			for _, v := range otherVotes {
+				if err := h.VoteRepository.Delete(ctx, v); err != nil {
+					utils.HandleError(ctx, err, "VoteRepository.Delete")
+					return messages.OpinionUpdateFailed
+				}
+				if err := h.VoteChangeRepository.Create(ctx, *vote.NewVoteResetChange(v, *op.EditedAt())); err != nil {
+					utils.HandleError(ctx, err, "VoteChangeRepository.Create")
+					return messages.OpinionUpdateFailed
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &EditOpinionOutput{
+		Opinion: dto.Opinion{
+			OpinionID:       op.OpinionID(),
+			TalkSessionID:   op.TalkSessionID(),
+			UserID:          op.UserID(),
+			ParentOpinionID: op.ParentOpinionID(),
+			Title:           op.Title(),
+			Content:         op.Content(),
+			CreatedAt:       op.CreatedAt(),
+			ReferenceURL:    op.ReferenceURL(),
+			EditedAt:        utils.ToNullableSQL[sql.NullTime](op.EditedAt()),
+		},
+		VotesReset: revision.VotesReset(),
+	}, nil
+}
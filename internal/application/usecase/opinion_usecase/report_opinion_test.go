@@ -32,11 +32,21 @@ func (m *mockOpinionRepository) Create(ctx context.Context, op opinion.Opinion)
 	return args.Error(0)
 }
 
+func (m *mockOpinionRepository) Update(ctx context.Context, op opinion.Opinion) error {
+	args := m.Called(ctx, op)
+	return args.Error(0)
+}
+
 func (m *mockOpinionRepository) FindByParentID(ctx context.Context, id shared.UUID[opinion.Opinion]) ([]opinion.Opinion, error) {
 	args := m.Called(ctx, id)
 	return args.Get(0).([]opinion.Opinion), args.Error(1)
 }
 
+func (m *mockOpinionRepository) FindSeedsByTalkSessionID(ctx context.Context, id shared.UUID[talksession.TalkSession]) ([]opinion.Opinion, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).([]opinion.Opinion), args.Error(1)
+}
+
 func (m *mockOpinionRepository) FindByTalkSessionWithoutVote(
 	ctx context.Context,
 	userID shared.UUID[user.User],
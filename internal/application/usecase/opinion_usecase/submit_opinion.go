@@ -47,6 +47,7 @@ type (
 		opinion.OpinionRepository
 		opinion.OpinionService
 		vote.VoteRepository
+		vote.VoteChangeRepository
 		service.TalkSessionAccessControl
 		talksession.TalkSessionRepository
 		image.ImageStorage
@@ -59,6 +60,7 @@ func NewSubmitOpinionHandler(
 	opinionRepository opinion.OpinionRepository,
 	opinionService opinion.OpinionService,
 	voteRepository vote.VoteRepository,
+	voteChangeRepository vote.VoteChangeRepository,
 	talkSessionAccessControl service.TalkSessionAccessControl,
 	talkSessionRepository talksession.TalkSessionRepository,
 	dbManager *db.DBManager,
@@ -70,6 +72,7 @@ func NewSubmitOpinionHandler(
 		OpinionService:           opinionService,
 		OpinionRepository:        opinionRepository,
 		VoteRepository:           voteRepository,
+		VoteChangeRepository:     voteChangeRepository,
 		TalkSessionAccessControl: talkSessionAccessControl,
 		TalkSessionRepository:    talkSessionRepository,
 		ImageStorage:             imageStorage,
@@ -177,6 +180,7 @@ func (h *submitOpinionHandler) Execute(ctx context.Context, input SubmitOpinionI
 			opinion.ChangeReferenceImageURL(url)
 		}
 
+		opinion.Submit()
 		if err := h.OpinionRepository.Create(ctx, *opinion); err != nil {
 			utils.HandleError(ctx, err, "OpinionRepository.Create")
 			return messages.OpinionCreateFailed
@@ -204,6 +208,10 @@ func (h *submitOpinionHandler) Execute(ctx context.Context, input SubmitOpinionI
 		if err := h.VoteRepository.Create(ctx, *v); err != nil {
 			return messages.VoteFailed
 		}
+		if err := h.VoteChangeRepository.Create(ctx, *vote.NewVoteChange(*v, vote.UnVoted, v.CreatedAt)); err != nil {
+			utils.HandleError(ctx, err, "VoteChangeRepository.Create")
+			return messages.VoteFailed
+		}
 
 		return nil
 	}); err != nil {
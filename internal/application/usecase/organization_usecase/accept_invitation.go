@@ -0,0 +1,74 @@
+package organization_usecase
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	organization_svc "github.com/neko-dream/api/internal/domain/service/organization"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type AcceptOrganizationInvitationCommand interface {
+	Execute(ctx context.Context, input AcceptOrganizationInvitationInput) (*AcceptOrganizationInvitationOutput, error)
+}
+
+type AcceptOrganizationInvitationInput struct {
+	UserID shared.UUID[user.User]
+	Token  string
+}
+
+type AcceptOrganizationInvitationOutput struct {
+	Organization     *organization.Organization
+	OrganizationUser *organization.OrganizationUser
+}
+
+type acceptOrganizationInvitationInteractor struct {
+	invitationService  organization_svc.OrganizationInvitationService
+	auditLogRepository organization.OrganizationAuditLogRepository
+	*db.DBManager
+}
+
+func NewAcceptOrganizationInvitationInteractor(
+	invitationService organization_svc.OrganizationInvitationService,
+	auditLogRepository organization.OrganizationAuditLogRepository,
+	dbManager *db.DBManager,
+) AcceptOrganizationInvitationCommand {
+	return &acceptOrganizationInvitationInteractor{
+		invitationService:  invitationService,
+		auditLogRepository: auditLogRepository,
+		DBManager:          dbManager,
+	}
+}
+
+func (i *acceptOrganizationInvitationInteractor) Execute(ctx context.Context, input AcceptOrganizationInvitationInput) (*AcceptOrganizationInvitationOutput, error) {
+	ctx, span := otel.Tracer("organization_command").Start(ctx, "acceptOrganizationInvitationInteractor.Execute")
+	defer span.End()
+
+	var output AcceptOrganizationInvitationOutput
+	if err := i.ExecTx(ctx, func(ctx context.Context) error {
+		org, orgUser, err := i.invitationService.Accept(ctx, input.UserID, input.Token)
+		if err != nil {
+			return err
+		}
+		output.Organization = org
+		output.OrganizationUser = orgUser
+
+		auditLog := organization.NewOrganizationAuditLog(org.OrganizationID, input.UserID, organization.AuditActionInvitationAccepted, organization.AuditTargetUser, input.UserID.String(), clock.Now(ctx))
+		auditLog.RecordChange("role", nil, orgUser.Role)
+		if err := i.auditLogRepository.Create(ctx, auditLog); err != nil {
+			utils.HandleError(ctx, err, "OrganizationAuditLogRepository.Create")
+			return messages.OrganizationInternalServerError
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
@@ -0,0 +1,34 @@
+package organization_usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/pkg/utils"
+)
+
+// findOrganizationUserForAudit 監査ログに変更前の値を残すため、操作対象の組織ユーザーを取得する
+func findOrganizationUserForAudit(
+	ctx context.Context,
+	organizationUserRepo organization.OrganizationUserRepository,
+	organizationID shared.UUID[organization.Organization],
+	userID shared.UUID[user.User],
+) (*organization.OrganizationUser, error) {
+	orgUser, err := organizationUserRepo.FindByOrganizationIDAndUserID(ctx, organizationID, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, messages.OrganizationUserNotFound
+		}
+		utils.HandleError(ctx, err, "OrganizationUserRepository.FindByOrganizationIDAndUserID")
+		return nil, messages.OrganizationInternalServerError
+	}
+	if orgUser == nil {
+		return nil, messages.OrganizationUserNotFound
+	}
+	return orgUser, nil
+}
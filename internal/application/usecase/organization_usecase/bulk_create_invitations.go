@@ -0,0 +1,217 @@
+package organization_usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	organization_svc "github.com/neko-dream/api/internal/domain/service/organization"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+const (
+	// bulkInvitationMaxRows 一括招待で1度に取り込める最大行数
+	bulkInvitationMaxRows = 500
+	// bulkInvitationMaxFileSize 一括招待で受け付けるCSVの最大サイズ
+	bulkInvitationMaxFileSize = 1 << 20
+)
+
+type BulkCreateOrganizationInvitationsCommand interface {
+	Execute(ctx context.Context, input BulkCreateOrganizationInvitationsInput) (*BulkCreateOrganizationInvitationsOutput, error)
+}
+
+// BulkCreateOrganizationInvitationsInput
+// CSVは1列目にメールアドレス、2列目にロール（数値またはロール名。省略時はメンバー）を指定する
+// 1行目が「email」または「メールアドレス」で始まる場合はヘッダーとして読み飛ばす
+type BulkCreateOrganizationInvitationsInput struct {
+	UserID         shared.UUID[user.User]
+	OrganizationID shared.UUID[organization.Organization]
+	CSV            io.Reader
+}
+
+type BulkInvitationResult struct {
+	// Line CSVの行番号（1始まり）
+	Line       int
+	Email      string
+	Invitation *organization.OrganizationInvitation
+	// Err 招待できなかった理由。成功した場合はnil
+	Err error
+}
+
+type BulkCreateOrganizationInvitationsOutput struct {
+	Results []BulkInvitationResult
+}
+
+type bulkCreateOrganizationInvitationsInteractor struct {
+	invitationService  organization_svc.OrganizationInvitationService
+	auditLogRepository organization.OrganizationAuditLogRepository
+	*db.DBManager
+}
+
+func NewBulkCreateOrganizationInvitationsInteractor(
+	invitationService organization_svc.OrganizationInvitationService,
+	auditLogRepository organization.OrganizationAuditLogRepository,
+	dbManager *db.DBManager,
+) BulkCreateOrganizationInvitationsCommand {
+	return &bulkCreateOrganizationInvitationsInteractor{
+		invitationService:  invitationService,
+		auditLogRepository: auditLogRepository,
+		DBManager:          dbManager,
+	}
+}
+
+type invitationCSVRow struct {
+	line  int
+	email string
+	role  string
+}
+
+func (i *bulkCreateOrganizationInvitationsInteractor) Execute(ctx context.Context, input BulkCreateOrganizationInvitationsInput) (*BulkCreateOrganizationInvitationsOutput, error) {
+	ctx, span := otel.Tracer("organization_command").Start(ctx, "bulkCreateOrganizationInvitationsInteractor.Execute")
+	defer span.End()
+
+	rows, err := parseInvitationCSV(input.CSV)
+	if err != nil {
+		return nil, err
+	}
+
+	// 1行ずつ招待し、失敗した行があっても残りの行は処理する
+	results := make([]BulkInvitationResult, 0, len(rows))
+	seen := make(map[string]struct{}, len(rows))
+	for _, row := range rows {
+		result := BulkInvitationResult{Line: row.line, Email: row.email}
+
+		role, err := parseInvitationRole(row.role)
+		if err != nil {
+			result.Err = err
+			results = append(results, result)
+			continue
+		}
+		key := strings.ToLower(row.email)
+		if _, ok := seen[key]; ok {
+			result.Err = messages.OrganizationInvitationAlreadyPending
+			results = append(results, result)
+			continue
+		}
+		seen[key] = struct{}{}
+
+		if err := i.ExecTx(ctx, func(ctx context.Context) error {
+			invitation, err := i.invitationService.Invite(ctx, organization_svc.InviteParams{
+				OrganizationID: input.OrganizationID,
+				InviterID:      input.UserID,
+				Email:          row.email,
+				Role:           role,
+			})
+			if err != nil {
+				return err
+			}
+			result.Invitation = invitation
+
+			auditLog := organization.NewOrganizationAuditLog(input.OrganizationID, input.UserID, organization.AuditActionInvitationCreated, organization.AuditTargetInvitation, invitation.InvitationID().String(), clock.Now(ctx))
+			auditLog.RecordChange("role", nil, invitation.Role())
+			auditLog.RecordChange("expires_at", nil, invitation.ExpiresAt())
+			if err := i.auditLogRepository.Create(ctx, auditLog); err != nil {
+				utils.HandleError(ctx, err, "OrganizationAuditLogRepository.Create")
+				return messages.OrganizationInternalServerError
+			}
+			return nil
+		}); err != nil {
+			result.Err = err
+		}
+		results = append(results, result)
+	}
+
+	return &BulkCreateOrganizationInvitationsOutput{
+		Results: results,
+	}, nil
+}
+
+func parseInvitationCSV(r io.Reader) ([]invitationCSVRow, error) {
+	if r == nil {
+		return nil, messages.OrganizationInvitationCSVInvalid
+	}
+	data, err := io.ReadAll(io.LimitReader(r, bulkInvitationMaxFileSize+1))
+	if err != nil || len(data) > bulkInvitationMaxFileSize {
+		return nil, messages.OrganizationInvitationCSVInvalid
+	}
+	// Excelで保存したCSVのBOMを取り除く
+	data = bytes.TrimPrefix(data, []byte("\ufeff"))
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var rows []invitationCSVRow
+	line := 0
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, messages.OrganizationInvitationCSVInvalid
+		}
+		line++
+
+		email := strings.TrimSpace(record[0])
+		if line == 1 && isInvitationCSVHeader(email) {
+			continue
+		}
+		if email == "" {
+			continue
+		}
+
+		var role string
+		if len(record) > 1 {
+			role = strings.TrimSpace(record[1])
+		}
+		rows = append(rows, invitationCSVRow{line: line, email: email, role: role})
+		if len(rows) > bulkInvitationMaxRows {
+			return nil, messages.OrganizationInvitationCSVInvalid
+		}
+	}
+	if len(rows) == 0 {
+		return nil, messages.OrganizationInvitationCSVInvalid
+	}
+
+	return rows, nil
+}
+
+func isInvitationCSVHeader(cell string) bool {
+	return strings.EqualFold(cell, "email") || cell == "メールアドレス"
+}
+
+// parseInvitationRole CSVのロール列を解釈する。省略時はメンバーとして扱う
+func parseInvitationRole(s string) (organization.OrganizationUserRole, error) {
+	if s == "" {
+		return organization.OrganizationUserRoleMember, nil
+	}
+	if n, err := strconv.Atoi(s); err == nil {
+		role := organization.OrganizationUserRole(n)
+		if !role.IsValid() {
+			return 0, messages.OrganizationInvitationInvalidParameter
+		}
+		return role, nil
+	}
+	for _, role := range []organization.OrganizationUserRole{
+		organization.OrganizationUserRoleOwner,
+		organization.OrganizationUserRoleAdmin,
+		organization.OrganizationUserRoleMember,
+	} {
+		if organization.RoleToName(role) == s {
+			return role, nil
+		}
+	}
+	return 0, messages.OrganizationInvitationInvalidParameter
+}
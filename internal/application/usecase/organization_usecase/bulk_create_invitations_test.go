@@ -0,0 +1,69 @@
+package organization_usecase
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseInvitationCSV(t *testing.T) {
+	t.Run("ヘッダーとBOMを読み飛ばし行番号を保持する", func(t *testing.T) {
+		csv := "\ufeffemail,role\nalice@example.com,30\n\nbob@example.com\n"
+
+		rows, err := parseInvitationCSV(strings.NewReader(csv))
+		require.NoError(t, err)
+
+		assert.Equal(t, []invitationCSVRow{
+			{line: 2, email: "alice@example.com", role: "30"},
+			{line: 3, email: "bob@example.com", role: ""},
+		}, rows)
+	})
+
+	t.Run("ヘッダーがなくても読み込める", func(t *testing.T) {
+		rows, err := parseInvitationCSV(strings.NewReader("alice@example.com,管理者\n"))
+		require.NoError(t, err)
+		assert.Len(t, rows, 1)
+		assert.Equal(t, 1, rows[0].line)
+	})
+
+	t.Run("空のCSVはエラー", func(t *testing.T) {
+		_, err := parseInvitationCSV(strings.NewReader("email,role\n"))
+		assert.ErrorIs(t, err, messages.OrganizationInvitationCSVInvalid)
+	})
+
+	t.Run("行数の上限を超えるとエラー", func(t *testing.T) {
+		csv := strings.Repeat("a@example.com\n", bulkInvitationMaxRows+1)
+		_, err := parseInvitationCSV(strings.NewReader(csv))
+		assert.ErrorIs(t, err, messages.OrganizationInvitationCSVInvalid)
+	})
+}
+
+func TestParseInvitationRole(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    organization.OrganizationUserRole
+		wantErr bool
+	}{
+		{name: "省略時はメンバー", input: "", want: organization.OrganizationUserRoleMember},
+		{name: "数値で指定できる", input: "30", want: organization.OrganizationUserRoleAdmin},
+		{name: "ロール名で指定できる", input: "オーナー", want: organization.OrganizationUserRoleOwner},
+		{name: "未定義の数値はエラー", input: "35", wantErr: true},
+		{name: "未定義の名前はエラー", input: "guest", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseInvitationRole(tt.input)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, messages.OrganizationInvitationInvalidParameter)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
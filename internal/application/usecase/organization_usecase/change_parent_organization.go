@@ -0,0 +1,97 @@
+package organization_usecase
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	organization_svc "github.com/neko-dream/api/internal/domain/service/organization"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type ChangeParentOrganizationCommand interface {
+	Execute(ctx context.Context, input ChangeParentOrganizationInput) (*ChangeParentOrganizationOutput, error)
+}
+
+type ChangeParentOrganizationInput struct {
+	UserID         shared.UUID[user.User]
+	OrganizationID shared.UUID[organization.Organization]
+	// ParentOrganizationID nilの場合は親子関係を解除する
+	ParentOrganizationID *shared.UUID[organization.Organization]
+}
+
+type ChangeParentOrganizationOutput struct {
+	Organization *organization.Organization
+}
+
+type changeParentOrganizationInteractor struct {
+	hierarchyService   organization_svc.OrganizationHierarchyService
+	organizationRepo   organization.OrganizationRepository
+	auditLogRepository organization.OrganizationAuditLogRepository
+	*db.DBManager
+}
+
+func NewChangeParentOrganizationInteractor(
+	hierarchyService organization_svc.OrganizationHierarchyService,
+	organizationRepo organization.OrganizationRepository,
+	auditLogRepository organization.OrganizationAuditLogRepository,
+	dbManager *db.DBManager,
+) ChangeParentOrganizationCommand {
+	return &changeParentOrganizationInteractor{
+		hierarchyService:   hierarchyService,
+		organizationRepo:   organizationRepo,
+		auditLogRepository: auditLogRepository,
+		DBManager:          dbManager,
+	}
+}
+
+func (i *changeParentOrganizationInteractor) Execute(ctx context.Context, input ChangeParentOrganizationInput) (*ChangeParentOrganizationOutput, error) {
+	ctx, span := otel.Tracer("organization_command").Start(ctx, "changeParentOrganizationInteractor.Execute")
+	defer span.End()
+
+	var output ChangeParentOrganizationOutput
+	if err := i.ExecTx(ctx, func(ctx context.Context) error {
+		before, err := i.organizationRepo.FindByID(ctx, input.OrganizationID)
+		if err != nil || before == nil {
+			return messages.OrganizationNotFound
+		}
+		beforeParentID := before.ParentOrganizationID
+
+		org, err := i.hierarchyService.ChangeParent(ctx, organization_svc.ChangeParentParams{
+			OrganizationID: input.OrganizationID,
+			ParentID:       input.ParentOrganizationID,
+			OperatorID:     input.UserID,
+		})
+		if err != nil {
+			return err
+		}
+
+		auditLog := organization.NewOrganizationAuditLog(input.OrganizationID, input.UserID, organization.AuditActionParentChanged, organization.AuditTargetOrganization, input.OrganizationID.String(), clock.Now(ctx))
+		auditLog.RecordChange("parent_organization_id", organizationIDString(beforeParentID), organizationIDString(org.ParentOrganizationID))
+		if err := i.auditLogRepository.Create(ctx, auditLog); err != nil {
+			utils.HandleError(ctx, err, "OrganizationAuditLogRepository.Create")
+			return messages.OrganizationInternalServerError
+		}
+
+		output.Organization = org
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
+
+// organizationIDString 監査ログに記録するため組織IDを文字列にする
+func organizationIDString(id *shared.UUID[organization.Organization]) *string {
+	if id == nil {
+		return nil
+	}
+	s := id.String()
+	return &s
+}
@@ -0,0 +1,99 @@
+package organization_usecase
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/session"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	organization_svc "github.com/neko-dream/api/internal/domain/service/organization"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type ChangeOrganizationUserRoleCommand interface {
+	Execute(ctx context.Context, input ChangeOrganizationUserRoleInput) (*ChangeOrganizationUserRoleOutput, error)
+}
+
+type ChangeOrganizationUserRoleInput struct {
+	UserID         shared.UUID[user.User]
+	OrganizationID shared.UUID[organization.Organization]
+	TargetUserID   shared.UUID[user.User]
+	Role           organization.OrganizationUserRole
+}
+
+type ChangeOrganizationUserRoleOutput struct {
+	OrganizationUser *organization.OrganizationUser
+}
+
+type changeOrganizationUserRoleInteractor struct {
+	memberManager        organization_svc.OrganizationMemberManager
+	sessionService       session.SessionService
+	organizationUserRepo organization.OrganizationUserRepository
+	auditLogRepository   organization.OrganizationAuditLogRepository
+	*db.DBManager
+}
+
+func NewChangeOrganizationUserRoleInteractor(
+	memberManager organization_svc.OrganizationMemberManager,
+	sessionService session.SessionService,
+	organizationUserRepo organization.OrganizationUserRepository,
+	auditLogRepository organization.OrganizationAuditLogRepository,
+	dbManager *db.DBManager,
+) ChangeOrganizationUserRoleCommand {
+	return &changeOrganizationUserRoleInteractor{
+		memberManager:        memberManager,
+		sessionService:       sessionService,
+		organizationUserRepo: organizationUserRepo,
+		auditLogRepository:   auditLogRepository,
+		DBManager:            dbManager,
+	}
+}
+
+func (i *changeOrganizationUserRoleInteractor) Execute(ctx context.Context, input ChangeOrganizationUserRoleInput) (*ChangeOrganizationUserRoleOutput, error) {
+	ctx, span := otel.Tracer("organization_command").Start(ctx, "changeOrganizationUserRoleInteractor.Execute")
+	defer span.End()
+
+	var orgUser *organization.OrganizationUser
+	if err := i.ExecTx(ctx, func(ctx context.Context) error {
+		before, err := findOrganizationUserForAudit(ctx, i.organizationUserRepo, input.OrganizationID, input.TargetUserID)
+		if err != nil {
+			return err
+		}
+
+		changed, err := i.memberManager.ChangeRole(ctx, organization_svc.ChangeRoleParams{
+			OrganizationID: input.OrganizationID,
+			OperatorID:     input.UserID,
+			TargetUserID:   input.TargetUserID,
+			Role:           input.Role,
+		})
+		if err != nil {
+			return err
+		}
+		orgUser = changed
+
+		auditLog := organization.NewOrganizationAuditLog(input.OrganizationID, input.UserID, organization.AuditActionUserRoleChanged, organization.AuditTargetUser, input.TargetUserID.String(), clock.Now(ctx))
+		auditLog.RecordChange("role", before.Role, changed.Role)
+		if err := i.auditLogRepository.Create(ctx, auditLog); err != nil {
+			utils.HandleError(ctx, err, "OrganizationAuditLogRepository.Create")
+			return messages.OrganizationInternalServerError
+		}
+
+		// ロールはトークンに含まれるため、対象ユーザーの組織セッションを無効化して再ログインさせる
+		if err := i.sessionService.DeactivateOrganizationSessions(ctx, input.TargetUserID, input.OrganizationID); err != nil {
+			utils.HandleError(ctx, err, "SessionService.DeactivateOrganizationSessions")
+			return err
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &ChangeOrganizationUserRoleOutput{
+		OrganizationUser: orgUser,
+	}, nil
+}
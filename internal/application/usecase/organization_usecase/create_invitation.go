@@ -0,0 +1,82 @@
+package organization_usecase
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	organization_svc "github.com/neko-dream/api/internal/domain/service/organization"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type CreateOrganizationInvitationCommand interface {
+	Execute(ctx context.Context, input CreateOrganizationInvitationInput) (*CreateOrganizationInvitationOutput, error)
+}
+
+type CreateOrganizationInvitationInput struct {
+	UserID         shared.UUID[user.User]
+	OrganizationID shared.UUID[organization.Organization]
+	Email          string
+	Role           organization.OrganizationUserRole
+}
+
+type CreateOrganizationInvitationOutput struct {
+	Invitation *organization.OrganizationInvitation
+}
+
+type createOrganizationInvitationInteractor struct {
+	invitationService  organization_svc.OrganizationInvitationService
+	auditLogRepository organization.OrganizationAuditLogRepository
+	*db.DBManager
+}
+
+func NewCreateOrganizationInvitationInteractor(
+	invitationService organization_svc.OrganizationInvitationService,
+	auditLogRepository organization.OrganizationAuditLogRepository,
+	dbManager *db.DBManager,
+) CreateOrganizationInvitationCommand {
+	return &createOrganizationInvitationInteractor{
+		invitationService:  invitationService,
+		auditLogRepository: auditLogRepository,
+		DBManager:          dbManager,
+	}
+}
+
+func (i *createOrganizationInvitationInteractor) Execute(ctx context.Context, input CreateOrganizationInvitationInput) (*CreateOrganizationInvitationOutput, error) {
+	ctx, span := otel.Tracer("organization_command").Start(ctx, "createOrganizationInvitationInteractor.Execute")
+	defer span.End()
+
+	var invitation *organization.OrganizationInvitation
+	if err := i.ExecTx(ctx, func(ctx context.Context) error {
+		inv, err := i.invitationService.Invite(ctx, organization_svc.InviteParams{
+			OrganizationID: input.OrganizationID,
+			InviterID:      input.UserID,
+			Email:          input.Email,
+			Role:           input.Role,
+		})
+		if err != nil {
+			return err
+		}
+		invitation = inv
+
+		auditLog := organization.NewOrganizationAuditLog(input.OrganizationID, input.UserID, organization.AuditActionInvitationCreated, organization.AuditTargetInvitation, inv.InvitationID().String(), clock.Now(ctx))
+		auditLog.RecordChange("role", nil, inv.Role())
+		auditLog.RecordChange("expires_at", nil, inv.ExpiresAt())
+		if err := i.auditLogRepository.Create(ctx, auditLog); err != nil {
+			utils.HandleError(ctx, err, "OrganizationAuditLogRepository.Create")
+			return messages.OrganizationInternalServerError
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &CreateOrganizationInvitationOutput{
+		Invitation: invitation,
+	}, nil
+}
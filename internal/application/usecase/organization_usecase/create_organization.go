@@ -4,10 +4,13 @@ import (
 	"context"
 	"mime/multipart"
 
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/clock"
 	"github.com/neko-dream/api/internal/domain/model/organization"
 	"github.com/neko-dream/api/internal/domain/model/shared"
 	"github.com/neko-dream/api/internal/domain/model/user"
 	organization_svc "github.com/neko-dream/api/internal/domain/service/organization"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
 	"github.com/neko-dream/api/pkg/utils"
 	"go.opentelemetry.io/otel"
 )
@@ -29,13 +32,19 @@ type CreateOrganizationOutput struct {
 
 type createOrganizationInteractor struct {
 	organizationService organization_svc.OrganizationService
+	auditLogRepository  organization.OrganizationAuditLogRepository
+	*db.DBManager
 }
 
 func NewCreateOrganizationInteractor(
 	organizationService organization_svc.OrganizationService,
+	auditLogRepository organization.OrganizationAuditLogRepository,
+	dbManager *db.DBManager,
 ) CreateOrganizationCommand {
 	return &createOrganizationInteractor{
 		organizationService: organizationService,
+		auditLogRepository:  auditLogRepository,
+		DBManager:           dbManager,
 	}
 }
 
@@ -46,9 +55,23 @@ func (c *createOrganizationInteractor) Execute(ctx context.Context, input Create
 	orgType := organization.OrganizationType(input.Type)
 	ownerID := input.UserID
 
-	_, err := c.organizationService.CreateOrganization(ctx, input.Name, input.Code, input.IconImage, orgType, ownerID)
-	if err != nil {
-		utils.HandleError(ctx, err, "CreateOrganization")
+	if err := c.ExecTx(ctx, func(ctx context.Context) error {
+		org, err := c.organizationService.CreateOrganization(ctx, input.Name, input.Code, input.IconImage, orgType, ownerID)
+		if err != nil {
+			utils.HandleError(ctx, err, "CreateOrganization")
+			return err
+		}
+
+		auditLog := organization.NewOrganizationAuditLog(org.OrganizationID, input.UserID, organization.AuditActionOrganizationCreated, organization.AuditTargetOrganization, org.OrganizationID.String(), clock.Now(ctx))
+		auditLog.RecordChange("name", nil, org.Name)
+		auditLog.RecordChange("code", nil, org.Code)
+		auditLog.RecordChange("type", nil, org.OrganizationType)
+		if err := c.auditLogRepository.Create(ctx, auditLog); err != nil {
+			utils.HandleError(ctx, err, "OrganizationAuditLogRepository.Create")
+			return messages.OrganizationInternalServerError
+		}
+		return nil
+	}); err != nil {
 		return nil, err
 	}
 
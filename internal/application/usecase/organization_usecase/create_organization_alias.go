@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"github.com/neko-dream/api/internal/application/query/dto"
+	"github.com/neko-dream/api/internal/domain/model/clock"
 	"github.com/neko-dream/api/internal/domain/model/organization"
 	"github.com/neko-dream/api/internal/domain/model/session"
 	"github.com/neko-dream/api/internal/domain/model/shared"
@@ -29,17 +30,20 @@ type CreateOrganizationAliasUseCase struct {
 	dbManager       *db.DBManager
 	sessionRepo     session.SessionRepository
 	orgAliasService *service.OrganizationAliasService
+	auditLogRepo    organization.OrganizationAuditLogRepository
 }
 
 func NewCreateOrganizationAliasUseCase(
 	dbManager *db.DBManager,
 	sessionRepo session.SessionRepository,
 	orgAliasService *service.OrganizationAliasService,
+	auditLogRepo organization.OrganizationAuditLogRepository,
 ) *CreateOrganizationAliasUseCase {
 	return &CreateOrganizationAliasUseCase{
 		dbManager:       dbManager,
 		sessionRepo:     sessionRepo,
 		orgAliasService: orgAliasService,
+		auditLogRepo:    auditLogRepo,
 	}
 }
 
@@ -71,6 +75,12 @@ func (u *CreateOrganizationAliasUseCase) Execute(
 			return err
 		}
 
+		auditLog := organization.NewOrganizationAuditLog(input.OrganizationID, sess.UserID(), organization.AuditActionAliasCreated, organization.AuditTargetAlias, alias.AliasID().String(), clock.Now(ctx))
+		auditLog.RecordChange("alias_name", nil, alias.AliasName())
+		if err := u.auditLogRepo.Create(ctx, auditLog); err != nil {
+			return err
+		}
+
 		output = dto.OrganizationAlias{
 			AliasID:   alias.AliasID().String(),
 			AliasName: alias.AliasName(),
@@ -3,6 +3,7 @@ package organization_usecase
 import (
 	"context"
 
+	"github.com/neko-dream/api/internal/domain/model/clock"
 	"github.com/neko-dream/api/internal/domain/model/organization"
 	"github.com/neko-dream/api/internal/domain/model/session"
 	"github.com/neko-dream/api/internal/domain/model/shared"
@@ -19,17 +20,23 @@ type DeactivateOrganizationAliasUseCase struct {
 	dbManager       *db.DBManager
 	sessionRepo     session.SessionRepository
 	orgAliasService *service.OrganizationAliasService
+	aliasRepo       organization.OrganizationAliasRepository
+	auditLogRepo    organization.OrganizationAuditLogRepository
 }
 
 func NewDeactivateOrganizationAliasUseCase(
 	dbManager *db.DBManager,
 	sessionRepo session.SessionRepository,
 	orgAliasService *service.OrganizationAliasService,
+	aliasRepo organization.OrganizationAliasRepository,
+	auditLogRepo organization.OrganizationAuditLogRepository,
 ) *DeactivateOrganizationAliasUseCase {
 	return &DeactivateOrganizationAliasUseCase{
 		dbManager:       dbManager,
 		sessionRepo:     sessionRepo,
 		orgAliasService: orgAliasService,
+		aliasRepo:       aliasRepo,
+		auditLogRepo:    auditLogRepo,
 	}
 }
 
@@ -50,6 +57,16 @@ func (u *DeactivateOrganizationAliasUseCase) Execute(
 			return ErrSessionNotFound
 		}
 
-		return u.orgAliasService.DeactivateAlias(ctx, input.AliasID, sess.UserID())
+		if err := u.orgAliasService.DeactivateAlias(ctx, input.AliasID, sess.UserID()); err != nil {
+			return err
+		}
+
+		alias, err := u.aliasRepo.FindByID(ctx, input.AliasID)
+		if err != nil {
+			return err
+		}
+		auditLog := organization.NewOrganizationAuditLog(alias.OrganizationID(), sess.UserID(), organization.AuditActionAliasDeactivated, organization.AuditTargetAlias, input.AliasID.String(), clock.Now(ctx))
+		auditLog.RecordChange("alias_name", alias.AliasName(), nil)
+		return u.auditLogRepo.Create(ctx, auditLog)
 	})
 }
@@ -4,12 +4,15 @@ import (
 	"context"
 	"errors"
 
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/clock"
 	"github.com/neko-dream/api/internal/domain/model/organization"
 	"github.com/neko-dream/api/internal/domain/model/shared"
 	"github.com/neko-dream/api/internal/domain/model/user"
 	organization_svc "github.com/neko-dream/api/internal/domain/service/organization"
 	"github.com/neko-dream/api/internal/infrastructure/config"
 	"github.com/neko-dream/api/internal/infrastructure/email"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
 	"github.com/neko-dream/api/pkg/utils"
 	"go.opentelemetry.io/otel"
 )
@@ -33,8 +36,10 @@ type inviteOrganizationInteractor struct {
 	userRepository             user.UserRepository
 	organizationUserRepository organization.OrganizationUserRepository
 	organization_svc.OrganizationMemberManager
-	emailSender email.EmailSender
-	cfg         *config.Config
+	emailSender        email.EmailSender
+	cfg                *config.Config
+	auditLogRepository organization.OrganizationAuditLogRepository
+	*db.DBManager
 }
 
 func NewInviteOrganizationInteractor(
@@ -44,6 +49,8 @@ func NewInviteOrganizationInteractor(
 	organizationMemberManager organization_svc.OrganizationMemberManager,
 	emailSender email.EmailSender,
 	cfg *config.Config,
+	auditLogRepository organization.OrganizationAuditLogRepository,
+	dbManager *db.DBManager,
 ) InviteOrganizationCommand {
 	return &inviteOrganizationInteractor{
 		organizationService:        organizationService,
@@ -52,6 +59,8 @@ func NewInviteOrganizationInteractor(
 		OrganizationMemberManager:  organizationMemberManager,
 		emailSender:                emailSender,
 		cfg:                        cfg,
+		auditLogRepository:         auditLogRepository,
+		DBManager:                  dbManager,
 	}
 }
 
@@ -60,7 +69,7 @@ func (i *inviteOrganizationInteractor) Execute(ctx context.Context, input Invite
 	defer span.End()
 
 	// ユーザーの組織ユーザーを取得
-	orgUser, err := i.organizationUserRepository.FindByOrganizationIDAndUserID(ctx, input.OrganizationID, input.UserID)
+	orgUser, err := i.organizationUserRepository.FindEffectiveByOrganizationIDAndUserID(ctx, input.OrganizationID, input.UserID)
 	if err != nil {
 		return nil, err
 	}
@@ -82,15 +91,27 @@ func (i *inviteOrganizationInteractor) Execute(ctx context.Context, input Invite
 		return nil, errors.New("invalid role")
 	}
 
-	// 組織の招待を送信
-	_, err = i.OrganizationMemberManager.InviteUser(ctx, organization_svc.InviteUserParams{
-		OrganizationID: input.OrganizationID,
-		Role:           organization.OrganizationUserRole(input.Role),
-		UserID:         input.UserID,
-		Email:          input.Email,
-	})
-	if err != nil {
-		utils.HandleError(ctx, err, "InviteUser")
+	if err := i.ExecTx(ctx, func(ctx context.Context) error {
+		// 組織の招待を送信
+		invited, err := i.OrganizationMemberManager.InviteUser(ctx, organization_svc.InviteUserParams{
+			OrganizationID: input.OrganizationID,
+			Role:           organization.OrganizationUserRole(input.Role),
+			UserID:         input.UserID,
+			Email:          input.Email,
+		})
+		if err != nil {
+			utils.HandleError(ctx, err, "InviteUser")
+			return err
+		}
+
+		auditLog := organization.NewOrganizationAuditLog(input.OrganizationID, input.UserID, organization.AuditActionUserAdded, organization.AuditTargetUser, invited.UserID.String(), clock.Now(ctx))
+		auditLog.RecordChange("role", nil, invited.Role)
+		if err := i.auditLogRepository.Create(ctx, auditLog); err != nil {
+			utils.HandleError(ctx, err, "OrganizationAuditLogRepository.Create")
+			return messages.OrganizationInternalServerError
+		}
+		return nil
+	}); err != nil {
 		return nil, err
 	}
 
@@ -6,10 +6,12 @@ import (
 	"errors"
 
 	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/clock"
 	"github.com/neko-dream/api/internal/domain/model/organization"
 	"github.com/neko-dream/api/internal/domain/model/shared"
 	"github.com/neko-dream/api/internal/domain/model/user"
 	organization_svc "github.com/neko-dream/api/internal/domain/service/organization"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
 	"github.com/neko-dream/api/pkg/utils"
 	"go.opentelemetry.io/otel"
 )
@@ -33,17 +35,23 @@ type inviteOrganizationForUserInteractor struct {
 	userRepository             user.UserRepository
 	organizationUserRepository organization.OrganizationUserRepository
 	organizationMemberManager  organization_svc.OrganizationMemberManager
+	auditLogRepository         organization.OrganizationAuditLogRepository
+	*db.DBManager
 }
 
 func NewInviteOrganizationForUserInteractor(
 	userRepository user.UserRepository,
 	organizationUserRepository organization.OrganizationUserRepository,
 	organizationMemberManager organization_svc.OrganizationMemberManager,
+	auditLogRepository organization.OrganizationAuditLogRepository,
+	dbManager *db.DBManager,
 ) InviteOrganizationForUserCommand {
 	return &inviteOrganizationForUserInteractor{
 		userRepository:             userRepository,
 		organizationUserRepository: organizationUserRepository,
 		organizationMemberManager:  organizationMemberManager,
+		auditLogRepository:         auditLogRepository,
+		DBManager:                  dbManager,
 	}
 }
 
@@ -62,7 +70,7 @@ func (i *inviteOrganizationForUserInteractor) Execute(ctx context.Context, input
 	}
 
 	// ログインユーザーが組織の管理者であることを確認
-	orgUser, err := i.organizationUserRepository.FindByOrganizationIDAndUserID(ctx, input.OrganizationID, input.UserID)
+	orgUser, err := i.organizationUserRepository.FindEffectiveByOrganizationIDAndUserID(ctx, input.OrganizationID, input.UserID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, messages.OrganizationPermissionDenied
@@ -76,12 +84,24 @@ func (i *inviteOrganizationForUserInteractor) Execute(ctx context.Context, input
 		return nil, messages.OrganizationPermissionDenied
 	}
 
-	if err := i.organizationMemberManager.AddUser(ctx, organization_svc.InviteUserParams{
-		OrganizationID: input.OrganizationID,
-		Role:           targetRole,
-		UserID:         user.UserID(),
+	if err := i.ExecTx(ctx, func(ctx context.Context) error {
+		if err := i.organizationMemberManager.AddUser(ctx, organization_svc.InviteUserParams{
+			OrganizationID: input.OrganizationID,
+			Role:           targetRole,
+			UserID:         user.UserID(),
+		}); err != nil {
+			utils.HandleError(ctx, err, "organizationMemberManager.AddUser")
+			return err
+		}
+
+		auditLog := organization.NewOrganizationAuditLog(input.OrganizationID, input.UserID, organization.AuditActionUserAdded, organization.AuditTargetUser, user.UserID().String(), clock.Now(ctx))
+		auditLog.RecordChange("role", nil, targetRole)
+		if err := i.auditLogRepository.Create(ctx, auditLog); err != nil {
+			utils.HandleError(ctx, err, "OrganizationAuditLogRepository.Create")
+			return messages.OrganizationInternalServerError
+		}
+		return nil
 	}); err != nil {
-		utils.HandleError(ctx, err, "organizationMemberManager.AddUser")
 		return nil, err
 	}
 
@@ -0,0 +1,111 @@
+package organization_usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/internal/infrastructure/config"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type IssueOrganizationAPIKeyCommand interface {
+	Execute(ctx context.Context, input IssueOrganizationAPIKeyInput) (*IssueOrganizationAPIKeyOutput, error)
+}
+
+type IssueOrganizationAPIKeyInput struct {
+	UserID         shared.UUID[user.User]
+	OrganizationID shared.UUID[organization.Organization]
+	Name           string
+	Scopes         []string
+	ExpiresAt      *time.Time
+}
+
+type IssueOrganizationAPIKeyOutput struct {
+	APIKey *organization.OrganizationAPIKey
+	// 発行したキー本体。この時点でしか取得できない
+	RawKey string
+}
+
+type issueOrganizationAPIKeyInteractor struct {
+	apiKeyRepository   organization.OrganizationAPIKeyRepository
+	auditLogRepository organization.OrganizationAuditLogRepository
+	config             *config.Config
+	*db.DBManager
+}
+
+func NewIssueOrganizationAPIKeyInteractor(
+	apiKeyRepository organization.OrganizationAPIKeyRepository,
+	auditLogRepository organization.OrganizationAuditLogRepository,
+	config *config.Config,
+	dbManager *db.DBManager,
+) IssueOrganizationAPIKeyCommand {
+	return &issueOrganizationAPIKeyInteractor{
+		apiKeyRepository:   apiKeyRepository,
+		auditLogRepository: auditLogRepository,
+		config:             config,
+		DBManager:          dbManager,
+	}
+}
+
+func (i *issueOrganizationAPIKeyInteractor) Execute(ctx context.Context, input IssueOrganizationAPIKeyInput) (*IssueOrganizationAPIKeyOutput, error) {
+	ctx, span := otel.Tracer("organization_command").Start(ctx, "issueOrganizationAPIKeyInteractor.Execute")
+	defer span.End()
+
+	scopes := make([]organization.APIKeyScope, 0, len(input.Scopes))
+	for _, s := range input.Scopes {
+		scope, err := organization.ParseAPIKeyScope(s)
+		if err != nil {
+			return nil, messages.APIKeyInvalidParameterError
+		}
+		scopes = append(scopes, scope)
+	}
+
+	now := clock.Now(ctx)
+	if input.ExpiresAt != nil && !input.ExpiresAt.After(now) {
+		return nil, messages.APIKeyInvalidParameterError
+	}
+
+	key, rawKey, err := organization.NewOrganizationAPIKey(
+		input.OrganizationID,
+		input.Name,
+		scopes,
+		input.UserID,
+		input.ExpiresAt,
+		i.config.HASH_PEPPER,
+		now,
+	)
+	if err != nil {
+		return nil, messages.APIKeyInvalidParameterError
+	}
+
+	if err := i.ExecTx(ctx, func(ctx context.Context) error {
+		if err := i.apiKeyRepository.Create(ctx, key); err != nil {
+			utils.HandleError(ctx, err, "OrganizationAPIKeyRepository.Create")
+			return messages.OrganizationInternalServerError
+		}
+
+		auditLog := organization.NewOrganizationAuditLog(input.OrganizationID, input.UserID, organization.AuditActionAPIKeyIssued, organization.AuditTargetAPIKey, key.APIKeyID().String(), clock.Now(ctx))
+		auditLog.RecordChange("name", nil, key.Name())
+		auditLog.RecordChange("scopes", nil, key.Scopes())
+		auditLog.RecordChange("expires_at", nil, key.ExpiresAt())
+		if err := i.auditLogRepository.Create(ctx, auditLog); err != nil {
+			utils.HandleError(ctx, err, "OrganizationAuditLogRepository.Create")
+			return messages.OrganizationInternalServerError
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &IssueOrganizationAPIKeyOutput{
+		APIKey: key,
+		RawKey: rawKey,
+	}, nil
+}
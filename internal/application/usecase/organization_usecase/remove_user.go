@@ -0,0 +1,84 @@
+package organization_usecase
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/session"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	organization_svc "github.com/neko-dream/api/internal/domain/service/organization"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type RemoveOrganizationUserCommand interface {
+	Execute(ctx context.Context, input RemoveOrganizationUserInput) error
+}
+
+type RemoveOrganizationUserInput struct {
+	UserID         shared.UUID[user.User]
+	OrganizationID shared.UUID[organization.Organization]
+	TargetUserID   shared.UUID[user.User]
+}
+
+type removeOrganizationUserInteractor struct {
+	memberManager        organization_svc.OrganizationMemberManager
+	sessionService       session.SessionService
+	organizationUserRepo organization.OrganizationUserRepository
+	auditLogRepository   organization.OrganizationAuditLogRepository
+	*db.DBManager
+}
+
+func NewRemoveOrganizationUserInteractor(
+	memberManager organization_svc.OrganizationMemberManager,
+	sessionService session.SessionService,
+	organizationUserRepo organization.OrganizationUserRepository,
+	auditLogRepository organization.OrganizationAuditLogRepository,
+	dbManager *db.DBManager,
+) RemoveOrganizationUserCommand {
+	return &removeOrganizationUserInteractor{
+		memberManager:        memberManager,
+		sessionService:       sessionService,
+		organizationUserRepo: organizationUserRepo,
+		auditLogRepository:   auditLogRepository,
+		DBManager:            dbManager,
+	}
+}
+
+func (i *removeOrganizationUserInteractor) Execute(ctx context.Context, input RemoveOrganizationUserInput) error {
+	ctx, span := otel.Tracer("organization_command").Start(ctx, "removeOrganizationUserInteractor.Execute")
+	defer span.End()
+
+	return i.ExecTx(ctx, func(ctx context.Context) error {
+		before, err := findOrganizationUserForAudit(ctx, i.organizationUserRepo, input.OrganizationID, input.TargetUserID)
+		if err != nil {
+			return err
+		}
+
+		if err := i.memberManager.RemoveUser(ctx, organization_svc.RemoveUserParams{
+			OrganizationID: input.OrganizationID,
+			OperatorID:     input.UserID,
+			TargetUserID:   input.TargetUserID,
+		}); err != nil {
+			return err
+		}
+
+		auditLog := organization.NewOrganizationAuditLog(input.OrganizationID, input.UserID, organization.AuditActionUserRemoved, organization.AuditTargetUser, input.TargetUserID.String(), clock.Now(ctx))
+		auditLog.RecordChange("role", before.Role, nil)
+		if err := i.auditLogRepository.Create(ctx, auditLog); err != nil {
+			utils.HandleError(ctx, err, "OrganizationAuditLogRepository.Create")
+			return messages.OrganizationInternalServerError
+		}
+
+		// 削除したユーザーが組織アカウントとしてログインしているセッションを無効化する
+		if err := i.sessionService.DeactivateOrganizationSessions(ctx, input.TargetUserID, input.OrganizationID); err != nil {
+			utils.HandleError(ctx, err, "SessionService.DeactivateOrganizationSessions")
+			return err
+		}
+		return nil
+	})
+}
@@ -0,0 +1,76 @@
+package organization_usecase
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	organization_svc "github.com/neko-dream/api/internal/domain/service/organization"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type ResendOrganizationInvitationCommand interface {
+	Execute(ctx context.Context, input ResendOrganizationInvitationInput) (*ResendOrganizationInvitationOutput, error)
+}
+
+type ResendOrganizationInvitationInput struct {
+	UserID         shared.UUID[user.User]
+	OrganizationID shared.UUID[organization.Organization]
+	InvitationID   shared.UUID[organization.OrganizationInvitation]
+}
+
+type ResendOrganizationInvitationOutput struct {
+	Invitation *organization.OrganizationInvitation
+}
+
+type resendOrganizationInvitationInteractor struct {
+	invitationService  organization_svc.OrganizationInvitationService
+	auditLogRepository organization.OrganizationAuditLogRepository
+	*db.DBManager
+}
+
+func NewResendOrganizationInvitationInteractor(
+	invitationService organization_svc.OrganizationInvitationService,
+	auditLogRepository organization.OrganizationAuditLogRepository,
+	dbManager *db.DBManager,
+) ResendOrganizationInvitationCommand {
+	return &resendOrganizationInvitationInteractor{
+		invitationService:  invitationService,
+		auditLogRepository: auditLogRepository,
+		DBManager:          dbManager,
+	}
+}
+
+func (i *resendOrganizationInvitationInteractor) Execute(ctx context.Context, input ResendOrganizationInvitationInput) (*ResendOrganizationInvitationOutput, error) {
+	ctx, span := otel.Tracer("organization_command").Start(ctx, "resendOrganizationInvitationInteractor.Execute")
+	defer span.End()
+
+	var invitation *organization.OrganizationInvitation
+	if err := i.ExecTx(ctx, func(ctx context.Context) error {
+		inv, err := i.invitationService.Resend(ctx, input.OrganizationID, input.UserID, input.InvitationID)
+		if err != nil {
+			return err
+		}
+		invitation = inv
+
+		auditLog := organization.NewOrganizationAuditLog(input.OrganizationID, input.UserID, organization.AuditActionInvitationResent, organization.AuditTargetInvitation, inv.InvitationID().String(), clock.Now(ctx))
+		auditLog.RecordChange("send_count", inv.SendCount()-1, inv.SendCount())
+		auditLog.RecordChange("expires_at", nil, inv.ExpiresAt())
+		if err := i.auditLogRepository.Create(ctx, auditLog); err != nil {
+			utils.HandleError(ctx, err, "OrganizationAuditLogRepository.Create")
+			return messages.OrganizationInternalServerError
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &ResendOrganizationInvitationOutput{
+		Invitation: invitation,
+	}, nil
+}
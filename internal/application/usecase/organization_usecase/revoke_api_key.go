@@ -0,0 +1,80 @@
+package organization_usecase
+
+import (
+	"context"
+	"errors"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type RevokeOrganizationAPIKeyCommand interface {
+	Execute(ctx context.Context, input RevokeOrganizationAPIKeyInput) error
+}
+
+type RevokeOrganizationAPIKeyInput struct {
+	UserID         shared.UUID[user.User]
+	OrganizationID shared.UUID[organization.Organization]
+	APIKeyID       shared.UUID[organization.OrganizationAPIKey]
+}
+
+type revokeOrganizationAPIKeyInteractor struct {
+	apiKeyRepository   organization.OrganizationAPIKeyRepository
+	auditLogRepository organization.OrganizationAuditLogRepository
+	*db.DBManager
+}
+
+func NewRevokeOrganizationAPIKeyInteractor(
+	apiKeyRepository organization.OrganizationAPIKeyRepository,
+	auditLogRepository organization.OrganizationAuditLogRepository,
+	dbManager *db.DBManager,
+) RevokeOrganizationAPIKeyCommand {
+	return &revokeOrganizationAPIKeyInteractor{
+		apiKeyRepository:   apiKeyRepository,
+		auditLogRepository: auditLogRepository,
+		DBManager:          dbManager,
+	}
+}
+
+func (i *revokeOrganizationAPIKeyInteractor) Execute(ctx context.Context, input RevokeOrganizationAPIKeyInput) error {
+	ctx, span := otel.Tracer("organization_command").Start(ctx, "revokeOrganizationAPIKeyInteractor.Execute")
+	defer span.End()
+
+	key, err := i.apiKeyRepository.FindByID(ctx, input.APIKeyID)
+	if err != nil {
+		utils.HandleError(ctx, err, "OrganizationAPIKeyRepository.FindByID")
+		return messages.OrganizationInternalServerError
+	}
+	// 他組織のキーは存在しないものとして扱う
+	if key == nil || key.OrganizationID() != input.OrganizationID {
+		return messages.APIKeyNotFoundError
+	}
+
+	if err := key.Revoke(input.UserID, clock.Now(ctx)); err != nil {
+		if errors.Is(err, organization.ErrAPIKeyAlreadyRevoked) {
+			return nil
+		}
+		return err
+	}
+
+	return i.ExecTx(ctx, func(ctx context.Context) error {
+		if err := i.apiKeyRepository.Revoke(ctx, key); err != nil {
+			utils.HandleError(ctx, err, "OrganizationAPIKeyRepository.Revoke")
+			return messages.OrganizationInternalServerError
+		}
+
+		auditLog := organization.NewOrganizationAuditLog(input.OrganizationID, input.UserID, organization.AuditActionAPIKeyRevoked, organization.AuditTargetAPIKey, key.APIKeyID().String(), clock.Now(ctx))
+		auditLog.RecordChange("revoked_at", nil, key.RevokedAt())
+		if err := i.auditLogRepository.Create(ctx, auditLog); err != nil {
+			utils.HandleError(ctx, err, "OrganizationAuditLogRepository.Create")
+			return messages.OrganizationInternalServerError
+		}
+		return nil
+	})
+}
@@ -0,0 +1,62 @@
+package organization_usecase
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	organization_svc "github.com/neko-dream/api/internal/domain/service/organization"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type RevokeOrganizationInvitationCommand interface {
+	Execute(ctx context.Context, input RevokeOrganizationInvitationInput) error
+}
+
+type RevokeOrganizationInvitationInput struct {
+	UserID         shared.UUID[user.User]
+	OrganizationID shared.UUID[organization.Organization]
+	InvitationID   shared.UUID[organization.OrganizationInvitation]
+}
+
+type revokeOrganizationInvitationInteractor struct {
+	invitationService  organization_svc.OrganizationInvitationService
+	auditLogRepository organization.OrganizationAuditLogRepository
+	*db.DBManager
+}
+
+func NewRevokeOrganizationInvitationInteractor(
+	invitationService organization_svc.OrganizationInvitationService,
+	auditLogRepository organization.OrganizationAuditLogRepository,
+	dbManager *db.DBManager,
+) RevokeOrganizationInvitationCommand {
+	return &revokeOrganizationInvitationInteractor{
+		invitationService:  invitationService,
+		auditLogRepository: auditLogRepository,
+		DBManager:          dbManager,
+	}
+}
+
+func (i *revokeOrganizationInvitationInteractor) Execute(ctx context.Context, input RevokeOrganizationInvitationInput) error {
+	ctx, span := otel.Tracer("organization_command").Start(ctx, "revokeOrganizationInvitationInteractor.Execute")
+	defer span.End()
+
+	return i.ExecTx(ctx, func(ctx context.Context) error {
+		if err := i.invitationService.Revoke(ctx, input.OrganizationID, input.UserID, input.InvitationID); err != nil {
+			return err
+		}
+
+		auditLog := organization.NewOrganizationAuditLog(input.OrganizationID, input.UserID, organization.AuditActionInvitationRevoked, organization.AuditTargetInvitation, input.InvitationID.String(), clock.Now(ctx))
+		auditLog.RecordChange("status", organization.InvitationStatusPending, organization.InvitationStatusRevoked)
+		if err := i.auditLogRepository.Create(ctx, auditLog); err != nil {
+			utils.HandleError(ctx, err, "OrganizationAuditLogRepository.Create")
+			return messages.OrganizationInternalServerError
+		}
+		return nil
+	})
+}
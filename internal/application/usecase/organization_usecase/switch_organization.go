@@ -59,13 +59,13 @@ func (s *switchOrganizationInteractor) Execute(
 	// UUID[any]をUUID[organization.Organization]に変換
 	orgID := shared.UUID[organization.Organization](orgIDAny.UUID())
 
-	// ユーザーがその組織に所属しているか確認
-	_, err = s.organizationUserRepo.FindByOrganizationIDAndUserID(ctx, orgID, input.UserID)
+	// ユーザーがその組織に所属しているか確認（祖先の組織からの継承を含む）
+	_, err = s.organizationUserRepo.FindEffectiveByOrganizationIDAndUserID(ctx, orgID, input.UserID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, messages.OrganizationPermissionDenied
 		}
-		utils.HandleError(ctx, err, "organizationUserRepo.FindByOrganizationIDAndUserID")
+		utils.HandleError(ctx, err, "organizationUserRepo.FindEffectiveByOrganizationIDAndUserID")
 		return nil, errtrace.Wrap(err)
 	}
 
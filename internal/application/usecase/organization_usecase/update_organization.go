@@ -4,10 +4,13 @@ import (
 	"context"
 	"mime/multipart"
 
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/clock"
 	"github.com/neko-dream/api/internal/domain/model/organization"
 	"github.com/neko-dream/api/internal/domain/model/shared"
 	"github.com/neko-dream/api/internal/domain/model/user"
 	organization_svc "github.com/neko-dream/api/internal/domain/service/organization"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
 	"github.com/neko-dream/api/pkg/utils"
 	"go.opentelemetry.io/otel"
 )
@@ -25,13 +28,22 @@ type UpdateOrganizationInput struct {
 
 type UpdateOrganizationInteractor struct {
 	organizationService organization_svc.OrganizationService
+	organizationRepo    organization.OrganizationRepository
+	auditLogRepository  organization.OrganizationAuditLogRepository
+	*db.DBManager
 }
 
 func NewUpdateOrganizationInteractor(
 	organizationService organization_svc.OrganizationService,
+	organizationRepo organization.OrganizationRepository,
+	auditLogRepository organization.OrganizationAuditLogRepository,
+	dbManager *db.DBManager,
 ) UpdateOrganizationCommand {
 	return &UpdateOrganizationInteractor{
 		organizationService: organizationService,
+		organizationRepo:    organizationRepo,
+		auditLogRepository:  auditLogRepository,
+		DBManager:           dbManager,
 	}
 }
 
@@ -39,11 +51,33 @@ func (c *UpdateOrganizationInteractor) Execute(ctx context.Context, input Update
 	ctx, span := otel.Tracer("organization_command").Start(ctx, "UpdateOrganizationInteractor.Execute")
 	defer span.End()
 
-	err := c.organizationService.UpdateOrganization(ctx, input.OrganizationID, input.Name, input.IconImage)
-	if err != nil {
-		utils.HandleError(ctx, err, "UpdateOrganization")
-		return err
-	}
+	return c.ExecTx(ctx, func(ctx context.Context) error {
+		// 監査ログに変更前の値を残すため、更新前の組織を取得しておく
+		before, err := c.organizationRepo.FindByID(ctx, input.OrganizationID)
+		if err != nil {
+			utils.HandleError(ctx, err, "OrganizationRepository.FindByID")
+			return err
+		}
+		beforeName, beforeIconURL := before.Name, before.IconURL
+
+		if err := c.organizationService.UpdateOrganization(ctx, input.OrganizationID, input.Name, input.IconImage); err != nil {
+			utils.HandleError(ctx, err, "UpdateOrganization")
+			return err
+		}
+
+		after, err := c.organizationRepo.FindByID(ctx, input.OrganizationID)
+		if err != nil {
+			utils.HandleError(ctx, err, "OrganizationRepository.FindByID")
+			return err
+		}
 
-	return nil
+		auditLog := organization.NewOrganizationAuditLog(input.OrganizationID, input.UserID, organization.AuditActionOrganizationUpdated, organization.AuditTargetOrganization, input.OrganizationID.String(), clock.Now(ctx))
+		auditLog.RecordChange("name", beforeName, after.Name)
+		auditLog.RecordChange("icon_url", beforeIconURL, after.IconURL)
+		if err := c.auditLogRepository.Create(ctx, auditLog); err != nil {
+			utils.HandleError(ctx, err, "OrganizationAuditLogRepository.Create")
+			return messages.OrganizationInternalServerError
+		}
+		return nil
+	})
 }
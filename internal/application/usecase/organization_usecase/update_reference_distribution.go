@@ -0,0 +1,170 @@
+package organization_usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+// referenceDistributionMaxFileSize 母集団の構成で受け付けるCSVの最大サイズ
+const referenceDistributionMaxFileSize = 1 << 20
+
+type UpdateReferenceDistributionCommand interface {
+	Execute(ctx context.Context, input UpdateReferenceDistributionInput) (*UpdateReferenceDistributionOutput, error)
+}
+
+// UpdateReferenceDistributionInput
+// CSVは1列目に区分、2列目に人数または割合（「12.5%」のような百分率も可）を指定する
+// 1行目の2列目が数値でない場合はヘッダーとして読み飛ばす
+type UpdateReferenceDistributionInput struct {
+	UserID         shared.UUID[user.User]
+	OrganizationID shared.UUID[organization.Organization]
+	Dimension      analysis.DemographicDimension
+	CSV            io.Reader
+}
+
+type UpdateReferenceDistributionOutput struct {
+	Distribution *analysis.ReferenceDistribution
+}
+
+type updateReferenceDistributionInteractor struct {
+	distributionRepository analysis.ReferenceDistributionRepository
+	auditLogRepository     organization.OrganizationAuditLogRepository
+	*db.DBManager
+}
+
+func NewUpdateReferenceDistributionInteractor(
+	distributionRepository analysis.ReferenceDistributionRepository,
+	auditLogRepository organization.OrganizationAuditLogRepository,
+	dbManager *db.DBManager,
+) UpdateReferenceDistributionCommand {
+	return &updateReferenceDistributionInteractor{
+		distributionRepository: distributionRepository,
+		auditLogRepository:     auditLogRepository,
+		DBManager:              dbManager,
+	}
+}
+
+func (i *updateReferenceDistributionInteractor) Execute(ctx context.Context, input UpdateReferenceDistributionInput) (*UpdateReferenceDistributionOutput, error) {
+	ctx, span := otel.Tracer("organization_command").Start(ctx, "updateReferenceDistributionInteractor.Execute")
+	defer span.End()
+
+	categories, err := parseReferenceDistributionCSV(input.CSV)
+	if err != nil {
+		return nil, err
+	}
+	distribution, err := analysis.NewReferenceDistribution(input.OrganizationID, input.Dimension, categories, input.UserID, clock.Now(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.ExecTx(ctx, func(ctx context.Context) error {
+		before, err := i.distributionRepository.FindByDimension(ctx, input.OrganizationID, input.Dimension)
+		if err != nil {
+			utils.HandleError(ctx, err, "ReferenceDistributionRepository.FindByDimension")
+			return messages.OrganizationInternalServerError
+		}
+		var beforeCount any
+		if before != nil {
+			beforeCount = len(before.Categories)
+		}
+
+		if err := i.distributionRepository.Save(ctx, distribution); err != nil {
+			utils.HandleError(ctx, err, "ReferenceDistributionRepository.Save")
+			return messages.OrganizationInternalServerError
+		}
+
+		auditLog := organization.NewOrganizationAuditLog(input.OrganizationID, input.UserID, organization.AuditActionDemographicsUpdated, organization.AuditTargetOrganization, input.OrganizationID.String(), clock.Now(ctx))
+		auditLog.RecordChange(string(input.Dimension)+"_category_count", beforeCount, len(distribution.Categories))
+		if err := i.auditLogRepository.Create(ctx, auditLog); err != nil {
+			utils.HandleError(ctx, err, "OrganizationAuditLogRepository.Create")
+			return messages.OrganizationInternalServerError
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &UpdateReferenceDistributionOutput{
+		Distribution: distribution,
+	}, nil
+}
+
+func parseReferenceDistributionCSV(r io.Reader) ([]analysis.ReferenceCategory, error) {
+	if r == nil {
+		return nil, messages.ReferenceDistributionInvalid
+	}
+	data, err := io.ReadAll(io.LimitReader(r, referenceDistributionMaxFileSize+1))
+	if err != nil || len(data) > referenceDistributionMaxFileSize {
+		return nil, messages.ReferenceDistributionInvalid
+	}
+	// Excelで保存したCSVのBOMを取り除く
+	data = bytes.TrimPrefix(data, []byte("\ufeff"))
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var categories []analysis.ReferenceCategory
+	line := 0
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, messages.ReferenceDistributionInvalid
+		}
+		line++
+
+		category := strings.TrimSpace(record[0])
+		if category == "" {
+			continue
+		}
+		if len(record) < 2 {
+			return nil, messages.ReferenceDistributionInvalid
+		}
+		share, ok := parseReferenceShare(record[1])
+		if !ok {
+			if line == 1 {
+				continue
+			}
+			return nil, messages.ReferenceDistributionInvalid
+		}
+		categories = append(categories, analysis.ReferenceCategory{Category: category, Share: share})
+		if len(categories) > analysis.ReferenceCategoryMaxCount {
+			return nil, messages.ReferenceDistributionInvalid
+		}
+	}
+	if len(categories) == 0 {
+		return nil, messages.ReferenceDistributionInvalid
+	}
+
+	return categories, nil
+}
+
+// parseReferenceShare 「1,234」のような桁区切りや「12.5%」のような百分率も数値として読む
+func parseReferenceShare(s string) (float64, bool) {
+	s = strings.TrimSpace(strings.ReplaceAll(s, ",", ""))
+	s = strings.TrimSuffix(s, "%")
+	share, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, false
+	}
+	return share, true
+}
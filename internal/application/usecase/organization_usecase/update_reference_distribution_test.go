@@ -0,0 +1,46 @@
+package organization_usecase
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseReferenceDistributionCSV(t *testing.T) {
+	t.Run("ヘッダーとBOMを読み飛ばし桁区切りと百分率を読む", func(t *testing.T) {
+		csv := "\ufeff区分,人数\n男性,\"1,200\"\n\n女性,48.5%\n"
+
+		categories, err := parseReferenceDistributionCSV(strings.NewReader(csv))
+		require.NoError(t, err)
+
+		assert.Equal(t, []analysis.ReferenceCategory{
+			{Category: "男性", Share: 1200},
+			{Category: "女性", Share: 48.5},
+		}, categories)
+	})
+
+	t.Run("2行目以降の数値でない値はエラー", func(t *testing.T) {
+		_, err := parseReferenceDistributionCSV(strings.NewReader("男性,10\n女性,多い\n"))
+		assert.ErrorIs(t, err, messages.ReferenceDistributionInvalid)
+	})
+
+	t.Run("値の列がない行はエラー", func(t *testing.T) {
+		_, err := parseReferenceDistributionCSV(strings.NewReader("男性\n"))
+		assert.ErrorIs(t, err, messages.ReferenceDistributionInvalid)
+	})
+
+	t.Run("空のCSVはエラー", func(t *testing.T) {
+		_, err := parseReferenceDistributionCSV(strings.NewReader("区分,人数\n"))
+		assert.ErrorIs(t, err, messages.ReferenceDistributionInvalid)
+	})
+
+	t.Run("区分数の上限を超えるとエラー", func(t *testing.T) {
+		csv := strings.Repeat("区分,1\n", analysis.ReferenceCategoryMaxCount+1)
+		_, err := parseReferenceDistributionCSV(strings.NewReader(csv))
+		assert.ErrorIs(t, err, messages.ReferenceDistributionInvalid)
+	})
+}
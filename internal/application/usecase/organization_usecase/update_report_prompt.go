@@ -0,0 +1,91 @@
+package organization_usecase
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type UpdateReportPromptCommand interface {
+	Execute(ctx context.Context, input UpdateReportPromptInput) (*UpdateReportPromptOutput, error)
+}
+
+type UpdateReportPromptInput struct {
+	UserID         shared.UUID[user.User]
+	OrganizationID shared.UUID[organization.Organization]
+	// SystemPrompt 空の場合は既定の指示に戻す
+	SystemPrompt string
+	Instructions string
+}
+
+type UpdateReportPromptOutput struct {
+	Prompt *analysis.ReportPrompt
+}
+
+type updateReportPromptInteractor struct {
+	promptRepository   analysis.ReportPromptRepository
+	auditLogRepository organization.OrganizationAuditLogRepository
+	*db.DBManager
+}
+
+func NewUpdateReportPromptInteractor(
+	promptRepository analysis.ReportPromptRepository,
+	auditLogRepository organization.OrganizationAuditLogRepository,
+	dbManager *db.DBManager,
+) UpdateReportPromptCommand {
+	return &updateReportPromptInteractor{
+		promptRepository:   promptRepository,
+		auditLogRepository: auditLogRepository,
+		DBManager:          dbManager,
+	}
+}
+
+func (i *updateReportPromptInteractor) Execute(ctx context.Context, input UpdateReportPromptInput) (*UpdateReportPromptOutput, error) {
+	ctx, span := otel.Tracer("organization_command").Start(ctx, "updateReportPromptInteractor.Execute")
+	defer span.End()
+
+	prompt, err := analysis.NewReportPrompt(input.OrganizationID, input.SystemPrompt, input.Instructions, input.UserID, clock.Now(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.ExecTx(ctx, func(ctx context.Context) error {
+		before, err := i.promptRepository.FindByOrganizationID(ctx, input.OrganizationID)
+		if err != nil {
+			utils.HandleError(ctx, err, "ReportPromptRepository.FindByOrganizationID")
+			return messages.OrganizationInternalServerError
+		}
+		if before == nil {
+			before = &analysis.ReportPrompt{}
+		}
+
+		if err := i.promptRepository.Save(ctx, prompt); err != nil {
+			utils.HandleError(ctx, err, "ReportPromptRepository.Save")
+			return messages.OrganizationInternalServerError
+		}
+
+		auditLog := organization.NewOrganizationAuditLog(input.OrganizationID, input.UserID, organization.AuditActionReportPromptUpdated, organization.AuditTargetOrganization, input.OrganizationID.String(), clock.Now(ctx))
+		auditLog.RecordChange("system_prompt", before.SystemPrompt, prompt.SystemPrompt)
+		auditLog.RecordChange("instructions", before.Instructions, prompt.Instructions)
+		if err := i.auditLogRepository.Create(ctx, auditLog); err != nil {
+			utils.HandleError(ctx, err, "OrganizationAuditLogRepository.Create")
+			return messages.OrganizationInternalServerError
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &UpdateReportPromptOutput{
+		Prompt: prompt,
+	}, nil
+}
@@ -0,0 +1,214 @@
+package ownership_usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/ownership"
+	"github.com/neko-dream/api/internal/domain/model/session"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/talksession/talksession_collaborator"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type AcceptTransferCommand interface {
+	Execute(ctx context.Context, input AcceptTransferInput) (*AcceptTransferOutput, error)
+}
+
+type AcceptTransferInput struct {
+	UserID     shared.UUID[user.User]
+	TransferID shared.UUID[ownership.OwnershipTransfer]
+}
+
+type AcceptTransferOutput struct {
+	Transfer *ownership.OwnershipTransfer
+}
+
+type acceptTransferInteractor struct {
+	ownershipTransferRepository ownership.OwnershipTransferRepository
+	talkSessionRepository       talksession.TalkSessionRepository
+	collaboratorRepository      talksession_collaborator.TalkSessionCollaboratorRepository
+	organizationRepository      organization.OrganizationRepository
+	organizationUserRepository  organization.OrganizationUserRepository
+	sessionService              session.SessionService
+	auditLogRepository          organization.OrganizationAuditLogRepository
+	*db.DBManager
+}
+
+func NewAcceptTransferInteractor(
+	ownershipTransferRepository ownership.OwnershipTransferRepository,
+	talkSessionRepository talksession.TalkSessionRepository,
+	collaboratorRepository talksession_collaborator.TalkSessionCollaboratorRepository,
+	organizationRepository organization.OrganizationRepository,
+	organizationUserRepository organization.OrganizationUserRepository,
+	sessionService session.SessionService,
+	auditLogRepository organization.OrganizationAuditLogRepository,
+	dbManager *db.DBManager,
+) AcceptTransferCommand {
+	return &acceptTransferInteractor{
+		ownershipTransferRepository: ownershipTransferRepository,
+		talkSessionRepository:       talkSessionRepository,
+		collaboratorRepository:      collaboratorRepository,
+		organizationRepository:      organizationRepository,
+		organizationUserRepository:  organizationUserRepository,
+		sessionService:              sessionService,
+		auditLogRepository:          auditLogRepository,
+		DBManager:                   dbManager,
+	}
+}
+
+// Execute 譲渡先のユーザーが譲渡依頼を承諾し、オーナーを変更する
+func (i *acceptTransferInteractor) Execute(ctx context.Context, input AcceptTransferInput) (*AcceptTransferOutput, error) {
+	ctx, span := otel.Tracer("ownership_command").Start(ctx, "acceptTransferInteractor.Execute")
+	defer span.End()
+
+	var transfer *ownership.OwnershipTransfer
+	if err := i.ExecTx(ctx, func(ctx context.Context) error {
+		var err error
+		transfer, err = i.ownershipTransferRepository.FindByIDForUpdate(ctx, input.TransferID)
+		if err != nil {
+			utils.HandleError(ctx, err, "OwnershipTransferRepository.FindByIDForUpdate")
+			return messages.OwnershipTransferFailed
+		}
+		if transfer == nil {
+			return messages.OwnershipTransferNotFound
+		}
+
+		switch transfer.TargetType() {
+		case ownership.TransferTargetTalkSession:
+			err = i.acceptTalkSession(ctx, transfer, input.UserID)
+		case ownership.TransferTargetOrganization:
+			err = i.acceptOrganization(ctx, transfer, input.UserID)
+		default:
+			err = messages.OwnershipTransferFailed
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := i.ownershipTransferRepository.Update(ctx, transfer); err != nil {
+			utils.HandleError(ctx, err, "OwnershipTransferRepository.Update")
+			return messages.OwnershipTransferFailed
+		}
+		return recordTransferAuditLog(ctx, i.auditLogRepository, transfer, input.UserID, organization.AuditActionOwnershipAccepted)
+	}); err != nil {
+		return nil, err
+	}
+
+	return &AcceptTransferOutput{
+		Transfer: transfer,
+	}, nil
+}
+
+// acceptTalkSession セッションのオーナーを譲渡先のユーザーに変更する
+func (i *acceptTransferInteractor) acceptTalkSession(ctx context.Context, transfer *ownership.OwnershipTransfer, userID shared.UUID[user.User]) error {
+	talkSession, err := i.talkSessionRepository.FindByID(ctx, *transfer.TalkSessionID())
+	if err != nil || talkSession == nil {
+		return messages.TalkSessionNotFound
+	}
+	if err := transfer.Accept(userID, talkSession.OwnerUserID(), clock.Now(ctx)); err != nil {
+		return transferError(err)
+	}
+
+	// 依頼後に組織を抜けている場合は承諾できない
+	if talkSession.OrganizationID() != nil {
+		member, err := findEffectiveOrganizationUser(ctx, i.organizationUserRepository, *talkSession.OrganizationID(), userID)
+		if err != nil {
+			return err
+		}
+		if member == nil {
+			return messages.OwnershipTransferRecipientNotMember
+		}
+	}
+
+	talkSession.ChangeOwner(transfer.ToUserID())
+	if err := i.talkSessionRepository.Update(ctx, talkSession); err != nil {
+		utils.HandleError(ctx, err, "TalkSessionRepository.Update")
+		return messages.OwnershipTransferFailed
+	}
+	// オーナーは共同管理者を兼ねられないため、共同管理者だった場合は外す
+	if err := i.collaboratorRepository.Delete(ctx, talkSession.TalkSessionID(), transfer.ToUserID()); err != nil {
+		utils.HandleError(ctx, err, "TalkSessionCollaboratorRepository.Delete")
+		return messages.OwnershipTransferFailed
+	}
+	return nil
+}
+
+// acceptOrganization 組織のオーナーを変更する
+// 譲渡先のユーザーはオーナーロールに、元のオーナーは管理者ロールに変更する
+func (i *acceptTransferInteractor) acceptOrganization(ctx context.Context, transfer *ownership.OwnershipTransfer, userID shared.UUID[user.User]) error {
+	org, err := i.organizationRepository.FindByID(ctx, *transfer.OrganizationID())
+	if err != nil || org == nil {
+		return messages.OrganizationNotFound
+	}
+	if err := transfer.Accept(userID, org.OwnerID, clock.Now(ctx)); err != nil {
+		return transferError(err)
+	}
+
+	recipient, err := i.findOrganizationUser(ctx, org.OrganizationID, transfer.ToUserID())
+	if err != nil {
+		return err
+	}
+	if recipient == nil {
+		return messages.OwnershipTransferRecipientNotMember
+	}
+	previousOwner, err := i.findOrganizationUser(ctx, org.OrganizationID, transfer.FromUserID())
+	if err != nil {
+		return err
+	}
+
+	org.ChangeOwner(transfer.ToUserID())
+	if err := i.organizationRepository.Update(ctx, org); err != nil {
+		utils.HandleError(ctx, err, "OrganizationRepository.Update")
+		return messages.OwnershipTransferFailed
+	}
+
+	if recipient.Role > organization.OrganizationUserRoleOwner {
+		if err := i.changeRole(ctx, recipient, organization.OrganizationUserRoleOwner); err != nil {
+			return err
+		}
+	}
+	if previousOwner != nil && previousOwner.Role == organization.OrganizationUserRoleOwner {
+		if err := i.changeRole(ctx, previousOwner, organization.OrganizationUserRoleAdmin); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// findOrganizationUser 組織に直接所属するユーザーを取得する。所属していない場合はnilを返す
+func (i *acceptTransferInteractor) findOrganizationUser(ctx context.Context, organizationID shared.UUID[organization.Organization], userID shared.UUID[user.User]) (*organization.OrganizationUser, error) {
+	orgUser, err := i.organizationUserRepository.FindByOrganizationIDAndUserID(ctx, organizationID, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		utils.HandleError(ctx, err, "OrganizationUserRepository.FindByOrganizationIDAndUserID")
+		return nil, messages.OwnershipTransferFailed
+	}
+	return orgUser, nil
+}
+
+// changeRole ロールを変更し、ロールを含むトークンを持つ組織セッションを無効化する
+func (i *acceptTransferInteractor) changeRole(ctx context.Context, orgUser *organization.OrganizationUser, role organization.OrganizationUserRole) error {
+	if err := orgUser.SetRole(role); err != nil {
+		return messages.OwnershipTransferFailed
+	}
+	if err := i.organizationUserRepository.Update(ctx, *orgUser); err != nil {
+		utils.HandleError(ctx, err, "OrganizationUserRepository.Update")
+		return messages.OwnershipTransferFailed
+	}
+	if err := i.sessionService.DeactivateOrganizationSessions(ctx, orgUser.UserID, orgUser.OrganizationID); err != nil {
+		utils.HandleError(ctx, err, "SessionService.DeactivateOrganizationSessions")
+		return messages.OwnershipTransferFailed
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package ownership_usecase
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/ownership"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type BulkTransferTalkSessionsCommand interface {
+	Execute(ctx context.Context, input BulkTransferTalkSessionsInput) (*BulkTransferTalkSessionsOutput, error)
+}
+
+type BulkTransferTalkSessionsInput struct {
+	UserID             shared.UUID[user.User]
+	OrganizationID     shared.UUID[organization.Organization]
+	FromDisplayID      string // 退職するメンバーのDisplayID
+	RecipientDisplayID string
+}
+
+type BulkTransferTalkSessionsOutput struct {
+	Transfers []*ownership.OwnershipTransfer
+	// SkippedCount 既に未承諾の譲渡依頼があったため依頼しなかったセッションの数
+	SkippedCount int
+}
+
+type bulkTransferTalkSessionsInteractor struct {
+	talkSessionRepository       talksession.TalkSessionRepository
+	userRepository              user.UserRepository
+	organizationUserRepository  organization.OrganizationUserRepository
+	ownershipTransferRepository ownership.OwnershipTransferRepository
+	auditLogRepository          organization.OrganizationAuditLogRepository
+	*db.DBManager
+}
+
+func NewBulkTransferTalkSessionsInteractor(
+	talkSessionRepository talksession.TalkSessionRepository,
+	userRepository user.UserRepository,
+	organizationUserRepository organization.OrganizationUserRepository,
+	ownershipTransferRepository ownership.OwnershipTransferRepository,
+	auditLogRepository organization.OrganizationAuditLogRepository,
+	dbManager *db.DBManager,
+) BulkTransferTalkSessionsCommand {
+	return &bulkTransferTalkSessionsInteractor{
+		talkSessionRepository:       talkSessionRepository,
+		userRepository:              userRepository,
+		organizationUserRepository:  organizationUserRepository,
+		ownershipTransferRepository: ownershipTransferRepository,
+		auditLogRepository:          auditLogRepository,
+		DBManager:                   dbManager,
+	}
+}
+
+// Execute 組織内で指定したメンバーがオーナーのセッションを、まとめて別のメンバーに譲渡依頼する
+// 組織のオーナー以上のみ実行できる。譲渡先のユーザーはセッションごとに承諾する
+func (i *bulkTransferTalkSessionsInteractor) Execute(ctx context.Context, input BulkTransferTalkSessionsInput) (*BulkTransferTalkSessionsOutput, error) {
+	ctx, span := otel.Tracer("ownership_command").Start(ctx, "bulkTransferTalkSessionsInteractor.Execute")
+	defer span.End()
+
+	ok, err := hasOrganizationRole(ctx, i.organizationUserRepository, input.OrganizationID, input.UserID, organization.OrganizationUserRoleOwner)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, messages.ForbiddenError
+	}
+
+	from, err := i.userRepository.FindByDisplayID(ctx, input.FromDisplayID)
+	if err != nil || from == nil {
+		return nil, messages.UserNotFoundError
+	}
+	recipient, err := findRecipient(ctx, i.userRepository, input.RecipientDisplayID)
+	if err != nil {
+		return nil, err
+	}
+	if from.UserID() == recipient.UserID() {
+		return nil, messages.OwnershipTransferToSelf
+	}
+	member, err := findEffectiveOrganizationUser(ctx, i.organizationUserRepository, input.OrganizationID, recipient.UserID())
+	if err != nil {
+		return nil, err
+	}
+	if member == nil {
+		return nil, messages.OwnershipTransferRecipientNotMember
+	}
+
+	talkSessions, err := i.talkSessionRepository.FindByOrganizationIDAndOwnerID(ctx, input.OrganizationID, from.UserID())
+	if err != nil {
+		utils.HandleError(ctx, err, "TalkSessionRepository.FindByOrganizationIDAndOwnerID")
+		return nil, messages.OwnershipTransferFailed
+	}
+
+	output := &BulkTransferTalkSessionsOutput{
+		Transfers: make([]*ownership.OwnershipTransfer, 0, len(talkSessions)),
+	}
+	if err := i.ExecTx(ctx, func(ctx context.Context) error {
+		for _, talkSession := range talkSessions {
+			pending, err := i.ownershipTransferRepository.FindPendingByTalkSessionID(ctx, talkSession.TalkSessionID())
+			if err != nil {
+				utils.HandleError(ctx, err, "OwnershipTransferRepository.FindPendingByTalkSessionID")
+				return messages.OwnershipTransferFailed
+			}
+			if pending != nil {
+				output.SkippedCount++
+				continue
+			}
+
+			transfer, err := ownership.NewTalkSessionOwnershipTransfer(talkSession, recipient.UserID(), input.UserID, clock.Now(ctx))
+			if err != nil {
+				return transferError(err)
+			}
+			if err := i.ownershipTransferRepository.Create(ctx, transfer); err != nil {
+				utils.HandleError(ctx, err, "OwnershipTransferRepository.Create")
+				return messages.OwnershipTransferFailed
+			}
+			if err := recordTransferAuditLog(ctx, i.auditLogRepository, transfer, input.UserID, organization.AuditActionOwnershipRequested); err != nil {
+				return err
+			}
+			output.Transfers = append(output.Transfers, transfer)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
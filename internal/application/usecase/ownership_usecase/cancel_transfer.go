@@ -0,0 +1,83 @@
+package ownership_usecase
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/ownership"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type CancelTransferCommand interface {
+	Execute(ctx context.Context, input CancelTransferInput) (*CancelTransferOutput, error)
+}
+
+type CancelTransferInput struct {
+	UserID     shared.UUID[user.User]
+	TransferID shared.UUID[ownership.OwnershipTransfer]
+}
+
+type CancelTransferOutput struct {
+	Transfer *ownership.OwnershipTransfer
+}
+
+type cancelTransferInteractor struct {
+	ownershipTransferRepository ownership.OwnershipTransferRepository
+	auditLogRepository          organization.OrganizationAuditLogRepository
+	*db.DBManager
+}
+
+func NewCancelTransferInteractor(
+	ownershipTransferRepository ownership.OwnershipTransferRepository,
+	auditLogRepository organization.OrganizationAuditLogRepository,
+	dbManager *db.DBManager,
+) CancelTransferCommand {
+	return &cancelTransferInteractor{
+		ownershipTransferRepository: ownershipTransferRepository,
+		auditLogRepository:          auditLogRepository,
+		DBManager:                   dbManager,
+	}
+}
+
+// Execute 依頼者か譲渡元のユーザーが譲渡依頼を取り消す
+func (i *cancelTransferInteractor) Execute(ctx context.Context, input CancelTransferInput) (*CancelTransferOutput, error) {
+	ctx, span := otel.Tracer("ownership_command").Start(ctx, "cancelTransferInteractor.Execute")
+	defer span.End()
+
+	var transfer *ownership.OwnershipTransfer
+	if err := i.ExecTx(ctx, func(ctx context.Context) error {
+		var err error
+		transfer, err = i.ownershipTransferRepository.FindByIDForUpdate(ctx, input.TransferID)
+		if err != nil {
+			utils.HandleError(ctx, err, "OwnershipTransferRepository.FindByIDForUpdate")
+			return messages.OwnershipTransferFailed
+		}
+		if transfer == nil {
+			return messages.OwnershipTransferNotFound
+		}
+
+		if !transfer.CanCancel(input.UserID) {
+			return messages.ForbiddenError
+		}
+		if err := transfer.Cancel(clock.Now(ctx)); err != nil {
+			return transferError(err)
+		}
+		if err := i.ownershipTransferRepository.Update(ctx, transfer); err != nil {
+			utils.HandleError(ctx, err, "OwnershipTransferRepository.Update")
+			return messages.OwnershipTransferFailed
+		}
+		return recordTransferAuditLog(ctx, i.auditLogRepository, transfer, input.UserID, organization.AuditActionOwnershipCancelled)
+	}); err != nil {
+		return nil, err
+	}
+
+	return &CancelTransferOutput{
+		Transfer: transfer,
+	}, nil
+}
@@ -0,0 +1,80 @@
+package ownership_usecase
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/ownership"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type DeclineTransferCommand interface {
+	Execute(ctx context.Context, input DeclineTransferInput) (*DeclineTransferOutput, error)
+}
+
+type DeclineTransferInput struct {
+	UserID     shared.UUID[user.User]
+	TransferID shared.UUID[ownership.OwnershipTransfer]
+}
+
+type DeclineTransferOutput struct {
+	Transfer *ownership.OwnershipTransfer
+}
+
+type declineTransferInteractor struct {
+	ownershipTransferRepository ownership.OwnershipTransferRepository
+	auditLogRepository          organization.OrganizationAuditLogRepository
+	*db.DBManager
+}
+
+func NewDeclineTransferInteractor(
+	ownershipTransferRepository ownership.OwnershipTransferRepository,
+	auditLogRepository organization.OrganizationAuditLogRepository,
+	dbManager *db.DBManager,
+) DeclineTransferCommand {
+	return &declineTransferInteractor{
+		ownershipTransferRepository: ownershipTransferRepository,
+		auditLogRepository:          auditLogRepository,
+		DBManager:                   dbManager,
+	}
+}
+
+// Execute 譲渡先のユーザーが譲渡依頼を辞退する
+func (i *declineTransferInteractor) Execute(ctx context.Context, input DeclineTransferInput) (*DeclineTransferOutput, error) {
+	ctx, span := otel.Tracer("ownership_command").Start(ctx, "declineTransferInteractor.Execute")
+	defer span.End()
+
+	var transfer *ownership.OwnershipTransfer
+	if err := i.ExecTx(ctx, func(ctx context.Context) error {
+		var err error
+		transfer, err = i.ownershipTransferRepository.FindByIDForUpdate(ctx, input.TransferID)
+		if err != nil {
+			utils.HandleError(ctx, err, "OwnershipTransferRepository.FindByIDForUpdate")
+			return messages.OwnershipTransferFailed
+		}
+		if transfer == nil {
+			return messages.OwnershipTransferNotFound
+		}
+
+		if err := transfer.Decline(input.UserID, clock.Now(ctx)); err != nil {
+			return transferError(err)
+		}
+		if err := i.ownershipTransferRepository.Update(ctx, transfer); err != nil {
+			utils.HandleError(ctx, err, "OwnershipTransferRepository.Update")
+			return messages.OwnershipTransferFailed
+		}
+		return recordTransferAuditLog(ctx, i.auditLogRepository, transfer, input.UserID, organization.AuditActionOwnershipDeclined)
+	}); err != nil {
+		return nil, err
+	}
+
+	return &DeclineTransferOutput{
+		Transfer: transfer,
+	}, nil
+}
@@ -0,0 +1,102 @@
+package ownership_usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/ownership"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/pkg/utils"
+)
+
+// transferError ドメインのエラーをAPIのエラーに変換する
+func transferError(err error) error {
+	switch {
+	case errors.Is(err, ownership.ErrTransferToSelf):
+		return messages.OwnershipTransferToSelf
+	case errors.Is(err, ownership.ErrTransferNotPending):
+		return messages.OwnershipTransferNotPending
+	case errors.Is(err, ownership.ErrTransferExpired):
+		return messages.OwnershipTransferExpired
+	case errors.Is(err, ownership.ErrNotTransferRecipient):
+		return messages.OwnershipTransferNotRecipient
+	case errors.Is(err, ownership.ErrTransferOutdated):
+		return messages.OwnershipTransferOutdated
+	default:
+		return messages.OwnershipTransferFailed
+	}
+}
+
+// findEffectiveOrganizationUser 祖先の組織からの継承を含めた所属を取得する。所属していない場合はnilを返す
+func findEffectiveOrganizationUser(
+	ctx context.Context,
+	organizationUserRepository organization.OrganizationUserRepository,
+	organizationID shared.UUID[organization.Organization],
+	userID shared.UUID[user.User],
+) (*organization.OrganizationUser, error) {
+	orgUser, err := organizationUserRepository.FindEffectiveByOrganizationIDAndUserID(ctx, organizationID, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		utils.HandleError(ctx, err, "OrganizationUserRepository.FindEffectiveByOrganizationIDAndUserID")
+		return nil, messages.OwnershipTransferFailed
+	}
+	return orgUser, nil
+}
+
+// hasOrganizationRole 組織で指定したロール以上の権限を持つか
+func hasOrganizationRole(
+	ctx context.Context,
+	organizationUserRepository organization.OrganizationUserRepository,
+	organizationID shared.UUID[organization.Organization],
+	userID shared.UUID[user.User],
+	minRole organization.OrganizationUserRole,
+) (bool, error) {
+	orgUser, err := findEffectiveOrganizationUser(ctx, organizationUserRepository, organizationID, userID)
+	if err != nil {
+		return false, err
+	}
+	return orgUser != nil && orgUser.Role <= minRole, nil
+}
+
+// findRecipient 譲渡先のユーザーを取得する
+func findRecipient(ctx context.Context, userRepository user.UserRepository, displayID string) (*user.User, error) {
+	recipient, err := userRepository.FindByDisplayID(ctx, displayID)
+	if err != nil || recipient == nil {
+		return nil, messages.UserNotFoundError
+	}
+	return recipient, nil
+}
+
+// recordTransferAuditLog 組織に関係する譲渡依頼の操作を監査ログに記録する
+// 組織に所属しないセッションの譲渡は、譲渡依頼そのものが履歴として残る
+func recordTransferAuditLog(
+	ctx context.Context,
+	auditLogRepository organization.OrganizationAuditLogRepository,
+	transfer *ownership.OwnershipTransfer,
+	actorID shared.UUID[user.User],
+	action organization.AuditAction,
+) error {
+	if transfer.OrganizationID() == nil {
+		return nil
+	}
+
+	auditLog := organization.NewOrganizationAuditLog(*transfer.OrganizationID(), actorID, action, organization.AuditTargetTransfer, transfer.TransferID().String(), clock.Now(ctx))
+	auditLog.RecordChange("target_type", nil, transfer.TargetType())
+	if transfer.TalkSessionID() != nil {
+		auditLog.RecordChange("talk_session_id", nil, transfer.TalkSessionID().String())
+	}
+	auditLog.RecordChange("from_user_id", nil, transfer.FromUserID().String())
+	auditLog.RecordChange("to_user_id", nil, transfer.ToUserID().String())
+	if err := auditLogRepository.Create(ctx, auditLog); err != nil {
+		utils.HandleError(ctx, err, "OrganizationAuditLogRepository.Create")
+		return messages.OwnershipTransferFailed
+	}
+	return nil
+}
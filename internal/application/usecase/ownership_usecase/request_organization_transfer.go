@@ -0,0 +1,119 @@
+package ownership_usecase
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/ownership"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type RequestOrganizationTransferCommand interface {
+	Execute(ctx context.Context, input RequestOrganizationTransferInput) (*RequestOrganizationTransferOutput, error)
+}
+
+type RequestOrganizationTransferInput struct {
+	UserID             shared.UUID[user.User]
+	OrganizationID     shared.UUID[organization.Organization]
+	RecipientDisplayID string
+}
+
+type RequestOrganizationTransferOutput struct {
+	Transfer *ownership.OwnershipTransfer
+}
+
+type requestOrganizationTransferInteractor struct {
+	organizationRepository      organization.OrganizationRepository
+	organizationUserRepository  organization.OrganizationUserRepository
+	userRepository              user.UserRepository
+	ownershipTransferRepository ownership.OwnershipTransferRepository
+	auditLogRepository          organization.OrganizationAuditLogRepository
+	*db.DBManager
+}
+
+func NewRequestOrganizationTransferInteractor(
+	organizationRepository organization.OrganizationRepository,
+	organizationUserRepository organization.OrganizationUserRepository,
+	userRepository user.UserRepository,
+	ownershipTransferRepository ownership.OwnershipTransferRepository,
+	auditLogRepository organization.OrganizationAuditLogRepository,
+	dbManager *db.DBManager,
+) RequestOrganizationTransferCommand {
+	return &requestOrganizationTransferInteractor{
+		organizationRepository:      organizationRepository,
+		organizationUserRepository:  organizationUserRepository,
+		userRepository:              userRepository,
+		ownershipTransferRepository: ownershipTransferRepository,
+		auditLogRepository:          auditLogRepository,
+		DBManager:                   dbManager,
+	}
+}
+
+// Execute 組織のオーナー権限の譲渡を依頼する
+// 現在のオーナーか運営のみ依頼でき、組織に直接所属するユーザーにのみ譲渡できる
+func (i *requestOrganizationTransferInteractor) Execute(ctx context.Context, input RequestOrganizationTransferInput) (*RequestOrganizationTransferOutput, error) {
+	ctx, span := otel.Tracer("ownership_command").Start(ctx, "requestOrganizationTransferInteractor.Execute")
+	defer span.End()
+
+	org, err := i.organizationRepository.FindByID(ctx, input.OrganizationID)
+	if err != nil || org == nil {
+		return nil, messages.OrganizationNotFound
+	}
+
+	if org.OwnerID != input.UserID {
+		ok, err := hasOrganizationRole(ctx, i.organizationUserRepository, input.OrganizationID, input.UserID, organization.OrganizationUserRoleSuperAdmin)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, messages.ForbiddenError
+		}
+	}
+
+	recipient, err := findRecipient(ctx, i.userRepository, input.RecipientDisplayID)
+	if err != nil {
+		return nil, err
+	}
+	member, err := findEffectiveOrganizationUser(ctx, i.organizationUserRepository, input.OrganizationID, recipient.UserID())
+	if err != nil {
+		return nil, err
+	}
+	if member == nil || member.IsInherited() {
+		return nil, messages.OwnershipTransferRecipientNotMember
+	}
+
+	var transfer *ownership.OwnershipTransfer
+	if err := i.ExecTx(ctx, func(ctx context.Context) error {
+		pending, err := i.ownershipTransferRepository.FindPendingByOrganizationID(ctx, input.OrganizationID)
+		if err != nil {
+			utils.HandleError(ctx, err, "OwnershipTransferRepository.FindPendingByOrganizationID")
+			return messages.OwnershipTransferFailed
+		}
+		if pending != nil {
+			return messages.OwnershipTransferAlreadyPending
+		}
+
+		transfer, err = ownership.NewOrganizationOwnershipTransfer(org, recipient.UserID(), input.UserID, clock.Now(ctx))
+		if err != nil {
+			return transferError(err)
+		}
+		if err := i.ownershipTransferRepository.Create(ctx, transfer); err != nil {
+			utils.HandleError(ctx, err, "OwnershipTransferRepository.Create")
+			return messages.OwnershipTransferFailed
+		}
+
+		return recordTransferAuditLog(ctx, i.auditLogRepository, transfer, input.UserID, organization.AuditActionOwnershipRequested)
+	}); err != nil {
+		return nil, err
+	}
+
+	return &RequestOrganizationTransferOutput{
+		Transfer: transfer,
+	}, nil
+}
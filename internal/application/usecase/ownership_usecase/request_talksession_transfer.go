@@ -0,0 +1,125 @@
+package ownership_usecase
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/ownership"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type RequestTalkSessionTransferCommand interface {
+	Execute(ctx context.Context, input RequestTalkSessionTransferInput) (*RequestTalkSessionTransferOutput, error)
+}
+
+type RequestTalkSessionTransferInput struct {
+	UserID             shared.UUID[user.User]
+	TalkSessionID      shared.UUID[talksession.TalkSession]
+	RecipientDisplayID string
+}
+
+type RequestTalkSessionTransferOutput struct {
+	Transfer *ownership.OwnershipTransfer
+}
+
+type requestTalkSessionTransferInteractor struct {
+	talkSessionRepository       talksession.TalkSessionRepository
+	userRepository              user.UserRepository
+	organizationUserRepository  organization.OrganizationUserRepository
+	ownershipTransferRepository ownership.OwnershipTransferRepository
+	auditLogRepository          organization.OrganizationAuditLogRepository
+	*db.DBManager
+}
+
+func NewRequestTalkSessionTransferInteractor(
+	talkSessionRepository talksession.TalkSessionRepository,
+	userRepository user.UserRepository,
+	organizationUserRepository organization.OrganizationUserRepository,
+	ownershipTransferRepository ownership.OwnershipTransferRepository,
+	auditLogRepository organization.OrganizationAuditLogRepository,
+	dbManager *db.DBManager,
+) RequestTalkSessionTransferCommand {
+	return &requestTalkSessionTransferInteractor{
+		talkSessionRepository:       talkSessionRepository,
+		userRepository:              userRepository,
+		organizationUserRepository:  organizationUserRepository,
+		ownershipTransferRepository: ownershipTransferRepository,
+		auditLogRepository:          auditLogRepository,
+		DBManager:                   dbManager,
+	}
+}
+
+// Execute セッションのオーナー権限の譲渡を依頼する
+// 現在のオーナーか、セッションが所属する組織の管理者が依頼できる。組織のセッションは組織に所属するユーザーにのみ譲渡できる
+func (i *requestTalkSessionTransferInteractor) Execute(ctx context.Context, input RequestTalkSessionTransferInput) (*RequestTalkSessionTransferOutput, error) {
+	ctx, span := otel.Tracer("ownership_command").Start(ctx, "requestTalkSessionTransferInteractor.Execute")
+	defer span.End()
+
+	talkSession, err := i.talkSessionRepository.FindByID(ctx, input.TalkSessionID)
+	if err != nil || talkSession == nil {
+		return nil, messages.TalkSessionNotFound
+	}
+
+	if talkSession.OwnerUserID() != input.UserID {
+		if talkSession.OrganizationID() == nil {
+			return nil, messages.ForbiddenError
+		}
+		ok, err := hasOrganizationRole(ctx, i.organizationUserRepository, *talkSession.OrganizationID(), input.UserID, organization.OrganizationUserRoleAdmin)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, messages.ForbiddenError
+		}
+	}
+
+	recipient, err := findRecipient(ctx, i.userRepository, input.RecipientDisplayID)
+	if err != nil {
+		return nil, err
+	}
+	if talkSession.OrganizationID() != nil {
+		member, err := findEffectiveOrganizationUser(ctx, i.organizationUserRepository, *talkSession.OrganizationID(), recipient.UserID())
+		if err != nil {
+			return nil, err
+		}
+		if member == nil {
+			return nil, messages.OwnershipTransferRecipientNotMember
+		}
+	}
+
+	var transfer *ownership.OwnershipTransfer
+	if err := i.ExecTx(ctx, func(ctx context.Context) error {
+		pending, err := i.ownershipTransferRepository.FindPendingByTalkSessionID(ctx, input.TalkSessionID)
+		if err != nil {
+			utils.HandleError(ctx, err, "OwnershipTransferRepository.FindPendingByTalkSessionID")
+			return messages.OwnershipTransferFailed
+		}
+		if pending != nil {
+			return messages.OwnershipTransferAlreadyPending
+		}
+
+		transfer, err = ownership.NewTalkSessionOwnershipTransfer(talkSession, recipient.UserID(), input.UserID, clock.Now(ctx))
+		if err != nil {
+			return transferError(err)
+		}
+		if err := i.ownershipTransferRepository.Create(ctx, transfer); err != nil {
+			utils.HandleError(ctx, err, "OwnershipTransferRepository.Create")
+			return messages.OwnershipTransferFailed
+		}
+
+		return recordTransferAuditLog(ctx, i.auditLogRepository, transfer, input.UserID, organization.AuditActionOwnershipRequested)
+	}); err != nil {
+		return nil, err
+	}
+
+	return &RequestTalkSessionTransferOutput{
+		Transfer: transfer,
+	}, nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/neko-dream/api/internal/domain/model/opinion"
 	"github.com/neko-dream/api/internal/domain/model/shared"
 	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/talksession/talksession_collaborator"
 	"github.com/neko-dream/api/internal/domain/model/user"
 	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
 	"github.com/neko-dream/api/pkg/utils"
@@ -24,9 +25,10 @@ type SolveReportInput struct {
 }
 
 type solveReportCommandInteractor struct {
-	reportRep      opinion.ReportRepository
-	opinionRep     opinion.OpinionRepository
-	talkSessionRep talksession.TalkSessionRepository
+	reportRep         opinion.ReportRepository
+	opinionRep        opinion.OpinionRepository
+	talkSessionRep    talksession.TalkSessionRepository
+	permissionService talksession_collaborator.TalkSessionPermissionService
 	*db.DBManager
 }
 
@@ -34,13 +36,15 @@ func NewSolveReportCommandInteractor(
 	reportRepository opinion.ReportRepository,
 	opinionRepository opinion.OpinionRepository,
 	talkSessionRepository talksession.TalkSessionRepository,
+	permissionService talksession_collaborator.TalkSessionPermissionService,
 	dbManager *db.DBManager,
 ) SolveReportCommand {
 	return &solveReportCommandInteractor{
-		reportRep:      reportRepository,
-		opinionRep:     opinionRepository,
-		talkSessionRep: talkSessionRepository,
-		DBManager:      dbManager,
+		reportRep:         reportRepository,
+		opinionRep:        opinionRepository,
+		talkSessionRep:    talkSessionRepository,
+		permissionService: permissionService,
+		DBManager:         dbManager,
 	}
 }
 
@@ -61,8 +65,12 @@ func (s *solveReportCommandInteractor) Execute(ctx context.Context, input SolveR
 		return err
 	}
 
-	// talkSessionのオーナーと一致するか確認
-	if talkSession.OwnerUserID() != input.UserID {
+	// talkSessionのオーナーか、通報に対応できる共同管理者か確認
+	canModerate, err := s.permissionService.HasPermission(ctx, talkSession, input.UserID, talksession_collaborator.PermissionModerate)
+	if err != nil {
+		return err
+	}
+	if !canModerate {
 		return messages.TalkSessionNotFound
 	}
 
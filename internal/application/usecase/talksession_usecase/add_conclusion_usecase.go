@@ -7,6 +7,7 @@ import (
 	"github.com/neko-dream/api/internal/domain/model/conclusion"
 	"github.com/neko-dream/api/internal/domain/model/shared"
 	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/talksession/talksession_collaborator"
 	"github.com/neko-dream/api/internal/domain/model/user"
 	"go.opentelemetry.io/otel"
 )
@@ -25,16 +26,19 @@ type (
 	addConclusionCommandHandler struct {
 		talksession.TalkSessionRepository
 		conclusion.ConclusionRepository
+		talksession_collaborator.TalkSessionPermissionService
 	}
 )
 
 func NewAddConclusionCommandHandler(
 	TalkSessionRepo talksession.TalkSessionRepository,
 	concRepo conclusion.ConclusionRepository,
+	permissionService talksession_collaborator.TalkSessionPermissionService,
 ) AddConclusionCommand {
 	return &addConclusionCommandHandler{
-		TalkSessionRepository: TalkSessionRepo,
-		ConclusionRepository:  concRepo,
+		TalkSessionRepository:        TalkSessionRepo,
+		ConclusionRepository:         concRepo,
+		TalkSessionPermissionService: permissionService,
 	}
 }
 
@@ -48,8 +52,12 @@ func (i *addConclusionCommandHandler) Execute(ctx context.Context, input AddConc
 		return err
 	}
 
-	// オーナーでなければ結論を作成できない
-	if res.OwnerUserID().UUID() != input.UserID.UUID() {
+	// オーナーか、結論を投稿できる共同管理者でなければ結論を作成できない
+	canConclude, err := i.TalkSessionPermissionService.HasPermission(ctx, res, input.UserID, talksession_collaborator.PermissionConclude)
+	if err != nil {
+		return err
+	}
+	if !canConclude {
 		return messages.TalkSessionNotOwner
 	}
 
@@ -0,0 +1,187 @@
+package talksession_usecase
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	"braces.dev/errtrace"
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/opinion"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/talksession/talksession_template"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type (
+	CloneTalkSessionUseCase interface {
+		Execute(context.Context, CloneTalkSessionInput) (*CloneTalkSessionOutput, error)
+	}
+
+	// CloneTalkSessionInput セッションまたはテンプレートから下書きを作成するための入力データ
+	// SourceTalkSessionIDとTemplateIDのどちらか一方を指定する
+	CloneTalkSessionInput struct {
+		UserID              shared.UUID[user.User]                                 // 操作するユーザーのID。作成した下書きのオーナーになる
+		SourceTalkSessionID *shared.UUID[talksession.TalkSession]                  // 複製元のセッションのID
+		TemplateID          *shared.UUID[talksession_template.TalkSessionTemplate] // 複製元のテンプレートのID
+		OrganizationID      *shared.UUID[organization.Organization]                // テンプレートが属する組織のID
+		Theme               *string                                                // 未指定の場合は複製元のテーマを使う
+		ScheduledEndTime    time.Time                                              // 予定終了時刻
+	}
+
+	CloneTalkSessionOutput struct {
+		TalkSessionID shared.UUID[talksession.TalkSession]
+	}
+
+	cloneTalkSessionHandler struct {
+		talksession.TalkSessionRepository
+		opinion.OpinionRepository
+		talksession_template.TalkSessionTemplateRepository
+		organization.OrganizationUserRepository
+		organization.OrganizationAuditLogRepository
+		*db.DBManager
+	}
+)
+
+func (in *CloneTalkSessionInput) Validate() error {
+	if (in.SourceTalkSessionID == nil) == (in.TemplateID == nil) {
+		return messages.BadRequestError
+	}
+	if in.Theme != nil && utf8.RuneCountInString(*in.Theme) > 100 {
+		return messages.TalkSessionThemeTooLong
+	}
+	return nil
+}
+
+func NewCloneTalkSessionUseCase(
+	talkSessionRepository talksession.TalkSessionRepository,
+	opinionRepository opinion.OpinionRepository,
+	templateRepository talksession_template.TalkSessionTemplateRepository,
+	organizationUserRepository organization.OrganizationUserRepository,
+	auditLogRepository organization.OrganizationAuditLogRepository,
+	DBManager *db.DBManager,
+) CloneTalkSessionUseCase {
+	return &cloneTalkSessionHandler{
+		TalkSessionRepository:          talkSessionRepository,
+		OpinionRepository:              opinionRepository,
+		TalkSessionTemplateRepository:  templateRepository,
+		OrganizationUserRepository:     organizationUserRepository,
+		OrganizationAuditLogRepository: auditLogRepository,
+		DBManager:                      DBManager,
+	}
+}
+
+// Execute セッションまたはテンプレートを複製して下書きのセッションを作成する
+// 参加制限・シード意見・サムネイルは引き継ぎ、投票や参加者の情報は引き継がない
+func (i *cloneTalkSessionHandler) Execute(ctx context.Context, input CloneTalkSessionInput) (*CloneTalkSessionOutput, error) {
+	ctx, span := otel.Tracer("talksession_command").Start(ctx, "cloneTalkSessionHandler.Execute")
+	defer span.End()
+
+	if err := input.Validate(); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	if input.ScheduledEndTime.Before(clock.Now(ctx)) {
+		return nil, messages.InvalidScheduledEndTime
+	}
+
+	var output CloneTalkSessionOutput
+	if err := i.DBManager.ExecTx(ctx, func(ctx context.Context) error {
+		talkSessionID := shared.NewUUID[talksession.TalkSession]()
+		now := clock.Now(ctx)
+
+		var (
+			draft        *talksession.TalkSession
+			seedOpinions []talksession_template.SeedOpinion
+			sourceKey    string
+			sourceID     string
+		)
+		if input.SourceTalkSessionID != nil {
+			src, err := i.TalkSessionRepository.FindByID(ctx, *input.SourceTalkSessionID)
+			if err != nil {
+				utils.HandleError(ctx, err, "TalkSessionRepository.FindByID")
+				return messages.TalkSessionNotFound
+			}
+			if err := requireTalkSessionManager(ctx, i.OrganizationUserRepository, src, input.UserID); err != nil {
+				return err
+			}
+			seeds, err := i.OpinionRepository.FindSeedsByTalkSessionID(ctx, src.TalkSessionID())
+			if err != nil {
+				utils.HandleError(ctx, err, "OpinionRepository.FindSeedsByTalkSessionID")
+				return messages.TalkSessionCloneFailed
+			}
+
+			theme := src.Theme()
+			if input.Theme != nil {
+				theme = *input.Theme
+			}
+			draft = src.CloneAsDraft(talkSessionID, input.UserID, theme, now, input.ScheduledEndTime)
+			seedOpinions = talksession_template.NewSeedOpinions(seeds)
+			sourceKey, sourceID = "source_talk_session_id", src.TalkSessionID().String()
+		} else {
+			template, err := i.TalkSessionTemplateRepository.FindByID(ctx, *input.TemplateID)
+			if err != nil {
+				utils.HandleError(ctx, err, "TalkSessionTemplateRepository.FindByID")
+				return messages.TalkSessionCloneFailed
+			}
+			if template == nil || (input.OrganizationID != nil && template.OrganizationID() != *input.OrganizationID) {
+				return messages.TalkSessionTemplateNotFound
+			}
+			// テンプレートは組織のメンバーであれば誰でも使える
+			if err := requireOrganizationRole(ctx, i.OrganizationUserRepository, template.OrganizationID(), input.UserID, organization.OrganizationUserRoleMember); err != nil {
+				return err
+			}
+
+			theme := template.Theme()
+			if input.Theme != nil {
+				theme = *input.Theme
+			}
+			draft, err = template.NewDraftTalkSession(ctx, talkSessionID, input.UserID, theme, now, input.ScheduledEndTime)
+			if err != nil {
+				utils.HandleError(ctx, err, "TalkSessionTemplate.NewDraftTalkSession")
+				return messages.TalkSessionCloneFailed
+			}
+			seedOpinions = template.SeedOpinions()
+			sourceKey, sourceID = "template_id", template.TemplateID().String()
+		}
+
+		if err := i.TalkSessionRepository.Create(ctx, draft); err != nil {
+			utils.HandleError(ctx, err, "TalkSessionRepository.Create")
+			return messages.TalkSessionCloneFailed
+		}
+
+		for _, seed := range seedOpinions {
+			op, err := seed.ToOpinion(shared.NewUUID[opinion.Opinion](), talkSessionID, now)
+			if err != nil {
+				utils.HandleError(ctx, err, "SeedOpinion.ToOpinion")
+				return messages.TalkSessionCloneFailed
+			}
+			if err := i.OpinionRepository.Create(ctx, *op); err != nil {
+				utils.HandleError(ctx, err, "OpinionRepository.Create")
+				return messages.TalkSessionCloneFailed
+			}
+		}
+
+		if draft.OrganizationID() != nil {
+			auditLog := organization.NewOrganizationAuditLog(*draft.OrganizationID(), input.UserID, organization.AuditActionTalkSessionCloned, organization.AuditTargetTalkSession, talkSessionID.String(), now)
+			auditLog.RecordChange(sourceKey, nil, sourceID)
+			auditLog.RecordChange("theme", nil, draft.Theme())
+			if err := i.OrganizationAuditLogRepository.Create(ctx, auditLog); err != nil {
+				utils.HandleError(ctx, err, "OrganizationAuditLogRepository.Create")
+				return messages.TalkSessionCloneFailed
+			}
+		}
+
+		output.TalkSessionID = talkSessionID
+		return nil
+	}); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	return &output, nil
+}
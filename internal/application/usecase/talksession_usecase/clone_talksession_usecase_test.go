@@ -0,0 +1,60 @@
+package talksession_usecase_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/neko-dream/api/internal/application/usecase/talksession_usecase"
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/talksession/talksession_template"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCloneTalkSessionInput_Validate(t *testing.T) {
+	talkSessionID := shared.NewUUID[talksession.TalkSession]()
+	templateID := shared.NewUUID[talksession_template.TalkSessionTemplate]()
+
+	tests := []struct {
+		name    string
+		input   talksession_usecase.CloneTalkSessionInput
+		wantErr error
+	}{
+		{
+			name:  "セッションから複製できる",
+			input: talksession_usecase.CloneTalkSessionInput{SourceTalkSessionID: &talkSessionID},
+		},
+		{
+			name:  "テンプレートから複製できる",
+			input: talksession_usecase.CloneTalkSessionInput{TemplateID: &templateID, Theme: lo.ToPtr("新しいテーマ")},
+		},
+		{
+			name:    "複製元が未指定の場合はエラー",
+			input:   talksession_usecase.CloneTalkSessionInput{},
+			wantErr: messages.BadRequestError,
+		},
+		{
+			name:    "複製元を両方指定した場合はエラー",
+			input:   talksession_usecase.CloneTalkSessionInput{SourceTalkSessionID: &talkSessionID, TemplateID: &templateID},
+			wantErr: messages.BadRequestError,
+		},
+		{
+			name:    "テーマが100文字を超える場合はエラー",
+			input:   talksession_usecase.CloneTalkSessionInput{SourceTalkSessionID: &talkSessionID, Theme: lo.ToPtr(strings.Repeat("あ", 101))},
+			wantErr: messages.TalkSessionThemeTooLong,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.input.Validate()
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package talksession_usecase
+
+import (
+	"context"
+
+	"braces.dev/errtrace"
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession/talksession_template"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type (
+	DeleteTalkSessionTemplateUseCase interface {
+		Execute(context.Context, DeleteTalkSessionTemplateInput) error
+	}
+
+	DeleteTalkSessionTemplateInput struct {
+		OrganizationID shared.UUID[organization.Organization]
+		TemplateID     shared.UUID[talksession_template.TalkSessionTemplate]
+		UserID         shared.UUID[user.User]
+	}
+
+	deleteTalkSessionTemplateHandler struct {
+		talksession_template.TalkSessionTemplateRepository
+		organization.OrganizationUserRepository
+		organization.OrganizationAuditLogRepository
+		*db.DBManager
+	}
+)
+
+func NewDeleteTalkSessionTemplateUseCase(
+	templateRepository talksession_template.TalkSessionTemplateRepository,
+	organizationUserRepository organization.OrganizationUserRepository,
+	auditLogRepository organization.OrganizationAuditLogRepository,
+	DBManager *db.DBManager,
+) DeleteTalkSessionTemplateUseCase {
+	return &deleteTalkSessionTemplateHandler{
+		TalkSessionTemplateRepository:  templateRepository,
+		OrganizationUserRepository:     organizationUserRepository,
+		OrganizationAuditLogRepository: auditLogRepository,
+		DBManager:                      DBManager,
+	}
+}
+
+// Execute テンプレートを削除する。作成者か組織の管理者のみ削除できる
+func (i *deleteTalkSessionTemplateHandler) Execute(ctx context.Context, input DeleteTalkSessionTemplateInput) error {
+	ctx, span := otel.Tracer("talksession_command").Start(ctx, "deleteTalkSessionTemplateHandler.Execute")
+	defer span.End()
+
+	template, err := i.TalkSessionTemplateRepository.FindByID(ctx, input.TemplateID)
+	if err != nil {
+		utils.HandleError(ctx, err, "TalkSessionTemplateRepository.FindByID")
+		return messages.TalkSessionTemplateNotFound
+	}
+	if template == nil || template.OrganizationID() != input.OrganizationID {
+		return messages.TalkSessionTemplateNotFound
+	}
+	if template.CreatedBy() != input.UserID {
+		if err := requireOrganizationRole(ctx, i.OrganizationUserRepository, template.OrganizationID(), input.UserID, organization.OrganizationUserRoleAdmin); err != nil {
+			return err
+		}
+	}
+
+	return errtrace.Wrap(i.DBManager.ExecTx(ctx, func(ctx context.Context) error {
+		if err := i.TalkSessionTemplateRepository.Delete(ctx, input.TemplateID); err != nil {
+			utils.HandleError(ctx, err, "TalkSessionTemplateRepository.Delete")
+			return messages.OrganizationInternalServerError
+		}
+		auditLog := organization.NewOrganizationAuditLog(template.OrganizationID(), input.UserID, organization.AuditActionTemplateDeleted, organization.AuditTargetTemplate, input.TemplateID.String(), clock.Now(ctx))
+		auditLog.RecordChange("name", template.Name(), nil)
+		if err := i.OrganizationAuditLogRepository.Create(ctx, auditLog); err != nil {
+			utils.HandleError(ctx, err, "OrganizationAuditLogRepository.Create")
+			return messages.OrganizationInternalServerError
+		}
+		return nil
+	}))
+}
@@ -9,8 +9,10 @@ import (
 	"github.com/neko-dream/api/internal/application/query/dto"
 	"github.com/neko-dream/api/internal/domain/messages"
 	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/organization"
 	"github.com/neko-dream/api/internal/domain/model/shared"
 	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/talksession/talksession_collaborator"
 	"github.com/neko-dream/api/internal/domain/model/user"
 	"github.com/neko-dream/api/internal/infrastructure/config"
 	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
@@ -45,6 +47,8 @@ type (
 	editTalkSessionHandler struct {
 		talksession.TalkSessionRepository
 		user.UserRepository
+		talksession_collaborator.TalkSessionPermissionService
+		organization.OrganizationAuditLogRepository
 		*db.DBManager
 		*config.Config
 	}
@@ -69,14 +73,18 @@ func (in *EditTalkSessionInput) Validate() error {
 func NewEditTalkSessionUseCase(
 	talkSessionRepository talksession.TalkSessionRepository,
 	userRepository user.UserRepository,
+	permissionService talksession_collaborator.TalkSessionPermissionService,
+	auditLogRepository organization.OrganizationAuditLogRepository,
 	DBManager *db.DBManager,
 	config *config.Config,
 ) EditTalkSessionUseCase {
 	return &editTalkSessionHandler{
-		TalkSessionRepository: talkSessionRepository,
-		UserRepository:        userRepository,
-		DBManager:             DBManager,
-		Config:                config,
+		TalkSessionRepository:          talkSessionRepository,
+		UserRepository:                 userRepository,
+		TalkSessionPermissionService:   permissionService,
+		OrganizationAuditLogRepository: auditLogRepository,
+		DBManager:                      DBManager,
+		Config:                         config,
 	}
 }
 
@@ -91,9 +99,15 @@ func (i *editTalkSessionHandler) Execute(ctx context.Context, input EditTalkSess
 		return nil, messages.TalkSessionNotFound
 	}
 
-	// ローカル環境以外では編集するユーザーがオーナーかどうかを確認
-	if i.Config.Env != config.LOCAL && talkSession.OwnerUserID() != input.UserID {
-		return nil, messages.ForbiddenError
+	// ローカル環境以外では編集するユーザーがオーナーか共同オーナーかどうかを確認
+	if i.Config.Env != config.LOCAL {
+		canEdit, err := i.TalkSessionPermissionService.HasPermission(ctx, talkSession, input.UserID, talksession_collaborator.PermissionEdit)
+		if err != nil {
+			return nil, messages.TalkSessionUpdateFailed
+		}
+		if !canEdit {
+			return nil, messages.ForbiddenError
+		}
 	}
 
 	var output EditTalkSessionOutput
@@ -105,6 +119,20 @@ func (i *editTalkSessionHandler) Execute(ctx context.Context, input EditTalkSess
 	}
 
 	if err := i.DBManager.ExecTx(ctx, func(ctx context.Context) error {
+		// 組織のセッションは監査ログに変更前後を残す
+		var auditLog *organization.OrganizationAuditLog
+		if talkSession.OrganizationID() != nil {
+			auditLog = organization.NewOrganizationAuditLog(*talkSession.OrganizationID(), input.UserID, organization.AuditActionTalkSessionUpdated, organization.AuditTargetTalkSession, input.TalkSessionID.String(), clock.Now(ctx))
+			auditLog.RecordChange("theme", talkSession.Theme(), input.Theme)
+			auditLog.RecordChange("description", talkSession.Description(), input.Description)
+			auditLog.RecordChange("thumbnail_url", talkSession.ThumbnailURL(), input.ThumbnailURL)
+			auditLog.RecordChange("scheduled_end_time", talkSession.ScheduledEndTime(), input.ScheduledEndTime)
+			auditLog.RecordChange("city", talkSession.City(), input.City)
+			auditLog.RecordChange("prefecture", talkSession.Prefecture(), input.Prefecture)
+			if input.HideTop != nil {
+				auditLog.RecordChange("hide_top", talkSession.HideTop(), input.HideTop)
+			}
+		}
 
 		talkSession.ChangeTheme(input.Theme)
 		talkSession.ChangeDescription(input.Description)
@@ -127,6 +155,12 @@ func (i *editTalkSessionHandler) Execute(ctx context.Context, input EditTalkSess
 			utils.HandleError(ctx, err, "TalkSessionRepository.Update")
 			return messages.TalkSessionUpdateFailed
 		}
+		if auditLog != nil {
+			if err := i.OrganizationAuditLogRepository.Create(ctx, auditLog); err != nil {
+				utils.HandleError(ctx, err, "OrganizationAuditLogRepository.Create")
+				return messages.TalkSessionUpdateFailed
+			}
+		}
 
 		output.TalkSession = dto.TalkSession{
 			TalkSessionID:    input.TalkSessionID,
@@ -10,6 +10,7 @@ import (
 	"github.com/neko-dream/api/internal/domain/model/clock"
 	"github.com/neko-dream/api/internal/domain/model/shared"
 	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/talksession/talksession_collaborator"
 	"github.com/neko-dream/api/internal/domain/model/user"
 	"github.com/neko-dream/api/internal/infrastructure/config"
 	"github.com/neko-dream/api/internal/infrastructure/crypto"
@@ -70,6 +71,8 @@ func TestEditTalkSessionUseCase_Execute(t *testing.T) {
 	editCommand := talksession_usecase.NewEditTalkSessionUseCase(
 		talkSessionRepo,
 		userRepo,
+		talksession_collaborator.NewTalkSessionPermissionService(repository.NewTalkSessionCollaboratorRepository(dbManager)),
+		repository.NewOrganizationAuditLogRepository(dbManager),
 		dbManager,
 		testConfig,
 	)
@@ -659,6 +662,8 @@ func TestEditTalkSessionUseCase_ProductionEnvironment(t *testing.T) {
 	editCommand := talksession_usecase.NewEditTalkSessionUseCase(
 		talkSessionRepo,
 		userRepo,
+		talksession_collaborator.NewTalkSessionPermissionService(repository.NewTalkSessionCollaboratorRepository(dbManager)),
+		repository.NewOrganizationAuditLogRepository(dbManager),
 		dbManager,
 		prodConfig,
 	)
@@ -0,0 +1,97 @@
+package talksession_usecase
+
+import (
+	"context"
+	"errors"
+
+	"braces.dev/errtrace"
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/talksession/talksession_collaborator"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type (
+	PublishTalkSessionUseCase interface {
+		Execute(context.Context, PublishTalkSessionInput) error
+	}
+
+	// PublishTalkSessionInput 下書きのセッションを公開するための入力データ
+	PublishTalkSessionInput struct {
+		TalkSessionID shared.UUID[talksession.TalkSession]
+		UserID        shared.UUID[user.User]
+	}
+
+	publishTalkSessionHandler struct {
+		talksession.TalkSessionRepository
+		talksession_collaborator.TalkSessionPermissionService
+		organization.OrganizationAuditLogRepository
+		*db.DBManager
+	}
+)
+
+func NewPublishTalkSessionUseCase(
+	talkSessionRepository talksession.TalkSessionRepository,
+	permissionService talksession_collaborator.TalkSessionPermissionService,
+	auditLogRepository organization.OrganizationAuditLogRepository,
+	DBManager *db.DBManager,
+) PublishTalkSessionUseCase {
+	return &publishTalkSessionHandler{
+		TalkSessionRepository:          talkSessionRepository,
+		TalkSessionPermissionService:   permissionService,
+		OrganizationAuditLogRepository: auditLogRepository,
+		DBManager:                      DBManager,
+	}
+}
+
+// Execute 下書きのセッションを公開し、参加を受け付けるようにする
+// 公開できるのはセッションのオーナーと共同オーナーのみ
+func (i *publishTalkSessionHandler) Execute(ctx context.Context, input PublishTalkSessionInput) error {
+	ctx, span := otel.Tracer("talksession_command").Start(ctx, "publishTalkSessionHandler.Execute")
+	defer span.End()
+
+	talkSession, err := i.TalkSessionRepository.FindByID(ctx, input.TalkSessionID)
+	if err != nil {
+		utils.HandleError(ctx, err, "TalkSessionRepository.FindByID")
+		return messages.TalkSessionNotFound
+	}
+	canPublish, err := i.TalkSessionPermissionService.HasPermission(ctx, talkSession, input.UserID, talksession_collaborator.PermissionEdit)
+	if err != nil {
+		return messages.TalkSessionUpdateFailed
+	}
+	if !canPublish {
+		return messages.ForbiddenError
+	}
+
+	if err := talkSession.Publish(ctx); err != nil {
+		switch {
+		case errors.Is(err, talksession.ErrSessionNotDraft):
+			return messages.TalkSessionNotDraft
+		case errors.Is(err, talksession.ErrSessionAlreadyEnded):
+			return messages.InvalidScheduledEndTime
+		}
+		return errtrace.Wrap(err)
+	}
+
+	return i.DBManager.ExecTx(ctx, func(ctx context.Context) error {
+		if err := i.TalkSessionRepository.Update(ctx, talkSession); err != nil {
+			utils.HandleError(ctx, err, "TalkSessionRepository.Update")
+			return messages.TalkSessionUpdateFailed
+		}
+		if talkSession.OrganizationID() != nil {
+			auditLog := organization.NewOrganizationAuditLog(*talkSession.OrganizationID(), input.UserID, organization.AuditActionTalkSessionPublished, organization.AuditTargetTalkSession, input.TalkSessionID.String(), clock.Now(ctx))
+			auditLog.RecordChange("is_draft", true, false)
+			if err := i.OrganizationAuditLogRepository.Create(ctx, auditLog); err != nil {
+				utils.HandleError(ctx, err, "OrganizationAuditLogRepository.Create")
+				return messages.TalkSessionUpdateFailed
+			}
+		}
+		return nil
+	})
+}
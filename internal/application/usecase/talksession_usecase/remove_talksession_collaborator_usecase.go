@@ -0,0 +1,108 @@
+package talksession_usecase
+
+import (
+	"context"
+
+	"braces.dev/errtrace"
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/talksession/talksession_collaborator"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type (
+	RemoveTalkSessionCollaboratorUseCase interface {
+		Execute(context.Context, RemoveTalkSessionCollaboratorInput) error
+	}
+
+	RemoveTalkSessionCollaboratorInput struct {
+		TalkSessionID   shared.UUID[talksession.TalkSession]
+		UserID          shared.UUID[user.User]
+		TargetDisplayID string
+	}
+
+	removeTalkSessionCollaboratorHandler struct {
+		talksession.TalkSessionRepository
+		user.UserRepository
+		talksession_collaborator.TalkSessionCollaboratorRepository
+		talksession_collaborator.TalkSessionPermissionService
+		organization.OrganizationAuditLogRepository
+		*db.DBManager
+	}
+)
+
+func NewRemoveTalkSessionCollaboratorUseCase(
+	talkSessionRepository talksession.TalkSessionRepository,
+	userRepository user.UserRepository,
+	collaboratorRepository talksession_collaborator.TalkSessionCollaboratorRepository,
+	permissionService talksession_collaborator.TalkSessionPermissionService,
+	auditLogRepository organization.OrganizationAuditLogRepository,
+	DBManager *db.DBManager,
+) RemoveTalkSessionCollaboratorUseCase {
+	return &removeTalkSessionCollaboratorHandler{
+		TalkSessionRepository:             talkSessionRepository,
+		UserRepository:                    userRepository,
+		TalkSessionCollaboratorRepository: collaboratorRepository,
+		TalkSessionPermissionService:      permissionService,
+		OrganizationAuditLogRepository:    auditLogRepository,
+		DBManager:                         DBManager,
+	}
+}
+
+// Execute 共同管理者を外す。オーナーと共同オーナーの他、共同管理者自身も外れることができる
+func (i *removeTalkSessionCollaboratorHandler) Execute(ctx context.Context, input RemoveTalkSessionCollaboratorInput) error {
+	ctx, span := otel.Tracer("talksession_command").Start(ctx, "removeTalkSessionCollaboratorHandler.Execute")
+	defer span.End()
+
+	talkSession, err := i.TalkSessionRepository.FindByID(ctx, input.TalkSessionID)
+	if err != nil {
+		utils.HandleError(ctx, err, "TalkSessionRepository.FindByID")
+		return messages.TalkSessionNotFound
+	}
+	target, err := i.UserRepository.FindByDisplayID(ctx, input.TargetDisplayID)
+	if err != nil || target == nil {
+		return messages.UserNotFoundError
+	}
+
+	if target.UserID() != input.UserID {
+		ok, err := i.TalkSessionPermissionService.HasPermission(ctx, talkSession, input.UserID, talksession_collaborator.PermissionManageCollaborators)
+		if err != nil {
+			return messages.TalkSessionCollaboratorUpdateFailed
+		}
+		if !ok {
+			return messages.ForbiddenError
+		}
+	}
+
+	return errtrace.Wrap(i.DBManager.ExecTx(ctx, func(ctx context.Context) error {
+		collaborator, err := i.TalkSessionCollaboratorRepository.FindByTalkSessionIDAndUserID(ctx, input.TalkSessionID, target.UserID())
+		if err != nil {
+			utils.HandleError(ctx, err, "TalkSessionCollaboratorRepository.FindByTalkSessionIDAndUserID")
+			return messages.TalkSessionCollaboratorUpdateFailed
+		}
+		if collaborator == nil {
+			return messages.TalkSessionCollaboratorNotFound
+		}
+		if err := i.TalkSessionCollaboratorRepository.Delete(ctx, input.TalkSessionID, target.UserID()); err != nil {
+			utils.HandleError(ctx, err, "TalkSessionCollaboratorRepository.Delete")
+			return messages.TalkSessionCollaboratorUpdateFailed
+		}
+
+		if talkSession.OrganizationID() != nil {
+			auditLog := organization.NewOrganizationAuditLog(*talkSession.OrganizationID(), input.UserID, organization.AuditActionCollaboratorRemoved, organization.AuditTargetUser, target.UserID().String(), clock.Now(ctx))
+			auditLog.RecordChange("talk_session_id", input.TalkSessionID.String(), nil)
+			auditLog.RecordChange("collaborator_role", collaborator.Role(), nil)
+			if err := i.OrganizationAuditLogRepository.Create(ctx, auditLog); err != nil {
+				utils.HandleError(ctx, err, "OrganizationAuditLogRepository.Create")
+				return messages.TalkSessionCollaboratorUpdateFailed
+			}
+		}
+		return nil
+	}))
+}
@@ -0,0 +1,126 @@
+package talksession_usecase
+
+import (
+	"context"
+	"errors"
+
+	"braces.dev/errtrace"
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/opinion"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/talksession/talksession_template"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type (
+	SaveTalkSessionTemplateUseCase interface {
+		Execute(context.Context, SaveTalkSessionTemplateInput) (*SaveTalkSessionTemplateOutput, error)
+	}
+
+	// SaveTalkSessionTemplateInput セッションをテンプレートとして保存するための入力データ
+	SaveTalkSessionTemplateInput struct {
+		TalkSessionID shared.UUID[talksession.TalkSession] // テンプレートにするセッションのID
+		UserID        shared.UUID[user.User]               // 操作するユーザーのID
+		Name          string                               // テンプレート名
+	}
+
+	SaveTalkSessionTemplateOutput struct {
+		Template *talksession_template.TalkSessionTemplate
+	}
+
+	saveTalkSessionTemplateHandler struct {
+		talksession.TalkSessionRepository
+		opinion.OpinionRepository
+		talksession_template.TalkSessionTemplateRepository
+		organization.OrganizationUserRepository
+		organization.OrganizationAuditLogRepository
+		*db.DBManager
+	}
+)
+
+func NewSaveTalkSessionTemplateUseCase(
+	talkSessionRepository talksession.TalkSessionRepository,
+	opinionRepository opinion.OpinionRepository,
+	templateRepository talksession_template.TalkSessionTemplateRepository,
+	organizationUserRepository organization.OrganizationUserRepository,
+	auditLogRepository organization.OrganizationAuditLogRepository,
+	DBManager *db.DBManager,
+) SaveTalkSessionTemplateUseCase {
+	return &saveTalkSessionTemplateHandler{
+		TalkSessionRepository:          talkSessionRepository,
+		OpinionRepository:              opinionRepository,
+		TalkSessionTemplateRepository:  templateRepository,
+		OrganizationUserRepository:     organizationUserRepository,
+		OrganizationAuditLogRepository: auditLogRepository,
+		DBManager:                      DBManager,
+	}
+}
+
+// Execute セッションの設定とシード意見を組織のテンプレートとして保存する
+// セッションのオーナーか、セッションが属する組織の管理者のみ保存できる
+func (i *saveTalkSessionTemplateHandler) Execute(ctx context.Context, input SaveTalkSessionTemplateInput) (*SaveTalkSessionTemplateOutput, error) {
+	ctx, span := otel.Tracer("talksession_command").Start(ctx, "saveTalkSessionTemplateHandler.Execute")
+	defer span.End()
+
+	talkSession, err := i.TalkSessionRepository.FindByID(ctx, input.TalkSessionID)
+	if err != nil {
+		utils.HandleError(ctx, err, "TalkSessionRepository.FindByID")
+		return nil, messages.TalkSessionNotFound
+	}
+	if err := requireTalkSessionManager(ctx, i.OrganizationUserRepository, talkSession, input.UserID); err != nil {
+		return nil, err
+	}
+
+	var output SaveTalkSessionTemplateOutput
+	if err := i.DBManager.ExecTx(ctx, func(ctx context.Context) error {
+		seeds, err := i.OpinionRepository.FindSeedsByTalkSessionID(ctx, input.TalkSessionID)
+		if err != nil {
+			utils.HandleError(ctx, err, "OpinionRepository.FindSeedsByTalkSessionID")
+			return messages.TalkSessionTemplateCreateFailed
+		}
+
+		template, err := talksession_template.NewTalkSessionTemplateFromTalkSession(
+			shared.NewUUID[talksession_template.TalkSessionTemplate](),
+			input.Name,
+			talkSession,
+			seeds,
+			input.UserID,
+			clock.Now(ctx),
+		)
+		if err != nil {
+			switch {
+			case errors.Is(err, talksession_template.ErrInvalidTemplateName):
+				return messages.TalkSessionTemplateNameInvalid
+			case errors.Is(err, talksession_template.ErrTalkSessionHasNoOrganization):
+				return messages.TalkSessionTemplateRequiresOrganization
+			}
+			return errtrace.Wrap(err)
+		}
+
+		if err := i.TalkSessionTemplateRepository.Create(ctx, template); err != nil {
+			utils.HandleError(ctx, err, "TalkSessionTemplateRepository.Create")
+			return messages.TalkSessionTemplateCreateFailed
+		}
+
+		auditLog := organization.NewOrganizationAuditLog(template.OrganizationID(), input.UserID, organization.AuditActionTemplateCreated, organization.AuditTargetTemplate, template.TemplateID().String(), clock.Now(ctx))
+		auditLog.RecordChange("name", nil, template.Name())
+		auditLog.RecordChange("source_talk_session_id", nil, input.TalkSessionID.String())
+		if err := i.OrganizationAuditLogRepository.Create(ctx, auditLog); err != nil {
+			utils.HandleError(ctx, err, "OrganizationAuditLogRepository.Create")
+			return messages.TalkSessionTemplateCreateFailed
+		}
+
+		output.Template = template
+		return nil
+	}); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	return &output, nil
+}
@@ -0,0 +1,137 @@
+package talksession_usecase
+
+import (
+	"context"
+	"errors"
+
+	"braces.dev/errtrace"
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/talksession/talksession_collaborator"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type (
+	SetTalkSessionCollaboratorUseCase interface {
+		Execute(context.Context, SetTalkSessionCollaboratorInput) (*SetTalkSessionCollaboratorOutput, error)
+	}
+
+	// SetTalkSessionCollaboratorInput 共同管理者を追加、またはロールを変更するための入力データ
+	SetTalkSessionCollaboratorInput struct {
+		TalkSessionID   shared.UUID[talksession.TalkSession] // 対象のセッションID
+		UserID          shared.UUID[user.User]               // 操作するユーザーのID
+		TargetDisplayID string                               // 共同管理者にするユーザーのDisplayID
+		Role            string                               // 付与するロール
+	}
+
+	SetTalkSessionCollaboratorOutput struct {
+		Collaborator *talksession_collaborator.TalkSessionCollaborator
+		User         *user.User
+	}
+
+	setTalkSessionCollaboratorHandler struct {
+		talksession.TalkSessionRepository
+		user.UserRepository
+		talksession_collaborator.TalkSessionCollaboratorRepository
+		talksession_collaborator.TalkSessionPermissionService
+		organization.OrganizationAuditLogRepository
+		*db.DBManager
+	}
+)
+
+func NewSetTalkSessionCollaboratorUseCase(
+	talkSessionRepository talksession.TalkSessionRepository,
+	userRepository user.UserRepository,
+	collaboratorRepository talksession_collaborator.TalkSessionCollaboratorRepository,
+	permissionService talksession_collaborator.TalkSessionPermissionService,
+	auditLogRepository organization.OrganizationAuditLogRepository,
+	DBManager *db.DBManager,
+) SetTalkSessionCollaboratorUseCase {
+	return &setTalkSessionCollaboratorHandler{
+		TalkSessionRepository:             talkSessionRepository,
+		UserRepository:                    userRepository,
+		TalkSessionCollaboratorRepository: collaboratorRepository,
+		TalkSessionPermissionService:      permissionService,
+		OrganizationAuditLogRepository:    auditLogRepository,
+		DBManager:                         DBManager,
+	}
+}
+
+// Execute 共同管理者を追加する。既に共同管理者の場合はロールを変更する
+// オーナーか共同オーナーのみ実行できる
+func (i *setTalkSessionCollaboratorHandler) Execute(ctx context.Context, input SetTalkSessionCollaboratorInput) (*SetTalkSessionCollaboratorOutput, error) {
+	ctx, span := otel.Tracer("talksession_command").Start(ctx, "setTalkSessionCollaboratorHandler.Execute")
+	defer span.End()
+
+	role, err := talksession_collaborator.ParseCollaboratorRole(input.Role)
+	if err != nil {
+		return nil, messages.TalkSessionCollaboratorRoleInvalid
+	}
+
+	talkSession, err := i.TalkSessionRepository.FindByID(ctx, input.TalkSessionID)
+	if err != nil {
+		utils.HandleError(ctx, err, "TalkSessionRepository.FindByID")
+		return nil, messages.TalkSessionNotFound
+	}
+	ok, err := i.TalkSessionPermissionService.HasPermission(ctx, talkSession, input.UserID, talksession_collaborator.PermissionManageCollaborators)
+	if err != nil {
+		return nil, messages.TalkSessionCollaboratorUpdateFailed
+	}
+	if !ok {
+		return nil, messages.ForbiddenError
+	}
+
+	target, err := i.UserRepository.FindByDisplayID(ctx, input.TargetDisplayID)
+	if err != nil || target == nil {
+		return nil, messages.UserNotFoundError
+	}
+
+	var output SetTalkSessionCollaboratorOutput
+	if err := i.DBManager.ExecTx(ctx, func(ctx context.Context) error {
+		before, err := i.TalkSessionCollaboratorRepository.FindByTalkSessionIDAndUserID(ctx, input.TalkSessionID, target.UserID())
+		if err != nil {
+			utils.HandleError(ctx, err, "TalkSessionCollaboratorRepository.FindByTalkSessionIDAndUserID")
+			return messages.TalkSessionCollaboratorUpdateFailed
+		}
+
+		collaborator, err := talksession_collaborator.NewTalkSessionCollaborator(talkSession, target.UserID(), role, input.UserID, clock.Now(ctx))
+		if err != nil {
+			if errors.Is(err, talksession_collaborator.ErrOwnerCannotCollaborate) {
+				return messages.TalkSessionCollaboratorIsOwner
+			}
+			return errtrace.Wrap(err)
+		}
+		if err := i.TalkSessionCollaboratorRepository.Save(ctx, collaborator); err != nil {
+			utils.HandleError(ctx, err, "TalkSessionCollaboratorRepository.Save")
+			return messages.TalkSessionCollaboratorUpdateFailed
+		}
+
+		if talkSession.OrganizationID() != nil {
+			var beforeRole any
+			if before != nil {
+				beforeRole = before.Role()
+			}
+			auditLog := organization.NewOrganizationAuditLog(*talkSession.OrganizationID(), input.UserID, organization.AuditActionCollaboratorChanged, organization.AuditTargetUser, target.UserID().String(), clock.Now(ctx))
+			auditLog.RecordChange("talk_session_id", nil, input.TalkSessionID.String())
+			auditLog.RecordChange("collaborator_role", beforeRole, role)
+			if err := i.OrganizationAuditLogRepository.Create(ctx, auditLog); err != nil {
+				utils.HandleError(ctx, err, "OrganizationAuditLogRepository.Create")
+				return messages.TalkSessionCollaboratorUpdateFailed
+			}
+		}
+
+		output.Collaborator = collaborator
+		output.User = target
+		return nil
+	}); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	return &output, nil
+}
@@ -51,6 +51,7 @@ type (
 		user.UserRepository
 		organization.OrganizationUserRepository
 		organization.OrganizationAliasRepository
+		organization.OrganizationAuditLogRepository
 		*db.DBManager
 		*config.Config
 	}
@@ -74,16 +75,18 @@ func NewStartTalkSessionUseCase(
 	userRepository user.UserRepository,
 	organizationUserRepository organization.OrganizationUserRepository,
 	organizationAliasRepository organization.OrganizationAliasRepository,
+	auditLogRepository organization.OrganizationAuditLogRepository,
 	DBManager *db.DBManager,
 	config *config.Config,
 ) StartTalkSessionUseCase {
 	return &startTalkSessionHandler{
-		TalkSessionRepository:       talkSessionRepository,
-		UserRepository:              userRepository,
-		OrganizationUserRepository:  organizationUserRepository,
-		OrganizationAliasRepository: organizationAliasRepository,
-		DBManager:                   DBManager,
-		Config:                      config,
+		TalkSessionRepository:          talkSessionRepository,
+		UserRepository:                 userRepository,
+		OrganizationUserRepository:     organizationUserRepository,
+		OrganizationAliasRepository:    organizationAliasRepository,
+		OrganizationAuditLogRepository: auditLogRepository,
+		DBManager:                      DBManager,
+		Config:                         config,
 	}
 }
 
@@ -171,6 +174,18 @@ func (i *startTalkSessionHandler) Execute(ctx context.Context, input StartTalkSe
 			utils.HandleError(ctx, err, "TalkSessionRepository.Create")
 			return messages.TalkSessionCreateFailed
 		}
+		if organizationID != nil {
+			auditLog := organization.NewOrganizationAuditLog(*organizationID, input.OwnerID, organization.AuditActionTalkSessionStarted, organization.AuditTargetTalkSession, talkSessionID.String(), clock.Now(ctx))
+			auditLog.RecordChange("theme", nil, talkSession.Theme())
+			auditLog.RecordChange("scheduled_end_time", nil, talkSession.ScheduledEndTime())
+			if organizationAliasID != nil {
+				auditLog.RecordChange("organization_alias_id", nil, organizationAliasID.String())
+			}
+			if err := i.OrganizationAuditLogRepository.Create(ctx, auditLog); err != nil {
+				utils.HandleError(ctx, err, "OrganizationAuditLogRepository.Create")
+				return messages.TalkSessionCreateFailed
+			}
+		}
 
 		output.TalkSession = dto.TalkSession{
 			TalkSessionID:    talkSessionID,
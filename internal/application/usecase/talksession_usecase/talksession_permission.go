@@ -0,0 +1,52 @@
+package talksession_usecase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/pkg/utils"
+)
+
+// requireOrganizationRole 祖先の組織からの継承を含め、組織で指定したロール以上の権限を持つか確認する
+func requireOrganizationRole(
+	ctx context.Context,
+	organizationUserRepository organization.OrganizationUserRepository,
+	organizationID shared.UUID[organization.Organization],
+	userID shared.UUID[user.User],
+	minRole organization.OrganizationUserRole,
+) error {
+	orgUser, err := organizationUserRepository.FindEffectiveByOrganizationIDAndUserID(ctx, organizationID, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return messages.ForbiddenError
+		}
+		utils.HandleError(ctx, err, "OrganizationUserRepository.FindEffectiveByOrganizationIDAndUserID")
+		return messages.OrganizationInternalServerError
+	}
+	if orgUser == nil || orgUser.Role > minRole {
+		return messages.ForbiddenError
+	}
+	return nil
+}
+
+// requireTalkSessionManager セッションのオーナーか、セッションが属する組織の管理者であるか確認する
+func requireTalkSessionManager(
+	ctx context.Context,
+	organizationUserRepository organization.OrganizationUserRepository,
+	talkSession *talksession.TalkSession,
+	userID shared.UUID[user.User],
+) error {
+	if talkSession.OwnerUserID() == userID {
+		return nil
+	}
+	if talkSession.OrganizationID() == nil {
+		return messages.ForbiddenError
+	}
+	return requireOrganizationRole(ctx, organizationUserRepository, *talkSession.OrganizationID(), userID, organization.OrganizationUserRoleAdmin)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/neko-dream/api/internal/domain/model/clock"
 	"github.com/neko-dream/api/internal/domain/model/shared"
 	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/talksession/talksession_collaborator"
 	timelineactions "github.com/neko-dream/api/internal/domain/model/timeline_actions"
 	"github.com/neko-dream/api/internal/domain/model/user"
 	"github.com/neko-dream/api/pkg/utils"
@@ -34,6 +35,7 @@ type (
 		timelineactions.ActionItemRepository
 		talksession.TalkSessionRepository
 		timelineactions.ActionItemService
+		talksession_collaborator.TalkSessionPermissionService
 	}
 )
 
@@ -41,11 +43,13 @@ func NewAddTimeLine(
 	actionItemRepository timelineactions.ActionItemRepository,
 	talkSessionRepository talksession.TalkSessionRepository,
 	actionItemService timelineactions.ActionItemService,
+	permissionService talksession_collaborator.TalkSessionPermissionService,
 ) AddTimeLine {
 	return &addTimeLineInteractor{
-		ActionItemRepository:  actionItemRepository,
-		TalkSessionRepository: talkSessionRepository,
-		ActionItemService:     actionItemService,
+		ActionItemRepository:         actionItemRepository,
+		TalkSessionRepository:        talkSessionRepository,
+		ActionItemService:            actionItemService,
+		TalkSessionPermissionService: permissionService,
 	}
 }
 
@@ -65,8 +69,12 @@ func (i *addTimeLineInteractor) Execute(ctx context.Context, input AddTimeLineIn
 	if !talkSession.IsFinished(ctx) {
 		return nil, messages.TalkSessionNotFinished
 	}
-	// セッションのオーナーでなければTimelineは作成できない
-	if talkSession.OwnerUserID() != input.OwnerID {
+	// セッションのオーナーか、タイムラインを管理できる共同管理者でなければTimelineは作成できない
+	canManage, err := i.TalkSessionPermissionService.HasPermission(ctx, talkSession, input.OwnerID, talksession_collaborator.PermissionManageTimeline)
+	if err != nil {
+		return nil, err
+	}
+	if !canManage {
 		return nil, messages.TalkSessionNotOwner
 	}
 	now := clock.Now(ctx)
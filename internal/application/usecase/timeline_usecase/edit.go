@@ -6,6 +6,7 @@ import (
 	"github.com/neko-dream/api/internal/domain/messages"
 	"github.com/neko-dream/api/internal/domain/model/shared"
 	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/talksession/talksession_collaborator"
 	timelineactions "github.com/neko-dream/api/internal/domain/model/timeline_actions"
 	"github.com/neko-dream/api/internal/domain/model/user"
 	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
@@ -33,6 +34,7 @@ type (
 		timelineactions.ActionItemRepository
 		talksession.TalkSessionRepository
 		timelineactions.ActionItemService
+		talksession_collaborator.TalkSessionPermissionService
 		*db.DBManager
 	}
 )
@@ -41,13 +43,15 @@ func NewEditTimeLine(
 	actionItemRepository timelineactions.ActionItemRepository,
 	talkSessionRepository talksession.TalkSessionRepository,
 	actionItemService timelineactions.ActionItemService,
+	permissionService talksession_collaborator.TalkSessionPermissionService,
 	dbManager *db.DBManager,
 ) EditTimeLine {
 	return &EditTimeLineInteractor{
-		ActionItemRepository:  actionItemRepository,
-		TalkSessionRepository: talkSessionRepository,
-		ActionItemService:     actionItemService,
-		DBManager:             dbManager,
+		ActionItemRepository:         actionItemRepository,
+		TalkSessionRepository:        talkSessionRepository,
+		ActionItemService:            actionItemService,
+		TalkSessionPermissionService: permissionService,
+		DBManager:                    dbManager,
 	}
 }
 
@@ -67,8 +71,12 @@ func (i *EditTimeLineInteractor) Execute(ctx context.Context, input EditTimeLine
 	if !talkSession.IsFinished(ctx) {
 		return nil, messages.TalkSessionNotFinished
 	}
-	// セッションのオーナーでなければTimelineは作成できない
-	if talkSession.OwnerUserID() != input.OwnerID {
+	// セッションのオーナーか、タイムラインを管理できる共同管理者でなければTimelineは編集できない
+	canManage, err := i.TalkSessionPermissionService.HasPermission(ctx, talkSession, input.OwnerID, talksession_collaborator.PermissionManageTimeline)
+	if err != nil {
+		return nil, err
+	}
+	if !canManage {
 		return nil, messages.TalkSessionNotOwner
 	}
 
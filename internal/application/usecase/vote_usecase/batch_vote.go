@@ -0,0 +1,262 @@
+package vote_usecase
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/opinion"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/internal/domain/model/vote"
+	"github.com/neko-dream/api/internal/domain/service"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	"github.com/neko-dream/api/pkg/utils"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel"
+)
+
+type (
+	BatchVote interface {
+		Execute(context.Context, BatchVoteInput) (*BatchVoteOutput, error)
+	}
+
+	BatchVoteInput struct {
+		TalkSessionID shared.UUID[talksession.TalkSession]
+		UserID        shared.UUID[user.User]
+		Votes         []BatchVoteItem
+	}
+
+	BatchVoteItem struct {
+		// IdempotencyKey 端末で投票ごとに発行するキー。同じキーの投票は一度だけ反映する
+		IdempotencyKey  string
+		TargetOpinionID shared.UUID[opinion.Opinion]
+		VoteType        string
+		// IsImportant 重要な意見として印を付けるか。nilの場合は変更しない
+		IsImportant *bool
+		// VotedAt 端末で投票した日時。この順に反映する
+		// 反映する順序を決めるためだけに使い、投票日時には反映した時点のサーバーの時刻を記録する
+		VotedAt time.Time
+	}
+
+	BatchVoteOutput struct {
+		// Results 送信された順の投票ごとの結果
+		Results []BatchVoteResult
+	}
+
+	BatchVoteResult struct {
+		IdempotencyKey string
+		OpinionID      shared.UUID[opinion.Opinion]
+		Status         BatchVoteStatus
+		// Error 投票できなかった場合のエラー
+		Error *messages.APIError
+	}
+
+	BatchVoteStatus string
+
+	batchVoteHandler struct {
+		*voteHandler
+		vote.VoteReceiptRepository
+	}
+)
+
+const (
+	// BatchVoteStatusApplied 投票を反映した
+	BatchVoteStatusApplied BatchVoteStatus = "applied"
+	// BatchVoteStatusDuplicate 同じ冪等キーの投票を処理済み
+	BatchVoteStatusDuplicate BatchVoteStatus = "duplicate"
+	// BatchVoteStatusFailed 投票できなかった
+	BatchVoteStatusFailed BatchVoteStatus = "failed"
+)
+
+func NewBatchVoteHandler(
+	opinionService opinion.OpinionService,
+	opinionRepository opinion.OpinionRepository,
+	talkSessionRepository talksession.TalkSessionRepository,
+	voteRepository vote.VoteRepository,
+	voteChangeRepository vote.VoteChangeRepository,
+	voteReceiptRepository vote.VoteReceiptRepository,
+	talkSessionAccessControl service.TalkSessionAccessControl,
+	DBManager *db.DBManager,
+) BatchVote {
+	return &batchVoteHandler{
+		voteHandler: &voteHandler{
+			OpinionService:           opinionService,
+			OpinionRepository:        opinionRepository,
+			VoteRepository:           voteRepository,
+			VoteChangeRepository:     voteChangeRepository,
+			TalkSessionRepository:    talkSessionRepository,
+			TalkSessionAccessControl: talkSessionAccessControl,
+			DBManager:                DBManager,
+		},
+		VoteReceiptRepository: voteReceiptRepository,
+	}
+}
+
+// Execute 端末でまとめた投票を1つのトランザクションで反映する
+// 投票ごとの入力の誤りや投票できない意見は結果として返し、他の投票は反映する
+func (h *batchVoteHandler) Execute(ctx context.Context, input BatchVoteInput) (*BatchVoteOutput, error) {
+	ctx, span := otel.Tracer("vote_command").Start(ctx, "batchVoteHandler.Execute")
+	defer span.End()
+
+	if len(input.Votes) == 0 || len(input.Votes) > vote.MaxBatchVotes {
+		return nil, messages.VoteBatchSizeInvalid
+	}
+
+	results := make([]BatchVoteResult, len(input.Votes))
+	for idx, item := range input.Votes {
+		results[idx] = BatchVoteResult{
+			IdempotencyKey: item.IdempotencyKey,
+			OpinionID:      item.TargetOpinionID,
+		}
+	}
+
+	// 端末で投票した順に反映する
+	order := make([]int, len(input.Votes))
+	for idx := range order {
+		order[idx] = idx
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return input.Votes[a].VotedAt.Compare(input.Votes[b].VotedAt)
+	})
+
+	if err := h.ExecTx(ctx, func(ctx context.Context) error {
+		now := clock.Now(ctx)
+		keys := lo.Uniq(lo.Map(input.Votes, func(item BatchVoteItem, _ int) string {
+			return item.IdempotencyKey
+		}))
+		found, err := h.VoteReceiptRepository.FindByKeys(ctx, input.UserID, keys)
+		if err != nil {
+			utils.HandleError(ctx, err, "VoteReceiptRepository.FindByKeys")
+			return messages.VoteFailed
+		}
+		receipts := lo.SliceToMap(found, func(r vote.VoteReceipt) (string, vote.VoteReceipt) {
+			return r.IdempotencyKey, r
+		})
+
+		for _, idx := range order {
+			item := input.Votes[idx]
+			result := &results[idx]
+
+			if err := vote.ValidateIdempotencyKey(item.IdempotencyKey); err != nil {
+				result.fail(messages.VoteIdempotencyKeyInvalid)
+				continue
+			}
+			vt, err := vote.VoteFromString(lo.ToPtr(item.VoteType))
+			if err != nil || vt == nil {
+				result.fail(messages.VoteTypeInvalid)
+				continue
+			}
+
+			// 処理済みの冪等キーは反映せず、記録した結果を返す
+			if receipt, ok := receipts[item.IdempotencyKey]; ok {
+				result.duplicate(receipt, item.TargetOpinionID, *vt)
+				continue
+			}
+			if err := vote.ValidateClientVotedAt(item.VotedAt, now); err != nil {
+				result.fail(messages.VoteVotedAtInFuture)
+				continue
+			}
+
+			// 反映する前に冪等キーを記録し、同じキーの一括投票が同時に送信されても一度だけ反映する
+			receipt := vote.VoteReceipt{
+				UserID:         input.UserID,
+				IdempotencyKey: item.IdempotencyKey,
+				OpinionID:      item.TargetOpinionID,
+				VoteType:       *vt,
+				ClientVotedAt:  item.VotedAt,
+				CreatedAt:      now,
+			}
+			created, err := h.VoteReceiptRepository.Create(ctx, receipt)
+			if err != nil {
+				utils.HandleError(ctx, err, "VoteReceiptRepository.Create")
+				return messages.VoteFailed
+			}
+			if !created {
+				// 他の一括投票が先に処理したため、その結果を返す
+				stored, err := h.VoteReceiptRepository.FindByKeys(ctx, input.UserID, []string{item.IdempotencyKey})
+				if err != nil || len(stored) == 0 {
+					utils.HandleError(ctx, err, "VoteReceiptRepository.FindByKeys")
+					return messages.VoteFailed
+				}
+				receipts[item.IdempotencyKey] = stored[0]
+				result.duplicate(stored[0], item.TargetOpinionID, *vt)
+				continue
+			}
+
+			voteErr, err := h.voteItem(ctx, input, item)
+			if err != nil {
+				return err
+			}
+			if voteErr != nil {
+				receipt.Error = voteErr
+				if err := h.VoteReceiptRepository.UpdateError(ctx, receipt); err != nil {
+					utils.HandleError(ctx, err, "VoteReceiptRepository.UpdateError")
+					return messages.VoteFailed
+				}
+			}
+			receipts[item.IdempotencyKey] = receipt
+
+			if voteErr != nil {
+				result.fail(voteErr)
+				continue
+			}
+			result.Status = BatchVoteStatusApplied
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return &BatchVoteOutput{
+		Results: results,
+	}, nil
+}
+
+// voteItem 1件の投票を反映する
+// 投票できない場合は1つ目の戻り値でエラーを返す。2つ目の戻り値がエラーの場合は一括投票全体を失敗とする
+func (h *batchVoteHandler) voteItem(ctx context.Context, input BatchVoteInput, item BatchVoteItem) (*messages.APIError, error) {
+	op, err := h.OpinionRepository.FindByID(ctx, item.TargetOpinionID)
+	if err != nil {
+		utils.HandleError(ctx, err, "OpinionRepository.FindByID")
+		return messages.OpinionNotFound, nil
+	}
+	if op.TalkSessionID() != input.TalkSessionID {
+		return messages.VoteOpinionNotInTalkSession, nil
+	}
+
+	err = h.vote(ctx, op, VoteInput{
+		TargetOpinionID: item.TargetOpinionID,
+		UserID:          input.UserID,
+		VoteType:        item.VoteType,
+		IsImportant:     item.IsImportant,
+	})
+	if err == nil {
+		return nil, nil
+	}
+	var apiErr *messages.APIError
+	if errors.As(err, &apiErr) && apiErr.StatusCode < http.StatusInternalServerError {
+		return apiErr, nil
+	}
+	return nil, err
+}
+
+// duplicate 処理済みの冪等キーの結果を返す。記録した投票と異なる場合は冪等キーの使い回しとして失敗にする
+func (r *BatchVoteResult) duplicate(receipt vote.VoteReceipt, opinionID shared.UUID[opinion.Opinion], voteType vote.VoteType) {
+	if !receipt.Matches(opinionID, voteType) {
+		r.fail(messages.VoteIdempotencyKeyConflict)
+		return
+	}
+	r.Status = BatchVoteStatusDuplicate
+	r.Error = receipt.Error
+}
+
+func (r *BatchVoteResult) fail(err *messages.APIError) {
+	r.Status = BatchVoteStatusFailed
+	r.Error = err
+}
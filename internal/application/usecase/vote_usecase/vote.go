@@ -2,12 +2,9 @@ package vote_usecase
 
 import (
 	"context"
-	"database/sql"
-	"errors"
 
 	"braces.dev/errtrace"
 	"github.com/neko-dream/api/internal/domain/messages"
-	"github.com/neko-dream/api/internal/domain/model/analysis"
 	"github.com/neko-dream/api/internal/domain/model/clock"
 	"github.com/neko-dream/api/internal/domain/model/opinion"
 	"github.com/neko-dream/api/internal/domain/model/shared"
@@ -19,7 +16,6 @@ import (
 	"github.com/neko-dream/api/pkg/utils"
 	"github.com/samber/lo"
 	"go.opentelemetry.io/otel"
-	"go.opentelemetry.io/otel/trace"
 )
 
 type (
@@ -31,6 +27,8 @@ type (
 		TargetOpinionID shared.UUID[opinion.Opinion]
 		UserID          shared.UUID[user.User]
 		VoteType        string
+		// IsImportant 重要な意見として印を付けるか。nilの場合は変更しない
+		IsImportant *bool
 	}
 
 	voteHandler struct {
@@ -38,8 +36,7 @@ type (
 		opinion.OpinionRepository
 		talksession.TalkSessionRepository
 		vote.VoteRepository
-		analysis.AnalysisService
-		analysis.AnalysisRepository
+		vote.VoteChangeRepository
 		service.TalkSessionAccessControl
 		*db.DBManager
 	}
@@ -50,8 +47,7 @@ func NewVoteHandler(
 	opinionRepository opinion.OpinionRepository,
 	talkSessionRepository talksession.TalkSessionRepository,
 	voteRepository vote.VoteRepository,
-	analysisService analysis.AnalysisService,
-	analysisRepository analysis.AnalysisRepository,
+	voteChangeRepository vote.VoteChangeRepository,
 	talkSessionAccessControl service.TalkSessionAccessControl,
 	DBManager *db.DBManager,
 ) Vote {
@@ -59,9 +55,8 @@ func NewVoteHandler(
 		OpinionService:           opinionService,
 		OpinionRepository:        opinionRepository,
 		VoteRepository:           voteRepository,
+		VoteChangeRepository:     voteChangeRepository,
 		TalkSessionRepository:    talkSessionRepository,
-		AnalysisService:          analysisService,
-		AnalysisRepository:       analysisRepository,
 		TalkSessionAccessControl: talkSessionAccessControl,
 		DBManager:                DBManager,
 	}
@@ -78,6 +73,23 @@ func (i *voteHandler) Execute(ctx context.Context, input VoteInput) error {
 		return messages.OpinionNotFound
 	}
 
+	if err := i.vote(ctx, op, input); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// vote 意見に投票する。分析は投票のイベントからスケジューラーが再計算する
+func (i *voteHandler) vote(ctx context.Context, op *opinion.Opinion, input VoteInput) error {
+	ctx, span := otel.Tracer("vote_command").Start(ctx, "voteHandler.vote")
+	defer span.End()
+
+	// 投稿者が削除した意見には投票できない
+	if op.IsDeleted() {
+		return messages.OpinionAlreadyDeleted
+	}
+
 	// セッションを探す
 	session, err := i.TalkSessionRepository.FindByID(ctx, op.TalkSessionID())
 	if err != nil {
@@ -119,11 +131,28 @@ func (i *voteHandler) Execute(ctx context.Context, input VoteInput) error {
 				utils.HandleError(ctx, err, "VoteFromString")
 				return err
 			}
+			// 同じ投票であれば変更しない
+			importanceChanged := input.IsImportant != nil && *input.IsImportant != vo.IsImportant
+			if vo.VoteType == *vt && !importanceChanged {
+				return nil
+			}
+			previousVoteType := vo.VoteType
 			vo.ChangeVoteType(*vt)
+			if input.IsImportant != nil {
+				vo.ChangeImportance(*input.IsImportant)
+			}
 			if err := i.VoteRepository.Update(ctx, *vo); err != nil {
 				utils.HandleError(ctx, err, "VoteRepository.Update")
 				return err
 			}
+			// 変更履歴には投票の種類の変更のみを残す
+			if previousVoteType == vo.VoteType {
+				return nil
+			}
+			if err := i.VoteChangeRepository.Create(ctx, *vote.NewVoteChange(*vo, previousVoteType, clock.Now(ctx))); err != nil {
+				utils.HandleError(ctx, err, "VoteChangeRepository.Create")
+				return messages.VoteFailed
+			}
 			return nil
 		}
 		vt, err := vote.VoteFromString(lo.ToPtr(input.VoteType))
@@ -133,7 +162,7 @@ func (i *voteHandler) Execute(ctx context.Context, input VoteInput) error {
 		}
 
 		// 投票を行っていない場合、投票を行う
-		vote, err := vote.NewVote(
+		vo, err := vote.NewVote(
 			shared.NewUUID[vote.Vote](),
 			input.TargetOpinionID,
 			op.TalkSessionID(),
@@ -145,8 +174,13 @@ func (i *voteHandler) Execute(ctx context.Context, input VoteInput) error {
 			utils.HandleError(ctx, err, "NewVote")
 			return err
 		}
+		vo.ChangeImportance(lo.FromPtr(input.IsImportant))
 
-		if err := i.VoteRepository.Create(ctx, *vote); err != nil {
+		if err := i.VoteRepository.Create(ctx, *vo); err != nil {
+			return messages.VoteFailed
+		}
+		if err := i.VoteChangeRepository.Create(ctx, *vote.NewVoteChange(*vo, vote.UnVoted, vo.CreatedAt)); err != nil {
+			utils.HandleError(ctx, err, "VoteChangeRepository.Create")
 			return messages.VoteFailed
 		}
 
@@ -155,33 +189,5 @@ func (i *voteHandler) Execute(ctx context.Context, input VoteInput) error {
 		return errtrace.Wrap(err)
 	}
 
-	// 非同期で分析を開始
-	i.StartAnalysisIfNeeded(ctx, op.TalkSessionID())
-
 	return nil
 }
-
-func (i *voteHandler) StartAnalysisIfNeeded(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession]) {
-	ctx, span := otel.Tracer("vote_command").Start(ctx, "voteHandler.StartAnalysisIfNeeded")
-	defer span.End()
-
-	bg := context.Background()
-	span = trace.SpanFromContext(ctx)
-	bg = trace.ContextWithSpan(bg, span)
-	go func() {
-		_ = i.AnalysisService.StartAnalysis(bg, talkSessionID)
-
-		// 分析レポートを取得
-		report, err := i.AnalysisRepository.FindByTalkSessionID(bg, talkSessionID)
-		if err != nil && !errors.Is(err, sql.ErrNoRows) {
-			utils.HandleError(ctx, err, "AnalysisRepository.FindByTalkSessionID")
-			return
-		}
-		if report == nil {
-			return
-		}
-		if report.ShouldReGenerateReport() {
-			_ = i.AnalysisService.GenerateReport(bg, talkSessionID)
-		}
-	}()
-}
@@ -27,4 +27,59 @@ var (
 		Code:       "ANALYSIS-0004",
 		Message:    "レポートのフィードバックに失敗しました。",
 	}
+	AnalysisSnapshotNotFound = &APIError{
+		StatusCode: 404,
+		Code:       "ANALYSIS-0005",
+		Message:    "分析結果のスナップショットが見つかりません。",
+	}
+	AnalysisJobNotFound = &APIError{
+		StatusCode: 404,
+		Code:       "ANALYSIS-0006",
+		Message:    "分析ジョブが見つかりません。",
+	}
+	ReportVersionNotFound = &APIError{
+		StatusCode: 404,
+		Code:       "ANALYSIS-0007",
+		Message:    "レポートのバージョンが見つかりません。",
+	}
+	ReportPromptTooLong = &APIError{
+		StatusCode: 400,
+		Code:       "ANALYSIS-0008",
+		Message:    "レポート生成の指示は4000文字以内で入力してください。",
+	}
+	InvalidReportSections = &APIError{
+		StatusCode: 500,
+		Code:       "ANALYSIS-0009",
+		Message:    "生成されたレポートの形式が正しくありません。",
+	}
+	TermFrequenciesNotFound = &APIError{
+		StatusCode: 404,
+		Code:       "ANALYSIS-0010",
+		Message:    "ワードクラウドの集計が見つかりません。",
+	}
+	MyPositionNotFound = &APIError{
+		StatusCode: 404,
+		Code:       "ANALYSIS-0011",
+		Message:    "分析結果にあなたの位置がありません。投票すると次の分析で反映されます。",
+	}
+	ReferenceDistributionInvalid = &APIError{
+		StatusCode: 400,
+		Code:       "ANALYSIS-0012",
+		Message:    "母集団の構成のCSVが正しくありません。1列目に区分、2列目に人数または割合を指定してください。",
+	}
+	InvalidDemographicDimension = &APIError{
+		StatusCode: 400,
+		Code:       "ANALYSIS-0013",
+		Message:    "属性はage_band・gender・prefecture・cityのいずれかを指定してください。",
+	}
+	ReferenceDistributionNotFound = &APIError{
+		StatusCode: 404,
+		Code:       "ANALYSIS-0014",
+		Message:    "比較する母集団の構成が登録されていません。",
+	}
+	AnalysisAlreadyRunning = &APIError{
+		StatusCode: 409,
+		Code:       "ANALYSIS-0015",
+		Message:    "このセッションの分析は実行中です。終わってから再度お試しください。",
+	}
 )
@@ -75,4 +75,16 @@ var (
 		Code:       "AUTH-0012",
 		Message:    "セッション情報の解析に失敗しました。再度ログインしてください。",
 	}
+	// ログイン失敗が続きアカウントがロックされている場合のエラー
+	AccountLockedError = &APIError{
+		StatusCode: 423,
+		Code:       "AUTH-0013",
+		Message:    "ログインの失敗が続いたため、アカウントを一時的にロックしています。しばらくしてから再度お試しください。",
+	}
+	// 同一IPアドレスからのログイン失敗が多すぎる場合のエラー
+	TooManyLoginAttemptsError = &APIError{
+		StatusCode: 429,
+		Code:       "AUTH-0014",
+		Message:    "ログインの試行回数が多すぎます。しばらくしてから再度お試しください。",
+	}
 )
@@ -58,4 +58,34 @@ var (
 		Code:       "OPINION-011",
 		Message:    "シード意見はセッション成者のみが投票できます",
 	}
+	OpinionNotAuthor = &APIError{
+		StatusCode: http.StatusForbidden,
+		Code:       "OPINION-012",
+		Message:    "意見の投稿者のみ編集・削除できます",
+	}
+	OpinionEditWindowClosed = &APIError{
+		StatusCode: http.StatusBadRequest,
+		Code:       "OPINION-013",
+		Message:    "意見を編集できる期間を過ぎています",
+	}
+	OpinionTooManyVotesToEdit = &APIError{
+		StatusCode: http.StatusBadRequest,
+		Code:       "OPINION-014",
+		Message:    "多くの投票が集まった意見は編集できません",
+	}
+	OpinionAlreadyDeleted = &APIError{
+		StatusCode: http.StatusBadRequest,
+		Code:       "OPINION-015",
+		Message:    "この意見は削除されています",
+	}
+	OpinionNotChanged = &APIError{
+		StatusCode: http.StatusBadRequest,
+		Code:       "OPINION-016",
+		Message:    "意見の内容が変更されていません",
+	}
+	OpinionUpdateFailed = &APIError{
+		StatusCode: http.StatusInternalServerError,
+		Code:       "OPINION-017",
+		Message:    "意見の更新に失敗しました。時間をおいて再度お試しください",
+	}
 )
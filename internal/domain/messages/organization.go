@@ -64,4 +64,94 @@ var (
 		Code:       "ORGANIZATION-012",
 		Message:    "この操作を実行する権限がありません",
 	}
+	APIKeyInvalidError = &APIError{
+		StatusCode: http.StatusUnauthorized,
+		Code:       "ORGANIZATION-013",
+		Message:    "APIキーが無効か、有効期限が切れています",
+	}
+	APIKeyScopeDeniedError = &APIError{
+		StatusCode: http.StatusForbidden,
+		Code:       "ORGANIZATION-014",
+		Message:    "APIキーにこの操作を行うスコープがありません",
+	}
+	APIKeyNotFoundError = &APIError{
+		StatusCode: http.StatusNotFound,
+		Code:       "ORGANIZATION-015",
+		Message:    "APIキーが見つかりません",
+	}
+	APIKeyInvalidParameterError = &APIError{
+		StatusCode: http.StatusBadRequest,
+		Code:       "ORGANIZATION-016",
+		Message:    "APIキーの名前またはスコープが正しくありません",
+	}
+	OrganizationUserNotFound = &APIError{
+		StatusCode: http.StatusNotFound,
+		Code:       "ORGANIZATION-017",
+		Message:    "組織にこのユーザーは所属していません",
+	}
+	OrganizationRoleInvalid = &APIError{
+		StatusCode: http.StatusBadRequest,
+		Code:       "ORGANIZATION-018",
+		Message:    "無効なロールです",
+	}
+	OrganizationCannotModifySelf = &APIError{
+		StatusCode: http.StatusBadRequest,
+		Code:       "ORGANIZATION-019",
+		Message:    "自分自身のロール変更・削除はできません",
+	}
+	OrganizationLastOwnerError = &APIError{
+		StatusCode: http.StatusBadRequest,
+		Code:       "ORGANIZATION-020",
+		Message:    "組織には最低1人のオーナーが必要です",
+	}
+	OrganizationInvitationNotFound = &APIError{
+		StatusCode: http.StatusNotFound,
+		Code:       "ORGANIZATION-021",
+		Message:    "招待が見つかりません",
+	}
+	OrganizationInvitationAlreadyPending = &APIError{
+		StatusCode: http.StatusBadRequest,
+		Code:       "ORGANIZATION-022",
+		Message:    "このメールアドレスには既に招待を送信しています",
+	}
+	OrganizationInvitationNotPending = &APIError{
+		StatusCode: http.StatusBadRequest,
+		Code:       "ORGANIZATION-023",
+		Message:    "この招待は既に承諾済みか取り消されています",
+	}
+	OrganizationInvitationExpired = &APIError{
+		StatusCode: http.StatusBadRequest,
+		Code:       "ORGANIZATION-024",
+		Message:    "招待の有効期限が切れています。管理者に再送を依頼してください",
+	}
+	OrganizationInvitationEmailMismatch = &APIError{
+		StatusCode: http.StatusForbidden,
+		Code:       "ORGANIZATION-025",
+		Message:    "招待されたメールアドレスのアカウントでログインしてください",
+	}
+	OrganizationInvitationInvalidParameter = &APIError{
+		StatusCode: http.StatusBadRequest,
+		Code:       "ORGANIZATION-026",
+		Message:    "招待先のメールアドレスまたはロールが正しくありません",
+	}
+	OrganizationInvitationResendLimit = &APIError{
+		StatusCode: http.StatusBadRequest,
+		Code:       "ORGANIZATION-027",
+		Message:    "招待メールの再送上限に達しました。招待を取り消して作成し直してください",
+	}
+	OrganizationInvitationCSVInvalid = &APIError{
+		StatusCode: http.StatusBadRequest,
+		Code:       "ORGANIZATION-028",
+		Message:    "CSVファイルの形式が正しくありません",
+	}
+	OrganizationHierarchyCycle = &APIError{
+		StatusCode: http.StatusBadRequest,
+		Code:       "ORGANIZATION-029",
+		Message:    "自身または配下の組織を親組織に設定することはできません",
+	}
+	OrganizationHierarchyTooDeep = &APIError{
+		StatusCode: http.StatusBadRequest,
+		Code:       "ORGANIZATION-030",
+		Message:    "組織の階層が深すぎます。階層は5段階までです",
+	}
 )
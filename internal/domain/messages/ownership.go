@@ -0,0 +1,49 @@
+package messages
+
+var (
+	OwnershipTransferNotFound = &APIError{
+		StatusCode: 404,
+		Code:       "OWNERSHIP-0000",
+		Message:    "譲渡依頼が見つかりません。",
+	}
+	OwnershipTransferAlreadyPending = &APIError{
+		StatusCode: 400,
+		Code:       "OWNERSHIP-0001",
+		Message:    "既に未承諾の譲渡依頼があります。取り消してから依頼し直してください。",
+	}
+	OwnershipTransferToSelf = &APIError{
+		StatusCode: 400,
+		Code:       "OWNERSHIP-0002",
+		Message:    "現在のオーナーには譲渡できません。",
+	}
+	OwnershipTransferNotPending = &APIError{
+		StatusCode: 400,
+		Code:       "OWNERSHIP-0003",
+		Message:    "この譲渡依頼は既に承諾・辞退・取り消しされています。",
+	}
+	OwnershipTransferExpired = &APIError{
+		StatusCode: 400,
+		Code:       "OWNERSHIP-0004",
+		Message:    "譲渡依頼の有効期限が切れています。",
+	}
+	OwnershipTransferNotRecipient = &APIError{
+		StatusCode: 403,
+		Code:       "OWNERSHIP-0005",
+		Message:    "譲渡先のユーザーのみ承諾・辞退できます。",
+	}
+	OwnershipTransferOutdated = &APIError{
+		StatusCode: 400,
+		Code:       "OWNERSHIP-0006",
+		Message:    "依頼後にオーナーが変更されたため、この譲渡依頼は承諾できません。",
+	}
+	OwnershipTransferRecipientNotMember = &APIError{
+		StatusCode: 400,
+		Code:       "OWNERSHIP-0007",
+		Message:    "譲渡先のユーザーが組織に所属していません。",
+	}
+	OwnershipTransferFailed = &APIError{
+		StatusCode: 500,
+		Code:       "OWNERSHIP-0008",
+		Message:    "オーナーの譲渡に失敗しました。",
+	}
+)
@@ -91,4 +91,59 @@ var (
 		Code:       "TALKSESSION-0017",
 		Message:    "セッションは終了しています。",
 	}
+	TalkSessionIsDraft = &APIError{
+		StatusCode: 400,
+		Code:       "TALKSESSION-0018",
+		Message:    "このセッションは下書きのため参加できません。",
+	}
+	TalkSessionNotDraft = &APIError{
+		StatusCode: 400,
+		Code:       "TALKSESSION-0019",
+		Message:    "このセッションは既に公開されています。",
+	}
+	TalkSessionTemplateNotFound = &APIError{
+		StatusCode: 404,
+		Code:       "TALKSESSION-0020",
+		Message:    "セッションのテンプレートが見つかりません。",
+	}
+	TalkSessionTemplateNameInvalid = &APIError{
+		StatusCode: 400,
+		Code:       "TALKSESSION-0021",
+		Message:    "テンプレート名は1~100文字で入力してください。",
+	}
+	TalkSessionTemplateRequiresOrganization = &APIError{
+		StatusCode: 400,
+		Code:       "TALKSESSION-0022",
+		Message:    "組織に紐づかないセッションはテンプレートにできません。",
+	}
+	TalkSessionTemplateCreateFailed = &APIError{
+		StatusCode: 500,
+		Code:       "TALKSESSION-0023",
+		Message:    "セッションのテンプレートの作成に失敗しました。",
+	}
+	TalkSessionCloneFailed = &APIError{
+		StatusCode: 500,
+		Code:       "TALKSESSION-0024",
+		Message:    "セッションの複製に失敗しました。",
+	}
+	TalkSessionCollaboratorRoleInvalid = &APIError{
+		StatusCode: 400,
+		Code:       "TALKSESSION-0025",
+		Message:    "共同管理者のロールが不正です。",
+	}
+	TalkSessionCollaboratorIsOwner = &APIError{
+		StatusCode: 400,
+		Code:       "TALKSESSION-0026",
+		Message:    "セッションのオーナーは共同管理者にできません。",
+	}
+	TalkSessionCollaboratorNotFound = &APIError{
+		StatusCode: 404,
+		Code:       "TALKSESSION-0027",
+		Message:    "共同管理者が見つかりません。",
+	}
+	TalkSessionCollaboratorUpdateFailed = &APIError{
+		StatusCode: 500,
+		Code:       "TALKSESSION-0028",
+		Message:    "共同管理者の更新に失敗しました。",
+	}
 )
@@ -14,4 +14,34 @@ var (
 		Code:       "VOTE-003",
 		Message:    "投票に失敗しました。時間をおいて再度お試しください",
 	}
+	VoteTypeInvalid = &APIError{
+		StatusCode: http.StatusBadRequest,
+		Code:       "VOTE-004",
+		Message:    "投票の種類が不正です",
+	}
+	VoteBatchSizeInvalid = &APIError{
+		StatusCode: http.StatusBadRequest,
+		Code:       "VOTE-005",
+		Message:    "一度に送信できる投票は1件以上100件以下です",
+	}
+	VoteIdempotencyKeyInvalid = &APIError{
+		StatusCode: http.StatusBadRequest,
+		Code:       "VOTE-006",
+		Message:    "冪等キーは1文字以上255文字以下で指定してください",
+	}
+	VoteIdempotencyKeyConflict = &APIError{
+		StatusCode: http.StatusBadRequest,
+		Code:       "VOTE-007",
+		Message:    "同じ冪等キーで異なる投票が送信されています",
+	}
+	VoteVotedAtInFuture = &APIError{
+		StatusCode: http.StatusBadRequest,
+		Code:       "VOTE-008",
+		Message:    "投票日時が未来の日時になっています。端末の時刻を確認してください",
+	}
+	VoteOpinionNotInTalkSession = &APIError{
+		StatusCode: http.StatusBadRequest,
+		Code:       "VOTE-009",
+		Message:    "このセッションの意見ではありません",
+	}
 )
@@ -79,14 +79,16 @@ func (r *AnalysisReport) ApplyFeedback(ctx context.Context, feedbackType Feedbac
 	ctx, span := otel.Tracer("analysis").Start(ctx, "AnalysisReport.ApplyFeedback")
 	defer span.End()
 
+	now := clock.Now(ctx)
 	r.Feedbacks = append(r.Feedbacks, Feedback{
 		FeedbackID: shared.NewUUID[Feedback](),
 		Type:       feedbackType,
 		UserID:     userID,
+		CreatedAt:  now,
 	})
 
 	// フィードバックが追加されたら更新日時を更新する
-	r.UpdatedAt = clock.Now(ctx)
+	r.UpdatedAt = now
 }
 
 func (r *AnalysisReport) HasReceivedFeedbackFrom(ctx context.Context, userID shared.UUID[user.User]) bool {
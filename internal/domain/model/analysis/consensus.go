@@ -0,0 +1,86 @@
+package analysis
+
+import (
+	"slices"
+)
+
+// MinVotesForConsensus 合意・対立の順位に含める意見の最低投票数
+// 投票が少ない意見は平滑化しても偶然の偏りが大きいため除く
+const MinVotesForConsensus = 3
+
+type (
+	// GroupVoteCount グループごとの意見への投票数
+	GroupVoteCount struct {
+		GroupID       GroupID
+		AgreeCount    int
+		DisagreeCount int
+		PassCount     int
+	}
+
+	// OpinionConsensus 意見ごとのグループ間の合意度と対立度
+	OpinionConsensus struct {
+		// Groups グループIDの順の投票数。投票がないグループも含む
+		Groups []GroupVoteCount
+		// Consensus 全グループの賛成確率の積。全てのグループが賛成しているほど1に近い
+		Consensus float64
+		// Divisiveness グループ間の賛成確率の最大と最小の差。グループで意見が分かれているほど1に近い
+		Divisiveness float64
+	}
+)
+
+// TotalCount グループの投票数
+func (c GroupVoteCount) TotalCount() int {
+	return c.AgreeCount + c.DisagreeCount + c.PassCount
+}
+
+// AgreeProbability グループが賛成する確率
+// 投票が少ないグループで極端な値にならないよう、ラプラス平滑化する。投票がない場合は0.5
+func (c GroupVoteCount) AgreeProbability() float64 {
+	return float64(c.AgreeCount+1) / float64(c.TotalCount()+2)
+}
+
+// NewOpinionConsensus 意見へのグループごとの投票数から合意度と対立度を求める
+// groupIDsはトークセッションの全てのグループ。投票がないグループは賛成確率0.5として扱う
+func NewOpinionConsensus(groupIDs []GroupID, counts []GroupVoteCount) OpinionConsensus {
+	countMap := make(map[GroupID]GroupVoteCount, len(counts))
+	for _, count := range counts {
+		countMap[count.GroupID] = count
+	}
+
+	sorted := slices.Clone(groupIDs)
+	slices.Sort(sorted)
+	sorted = slices.Compact(sorted)
+
+	res := OpinionConsensus{
+		Groups: make([]GroupVoteCount, 0, len(sorted)),
+	}
+	if len(sorted) == 0 {
+		return res
+	}
+
+	res.Consensus = 1
+	minProbability, maxProbability := 1.0, 0.0
+	for _, groupID := range sorted {
+		count, ok := countMap[groupID]
+		if !ok {
+			count = GroupVoteCount{GroupID: groupID}
+		}
+		res.Groups = append(res.Groups, count)
+
+		p := count.AgreeProbability()
+		res.Consensus *= p
+		minProbability = min(minProbability, p)
+		maxProbability = max(maxProbability, p)
+	}
+	res.Divisiveness = maxProbability - minProbability
+	return res
+}
+
+// TotalCount 全グループの投票数
+func (o OpinionConsensus) TotalCount() int {
+	total := 0
+	for _, group := range o.Groups {
+		total += group.TotalCount()
+	}
+	return total
+}
@@ -0,0 +1,81 @@
+package analysis_test
+
+import (
+	"testing"
+
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupVoteCount_AgreeProbability(t *testing.T) {
+	tests := []struct {
+		name  string
+		count analysis.GroupVoteCount
+		want  float64
+	}{
+		{
+			name:  "投票がなければ0.5",
+			count: analysis.GroupVoteCount{},
+			want:  0.5,
+		},
+		{
+			name:  "1票の賛成だけでは1にならない",
+			count: analysis.GroupVoteCount{AgreeCount: 1},
+			want:  2.0 / 3.0,
+		},
+		{
+			name:  "保留も投票数に含める",
+			count: analysis.GroupVoteCount{AgreeCount: 3, DisagreeCount: 1, PassCount: 2},
+			want:  4.0 / 8.0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, tt.count.AgreeProbability(), 1e-9)
+		})
+	}
+}
+
+func TestNewOpinionConsensus(t *testing.T) {
+	groupIDs := []analysis.GroupID{1, 0}
+
+	t.Run("全てのグループが賛成している意見は合意度が高く対立度が低い", func(t *testing.T) {
+		agreed := analysis.NewOpinionConsensus(groupIDs, []analysis.GroupVoteCount{
+			{GroupID: 0, AgreeCount: 8},
+			{GroupID: 1, AgreeCount: 8},
+		})
+		split := analysis.NewOpinionConsensus(groupIDs, []analysis.GroupVoteCount{
+			{GroupID: 0, AgreeCount: 8},
+			{GroupID: 1, DisagreeCount: 8},
+		})
+
+		assert.Greater(t, agreed.Consensus, split.Consensus)
+		assert.InDelta(t, 0, agreed.Divisiveness, 1e-9)
+		assert.InDelta(t, 0.8, split.Divisiveness, 1e-9)
+	})
+
+	t.Run("投票がないグループも0.5として含め、グループIDの順に並べる", func(t *testing.T) {
+		consensus := analysis.NewOpinionConsensus(groupIDs, []analysis.GroupVoteCount{
+			{GroupID: 1, AgreeCount: 2},
+		})
+
+		assert.Equal(t, []analysis.GroupVoteCount{
+			{GroupID: 0},
+			{GroupID: 1, AgreeCount: 2},
+		}, consensus.Groups)
+		assert.InDelta(t, 0.5*0.75, consensus.Consensus, 1e-9)
+		assert.InDelta(t, 0.25, consensus.Divisiveness, 1e-9)
+		assert.Equal(t, 2, consensus.TotalCount())
+	})
+
+	t.Run("グループがなければ合意度も対立度も0", func(t *testing.T) {
+		consensus := analysis.NewOpinionConsensus(nil, []analysis.GroupVoteCount{
+			{GroupID: 0, AgreeCount: 2},
+		})
+
+		assert.Empty(t, consensus.Groups)
+		assert.Zero(t, consensus.Consensus)
+		assert.Zero(t, consensus.Divisiveness)
+	})
+}
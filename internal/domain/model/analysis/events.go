@@ -0,0 +1,26 @@
+package analysis
+
+import (
+	"github.com/neko-dream/api/internal/domain/model/event"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+)
+
+const (
+	EventTypeAnalysisReportGenerated event.EventType = "analysis.report_generated"
+)
+
+// AnalysisReportGeneratedEvent レポートの生成が完了したイベント。参加者への通知に使う
+type AnalysisReportGeneratedEvent struct {
+	event.BaseEvent
+	JobID         shared.UUID[AnalysisJob]             `json:"job_id"`
+	TalkSessionID shared.UUID[talksession.TalkSession] `json:"talk_session_id"`
+}
+
+func NewAnalysisReportGeneratedEvent(job *AnalysisJob) *AnalysisReportGeneratedEvent {
+	return &AnalysisReportGeneratedEvent{
+		BaseEvent:     event.NewBaseEvent(EventTypeAnalysisReportGenerated, job.JobID().String(), "AnalysisJob"),
+		JobID:         job.JobID(),
+		TalkSessionID: job.TalkSessionID(),
+	}
+}
@@ -0,0 +1,206 @@
+package analysis
+
+import (
+	"context"
+	"time"
+
+	"github.com/neko-dream/api/internal/domain/model/event"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+)
+
+// AnalysisJobType 非同期に実行する生成処理の種類
+type AnalysisJobType string
+
+const (
+	AnalysisJobTypeReport    AnalysisJobType = "report"
+	AnalysisJobTypeWordCloud AnalysisJobType = "wordcloud"
+)
+
+// AnalysisJobStatus ジョブの状態
+type AnalysisJobStatus string
+
+const (
+	AnalysisJobStatusQueued    AnalysisJobStatus = "queued"
+	AnalysisJobStatusRunning   AnalysisJobStatus = "running"
+	AnalysisJobStatusSucceeded AnalysisJobStatus = "succeeded"
+	AnalysisJobStatusFailed    AnalysisJobStatus = "failed"
+)
+
+const (
+	// AnalysisJobMaxAttempts 失敗した場合に再実行する上限の回数。最初の実行を含む
+	AnalysisJobMaxAttempts = 3
+	// AnalysisJobRetryDelay 最初の再実行までの間隔。再実行のたびに倍にする
+	AnalysisJobRetryDelay = 30 * time.Second
+	// AnalysisJobRunTimeout 実行中のままこの時間が経ったジョブは止まったとみなし、再び実行する
+	AnalysisJobRunTimeout = 10 * time.Minute
+)
+
+type (
+	AnalysisJobRepository interface {
+		// Create 同じセッション・種類の待機中・実行中のジョブがある場合は作成せずfalseを返す
+		Create(ctx context.Context, job *AnalysisJob) (bool, error)
+		Update(ctx context.Context, job *AnalysisJob) error
+		// FindByID 存在しない場合はnilを返す
+		FindByID(ctx context.Context, jobID shared.UUID[AnalysisJob]) (*AnalysisJob, error)
+		// FindActive 同じセッション・種類の待機中・実行中のジョブを取得する。存在しない場合はnilを返す
+		FindActive(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession], jobType AnalysisJobType) (*AnalysisJob, error)
+		// FindRunnableForUpdate 実行できるジョブを取得し、トランザクションの間ロックする
+		FindRunnableForUpdate(ctx context.Context, now time.Time, limit int) ([]*AnalysisJob, error)
+	}
+
+	// AnalysisJobService ジョブの登録
+	AnalysisJobService interface {
+		// Enqueue ジョブを登録する。同じセッション・種類の待機中・実行中のジョブがある場合はそのジョブを返す
+		Enqueue(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession], jobType AnalysisJobType) (*AnalysisJob, error)
+	}
+)
+
+// AnalysisJob レポート・ワードクラウドを非同期に生成するジョブ
+type AnalysisJob struct {
+	event.EventRecorder
+	jobID         shared.UUID[AnalysisJob]
+	talkSessionID shared.UUID[talksession.TalkSession]
+	jobType       AnalysisJobType
+	status        AnalysisJobStatus
+	attempts      int
+	lastError     *string
+	runAfter      time.Time
+	startedAt     *time.Time
+	finishedAt    *time.Time
+	createdAt     time.Time
+	updatedAt     time.Time
+}
+
+func NewAnalysisJob(
+	talkSessionID shared.UUID[talksession.TalkSession],
+	jobType AnalysisJobType,
+	now time.Time,
+) *AnalysisJob {
+	return &AnalysisJob{
+		jobID:         shared.NewUUID[AnalysisJob](),
+		talkSessionID: talkSessionID,
+		jobType:       jobType,
+		status:        AnalysisJobStatusQueued,
+		runAfter:      now,
+		createdAt:     now,
+		updatedAt:     now,
+	}
+}
+
+// ReconstructAnalysisJob DBから取得したデータでジョブを再構築
+func ReconstructAnalysisJob(
+	jobID shared.UUID[AnalysisJob],
+	talkSessionID shared.UUID[talksession.TalkSession],
+	jobType AnalysisJobType,
+	status AnalysisJobStatus,
+	attempts int,
+	lastError *string,
+	runAfter time.Time,
+	startedAt *time.Time,
+	finishedAt *time.Time,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *AnalysisJob {
+	return &AnalysisJob{
+		jobID:         jobID,
+		talkSessionID: talkSessionID,
+		jobType:       jobType,
+		status:        status,
+		attempts:      attempts,
+		lastError:     lastError,
+		runAfter:      runAfter,
+		startedAt:     startedAt,
+		finishedAt:    finishedAt,
+		createdAt:     createdAt,
+		updatedAt:     updatedAt,
+	}
+}
+
+// Start ジョブの実行を開始する。止まったジョブが再実行の上限に達している場合は失敗にしてfalseを返す
+func (j *AnalysisJob) Start(now time.Time) bool {
+	if j.attempts >= AnalysisJobMaxAttempts {
+		reason := "実行がタイムアウトしました"
+		j.lastError = &reason
+		j.finish(AnalysisJobStatusFailed, now)
+		return false
+	}
+
+	j.status = AnalysisJobStatusRunning
+	j.attempts++
+	j.startedAt = &now
+	j.updatedAt = now
+	return true
+}
+
+// Succeed ジョブの成功を記録する。レポートの公開するバージョンが変わった場合のみ参加者に知らせるためのイベントを残す
+func (j *AnalysisJob) Succeed(now time.Time, reportPublished bool) {
+	j.finish(AnalysisJobStatusSucceeded, now)
+	j.lastError = nil
+	if j.jobType == AnalysisJobTypeReport && reportPublished {
+		j.RecordEvent(NewAnalysisReportGeneratedEvent(j))
+	}
+}
+
+// Fail ジョブの失敗を記録する。上限に達するまでは間隔を空けて再実行する
+func (j *AnalysisJob) Fail(now time.Time, reason string) {
+	j.lastError = &reason
+	if j.attempts >= AnalysisJobMaxAttempts {
+		j.finish(AnalysisJobStatusFailed, now)
+		return
+	}
+
+	j.status = AnalysisJobStatusQueued
+	j.runAfter = now.Add(AnalysisJobRetryDelay << (j.attempts - 1))
+	j.updatedAt = now
+}
+
+func (j *AnalysisJob) finish(status AnalysisJobStatus, now time.Time) {
+	j.status = status
+	j.finishedAt = &now
+	j.updatedAt = now
+}
+
+func (j *AnalysisJob) JobID() shared.UUID[AnalysisJob] {
+	return j.jobID
+}
+
+func (j *AnalysisJob) TalkSessionID() shared.UUID[talksession.TalkSession] {
+	return j.talkSessionID
+}
+
+func (j *AnalysisJob) JobType() AnalysisJobType {
+	return j.jobType
+}
+
+func (j *AnalysisJob) Status() AnalysisJobStatus {
+	return j.status
+}
+
+func (j *AnalysisJob) Attempts() int {
+	return j.attempts
+}
+
+func (j *AnalysisJob) LastError() *string {
+	return j.lastError
+}
+
+func (j *AnalysisJob) RunAfter() time.Time {
+	return j.runAfter
+}
+
+func (j *AnalysisJob) StartedAt() *time.Time {
+	return j.startedAt
+}
+
+func (j *AnalysisJob) FinishedAt() *time.Time {
+	return j.finishedAt
+}
+
+func (j *AnalysisJob) CreatedAt() time.Time {
+	return j.createdAt
+}
+
+func (j *AnalysisJob) UpdatedAt() time.Time {
+	return j.updatedAt
+}
@@ -0,0 +1,138 @@
+package analysis_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnalysisJob_Fail(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		failures     int
+		wantStatus   analysis.AnalysisJobStatus
+		wantRunAfter time.Time
+	}{
+		{
+			name:         "1回目の失敗は30秒後に再実行する",
+			failures:     1,
+			wantStatus:   analysis.AnalysisJobStatusQueued,
+			wantRunAfter: now.Add(30 * time.Second),
+		},
+		{
+			name:         "2回目の失敗は間隔を倍にして再実行する",
+			failures:     2,
+			wantStatus:   analysis.AnalysisJobStatusQueued,
+			wantRunAfter: now.Add(60 * time.Second),
+		},
+		{
+			name:       "上限まで失敗したら再実行しない",
+			failures:   analysis.AnalysisJobMaxAttempts,
+			wantStatus: analysis.AnalysisJobStatusFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := analysis.NewAnalysisJob(shared.NewUUID[talksession.TalkSession](), analysis.AnalysisJobTypeReport, now)
+			for range tt.failures {
+				assert.True(t, job.Start(now))
+				job.Fail(now, "error")
+			}
+
+			assert.Equal(t, tt.wantStatus, job.Status())
+			assert.Equal(t, tt.failures, job.Attempts())
+			assert.Equal(t, "error", *job.LastError())
+			if tt.wantStatus == analysis.AnalysisJobStatusQueued {
+				assert.Equal(t, tt.wantRunAfter, job.RunAfter())
+				assert.Nil(t, job.FinishedAt())
+			} else {
+				assert.Equal(t, now, *job.FinishedAt())
+			}
+		})
+	}
+}
+
+func TestAnalysisJob_Start(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("実行中にして実行回数を増やす", func(t *testing.T) {
+		job := analysis.NewAnalysisJob(shared.NewUUID[talksession.TalkSession](), analysis.AnalysisJobTypeWordCloud, now)
+
+		assert.True(t, job.Start(now))
+		assert.Equal(t, analysis.AnalysisJobStatusRunning, job.Status())
+		assert.Equal(t, 1, job.Attempts())
+		assert.Equal(t, now, *job.StartedAt())
+	})
+
+	t.Run("止まったまま上限に達したジョブは実行せず失敗にする", func(t *testing.T) {
+		startedAt := now.Add(-analysis.AnalysisJobRunTimeout)
+		job := analysis.ReconstructAnalysisJob(
+			shared.NewUUID[analysis.AnalysisJob](),
+			shared.NewUUID[talksession.TalkSession](),
+			analysis.AnalysisJobTypeReport,
+			analysis.AnalysisJobStatusRunning,
+			analysis.AnalysisJobMaxAttempts,
+			nil,
+			startedAt,
+			&startedAt,
+			nil,
+			startedAt,
+			startedAt,
+		)
+
+		assert.False(t, job.Start(now))
+		assert.Equal(t, analysis.AnalysisJobStatusFailed, job.Status())
+		assert.Equal(t, analysis.AnalysisJobMaxAttempts, job.Attempts())
+		assert.NotNil(t, job.LastError())
+	})
+}
+
+func TestAnalysisJob_Succeed(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		jobType         analysis.AnalysisJobType
+		reportPublished bool
+		wantEvents      int
+	}{
+		{
+			name:            "公開するレポートが変わった場合は参加者に通知するイベントを残す",
+			jobType:         analysis.AnalysisJobTypeReport,
+			reportPublished: true,
+			wantEvents:      1,
+		},
+		{
+			name:       "公開するレポートが変わらない場合は通知しない",
+			jobType:    analysis.AnalysisJobTypeReport,
+			wantEvents: 0,
+		},
+		{
+			name:       "ワードクラウドは通知しない",
+			jobType:    analysis.AnalysisJobTypeWordCloud,
+			wantEvents: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := analysis.NewAnalysisJob(shared.NewUUID[talksession.TalkSession](), tt.jobType, now)
+			job.Start(now)
+			job.Fail(now, "error")
+			job.Start(now)
+			job.Succeed(now, tt.reportPublished)
+
+			assert.Equal(t, analysis.AnalysisJobStatusSucceeded, job.Status())
+			assert.Nil(t, job.LastError())
+			assert.Equal(t, now, *job.FinishedAt())
+			assert.Len(t, job.GetRecordedEvents(), tt.wantEvents)
+		})
+	}
+}
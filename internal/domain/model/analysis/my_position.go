@@ -0,0 +1,118 @@
+package analysis
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	"github.com/neko-dream/api/internal/domain/model/vote"
+)
+
+// MyPositionOpinionLimit グループとの一致・不一致、おすすめの意見それぞれで返す数
+const MyPositionOpinionLimit = 5
+
+type (
+	// MemberPosition 分析で分類されたユーザーの位置
+	MemberPosition struct {
+		GroupID GroupID
+		PosX    float64
+		PosY    float64
+	}
+
+	// GroupCentroid グループに属するユーザーの位置の平均
+	GroupCentroid struct {
+		GroupID     GroupID
+		PosX        float64
+		PosY        float64
+		MemberCount int
+	}
+
+	// GroupDistance ユーザーの位置からグループの中心までの距離
+	GroupDistance struct {
+		GroupCentroid
+		Distance float64
+	}
+
+	// OpinionAlignment 自分の投票がグループの投票とどれだけ一致しているか
+	OpinionAlignment struct {
+		// Group 自分の投票を除いたグループの投票数
+		Group GroupVoteCount
+		// MyVote 自分の投票。賛成か反対
+		MyVote vote.VoteType
+		// Alignment グループが自分と同じ投票をする確率
+		Alignment float64
+	}
+)
+
+// NewGroupCentroids グループID順の中心
+func NewGroupCentroids(positions []MemberPosition) []GroupCentroid {
+	centroids := make(map[GroupID]*GroupCentroid)
+	for _, p := range positions {
+		c, ok := centroids[p.GroupID]
+		if !ok {
+			c = &GroupCentroid{GroupID: p.GroupID}
+			centroids[p.GroupID] = c
+		}
+		c.PosX += p.PosX
+		c.PosY += p.PosY
+		c.MemberCount++
+	}
+
+	res := make([]GroupCentroid, 0, len(centroids))
+	for _, c := range centroids {
+		c.PosX /= float64(c.MemberCount)
+		c.PosY /= float64(c.MemberCount)
+		res = append(res, *c)
+	}
+	slices.SortFunc(res, func(a, b GroupCentroid) int {
+		return cmp.Compare(a.GroupID, b.GroupID)
+	})
+	return res
+}
+
+// DistancesFrom 位置から各グループの中心までの距離。近い順
+func DistancesFrom(position MemberPosition, centroids []GroupCentroid) []GroupDistance {
+	res := make([]GroupDistance, 0, len(centroids))
+	for _, c := range centroids {
+		res = append(res, GroupDistance{
+			GroupCentroid: c,
+			Distance:      math.Hypot(position.PosX-c.PosX, position.PosY-c.PosY),
+		})
+	}
+	slices.SortStableFunc(res, func(a, b GroupDistance) int {
+		return cmp.Compare(a.Distance, b.Distance)
+	})
+	return res
+}
+
+// DisagreeProbability グループが反対する確率。AgreeProbabilityと同じく平滑化する
+func (c GroupVoteCount) DisagreeProbability() float64 {
+	return float64(c.DisagreeCount+1) / float64(c.TotalCount()+2)
+}
+
+// Without 自分の投票を除いた投票数
+func (c GroupVoteCount) Without(v vote.VoteType) GroupVoteCount {
+	switch v {
+	case vote.Agree:
+		c.AgreeCount = max(0, c.AgreeCount-1)
+	case vote.Disagree:
+		c.DisagreeCount = max(0, c.DisagreeCount-1)
+	case vote.Pass:
+		c.PassCount = max(0, c.PassCount-1)
+	}
+	return c
+}
+
+// NewOpinionAlignment 自分の投票とグループの投票の一致度。保留の場合は比べられないためfalse
+// groupは自分の投票を含むグループの投票数
+func NewOpinionAlignment(myVote vote.VoteType, group GroupVoteCount) (OpinionAlignment, bool) {
+	others := group.Without(myVote)
+	switch myVote {
+	case vote.Agree:
+		return OpinionAlignment{Group: others, MyVote: myVote, Alignment: others.AgreeProbability()}, true
+	case vote.Disagree:
+		return OpinionAlignment{Group: others, MyVote: myVote, Alignment: others.DisagreeProbability()}, true
+	default:
+		return OpinionAlignment{}, false
+	}
+}
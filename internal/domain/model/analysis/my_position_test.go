@@ -0,0 +1,59 @@
+package analysis_test
+
+import (
+	"testing"
+
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/vote"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewGroupCentroids(t *testing.T) {
+	centroids := analysis.NewGroupCentroids([]analysis.MemberPosition{
+		{GroupID: analysis.GroupIDLemon, PosX: 4, PosY: 4},
+		{GroupID: analysis.GroupIDStrawberry, PosX: 0, PosY: 0},
+		{GroupID: analysis.GroupIDStrawberry, PosX: 2, PosY: 2},
+	})
+
+	assert.Equal(t, []analysis.GroupCentroid{
+		{GroupID: analysis.GroupIDStrawberry, PosX: 1, PosY: 1, MemberCount: 2},
+		{GroupID: analysis.GroupIDLemon, PosX: 4, PosY: 4, MemberCount: 1},
+	}, centroids)
+}
+
+func TestDistancesFrom(t *testing.T) {
+	distances := analysis.DistancesFrom(analysis.MemberPosition{PosX: 4, PosY: 0}, []analysis.GroupCentroid{
+		{GroupID: analysis.GroupIDStrawberry, PosX: 0, PosY: 0, MemberCount: 2},
+		{GroupID: analysis.GroupIDLemon, PosX: 4, PosY: 3, MemberCount: 1},
+	})
+
+	assert.Equal(t, analysis.GroupIDLemon, distances[0].GroupID)
+	assert.InDelta(t, 3, distances[0].Distance, 1e-9)
+	assert.Equal(t, analysis.GroupIDStrawberry, distances[1].GroupID)
+	assert.InDelta(t, 4, distances[1].Distance, 1e-9)
+}
+
+func TestNewOpinionAlignment(t *testing.T) {
+	group := analysis.GroupVoteCount{GroupID: analysis.GroupIDStrawberry, AgreeCount: 4, DisagreeCount: 1}
+
+	t.Run("自分の投票を除いてグループと比べる", func(t *testing.T) {
+		alignment, ok := analysis.NewOpinionAlignment(vote.Agree, group)
+		assert.True(t, ok)
+		assert.Equal(t, 3, alignment.Group.AgreeCount)
+		// (3+1)/(4+2)
+		assert.InDelta(t, 4.0/6.0, alignment.Alignment, 1e-9)
+	})
+
+	t.Run("反対の場合はグループが反対する確率", func(t *testing.T) {
+		alignment, ok := analysis.NewOpinionAlignment(vote.Disagree, group)
+		assert.True(t, ok)
+		assert.Equal(t, 0, alignment.Group.DisagreeCount)
+		// (0+1)/(4+2)
+		assert.InDelta(t, 1.0/6.0, alignment.Alignment, 1e-9)
+	})
+
+	t.Run("保留は比べない", func(t *testing.T) {
+		_, ok := analysis.NewOpinionAlignment(vote.Pass, group)
+		assert.False(t, ok)
+	})
+}
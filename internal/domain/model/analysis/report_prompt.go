@@ -0,0 +1,153 @@
+package analysis
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+)
+
+// ReportGeneratorLLM プロンプトを組み立て、OpenAI互換のAPIで生成したレポート
+const ReportGeneratorLLM = "llm"
+
+const (
+	// ReportPromptMaxLength 組織ごとに設定できる指示の最大文字数
+	ReportPromptMaxLength = 4000
+
+	// DefaultReportSystemPrompt 組織が指示を設定していない場合に使うLLMの役割
+	DefaultReportSystemPrompt = `あなたは市民参加型の対話の結果をまとめる編集者です。
+与えられた意見と投票の集計だけを根拠に、中立的で平易な日本語でレポートを書いてください。
+特定の立場を支持したり、与えられていない事実を補ったりしてはいけません。`
+)
+
+// reportOutputFormat レポートの出力形式。組織の指示に関係なく必ず付ける
+const reportOutputFormat = `次の形式のJSONだけを出力してください。
+{
+  "summary": "対話全体の要約",
+  "groupStances": [{"groupId": 0, "stance": "グループの立場の説明"}],
+  "consensus": ["グループを越えて合意している点"],
+  "openQuestions": ["意見が分かれている、または議論が足りない論点"]
+}`
+
+type (
+	// ReportPromptRepository 組織ごとのレポート生成の指示
+	ReportPromptRepository interface {
+		// FindByOrganizationID 設定されていない場合はnilを返す
+		FindByOrganizationID(ctx context.Context, organizationID shared.UUID[organization.Organization]) (*ReportPrompt, error)
+		Save(ctx context.Context, prompt *ReportPrompt) error
+	}
+
+	// ReportPrompt 組織ごとのレポート生成の指示
+	ReportPrompt struct {
+		OrganizationID shared.UUID[organization.Organization]
+		// SystemPrompt LLMの役割。空の場合はDefaultReportSystemPromptを使う
+		SystemPrompt string
+		// Instructions 文体や重視する観点など、組織ごとに追加する指示
+		Instructions string
+		UpdatedBy    *shared.UUID[user.User]
+		UpdatedAt    time.Time
+	}
+
+	// ReportMaterial レポートの生成に使うセッションの集計
+	ReportMaterial struct {
+		Theme       string
+		Description *string
+		Groups      []ReportGroupMaterial
+		// Consensus グループを越えて賛成されている意見
+		Consensus []ReportOpinionMaterial
+		// Divisive グループで賛否が分かれている意見
+		Divisive []ReportOpinionMaterial
+	}
+
+	// ReportGroupMaterial グループの人数と代表的な意見
+	ReportGroupMaterial struct {
+		GroupID     GroupID
+		MemberCount int
+		Opinions    []ReportOpinionMaterial
+	}
+
+	ReportOpinionMaterial struct {
+		Content       string
+		AgreeCount    int
+		DisagreeCount int
+		PassCount     int
+	}
+)
+
+// NewReportPrompt 組織の指示を作成する
+func NewReportPrompt(
+	organizationID shared.UUID[organization.Organization],
+	systemPrompt string,
+	instructions string,
+	updatedBy shared.UUID[user.User],
+	updatedAt time.Time,
+) (*ReportPrompt, error) {
+	systemPrompt = strings.TrimSpace(systemPrompt)
+	instructions = strings.TrimSpace(instructions)
+	if utf8.RuneCountInString(systemPrompt) > ReportPromptMaxLength || utf8.RuneCountInString(instructions) > ReportPromptMaxLength {
+		return nil, messages.ReportPromptTooLong
+	}
+
+	return &ReportPrompt{
+		OrganizationID: organizationID,
+		SystemPrompt:   systemPrompt,
+		Instructions:   instructions,
+		UpdatedBy:      &updatedBy,
+		UpdatedAt:      updatedAt,
+	}, nil
+}
+
+// System LLMに渡すシステムメッセージ
+// promptがnilの場合は既定の指示を使う
+func (p *ReportPrompt) System() string {
+	system := DefaultReportSystemPrompt
+	if p != nil && p.SystemPrompt != "" {
+		system = p.SystemPrompt
+	}
+	if p != nil && p.Instructions != "" {
+		system += "\n\n" + p.Instructions
+	}
+	return system + "\n\n" + reportOutputFormat
+}
+
+// User LLMに渡すセッションの集計
+func (m ReportMaterial) User() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# テーマ\n%s\n", m.Theme)
+	if m.Description != nil && *m.Description != "" {
+		fmt.Fprintf(&b, "\n# 説明\n%s\n", *m.Description)
+	}
+
+	b.WriteString("\n# グループ\n")
+	if len(m.Groups) == 0 {
+		b.WriteString("まだグループに分かれていません。\n")
+	}
+	for _, group := range m.Groups {
+		fmt.Fprintf(&b, "\n## グループ%s (groupId: %d, %d人)\n", group.GroupID.String(), int(group.GroupID), group.MemberCount)
+		writeOpinions(&b, group.Opinions)
+	}
+
+	b.WriteString("\n# 合意している意見\n")
+	writeOpinions(&b, m.Consensus)
+	b.WriteString("\n# 賛否が分かれている意見\n")
+	writeOpinions(&b, m.Divisive)
+	return b.String()
+}
+
+func writeOpinions(b *strings.Builder, opinions []ReportOpinionMaterial) {
+	if len(opinions) == 0 {
+		b.WriteString("- なし\n")
+		return
+	}
+	for _, op := range opinions {
+		// 改行を含む意見で箇条書きが崩れないよう1行にまとめる
+		content := strings.Join(strings.Fields(op.Content), " ")
+		fmt.Fprintf(b, "- %s (賛成%d, 反対%d, 保留%d)\n", content, op.AgreeCount, op.DisagreeCount, op.PassCount)
+	}
+}
@@ -0,0 +1,105 @@
+package analysis_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReportPrompt(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	orgID := shared.NewUUID[organization.Organization]()
+	userID := shared.NewUUID[user.User]()
+
+	t.Run("設定していない場合は既定の指示を使う", func(t *testing.T) {
+		var prompt *analysis.ReportPrompt
+		system := prompt.System()
+
+		assert.True(t, strings.HasPrefix(system, analysis.DefaultReportSystemPrompt))
+		assert.Contains(t, system, `"openQuestions"`)
+	})
+
+	t.Run("組織の指示は既定の指示に追加される", func(t *testing.T) {
+		prompt, err := analysis.NewReportPrompt(orgID, "", "  敬体で書いてください  ", userID, now)
+		assert.NoError(t, err)
+
+		system := prompt.System()
+		assert.True(t, strings.HasPrefix(system, analysis.DefaultReportSystemPrompt+"\n\n敬体で書いてください"))
+		assert.Contains(t, system, `"openQuestions"`)
+	})
+
+	t.Run("役割を置き換えても出力形式は残る", func(t *testing.T) {
+		prompt, err := analysis.NewReportPrompt(orgID, "あなたは行政の担当者です。", "", userID, now)
+		assert.NoError(t, err)
+
+		system := prompt.System()
+		assert.NotContains(t, system, analysis.DefaultReportSystemPrompt)
+		assert.Contains(t, system, `"openQuestions"`)
+	})
+
+	t.Run("長すぎる指示は設定できない", func(t *testing.T) {
+		_, err := analysis.NewReportPrompt(orgID, "", strings.Repeat("あ", analysis.ReportPromptMaxLength+1), userID, now)
+		assert.ErrorIs(t, err, messages.ReportPromptTooLong)
+	})
+}
+
+func TestReportMaterial_User(t *testing.T) {
+	description := "駅前の再開発について"
+	material := analysis.ReportMaterial{
+		Theme:       "駅前広場",
+		Description: &description,
+		Groups: []analysis.ReportGroupMaterial{
+			{
+				GroupID:     analysis.GroupIDStrawberry,
+				MemberCount: 3,
+				Opinions: []analysis.ReportOpinionMaterial{
+					{Content: "ベンチを\n増やしたい", AgreeCount: 3, DisagreeCount: 1},
+				},
+			},
+		},
+	}
+
+	user := material.User()
+	assert.Contains(t, user, "# テーマ\n駅前広場")
+	assert.Contains(t, user, "駅前の再開発について")
+	assert.Contains(t, user, "## グループA (groupId: 0, 3人)")
+	assert.Contains(t, user, "- ベンチを 増やしたい (賛成3, 反対1, 保留0)")
+	assert.Contains(t, user, "# 合意している意見\n- なし")
+}
+
+func TestParseReportSections(t *testing.T) {
+	t.Run("コードブロックで囲まれていても読み取れる", func(t *testing.T) {
+		sections, err := analysis.ParseReportSections("```json\n" + `{"summary":"要約","groupStances":[{"groupId":1,"stance":"賛成"}],"consensus":["合意"],"openQuestions":[]}` + "\n```")
+		assert.NoError(t, err)
+		assert.Equal(t, "要約", sections.Summary)
+		assert.Equal(t, []analysis.GroupStance{{GroupID: analysis.GroupIDLemon, Stance: "賛成"}}, sections.GroupStances)
+	})
+
+	t.Run("要約がない場合は失敗する", func(t *testing.T) {
+		_, err := analysis.ParseReportSections(`{"summary":"","consensus":["合意"]}`)
+		assert.ErrorIs(t, err, messages.InvalidReportSections)
+	})
+
+	t.Run("JSONでない場合は失敗する", func(t *testing.T) {
+		_, err := analysis.ParseReportSections("レポートです")
+		assert.ErrorIs(t, err, messages.InvalidReportSections)
+	})
+}
+
+func TestReportSections_Markdown(t *testing.T) {
+	sections := analysis.ReportSections{
+		Summary:       "要約",
+		GroupStances:  []analysis.GroupStance{{GroupID: analysis.GroupIDStrawberry, Stance: "賛成が多い"}},
+		Consensus:     []string{"安全が大事"},
+		OpenQuestions: nil,
+	}
+
+	assert.Equal(t, "## 概要\n\n要約\n\n## グループごとの立場\n\n### グループA\n\n賛成が多い\n\n## 合意している点\n\n- 安全が大事\n", sections.Markdown())
+}
@@ -0,0 +1,71 @@
+package analysis
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+)
+
+type (
+	// ReportSections 構造化されたレポート
+	ReportSections struct {
+		Summary       string        `json:"summary"`
+		GroupStances  []GroupStance `json:"groupStances"`
+		Consensus     []string      `json:"consensus"`
+		OpenQuestions []string      `json:"openQuestions"`
+	}
+
+	// GroupStance グループごとの立場
+	GroupStance struct {
+		GroupID GroupID `json:"groupId"`
+		Stance  string  `json:"stance"`
+	}
+)
+
+// ParseReportSections LLMの出力をレポートとして読み取る
+// コードブロックで囲まれている場合も受け付ける
+func ParseReportSections(content string) (*ReportSections, error) {
+	content = strings.TrimSpace(content)
+	if strings.HasPrefix(content, "```") {
+		content = strings.TrimPrefix(content, "```json")
+		content = strings.TrimPrefix(content, "```")
+		content = strings.TrimSuffix(strings.TrimSpace(content), "```")
+	}
+
+	var sections ReportSections
+	if err := json.Unmarshal([]byte(content), &sections); err != nil {
+		return nil, messages.InvalidReportSections
+	}
+	if strings.TrimSpace(sections.Summary) == "" {
+		return nil, messages.InvalidReportSections
+	}
+	return &sections, nil
+}
+
+// Markdown 既存のレポートと同じく本文として表示できるよう整形する
+func (s *ReportSections) Markdown() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "## 概要\n\n%s\n", strings.TrimSpace(s.Summary))
+
+	if len(s.GroupStances) > 0 {
+		b.WriteString("\n## グループごとの立場\n")
+		for _, stance := range s.GroupStances {
+			fmt.Fprintf(&b, "\n### グループ%s\n\n%s\n", stance.GroupID.String(), strings.TrimSpace(stance.Stance))
+		}
+	}
+	writeSection(&b, "合意している点", s.Consensus)
+	writeSection(&b, "残された論点", s.OpenQuestions)
+	return b.String()
+}
+
+func writeSection(b *strings.Builder, title string, items []string) {
+	if len(items) == 0 {
+		return
+	}
+	fmt.Fprintf(b, "\n## %s\n\n", title)
+	for _, item := range items {
+		fmt.Fprintf(b, "- %s\n", strings.TrimSpace(item))
+	}
+}
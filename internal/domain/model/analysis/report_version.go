@@ -0,0 +1,189 @@
+package analysis
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/user"
+)
+
+// ReportGeneratorAnalysisAPI 外部の分析APIで生成したレポート
+const ReportGeneratorAnalysisAPI = "analysis-api"
+
+// ReportNotificationInterval 公開するバージョンが変わったことを同じセッションの参加者に通知する最短の間隔
+const ReportNotificationInterval = 6 * time.Hour
+
+type (
+	ReportVersionRepository interface {
+		// Capture 生成されたレポートを新しいバージョンとして保存する
+		// レポートがない場合や、最新のバージョンから変わっていない場合は保存せずnilを返す
+		Capture(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession], generator ReportGenerator, createdAt time.Time) (*ReportVersion, error)
+		// FindByID 存在しない場合はnilを返す
+		FindByID(ctx context.Context, reportVersionID shared.UUID[ReportVersion]) (*ReportVersion, error)
+		// FindLatest 存在しない場合はnilを返す
+		FindLatest(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession]) (*ReportVersion, error)
+		// FindPublication 存在しない場合はnilを返す
+		FindPublication(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession]) (*ReportPublication, error)
+		SavePublication(ctx context.Context, publication *ReportPublication) error
+		// ClaimNotification 前回の通知からinterval以上経っていれば通知日時を記録してtrueを返す
+		// 公開するバージョンがない場合もfalseを返す
+		ClaimNotification(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession], now time.Time, interval time.Duration) (bool, error)
+	}
+
+	// ReportVersionService 生成されたレポートのバージョン管理
+	ReportVersionService interface {
+		// Record 生成されたレポートをバージョンとして残し、固定されていなければ公開するバージョンを最新にする
+		// 前回から変わっていない場合はnilを返す
+		Record(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession], generator ReportGenerator) (*ReportVersion, error)
+	}
+
+	// ReportGenerator レポートを生成した実装
+	ReportGenerator struct {
+		Name string
+		// Model 生成に使ったモデル。分からない場合はnil
+		Model *string
+	}
+
+	// ReportVersion 生成されたレポートの版。保存後は変更しない
+	ReportVersion struct {
+		ReportVersionID shared.UUID[ReportVersion]
+		TalkSessionID   shared.UUID[talksession.TalkSession]
+		// Version セッションごとに1から始まる番号
+		Version int
+		Report  string
+		// Sections 構造化されたレポート。分析APIで生成した場合はnil
+		Sections  *ReportSections
+		Generator ReportGenerator
+		CreatedAt time.Time
+	}
+
+	// ReportPublication セッションで公開するレポートのバージョン
+	ReportPublication struct {
+		TalkSessionID   shared.UUID[talksession.TalkSession]
+		ReportVersionID shared.UUID[ReportVersion]
+		// Pinned trueの場合、新しいバージョンが生成されても公開するバージョンを変えない
+		Pinned bool
+		// PublishedBy 管理者が選んだ場合の操作者。自動で切り替えた場合はnil
+		PublishedBy *shared.UUID[user.User]
+		PublishedAt time.Time
+	}
+)
+
+// AnalysisReportID フィードバックの対象としてのID
+func (v *ReportVersion) AnalysisReportID() shared.UUID[AnalysisReport] {
+	return shared.UUID[AnalysisReport](v.ReportVersionID)
+}
+
+// NewReportPublication 最初のバージョンを公開する
+func NewReportPublication(version *ReportVersion, now time.Time) *ReportPublication {
+	return &ReportPublication{
+		TalkSessionID:   version.TalkSessionID,
+		ReportVersionID: version.ReportVersionID,
+		PublishedAt:     now,
+	}
+}
+
+// Follow 新しいバージョンが生成されたときに公開するバージョンを切り替える
+// 固定されている場合は切り替えずfalseを返す
+func (p *ReportPublication) Follow(version *ReportVersion, now time.Time) bool {
+	if p.Pinned || p.ReportVersionID == version.ReportVersionID {
+		return false
+	}
+	p.ReportVersionID = version.ReportVersionID
+	p.PublishedBy = nil
+	p.PublishedAt = now
+	return true
+}
+
+// Pin 指定したバージョンを公開し、新しいバージョンが生成されても切り替わらないよう固定する
+// 古いバージョンを指定すればロールバックになる
+func (p *ReportPublication) Pin(version *ReportVersion, userID shared.UUID[user.User], now time.Time) error {
+	if version.TalkSessionID != p.TalkSessionID {
+		return messages.ReportVersionNotFound
+	}
+	p.ReportVersionID = version.ReportVersionID
+	p.Pinned = true
+	p.PublishedBy = &userID
+	p.PublishedAt = now
+	return nil
+}
+
+// Unpin 固定を解除し、最新のバージョンを公開する
+func (p *ReportPublication) Unpin(latest *ReportVersion, userID shared.UUID[user.User], now time.Time) {
+	p.ReportVersionID = latest.ReportVersionID
+	p.Pinned = false
+	p.PublishedBy = &userID
+	p.PublishedAt = now
+}
+
+// ReportDiffOp 差分の行の種類
+type ReportDiffOp string
+
+const (
+	ReportDiffOpEqual  ReportDiffOp = "equal"
+	ReportDiffOpInsert ReportDiffOp = "insert"
+	ReportDiffOpDelete ReportDiffOp = "delete"
+)
+
+// ReportDiffLine 差分の1行
+type ReportDiffLine struct {
+	Op   ReportDiffOp
+	Text string
+}
+
+// DiffReports 2つのレポートを行単位で比べる
+// 最長共通部分列を残し、削除された行を追加された行より先に並べる
+func DiffReports(from, to string) []ReportDiffLine {
+	a := splitReportLines(from)
+	b := splitReportLines(to)
+
+	// lcs[i][j] a[i:]とb[j:]の最長共通部分列の長さ
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	lines := make([]ReportDiffLine, 0, max(len(a), len(b)))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			lines = append(lines, ReportDiffLine{Op: ReportDiffOpEqual, Text: a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			lines = append(lines, ReportDiffLine{Op: ReportDiffOpDelete, Text: a[i]})
+			i++
+		default:
+			lines = append(lines, ReportDiffLine{Op: ReportDiffOpInsert, Text: b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		lines = append(lines, ReportDiffLine{Op: ReportDiffOpDelete, Text: a[i]})
+	}
+	for ; j < len(b); j++ {
+		lines = append(lines, ReportDiffLine{Op: ReportDiffOpInsert, Text: b[j]})
+	}
+	return lines
+}
+
+func splitReportLines(report string) []string {
+	if report == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(strings.ReplaceAll(report, "\r\n", "\n"), "\n"), "\n")
+}
@@ -0,0 +1,121 @@
+package analysis_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffReports(t *testing.T) {
+	tests := []struct {
+		name string
+		from string
+		to   string
+		want []analysis.ReportDiffLine
+	}{
+		{
+			name: "同じ内容なら全ての行が変わらない",
+			from: "a\nb\n",
+			to:   "a\nb\n",
+			want: []analysis.ReportDiffLine{
+				{Op: analysis.ReportDiffOpEqual, Text: "a"},
+				{Op: analysis.ReportDiffOpEqual, Text: "b"},
+			},
+		},
+		{
+			name: "書き換えた行は削除の後に追加を並べる",
+			from: "a\nb\nc",
+			to:   "a\nx\nc",
+			want: []analysis.ReportDiffLine{
+				{Op: analysis.ReportDiffOpEqual, Text: "a"},
+				{Op: analysis.ReportDiffOpDelete, Text: "b"},
+				{Op: analysis.ReportDiffOpInsert, Text: "x"},
+				{Op: analysis.ReportDiffOpEqual, Text: "c"},
+			},
+		},
+		{
+			name: "末尾に追加した行",
+			from: "a",
+			to:   "a\r\nb",
+			want: []analysis.ReportDiffLine{
+				{Op: analysis.ReportDiffOpEqual, Text: "a"},
+				{Op: analysis.ReportDiffOpInsert, Text: "b"},
+			},
+		},
+		{
+			name: "空のレポートとの比較は全て削除になる",
+			from: "a\nb",
+			to:   "",
+			want: []analysis.ReportDiffLine{
+				{Op: analysis.ReportDiffOpDelete, Text: "a"},
+				{Op: analysis.ReportDiffOpDelete, Text: "b"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, analysis.DiffReports(tt.from, tt.to))
+		})
+	}
+}
+
+func TestReportPublication(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	talkSessionID := shared.NewUUID[talksession.TalkSession]()
+	adminID := shared.NewUUID[user.User]()
+	newVersion := func(version int) *analysis.ReportVersion {
+		return &analysis.ReportVersion{
+			ReportVersionID: shared.NewUUID[analysis.ReportVersion](),
+			TalkSessionID:   talkSessionID,
+			Version:         version,
+		}
+	}
+
+	t.Run("固定されていなければ新しいバージョンに切り替わる", func(t *testing.T) {
+		v1, v2 := newVersion(1), newVersion(2)
+		p := analysis.NewReportPublication(v1, now)
+
+		assert.True(t, p.Follow(v2, now.Add(time.Minute)))
+		assert.Equal(t, v2.ReportVersionID, p.ReportVersionID)
+		assert.Nil(t, p.PublishedBy)
+	})
+
+	t.Run("固定すると新しいバージョンが生成されても切り替わらない", func(t *testing.T) {
+		v1, v2, v3 := newVersion(1), newVersion(2), newVersion(3)
+		p := analysis.NewReportPublication(v2, now)
+
+		assert.NoError(t, p.Pin(v1, adminID, now))
+		assert.False(t, p.Follow(v3, now.Add(time.Minute)))
+		assert.Equal(t, v1.ReportVersionID, p.ReportVersionID)
+		assert.Equal(t, &adminID, p.PublishedBy)
+	})
+
+	t.Run("固定を解除すると最新のバージョンを公開する", func(t *testing.T) {
+		v1, v2 := newVersion(1), newVersion(2)
+		p := analysis.NewReportPublication(v1, now)
+		assert.NoError(t, p.Pin(v1, adminID, now))
+
+		p.Unpin(v2, adminID, now)
+		assert.False(t, p.Pinned)
+		assert.Equal(t, v2.ReportVersionID, p.ReportVersionID)
+	})
+
+	t.Run("他のセッションのバージョンは公開できない", func(t *testing.T) {
+		p := analysis.NewReportPublication(newVersion(1), now)
+		other := &analysis.ReportVersion{
+			ReportVersionID: shared.NewUUID[analysis.ReportVersion](),
+			TalkSessionID:   shared.NewUUID[talksession.TalkSession](),
+			Version:         1,
+		}
+
+		assert.ErrorIs(t, p.Pin(other, adminID, now), messages.ReportVersionNotFound)
+		assert.False(t, p.Pinned)
+	})
+}
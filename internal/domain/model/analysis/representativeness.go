@@ -0,0 +1,345 @@
+package analysis
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/internal/domain/model/vote"
+	"golang.org/x/text/unicode/norm"
+)
+
+// DemographicDimension 参加者と母集団を比べる属性
+type DemographicDimension string
+
+const (
+	DemographicAgeBand    DemographicDimension = "age_band"
+	DemographicGender     DemographicDimension = "gender"
+	DemographicPrefecture DemographicDimension = "prefecture"
+	DemographicCity       DemographicDimension = "city"
+)
+
+// ReferenceCategoryMaxCount 1つの属性で登録できる区分の最大数。全国の市区町村を登録できる数にする
+const ReferenceCategoryMaxCount = 2000
+
+// DemographicDimensions 比べられる属性
+var DemographicDimensions = []DemographicDimension{
+	DemographicAgeBand,
+	DemographicGender,
+	DemographicPrefecture,
+	DemographicCity,
+}
+
+// AgeBands 年齢の区分。10歳ごとに分け、19歳以下と70歳以上はまとめる
+var AgeBands = []string{"0-19", "20-29", "30-39", "40-49", "50-59", "60-69", "70-"}
+
+type (
+	// ReferenceDistributionRepository 組織が登録した母集団の構成
+	ReferenceDistributionRepository interface {
+		FindByOrganizationID(ctx context.Context, organizationID shared.UUID[organization.Organization]) ([]ReferenceDistribution, error)
+		// FindByDimension 登録されていない場合はnilを返す
+		FindByDimension(ctx context.Context, organizationID shared.UUID[organization.Organization], dimension DemographicDimension) (*ReferenceDistribution, error)
+		Save(ctx context.Context, distribution *ReferenceDistribution) error
+	}
+
+	// ParticipantDemographicRepository セッションの参加者の属性
+	ParticipantDemographicRepository interface {
+		// FindByTalkSessionID 意見を投稿したか投票したユーザーの復号した属性。属性を登録していないユーザーはnil
+		FindByTalkSessionID(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession]) (map[shared.UUID[user.User]]*user.UserDemographic, error)
+	}
+
+	// ReferenceDistribution 国勢調査などから組織が登録した、属性ごとの母集団の構成
+	ReferenceDistribution struct {
+		OrganizationID shared.UUID[organization.Organization]
+		Dimension      DemographicDimension
+		// Categories 登録した順の区分。割合の合計は1
+		Categories []ReferenceCategory
+		UpdatedBy  *shared.UUID[user.User]
+		UpdatedAt  time.Time
+	}
+
+	ReferenceCategory struct {
+		Category string
+		Share    float64
+	}
+
+	// CategoryComparison 区分ごとの母集団と参加者の割合
+	CategoryComparison struct {
+		Category       string
+		ReferenceShare float64
+		// ParticipantCount 区分に当てはまる参加者の数
+		ParticipantCount int
+		// ParticipantShare 属性を登録した参加者に占める割合
+		ParticipantShare float64
+		// Weight 事後層化の重み。母集団の割合を参加者の割合で割ったもの。参加者がいない区分は0
+		Weight float64
+	}
+
+	// Representativeness 参加者の構成が母集団とどれだけ離れているか
+	Representativeness struct {
+		Dimension DemographicDimension
+		// Categories 母集団の区分を登録した順に並べ、母集団にない区分の参加者を最後に加える
+		Categories []CategoryComparison
+		// ParticipantCount 参加者の数。属性を登録していない参加者も含む
+		ParticipantCount int
+		// UnknownCount 属性を登録していない参加者の数
+		UnknownCount int
+		// TotalVariationDistance 割合の差の絶対値の合計の半分。0で母集団と同じ構成、1で重ならない
+		TotalVariationDistance float64
+		// MaxDeviation 割合の差が最も大きい区分での差の絶対値
+		MaxDeviation float64
+		// ChiSquare 母集団の区分に当てはまる参加者での適合度のχ²値
+		ChiSquare float64
+		// DegreesOfFreedom χ²値の自由度
+		DegreesOfFreedom int
+		// UncoveredShare 参加者がいない区分の母集団での割合の合計。重みを付けても補えない
+		UncoveredShare float64
+	}
+
+	// WeightedVoteRates 事後層化の重みを付けた投票の割合
+	WeightedVoteRates struct {
+		Agree    float64
+		Disagree float64
+		Pass     float64
+	}
+)
+
+// NewDemographicDimension 属性の名前から属性を返す
+func NewDemographicDimension(s string) (DemographicDimension, error) {
+	dimension := DemographicDimension(s)
+	if !slices.Contains(DemographicDimensions, dimension) {
+		return "", messages.InvalidDemographicDimension
+	}
+	return dimension, nil
+}
+
+// AgeBand 年齢の区分
+func AgeBand(age int) string {
+	switch {
+	case age < 20:
+		return AgeBands[0]
+	case age >= 70:
+		return AgeBands[len(AgeBands)-1]
+	}
+	lower := age / 10 * 10
+	return fmt.Sprintf("%d-%d", lower, lower+9)
+}
+
+// DemographicCategory 参加者の属性の区分。属性を登録していない場合は空文字
+func DemographicCategory(ctx context.Context, demographic *user.UserDemographic, dimension DemographicDimension) string {
+	if demographic == nil {
+		return ""
+	}
+	switch dimension {
+	case DemographicAgeBand:
+		if demographic.DateOfBirth() == nil {
+			return ""
+		}
+		return AgeBand(demographic.Age(ctx))
+	case DemographicGender:
+		if demographic.Gender() == nil {
+			return ""
+		}
+		return demographic.Gender().String()
+	case DemographicPrefecture:
+		if demographic.Prefecture() == nil {
+			return ""
+		}
+		return normalizeCategory(*demographic.Prefecture())
+	case DemographicCity:
+		if demographic.City() == nil {
+			return ""
+		}
+		return normalizeCategory(demographic.City().String())
+	}
+	return ""
+}
+
+// normalizeCategory 全角・半角の違いや前後の空白で参加者と母集団の区分がずれないよう揃える
+func normalizeCategory(s string) string {
+	return strings.TrimSpace(norm.NFKC.String(s))
+}
+
+// NewReferenceDistribution 区分ごとの人数または割合から母集団の構成を作る。割合は合計が1になるよう揃える。
+// 区分の名前は参加者の属性と同じように揃え、都道府県は正式な名前だけを受け付ける
+func NewReferenceDistribution(
+	organizationID shared.UUID[organization.Organization],
+	dimension DemographicDimension,
+	categories []ReferenceCategory,
+	updatedBy shared.UUID[user.User],
+	updatedAt time.Time,
+) (*ReferenceDistribution, error) {
+	if !slices.Contains(DemographicDimensions, dimension) {
+		return nil, messages.InvalidDemographicDimension
+	}
+	if len(categories) == 0 || len(categories) > ReferenceCategoryMaxCount {
+		return nil, messages.ReferenceDistributionInvalid
+	}
+
+	total := 0.0
+	seen := make(map[string]struct{}, len(categories))
+	normalized := make([]ReferenceCategory, 0, len(categories))
+	for _, c := range categories {
+		category := normalizeCategory(c.Category)
+		if category == "" || c.Share < 0 || math.IsNaN(c.Share) || math.IsInf(c.Share, 0) {
+			return nil, messages.ReferenceDistributionInvalid
+		}
+		if _, ok := seen[category]; ok {
+			return nil, messages.ReferenceDistributionInvalid
+		}
+		seen[category] = struct{}{}
+		// 参加者の区分と突き合わせられない区分は受け付けない
+		switch dimension {
+		case DemographicAgeBand:
+			if !slices.Contains(AgeBands, category) {
+				return nil, messages.ReferenceDistributionInvalid
+			}
+		case DemographicGender:
+			if user.NewGender(&category) == nil {
+				return nil, messages.ReferenceDistributionInvalid
+			}
+		case DemographicPrefecture:
+			if !user.IsPrefecture(category) {
+				return nil, messages.ReferenceDistributionInvalid
+			}
+		}
+		total += c.Share
+		normalized = append(normalized, ReferenceCategory{Category: category, Share: c.Share})
+	}
+	if total <= 0 {
+		return nil, messages.ReferenceDistributionInvalid
+	}
+	for i := range normalized {
+		normalized[i].Share /= total
+	}
+
+	return &ReferenceDistribution{
+		OrganizationID: organizationID,
+		Dimension:      dimension,
+		Categories:     normalized,
+		UpdatedBy:      &updatedBy,
+		UpdatedAt:      updatedAt,
+	}, nil
+}
+
+// NewRepresentativeness 参加者ごとの区分を母集団の構成と比べる。空文字の区分は属性を登録していない参加者として数える
+func NewRepresentativeness(reference ReferenceDistribution, participants []string) Representativeness {
+	res := Representativeness{
+		Dimension:        reference.Dimension,
+		ParticipantCount: len(participants),
+	}
+
+	counts := make(map[string]int)
+	outside := make([]string, 0)
+	referenced := make(map[string]bool, len(reference.Categories))
+	for _, c := range reference.Categories {
+		referenced[c.Category] = true
+	}
+	for _, category := range participants {
+		if category == "" {
+			res.UnknownCount++
+			continue
+		}
+		if counts[category] == 0 && !referenced[category] {
+			outside = append(outside, category)
+		}
+		counts[category]++
+	}
+	known := res.ParticipantCount - res.UnknownCount
+	slices.Sort(outside)
+
+	res.Categories = make([]CategoryComparison, 0, len(reference.Categories)+len(outside))
+	for _, c := range reference.Categories {
+		res.Categories = append(res.Categories, CategoryComparison{
+			Category:         c.Category,
+			ReferenceShare:   c.Share,
+			ParticipantCount: counts[c.Category],
+		})
+	}
+	// 母集団にない区分の参加者は、母集団の外にいるものとして重みを0にする
+	for _, category := range outside {
+		res.Categories = append(res.Categories, CategoryComparison{
+			Category:         category,
+			ParticipantCount: counts[category],
+		})
+	}
+
+	inReference := 0
+	for _, c := range reference.Categories {
+		inReference += counts[c.Category]
+	}
+	for i := range res.Categories {
+		c := &res.Categories[i]
+		if known > 0 {
+			c.ParticipantShare = float64(c.ParticipantCount) / float64(known)
+		}
+		if c.ParticipantCount > 0 {
+			c.Weight = c.ReferenceShare / c.ParticipantShare
+		} else {
+			res.UncoveredShare += c.ReferenceShare
+		}
+
+		deviation := math.Abs(c.ParticipantShare - c.ReferenceShare)
+		res.TotalVariationDistance += deviation / 2
+		res.MaxDeviation = max(res.MaxDeviation, deviation)
+
+		if c.ReferenceShare > 0 && inReference > 0 {
+			expected := float64(inReference) * c.ReferenceShare
+			diff := float64(c.ParticipantCount) - expected
+			res.ChiSquare += diff * diff / expected
+		}
+	}
+	if known == 0 {
+		// 比べる参加者がいない場合は差を求められない
+		res.TotalVariationDistance = 0
+		res.MaxDeviation = 0
+	}
+	res.DegreesOfFreedom = max(0, len(reference.Categories)-1)
+	return res
+}
+
+// Weight 区分に当てはまる参加者の重み。属性を登録していない参加者は補正せず1にする
+func (r Representativeness) Weight(category string) float64 {
+	if category == "" {
+		return 1
+	}
+	for _, c := range r.Categories {
+		if c.Category == category {
+			return c.Weight
+		}
+	}
+	return 0
+}
+
+// Add 重みを付けて投票を加える
+func (w *WeightedVoteRates) Add(voteType vote.VoteType, weight float64) {
+	switch voteType {
+	case vote.Agree:
+		w.Agree += weight
+	case vote.Disagree:
+		w.Disagree += weight
+	case vote.Pass:
+		w.Pass += weight
+	}
+}
+
+// Normalize 重みの合計が1になるよう割合にする。投票がない場合はすべて0
+func (w WeightedVoteRates) Normalize() WeightedVoteRates {
+	total := w.Agree + w.Disagree + w.Pass
+	if total == 0 {
+		return WeightedVoteRates{}
+	}
+	return WeightedVoteRates{
+		Agree:    w.Agree / total,
+		Disagree: w.Disagree / total,
+		Pass:     w.Pass / total,
+	}
+}
@@ -0,0 +1,153 @@
+package analysis_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/internal/domain/model/vote"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReferenceDistribution(t *testing.T) {
+	orgID := shared.NewUUID[organization.Organization]()
+	userID := shared.NewUUID[user.User]()
+	now := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("人数から割合に揃える", func(t *testing.T) {
+		distribution, err := analysis.NewReferenceDistribution(orgID, analysis.DemographicGender, []analysis.ReferenceCategory{
+			{Category: " 男性 ", Share: 300},
+			{Category: "女性", Share: 100},
+		}, userID, now)
+		require.NoError(t, err)
+
+		assert.Equal(t, []analysis.ReferenceCategory{
+			{Category: "男性", Share: 0.75},
+			{Category: "女性", Share: 0.25},
+		}, distribution.Categories)
+	})
+
+	t.Run("市区町村の名前は参加者の属性と同じように揃える", func(t *testing.T) {
+		distribution, err := analysis.NewReferenceDistribution(orgID, analysis.DemographicCity, []analysis.ReferenceCategory{
+			{Category: "\u3000ＡＢＣ市 ", Share: 1},
+		}, userID, now)
+		require.NoError(t, err)
+
+		assert.Equal(t, "ABC市", distribution.Categories[0].Category)
+	})
+
+	tests := []struct {
+		name       string
+		dimension  analysis.DemographicDimension
+		categories []analysis.ReferenceCategory
+		want       error
+	}{
+		{name: "未定義の属性", dimension: "income", categories: []analysis.ReferenceCategory{{Category: "a", Share: 1}}, want: messages.InvalidDemographicDimension},
+		{name: "区分がない", dimension: analysis.DemographicPrefecture, want: messages.ReferenceDistributionInvalid},
+		{name: "区分が重複している", dimension: analysis.DemographicPrefecture, categories: []analysis.ReferenceCategory{{Category: "東京都", Share: 1}, {Category: "東京都", Share: 2}}, want: messages.ReferenceDistributionInvalid},
+		{name: "負の人数", dimension: analysis.DemographicPrefecture, categories: []analysis.ReferenceCategory{{Category: "東京都", Share: -1}}, want: messages.ReferenceDistributionInvalid},
+		{name: "合計が0", dimension: analysis.DemographicPrefecture, categories: []analysis.ReferenceCategory{{Category: "東京都", Share: 0}}, want: messages.ReferenceDistributionInvalid},
+		{name: "年齢の区分が定義と違う", dimension: analysis.DemographicAgeBand, categories: []analysis.ReferenceCategory{{Category: "20代", Share: 1}}, want: messages.ReferenceDistributionInvalid},
+		{name: "都道府県の名前が正式でない", dimension: analysis.DemographicPrefecture, categories: []analysis.ReferenceCategory{{Category: "東京", Share: 1}}, want: messages.ReferenceDistributionInvalid},
+		{name: "性別の区分が定義と違う", dimension: analysis.DemographicGender, categories: []analysis.ReferenceCategory{{Category: "male", Share: 1}}, want: messages.ReferenceDistributionInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := analysis.NewReferenceDistribution(orgID, tt.dimension, tt.categories, userID, now)
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+}
+
+func TestAgeBand(t *testing.T) {
+	assert.Equal(t, "0-19", analysis.AgeBand(15))
+	assert.Equal(t, "20-29", analysis.AgeBand(20))
+	assert.Equal(t, "60-69", analysis.AgeBand(69))
+	assert.Equal(t, "70-", analysis.AgeBand(85))
+}
+
+func TestDemographicCategory(t *testing.T) {
+	ctx := clock.SetNow(context.Background(), time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC))
+	demographic := user.NewUserDemographic(ctx, shared.NewUUID[user.UserDemographic](), lo.ToPtr(19900401), lo.ToPtr("女性"), lo.ToPtr("渋谷区"), lo.ToPtr("東京都"))
+
+	assert.Equal(t, "30-39", analysis.DemographicCategory(ctx, &demographic, analysis.DemographicAgeBand))
+	assert.Equal(t, "女性", analysis.DemographicCategory(ctx, &demographic, analysis.DemographicGender))
+	assert.Equal(t, "東京都", analysis.DemographicCategory(ctx, &demographic, analysis.DemographicPrefecture))
+	assert.Equal(t, "渋谷区", analysis.DemographicCategory(ctx, &demographic, analysis.DemographicCity))
+	assert.Equal(t, "", analysis.DemographicCategory(ctx, nil, analysis.DemographicGender))
+
+	fullWidth := user.NewUserDemographic(ctx, shared.NewUUID[user.UserDemographic](), nil, nil, lo.ToPtr("ＡＢＣ市\u3000"), nil)
+	assert.Equal(t, "ABC市", analysis.DemographicCategory(ctx, &fullWidth, analysis.DemographicCity))
+}
+
+func TestNewRepresentativeness(t *testing.T) {
+	reference := analysis.ReferenceDistribution{
+		Dimension: analysis.DemographicGender,
+		Categories: []analysis.ReferenceCategory{
+			{Category: "男性", Share: 0.5},
+			{Category: "女性", Share: 0.5},
+		},
+	}
+
+	t.Run("偏りに応じて差と重みを求める", func(t *testing.T) {
+		// 男性3人・女性1人・未登録1人
+		r := analysis.NewRepresentativeness(reference, []string{"男性", "男性", "男性", "女性", ""})
+
+		assert.Equal(t, 5, r.ParticipantCount)
+		assert.Equal(t, 1, r.UnknownCount)
+		require.Len(t, r.Categories, 2)
+		assert.InDelta(t, 0.75, r.Categories[0].ParticipantShare, 1e-9)
+		assert.InDelta(t, 0.5/0.75, r.Categories[0].Weight, 1e-9)
+		assert.InDelta(t, 2, r.Categories[1].Weight, 1e-9)
+		assert.InDelta(t, 0.25, r.TotalVariationDistance, 1e-9)
+		assert.InDelta(t, 0.25, r.MaxDeviation, 1e-9)
+		// 期待値は2人ずつ
+		assert.InDelta(t, 1, r.ChiSquare, 1e-9)
+		assert.Equal(t, 1, r.DegreesOfFreedom)
+		assert.Zero(t, r.UncoveredShare)
+
+		assert.InDelta(t, 2, r.Weight("女性"), 1e-9)
+		assert.Equal(t, 1.0, r.Weight(""))
+	})
+
+	t.Run("母集団にない区分の参加者は重み0で最後に加える", func(t *testing.T) {
+		r := analysis.NewRepresentativeness(reference, []string{"男性", "その他"})
+
+		require.Len(t, r.Categories, 3)
+		assert.Equal(t, "その他", r.Categories[2].Category)
+		assert.Zero(t, r.Weight("その他"))
+		assert.Zero(t, r.Weight("女性"))
+		assert.InDelta(t, 0.5, r.UncoveredShare, 1e-9)
+		assert.InDelta(t, 0.5, r.TotalVariationDistance, 1e-9)
+	})
+
+	t.Run("属性を登録した参加者がいない場合は差を0にする", func(t *testing.T) {
+		r := analysis.NewRepresentativeness(reference, []string{"", ""})
+
+		assert.Equal(t, 2, r.UnknownCount)
+		assert.Zero(t, r.TotalVariationDistance)
+		assert.InDelta(t, 1, r.UncoveredShare, 1e-9)
+	})
+}
+
+func TestWeightedVoteRates(t *testing.T) {
+	var rates analysis.WeightedVoteRates
+	rates.Add(vote.Agree, 2)
+	rates.Add(vote.Disagree, 0.5)
+	rates.Add(vote.Disagree, 0.5)
+
+	got := rates.Normalize()
+	assert.InDelta(t, 2.0/3, got.Agree, 1e-9)
+	assert.InDelta(t, 1.0/3, got.Disagree, 1e-9)
+	assert.Zero(t, got.Pass)
+
+	assert.Equal(t, analysis.WeightedVoteRates{}, analysis.WeightedVoteRates{}.Normalize())
+}
@@ -0,0 +1,98 @@
+package analysis
+
+import (
+	"context"
+	"time"
+
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+)
+
+type (
+	AnalysisScheduleRepository interface {
+		// RecordActivity 分析を待っている投票・意見の数を加算する
+		RecordActivity(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession], voteCount, opinionCount int, occurredAt time.Time) error
+		// FindByTalkSessionID スケジュールがない場合はnilを返す
+		FindByTalkSessionID(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession]) (*AnalysisSchedule, error)
+		// FindDueForUpdate 再計算の条件を満たしたスケジュールを古い順に取得し、トランザクションの間ロックする
+		FindDueForUpdate(ctx context.Context, now time.Time, policy RecomputePolicy, limit int) ([]AnalysisSchedule, error)
+		// FindByTalkSessionIDForUpdate スケジュールがない場合は作成し、トランザクションの間ロックする
+		FindByTalkSessionIDForUpdate(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession], now time.Time) (*AnalysisSchedule, error)
+		// Start 分析の開始を記録する。待っていた投票・意見の数はリセットする
+		Start(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession], startedAt time.Time) error
+		Complete(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession], completedAt time.Time) error
+		// Fail 分析の失敗を記録する。分析を待っている状態に戻し、後で再実行する
+		Fail(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession], failedAt time.Time, reason string) error
+	}
+
+	// AnalysisSchedule 投票・意見に応じた分析の再計算のスケジュール
+	AnalysisSchedule struct {
+		TalkSessionID shared.UUID[talksession.TalkSession]
+		// PendingVoteCount 最後の分析以降の投票数
+		PendingVoteCount int
+		// PendingOpinionCount 最後の分析以降の意見数
+		PendingOpinionCount int
+		// PendingSince 最後の分析以降で最初に投票・意見があった日時
+		PendingSince    *time.Time
+		RunningSince    *time.Time
+		LastCompletedAt *time.Time
+		LastFailedAt    *time.Time
+	}
+
+	// RecomputePolicy 分析を再計算する条件。投票数と経過時間のどちらかを満たせば再計算する
+	RecomputePolicy struct {
+		// VoteThreshold 最後の分析以降の投票がこの数に達したら再計算する
+		VoteThreshold int
+		// MaxDelay 最後の分析以降で最初の投票・意見からこの時間が経ったら、投票が少なくても再計算する
+		MaxDelay time.Duration
+		// RunTimeout 実行中のままこの時間が経った分析は止まったとみなし、再び実行できるようにする
+		RunTimeout time.Duration
+	}
+
+	RecomputeStatus string
+)
+
+const (
+	// RecomputeStatusIdle 分析を待っている投票・意見がない
+	RecomputeStatusIdle RecomputeStatus = "idle"
+	// RecomputeStatusPending 分析を待っている投票・意見がある
+	RecomputeStatusPending RecomputeStatus = "pending"
+	// RecomputeStatusRunning 分析を実行中
+	RecomputeStatusRunning RecomputeStatus = "running"
+)
+
+// IsRunning 分析を実行中か。RunTimeoutを過ぎた分析は実行中とみなさない
+func (s AnalysisSchedule) IsRunning(now time.Time, policy RecomputePolicy) bool {
+	return s.RunningSince != nil && now.Sub(*s.RunningSince) < policy.RunTimeout
+}
+
+// NextRunAt 投票数が足りない場合に再計算する日時。分析を待っている投票・意見がない場合はnil
+func (s AnalysisSchedule) NextRunAt(policy RecomputePolicy) *time.Time {
+	if s.PendingSince == nil {
+		return nil
+	}
+	next := s.PendingSince.Add(policy.MaxDelay)
+	return &next
+}
+
+// IsDue 今すぐ再計算するか。実行中の分析がある場合は、同じセッションの分析を同時に実行しないよう待つ
+func (s AnalysisSchedule) IsDue(now time.Time, policy RecomputePolicy) bool {
+	if s.PendingSince == nil || s.IsRunning(now, policy) {
+		return false
+	}
+	if s.PendingVoteCount >= policy.VoteThreshold {
+		return true
+	}
+	return !now.Before(*s.NextRunAt(policy))
+}
+
+func (s AnalysisSchedule) Status(now time.Time, policy RecomputePolicy) RecomputeStatus {
+	switch {
+	case s.IsRunning(now, policy):
+		return RecomputeStatusRunning
+	case s.PendingSince != nil:
+		return RecomputeStatusPending
+	default:
+		return RecomputeStatusIdle
+	}
+}
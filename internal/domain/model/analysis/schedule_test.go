@@ -0,0 +1,148 @@
+package analysis_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnalysisSchedule_IsDue(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	policy := analysis.RecomputePolicy{
+		VoteThreshold: 10,
+		MaxDelay:      5 * time.Minute,
+		RunTimeout:    10 * time.Minute,
+	}
+
+	tests := []struct {
+		name     string
+		schedule analysis.AnalysisSchedule
+		want     bool
+	}{
+		{
+			name:     "分析を待っている投票・意見がなければ再計算しない",
+			schedule: analysis.AnalysisSchedule{},
+			want:     false,
+		},
+		{
+			name: "投票数が閾値に達したら再計算する",
+			schedule: analysis.AnalysisSchedule{
+				PendingVoteCount: 10,
+				PendingSince:     lo.ToPtr(now.Add(-time.Second)),
+			},
+			want: true,
+		},
+		{
+			name: "投票数が足りなくても最初の投票から一定時間が経ったら再計算する",
+			schedule: analysis.AnalysisSchedule{
+				PendingVoteCount: 1,
+				PendingSince:     lo.ToPtr(now.Add(-5 * time.Minute)),
+			},
+			want: true,
+		},
+		{
+			name: "意見だけでも一定時間が経ったら再計算する",
+			schedule: analysis.AnalysisSchedule{
+				PendingOpinionCount: 1,
+				PendingSince:        lo.ToPtr(now.Add(-6 * time.Minute)),
+			},
+			want: true,
+		},
+		{
+			name: "投票数が足りず時間も経っていなければ待つ",
+			schedule: analysis.AnalysisSchedule{
+				PendingVoteCount: 9,
+				PendingSince:     lo.ToPtr(now.Add(-4 * time.Minute)),
+			},
+			want: false,
+		},
+		{
+			name: "実行中の分析がある場合は待つ",
+			schedule: analysis.AnalysisSchedule{
+				PendingVoteCount: 20,
+				PendingSince:     lo.ToPtr(now.Add(-time.Minute)),
+				RunningSince:     lo.ToPtr(now.Add(-time.Minute)),
+			},
+			want: false,
+		},
+		{
+			name: "止まったとみなした分析は再び実行する",
+			schedule: analysis.AnalysisSchedule{
+				PendingVoteCount: 20,
+				PendingSince:     lo.ToPtr(now.Add(-time.Minute)),
+				RunningSince:     lo.ToPtr(now.Add(-10 * time.Minute)),
+			},
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.schedule.IsDue(now, policy))
+		})
+	}
+}
+
+func TestAnalysisSchedule_Status(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	policy := testRecomputePolicy
+
+	tests := []struct {
+		name          string
+		schedule      analysis.AnalysisSchedule
+		wantStatus    analysis.RecomputeStatus
+		wantNextRunAt *time.Time
+	}{
+		{
+			name: "分析を待っている投票・意見がなければidle",
+			schedule: analysis.AnalysisSchedule{
+				LastCompletedAt: lo.ToPtr(now.Add(-time.Hour)),
+			},
+			wantStatus:    analysis.RecomputeStatusIdle,
+			wantNextRunAt: nil,
+		},
+		{
+			name: "分析を待っている投票があればpending",
+			schedule: analysis.AnalysisSchedule{
+				PendingVoteCount: 3,
+				PendingSince:     lo.ToPtr(now.Add(-time.Minute)),
+			},
+			wantStatus:    analysis.RecomputeStatusPending,
+			wantNextRunAt: lo.ToPtr(now.Add(-time.Minute + policy.MaxDelay)),
+		},
+		{
+			name: "実行中ならpendingよりrunningを優先する",
+			schedule: analysis.AnalysisSchedule{
+				PendingVoteCount: 3,
+				PendingSince:     lo.ToPtr(now.Add(-time.Minute)),
+				RunningSince:     lo.ToPtr(now.Add(-2 * time.Minute)),
+			},
+			wantStatus:    analysis.RecomputeStatusRunning,
+			wantNextRunAt: lo.ToPtr(now.Add(-time.Minute + policy.MaxDelay)),
+		},
+		{
+			name: "止まったとみなした分析はrunningにしない",
+			schedule: analysis.AnalysisSchedule{
+				RunningSince: lo.ToPtr(now.Add(-policy.RunTimeout)),
+			},
+			wantStatus:    analysis.RecomputeStatusIdle,
+			wantNextRunAt: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantStatus, tt.schedule.Status(now, policy))
+			assert.Equal(t, tt.wantNextRunAt, tt.schedule.NextRunAt(policy))
+		})
+	}
+}
+
+var testRecomputePolicy = analysis.RecomputePolicy{
+	VoteThreshold: 10,
+	MaxDelay:      5 * time.Minute,
+	RunTimeout:    10 * time.Minute,
+}
@@ -0,0 +1,72 @@
+package analysis
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/user"
+)
+
+type (
+	AnalysisSnapshotRepository interface {
+		// Capture 現在のグループ分けと代表意見をスナップショットとして保存する
+		// 分析結果がない場合や、前回のスナップショットから変わっていない場合は保存せずnilを返す
+		Capture(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession], createdAt time.Time) (*AnalysisSnapshot, error)
+	}
+
+	// AnalysisSnapshot ある時点の分析結果
+	AnalysisSnapshot struct {
+		AnalysisSnapshotID shared.UUID[AnalysisSnapshot]
+		TalkSessionID      shared.UUID[talksession.TalkSession]
+		UserCount          int
+		GroupCount         int
+		CreatedAt          time.Time
+	}
+
+	// GroupMove スナップショット間でのユーザーのグループの移動
+	GroupMove struct {
+		UserID shared.UUID[user.User]
+		// FromGroupID 移動前のグループ。後のスナップショットで初めて分類された場合はnil
+		FromGroupID *GroupID
+		// ToGroupID 移動後のグループ。後のスナップショットで分類されなくなった場合はnil
+		ToGroupID *GroupID
+	}
+)
+
+// DiffGroupAssignments 2つのスナップショットのグループ分けを比べ、グループが変わったユーザーを返す
+// 結果はユーザーIDの順に並べる
+func DiffGroupAssignments(from, to map[shared.UUID[user.User]]GroupID) []GroupMove {
+	moves := make([]GroupMove, 0)
+	for userID, fromGroupID := range from {
+		toGroupID, ok := to[userID]
+		if ok && toGroupID == fromGroupID {
+			continue
+		}
+		move := GroupMove{
+			UserID:      userID,
+			FromGroupID: &fromGroupID,
+		}
+		if ok {
+			move.ToGroupID = &toGroupID
+		}
+		moves = append(moves, move)
+	}
+	for userID, toGroupID := range to {
+		if _, ok := from[userID]; ok {
+			continue
+		}
+		moves = append(moves, GroupMove{
+			UserID:    userID,
+			ToGroupID: &toGroupID,
+		})
+	}
+
+	slices.SortFunc(moves, func(a, b GroupMove) int {
+		return strings.Compare(a.UserID.String(), b.UserID.String())
+	})
+	return moves
+}
@@ -0,0 +1,73 @@
+package analysis_test
+
+import (
+	"testing"
+
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffGroupAssignments(t *testing.T) {
+	stay := shared.NewUUID[user.User]()
+	moved := shared.NewUUID[user.User]()
+	joined := shared.NewUUID[user.User]()
+	left := shared.NewUUID[user.User]()
+
+	tests := []struct {
+		name string
+		from map[shared.UUID[user.User]]analysis.GroupID
+		to   map[shared.UUID[user.User]]analysis.GroupID
+		want []analysis.GroupMove
+	}{
+		{
+			name: "グループが変わらなければ移動はない",
+			from: map[shared.UUID[user.User]]analysis.GroupID{stay: 0},
+			to:   map[shared.UUID[user.User]]analysis.GroupID{stay: 0},
+			want: []analysis.GroupMove{},
+		},
+		{
+			name: "グループが変わったユーザーを返す",
+			from: map[shared.UUID[user.User]]analysis.GroupID{stay: 0, moved: 0},
+			to:   map[shared.UUID[user.User]]analysis.GroupID{stay: 0, moved: 1},
+			want: []analysis.GroupMove{
+				{UserID: moved, FromGroupID: lo.ToPtr(analysis.GroupID(0)), ToGroupID: lo.ToPtr(analysis.GroupID(1))},
+			},
+		},
+		{
+			name: "後から分類されたユーザーは移動前のグループがない",
+			from: map[shared.UUID[user.User]]analysis.GroupID{},
+			to:   map[shared.UUID[user.User]]analysis.GroupID{joined: 2},
+			want: []analysis.GroupMove{
+				{UserID: joined, ToGroupID: lo.ToPtr(analysis.GroupID(2))},
+			},
+		},
+		{
+			name: "分類されなくなったユーザーは移動後のグループがない",
+			from: map[shared.UUID[user.User]]analysis.GroupID{left: 1},
+			to:   map[shared.UUID[user.User]]analysis.GroupID{},
+			want: []analysis.GroupMove{
+				{UserID: left, FromGroupID: lo.ToPtr(analysis.GroupID(1))},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, analysis.DiffGroupAssignments(tt.from, tt.to))
+		})
+	}
+
+	t.Run("ユーザーIDの順に並べる", func(t *testing.T) {
+		from := map[shared.UUID[user.User]]analysis.GroupID{moved: 0, left: 1}
+		to := map[shared.UUID[user.User]]analysis.GroupID{moved: 1, joined: 0}
+		moves := analysis.DiffGroupAssignments(from, to)
+
+		assert.Len(t, moves, 3)
+		assert.IsIncreasing(t, lo.Map(moves, func(m analysis.GroupMove, _ int) string {
+			return m.UserID.String()
+		}))
+	})
+}
@@ -0,0 +1,176 @@
+package analysis
+
+import (
+	"cmp"
+	"math"
+	"slices"
+	"time"
+
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+)
+
+const (
+	// WordCloudGeneratorLocal ワードクラウドをアプリケーション内で生成する
+	WordCloudGeneratorLocal = "local"
+	// TermFrequencyLimit 全体・グループごとに残す単語の数
+	TermFrequencyLimit = 100
+)
+
+// Tokenizer 意見の本文を単語に分割する
+type Tokenizer interface {
+	// Tokenize 名詞・動詞・形容詞などの内容語を原形で返す
+	Tokenize(text string) []string
+}
+
+// TermDocument 意見1件分の単語。投稿者がグループに属していない場合はGroupIDがnil
+type TermDocument struct {
+	GroupID *GroupID
+	Terms   []string
+}
+
+// TermScore 単語の出現回数と重要度
+type TermScore struct {
+	Term  string  `json:"term"`
+	Count int     `json:"count"`
+	Score float64 `json:"score"`
+}
+
+// GroupTerms グループの意見で特徴的な単語
+type GroupTerms struct {
+	GroupID      GroupID     `json:"groupId"`
+	OpinionCount int         `json:"opinionCount"`
+	Terms        []TermScore `json:"terms"`
+}
+
+// TermFrequencies セッション全体とグループごとの単語の集計
+type TermFrequencies struct {
+	TalkSessionID shared.UUID[talksession.TalkSession] `json:"-"`
+	// WordCloudURL 集計から描画したワードクラウドの画像
+	WordCloudURL string `json:"-"`
+	// Overall セッション全体で出現回数が多い順の単語。Scoreは最も多い単語を1とした割合
+	Overall []TermScore `json:"overall"`
+	// Groups グループごとにTF-IDFが高い順の単語
+	Groups      []GroupTerms `json:"groups"`
+	GeneratedAt time.Time    `json:"-"`
+}
+
+// NewTermFrequencies 意見ごとの単語から、全体の出現回数とグループごとのTF-IDFを求める。
+// TF-IDFはグループの意見をまとめて1つの文書とみなし、他のグループでも使われている単語ほど低くする
+func NewTermFrequencies(
+	talkSessionID shared.UUID[talksession.TalkSession],
+	documents []TermDocument,
+	generatedAt time.Time,
+) *TermFrequencies {
+	overall := make(map[string]int)
+	groupCounts := make(map[GroupID]map[string]int)
+	groupOpinions := make(map[GroupID]int)
+	for _, doc := range documents {
+		for _, term := range doc.Terms {
+			overall[term]++
+		}
+		if doc.GroupID == nil {
+			continue
+		}
+		counts, ok := groupCounts[*doc.GroupID]
+		if !ok {
+			counts = make(map[string]int)
+			groupCounts[*doc.GroupID] = counts
+		}
+		for _, term := range doc.Terms {
+			counts[term]++
+		}
+		groupOpinions[*doc.GroupID]++
+	}
+
+	tf := &TermFrequencies{
+		TalkSessionID: talkSessionID,
+		Overall:       rankOverall(overall),
+		Groups:        make([]GroupTerms, 0, len(groupCounts)),
+		GeneratedAt:   generatedAt,
+	}
+
+	// 単語が使われているグループの数
+	documentFrequency := make(map[string]int)
+	for _, counts := range groupCounts {
+		for term := range counts {
+			documentFrequency[term]++
+		}
+	}
+	groupIDs := make([]GroupID, 0, len(groupCounts))
+	for groupID := range groupCounts {
+		groupIDs = append(groupIDs, groupID)
+	}
+	slices.Sort(groupIDs)
+
+	for _, groupID := range groupIDs {
+		counts := groupCounts[groupID]
+		total := 0
+		for _, count := range counts {
+			total += count
+		}
+		terms := make([]TermScore, 0, len(counts))
+		for term, count := range counts {
+			terms = append(terms, TermScore{
+				Term:  term,
+				Count: count,
+				Score: float64(count) / float64(total) * inverseDocumentFrequency(len(groupCounts), documentFrequency[term]),
+			})
+		}
+		tf.Groups = append(tf.Groups, GroupTerms{
+			GroupID:      groupID,
+			OpinionCount: groupOpinions[groupID],
+			Terms:        topTerms(terms),
+		})
+	}
+	return tf
+}
+
+// inverseDocumentFrequency 全てのグループで使われている単語も0にならないよう平滑化する
+func inverseDocumentFrequency(documents, frequency int) float64 {
+	return math.Log(float64(1+documents)/float64(1+frequency)) + 1
+}
+
+func rankOverall(counts map[string]int) []TermScore {
+	terms := make([]TermScore, 0, len(counts))
+	maxCount := 0
+	for term, count := range counts {
+		terms = append(terms, TermScore{Term: term, Count: count})
+		maxCount = max(maxCount, count)
+	}
+	for i := range terms {
+		terms[i].Score = float64(terms[i].Count) / float64(maxCount)
+	}
+	return topTerms(terms)
+}
+
+// topTerms 重要度が高い順に上位の単語を返す。同じ重要度の場合は出現回数、単語の順
+func topTerms(terms []TermScore) []TermScore {
+	slices.SortFunc(terms, func(a, b TermScore) int {
+		if c := cmp.Compare(b.Score, a.Score); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(b.Count, a.Count); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Term, b.Term)
+	})
+	return terms[:min(len(terms), TermFrequencyLimit)]
+}
+
+// CharacteristicGroup 単語のTF-IDFが最も高いグループ。どのグループでも使われていない場合はfalse
+func (t *TermFrequencies) CharacteristicGroup(term string) (GroupID, bool) {
+	var (
+		found bool
+		best  GroupID
+		score float64
+	)
+	for _, group := range t.Groups {
+		for _, ts := range group.Terms {
+			if ts.Term == term && (!found || ts.Score > score) {
+				found, best, score = true, group.GroupID, ts.Score
+			}
+		}
+	}
+	return best, found
+}
@@ -0,0 +1,56 @@
+package analysis_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTermFrequencies(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	talkSessionID := shared.NewUUID[talksession.TalkSession]()
+	groupA := lo.ToPtr(analysis.GroupIDStrawberry)
+	groupB := lo.ToPtr(analysis.GroupIDLemon)
+
+	tf := analysis.NewTermFrequencies(talkSessionID, []analysis.TermDocument{
+		{GroupID: groupA, Terms: []string{"公園", "ベンチ"}},
+		{GroupID: groupA, Terms: []string{"公園", "遊具"}},
+		{GroupID: groupB, Terms: []string{"公園", "駐車場"}},
+		{GroupID: nil, Terms: []string{"公園"}},
+	}, now)
+
+	t.Run("全体は出現回数が多い順で、最も多い単語を1とする", func(t *testing.T) {
+		assert.Equal(t, analysis.TermScore{Term: "公園", Count: 4, Score: 1}, tf.Overall[0])
+		assert.Equal(t, "ベンチ", tf.Overall[1].Term)
+		assert.InDelta(t, 0.25, tf.Overall[1].Score, 1e-9)
+	})
+
+	t.Run("グループに属していない意見はグループの集計に含めない", func(t *testing.T) {
+		assert.Len(t, tf.Groups, 2)
+		assert.Equal(t, analysis.GroupIDStrawberry, tf.Groups[0].GroupID)
+		assert.Equal(t, 2, tf.Groups[0].OpinionCount)
+		assert.Equal(t, analysis.GroupIDLemon, tf.Groups[1].GroupID)
+		assert.Equal(t, 1, tf.Groups[1].OpinionCount)
+	})
+
+	t.Run("全てのグループで使われている単語はグループの特徴として低くなる", func(t *testing.T) {
+		terms := tf.Groups[1].Terms
+		assert.Equal(t, "駐車場", terms[0].Term)
+		assert.Equal(t, "公園", terms[1].Term)
+		assert.Greater(t, terms[0].Score, terms[1].Score)
+	})
+
+	t.Run("単語のTF-IDFが最も高いグループを返す", func(t *testing.T) {
+		groupID, ok := tf.CharacteristicGroup("遊具")
+		assert.True(t, ok)
+		assert.Equal(t, analysis.GroupIDStrawberry, groupID)
+
+		_, ok = tf.CharacteristicGroup("図書館")
+		assert.False(t, ok)
+	})
+}
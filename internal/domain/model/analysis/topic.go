@@ -0,0 +1,224 @@
+package analysis
+
+import (
+	"cmp"
+	"math"
+	"slices"
+)
+
+const (
+	// TopicMaxCount 意見を分けるトピックの最大数
+	TopicMaxCount = 10
+	// TopicLabelTermCount トピックのラベルに使う単語の数
+	TopicLabelTermCount = 5
+	// topicMaxIterations k-meansの割り当てが収束しない場合に打ち切る回数
+	topicMaxIterations = 50
+)
+
+// Topic 本文の単語が似ている意見のまとまり
+type Topic struct {
+	// Terms トピックで重要な順の単語
+	Terms []string
+	// Members トピックに属する意見の、ClusterTopicsに渡した順での位置
+	Members []int
+}
+
+// TopicCount 意見の数に応じたトピックの数
+func TopicCount(documents int) int {
+	return max(1, min(TopicMaxCount, int(math.Round(math.Sqrt(float64(documents)/2)))))
+}
+
+// ClusterTopics 意見ごとの単語をTF-IDFで重み付けし、コサイン類似度のk-meansでトピックに分ける。
+// 結果が毎回同じになるよう、初期の中心は渡した順で最初の意見から、既存の中心に最も似ていない意見を選んでいく。
+// 単語がない意見はどのトピックにも入れない。トピックは意見が多い順
+func ClusterTopics(documents [][]string) []Topic {
+	vocabulary := make(map[string]int)
+	terms := make([]string, 0)
+	documentFrequency := make([]int, 0)
+	counts := make([]map[int]int, len(documents))
+	for i, doc := range documents {
+		counts[i] = make(map[int]int)
+		for _, term := range doc {
+			index, ok := vocabulary[term]
+			if !ok {
+				index = len(terms)
+				vocabulary[term] = index
+				terms = append(terms, term)
+				documentFrequency = append(documentFrequency, 0)
+			}
+			if counts[i][index] == 0 {
+				documentFrequency[index]++
+			}
+			counts[i][index]++
+		}
+	}
+
+	vectors := make([]map[int]float64, 0, len(documents))
+	positions := make([]int, 0, len(documents))
+	for i, c := range counts {
+		if len(c) == 0 {
+			continue
+		}
+		vector := make(map[int]float64, len(c))
+		for index, count := range c {
+			vector[index] = float64(count) * inverseDocumentFrequency(len(documents), documentFrequency[index])
+		}
+		normalizeSparse(vector)
+		vectors = append(vectors, vector)
+		positions = append(positions, i)
+	}
+	if len(vectors) == 0 {
+		return []Topic{}
+	}
+
+	k := min(TopicCount(len(vectors)), len(vectors))
+	centroids := initialCentroids(vectors, k, len(terms))
+	assignments := make([]int, len(vectors))
+	for iteration := 0; iteration < topicMaxIterations; iteration++ {
+		changed := false
+		for i, vector := range vectors {
+			nearest := nearestCentroid(vector, centroids)
+			if iteration == 0 || nearest != assignments[i] {
+				changed = true
+			}
+			assignments[i] = nearest
+		}
+		if !changed {
+			break
+		}
+		centroids = updateCentroids(vectors, assignments, centroids)
+	}
+
+	topics := make([]Topic, len(centroids))
+	for i, c := range assignments {
+		topics[c].Members = append(topics[c].Members, positions[i])
+	}
+	for i := range topics {
+		topics[i].Terms = topTermsOf(centroids[i], terms)
+	}
+	topics = slices.DeleteFunc(topics, func(t Topic) bool { return len(t.Members) == 0 })
+	slices.SortStableFunc(topics, func(a, b Topic) int {
+		return cmp.Compare(len(b.Members), len(a.Members))
+	})
+	return topics
+}
+
+// initialCentroids 最初の意見から始め、既存の中心との類似度が最も低い意見を順に中心にする
+func initialCentroids(vectors []map[int]float64, k, dimension int) [][]float64 {
+	centroids := [][]float64{toDense(vectors[0], dimension)}
+	// 各意見と最も近い中心との類似度
+	closest := make([]float64, len(vectors))
+	for i, vector := range vectors {
+		closest[i] = dot(vector, centroids[0])
+	}
+	for len(centroids) < k {
+		next := 0
+		for i := range vectors {
+			if closest[i] < closest[next] {
+				next = i
+			}
+		}
+		centroid := toDense(vectors[next], dimension)
+		centroids = append(centroids, centroid)
+		for i, vector := range vectors {
+			closest[i] = max(closest[i], dot(vector, centroid))
+		}
+	}
+	return centroids
+}
+
+func nearestCentroid(vector map[int]float64, centroids [][]float64) int {
+	best, bestSimilarity := 0, math.Inf(-1)
+	for i, centroid := range centroids {
+		if similarity := dot(vector, centroid); similarity > bestSimilarity {
+			best, bestSimilarity = i, similarity
+		}
+	}
+	return best
+}
+
+// updateCentroids 属する意見の平均を正規化して新しい中心にする。意見がなくなった中心はそのまま残す
+func updateCentroids(vectors []map[int]float64, assignments []int, previous [][]float64) [][]float64 {
+	centroids := make([][]float64, len(previous))
+	for i := range centroids {
+		centroids[i] = make([]float64, len(previous[i]))
+	}
+	members := make([]int, len(previous))
+	for i, vector := range vectors {
+		c := assignments[i]
+		members[c]++
+		for index, weight := range vector {
+			centroids[c][index] += weight
+		}
+	}
+	for i := range centroids {
+		if members[i] == 0 {
+			centroids[i] = previous[i]
+			continue
+		}
+		normalizeDense(centroids[i])
+	}
+	return centroids
+}
+
+// topTermsOf 中心での重みが大きい順に単語を返す
+func topTermsOf(centroid []float64, terms []string) []string {
+	indexes := make([]int, 0, len(centroid))
+	for i, weight := range centroid {
+		if weight > 0 {
+			indexes = append(indexes, i)
+		}
+	}
+	slices.SortFunc(indexes, func(a, b int) int {
+		if c := cmp.Compare(centroid[b], centroid[a]); c != 0 {
+			return c
+		}
+		return cmp.Compare(terms[a], terms[b])
+	})
+	res := make([]string, 0, TopicLabelTermCount)
+	for _, i := range indexes[:min(len(indexes), TopicLabelTermCount)] {
+		res = append(res, terms[i])
+	}
+	return res
+}
+
+func dot(vector map[int]float64, dense []float64) float64 {
+	sum := 0.0
+	for index, weight := range vector {
+		sum += weight * dense[index]
+	}
+	return sum
+}
+
+func toDense(vector map[int]float64, dimension int) []float64 {
+	dense := make([]float64, dimension)
+	for index, weight := range vector {
+		dense[index] = weight
+	}
+	return dense
+}
+
+func normalizeSparse(vector map[int]float64) {
+	norm := 0.0
+	for _, weight := range vector {
+		norm += weight * weight
+	}
+	norm = math.Sqrt(norm)
+	for index := range vector {
+		vector[index] /= norm
+	}
+}
+
+func normalizeDense(vector []float64) {
+	norm := 0.0
+	for _, weight := range vector {
+		norm += weight * weight
+	}
+	if norm == 0 {
+		return
+	}
+	norm = math.Sqrt(norm)
+	for i := range vector {
+		vector[i] /= norm
+	}
+}
@@ -0,0 +1,47 @@
+package analysis_test
+
+import (
+	"testing"
+
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTopicCount(t *testing.T) {
+	assert.Equal(t, 1, analysis.TopicCount(0))
+	assert.Equal(t, 1, analysis.TopicCount(2))
+	assert.Equal(t, 2, analysis.TopicCount(8))
+	assert.Equal(t, analysis.TopicMaxCount, analysis.TopicCount(500))
+}
+
+func TestClusterTopics(t *testing.T) {
+	t.Run("単語が似ている意見を同じトピックにまとめる", func(t *testing.T) {
+		topics := analysis.ClusterTopics([][]string{
+			{"公園", "遊具", "子ども"},
+			{"駐車場", "車", "渋滞"},
+			{"公園", "遊具", "ベンチ"},
+			{"渋滞", "車", "信号"},
+			{"公園", "子ども", "ベンチ"},
+			{"駐車場", "渋滞", "バス"},
+			{"公園", "遊具"},
+			{"車", "信号", "バス"},
+		})
+
+		assert.Len(t, topics, 2)
+		assert.ElementsMatch(t, []int{0, 2, 4, 6}, topics[0].Members)
+		assert.ElementsMatch(t, []int{1, 3, 5, 7}, topics[1].Members)
+		assert.Equal(t, "公園", topics[0].Terms[0])
+		assert.LessOrEqual(t, len(topics[0].Terms), analysis.TopicLabelTermCount)
+	})
+
+	t.Run("単語がない意見はどのトピックにも入れない", func(t *testing.T) {
+		topics := analysis.ClusterTopics([][]string{{"公園"}, {}, {"公園", "遊具"}})
+
+		assert.Len(t, topics, 1)
+		assert.Equal(t, []int{0, 2}, topics[0].Members)
+	})
+
+	t.Run("意見がない場合は空", func(t *testing.T) {
+		assert.Empty(t, analysis.ClusterTopics(nil))
+	})
+}
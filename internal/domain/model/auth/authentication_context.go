@@ -24,6 +24,15 @@ type AuthenticationContext struct {
 	OrganizationID   *shared.UUID[organization.Organization]
 	OrganizationCode *string
 	OrganizationRole *organization.OrganizationUserRole
+
+	// APIキーで認証した場合のみ設定される
+	APIKeyID     *shared.UUID[organization.OrganizationAPIKey]
+	APIKeyScopes []organization.APIKeyScope
+}
+
+// APIキーによる認証かを確認
+func (ac *AuthenticationContext) IsAPIKey() bool {
+	return ac.APIKeyID != nil
 }
 
 // 組織コンテキスト内かどうかを確認
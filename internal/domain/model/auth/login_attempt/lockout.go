@@ -0,0 +1,107 @@
+package login_attempt
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// LockoutScope ロックの単位
+type LockoutScope string
+
+const (
+	LockoutScopeAccount LockoutScope = "account"
+	LockoutScopeIP      LockoutScope = "ip"
+)
+
+type LockoutRepository interface {
+	// FindByKey 存在しない場合はnilを返す
+	FindByKey(ctx context.Context, scope LockoutScope, key string) (*Lockout, error)
+	// FindByKeyForUpdate 存在しない場合は失敗0回の状態で作成し、トランザクションが終わるまで行をロックする
+	FindByKeyForUpdate(ctx context.Context, scope LockoutScope, key string) (*Lockout, error)
+	Save(ctx context.Context, lockout Lockout) error
+	Delete(ctx context.Context, scope LockoutScope, key string) error
+}
+
+// LockoutPolicy ロックアウトの閾値
+type LockoutPolicy struct {
+	// ロックするまでに許容する失敗回数
+	MaxFailures int
+	// この期間失敗がなければ失敗回数をリセットする
+	FailureWindow time.Duration
+	// 初回ロック時のロック時間。ロックされるたびに倍になる
+	BaseLockDuration time.Duration
+	// ロック時間の上限。0の場合は上限なし
+	MaxLockDuration time.Duration
+}
+
+// LockDuration lockoutCount回目のロックでのロック時間を返す
+func (p LockoutPolicy) LockDuration(lockoutCount int) time.Duration {
+	if lockoutCount < 1 {
+		return 0
+	}
+
+	d := p.BaseLockDuration
+	for i := 1; i < lockoutCount; i++ {
+		// 上限なしの場合もオーバーフローはさせない
+		if d > math.MaxInt64/2 {
+			return math.MaxInt64
+		}
+		d *= 2
+		if p.MaxLockDuration > 0 && d >= p.MaxLockDuration {
+			return p.MaxLockDuration
+		}
+	}
+	if p.MaxLockDuration > 0 && d > p.MaxLockDuration {
+		return p.MaxLockDuration
+	}
+	return d
+}
+
+// Lockout アカウントまたはIPアドレス単位のログイン失敗状況
+type Lockout struct {
+	Scope        LockoutScope
+	Key          string
+	FailureCount int
+	LockoutCount int
+	LastFailedAt *time.Time
+	LockedUntil  *time.Time
+}
+
+func NewLockout(scope LockoutScope, key string) *Lockout {
+	return &Lockout{
+		Scope: scope,
+		Key:   key,
+	}
+}
+
+// IsLocked nowの時点でロック中か
+func (l *Lockout) IsLocked(now time.Time) bool {
+	return l.LockedUntil != nil && now.Before(*l.LockedUntil)
+}
+
+// RecordFailure 失敗を記録し、今回の失敗でロックされた場合はtrueを返す
+func (l *Lockout) RecordFailure(now time.Time, policy LockoutPolicy) bool {
+	if l.IsLocked(now) {
+		l.LastFailedAt = &now
+		return false
+	}
+
+	// ロックが明けた後、もしくは一定期間失敗がなければカウントし直す
+	if l.LockedUntil != nil || (l.LastFailedAt != nil && now.Sub(*l.LastFailedAt) > policy.FailureWindow) {
+		l.FailureCount = 0
+		l.LockedUntil = nil
+	}
+
+	l.FailureCount++
+	l.LastFailedAt = &now
+
+	if policy.MaxFailures <= 0 || l.FailureCount < policy.MaxFailures {
+		return false
+	}
+
+	l.LockoutCount++
+	lockedUntil := now.Add(policy.LockDuration(l.LockoutCount))
+	l.LockedUntil = &lockedUntil
+	return true
+}
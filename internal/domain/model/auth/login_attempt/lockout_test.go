@@ -0,0 +1,100 @@
+package login_attempt_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/neko-dream/api/internal/domain/model/auth/login_attempt"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLockoutPolicy_LockDuration(t *testing.T) {
+	policy := login_attempt.LockoutPolicy{
+		BaseLockDuration: time.Minute,
+		MaxLockDuration:  10 * time.Minute,
+	}
+
+	tests := []struct {
+		name         string
+		lockoutCount int
+		want         time.Duration
+	}{
+		{name: "ロックされていない場合は0", lockoutCount: 0, want: 0},
+		{name: "初回は基準時間", lockoutCount: 1, want: time.Minute},
+		{name: "2回目は倍になる", lockoutCount: 2, want: 2 * time.Minute},
+		{name: "4回目は8倍になる", lockoutCount: 4, want: 8 * time.Minute},
+		{name: "上限を超えない", lockoutCount: 10, want: 10 * time.Minute},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, policy.LockDuration(tt.lockoutCount))
+		})
+	}
+
+	t.Run("上限が0の場合は上限なし", func(t *testing.T) {
+		uncapped := login_attempt.LockoutPolicy{
+			BaseLockDuration: time.Minute,
+		}
+		assert.Equal(t, time.Minute, uncapped.LockDuration(1))
+		assert.Equal(t, 8*time.Minute, uncapped.LockDuration(4))
+		assert.Equal(t, time.Duration(math.MaxInt64), uncapped.LockDuration(100))
+	})
+}
+
+func TestLockout_RecordFailure(t *testing.T) {
+	policy := login_attempt.LockoutPolicy{
+		MaxFailures:      3,
+		FailureWindow:    15 * time.Minute,
+		BaseLockDuration: time.Minute,
+		MaxLockDuration:  time.Hour,
+	}
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("閾値に達するとロックされる", func(t *testing.T) {
+		lockout := login_attempt.NewLockout(login_attempt.LockoutScopeAccount, "user")
+
+		assert.False(t, lockout.RecordFailure(now, policy))
+		assert.False(t, lockout.RecordFailure(now.Add(time.Second), policy))
+		assert.True(t, lockout.RecordFailure(now.Add(2*time.Second), policy))
+
+		assert.True(t, lockout.IsLocked(now.Add(30*time.Second)))
+		assert.False(t, lockout.IsLocked(now.Add(2*time.Minute)))
+	})
+
+	t.Run("ロック中の失敗ではロック時間が延びない", func(t *testing.T) {
+		lockout := login_attempt.NewLockout(login_attempt.LockoutScopeAccount, "user")
+		for i := range 3 {
+			lockout.RecordFailure(now.Add(time.Duration(i)*time.Second), policy)
+		}
+		lockedUntil := *lockout.LockedUntil
+
+		assert.False(t, lockout.RecordFailure(now.Add(10*time.Second), policy))
+		assert.Equal(t, lockedUntil, *lockout.LockedUntil)
+	})
+
+	t.Run("ロック解除後に再度ロックされるとロック時間が倍になる", func(t *testing.T) {
+		lockout := login_attempt.NewLockout(login_attempt.LockoutScopeAccount, "user")
+		for i := range 3 {
+			lockout.RecordFailure(now.Add(time.Duration(i)*time.Second), policy)
+		}
+
+		after := now.Add(5 * time.Minute)
+		for i := range 3 {
+			lockout.RecordFailure(after.Add(time.Duration(i)*time.Second), policy)
+		}
+
+		assert.Equal(t, 2, lockout.LockoutCount)
+		assert.Equal(t, after.Add(2*time.Second).Add(2*time.Minute), *lockout.LockedUntil)
+	})
+
+	t.Run("一定期間失敗がなければ失敗回数がリセットされる", func(t *testing.T) {
+		lockout := login_attempt.NewLockout(login_attempt.LockoutScopeIP, "192.0.2.1")
+		lockout.RecordFailure(now, policy)
+		lockout.RecordFailure(now.Add(time.Second), policy)
+
+		assert.False(t, lockout.RecordFailure(now.Add(time.Hour), policy))
+		assert.Equal(t, 1, lockout.FailureCount)
+		assert.Nil(t, lockout.LockedUntil)
+	})
+}
@@ -0,0 +1,85 @@
+package login_attempt
+
+import (
+	"context"
+	"time"
+
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+)
+
+// FailureReason ログイン失敗の理由
+type FailureReason string
+
+const (
+	FailureReasonInvalidCredentials FailureReason = "invalid_credentials"
+	FailureReasonAccountLocked      FailureReason = "account_locked"
+	FailureReasonIPLocked           FailureReason = "ip_locked"
+)
+
+type LoginAttemptRepository interface {
+	Create(ctx context.Context, attempt LoginAttempt) error
+}
+
+// LoginAttempt パスワードログインの試行記録。監査用に成功・失敗の両方を保存する
+type LoginAttempt struct {
+	LoginAttemptID shared.UUID[LoginAttempt]
+	// ユーザーが特定できなかった場合はnil
+	UserID        *shared.UUID[user.User]
+	IPAddress     *string
+	UserAgent     *string
+	Succeeded     bool
+	FailureReason *FailureReason
+	AttemptedAt   time.Time
+}
+
+func NewSucceededLoginAttempt(
+	userID shared.UUID[user.User],
+	ipAddress *string,
+	userAgent *string,
+	attemptedAt time.Time,
+) LoginAttempt {
+	return LoginAttempt{
+		LoginAttemptID: shared.NewUUID[LoginAttempt](),
+		UserID:         &userID,
+		IPAddress:      ipAddress,
+		UserAgent:      userAgent,
+		Succeeded:      true,
+		AttemptedAt:    attemptedAt,
+	}
+}
+
+func NewFailedLoginAttempt(
+	userID *shared.UUID[user.User],
+	ipAddress *string,
+	userAgent *string,
+	reason FailureReason,
+	attemptedAt time.Time,
+) LoginAttempt {
+	return LoginAttempt{
+		LoginAttemptID: shared.NewUUID[LoginAttempt](),
+		UserID:         userID,
+		IPAddress:      ipAddress,
+		UserAgent:      userAgent,
+		Succeeded:      false,
+		FailureReason:  &reason,
+		AttemptedAt:    attemptedAt,
+	}
+}
+
+// AttemptSource ログイン試行の送信元
+type AttemptSource struct {
+	IPAddress *string
+	UserAgent *string
+}
+
+type LoginThrottle interface {
+	// CheckIP IPアドレス単位でロックされていればエラーを返す
+	CheckIP(ctx context.Context, source AttemptSource) error
+	// CheckAccount アカウント単位でロックされていればエラーを返す
+	CheckAccount(ctx context.Context, userID shared.UUID[user.User], source AttemptSource) error
+	// RecordFailure 失敗を記録する。今回の失敗でアカウントがロックされた場合はロックの解除日時を返す
+	RecordFailure(ctx context.Context, userID *shared.UUID[user.User], source AttemptSource) (*time.Time, error)
+	// RecordSuccess 成功を記録し、失敗回数をリセットする
+	RecordSuccess(ctx context.Context, userID shared.UUID[user.User], source AttemptSource) error
+}
@@ -0,0 +1,104 @@
+package signing_key
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Status 署名鍵の状態
+type Status string
+
+const (
+	// StatusActive 署名に使用中
+	StatusActive Status = "active"
+	// StatusRetired ローテーション済み。猶予期間中は検証にのみ使用する
+	StatusRetired Status = "retired"
+)
+
+// Algorithm 署名アルゴリズム
+type Algorithm string
+
+const (
+	AlgorithmES256 Algorithm = "ES256"
+	AlgorithmRS256 Algorithm = "RS256"
+)
+
+var (
+	ErrKeyNotFound    = errors.New("署名鍵が見つかりません")
+	ErrAlreadyRetired = errors.New("署名鍵は既にローテーション済みです")
+)
+
+type SigningKeyRepository interface {
+	Create(ctx context.Context, key SigningKey) error
+	Retire(ctx context.Context, key SigningKey) error
+	// FindActiveForUpdate 署名中の鍵を行ロックして取得する。存在しない場合はnilを返す
+	FindActiveForUpdate(ctx context.Context) (*SigningKey, error)
+	// FindVerifiable 署名中の鍵と猶予期間内の鍵を新しい順に返す
+	FindVerifiable(ctx context.Context, now time.Time) ([]SigningKey, error)
+}
+
+// KeyRing 署名鍵の管理。再起動せずに鍵をローテーションできる
+type KeyRing interface {
+	// Rotate 新しい署名鍵を発行し、現在の鍵を猶予期間付きで退役させる
+	Rotate(ctx context.Context) (*SigningKey, error)
+	// VerifiableKeys 検証に使用できる鍵の一覧。JWKSとして公開する
+	VerifiableKeys(ctx context.Context) ([]SigningKey, error)
+}
+
+// SigningKey トークンの署名鍵。KeyIDはJWTヘッダのkidとして使用する
+type SigningKey struct {
+	KeyID     string
+	Algorithm Algorithm
+	// PKCS#8 PEM形式
+	PrivateKeyPEM string
+	// PKIX PEM形式
+	PublicKeyPEM string
+	Status       Status
+	CreatedAt    time.Time
+	RetiredAt    *time.Time
+	VerifyUntil  *time.Time
+}
+
+func NewSigningKey(
+	keyID string,
+	algorithm Algorithm,
+	privateKeyPEM string,
+	publicKeyPEM string,
+	createdAt time.Time,
+) *SigningKey {
+	return &SigningKey{
+		KeyID:         keyID,
+		Algorithm:     algorithm,
+		PrivateKeyPEM: privateKeyPEM,
+		PublicKeyPEM:  publicKeyPEM,
+		Status:        StatusActive,
+		CreatedAt:     createdAt,
+	}
+}
+
+// Retire 鍵を退役させる。gracePeriodの間は検証にのみ使用できる
+func (k *SigningKey) Retire(now time.Time, gracePeriod time.Duration) error {
+	if k.Status == StatusRetired {
+		return ErrAlreadyRetired
+	}
+
+	verifyUntil := now.Add(gracePeriod)
+	k.Status = StatusRetired
+	k.RetiredAt = &now
+	k.VerifyUntil = &verifyUntil
+	return nil
+}
+
+// CanSign 署名に使用できるか
+func (k *SigningKey) CanSign() bool {
+	return k.Status == StatusActive
+}
+
+// CanVerify nowの時点で検証に使用できるか
+func (k *SigningKey) CanVerify(now time.Time) bool {
+	if k.Status == StatusActive {
+		return true
+	}
+	return k.VerifyUntil != nil && now.Before(*k.VerifyUntil)
+}
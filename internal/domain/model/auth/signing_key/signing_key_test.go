@@ -0,0 +1,78 @@
+package signing_key_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/neko-dream/api/internal/domain/model/auth/signing_key"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSigningKey_Retire(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	grace := 24 * time.Hour
+
+	t.Run("退役すると署名には使えず猶予期間中のみ検証できる", func(t *testing.T) {
+		key := signing_key.NewSigningKey("kid", signing_key.AlgorithmES256, "private", "public", now)
+		require.True(t, key.CanSign())
+
+		require.NoError(t, key.Retire(now, grace))
+
+		assert.Equal(t, signing_key.StatusRetired, key.Status)
+		assert.False(t, key.CanSign())
+		assert.True(t, key.CanVerify(now.Add(grace-time.Second)))
+		assert.False(t, key.CanVerify(now.Add(grace)))
+	})
+
+	t.Run("退役済みの鍵は再度退役できない", func(t *testing.T) {
+		key := signing_key.NewSigningKey("kid", signing_key.AlgorithmES256, "private", "public", now)
+		require.NoError(t, key.Retire(now, grace))
+
+		assert.ErrorIs(t, key.Retire(now.Add(time.Hour), grace), signing_key.ErrAlreadyRetired)
+		assert.Equal(t, now.Add(grace), *key.VerifyUntil)
+	})
+}
+
+func TestSigningKey_CanVerify(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		key  signing_key.SigningKey
+		want bool
+	}{
+		{
+			name: "署名中の鍵は検証できる",
+			key:  signing_key.SigningKey{Status: signing_key.StatusActive},
+			want: true,
+		},
+		{
+			name: "猶予期間内の鍵は検証できる",
+			key: signing_key.SigningKey{
+				Status:      signing_key.StatusRetired,
+				VerifyUntil: lo.ToPtr(now.Add(time.Hour)),
+			},
+			want: true,
+		},
+		{
+			name: "猶予期間を過ぎた鍵は検証できない",
+			key: signing_key.SigningKey{
+				Status:      signing_key.StatusRetired,
+				VerifyUntil: lo.ToPtr(now.Add(-time.Hour)),
+			},
+			want: false,
+		},
+		{
+			name: "期限のない退役済みの鍵は検証できない",
+			key:  signing_key.SigningKey{Status: signing_key.StatusRetired},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.key.CanVerify(now))
+		})
+	}
+}
@@ -56,6 +56,21 @@ func (mr *MockOrganizationRepositoryMockRecorder) Create(ctx, org any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOrganizationRepository)(nil).Create), ctx, org)
 }
 
+// FindAncestorIDs mocks base method.
+func (m *MockOrganizationRepository) FindAncestorIDs(ctx context.Context, id shared.UUID[organization.Organization]) ([]shared.UUID[organization.Organization], error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAncestorIDs", ctx, id)
+	ret0, _ := ret[0].([]shared.UUID[organization.Organization])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAncestorIDs indicates an expected call of FindAncestorIDs.
+func (mr *MockOrganizationRepositoryMockRecorder) FindAncestorIDs(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAncestorIDs", reflect.TypeOf((*MockOrganizationRepository)(nil).FindAncestorIDs), ctx, id)
+}
+
 // FindByCode mocks base method.
 func (m *MockOrganizationRepository) FindByCode(ctx context.Context, code string) (*organization.Organization, error) {
 	m.ctrl.T.Helper()
@@ -116,6 +131,36 @@ func (mr *MockOrganizationRepositoryMockRecorder) FindByName(ctx, name any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByName", reflect.TypeOf((*MockOrganizationRepository)(nil).FindByName), ctx, name)
 }
 
+// FindChildren mocks base method.
+func (m *MockOrganizationRepository) FindChildren(ctx context.Context, parentID shared.UUID[organization.Organization]) ([]*organization.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindChildren", ctx, parentID)
+	ret0, _ := ret[0].([]*organization.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindChildren indicates an expected call of FindChildren.
+func (mr *MockOrganizationRepositoryMockRecorder) FindChildren(ctx, parentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindChildren", reflect.TypeOf((*MockOrganizationRepository)(nil).FindChildren), ctx, parentID)
+}
+
+// FindDescendants mocks base method.
+func (m *MockOrganizationRepository) FindDescendants(ctx context.Context, id shared.UUID[organization.Organization]) ([]*organization.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDescendants", ctx, id)
+	ret0, _ := ret[0].([]*organization.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDescendants indicates an expected call of FindDescendants.
+func (mr *MockOrganizationRepositoryMockRecorder) FindDescendants(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDescendants", reflect.TypeOf((*MockOrganizationRepository)(nil).FindDescendants), ctx, id)
+}
+
 // Update mocks base method.
 func (m *MockOrganizationRepository) Update(ctx context.Context, org *organization.Organization) error {
 	m.ctrl.T.Helper()
@@ -57,6 +57,20 @@ func (mr *MockOrganizationUserRepositoryMockRecorder) Create(ctx, orgUser any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOrganizationUserRepository)(nil).Create), ctx, orgUser)
 }
 
+// Delete mocks base method.
+func (m *MockOrganizationUserRepository) Delete(ctx context.Context, orgID shared.UUID[organization.Organization], userID shared.UUID[user.User]) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, orgID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockOrganizationUserRepositoryMockRecorder) Delete(ctx, orgID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockOrganizationUserRepository)(nil).Delete), ctx, orgID, userID)
+}
+
 // FindByOrganizationID mocks base method.
 func (m *MockOrganizationUserRepository) FindByOrganizationID(ctx context.Context, orgID shared.UUID[organization.Organization]) ([]*organization.OrganizationUser, error) {
 	m.ctrl.T.Helper()
@@ -101,3 +115,47 @@ func (mr *MockOrganizationUserRepositoryMockRecorder) FindByUserID(ctx, userID a
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserID", reflect.TypeOf((*MockOrganizationUserRepository)(nil).FindByUserID), ctx, userID)
 }
+
+// FindEffectiveByOrganizationIDAndUserID mocks base method.
+func (m *MockOrganizationUserRepository) FindEffectiveByOrganizationIDAndUserID(ctx context.Context, orgID shared.UUID[organization.Organization], userID shared.UUID[user.User]) (*organization.OrganizationUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindEffectiveByOrganizationIDAndUserID", ctx, orgID, userID)
+	ret0, _ := ret[0].(*organization.OrganizationUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindEffectiveByOrganizationIDAndUserID indicates an expected call of FindEffectiveByOrganizationIDAndUserID.
+func (mr *MockOrganizationUserRepositoryMockRecorder) FindEffectiveByOrganizationIDAndUserID(ctx, orgID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindEffectiveByOrganizationIDAndUserID", reflect.TypeOf((*MockOrganizationUserRepository)(nil).FindEffectiveByOrganizationIDAndUserID), ctx, orgID, userID)
+}
+
+// FindOwnersForUpdate mocks base method.
+func (m *MockOrganizationUserRepository) FindOwnersForUpdate(ctx context.Context, orgID shared.UUID[organization.Organization]) ([]*organization.OrganizationUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOwnersForUpdate", ctx, orgID)
+	ret0, _ := ret[0].([]*organization.OrganizationUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOwnersForUpdate indicates an expected call of FindOwnersForUpdate.
+func (mr *MockOrganizationUserRepositoryMockRecorder) FindOwnersForUpdate(ctx, orgID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOwnersForUpdate", reflect.TypeOf((*MockOrganizationUserRepository)(nil).FindOwnersForUpdate), ctx, orgID)
+}
+
+// Update mocks base method.
+func (m *MockOrganizationUserRepository) Update(ctx context.Context, orgUser organization.OrganizationUser) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, orgUser)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockOrganizationUserRepositoryMockRecorder) Update(ctx, orgUser any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockOrganizationUserRepository)(nil).Update), ctx, orgUser)
+}
@@ -16,6 +16,10 @@ const (
 	PushNotificationTypeNewTalkSession PushNotificationType = "new_talk_session"
 	// PushNotificationTypeTalkSessionEnd セッション終了
 	PushNotificationTypeTalkSessionEnd PushNotificationType = "talk_session_end"
+	// PushNotificationTypeOwnershipTransfer オーナー権限の譲渡依頼・承諾・辞退
+	PushNotificationTypeOwnershipTransfer PushNotificationType = "ownership_transfer"
+	// PushNotificationTypeAnalysisReportReady 分析レポートの完成
+	PushNotificationTypeAnalysisReportReady PushNotificationType = "analysis_report_ready"
 )
 
 type PushNotification struct {
@@ -0,0 +1,31 @@
+package opinion
+
+import (
+	"github.com/neko-dream/api/internal/domain/model/event"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/user"
+)
+
+const (
+	EventTypeOpinionSubmitted event.EventType = "opinion.submitted"
+)
+
+// OpinionSubmittedEvent 意見の投稿のイベント。分析の再計算に使う
+type OpinionSubmittedEvent struct {
+	event.BaseEvent
+	OpinionID       shared.UUID[Opinion]                 `json:"opinion_id"`
+	TalkSessionID   shared.UUID[talksession.TalkSession] `json:"talk_session_id"`
+	UserID          shared.UUID[user.User]               `json:"user_id"`
+	ParentOpinionID *shared.UUID[Opinion]                `json:"parent_opinion_id,omitempty"`
+}
+
+func NewOpinionSubmittedEvent(op *Opinion) *OpinionSubmittedEvent {
+	return &OpinionSubmittedEvent{
+		BaseEvent:       event.NewBaseEvent(EventTypeOpinionSubmitted, op.OpinionID().String(), "Opinion"),
+		OpinionID:       op.OpinionID(),
+		TalkSessionID:   op.TalkSessionID(),
+		UserID:          op.UserID(),
+		ParentOpinionID: op.ParentOpinionID(),
+	}
+}
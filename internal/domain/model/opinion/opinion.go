@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/neko-dream/api/internal/domain/messages"
 	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/event"
 	"github.com/neko-dream/api/internal/domain/model/shared"
 	"github.com/neko-dream/api/internal/domain/model/talksession"
 	"github.com/neko-dream/api/internal/domain/model/user"
@@ -17,8 +18,12 @@ import (
 type (
 	OpinionRepository interface {
 		Create(context.Context, Opinion) error
+		// Update 編集・削除した意見を保存する
+		Update(context.Context, Opinion) error
 		FindByID(context.Context, shared.UUID[Opinion]) (*Opinion, error)
 		FindByParentID(context.Context, shared.UUID[Opinion]) ([]Opinion, error)
+		// FindSeedsByTalkSessionID セッションのシード意見を投稿順に取得
+		FindSeedsByTalkSessionID(context.Context, shared.UUID[talksession.TalkSession]) ([]Opinion, error)
 		// FindByTalkSessionWithoutVote まだユーザーが投票していない意見をランダムに取得
 		FindByTalkSessionWithoutVote(
 			ctx context.Context,
@@ -44,6 +49,10 @@ type (
 		opinions          []Opinion
 		referenceURL      *string
 		referenceImageURL *string
+		revision          int
+		editedAt          *time.Time
+		deletedAt         *time.Time
+		event.EventRecorder
 	}
 )
 
@@ -77,9 +86,15 @@ func NewOpinion(
 		createdAt:       createdAt,
 		referenceURL:    referenceURL,
 		opinions:        []Opinion{},
+		revision:        1,
 	}, nil
 }
 
+// Submit 意見が投稿されたことを記録する。保存時に投稿イベントとして残す
+func (o *Opinion) Submit() {
+	o.RecordEvent(NewOpinionSubmittedEvent(o))
+}
+
 func (o *Opinion) Reply(opinion Opinion) {
 	o.opinions = append(o.opinions, opinion)
 }
@@ -131,6 +146,31 @@ func (o *Opinion) ChangeReferenceImageURL(url *string) {
 	o.referenceImageURL = url
 }
 
+// Revision 編集のたびに1ずつ増える版番号
+func (o *Opinion) Revision() int {
+	return o.revision
+}
+
+func (o *Opinion) EditedAt() *time.Time {
+	return o.editedAt
+}
+
+func (o *Opinion) DeletedAt() *time.Time {
+	return o.deletedAt
+}
+
+// IsDeleted 投稿者により削除されているか
+func (o *Opinion) IsDeleted() bool {
+	return o.deletedAt != nil
+}
+
+// RestoreEditState DBから取得した編集・削除の状態を復元する
+func (o *Opinion) RestoreEditState(revision int, editedAt *time.Time, deletedAt *time.Time) {
+	o.revision = revision
+	o.editedAt = editedAt
+	o.deletedAt = deletedAt
+}
+
 func (o *Opinion) SetSeed() {
 	o.userID = SeedUserID
 }
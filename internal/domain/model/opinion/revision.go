@@ -0,0 +1,201 @@
+package opinion
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/samber/lo"
+)
+
+type (
+	OpinionRevisionRepository interface {
+		Create(context.Context, OpinionRevision) error
+	}
+
+	// EditPolicy 投稿者が意見を編集できる条件
+	EditPolicy struct {
+		// GracePeriod 投稿してから編集できる期間
+		GracePeriod time.Duration
+		// MaxVotes 編集できる投稿者以外の投票数の上限
+		MaxVotes int
+	}
+
+	// OpinionRevision 編集される前の意見の内容
+	OpinionRevision struct {
+		opinionID    shared.UUID[Opinion]
+		revision     int
+		title        *string
+		content      string
+		referenceURL *string
+		voteCount    int
+		votesReset   bool
+		createdAt    time.Time
+		replacedAt   time.Time
+	}
+)
+
+func NewOpinionRevision(
+	opinionID shared.UUID[Opinion],
+	revision int,
+	title *string,
+	content string,
+	referenceURL *string,
+	voteCount int,
+	votesReset bool,
+	createdAt time.Time,
+	replacedAt time.Time,
+) *OpinionRevision {
+	return &OpinionRevision{
+		opinionID:    opinionID,
+		revision:     revision,
+		title:        title,
+		content:      content,
+		referenceURL: referenceURL,
+		voteCount:    voteCount,
+		votesReset:   votesReset,
+		createdAt:    createdAt,
+		replacedAt:   replacedAt,
+	}
+}
+
+// Edit 投稿者が意見を編集し、編集前の内容を返す
+// voteCountは投稿者以外の投票数。内容が大きく変わった場合、返す版のVotesResetがtrueになるので、呼び出し側で投票をリセットする
+func (o *Opinion) Edit(
+	userID shared.UUID[user.User],
+	title *string,
+	content string,
+	referenceURL *string,
+	voteCount int,
+	policy EditPolicy,
+	now time.Time,
+) (*OpinionRevision, error) {
+	if o.IsDeleted() {
+		return nil, messages.OpinionAlreadyDeleted
+	}
+	if o.userID != userID {
+		return nil, messages.OpinionNotAuthor
+	}
+	if now.After(o.createdAt.Add(policy.GracePeriod)) {
+		return nil, messages.OpinionEditWindowClosed
+	}
+	if voteCount > policy.MaxVotes {
+		return nil, messages.OpinionTooManyVotesToEdit
+	}
+
+	if utf8.RuneCountInString(content) > 140 || utf8.RuneCountInString(content) < 5 {
+		return nil, messages.OpinionContentBadLengthForUpdate
+	}
+	if title != nil && (utf8.RuneCountInString(*title) > 50 || utf8.RuneCountInString(*title) < 5) {
+		return nil, messages.OpinionTitleBadLength
+	}
+	if lo.FromPtr(o.title) == lo.FromPtr(title) && o.content == content && lo.FromPtr(o.referenceURL) == lo.FromPtr(referenceURL) {
+		return nil, messages.OpinionNotChanged
+	}
+
+	effectiveAt := o.createdAt
+	if o.editedAt != nil {
+		effectiveAt = *o.editedAt
+	}
+	revision := NewOpinionRevision(
+		o.opinionID,
+		o.revision,
+		o.title,
+		o.content,
+		o.referenceURL,
+		voteCount,
+		voteCount > 0 && IsSubstantiveEdit(lo.FromPtr(o.title)+"\n"+o.content, lo.FromPtr(title)+"\n"+content),
+		effectiveAt,
+		now,
+	)
+
+	o.title = title
+	o.content = content
+	o.referenceURL = referenceURL
+	o.revision++
+	o.editedAt = &now
+	return revision, nil
+}
+
+// Delete 投稿者が意見を削除する。削除はいつでもできる
+func (o *Opinion) Delete(userID shared.UUID[user.User], now time.Time) error {
+	if o.userID != userID {
+		return messages.OpinionNotAuthor
+	}
+	if o.IsDeleted() {
+		return messages.OpinionAlreadyDeleted
+	}
+	o.deletedAt = &now
+	return nil
+}
+
+// IsSubstantiveEdit 誤字の修正程度を超えて内容が変わったか
+// 変更された文字数が3文字か元の文字数の1割のうち大きい方を超えると、内容が変わったとみなす
+func IsSubstantiveEdit(before, after string) bool {
+	threshold := max(3, utf8.RuneCountInString(before)/10)
+	return editDistance([]rune(before), []rune(after)) > threshold
+}
+
+// editDistance 文字単位のレーベンシュタイン距離
+func editDistance(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+func (r *OpinionRevision) OpinionID() shared.UUID[Opinion] {
+	return r.opinionID
+}
+
+func (r *OpinionRevision) Revision() int {
+	return r.revision
+}
+
+func (r *OpinionRevision) Title() *string {
+	return r.title
+}
+
+func (r *OpinionRevision) Content() string {
+	return r.content
+}
+
+func (r *OpinionRevision) ReferenceURL() *string {
+	return r.referenceURL
+}
+
+// VoteCount 編集された時点での投稿者以外の投票数
+func (r *OpinionRevision) VoteCount() int {
+	return r.voteCount
+}
+
+// VotesReset この版への投票をリセットしたか
+func (r *OpinionRevision) VotesReset() bool {
+	return r.votesReset
+}
+
+// CreatedAt この版の内容になった日時
+func (r *OpinionRevision) CreatedAt() time.Time {
+	return r.createdAt
+}
+
+// ReplacedAt 次の版に編集された日時
+func (r *OpinionRevision) ReplacedAt() time.Time {
+	return r.replacedAt
+}
@@ -0,0 +1,200 @@
+package opinion_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/opinion"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newEditableOpinion(t *testing.T, userID shared.UUID[user.User], createdAt time.Time) *opinion.Opinion {
+	t.Helper()
+	op, err := opinion.NewOpinion(
+		shared.NewUUID[opinion.Opinion](),
+		shared.NewUUID[talksession.TalkSession](),
+		userID,
+		nil,
+		lo.ToPtr("元のタイトル"),
+		"駅前の駐輪場を増やしてほしい",
+		createdAt,
+		nil,
+	)
+	require.NoError(t, err)
+	return op
+}
+
+func TestOpinion_Edit(t *testing.T) {
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	authorID := shared.NewUUID[user.User]()
+	policy := opinion.EditPolicy{GracePeriod: 30 * time.Minute, MaxVotes: 5}
+
+	tests := []struct {
+		name      string
+		userID    shared.UUID[user.User]
+		title     *string
+		content   string
+		voteCount int
+		at        time.Time
+		prepare   func(op *opinion.Opinion)
+		wantErr   error
+	}{
+		{
+			name:    "投稿者以外は編集できない",
+			userID:  shared.NewUUID[user.User](),
+			title:   lo.ToPtr("元のタイトル"),
+			content: "駅前の駐輪場を増やしてほしいです",
+			at:      createdAt.Add(time.Minute),
+			wantErr: messages.OpinionNotAuthor,
+		},
+		{
+			name:    "編集できる期間を過ぎると編集できない",
+			userID:  authorID,
+			title:   lo.ToPtr("元のタイトル"),
+			content: "駅前の駐輪場を増やしてほしいです",
+			at:      createdAt.Add(policy.GracePeriod + time.Second),
+			wantErr: messages.OpinionEditWindowClosed,
+		},
+		{
+			name:      "投票数が上限を超えると編集できない",
+			userID:    authorID,
+			title:     lo.ToPtr("元のタイトル"),
+			content:   "駅前の駐輪場を増やしてほしいです",
+			voteCount: policy.MaxVotes + 1,
+			at:        createdAt.Add(time.Minute),
+			wantErr:   messages.OpinionTooManyVotesToEdit,
+		},
+		{
+			name:    "内容が短すぎると編集できない",
+			userID:  authorID,
+			title:   lo.ToPtr("元のタイトル"),
+			content: "短い",
+			at:      createdAt.Add(time.Minute),
+			wantErr: messages.OpinionContentBadLengthForUpdate,
+		},
+		{
+			name:    "変更がなければ編集できない",
+			userID:  authorID,
+			title:   lo.ToPtr("元のタイトル"),
+			content: "駅前の駐輪場を増やしてほしい",
+			at:      createdAt.Add(time.Minute),
+			wantErr: messages.OpinionNotChanged,
+		},
+		{
+			name:    "削除した意見は編集できない",
+			userID:  authorID,
+			title:   lo.ToPtr("元のタイトル"),
+			content: "駅前の駐輪場を増やしてほしいです",
+			at:      createdAt.Add(time.Minute),
+			prepare: func(op *opinion.Opinion) {
+				require.NoError(t, op.Delete(authorID, createdAt))
+			},
+			wantErr: messages.OpinionAlreadyDeleted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			op := newEditableOpinion(t, authorID, createdAt)
+			if tt.prepare != nil {
+				tt.prepare(op)
+			}
+
+			_, err := op.Edit(tt.userID, tt.title, tt.content, nil, tt.voteCount, policy, tt.at)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, 1, op.Revision())
+		})
+	}
+
+	t.Run("編集前の内容が版として残り、版番号が上がる", func(t *testing.T) {
+		op := newEditableOpinion(t, authorID, createdAt)
+		firstEdit := createdAt.Add(time.Minute)
+		revision, err := op.Edit(authorID, lo.ToPtr("元のタイトル"), "駅前の駐輪場を増やしてほしいです", nil, 0, policy, firstEdit)
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, revision.Revision())
+		assert.Equal(t, "駅前の駐輪場を増やしてほしい", revision.Content())
+		assert.Equal(t, createdAt, revision.CreatedAt())
+		assert.Equal(t, firstEdit, revision.ReplacedAt())
+		assert.False(t, revision.VotesReset())
+		assert.Equal(t, 2, op.Revision())
+		assert.Equal(t, "駅前の駐輪場を増やしてほしいです", op.Content())
+		assert.Equal(t, firstEdit, *op.EditedAt())
+
+		secondEdit := createdAt.Add(2 * time.Minute)
+		revision, err = op.Edit(authorID, lo.ToPtr("元のタイトル"), "駅前の駐輪場を増やしてください", nil, 0, policy, secondEdit)
+		require.NoError(t, err)
+		assert.Equal(t, 2, revision.Revision())
+		assert.Equal(t, firstEdit, revision.CreatedAt())
+		assert.Equal(t, 3, op.Revision())
+	})
+
+	t.Run("投票がある意見の内容が大きく変わると投票をリセットする", func(t *testing.T) {
+		op := newEditableOpinion(t, authorID, createdAt)
+		revision, err := op.Edit(authorID, lo.ToPtr("元のタイトル"), "公園の遊具を新しくしてほしい", nil, 2, policy, createdAt.Add(time.Minute))
+		require.NoError(t, err)
+		assert.True(t, revision.VotesReset())
+		assert.Equal(t, 2, revision.VoteCount())
+	})
+
+	t.Run("誤字の修正では投票をリセットしない", func(t *testing.T) {
+		op := newEditableOpinion(t, authorID, createdAt)
+		revision, err := op.Edit(authorID, lo.ToPtr("元のタイトル"), "駅前の駐輪場を増やして欲しい", nil, 2, policy, createdAt.Add(time.Minute))
+		require.NoError(t, err)
+		assert.False(t, revision.VotesReset())
+	})
+}
+
+func TestOpinion_Delete(t *testing.T) {
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	authorID := shared.NewUUID[user.User]()
+	op := newEditableOpinion(t, authorID, createdAt)
+
+	// 削除は編集できる期間を過ぎてもできる
+	deletedAt := createdAt.Add(30 * 24 * time.Hour)
+	assert.ErrorIs(t, op.Delete(shared.NewUUID[user.User](), deletedAt), messages.OpinionNotAuthor)
+	require.NoError(t, op.Delete(authorID, deletedAt))
+	assert.True(t, op.IsDeleted())
+	assert.Equal(t, deletedAt, *op.DeletedAt())
+	assert.ErrorIs(t, op.Delete(authorID, deletedAt), messages.OpinionAlreadyDeleted)
+}
+
+func TestIsSubstantiveEdit(t *testing.T) {
+	tests := []struct {
+		name   string
+		before string
+		after  string
+		want   bool
+	}{
+		{
+			name:   "数文字の修正は内容の変更とみなさない",
+			before: "駅前の駐輪場を増やしてほしい",
+			after:  "駅前の駐輪場を増やして欲しい",
+			want:   false,
+		},
+		{
+			name:   "主張が変わる修正は内容の変更とみなす",
+			before: "駅前の駐輪場を増やしてほしい",
+			after:  "駅前の駐輪場を減らして広場にしてほしい",
+			want:   true,
+		},
+		{
+			name:   "長い文章では1割までの修正は内容の変更とみなさない",
+			before: "市役所の窓口の受付時間を平日の夜と土曜日の午前中にも広げて、働いている人でも手続きができるようにしてほしいと考えています",
+			after:  "市役所の窓口の受付時間を平日の夜間と土曜日の午前にも広げて、働いている人でも手続きができるようにしてほしいと思っています",
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, opinion.IsSubstantiveEdit(tt.before, tt.after))
+		})
+	}
+}
@@ -20,6 +20,13 @@ type OrganizationRepository interface {
 	FindByName(ctx context.Context, name string) (*Organization, error)
 	FindByCode(ctx context.Context, code string) (*Organization, error)
 
+	// 組織の階層
+	FindChildren(ctx context.Context, parentID shared.UUID[Organization]) ([]*Organization, error)
+	// FindAncestorIDs 祖先の組織IDを近い順に返す（自身は含まない）
+	FindAncestorIDs(ctx context.Context, id shared.UUID[Organization]) ([]shared.UUID[Organization], error)
+	// FindDescendants 子孫の組織を返す（自身は含まない）
+	FindDescendants(ctx context.Context, id shared.UUID[Organization]) ([]*Organization, error)
+
 	// 組織の作成・更新・削除
 	Create(ctx context.Context, org *Organization) error
 	Update(ctx context.Context, org *Organization) error
@@ -40,6 +47,8 @@ type Organization struct {
 	Code             string
 	IconURL          *string
 	OwnerID          shared.UUID[user.User]
+	// ParentOrganizationID 親組織。最上位の組織はnil
+	ParentOrganizationID *shared.UUID[Organization]
 }
 
 func NewOrganization(
@@ -60,6 +69,11 @@ func NewOrganization(
 	}
 }
 
+// ChangeOwner オーナーを変更する。譲渡の承諾時に使う
+func (o *Organization) ChangeOwner(ownerID shared.UUID[user.User]) {
+	o.OwnerID = ownerID
+}
+
 // CanChangeRole はユーザーが他のユーザーのロールを変更できるかを判断するのじゃ
 func (o *Organization) CanChangeRole(currentUserRole OrganizationUserRole, targetRole OrganizationUserRole) bool {
 	// Admin以上の権限を持ち、かつ自分の権限以下のロールにのみ変更可能
@@ -0,0 +1,251 @@
+package organization
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+)
+
+// APIKeyScope APIキーに許可する操作の範囲
+type APIKeyScope string
+
+const (
+	// APIKeyScopeSessionsRead セッションの閲覧
+	APIKeyScopeSessionsRead APIKeyScope = "sessions:read"
+	// APIKeyScopeSessionsWrite セッションの作成・編集
+	APIKeyScopeSessionsWrite APIKeyScope = "sessions:write"
+	// APIKeyScopeResultsExport 分析結果・レポートの取得
+	APIKeyScopeResultsExport APIKeyScope = "results:export"
+)
+
+const (
+	// APIKeyPrefix 発行するAPIキーの接頭辞
+	APIKeyPrefix = "khk_"
+	// apiKeySecretLength 接頭辞を除いたキー本体の文字数
+	apiKeySecretLength = 26
+	// apiKeyDisplayPrefixLength 一覧表示用に保持する先頭の文字数
+	apiKeyDisplayPrefixLength = 12
+	// apiKeyLastUsedResolution 最終使用日時を更新する最短間隔
+	apiKeyLastUsedResolution = time.Minute
+)
+
+var (
+	ErrInvalidAPIKeyScope   = errors.New("無効なAPIキーのスコープです")
+	ErrInvalidAPIKeyName    = errors.New("APIキー名は1~100文字である必要があります")
+	ErrAPIKeyScopeRequired  = errors.New("APIキーには1つ以上のスコープが必要です")
+	ErrAPIKeyAlreadyRevoked = errors.New("APIキーは既に失効しています")
+)
+
+func ParseAPIKeyScope(s string) (APIKeyScope, error) {
+	switch scope := APIKeyScope(s); scope {
+	case APIKeyScopeSessionsRead, APIKeyScopeSessionsWrite, APIKeyScopeResultsExport:
+		return scope, nil
+	default:
+		return "", ErrInvalidAPIKeyScope
+	}
+}
+
+// OrganizationAPIKeyRepository リポジトリインターフェース
+type OrganizationAPIKeyRepository interface {
+	Create(ctx context.Context, key *OrganizationAPIKey) error
+	// FindByID 存在しない場合はnilを返す
+	FindByID(ctx context.Context, apiKeyID shared.UUID[OrganizationAPIKey]) (*OrganizationAPIKey, error)
+	// FindByHash 存在しない場合はnilを返す
+	FindByHash(ctx context.Context, keyHash string) (*OrganizationAPIKey, error)
+	FindByOrganizationID(ctx context.Context, organizationID shared.UUID[Organization]) ([]*OrganizationAPIKey, error)
+	Revoke(ctx context.Context, key *OrganizationAPIKey) error
+	UpdateLastUsed(ctx context.Context, key *OrganizationAPIKey) error
+}
+
+// OrganizationAPIKey 組織のAPIキー。キー本体は発行時にのみ返し、ハッシュのみ保存する
+type OrganizationAPIKey struct {
+	apiKeyID       shared.UUID[OrganizationAPIKey]
+	organizationID shared.UUID[Organization]
+	name           string
+	keyPrefix      string
+	keyHash        string
+	scopes         []APIKeyScope
+	createdBy      shared.UUID[user.User]
+	expiresAt      *time.Time
+	lastUsedAt     *time.Time
+	revokedAt      *time.Time
+	revokedBy      *shared.UUID[user.User]
+	createdAt      time.Time
+}
+
+// NewOrganizationAPIKey 新しいAPIキーを発行する。戻り値の文字列はキー本体で、この時点でしか取得できない
+func NewOrganizationAPIKey(
+	organizationID shared.UUID[Organization],
+	name string,
+	scopes []APIKeyScope,
+	createdBy shared.UUID[user.User],
+	expiresAt *time.Time,
+	pepper string,
+	now time.Time,
+) (*OrganizationAPIKey, string, error) {
+	runeCount := utf8.RuneCountInString(name)
+	if runeCount < 1 || runeCount > 100 {
+		return nil, "", ErrInvalidAPIKeyName
+	}
+	if len(scopes) == 0 {
+		return nil, "", ErrAPIKeyScopeRequired
+	}
+
+	rawKey := generateAPIKey()
+
+	return &OrganizationAPIKey{
+		apiKeyID:       shared.NewUUID[OrganizationAPIKey](),
+		organizationID: organizationID,
+		name:           name,
+		keyPrefix:      rawKey[:apiKeyDisplayPrefixLength],
+		keyHash:        HashAPIKey(rawKey, pepper),
+		scopes:         slices.Compact(slices.Sorted(slices.Values(scopes))),
+		createdBy:      createdBy,
+		expiresAt:      expiresAt,
+		createdAt:      now,
+	}, rawKey, nil
+}
+
+// ReconstructOrganizationAPIKey DBから取得したデータでAPIキーを再構築
+func ReconstructOrganizationAPIKey(
+	apiKeyID shared.UUID[OrganizationAPIKey],
+	organizationID shared.UUID[Organization],
+	name string,
+	keyPrefix string,
+	keyHash string,
+	scopes []APIKeyScope,
+	createdBy shared.UUID[user.User],
+	expiresAt *time.Time,
+	lastUsedAt *time.Time,
+	revokedAt *time.Time,
+	revokedBy *shared.UUID[user.User],
+	createdAt time.Time,
+) *OrganizationAPIKey {
+	return &OrganizationAPIKey{
+		apiKeyID:       apiKeyID,
+		organizationID: organizationID,
+		name:           name,
+		keyPrefix:      keyPrefix,
+		keyHash:        keyHash,
+		scopes:         scopes,
+		createdBy:      createdBy,
+		expiresAt:      expiresAt,
+		lastUsedAt:     lastUsedAt,
+		revokedAt:      revokedAt,
+		revokedBy:      revokedBy,
+		createdAt:      createdAt,
+	}
+}
+
+// HashAPIKey 保存・照合用のハッシュを計算する
+func HashAPIKey(rawKey, pepper string) string {
+	return hashSecret(rawKey, pepper)
+}
+
+// hashSecret 発行した秘密情報を保存・照合するためのHMAC-SHA256
+func hashSecret(secret, pepper string) string {
+	mac := hmac.New(sha256.New, []byte(pepper))
+	mac.Write([]byte(secret))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// IsAPIKeyFormat 文字列がAPIキーの形式かどうか
+func IsAPIKeyFormat(rawKey string) bool {
+	return strings.HasPrefix(rawKey, APIKeyPrefix) && len(rawKey) == len(APIKeyPrefix)+apiKeySecretLength
+}
+
+func generateAPIKey() string {
+	// rand.Textは128bit相当のbase32文字列を返す
+	return APIKeyPrefix + rand.Text()
+}
+
+// IsActive nowの時点で使用できるか
+func (k *OrganizationAPIKey) IsActive(now time.Time) bool {
+	if k.revokedAt != nil {
+		return false
+	}
+	return k.expiresAt == nil || now.Before(*k.expiresAt)
+}
+
+// HasScope 指定したスコープが許可されているか
+func (k *OrganizationAPIKey) HasScope(scope APIKeyScope) bool {
+	return slices.Contains(k.scopes, scope)
+}
+
+// Revoke APIキーを失効させる
+func (k *OrganizationAPIKey) Revoke(revokedBy shared.UUID[user.User], now time.Time) error {
+	if k.revokedAt != nil {
+		return ErrAPIKeyAlreadyRevoked
+	}
+	k.revokedAt = &now
+	k.revokedBy = &revokedBy
+	return nil
+}
+
+// MarkUsed 最終使用日時を記録する。永続化が必要な場合はtrueを返す
+func (k *OrganizationAPIKey) MarkUsed(now time.Time) bool {
+	if k.lastUsedAt != nil && now.Sub(*k.lastUsedAt) < apiKeyLastUsedResolution {
+		return false
+	}
+	k.lastUsedAt = &now
+	return true
+}
+
+func (k *OrganizationAPIKey) APIKeyID() shared.UUID[OrganizationAPIKey] {
+	return k.apiKeyID
+}
+
+func (k *OrganizationAPIKey) OrganizationID() shared.UUID[Organization] {
+	return k.organizationID
+}
+
+func (k *OrganizationAPIKey) Name() string {
+	return k.name
+}
+
+// KeyPrefix キーの先頭部分。一覧でキーを識別するために使用する
+func (k *OrganizationAPIKey) KeyPrefix() string {
+	return k.keyPrefix
+}
+
+func (k *OrganizationAPIKey) KeyHash() string {
+	return k.keyHash
+}
+
+func (k *OrganizationAPIKey) Scopes() []APIKeyScope {
+	return k.scopes
+}
+
+func (k *OrganizationAPIKey) CreatedBy() shared.UUID[user.User] {
+	return k.createdBy
+}
+
+func (k *OrganizationAPIKey) ExpiresAt() *time.Time {
+	return k.expiresAt
+}
+
+func (k *OrganizationAPIKey) LastUsedAt() *time.Time {
+	return k.lastUsedAt
+}
+
+func (k *OrganizationAPIKey) RevokedAt() *time.Time {
+	return k.revokedAt
+}
+
+func (k *OrganizationAPIKey) RevokedBy() *shared.UUID[user.User] {
+	return k.revokedBy
+}
+
+func (k *OrganizationAPIKey) CreatedAt() time.Time {
+	return k.createdAt
+}
@@ -0,0 +1,178 @@
+package organization_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOrganizationAPIKey(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	orgID := shared.NewUUID[organization.Organization]()
+	userID := shared.NewUUID[user.User]()
+
+	t.Run("発行したキーは形式が正しくハッシュのみ保持する", func(t *testing.T) {
+		key, rawKey, err := organization.NewOrganizationAPIKey(
+			orgID, "連携用", []organization.APIKeyScope{organization.APIKeyScopeSessionsRead}, userID, nil, "pepper", now,
+		)
+		require.NoError(t, err)
+
+		assert.True(t, organization.IsAPIKeyFormat(rawKey))
+		assert.True(t, strings.HasPrefix(rawKey, key.KeyPrefix()))
+		assert.Equal(t, organization.HashAPIKey(rawKey, "pepper"), key.KeyHash())
+		assert.NotContains(t, key.KeyHash(), rawKey)
+	})
+
+	t.Run("重複したスコープはまとめられる", func(t *testing.T) {
+		key, _, err := organization.NewOrganizationAPIKey(orgID, "連携用", []organization.APIKeyScope{
+			organization.APIKeyScopeSessionsWrite,
+			organization.APIKeyScopeSessionsRead,
+			organization.APIKeyScopeSessionsWrite,
+		}, userID, nil, "pepper", now)
+		require.NoError(t, err)
+
+		assert.Equal(t, []organization.APIKeyScope{
+			organization.APIKeyScopeSessionsRead,
+			organization.APIKeyScopeSessionsWrite,
+		}, key.Scopes())
+	})
+
+	tests := []struct {
+		name    string
+		keyName string
+		scopes  []organization.APIKeyScope
+		wantErr error
+	}{
+		{
+			name:    "名前が空の場合はエラー",
+			keyName: "",
+			scopes:  []organization.APIKeyScope{organization.APIKeyScopeSessionsRead},
+			wantErr: organization.ErrInvalidAPIKeyName,
+		},
+		{
+			name:    "名前が100文字を超える場合はエラー",
+			keyName: strings.Repeat("あ", 101),
+			scopes:  []organization.APIKeyScope{organization.APIKeyScopeSessionsRead},
+			wantErr: organization.ErrInvalidAPIKeyName,
+		},
+		{
+			name:    "スコープがない場合はエラー",
+			keyName: "連携用",
+			scopes:  nil,
+			wantErr: organization.ErrAPIKeyScopeRequired,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := organization.NewOrganizationAPIKey(orgID, tt.keyName, tt.scopes, userID, nil, "pepper", now)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestOrganizationAPIKey_IsActive(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	userID := shared.NewUUID[user.User]()
+
+	newKey := func(expiresAt, revokedAt *time.Time) *organization.OrganizationAPIKey {
+		return organization.ReconstructOrganizationAPIKey(
+			shared.NewUUID[organization.OrganizationAPIKey](),
+			shared.NewUUID[organization.Organization](),
+			"連携用", "khk_ABCDEFGH", "hash",
+			[]organization.APIKeyScope{organization.APIKeyScopeSessionsRead},
+			userID, expiresAt, nil, revokedAt, nil, now.Add(-time.Hour),
+		)
+	}
+
+	tests := []struct {
+		name string
+		key  *organization.OrganizationAPIKey
+		want bool
+	}{
+		{
+			name: "期限のないキーは使用できる",
+			key:  newKey(nil, nil),
+			want: true,
+		},
+		{
+			name: "期限内のキーは使用できる",
+			key:  newKey(lo.ToPtr(now.Add(time.Hour)), nil),
+			want: true,
+		},
+		{
+			name: "期限切れのキーは使用できない",
+			key:  newKey(lo.ToPtr(now), nil),
+			want: false,
+		},
+		{
+			name: "失効したキーは使用できない",
+			key:  newKey(nil, lo.ToPtr(now.Add(-time.Minute))),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.key.IsActive(now))
+		})
+	}
+}
+
+func TestOrganizationAPIKey_Revoke(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	userID := shared.NewUUID[user.User]()
+	key, _, err := organization.NewOrganizationAPIKey(
+		shared.NewUUID[organization.Organization](), "連携用",
+		[]organization.APIKeyScope{organization.APIKeyScopeResultsExport}, userID, nil, "pepper", now,
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, key.Revoke(userID, now))
+	assert.False(t, key.IsActive(now))
+	assert.Equal(t, userID, *key.RevokedBy())
+
+	assert.ErrorIs(t, key.Revoke(userID, now.Add(time.Hour)), organization.ErrAPIKeyAlreadyRevoked)
+	assert.Equal(t, now, *key.RevokedAt())
+}
+
+func TestOrganizationAPIKey_MarkUsed(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	key, _, err := organization.NewOrganizationAPIKey(
+		shared.NewUUID[organization.Organization](), "連携用",
+		[]organization.APIKeyScope{organization.APIKeyScopeSessionsRead}, shared.NewUUID[user.User](), nil, "pepper", now,
+	)
+	require.NoError(t, err)
+
+	assert.True(t, key.MarkUsed(now), "初回は記録する")
+	assert.False(t, key.MarkUsed(now.Add(30*time.Second)), "短い間隔では記録しない")
+	assert.True(t, key.MarkUsed(now.Add(time.Minute)))
+	assert.Equal(t, now.Add(time.Minute), *key.LastUsedAt())
+}
+
+func TestOrganizationAPIKey_HasScope(t *testing.T) {
+	key, _, err := organization.NewOrganizationAPIKey(
+		shared.NewUUID[organization.Organization](), "連携用",
+		[]organization.APIKeyScope{organization.APIKeyScopeSessionsRead}, shared.NewUUID[user.User](), nil, "pepper", time.Now(),
+	)
+	require.NoError(t, err)
+
+	assert.True(t, key.HasScope(organization.APIKeyScopeSessionsRead))
+	assert.False(t, key.HasScope(organization.APIKeyScopeSessionsWrite))
+}
+
+func TestParseAPIKeyScope(t *testing.T) {
+	for _, s := range []string{"sessions:read", "sessions:write", "results:export"} {
+		scope, err := organization.ParseAPIKeyScope(s)
+		require.NoError(t, err)
+		assert.Equal(t, organization.APIKeyScope(s), scope)
+	}
+
+	_, err := organization.ParseAPIKeyScope("admin")
+	assert.ErrorIs(t, err, organization.ErrInvalidAPIKeyScope)
+}
@@ -0,0 +1,217 @@
+package organization
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+)
+
+// AuditAction 監査ログに記録する操作の種類
+type AuditAction string
+
+const (
+	AuditActionOrganizationCreated     AuditAction = "organization.created"
+	AuditActionOrganizationUpdated     AuditAction = "organization.updated"
+	AuditActionParentChanged           AuditAction = "organization.parent_changed"
+	AuditActionAliasCreated            AuditAction = "organization.alias_created"
+	AuditActionAliasDeactivated        AuditAction = "organization.alias_deactivated"
+	AuditActionUserAdded               AuditAction = "organization.user_added"
+	AuditActionUserRoleChanged         AuditAction = "organization.user_role_changed"
+	AuditActionUserRemoved             AuditAction = "organization.user_removed"
+	AuditActionInvitationCreated       AuditAction = "organization.invitation_created"
+	AuditActionInvitationResent        AuditAction = "organization.invitation_resent"
+	AuditActionInvitationRevoked       AuditAction = "organization.invitation_revoked"
+	AuditActionInvitationAccepted      AuditAction = "organization.invitation_accepted"
+	AuditActionAPIKeyIssued            AuditAction = "organization.api_key_issued"
+	AuditActionAPIKeyRevoked           AuditAction = "organization.api_key_revoked"
+	AuditActionReportPromptUpdated     AuditAction = "organization.report_prompt_updated"
+	AuditActionDemographicsUpdated     AuditAction = "organization.reference_demographics_updated"
+	AuditActionTalkSessionStarted      AuditAction = "talksession.started"
+	AuditActionTalkSessionUpdated      AuditAction = "talksession.updated"
+	AuditActionReportVisibilityToggled AuditAction = "talksession.report_visibility_changed"
+	AuditActionReportVersionPublished  AuditAction = "talksession.report_version_published"
+	AuditActionTalkSessionCloned       AuditAction = "talksession.cloned"
+	AuditActionTalkSessionPublished    AuditAction = "talksession.published"
+	AuditActionCollaboratorChanged     AuditAction = "talksession.collaborator_changed"
+	AuditActionCollaboratorRemoved     AuditAction = "talksession.collaborator_removed"
+	AuditActionTemplateCreated         AuditAction = "talksession.template_created"
+	AuditActionTemplateDeleted         AuditAction = "talksession.template_deleted"
+	AuditActionOwnershipRequested      AuditAction = "ownership.transfer_requested"
+	AuditActionOwnershipAccepted       AuditAction = "ownership.transfer_accepted"
+	AuditActionOwnershipDeclined       AuditAction = "ownership.transfer_declined"
+	AuditActionOwnershipCancelled      AuditAction = "ownership.transfer_cancelled"
+)
+
+// AuditTargetType 操作対象の種類
+type AuditTargetType string
+
+const (
+	AuditTargetOrganization AuditTargetType = "organization"
+	AuditTargetAlias        AuditTargetType = "alias"
+	AuditTargetUser         AuditTargetType = "user"
+	AuditTargetInvitation   AuditTargetType = "invitation"
+	AuditTargetAPIKey       AuditTargetType = "api_key"
+	AuditTargetTalkSession  AuditTargetType = "talksession"
+	AuditTargetTemplate     AuditTargetType = "talksession_template"
+	AuditTargetTransfer     AuditTargetType = "ownership_transfer"
+)
+
+const (
+	// AuditLogDefaultLimit 一覧取得時の既定の件数
+	AuditLogDefaultLimit = 50
+	// AuditLogMaxLimit 一覧取得時に1度に取得できる最大件数
+	AuditLogMaxLimit = 1000
+)
+
+// OrganizationAuditLogRepository リポジトリインターフェース。監査ログは追記のみで更新・削除は行わない
+type OrganizationAuditLogRepository interface {
+	Create(ctx context.Context, auditLog *OrganizationAuditLog) error
+	FindByOrganizationID(ctx context.Context, organizationID shared.UUID[Organization], filter AuditLogFilter) ([]*OrganizationAuditLog, error)
+	CountByOrganizationID(ctx context.Context, organizationID shared.UUID[Organization], filter AuditLogFilter) (int, error)
+}
+
+// AuditLogFilter 監査ログの絞り込み条件。nilの項目は絞り込まない
+type AuditLogFilter struct {
+	Action  *AuditAction
+	ActorID *shared.UUID[user.User]
+	// Since 以降（この時刻を含む）
+	Since *time.Time
+	// Until より前（この時刻を含まない）
+	Until *time.Time
+	// Before 指定した監査ログより古いものだけを取得する。件数の多い取得でOffsetの代わりに使う
+	Before *AuditLogCursor
+	Limit  int
+	Offset int
+}
+
+// AuditLogCursor 監査ログの並び順（作成日時、IDの降順）における位置
+type AuditLogCursor struct {
+	CreatedAt  time.Time
+	AuditLogID shared.UUID[OrganizationAuditLog]
+}
+
+// AuditChange 項目ごとの変更前後の値
+type AuditChange struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+// AuditChanges 項目名ごとの変更差分
+type AuditChanges map[string]AuditChange
+
+// OrganizationAuditLog 組織に対する管理操作の記録
+type OrganizationAuditLog struct {
+	auditLogID     shared.UUID[OrganizationAuditLog]
+	organizationID shared.UUID[Organization]
+	actorID        shared.UUID[user.User]
+	action         AuditAction
+	targetType     AuditTargetType
+	targetID       string
+	changes        AuditChanges
+	createdAt      time.Time
+}
+
+func NewOrganizationAuditLog(
+	organizationID shared.UUID[Organization],
+	actorID shared.UUID[user.User],
+	action AuditAction,
+	targetType AuditTargetType,
+	targetID string,
+	now time.Time,
+) *OrganizationAuditLog {
+	return &OrganizationAuditLog{
+		auditLogID:     shared.NewUUID[OrganizationAuditLog](),
+		organizationID: organizationID,
+		actorID:        actorID,
+		action:         action,
+		targetType:     targetType,
+		targetID:       targetID,
+		changes:        AuditChanges{},
+		createdAt:      now,
+	}
+}
+
+func ReconstructOrganizationAuditLog(
+	auditLogID shared.UUID[OrganizationAuditLog],
+	organizationID shared.UUID[Organization],
+	actorID shared.UUID[user.User],
+	action AuditAction,
+	targetType AuditTargetType,
+	targetID string,
+	changes AuditChanges,
+	createdAt time.Time,
+) *OrganizationAuditLog {
+	if changes == nil {
+		changes = AuditChanges{}
+	}
+	return &OrganizationAuditLog{
+		auditLogID:     auditLogID,
+		organizationID: organizationID,
+		actorID:        actorID,
+		action:         action,
+		targetType:     targetType,
+		targetID:       targetID,
+		changes:        changes,
+		createdAt:      createdAt,
+	}
+}
+
+// RecordChange 項目の変更前後を記録する。値が変わっていない項目は記録しない
+// 作成時はbeforeにnil、削除時はafterにnilを渡す
+func (l *OrganizationAuditLog) RecordChange(field string, before, after any) {
+	before, after = derefAuditValue(before), derefAuditValue(after)
+	if reflect.DeepEqual(before, after) {
+		return
+	}
+	l.changes[field] = AuditChange{Before: before, After: after}
+}
+
+// derefAuditValue ポインタは指す値で比較・記録する
+func derefAuditValue(v any) any {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() {
+		return nil
+	}
+	return rv.Interface()
+}
+
+func (l *OrganizationAuditLog) AuditLogID() shared.UUID[OrganizationAuditLog] {
+	return l.auditLogID
+}
+
+func (l *OrganizationAuditLog) OrganizationID() shared.UUID[Organization] {
+	return l.organizationID
+}
+
+func (l *OrganizationAuditLog) ActorID() shared.UUID[user.User] {
+	return l.actorID
+}
+
+func (l *OrganizationAuditLog) Action() AuditAction {
+	return l.action
+}
+
+func (l *OrganizationAuditLog) TargetType() AuditTargetType {
+	return l.targetType
+}
+
+func (l *OrganizationAuditLog) TargetID() string {
+	return l.targetID
+}
+
+func (l *OrganizationAuditLog) Changes() AuditChanges {
+	return l.changes
+}
+
+func (l *OrganizationAuditLog) CreatedAt() time.Time {
+	return l.createdAt
+}
@@ -0,0 +1,71 @@
+package organization_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrganizationAuditLog_RecordChange(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		before any
+		after  any
+		want   organization.AuditChanges
+	}{
+		{
+			name:   "値が変わった項目は記録される",
+			before: "旧名称",
+			after:  "新名称",
+			want:   organization.AuditChanges{"field": {Before: "旧名称", After: "新名称"}},
+		},
+		{
+			name:   "値が変わっていない項目は記録されない",
+			before: organization.OrganizationUserRoleAdmin,
+			after:  organization.OrganizationUserRoleAdmin,
+			want:   organization.AuditChanges{},
+		},
+		{
+			name:   "ポインタは指す値で比較される",
+			before: lo.ToPtr("札幌市"),
+			after:  lo.ToPtr("札幌市"),
+			want:   organization.AuditChanges{},
+		},
+		{
+			name:   "nilのポインタはnilとして記録される",
+			before: (*string)(nil),
+			after:  lo.ToPtr("https://example.com/icon.png"),
+			want:   organization.AuditChanges{"field": {Before: nil, After: "https://example.com/icon.png"}},
+		},
+		{
+			name:   "削除時はafterをnilとして記録される",
+			before: organization.OrganizationUserRoleMember,
+			after:  nil,
+			want:   organization.AuditChanges{"field": {Before: organization.OrganizationUserRoleMember, After: nil}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			auditLog := organization.NewOrganizationAuditLog(
+				shared.NewUUID[organization.Organization](),
+				shared.NewUUID[user.User](),
+				organization.AuditActionOrganizationUpdated,
+				organization.AuditTargetOrganization,
+				"target",
+				now,
+			)
+
+			auditLog.RecordChange("field", tt.before, tt.after)
+
+			assert.Equal(t, tt.want, auditLog.Changes())
+			assert.Equal(t, now, auditLog.CreatedAt())
+		})
+	}
+}
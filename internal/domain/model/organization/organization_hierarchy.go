@@ -0,0 +1,89 @@
+package organization
+
+import (
+	"errors"
+
+	"github.com/neko-dream/api/internal/domain/model/shared"
+)
+
+// MaxOrganizationDepth 組織階層の最大の深さ（最上位の組織を1とする）
+// 例: 国 > 都道府県 > 市区町村 > 部署 > 課
+const MaxOrganizationDepth = 5
+
+var (
+	ErrOrganizationHierarchyCycle   = errors.New("organization hierarchy must not contain a cycle")
+	ErrOrganizationHierarchyTooDeep = errors.New("organization hierarchy is too deep")
+)
+
+// SetParent 親組織を設定する
+// parentAncestorIDsは親組織の祖先のID、descendantsは自身の子孫の組織
+func (o *Organization) SetParent(parent *Organization, parentAncestorIDs []shared.UUID[Organization], descendants []*Organization) error {
+	if parent == nil {
+		o.ClearParent()
+		return nil
+	}
+	if parent.OrganizationID == o.OrganizationID {
+		return ErrOrganizationHierarchyCycle
+	}
+	for _, d := range descendants {
+		if d.OrganizationID == parent.OrganizationID {
+			return ErrOrganizationHierarchyCycle
+		}
+	}
+	for _, id := range parentAncestorIDs {
+		if id == o.OrganizationID {
+			return ErrOrganizationHierarchyCycle
+		}
+	}
+
+	// 親の深さ + 自身 + 自身の配下の高さが上限を超えないこと
+	depth := len(parentAncestorIDs) + 2 + SubtreeHeight(o.OrganizationID, descendants)
+	if depth > MaxOrganizationDepth {
+		return ErrOrganizationHierarchyTooDeep
+	}
+
+	o.ParentOrganizationID = &parent.OrganizationID
+	return nil
+}
+
+// ClearParent 親組織との関係を解除し、最上位の組織にする
+func (o *Organization) ClearParent() {
+	o.ParentOrganizationID = nil
+}
+
+// HasParent 親組織を持つか
+func (o *Organization) HasParent() bool {
+	return o.ParentOrganizationID != nil
+}
+
+// SubtreeHeight rootIDの配下の階層数を返す。子がいなければ0
+func SubtreeHeight(rootID shared.UUID[Organization], descendants []*Organization) int {
+	children := make(map[shared.UUID[Organization]][]shared.UUID[Organization], len(descendants))
+	for _, d := range descendants {
+		if d.ParentOrganizationID == nil {
+			continue
+		}
+		children[*d.ParentOrganizationID] = append(children[*d.ParentOrganizationID], d.OrganizationID)
+	}
+
+	height := 0
+	current := []shared.UUID[Organization]{rootID}
+	visited := map[shared.UUID[Organization]]bool{rootID: true}
+	for {
+		var next []shared.UUID[Organization]
+		for _, id := range current {
+			for _, child := range children[id] {
+				if visited[child] {
+					continue
+				}
+				visited[child] = true
+				next = append(next, child)
+			}
+		}
+		if len(next) == 0 {
+			return height
+		}
+		height++
+		current = next
+	}
+}
@@ -0,0 +1,109 @@
+package organization_test
+
+import (
+	"testing"
+
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/stretchr/testify/assert"
+)
+
+func newHierarchyOrganization(name string, parent *organization.Organization) *organization.Organization {
+	org := organization.NewOrganization(
+		shared.NewUUID[organization.Organization](),
+		organization.OrganizationTypeGovernment,
+		name,
+		name,
+		nil,
+		shared.NewUUID[user.User](),
+	)
+	if parent != nil {
+		org.ParentOrganizationID = &parent.OrganizationID
+	}
+	return org
+}
+
+func TestOrganization_SetParent(t *testing.T) {
+	prefecture := newHierarchyOrganization("hokkaido", nil)
+	city := newHierarchyOrganization("sapporo", nil)
+	ward := newHierarchyOrganization("chuo", city)
+	section := newHierarchyOrganization("kikaku", ward)
+
+	tests := []struct {
+		name              string
+		child             *organization.Organization
+		parent            *organization.Organization
+		parentAncestorIDs []shared.UUID[organization.Organization]
+		descendants       []*organization.Organization
+		wantErr           error
+	}{
+		{
+			name:   "最上位の組織を親にできる",
+			child:  city,
+			parent: prefecture,
+		},
+		{
+			name:        "配下を持つ組織も上限以内なら親を設定できる",
+			child:       city,
+			parent:      prefecture,
+			descendants: []*organization.Organization{ward, section},
+		},
+		{
+			name:    "自身を親にはできない",
+			child:   city,
+			parent:  city,
+			wantErr: organization.ErrOrganizationHierarchyCycle,
+		},
+		{
+			name:              "子孫を親にはできない",
+			child:             city,
+			parent:            section,
+			parentAncestorIDs: []shared.UUID[organization.Organization]{ward.OrganizationID, city.OrganizationID},
+			descendants:       []*organization.Organization{ward, section},
+			wantErr:           organization.ErrOrganizationHierarchyCycle,
+		},
+		{
+			name:   "階層が上限を超える場合は設定できない",
+			child:  city,
+			parent: prefecture,
+			parentAncestorIDs: []shared.UUID[organization.Organization]{
+				shared.NewUUID[organization.Organization](),
+				shared.NewUUID[organization.Organization](),
+			},
+			descendants: []*organization.Organization{ward, section},
+			wantErr:     organization.ErrOrganizationHierarchyTooDeep,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			child := *tt.child
+			err := child.SetParent(tt.parent, tt.parentAncestorIDs, tt.descendants)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Equal(t, tt.child.ParentOrganizationID, child.ParentOrganizationID)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, &tt.parent.OrganizationID, child.ParentOrganizationID)
+		})
+	}
+
+	t.Run("nilを渡すと親子関係が解除される", func(t *testing.T) {
+		child := *ward
+		assert.NoError(t, child.SetParent(nil, nil, nil))
+		assert.False(t, child.HasParent())
+	})
+}
+
+func TestSubtreeHeight(t *testing.T) {
+	city := newHierarchyOrganization("sapporo", nil)
+	ward := newHierarchyOrganization("chuo", city)
+	otherWard := newHierarchyOrganization("kita", city)
+	section := newHierarchyOrganization("kikaku", ward)
+
+	assert.Equal(t, 0, organization.SubtreeHeight(city.OrganizationID, nil))
+	assert.Equal(t, 1, organization.SubtreeHeight(city.OrganizationID, []*organization.Organization{ward, otherWard}))
+	assert.Equal(t, 2, organization.SubtreeHeight(city.OrganizationID, []*organization.Organization{ward, otherWard, section}))
+	assert.Equal(t, 1, organization.SubtreeHeight(ward.OrganizationID, []*organization.Organization{section}))
+}
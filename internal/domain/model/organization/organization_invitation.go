@@ -0,0 +1,277 @@
+package organization
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+)
+
+// InvitationStatus 招待の状態
+type InvitationStatus string
+
+const (
+	InvitationStatusPending  InvitationStatus = "pending"
+	InvitationStatusAccepted InvitationStatus = "accepted"
+	InvitationStatusRevoked  InvitationStatus = "revoked"
+	// InvitationStatusExpired 期限切れ。保存はせず、有効期限から判定する
+	InvitationStatusExpired InvitationStatus = "expired"
+)
+
+const (
+	// InvitationLifetime 招待リンクの有効期間
+	InvitationLifetime = 7 * 24 * time.Hour
+	// InvitationMaxSendCount 1件の招待でメールを送信できる上限回数
+	InvitationMaxSendCount = 5
+)
+
+var (
+	ErrInvalidInvitationEmail  = errors.New("招待先のメールアドレスが正しくありません")
+	ErrInvalidInvitationRole   = errors.New("招待するロールが正しくありません")
+	ErrInvitationNotPending    = errors.New("招待は既に承諾済みか取り消されています")
+	ErrInvitationExpired       = errors.New("招待の有効期限が切れています")
+	ErrInvitationEmailMismatch = errors.New("招待先のメールアドレスと一致しません")
+	ErrInvitationResendLimit   = errors.New("招待メールの再送上限に達しました")
+)
+
+// OrganizationInvitationRepository リポジトリインターフェース
+type OrganizationInvitationRepository interface {
+	Create(ctx context.Context, invitation *OrganizationInvitation) error
+	Update(ctx context.Context, invitation *OrganizationInvitation) error
+	// FindByID 存在しない場合はnilを返す
+	FindByID(ctx context.Context, invitationID shared.UUID[OrganizationInvitation]) (*OrganizationInvitation, error)
+	// FindByTokenHash 存在しない場合はnilを返す
+	FindByTokenHash(ctx context.Context, tokenHash string) (*OrganizationInvitation, error)
+	// FindPendingByEmailHash 未承諾の招待を取得する。存在しない場合はnilを返す
+	FindPendingByEmailHash(ctx context.Context, organizationID shared.UUID[Organization], emailHash string) (*OrganizationInvitation, error)
+	FindByOrganizationID(ctx context.Context, organizationID shared.UUID[Organization]) ([]*OrganizationInvitation, error)
+}
+
+// OrganizationInvitation 組織への招待。招待トークンは送信時にのみ扱い、ハッシュのみ保存する
+type OrganizationInvitation struct {
+	invitationID   shared.UUID[OrganizationInvitation]
+	organizationID shared.UUID[Organization]
+	email          string
+	emailHash      string
+	role           OrganizationUserRole
+	tokenHash      string
+	status         InvitationStatus
+	invitedBy      shared.UUID[user.User]
+	expiresAt      time.Time
+	sendCount      int
+	lastSentAt     time.Time
+	acceptedBy     *shared.UUID[user.User]
+	acceptedAt     *time.Time
+	revokedAt      *time.Time
+	createdAt      time.Time
+}
+
+// NormalizeInvitationEmail 招待先メールアドレスを検証し、比較用に正規化する
+func NormalizeInvitationEmail(email string) (string, error) {
+	email = strings.ToLower(strings.TrimSpace(email))
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		return "", ErrInvalidInvitationEmail
+	}
+	return email, nil
+}
+
+// NewOrganizationInvitation 招待を作成する。戻り値の文字列は招待トークンで、この時点でしか取得できない
+// emailはNormalizeInvitationEmailで正規化済みのもの、emailHashはそのハッシュを渡す
+func NewOrganizationInvitation(
+	organizationID shared.UUID[Organization],
+	email string,
+	emailHash string,
+	role OrganizationUserRole,
+	invitedBy shared.UUID[user.User],
+	pepper string,
+	now time.Time,
+) (*OrganizationInvitation, string, error) {
+	if _, err := NormalizeInvitationEmail(email); err != nil {
+		return nil, "", err
+	}
+	// 運営ロールは招待では付与しない
+	if !role.IsValid() || role == OrganizationUserRoleSuperAdmin {
+		return nil, "", ErrInvalidInvitationRole
+	}
+
+	token := rand.Text()
+
+	return &OrganizationInvitation{
+		invitationID:   shared.NewUUID[OrganizationInvitation](),
+		organizationID: organizationID,
+		email:          email,
+		emailHash:      emailHash,
+		role:           role,
+		tokenHash:      HashInvitationToken(token, pepper),
+		status:         InvitationStatusPending,
+		invitedBy:      invitedBy,
+		expiresAt:      now.Add(InvitationLifetime),
+		sendCount:      1,
+		lastSentAt:     now,
+		createdAt:      now,
+	}, token, nil
+}
+
+// ReconstructOrganizationInvitation DBから取得したデータで招待を再構築
+func ReconstructOrganizationInvitation(
+	invitationID shared.UUID[OrganizationInvitation],
+	organizationID shared.UUID[Organization],
+	email string,
+	emailHash string,
+	role OrganizationUserRole,
+	tokenHash string,
+	status InvitationStatus,
+	invitedBy shared.UUID[user.User],
+	expiresAt time.Time,
+	sendCount int,
+	lastSentAt time.Time,
+	acceptedBy *shared.UUID[user.User],
+	acceptedAt *time.Time,
+	revokedAt *time.Time,
+	createdAt time.Time,
+) *OrganizationInvitation {
+	return &OrganizationInvitation{
+		invitationID:   invitationID,
+		organizationID: organizationID,
+		email:          email,
+		emailHash:      emailHash,
+		role:           role,
+		tokenHash:      tokenHash,
+		status:         status,
+		invitedBy:      invitedBy,
+		expiresAt:      expiresAt,
+		sendCount:      sendCount,
+		lastSentAt:     lastSentAt,
+		acceptedBy:     acceptedBy,
+		acceptedAt:     acceptedAt,
+		revokedAt:      revokedAt,
+		createdAt:      createdAt,
+	}
+}
+
+// HashInvitationToken 保存・照合用のハッシュを計算する
+func HashInvitationToken(token, pepper string) string {
+	return hashSecret(token, pepper)
+}
+
+// Status nowの時点での状態。未承諾で有効期限を過ぎたものはInvitationStatusExpiredを返す
+func (i *OrganizationInvitation) Status(now time.Time) InvitationStatus {
+	if i.status == InvitationStatusPending && !now.Before(i.expiresAt) {
+		return InvitationStatusExpired
+	}
+	return i.status
+}
+
+// Accept 招待を承諾する。承諾するユーザーのメールアドレスのハッシュが招待先と一致する必要がある
+func (i *OrganizationInvitation) Accept(userID shared.UUID[user.User], userEmailHash string, now time.Time) error {
+	switch i.Status(now) {
+	case InvitationStatusPending:
+	case InvitationStatusExpired:
+		return ErrInvitationExpired
+	default:
+		return ErrInvitationNotPending
+	}
+	if userEmailHash == "" || userEmailHash != i.emailHash {
+		return ErrInvitationEmailMismatch
+	}
+
+	i.status = InvitationStatusAccepted
+	i.acceptedBy = &userID
+	i.acceptedAt = &now
+	return nil
+}
+
+// Revoke 招待を取り消す。期限切れの招待も取り消せる
+func (i *OrganizationInvitation) Revoke(now time.Time) error {
+	if i.status != InvitationStatusPending {
+		return ErrInvitationNotPending
+	}
+	i.status = InvitationStatusRevoked
+	i.revokedAt = &now
+	return nil
+}
+
+// Resend 招待トークンを再発行し、有効期限を延長する。戻り値は新しい招待トークン
+// 以前に送信したリンクは使えなくなる
+func (i *OrganizationInvitation) Resend(pepper string, now time.Time) (string, error) {
+	if i.status != InvitationStatusPending {
+		return "", ErrInvitationNotPending
+	}
+	if i.sendCount >= InvitationMaxSendCount {
+		return "", ErrInvitationResendLimit
+	}
+
+	token := rand.Text()
+	i.tokenHash = HashInvitationToken(token, pepper)
+	i.expiresAt = now.Add(InvitationLifetime)
+	i.sendCount++
+	i.lastSentAt = now
+	return token, nil
+}
+
+func (i *OrganizationInvitation) InvitationID() shared.UUID[OrganizationInvitation] {
+	return i.invitationID
+}
+
+func (i *OrganizationInvitation) OrganizationID() shared.UUID[Organization] {
+	return i.organizationID
+}
+
+func (i *OrganizationInvitation) Email() string {
+	return i.email
+}
+
+func (i *OrganizationInvitation) EmailHash() string {
+	return i.emailHash
+}
+
+func (i *OrganizationInvitation) Role() OrganizationUserRole {
+	return i.role
+}
+
+func (i *OrganizationInvitation) TokenHash() string {
+	return i.tokenHash
+}
+
+// StoredStatus 保存されている状態。期限切れの判定はStatusを使う
+func (i *OrganizationInvitation) StoredStatus() InvitationStatus {
+	return i.status
+}
+
+func (i *OrganizationInvitation) InvitedBy() shared.UUID[user.User] {
+	return i.invitedBy
+}
+
+func (i *OrganizationInvitation) ExpiresAt() time.Time {
+	return i.expiresAt
+}
+
+func (i *OrganizationInvitation) SendCount() int {
+	return i.sendCount
+}
+
+func (i *OrganizationInvitation) LastSentAt() time.Time {
+	return i.lastSentAt
+}
+
+func (i *OrganizationInvitation) AcceptedBy() *shared.UUID[user.User] {
+	return i.acceptedBy
+}
+
+func (i *OrganizationInvitation) AcceptedAt() *time.Time {
+	return i.acceptedAt
+}
+
+func (i *OrganizationInvitation) RevokedAt() *time.Time {
+	return i.revokedAt
+}
+
+func (i *OrganizationInvitation) CreatedAt() time.Time {
+	return i.createdAt
+}
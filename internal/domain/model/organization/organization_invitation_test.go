@@ -0,0 +1,156 @@
+package organization_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newInvitation(t *testing.T, now time.Time) (*organization.OrganizationInvitation, string) {
+	t.Helper()
+	invitation, token, err := organization.NewOrganizationInvitation(
+		shared.NewUUID[organization.Organization](),
+		"member@example.com",
+		"email-hash",
+		organization.OrganizationUserRoleMember,
+		shared.NewUUID[user.User](),
+		"pepper",
+		now,
+	)
+	require.NoError(t, err)
+	return invitation, token
+}
+
+func TestNormalizeInvitationEmail(t *testing.T) {
+	tests := []struct {
+		name    string
+		email   string
+		want    string
+		wantErr bool
+	}{
+		{name: "前後の空白と大文字は正規化される", email: "  Member@Example.COM ", want: "member@example.com"},
+		{name: "表示名付きの形式は受け付けない", email: "Member <member@example.com>", wantErr: true},
+		{name: "@のない文字列は受け付けない", email: "member", wantErr: true},
+		{name: "空文字は受け付けない", email: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := organization.NormalizeInvitationEmail(tt.email)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, organization.ErrInvalidInvitationEmail)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewOrganizationInvitation(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("トークンはハッシュのみ保持し7日間有効", func(t *testing.T) {
+		invitation, token := newInvitation(t, now)
+
+		assert.NotEmpty(t, token)
+		assert.Equal(t, organization.HashInvitationToken(token, "pepper"), invitation.TokenHash())
+		assert.Equal(t, organization.InvitationStatusPending, invitation.Status(now))
+		assert.Equal(t, now.Add(organization.InvitationLifetime), invitation.ExpiresAt())
+		assert.Equal(t, 1, invitation.SendCount())
+	})
+
+	t.Run("運営ロールでは招待できない", func(t *testing.T) {
+		_, _, err := organization.NewOrganizationInvitation(
+			shared.NewUUID[organization.Organization](), "member@example.com", "email-hash",
+			organization.OrganizationUserRoleSuperAdmin, shared.NewUUID[user.User](), "pepper", now,
+		)
+		assert.ErrorIs(t, err, organization.ErrInvalidInvitationRole)
+	})
+}
+
+func TestOrganizationInvitation_Accept(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	userID := shared.NewUUID[user.User]()
+
+	t.Run("招待先のメールアドレスのユーザーは承諾できる", func(t *testing.T) {
+		invitation, _ := newInvitation(t, now)
+
+		require.NoError(t, invitation.Accept(userID, "email-hash", now.Add(time.Hour)))
+		assert.Equal(t, organization.InvitationStatusAccepted, invitation.Status(now))
+		assert.Equal(t, userID, *invitation.AcceptedBy())
+	})
+
+	t.Run("異なるメールアドレスのユーザーは承諾できない", func(t *testing.T) {
+		invitation, _ := newInvitation(t, now)
+
+		assert.ErrorIs(t, invitation.Accept(userID, "other-hash", now), organization.ErrInvitationEmailMismatch)
+		assert.ErrorIs(t, invitation.Accept(userID, "", now), organization.ErrInvitationEmailMismatch)
+	})
+
+	t.Run("期限切れの招待は承諾できない", func(t *testing.T) {
+		invitation, _ := newInvitation(t, now)
+		expired := now.Add(organization.InvitationLifetime)
+
+		assert.Equal(t, organization.InvitationStatusExpired, invitation.Status(expired))
+		assert.ErrorIs(t, invitation.Accept(userID, "email-hash", expired), organization.ErrInvitationExpired)
+	})
+
+	t.Run("取り消した招待は承諾できない", func(t *testing.T) {
+		invitation, _ := newInvitation(t, now)
+		require.NoError(t, invitation.Revoke(now))
+
+		assert.ErrorIs(t, invitation.Accept(userID, "email-hash", now), organization.ErrInvitationNotPending)
+	})
+}
+
+func TestOrganizationInvitation_Resend(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("再送するとトークンが変わり有効期限が延長される", func(t *testing.T) {
+		invitation, oldToken := newInvitation(t, now)
+		// 期限切れの招待も再送できる
+		later := now.Add(organization.InvitationLifetime + time.Hour)
+
+		newToken, err := invitation.Resend("pepper", later)
+		require.NoError(t, err)
+
+		assert.NotEqual(t, oldToken, newToken)
+		assert.Equal(t, organization.HashInvitationToken(newToken, "pepper"), invitation.TokenHash())
+		assert.Equal(t, organization.InvitationStatusPending, invitation.Status(later))
+		assert.Equal(t, later.Add(organization.InvitationLifetime), invitation.ExpiresAt())
+		assert.Equal(t, 2, invitation.SendCount())
+	})
+
+	t.Run("送信回数の上限を超えて再送できない", func(t *testing.T) {
+		invitation, _ := newInvitation(t, now)
+		for range organization.InvitationMaxSendCount - 1 {
+			_, err := invitation.Resend("pepper", now)
+			require.NoError(t, err)
+		}
+
+		_, err := invitation.Resend("pepper", now)
+		assert.ErrorIs(t, err, organization.ErrInvitationResendLimit)
+	})
+
+	t.Run("承諾済みの招待は再送できない", func(t *testing.T) {
+		invitation, _ := newInvitation(t, now)
+		require.NoError(t, invitation.Accept(shared.NewUUID[user.User](), "email-hash", now))
+
+		_, err := invitation.Resend("pepper", now)
+		assert.ErrorIs(t, err, organization.ErrInvitationNotPending)
+	})
+}
+
+func TestOrganizationInvitation_Revoke(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	invitation, _ := newInvitation(t, now)
+
+	require.NoError(t, invitation.Revoke(now))
+	assert.Equal(t, organization.InvitationStatusRevoked, invitation.Status(now))
+	assert.ErrorIs(t, invitation.Revoke(now), organization.ErrInvitationNotPending)
+}
@@ -12,11 +12,17 @@ import (
 type OrganizationUserRepository interface {
 	// OrganizationUserの取得
 	FindByOrganizationIDAndUserID(ctx context.Context, orgID shared.UUID[Organization], userID shared.UUID[user.User]) (*OrganizationUser, error)
+	// FindEffectiveByOrganizationIDAndUserID 祖先の組織から継承したロールを含め、組織での実効ロールを持つ所属を返す
+	FindEffectiveByOrganizationIDAndUserID(ctx context.Context, orgID shared.UUID[Organization], userID shared.UUID[user.User]) (*OrganizationUser, error)
 	FindByOrganizationID(ctx context.Context, orgID shared.UUID[Organization]) ([]*OrganizationUser, error)
 	FindByUserID(ctx context.Context, userID shared.UUID[user.User]) ([]*OrganizationUser, error)
+	// FindOwnersForUpdate 組織のオーナーを返し、トランザクションが終わるまで行をロックする
+	FindOwnersForUpdate(ctx context.Context, orgID shared.UUID[Organization]) ([]*OrganizationUser, error)
 
 	// OrganizationUserの作成・更新・削除
 	Create(ctx context.Context, orgUser OrganizationUser) error
+	Update(ctx context.Context, orgUser OrganizationUser) error
+	Delete(ctx context.Context, orgID shared.UUID[Organization], userID shared.UUID[user.User]) error
 }
 
 type OrganizationUserRole int
@@ -69,11 +75,23 @@ const (
 	OrganizationUserRoleMember     OrganizationUserRole = 40
 )
 
+// IsValid 定義済みのロールかどうか
+func (r OrganizationUserRole) IsValid() bool {
+	switch r {
+	case OrganizationUserRoleSuperAdmin, OrganizationUserRoleOwner, OrganizationUserRoleAdmin, OrganizationUserRoleMember:
+		return true
+	default:
+		return false
+	}
+}
+
 type OrganizationUser struct {
 	OrganizationUserID shared.UUID[OrganizationUser]
 	OrganizationID     shared.UUID[Organization]
 	UserID             shared.UUID[user.User]
 	Role               OrganizationUserRole
+	// InheritedFrom 祖先の組織から継承した所属の場合、継承元の組織ID
+	InheritedFrom *shared.UUID[Organization]
 }
 
 // NewOrganizationUser は新しいOrganizationUserを作成するのじゃ
@@ -91,6 +109,11 @@ func NewOrganizationUser(
 	}
 }
 
+// IsInherited 祖先の組織から継承した所属か
+func (ou *OrganizationUser) IsInherited() bool {
+	return ou.InheritedFrom != nil
+}
+
 // SetRole
 func (ou *OrganizationUser) SetRole(role OrganizationUserRole) error {
 	if role < OrganizationUserRoleSuperAdmin || role > OrganizationUserRoleMember {
@@ -104,3 +127,12 @@ func (ou *OrganizationUser) SetRole(role OrganizationUserRole) error {
 func (ou *OrganizationUser) HasPermissionToChangeRoleTo(targetRole OrganizationUserRole) bool {
 	return int(ou.Role) <= int(targetRole) && ou.Role <= OrganizationUserRoleAdmin
 }
+
+// CanManage 対象ユーザーのロール変更・削除ができるか
+// Admin以上で、かつ自分と同じかそれ以下のロールのユーザーのみ操作できる
+func (ou *OrganizationUser) CanManage(target *OrganizationUser) bool {
+	if target == nil || ou.UserID == target.UserID {
+		return false
+	}
+	return ou.HasPermissionToChangeRoleTo(target.Role)
+}
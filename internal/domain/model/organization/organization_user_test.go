@@ -325,3 +325,84 @@ func TestNewOrganizationUser(t *testing.T) {
 		})
 	}
 }
+
+func TestOrganizationUserRole_IsValid(t *testing.T) {
+	tests := []struct {
+		name     string
+		role     OrganizationUserRole
+		expected bool
+	}{
+		{name: "SuperAdmin is valid", role: OrganizationUserRoleSuperAdmin, expected: true},
+		{name: "Owner is valid", role: OrganizationUserRoleOwner, expected: true},
+		{name: "Admin is valid", role: OrganizationUserRoleAdmin, expected: true},
+		{name: "Member is valid", role: OrganizationUserRoleMember, expected: true},
+		{name: "Role between defined values is invalid", role: 25, expected: false},
+		{name: "Zero is invalid", role: 0, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.role.IsValid())
+		})
+	}
+}
+
+func TestOrganizationUser_CanManage(t *testing.T) {
+	newOrgUser := func(role OrganizationUserRole) *OrganizationUser {
+		return &OrganizationUser{
+			OrganizationUserID: shared.NewUUID[OrganizationUser](),
+			OrganizationID:     shared.NewUUID[Organization](),
+			UserID:             shared.NewUUID[user.User](),
+			Role:               role,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		operator *OrganizationUser
+		target   *OrganizationUser
+		expected bool
+	}{
+		{
+			name:     "Owner can manage Admin",
+			operator: newOrgUser(OrganizationUserRoleOwner),
+			target:   newOrgUser(OrganizationUserRoleAdmin),
+			expected: true,
+		},
+		{
+			name:     "Owner can manage another Owner",
+			operator: newOrgUser(OrganizationUserRoleOwner),
+			target:   newOrgUser(OrganizationUserRoleOwner),
+			expected: true,
+		},
+		{
+			name:     "Admin cannot manage Owner",
+			operator: newOrgUser(OrganizationUserRoleAdmin),
+			target:   newOrgUser(OrganizationUserRoleOwner),
+			expected: false,
+		},
+		{
+			name:     "Member cannot manage Member",
+			operator: newOrgUser(OrganizationUserRoleMember),
+			target:   newOrgUser(OrganizationUserRoleMember),
+			expected: false,
+		},
+		{
+			name:     "Nil target cannot be managed",
+			operator: newOrgUser(OrganizationUserRoleOwner),
+			target:   nil,
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.operator.CanManage(tt.target))
+		})
+	}
+
+	t.Run("Cannot manage self", func(t *testing.T) {
+		owner := newOrgUser(OrganizationUserRoleOwner)
+		assert.False(t, owner.CanManage(owner))
+	})
+}
@@ -0,0 +1,40 @@
+package ownership
+
+import (
+	"github.com/neko-dream/api/internal/domain/model/event"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/user"
+)
+
+const (
+	EventTypeOwnershipTransferRequested event.EventType = "ownership.transfer_requested"
+	EventTypeOwnershipTransferAccepted  event.EventType = "ownership.transfer_accepted"
+	EventTypeOwnershipTransferDeclined  event.EventType = "ownership.transfer_declined"
+)
+
+// OwnershipTransferEvent 譲渡依頼の作成・承諾・辞退のイベント。関係するユーザーへの通知に使う
+type OwnershipTransferEvent struct {
+	event.BaseEvent
+	TransferID     shared.UUID[OwnershipTransfer]          `json:"transfer_id"`
+	TargetType     TransferTargetType                      `json:"target_type"`
+	TalkSessionID  *shared.UUID[talksession.TalkSession]   `json:"talk_session_id,omitempty"`
+	OrganizationID *shared.UUID[organization.Organization] `json:"organization_id,omitempty"`
+	FromUserID     shared.UUID[user.User]                  `json:"from_user_id"`
+	ToUserID       shared.UUID[user.User]                  `json:"to_user_id"`
+	RequestedBy    shared.UUID[user.User]                  `json:"requested_by"`
+}
+
+func NewOwnershipTransferEvent(eventType event.EventType, transfer *OwnershipTransfer) *OwnershipTransferEvent {
+	return &OwnershipTransferEvent{
+		BaseEvent:      event.NewBaseEvent(eventType, transfer.TransferID().String(), "OwnershipTransfer"),
+		TransferID:     transfer.TransferID(),
+		TargetType:     transfer.TargetType(),
+		TalkSessionID:  transfer.TalkSessionID(),
+		OrganizationID: transfer.OrganizationID(),
+		FromUserID:     transfer.FromUserID(),
+		ToUserID:       transfer.ToUserID(),
+		RequestedBy:    transfer.RequestedBy(),
+	}
+}
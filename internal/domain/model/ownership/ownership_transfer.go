@@ -0,0 +1,288 @@
+package ownership
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/neko-dream/api/internal/domain/model/event"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/user"
+)
+
+// TransferTargetType 譲渡する対象の種類
+type TransferTargetType string
+
+const (
+	TransferTargetTalkSession  TransferTargetType = "talksession"
+	TransferTargetOrganization TransferTargetType = "organization"
+)
+
+// TransferStatus 譲渡依頼の状態
+type TransferStatus string
+
+const (
+	TransferStatusPending   TransferStatus = "pending"
+	TransferStatusAccepted  TransferStatus = "accepted"
+	TransferStatusDeclined  TransferStatus = "declined"
+	TransferStatusCancelled TransferStatus = "cancelled"
+	// TransferStatusExpired 期限切れ。保存はせず、有効期限から判定する
+	TransferStatusExpired TransferStatus = "expired"
+)
+
+// TransferLifetime 譲渡依頼の有効期間
+const TransferLifetime = 14 * 24 * time.Hour
+
+var (
+	ErrTransferToSelf       = errors.New("現在のオーナーには譲渡できません")
+	ErrTransferNotPending   = errors.New("譲渡依頼は既に承諾・辞退・取り消しされています")
+	ErrTransferExpired      = errors.New("譲渡依頼の有効期限が切れています")
+	ErrNotTransferRecipient = errors.New("譲渡先のユーザーではありません")
+	ErrTransferOutdated     = errors.New("依頼後にオーナーが変更されています")
+)
+
+// OwnershipTransferRepository リポジトリインターフェース
+type OwnershipTransferRepository interface {
+	Create(ctx context.Context, transfer *OwnershipTransfer) error
+	Update(ctx context.Context, transfer *OwnershipTransfer) error
+	// FindByID 存在しない場合はnilを返す
+	FindByID(ctx context.Context, transferID shared.UUID[OwnershipTransfer]) (*OwnershipTransfer, error)
+	// FindByIDForUpdate トランザクションが終わるまで依頼の行をロックして取得する。存在しない場合はnilを返す
+	FindByIDForUpdate(ctx context.Context, transferID shared.UUID[OwnershipTransfer]) (*OwnershipTransfer, error)
+	// FindPendingByTalkSessionID 有効期限内の未承諾の依頼を取得する。存在しない場合はnilを返す
+	FindPendingByTalkSessionID(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession]) (*OwnershipTransfer, error)
+	// FindPendingByOrganizationID 組織そのものの有効期限内の未承諾の依頼を取得する。存在しない場合はnilを返す
+	FindPendingByOrganizationID(ctx context.Context, organizationID shared.UUID[organization.Organization]) (*OwnershipTransfer, error)
+}
+
+// OwnershipTransfer セッション・組織のオーナー権限の譲渡依頼
+// 譲渡先のユーザーが承諾するまでオーナーは変更しない
+type OwnershipTransfer struct {
+	event.EventRecorder
+	transferID     shared.UUID[OwnershipTransfer]
+	targetType     TransferTargetType
+	talkSessionID  *shared.UUID[talksession.TalkSession]
+	organizationID *shared.UUID[organization.Organization]
+	fromUserID     shared.UUID[user.User]
+	toUserID       shared.UUID[user.User]
+	requestedBy    shared.UUID[user.User]
+	status         TransferStatus
+	expiresAt      time.Time
+	respondedAt    *time.Time
+	createdAt      time.Time
+}
+
+// NewTalkSessionOwnershipTransfer セッションの譲渡依頼を作成する
+// requestedByは現在のオーナーか、退職者のセッションを引き継がせる組織の管理者
+func NewTalkSessionOwnershipTransfer(
+	talkSession *talksession.TalkSession,
+	toUserID shared.UUID[user.User],
+	requestedBy shared.UUID[user.User],
+	now time.Time,
+) (*OwnershipTransfer, error) {
+	if talkSession.OwnerUserID() == toUserID {
+		return nil, ErrTransferToSelf
+	}
+
+	talkSessionID := talkSession.TalkSessionID()
+	return newOwnershipTransfer(
+		TransferTargetTalkSession,
+		&talkSessionID,
+		talkSession.OrganizationID(),
+		talkSession.OwnerUserID(),
+		toUserID,
+		requestedBy,
+		now,
+	), nil
+}
+
+// NewOrganizationOwnershipTransfer 組織の譲渡依頼を作成する
+func NewOrganizationOwnershipTransfer(
+	org *organization.Organization,
+	toUserID shared.UUID[user.User],
+	requestedBy shared.UUID[user.User],
+	now time.Time,
+) (*OwnershipTransfer, error) {
+	if org.OwnerID == toUserID {
+		return nil, ErrTransferToSelf
+	}
+
+	organizationID := org.OrganizationID
+	return newOwnershipTransfer(
+		TransferTargetOrganization,
+		nil,
+		&organizationID,
+		org.OwnerID,
+		toUserID,
+		requestedBy,
+		now,
+	), nil
+}
+
+func newOwnershipTransfer(
+	targetType TransferTargetType,
+	talkSessionID *shared.UUID[talksession.TalkSession],
+	organizationID *shared.UUID[organization.Organization],
+	fromUserID shared.UUID[user.User],
+	toUserID shared.UUID[user.User],
+	requestedBy shared.UUID[user.User],
+	now time.Time,
+) *OwnershipTransfer {
+	t := &OwnershipTransfer{
+		transferID:     shared.NewUUID[OwnershipTransfer](),
+		targetType:     targetType,
+		talkSessionID:  talkSessionID,
+		organizationID: organizationID,
+		fromUserID:     fromUserID,
+		toUserID:       toUserID,
+		requestedBy:    requestedBy,
+		status:         TransferStatusPending,
+		expiresAt:      now.Add(TransferLifetime),
+		createdAt:      now,
+	}
+	t.RecordEvent(NewOwnershipTransferEvent(EventTypeOwnershipTransferRequested, t))
+	return t
+}
+
+// ReconstructOwnershipTransfer DBから取得したデータで譲渡依頼を再構築
+func ReconstructOwnershipTransfer(
+	transferID shared.UUID[OwnershipTransfer],
+	targetType TransferTargetType,
+	talkSessionID *shared.UUID[talksession.TalkSession],
+	organizationID *shared.UUID[organization.Organization],
+	fromUserID shared.UUID[user.User],
+	toUserID shared.UUID[user.User],
+	requestedBy shared.UUID[user.User],
+	status TransferStatus,
+	expiresAt time.Time,
+	respondedAt *time.Time,
+	createdAt time.Time,
+) *OwnershipTransfer {
+	return &OwnershipTransfer{
+		transferID:     transferID,
+		targetType:     targetType,
+		talkSessionID:  talkSessionID,
+		organizationID: organizationID,
+		fromUserID:     fromUserID,
+		toUserID:       toUserID,
+		requestedBy:    requestedBy,
+		status:         status,
+		expiresAt:      expiresAt,
+		respondedAt:    respondedAt,
+		createdAt:      createdAt,
+	}
+}
+
+// Status nowの時点での状態。未承諾で有効期限を過ぎたものはTransferStatusExpiredを返す
+func (t *OwnershipTransfer) Status(now time.Time) TransferStatus {
+	if t.status == TransferStatusPending && !now.Before(t.expiresAt) {
+		return TransferStatusExpired
+	}
+	return t.status
+}
+
+// Accept 譲渡先のユーザーが依頼を承諾する
+// currentOwnerIDは対象の現在のオーナーで、依頼後にオーナーが変わっている場合は承諾できない
+func (t *OwnershipTransfer) Accept(userID shared.UUID[user.User], currentOwnerID shared.UUID[user.User], now time.Time) error {
+	if err := t.respond(userID, now); err != nil {
+		return err
+	}
+	if currentOwnerID != t.fromUserID {
+		return ErrTransferOutdated
+	}
+
+	t.status = TransferStatusAccepted
+	t.respondedAt = &now
+	t.RecordEvent(NewOwnershipTransferEvent(EventTypeOwnershipTransferAccepted, t))
+	return nil
+}
+
+// Decline 譲渡先のユーザーが依頼を辞退する
+func (t *OwnershipTransfer) Decline(userID shared.UUID[user.User], now time.Time) error {
+	if err := t.respond(userID, now); err != nil {
+		return err
+	}
+
+	t.status = TransferStatusDeclined
+	t.respondedAt = &now
+	t.RecordEvent(NewOwnershipTransferEvent(EventTypeOwnershipTransferDeclined, t))
+	return nil
+}
+
+// Cancel 依頼を取り消す。期限切れの依頼も取り消せる
+func (t *OwnershipTransfer) Cancel(now time.Time) error {
+	if t.status != TransferStatusPending {
+		return ErrTransferNotPending
+	}
+	t.status = TransferStatusCancelled
+	t.respondedAt = &now
+	return nil
+}
+
+// CanCancel 依頼者か、譲渡元のユーザーであれば取り消せる
+func (t *OwnershipTransfer) CanCancel(userID shared.UUID[user.User]) bool {
+	return userID == t.requestedBy || userID == t.fromUserID
+}
+
+func (t *OwnershipTransfer) respond(userID shared.UUID[user.User], now time.Time) error {
+	if userID != t.toUserID {
+		return ErrNotTransferRecipient
+	}
+	switch t.Status(now) {
+	case TransferStatusPending:
+		return nil
+	case TransferStatusExpired:
+		return ErrTransferExpired
+	default:
+		return ErrTransferNotPending
+	}
+}
+
+func (t *OwnershipTransfer) TransferID() shared.UUID[OwnershipTransfer] {
+	return t.transferID
+}
+
+func (t *OwnershipTransfer) TargetType() TransferTargetType {
+	return t.targetType
+}
+
+// TalkSessionID セッションの譲渡の場合のみ値を持つ
+func (t *OwnershipTransfer) TalkSessionID() *shared.UUID[talksession.TalkSession] {
+	return t.talkSessionID
+}
+
+// OrganizationID 組織の譲渡では対象の組織、セッションの譲渡ではセッションが所属する組織
+func (t *OwnershipTransfer) OrganizationID() *shared.UUID[organization.Organization] {
+	return t.organizationID
+}
+
+func (t *OwnershipTransfer) FromUserID() shared.UUID[user.User] {
+	return t.fromUserID
+}
+
+func (t *OwnershipTransfer) ToUserID() shared.UUID[user.User] {
+	return t.toUserID
+}
+
+func (t *OwnershipTransfer) RequestedBy() shared.UUID[user.User] {
+	return t.requestedBy
+}
+
+// StoredStatus 保存されている状態。期限切れの判定はStatusを使う
+func (t *OwnershipTransfer) StoredStatus() TransferStatus {
+	return t.status
+}
+
+func (t *OwnershipTransfer) ExpiresAt() time.Time {
+	return t.expiresAt
+}
+
+func (t *OwnershipTransfer) RespondedAt() *time.Time {
+	return t.respondedAt
+}
+
+func (t *OwnershipTransfer) CreatedAt() time.Time {
+	return t.createdAt
+}
@@ -0,0 +1,201 @@
+package ownership_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/ownership"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTalkSession(ownerID shared.UUID[user.User], now time.Time) *talksession.TalkSession {
+	orgID := shared.NewUUID[organization.Organization]()
+	return talksession.NewTalkSession(
+		shared.NewUUID[talksession.TalkSession](),
+		"テーマ",
+		nil,
+		nil,
+		ownerID,
+		now,
+		now.Add(24*time.Hour),
+		nil,
+		nil,
+		nil,
+		false,
+		&orgID,
+		nil,
+	)
+}
+
+func TestNewTalkSessionOwnershipTransfer(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ownerID := shared.NewUUID[user.User]()
+	ts := newTalkSession(ownerID, now)
+
+	t.Run("現在のオーナーには譲渡できない", func(t *testing.T) {
+		_, err := ownership.NewTalkSessionOwnershipTransfer(ts, ownerID, ownerID, now)
+		assert.ErrorIs(t, err, ownership.ErrTransferToSelf)
+	})
+
+	t.Run("譲渡元は現在のオーナーで、14日間有効な依頼が作成される", func(t *testing.T) {
+		toUserID := shared.NewUUID[user.User]()
+		adminID := shared.NewUUID[user.User]()
+		transfer, err := ownership.NewTalkSessionOwnershipTransfer(ts, toUserID, adminID, now)
+		require.NoError(t, err)
+
+		assert.Equal(t, ownership.TransferTargetTalkSession, transfer.TargetType())
+		assert.Equal(t, ts.TalkSessionID(), *transfer.TalkSessionID())
+		assert.Equal(t, *ts.OrganizationID(), *transfer.OrganizationID())
+		assert.Equal(t, ownerID, transfer.FromUserID())
+		assert.Equal(t, toUserID, transfer.ToUserID())
+		assert.Equal(t, adminID, transfer.RequestedBy())
+		assert.Equal(t, ownership.TransferStatusPending, transfer.Status(now))
+		assert.Equal(t, now.Add(ownership.TransferLifetime), transfer.ExpiresAt())
+
+		events := transfer.GetRecordedEvents()
+		require.Len(t, events, 1)
+		assert.Equal(t, ownership.EventTypeOwnershipTransferRequested, events[0].EventType())
+	})
+}
+
+func TestNewOrganizationOwnershipTransfer(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ownerID := shared.NewUUID[user.User]()
+	org := organization.NewOrganization(shared.NewUUID[organization.Organization](), organization.OrganizationTypeNormal, "組織", "org_code", nil, ownerID)
+
+	_, err := ownership.NewOrganizationOwnershipTransfer(org, ownerID, ownerID, now)
+	assert.ErrorIs(t, err, ownership.ErrTransferToSelf)
+
+	transfer, err := ownership.NewOrganizationOwnershipTransfer(org, shared.NewUUID[user.User](), ownerID, now)
+	require.NoError(t, err)
+	assert.Equal(t, ownership.TransferTargetOrganization, transfer.TargetType())
+	assert.Nil(t, transfer.TalkSessionID())
+	assert.Equal(t, org.OrganizationID, *transfer.OrganizationID())
+}
+
+func TestOwnershipTransfer_Accept(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ownerID := shared.NewUUID[user.User]()
+	toUserID := shared.NewUUID[user.User]()
+
+	tests := []struct {
+		name           string
+		userID         shared.UUID[user.User]
+		currentOwnerID shared.UUID[user.User]
+		at             time.Time
+		prepare        func(transfer *ownership.OwnershipTransfer)
+		wantErr        error
+	}{
+		{
+			name:           "譲渡先のユーザーは承諾できる",
+			userID:         toUserID,
+			currentOwnerID: ownerID,
+			at:             now.Add(time.Hour),
+		},
+		{
+			name:           "譲渡先以外のユーザーは承諾できない",
+			userID:         ownerID,
+			currentOwnerID: ownerID,
+			at:             now.Add(time.Hour),
+			wantErr:        ownership.ErrNotTransferRecipient,
+		},
+		{
+			name:           "有効期限を過ぎると承諾できない",
+			userID:         toUserID,
+			currentOwnerID: ownerID,
+			at:             now.Add(ownership.TransferLifetime),
+			wantErr:        ownership.ErrTransferExpired,
+		},
+		{
+			name:           "依頼後にオーナーが変わっていると承諾できない",
+			userID:         toUserID,
+			currentOwnerID: shared.NewUUID[user.User](),
+			at:             now.Add(time.Hour),
+			wantErr:        ownership.ErrTransferOutdated,
+		},
+		{
+			name:           "取り消された依頼は承諾できない",
+			userID:         toUserID,
+			currentOwnerID: ownerID,
+			at:             now.Add(time.Hour),
+			prepare: func(transfer *ownership.OwnershipTransfer) {
+				require.NoError(t, transfer.Cancel(now))
+			},
+			wantErr: ownership.ErrTransferNotPending,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transfer, err := ownership.NewTalkSessionOwnershipTransfer(newTalkSession(ownerID, now), toUserID, ownerID, now)
+			require.NoError(t, err)
+			transfer.ClearRecordedEvents()
+			if tt.prepare != nil {
+				tt.prepare(transfer)
+			}
+
+			err = transfer.Accept(tt.userID, tt.currentOwnerID, tt.at)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, transfer.GetRecordedEvents())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, ownership.TransferStatusAccepted, transfer.Status(tt.at))
+			assert.Equal(t, tt.at, *transfer.RespondedAt())
+
+			events := transfer.GetRecordedEvents()
+			require.Len(t, events, 1)
+			assert.Equal(t, ownership.EventTypeOwnershipTransferAccepted, events[0].EventType())
+		})
+	}
+}
+
+func TestOwnershipTransfer_Decline(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ownerID := shared.NewUUID[user.User]()
+	toUserID := shared.NewUUID[user.User]()
+
+	transfer, err := ownership.NewTalkSessionOwnershipTransfer(newTalkSession(ownerID, now), toUserID, ownerID, now)
+	require.NoError(t, err)
+	transfer.ClearRecordedEvents()
+
+	assert.ErrorIs(t, transfer.Decline(ownerID, now), ownership.ErrNotTransferRecipient)
+	require.NoError(t, transfer.Decline(toUserID, now))
+	assert.Equal(t, ownership.TransferStatusDeclined, transfer.Status(now))
+	assert.ErrorIs(t, transfer.Decline(toUserID, now), ownership.ErrTransferNotPending)
+
+	events := transfer.GetRecordedEvents()
+	require.Len(t, events, 1)
+	assert.Equal(t, ownership.EventTypeOwnershipTransferDeclined, events[0].EventType())
+}
+
+func TestOwnershipTransfer_Cancel(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ownerID := shared.NewUUID[user.User]()
+	adminID := shared.NewUUID[user.User]()
+	toUserID := shared.NewUUID[user.User]()
+
+	transfer, err := ownership.NewTalkSessionOwnershipTransfer(newTalkSession(ownerID, now), toUserID, adminID, now)
+	require.NoError(t, err)
+
+	t.Run("依頼者と譲渡元のユーザーのみ取り消せる", func(t *testing.T) {
+		assert.True(t, transfer.CanCancel(adminID))
+		assert.True(t, transfer.CanCancel(ownerID))
+		assert.False(t, transfer.CanCancel(toUserID))
+	})
+
+	t.Run("期限切れの依頼も取り消せるが、取り消し済みの依頼は取り消せない", func(t *testing.T) {
+		expired := now.Add(ownership.TransferLifetime)
+		assert.Equal(t, ownership.TransferStatusExpired, transfer.Status(expired))
+
+		require.NoError(t, transfer.Cancel(expired))
+		assert.Equal(t, ownership.TransferStatusCancelled, transfer.Status(expired))
+		assert.ErrorIs(t, transfer.Cancel(expired), ownership.ErrTransferNotPending)
+	})
+}
@@ -38,6 +38,8 @@ type (
 		OrganizationRole       *string    `json:"organizationRole,omitempty"` // 組織でのロール名
 		IsWithdrawn            bool       `json:"isWithdrawn,omitempty"`      // 退会ユーザーフラグ
 		WithdrawalDate         *time.Time `json:"withdrawalDate,omitempty"`   // 退会日時
+		APIKeyID               *string    `json:"apiKeyID,omitempty"`         // APIキーで認証した場合のキーID
+		APIKeyScopes           []string   `json:"apiKeyScopes,omitempty"`     // APIキーに許可されたスコープ
 	}
 )
 
@@ -144,6 +146,11 @@ func (c *Claim) SessionID() (shared.UUID[Session], error) {
 	return shared.ParseUUID[Session](c.Jti)
 }
 
+// IsAPIKey APIキーによる認証で作られたクレームか
+func (c *Claim) IsAPIKey() bool {
+	return c.APIKeyID != nil
+}
+
 func (c *Claim) Audience() string {
 	return Audience
 }
@@ -22,6 +22,8 @@ type (
 		// 終了処理用の新規メソッド
 		GetUnprocessedEndedSessions(ctx context.Context, limit int) ([]*TalkSession, error)
 		GetParticipantIDs(ctx context.Context, talkSessionID shared.UUID[TalkSession]) ([]shared.UUID[user.User], error)
+		// FindByOrganizationIDAndOwnerID 組織内で指定したユーザーがオーナーのセッションを取得する（下書きを含む）
+		FindByOrganizationIDAndOwnerID(ctx context.Context, organizationID shared.UUID[organization.Organization], ownerUserID shared.UUID[user.User]) ([]*TalkSession, error)
 	}
 
 	TalkSession struct {
@@ -40,6 +42,7 @@ type (
 		organizationID      *shared.UUID[organization.Organization]
 		organizationAliasID *shared.UUID[organization.OrganizationAlias]
 		hideTop             bool // トップに表示するかどうか
+		isDraft             bool // 下書きかどうか
 		// イベント記録用（埋め込み）
 		event.EventRecorder
 		// 終了処理済みフラグ
@@ -152,6 +155,74 @@ func (t *TalkSession) ChangeHideTop(hideTop bool) {
 	t.hideTop = hideTop
 }
 
+// ChangeOwner オーナーを変更する。譲渡の承諾時に使う
+func (t *TalkSession) ChangeOwner(ownerUserID shared.UUID[user.User]) {
+	t.ownerUserID = ownerUserID
+}
+
+// IsDraft 下書きかどうか。下書きは公開するまで一覧に表示されず、オーナー以外は参加できない
+func (t *TalkSession) IsDraft() bool {
+	return t.isDraft
+}
+
+// MarkAsDraft 下書きとしてマーク（リポジトリ用）
+func (t *TalkSession) MarkAsDraft() {
+	t.isDraft = true
+}
+
+// Publish 下書きを公開し、開始イベントを記録する
+func (t *TalkSession) Publish(ctx context.Context) error {
+	ctx, span := otel.Tracer("talksession").Start(ctx, "TalkSession.Publish")
+	defer span.End()
+
+	if !t.isDraft {
+		return ErrSessionNotDraft
+	}
+	if t.IsFinished(ctx) {
+		return ErrSessionAlreadyEnded
+	}
+	if err := t.StartSession(); err != nil {
+		return err
+	}
+	t.isDraft = false
+	return nil
+}
+
+// CloneAsDraft 設定を引き継いだ下書きのセッションを作成する
+// 参加制限・サムネイル・位置情報・組織は引き継ぎ、投票や参加者の情報は引き継がない
+func (t *TalkSession) CloneAsDraft(
+	talkSessionID shared.UUID[TalkSession],
+	ownerUserID shared.UUID[user.User],
+	theme string,
+	createdAt time.Time,
+	scheduledEndTime time.Time,
+) *TalkSession {
+	var location *Location
+	if t.location != nil {
+		location = NewLocation(talkSessionID, t.location.latitude, t.location.longitude)
+	}
+
+	cloned := NewTalkSession(
+		talkSessionID,
+		theme,
+		t.description,
+		t.thumbnailURL,
+		ownerUserID,
+		createdAt,
+		scheduledEndTime,
+		location,
+		t.city,
+		t.prefecture,
+		t.hideTop,
+		t.organizationID,
+		t.organizationAliasID,
+	)
+	cloned.restrictions = append([]*RestrictionAttribute{}, t.restrictions...)
+	cloned.hideReport = t.hideReport
+	cloned.isDraft = true
+	return cloned
+}
+
 func (t *TalkSession) Restrictions() []*RestrictionAttribute {
 	return t.restrictions
 }
@@ -287,4 +358,5 @@ var (
 	ErrSessionAlreadyStarted = errors.New("session has already been started")
 	ErrSessionAlreadyEnded   = errors.New("session has already been ended")
 	ErrSessionNotYetFinished = errors.New("session has not yet reached scheduled end time")
+	ErrSessionNotDraft       = errors.New("session is not a draft")
 )
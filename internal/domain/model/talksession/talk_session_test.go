@@ -470,3 +470,89 @@ func TestTalkSession_UpdateRestrictions(t *testing.T) {
 		assert.NotNil(t, ts.Restrictions())
 	})
 }
+
+func TestTalkSession_CloneAsDraft(t *testing.T) {
+	orgID := shared.MustParseUUID[organization.Organization]("00000000-0000-0000-0000-000000000003")
+	aliasID := shared.MustParseUUID[organization.OrganizationAlias]("00000000-0000-0000-0000-000000000004")
+	source := talksession.NewTalkSession(
+		shared.MustParseUUID[talksession.TalkSession]("00000000-0000-0000-0000-000000000001"),
+		"元のテーマ",
+		lo.ToPtr("説明"),
+		lo.ToPtr("https://example.com/thumbnail.png"),
+		shared.MustParseUUID[user.User]("00000000-0000-0000-0000-000000000002"),
+		time.Now().Add(-48*time.Hour),
+		time.Now().Add(-24*time.Hour),
+		talksession.NewLocation(shared.MustParseUUID[talksession.TalkSession]("00000000-0000-0000-0000-000000000001"), 35.0, 139.0),
+		lo.ToPtr("千代田区"),
+		lo.ToPtr("東京都"),
+		true,
+		&orgID,
+		&aliasID,
+	)
+	require.NoError(t, source.UpdateRestrictions(context.Background(), []string{string(talksession.DemographicsGender)}))
+
+	newID := shared.MustParseUUID[talksession.TalkSession]("00000000-0000-0000-0000-000000000010")
+	newOwner := shared.MustParseUUID[user.User]("00000000-0000-0000-0000-000000000011")
+	endTime := time.Now().Add(24 * time.Hour)
+	cloned := source.CloneAsDraft(newID, newOwner, "新しいテーマ", time.Now(), endTime)
+
+	assert.True(t, cloned.IsDraft())
+	assert.Equal(t, newID, cloned.TalkSessionID())
+	assert.Equal(t, newOwner, cloned.OwnerUserID())
+	assert.Equal(t, "新しいテーマ", cloned.Theme())
+	assert.Equal(t, endTime, cloned.ScheduledEndTime())
+	assert.Equal(t, source.Description(), cloned.Description())
+	assert.Equal(t, source.ThumbnailURL(), cloned.ThumbnailURL())
+	assert.Equal(t, source.RestrictionList(), cloned.RestrictionList())
+	assert.Equal(t, source.HideTop(), cloned.HideTop())
+	assert.Equal(t, &orgID, cloned.OrganizationID())
+	assert.Equal(t, &aliasID, cloned.OrganizationAliasID())
+	require.NotNil(t, cloned.Location())
+	assert.Equal(t, newID, cloned.Location().TalkSessionID())
+	// 開始イベントは公開時に記録する
+	assert.Empty(t, cloned.GetRecordedEvents())
+	assert.False(t, source.IsDraft())
+}
+
+func TestTalkSession_Publish(t *testing.T) {
+	newDraft := func(scheduledEndTime time.Time) *talksession.TalkSession {
+		ts := talksession.NewTalkSession(
+			shared.MustParseUUID[talksession.TalkSession]("00000000-0000-0000-0000-000000000001"),
+			"テーマ",
+			nil,
+			nil,
+			shared.MustParseUUID[user.User]("00000000-0000-0000-0000-000000000002"),
+			time.Now(),
+			scheduledEndTime,
+			nil,
+			nil,
+			nil,
+			false,
+			nil,
+			nil,
+		)
+		ts.MarkAsDraft()
+		return ts
+	}
+
+	t.Run("下書きを公開すると開始イベントが記録される", func(t *testing.T) {
+		ts := newDraft(time.Now().Add(time.Hour))
+		require.NoError(t, ts.Publish(context.Background()))
+		assert.False(t, ts.IsDraft())
+		events := ts.GetRecordedEvents()
+		require.Len(t, events, 1)
+		assert.Equal(t, talksession.EventTypeTalkSessionStarted, events[0].EventType())
+	})
+
+	t.Run("公開済みのセッションは公開できない", func(t *testing.T) {
+		ts := newDraft(time.Now().Add(time.Hour))
+		require.NoError(t, ts.Publish(context.Background()))
+		assert.Equal(t, talksession.ErrSessionNotDraft, ts.Publish(context.Background()))
+	})
+
+	t.Run("終了予定時刻を過ぎた下書きは公開できない", func(t *testing.T) {
+		ts := newDraft(time.Now().Add(-time.Hour))
+		assert.Equal(t, talksession.ErrSessionAlreadyEnded, ts.Publish(context.Background()))
+		assert.True(t, ts.IsDraft())
+	})
+}
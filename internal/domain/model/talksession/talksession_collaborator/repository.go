@@ -0,0 +1,17 @@
+package talksession_collaborator
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/user"
+)
+
+type TalkSessionCollaboratorRepository interface {
+	// Save 共同管理者を追加する。既に追加されている場合はロールを更新する
+	Save(ctx context.Context, collaborator *TalkSessionCollaborator) error
+	// FindByTalkSessionIDAndUserID 存在しない場合はnilを返す
+	FindByTalkSessionIDAndUserID(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession], userID shared.UUID[user.User]) (*TalkSessionCollaborator, error)
+	Delete(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession], userID shared.UUID[user.User]) error
+}
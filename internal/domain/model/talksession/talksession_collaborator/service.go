@@ -0,0 +1,53 @@
+package talksession_collaborator
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+// TalkSessionPermissionService セッションのオーナーと共同管理者の権限を判定する
+type TalkSessionPermissionService interface {
+	// HasPermission オーナーは全ての権限を持ち、共同管理者はロールに応じた権限を持つ
+	HasPermission(ctx context.Context, talkSession *talksession.TalkSession, userID shared.UUID[user.User], permission Permission) (bool, error)
+}
+
+type talkSessionPermissionService struct {
+	collaboratorRepository TalkSessionCollaboratorRepository
+}
+
+func NewTalkSessionPermissionService(
+	collaboratorRepository TalkSessionCollaboratorRepository,
+) TalkSessionPermissionService {
+	return &talkSessionPermissionService{
+		collaboratorRepository: collaboratorRepository,
+	}
+}
+
+func (s *talkSessionPermissionService) HasPermission(
+	ctx context.Context,
+	talkSession *talksession.TalkSession,
+	userID shared.UUID[user.User],
+	permission Permission,
+) (bool, error) {
+	ctx, span := otel.Tracer("talksession_collaborator").Start(ctx, "talkSessionPermissionService.HasPermission")
+	defer span.End()
+
+	if talkSession.OwnerUserID() == userID {
+		return true, nil
+	}
+
+	collaborator, err := s.collaboratorRepository.FindByTalkSessionIDAndUserID(ctx, talkSession.TalkSessionID(), userID)
+	if err != nil {
+		utils.HandleError(ctx, err, "TalkSessionCollaboratorRepository.FindByTalkSessionIDAndUserID")
+		return false, err
+	}
+	if collaborator == nil {
+		return false, nil
+	}
+	return collaborator.Role().Has(permission), nil
+}
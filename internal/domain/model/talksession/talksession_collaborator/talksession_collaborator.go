@@ -0,0 +1,158 @@
+package talksession_collaborator
+
+import (
+	"errors"
+	"time"
+
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/user"
+)
+
+var (
+	ErrInvalidRole            = errors.New("共同管理者のロールが不正です")
+	ErrOwnerCannotCollaborate = errors.New("セッションのオーナーは共同管理者にできません")
+)
+
+// CollaboratorRole セッションの共同管理者のロール
+type CollaboratorRole string
+
+const (
+	// RoleCoOwner オーナーと同等にセッションを編集でき、共同管理者を管理できる
+	RoleCoOwner CollaboratorRole = "co_owner"
+	// RoleFacilitator 結論の投稿やタイムラインの管理など、セッションの進行を担う
+	RoleFacilitator CollaboratorRole = "facilitator"
+	// RoleModerator 通報された意見に対応する
+	RoleModerator CollaboratorRole = "moderator"
+	// RoleViewer 管理画面の閲覧のみ
+	RoleViewer CollaboratorRole = "viewer"
+)
+
+func ParseCollaboratorRole(role string) (CollaboratorRole, error) {
+	switch r := CollaboratorRole(role); r {
+	case RoleCoOwner, RoleFacilitator, RoleModerator, RoleViewer:
+		return r, nil
+	}
+	return "", ErrInvalidRole
+}
+
+// Permission セッションに対する操作の権限
+type Permission int
+
+const (
+	// PermissionView 通報や共同管理者の一覧など、管理用の情報を閲覧する
+	PermissionView Permission = iota
+	// PermissionModerate 通報された意見に対応する
+	PermissionModerate
+	// PermissionManageTimeline タイムラインを追加・編集する
+	PermissionManageTimeline
+	// PermissionConclude 結論を投稿する
+	PermissionConclude
+	// PermissionEdit セッションの内容を編集する
+	PermissionEdit
+	// PermissionManageCollaborators 共同管理者を追加・変更・削除する
+	PermissionManageCollaborators
+)
+
+var rolePermissions = map[CollaboratorRole][]Permission{
+	RoleCoOwner: {
+		PermissionView,
+		PermissionModerate,
+		PermissionManageTimeline,
+		PermissionConclude,
+		PermissionEdit,
+		PermissionManageCollaborators,
+	},
+	RoleFacilitator: {
+		PermissionView,
+		PermissionModerate,
+		PermissionManageTimeline,
+		PermissionConclude,
+	},
+	RoleModerator: {
+		PermissionView,
+		PermissionModerate,
+	},
+	RoleViewer: {
+		PermissionView,
+	},
+}
+
+// Has ロールが指定した権限を持つか
+func (r CollaboratorRole) Has(permission Permission) bool {
+	for _, p := range rolePermissions[r] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// TalkSessionCollaborator セッションの共同管理者
+type TalkSessionCollaborator struct {
+	talkSessionID shared.UUID[talksession.TalkSession]
+	userID        shared.UUID[user.User]
+	role          CollaboratorRole
+	addedBy       shared.UUID[user.User]
+	createdAt     time.Time
+}
+
+func NewTalkSessionCollaborator(
+	talkSession *talksession.TalkSession,
+	userID shared.UUID[user.User],
+	role CollaboratorRole,
+	addedBy shared.UUID[user.User],
+	createdAt time.Time,
+) (*TalkSessionCollaborator, error) {
+	if talkSession.OwnerUserID() == userID {
+		return nil, ErrOwnerCannotCollaborate
+	}
+	if _, err := ParseCollaboratorRole(string(role)); err != nil {
+		return nil, err
+	}
+
+	return &TalkSessionCollaborator{
+		talkSessionID: talkSession.TalkSessionID(),
+		userID:        userID,
+		role:          role,
+		addedBy:       addedBy,
+		createdAt:     createdAt,
+	}, nil
+}
+
+// ReconstructTalkSessionCollaborator 永続化された共同管理者を復元する
+func ReconstructTalkSessionCollaborator(
+	talkSessionID shared.UUID[talksession.TalkSession],
+	userID shared.UUID[user.User],
+	role CollaboratorRole,
+	addedBy shared.UUID[user.User],
+	createdAt time.Time,
+) *TalkSessionCollaborator {
+	return &TalkSessionCollaborator{
+		talkSessionID: talkSessionID,
+		userID:        userID,
+		role:          role,
+		addedBy:       addedBy,
+		createdAt:     createdAt,
+	}
+}
+
+func (c *TalkSessionCollaborator) TalkSessionID() shared.UUID[talksession.TalkSession] {
+	return c.talkSessionID
+}
+
+func (c *TalkSessionCollaborator) UserID() shared.UUID[user.User] {
+	return c.userID
+}
+
+func (c *TalkSessionCollaborator) Role() CollaboratorRole {
+	return c.role
+}
+
+func (c *TalkSessionCollaborator) AddedBy() shared.UUID[user.User] {
+	return c.addedBy
+}
+
+func (c *TalkSessionCollaborator) CreatedAt() time.Time {
+	return c.createdAt
+}
@@ -0,0 +1,140 @@
+package talksession_collaborator_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/talksession/talksession_collaborator"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTalkSession(ownerID shared.UUID[user.User]) *talksession.TalkSession {
+	return talksession.NewTalkSession(
+		shared.NewUUID[talksession.TalkSession](),
+		"公園の使い方",
+		nil,
+		nil,
+		ownerID,
+		time.Now(),
+		time.Now().Add(24*time.Hour),
+		nil,
+		nil,
+		nil,
+		false,
+		nil,
+		nil,
+	)
+}
+
+type fakeCollaboratorRepository struct {
+	collaborators []*talksession_collaborator.TalkSessionCollaborator
+}
+
+func (r *fakeCollaboratorRepository) Save(_ context.Context, collaborator *talksession_collaborator.TalkSessionCollaborator) error {
+	r.collaborators = append(r.collaborators, collaborator)
+	return nil
+}
+
+func (r *fakeCollaboratorRepository) FindByTalkSessionIDAndUserID(_ context.Context, talkSessionID shared.UUID[talksession.TalkSession], userID shared.UUID[user.User]) (*talksession_collaborator.TalkSessionCollaborator, error) {
+	for _, c := range r.collaborators {
+		if c.TalkSessionID() == talkSessionID && c.UserID() == userID {
+			return c, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *fakeCollaboratorRepository) Delete(_ context.Context, _ shared.UUID[talksession.TalkSession], _ shared.UUID[user.User]) error {
+	return nil
+}
+
+func TestCollaboratorRole_Has(t *testing.T) {
+	tests := []struct {
+		name       string
+		role       talksession_collaborator.CollaboratorRole
+		permission talksession_collaborator.Permission
+		want       bool
+	}{
+		{name: "共同オーナーは共同管理者を管理できる", role: talksession_collaborator.RoleCoOwner, permission: talksession_collaborator.PermissionManageCollaborators, want: true},
+		{name: "共同オーナーはセッションを編集できる", role: talksession_collaborator.RoleCoOwner, permission: talksession_collaborator.PermissionEdit, want: true},
+		{name: "ファシリテーターは結論を投稿できる", role: talksession_collaborator.RoleFacilitator, permission: talksession_collaborator.PermissionConclude, want: true},
+		{name: "ファシリテーターはタイムラインを管理できる", role: talksession_collaborator.RoleFacilitator, permission: talksession_collaborator.PermissionManageTimeline, want: true},
+		{name: "ファシリテーターはセッションを編集できない", role: talksession_collaborator.RoleFacilitator, permission: talksession_collaborator.PermissionEdit, want: false},
+		{name: "モデレーターは通報に対応できる", role: talksession_collaborator.RoleModerator, permission: talksession_collaborator.PermissionModerate, want: true},
+		{name: "モデレーターは結論を投稿できない", role: talksession_collaborator.RoleModerator, permission: talksession_collaborator.PermissionConclude, want: false},
+		{name: "閲覧者は閲覧できる", role: talksession_collaborator.RoleViewer, permission: talksession_collaborator.PermissionView, want: true},
+		{name: "閲覧者は通報に対応できない", role: talksession_collaborator.RoleViewer, permission: talksession_collaborator.PermissionModerate, want: false},
+		{name: "未定義のロールは権限を持たない", role: talksession_collaborator.CollaboratorRole("admin"), permission: talksession_collaborator.PermissionView, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.role.Has(tt.permission))
+		})
+	}
+}
+
+func TestNewTalkSessionCollaborator(t *testing.T) {
+	ownerID := shared.NewUUID[user.User]()
+	ts := newTalkSession(ownerID)
+
+	tests := []struct {
+		name    string
+		userID  shared.UUID[user.User]
+		role    talksession_collaborator.CollaboratorRole
+		wantErr error
+	}{
+		{name: "共同管理者を作成できる", userID: shared.NewUUID[user.User](), role: talksession_collaborator.RoleFacilitator},
+		{name: "オーナーは共同管理者にできない", userID: ownerID, role: talksession_collaborator.RoleCoOwner, wantErr: talksession_collaborator.ErrOwnerCannotCollaborate},
+		{name: "未定義のロールはエラー", userID: shared.NewUUID[user.User](), role: talksession_collaborator.CollaboratorRole("admin"), wantErr: talksession_collaborator.ErrInvalidRole},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := talksession_collaborator.NewTalkSessionCollaborator(ts, tt.userID, tt.role, ownerID, time.Now())
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, ts.TalkSessionID(), c.TalkSessionID())
+			assert.Equal(t, tt.role, c.Role())
+		})
+	}
+}
+
+func TestTalkSessionPermissionService_HasPermission(t *testing.T) {
+	ctx := context.Background()
+	ownerID := shared.NewUUID[user.User]()
+	moderatorID := shared.NewUUID[user.User]()
+	ts := newTalkSession(ownerID)
+
+	repo := &fakeCollaboratorRepository{}
+	moderator, err := talksession_collaborator.NewTalkSessionCollaborator(ts, moderatorID, talksession_collaborator.RoleModerator, ownerID, time.Now())
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, moderator))
+
+	service := talksession_collaborator.NewTalkSessionPermissionService(repo)
+
+	tests := []struct {
+		name       string
+		userID     shared.UUID[user.User]
+		permission talksession_collaborator.Permission
+		want       bool
+	}{
+		{name: "オーナーは全ての権限を持つ", userID: ownerID, permission: talksession_collaborator.PermissionManageCollaborators, want: true},
+		{name: "共同管理者はロールの権限を持つ", userID: moderatorID, permission: talksession_collaborator.PermissionModerate, want: true},
+		{name: "共同管理者はロールにない権限を持たない", userID: moderatorID, permission: talksession_collaborator.PermissionEdit, want: false},
+		{name: "共同管理者でないユーザーは権限を持たない", userID: shared.NewUUID[user.User](), permission: talksession_collaborator.PermissionView, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := service.HasPermission(ctx, ts, tt.userID, tt.permission)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,253 @@
+package talksession_template
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/neko-dream/api/internal/domain/model/opinion"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/user"
+)
+
+var (
+	ErrInvalidTemplateName          = errors.New("テンプレート名は1~100文字である必要があります")
+	ErrTalkSessionHasNoOrganization = errors.New("組織に紐づかないセッションはテンプレートにできません")
+)
+
+// TalkSessionTemplateRepository リポジトリインターフェース
+type TalkSessionTemplateRepository interface {
+	Create(ctx context.Context, template *TalkSessionTemplate) error
+	FindByID(ctx context.Context, templateID shared.UUID[TalkSessionTemplate]) (*TalkSessionTemplate, error)
+	FindByOrganizationID(ctx context.Context, organizationID shared.UUID[organization.Organization]) ([]*TalkSessionTemplate, error)
+	Delete(ctx context.Context, templateID shared.UUID[TalkSessionTemplate]) error
+}
+
+// SeedOpinion テンプレートに保存するシード意見
+type SeedOpinion struct {
+	Title        *string `json:"title,omitempty"`
+	Content      string  `json:"content"`
+	ReferenceURL *string `json:"reference_url,omitempty"`
+	PictureURL   *string `json:"picture_url,omitempty"`
+}
+
+// NewSeedOpinions シード意見の内容を取り出す
+func NewSeedOpinions(opinions []opinion.Opinion) []SeedOpinion {
+	seeds := make([]SeedOpinion, 0, len(opinions))
+	for _, op := range opinions {
+		seeds = append(seeds, SeedOpinion{
+			Title:        op.Title(),
+			Content:      op.Content(),
+			ReferenceURL: op.ReferenceURL(),
+			PictureURL:   op.ReferenceImageURL(),
+		})
+	}
+	return seeds
+}
+
+// ToOpinion 指定したセッションのシード意見として作成する
+func (s SeedOpinion) ToOpinion(
+	opinionID shared.UUID[opinion.Opinion],
+	talkSessionID shared.UUID[talksession.TalkSession],
+	createdAt time.Time,
+) (*opinion.Opinion, error) {
+	op, err := opinion.NewOpinion(
+		opinionID,
+		talkSessionID,
+		opinion.SeedUserID,
+		nil,
+		s.Title,
+		s.Content,
+		createdAt,
+		s.ReferenceURL,
+	)
+	if err != nil {
+		return nil, err
+	}
+	op.SetSeed()
+	op.ChangeReferenceImageURL(s.PictureURL)
+	return op, nil
+}
+
+// TalkSessionTemplate 組織内で使い回すセッションのひな形
+// 参加制限・説明・シード意見・サムネイルなどの設定のみを持ち、投票や参加者の情報は持たない
+type TalkSessionTemplate struct {
+	templateID          shared.UUID[TalkSessionTemplate]
+	organizationID      shared.UUID[organization.Organization]
+	organizationAliasID *shared.UUID[organization.OrganizationAlias]
+	name                string
+	theme               string
+	description         *string
+	thumbnailURL        *string
+	city                *string
+	prefecture          *string
+	restrictions        []string
+	hideTop             bool
+	seedOpinions        []SeedOpinion
+	createdBy           shared.UUID[user.User]
+	createdAt           time.Time
+}
+
+func NewTalkSessionTemplate(
+	templateID shared.UUID[TalkSessionTemplate],
+	organizationID shared.UUID[organization.Organization],
+	organizationAliasID *shared.UUID[organization.OrganizationAlias],
+	name string,
+	theme string,
+	description *string,
+	thumbnailURL *string,
+	city *string,
+	prefecture *string,
+	restrictions []string,
+	hideTop bool,
+	seedOpinions []SeedOpinion,
+	createdBy shared.UUID[user.User],
+	createdAt time.Time,
+) (*TalkSessionTemplate, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > 100 {
+		return nil, ErrInvalidTemplateName
+	}
+
+	return &TalkSessionTemplate{
+		templateID:          templateID,
+		organizationID:      organizationID,
+		organizationAliasID: organizationAliasID,
+		name:                name,
+		theme:               theme,
+		description:         description,
+		thumbnailURL:        thumbnailURL,
+		city:                city,
+		prefecture:          prefecture,
+		restrictions:        restrictions,
+		hideTop:             hideTop,
+		seedOpinions:        seedOpinions,
+		createdBy:           createdBy,
+		createdAt:           createdAt,
+	}, nil
+}
+
+// NewTalkSessionTemplateFromTalkSession セッションの設定とシード意見からテンプレートを作成する
+func NewTalkSessionTemplateFromTalkSession(
+	templateID shared.UUID[TalkSessionTemplate],
+	name string,
+	talkSession *talksession.TalkSession,
+	seedOpinions []opinion.Opinion,
+	createdBy shared.UUID[user.User],
+	createdAt time.Time,
+) (*TalkSessionTemplate, error) {
+	if talkSession.OrganizationID() == nil {
+		return nil, ErrTalkSessionHasNoOrganization
+	}
+
+	return NewTalkSessionTemplate(
+		templateID,
+		*talkSession.OrganizationID(),
+		talkSession.OrganizationAliasID(),
+		name,
+		talkSession.Theme(),
+		talkSession.Description(),
+		talkSession.ThumbnailURL(),
+		talkSession.City(),
+		talkSession.Prefecture(),
+		talkSession.RestrictionList(),
+		talkSession.HideTop(),
+		NewSeedOpinions(seedOpinions),
+		createdBy,
+		createdAt,
+	)
+}
+
+// NewDraftTalkSession テンプレートから下書きのセッションを作成する
+func (t *TalkSessionTemplate) NewDraftTalkSession(
+	ctx context.Context,
+	talkSessionID shared.UUID[talksession.TalkSession],
+	ownerUserID shared.UUID[user.User],
+	theme string,
+	createdAt time.Time,
+	scheduledEndTime time.Time,
+) (*talksession.TalkSession, error) {
+	organizationID := t.organizationID
+	ts := talksession.NewTalkSession(
+		talkSessionID,
+		theme,
+		t.description,
+		t.thumbnailURL,
+		ownerUserID,
+		createdAt,
+		scheduledEndTime,
+		nil,
+		t.city,
+		t.prefecture,
+		t.hideTop,
+		&organizationID,
+		t.organizationAliasID,
+	)
+	if len(t.restrictions) > 0 {
+		if err := ts.UpdateRestrictions(ctx, t.restrictions); err != nil {
+			return nil, err
+		}
+	}
+	ts.MarkAsDraft()
+	return ts, nil
+}
+
+func (t *TalkSessionTemplate) TemplateID() shared.UUID[TalkSessionTemplate] {
+	return t.templateID
+}
+
+func (t *TalkSessionTemplate) OrganizationID() shared.UUID[organization.Organization] {
+	return t.organizationID
+}
+
+func (t *TalkSessionTemplate) OrganizationAliasID() *shared.UUID[organization.OrganizationAlias] {
+	return t.organizationAliasID
+}
+
+func (t *TalkSessionTemplate) Name() string {
+	return t.name
+}
+
+func (t *TalkSessionTemplate) Theme() string {
+	return t.theme
+}
+
+func (t *TalkSessionTemplate) Description() *string {
+	return t.description
+}
+
+func (t *TalkSessionTemplate) ThumbnailURL() *string {
+	return t.thumbnailURL
+}
+
+func (t *TalkSessionTemplate) City() *string {
+	return t.city
+}
+
+func (t *TalkSessionTemplate) Prefecture() *string {
+	return t.prefecture
+}
+
+func (t *TalkSessionTemplate) Restrictions() []string {
+	return t.restrictions
+}
+
+func (t *TalkSessionTemplate) HideTop() bool {
+	return t.hideTop
+}
+
+func (t *TalkSessionTemplate) SeedOpinions() []SeedOpinion {
+	return t.seedOpinions
+}
+
+func (t *TalkSessionTemplate) CreatedBy() shared.UUID[user.User] {
+	return t.createdBy
+}
+
+func (t *TalkSessionTemplate) CreatedAt() time.Time {
+	return t.createdAt
+}
@@ -0,0 +1,169 @@
+package talksession_template_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/neko-dream/api/internal/domain/model/opinion"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/talksession/talksession_template"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newOrganizationTalkSession(t *testing.T, organizationID *shared.UUID[organization.Organization]) *talksession.TalkSession {
+	t.Helper()
+	ts := talksession.NewTalkSession(
+		shared.NewUUID[talksession.TalkSession](),
+		"公園の使い方",
+		lo.ToPtr("説明"),
+		lo.ToPtr("https://example.com/thumbnail.png"),
+		shared.NewUUID[user.User](),
+		time.Now(),
+		time.Now().Add(24*time.Hour),
+		nil,
+		lo.ToPtr("札幌市"),
+		lo.ToPtr("北海道"),
+		true,
+		organizationID,
+		nil,
+	)
+	require.NoError(t, ts.UpdateRestrictions(context.Background(), []string{string(talksession.DemographicsBirth)}))
+	return ts
+}
+
+func TestNewTalkSessionTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		tmplName string
+		wantErr  error
+	}{
+		{name: "テンプレートを作成できる", tmplName: "定例ヒアリング"},
+		{name: "前後の空白は取り除かれる", tmplName: "  定例ヒアリング  "},
+		{name: "名前が空の場合はエラー", tmplName: "   ", wantErr: talksession_template.ErrInvalidTemplateName},
+		{name: "名前が100文字を超える場合はエラー", tmplName: strings.Repeat("あ", 101), wantErr: talksession_template.ErrInvalidTemplateName},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := talksession_template.NewTalkSessionTemplate(
+				shared.NewUUID[talksession_template.TalkSessionTemplate](),
+				shared.NewUUID[organization.Organization](),
+				nil,
+				tt.tmplName,
+				"テーマ",
+				nil,
+				nil,
+				nil,
+				nil,
+				nil,
+				false,
+				nil,
+				shared.NewUUID[user.User](),
+				time.Now(),
+			)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "定例ヒアリング", tmpl.Name())
+		})
+	}
+}
+
+func TestNewTalkSessionTemplateFromTalkSession(t *testing.T) {
+	orgID := shared.NewUUID[organization.Organization]()
+	ts := newOrganizationTalkSession(t, &orgID)
+
+	seed, err := opinion.NewOpinion(
+		shared.NewUUID[opinion.Opinion](),
+		ts.TalkSessionID(),
+		opinion.SeedUserID,
+		nil,
+		lo.ToPtr("遊具について"),
+		"遊具を増やしてほしい",
+		time.Now(),
+		nil,
+	)
+	require.NoError(t, err)
+	seed.ChangeReferenceImageURL(lo.ToPtr("https://example.com/seed.png"))
+
+	t.Run("セッションの設定とシード意見を引き継ぐ", func(t *testing.T) {
+		tmpl, err := talksession_template.NewTalkSessionTemplateFromTalkSession(
+			shared.NewUUID[talksession_template.TalkSessionTemplate](),
+			"公園テンプレート",
+			ts,
+			[]opinion.Opinion{*seed},
+			shared.NewUUID[user.User](),
+			time.Now(),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, orgID, tmpl.OrganizationID())
+		assert.Equal(t, ts.Theme(), tmpl.Theme())
+		assert.Equal(t, ts.ThumbnailURL(), tmpl.ThumbnailURL())
+		assert.Equal(t, []string{string(talksession.DemographicsBirth)}, tmpl.Restrictions())
+		assert.True(t, tmpl.HideTop())
+		assert.Equal(t, []talksession_template.SeedOpinion{{
+			Title:      lo.ToPtr("遊具について"),
+			Content:    "遊具を増やしてほしい",
+			PictureURL: lo.ToPtr("https://example.com/seed.png"),
+		}}, tmpl.SeedOpinions())
+	})
+
+	t.Run("組織に紐づかないセッションはテンプレートにできない", func(t *testing.T) {
+		_, err := talksession_template.NewTalkSessionTemplateFromTalkSession(
+			shared.NewUUID[talksession_template.TalkSessionTemplate](),
+			"公園テンプレート",
+			newOrganizationTalkSession(t, nil),
+			nil,
+			shared.NewUUID[user.User](),
+			time.Now(),
+		)
+		assert.ErrorIs(t, err, talksession_template.ErrTalkSessionHasNoOrganization)
+	})
+}
+
+func TestTalkSessionTemplate_NewDraftTalkSession(t *testing.T) {
+	orgID := shared.NewUUID[organization.Organization]()
+	tmpl, err := talksession_template.NewTalkSessionTemplateFromTalkSession(
+		shared.NewUUID[talksession_template.TalkSessionTemplate](),
+		"公園テンプレート",
+		newOrganizationTalkSession(t, &orgID),
+		nil,
+		shared.NewUUID[user.User](),
+		time.Now(),
+	)
+	require.NoError(t, err)
+
+	ownerID := shared.NewUUID[user.User]()
+	ts, err := tmpl.NewDraftTalkSession(context.Background(), shared.NewUUID[talksession.TalkSession](), ownerID, "新しいテーマ", time.Now(), time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	assert.True(t, ts.IsDraft())
+	assert.Equal(t, ownerID, ts.OwnerUserID())
+	assert.Equal(t, "新しいテーマ", ts.Theme())
+	assert.Equal(t, &orgID, ts.OrganizationID())
+	assert.Equal(t, tmpl.Restrictions(), []string(ts.RestrictionList()))
+	assert.Empty(t, ts.GetRecordedEvents())
+}
+
+func TestSeedOpinion_ToOpinion(t *testing.T) {
+	talkSessionID := shared.NewUUID[talksession.TalkSession]()
+	seed := talksession_template.SeedOpinion{
+		Content:    "遊具を増やしてほしい",
+		PictureURL: lo.ToPtr("https://example.com/seed.png"),
+	}
+
+	op, err := seed.ToOpinion(shared.NewUUID[opinion.Opinion](), talkSessionID, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, opinion.SeedUserID, op.UserID())
+	assert.Equal(t, talkSessionID, op.TalkSessionID())
+	assert.Nil(t, op.ParentOpinionID())
+	assert.Equal(t, seed.PictureURL, op.ReferenceImageURL())
+}
@@ -0,0 +1,36 @@
+package vote
+
+import (
+	"github.com/neko-dream/api/internal/domain/model/event"
+	"github.com/neko-dream/api/internal/domain/model/opinion"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/user"
+)
+
+const (
+	EventTypeVoteChanged event.EventType = "vote.changed"
+)
+
+// VoteChangedEvent 投票・投票の変更のイベント。分析の再計算に使う
+type VoteChangedEvent struct {
+	event.BaseEvent
+	TalkSessionID    shared.UUID[talksession.TalkSession] `json:"talk_session_id"`
+	OpinionID        shared.UUID[opinion.Opinion]         `json:"opinion_id"`
+	UserID           shared.UUID[user.User]               `json:"user_id"`
+	PreviousVoteType VoteType                             `json:"previous_vote_type"`
+	VoteType         VoteType                             `json:"vote_type"`
+	Reason           VoteChangeReason                     `json:"reason"`
+}
+
+func NewVoteChangedEvent(change *VoteChange) *VoteChangedEvent {
+	return &VoteChangedEvent{
+		BaseEvent:        event.NewBaseEvent(EventTypeVoteChanged, change.VoteID.String(), "Vote"),
+		TalkSessionID:    change.TalkSessionID,
+		OpinionID:        change.OpinionID,
+		UserID:           change.UserID,
+		PreviousVoteType: change.PreviousVoteType,
+		VoteType:         change.VoteType,
+		Reason:           change.Reason,
+	}
+}
@@ -15,6 +15,8 @@ type (
 		Create(ctx context.Context, vote Vote) error
 		Update(ctx context.Context, vote Vote) error
 		FindByOpinionAndUserID(ctx context.Context, opinionID shared.UUID[opinion.Opinion], userID shared.UUID[user.User]) (*Vote, error)
+		FindByOpinionID(ctx context.Context, opinionID shared.UUID[opinion.Opinion]) ([]Vote, error)
+		Delete(ctx context.Context, vote Vote) error
 	}
 
 	Vote struct {
@@ -23,6 +25,7 @@ type (
 		TalkSessionID shared.UUID[talksession.TalkSession]
 		UserID        shared.UUID[user.User]
 		VoteType      VoteType
+		IsImportant   bool
 		CreatedAt     time.Time
 	}
 )
@@ -31,6 +34,11 @@ func (v *Vote) ChangeVoteType(voteType VoteType) {
 	v.VoteType = voteType
 }
 
+// ChangeImportance 投票したユーザーにとって重要な意見かどうかを変更する。投票の種類とは独立している
+func (v *Vote) ChangeImportance(important bool) {
+	v.IsImportant = important
+}
+
 func NewVote(
 	voteID shared.UUID[Vote],
 	parentOpinionID shared.UUID[opinion.Opinion],
@@ -0,0 +1,76 @@
+package vote
+
+import (
+	"context"
+	"time"
+
+	"github.com/neko-dream/api/internal/domain/model/event"
+	"github.com/neko-dream/api/internal/domain/model/opinion"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/user"
+)
+
+type (
+	// VoteChangeRepository 投票の変更履歴。履歴は追記のみで更新・削除しない
+	VoteChangeRepository interface {
+		Create(ctx context.Context, change VoteChange) error
+	}
+
+	VoteChangeReason string
+
+	// VoteChange 投票の変更。初めての投票も未投票からの変更として記録する
+	VoteChange struct {
+		VoteChangeID     shared.UUID[VoteChange]
+		VoteID           shared.UUID[Vote]
+		OpinionID        shared.UUID[opinion.Opinion]
+		TalkSessionID    shared.UUID[talksession.TalkSession]
+		UserID           shared.UUID[user.User]
+		PreviousVoteType VoteType
+		VoteType         VoteType
+		Reason           VoteChangeReason
+		CreatedAt        time.Time
+		event.EventRecorder
+	}
+)
+
+const (
+	// VoteChangeReasonVoted ユーザーが投票した
+	VoteChangeReasonVoted VoteChangeReason = "voted"
+	// VoteChangeReasonOpinionEdited 意見が編集されたため投票がリセットされた
+	VoteChangeReasonOpinionEdited VoteChangeReason = "opinion_edited"
+)
+
+// NewVoteChange ユーザーの投票を記録する。voteは変更後の投票
+func NewVoteChange(vote Vote, previousVoteType VoteType, createdAt time.Time) *VoteChange {
+	change := &VoteChange{
+		VoteChangeID:     shared.NewUUID[VoteChange](),
+		VoteID:           vote.VoteID,
+		OpinionID:        vote.OpinionID,
+		TalkSessionID:    vote.TalkSessionID,
+		UserID:           vote.UserID,
+		PreviousVoteType: previousVoteType,
+		VoteType:         vote.VoteType,
+		Reason:           VoteChangeReasonVoted,
+		CreatedAt:        createdAt,
+	}
+	change.RecordEvent(NewVoteChangedEvent(change))
+	return change
+}
+
+// NewVoteResetChange 意見の編集で投票がリセットされたことを記録する
+func NewVoteResetChange(vote Vote, createdAt time.Time) *VoteChange {
+	change := &VoteChange{
+		VoteChangeID:     shared.NewUUID[VoteChange](),
+		VoteID:           vote.VoteID,
+		OpinionID:        vote.OpinionID,
+		TalkSessionID:    vote.TalkSessionID,
+		UserID:           vote.UserID,
+		PreviousVoteType: vote.VoteType,
+		VoteType:         UnVoted,
+		Reason:           VoteChangeReasonOpinionEdited,
+		CreatedAt:        createdAt,
+	}
+	change.RecordEvent(NewVoteChangedEvent(change))
+	return change
+}
@@ -0,0 +1,82 @@
+package vote_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/neko-dream/api/internal/domain/model/opinion"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/internal/domain/model/vote"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewVoteChange(t *testing.T) {
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	changedAt := createdAt.Add(time.Hour)
+
+	tests := []struct {
+		name             string
+		previousVoteType vote.VoteType
+		voteType         vote.VoteType
+	}{
+		{
+			name:             "初めての投票は未投票からの変更として記録する",
+			previousVoteType: vote.UnVoted,
+			voteType:         vote.Agree,
+		},
+		{
+			name:             "反対から賛成への変更",
+			previousVoteType: vote.Disagree,
+			voteType:         vote.Agree,
+		},
+		{
+			name:             "パスからパスへの変更",
+			previousVoteType: vote.Pass,
+			voteType:         vote.Pass,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, err := vote.NewVote(
+				shared.NewUUID[vote.Vote](),
+				shared.NewUUID[opinion.Opinion](),
+				shared.NewUUID[talksession.TalkSession](),
+				shared.NewUUID[user.User](),
+				tt.voteType,
+				createdAt,
+			)
+			require.NoError(t, err)
+
+			change := vote.NewVoteChange(*v, tt.previousVoteType, changedAt)
+			assert.Equal(t, v.VoteID, change.VoteID)
+			assert.Equal(t, v.OpinionID, change.OpinionID)
+			assert.Equal(t, v.UserID, change.UserID)
+			assert.Equal(t, tt.previousVoteType, change.PreviousVoteType)
+			assert.Equal(t, tt.voteType, change.VoteType)
+			assert.Equal(t, vote.VoteChangeReasonVoted, change.Reason)
+			assert.Equal(t, changedAt, change.CreatedAt)
+		})
+	}
+}
+
+func TestNewVoteResetChange(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	v, err := vote.NewVote(
+		shared.NewUUID[vote.Vote](),
+		shared.NewUUID[opinion.Opinion](),
+		shared.NewUUID[talksession.TalkSession](),
+		shared.NewUUID[user.User](),
+		vote.Disagree,
+		now,
+	)
+	require.NoError(t, err)
+
+	change := vote.NewVoteResetChange(*v, now.Add(time.Minute))
+	assert.Equal(t, vote.Disagree, change.PreviousVoteType)
+	assert.Equal(t, vote.UnVoted, change.VoteType)
+	assert.Equal(t, vote.VoteChangeReasonOpinionEdited, change.Reason)
+}
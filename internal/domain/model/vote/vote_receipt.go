@@ -0,0 +1,66 @@
+package vote
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/opinion"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+)
+
+const (
+	// MaxBatchVotes 一括投票で一度に送信できる投票数
+	MaxBatchVotes = 100
+	// MaxIdempotencyKeyLength 冪等キーの最大文字数
+	MaxIdempotencyKeyLength = 255
+	// VotedAtTolerance 端末の時刻のずれとして許容する時間
+	VotedAtTolerance = 5 * time.Minute
+)
+
+type (
+	VoteReceiptRepository interface {
+		// Create 処理結果を記録する。同じ冪等キーの処理結果がすでにある場合は記録せずfalseを返す
+		Create(ctx context.Context, receipt VoteReceipt) (bool, error)
+		// UpdateError 投票できなかった場合のエラーを記録する
+		UpdateError(ctx context.Context, receipt VoteReceipt) error
+		FindByKeys(ctx context.Context, userID shared.UUID[user.User], keys []string) ([]VoteReceipt, error)
+	}
+
+	// VoteReceipt 一括投票の冪等キーごとの処理結果
+	// 同じ冪等キーで再送された投票は反映せず、記録した結果を返す
+	VoteReceipt struct {
+		UserID         shared.UUID[user.User]
+		IdempotencyKey string
+		OpinionID      shared.UUID[opinion.Opinion]
+		VoteType       VoteType
+		// Error 投票できなかった場合のエラー。反映された場合はnil
+		Error *messages.APIError
+		// ClientVotedAt 端末で投票した日時。反映する順序を決めるためだけに使い、投票日時にはサーバーで反映した日時を使う
+		ClientVotedAt time.Time
+		CreatedAt     time.Time
+	}
+)
+
+// ValidateIdempotencyKey 冪等キーの長さを確認する
+func ValidateIdempotencyKey(key string) error {
+	if key == "" || utf8.RuneCountInString(key) > MaxIdempotencyKeyLength {
+		return messages.VoteIdempotencyKeyInvalid
+	}
+	return nil
+}
+
+// ValidateClientVotedAt 端末で投票した日時が未来になっていないか確認する
+func ValidateClientVotedAt(votedAt, now time.Time) error {
+	if votedAt.After(now.Add(VotedAtTolerance)) {
+		return messages.VoteVotedAtInFuture
+	}
+	return nil
+}
+
+// Matches 再送された投票が記録した投票と同じか。異なる場合は冪等キーの使い回しとみなす
+func (r *VoteReceipt) Matches(opinionID shared.UUID[opinion.Opinion], voteType VoteType) bool {
+	return r.OpinionID == opinionID && r.VoteType == voteType
+}
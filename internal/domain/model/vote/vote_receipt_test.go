@@ -0,0 +1,62 @@
+package vote_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/opinion"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/internal/domain/model/vote"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateIdempotencyKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		key     string
+		wantErr error
+	}{
+		{name: "UUIDの冪等キーは使える", key: "0b6f0c4e-8a3c-4f51-9a7e-3b7a0f1d2c11"},
+		{name: "255文字の冪等キーは使える", key: strings.Repeat("a", 255)},
+		{name: "空の冪等キーは使えない", key: "", wantErr: messages.VoteIdempotencyKeyInvalid},
+		{name: "256文字の冪等キーは使えない", key: strings.Repeat("a", 256), wantErr: messages.VoteIdempotencyKeyInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := vote.ValidateIdempotencyKey(tt.key)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestValidateClientVotedAt(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	// オフラインで投票した過去の日時は受け付ける
+	assert.NoError(t, vote.ValidateClientVotedAt(now.Add(-24*time.Hour), now))
+	// 端末の時刻のずれは許容する
+	assert.NoError(t, vote.ValidateClientVotedAt(now.Add(vote.VotedAtTolerance), now))
+	assert.ErrorIs(t, vote.ValidateClientVotedAt(now.Add(vote.VotedAtTolerance+time.Second), now), messages.VoteVotedAtInFuture)
+}
+
+func TestVoteReceipt_Matches(t *testing.T) {
+	opinionID := shared.NewUUID[opinion.Opinion]()
+	receipt := vote.VoteReceipt{
+		UserID:         shared.NewUUID[user.User](),
+		IdempotencyKey: "key",
+		OpinionID:      opinionID,
+		VoteType:       vote.Agree,
+	}
+
+	assert.True(t, receipt.Matches(opinionID, vote.Agree))
+	assert.False(t, receipt.Matches(opinionID, vote.Disagree))
+	assert.False(t, receipt.Matches(shared.NewUUID[opinion.Opinion](), vote.Agree))
+}
@@ -150,3 +150,24 @@ func TestVote_ChangeVoteType(t *testing.T) {
 		})
 	}
 }
+
+func TestVote_ChangeImportance(t *testing.T) {
+	v, err := vote.NewVote(
+		shared.MustParseUUID[vote.Vote]("00000000-0000-0000-0000-000000000001"),
+		shared.MustParseUUID[opinion.Opinion]("00000000-0000-0000-0000-000000000002"),
+		shared.MustParseUUID[talksession.TalkSession]("00000000-0000-0000-0000-000000000003"),
+		shared.MustParseUUID[user.User]("00000000-0000-0000-0000-000000000004"),
+		vote.Disagree,
+		time.Now(),
+	)
+	require.NoError(t, err)
+	assert.False(t, v.IsImportant, "投票した時点では重要な意見の印は付いていない")
+
+	// 重要な意見の印は投票の種類とは独立している
+	v.ChangeImportance(true)
+	assert.True(t, v.IsImportant)
+	assert.Equal(t, vote.Disagree, v.VoteType)
+
+	v.ChangeVoteType(vote.Agree)
+	assert.True(t, v.IsImportant)
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"go.opentelemetry.io/otel"
+)
+
+type analysisJobService struct {
+	analysis.AnalysisJobRepository
+}
+
+func NewAnalysisJobService(
+	analysisJobRepository analysis.AnalysisJobRepository,
+) analysis.AnalysisJobService {
+	return &analysisJobService{
+		AnalysisJobRepository: analysisJobRepository,
+	}
+}
+
+// Enqueue ジョブを登録する。待機中・実行中のジョブがあれば、同じ生成を重ねて実行しないようそのジョブを返す
+func (s *analysisJobService) Enqueue(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession], jobType analysis.AnalysisJobType) (*analysis.AnalysisJob, error) {
+	ctx, span := otel.Tracer("service").Start(ctx, "analysisJobService.Enqueue")
+	defer span.End()
+
+	active, err := s.AnalysisJobRepository.FindActive(ctx, talkSessionID, jobType)
+	if err != nil {
+		return nil, err
+	}
+	if active != nil {
+		return active, nil
+	}
+
+	job := analysis.NewAnalysisJob(talkSessionID, jobType, clock.Now(ctx))
+	created, err := s.AnalysisJobRepository.Create(ctx, job)
+	if err != nil {
+		return nil, err
+	}
+	if created {
+		return job, nil
+	}
+
+	// 同時に登録されたジョブを返す
+	return s.AnalysisJobRepository.FindActive(ctx, talkSessionID, jobType)
+}
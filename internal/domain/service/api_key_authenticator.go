@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/session"
+	"github.com/neko-dream/api/internal/infrastructure/config"
+	"github.com/neko-dream/api/pkg/utils"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel"
+)
+
+// APIキーから作るクレームの有効期間。リクエスト単位で検証するため短くてよい
+const apiKeyClaimLifetime = 5 * time.Minute
+
+// APIKeyAuthenticator 組織APIキーによるサーバー間認証
+type APIKeyAuthenticator interface {
+	// Authenticate APIキーを検証し、requiredScopeが許可されていればセッションクレームを返す
+	Authenticate(ctx context.Context, rawKey string, requiredScope organization.APIKeyScope) (*session.Claim, error)
+}
+
+type apiKeyAuthenticator struct {
+	apiKeyRepository     organization.OrganizationAPIKeyRepository
+	organizationRepo     organization.OrganizationRepository
+	organizationUserRepo organization.OrganizationUserRepository
+	config               *config.Config
+}
+
+func NewAPIKeyAuthenticator(
+	apiKeyRepository organization.OrganizationAPIKeyRepository,
+	organizationRepo organization.OrganizationRepository,
+	organizationUserRepo organization.OrganizationUserRepository,
+	config *config.Config,
+) APIKeyAuthenticator {
+	return &apiKeyAuthenticator{
+		apiKeyRepository:     apiKeyRepository,
+		organizationRepo:     organizationRepo,
+		organizationUserRepo: organizationUserRepo,
+		config:               config,
+	}
+}
+
+// Authenticate implements APIKeyAuthenticator.
+func (a *apiKeyAuthenticator) Authenticate(ctx context.Context, rawKey string, requiredScope organization.APIKeyScope) (*session.Claim, error) {
+	ctx, span := otel.Tracer("service").Start(ctx, "apiKeyAuthenticator.Authenticate")
+	defer span.End()
+
+	if !organization.IsAPIKeyFormat(rawKey) {
+		return nil, messages.APIKeyInvalidError
+	}
+
+	key, err := a.apiKeyRepository.FindByHash(ctx, organization.HashAPIKey(rawKey, a.config.HASH_PEPPER))
+	if err != nil {
+		utils.HandleError(ctx, err, "OrganizationAPIKeyRepository.FindByHash")
+		return nil, messages.InternalServerError
+	}
+	now := clock.Now(ctx)
+	if key == nil || !key.IsActive(now) {
+		return nil, messages.APIKeyInvalidError
+	}
+	if !key.HasScope(requiredScope) {
+		return nil, messages.APIKeyScopeDeniedError
+	}
+
+	org, err := a.organizationRepo.FindByID(ctx, key.OrganizationID())
+	if err != nil || org == nil {
+		return nil, messages.APIKeyInvalidError
+	}
+	// 作成者が組織を抜けている場合、そのキーは使えない
+	orgUser, err := a.organizationUserRepo.FindEffectiveByOrganizationIDAndUserID(ctx, key.OrganizationID(), key.CreatedBy())
+	if err != nil || orgUser == nil {
+		return nil, messages.APIKeyInvalidError
+	}
+
+	if key.MarkUsed(now) {
+		// 最終使用日時は参考情報のため、記録に失敗しても認証は通す
+		if err := a.apiKeyRepository.UpdateLastUsed(ctx, key); err != nil {
+			utils.HandleError(ctx, err, "OrganizationAPIKeyRepository.UpdateLastUsed")
+		}
+	}
+
+	// キーの権限は作成者のロールを超えず、管理者を上限とする
+	role := orgUser.Role
+	if role < organization.OrganizationUserRoleAdmin {
+		role = organization.OrganizationUserRoleAdmin
+	}
+
+	scopes := make([]string, 0, len(key.Scopes()))
+	for _, scope := range key.Scopes() {
+		scopes = append(scopes, string(scope))
+	}
+	orgType := int(org.OrganizationType)
+
+	return &session.Claim{
+		Sub:              key.CreatedBy().String(),
+		Iat:              now.Unix(),
+		Exp:              now.Add(apiKeyClaimLifetime).Unix(),
+		IsRegistered:     true,
+		OrgType:          &orgType,
+		OrganizationID:   lo.ToPtr(org.OrganizationID.String()),
+		OrganizationCode: lo.ToPtr(org.Code),
+		OrganizationRole: lo.ToPtr(organization.RoleToName(role)),
+		APIKeyID:         lo.ToPtr(key.APIKeyID().String()),
+		APIKeyScopes:     scopes,
+	}, nil
+}
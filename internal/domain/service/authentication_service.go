@@ -180,15 +180,8 @@ func (a *authenticationService) BuildAuthContext(ctx context.Context, claim *ses
 		return nil, messages.AuthenticationFailedError
 	}
 
-	sessionID, err := claim.SessionID()
-	if err != nil {
-		utils.HandleError(ctx, err, "claim.SessionID")
-		return nil, messages.SessionParseError
-	}
-
 	authCtx := &auth.AuthenticationContext{
 		UserID:                 userID,
-		SessionID:              sessionID,
 		DisplayName:            claim.DisplayName,
 		DisplayID:              claim.DisplayID,
 		IconURL:                claim.IconURL,
@@ -211,5 +204,26 @@ func (a *authenticationService) BuildAuthContext(ctx context.Context, claim *ses
 		authCtx.OrganizationRole = &role
 	}
 
+	// APIキーはセッションを持たない
+	if claim.IsAPIKey() {
+		apiKeyID, err := shared.ParseUUID[organization.OrganizationAPIKey](*claim.APIKeyID)
+		if err != nil {
+			utils.HandleError(ctx, err, "ParseUUID APIKeyID")
+			return nil, messages.AuthenticationFailedError
+		}
+		authCtx.APIKeyID = &apiKeyID
+		for _, scope := range claim.APIKeyScopes {
+			authCtx.APIKeyScopes = append(authCtx.APIKeyScopes, organization.APIKeyScope(scope))
+		}
+		return authCtx, nil
+	}
+
+	sessionID, err := claim.SessionID()
+	if err != nil {
+		utils.HandleError(ctx, err, "claim.SessionID")
+		return nil, messages.SessionParseError
+	}
+	authCtx.SessionID = sessionID
+
 	return authCtx, nil
 }
@@ -9,6 +9,8 @@ import (
 	"github.com/neko-dream/api/internal/domain/model/session"
 	"github.com/neko-dream/api/internal/domain/model/shared"
 	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/pkg/utils"
+	"github.com/samber/lo"
 	"go.opentelemetry.io/otel"
 )
 
@@ -26,6 +28,9 @@ type AuthorizationService interface {
 	// 組織ロール必須
 	RequireOrganizationRole(ctx context.Context, minRole organization.OrganizationUserRole) (*auth.AuthenticationContext, error)
 
+	// 指定した組織に対する組織ロール必須（現在の組織またはその子孫の組織を操作できる）
+	RequireOrganizationRoleFor(ctx context.Context, organizationID shared.UUID[organization.Organization], minRole organization.OrganizationUserRole) (*auth.AuthenticationContext, error)
+
 	// スーパー管理者必須（RequireOrganizationRoleのエイリアス）
 	RequireSuperAdmin(ctx context.Context) (*auth.AuthenticationContext, error)
 
@@ -47,13 +52,16 @@ type AuthorizationService interface {
 
 type authorizationService struct {
 	authenticationService AuthenticationService
+	organizationRepo      organization.OrganizationRepository
 }
 
 func NewAuthorizationService(
 	authenticationService AuthenticationService,
+	organizationRepo organization.OrganizationRepository,
 ) AuthorizationService {
 	return &authorizationService{
 		authenticationService: authenticationService,
+		organizationRepo:      organizationRepo,
 	}
 }
 
@@ -119,6 +127,32 @@ func (a *authorizationService) RequireOrganizationRole(ctx context.Context, minR
 	return authCtx, nil
 }
 
+// RequireOrganizationRoleFor 指定した組織に対する組織ロール必須
+// 親組織のロールは子孫の組織にも継承されるため、現在の組織が対象の組織の祖先であれば許可する
+func (a *authorizationService) RequireOrganizationRoleFor(ctx context.Context, organizationID shared.UUID[organization.Organization], minRole organization.OrganizationUserRole) (*auth.AuthenticationContext, error) {
+	ctx, span := otel.Tracer("service").Start(ctx, "authorizationService.RequireOrganizationRoleFor")
+	defer span.End()
+
+	authCtx, err := a.RequireOrganizationRole(ctx, minRole)
+	if err != nil {
+		return nil, err
+	}
+	if *authCtx.OrganizationID == organizationID {
+		return authCtx, nil
+	}
+
+	ancestorIDs, err := a.organizationRepo.FindAncestorIDs(ctx, organizationID)
+	if err != nil {
+		utils.HandleError(ctx, err, "OrganizationRepository.FindAncestorIDs")
+		return nil, messages.OrganizationInternalServerError
+	}
+	if !lo.Contains(ancestorIDs, *authCtx.OrganizationID) {
+		return nil, messages.OrganizationPermissionDenied
+	}
+
+	return authCtx, nil
+}
+
 // RequireSuperAdmin スーパー管理者必須（RequireOrgRoleのエイリアス）
 func (a *authorizationService) RequireSuperAdmin(ctx context.Context) (*auth.AuthenticationContext, error) {
 	ctx, span := otel.Tracer("service").Start(ctx, "authorizationService.RequireSuperAdmin")
@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"braces.dev/errtrace"
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/auth/login_attempt"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/internal/infrastructure/config"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type loginThrottle struct {
+	attemptRepo   login_attempt.LoginAttemptRepository
+	lockoutRepo   login_attempt.LockoutRepository
+	accountPolicy login_attempt.LockoutPolicy
+	ipPolicy      login_attempt.LockoutPolicy
+	*db.DBManager
+}
+
+func NewLoginThrottle(
+	attemptRepo login_attempt.LoginAttemptRepository,
+	lockoutRepo login_attempt.LockoutRepository,
+	cfg *config.Config,
+	dbManager *db.DBManager,
+) login_attempt.LoginThrottle {
+	window := time.Duration(cfg.LoginFailureWindow) * time.Second
+	base := time.Duration(cfg.LoginLockoutBaseDuration) * time.Second
+	maxDuration := time.Duration(cfg.LoginLockoutMaxDuration) * time.Second
+
+	return &loginThrottle{
+		attemptRepo: attemptRepo,
+		lockoutRepo: lockoutRepo,
+		accountPolicy: login_attempt.LockoutPolicy{
+			MaxFailures:      cfg.LoginMaxFailuresPerAccount,
+			FailureWindow:    window,
+			BaseLockDuration: base,
+			MaxLockDuration:  maxDuration,
+		},
+		ipPolicy: login_attempt.LockoutPolicy{
+			MaxFailures:      cfg.LoginMaxFailuresPerIP,
+			FailureWindow:    window,
+			BaseLockDuration: base,
+			MaxLockDuration:  maxDuration,
+		},
+		DBManager: dbManager,
+	}
+}
+
+// CheckIP implements login_attempt.LoginThrottle.
+func (t *loginThrottle) CheckIP(ctx context.Context, source login_attempt.AttemptSource) error {
+	ctx, span := otel.Tracer("service").Start(ctx, "loginThrottle.CheckIP")
+	defer span.End()
+
+	if source.IPAddress == nil || *source.IPAddress == "" {
+		return nil
+	}
+
+	now := clock.Now(ctx)
+	lockout, err := t.lockoutRepo.FindByKey(ctx, login_attempt.LockoutScopeIP, *source.IPAddress)
+	if err != nil {
+		return errtrace.Wrap(err)
+	}
+	if lockout == nil || !lockout.IsLocked(now) {
+		return nil
+	}
+
+	t.recordAttempt(ctx, login_attempt.NewFailedLoginAttempt(nil, source.IPAddress, source.UserAgent, login_attempt.FailureReasonIPLocked, now))
+	return messages.TooManyLoginAttemptsError
+}
+
+// CheckAccount implements login_attempt.LoginThrottle.
+func (t *loginThrottle) CheckAccount(ctx context.Context, userID shared.UUID[user.User], source login_attempt.AttemptSource) error {
+	ctx, span := otel.Tracer("service").Start(ctx, "loginThrottle.CheckAccount")
+	defer span.End()
+
+	now := clock.Now(ctx)
+	lockout, err := t.lockoutRepo.FindByKey(ctx, login_attempt.LockoutScopeAccount, userID.String())
+	if err != nil {
+		return errtrace.Wrap(err)
+	}
+	if lockout == nil || !lockout.IsLocked(now) {
+		return nil
+	}
+
+	t.recordAttempt(ctx, login_attempt.NewFailedLoginAttempt(&userID, source.IPAddress, source.UserAgent, login_attempt.FailureReasonAccountLocked, now))
+	return messages.AccountLockedError
+}
+
+// RecordFailure implements login_attempt.LoginThrottle.
+func (t *loginThrottle) RecordFailure(ctx context.Context, userID *shared.UUID[user.User], source login_attempt.AttemptSource) (*time.Time, error) {
+	ctx, span := otel.Tracer("service").Start(ctx, "loginThrottle.RecordFailure")
+	defer span.End()
+
+	now := clock.Now(ctx)
+	t.recordAttempt(ctx, login_attempt.NewFailedLoginAttempt(userID, source.IPAddress, source.UserAgent, login_attempt.FailureReasonInvalidCredentials, now))
+
+	if source.IPAddress != nil && *source.IPAddress != "" {
+		if _, err := t.countFailure(ctx, login_attempt.LockoutScopeIP, *source.IPAddress, t.ipPolicy, now); err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+	}
+
+	if userID == nil {
+		return nil, nil
+	}
+	lockout, err := t.countFailure(ctx, login_attempt.LockoutScopeAccount, userID.String(), t.accountPolicy, now)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	if lockout == nil {
+		return nil, nil
+	}
+
+	return lockout.LockedUntil, nil
+}
+
+// RecordSuccess implements login_attempt.LoginThrottle.
+func (t *loginThrottle) RecordSuccess(ctx context.Context, userID shared.UUID[user.User], source login_attempt.AttemptSource) error {
+	ctx, span := otel.Tracer("service").Start(ctx, "loginThrottle.RecordSuccess")
+	defer span.End()
+
+	t.recordAttempt(ctx, login_attempt.NewSucceededLoginAttempt(userID, source.IPAddress, source.UserAgent, clock.Now(ctx)))
+
+	// IP単位のカウンタは共有IPからの攻撃を考慮してリセットしない
+	return errtrace.Wrap(t.lockoutRepo.Delete(ctx, login_attempt.LockoutScopeAccount, userID.String()))
+}
+
+func (t *loginThrottle) countFailure(
+	ctx context.Context,
+	scope login_attempt.LockoutScope,
+	key string,
+	policy login_attempt.LockoutPolicy,
+	now time.Time,
+) (*login_attempt.Lockout, error) {
+	// 同時に失敗したリクエストで回数を取りこぼさないよう、行をロックして読み書きする
+	var lockout *login_attempt.Lockout
+	var locked bool
+	if err := t.ExecTx(ctx, func(ctx context.Context) error {
+		var err error
+		lockout, err = t.lockoutRepo.FindByKeyForUpdate(ctx, scope, key)
+		if err != nil {
+			return errtrace.Wrap(err)
+		}
+
+		locked = lockout.RecordFailure(now, policy)
+		return errtrace.Wrap(t.lockoutRepo.Save(ctx, *lockout))
+	}); err != nil {
+		return nil, err
+	}
+	if !locked {
+		return nil, nil
+	}
+
+	// 今回の失敗でロックされた場合のみ返す
+	return lockout, nil
+}
+
+// recordAttempt 監査ログの書き込みに失敗してもログイン処理自体は継続する
+func (t *loginThrottle) recordAttempt(ctx context.Context, attempt login_attempt.LoginAttempt) {
+	if err := t.attemptRepo.Create(ctx, attempt); err != nil {
+		utils.HandleError(ctx, err, "LoginAttemptRepository.Create")
+	}
+}
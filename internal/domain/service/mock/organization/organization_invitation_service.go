@@ -0,0 +1,105 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: organization_invitation_service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=organization_invitation_service.go -package=mock_organization -destination=../mock/organization/organization_invitation_service.go
+//
+
+// Package mock_organization is a generated GoMock package.
+package mock_organization
+
+import (
+	context "context"
+	reflect "reflect"
+
+	organization "github.com/neko-dream/api/internal/domain/model/organization"
+	shared "github.com/neko-dream/api/internal/domain/model/shared"
+	user "github.com/neko-dream/api/internal/domain/model/user"
+	organization0 "github.com/neko-dream/api/internal/domain/service/organization"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockOrganizationInvitationService is a mock of OrganizationInvitationService interface.
+type MockOrganizationInvitationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockOrganizationInvitationServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockOrganizationInvitationServiceMockRecorder is the mock recorder for MockOrganizationInvitationService.
+type MockOrganizationInvitationServiceMockRecorder struct {
+	mock *MockOrganizationInvitationService
+}
+
+// NewMockOrganizationInvitationService creates a new mock instance.
+func NewMockOrganizationInvitationService(ctrl *gomock.Controller) *MockOrganizationInvitationService {
+	mock := &MockOrganizationInvitationService{ctrl: ctrl}
+	mock.recorder = &MockOrganizationInvitationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrganizationInvitationService) EXPECT() *MockOrganizationInvitationServiceMockRecorder {
+	return m.recorder
+}
+
+// Accept mocks base method.
+func (m *MockOrganizationInvitationService) Accept(ctx context.Context, userID shared.UUID[user.User], token string) (*organization.Organization, *organization.OrganizationUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Accept", ctx, userID, token)
+	ret0, _ := ret[0].(*organization.Organization)
+	ret1, _ := ret[1].(*organization.OrganizationUser)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Accept indicates an expected call of Accept.
+func (mr *MockOrganizationInvitationServiceMockRecorder) Accept(ctx, userID, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Accept", reflect.TypeOf((*MockOrganizationInvitationService)(nil).Accept), ctx, userID, token)
+}
+
+// Invite mocks base method.
+func (m *MockOrganizationInvitationService) Invite(ctx context.Context, params organization0.InviteParams) (*organization.OrganizationInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Invite", ctx, params)
+	ret0, _ := ret[0].(*organization.OrganizationInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Invite indicates an expected call of Invite.
+func (mr *MockOrganizationInvitationServiceMockRecorder) Invite(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Invite", reflect.TypeOf((*MockOrganizationInvitationService)(nil).Invite), ctx, params)
+}
+
+// Resend mocks base method.
+func (m *MockOrganizationInvitationService) Resend(ctx context.Context, organizationID shared.UUID[organization.Organization], operatorID shared.UUID[user.User], invitationID shared.UUID[organization.OrganizationInvitation]) (*organization.OrganizationInvitation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resend", ctx, organizationID, operatorID, invitationID)
+	ret0, _ := ret[0].(*organization.OrganizationInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Resend indicates an expected call of Resend.
+func (mr *MockOrganizationInvitationServiceMockRecorder) Resend(ctx, organizationID, operatorID, invitationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resend", reflect.TypeOf((*MockOrganizationInvitationService)(nil).Resend), ctx, organizationID, operatorID, invitationID)
+}
+
+// Revoke mocks base method.
+func (m *MockOrganizationInvitationService) Revoke(ctx context.Context, organizationID shared.UUID[organization.Organization], operatorID shared.UUID[user.User], invitationID shared.UUID[organization.OrganizationInvitation]) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", ctx, organizationID, operatorID, invitationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockOrganizationInvitationServiceMockRecorder) Revoke(ctx, organizationID, operatorID, invitationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockOrganizationInvitationService)(nil).Revoke), ctx, organizationID, operatorID, invitationID)
+}
@@ -24,6 +24,7 @@ import (
 type MockOrganizationMemberManager struct {
 	ctrl     *gomock.Controller
 	recorder *MockOrganizationMemberManagerMockRecorder
+	isgomock struct{}
 }
 
 // MockOrganizationMemberManagerMockRecorder is the mock recorder for MockOrganizationMemberManager.
@@ -57,6 +58,21 @@ func (mr *MockOrganizationMemberManagerMockRecorder) AddUser(ctx, params any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUser", reflect.TypeOf((*MockOrganizationMemberManager)(nil).AddUser), ctx, params)
 }
 
+// ChangeRole mocks base method.
+func (m *MockOrganizationMemberManager) ChangeRole(ctx context.Context, params organization0.ChangeRoleParams) (*organization.OrganizationUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeRole", ctx, params)
+	ret0, _ := ret[0].(*organization.OrganizationUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeRole indicates an expected call of ChangeRole.
+func (mr *MockOrganizationMemberManagerMockRecorder) ChangeRole(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeRole", reflect.TypeOf((*MockOrganizationMemberManager)(nil).ChangeRole), ctx, params)
+}
+
 // InviteUser mocks base method.
 func (m *MockOrganizationMemberManager) InviteUser(ctx context.Context, params organization0.InviteUserParams) (*organization.OrganizationUser, error) {
 	m.ctrl.T.Helper()
@@ -86,3 +102,17 @@ func (mr *MockOrganizationMemberManagerMockRecorder) IsSuperAdmin(ctx, userID an
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSuperAdmin", reflect.TypeOf((*MockOrganizationMemberManager)(nil).IsSuperAdmin), ctx, userID)
 }
+
+// RemoveUser mocks base method.
+func (m *MockOrganizationMemberManager) RemoveUser(ctx context.Context, params organization0.RemoveUserParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveUser", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveUser indicates an expected call of RemoveUser.
+func (mr *MockOrganizationMemberManagerMockRecorder) RemoveUser(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUser", reflect.TypeOf((*MockOrganizationMemberManager)(nil).RemoveUser), ctx, params)
+}
@@ -0,0 +1,122 @@
+package organization
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+// OrganizationHierarchyService 組織の親子関係を扱う
+type OrganizationHierarchyService interface {
+	// ChangeParent 親組織を変更する。parentIDがnilの場合は親子関係を解除する
+	ChangeParent(ctx context.Context, params ChangeParentParams) (*organization.Organization, error)
+}
+
+type ChangeParentParams struct {
+	OrganizationID shared.UUID[organization.Organization]
+	ParentID       *shared.UUID[organization.Organization]
+	OperatorID     shared.UUID[user.User]
+}
+
+type organizationHierarchyService struct {
+	organizationRepo     organization.OrganizationRepository
+	organizationUserRepo organization.OrganizationUserRepository
+}
+
+func NewOrganizationHierarchyService(
+	organizationRepo organization.OrganizationRepository,
+	organizationUserRepo organization.OrganizationUserRepository,
+) OrganizationHierarchyService {
+	return &organizationHierarchyService{
+		organizationRepo:     organizationRepo,
+		organizationUserRepo: organizationUserRepo,
+	}
+}
+
+// ChangeParent 親組織を変更する
+// 付け替える組織・現在の親組織・新しい親組織のいずれに対してもオーナー権限が必要
+func (s *organizationHierarchyService) ChangeParent(ctx context.Context, params ChangeParentParams) (*organization.Organization, error) {
+	ctx, span := otel.Tracer("organization").Start(ctx, "organizationHierarchyService.ChangeParent")
+	defer span.End()
+
+	org, err := s.organizationRepo.FindByID(ctx, params.OrganizationID)
+	if err != nil || org == nil {
+		return nil, messages.OrganizationNotFound
+	}
+	if err := s.requireOwner(ctx, org.OrganizationID, params.OperatorID); err != nil {
+		return nil, err
+	}
+	if org.ParentOrganizationID != nil {
+		if err := s.requireOwner(ctx, *org.ParentOrganizationID, params.OperatorID); err != nil {
+			return nil, err
+		}
+	}
+
+	if params.ParentID == nil {
+		org.ClearParent()
+		if err := s.organizationRepo.Update(ctx, org); err != nil {
+			utils.HandleError(ctx, err, "OrganizationRepository.Update")
+			return nil, messages.OrganizationInternalServerError
+		}
+		return org, nil
+	}
+
+	parent, err := s.organizationRepo.FindByID(ctx, *params.ParentID)
+	if err != nil || parent == nil {
+		return nil, messages.OrganizationNotFound
+	}
+	if err := s.requireOwner(ctx, parent.OrganizationID, params.OperatorID); err != nil {
+		return nil, err
+	}
+
+	parentAncestorIDs, err := s.organizationRepo.FindAncestorIDs(ctx, parent.OrganizationID)
+	if err != nil {
+		utils.HandleError(ctx, err, "OrganizationRepository.FindAncestorIDs")
+		return nil, messages.OrganizationInternalServerError
+	}
+	descendants, err := s.organizationRepo.FindDescendants(ctx, org.OrganizationID)
+	if err != nil {
+		utils.HandleError(ctx, err, "OrganizationRepository.FindDescendants")
+		return nil, messages.OrganizationInternalServerError
+	}
+
+	if err := org.SetParent(parent, parentAncestorIDs, descendants); err != nil {
+		switch {
+		case errors.Is(err, organization.ErrOrganizationHierarchyCycle):
+			return nil, messages.OrganizationHierarchyCycle
+		case errors.Is(err, organization.ErrOrganizationHierarchyTooDeep):
+			return nil, messages.OrganizationHierarchyTooDeep
+		default:
+			return nil, err
+		}
+	}
+
+	if err := s.organizationRepo.Update(ctx, org); err != nil {
+		utils.HandleError(ctx, err, "OrganizationRepository.Update")
+		return nil, messages.OrganizationInternalServerError
+	}
+	return org, nil
+}
+
+// requireOwner 祖先の組織からの継承を含め、オーナー以上の権限を持つか確認する
+func (s *organizationHierarchyService) requireOwner(ctx context.Context, organizationID shared.UUID[organization.Organization], userID shared.UUID[user.User]) error {
+	orgUser, err := s.organizationUserRepo.FindEffectiveByOrganizationIDAndUserID(ctx, organizationID, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return messages.OrganizationPermissionDenied
+		}
+		utils.HandleError(ctx, err, "OrganizationUserRepository.FindEffectiveByOrganizationIDAndUserID")
+		return messages.OrganizationInternalServerError
+	}
+	if orgUser == nil || orgUser.Role > organization.OrganizationUserRoleOwner {
+		return messages.OrganizationPermissionDenied
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package organization_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	mock_organization_model "github.com/neko-dream/api/internal/domain/model/mock/organization"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	organization_service "github.com/neko-dream/api/internal/domain/service/organization"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestOrganizationHierarchyService_ChangeParent(t *testing.T) {
+	ctx := context.Background()
+	operatorID := shared.NewUUID[user.User]()
+
+	newOrg := func(name string) *organization.Organization {
+		return organization.NewOrganization(shared.NewUUID[organization.Organization](), organization.OrganizationTypeGovernment, name, name, nil, shared.NewUUID[user.User]())
+	}
+	orgUser := func(orgID shared.UUID[organization.Organization], role organization.OrganizationUserRole) *organization.OrganizationUser {
+		return organization.NewOrganizationUser(shared.NewUUID[organization.OrganizationUser](), orgID, operatorID, role)
+	}
+
+	prefecture := newOrg("hokkaido")
+	city := newOrg("sapporo")
+	ward := newOrg("chuo")
+	ward.ParentOrganizationID = &city.OrganizationID
+
+	tests := []struct {
+		name        string
+		org         *organization.Organization
+		parentID    *shared.UUID[organization.Organization]
+		setupMocks  func(*mock_organization_model.MockOrganizationRepository, *mock_organization_model.MockOrganizationUserRepository)
+		wantParent  *shared.UUID[organization.Organization]
+		expectError error
+	}{
+		{
+			name:     "両方の組織のオーナーは親組織を設定できる",
+			org:      city,
+			parentID: &prefecture.OrganizationID,
+			setupMocks: func(orgRepo *mock_organization_model.MockOrganizationRepository, orgUserRepo *mock_organization_model.MockOrganizationUserRepository) {
+				orgRepo.EXPECT().FindByID(gomock.Any(), prefecture.OrganizationID).Return(prefecture, nil)
+				orgUserRepo.EXPECT().FindEffectiveByOrganizationIDAndUserID(gomock.Any(), city.OrganizationID, operatorID).Return(orgUser(city.OrganizationID, organization.OrganizationUserRoleOwner), nil)
+				orgUserRepo.EXPECT().FindEffectiveByOrganizationIDAndUserID(gomock.Any(), prefecture.OrganizationID, operatorID).Return(orgUser(prefecture.OrganizationID, organization.OrganizationUserRoleOwner), nil)
+				orgRepo.EXPECT().FindAncestorIDs(gomock.Any(), prefecture.OrganizationID).Return(nil, nil)
+				orgRepo.EXPECT().FindDescendants(gomock.Any(), city.OrganizationID).Return([]*organization.Organization{ward}, nil)
+				orgRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantParent: &prefecture.OrganizationID,
+		},
+		{
+			name:     "親組織の権限がなければ設定できない",
+			org:      city,
+			parentID: &prefecture.OrganizationID,
+			setupMocks: func(orgRepo *mock_organization_model.MockOrganizationRepository, orgUserRepo *mock_organization_model.MockOrganizationUserRepository) {
+				orgRepo.EXPECT().FindByID(gomock.Any(), prefecture.OrganizationID).Return(prefecture, nil)
+				orgUserRepo.EXPECT().FindEffectiveByOrganizationIDAndUserID(gomock.Any(), city.OrganizationID, operatorID).Return(orgUser(city.OrganizationID, organization.OrganizationUserRoleOwner), nil)
+				orgUserRepo.EXPECT().FindEffectiveByOrganizationIDAndUserID(gomock.Any(), prefecture.OrganizationID, operatorID).Return(nil, sql.ErrNoRows)
+			},
+			expectError: messages.OrganizationPermissionDenied,
+		},
+		{
+			name:     "管理者は親組織を変更できない",
+			org:      city,
+			parentID: &prefecture.OrganizationID,
+			setupMocks: func(orgRepo *mock_organization_model.MockOrganizationRepository, orgUserRepo *mock_organization_model.MockOrganizationUserRepository) {
+				orgUserRepo.EXPECT().FindEffectiveByOrganizationIDAndUserID(gomock.Any(), city.OrganizationID, operatorID).Return(orgUser(city.OrganizationID, organization.OrganizationUserRoleAdmin), nil)
+			},
+			expectError: messages.OrganizationPermissionDenied,
+		},
+		{
+			name:     "子孫の組織を親にはできない",
+			org:      city,
+			parentID: &ward.OrganizationID,
+			setupMocks: func(orgRepo *mock_organization_model.MockOrganizationRepository, orgUserRepo *mock_organization_model.MockOrganizationUserRepository) {
+				orgRepo.EXPECT().FindByID(gomock.Any(), ward.OrganizationID).Return(ward, nil)
+				orgUserRepo.EXPECT().FindEffectiveByOrganizationIDAndUserID(gomock.Any(), gomock.Any(), operatorID).Return(orgUser(city.OrganizationID, organization.OrganizationUserRoleOwner), nil).Times(2)
+				orgRepo.EXPECT().FindAncestorIDs(gomock.Any(), ward.OrganizationID).Return([]shared.UUID[organization.Organization]{city.OrganizationID}, nil)
+				orgRepo.EXPECT().FindDescendants(gomock.Any(), city.OrganizationID).Return([]*organization.Organization{ward}, nil)
+			},
+			expectError: messages.OrganizationHierarchyCycle,
+		},
+		{
+			name:     "親組織のオーナーは親子関係を解除できる",
+			org:      ward,
+			parentID: nil,
+			setupMocks: func(orgRepo *mock_organization_model.MockOrganizationRepository, orgUserRepo *mock_organization_model.MockOrganizationUserRepository) {
+				inherited := orgUser(ward.OrganizationID, organization.OrganizationUserRoleOwner)
+				inherited.InheritedFrom = &city.OrganizationID
+				orgUserRepo.EXPECT().FindEffectiveByOrganizationIDAndUserID(gomock.Any(), ward.OrganizationID, operatorID).Return(inherited, nil)
+				orgUserRepo.EXPECT().FindEffectiveByOrganizationIDAndUserID(gomock.Any(), city.OrganizationID, operatorID).Return(orgUser(city.OrganizationID, organization.OrganizationUserRoleOwner), nil)
+				orgRepo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+			},
+			wantParent: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockOrgRepo := mock_organization_model.NewMockOrganizationRepository(ctrl)
+			mockOrgUserRepo := mock_organization_model.NewMockOrganizationUserRepository(ctrl)
+			org := *tt.org
+			mockOrgRepo.EXPECT().FindByID(gomock.Any(), org.OrganizationID).Return(&org, nil)
+			tt.setupMocks(mockOrgRepo, mockOrgUserRepo)
+
+			service := organization_service.NewOrganizationHierarchyService(mockOrgRepo, mockOrgUserRepo)
+			result, err := service.ChangeParent(ctx, organization_service.ChangeParentParams{
+				OrganizationID: org.OrganizationID,
+				ParentID:       tt.parentID,
+				OperatorID:     operatorID,
+			})
+
+			if tt.expectError != nil {
+				assert.Equal(t, tt.expectError, err)
+				assert.Nil(t, result)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantParent, result.ParentOrganizationID)
+		})
+	}
+}
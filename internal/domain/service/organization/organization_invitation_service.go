@@ -0,0 +1,351 @@
+package organization
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/internal/infrastructure/config"
+	"github.com/neko-dream/api/internal/infrastructure/email"
+	email_template "github.com/neko-dream/api/internal/infrastructure/email/template"
+	"github.com/neko-dream/api/pkg/hash"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type InviteParams struct {
+	OrganizationID shared.UUID[organization.Organization]
+	InviterID      shared.UUID[user.User]
+	Email          string
+	Role           organization.OrganizationUserRole
+}
+
+//go:generate go tool mockgen -source=$GOFILE -package=mock_$GOPACKAGE -destination=../mock/$GOPACKAGE/$GOFILE
+type OrganizationInvitationService interface {
+	// 招待リンクの発行と送信
+	Invite(ctx context.Context, params InviteParams) (*organization.OrganizationInvitation, error)
+	// 招待リンクの再送。以前のリンクは無効になる
+	Resend(ctx context.Context, organizationID shared.UUID[organization.Organization], operatorID shared.UUID[user.User], invitationID shared.UUID[organization.OrganizationInvitation]) (*organization.OrganizationInvitation, error)
+	// 招待の取り消し
+	Revoke(ctx context.Context, organizationID shared.UUID[organization.Organization], operatorID shared.UUID[user.User], invitationID shared.UUID[organization.OrganizationInvitation]) error
+	// 招待の承諾。招待後に登録したユーザーも、招待先のメールアドレスであれば承諾できる
+	Accept(ctx context.Context, userID shared.UUID[user.User], token string) (*organization.Organization, *organization.OrganizationUser, error)
+}
+
+type organizationInvitationService struct {
+	organizationRepo     organization.OrganizationRepository
+	organizationUserRepo organization.OrganizationUserRepository
+	invitationRepo       organization.OrganizationInvitationRepository
+	userRepo             user.UserRepository
+	emailSender          email.EmailSender
+	cfg                  *config.Config
+}
+
+func NewOrganizationInvitationService(
+	organizationRepo organization.OrganizationRepository,
+	organizationUserRepo organization.OrganizationUserRepository,
+	invitationRepo organization.OrganizationInvitationRepository,
+	userRepo user.UserRepository,
+	emailSender email.EmailSender,
+	cfg *config.Config,
+) OrganizationInvitationService {
+	return &organizationInvitationService{
+		organizationRepo:     organizationRepo,
+		organizationUserRepo: organizationUserRepo,
+		invitationRepo:       invitationRepo,
+		userRepo:             userRepo,
+		emailSender:          emailSender,
+		cfg:                  cfg,
+	}
+}
+
+// Invite 招待を作成し、招待リンクをメールで送信する
+func (s *organizationInvitationService) Invite(ctx context.Context, params InviteParams) (*organization.OrganizationInvitation, error) {
+	ctx, span := otel.Tracer("organization").Start(ctx, "organizationInvitationService.Invite")
+	defer span.End()
+
+	email, err := organization.NormalizeInvitationEmail(params.Email)
+	if err != nil {
+		return nil, messages.OrganizationInvitationInvalidParameter
+	}
+	if !params.Role.IsValid() || params.Role == organization.OrganizationUserRoleSuperAdmin {
+		return nil, messages.OrganizationInvitationInvalidParameter
+	}
+	if err := s.authorizeInvitationRole(ctx, params.OrganizationID, params.InviterID, params.Role); err != nil {
+		return nil, err
+	}
+
+	org, err := s.organizationRepo.FindByID(ctx, params.OrganizationID)
+	if err != nil || org == nil {
+		return nil, messages.OrganizationNotFound
+	}
+
+	emailHash, err := hash.HashEmail(email, s.cfg.HASH_PEPPER)
+	if err != nil {
+		utils.HandleError(ctx, err, "HashEmail")
+		return nil, messages.OrganizationInternalServerError
+	}
+
+	now := clock.Now(ctx)
+	pending, err := s.invitationRepo.FindPendingByEmailHash(ctx, params.OrganizationID, emailHash)
+	if err != nil {
+		utils.HandleError(ctx, err, "OrganizationInvitationRepository.FindPendingByEmailHash")
+		return nil, messages.OrganizationInternalServerError
+	}
+	if pending != nil {
+		if pending.Status(now) == organization.InvitationStatusPending {
+			return nil, messages.OrganizationInvitationAlreadyPending
+		}
+		// 期限切れの招待は取り消してから作り直す
+		if err := pending.Revoke(now); err != nil {
+			return nil, invitationError(err)
+		}
+		if err := s.invitationRepo.Update(ctx, pending); err != nil {
+			utils.HandleError(ctx, err, "OrganizationInvitationRepository.Update")
+			return nil, messages.OrganizationInternalServerError
+		}
+	}
+
+	invitation, token, err := organization.NewOrganizationInvitation(params.OrganizationID, email, emailHash, params.Role, params.InviterID, s.cfg.HASH_PEPPER, now)
+	if err != nil {
+		return nil, invitationError(err)
+	}
+	if err := s.invitationRepo.Create(ctx, invitation); err != nil {
+		utils.HandleError(ctx, err, "OrganizationInvitationRepository.Create")
+		return nil, messages.OrganizationInternalServerError
+	}
+
+	if err := s.sendInvitationMail(ctx, invitation, org, token); err != nil {
+		return nil, messages.OrganizationInternalServerError
+	}
+
+	return invitation, nil
+}
+
+// Resend 招待リンクを再発行して送信する
+func (s *organizationInvitationService) Resend(
+	ctx context.Context,
+	organizationID shared.UUID[organization.Organization],
+	operatorID shared.UUID[user.User],
+	invitationID shared.UUID[organization.OrganizationInvitation],
+) (*organization.OrganizationInvitation, error) {
+	ctx, span := otel.Tracer("organization").Start(ctx, "organizationInvitationService.Resend")
+	defer span.End()
+
+	invitation, err := s.findInvitation(ctx, organizationID, invitationID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeInvitationRole(ctx, organizationID, operatorID, invitation.Role()); err != nil {
+		return nil, err
+	}
+
+	org, err := s.organizationRepo.FindByID(ctx, organizationID)
+	if err != nil || org == nil {
+		return nil, messages.OrganizationNotFound
+	}
+
+	token, err := invitation.Resend(s.cfg.HASH_PEPPER, clock.Now(ctx))
+	if err != nil {
+		return nil, invitationError(err)
+	}
+	if err := s.invitationRepo.Update(ctx, invitation); err != nil {
+		utils.HandleError(ctx, err, "OrganizationInvitationRepository.Update")
+		return nil, messages.OrganizationInternalServerError
+	}
+
+	if err := s.sendInvitationMail(ctx, invitation, org, token); err != nil {
+		return nil, messages.OrganizationInternalServerError
+	}
+
+	return invitation, nil
+}
+
+// Revoke 招待を取り消す
+func (s *organizationInvitationService) Revoke(
+	ctx context.Context,
+	organizationID shared.UUID[organization.Organization],
+	operatorID shared.UUID[user.User],
+	invitationID shared.UUID[organization.OrganizationInvitation],
+) error {
+	ctx, span := otel.Tracer("organization").Start(ctx, "organizationInvitationService.Revoke")
+	defer span.End()
+
+	invitation, err := s.findInvitation(ctx, organizationID, invitationID)
+	if err != nil {
+		return err
+	}
+	if err := s.authorizeInvitationRole(ctx, organizationID, operatorID, invitation.Role()); err != nil {
+		return err
+	}
+
+	if err := invitation.Revoke(clock.Now(ctx)); err != nil {
+		return invitationError(err)
+	}
+	if err := s.invitationRepo.Update(ctx, invitation); err != nil {
+		utils.HandleError(ctx, err, "OrganizationInvitationRepository.Update")
+		return messages.OrganizationInternalServerError
+	}
+
+	return nil
+}
+
+// Accept 招待を承諾して組織に参加する
+func (s *organizationInvitationService) Accept(ctx context.Context, userID shared.UUID[user.User], token string) (*organization.Organization, *organization.OrganizationUser, error) {
+	ctx, span := otel.Tracer("organization").Start(ctx, "organizationInvitationService.Accept")
+	defer span.End()
+
+	invitation, err := s.invitationRepo.FindByTokenHash(ctx, organization.HashInvitationToken(token, s.cfg.HASH_PEPPER))
+	if err != nil {
+		utils.HandleError(ctx, err, "OrganizationInvitationRepository.FindByTokenHash")
+		return nil, nil, messages.OrganizationInternalServerError
+	}
+	if invitation == nil {
+		return nil, nil, messages.OrganizationInvitationNotFound
+	}
+
+	usr, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		utils.HandleError(ctx, err, "UserRepository.FindByID")
+		return nil, nil, messages.OrganizationInternalServerError
+	}
+	if usr == nil {
+		return nil, nil, messages.ForbiddenError
+	}
+
+	// 招待先と同じメールアドレスのユーザーのみ承諾できる
+	var emailHash string
+	if usr.Email() != nil {
+		if email, err := organization.NormalizeInvitationEmail(*usr.Email()); err == nil {
+			emailHash, err = hash.HashEmail(email, s.cfg.HASH_PEPPER)
+			if err != nil {
+				utils.HandleError(ctx, err, "HashEmail")
+				return nil, nil, messages.OrganizationInternalServerError
+			}
+		}
+	}
+	if err := invitation.Accept(userID, emailHash, clock.Now(ctx)); err != nil {
+		return nil, nil, invitationError(err)
+	}
+	if err := s.invitationRepo.Update(ctx, invitation); err != nil {
+		utils.HandleError(ctx, err, "OrganizationInvitationRepository.Update")
+		return nil, nil, messages.OrganizationInternalServerError
+	}
+
+	org, err := s.organizationRepo.FindByID(ctx, invitation.OrganizationID())
+	if err != nil || org == nil {
+		return nil, nil, messages.OrganizationNotFound
+	}
+
+	// 既に所属している場合はロールを変えずにそのまま返す
+	orgUser, err := s.organizationUserRepo.FindByOrganizationIDAndUserID(ctx, invitation.OrganizationID(), userID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		utils.HandleError(ctx, err, "OrganizationUserRepository.FindByOrganizationIDAndUserID")
+		return nil, nil, messages.OrganizationInternalServerError
+	}
+	if orgUser != nil {
+		return org, orgUser, nil
+	}
+
+	orgUser = organization.NewOrganizationUser(
+		shared.NewUUID[organization.OrganizationUser](),
+		invitation.OrganizationID(),
+		userID,
+		invitation.Role(),
+	)
+	if err := s.organizationUserRepo.Create(ctx, *orgUser); err != nil {
+		utils.HandleError(ctx, err, "OrganizationUserRepository.Create")
+		return nil, nil, messages.OrganizationInternalServerError
+	}
+
+	return org, orgUser, nil
+}
+
+// authorizeInvitationRole 操作者がroleのユーザーを招待・管理できるか確認する
+// ロールはトークンではなくDBの値で判定する
+func (s *organizationInvitationService) authorizeInvitationRole(
+	ctx context.Context,
+	organizationID shared.UUID[organization.Organization],
+	operatorID shared.UUID[user.User],
+	role organization.OrganizationUserRole,
+) error {
+	operator, err := s.organizationUserRepo.FindEffectiveByOrganizationIDAndUserID(ctx, organizationID, operatorID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return messages.OrganizationPermissionDenied
+		}
+		utils.HandleError(ctx, err, "OrganizationUserRepository.FindEffectiveByOrganizationIDAndUserID")
+		return messages.OrganizationInternalServerError
+	}
+	if operator == nil || !operator.HasPermissionToChangeRoleTo(role) {
+		return messages.OrganizationPermissionDenied
+	}
+	return nil
+}
+
+// findInvitation 組織の招待を取得する。他組織の招待は存在しないものとして扱う
+func (s *organizationInvitationService) findInvitation(
+	ctx context.Context,
+	organizationID shared.UUID[organization.Organization],
+	invitationID shared.UUID[organization.OrganizationInvitation],
+) (*organization.OrganizationInvitation, error) {
+	invitation, err := s.invitationRepo.FindByID(ctx, invitationID)
+	if err != nil {
+		utils.HandleError(ctx, err, "OrganizationInvitationRepository.FindByID")
+		return nil, messages.OrganizationInternalServerError
+	}
+	if invitation == nil || invitation.OrganizationID() != organizationID {
+		return nil, messages.OrganizationInvitationNotFound
+	}
+	return invitation, nil
+}
+
+func (s *organizationInvitationService) sendInvitationMail(
+	ctx context.Context,
+	invitation *organization.OrganizationInvitation,
+	org *organization.Organization,
+	token string,
+) error {
+	ctx, span := otel.Tracer("organization").Start(ctx, "organizationInvitationService.sendInvitationMail")
+	defer span.End()
+
+	acceptURL := fmt.Sprintf("%s/organizations/invitations/accept?token=%s", s.cfg.WEBSITE_URL, url.QueryEscape(token))
+	if err := s.emailSender.Send(ctx, invitation.Email(), email_template.OrganizationInvitationLinkEmailTemplate, map[string]any{
+		"Title":            "【ことひろ】招待が届いています",
+		"CompanyLogo":      "https://github.com/neko-dream/api/raw/develop/docs/public/assets/icon.png",
+		"OrganizationName": org.Name,
+		"RoleName":         organization.RoleToName(invitation.Role()),
+		"AcceptURL":        acceptURL,
+		"ExpiresAt":        invitation.ExpiresAt().In(time.FixedZone("Asia/Tokyo", 9*60*60)).Format("2006年01月02日 15:04"),
+	}); err != nil {
+		utils.HandleError(ctx, err, "EmailSender.Send")
+		return err
+	}
+	return nil
+}
+
+// invitationError ドメインのエラーをAPIエラーに変換する
+func invitationError(err error) error {
+	switch {
+	case errors.Is(err, organization.ErrInvalidInvitationEmail), errors.Is(err, organization.ErrInvalidInvitationRole):
+		return messages.OrganizationInvitationInvalidParameter
+	case errors.Is(err, organization.ErrInvitationNotPending):
+		return messages.OrganizationInvitationNotPending
+	case errors.Is(err, organization.ErrInvitationExpired):
+		return messages.OrganizationInvitationExpired
+	case errors.Is(err, organization.ErrInvitationEmailMismatch):
+		return messages.OrganizationInvitationEmailMismatch
+	case errors.Is(err, organization.ErrInvitationResendLimit):
+		return messages.OrganizationInvitationResendLimit
+	default:
+		return err
+	}
+}
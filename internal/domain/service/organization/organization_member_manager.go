@@ -28,6 +28,19 @@ type InviteUserParams struct {
 	Email          string
 }
 
+type ChangeRoleParams struct {
+	OrganizationID shared.UUID[organization.Organization]
+	OperatorID     shared.UUID[user.User]
+	TargetUserID   shared.UUID[user.User]
+	Role           organization.OrganizationUserRole
+}
+
+type RemoveUserParams struct {
+	OrganizationID shared.UUID[organization.Organization]
+	OperatorID     shared.UUID[user.User]
+	TargetUserID   shared.UUID[user.User]
+}
+
 //go:generate go tool mockgen -source=$GOFILE -package=mock_$GOPACKAGE -destination=../mock/$GOPACKAGE/$GOFILE
 type OrganizationMemberManager interface {
 	// ユーザーの発行
@@ -35,6 +48,10 @@ type OrganizationMemberManager interface {
 	// ユーザーの招待
 	AddUser(ctx context.Context, params InviteUserParams) error
 	IsSuperAdmin(ctx context.Context, userID shared.UUID[user.User]) (bool, error)
+	// ユーザーのロール変更
+	ChangeRole(ctx context.Context, params ChangeRoleParams) (*organization.OrganizationUser, error)
+	// ユーザーの削除
+	RemoveUser(ctx context.Context, params RemoveUserParams) error
 }
 
 type organizationMemberManager struct {
@@ -112,6 +129,121 @@ func (s *organizationMemberManager) AddUser(ctx context.Context, params InviteUs
 	return nil
 }
 
+// ChangeRole 組織ユーザーのロールを変更する
+// 操作者は自分と同じかそれ以下のロールのユーザーに対して、自分と同じかそれ以下のロールにのみ変更できる
+func (s *organizationMemberManager) ChangeRole(ctx context.Context, params ChangeRoleParams) (*organization.OrganizationUser, error) {
+	ctx, span := otel.Tracer("organization").Start(ctx, "organizationMemberManager.ChangeRole")
+	defer span.End()
+
+	if !params.Role.IsValid() {
+		return nil, messages.OrganizationRoleInvalid
+	}
+
+	operator, target, err := s.findManageableUser(ctx, params.OrganizationID, params.OperatorID, params.TargetUserID)
+	if err != nil {
+		return nil, err
+	}
+	if !operator.HasPermissionToChangeRoleTo(params.Role) {
+		return nil, messages.OrganizationPermissionDenied
+	}
+	if target.Role == params.Role {
+		return target, nil
+	}
+
+	// オーナーを降格する場合、他にオーナーが残っている必要がある
+	if target.Role == organization.OrganizationUserRoleOwner {
+		if err := s.ensureAnotherOwner(ctx, params.OrganizationID); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := target.SetRole(params.Role); err != nil {
+		return nil, messages.OrganizationRoleInvalid
+	}
+	if err := s.organizationUserRepo.Update(ctx, *target); err != nil {
+		utils.HandleError(ctx, err, "OrganizationUserRepository.Update")
+		return nil, messages.OrganizationInternalServerError
+	}
+
+	return target, nil
+}
+
+// RemoveUser 組織からユーザーを削除する
+func (s *organizationMemberManager) RemoveUser(ctx context.Context, params RemoveUserParams) error {
+	ctx, span := otel.Tracer("organization").Start(ctx, "organizationMemberManager.RemoveUser")
+	defer span.End()
+
+	_, target, err := s.findManageableUser(ctx, params.OrganizationID, params.OperatorID, params.TargetUserID)
+	if err != nil {
+		return err
+	}
+
+	if target.Role == organization.OrganizationUserRoleOwner {
+		if err := s.ensureAnotherOwner(ctx, params.OrganizationID); err != nil {
+			return err
+		}
+	}
+
+	if err := s.organizationUserRepo.Delete(ctx, params.OrganizationID, params.TargetUserID); err != nil {
+		utils.HandleError(ctx, err, "OrganizationUserRepository.Delete")
+		return messages.OrganizationInternalServerError
+	}
+
+	return nil
+}
+
+// findManageableUser 操作者と対象ユーザーを取得し、操作者が対象ユーザーを管理できるか確認する
+// ロールはトークンではなくDBの値で判定する
+func (s *organizationMemberManager) findManageableUser(
+	ctx context.Context,
+	orgID shared.UUID[organization.Organization],
+	operatorID shared.UUID[user.User],
+	targetUserID shared.UUID[user.User],
+) (*organization.OrganizationUser, *organization.OrganizationUser, error) {
+	if operatorID == targetUserID {
+		return nil, nil, messages.OrganizationCannotModifySelf
+	}
+
+	operator, err := s.organizationUserRepo.FindEffectiveByOrganizationIDAndUserID(ctx, orgID, operatorID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, messages.OrganizationPermissionDenied
+		}
+		utils.HandleError(ctx, err, "OrganizationUserRepository.FindEffectiveByOrganizationIDAndUserID")
+		return nil, nil, messages.OrganizationInternalServerError
+	}
+
+	target, err := s.organizationUserRepo.FindByOrganizationIDAndUserID(ctx, orgID, targetUserID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, messages.OrganizationUserNotFound
+		}
+		utils.HandleError(ctx, err, "OrganizationUserRepository.FindByOrganizationIDAndUserID")
+		return nil, nil, messages.OrganizationInternalServerError
+	}
+
+	if !operator.CanManage(target) {
+		return nil, nil, messages.OrganizationPermissionDenied
+	}
+
+	return operator, target, nil
+}
+
+// ensureAnotherOwner 組織にオーナーが2人以上いることを確認する
+// 呼び出し元のトランザクションが終わるまでオーナーの行をロックし、同時に降格・削除しても最後のオーナーが残るようにする
+func (s *organizationMemberManager) ensureAnotherOwner(ctx context.Context, orgID shared.UUID[organization.Organization]) error {
+	owners, err := s.organizationUserRepo.FindOwnersForUpdate(ctx, orgID)
+	if err != nil {
+		utils.HandleError(ctx, err, "OrganizationUserRepository.FindOwnersForUpdate")
+		return messages.OrganizationInternalServerError
+	}
+	if len(owners) <= 1 {
+		return messages.OrganizationLastOwnerError
+	}
+
+	return nil
+}
+
 // IsSuperAdmin implements OrganizationService.
 func (s *organizationMemberManager) IsSuperAdmin(ctx context.Context, userID shared.UUID[user.User]) (bool, error) {
 	ctx, span := otel.Tracer("organization").Start(ctx, "organizationMemberManager.IsSuperAdmin")
@@ -178,7 +310,27 @@ func (s *organizationMemberManager) InviteUser(ctx context.Context, input Invite
 		}
 		pass := password_auth.GeneratePassword(16)
 		if existUser != nil {
-			// 登録済みなので、一旦OK
+			// 登録済みのユーザーは組織アカウントだけ作成する。既に所属している場合はエラー
+			member, err := s.organizationUserRepo.FindByOrganizationIDAndUserID(ctx, input.OrganizationID, existUser.UserID())
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				utils.HandleError(ctx, err, "OrganizationUserRepository.FindByOrganizationIDAndUserID")
+				return errtrace.Wrap(err)
+			}
+			if member != nil {
+				return messages.UserAlreadyInOrganization
+			}
+			orgUsr := organization.OrganizationUser{
+				OrganizationUserID: shared.NewUUID[organization.OrganizationUser](),
+				OrganizationID:     input.OrganizationID,
+				UserID:             existUser.UserID(),
+				Role:               input.Role,
+			}
+			if err := s.organizationUserRepo.Create(ctx, orgUsr); err != nil {
+				utils.HandleError(ctx, err, "OrganizationUserRepository.Create")
+				return errtrace.Wrap(err)
+			}
+			orgUser = &orgUsr
+
 			if err := s.passwordAuthManager.UpdatePassword(ctx, existUser.UserID(), pass); err != nil {
 				utils.HandleError(ctx, err, "PasswordAuthManager.RegisterPassword")
 				return errtrace.Wrap(err)
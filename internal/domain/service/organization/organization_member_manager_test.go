@@ -0,0 +1,372 @@
+package organization_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	password_auth "github.com/neko-dream/api/internal/domain/model/auth/password"
+	mock_organization_model "github.com/neko-dream/api/internal/domain/model/mock/organization"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	organization_service "github.com/neko-dream/api/internal/domain/service/organization"
+	"github.com/neko-dream/api/internal/infrastructure/config"
+	email_template "github.com/neko-dream/api/internal/infrastructure/email/template"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestOrganizationMemberManager_ChangeRole(t *testing.T) {
+	ctx := context.Background()
+	orgID := shared.NewUUID[organization.Organization]()
+	operatorID := shared.NewUUID[user.User]()
+	targetID := shared.NewUUID[user.User]()
+
+	orgUser := func(userID shared.UUID[user.User], role organization.OrganizationUserRole) *organization.OrganizationUser {
+		return organization.NewOrganizationUser(shared.NewUUID[organization.OrganizationUser](), orgID, userID, role)
+	}
+
+	tests := []struct {
+		name        string
+		targetID    shared.UUID[user.User]
+		role        organization.OrganizationUserRole
+		setupMocks  func(*mock_organization_model.MockOrganizationUserRepository)
+		expectError error
+	}{
+		{
+			name:     "オーナーは管理者をメンバーに変更できる",
+			targetID: targetID,
+			role:     organization.OrganizationUserRoleMember,
+			setupMocks: func(repo *mock_organization_model.MockOrganizationUserRepository) {
+				repo.EXPECT().FindEffectiveByOrganizationIDAndUserID(gomock.Any(), orgID, operatorID).Return(orgUser(operatorID, organization.OrganizationUserRoleOwner), nil)
+				repo.EXPECT().FindByOrganizationIDAndUserID(gomock.Any(), orgID, targetID).Return(orgUser(targetID, organization.OrganizationUserRoleAdmin), nil)
+				repo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, ou organization.OrganizationUser) error {
+					assert.Equal(t, organization.OrganizationUserRoleMember, ou.Role)
+					return nil
+				})
+			},
+		},
+		{
+			name:     "管理者は自分より上のロールに変更できない",
+			targetID: targetID,
+			role:     organization.OrganizationUserRoleOwner,
+			setupMocks: func(repo *mock_organization_model.MockOrganizationUserRepository) {
+				repo.EXPECT().FindEffectiveByOrganizationIDAndUserID(gomock.Any(), orgID, operatorID).Return(orgUser(operatorID, organization.OrganizationUserRoleAdmin), nil)
+				repo.EXPECT().FindByOrganizationIDAndUserID(gomock.Any(), orgID, targetID).Return(orgUser(targetID, organization.OrganizationUserRoleMember), nil)
+			},
+			expectError: messages.OrganizationPermissionDenied,
+		},
+		{
+			name:     "管理者はオーナーのロールを変更できない",
+			targetID: targetID,
+			role:     organization.OrganizationUserRoleMember,
+			setupMocks: func(repo *mock_organization_model.MockOrganizationUserRepository) {
+				repo.EXPECT().FindEffectiveByOrganizationIDAndUserID(gomock.Any(), orgID, operatorID).Return(orgUser(operatorID, organization.OrganizationUserRoleAdmin), nil)
+				repo.EXPECT().FindByOrganizationIDAndUserID(gomock.Any(), orgID, targetID).Return(orgUser(targetID, organization.OrganizationUserRoleOwner), nil)
+			},
+			expectError: messages.OrganizationPermissionDenied,
+		},
+		{
+			name:     "最後のオーナーは降格できない",
+			targetID: targetID,
+			role:     organization.OrganizationUserRoleAdmin,
+			setupMocks: func(repo *mock_organization_model.MockOrganizationUserRepository) {
+				repo.EXPECT().FindEffectiveByOrganizationIDAndUserID(gomock.Any(), orgID, operatorID).Return(orgUser(operatorID, organization.OrganizationUserRoleSuperAdmin), nil)
+				repo.EXPECT().FindByOrganizationIDAndUserID(gomock.Any(), orgID, targetID).Return(orgUser(targetID, organization.OrganizationUserRoleOwner), nil)
+				repo.EXPECT().FindOwnersForUpdate(gomock.Any(), orgID).Return([]*organization.OrganizationUser{
+					orgUser(targetID, organization.OrganizationUserRoleOwner),
+				}, nil)
+			},
+			expectError: messages.OrganizationLastOwnerError,
+		},
+		{
+			name:     "他にオーナーがいれば降格できる",
+			targetID: targetID,
+			role:     organization.OrganizationUserRoleAdmin,
+			setupMocks: func(repo *mock_organization_model.MockOrganizationUserRepository) {
+				repo.EXPECT().FindEffectiveByOrganizationIDAndUserID(gomock.Any(), orgID, operatorID).Return(orgUser(operatorID, organization.OrganizationUserRoleOwner), nil)
+				repo.EXPECT().FindByOrganizationIDAndUserID(gomock.Any(), orgID, targetID).Return(orgUser(targetID, organization.OrganizationUserRoleOwner), nil)
+				repo.EXPECT().FindOwnersForUpdate(gomock.Any(), orgID).Return([]*organization.OrganizationUser{
+					orgUser(operatorID, organization.OrganizationUserRoleOwner),
+					orgUser(targetID, organization.OrganizationUserRoleOwner),
+				}, nil)
+				repo.EXPECT().Update(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:        "自分自身のロールは変更できない",
+			targetID:    operatorID,
+			role:        organization.OrganizationUserRoleMember,
+			setupMocks:  func(repo *mock_organization_model.MockOrganizationUserRepository) {},
+			expectError: messages.OrganizationCannotModifySelf,
+		},
+		{
+			name:        "未定義のロールは指定できない",
+			targetID:    targetID,
+			role:        organization.OrganizationUserRole(25),
+			setupMocks:  func(repo *mock_organization_model.MockOrganizationUserRepository) {},
+			expectError: messages.OrganizationRoleInvalid,
+		},
+		{
+			name:     "組織に所属していないユーザーは変更できない",
+			targetID: targetID,
+			role:     organization.OrganizationUserRoleMember,
+			setupMocks: func(repo *mock_organization_model.MockOrganizationUserRepository) {
+				repo.EXPECT().FindEffectiveByOrganizationIDAndUserID(gomock.Any(), orgID, operatorID).Return(orgUser(operatorID, organization.OrganizationUserRoleOwner), nil)
+				repo.EXPECT().FindByOrganizationIDAndUserID(gomock.Any(), orgID, targetID).Return(nil, sql.ErrNoRows)
+			},
+			expectError: messages.OrganizationUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockOrgRepo := mock_organization_model.NewMockOrganizationRepository(ctrl)
+			mockOrgUserRepo := mock_organization_model.NewMockOrganizationUserRepository(ctrl)
+			tt.setupMocks(mockOrgUserRepo)
+
+			manager := organization_service.NewOrganizationMemberManager(mockOrgRepo, mockOrgUserRepo, nil, nil, nil, nil, nil, nil, nil)
+
+			result, err := manager.ChangeRole(ctx, organization_service.ChangeRoleParams{
+				OrganizationID: orgID,
+				OperatorID:     operatorID,
+				TargetUserID:   tt.targetID,
+				Role:           tt.role,
+			})
+
+			if tt.expectError != nil {
+				assert.Equal(t, tt.expectError, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.role, result.Role)
+			}
+		})
+	}
+}
+
+func TestOrganizationMemberManager_RemoveUser(t *testing.T) {
+	ctx := context.Background()
+	orgID := shared.NewUUID[organization.Organization]()
+	operatorID := shared.NewUUID[user.User]()
+	targetID := shared.NewUUID[user.User]()
+
+	orgUser := func(userID shared.UUID[user.User], role organization.OrganizationUserRole) *organization.OrganizationUser {
+		return organization.NewOrganizationUser(shared.NewUUID[organization.OrganizationUser](), orgID, userID, role)
+	}
+
+	tests := []struct {
+		name        string
+		setupMocks  func(*mock_organization_model.MockOrganizationUserRepository)
+		expectError error
+	}{
+		{
+			name: "管理者はメンバーを削除できる",
+			setupMocks: func(repo *mock_organization_model.MockOrganizationUserRepository) {
+				repo.EXPECT().FindEffectiveByOrganizationIDAndUserID(gomock.Any(), orgID, operatorID).Return(orgUser(operatorID, organization.OrganizationUserRoleAdmin), nil)
+				repo.EXPECT().FindByOrganizationIDAndUserID(gomock.Any(), orgID, targetID).Return(orgUser(targetID, organization.OrganizationUserRoleMember), nil)
+				repo.EXPECT().Delete(gomock.Any(), orgID, targetID).Return(nil)
+			},
+		},
+		{
+			name: "親組織の管理者は子組織のメンバーを削除できる",
+			setupMocks: func(repo *mock_organization_model.MockOrganizationUserRepository) {
+				operator := orgUser(operatorID, organization.OrganizationUserRoleAdmin)
+				operator.InheritedFrom = lo.ToPtr(shared.NewUUID[organization.Organization]())
+				repo.EXPECT().FindEffectiveByOrganizationIDAndUserID(gomock.Any(), orgID, operatorID).Return(operator, nil)
+				repo.EXPECT().FindByOrganizationIDAndUserID(gomock.Any(), orgID, targetID).Return(orgUser(targetID, organization.OrganizationUserRoleMember), nil)
+				repo.EXPECT().Delete(gomock.Any(), orgID, targetID).Return(nil)
+			},
+		},
+		{
+			name: "メンバーは他のメンバーを削除できない",
+			setupMocks: func(repo *mock_organization_model.MockOrganizationUserRepository) {
+				repo.EXPECT().FindEffectiveByOrganizationIDAndUserID(gomock.Any(), orgID, operatorID).Return(orgUser(operatorID, organization.OrganizationUserRoleMember), nil)
+				repo.EXPECT().FindByOrganizationIDAndUserID(gomock.Any(), orgID, targetID).Return(orgUser(targetID, organization.OrganizationUserRoleMember), nil)
+			},
+			expectError: messages.OrganizationPermissionDenied,
+		},
+		{
+			name: "最後のオーナーは削除できない",
+			setupMocks: func(repo *mock_organization_model.MockOrganizationUserRepository) {
+				repo.EXPECT().FindEffectiveByOrganizationIDAndUserID(gomock.Any(), orgID, operatorID).Return(orgUser(operatorID, organization.OrganizationUserRoleSuperAdmin), nil)
+				repo.EXPECT().FindByOrganizationIDAndUserID(gomock.Any(), orgID, targetID).Return(orgUser(targetID, organization.OrganizationUserRoleOwner), nil)
+				repo.EXPECT().FindOwnersForUpdate(gomock.Any(), orgID).Return([]*organization.OrganizationUser{
+					orgUser(targetID, organization.OrganizationUserRoleOwner),
+				}, nil)
+			},
+			expectError: messages.OrganizationLastOwnerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockOrgRepo := mock_organization_model.NewMockOrganizationRepository(ctrl)
+			mockOrgUserRepo := mock_organization_model.NewMockOrganizationUserRepository(ctrl)
+			tt.setupMocks(mockOrgUserRepo)
+
+			manager := organization_service.NewOrganizationMemberManager(mockOrgRepo, mockOrgUserRepo, nil, nil, nil, nil, nil, nil, nil)
+
+			err := manager.RemoveUser(ctx, organization_service.RemoveUserParams{
+				OrganizationID: orgID,
+				OperatorID:     operatorID,
+				TargetUserID:   targetID,
+			})
+
+			if tt.expectError != nil {
+				assert.Equal(t, tt.expectError, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// txOnlyDriver トランザクションの開始と終了だけを受け付けるドライバ。リポジトリはすべてモックにする
+type txOnlyDriver struct{}
+
+func (txOnlyDriver) Open(string) (driver.Conn, error) { return txOnlyConn{}, nil }
+
+type txOnlyConn struct{}
+
+func (txOnlyConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not supported") }
+func (txOnlyConn) Close() error                        { return nil }
+func (txOnlyConn) Begin() (driver.Tx, error)           { return txOnlyConn{}, nil }
+func (txOnlyConn) Commit() error                       { return nil }
+func (txOnlyConn) Rollback() error                     { return nil }
+
+func init() {
+	sql.Register("organization_test_tx_only", txOnlyDriver{})
+}
+
+type stubUserRepository struct {
+	user.UserRepository
+	existing *user.User
+}
+
+func (r *stubUserRepository) FindBySubject(context.Context, user.UserSubject) (*user.User, error) {
+	if r.existing == nil {
+		return nil, sql.ErrNoRows
+	}
+	return r.existing, nil
+}
+
+type stubPasswordAuthManager struct {
+	password_auth.PasswordAuthManager
+	updated []shared.UUID[user.User]
+}
+
+func (m *stubPasswordAuthManager) UpdatePassword(_ context.Context, userID shared.UUID[user.User], _ string) error {
+	m.updated = append(m.updated, userID)
+	return nil
+}
+
+type stubEmailSender struct {
+	sent []string
+}
+
+func (s *stubEmailSender) Send(_ context.Context, to string, _ email_template.EmailTemplateType, _ map[string]any) error {
+	s.sent = append(s.sent, to)
+	return nil
+}
+
+func TestOrganizationMemberManager_InviteUser_ExistingUser(t *testing.T) {
+	ctx := context.Background()
+	orgID := shared.NewUUID[organization.Organization]()
+	operatorID := shared.NewUUID[user.User]()
+	existing := user.NewUser(shared.NewUUID[user.User](), nil, nil, "subject", shared.ProviderPassword, nil)
+	org := organization.NewOrganization(orgID, organization.OrganizationTypeNormal, "org", "org-code", nil, operatorID)
+
+	sqlDB, err := sql.Open("organization_test_tx_only", "")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+
+	tests := []struct {
+		name        string
+		setupMocks  func(*mock_organization_model.MockOrganizationUserRepository)
+		expectError error
+	}{
+		{
+			name: "登録済みのユーザーを招待すると組織アカウントを作成して返す",
+			setupMocks: func(repo *mock_organization_model.MockOrganizationUserRepository) {
+				repo.EXPECT().FindByOrganizationIDAndUserID(gomock.Any(), orgID, existing.UserID()).Return(nil, sql.ErrNoRows)
+				repo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, orgUser organization.OrganizationUser) error {
+					assert.Equal(t, orgID, orgUser.OrganizationID)
+					assert.Equal(t, existing.UserID(), orgUser.UserID)
+					assert.Equal(t, organization.OrganizationUserRoleMember, orgUser.Role)
+					return nil
+				})
+			},
+		},
+		{
+			name: "既に所属しているユーザーは招待できない",
+			setupMocks: func(repo *mock_organization_model.MockOrganizationUserRepository) {
+				repo.EXPECT().FindByOrganizationIDAndUserID(gomock.Any(), orgID, existing.UserID()).
+					Return(organization.NewOrganizationUser(shared.NewUUID[organization.OrganizationUser](), orgID, existing.UserID(), organization.OrganizationUserRoleMember), nil)
+			},
+			expectError: messages.UserAlreadyInOrganization,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockOrgRepo := mock_organization_model.NewMockOrganizationRepository(ctrl)
+			mockOrgUserRepo := mock_organization_model.NewMockOrganizationUserRepository(ctrl)
+			mockOrgUserRepo.EXPECT().FindByUserID(gomock.Any(), operatorID).Return([]*organization.OrganizationUser{
+				organization.NewOrganizationUser(shared.NewUUID[organization.OrganizationUser](), orgID, operatorID, organization.OrganizationUserRoleSuperAdmin),
+			}, nil)
+			mockOrgRepo.EXPECT().FindByID(gomock.Any(), orgID).Return(org, nil)
+			tt.setupMocks(mockOrgUserRepo)
+
+			passwordManager := &stubPasswordAuthManager{}
+			emailSender := &stubEmailSender{}
+			manager := organization_service.NewOrganizationMemberManager(
+				mockOrgRepo,
+				mockOrgUserRepo,
+				&config.Config{HASH_PEPPER: "pepper"},
+				&stubUserRepository{existing: &existing},
+				nil,
+				nil,
+				passwordManager,
+				emailSender,
+				db.NewDBManager(sqlDB),
+			)
+
+			invited, err := manager.InviteUser(ctx, organization_service.InviteUserParams{
+				OrganizationID: orgID,
+				UserID:         operatorID,
+				Email:          "existing@example.com",
+				Role:           organization.OrganizationUserRoleMember,
+			})
+
+			if tt.expectError != nil {
+				assert.ErrorIs(t, err, tt.expectError)
+				assert.Nil(t, invited)
+				assert.Empty(t, emailSender.sent)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, invited)
+			assert.Equal(t, existing.UserID(), invited.UserID)
+			assert.Equal(t, organization.OrganizationUserRoleMember, invited.Role)
+			assert.Equal(t, []shared.UUID[user.User]{existing.UserID()}, passwordManager.updated)
+			assert.Equal(t, []string{"existing@example.com"}, emailSender.sent)
+		})
+	}
+}
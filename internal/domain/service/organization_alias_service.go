@@ -119,7 +119,7 @@ func (s *OrganizationAliasService) CanManageAlias(
 	defer span.End()
 
 	// ユーザーの組織内ロールを取得
-	orgUser, err := s.orgUserRepo.FindByOrganizationIDAndUserID(ctx, organizationID, userID)
+	orgUser, err := s.orgUserRepo.FindEffectiveByOrganizationIDAndUserID(ctx, organizationID, userID)
 	if err != nil {
 		return false, err
 	}
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"go.opentelemetry.io/otel"
+)
+
+type reportVersionService struct {
+	analysis.ReportVersionRepository
+}
+
+func NewReportVersionService(
+	reportVersionRepository analysis.ReportVersionRepository,
+) analysis.ReportVersionService {
+	return &reportVersionService{
+		ReportVersionRepository: reportVersionRepository,
+	}
+}
+
+// Record 生成されたレポートをバージョンとして残し、固定されていなければ公開するバージョンを最新にする
+func (s *reportVersionService) Record(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession], generator analysis.ReportGenerator) (*analysis.ReportVersion, error) {
+	ctx, span := otel.Tracer("service").Start(ctx, "reportVersionService.Record")
+	defer span.End()
+
+	now := clock.Now(ctx)
+	version, err := s.ReportVersionRepository.Capture(ctx, talkSessionID, generator, now)
+	if err != nil {
+		return nil, err
+	}
+	if version == nil {
+		return nil, nil
+	}
+
+	publication, err := s.ReportVersionRepository.FindPublication(ctx, talkSessionID)
+	if err != nil {
+		return nil, err
+	}
+	if publication == nil {
+		publication = analysis.NewReportPublication(version, now)
+	} else if !publication.Follow(version, now) {
+		// 管理者が固定したバージョンを公開し続ける
+		return version, nil
+	}
+
+	if err := s.ReportVersionRepository.SavePublication(ctx, publication); err != nil {
+		return nil, err
+	}
+	return version, nil
+}
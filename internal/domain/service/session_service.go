@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"braces.dev/errtrace"
+	"github.com/google/uuid"
 	"github.com/neko-dream/api/internal/domain/model/clock"
 	"github.com/neko-dream/api/internal/domain/model/organization"
 	"github.com/neko-dream/api/internal/domain/model/session"
@@ -15,7 +16,8 @@ import (
 )
 
 type sessionService struct {
-	sessionRepository session.SessionRepository
+	sessionRepository      session.SessionRepository
+	organizationRepository organization.OrganizationRepository
 }
 
 var (
@@ -40,6 +42,53 @@ func (s *sessionService) DeactivateUserSessions(ctx context.Context, userID shar
 	return nil
 }
 
+// DeactivateOrganizationSessions 指定した組織とその子孫の組織でログインしているユーザーのセッションを無効化する。
+// 子孫の組織のセッションも親組織から継承したロールを持つため、あわせて無効化する
+func (s *sessionService) DeactivateOrganizationSessions(
+	ctx context.Context,
+	userID shared.UUID[user.User],
+	organizationID shared.UUID[organization.Organization],
+) error {
+	ctx, span := otel.Tracer("service").Start(ctx, "sessionService.DeactivateOrganizationSessions")
+	defer span.End()
+
+	descendants, err := s.organizationRepository.FindDescendants(ctx, organizationID)
+	if err != nil {
+		utils.HandleError(ctx, err, "organizationRepository.FindDescendants")
+		return errtrace.Wrap(err)
+	}
+	targetOrgIDs := make(map[uuid.UUID]struct{}, len(descendants)+1)
+	targetOrgIDs[organizationID.UUID()] = struct{}{}
+	for _, descendant := range descendants {
+		targetOrgIDs[descendant.OrganizationID.UUID()] = struct{}{}
+	}
+
+	sessions, err := s.sessionRepository.FindByUserID(ctx, userID)
+	if err != nil {
+		utils.HandleError(ctx, err, "sessionRepository.FindByUserID")
+		return errtrace.Wrap(err)
+	}
+
+	for _, sess := range sessions {
+		if sess.OrganizationID() == nil {
+			continue
+		}
+		if _, ok := targetOrgIDs[sess.OrganizationID().UUID()]; !ok {
+			continue
+		}
+		if sess.Status() != session.SESSION_ACTIVE {
+			continue
+		}
+		sess.Deactivate(ctx)
+		if _, err := s.sessionRepository.Update(ctx, sess); err != nil {
+			utils.HandleError(ctx, err, "sessionRepository.Update")
+			return errtrace.Wrap(err)
+		}
+	}
+
+	return nil
+}
+
 // RefreshSession implements session.SessionService.
 func (s *sessionService) RefreshSession(
 	ctx context.Context,
@@ -134,8 +183,10 @@ func (s *sessionService) SwitchOrganization(
 
 func NewSessionService(
 	sessionRepository session.SessionRepository,
+	organizationRepository organization.OrganizationRepository,
 ) session.SessionService {
 	return &sessionService{
-		sessionRepository: sessionRepository,
+		sessionRepository:      sessionRepository,
+		organizationRepository: organizationRepository,
 	}
 }
@@ -62,6 +62,11 @@ func (t *talkSessionAccessControl) CanUserJoin(ctx context.Context, talkSessionI
 		return true, nil
 	}
 
+	// 下書きのセッションにはオーナー以外参加できない
+	if talkSession.IsDraft() {
+		return false, messages.TalkSessionIsDraft
+	}
+
 	// userの存在確認
 	var user *user.User
 	if userID != nil {
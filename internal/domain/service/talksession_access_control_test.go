@@ -219,4 +219,27 @@ func TestCanUserJoin(t *testing.T) {
 		mockTS.AssertExpectations(t)
 		mockUser.AssertExpectations(t)
 	})
+
+	t.Run("下書きのセッションにはオーナー以外参加できない", func(t *testing.T) {
+		// Arrange
+		mockTS := &mockTalkSessionRepository{}
+		mockUser := &mockUserRepository{}
+		mockTSConsent := &mockTalkSessionConsentService{}
+		talkSessionID := shared.NewUUID[talksession.TalkSession]()
+		userID := shared.NewUUID[user.User]()
+		svc := NewTalkSessionAccessControl(mockTS, mockUser, mockTSConsent)
+
+		ts := &talksession.TalkSession{}
+		ts.MarkAsDraft()
+		mockTS.On("FindByID", mock.Anything, talkSessionID).Return(ts, nil)
+
+		// Act
+		result, err := svc.CanUserJoin(ctx, talkSessionID, &userID)
+
+		// Assert
+		assert.Equal(t, messages.TalkSessionIsDraft, err)
+		assert.False(t, result)
+		mockTS.AssertExpectations(t)
+		mockUser.AssertNotCalled(t, "FindByID", mock.Anything, userID)
+	})
 }
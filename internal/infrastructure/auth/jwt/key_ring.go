@@ -0,0 +1,423 @@
+package jwt
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"braces.dev/errtrace"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/lestrrat-go/jwx/v3/jwk"
+	"github.com/neko-dream/api/internal/domain/model/auth/signing_key"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/infrastructure/config"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+var (
+	ErrUnsupportedKey = errors.New("対応していない鍵形式です")
+	ErrInvalidPEM     = errors.New("PEMの解析に失敗しました")
+)
+
+// 未知のkidを受け取った場合に、DBを再読込する最短間隔
+const minReloadInterval = 10 * time.Second
+
+// loadedKey 解析済みの署名鍵
+type loadedKey struct {
+	signing_key.SigningKey
+	privateKey crypto.Signer
+	method     jwt.SigningMethod
+}
+
+// KeyRing DBに保存された署名鍵をキャッシュし、定期的に再読込する。
+// 他のインスタンスでローテーションした鍵も再起動せずに反映される。
+type KeyRing struct {
+	repo            signing_key.SigningKeyRepository
+	dbManager       *db.DBManager
+	initialKeyPEM   string
+	gracePeriod     time.Duration
+	refreshInterval time.Duration
+
+	mu       sync.RWMutex
+	keys     map[string]*loadedKey
+	active   *loadedKey
+	loadedAt time.Time
+}
+
+func NewKeyRing(
+	repo signing_key.SigningKeyRepository,
+	dbManager *db.DBManager,
+	cfg *config.Config,
+) *KeyRing {
+	return &KeyRing{
+		repo:            repo,
+		dbManager:       dbManager,
+		initialKeyPEM:   cfg.TokenPrivateKey,
+		gracePeriod:     time.Duration(cfg.TokenKeyGracePeriod) * time.Hour,
+		refreshInterval: time.Duration(cfg.TokenKeyRefreshInterval) * time.Second,
+		keys:            map[string]*loadedKey{},
+	}
+}
+
+// NewSigningKeyRing KeyRingをドメインのインターフェースとして提供する
+func NewSigningKeyRing(keyRing *KeyRing) signing_key.KeyRing {
+	return keyRing
+}
+
+// Rotate implements signing_key.KeyRing.
+func (k *KeyRing) Rotate(ctx context.Context) (*signing_key.SigningKey, error) {
+	ctx, span := otel.Tracer("jwt").Start(ctx, "KeyRing.Rotate")
+	defer span.End()
+
+	var newKey *signing_key.SigningKey
+	if err := k.dbManager.ExecTx(ctx, func(ctx context.Context) error {
+		now := clock.Now(ctx)
+
+		current, err := k.repo.FindActiveForUpdate(ctx)
+		if err != nil {
+			return errtrace.Wrap(err)
+		}
+		if current != nil {
+			if err := current.Retire(now, k.gracePeriod); err != nil {
+				return errtrace.Wrap(err)
+			}
+			if err := k.repo.Retire(ctx, *current); err != nil {
+				return errtrace.Wrap(err)
+			}
+		}
+
+		newKey, err = generateSigningKey(now)
+		if err != nil {
+			return errtrace.Wrap(err)
+		}
+		return errtrace.Wrap(k.repo.Create(ctx, *newKey))
+	}); err != nil {
+		utils.HandleError(ctx, err, "KeyRing.Rotate")
+		return nil, err
+	}
+
+	if err := k.reload(ctx); err != nil {
+		return nil, err
+	}
+
+	return newKey, nil
+}
+
+// VerifiableKeys implements signing_key.KeyRing.
+func (k *KeyRing) VerifiableKeys(ctx context.Context) ([]signing_key.SigningKey, error) {
+	ctx, span := otel.Tracer("jwt").Start(ctx, "KeyRing.VerifiableKeys")
+	defer span.End()
+
+	if err := k.refreshIfStale(ctx); err != nil {
+		return nil, err
+	}
+
+	now := clock.Now(ctx)
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	keys := make([]signing_key.SigningKey, 0, len(k.keys))
+	for _, key := range k.keys {
+		if key.CanVerify(now) {
+			keys = append(keys, key.SigningKey)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].CreatedAt.After(keys[j].CreatedAt)
+	})
+	return keys, nil
+}
+
+// signingKey 署名に使用する鍵を返す。鍵が1つもなければ初期鍵を作成する
+func (k *KeyRing) signingKey(ctx context.Context) (*loadedKey, error) {
+	ctx, span := otel.Tracer("jwt").Start(ctx, "KeyRing.signingKey")
+	defer span.End()
+
+	if err := k.refreshIfStale(ctx); err != nil {
+		return nil, err
+	}
+
+	k.mu.RLock()
+	active := k.active
+	k.mu.RUnlock()
+	if active != nil {
+		return active, nil
+	}
+
+	if err := k.createInitialKey(ctx); err != nil {
+		return nil, err
+	}
+	if err := k.reload(ctx); err != nil {
+		return nil, err
+	}
+
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+	if k.active == nil {
+		return nil, errtrace.Wrap(signing_key.ErrKeyNotFound)
+	}
+	return k.active, nil
+}
+
+// verificationKey kidに対応する検証用の鍵を返す
+func (k *KeyRing) verificationKey(ctx context.Context, kid string) (*loadedKey, error) {
+	ctx, span := otel.Tracer("jwt").Start(ctx, "KeyRing.verificationKey")
+	defer span.End()
+
+	if err := k.refreshIfStale(ctx); err != nil {
+		return nil, err
+	}
+
+	k.mu.RLock()
+	key, ok := k.keys[kid]
+	loadedAt := k.loadedAt
+	k.mu.RUnlock()
+
+	// 他のインスタンスでローテーションされた直後の可能性があるため再読込する
+	if !ok && clock.Now(ctx).Sub(loadedAt) > minReloadInterval {
+		if err := k.reload(ctx); err != nil {
+			return nil, err
+		}
+		k.mu.RLock()
+		key, ok = k.keys[kid]
+		k.mu.RUnlock()
+	}
+	if !ok || !key.CanVerify(clock.Now(ctx)) {
+		return nil, errtrace.Wrap(signing_key.ErrKeyNotFound)
+	}
+
+	return key, nil
+}
+
+func (k *KeyRing) refreshIfStale(ctx context.Context) error {
+	k.mu.RLock()
+	stale := k.loadedAt.IsZero() || clock.Now(ctx).Sub(k.loadedAt) > k.refreshInterval
+	k.mu.RUnlock()
+
+	if !stale {
+		return nil
+	}
+	return k.reload(ctx)
+}
+
+func (k *KeyRing) reload(ctx context.Context) error {
+	ctx, span := otel.Tracer("jwt").Start(ctx, "KeyRing.reload")
+	defer span.End()
+
+	now := clock.Now(ctx)
+	rows, err := k.repo.FindVerifiable(ctx, now)
+	if err != nil {
+		utils.HandleError(ctx, err, "SigningKeyRepository.FindVerifiable")
+		return errtrace.Wrap(err)
+	}
+
+	keys := make(map[string]*loadedKey, len(rows))
+	var active *loadedKey
+	for _, row := range rows {
+		key, err := parseSigningKey(row)
+		if err != nil {
+			// 壊れた鍵があっても他の鍵での検証は継続する
+			utils.HandleError(ctx, err, "parseSigningKey")
+			continue
+		}
+		keys[row.KeyID] = key
+		if key.CanSign() {
+			active = key
+		}
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.keys = keys
+	k.active = active
+	k.loadedAt = now
+
+	return nil
+}
+
+// createInitialKey TOKEN_PRIVATEが設定されていればそれを、なければ新しい鍵を初期鍵として登録する
+func (k *KeyRing) createInitialKey(ctx context.Context) error {
+	ctx, span := otel.Tracer("jwt").Start(ctx, "KeyRing.createInitialKey")
+	defer span.End()
+
+	now := clock.Now(ctx)
+	var (
+		key *signing_key.SigningKey
+		err error
+	)
+	if k.initialKeyPEM != "" {
+		key, err = importSigningKey(k.initialKeyPEM, now)
+	} else {
+		key, err = generateSigningKey(now)
+	}
+	if err != nil {
+		utils.HandleError(ctx, err, "createInitialKey")
+		return errtrace.Wrap(err)
+	}
+
+	if err := k.repo.Create(ctx, *key); err != nil {
+		// 他のインスタンスが同時に初期鍵を作成した場合は、そちらを使用する
+		active, findErr := k.repo.FindVerifiable(ctx, now)
+		if findErr == nil && len(active) > 0 {
+			return nil
+		}
+		return errtrace.Wrap(err)
+	}
+
+	return nil
+}
+
+// PublicJWK 署名鍵の公開鍵をJWKに変換する
+func PublicJWK(key signing_key.SigningKey) (jwk.Key, error) {
+	publicKey, err := parsePublicKeyPEM(key.PublicKeyPEM)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	jwkKey, err := jwk.Import(publicKey)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	if err := jwkKey.Set(jwk.KeyIDKey, key.KeyID); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	if err := jwkKey.Set(jwk.AlgorithmKey, string(key.Algorithm)); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	if err := jwkKey.Set(jwk.KeyUsageKey, "sig"); err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	return jwkKey, nil
+}
+
+func generateSigningKey(now time.Time) (*signing_key.SigningKey, error) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return newSigningKeyFromPrivate(privateKey, now)
+}
+
+func importSigningKey(privateKeyPEM string, now time.Time) (*signing_key.SigningKey, error) {
+	// 環境変数では改行がエスケープされていることがある
+	privateKey, err := parsePrivateKeyPEM(strings.ReplaceAll(privateKeyPEM, `\n`, "\n"))
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return newSigningKeyFromPrivate(privateKey, now)
+}
+
+func newSigningKeyFromPrivate(privateKey crypto.Signer, now time.Time) (*signing_key.SigningKey, error) {
+	algorithm, err := algorithmOf(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	privateDER, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	publicDER, err := x509.MarshalPKIXPublicKey(privateKey.Public())
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	// kidはRFC 7638のJWKサムプリントを使用する
+	publicJWK, err := jwk.Import(privateKey.Public())
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	thumbprint, err := publicJWK.Thumbprint(crypto.SHA256)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	return signing_key.NewSigningKey(
+		base64.RawURLEncoding.EncodeToString(thumbprint),
+		algorithm,
+		string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDER})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER})),
+		now,
+	), nil
+}
+
+func parseSigningKey(key signing_key.SigningKey) (*loadedKey, error) {
+	privateKey, err := parsePrivateKeyPEM(key.PrivateKeyPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	var method jwt.SigningMethod
+	switch key.Algorithm {
+	case signing_key.AlgorithmES256:
+		method = jwt.SigningMethodES256
+	case signing_key.AlgorithmRS256:
+		method = jwt.SigningMethodRS256
+	default:
+		return nil, errtrace.Wrap(ErrUnsupportedKey)
+	}
+
+	return &loadedKey{
+		SigningKey: key,
+		privateKey: privateKey,
+		method:     method,
+	}, nil
+}
+
+func parsePrivateKeyPEM(privateKeyPEM string) (crypto.Signer, error) {
+	block, _ := pem.Decode([]byte(privateKeyPEM))
+	if block == nil {
+		return nil, errtrace.Wrap(ErrInvalidPEM)
+	}
+
+	if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		if signer, ok := key.(crypto.Signer); ok {
+			return signer, nil
+		}
+		return nil, errtrace.Wrap(ErrUnsupportedKey)
+	}
+	if key, err := x509.ParseECPrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	return nil, errtrace.Wrap(ErrInvalidPEM)
+}
+
+func parsePublicKeyPEM(publicKeyPEM string) (crypto.PublicKey, error) {
+	block, _ := pem.Decode([]byte(publicKeyPEM))
+	if block == nil {
+		return nil, errtrace.Wrap(ErrInvalidPEM)
+	}
+	return errtrace.Wrap2(x509.ParsePKIXPublicKey(block.Bytes))
+}
+
+func algorithmOf(privateKey crypto.Signer) (signing_key.Algorithm, error) {
+	switch key := privateKey.(type) {
+	case *ecdsa.PrivateKey:
+		if key.Curve != elliptic.P256() {
+			return "", errtrace.Wrap(ErrUnsupportedKey)
+		}
+		return signing_key.AlgorithmES256, nil
+	case *rsa.PrivateKey:
+		return signing_key.AlgorithmRS256, nil
+	default:
+		return "", errtrace.Wrap(ErrUnsupportedKey)
+	}
+}
@@ -21,7 +21,9 @@ import (
 )
 
 type tokenManager struct {
-	secret string
+	// kidを持たない旧形式(HS256)のトークンの検証、およびkeyRing未設定時の署名に使用する
+	secret  string
+	keyRing *KeyRing
 	*db.DBManager
 	session.SessionRepository
 	organization.OrganizationUserRepository
@@ -106,7 +108,7 @@ func (j *tokenManager) Generate(ctx context.Context, user user.User, sessionID s
 			organizationID = lo.ToPtr(org.OrganizationID.String())
 			organizationCode = lo.ToPtr(org.Code)
 			// 組織でのユーザーのロールを取得
-			orgUser, err := j.OrganizationUserRepository.FindByOrganizationIDAndUserID(ctx, orgID, user.UserID())
+			orgUser, err := j.OrganizationUserRepository.FindEffectiveByOrganizationIDAndUserID(ctx, orgID, user.UserID())
 			if err == nil && orgUser != nil {
 				organizationRole = lo.ToPtr(organization.RoleToName(orgUser.Role))
 			}
@@ -114,8 +116,19 @@ func (j *tokenManager) Generate(ctx context.Context, user user.User, sessionID s
 	}
 
 	claim := session.NewClaimWithOrganization(ctx, user, sessionID, requiredPasswordChange, orgType, organizationID, organizationCode, organizationRole)
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claim.GenMapClaim())
-	return errtrace.Wrap2(token.SignedString([]byte(j.secret)))
+	if j.keyRing == nil {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, claim.GenMapClaim())
+		return errtrace.Wrap2(token.SignedString([]byte(j.secret)))
+	}
+
+	key, err := j.keyRing.signingKey(ctx)
+	if err != nil {
+		utils.HandleError(ctx, err, "KeyRing.signingKey")
+		return "", errtrace.Wrap(err)
+	}
+	token := jwt.NewWithClaims(key.method, claim.GenMapClaim())
+	token.Header["kid"] = key.KeyID
+	return errtrace.Wrap2(token.SignedString(key.privateKey))
 }
 
 func (j *tokenManager) Parse(ctx context.Context, token string) (*session.Claim, error) {
@@ -123,6 +136,20 @@ func (j *tokenManager) Parse(ctx context.Context, token string) (*session.Claim,
 	defer span.End()
 
 	parsedToken, err := jwt.Parse(token, func(token *jwt.Token) (any, error) {
+		// kidがあれば対応する署名鍵で検証する
+		if kid, ok := token.Header["kid"].(string); ok && j.keyRing != nil {
+			key, err := j.keyRing.verificationKey(ctx, kid)
+			if err != nil {
+				return nil, errtrace.Wrap(err)
+			}
+			// アルゴリズムの確認
+			if token.Method.Alg() != key.method.Alg() {
+				utils.HandleError(ctx, jwt.ErrInvalidKeyType, "InvalidKeyType")
+				return nil, errtrace.Wrap(jwt.ErrInvalidKeyType)
+			}
+			return key.privateKey.Public(), nil
+		}
+
 		// アルゴリズムの確認
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			utils.HandleError(ctx, jwt.ErrInvalidKeyType, "InvalidKeyType")
@@ -155,9 +182,11 @@ func NewTokenManager(
 	dbm *db.DBManager,
 	orgUserRep organization.OrganizationUserRepository,
 	orgRep organization.OrganizationRepository,
+	keyRing *KeyRing,
 ) session.TokenManager {
 	return &tokenManager{
 		secret:                     conf.TokenSecret,
+		keyRing:                    keyRing,
 		SessionRepository:          sessRepo,
 		DBManager:                  dbm,
 		OrganizationUserRepository: orgUserRep,
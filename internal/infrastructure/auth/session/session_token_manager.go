@@ -159,7 +159,7 @@ func (s *sessionTokenManager) Parse(ctx context.Context, token string) (*session
 			orgType = lo.ToPtr(int(org.OrganizationType))
 
 			// 組織でのロールを取得
-			orgUser, err := s.OrganizationUserRepository.FindByOrganizationIDAndUserID(ctx, org.OrganizationID, sess.UserID())
+			orgUser, err := s.OrganizationUserRepository.FindEffectiveByOrganizationIDAndUserID(ctx, org.OrganizationID, sess.UserID())
 			if err == nil && orgUser != nil {
 				organizationRole = lo.ToPtr(organization.RoleToName(orgUser.Role))
 			}
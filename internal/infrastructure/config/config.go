@@ -22,8 +22,13 @@ type Config struct {
 
 	TokenSecret string `env:"TOKEN_SECRET"`
 
+	// 署名鍵が未登録の場合に初期鍵として取り込むPEM形式の秘密鍵
 	TokenPrivateKey string `env:"TOKEN_PRIVATE"`
 	TokenPublicKey  string `env:"TOKEN_PUBLIC"`
+	// ローテーション後に古い署名鍵で検証を許可する期間
+	TokenKeyGracePeriod int `env:"TOKEN_KEY_GRACE_PERIOD" envDefault:"336"` // 時間
+	// 署名鍵をDBから再読込する間隔
+	TokenKeyRefreshInterval int `env:"TOKEN_KEY_REFRESH_INTERVAL" envDefault:"60"` // 秒
 
 	R2_REGION            string `env:"R2_REGION"`
 	R2_ACCESS_KEY_ID     string `env:"R2_ACCESS_KEY_ID"`
@@ -39,6 +44,19 @@ type Config struct {
 	ANALYSIS_USER_PASSWORD string `env:"ANALYSIS_USER_PASSWORD"`
 	ANALYSIS_API_DOMAIN    string `env:"ANALYSIS_API_DOMAIN"`
 
+	// レポートの生成方法 (analysis-api: 分析API, llm: プロンプトを組み立ててOpenAI互換のAPIで生成)
+	ReportGenerator string `env:"REPORT_GENERATOR" envDefault:"analysis-api"`
+	// OpenAI互換のAPIの設定。/chat/completionsを呼び出す
+	LLMBaseURL string `env:"LLM_BASE_URL" envDefault:"https://api.openai.com/v1"`
+	LLMAPIKey  string `env:"LLM_API_KEY"`
+	LLMModel   string `env:"LLM_MODEL" envDefault:"gpt-4o-mini"`
+	LLMTimeout int    `env:"LLM_TIMEOUT" envDefault:"120"` // 秒
+
+	// ワードクラウドの生成方法 (analysis-api: 分析API, local: アプリケーション内で形態素解析して描画)
+	WordCloudGenerator string `env:"WORDCLOUD_GENERATOR" envDefault:"analysis-api"`
+	// ワードクラウドに使う日本語を含むTrueType/OpenTypeフォント。空の場合は同梱のビットマップフォントを使う
+	WordCloudFontPath string `env:"WORDCLOUD_FONT_PATH"`
+
 	SENTRY_DSN       string `env:"SENTRY_DSN"`
 	BASELIME_API_KEY string `env:"BASELIME_API_KEY"`
 
@@ -64,6 +82,26 @@ type Config struct {
 	HASH_PEPPER     string `env:"HASH_PEPPER"`
 	HASH_ITERATIONS int    `env:"HASH_ITERATIONS"`
 
+	// パスワードログインのロックアウト設定
+	LoginMaxFailuresPerAccount int `env:"LOGIN_MAX_FAILURES_PER_ACCOUNT" envDefault:"5"`
+	LoginMaxFailuresPerIP      int `env:"LOGIN_MAX_FAILURES_PER_IP" envDefault:"30"`
+	LoginFailureWindow         int `env:"LOGIN_FAILURE_WINDOW" envDefault:"900"`         // 秒
+	LoginLockoutBaseDuration   int `env:"LOGIN_LOCKOUT_BASE_DURATION" envDefault:"60"`   // 秒
+	LoginLockoutMaxDuration    int `env:"LOGIN_LOCKOUT_MAX_DURATION" envDefault:"86400"` // 秒
+
+	// 投稿者による意見の編集設定
+	OpinionEditGracePeriod int `env:"OPINION_EDIT_GRACE_PERIOD" envDefault:"30"` // 分
+	OpinionEditMaxVotes    int `env:"OPINION_EDIT_MAX_VOTES" envDefault:"5"`     // 投稿者以外の投票数
+
+	// 投票・意見に応じた分析の再計算の設定
+	AnalysisRecomputeVoteThreshold int `env:"ANALYSIS_RECOMPUTE_VOTE_THRESHOLD" envDefault:"10"` // 最後の分析以降の投票数
+	AnalysisRecomputeMaxDelay      int `env:"ANALYSIS_RECOMPUTE_MAX_DELAY" envDefault:"300"`     // 秒
+	AnalysisRecomputeRunTimeout    int `env:"ANALYSIS_RECOMPUTE_RUN_TIMEOUT" envDefault:"600"`   // 秒
+
+	// 信頼するリバースプロキシのIPアドレスまたはCIDR (カンマ区切り)
+	// 接続元がこれに含まれる場合のみX-Forwarded-Forからクライアントのアドレスを取得する
+	TrustedProxies []string `env:"TRUSTED_PROXIES" envSeparator:","`
+
 	// HTTPサーバー設定
 	HTTPReadTimeout  int `env:"HTTP_READ_TIMEOUT" envDefault:"15"`  // 秒
 	HTTPWriteTimeout int `env:"HTTP_WRITE_TIMEOUT" envDefault:"15"` // 秒
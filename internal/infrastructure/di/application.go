@@ -7,6 +7,7 @@ import (
 	talksession_query "github.com/neko-dream/api/internal/infrastructure/persistence/query/talksession"
 	user_query "github.com/neko-dream/api/internal/infrastructure/persistence/query/user"
 
+	"github.com/neko-dream/api/internal/application/analysis_scheduler"
 	"github.com/neko-dream/api/internal/application/event_processor"
 	"github.com/neko-dream/api/internal/application/event_processor/handlers"
 	opinion_q "github.com/neko-dream/api/internal/application/query/opinion"
@@ -18,8 +19,10 @@ import (
 	"github.com/neko-dream/api/internal/application/usecase/analysis_usecase"
 	"github.com/neko-dream/api/internal/application/usecase/auth_usecase"
 	"github.com/neko-dream/api/internal/application/usecase/image_usecase"
+	"github.com/neko-dream/api/internal/application/usecase/manage_usecase"
 	"github.com/neko-dream/api/internal/application/usecase/opinion_usecase"
 	"github.com/neko-dream/api/internal/application/usecase/organization_usecase"
+	"github.com/neko-dream/api/internal/application/usecase/ownership_usecase"
 	"github.com/neko-dream/api/internal/application/usecase/policy_usecase"
 	"github.com/neko-dream/api/internal/application/usecase/report_usecase"
 	"github.com/neko-dream/api/internal/application/usecase/talksession_usecase"
@@ -37,21 +40,34 @@ func useCaseDeps() []ProvideArg {
 		{talksession_usecase.NewStartTalkSessionUseCase, nil},
 		{talksession_usecase.NewTakeConsentUseCase, nil},
 		{talksession_usecase.NewEditTalkSessionUseCase, nil},
+		{talksession_usecase.NewSaveTalkSessionTemplateUseCase, nil},
+		{talksession_usecase.NewCloneTalkSessionUseCase, nil},
+		{talksession_usecase.NewPublishTalkSessionUseCase, nil},
+		{talksession_usecase.NewDeleteTalkSessionTemplateUseCase, nil},
+		{talksession_usecase.NewSetTalkSessionCollaboratorUseCase, nil},
+		{talksession_usecase.NewRemoveTalkSessionCollaboratorUseCase, nil},
+		{manage_usecase.NewToggleReportVisibilityInteractor, nil},
+		{manage_usecase.NewPublishReportVersionInteractor, nil},
 		{talksession_query.NewBrowseTalkSessionQueryHandler, nil},
 		{talksession_query.NewBrowseOpenedByUserQueryHandler, nil},
+		{talksession_query.NewBrowseOrganizationTalkSessionsQueryHandler, nil},
 		{talksession_query.NewBrowseJoinedTalkSessionQueryHandler, nil},
 		{talksession_query.NewGetTalkSessionDetailByIDQueryHandler, nil},
 		{talksession_query.NewGetConclusionByIDQueryHandler, nil},
 		{talksession_query.NewGetRestrictionsQuery, nil},
 		{talksession_query.NewHasConsentQuery, nil},
+		{talksession_query.NewGetCollaboratorsQuery, nil},
 		{talksession.NewIsTalkSessionSatisfiedInteractor, nil},
 		{opinion_usecase.NewSubmitOpinionHandler, nil},
 		{opinion_usecase.NewReportOpinion, nil},
+		{opinion_usecase.NewEditOpinionHandler, nil},
+		{opinion_usecase.NewDeleteOpinionHandler, nil},
 		{opinion_query.NewGetOpinionsByTalkSessionIDQueryHandler, nil},
 		{opinion_query.NewGetOpinionDetailByIDQueryHandler, nil},
 		{opinion_query.NewGetOpinionRepliesQueryHandler, nil},
 		{opinion_query.NewSwipeOpinionsQueryHandler, nil},
 		{opinion_query.NewGetMyOpinionsQueryHandler, nil},
+		{opinion_query.NewGetOpinionRevisionsQueryHandler, nil},
 		{opinion_query.NewGetOpinionGroupRatioInteractor, nil},
 		{opinion_q.NewGetReportReasons, nil},
 		{user_usecase.NewEditHandler, nil},
@@ -60,6 +76,7 @@ func useCaseDeps() []ProvideArg {
 		{user_query.NewDetailHandler, nil},
 		{user_query.NewGetByDisplayIDHandler, nil},
 		{vote_usecase.NewVoteHandler, nil},
+		{vote_usecase.NewBatchVoteHandler, nil},
 		{auth_usecase.NewAuthLogin, nil},
 		{auth_usecase.NewRevoke, nil},
 		{auth_usecase.NewAuthCallback, nil},
@@ -74,6 +91,19 @@ func useCaseDeps() []ProvideArg {
 		{timeline_query.NewGetTimeLine, nil},
 		{analysis_query.NewGetAnalysisResultHandler, nil},
 		{analysis_query.NewGetReportQueryHandler, nil},
+		{analysis_query.NewGetVoteShiftsQuery, nil},
+		{analysis_query.NewGetAnalysisSnapshotsQuery, nil},
+		{analysis_query.NewGetAnalysisSnapshotQuery, nil},
+		{analysis_query.NewGetAnalysisSnapshotDiffQuery, nil},
+		{analysis_query.NewGetConsensusQuery, nil},
+		{analysis_query.NewGetTermFrequenciesQuery, nil},
+		{analysis_query.NewGetTopicsQuery, nil},
+		{analysis_query.NewGetMyPositionQuery, nil},
+		{analysis_query.NewGetRepresentativenessQuery, nil},
+		{analysis_query.NewGetAnalysisStatusQuery, nil},
+		{analysis_query.NewGetAnalysisJobQuery, nil},
+		{analysis_query.NewGetReportVersionsQuery, nil},
+		{analysis_query.NewGetReportVersionDiffQuery, nil},
 		{report_query.NewGetByTalkSessionQueryInteractor, nil},
 		{report_query.NewGetOpinionReportQueryInteractor, nil},
 		{report_usecase.NewSolveReportCommandInteractor, nil},
@@ -89,10 +119,39 @@ func useCaseDeps() []ProvideArg {
 		{organization_usecase.NewListOrganizationAliasesUseCase, nil},
 		{organization_usecase.NewSwitchOrganizationUseCase, nil},
 		{organization_usecase.NewUpdateOrganizationInteractor, nil},
+		{organization_usecase.NewChangeParentOrganizationInteractor, nil},
 		{organization_query.NewListOrganizationUsersQuery, nil},
+		{organization_usecase.NewIssueOrganizationAPIKeyInteractor, nil},
+		{organization_usecase.NewRevokeOrganizationAPIKeyInteractor, nil},
+		{organization_usecase.NewUpdateReportPromptInteractor, nil},
+		{organization_usecase.NewUpdateReferenceDistributionInteractor, nil},
+		{organization_query.NewListOrganizationAPIKeysQuery, nil},
+		{organization_usecase.NewChangeOrganizationUserRoleInteractor, nil},
+		{organization_usecase.NewRemoveOrganizationUserInteractor, nil},
+		{organization_usecase.NewCreateOrganizationInvitationInteractor, nil},
+		{organization_usecase.NewBulkCreateOrganizationInvitationsInteractor, nil},
+		{organization_usecase.NewResendOrganizationInvitationInteractor, nil},
+		{organization_usecase.NewRevokeOrganizationInvitationInteractor, nil},
+		{organization_usecase.NewAcceptOrganizationInvitationInteractor, nil},
+		{organization_query.NewListOrganizationInvitationsQuery, nil},
+		{organization_query.NewListOrganizationAuditLogsQuery, nil},
+		{organization_query.NewExportOrganizationAuditLogsQuery, nil},
+		{organization_query.NewListTalkSessionTemplatesQuery, nil},
+		{ownership_usecase.NewRequestTalkSessionTransferInteractor, nil},
+		{ownership_usecase.NewRequestOrganizationTransferInteractor, nil},
+		{ownership_usecase.NewBulkTransferTalkSessionsInteractor, nil},
+		{ownership_usecase.NewAcceptTransferInteractor, nil},
+		{ownership_usecase.NewDeclineTransferInteractor, nil},
+		{ownership_usecase.NewCancelTransferInteractor, nil},
 		{analysis_usecase.NewApplyFeedbackInteractor, nil},
 		{event_processor.NewEventHandlerRegistry, nil},
 		{handlers.NewTalkSessionPushNotificationHandler, nil},
+		{handlers.NewOwnershipTransferPushNotificationHandler, nil},
+		{handlers.NewAnalysisActivityHandler, nil},
+		{handlers.NewAnalysisReportPushNotificationHandler, nil},
 		{SetupEventProcessor, nil},
+		{analysis_scheduler.NewRecomputePolicy, nil},
+		{analysis_scheduler.NewAnalysisScheduler, nil},
+		{analysis_scheduler.NewAnalysisJobWorker, nil},
 	}
 }
@@ -1,6 +1,7 @@
 package di
 
 import (
+	"github.com/neko-dream/api/internal/domain/model/talksession/talksession_collaborator"
 	"github.com/neko-dream/api/internal/domain/model/talksession/talksession_consent"
 	"github.com/neko-dream/api/internal/domain/service"
 	organization_svc "github.com/neko-dream/api/internal/domain/service/organization"
@@ -17,14 +18,21 @@ func domainDeps() []ProvideArg {
 		{service.NewUserService, nil},
 		{service.NewOpinionService, nil},
 		{service.NewActionItemService, nil},
+		{service.NewAnalysisJobService, nil},
+		{service.NewReportVersionService, nil},
 		{service.NewStateGenerator, nil},
 		{service.NewProfileIconService, nil},
 		{service.NewTalkSessionAccessControl, nil},
 		{service.NewConsentService, nil},
 		{service.NewPasswordAuthManager, nil},
+		{service.NewLoginThrottle, nil},
+		{service.NewAPIKeyAuthenticator, nil},
 		{organization_svc.NewOrganizationService, nil},
 		{organization_svc.NewOrganizationMemberManager, nil},
+		{organization_svc.NewOrganizationHierarchyService, nil},
+		{organization_svc.NewOrganizationInvitationService, nil},
 		{talksession_consent.NewTalkSessionConsentService, nil},
+		{talksession_collaborator.NewTalkSessionPermissionService, nil},
 		{service.NewOrganizationAliasService, nil},
 	}
 }
@@ -3,18 +3,31 @@ package di
 import (
 	"github.com/neko-dream/api/internal/application/event_processor"
 	"github.com/neko-dream/api/internal/application/event_processor/handlers"
+	"github.com/neko-dream/api/internal/domain/model/analysis"
 	"github.com/neko-dream/api/internal/domain/model/event"
+	"github.com/neko-dream/api/internal/domain/model/opinion"
+	"github.com/neko-dream/api/internal/domain/model/ownership"
 	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/vote"
 )
 
 func SetupEventProcessor(
 	eventStore event.EventStore,
 	registry *event_processor.EventHandlerRegistry,
 	pushHandler *handlers.TalkSessionPushNotificationHandler,
+	ownershipTransferHandler *handlers.OwnershipTransferPushNotificationHandler,
+	analysisActivityHandler *handlers.AnalysisActivityHandler,
+	analysisReportHandler *handlers.AnalysisReportPushNotificationHandler,
 ) *event_processor.EventProcessor {
 
 	registry.Register(talksession.EventTypeTalkSessionStarted, pushHandler)
 	registry.Register(talksession.EventTypeTalkSessionEnded, pushHandler)
+	registry.Register(ownership.EventTypeOwnershipTransferRequested, ownershipTransferHandler)
+	registry.Register(ownership.EventTypeOwnershipTransferAccepted, ownershipTransferHandler)
+	registry.Register(ownership.EventTypeOwnershipTransferDeclined, ownershipTransferHandler)
+	registry.Register(vote.EventTypeVoteChanged, analysisActivityHandler)
+	registry.Register(opinion.EventTypeOpinionSubmitted, analysisActivityHandler)
+	registry.Register(analysis.EventTypeAnalysisReportGenerated, analysisReportHandler)
 
 	return event_processor.NewEventProcessor(eventStore, registry)
 }
@@ -1,19 +1,21 @@
 package di
 
 import (
+	"github.com/neko-dream/api/internal/infrastructure/auth/jwt"
 	"github.com/neko-dream/api/internal/infrastructure/auth/oauth"
 	"github.com/neko-dream/api/internal/infrastructure/auth/session"
 	"github.com/neko-dream/api/internal/infrastructure/config"
 	"github.com/neko-dream/api/internal/infrastructure/crypto"
-	client "github.com/neko-dream/api/internal/infrastructure/external/analysis"
 	"github.com/neko-dream/api/internal/infrastructure/external/aws"
 	"github.com/neko-dream/api/internal/infrastructure/external/aws/pinpoint"
 	"github.com/neko-dream/api/internal/infrastructure/external/aws/ses"
+	"github.com/neko-dream/api/internal/infrastructure/external/wordcloud"
 	"github.com/neko-dream/api/internal/infrastructure/http/cookie"
 	"github.com/neko-dream/api/internal/infrastructure/persistence"
 	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
 	"github.com/neko-dream/api/internal/infrastructure/persistence/postgresql"
 	"github.com/neko-dream/api/internal/infrastructure/persistence/query/organization"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/query/ownership"
 	"github.com/neko-dream/api/internal/infrastructure/persistence/repository"
 	"github.com/neko-dream/api/internal/infrastructure/telemetry"
 )
@@ -29,6 +31,8 @@ func infraDeps() []ProvideArg {
 		{db.NewDBManager, nil},
 		{oauth.NewProviderFactory, nil},
 		{session.NewSessionTokenManager, nil},
+		{jwt.NewKeyRing, nil},
+		{jwt.NewSigningKeyRing, nil},
 		// {telemetry.SentryProvider, nil},
 		{telemetry.BaselimeProvider, nil},
 		{repository.InitS3Client, nil},
@@ -39,20 +43,40 @@ func infraDeps() []ProvideArg {
 		{repository.NewTalkSessionRepository, nil},
 		{repository.NewOpinionRepository, nil},
 		{repository.NewVoteRepository, nil},
+		{repository.NewVoteChangeRepository, nil},
+		{repository.NewVoteReceiptRepository, nil},
 		{repository.NewConclusionRepository, nil},
 		{repository.NewActionItemRepository, nil},
 		{repository.NewPolicyRepository, nil},
 		{repository.NewConsentRecordRepository, nil},
 		{repository.NewReportRepository, nil},
+		{repository.NewOpinionRevisionRepository, nil},
 		{repository.NewPasswordAuthRepository, nil},
+		{repository.NewLoginAttemptRepository, nil},
+		{repository.NewLoginLockoutRepository, nil},
+		{repository.NewSigningKeyRepository, nil},
 		{repository.NewOrganizationUserRepository, nil},
 		{repository.NewOrganizationRepository, nil},
 		{repository.NewOrganizationAliasRepository, nil},
+		{repository.NewOrganizationAPIKeyRepository, nil},
+		{repository.NewOrganizationInvitationRepository, nil},
+		{repository.NewOrganizationAuditLogRepository, nil},
 		{repository.NewUserStatusChangeLogRepository, nil},
 		{repository.NewTalkSessionConsentRepository, nil},
+		{repository.NewTalkSessionTemplateRepository, nil},
+		{repository.NewTalkSessionCollaboratorRepository, nil},
+		{repository.NewOwnershipTransferRepository, nil},
 		{repository.NewAnalysisRepository, nil},
+		{repository.NewAnalysisSnapshotRepository, nil},
+		{repository.NewAnalysisScheduleRepository, nil},
+		{repository.NewAnalysisJobRepository, nil},
+		{repository.NewReportVersionRepository, nil},
 		{repository.NewAuthStateRepository, nil},
-		{client.NewAnalysisService, nil},
+		{repository.NewReportPromptRepository, nil},
+		{repository.NewReferenceDistributionRepository, nil},
+		{repository.NewParticipantDemographicRepository, nil},
+		{wordcloud.NewTokenizer, nil},
+		{wordcloud.NewAnalysisService, nil},
 		{aws.NewAWSConfig, nil},
 		{aws.NewSESClient, nil},
 		{aws.NewPinpointClient, nil},
@@ -64,6 +88,9 @@ func infraDeps() []ProvideArg {
 		{repository.NewNotificationPreferenceRepository, nil},
 		{db.NewDummyInitializer, nil},
 		{organization.NewListJoinedOrganizationQuery, nil},
+		{organization.NewGetOrganizationStatsQuery, nil},
+		{ownership.NewGetOwnershipTransferQuery, nil},
+		{ownership.NewListOwnershipTransfersQuery, nil},
 		{persistence.NewEventStore, nil},
 	}
 }
@@ -0,0 +1,25 @@
+{{ template "header" . }}
+  <div class="container">
+      <div class="header">
+          {{if .CompanyLogo}}
+          <img src="{{.CompanyLogo}}" alt="{{.AppName}}" class="logo">
+          {{else}}
+          <h2>{{.AppName}}</h2>
+          {{end}}
+      </div>
+      <div class="content">
+          <h1>アカウントを一時的にロックしました</h1>
+
+          <p>{{.AppName}}のアカウントで、パスワードによるログインの失敗が続いたため、アカウントを一時的にロックしました。</p>
+
+          <p class="expiry-notice">※ロックは{{.LockedUntil}}に自動的に解除されます</p>
+
+          <p>ご自身でのログイン操作に心当たりがない場合は、第三者による不正なログインの可能性があります。ロック解除後にパスワードを変更してください。</p>
+
+          <a href="{{.WebsiteURL}}" class="button">{{.AppName}}を開く</a>
+
+          <div class="help-text">
+              <p>ご不明な点がございましたら、<a href="mailto:{{.ContactEmail}}">{{.ContactEmail}}</a>までお問い合わせください。</p>
+          </div>
+      </div>
+{{ template "footer" . }}
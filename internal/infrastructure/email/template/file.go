@@ -19,6 +19,10 @@ const (
 	VerificationEmailTemplate EmailTemplateType = "verification_email.tpl"
 	// OrganizationInvitationEmailTemplate
 	OrganizationInvitationEmailTemplate EmailTemplateType = "organization_invitation.tpl"
+	// OrganizationInvitationLinkEmailTemplate
+	OrganizationInvitationLinkEmailTemplate EmailTemplateType = "organization_invitation_link.tpl"
+	// AccountLockedEmailTemplate
+	AccountLockedEmailTemplate EmailTemplateType = "account_locked.tpl"
 )
 
 func LoadMailTemplate(templateType EmailTemplateType) (*template.Template, error) {
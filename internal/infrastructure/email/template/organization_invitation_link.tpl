@@ -0,0 +1,27 @@
+{{ template "header" . }}
+  <div class="container">
+      <div class="header">
+          {{if .CompanyLogo}}
+          <img src="{{.CompanyLogo}}" alt="{{.AppName}}" class="logo">
+          {{else}}
+          <h2>{{.AppName}}</h2>
+          {{end}}
+      </div>
+      <div class="content">
+          <p><strong>{{.OrganizationName}}</strong>があなたを<strong>{{.RoleName}}</strong>として招待しています。</p>
+
+          <p>下記のボタンから{{.AppName}}にログインし、招待を承諾してください。アカウントをお持ちでない場合は、このメールアドレスで登録してから承諾できます。</p>
+
+          <a href="{{.AcceptURL}}" class="button">招待を承諾する</a>
+
+          <p class="expiry-notice">※このリンクは{{.ExpiresAt}}まで有効です</p>
+
+          <p>ボタンが機能しない場合は、以下のURLをブラウザに貼り付けてください：</p>
+          <p style="word-break: break-all; font-size: 14px; color: #555;">{{.AcceptURL}}</p>
+
+          <div class="help-text">
+              <p>このメールに心当たりがない場合は、無視していただいて構いません。</p>
+              <p>ご不明な点がございましたら、<a href="mailto:{{.ContactEmail}}">{{.ContactEmail}}</a>までお問い合わせください。</p>
+          </div>
+      </div>
+{{ template "footer" . }}
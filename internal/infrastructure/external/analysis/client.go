@@ -5,9 +5,11 @@ import (
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"fmt"
 	"net/http"
 
 	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/clock"
 	"github.com/neko-dream/api/internal/domain/model/image"
 	"github.com/neko-dream/api/internal/domain/model/image/meta"
 	"github.com/neko-dream/api/internal/domain/model/shared"
@@ -21,20 +23,26 @@ import (
 )
 
 type analysisService struct {
-	conf     *config.Config
-	imageRep image.ImageStorage
+	conf         *config.Config
+	imageRep     image.ImageStorage
+	snapshotRepo analysis.AnalysisSnapshotRepository
+	versionSvc   analysis.ReportVersionService
 	db.DBManager
 }
 
 func NewAnalysisService(
 	conf *config.Config,
 	imageRep image.ImageStorage,
+	snapshotRepo analysis.AnalysisSnapshotRepository,
+	versionSvc analysis.ReportVersionService,
 	dbm *db.DBManager,
 ) analysis.AnalysisService {
 	return &analysisService{
-		conf:      conf,
-		imageRep:  imageRep,
-		DBManager: *dbm,
+		conf:         conf,
+		imageRep:     imageRep,
+		snapshotRepo: snapshotRepo,
+		versionSvc:   versionSvc,
+		DBManager:    *dbm,
 	}
 }
 
@@ -68,10 +76,13 @@ func (a *analysisService) GenerateReport(ctx context.Context, talkSessionID shar
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode != http.StatusOK {
-		utils.HandleError(ctx, err, "PostReportsGenerates")
-		return err
+		return fmt.Errorf("PostReportsGenerates: unexpected status %d", resp.StatusCode)
 	}
 
+	// レポートは上書きされるため、生成のたびにバージョンとして残す
+	if _, err := a.versionSvc.Record(ctx, talkSessionID, analysis.ReportGenerator{Name: analysis.ReportGeneratorAnalysisAPI}); err != nil {
+		utils.HandleError(ctx, err, "ReportVersionService.Record")
+	}
 	return nil
 }
 
@@ -91,7 +102,8 @@ func (a *analysisService) StartAnalysis(ctx context.Context, talkSessionID share
 	// クライアントの初期化
 	c, err := NewClient(a.conf.ANALYSIS_API_DOMAIN, WithHTTPClient(httpClient))
 	if err != nil {
-		return nil
+		utils.HandleError(ctx, err, "NewClient")
+		return err
 	}
 	// APIリクエストの実行
 	resp, err := c.PostPredictsGroups(ctx, PostPredictsGroupsJSONRequestBody{
@@ -99,11 +111,17 @@ func (a *analysisService) StartAnalysis(ctx context.Context, talkSessionID share
 		UserId:        "0",
 	})
 	if err != nil {
-		return nil
+		utils.HandleError(ctx, err, "PostPredictsGroups")
+		return err
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode != http.StatusOK {
-		return nil
+		return fmt.Errorf("PostPredictsGroups: unexpected status %d", resp.StatusCode)
+	}
+
+	// 分析結果は上書きされるため、グループの変化を追えるようスナップショットを残す
+	if _, err := a.snapshotRepo.Capture(ctx, talkSessionID, clock.Now(ctx)); err != nil {
+		utils.HandleError(ctx, err, "AnalysisSnapshotRepository.Capture")
 	}
 	return nil
 }
@@ -134,6 +152,9 @@ func (a *analysisService) GenerateImage(ctx context.Context, talkSessionID share
 		return nil, err
 	}
 	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("PostReportsWordclouds: unexpected status %d", resp.StatusCode)
+	}
 
 	var wordcloud analysis.WordCloudResponse
 	if err := json.NewDecoder(resp.Body).Decode(&wordcloud); err != nil {
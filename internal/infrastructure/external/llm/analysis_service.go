@@ -0,0 +1,199 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"slices"
+
+	"github.com/neko-dream/api/internal/application/query/analysis_query"
+	"github.com/neko-dream/api/internal/application/query/dto"
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/image"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/infrastructure/config"
+	client "github.com/neko-dream/api/internal/infrastructure/external/analysis"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	model "github.com/neko-dream/api/internal/infrastructure/persistence/sqlc/generated"
+	"github.com/neko-dream/api/pkg/utils"
+	"github.com/sqlc-dev/pqtype"
+	"go.opentelemetry.io/otel"
+)
+
+// analysisService レポートだけをアプリケーション内で生成する
+// グループ分けと画像の生成は分析APIをそのまま使う
+type analysisService struct {
+	analysis.AnalysisService
+	client          *Client
+	talkSessionRepo talksession.TalkSessionRepository
+	promptRepo      analysis.ReportPromptRepository
+	versionSvc      analysis.ReportVersionService
+	getConsensus    analysis_query.GetConsensusQuery
+	*db.DBManager
+}
+
+// NewAnalysisService REPORT_GENERATORがllmの場合はレポートをLLMで生成し、それ以外は分析APIの実装を返す
+func NewAnalysisService(
+	conf *config.Config,
+	imageRep image.ImageStorage,
+	snapshotRepo analysis.AnalysisSnapshotRepository,
+	versionSvc analysis.ReportVersionService,
+	talkSessionRepo talksession.TalkSessionRepository,
+	promptRepo analysis.ReportPromptRepository,
+	getConsensus analysis_query.GetConsensusQuery,
+	dbm *db.DBManager,
+) analysis.AnalysisService {
+	remote := client.NewAnalysisService(conf, imageRep, snapshotRepo, versionSvc, dbm)
+	if conf.ReportGenerator != analysis.ReportGeneratorLLM {
+		return remote
+	}
+
+	return &analysisService{
+		AnalysisService: remote,
+		client:          NewClient(conf),
+		talkSessionRepo: talkSessionRepo,
+		promptRepo:      promptRepo,
+		versionSvc:      versionSvc,
+		getConsensus:    getConsensus,
+		DBManager:       dbm,
+	}
+}
+
+// GenerateReport セッションの集計からプロンプトを組み立ててレポートを生成する
+func (a *analysisService) GenerateReport(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession]) error {
+	ctx, span := otel.Tracer("llm").Start(ctx, "analysisService.GenerateReport")
+	defer span.End()
+
+	talkSession, err := a.talkSessionRepo.FindByID(ctx, talkSessionID)
+	if err != nil {
+		utils.HandleError(ctx, err, "TalkSessionRepository.FindByID")
+		return messages.TalkSessionNotFound
+	}
+
+	// 組織のセッションは組織の指示を使う
+	var prompt *analysis.ReportPrompt
+	if talkSession.OrganizationID() != nil {
+		prompt, err = a.promptRepo.FindByOrganizationID(ctx, *talkSession.OrganizationID())
+		if err != nil {
+			utils.HandleError(ctx, err, "ReportPromptRepository.FindByOrganizationID")
+			return err
+		}
+	}
+
+	material, err := a.buildMaterial(ctx, talkSession)
+	if err != nil {
+		return err
+	}
+
+	content, usedModel, err := a.client.Complete(ctx, []ChatMessage{
+		{Role: "system", Content: prompt.System()},
+		{Role: "user", Content: material.User()},
+	})
+	if err != nil {
+		utils.HandleError(ctx, err, "Client.Complete")
+		return messages.GenerateAnalysisReportFailed
+	}
+	sections, err := analysis.ParseReportSections(content)
+	if err != nil {
+		utils.HandleError(ctx, err, "analysis.ParseReportSections")
+		return err
+	}
+	raw, err := json.Marshal(sections)
+	if err != nil {
+		return err
+	}
+
+	if err := a.GetQueries(ctx).SaveGeneratedReport(ctx, model.SaveGeneratedReportParams{
+		TalkSessionID: talkSessionID.UUID(),
+		Report:        sections.Markdown(),
+		Sections:      pqtype.NullRawMessage{RawMessage: raw, Valid: true},
+		GeneratedAt:   clock.Now(ctx),
+	}); err != nil {
+		utils.HandleError(ctx, err, "SaveGeneratedReport")
+		return err
+	}
+
+	if _, err := a.versionSvc.Record(ctx, talkSessionID, analysis.ReportGenerator{
+		Name:  analysis.ReportGeneratorLLM,
+		Model: &usedModel,
+	}); err != nil {
+		// バージョンが記録されないとレポートを公開できないため、ジョブを失敗させて再実行する
+		utils.HandleError(ctx, err, "ReportVersionService.Record")
+		return err
+	}
+	return nil
+}
+
+// buildMaterial グループの代表的な意見と、合意・対立している意見を集める
+func (a *analysisService) buildMaterial(ctx context.Context, talkSession *talksession.TalkSession) (*analysis.ReportMaterial, error) {
+	talkSessionID := talkSession.TalkSessionID()
+
+	members, err := a.GetQueries(ctx).GetGroupInfoByTalkSessionId(ctx, talkSessionID.UUID())
+	if err != nil {
+		utils.HandleError(ctx, err, "GetGroupInfoByTalkSessionId")
+		return nil, messages.InternalServerError
+	}
+	groups := make(map[int32]*analysis.ReportGroupMaterial)
+	for _, member := range members {
+		group, ok := groups[member.GroupID]
+		if !ok {
+			group = &analysis.ReportGroupMaterial{GroupID: analysis.NewGroupIDFromInt(int(member.GroupID))}
+			groups[member.GroupID] = group
+		}
+		group.MemberCount++
+	}
+
+	// 投稿者・運営が削除した意見はレポートに含めない
+	representatives, err := a.GetQueries(ctx).GetReportRepresentativeOpinionsByTalkSessionID(ctx, talkSessionID.UUID())
+	if err != nil {
+		utils.HandleError(ctx, err, "GetReportRepresentativeOpinionsByTalkSessionID")
+		return nil, messages.InternalServerError
+	}
+	for _, row := range representatives {
+		group, ok := groups[row.RepresentativeOpinion.GroupID]
+		if !ok {
+			continue
+		}
+		group.Opinions = append(group.Opinions, analysis.ReportOpinionMaterial{
+			Content:       row.Opinion.Content,
+			AgreeCount:    int(row.RepresentativeOpinion.AgreeCount),
+			DisagreeCount: int(row.RepresentativeOpinion.DisagreeCount),
+			PassCount:     int(row.RepresentativeOpinion.PassCount),
+		})
+	}
+
+	consensus, err := a.getConsensus.Execute(ctx, analysis_query.GetConsensusInput{
+		TalkSessionID: talkSessionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	material := &analysis.ReportMaterial{
+		Theme:       talkSession.Theme(),
+		Description: talkSession.Description(),
+		Consensus:   toOpinionMaterials(consensus.Consensus),
+		Divisive:    toOpinionMaterials(consensus.Divisive),
+	}
+	for _, groupID := range slices.Sorted(maps.Keys(groups)) {
+		material.Groups = append(material.Groups, *groups[groupID])
+	}
+	return material, nil
+}
+
+func toOpinionMaterials(opinions []dto.OpinionConsensus) []analysis.ReportOpinionMaterial {
+	materials := make([]analysis.ReportOpinionMaterial, 0, len(opinions))
+	for _, op := range opinions {
+		material := analysis.ReportOpinionMaterial{Content: op.Opinion.Content}
+		for _, group := range op.Groups {
+			material.AgreeCount += group.AgreeCount
+			material.DisagreeCount += group.DisagreeCount
+			material.PassCount += group.PassCount
+		}
+		materials = append(materials, material)
+	}
+	return materials
+}
@@ -0,0 +1,112 @@
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/neko-dream/api/internal/infrastructure/config"
+	"go.opentelemetry.io/otel"
+)
+
+// ChatMessage OpenAI互換のAPIに渡すメッセージ
+type ChatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type (
+	chatCompletionRequest struct {
+		Model          string         `json:"model"`
+		Messages       []ChatMessage  `json:"messages"`
+		Temperature    float64        `json:"temperature"`
+		ResponseFormat responseFormat `json:"response_format"`
+	}
+
+	responseFormat struct {
+		Type string `json:"type"`
+	}
+
+	chatCompletionResponse struct {
+		Model   string `json:"model"`
+		Choices []struct {
+			Message ChatMessage `json:"message"`
+		} `json:"choices"`
+	}
+)
+
+// Client OpenAI互換の/chat/completionsを呼び出す
+type Client struct {
+	baseURL    string
+	apiKey     string
+	model      string
+	httpClient *http.Client
+}
+
+func NewClient(conf *config.Config) *Client {
+	return &Client{
+		baseURL: strings.TrimSuffix(conf.LLMBaseURL, "/"),
+		apiKey:  conf.LLMAPIKey,
+		model:   conf.LLMModel,
+		httpClient: &http.Client{
+			Timeout: time.Duration(conf.LLMTimeout) * time.Second,
+		},
+	}
+}
+
+// Complete JSONで応答するよう指定して生成し、応答の本文と実際に使われたモデルを返す
+func (c *Client) Complete(ctx context.Context, messages []ChatMessage) (string, string, error) {
+	ctx, span := otel.Tracer("llm").Start(ctx, "Client.Complete")
+	defer span.End()
+
+	body, err := json.Marshal(chatCompletionRequest{
+		Model:       c.model,
+		Messages:    messages,
+		Temperature: 0.2,
+		ResponseFormat: responseFormat{
+			Type: "json_object",
+		},
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+"/chat/completions", bytes.NewReader(body))
+	if err != nil {
+		return "", "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.apiKey)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		// エラーの内容が分かるよう先頭だけ残す
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", "", fmt.Errorf("chat/completions: unexpected status %d: %s", resp.StatusCode, msg)
+	}
+
+	var res chatCompletionResponse
+	if err := json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return "", "", err
+	}
+	if len(res.Choices) == 0 {
+		return "", "", fmt.Errorf("chat/completions: no choices")
+	}
+
+	model := res.Model
+	if model == "" {
+		model = c.model
+	}
+	return res.Choices[0].Message.Content, model, nil
+}
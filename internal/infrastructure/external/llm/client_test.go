@@ -0,0 +1,72 @@
+package llm_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/neko-dream/api/internal/infrastructure/config"
+	"github.com/neko-dream/api/internal/infrastructure/external/llm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newStubServer OpenAI互換の/chat/completionsを返すスタブ
+func newStubServer(t *testing.T, status int, body string) (*httptest.Server, *http.Request, *map[string]any) {
+	t.Helper()
+
+	var gotReq http.Request
+	gotBody := map[string]any{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotReq = *r
+		_ = json.NewDecoder(r.Body).Decode(&gotBody)
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server, &gotReq, &gotBody
+}
+
+func TestClient_Complete(t *testing.T) {
+	t.Run("メッセージを送り、応答の本文とモデルを返す", func(t *testing.T) {
+		server, req, body := newStubServer(t, http.StatusOK, `{"model":"stub-model-2025","choices":[{"message":{"role":"assistant","content":"{\"summary\":\"要約\"}"}}]}`)
+		client := llm.NewClient(&config.Config{
+			LLMBaseURL: server.URL + "/",
+			LLMAPIKey:  "secret",
+			LLMModel:   "stub-model",
+			LLMTimeout: 5,
+		})
+
+		content, model, err := client.Complete(context.Background(), []llm.ChatMessage{
+			{Role: "system", Content: "役割"},
+			{Role: "user", Content: "集計"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, `{"summary":"要約"}`, content)
+		assert.Equal(t, "stub-model-2025", model)
+
+		assert.Equal(t, "/chat/completions", req.URL.Path)
+		assert.Equal(t, "Bearer secret", req.Header.Get("Authorization"))
+		assert.Equal(t, "stub-model", (*body)["model"])
+		assert.Equal(t, map[string]any{"type": "json_object"}, (*body)["response_format"])
+		assert.Len(t, (*body)["messages"], 2)
+	})
+
+	t.Run("エラーのステータスは失敗として返す", func(t *testing.T) {
+		server, _, _ := newStubServer(t, http.StatusTooManyRequests, `{"error":"rate limited"}`)
+		client := llm.NewClient(&config.Config{LLMBaseURL: server.URL, LLMModel: "stub-model", LLMTimeout: 5})
+
+		_, _, err := client.Complete(context.Background(), []llm.ChatMessage{{Role: "user", Content: "集計"}})
+		assert.ErrorContains(t, err, "429")
+	})
+
+	t.Run("候補がない場合は失敗する", func(t *testing.T) {
+		server, _, _ := newStubServer(t, http.StatusOK, `{"choices":[]}`)
+		client := llm.NewClient(&config.Config{LLMBaseURL: server.URL, LLMModel: "stub-model", LLMTimeout: 5})
+
+		_, _, err := client.Complete(context.Background(), []llm.ChatMessage{{Role: "user", Content: "集計"}})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,142 @@
+package wordcloud
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/neko-dream/api/internal/application/query/analysis_query"
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/image"
+	"github.com/neko-dream/api/internal/domain/model/image/meta"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/infrastructure/config"
+	"github.com/neko-dream/api/internal/infrastructure/external/llm"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	model "github.com/neko-dream/api/internal/infrastructure/persistence/sqlc/generated"
+	http_utils "github.com/neko-dream/api/pkg/http"
+	"github.com/neko-dream/api/pkg/utils"
+	"github.com/samber/lo"
+	"github.com/sqlc-dev/pqtype"
+	"go.opentelemetry.io/otel"
+)
+
+// analysisService ワードクラウドだけをアプリケーション内で生成する
+// t-SNEの画像は生成しない
+type analysisService struct {
+	analysis.AnalysisService
+	tokenizer analysis.Tokenizer
+	renderer  *Renderer
+	imageRep  image.ImageStorage
+	*db.DBManager
+}
+
+// NewAnalysisService WORDCLOUD_GENERATORがlocalの場合はワードクラウドをアプリケーション内で生成し、それ以外はレポート生成の設定に応じた実装を返す
+func NewAnalysisService(
+	conf *config.Config,
+	imageRep image.ImageStorage,
+	snapshotRepo analysis.AnalysisSnapshotRepository,
+	versionSvc analysis.ReportVersionService,
+	talkSessionRepo talksession.TalkSessionRepository,
+	promptRepo analysis.ReportPromptRepository,
+	getConsensus analysis_query.GetConsensusQuery,
+	tokenizer analysis.Tokenizer,
+	dbm *db.DBManager,
+) (analysis.AnalysisService, error) {
+	base := llm.NewAnalysisService(conf, imageRep, snapshotRepo, versionSvc, talkSessionRepo, promptRepo, getConsensus, dbm)
+	if conf.WordCloudGenerator != analysis.WordCloudGeneratorLocal {
+		return base, nil
+	}
+
+	renderer, err := NewRenderer(conf.WordCloudFontPath)
+	if err != nil {
+		return nil, err
+	}
+	return &analysisService{
+		AnalysisService: base,
+		tokenizer:       tokenizer,
+		renderer:        renderer,
+		imageRep:        imageRep,
+		DBManager:       dbm,
+	}, nil
+}
+
+// GenerateImage 意見を形態素解析して単語を集計し、ワードクラウドを描いて集計と一緒に保存する
+func (a *analysisService) GenerateImage(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession]) (*analysis.WordCloudResponse, error) {
+	ctx, span := otel.Tracer("wordcloud").Start(ctx, "analysisService.GenerateImage")
+	defer span.End()
+
+	rows, err := a.GetQueries(ctx).GetOpinionTextsWithGroupByTalkSessionID(ctx, talkSessionID.UUID())
+	if err != nil {
+		utils.HandleError(ctx, err, "GetOpinionTextsWithGroupByTalkSessionID")
+		return nil, messages.InternalServerError
+	}
+	documents := make([]analysis.TermDocument, 0, len(rows))
+	for _, row := range rows {
+		text := row.Content
+		if row.Title.Valid {
+			text = row.Title.String + "\n" + text
+		}
+		doc := analysis.TermDocument{Terms: a.tokenizer.Tokenize(text)}
+		if row.GroupID.Valid {
+			doc.GroupID = lo.ToPtr(analysis.NewGroupIDFromInt(int(row.GroupID.Int32)))
+		}
+		documents = append(documents, doc)
+	}
+	tf := analysis.NewTermFrequencies(talkSessionID, documents, clock.Now(ctx))
+
+	png, err := a.renderer.Render(toWords(tf))
+	if err != nil {
+		utils.HandleError(ctx, err, "Renderer.Render")
+		return nil, err
+	}
+	imgInfo, err := meta.NewImageForAnalysis(ctx, bytes.NewReader(png))
+	if err != nil {
+		utils.HandleError(ctx, err, "meta.NewImageForAnalysis")
+		return nil, err
+	}
+	file, err := http_utils.CreateFileHeader(ctx, bytes.NewReader(png), "wordcloud.png")
+	if err != nil {
+		utils.HandleError(ctx, err, "http_utils.CreateFileHeader")
+		return nil, err
+	}
+	url, err := a.imageRep.Upload(ctx, *imgInfo, file)
+	if err != nil {
+		utils.HandleError(ctx, err, "imageRep.Upload")
+		return nil, err
+	}
+
+	raw, err := json.Marshal(tf)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.GetQueries(ctx).SaveGeneratedWordCloud(ctx, model.SaveGeneratedWordCloudParams{
+		TalkSessionID:   talkSessionID.UUID(),
+		WordmapUrl:      *url,
+		TermFrequencies: pqtype.NullRawMessage{RawMessage: raw, Valid: true},
+	}); err != nil {
+		utils.HandleError(ctx, err, "SaveGeneratedWordCloud")
+		return nil, err
+	}
+
+	return &analysis.WordCloudResponse{
+		Wordcloud: base64.StdEncoding.EncodeToString(png),
+	}, nil
+}
+
+// toWords 全体の出現回数で大きさを、最も特徴的なグループで色を決める
+func toWords(tf *analysis.TermFrequencies) []Word {
+	words := make([]Word, 0, len(tf.Overall))
+	for _, term := range tf.Overall {
+		word := Word{Text: term.Term, Weight: term.Score}
+		if groupID, ok := tf.CharacteristicGroup(term.Term); ok {
+			word.Group = lo.ToPtr(int(groupID))
+		}
+		words = append(words, word)
+	}
+	return words
+}
@@ -0,0 +1,180 @@
+package wordcloud
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"math"
+	"os"
+
+	"github.com/hajimehoshi/bitmapfont/v3"
+	xdraw "golang.org/x/image/draw"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+const (
+	canvasWidth  = 1200
+	canvasHeight = 800
+	minFontSize  = 16
+	maxFontSize  = 120
+	// wordPadding 単語同士の間隔
+	wordPadding = 4
+	// bitmapFontSize 同梱のビットマップフォントの大きさ
+	bitmapFontSize = 12
+)
+
+var (
+	background = color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+	// groupColors グループごとの単語の色。どのグループにも属さない単語はneutralColor
+	groupColors = []color.RGBA{
+		{R: 0xe1, G: 0x4b, B: 0x5a, A: 0xff},
+		{R: 0xd8, G: 0x9b, B: 0x00, A: 0xff},
+		{R: 0x3b, G: 0x8e, B: 0x4f, A: 0xff},
+		{R: 0x2f, G: 0x6f, B: 0xb5, A: 0xff},
+		{R: 0x8a, G: 0x4f, B: 0xb0, A: 0xff},
+		{R: 0xd0, G: 0x6a, B: 0x2b, A: 0xff},
+		{R: 0x1f, G: 0x9a, B: 0x9a, A: 0xff},
+		{R: 0x9c, G: 0x5b, B: 0x3c, A: 0xff},
+	}
+	neutralColor = color.RGBA{R: 0x55, G: 0x55, B: 0x55, A: 0xff}
+)
+
+// Word ワードクラウドに描く単語。Weightは0から1で、大きいほど文字を大きくする
+type Word struct {
+	Text   string
+	Weight float64
+	// Group 色分けに使うグループ。nilの場合はグループに関係なく同じ色にする
+	Group *int
+}
+
+// Renderer 単語を重ならないよう中心から螺旋状に並べてPNGに描く
+type Renderer struct {
+	// font 指定がない場合は日本語を含むビットマップフォントを拡大して使う
+	font *opentype.Font
+}
+
+// NewRenderer fontPathが空の場合は同梱のビットマップフォントを使う
+func NewRenderer(fontPath string) (*Renderer, error) {
+	if fontPath == "" {
+		return &Renderer{}, nil
+	}
+	data, err := os.ReadFile(fontPath)
+	if err != nil {
+		return nil, err
+	}
+	f, err := opentype.Parse(data)
+	if err != nil {
+		return nil, err
+	}
+	return &Renderer{font: f}, nil
+}
+
+// Render 重みが大きい順に配置し、置けなかった単語は描かない
+func (r *Renderer) Render(words []Word) ([]byte, error) {
+	dst := image.NewRGBA(image.Rect(0, 0, canvasWidth, canvasHeight))
+	draw.Draw(dst, dst.Bounds(), image.NewUniform(background), image.Point{}, draw.Src)
+
+	placed := make([]image.Rectangle, 0, len(words))
+	for _, word := range words {
+		size := minFontSize + (maxFontSize-minFontSize)*math.Sqrt(max(0, min(1, word.Weight)))
+		mask, err := r.renderMask(word.Text, size)
+		if err != nil {
+			return nil, err
+		}
+		rect, ok := findPlace(mask.Bounds().Size(), placed)
+		if !ok {
+			continue
+		}
+		placed = append(placed, rect)
+
+		c := neutralColor
+		if word.Group != nil {
+			c = groupColors[*word.Group%len(groupColors)]
+		}
+		draw.DrawMask(dst, rect, image.NewUniform(c), image.Point{}, mask, image.Point{}, draw.Over)
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, dst); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// renderMask 単語を指定した大きさで描いたアルファマスクを返す
+func (r *Renderer) renderMask(text string, size float64) (*image.Alpha, error) {
+	if r.font != nil {
+		face, err := opentype.NewFace(r.font, &opentype.FaceOptions{
+			Size:    size,
+			DPI:     72,
+			Hinting: font.HintingFull,
+		})
+		if err != nil {
+			return nil, err
+		}
+		defer face.Close()
+		return drawText(face, text), nil
+	}
+
+	// ビットマップフォントは大きさを変えられないため、描いてから拡大する
+	src := drawText(bitmapfont.Face, text)
+	scale := size / bitmapFontSize
+	b := src.Bounds()
+	scaled := image.NewAlpha(image.Rect(0, 0, int(float64(b.Dx())*scale), int(float64(b.Dy())*scale)))
+	xdraw.ApproxBiLinear.Scale(scaled, scaled.Bounds(), src, b, xdraw.Src, nil)
+	return scaled, nil
+}
+
+func drawText(face font.Face, text string) *image.Alpha {
+	metrics := face.Metrics()
+	width := font.MeasureString(face, text).Ceil()
+	height := (metrics.Ascent + metrics.Descent).Ceil()
+	mask := image.NewAlpha(image.Rect(0, 0, max(1, width), max(1, height)))
+	d := &font.Drawer{
+		Dst:  mask,
+		Src:  image.Opaque,
+		Face: face,
+		Dot:  fixed.Point26_6{X: 0, Y: metrics.Ascent},
+	}
+	d.DrawString(text)
+	return mask
+}
+
+// findPlace キャンバスの中心から外側へ螺旋状にずらし、他の単語と重ならない位置を探す
+func findPlace(size image.Point, placed []image.Rectangle) (image.Rectangle, bool) {
+	if size.X > canvasWidth || size.Y > canvasHeight {
+		return image.Rectangle{}, false
+	}
+	canvas := image.Rect(0, 0, canvasWidth, canvasHeight)
+	center := image.Pt((canvasWidth-size.X)/2, (canvasHeight-size.Y)/2)
+	// キャンバスの縦横比に合わせて横長の螺旋にする
+	aspect := float64(canvasWidth) / float64(canvasHeight)
+	maxRadius := math.Hypot(canvasWidth, canvasHeight) / 2
+
+	for t := 0.0; ; t += 0.1 {
+		radius := 2 * t
+		if radius > maxRadius {
+			return image.Rectangle{}, false
+		}
+		p := center.Add(image.Pt(int(radius*math.Cos(t)*aspect), int(radius*math.Sin(t))))
+		rect := image.Rectangle{Min: p, Max: p.Add(size)}
+		if !rect.In(canvas) || overlaps(rect, placed) {
+			continue
+		}
+		return rect, true
+	}
+}
+
+func overlaps(rect image.Rectangle, placed []image.Rectangle) bool {
+	padded := rect.Inset(-wordPadding)
+	for _, p := range placed {
+		if padded.Overlaps(p) {
+			return true
+		}
+	}
+	return false
+}
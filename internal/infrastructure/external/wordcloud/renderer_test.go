@@ -0,0 +1,34 @@
+package wordcloud_test
+
+import (
+	"bytes"
+	"image/png"
+	"testing"
+
+	"github.com/neko-dream/api/internal/infrastructure/external/wordcloud"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderer_Render(t *testing.T) {
+	renderer, err := wordcloud.NewRenderer("")
+	require.NoError(t, err)
+
+	data, err := renderer.Render([]wordcloud.Word{
+		{Text: "駅前", Weight: 1, Group: lo.ToPtr(0)},
+		{Text: "広場", Weight: 0.5, Group: lo.ToPtr(1)},
+		{Text: "ベンチ", Weight: 0.1},
+	})
+	require.NoError(t, err)
+
+	img, err := png.Decode(bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, 1200, img.Bounds().Dx())
+	assert.Equal(t, 800, img.Bounds().Dy())
+}
+
+func TestNewRenderer(t *testing.T) {
+	_, err := wordcloud.NewRenderer("/not/found.ttf")
+	assert.Error(t, err)
+}
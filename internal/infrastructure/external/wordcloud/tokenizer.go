@@ -0,0 +1,137 @@
+package wordcloud
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/ikawaha/kagome-dict/ipa"
+	"github.com/ikawaha/kagome/v2/tokenizer"
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"golang.org/x/text/unicode/norm"
+)
+
+// ipaFeature IPA辞書の素性の位置
+const (
+	featurePOS = iota
+	featurePOSDetail
+	_
+	_
+	_
+	_
+	featureBaseForm
+)
+
+var (
+	// ignoredNounDetails 内容を表さない名詞の細分類
+	ignoredNounDetails = map[string]bool{
+		"非自立":  true,
+		"代名詞":  true,
+		"数":    true,
+		"接尾":   true,
+		"副詞可能": true,
+		"特殊":   true,
+	}
+
+	// stopWords どの意見にも現れ、ワードクラウドで目立つだけの単語
+	stopWords = map[string]bool{
+		"する":  true,
+		"いる":  true,
+		"ある":  true,
+		"なる":  true,
+		"れる":  true,
+		"られる": true,
+		"できる": true,
+		"思う":  true,
+		"言う":  true,
+		"いう":  true,
+		"くる":  true,
+		"来る":  true,
+		"やる":  true,
+		"ない":  true,
+		"いい":  true,
+		"よい":  true,
+		"良い":  true,
+		"こと":  true,
+		"もの":  true,
+		"よう":  true,
+		"ため":  true,
+	}
+)
+
+type kagomeTokenizer struct {
+	load func() *tokenizer.Tokenizer
+}
+
+// NewTokenizer IPA辞書で形態素解析するTokenizer
+// 辞書は大きいため、最初に使われたときに読み込む
+func NewTokenizer() analysis.Tokenizer {
+	return &kagomeTokenizer{
+		load: sync.OnceValue(func() *tokenizer.Tokenizer {
+			t, err := tokenizer.New(ipa.Dict(), tokenizer.OmitBosEos())
+			if err != nil {
+				// 同梱の辞書を渡しているため、失敗するのは辞書が壊れている場合だけ
+				panic(err)
+			}
+			return t
+		}),
+	}
+}
+
+// Tokenize 全角英数を半角に揃えてから、名詞・自立語の動詞・形容詞を原形で返す
+func (k *kagomeTokenizer) Tokenize(text string) []string {
+	text = strings.ToLower(norm.NFKC.String(text))
+
+	terms := make([]string, 0)
+	for _, token := range k.load().Tokenize(text) {
+		features := token.Features()
+		if len(features) <= featurePOSDetail {
+			continue
+		}
+		switch features[featurePOS] {
+		case "名詞":
+			if ignoredNounDetails[features[featurePOSDetail]] {
+				continue
+			}
+		case "動詞", "形容詞":
+			if features[featurePOSDetail] != "自立" {
+				continue
+			}
+		default:
+			continue
+		}
+
+		term := token.Surface
+		if len(features) > featureBaseForm && features[featureBaseForm] != "*" {
+			term = features[featureBaseForm]
+		}
+		if !isContentTerm(term) {
+			continue
+		}
+		terms = append(terms, term)
+	}
+	return terms
+}
+
+// isContentTerm 1文字のかな・記号や数字だけの単語を除く。1文字の漢字は意味を持つため残す
+func isContentTerm(term string) bool {
+	if term == "" || stopWords[term] {
+		return false
+	}
+	hasLetter := false
+	for _, r := range term {
+		if unicode.IsLetter(r) {
+			hasLetter = true
+			break
+		}
+	}
+	if !hasLetter {
+		return false
+	}
+	if utf8.RuneCountInString(term) == 1 {
+		r, _ := utf8.DecodeRuneInString(term)
+		return unicode.Is(unicode.Han, r)
+	}
+	return true
+}
@@ -0,0 +1,27 @@
+package wordcloud_test
+
+import (
+	"testing"
+
+	"github.com/neko-dream/api/internal/infrastructure/external/wordcloud"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenizer_Tokenize(t *testing.T) {
+	tokenizer := wordcloud.NewTokenizer()
+
+	t.Run("名詞・動詞・形容詞を原形で返す", func(t *testing.T) {
+		assert.Equal(t,
+			[]string{"駅前", "広場", "ベンチ", "増やす", "暗い", "怖い"},
+			tokenizer.Tokenize("駅前の広場にベンチを増やしてほしいと思います。暗くて怖い。"),
+		)
+	})
+
+	t.Run("全角英字は半角の小文字に揃える", func(t *testing.T) {
+		assert.Equal(t, []string{"ai", "渋滞", "減らす"}, tokenizer.Tokenize("ＡＩで渋滞を減らしたい！"))
+	})
+
+	t.Run("数や代名詞は除く", func(t *testing.T) {
+		assert.Equal(t, []string{"公園"}, tokenizer.Tokenize("これは2つの公園です"))
+	})
+}
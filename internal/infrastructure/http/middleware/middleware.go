@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/neko-dream/api/internal/domain/model/organization"
 	"github.com/neko-dream/api/internal/domain/model/session"
+	"github.com/neko-dream/api/internal/domain/service"
 	"github.com/neko-dream/api/internal/infrastructure/di"
 	http_utils "github.com/neko-dream/api/pkg/http"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
@@ -120,6 +122,44 @@ func SetContextCookieKey(cont *dig.Container) Middleware {
 	}
 }
 
+// contextにAPIキーの認証情報をセットするミドルウェア
+// 認証が任意のOperationでもAPIキーの組織コンテキストを使えるようにする
+func SetContextAPIKey(cont *dig.Container, find RouteFinder, requiredScope func(operationName string) (organization.APIKeyScope, bool)) Middleware {
+	authenticator := di.Invoke[service.APIKeyAuthenticator](cont)
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			apiKey := r.Header.Get("X-API-Key")
+			// Cookieで認証済み、またはAPIキーがない場合はそのまま続行
+			if apiKey == "" || session.GetSession(ctx) != nil {
+				h.ServeHTTP(w, r)
+				return
+			}
+
+			route, ok := find(r.Method, r.URL)
+			if !ok {
+				h.ServeHTTP(w, r)
+				return
+			}
+			scope, ok := requiredScope(route.Name())
+			if !ok {
+				h.ServeHTTP(w, r)
+				return
+			}
+
+			claim, err := authenticator.Authenticate(ctx, apiKey, scope)
+			if err != nil {
+				// 認証必須のOperationはSecurityHandlerでエラーになるため、ここでは続行
+				h.ServeHTTP(w, r)
+				return
+			}
+
+			r = r.WithContext(session.SetSession(ctx, claim))
+			h.ServeHTTP(w, r)
+		})
+	}
+}
+
 func Wrap(h http.Handler, middlewares ...Middleware) http.Handler {
 	switch len(middlewares) {
 	case 0:
@@ -0,0 +1,56 @@
+package analysis
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/application/query/analysis_query"
+	"github.com/neko-dream/api/internal/application/query/dto"
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/pkg/utils"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel"
+)
+
+type getAnalysisJobQuery struct {
+	analysis.AnalysisJobRepository
+}
+
+func NewGetAnalysisJobQuery(jobRepository analysis.AnalysisJobRepository) analysis_query.GetAnalysisJobQuery {
+	return &getAnalysisJobQuery{
+		AnalysisJobRepository: jobRepository,
+	}
+}
+
+// Execute セッションの生成ジョブの状況を返す。別のセッションのジョブは見つからないものとして扱う
+func (q *getAnalysisJobQuery) Execute(ctx context.Context, input analysis_query.GetAnalysisJobInput) (*analysis_query.GetAnalysisJobOutput, error) {
+	ctx, span := otel.Tracer("analysis_query").Start(ctx, "getAnalysisJobQuery.Execute")
+	defer span.End()
+
+	job, err := q.AnalysisJobRepository.FindByID(ctx, input.JobID)
+	if err != nil {
+		utils.HandleError(ctx, err, "AnalysisJobRepository.FindByID")
+		return nil, messages.InternalServerError
+	}
+	if job == nil || job.TalkSessionID() != input.TalkSessionID {
+		return nil, messages.AnalysisJobNotFound
+	}
+
+	out := dto.AnalysisJob{
+		JobID:       job.JobID().String(),
+		Type:        job.JobType(),
+		Status:      job.Status(),
+		Attempts:    job.Attempts(),
+		MaxAttempts: analysis.AnalysisJobMaxAttempts,
+		StartedAt:   job.StartedAt(),
+		FinishedAt:  job.FinishedAt(),
+		CreatedAt:   job.CreatedAt(),
+	}
+	if job.Status() == analysis.AnalysisJobStatusQueued {
+		out.NextRunAt = lo.ToPtr(job.RunAfter())
+	}
+
+	return &analysis_query.GetAnalysisJobOutput{
+		Job: out,
+	}, nil
+}
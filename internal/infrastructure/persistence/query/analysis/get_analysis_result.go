@@ -12,6 +12,7 @@ import (
 	model "github.com/neko-dream/api/internal/infrastructure/persistence/sqlc/generated"
 	dto_mapper "github.com/neko-dream/api/internal/infrastructure/persistence/utils"
 	"github.com/neko-dream/api/pkg/utils"
+	"github.com/samber/lo"
 	"go.opentelemetry.io/otel"
 )
 
@@ -98,12 +99,19 @@ func (g *GetAnalysisResultHandler) Execute(ctx context.Context, input analysis_q
 		groupOpinionsMap[int32(row.GroupID)] = append(groupOpinionsMap[int32(row.GroupID)], row)
 	}
 
+	importantOpinions, err := findImportantOpinions(ctx, g.GetQueries(ctx), input.TalkSessionID)
+	if err != nil {
+		utils.HandleError(ctx, err, "重要な意見の取得に失敗")
+		return nil, err
+	}
+
 	groupOpinions := make([]dto.OpinionGroup, 0, len(groupOpinionsMap))
 	for groupID, opinions := range groupOpinionsMap {
 		groupOpinions = append(groupOpinions, dto.OpinionGroup{
-			GroupName: analysis.NewGroupIDFromInt(int(groupID)).String(),
-			GroupID:   int(groupID),
-			Opinions:  opinions,
+			GroupName:         analysis.NewGroupIDFromInt(int(groupID)).String(),
+			GroupID:           int(groupID),
+			Opinions:          opinions,
+			ImportantOpinions: lo.CoalesceSliceOrEmpty(importantOpinions[int(groupID)]),
 		})
 	}
 
@@ -0,0 +1,137 @@
+package analysis
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"slices"
+
+	"github.com/jinzhu/copier"
+	"github.com/neko-dream/api/internal/application/query/analysis_query"
+	"github.com/neko-dream/api/internal/application/query/dto"
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	model "github.com/neko-dream/api/internal/infrastructure/persistence/sqlc/generated"
+	dto_mapper "github.com/neko-dream/api/internal/infrastructure/persistence/utils"
+	"github.com/neko-dream/api/pkg/utils"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel"
+)
+
+type getAnalysisSnapshotQuery struct {
+	*db.DBManager
+}
+
+func NewGetAnalysisSnapshotQuery(dbManager *db.DBManager) analysis_query.GetAnalysisSnapshotQuery {
+	return &getAnalysisSnapshotQuery{
+		DBManager: dbManager,
+	}
+}
+
+// Execute スナップショット時点のグループ分けと代表意見を返す
+func (q *getAnalysisSnapshotQuery) Execute(ctx context.Context, input analysis_query.GetAnalysisSnapshotInput) (*analysis_query.GetAnalysisSnapshotOutput, error) {
+	ctx, span := otel.Tracer("analysis_query").Start(ctx, "getAnalysisSnapshotQuery.Execute")
+	defer span.End()
+
+	snapshot, err := findAnalysisSnapshot(ctx, q.GetQueries(ctx), input.TalkSessionID, input.AnalysisSnapshotID)
+	if err != nil {
+		return nil, err
+	}
+
+	userRows, err := q.GetQueries(ctx).GetAnalysisSnapshotUsers(ctx, snapshot.AnalysisSnapshotID)
+	if err != nil {
+		utils.HandleError(ctx, err, "GetAnalysisSnapshotUsers")
+		return nil, messages.InternalServerError
+	}
+	positions := make([]dto.UserPosition, 0, len(userRows))
+	groupOpinionsMap := make(map[int][]dto.OpinionWithRepresentative)
+	for _, row := range userRows {
+		var position dto.UserPosition
+		err = errors.Join(err, copier.CopyWithOption(&position, row, copier.Option{
+			DeepCopy:    true,
+			IgnoreEmpty: true,
+		}))
+		position.GroupName = analysis.NewGroupIDFromInt(position.GroupID).String()
+		positions = append(positions, position)
+		groupOpinionsMap[position.GroupID] = make([]dto.OpinionWithRepresentative, 0)
+	}
+	if err != nil {
+		utils.HandleError(ctx, err, "copier.CopyWithOptionでエラー")
+		return nil, messages.InternalServerError
+	}
+
+	representativeRows, err := q.GetQueries(ctx).GetAnalysisSnapshotRepresentativeOpinions(ctx, snapshot.AnalysisSnapshotID)
+	if err != nil {
+		utils.HandleError(ctx, err, "GetAnalysisSnapshotRepresentativeOpinions")
+		return nil, messages.InternalServerError
+	}
+	representatives := make([]dto.OpinionWithRepresentative, 0, len(representativeRows))
+	for _, row := range representativeRows {
+		var res dto.OpinionWithRepresentative
+		if err := copier.CopyWithOption(&res, row, copier.Option{
+			DeepCopy:    true,
+			IgnoreEmpty: true,
+		}); err != nil {
+			utils.HandleError(ctx, err, "copier.CopyWithOptionでエラー")
+			return nil, messages.InternalServerError
+		}
+		res.RepresentativeOpinion = dto.RepresentativeOpinion{
+			OpinionID:     res.Opinion.OpinionID,
+			GroupID:       int(row.AnalysisSnapshotRepresentativeOpinion.GroupID),
+			AgreeCount:    int(row.AnalysisSnapshotRepresentativeOpinion.AgreeCount),
+			DisagreeCount: int(row.AnalysisSnapshotRepresentativeOpinion.DisagreeCount),
+			PassCount:     int(row.AnalysisSnapshotRepresentativeOpinion.PassCount),
+		}
+		representatives = append(representatives, res)
+	}
+
+	// 通報で削除された意見はスナップショットでも隠す
+	reports, err := q.GetQueries(ctx).FindReportByOpinionIDs(ctx, model.FindReportByOpinionIDsParams{
+		OpinionIds: dto_mapper.ExtractOpinionIDsWithRepresentative(representatives),
+		Status:     "deleted",
+	})
+	if err != nil {
+		utils.HandleError(ctx, err, "通報情報の取得に失敗")
+		return nil, messages.InternalServerError
+	}
+	representatives = dto_mapper.ProcessReportedOpinionsWithRepresentative(representatives, reports)
+	for _, row := range representatives {
+		groupOpinionsMap[row.RepresentativeOpinion.GroupID] = append(groupOpinionsMap[row.RepresentativeOpinion.GroupID], row)
+	}
+
+	groupIDs := lo.Keys(groupOpinionsMap)
+	slices.Sort(groupIDs)
+	groupOpinions := make([]dto.OpinionGroup, 0, len(groupIDs))
+	for _, groupID := range groupIDs {
+		groupOpinions = append(groupOpinions, dto.OpinionGroup{
+			GroupName: analysis.NewGroupIDFromInt(groupID).String(),
+			GroupID:   groupID,
+			Opinions:  groupOpinionsMap[groupID],
+		})
+	}
+
+	return &analysis_query.GetAnalysisSnapshotOutput{
+		Snapshot:      toAnalysisSnapshot(*snapshot),
+		Positions:     positions,
+		GroupOpinions: groupOpinions,
+	}, nil
+}
+
+// findAnalysisSnapshot トークセッションのスナップショットを取得する。見つからない場合はAnalysisSnapshotNotFoundを返す
+func findAnalysisSnapshot(ctx context.Context, q *model.Queries, talkSessionID shared.UUID[talksession.TalkSession], snapshotID shared.UUID[analysis.AnalysisSnapshot]) (*model.AnalysisSnapshot, error) {
+	snapshot, err := q.FindAnalysisSnapshotByID(ctx, model.FindAnalysisSnapshotByIDParams{
+		AnalysisSnapshotID: snapshotID.UUID(),
+		TalkSessionID:      talkSessionID.UUID(),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, messages.AnalysisSnapshotNotFound
+		}
+		utils.HandleError(ctx, err, "FindAnalysisSnapshotByID")
+		return nil, messages.InternalServerError
+	}
+	return &snapshot, nil
+}
@@ -0,0 +1,94 @@
+package analysis
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/application/query/analysis_query"
+	"github.com/neko-dream/api/internal/application/query/dto"
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	model "github.com/neko-dream/api/internal/infrastructure/persistence/sqlc/generated"
+	"github.com/neko-dream/api/pkg/utils"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel"
+)
+
+type getAnalysisSnapshotDiffQuery struct {
+	*db.DBManager
+}
+
+func NewGetAnalysisSnapshotDiffQuery(dbManager *db.DBManager) analysis_query.GetAnalysisSnapshotDiffQuery {
+	return &getAnalysisSnapshotDiffQuery{
+		DBManager: dbManager,
+	}
+}
+
+// Execute 2つのスナップショットを比べ、グループが変わったユーザーを返す
+func (q *getAnalysisSnapshotDiffQuery) Execute(ctx context.Context, input analysis_query.GetAnalysisSnapshotDiffInput) (*analysis_query.GetAnalysisSnapshotDiffOutput, error) {
+	ctx, span := otel.Tracer("analysis_query").Start(ctx, "getAnalysisSnapshotDiffQuery.Execute")
+	defer span.End()
+
+	from, err := findAnalysisSnapshot(ctx, q.GetQueries(ctx), input.TalkSessionID, input.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := findAnalysisSnapshot(ctx, q.GetQueries(ctx), input.TalkSessionID, input.To)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make(map[shared.UUID[user.User]]dto.User)
+	fromGroups, err := q.findGroupAssignments(ctx, *from, users)
+	if err != nil {
+		return nil, err
+	}
+	toGroups, err := q.findGroupAssignments(ctx, *to, users)
+	if err != nil {
+		return nil, err
+	}
+
+	groupMoves := analysis.DiffGroupAssignments(fromGroups, toGroups)
+	moves := make([]dto.GroupMove, 0, len(groupMoves))
+	for _, move := range groupMoves {
+		res := dto.GroupMove{
+			User: users[move.UserID],
+		}
+		if move.FromGroupID != nil {
+			res.FromGroupID = lo.ToPtr(int(*move.FromGroupID))
+		}
+		if move.ToGroupID != nil {
+			res.ToGroupID = lo.ToPtr(int(*move.ToGroupID))
+		}
+		moves = append(moves, res)
+	}
+
+	return &analysis_query.GetAnalysisSnapshotDiffOutput{
+		From:  toAnalysisSnapshot(*from),
+		To:    toAnalysisSnapshot(*to),
+		Moves: moves,
+	}, nil
+}
+
+// findGroupAssignments スナップショット時点のユーザーごとのグループを返す。ユーザーの表示情報はusersに追加する
+func (q *getAnalysisSnapshotDiffQuery) findGroupAssignments(ctx context.Context, snapshot model.AnalysisSnapshot, users map[shared.UUID[user.User]]dto.User) (map[shared.UUID[user.User]]analysis.GroupID, error) {
+	rows, err := q.GetQueries(ctx).GetAnalysisSnapshotUsers(ctx, snapshot.AnalysisSnapshotID)
+	if err != nil {
+		utils.HandleError(ctx, err, "GetAnalysisSnapshotUsers")
+		return nil, messages.InternalServerError
+	}
+
+	groups := make(map[shared.UUID[user.User]]analysis.GroupID, len(rows))
+	for _, row := range rows {
+		userID := shared.UUID[user.User](row.UserID)
+		groups[userID] = analysis.NewGroupIDFromInt(int(row.GroupID))
+		users[userID] = dto.User{
+			DisplayID:   row.DisplayID.String,
+			DisplayName: row.DisplayName.String,
+			IconURL:     utils.ToPtrIf(row.IconUrl.Valid, row.IconUrl.String),
+		}
+	}
+	return groups, nil
+}
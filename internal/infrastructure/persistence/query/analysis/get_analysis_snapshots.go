@@ -0,0 +1,53 @@
+package analysis
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/application/query/analysis_query"
+	"github.com/neko-dream/api/internal/application/query/dto"
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	model "github.com/neko-dream/api/internal/infrastructure/persistence/sqlc/generated"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type getAnalysisSnapshotsQuery struct {
+	*db.DBManager
+}
+
+func NewGetAnalysisSnapshotsQuery(dbManager *db.DBManager) analysis_query.GetAnalysisSnapshotsQuery {
+	return &getAnalysisSnapshotsQuery{
+		DBManager: dbManager,
+	}
+}
+
+// Execute トークセッションの分析結果のスナップショットを古い順に返す
+func (q *getAnalysisSnapshotsQuery) Execute(ctx context.Context, input analysis_query.GetAnalysisSnapshotsInput) (*analysis_query.GetAnalysisSnapshotsOutput, error) {
+	ctx, span := otel.Tracer("analysis_query").Start(ctx, "getAnalysisSnapshotsQuery.Execute")
+	defer span.End()
+
+	rows, err := q.GetQueries(ctx).GetAnalysisSnapshotsByTalkSessionID(ctx, input.TalkSessionID.UUID())
+	if err != nil {
+		utils.HandleError(ctx, err, "GetAnalysisSnapshotsByTalkSessionID")
+		return nil, messages.InternalServerError
+	}
+
+	snapshots := make([]dto.AnalysisSnapshot, 0, len(rows))
+	for _, row := range rows {
+		snapshots = append(snapshots, toAnalysisSnapshot(row))
+	}
+
+	return &analysis_query.GetAnalysisSnapshotsOutput{
+		Snapshots: snapshots,
+	}, nil
+}
+
+func toAnalysisSnapshot(row model.AnalysisSnapshot) dto.AnalysisSnapshot {
+	return dto.AnalysisSnapshot{
+		AnalysisSnapshotID: row.AnalysisSnapshotID.String(),
+		UserCount:          int(row.UserCount),
+		GroupCount:         int(row.GroupCount),
+		CreatedAt:          row.CreatedAt,
+	}
+}
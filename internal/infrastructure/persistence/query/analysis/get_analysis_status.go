@@ -0,0 +1,67 @@
+package analysis
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/application/query/analysis_query"
+	"github.com/neko-dream/api/internal/application/query/dto"
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type getAnalysisStatusQuery struct {
+	analysis.AnalysisScheduleRepository
+	policy analysis.RecomputePolicy
+}
+
+func NewGetAnalysisStatusQuery(
+	scheduleRepository analysis.AnalysisScheduleRepository,
+	policy analysis.RecomputePolicy,
+) analysis_query.GetAnalysisStatusQuery {
+	return &getAnalysisStatusQuery{
+		AnalysisScheduleRepository: scheduleRepository,
+		policy:                     policy,
+	}
+}
+
+// Execute セッションの分析の再計算の状況を返す。投票・意見がまだない場合はidle
+func (q *getAnalysisStatusQuery) Execute(ctx context.Context, input analysis_query.GetAnalysisStatusInput) (*analysis_query.GetAnalysisStatusOutput, error) {
+	ctx, span := otel.Tracer("analysis_query").Start(ctx, "getAnalysisStatusQuery.Execute")
+	defer span.End()
+
+	schedule, err := q.AnalysisScheduleRepository.FindByTalkSessionID(ctx, input.TalkSessionID)
+	if err != nil {
+		utils.HandleError(ctx, err, "AnalysisScheduleRepository.FindByTalkSessionID")
+		return nil, messages.InternalServerError
+	}
+	if schedule == nil {
+		return &analysis_query.GetAnalysisStatusOutput{
+			Status: dto.AnalysisStatus{
+				Status: analysis.RecomputeStatusIdle,
+			},
+		}, nil
+	}
+
+	policy := q.policy
+	status := schedule.Status(clock.Now(ctx), policy)
+	out := dto.AnalysisStatus{
+		Status:              status,
+		PendingVoteCount:    schedule.PendingVoteCount,
+		PendingOpinionCount: schedule.PendingOpinionCount,
+		PendingSince:        schedule.PendingSince,
+		NextRunAt:           schedule.NextRunAt(policy),
+		LastCompletedAt:     schedule.LastCompletedAt,
+		LastFailedAt:        schedule.LastFailedAt,
+	}
+	// 止まったとみなした分析の開始日時は返さない
+	if status == analysis.RecomputeStatusRunning {
+		out.RunningSince = schedule.RunningSince
+	}
+
+	return &analysis_query.GetAnalysisStatusOutput{
+		Status: out,
+	}, nil
+}
@@ -0,0 +1,152 @@
+package analysis
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/jinzhu/copier"
+	"github.com/neko-dream/api/internal/application/query/analysis_query"
+	"github.com/neko-dream/api/internal/application/query/dto"
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	"github.com/neko-dream/api/pkg/utils"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel"
+)
+
+// consensusOpinionsLimit 合意・対立それぞれで返す意見の数
+const consensusOpinionsLimit = 10
+
+type getConsensusQuery struct {
+	*db.DBManager
+}
+
+func NewGetConsensusQuery(dbManager *db.DBManager) analysis_query.GetConsensusQuery {
+	return &getConsensusQuery{
+		DBManager: dbManager,
+	}
+}
+
+type scoredOpinion struct {
+	opinionID uuid.UUID
+	analysis.OpinionConsensus
+}
+
+// Execute グループごとの賛成確率から、全てのグループが賛成している意見と賛否が分かれている意見を返す
+func (q *getConsensusQuery) Execute(ctx context.Context, input analysis_query.GetConsensusInput) (*analysis_query.GetConsensusOutput, error) {
+	ctx, span := otel.Tracer("analysis_query").Start(ctx, "getConsensusQuery.Execute")
+	defer span.End()
+
+	groupRows, err := q.GetQueries(ctx).GetGroupListByTalkSessionId(ctx, input.TalkSessionID.UUID())
+	if err != nil {
+		utils.HandleError(ctx, err, "GetGroupListByTalkSessionId")
+		return nil, messages.InternalServerError
+	}
+	groupIDs := lo.Map(groupRows, func(groupID int32, _ int) analysis.GroupID {
+		return analysis.NewGroupIDFromInt(int(groupID))
+	})
+
+	countRows, err := q.GetQueries(ctx).GetGroupVoteCountsByTalkSessionID(ctx, input.TalkSessionID.UUID())
+	if err != nil {
+		utils.HandleError(ctx, err, "GetGroupVoteCountsByTalkSessionID")
+		return nil, messages.InternalServerError
+	}
+	countsByOpinion := make(map[uuid.UUID][]analysis.GroupVoteCount)
+	for _, row := range countRows {
+		countsByOpinion[row.OpinionID] = append(countsByOpinion[row.OpinionID], analysis.GroupVoteCount{
+			GroupID:       analysis.NewGroupIDFromInt(int(row.GroupID)),
+			AgreeCount:    int(row.AgreeCount),
+			DisagreeCount: int(row.DisagreeCount),
+			PassCount:     int(row.PassCount),
+		})
+	}
+
+	scored := make([]scoredOpinion, 0, len(countsByOpinion))
+	for opinionID, counts := range countsByOpinion {
+		consensus := analysis.NewOpinionConsensus(groupIDs, counts)
+		if consensus.TotalCount() < analysis.MinVotesForConsensus {
+			continue
+		}
+		scored = append(scored, scoredOpinion{
+			opinionID:        opinionID,
+			OpinionConsensus: consensus,
+		})
+	}
+
+	consensus := topOpinions(scored, func(o scoredOpinion) float64 { return o.Consensus })
+	divisive := make([]scoredOpinion, 0)
+	if len(groupIDs) > 1 {
+		divisive = topOpinions(scored, func(o scoredOpinion) float64 { return o.Divisiveness })
+	}
+
+	opinionIDs := lo.Uniq(lo.Map(append(slices.Clone(consensus), divisive...), func(o scoredOpinion, _ int) uuid.UUID {
+		return o.opinionID
+	}))
+	opinions := make(map[uuid.UUID]dto.OpinionConsensus, len(opinionIDs))
+	if len(opinionIDs) > 0 {
+		rows, err := q.GetQueries(ctx).FindOpinionsByOpinionIDs(ctx, opinionIDs)
+		if err != nil {
+			utils.HandleError(ctx, err, "FindOpinionsByOpinionIDs")
+			return nil, messages.InternalServerError
+		}
+		for _, row := range rows {
+			var res dto.OpinionConsensus
+			if err := copier.CopyWithOption(&res, row, copier.Option{
+				DeepCopy:    true,
+				IgnoreEmpty: true,
+			}); err != nil {
+				utils.HandleError(ctx, err, "copier.CopyWithOptionでエラー")
+				return nil, messages.InternalServerError
+			}
+			opinions[row.Opinion.OpinionID] = res
+		}
+	}
+
+	return &analysis_query.GetConsensusOutput{
+		Consensus: toOpinionConsensus(consensus, opinions),
+		Divisive:  toOpinionConsensus(divisive, opinions),
+	}, nil
+}
+
+// topOpinions スコアが高い順に上位の意見を返す。同じスコアの場合は投票が多い意見を優先する
+func topOpinions(opinions []scoredOpinion, score func(scoredOpinion) float64) []scoredOpinion {
+	sorted := slices.Clone(opinions)
+	slices.SortFunc(sorted, func(a, b scoredOpinion) int {
+		if c := cmp.Compare(score(b), score(a)); c != 0 {
+			return c
+		}
+		if c := cmp.Compare(b.TotalCount(), a.TotalCount()); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.opinionID.String(), b.opinionID.String())
+	})
+	return sorted[:min(len(sorted), consensusOpinionsLimit)]
+}
+
+func toOpinionConsensus(scored []scoredOpinion, opinions map[uuid.UUID]dto.OpinionConsensus) []dto.OpinionConsensus {
+	res := make([]dto.OpinionConsensus, 0, len(scored))
+	for _, s := range scored {
+		op, ok := opinions[s.opinionID]
+		if !ok {
+			continue
+		}
+		op.Consensus = s.Consensus
+		op.Divisiveness = s.Divisiveness
+		op.Groups = make([]dto.GroupAgreement, 0, len(s.Groups))
+		for _, group := range s.Groups {
+			op.Groups = append(op.Groups, dto.GroupAgreement{
+				GroupName:        group.GroupID.String(),
+				GroupID:          int(group.GroupID),
+				AgreeCount:       group.AgreeCount,
+				DisagreeCount:    group.DisagreeCount,
+				PassCount:        group.PassCount,
+				AgreeProbability: group.AgreeProbability(),
+			})
+		}
+		res = append(res, op)
+	}
+	return res
+}
@@ -0,0 +1,252 @@
+package analysis
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/jinzhu/copier"
+	"github.com/neko-dream/api/internal/application/query/analysis_query"
+	"github.com/neko-dream/api/internal/application/query/dto"
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/vote"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	model "github.com/neko-dream/api/internal/infrastructure/persistence/sqlc/generated"
+	"github.com/neko-dream/api/pkg/utils"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel"
+)
+
+type getMyPositionQuery struct {
+	*db.DBManager
+}
+
+func NewGetMyPositionQuery(dbManager *db.DBManager) analysis_query.GetMyPositionQuery {
+	return &getMyPositionQuery{
+		DBManager: dbManager,
+	}
+}
+
+type (
+	alignedOpinion struct {
+		opinionID uuid.UUID
+		analysis.OpinionAlignment
+	}
+
+	suggestedOpinion struct {
+		opinionID uuid.UUID
+		group     analysis.GroupVoteCount
+	}
+)
+
+// Execute 自分のグループと各グループの中心までの距離、グループとの投票の一致・不一致、同じグループのメンバーが賛成している未投票の意見を返す
+func (q *getMyPositionQuery) Execute(ctx context.Context, input analysis_query.GetMyPositionInput) (*analysis_query.GetMyPositionOutput, error) {
+	ctx, span := otel.Tracer("analysis_query").Start(ctx, "getMyPositionQuery.Execute")
+	defer span.End()
+
+	groupInfoRows, err := q.GetQueries(ctx).GetGroupInfoByTalkSessionId(ctx, input.TalkSessionID.UUID())
+	if err != nil {
+		utils.HandleError(ctx, err, "GetGroupInfoByTalkSessionId")
+		return nil, messages.InternalServerError
+	}
+	positions := make([]analysis.MemberPosition, 0, len(groupInfoRows))
+	var me *model.GetGroupInfoByTalkSessionIdRow
+	for _, row := range groupInfoRows {
+		positions = append(positions, analysis.MemberPosition{
+			GroupID: analysis.NewGroupIDFromInt(int(row.GroupID)),
+			PosX:    row.PosX,
+			PosY:    row.PosY,
+		})
+		if row.UserID == input.UserID.UUID() {
+			me = &row
+		}
+	}
+	if me == nil {
+		return nil, messages.MyPositionNotFound
+	}
+	myGroupID := analysis.NewGroupIDFromInt(int(me.GroupID))
+
+	var position dto.UserPosition
+	if err := copier.CopyWithOption(&position, me, copier.Option{
+		DeepCopy:    true,
+		IgnoreEmpty: true,
+	}); err != nil {
+		utils.HandleError(ctx, err, "copier.CopyWithOptionでエラー")
+		return nil, messages.InternalServerError
+	}
+	position.GroupName = myGroupID.String()
+
+	distances := make([]dto.GroupDistance, 0)
+	for _, d := range analysis.DistancesFrom(analysis.MemberPosition{
+		GroupID: myGroupID,
+		PosX:    me.PosX,
+		PosY:    me.PosY,
+	}, analysis.NewGroupCentroids(positions)) {
+		distances = append(distances, dto.GroupDistance{
+			GroupName:   d.GroupID.String(),
+			GroupID:     int(d.GroupID),
+			MemberCount: d.MemberCount,
+			CentroidX:   d.PosX,
+			CentroidY:   d.PosY,
+			Distance:    d.Distance,
+		})
+	}
+
+	voteRows, err := q.GetQueries(ctx).GetUserVotesByTalkSessionID(ctx, model.GetUserVotesByTalkSessionIDParams{
+		TalkSessionID: input.TalkSessionID.UUID(),
+		UserID:        input.UserID.UUID(),
+	})
+	if err != nil {
+		utils.HandleError(ctx, err, "GetUserVotesByTalkSessionID")
+		return nil, messages.InternalServerError
+	}
+	myVotes := make(map[uuid.UUID]vote.VoteType, len(voteRows))
+	for _, row := range voteRows {
+		myVotes[row.OpinionID] = vote.VoteType(row.VoteType)
+	}
+
+	countRows, err := q.GetQueries(ctx).GetGroupVoteCountsByTalkSessionID(ctx, input.TalkSessionID.UUID())
+	if err != nil {
+		utils.HandleError(ctx, err, "GetGroupVoteCountsByTalkSessionID")
+		return nil, messages.InternalServerError
+	}
+	aligned := make([]alignedOpinion, 0)
+	suggested := make([]suggestedOpinion, 0)
+	for _, row := range countRows {
+		if analysis.NewGroupIDFromInt(int(row.GroupID)) != myGroupID {
+			continue
+		}
+		count := analysis.GroupVoteCount{
+			GroupID:       myGroupID,
+			AgreeCount:    int(row.AgreeCount),
+			DisagreeCount: int(row.DisagreeCount),
+			PassCount:     int(row.PassCount),
+		}
+
+		myVote, voted := myVotes[row.OpinionID]
+		if !voted {
+			if count.TotalCount() >= analysis.MinVotesForConsensus {
+				suggested = append(suggested, suggestedOpinion{opinionID: row.OpinionID, group: count})
+			}
+			continue
+		}
+		alignment, ok := analysis.NewOpinionAlignment(myVote, count)
+		// 自分以外のメンバーが投票していない意見は比べられない
+		if !ok || alignment.Group.TotalCount() == 0 {
+			continue
+		}
+		aligned = append(aligned, alignedOpinion{opinionID: row.OpinionID, OpinionAlignment: alignment})
+	}
+
+	// 一致している意見と食い違っている意見が重ならないよう、一致度が0.5を超えるかで分ける
+	agree := lo.Filter(aligned, func(o alignedOpinion, _ int) bool { return o.Alignment > 0.5 })
+	disagree := lo.Filter(aligned, func(o alignedOpinion, _ int) bool { return o.Alignment < 0.5 })
+	slices.SortFunc(agree, func(a, b alignedOpinion) int {
+		if c := cmp.Compare(b.Alignment, a.Alignment); c != 0 {
+			return c
+		}
+		return compareAligned(a, b)
+	})
+	slices.SortFunc(disagree, func(a, b alignedOpinion) int {
+		if c := cmp.Compare(a.Alignment, b.Alignment); c != 0 {
+			return c
+		}
+		return compareAligned(a, b)
+	})
+	agree = agree[:min(len(agree), analysis.MyPositionOpinionLimit)]
+	disagree = disagree[:min(len(disagree), analysis.MyPositionOpinionLimit)]
+
+	slices.SortFunc(suggested, func(a, b suggestedOpinion) int {
+		if c := cmp.Compare(b.group.AgreeProbability(), a.group.AgreeProbability()); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.opinionID.String(), b.opinionID.String())
+	})
+	// 多くのメンバーが反対している意見は勧めない
+	suggested = slices.DeleteFunc(suggested, func(o suggestedOpinion) bool { return o.group.AgreeProbability() <= 0.5 })
+	suggested = suggested[:min(len(suggested), analysis.MyPositionOpinionLimit)]
+
+	opinionIDs := lo.Uniq(slices.Concat(
+		lo.Map(agree, func(o alignedOpinion, _ int) uuid.UUID { return o.opinionID }),
+		lo.Map(disagree, func(o alignedOpinion, _ int) uuid.UUID { return o.opinionID }),
+		lo.Map(suggested, func(o suggestedOpinion, _ int) uuid.UUID { return o.opinionID }),
+	))
+	opinions := make(map[uuid.UUID]dto.SwipeOpinion, len(opinionIDs))
+	if len(opinionIDs) > 0 {
+		rows, err := q.GetQueries(ctx).FindOpinionsByOpinionIDs(ctx, opinionIDs)
+		if err != nil {
+			utils.HandleError(ctx, err, "FindOpinionsByOpinionIDs")
+			return nil, messages.InternalServerError
+		}
+		for _, row := range rows {
+			var res dto.SwipeOpinion
+			if err := copier.CopyWithOption(&res, row, copier.Option{
+				DeepCopy:    true,
+				IgnoreEmpty: true,
+			}); err != nil {
+				utils.HandleError(ctx, err, "copier.CopyWithOptionでエラー")
+				return nil, messages.InternalServerError
+			}
+			opinions[row.Opinion.OpinionID] = res
+		}
+	}
+
+	out := &analysis_query.GetMyPositionOutput{
+		Position:          position,
+		Distances:         distances,
+		AgreeWithGroup:    toOpinionAlignments(agree, opinions),
+		DisagreeWithGroup: toOpinionAlignments(disagree, opinions),
+		Suggestions:       make([]dto.OpinionSuggestion, 0, len(suggested)),
+	}
+	for _, s := range suggested {
+		op, ok := opinions[s.opinionID]
+		if !ok {
+			continue
+		}
+		out.Suggestions = append(out.Suggestions, dto.OpinionSuggestion{
+			Opinion: op.Opinion,
+			User:    op.User,
+			Group:   toGroupAgreement(s.group),
+		})
+	}
+	return out, nil
+}
+
+// compareAligned 一致度が同じ場合は、グループの投票が多い意見を優先する
+func compareAligned(a, b alignedOpinion) int {
+	if c := cmp.Compare(b.Group.TotalCount(), a.Group.TotalCount()); c != 0 {
+		return c
+	}
+	return cmp.Compare(a.opinionID.String(), b.opinionID.String())
+}
+
+func toOpinionAlignments(aligned []alignedOpinion, opinions map[uuid.UUID]dto.SwipeOpinion) []dto.OpinionAlignment {
+	res := make([]dto.OpinionAlignment, 0, len(aligned))
+	for _, a := range aligned {
+		op, ok := opinions[a.opinionID]
+		if !ok {
+			continue
+		}
+		res = append(res, dto.OpinionAlignment{
+			Opinion:    op.Opinion,
+			User:       op.User,
+			MyVoteType: a.MyVote.String(),
+			Group:      toGroupAgreement(a.Group),
+			Alignment:  a.Alignment,
+		})
+	}
+	return res
+}
+
+func toGroupAgreement(count analysis.GroupVoteCount) dto.GroupAgreement {
+	return dto.GroupAgreement{
+		GroupName:        count.GroupID.String(),
+		GroupID:          int(count.GroupID),
+		AgreeCount:       count.AgreeCount,
+		DisagreeCount:    count.DisagreeCount,
+		PassCount:        count.PassCount,
+		AgreeProbability: count.AgreeProbability(),
+	}
+}
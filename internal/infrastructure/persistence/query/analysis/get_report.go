@@ -3,32 +3,37 @@ package analysis
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
+	"slices"
 
 	"github.com/neko-dream/api/internal/application/query/analysis_query"
+	"github.com/neko-dream/api/internal/application/query/dto"
 	"github.com/neko-dream/api/internal/domain/messages"
 	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/shared"
 	"github.com/neko-dream/api/internal/domain/model/talksession"
 	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
 	model "github.com/neko-dream/api/internal/infrastructure/persistence/sqlc/generated"
 	"github.com/neko-dream/api/pkg/utils"
+	"github.com/samber/lo"
 	"go.opentelemetry.io/otel"
 )
 
 type GetReportQueryHandler struct {
 	*db.DBManager
-	analysis.AnalysisService
+	analysis.AnalysisJobService
 	talksession.TalkSessionRepository
 }
 
 func NewGetReportQueryHandler(
 	tm *db.DBManager,
-	as analysis.AnalysisService,
+	jobService analysis.AnalysisJobService,
 	talkSessionRep talksession.TalkSessionRepository,
 ) analysis_query.GetReportQuery {
 	return &GetReportQueryHandler{
 		DBManager:             tm,
-		AnalysisService:       as,
+		AnalysisJobService:    jobService,
 		TalkSessionRepository: talkSessionRep,
 	}
 }
@@ -68,19 +73,66 @@ func (h *GetReportQueryHandler) Execute(ctx context.Context, input analysis_quer
 		}, nil
 	}
 
+	importantOpinions, err := h.groupImportantOpinions(ctx, input.TalkSessionID)
+	if err != nil {
+		utils.HandleError(ctx, err, "重要な意見の取得に失敗しました")
+		return nil, messages.InternalServerError
+	}
+
 	out, err := h.GetQueries(ctx).GetReportByTalkSessionId(ctx, input.TalkSessionID.UUID())
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			if err := h.AnalysisService.GenerateReport(ctx, input.TalkSessionID); err != nil {
-				utils.HandleError(ctx, err, "レポートの生成に失敗しました")
+			// 生成には時間がかかるので、ジョブとして登録して次の取得で返す
+			if _, err := h.AnalysisJobService.Enqueue(ctx, input.TalkSessionID, analysis.AnalysisJobTypeReport); err != nil {
+				utils.HandleError(ctx, err, "レポートの生成の登録に失敗しました")
 			}
 		}
 		return &analysis_query.GetReportOutput{
-			Report: &out.Report,
+			Report:            &out.Report,
+			ImportantOpinions: importantOpinions,
 		}, nil
 	}
 
+	var reportID *shared.UUID[analysis.AnalysisReport]
+	if out.ReportVersionID.Valid {
+		id := shared.UUID[analysis.AnalysisReport](out.ReportVersionID.UUID)
+		reportID = &id
+	}
+
+	var sections *analysis.ReportSections
+	if out.Sections.Valid {
+		sections = &analysis.ReportSections{}
+		if err := json.Unmarshal(out.Sections.RawMessage, sections); err != nil {
+			// 本文は表示できるので構造化された内容だけ諦める
+			utils.HandleError(ctx, err, "レポートの構造化された内容の読み取りに失敗しました")
+			sections = nil
+		}
+	}
+
 	return &analysis_query.GetReportOutput{
-		Report: &out.Report,
+		Report:            &out.Report,
+		ReportID:          reportID,
+		Sections:          sections,
+		ImportantOpinions: importantOpinions,
 	}, nil
 }
+
+// groupImportantOpinions 重要だと印を付けられた意見をグループID順に並べる
+func (h *GetReportQueryHandler) groupImportantOpinions(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession]) ([]dto.GroupImportantOpinions, error) {
+	importantOpinions, err := findImportantOpinions(ctx, h.GetQueries(ctx), talkSessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	groupIDs := lo.Keys(importantOpinions)
+	slices.Sort(groupIDs)
+	groups := make([]dto.GroupImportantOpinions, 0, len(groupIDs))
+	for _, groupID := range groupIDs {
+		groups = append(groups, dto.GroupImportantOpinions{
+			GroupName: analysis.NewGroupIDFromInt(groupID).String(),
+			GroupID:   groupID,
+			Opinions:  importantOpinions[groupID],
+		})
+	}
+	return groups, nil
+}
@@ -0,0 +1,72 @@
+package analysis
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/application/query/analysis_query"
+	"github.com/neko-dream/api/internal/application/query/dto"
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	"github.com/neko-dream/api/pkg/utils"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel"
+)
+
+type getReportVersionDiffQuery struct {
+	*db.DBManager
+	analysis.ReportVersionRepository
+}
+
+func NewGetReportVersionDiffQuery(
+	dbManager *db.DBManager,
+	reportVersionRepository analysis.ReportVersionRepository,
+) analysis_query.GetReportVersionDiffQuery {
+	return &getReportVersionDiffQuery{
+		DBManager:               dbManager,
+		ReportVersionRepository: reportVersionRepository,
+	}
+}
+
+// Execute 2つのバージョンのレポートを行単位で比べる
+func (q *getReportVersionDiffQuery) Execute(ctx context.Context, input analysis_query.GetReportVersionDiffInput) (*analysis_query.GetReportVersionDiffOutput, error) {
+	ctx, span := otel.Tracer("analysis_query").Start(ctx, "getReportVersionDiffQuery.Execute")
+	defer span.End()
+
+	from, err := q.findVersion(ctx, input, input.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := q.findVersion(ctx, input, input.To)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := findReportVersions(ctx, q.GetQueries(ctx), input.TalkSessionID)
+	if err != nil {
+		return nil, err
+	}
+	summaries := lo.KeyBy(versions, func(v dto.ReportVersion) string {
+		return v.VersionID
+	})
+
+	return &analysis_query.GetReportVersionDiffOutput{
+		From:  summaries[from.ReportVersionID.String()],
+		To:    summaries[to.ReportVersionID.String()],
+		Lines: analysis.DiffReports(from.Report, to.Report),
+	}, nil
+}
+
+// findVersion 別のセッションのバージョンは見つからないものとして扱う
+func (q *getReportVersionDiffQuery) findVersion(ctx context.Context, input analysis_query.GetReportVersionDiffInput, versionID shared.UUID[analysis.ReportVersion]) (*analysis.ReportVersion, error) {
+	version, err := q.ReportVersionRepository.FindByID(ctx, versionID)
+	if err != nil {
+		utils.HandleError(ctx, err, "ReportVersionRepository.FindByID")
+		return nil, messages.InternalServerError
+	}
+	if version == nil || version.TalkSessionID != input.TalkSessionID {
+		return nil, messages.ReportVersionNotFound
+	}
+	return version, nil
+}
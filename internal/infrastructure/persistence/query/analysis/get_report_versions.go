@@ -0,0 +1,64 @@
+package analysis
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/application/query/analysis_query"
+	"github.com/neko-dream/api/internal/application/query/dto"
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	model "github.com/neko-dream/api/internal/infrastructure/persistence/sqlc/generated"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type getReportVersionsQuery struct {
+	*db.DBManager
+}
+
+func NewGetReportVersionsQuery(dbManager *db.DBManager) analysis_query.GetReportVersionsQuery {
+	return &getReportVersionsQuery{
+		DBManager: dbManager,
+	}
+}
+
+// Execute セッションのレポートのバージョンを新しい順に返す
+func (q *getReportVersionsQuery) Execute(ctx context.Context, input analysis_query.GetReportVersionsInput) (*analysis_query.GetReportVersionsOutput, error) {
+	ctx, span := otel.Tracer("analysis_query").Start(ctx, "getReportVersionsQuery.Execute")
+	defer span.End()
+
+	versions, err := findReportVersions(ctx, q.GetQueries(ctx), input.TalkSessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &analysis_query.GetReportVersionsOutput{
+		Versions: versions,
+	}, nil
+}
+
+func findReportVersions(ctx context.Context, queries *model.Queries, talkSessionID shared.UUID[talksession.TalkSession]) ([]dto.ReportVersion, error) {
+	rows, err := queries.GetReportVersionsWithFeedback(ctx, talkSessionID.UUID())
+	if err != nil {
+		utils.HandleError(ctx, err, "GetReportVersionsWithFeedback")
+		return nil, messages.InternalServerError
+	}
+
+	versions := make([]dto.ReportVersion, 0, len(rows))
+	for _, row := range rows {
+		versions = append(versions, dto.ReportVersion{
+			VersionID: row.TalkSessionReportHistoryID.String(),
+			Version:   int(row.Version),
+			Generator: row.Generator,
+			Model:     utils.ToPtrIf(row.Model.Valid, row.Model.String),
+			CreatedAt: row.CreatedAt,
+			GoodCount: int(row.GoodCount),
+			BadCount:  int(row.BadCount),
+			Published: row.Published,
+			Pinned:    row.Pinned,
+		})
+	}
+	return versions, nil
+}
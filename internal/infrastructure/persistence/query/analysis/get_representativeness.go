@@ -0,0 +1,73 @@
+package analysis
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/application/query/analysis_query"
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type getRepresentativenessQuery struct {
+	talkSessionRepository  talksession.TalkSessionRepository
+	distributionRepository analysis.ReferenceDistributionRepository
+	participantRepository  analysis.ParticipantDemographicRepository
+}
+
+func NewGetRepresentativenessQuery(
+	talkSessionRepository talksession.TalkSessionRepository,
+	distributionRepository analysis.ReferenceDistributionRepository,
+	participantRepository analysis.ParticipantDemographicRepository,
+) analysis_query.GetRepresentativenessQuery {
+	return &getRepresentativenessQuery{
+		talkSessionRepository:  talkSessionRepository,
+		distributionRepository: distributionRepository,
+		participantRepository:  participantRepository,
+	}
+}
+
+// Execute 組織のセッションの参加者の構成を、組織が登録した母集団の構成と属性ごとに比べる
+func (q *getRepresentativenessQuery) Execute(ctx context.Context, input analysis_query.GetRepresentativenessInput) (*analysis_query.GetRepresentativenessOutput, error) {
+	ctx, span := otel.Tracer("analysis_query").Start(ctx, "getRepresentativenessQuery.Execute")
+	defer span.End()
+
+	talkSession, err := q.talkSessionRepository.FindByID(ctx, input.TalkSessionID)
+	if err != nil {
+		utils.HandleError(ctx, err, "TalkSessionRepository.FindByID")
+		return nil, messages.TalkSessionNotFound
+	}
+	// 他の組織のセッションの参加者の属性は見せない
+	if talkSession.OrganizationID() == nil || *talkSession.OrganizationID() != input.OrganizationID {
+		return nil, messages.TalkSessionNotFound
+	}
+
+	distributions, err := q.distributionRepository.FindByOrganizationID(ctx, input.OrganizationID)
+	if err != nil {
+		utils.HandleError(ctx, err, "ReferenceDistributionRepository.FindByOrganizationID")
+		return nil, messages.InternalServerError
+	}
+	if len(distributions) == 0 {
+		return nil, messages.ReferenceDistributionNotFound
+	}
+
+	demographics, err := q.participantRepository.FindByTalkSessionID(ctx, input.TalkSessionID)
+	if err != nil {
+		utils.HandleError(ctx, err, "ParticipantDemographicRepository.FindByTalkSessionID")
+		return nil, messages.InternalServerError
+	}
+
+	out := &analysis_query.GetRepresentativenessOutput{
+		Dimensions: make([]analysis.Representativeness, 0, len(distributions)),
+	}
+	for _, distribution := range distributions {
+		participants := make([]string, 0, len(demographics))
+		for _, demographic := range demographics {
+			participants = append(participants, analysis.DemographicCategory(ctx, demographic, distribution.Dimension))
+		}
+		out.Dimensions = append(out.Dimensions, analysis.NewRepresentativeness(distribution, participants))
+	}
+	return out, nil
+}
@@ -0,0 +1,55 @@
+package analysis
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"github.com/neko-dream/api/internal/application/query/analysis_query"
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type getTermFrequenciesQuery struct {
+	*db.DBManager
+}
+
+func NewGetTermFrequenciesQuery(dbManager *db.DBManager) analysis_query.GetTermFrequenciesQuery {
+	return &getTermFrequenciesQuery{
+		DBManager: dbManager,
+	}
+}
+
+// Execute アプリケーション内で生成したワードクラウドの単語の集計を返す。分析APIで生成した場合は見つからないものとして扱う
+func (q *getTermFrequenciesQuery) Execute(ctx context.Context, input analysis_query.GetTermFrequenciesInput) (*analysis_query.GetTermFrequenciesOutput, error) {
+	ctx, span := otel.Tracer("analysis_query").Start(ctx, "getTermFrequenciesQuery.Execute")
+	defer span.End()
+
+	row, err := q.GetQueries(ctx).GetTermFrequencies(ctx, input.TalkSessionID.UUID())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, messages.TermFrequenciesNotFound
+		}
+		utils.HandleError(ctx, err, "GetTermFrequencies")
+		return nil, messages.InternalServerError
+	}
+
+	var tf analysis.TermFrequencies
+	if err := json.Unmarshal(row.TermFrequencies.RawMessage, &tf); err != nil {
+		utils.HandleError(ctx, err, "json.Unmarshal")
+		return nil, messages.InternalServerError
+	}
+	tf.TalkSessionID = shared.UUID[talksession.TalkSession](row.TalkSessionID)
+	tf.WordCloudURL = row.WordmapUrl
+	tf.GeneratedAt = row.UpdatedAt
+
+	return &analysis_query.GetTermFrequenciesOutput{
+		TermFrequencies: tf,
+	}, nil
+}
@@ -0,0 +1,223 @@
+package analysis
+
+import (
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jinzhu/copier"
+	"github.com/neko-dream/api/internal/application/query/analysis_query"
+	"github.com/neko-dream/api/internal/application/query/dto"
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	model "github.com/neko-dream/api/internal/infrastructure/persistence/sqlc/generated"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+// topicCacheTTL トピックの分け方を使い回す期間の上限。使われなくなったセッションの分をメモリから消すために使う
+const topicCacheTTL = time.Hour
+
+type getTopicsQuery struct {
+	tokenizer analysis.Tokenizer
+	*db.DBManager
+
+	mu         sync.Mutex
+	topicCache map[shared.UUID[talksession.TalkSession]]topicCacheEntry
+}
+
+// topicCacheEntry 形態素解析とk-meansの結果。分析結果と意見の本文が変わらない間は使い回す
+type topicCacheEntry struct {
+	fingerprint string
+	digest      [sha256.Size]byte
+	topics      []analysis.Topic
+	expiresAt   time.Time
+}
+
+func NewGetTopicsQuery(tokenizer analysis.Tokenizer, dbManager *db.DBManager) analysis_query.GetTopicsQuery {
+	return &getTopicsQuery{
+		tokenizer:  tokenizer,
+		DBManager:  dbManager,
+		topicCache: make(map[shared.UUID[talksession.TalkSession]]topicCacheEntry),
+	}
+}
+
+// Execute 意見の本文をトピックに分け、トピックごとに投票数を合計する
+func (q *getTopicsQuery) Execute(ctx context.Context, input analysis_query.GetTopicsInput) (*analysis_query.GetTopicsOutput, error) {
+	ctx, span := otel.Tracer("analysis_query").Start(ctx, "getTopicsQuery.Execute")
+	defer span.End()
+
+	if err := input.Validate(); err != nil {
+		utils.HandleError(ctx, err, "GetTopicsInput.Validate")
+		return nil, messages.BadRequestError
+	}
+
+	rows, err := q.GetQueries(ctx).GetOpinionsWithVoteCountsByTalkSessionID(ctx, input.TalkSessionID.UUID())
+	if err != nil {
+		utils.HandleError(ctx, err, "GetOpinionsWithVoteCountsByTalkSessionID")
+		return nil, messages.InternalServerError
+	}
+
+	groupRows, err := q.GetQueries(ctx).GetGroupListByTalkSessionId(ctx, input.TalkSessionID.UUID())
+	if err != nil {
+		utils.HandleError(ctx, err, "GetGroupListByTalkSessionId")
+		return nil, messages.InternalServerError
+	}
+	slices.Sort(groupRows)
+
+	countRows, err := q.GetQueries(ctx).GetGroupVoteCountsByTalkSessionID(ctx, input.TalkSessionID.UUID())
+	if err != nil {
+		utils.HandleError(ctx, err, "GetGroupVoteCountsByTalkSessionID")
+		return nil, messages.InternalServerError
+	}
+	countsByOpinion := make(map[uuid.UUID][]analysis.GroupVoteCount)
+	for _, row := range countRows {
+		countsByOpinion[row.OpinionID] = append(countsByOpinion[row.OpinionID], analysis.GroupVoteCount{
+			GroupID:       analysis.NewGroupIDFromInt(int(row.GroupID)),
+			AgreeCount:    int(row.AgreeCount),
+			DisagreeCount: int(row.DisagreeCount),
+			PassCount:     int(row.PassCount),
+		})
+	}
+
+	topics, err := q.topics(ctx, input.TalkSessionID, rows)
+	if err != nil {
+		return nil, err
+	}
+
+	opinions := make([]dto.TopicOpinion, 0, len(rows))
+	for _, row := range rows {
+		var op dto.TopicOpinion
+		if err := copier.CopyWithOption(&op, row, copier.Option{
+			DeepCopy:    true,
+			IgnoreEmpty: true,
+		}); err != nil {
+			utils.HandleError(ctx, err, "copier.CopyWithOptionでエラー")
+			return nil, messages.InternalServerError
+		}
+		op.AgreeCount = int(row.AgreeCount)
+		op.DisagreeCount = int(row.DisagreeCount)
+		op.PassCount = int(row.PassCount)
+		opinions = append(opinions, op)
+	}
+
+	out := &analysis_query.GetTopicsOutput{
+		Topics:                 make([]dto.Topic, 0, len(topics)),
+		UnassignedOpinionCount: len(rows),
+	}
+	for i, topic := range topics {
+		res := dto.Topic{
+			TopicID:  i,
+			Terms:    topic.Terms,
+			Opinions: make([]dto.TopicOpinion, 0, len(topic.Members)),
+		}
+		groups := make(map[analysis.GroupID]analysis.GroupVoteCount)
+		for _, member := range topic.Members {
+			op := opinions[member]
+			res.Opinions = append(res.Opinions, op)
+			res.AgreeCount += op.AgreeCount
+			res.DisagreeCount += op.DisagreeCount
+			res.PassCount += op.PassCount
+			for _, count := range countsByOpinion[rows[member].Opinion.OpinionID] {
+				group := groups[count.GroupID]
+				group.AgreeCount += count.AgreeCount
+				group.DisagreeCount += count.DisagreeCount
+				group.PassCount += count.PassCount
+				groups[count.GroupID] = group
+			}
+		}
+		slices.SortStableFunc(res.Opinions, func(a, b dto.TopicOpinion) int {
+			return cmp.Compare(b.AgreeCount+b.DisagreeCount+b.PassCount, a.AgreeCount+a.DisagreeCount+a.PassCount)
+		})
+		res.OpinionCount = len(res.Opinions)
+		start := min(*input.OpinionOffset, len(res.Opinions))
+		end := min(start+*input.OpinionLimit, len(res.Opinions))
+		res.Opinions = res.Opinions[start:end]
+
+		res.Groups = make([]dto.GroupAgreement, 0, len(groupRows))
+		for _, groupID := range groupRows {
+			id := analysis.NewGroupIDFromInt(int(groupID))
+			count := groups[id]
+			res.Groups = append(res.Groups, dto.GroupAgreement{
+				GroupName:        id.String(),
+				GroupID:          int(id),
+				AgreeCount:       count.AgreeCount,
+				DisagreeCount:    count.DisagreeCount,
+				PassCount:        count.PassCount,
+				AgreeProbability: count.AgreeProbability(),
+			})
+		}
+
+		out.Topics = append(out.Topics, res)
+		out.UnassignedOpinionCount -= len(topic.Members)
+	}
+	return out, nil
+}
+
+// topics 意見をトピックに分ける。形態素解析とk-meansは重いため、最新の分析結果と意見の本文が前回と同じ間は前回の結果を返す
+func (q *getTopicsQuery) topics(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession], rows []model.GetOpinionsWithVoteCountsByTalkSessionIDRow) ([]analysis.Topic, error) {
+	var fingerprint string
+	snapshot, err := q.GetQueries(ctx).FindLatestAnalysisSnapshot(ctx, talkSessionID.UUID())
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		utils.HandleError(ctx, err, "FindLatestAnalysisSnapshot")
+		return nil, messages.InternalServerError
+	}
+	if err == nil {
+		fingerprint = snapshot.Fingerprint
+	}
+
+	// 意見の追加・編集・削除で結果が変わるため、本文もキーに含める
+	hash := sha256.New()
+	for _, row := range rows {
+		hash.Write(row.Opinion.OpinionID[:])
+		hash.Write([]byte(row.Opinion.Title.String))
+		hash.Write([]byte{0})
+		hash.Write([]byte(row.Opinion.Content))
+		hash.Write([]byte{0})
+	}
+	var digest [sha256.Size]byte
+	copy(digest[:], hash.Sum(nil))
+
+	now := clock.Now(ctx)
+	q.mu.Lock()
+	entry, ok := q.topicCache[talkSessionID]
+	q.mu.Unlock()
+	if ok && now.Before(entry.expiresAt) && entry.fingerprint == fingerprint && entry.digest == digest {
+		return entry.topics, nil
+	}
+
+	documents := make([][]string, 0, len(rows))
+	for _, row := range rows {
+		text := row.Opinion.Content
+		if row.Opinion.Title.Valid {
+			text = row.Opinion.Title.String + "\n" + text
+		}
+		documents = append(documents, q.tokenizer.Tokenize(text))
+	}
+	topics := analysis.ClusterTopics(documents)
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for k, e := range q.topicCache {
+		if !now.Before(e.expiresAt) {
+			delete(q.topicCache, k)
+		}
+	}
+	q.topicCache[talkSessionID] = topicCacheEntry{
+		fingerprint: fingerprint,
+		digest:      digest,
+		topics:      topics,
+		expiresAt:   now.Add(topicCacheTTL),
+	}
+	return topics, nil
+}
@@ -0,0 +1,124 @@
+package analysis
+
+import (
+	"context"
+	"slices"
+
+	"github.com/neko-dream/api/internal/application/query/analysis_query"
+	"github.com/neko-dream/api/internal/application/query/dto"
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/vote"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type getVoteShiftsQuery struct {
+	*db.DBManager
+	talkSessionRepository talksession.TalkSessionRepository
+}
+
+func NewGetVoteShiftsQuery(
+	dbManager *db.DBManager,
+	talkSessionRepository talksession.TalkSessionRepository,
+) analysis_query.GetVoteShiftsQuery {
+	return &getVoteShiftsQuery{
+		DBManager:             dbManager,
+		talkSessionRepository: talkSessionRepository,
+	}
+}
+
+// Execute 投票の変更履歴から、セッション全体と意見ごとの投票の変化を集計する
+func (q *getVoteShiftsQuery) Execute(ctx context.Context, input analysis_query.GetVoteShiftsInput) (*analysis_query.GetVoteShiftsOutput, error) {
+	ctx, span := otel.Tracer("analysis_query").Start(ctx, "getVoteShiftsQuery.Execute")
+	defer span.End()
+
+	if _, err := q.talkSessionRepository.FindByID(ctx, input.TalkSessionID); err != nil {
+		utils.HandleError(ctx, err, "TalkSessionRepository.FindByID")
+		return nil, messages.TalkSessionNotFound
+	}
+
+	summary, err := q.GetQueries(ctx).GetVoteChangeSummaryByTalkSessionID(ctx, input.TalkSessionID.UUID())
+	if err != nil {
+		utils.HandleError(ctx, err, "GetVoteChangeSummaryByTalkSessionID")
+		return nil, messages.InternalServerError
+	}
+	rows, err := q.GetQueries(ctx).GetVoteShiftsByTalkSessionID(ctx, input.TalkSessionID.UUID())
+	if err != nil {
+		utils.HandleError(ctx, err, "GetVoteShiftsByTalkSessionID")
+		return nil, messages.InternalServerError
+	}
+
+	type shiftKey struct {
+		from, to vote.VoteType
+	}
+	sessionShifts := make(map[shiftKey]*dto.VoteShift)
+	opinions := make([]dto.OpinionVoteShift, 0)
+	for _, row := range rows {
+		opinionID := row.OpinionID.String()
+		if len(opinions) == 0 || opinions[len(opinions)-1].OpinionID != opinionID {
+			opinions = append(opinions, dto.OpinionVoteShift{
+				OpinionID: opinionID,
+				Shifts:    make([]dto.VoteShift, 0),
+			})
+		}
+		op := &opinions[len(opinions)-1]
+		op.ChangeCount += int(row.ChangeCount)
+		op.ChangedUserCount += int(row.UserCount)
+
+		// 変更した後に元の投票に戻したユーザーは、変更回数には含めるが変化には含めない
+		if row.FirstVoteType == row.LastVoteType {
+			continue
+		}
+		key := shiftKey{
+			from: vote.VoteTypeFromInt(int(row.FirstVoteType)),
+			to:   vote.VoteTypeFromInt(int(row.LastVoteType)),
+		}
+		op.Shifts = append(op.Shifts, dto.VoteShift{
+			From:                  key.from.String(),
+			To:                    key.to.String(),
+			UserCount:             int(row.UserCount),
+			AfterRepliesUserCount: int(row.AfterRepliesUserCount),
+		})
+
+		shift, ok := sessionShifts[key]
+		if !ok {
+			shift = &dto.VoteShift{
+				From: key.from.String(),
+				To:   key.to.String(),
+			}
+			sessionShifts[key] = shift
+		}
+		shift.UserCount += int(row.UserCount)
+		shift.AfterRepliesUserCount += int(row.AfterRepliesUserCount)
+	}
+
+	keys := make([]shiftKey, 0, len(sessionShifts))
+	for key := range sessionShifts {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b shiftKey) int {
+		if a.from != b.from {
+			return a.from.Int() - b.from.Int()
+		}
+		return a.to.Int() - b.to.Int()
+	})
+	shifts := make([]dto.VoteShift, 0, len(keys))
+	for _, key := range keys {
+		shifts = append(shifts, *sessionShifts[key])
+	}
+
+	// 投票を変更したユーザーが多い意見から返す
+	slices.SortStableFunc(opinions, func(a, b dto.OpinionVoteShift) int {
+		return b.ChangedUserCount - a.ChangedUserCount
+	})
+
+	return &analysis_query.GetVoteShiftsOutput{
+		VoterCount:       int(summary.VoterCount),
+		ChangeCount:      int(summary.ChangeCount),
+		ChangedUserCount: int(summary.ChangedUserCount),
+		Shifts:           shifts,
+		Opinions:         opinions,
+	}, nil
+}
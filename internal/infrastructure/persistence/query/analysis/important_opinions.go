@@ -0,0 +1,38 @@
+package analysis
+
+import (
+	"context"
+
+	"github.com/jinzhu/copier"
+	"github.com/neko-dream/api/internal/application/query/dto"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	model "github.com/neko-dream/api/internal/infrastructure/persistence/sqlc/generated"
+)
+
+// importantOpinionsPerGroup グループごとに返す重要な意見の数
+const importantOpinionsPerGroup = 5
+
+// findImportantOpinions グループIDごとに、メンバーが重要だと印を付けた数が多い順の意見を返す
+func findImportantOpinions(ctx context.Context, q *model.Queries, talkSessionID shared.UUID[talksession.TalkSession]) (map[int][]dto.ImportantOpinion, error) {
+	rows, err := q.GetImportantOpinionsByTalkSessionID(ctx, model.GetImportantOpinionsByTalkSessionIDParams{
+		TalkSessionID: talkSessionID.UUID(),
+		LimitPerGroup: importantOpinionsPerGroup,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	importantOpinions := make(map[int][]dto.ImportantOpinion)
+	for _, row := range rows {
+		var res dto.ImportantOpinion
+		if err := copier.CopyWithOption(&res, row, copier.Option{
+			DeepCopy:    true,
+			IgnoreEmpty: true,
+		}); err != nil {
+			return nil, err
+		}
+		importantOpinions[res.GroupID] = append(importantOpinions[res.GroupID], res)
+	}
+	return importantOpinions, nil
+}
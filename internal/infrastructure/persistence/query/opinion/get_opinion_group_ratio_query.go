@@ -2,21 +2,61 @@ package opinion_query
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"sync"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/neko-dream/api/internal/application/query/dto"
 	opinion_query "github.com/neko-dream/api/internal/application/query/opinion"
+	"github.com/neko-dream/api/internal/domain/messages"
 	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/opinion"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/internal/domain/model/vote"
 	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	"github.com/neko-dream/api/pkg/utils"
 	"go.opentelemetry.io/otel"
 )
 
+// participantCategoryCacheTTL 参加者の属性の区分を使い回す期間。属性の復号はセッションの参加者全員分になるため毎回は行わない
+const participantCategoryCacheTTL = 5 * time.Minute
+
 type getOpinionGroupRatioInteractor struct {
+	distributionRepository analysis.ReferenceDistributionRepository
+	participantRepository  analysis.ParticipantDemographicRepository
 	*db.DBManager
+
+	mu            sync.Mutex
+	categoryCache map[participantCategoryKey]participantCategoryEntry
+}
+
+type participantCategoryKey struct {
+	talkSessionID shared.UUID[talksession.TalkSession]
+	dimension     analysis.DemographicDimension
 }
 
-func NewGetOpinionGroupRatioInteractor(dbm *db.DBManager) opinion_query.GetOpinionGroupRatioQuery {
-	return &getOpinionGroupRatioInteractor{dbm}
+type participantCategoryEntry struct {
+	categories map[shared.UUID[user.User]]string
+	expiresAt  time.Time
+}
+
+func NewGetOpinionGroupRatioInteractor(
+	distributionRepository analysis.ReferenceDistributionRepository,
+	participantRepository analysis.ParticipantDemographicRepository,
+	dbm *db.DBManager,
+) opinion_query.GetOpinionGroupRatioQuery {
+	return &getOpinionGroupRatioInteractor{
+		distributionRepository: distributionRepository,
+		participantRepository:  participantRepository,
+		DBManager:              dbm,
+		categoryCache:          make(map[participantCategoryKey]participantCategoryEntry),
+	}
 }
 
 // Execute
@@ -34,6 +74,14 @@ func (g *getOpinionGroupRatioInteractor) Execute(ctx context.Context, input opin
 		return nil, err
 	}
 
+	var weighted map[int32]analysis.WeightedVoteRates
+	if input.WeightBy != nil {
+		weighted, err = g.weightedRates(ctx, input.OpinionID, *input.WeightBy)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	result := make([]dto.OpinionGroupRatio, len(res))
 	for i, r := range res {
 		result[i] = dto.OpinionGroupRatio{
@@ -43,7 +91,121 @@ func (g *getOpinionGroupRatioInteractor) Execute(ctx context.Context, input opin
 			DisagreeCount: int(r.RepresentativeOpinion.DisagreeCount),
 			PassCount:     int(r.RepresentativeOpinion.PassCount),
 		}
+		if input.WeightBy != nil {
+			rates := weighted[r.RepresentativeOpinion.GroupID].Normalize()
+			result[i].Weighted = &dto.WeightedVoteRates{
+				Dimension:    string(*input.WeightBy),
+				AgreeRate:    rates.Agree,
+				DisagreeRate: rates.Disagree,
+				PassRate:     rates.Pass,
+			}
+		}
 	}
 
 	return result, nil
 }
+
+// FindOrganizationID implements opinion_query.GetOpinionGroupRatioQuery.
+func (g *getOpinionGroupRatioInteractor) FindOrganizationID(ctx context.Context, opinionID shared.UUID[opinion.Opinion]) (*shared.UUID[organization.Organization], error) {
+	ctx, span := otel.Tracer("opinion_query").Start(ctx, "getOpinionGroupRatioInteractor.FindOrganizationID")
+	defer span.End()
+
+	session, err := g.GetQueries(ctx).GetTalkSessionOrganizationByOpinionID(ctx, opinionID.UUID())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, messages.OpinionNotFound
+		}
+		utils.HandleError(ctx, err, "GetTalkSessionOrganizationByOpinionID")
+		return nil, messages.InternalServerError
+	}
+	if !session.OrganizationID.Valid {
+		return nil, nil
+	}
+	organizationID := shared.UUID[organization.Organization](session.OrganizationID.UUID)
+	return &organizationID, nil
+}
+
+// weightedRates セッションの参加者の構成を組織が登録した母集団の構成に合わせる重みを、グループごとの投票に付ける
+func (g *getOpinionGroupRatioInteractor) weightedRates(ctx context.Context, opinionID shared.UUID[opinion.Opinion], dimension analysis.DemographicDimension) (map[int32]analysis.WeightedVoteRates, error) {
+	session, err := g.GetQueries(ctx).GetTalkSessionOrganizationByOpinionID(ctx, opinionID.UUID())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, messages.OpinionNotFound
+		}
+		utils.HandleError(ctx, err, "GetTalkSessionOrganizationByOpinionID")
+		return nil, messages.InternalServerError
+	}
+	if !session.OrganizationID.Valid {
+		return nil, messages.ReferenceDistributionNotFound
+	}
+
+	distribution, err := g.distributionRepository.FindByDimension(ctx, shared.UUID[organization.Organization](session.OrganizationID.UUID), dimension)
+	if err != nil {
+		utils.HandleError(ctx, err, "ReferenceDistributionRepository.FindByDimension")
+		return nil, messages.InternalServerError
+	}
+	if distribution == nil {
+		return nil, messages.ReferenceDistributionNotFound
+	}
+
+	categories, err := g.participantCategories(ctx, shared.UUID[talksession.TalkSession](session.TalkSessionID), dimension)
+	if err != nil {
+		return nil, err
+	}
+	// 重みは意見の投票者ではなくセッションの参加者全体の構成から求める
+	participants := make([]string, 0, len(categories))
+	for _, category := range categories {
+		participants = append(participants, category)
+	}
+	representativeness := analysis.NewRepresentativeness(*distribution, participants)
+
+	votes, err := g.GetQueries(ctx).GetGroupVotesByOpinionID(ctx, opinionID.UUID())
+	if err != nil {
+		utils.HandleError(ctx, err, "GetGroupVotesByOpinionID")
+		return nil, messages.InternalServerError
+	}
+	res := make(map[int32]analysis.WeightedVoteRates)
+	for _, v := range votes {
+		rates := res[v.GroupID]
+		rates.Add(vote.VoteType(v.VoteType), representativeness.Weight(categories[shared.UUID[user.User](v.UserID)]))
+		res[v.GroupID] = rates
+	}
+	return res, nil
+}
+
+// participantCategories セッションの参加者ごとの属性の区分。participantCategoryCacheTTLの間は前回の結果を返す。
+// ユーザーが属性を変更しても、キャッシュの期限が切れるまでは変更前の区分で重みを付ける
+func (g *getOpinionGroupRatioInteractor) participantCategories(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession], dimension analysis.DemographicDimension) (map[shared.UUID[user.User]]string, error) {
+	key := participantCategoryKey{talkSessionID: talkSessionID, dimension: dimension}
+	now := clock.Now(ctx)
+
+	g.mu.Lock()
+	entry, ok := g.categoryCache[key]
+	g.mu.Unlock()
+	if ok && now.Before(entry.expiresAt) {
+		return entry.categories, nil
+	}
+
+	demographics, err := g.participantRepository.FindByTalkSessionID(ctx, talkSessionID)
+	if err != nil {
+		utils.HandleError(ctx, err, "ParticipantDemographicRepository.FindByTalkSessionID")
+		return nil, messages.InternalServerError
+	}
+	categories := make(map[shared.UUID[user.User]]string, len(demographics))
+	for userID, demographic := range demographics {
+		categories[userID] = analysis.DemographicCategory(ctx, demographic, dimension)
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for k, e := range g.categoryCache {
+		if !now.Before(e.expiresAt) {
+			delete(g.categoryCache, k)
+		}
+	}
+	g.categoryCache[key] = participantCategoryEntry{
+		categories: categories,
+		expiresAt:  now.Add(participantCategoryCacheTTL),
+	}
+	return categories, nil
+}
@@ -0,0 +1,119 @@
+package opinion_query
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/neko-dream/api/internal/application/query/dto"
+	opinion_query "github.com/neko-dream/api/internal/application/query/opinion"
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/talksession/talksession_collaborator"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	model "github.com/neko-dream/api/internal/infrastructure/persistence/sqlc/generated"
+	"github.com/neko-dream/api/pkg/utils"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel"
+)
+
+type GetOpinionRevisionsQueryHandler struct {
+	*db.DBManager
+	talkSessionRep    talksession.TalkSessionRepository
+	permissionService talksession_collaborator.TalkSessionPermissionService
+}
+
+func NewGetOpinionRevisionsQueryHandler(
+	dbManager *db.DBManager,
+	talkSessionRep talksession.TalkSessionRepository,
+	permissionService talksession_collaborator.TalkSessionPermissionService,
+) opinion_query.GetOpinionRevisionsQuery {
+	return &GetOpinionRevisionsQueryHandler{
+		DBManager:         dbManager,
+		talkSessionRep:    talkSessionRep,
+		permissionService: permissionService,
+	}
+}
+
+func (g *GetOpinionRevisionsQueryHandler) Execute(ctx context.Context, in opinion_query.GetOpinionRevisionsInput) (*opinion_query.GetOpinionRevisionsOutput, error) {
+	ctx, span := otel.Tracer("opinion_query").Start(ctx, "GetOpinionRevisionsQueryHandler.Execute")
+	defer span.End()
+
+	row, err := g.GetQueries(ctx).GetOpinionByID(ctx, model.GetOpinionByIDParams{
+		OpinionID: in.OpinionID.UUID(),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, messages.OpinionNotFound
+		}
+		utils.HandleError(ctx, err, "意見の取得に失敗")
+		return nil, err
+	}
+	op := row.Opinion
+
+	// 投稿者以外は、セッションのオーナーか通報に対応できる共同管理者のみ閲覧できる
+	if op.UserID != in.UserID.UUID() {
+		talkSession, err := g.talkSessionRep.FindByID(ctx, shared.UUID[talksession.TalkSession](op.TalkSessionID))
+		if err != nil {
+			utils.HandleError(ctx, err, "TalkSessionRepository.FindByID")
+			return nil, messages.TalkSessionNotFound
+		}
+		canModerate, err := g.permissionService.HasPermission(ctx, talkSession, in.UserID, talksession_collaborator.PermissionModerate)
+		if err != nil {
+			return nil, err
+		}
+		if !canModerate {
+			return nil, messages.ForbiddenError
+		}
+	}
+
+	revisionRows, err := g.GetQueries(ctx).FindOpinionRevisionsByOpinionID(ctx, op.OpinionID)
+	if err != nil {
+		utils.HandleError(ctx, err, "編集履歴の取得に失敗")
+		return nil, err
+	}
+
+	current := dto.OpinionRevision{
+		Revision:  int(op.Revision),
+		Content:   op.Content,
+		CreatedAt: op.CreatedAt,
+	}
+	if op.EditedAt.Valid {
+		current.CreatedAt = op.EditedAt.Time
+	}
+	if op.Title.Valid {
+		current.Title = lo.ToPtr(op.Title.String)
+	}
+	if op.ReferenceUrl.Valid {
+		current.ReferenceURL = lo.ToPtr(op.ReferenceUrl.String)
+	}
+
+	revisions := make([]dto.OpinionRevision, 0, len(revisionRows)+1)
+	revisions = append(revisions, current)
+	for _, r := range revisionRows {
+		revision := dto.OpinionRevision{
+			Revision:   int(r.Revision),
+			Content:    r.Content,
+			VoteCount:  lo.ToPtr(int(r.VoteCount)),
+			VotesReset: r.VotesReset,
+			CreatedAt:  r.CreatedAt,
+			ReplacedAt: lo.ToPtr(r.ReplacedAt),
+		}
+		if r.Title.Valid {
+			revision.Title = lo.ToPtr(r.Title.String)
+		}
+		if r.ReferenceUrl.Valid {
+			revision.ReferenceURL = lo.ToPtr(r.ReferenceUrl.String)
+		}
+		revisions = append(revisions, revision)
+	}
+
+	output := &opinion_query.GetOpinionRevisionsOutput{
+		Revisions: revisions,
+	}
+	if op.DeletedAt.Valid {
+		output.DeletedAt = lo.ToPtr(op.DeletedAt.Time)
+	}
+	return output, nil
+}
@@ -0,0 +1,109 @@
+package organization
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/neko-dream/api/internal/application/query/organization_query"
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	model "github.com/neko-dream/api/internal/infrastructure/persistence/sqlc/generated"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type getOrganizationStatsQuery struct {
+	db *db.DBManager
+}
+
+func NewGetOrganizationStatsQuery(db *db.DBManager) organization_query.GetOrganizationStatsQuery {
+	return &getOrganizationStatsQuery{db: db}
+}
+
+// Execute 組織（および子孫の組織）の日次集計を集計単位ごとにまとめて返す
+// 集計値はトリガーで更新されるorganization_daily_statsから読み出す
+func (q *getOrganizationStatsQuery) Execute(ctx context.Context, input organization_query.GetOrganizationStatsInput) (*organization_query.GetOrganizationStatsOutput, error) {
+	ctx, span := otel.Tracer("organization").Start(ctx, "getOrganizationStatsQuery.Execute")
+	defer span.End()
+
+	if err := input.Validate(); err != nil {
+		return nil, messages.BadRequestError
+	}
+	since, until := *input.Since, *input.Until
+
+	organizationIDs := []uuid.UUID{input.OrganizationID.UUID()}
+	if input.IncludeDescendants {
+		descendants, err := q.db.GetQueries(ctx).FindDescendantOrganizations(ctx, model.FindDescendantOrganizationsParams{
+			OrganizationID: uuid.NullUUID{UUID: input.OrganizationID.UUID(), Valid: true},
+			MaxDepth:       organization.MaxOrganizationDepth,
+		})
+		if err != nil {
+			utils.HandleError(ctx, err, "FindDescendantOrganizationsでエラー")
+			return nil, messages.OrganizationInternalServerError
+		}
+		for _, d := range descendants {
+			organizationIDs = append(organizationIDs, d.Organization.OrganizationID)
+		}
+	}
+
+	total, err := q.db.GetQueries(ctx).GetOrganizationStatsTotal(ctx, model.GetOrganizationStatsTotalParams{
+		OrganizationIds: organizationIDs,
+		Since:           since,
+		Until:           until,
+	})
+	if err != nil {
+		utils.HandleError(ctx, err, "GetOrganizationStatsTotalでエラー")
+		return nil, messages.OrganizationInternalServerError
+	}
+
+	rows, err := q.db.GetQueries(ctx).GetOrganizationStatsBuckets(ctx, model.GetOrganizationStatsBucketsParams{
+		Bucket:          string(input.Bucket),
+		OrganizationIds: organizationIDs,
+		Since:           since,
+		Until:           until,
+	})
+	if err != nil {
+		utils.HandleError(ctx, err, "GetOrganizationStatsBucketsでエラー")
+		return nil, messages.OrganizationInternalServerError
+	}
+
+	byDate := make(map[string]model.GetOrganizationStatsBucketsRow, len(rows))
+	for _, row := range rows {
+		byDate[row.BucketDate.Format(time.DateOnly)] = row
+	}
+
+	// 集計のない単位も0件として埋める
+	dates := input.BucketDates(since, until)
+	periods := make([]organization_query.OrganizationStatsPeriod, 0, len(dates))
+	for _, date := range dates {
+		period := organization_query.OrganizationStatsPeriod{Date: date}
+		if row, ok := byDate[date.Format(time.DateOnly)]; ok {
+			period.OrganizationStatsCounts = organization_query.OrganizationStatsCounts{
+				TalkSessionCount:    int(row.TalkSessionsCreated),
+				ParticipantCount:    int(row.Participants),
+				OpinionCount:        int(row.Opinions),
+				VoteCount:           int(row.Votes),
+				ConsentCount:        int(row.Consents),
+				ReportFeedbackCount: int(row.ReportFeedbacks),
+			}
+		}
+		periods = append(periods, period)
+	}
+
+	return &organization_query.GetOrganizationStatsOutput{
+		Bucket: input.Bucket,
+		Since:  since,
+		Until:  until,
+		Totals: organization_query.OrganizationStatsCounts{
+			TalkSessionCount:    int(total.TalkSessionsCreated),
+			ParticipantCount:    int(total.Participants),
+			OpinionCount:        int(total.Opinions),
+			VoteCount:           int(total.Votes),
+			ConsentCount:        int(total.Consents),
+			ReportFeedbackCount: int(total.ReportFeedbacks),
+		},
+		Periods: periods,
+	}, nil
+}
@@ -5,11 +5,15 @@ import (
 	"database/sql"
 	"errors"
 
+	"github.com/google/uuid"
 	"github.com/jinzhu/copier"
 	"github.com/neko-dream/api/internal/application/query/dto"
 	"github.com/neko-dream/api/internal/application/query/organization_query"
+	"github.com/neko-dream/api/internal/domain/model/organization"
 	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	model "github.com/neko-dream/api/internal/infrastructure/persistence/sqlc/generated"
 	"github.com/neko-dream/api/pkg/utils"
+	"github.com/samber/lo"
 	"go.opentelemetry.io/otel"
 )
 
@@ -37,21 +41,69 @@ func (q *listJoinedOrganizationQuery) Execute(ctx context.Context, input organiz
 	}
 
 	orgRespList := make([]*dto.OrganizationResponse, 0, len(orgs))
+	indexByOrgID := make(map[uuid.UUID]int, len(orgs))
 	for _, org := range orgs {
-		var orgResp dto.OrganizationResponse
-		err := copier.CopyWithOption(&orgResp, org, copier.Option{
-			IgnoreEmpty: true,
-			DeepCopy:    true,
-		})
+		orgResp, err := toOrganizationResponse(org.User, org.OrganizationUser, org.Organization)
 		if err != nil {
 			utils.HandleError(ctx, err, "failed to copy organization")
 			return nil, err
 		}
-		orgResp.OrganizationUser.SetRoleName(int(org.OrganizationUser.Role))
-		orgRespList = append(orgRespList, &orgResp)
+		indexByOrgID[org.Organization.OrganizationID] = len(orgRespList)
+		orgRespList = append(orgRespList, orgResp)
+	}
+
+	// 親組織のロールは子孫の組織にも継承されるため、切り替え先として子孫の組織も返す
+	inherited, err := q.db.GetQueries(ctx).FindInheritedOrgUserByUserIDWithOrganization(ctx, model.FindInheritedOrgUserByUserIDWithOrganizationParams{
+		UserID:   input.UserID.UUID(),
+		MaxDepth: organization.MaxOrganizationDepth,
+	})
+	if err != nil {
+		utils.HandleError(ctx, err, "failed to find inherited organization")
+		return nil, err
+	}
+	// ロールの強い順に並んでいるため、組織ごとに最初の行を採用する
+	for _, org := range inherited {
+		if i, ok := indexByOrgID[org.Organization.OrganizationID]; ok {
+			if int(org.OrganizationUser.Role) >= orgRespList[i].OrganizationUser.Role {
+				continue
+			}
+		}
+		orgResp, err := toOrganizationResponse(org.User, org.OrganizationUser, org.Organization)
+		if err != nil {
+			utils.HandleError(ctx, err, "failed to copy organization")
+			return nil, err
+		}
+		orgResp.OrganizationUser.OrganizationID = org.Organization.OrganizationID.String()
+		orgResp.OrganizationUser.Inherited = true
+		if i, ok := indexByOrgID[org.Organization.OrganizationID]; ok {
+			orgRespList[i] = orgResp
+			continue
+		}
+		indexByOrgID[org.Organization.OrganizationID] = len(orgRespList)
+		orgRespList = append(orgRespList, orgResp)
 	}
 
 	return &organization_query.ListJoinedOrganizationOutput{
 		Organizations: orgRespList,
 	}, nil
 }
+
+func toOrganizationResponse(u model.User, orgUser model.OrganizationUser, org model.Organization) (*dto.OrganizationResponse, error) {
+	var orgResp dto.OrganizationResponse
+	src := struct {
+		User             model.User
+		OrganizationUser model.OrganizationUser
+		Organization     model.Organization
+	}{u, orgUser, org}
+	if err := copier.CopyWithOption(&orgResp, src, copier.Option{
+		IgnoreEmpty: true,
+		DeepCopy:    true,
+	}); err != nil {
+		return nil, err
+	}
+	orgResp.OrganizationUser.SetRoleName(int(orgUser.Role))
+	if org.ParentOrganizationID.Valid {
+		orgResp.Organization.ParentID = lo.ToPtr(org.ParentOrganizationID.UUID.String())
+	}
+	return &orgResp, nil
+}
@@ -0,0 +1,129 @@
+package ownership
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/neko-dream/api/internal/application/query/dto"
+	"github.com/neko-dream/api/internal/application/query/ownership_query"
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/ownership"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	model "github.com/neko-dream/api/internal/infrastructure/persistence/sqlc/generated"
+	"github.com/neko-dream/api/pkg/utils"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel"
+)
+
+type getOwnershipTransferQuery struct {
+	*db.DBManager
+}
+
+func NewGetOwnershipTransferQuery(tm *db.DBManager) ownership_query.GetOwnershipTransferQuery {
+	return &getOwnershipTransferQuery{DBManager: tm}
+}
+
+func (q *getOwnershipTransferQuery) Execute(ctx context.Context, input ownership_query.GetOwnershipTransferInput) (*ownership_query.OwnershipTransfer, error) {
+	ctx, span := otel.Tracer("ownership_query").Start(ctx, "getOwnershipTransferQuery.Execute")
+	defer span.End()
+
+	rows, err := q.GetQueries(ctx).FindOwnershipTransferDetails(ctx, model.FindOwnershipTransferDetailsParams{
+		TransferID: uuid.NullUUID{UUID: input.TransferID.UUID(), Valid: true},
+		UserID:     uuid.NullUUID{UUID: input.UserID.UUID(), Valid: true},
+	})
+	if err != nil {
+		utils.HandleError(ctx, err, "FindOwnershipTransferDetails")
+		return nil, messages.InternalServerError
+	}
+	if len(rows) == 0 {
+		return nil, messages.OwnershipTransferNotFound
+	}
+
+	return lo.ToPtr(toOwnershipTransfer(rows[0], clock.Now(ctx))), nil
+}
+
+type listOwnershipTransfersQuery struct {
+	*db.DBManager
+}
+
+func NewListOwnershipTransfersQuery(tm *db.DBManager) ownership_query.ListOwnershipTransfersQuery {
+	return &listOwnershipTransfersQuery{DBManager: tm}
+}
+
+func (q *listOwnershipTransfersQuery) Execute(ctx context.Context, input ownership_query.ListOwnershipTransfersInput) (*ownership_query.ListOwnershipTransfersOutput, error) {
+	ctx, span := otel.Tracer("ownership_query").Start(ctx, "listOwnershipTransfersQuery.Execute")
+	defer span.End()
+
+	rows, err := q.GetQueries(ctx).FindOwnershipTransferDetails(ctx, model.FindOwnershipTransferDetailsParams{
+		UserID: uuid.NullUUID{UUID: input.UserID.UUID(), Valid: true},
+	})
+	if err != nil {
+		utils.HandleError(ctx, err, "FindOwnershipTransferDetails")
+		return nil, messages.InternalServerError
+	}
+
+	now := clock.Now(ctx)
+	output := &ownership_query.ListOwnershipTransfersOutput{
+		Received: make([]ownership_query.OwnershipTransfer, 0),
+		Sent:     make([]ownership_query.OwnershipTransfer, 0),
+	}
+	for _, row := range rows {
+		transfer := toOwnershipTransfer(row, now)
+		if row.OwnershipTransfer.ToUserID == input.UserID.UUID() {
+			output.Received = append(output.Received, transfer)
+		} else {
+			output.Sent = append(output.Sent, transfer)
+		}
+	}
+
+	return output, nil
+}
+
+func toOwnershipTransfer(row model.FindOwnershipTransferDetailsRow, now time.Time) ownership_query.OwnershipTransfer {
+	t := row.OwnershipTransfer
+
+	transfer := ownership_query.OwnershipTransfer{
+		TransferID: shared.UUID[ownership.OwnershipTransfer](t.TransferID),
+		TargetType: ownership.TransferTargetType(t.TargetType),
+		FromUser: dto.User{
+			DisplayID:   row.FromDisplayID.String,
+			DisplayName: row.FromDisplayName.String,
+		},
+		ToUser: dto.User{
+			DisplayID:   row.ToDisplayID.String,
+			DisplayName: row.ToDisplayName.String,
+		},
+		Status:    ownership.TransferStatus(t.Status),
+		ExpiresAt: t.ExpiresAt,
+		CreatedAt: t.CreatedAt,
+	}
+	if transfer.Status == ownership.TransferStatusPending && !now.Before(t.ExpiresAt) {
+		transfer.Status = ownership.TransferStatusExpired
+	}
+	if row.FromIconUrl.Valid {
+		transfer.FromUser.IconURL = &row.FromIconUrl.String
+	}
+	if row.ToIconUrl.Valid {
+		transfer.ToUser.IconURL = &row.ToIconUrl.String
+	}
+	if t.RespondedAt.Valid {
+		transfer.RespondedAt = &t.RespondedAt.Time
+	}
+	if row.OrganizationCode.Valid {
+		transfer.OrganizationCode = &row.OrganizationCode.String
+	}
+
+	switch transfer.TargetType {
+	case ownership.TransferTargetTalkSession:
+		transfer.TalkSessionID = lo.ToPtr(shared.UUID[talksession.TalkSession](t.TalkSessionID.UUID))
+		transfer.TargetName = row.TalkSessionTheme.String
+	case ownership.TransferTargetOrganization:
+		transfer.TargetName = row.OrganizationName.String
+	}
+
+	return transfer
+}
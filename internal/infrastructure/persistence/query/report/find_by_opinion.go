@@ -11,6 +11,7 @@ import (
 	"github.com/neko-dream/api/internal/domain/model/opinion"
 	"github.com/neko-dream/api/internal/domain/model/shared"
 	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/talksession/talksession_collaborator"
 	"github.com/neko-dream/api/internal/domain/model/user"
 	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
 	model "github.com/neko-dream/api/internal/infrastructure/persistence/sqlc/generated"
@@ -20,16 +21,19 @@ import (
 
 type getOpinionReportQueryInteractor struct {
 	*db.DBManager
-	talkSessionRep talksession.TalkSessionRepository
+	talkSessionRep    talksession.TalkSessionRepository
+	permissionService talksession_collaborator.TalkSessionPermissionService
 }
 
 func NewGetOpinionReportQueryInteractor(
 	dbManager *db.DBManager,
 	talkSessionRep talksession.TalkSessionRepository,
+	permissionService talksession_collaborator.TalkSessionPermissionService,
 ) report_query.GetOpinionReportQuery {
 	return &getOpinionReportQueryInteractor{
-		DBManager:      dbManager,
-		talkSessionRep: talkSessionRep,
+		DBManager:         dbManager,
+		talkSessionRep:    talkSessionRep,
+		permissionService: permissionService,
 	}
 }
 
@@ -52,8 +56,12 @@ func (g *getOpinionReportQueryInteractor) Execute(ctx context.Context, input rep
 		return nil, err
 	}
 
-	// talkSessionのオーナーと一致するか確認
-	if talkSession.OwnerUserID() != input.UserID {
+	// talkSessionのオーナーか、通報に対応できる共同管理者か確認
+	canModerate, err := g.permissionService.HasPermission(ctx, talkSession, input.UserID, talksession_collaborator.PermissionModerate)
+	if err != nil {
+		return nil, err
+	}
+	if !canModerate {
 		return nil, messages.TalkSessionNotFound
 	}
 
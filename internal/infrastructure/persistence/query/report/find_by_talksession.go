@@ -12,6 +12,7 @@ import (
 	"github.com/neko-dream/api/internal/domain/model/opinion"
 	"github.com/neko-dream/api/internal/domain/model/shared"
 	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/talksession/talksession_collaborator"
 	"github.com/neko-dream/api/internal/domain/model/user"
 	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
 	model "github.com/neko-dream/api/internal/infrastructure/persistence/sqlc/generated"
@@ -22,16 +23,19 @@ import (
 
 type getByTalkSessionQueryInteractor struct {
 	*db.DBManager
-	talkSessionRep talksession.TalkSessionRepository
+	talkSessionRep    talksession.TalkSessionRepository
+	permissionService talksession_collaborator.TalkSessionPermissionService
 }
 
 func NewGetByTalkSessionQueryInteractor(
 	dbManager *db.DBManager,
 	talkSessionRep talksession.TalkSessionRepository,
+	permissionService talksession_collaborator.TalkSessionPermissionService,
 ) report_query.GetByTalkSessionQuery {
 	return &getByTalkSessionQueryInteractor{
-		DBManager:      dbManager,
-		talkSessionRep: talkSessionRep,
+		DBManager:         dbManager,
+		talkSessionRep:    talkSessionRep,
+		permissionService: permissionService,
 	}
 }
 
@@ -39,14 +43,17 @@ func (i *getByTalkSessionQueryInteractor) Execute(ctx context.Context, input rep
 	ctx, span := otel.Tracer("report").Start(ctx, "getByTalkSessionQueryInteractor.Execute")
 	defer span.End()
 
-	// 操作ユーザーがセッションの作成者かどうかを確認
+	// 操作ユーザーがセッションの作成者か、通報に対応できる共同管理者かどうかを確認
 	talkSession, err := i.talkSessionRep.FindByID(ctx, input.TalkSessionID)
 	if err != nil {
 		utils.HandleError(ctx, err, "talkSessionRep.FindByID")
 		return nil, err
 	}
-	if talkSession.OwnerUserID() != input.UserID {
-		utils.HandleError(ctx, err, "talkSession.UserID != input.UserID")
+	canModerate, err := i.permissionService.HasPermission(ctx, talkSession, input.UserID, talksession_collaborator.PermissionModerate)
+	if err != nil {
+		return nil, err
+	}
+	if !canModerate {
 		return nil, messages.TalkSessionNotFound
 	}
 
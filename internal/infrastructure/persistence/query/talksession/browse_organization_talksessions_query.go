@@ -0,0 +1,88 @@
+package talksession_query
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/google/uuid"
+	"github.com/jinzhu/copier"
+	"github.com/neko-dream/api/internal/application/query/dto"
+	"github.com/neko-dream/api/internal/application/query/talksession"
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	model "github.com/neko-dream/api/internal/infrastructure/persistence/sqlc/generated"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type BrowseOrganizationTalkSessionsQueryImpl struct {
+	*db.DBManager
+}
+
+func NewBrowseOrganizationTalkSessionsQueryHandler(tm *db.DBManager) talksession.BrowseOrganizationTalkSessionsQuery {
+	return &BrowseOrganizationTalkSessionsQueryImpl{
+		DBManager: tm,
+	}
+}
+
+// Execute 組織（および子孫の組織）のトークセッションを検索する
+func (h *BrowseOrganizationTalkSessionsQueryImpl) Execute(ctx context.Context, input talksession.BrowseOrganizationTalkSessionsInput) (*talksession.BrowseOrganizationTalkSessionsOutput, error) {
+	ctx, span := otel.Tracer("talksession_query").Start(ctx, "BrowseOrganizationTalkSessionsQueryImpl.Execute")
+	defer span.End()
+
+	if err := input.Validate(); err != nil {
+		return nil, err
+	}
+
+	organizationIDs := []uuid.UUID{input.OrganizationID.UUID()}
+	if input.IncludeDescendants {
+		descendants, err := h.GetQueries(ctx).FindDescendantOrganizations(ctx, model.FindDescendantOrganizationsParams{
+			OrganizationID: uuid.NullUUID{UUID: input.OrganizationID.UUID(), Valid: true},
+			MaxDepth:       organization.MaxOrganizationDepth,
+		})
+		if err != nil {
+			utils.HandleError(ctx, err, "FindDescendantOrganizationsでエラー")
+			return nil, messages.OrganizationInternalServerError
+		}
+		for _, d := range descendants {
+			organizationIDs = append(organizationIDs, d.Organization.OrganizationID)
+		}
+	}
+
+	var out talksession.BrowseOrganizationTalkSessionsOutput
+	status := sql.NullString{Valid: false}
+	if input.Status != "" {
+		status = sql.NullString{
+			String: string(input.Status),
+			Valid:  true,
+		}
+	}
+
+	talkSessionRow, err := h.GetQueries(ctx).GetTalkSessionsByOrganizationIDsWithCount(ctx, model.GetTalkSessionsByOrganizationIDsWithCountParams{
+		OrganizationIds: organizationIDs,
+		Limit:           utils.ToNullableSQL[sql.NullInt32](input.Limit),
+		Offset:          utils.ToNullableSQL[sql.NullInt32](input.Offset),
+		Theme:           utils.ToNullableSQL[sql.NullString](input.Theme),
+		Status:          status,
+	})
+	if err != nil {
+		utils.HandleError(ctx, err, "GetTalkSessionsByOrganizationIDsWithCountでエラー")
+		return nil, messages.TalkSessionNotFound
+	}
+	if len(talkSessionRow) <= 0 {
+		return &out, nil
+	}
+	var talkSessions []dto.TalkSessionWithDetail
+	if err := copier.CopyWithOption(&talkSessions, talkSessionRow, copier.Option{
+		DeepCopy:      true,
+		CaseSensitive: true,
+	}); err != nil {
+		utils.HandleError(ctx, err, "copier.CopyWithOptionでエラー")
+		return nil, err
+	}
+	out.TalkSessions = talkSessions
+	out.TotalCount = int32(talkSessionRow[0].TotalCount)
+
+	return &out, nil
+}
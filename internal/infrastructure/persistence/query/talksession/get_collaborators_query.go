@@ -0,0 +1,93 @@
+package talksession_query
+
+import (
+	"context"
+
+	"github.com/neko-dream/api/internal/application/query/dto"
+	"github.com/neko-dream/api/internal/application/query/talksession"
+	"github.com/neko-dream/api/internal/domain/messages"
+	talksession_model "github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/talksession/talksession_collaborator"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type getCollaboratorsQuery struct {
+	*db.DBManager
+	talkSessionRep    talksession_model.TalkSessionRepository
+	userRep           user.UserRepository
+	permissionService talksession_collaborator.TalkSessionPermissionService
+}
+
+func NewGetCollaboratorsQuery(
+	tm *db.DBManager,
+	talkSessionRep talksession_model.TalkSessionRepository,
+	userRep user.UserRepository,
+	permissionService talksession_collaborator.TalkSessionPermissionService,
+) talksession.GetCollaboratorsQuery {
+	return &getCollaboratorsQuery{
+		DBManager:         tm,
+		talkSessionRep:    talkSessionRep,
+		userRep:           userRep,
+		permissionService: permissionService,
+	}
+}
+
+// Execute セッションのオーナーと共同管理者の一覧を取得する
+func (q *getCollaboratorsQuery) Execute(ctx context.Context, input talksession.GetCollaboratorsInput) (*talksession.GetCollaboratorsOutput, error) {
+	ctx, span := otel.Tracer("talksession_query").Start(ctx, "getCollaboratorsQuery.Execute")
+	defer span.End()
+
+	talkSession, err := q.talkSessionRep.FindByID(ctx, input.TalkSessionID)
+	if err != nil {
+		utils.HandleError(ctx, err, "TalkSessionRepository.FindByID")
+		return nil, messages.TalkSessionNotFound
+	}
+	ok, err := q.permissionService.HasPermission(ctx, talkSession, input.UserID, talksession_collaborator.PermissionView)
+	if err != nil {
+		return nil, messages.InternalServerError
+	}
+	if !ok {
+		return nil, messages.ForbiddenError
+	}
+
+	owner, err := q.userRep.FindByID(ctx, talkSession.OwnerUserID())
+	if err != nil {
+		utils.HandleError(ctx, err, "UserRepository.FindByID")
+		return nil, messages.InternalServerError
+	}
+	ownerDTO := dto.User{IconURL: owner.IconURL()}
+	if owner.DisplayID() != nil && owner.DisplayName() != nil {
+		ownerDTO.DisplayID = *owner.DisplayID()
+		ownerDTO.DisplayName = *owner.DisplayName()
+	}
+
+	rows, err := q.GetQueries(ctx).FindTalkSessionCollaboratorsByTalkSessionID(ctx, input.TalkSessionID.UUID())
+	if err != nil {
+		utils.HandleError(ctx, err, "FindTalkSessionCollaboratorsByTalkSessionID")
+		return nil, messages.InternalServerError
+	}
+
+	collaborators := make([]talksession.Collaborator, 0, len(rows))
+	for _, row := range rows {
+		u := dto.User{
+			DisplayID:   row.User.DisplayID.String,
+			DisplayName: row.User.DisplayName.String,
+		}
+		if row.User.IconUrl.Valid {
+			u.IconURL = &row.User.IconUrl.String
+		}
+		collaborators = append(collaborators, talksession.Collaborator{
+			User:      u,
+			Role:      row.TalkSessionCollaborator.Role,
+			CreatedAt: row.TalkSessionCollaborator.CreatedAt,
+		})
+	}
+
+	return &talksession.GetCollaboratorsOutput{
+		Owner:         ownerDTO,
+		Collaborators: collaborators,
+	}, nil
+}
@@ -0,0 +1,164 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"braces.dev/errtrace"
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/event"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	model "github.com/neko-dream/api/internal/infrastructure/persistence/sqlc/generated"
+	"github.com/neko-dream/api/pkg/utils"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel"
+)
+
+type analysisJobRepository struct {
+	*db.DBManager
+	eventStore event.EventStore
+}
+
+func NewAnalysisJobRepository(
+	dbManager *db.DBManager,
+	eventStore event.EventStore,
+) analysis.AnalysisJobRepository {
+	return &analysisJobRepository{
+		DBManager:  dbManager,
+		eventStore: eventStore,
+	}
+}
+
+// Create ジョブを保存する。同じセッション・種類の待機中・実行中のジョブがある場合は保存しない
+func (r *analysisJobRepository) Create(ctx context.Context, job *analysis.AnalysisJob) (bool, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "analysisJobRepository.Create")
+	defer span.End()
+
+	rows, err := r.GetQueries(ctx).CreateAnalysisJob(ctx, model.CreateAnalysisJobParams{
+		AnalysisJobID: job.JobID().UUID(),
+		TalkSessionID: job.TalkSessionID().UUID(),
+		JobType:       string(job.JobType()),
+		Status:        string(job.Status()),
+		Attempts:      int32(job.Attempts()),
+		RunAfter:      job.RunAfter(),
+		CreatedAt:     job.CreatedAt(),
+	})
+	if err != nil {
+		utils.HandleError(ctx, err, "CreateAnalysisJob")
+		return false, errtrace.Wrap(err)
+	}
+
+	return rows > 0, nil
+}
+
+// Update ジョブの状態を保存する
+func (r *analysisJobRepository) Update(ctx context.Context, job *analysis.AnalysisJob) error {
+	ctx, span := otel.Tracer("repository").Start(ctx, "analysisJobRepository.Update")
+	defer span.End()
+
+	if err := r.GetQueries(ctx).UpdateAnalysisJob(ctx, model.UpdateAnalysisJobParams{
+		AnalysisJobID: job.JobID().UUID(),
+		Status:        string(job.Status()),
+		Attempts:      int32(job.Attempts()),
+		LastError:     utils.ToNullableSQL[sql.NullString](job.LastError()),
+		RunAfter:      job.RunAfter(),
+		StartedAt:     utils.ToNullableSQL[sql.NullTime](job.StartedAt()),
+		FinishedAt:    utils.ToNullableSQL[sql.NullTime](job.FinishedAt()),
+		UpdatedAt:     job.UpdatedAt(),
+	}); err != nil {
+		utils.HandleError(ctx, err, "UpdateAnalysisJob")
+		return errtrace.Wrap(err)
+	}
+
+	return r.storeEvents(ctx, job)
+}
+
+// FindByID IDでジョブを取得する
+func (r *analysisJobRepository) FindByID(ctx context.Context, jobID shared.UUID[analysis.AnalysisJob]) (*analysis.AnalysisJob, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "analysisJobRepository.FindByID")
+	defer span.End()
+
+	row, err := r.GetQueries(ctx).FindAnalysisJobByID(ctx, jobID.UUID())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		utils.HandleError(ctx, err, "FindAnalysisJobByID")
+		return nil, errtrace.Wrap(err)
+	}
+
+	return r.fromRow(row), nil
+}
+
+// FindActive 同じセッション・種類の待機中・実行中のジョブを取得する
+func (r *analysisJobRepository) FindActive(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession], jobType analysis.AnalysisJobType) (*analysis.AnalysisJob, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "analysisJobRepository.FindActive")
+	defer span.End()
+
+	row, err := r.GetQueries(ctx).FindActiveAnalysisJob(ctx, model.FindActiveAnalysisJobParams{
+		TalkSessionID: talkSessionID.UUID(),
+		JobType:       string(jobType),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		utils.HandleError(ctx, err, "FindActiveAnalysisJob")
+		return nil, errtrace.Wrap(err)
+	}
+
+	return r.fromRow(row), nil
+}
+
+// FindRunnableForUpdate 実行を待っているジョブと実行中のまま止まったジョブを取得する
+func (r *analysisJobRepository) FindRunnableForUpdate(ctx context.Context, now time.Time, limit int) ([]*analysis.AnalysisJob, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "analysisJobRepository.FindRunnableForUpdate")
+	defer span.End()
+
+	rows, err := r.GetQueries(ctx).FindRunnableAnalysisJobsForUpdate(ctx, model.FindRunnableAnalysisJobsForUpdateParams{
+		Now:         now,
+		StaleBefore: now.Add(-analysis.AnalysisJobRunTimeout),
+		Limit:       int32(limit),
+	})
+	if err != nil {
+		utils.HandleError(ctx, err, "FindRunnableAnalysisJobsForUpdate")
+		return nil, errtrace.Wrap(err)
+	}
+
+	return lo.Map(rows, func(row model.AnalysisJob, _ int) *analysis.AnalysisJob {
+		return r.fromRow(row)
+	}), nil
+}
+
+// storeEvents 通知用に記録されたイベントを保存する
+func (r *analysisJobRepository) storeEvents(ctx context.Context, job *analysis.AnalysisJob) error {
+	events := job.GetRecordedEvents()
+	if len(events) == 0 {
+		return nil
+	}
+	if err := r.eventStore.StoreBatch(ctx, events); err != nil {
+		return errtrace.Wrap(err)
+	}
+	job.ClearRecordedEvents()
+	return nil
+}
+
+func (r *analysisJobRepository) fromRow(row model.AnalysisJob) *analysis.AnalysisJob {
+	return analysis.ReconstructAnalysisJob(
+		shared.UUID[analysis.AnalysisJob](row.AnalysisJobID),
+		shared.UUID[talksession.TalkSession](row.TalkSessionID),
+		analysis.AnalysisJobType(row.JobType),
+		analysis.AnalysisJobStatus(row.Status),
+		int(row.Attempts),
+		utils.ToPtrIf(row.LastError.Valid, row.LastError.String),
+		row.RunAfter,
+		utils.ToPtrIf(row.StartedAt.Valid, row.StartedAt.Time),
+		utils.ToPtrIf(row.FinishedAt.Valid, row.FinishedAt.Time),
+		row.CreatedAt,
+		row.UpdatedAt,
+	)
+}
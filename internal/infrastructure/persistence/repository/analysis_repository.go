@@ -8,6 +8,7 @@ import (
 	"github.com/neko-dream/api/internal/domain/model/analysis"
 	"github.com/neko-dream/api/internal/domain/model/shared"
 	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/user"
 	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
 	model "github.com/neko-dream/api/internal/infrastructure/persistence/sqlc/generated"
 	"github.com/neko-dream/api/pkg/utils"
@@ -24,6 +25,7 @@ func NewAnalysisRepository(dbManager *db.DBManager) analysis.AnalysisRepository
 	}
 }
 
+// FindByTalkSessionID セッションで公開しているレポートを取得する
 func (r *analysisRepository) FindByTalkSessionID(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession]) (*analysis.AnalysisReport, error) {
 	ctx, span := otel.Tracer("repository").Start(ctx, "analysisRepository.FindByTalkSessionID")
 	defer span.End()
@@ -36,37 +38,26 @@ func (r *analysisRepository) FindByTalkSessionID(ctx context.Context, talkSessio
 		return nil, err
 	}
 
-	// feedbackRows, err := r.DBManager.GetQueries(ctx).GetFeedbackByReportHistoryID(ctx, analysisReport.AnalysisReportHistoryID)
-	// if err != nil {
-	// 	if !errors.Is(err, sql.ErrNoRows) {
-	// 		utils.HandleError(ctx, err, "failed to retrieve feedback by report history ID")
-	// 	}
-	// 	return nil, err
-	// }
-
-	// feedbacks := make([]analysis.Feedback, len(feedbackRows))
-	// for i, row := range feedbackRows {
-	// 	feedbacks[i] = analysis.Feedback{
-	// 		FeedbackID: shared.UUID[analysis.Feedback](row.ReportFeedbackID),
-	// 		UserID:     shared.UUID[user.User](row.UserID),
-	// 		Type:       analysis.FeedbackType(row.FeedbackType),
-	// 		CreatedAt:  row.CreatedAt,
-	// 	}
-	// }
-
-	reportModel := &analysis.AnalysisReport{
-		AnalysisReportID: shared.UUID[analysis.AnalysisReport](analysisReport.TalkSessionID),
-		Report:           &analysisReport.Report,
-		CreatedAt:        analysisReport.CreatedAt,
+	// バージョンとして残る前のレポートはセッションのIDで扱う
+	reportID := shared.UUID[analysis.AnalysisReport](analysisReport.TalkSessionID)
+	if analysisReport.ReportVersionID.Valid {
+		reportID = shared.UUID[analysis.AnalysisReport](analysisReport.ReportVersionID.UUID)
 	}
-	// if len(feedbacks) > 0 {
-	// 	reportModel.Feedbacks = feedbacks
-	// }
 
-	return reportModel, nil
+	feedbacks, err := r.findFeedbacks(ctx, reportID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &analysis.AnalysisReport{
+		AnalysisReportID: reportID,
+		Report:           &analysisReport.Report,
+		CreatedAt:        analysisReport.CreatedAt,
+		Feedbacks:        feedbacks,
+	}, nil
 }
 
-// FindByID implements analysis.AnalysisRepository.
+// FindByID レポートのバージョンを取得する。セッションのIDを渡した場合は公開しているバージョンを返す
 func (r *analysisRepository) FindByID(ctx context.Context, analysisReportID shared.UUID[analysis.AnalysisReport]) (*analysis.AnalysisReport, error) {
 	ctx, span := otel.Tracer("repository").Start(ctx, "analysisRepository.FindByID")
 	defer span.End()
@@ -79,34 +70,37 @@ func (r *analysisRepository) FindByID(ctx context.Context, analysisReportID shar
 		return nil, err
 	}
 
-	// feedbackRows, err := r.DBManager.GetQueries(ctx).GetFeedbackByReportHistoryID(ctx, analysisReport.AnalysisReportHistoryID)
-	// if err != nil {
-	// 	if !errors.Is(err, sql.ErrNoRows) {
-	// 		utils.HandleError(ctx, err, "failed to retrieve feedback by report history ID")
-	// 	}
-	// 	return nil, err
-	// }
-
-	// feedbacks := make([]analysis.Feedback, len(feedbackRows))
-	// for i, row := range feedbackRows {
-	// 	feedbacks[i] = analysis.Feedback{
-	// 		FeedbackID: shared.UUID[analysis.Feedback](row.ReportFeedbackID),
-	// 		UserID:     shared.UUID[user.User](row.UserID),
-	// 		Type:       analysis.FeedbackType(row.FeedbackType),
-	// 		CreatedAt:  row.CreatedAt,
-	// 	}
-	// }
-
-	reportModel := &analysis.AnalysisReport{
-		AnalysisReportID: shared.UUID[analysis.AnalysisReport](analysisReport.TalkSessionID),
+	reportID := shared.UUID[analysis.AnalysisReport](analysisReport.TalkSessionReportHistoryID)
+	feedbacks, err := r.findFeedbacks(ctx, reportID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &analysis.AnalysisReport{
+		AnalysisReportID: reportID,
 		Report:           &analysisReport.Report,
 		CreatedAt:        analysisReport.CreatedAt,
+		Feedbacks:        feedbacks,
+	}, nil
+}
+
+func (r *analysisRepository) findFeedbacks(ctx context.Context, reportID shared.UUID[analysis.AnalysisReport]) ([]analysis.Feedback, error) {
+	feedbackRows, err := r.GetQueries(ctx).GetFeedbackByReportHistoryID(ctx, reportID.UUID())
+	if err != nil {
+		utils.HandleError(ctx, err, "failed to retrieve feedback by report history ID")
+		return nil, err
 	}
-	// if len(feedbacks) > 0 {
-	// 	reportModel.Feedbacks = feedbacks
-	// }
 
-	return reportModel, nil
+	var feedbacks []analysis.Feedback
+	for _, row := range feedbackRows {
+		feedbacks = append(feedbacks, analysis.Feedback{
+			FeedbackID: shared.UUID[analysis.Feedback](row.ReportFeedbackID),
+			UserID:     shared.UUID[user.User](row.UserID),
+			Type:       analysis.FeedbackType(row.FeedbackType),
+			CreatedAt:  row.CreatedAt,
+		})
+	}
+	return feedbacks, nil
 }
 
 // SaveReport
@@ -121,6 +115,7 @@ func (r *analysisRepository) SaveReport(ctx context.Context, report *analysis.An
 				TalkSessionReportHistoryID: report.AnalysisReportID.UUID(),
 				UserID:                     feedback.UserID.UUID(),
 				FeedbackType:               int32(feedback.Type),
+				CreatedAt:                  feedback.CreatedAt,
 			}); err != nil {
 				utils.HandleError(ctx, err, "failed to save report feedback")
 				return err
@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	model "github.com/neko-dream/api/internal/infrastructure/persistence/sqlc/generated"
+	"github.com/neko-dream/api/pkg/utils"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel"
+)
+
+type analysisScheduleRepository struct {
+	*db.DBManager
+}
+
+func NewAnalysisScheduleRepository(dbManager *db.DBManager) analysis.AnalysisScheduleRepository {
+	return &analysisScheduleRepository{dbManager}
+}
+
+func (r *analysisScheduleRepository) RecordActivity(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession], voteCount, opinionCount int, occurredAt time.Time) error {
+	ctx, span := otel.Tracer("repository").Start(ctx, "analysisScheduleRepository.RecordActivity")
+	defer span.End()
+
+	return r.GetQueries(ctx).RecordAnalysisActivity(ctx, model.RecordAnalysisActivityParams{
+		TalkSessionID: talkSessionID.UUID(),
+		VoteCount:     int32(voteCount),
+		OpinionCount:  int32(opinionCount),
+		OccurredAt:    occurredAt,
+	})
+}
+
+func (r *analysisScheduleRepository) FindByTalkSessionID(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession]) (*analysis.AnalysisSchedule, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "analysisScheduleRepository.FindByTalkSessionID")
+	defer span.End()
+
+	row, err := r.GetQueries(ctx).FindAnalysisScheduleByTalkSessionID(ctx, talkSessionID.UUID())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return lo.ToPtr(analysisScheduleFromRow(row)), nil
+}
+
+func (r *analysisScheduleRepository) FindDueForUpdate(ctx context.Context, now time.Time, policy analysis.RecomputePolicy, limit int) ([]analysis.AnalysisSchedule, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "analysisScheduleRepository.FindDueForUpdate")
+	defer span.End()
+
+	rows, err := r.GetQueries(ctx).FindDueAnalysisSchedulesForUpdate(ctx, model.FindDueAnalysisSchedulesForUpdateParams{
+		StaleBefore:   now.Add(-policy.RunTimeout),
+		VoteThreshold: int32(policy.VoteThreshold),
+		DelayedBefore: now.Add(-policy.MaxDelay),
+		BatchSize:     int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return lo.Map(rows, func(row model.AnalysisSchedule, _ int) analysis.AnalysisSchedule {
+		return analysisScheduleFromRow(row)
+	}), nil
+}
+
+func (r *analysisScheduleRepository) FindByTalkSessionIDForUpdate(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession], now time.Time) (*analysis.AnalysisSchedule, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "analysisScheduleRepository.FindByTalkSessionIDForUpdate")
+	defer span.End()
+
+	row, err := r.GetQueries(ctx).LockAnalysisSchedule(ctx, model.LockAnalysisScheduleParams{
+		TalkSessionID: talkSessionID.UUID(),
+		Now:           now,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return lo.ToPtr(analysisScheduleFromRow(row)), nil
+}
+
+func (r *analysisScheduleRepository) Start(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession], startedAt time.Time) error {
+	ctx, span := otel.Tracer("repository").Start(ctx, "analysisScheduleRepository.Start")
+	defer span.End()
+
+	return r.GetQueries(ctx).StartAnalysisSchedule(ctx, model.StartAnalysisScheduleParams{
+		TalkSessionID: talkSessionID.UUID(),
+		StartedAt:     startedAt,
+	})
+}
+
+func (r *analysisScheduleRepository) Complete(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession], completedAt time.Time) error {
+	ctx, span := otel.Tracer("repository").Start(ctx, "analysisScheduleRepository.Complete")
+	defer span.End()
+
+	return r.GetQueries(ctx).CompleteAnalysisSchedule(ctx, model.CompleteAnalysisScheduleParams{
+		TalkSessionID: talkSessionID.UUID(),
+		CompletedAt:   completedAt,
+	})
+}
+
+func (r *analysisScheduleRepository) Fail(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession], failedAt time.Time, reason string) error {
+	ctx, span := otel.Tracer("repository").Start(ctx, "analysisScheduleRepository.Fail")
+	defer span.End()
+
+	return r.GetQueries(ctx).FailAnalysisSchedule(ctx, model.FailAnalysisScheduleParams{
+		TalkSessionID: talkSessionID.UUID(),
+		FailedAt:      failedAt,
+		FailureReason: reason,
+	})
+}
+
+func analysisScheduleFromRow(row model.AnalysisSchedule) analysis.AnalysisSchedule {
+	return analysis.AnalysisSchedule{
+		TalkSessionID:       shared.UUID[talksession.TalkSession](row.TalkSessionID),
+		PendingVoteCount:    int(row.PendingVoteCount),
+		PendingOpinionCount: int(row.PendingOpinionCount),
+		PendingSince:        utils.ToPtrIf(row.PendingSince.Valid, row.PendingSince.Time),
+		RunningSince:        utils.ToPtrIf(row.RunningSince.Valid, row.RunningSince.Time),
+		LastCompletedAt:     utils.ToPtrIf(row.LastCompletedAt.Valid, row.LastCompletedAt.Time),
+		LastFailedAt:        utils.ToPtrIf(row.LastFailedAt.Valid, row.LastFailedAt.Time),
+	}
+}
@@ -0,0 +1,85 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	model "github.com/neko-dream/api/internal/infrastructure/persistence/sqlc/generated"
+	"go.opentelemetry.io/otel"
+)
+
+type analysisSnapshotRepository struct {
+	*db.DBManager
+}
+
+func NewAnalysisSnapshotRepository(dbManager *db.DBManager) analysis.AnalysisSnapshotRepository {
+	return &analysisSnapshotRepository{dbManager}
+}
+
+func (r *analysisSnapshotRepository) Capture(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession], createdAt time.Time) (*analysis.AnalysisSnapshot, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "analysisSnapshotRepository.Capture")
+	defer span.End()
+
+	var snapshot *analysis.AnalysisSnapshot
+	err := r.ExecTx(ctx, func(ctx context.Context) error {
+		current, err := r.GetQueries(ctx).GetCurrentAnalysisFingerprint(ctx, talkSessionID.UUID())
+		if err != nil {
+			return err
+		}
+		// 分析結果がまだない
+		if current.UserCount == 0 {
+			return nil
+		}
+
+		latest, err := r.GetQueries(ctx).FindLatestAnalysisSnapshot(ctx, talkSessionID.UUID())
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		if err == nil && latest.Fingerprint == current.Fingerprint {
+			return nil
+		}
+
+		snapshotID := shared.NewUUID[analysis.AnalysisSnapshot]()
+		if err := r.GetQueries(ctx).CreateAnalysisSnapshot(ctx, model.CreateAnalysisSnapshotParams{
+			AnalysisSnapshotID: snapshotID.UUID(),
+			TalkSessionID:      talkSessionID.UUID(),
+			Fingerprint:        current.Fingerprint,
+			UserCount:          int32(current.UserCount),
+			GroupCount:         int32(current.GroupCount),
+			CreatedAt:          createdAt,
+		}); err != nil {
+			return err
+		}
+		if err := r.GetQueries(ctx).CopyUserGroupInfoToAnalysisSnapshot(ctx, model.CopyUserGroupInfoToAnalysisSnapshotParams{
+			AnalysisSnapshotID: snapshotID.UUID(),
+			TalkSessionID:      talkSessionID.UUID(),
+		}); err != nil {
+			return err
+		}
+		if err := r.GetQueries(ctx).CopyRepresentativeOpinionsToAnalysisSnapshot(ctx, model.CopyRepresentativeOpinionsToAnalysisSnapshotParams{
+			AnalysisSnapshotID: snapshotID.UUID(),
+			TalkSessionID:      talkSessionID.UUID(),
+		}); err != nil {
+			return err
+		}
+
+		snapshot = &analysis.AnalysisSnapshot{
+			AnalysisSnapshotID: snapshotID,
+			TalkSessionID:      talkSessionID,
+			UserCount:          int(current.UserCount),
+			GroupCount:         int(current.GroupCount),
+			CreatedAt:          createdAt,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"braces.dev/errtrace"
+	"github.com/google/uuid"
+	"github.com/neko-dream/api/internal/domain/model/auth/login_attempt"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	model "github.com/neko-dream/api/internal/infrastructure/persistence/sqlc/generated"
+	"github.com/neko-dream/api/pkg/utils"
+	"github.com/sqlc-dev/pqtype"
+	"go.opentelemetry.io/otel"
+)
+
+type loginAttemptRepository struct {
+	*db.DBManager
+}
+
+func NewLoginAttemptRepository(dbManager *db.DBManager) login_attempt.LoginAttemptRepository {
+	return &loginAttemptRepository{
+		DBManager: dbManager,
+	}
+}
+
+func (r *loginAttemptRepository) Create(ctx context.Context, attempt login_attempt.LoginAttempt) error {
+	ctx, span := otel.Tracer("repository").Start(ctx, "loginAttemptRepository.Create")
+	defer span.End()
+
+	var userID uuid.NullUUID
+	if attempt.UserID != nil {
+		userID = uuid.NullUUID{UUID: attempt.UserID.UUID(), Valid: true}
+	}
+
+	// IPアドレスとして解釈できない値は保存しない
+	var ipAddress pqtype.Inet
+	if attempt.IPAddress != nil {
+		if ipNet := utils.ParseIPNet(*attempt.IPAddress); ipNet.IP != nil {
+			ipAddress = pqtype.Inet{IPNet: ipNet, Valid: true}
+		}
+	}
+
+	var userAgent sql.NullString
+	if attempt.UserAgent != nil {
+		userAgent = sql.NullString{String: *attempt.UserAgent, Valid: true}
+	}
+
+	var failureReason sql.NullString
+	if attempt.FailureReason != nil {
+		failureReason = sql.NullString{String: string(*attempt.FailureReason), Valid: true}
+	}
+
+	if err := r.GetQueries(ctx).CreateLoginAttempt(ctx, model.CreateLoginAttemptParams{
+		LoginAttemptID: attempt.LoginAttemptID.UUID(),
+		UserID:         userID,
+		IpAddress:      ipAddress,
+		UserAgent:      userAgent,
+		Succeeded:      attempt.Succeeded,
+		FailureReason:  failureReason,
+		AttemptedAt:    attempt.AttemptedAt,
+	}); err != nil {
+		utils.HandleError(ctx, err, "CreateLoginAttempt")
+		return errtrace.Wrap(err)
+	}
+
+	return nil
+}
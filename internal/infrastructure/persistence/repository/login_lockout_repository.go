@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"braces.dev/errtrace"
+	"github.com/neko-dream/api/internal/domain/model/auth/login_attempt"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	model "github.com/neko-dream/api/internal/infrastructure/persistence/sqlc/generated"
+	"github.com/neko-dream/api/pkg/utils"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel"
+)
+
+type loginLockoutRepository struct {
+	*db.DBManager
+}
+
+func NewLoginLockoutRepository(dbManager *db.DBManager) login_attempt.LockoutRepository {
+	return &loginLockoutRepository{
+		DBManager: dbManager,
+	}
+}
+
+func (r *loginLockoutRepository) FindByKey(ctx context.Context, scope login_attempt.LockoutScope, key string) (*login_attempt.Lockout, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "loginLockoutRepository.FindByKey")
+	defer span.End()
+
+	row, err := r.GetQueries(ctx).FindLoginLockout(ctx, model.FindLoginLockoutParams{
+		Scope:   string(scope),
+		LockKey: key,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		utils.HandleError(ctx, err, "FindLoginLockout")
+		return nil, errtrace.Wrap(err)
+	}
+
+	return toLockout(row), nil
+}
+
+func (r *loginLockoutRepository) FindByKeyForUpdate(ctx context.Context, scope login_attempt.LockoutScope, key string) (*login_attempt.Lockout, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "loginLockoutRepository.FindByKeyForUpdate")
+	defer span.End()
+
+	row, err := r.GetQueries(ctx).LockLoginLockout(ctx, model.LockLoginLockoutParams{
+		Scope:     string(scope),
+		LockKey:   key,
+		UpdatedAt: clock.Now(ctx),
+	})
+	if err != nil {
+		utils.HandleError(ctx, err, "LockLoginLockout")
+		return nil, errtrace.Wrap(err)
+	}
+
+	return toLockout(row), nil
+}
+
+func toLockout(row model.LoginLockout) *login_attempt.Lockout {
+	lockout := &login_attempt.Lockout{
+		Scope:        login_attempt.LockoutScope(row.Scope),
+		Key:          row.LockKey,
+		FailureCount: int(row.FailureCount),
+		LockoutCount: int(row.LockoutCount),
+	}
+	if row.LastFailedAt.Valid {
+		lockout.LastFailedAt = lo.ToPtr(row.LastFailedAt.Time)
+	}
+	if row.LockedUntil.Valid {
+		lockout.LockedUntil = lo.ToPtr(row.LockedUntil.Time)
+	}
+	return lockout
+}
+
+func (r *loginLockoutRepository) Save(ctx context.Context, lockout login_attempt.Lockout) error {
+	ctx, span := otel.Tracer("repository").Start(ctx, "loginLockoutRepository.Save")
+	defer span.End()
+
+	var lastFailedAt, lockedUntil sql.NullTime
+	if lockout.LastFailedAt != nil {
+		lastFailedAt = sql.NullTime{Time: *lockout.LastFailedAt, Valid: true}
+	}
+	if lockout.LockedUntil != nil {
+		lockedUntil = sql.NullTime{Time: *lockout.LockedUntil, Valid: true}
+	}
+
+	if err := r.GetQueries(ctx).UpsertLoginLockout(ctx, model.UpsertLoginLockoutParams{
+		Scope:        string(lockout.Scope),
+		LockKey:      lockout.Key,
+		FailureCount: int32(lockout.FailureCount),
+		LockoutCount: int32(lockout.LockoutCount),
+		LastFailedAt: lastFailedAt,
+		LockedUntil:  lockedUntil,
+		UpdatedAt:    clock.Now(ctx),
+	}); err != nil {
+		utils.HandleError(ctx, err, "UpsertLoginLockout")
+		return errtrace.Wrap(err)
+	}
+
+	return nil
+}
+
+func (r *loginLockoutRepository) Delete(ctx context.Context, scope login_attempt.LockoutScope, key string) error {
+	ctx, span := otel.Tracer("repository").Start(ctx, "loginLockoutRepository.Delete")
+	defer span.End()
+
+	if err := r.GetQueries(ctx).DeleteLoginLockout(ctx, model.DeleteLoginLockoutParams{
+		Scope:   string(scope),
+		LockKey: key,
+	}); err != nil {
+		utils.HandleError(ctx, err, "DeleteLoginLockout")
+		return errtrace.Wrap(err)
+	}
+
+	return nil
+}
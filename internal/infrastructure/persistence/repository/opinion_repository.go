@@ -3,8 +3,10 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/google/uuid"
+	"github.com/neko-dream/api/internal/domain/model/event"
 	"github.com/neko-dream/api/internal/domain/model/image"
 	"github.com/neko-dream/api/internal/domain/model/opinion"
 	"github.com/neko-dream/api/internal/domain/model/shared"
@@ -20,15 +22,18 @@ import (
 type opinionRepository struct {
 	*db.DBManager
 	image.ImageStorage
+	eventStore event.EventStore
 }
 
 func NewOpinionRepository(
 	dbManager *db.DBManager,
 	imageRepo image.ImageStorage,
+	eventStore event.EventStore,
 ) opinion.OpinionRepository {
 	return &opinionRepository{
 		DBManager:    dbManager,
 		ImageStorage: imageRepo,
+		eventStore:   eventStore,
 	}
 }
 
@@ -69,6 +74,15 @@ func (o *opinionRepository) Create(ctx context.Context, op opinion.Opinion) erro
 		utils.HandleError(ctx, err, "opinionRepository.Create")
 		return err
 	}
+
+	// イベントがある場合は保存
+	events := op.GetRecordedEvents()
+	if len(events) > 0 {
+		if err := o.eventStore.StoreBatch(ctx, events); err != nil {
+			utils.HandleError(ctx, err, "opinionRepository.Create")
+			return err
+		}
+	}
 	return nil
 }
 
@@ -91,6 +105,10 @@ func (o *opinionRepository) FindByID(ctx context.Context, opinionID shared.UUID[
 	if op.Opinion.Title.Valid {
 		title = lo.ToPtr(op.Opinion.Title.String)
 	}
+	var referenceURL *string
+	if op.Opinion.ReferenceUrl.Valid {
+		referenceURL = lo.ToPtr(op.Opinion.ReferenceUrl.String)
+	}
 
 	opEntity, err := opinion.NewOpinion(
 		opinionID,
@@ -100,16 +118,49 @@ func (o *opinionRepository) FindByID(ctx context.Context, opinionID shared.UUID[
 		title,
 		op.Opinion.Content,
 		op.Opinion.CreatedAt,
-		lo.ToPtr(op.Opinion.ReferenceUrl.String),
+		referenceURL,
 	)
 	if err != nil {
 		utils.HandleError(ctx, err, "opinionRepository.FindByID")
 		return nil, err
 	}
+	restoreEditState(opEntity, op.Opinion)
 
 	return opEntity, nil
 }
 
+// Update 編集・削除した意見を保存する
+func (o *opinionRepository) Update(ctx context.Context, op opinion.Opinion) error {
+	ctx, span := otel.Tracer("repository").Start(ctx, "opinionRepository.Update")
+	defer span.End()
+
+	if err := o.GetQueries(ctx).UpdateOpinion(ctx, model.UpdateOpinionParams{
+		OpinionID:    op.OpinionID().UUID(),
+		Title:        utils.ToNullableSQL[sql.NullString](op.Title()),
+		Content:      op.Content(),
+		ReferenceUrl: utils.ToNullableSQL[sql.NullString](op.ReferenceURL()),
+		Revision:     int32(op.Revision()),
+		EditedAt:     utils.ToNullableSQL[sql.NullTime](op.EditedAt()),
+		DeletedAt:    utils.ToNullableSQL[sql.NullTime](op.DeletedAt()),
+	}); err != nil {
+		utils.HandleError(ctx, err, "opinionRepository.Update")
+		return err
+	}
+	return nil
+}
+
+// restoreEditState 編集・削除の状態をエンティティに復元する
+func restoreEditState(op *opinion.Opinion, row model.Opinion) {
+	var editedAt, deletedAt *time.Time
+	if row.EditedAt.Valid {
+		editedAt = lo.ToPtr(row.EditedAt.Time)
+	}
+	if row.DeletedAt.Valid {
+		deletedAt = lo.ToPtr(row.DeletedAt.Time)
+	}
+	op.RestoreEditState(int(row.Revision), editedAt, deletedAt)
+}
+
 // FindByParentID implements opinion.OpinionRepository.
 func (o *opinionRepository) FindByParentID(ctx context.Context, opinionID shared.UUID[opinion.Opinion]) ([]opinion.Opinion, error) {
 	ctx, span := otel.Tracer("repository").Start(ctx, "opinionRepository.FindByParentID")
@@ -120,6 +171,49 @@ func (o *opinionRepository) FindByParentID(ctx context.Context, opinionID shared
 	panic("unimplemented")
 }
 
+// FindSeedsByTalkSessionID implements opinion.OpinionRepository.
+func (o *opinionRepository) FindSeedsByTalkSessionID(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession]) ([]opinion.Opinion, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "opinionRepository.FindSeedsByTalkSessionID")
+	defer span.End()
+
+	rows, err := o.GetQueries(ctx).GetSeedOpinionsByTalkSessionID(ctx, talkSessionID.UUID())
+	if err != nil {
+		utils.HandleError(ctx, err, "opinionRepository.FindSeedsByTalkSessionID")
+		return nil, err
+	}
+
+	opinions := make([]opinion.Opinion, 0, len(rows))
+	for _, row := range rows {
+		var title, referenceURL *string
+		if row.Title.Valid {
+			title = lo.ToPtr(row.Title.String)
+		}
+		if row.ReferenceUrl.Valid {
+			referenceURL = lo.ToPtr(row.ReferenceUrl.String)
+		}
+		op, err := opinion.NewOpinion(
+			shared.UUID[opinion.Opinion](row.OpinionID),
+			talkSessionID,
+			shared.UUID[user.User](row.UserID),
+			nil,
+			title,
+			row.Content,
+			row.CreatedAt,
+			referenceURL,
+		)
+		if err != nil {
+			utils.HandleError(ctx, err, "opinionRepository.FindSeedsByTalkSessionID")
+			return nil, err
+		}
+		if row.PictureUrl.Valid {
+			op.ChangeReferenceImageURL(lo.ToPtr(row.PictureUrl.String))
+		}
+		restoreEditState(op, row)
+		opinions = append(opinions, *op)
+	}
+	return opinions, nil
+}
+
 // FindByTalkSessionID implements opinion.OpinionRepository.
 func (o *opinionRepository) FindByTalkSessionID(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession]) ([]opinion.Opinion, error) {
 	ctx, span := otel.Tracer("repository").Start(ctx, "opinionRepository.FindByTalkSessionID")
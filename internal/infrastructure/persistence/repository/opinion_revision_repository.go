@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/neko-dream/api/internal/domain/model/opinion"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	model "github.com/neko-dream/api/internal/infrastructure/persistence/sqlc/generated"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type opinionRevisionRepository struct {
+	*db.DBManager
+}
+
+func NewOpinionRevisionRepository(dbManager *db.DBManager) opinion.OpinionRevisionRepository {
+	return &opinionRevisionRepository{DBManager: dbManager}
+}
+
+// Create 編集前の意見の内容を保存する
+func (r *opinionRevisionRepository) Create(ctx context.Context, revision opinion.OpinionRevision) error {
+	ctx, span := otel.Tracer("repository").Start(ctx, "opinionRevisionRepository.Create")
+	defer span.End()
+
+	if err := r.GetQueries(ctx).CreateOpinionRevision(ctx, model.CreateOpinionRevisionParams{
+		OpinionID:    revision.OpinionID().UUID(),
+		Revision:     int32(revision.Revision()),
+		Title:        utils.ToNullableSQL[sql.NullString](revision.Title()),
+		Content:      revision.Content(),
+		ReferenceUrl: utils.ToNullableSQL[sql.NullString](revision.ReferenceURL()),
+		VoteCount:    int32(revision.VoteCount()),
+		VotesReset:   revision.VotesReset(),
+		CreatedAt:    revision.CreatedAt(),
+		ReplacedAt:   revision.ReplacedAt(),
+	}); err != nil {
+		utils.HandleError(ctx, err, "opinionRevisionRepository.Create")
+		return err
+	}
+	return nil
+}
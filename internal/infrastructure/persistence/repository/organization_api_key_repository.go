@@ -0,0 +1,192 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"braces.dev/errtrace"
+	"github.com/google/uuid"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	model "github.com/neko-dream/api/internal/infrastructure/persistence/sqlc/generated"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type organizationAPIKeyRepository struct {
+	*db.DBManager
+}
+
+func NewOrganizationAPIKeyRepository(dbManager *db.DBManager) organization.OrganizationAPIKeyRepository {
+	return &organizationAPIKeyRepository{
+		DBManager: dbManager,
+	}
+}
+
+// Create APIキーを保存する
+func (r *organizationAPIKeyRepository) Create(ctx context.Context, key *organization.OrganizationAPIKey) error {
+	ctx, span := otel.Tracer("repository").Start(ctx, "organizationAPIKeyRepository.Create")
+	defer span.End()
+
+	scopes := make([]string, 0, len(key.Scopes()))
+	for _, scope := range key.Scopes() {
+		scopes = append(scopes, string(scope))
+	}
+
+	var expiresAt sql.NullTime
+	if key.ExpiresAt() != nil {
+		expiresAt = sql.NullTime{Time: *key.ExpiresAt(), Valid: true}
+	}
+
+	if err := r.GetQueries(ctx).CreateOrganizationAPIKey(ctx, model.CreateOrganizationAPIKeyParams{
+		ApiKeyID:       key.APIKeyID().UUID(),
+		OrganizationID: key.OrganizationID().UUID(),
+		Name:           key.Name(),
+		KeyPrefix:      key.KeyPrefix(),
+		KeyHash:        key.KeyHash(),
+		Scopes:         scopes,
+		CreatedBy:      key.CreatedBy().UUID(),
+		ExpiresAt:      expiresAt,
+		CreatedAt:      key.CreatedAt(),
+	}); err != nil {
+		utils.HandleError(ctx, err, "CreateOrganizationAPIKey")
+		return errtrace.Wrap(err)
+	}
+
+	return nil
+}
+
+// FindByID IDでAPIキーを取得する
+func (r *organizationAPIKeyRepository) FindByID(ctx context.Context, apiKeyID shared.UUID[organization.OrganizationAPIKey]) (*organization.OrganizationAPIKey, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "organizationAPIKeyRepository.FindByID")
+	defer span.End()
+
+	row, err := r.GetQueries(ctx).FindOrganizationAPIKeyByID(ctx, apiKeyID.UUID())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		utils.HandleError(ctx, err, "FindOrganizationAPIKeyByID")
+		return nil, errtrace.Wrap(err)
+	}
+
+	return r.fromRow(row), nil
+}
+
+// FindByHash キーのハッシュでAPIキーを取得する
+func (r *organizationAPIKeyRepository) FindByHash(ctx context.Context, keyHash string) (*organization.OrganizationAPIKey, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "organizationAPIKeyRepository.FindByHash")
+	defer span.End()
+
+	row, err := r.GetQueries(ctx).FindOrganizationAPIKeyByHash(ctx, keyHash)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		utils.HandleError(ctx, err, "FindOrganizationAPIKeyByHash")
+		return nil, errtrace.Wrap(err)
+	}
+
+	return r.fromRow(row), nil
+}
+
+// FindByOrganizationID 組織のAPIキーを作成日の新しい順に取得する
+func (r *organizationAPIKeyRepository) FindByOrganizationID(ctx context.Context, organizationID shared.UUID[organization.Organization]) ([]*organization.OrganizationAPIKey, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "organizationAPIKeyRepository.FindByOrganizationID")
+	defer span.End()
+
+	rows, err := r.GetQueries(ctx).FindOrganizationAPIKeysByOrganizationID(ctx, organizationID.UUID())
+	if err != nil {
+		utils.HandleError(ctx, err, "FindOrganizationAPIKeysByOrganizationID")
+		return nil, errtrace.Wrap(err)
+	}
+
+	keys := make([]*organization.OrganizationAPIKey, 0, len(rows))
+	for _, row := range rows {
+		keys = append(keys, r.fromRow(row))
+	}
+	return keys, nil
+}
+
+// Revoke APIキーの失効を保存する
+func (r *organizationAPIKeyRepository) Revoke(ctx context.Context, key *organization.OrganizationAPIKey) error {
+	ctx, span := otel.Tracer("repository").Start(ctx, "organizationAPIKeyRepository.Revoke")
+	defer span.End()
+
+	if key.RevokedAt() == nil || key.RevokedBy() == nil {
+		return errtrace.Wrap(errors.New("api key is not revoked"))
+	}
+
+	if err := r.GetQueries(ctx).RevokeOrganizationAPIKey(ctx, model.RevokeOrganizationAPIKeyParams{
+		ApiKeyID:  key.APIKeyID().UUID(),
+		RevokedAt: sql.NullTime{Time: *key.RevokedAt(), Valid: true},
+		RevokedBy: uuid.NullUUID{UUID: key.RevokedBy().UUID(), Valid: true},
+	}); err != nil {
+		utils.HandleError(ctx, err, "RevokeOrganizationAPIKey")
+		return errtrace.Wrap(err)
+	}
+
+	return nil
+}
+
+// UpdateLastUsed 最終使用日時を保存する
+func (r *organizationAPIKeyRepository) UpdateLastUsed(ctx context.Context, key *organization.OrganizationAPIKey) error {
+	ctx, span := otel.Tracer("repository").Start(ctx, "organizationAPIKeyRepository.UpdateLastUsed")
+	defer span.End()
+
+	if key.LastUsedAt() == nil {
+		return nil
+	}
+
+	if err := r.GetQueries(ctx).UpdateOrganizationAPIKeyLastUsed(ctx, model.UpdateOrganizationAPIKeyLastUsedParams{
+		ApiKeyID:   key.APIKeyID().UUID(),
+		LastUsedAt: sql.NullTime{Time: *key.LastUsedAt(), Valid: true},
+	}); err != nil {
+		utils.HandleError(ctx, err, "UpdateOrganizationAPIKeyLastUsed")
+		return errtrace.Wrap(err)
+	}
+
+	return nil
+}
+
+func (r *organizationAPIKeyRepository) fromRow(row model.OrganizationApiKey) *organization.OrganizationAPIKey {
+	scopes := make([]organization.APIKeyScope, 0, len(row.Scopes))
+	for _, scope := range row.Scopes {
+		scopes = append(scopes, organization.APIKeyScope(scope))
+	}
+
+	var expiresAt, lastUsedAt, revokedAt *time.Time
+	if row.ExpiresAt.Valid {
+		expiresAt = &row.ExpiresAt.Time
+	}
+	if row.LastUsedAt.Valid {
+		lastUsedAt = &row.LastUsedAt.Time
+	}
+	if row.RevokedAt.Valid {
+		revokedAt = &row.RevokedAt.Time
+	}
+	var revokedBy *shared.UUID[user.User]
+	if row.RevokedBy.Valid {
+		id := shared.UUID[user.User](row.RevokedBy.UUID)
+		revokedBy = &id
+	}
+
+	return organization.ReconstructOrganizationAPIKey(
+		shared.UUID[organization.OrganizationAPIKey](row.ApiKeyID),
+		shared.UUID[organization.Organization](row.OrganizationID),
+		row.Name,
+		row.KeyPrefix,
+		row.KeyHash,
+		scopes,
+		shared.UUID[user.User](row.CreatedBy),
+		expiresAt,
+		lastUsedAt,
+		revokedAt,
+		revokedBy,
+		row.CreatedAt,
+	)
+}
@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	"braces.dev/errtrace"
+	"github.com/google/uuid"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	model "github.com/neko-dream/api/internal/infrastructure/persistence/sqlc/generated"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type organizationAuditLogRepository struct {
+	*db.DBManager
+}
+
+func NewOrganizationAuditLogRepository(dbManager *db.DBManager) organization.OrganizationAuditLogRepository {
+	return &organizationAuditLogRepository{
+		DBManager: dbManager,
+	}
+}
+
+// Create 監査ログを追記する
+func (r *organizationAuditLogRepository) Create(ctx context.Context, auditLog *organization.OrganizationAuditLog) error {
+	ctx, span := otel.Tracer("repository").Start(ctx, "organizationAuditLogRepository.Create")
+	defer span.End()
+
+	changes, err := json.Marshal(auditLog.Changes())
+	if err != nil {
+		utils.HandleError(ctx, err, "json.Marshal")
+		return errtrace.Wrap(err)
+	}
+
+	if err := r.GetQueries(ctx).CreateOrganizationAuditLog(ctx, model.CreateOrganizationAuditLogParams{
+		AuditLogID:     auditLog.AuditLogID().UUID(),
+		OrganizationID: auditLog.OrganizationID().UUID(),
+		ActorID:        auditLog.ActorID().UUID(),
+		Action:         string(auditLog.Action()),
+		TargetType:     string(auditLog.TargetType()),
+		TargetID:       auditLog.TargetID(),
+		Changes:        changes,
+		CreatedAt:      auditLog.CreatedAt(),
+	}); err != nil {
+		utils.HandleError(ctx, err, "CreateOrganizationAuditLog")
+		return errtrace.Wrap(err)
+	}
+
+	return nil
+}
+
+// FindByOrganizationID 組織の監査ログを新しい順に取得する
+func (r *organizationAuditLogRepository) FindByOrganizationID(ctx context.Context, organizationID shared.UUID[organization.Organization], filter organization.AuditLogFilter) ([]*organization.OrganizationAuditLog, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "organizationAuditLogRepository.FindByOrganizationID")
+	defer span.End()
+
+	action, actorID, since, until := auditLogFilterParams(filter)
+	var (
+		beforeCreatedAt  sql.NullTime
+		beforeAuditLogID uuid.NullUUID
+	)
+	if filter.Before != nil {
+		beforeCreatedAt = sql.NullTime{Time: filter.Before.CreatedAt, Valid: true}
+		beforeAuditLogID = uuid.NullUUID{UUID: filter.Before.AuditLogID.UUID(), Valid: true}
+	}
+	rows, err := r.GetQueries(ctx).FindOrganizationAuditLogs(ctx, model.FindOrganizationAuditLogsParams{
+		OrganizationID:   organizationID.UUID(),
+		Action:           action,
+		ActorID:          actorID,
+		Since:            since,
+		Until:            until,
+		BeforeCreatedAt:  beforeCreatedAt,
+		BeforeAuditLogID: beforeAuditLogID,
+		Limit:            int32(filter.Limit),
+		Offset:           int32(filter.Offset),
+	})
+	if err != nil {
+		utils.HandleError(ctx, err, "FindOrganizationAuditLogs")
+		return nil, errtrace.Wrap(err)
+	}
+
+	auditLogs := make([]*organization.OrganizationAuditLog, 0, len(rows))
+	for _, row := range rows {
+		var changes organization.AuditChanges
+		if err := json.Unmarshal(row.Changes, &changes); err != nil {
+			utils.HandleError(ctx, err, "json.Unmarshal")
+			return nil, errtrace.Wrap(err)
+		}
+		auditLogs = append(auditLogs, organization.ReconstructOrganizationAuditLog(
+			shared.UUID[organization.OrganizationAuditLog](row.AuditLogID),
+			shared.UUID[organization.Organization](row.OrganizationID),
+			shared.UUID[user.User](row.ActorID),
+			organization.AuditAction(row.Action),
+			organization.AuditTargetType(row.TargetType),
+			row.TargetID,
+			changes,
+			row.CreatedAt,
+		))
+	}
+	return auditLogs, nil
+}
+
+// CountByOrganizationID 絞り込み条件に一致する監査ログの件数を取得する
+func (r *organizationAuditLogRepository) CountByOrganizationID(ctx context.Context, organizationID shared.UUID[organization.Organization], filter organization.AuditLogFilter) (int, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "organizationAuditLogRepository.CountByOrganizationID")
+	defer span.End()
+
+	action, actorID, since, until := auditLogFilterParams(filter)
+	count, err := r.GetQueries(ctx).CountOrganizationAuditLogs(ctx, model.CountOrganizationAuditLogsParams{
+		OrganizationID: organizationID.UUID(),
+		Action:         action,
+		ActorID:        actorID,
+		Since:          since,
+		Until:          until,
+	})
+	if err != nil {
+		utils.HandleError(ctx, err, "CountOrganizationAuditLogs")
+		return 0, errtrace.Wrap(err)
+	}
+
+	return int(count), nil
+}
+
+func auditLogFilterParams(filter organization.AuditLogFilter) (sql.NullString, uuid.NullUUID, sql.NullTime, sql.NullTime) {
+	var (
+		action  sql.NullString
+		actorID uuid.NullUUID
+		since   sql.NullTime
+		until   sql.NullTime
+	)
+	if filter.Action != nil {
+		action = sql.NullString{String: string(*filter.Action), Valid: true}
+	}
+	if filter.ActorID != nil {
+		actorID = uuid.NullUUID{UUID: filter.ActorID.UUID(), Valid: true}
+	}
+	if filter.Since != nil {
+		since = sql.NullTime{Time: *filter.Since, Valid: true}
+	}
+	if filter.Until != nil {
+		until = sql.NullTime{Time: *filter.Until, Valid: true}
+	}
+	return action, actorID, since, until
+}
@@ -0,0 +1,218 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"braces.dev/errtrace"
+	"github.com/google/uuid"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/crypto"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	model "github.com/neko-dream/api/internal/infrastructure/persistence/sqlc/generated"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type organizationInvitationRepository struct {
+	*db.DBManager
+	encryptor crypto.Encryptor
+}
+
+func NewOrganizationInvitationRepository(
+	dbManager *db.DBManager,
+	encryptor crypto.Encryptor,
+) organization.OrganizationInvitationRepository {
+	return &organizationInvitationRepository{
+		DBManager: dbManager,
+		encryptor: encryptor,
+	}
+}
+
+// Create 招待を保存する
+func (r *organizationInvitationRepository) Create(ctx context.Context, invitation *organization.OrganizationInvitation) error {
+	ctx, span := otel.Tracer("repository").Start(ctx, "organizationInvitationRepository.Create")
+	defer span.End()
+
+	email, err := r.encryptor.EncryptString(ctx, invitation.Email())
+	if err != nil {
+		utils.HandleError(ctx, err, "Encryptor.EncryptString")
+		return errtrace.Wrap(err)
+	}
+
+	if err := r.GetQueries(ctx).CreateOrganizationInvitation(ctx, model.CreateOrganizationInvitationParams{
+		InvitationID:   invitation.InvitationID().UUID(),
+		OrganizationID: invitation.OrganizationID().UUID(),
+		Email:          email,
+		EmailHash:      invitation.EmailHash(),
+		Role:           int32(invitation.Role()),
+		TokenHash:      invitation.TokenHash(),
+		Status:         string(invitation.StoredStatus()),
+		InvitedBy:      invitation.InvitedBy().UUID(),
+		ExpiresAt:      invitation.ExpiresAt(),
+		SendCount:      int32(invitation.SendCount()),
+		LastSentAt:     invitation.LastSentAt(),
+		CreatedAt:      invitation.CreatedAt(),
+	}); err != nil {
+		utils.HandleError(ctx, err, "CreateOrganizationInvitation")
+		return errtrace.Wrap(err)
+	}
+
+	return nil
+}
+
+// Update 招待の状態を保存する
+func (r *organizationInvitationRepository) Update(ctx context.Context, invitation *organization.OrganizationInvitation) error {
+	ctx, span := otel.Tracer("repository").Start(ctx, "organizationInvitationRepository.Update")
+	defer span.End()
+
+	var acceptedBy uuid.NullUUID
+	if invitation.AcceptedBy() != nil {
+		acceptedBy = uuid.NullUUID{UUID: invitation.AcceptedBy().UUID(), Valid: true}
+	}
+	var acceptedAt, revokedAt sql.NullTime
+	if invitation.AcceptedAt() != nil {
+		acceptedAt = sql.NullTime{Time: *invitation.AcceptedAt(), Valid: true}
+	}
+	if invitation.RevokedAt() != nil {
+		revokedAt = sql.NullTime{Time: *invitation.RevokedAt(), Valid: true}
+	}
+
+	if err := r.GetQueries(ctx).UpdateOrganizationInvitation(ctx, model.UpdateOrganizationInvitationParams{
+		InvitationID: invitation.InvitationID().UUID(),
+		TokenHash:    invitation.TokenHash(),
+		Status:       string(invitation.StoredStatus()),
+		ExpiresAt:    invitation.ExpiresAt(),
+		SendCount:    int32(invitation.SendCount()),
+		LastSentAt:   invitation.LastSentAt(),
+		AcceptedBy:   acceptedBy,
+		AcceptedAt:   acceptedAt,
+		RevokedAt:    revokedAt,
+		UpdatedAt:    clock.Now(ctx),
+	}); err != nil {
+		utils.HandleError(ctx, err, "UpdateOrganizationInvitation")
+		return errtrace.Wrap(err)
+	}
+
+	return nil
+}
+
+// FindByID IDで招待を取得する
+func (r *organizationInvitationRepository) FindByID(ctx context.Context, invitationID shared.UUID[organization.OrganizationInvitation]) (*organization.OrganizationInvitation, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "organizationInvitationRepository.FindByID")
+	defer span.End()
+
+	row, err := r.GetQueries(ctx).FindOrganizationInvitationByID(ctx, invitationID.UUID())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		utils.HandleError(ctx, err, "FindOrganizationInvitationByID")
+		return nil, errtrace.Wrap(err)
+	}
+
+	return r.fromRow(ctx, row)
+}
+
+// FindByTokenHash 招待トークンのハッシュで招待を取得する
+func (r *organizationInvitationRepository) FindByTokenHash(ctx context.Context, tokenHash string) (*organization.OrganizationInvitation, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "organizationInvitationRepository.FindByTokenHash")
+	defer span.End()
+
+	row, err := r.GetQueries(ctx).FindOrganizationInvitationByTokenHash(ctx, tokenHash)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		utils.HandleError(ctx, err, "FindOrganizationInvitationByTokenHash")
+		return nil, errtrace.Wrap(err)
+	}
+
+	return r.fromRow(ctx, row)
+}
+
+// FindPendingByEmailHash 未承諾の招待をメールアドレスのハッシュで取得する
+func (r *organizationInvitationRepository) FindPendingByEmailHash(ctx context.Context, organizationID shared.UUID[organization.Organization], emailHash string) (*organization.OrganizationInvitation, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "organizationInvitationRepository.FindPendingByEmailHash")
+	defer span.End()
+
+	row, err := r.GetQueries(ctx).FindPendingOrganizationInvitationByEmailHash(ctx, model.FindPendingOrganizationInvitationByEmailHashParams{
+		OrganizationID: organizationID.UUID(),
+		EmailHash:      emailHash,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		utils.HandleError(ctx, err, "FindPendingOrganizationInvitationByEmailHash")
+		return nil, errtrace.Wrap(err)
+	}
+
+	return r.fromRow(ctx, row)
+}
+
+// FindByOrganizationID 組織の招待を作成日の新しい順に取得する
+func (r *organizationInvitationRepository) FindByOrganizationID(ctx context.Context, organizationID shared.UUID[organization.Organization]) ([]*organization.OrganizationInvitation, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "organizationInvitationRepository.FindByOrganizationID")
+	defer span.End()
+
+	rows, err := r.GetQueries(ctx).FindOrganizationInvitationsByOrganizationID(ctx, organizationID.UUID())
+	if err != nil {
+		utils.HandleError(ctx, err, "FindOrganizationInvitationsByOrganizationID")
+		return nil, errtrace.Wrap(err)
+	}
+
+	invitations := make([]*organization.OrganizationInvitation, 0, len(rows))
+	for _, row := range rows {
+		invitation, err := r.fromRow(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		invitations = append(invitations, invitation)
+	}
+	return invitations, nil
+}
+
+func (r *organizationInvitationRepository) fromRow(ctx context.Context, row model.OrganizationInvitation) (*organization.OrganizationInvitation, error) {
+	email, err := r.encryptor.DecryptString(ctx, row.Email)
+	if err != nil {
+		utils.HandleError(ctx, err, "Encryptor.DecryptString")
+		return nil, errtrace.Wrap(err)
+	}
+
+	var acceptedBy *shared.UUID[user.User]
+	if row.AcceptedBy.Valid {
+		id := shared.UUID[user.User](row.AcceptedBy.UUID)
+		acceptedBy = &id
+	}
+	var acceptedAt, revokedAt *time.Time
+	if row.AcceptedAt.Valid {
+		acceptedAt = &row.AcceptedAt.Time
+	}
+	if row.RevokedAt.Valid {
+		revokedAt = &row.RevokedAt.Time
+	}
+
+	return organization.ReconstructOrganizationInvitation(
+		shared.UUID[organization.OrganizationInvitation](row.InvitationID),
+		shared.UUID[organization.Organization](row.OrganizationID),
+		email,
+		row.EmailHash,
+		organization.OrganizationUserRole(row.Role),
+		row.TokenHash,
+		organization.InvitationStatus(row.Status),
+		shared.UUID[user.User](row.InvitedBy),
+		row.ExpiresAt,
+		int(row.SendCount),
+		row.LastSentAt,
+		acceptedBy,
+		acceptedAt,
+		revokedAt,
+		row.CreatedAt,
+	), nil
+}
@@ -32,6 +32,11 @@ func (o *organizationRepository) Update(ctx context.Context, org *organization.O
 		OrganizationID: org.OrganizationID.UUID(),
 		Name:           org.Name,
 		IconUrl:        sql.NullString{String: lo.FromPtrOr(org.IconURL, ""), Valid: org.IconURL != nil},
+		ParentOrganizationID: uuid.NullUUID{
+			UUID:  lo.FromPtrOr(org.ParentOrganizationID, shared.UUID[organization.Organization]{}).UUID(),
+			Valid: org.ParentOrganizationID != nil,
+		},
+		OwnerID: org.OwnerID.UUID(),
 	}); err != nil {
 		return err
 	}
@@ -67,19 +72,7 @@ func (o *organizationRepository) FindByID(ctx context.Context, id shared.UUID[or
 		return nil, err
 	}
 
-	var iconURL *string
-	if org.Organization.IconUrl.Valid {
-		iconURL = lo.ToPtr(org.Organization.IconUrl.String)
-	}
-
-	return organization.NewOrganization(
-		shared.UUID[organization.Organization](org.Organization.OrganizationID),
-		organization.OrganizationType(org.Organization.OrganizationType),
-		org.Organization.Name,
-		org.Organization.Code,
-		iconURL,
-		shared.UUID[user.User](org.Organization.OwnerID),
-	), nil
+	return toDomainOrganization(org.Organization), nil
 }
 
 // FindByIDs implements organization.OrganizationRepository.
@@ -103,19 +96,7 @@ func (o *organizationRepository) FindByIDs(ctx context.Context, ids []shared.UUI
 
 	var result []*organization.Organization
 	for _, org := range orgs {
-		var iconURL *string
-		if org.Organization.IconUrl.Valid {
-			iconURL = lo.ToPtr(org.Organization.IconUrl.String)
-		}
-
-		result = append(result, organization.NewOrganization(
-			shared.UUID[organization.Organization](org.Organization.OrganizationID),
-			organization.OrganizationType(org.Organization.OrganizationType),
-			org.Organization.Name,
-			org.Organization.Code,
-			iconURL,
-			shared.UUID[user.User](org.Organization.OwnerID),
-		))
+		result = append(result, toDomainOrganization(org.Organization))
 	}
 
 	return result, nil
@@ -131,19 +112,7 @@ func (o *organizationRepository) FindByName(ctx context.Context, name string) (*
 		return nil, err
 	}
 
-	var iconURL *string
-	if org.Organization.IconUrl.Valid {
-		iconURL = lo.ToPtr(org.Organization.IconUrl.String)
-	}
-
-	return organization.NewOrganization(
-		shared.UUID[organization.Organization](org.Organization.OrganizationID),
-		organization.OrganizationType(org.Organization.OrganizationType),
-		org.Organization.Name,
-		org.Organization.Code,
-		iconURL,
-		shared.UUID[user.User](org.Organization.OwnerID),
-	), nil
+	return toDomainOrganization(org.Organization), nil
 }
 
 // FindByCode implements organization.OrganizationRepository.
@@ -156,17 +125,82 @@ func (o *organizationRepository) FindByCode(ctx context.Context, code string) (*
 		return nil, err
 	}
 
+	return toDomainOrganization(org.Organization), nil
+}
+
+// FindChildren 直下の子組織を取得する
+func (o *organizationRepository) FindChildren(ctx context.Context, parentID shared.UUID[organization.Organization]) ([]*organization.Organization, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "organizationRepository.FindChildren")
+	defer span.End()
+
+	rows, err := o.GetQueries(ctx).FindChildOrganizations(ctx, uuid.NullUUID{UUID: parentID.UUID(), Valid: true})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*organization.Organization, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, toDomainOrganization(row.Organization))
+	}
+	return result, nil
+}
+
+// FindAncestorIDs 祖先の組織IDを近い順に取得する
+func (o *organizationRepository) FindAncestorIDs(ctx context.Context, id shared.UUID[organization.Organization]) ([]shared.UUID[organization.Organization], error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "organizationRepository.FindAncestorIDs")
+	defer span.End()
+
+	rows, err := o.GetQueries(ctx).FindAncestorOrganizationIDs(ctx, model.FindAncestorOrganizationIDsParams{
+		OrganizationID: id.UUID(),
+		MaxDepth:       organization.MaxOrganizationDepth,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]shared.UUID[organization.Organization], 0, len(rows))
+	for _, row := range rows {
+		result = append(result, shared.UUID[organization.Organization](row))
+	}
+	return result, nil
+}
+
+// FindDescendants 子孫の組織を浅い順に取得する
+func (o *organizationRepository) FindDescendants(ctx context.Context, id shared.UUID[organization.Organization]) ([]*organization.Organization, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "organizationRepository.FindDescendants")
+	defer span.End()
+
+	rows, err := o.GetQueries(ctx).FindDescendantOrganizations(ctx, model.FindDescendantOrganizationsParams{
+		OrganizationID: uuid.NullUUID{UUID: id.UUID(), Valid: true},
+		MaxDepth:       organization.MaxOrganizationDepth,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*organization.Organization, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, toDomainOrganization(row.Organization))
+	}
+	return result, nil
+}
+
+func toDomainOrganization(org model.Organization) *organization.Organization {
 	var iconURL *string
-	if org.Organization.IconUrl.Valid {
-		iconURL = lo.ToPtr(org.Organization.IconUrl.String)
+	if org.IconUrl.Valid {
+		iconURL = lo.ToPtr(org.IconUrl.String)
 	}
 
-	return organization.NewOrganization(
-		shared.UUID[organization.Organization](org.Organization.OrganizationID),
-		organization.OrganizationType(org.Organization.OrganizationType),
-		org.Organization.Name,
-		org.Organization.Code,
+	result := organization.NewOrganization(
+		shared.UUID[organization.Organization](org.OrganizationID),
+		organization.OrganizationType(org.OrganizationType),
+		org.Name,
+		org.Code,
 		iconURL,
-		shared.UUID[user.User](org.Organization.OwnerID),
-	), nil
+		shared.UUID[user.User](org.OwnerID),
+	)
+	if org.ParentOrganizationID.Valid {
+		result.ParentOrganizationID = lo.ToPtr(shared.UUID[organization.Organization](org.ParentOrganizationID.UUID))
+	}
+	return result
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/neko-dream/api/internal/domain/model/user"
 	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
 	model "github.com/neko-dream/api/internal/infrastructure/persistence/sqlc/generated"
+	"github.com/samber/lo"
 	"go.opentelemetry.io/otel"
 )
 
@@ -41,6 +42,38 @@ func (o *organizationUserRepository) Create(ctx context.Context, orgUser organiz
 	return nil
 }
 
+// Update implements organization.OrganizationUserRepository.
+func (o *organizationUserRepository) Update(ctx context.Context, orgUser organization.OrganizationUser) error {
+	ctx, span := otel.Tracer("repository").Start(ctx, "organizationUserRepository.Update")
+	defer span.End()
+
+	if err := o.GetQueries(ctx).UpdateOrgUserRole(ctx, model.UpdateOrgUserRoleParams{
+		OrganizationID: orgUser.OrganizationID.UUID(),
+		UserID:         orgUser.UserID.UUID(),
+		Role:           int32(orgUser.Role),
+		UpdatedAt:      clock.Now(ctx),
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Delete implements organization.OrganizationUserRepository.
+func (o *organizationUserRepository) Delete(ctx context.Context, orgID shared.UUID[organization.Organization], userID shared.UUID[user.User]) error {
+	ctx, span := otel.Tracer("repository").Start(ctx, "organizationUserRepository.Delete")
+	defer span.End()
+
+	if err := o.GetQueries(ctx).DeleteOrgUser(ctx, model.DeleteOrgUserParams{
+		OrganizationID: orgID.UUID(),
+		UserID:         userID.UUID(),
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // FindByOrganizationID implements organization.OrganizationUserRepository.
 func (o *organizationUserRepository) FindByOrganizationID(ctx context.Context, orgID shared.UUID[organization.Organization]) ([]*organization.OrganizationUser, error) {
 	ctx, span := otel.Tracer("repository").Start(ctx, "organizationUserRepository.FindByOrganizationID")
@@ -63,6 +96,31 @@ func (o *organizationUserRepository) FindByOrganizationID(ctx context.Context, o
 	return result, nil
 }
 
+// FindOwnersForUpdate implements organization.OrganizationUserRepository.
+func (o *organizationUserRepository) FindOwnersForUpdate(ctx context.Context, orgID shared.UUID[organization.Organization]) ([]*organization.OrganizationUser, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "organizationUserRepository.FindOwnersForUpdate")
+	defer span.End()
+
+	orgUsers, err := o.GetQueries(ctx).LockOrgUsersByOrganizationIDAndRole(ctx, model.LockOrgUsersByOrganizationIDAndRoleParams{
+		OrganizationID: orgID.UUID(),
+		Role:           int32(organization.OrganizationUserRoleOwner),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*organization.OrganizationUser, len(orgUsers))
+	for i, orgUser := range orgUsers {
+		result[i] = &organization.OrganizationUser{
+			OrganizationUserID: shared.UUID[organization.OrganizationUser](orgUser.OrganizationUser.OrganizationUserID),
+			OrganizationID:     shared.UUID[organization.Organization](orgUser.OrganizationUser.OrganizationID),
+			UserID:             shared.UUID[user.User](orgUser.OrganizationUser.UserID),
+			Role:               organization.OrganizationUserRole(orgUser.OrganizationUser.Role),
+		}
+	}
+	return result, nil
+}
+
 // FindByOrganizationIDAndUserID implements organization.OrganizationUserRepository.
 func (o *organizationUserRepository) FindByOrganizationIDAndUserID(ctx context.Context, orgID shared.UUID[organization.Organization], userID shared.UUID[user.User]) (*organization.OrganizationUser, error) {
 	ctx, span := otel.Tracer("repository").Start(ctx, "organizationUserRepository.FindByOrganizationIDAndUserID")
@@ -84,6 +142,33 @@ func (o *organizationUserRepository) FindByOrganizationIDAndUserID(ctx context.C
 	}, nil
 }
 
+// FindEffectiveByOrganizationIDAndUserID implements organization.OrganizationUserRepository.
+// 組織と祖先の組織の所属のうち最も強いロールを、対象の組織の所属として返す
+func (o *organizationUserRepository) FindEffectiveByOrganizationIDAndUserID(ctx context.Context, orgID shared.UUID[organization.Organization], userID shared.UUID[user.User]) (*organization.OrganizationUser, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "organizationUserRepository.FindEffectiveByOrganizationIDAndUserID")
+	defer span.End()
+
+	row, err := o.GetQueries(ctx).FindEffectiveOrgUserByOrganizationIDAndUserID(ctx, model.FindEffectiveOrgUserByOrganizationIDAndUserIDParams{
+		OrganizationID: orgID.UUID(),
+		UserID:         userID.UUID(),
+		MaxDepth:       organization.MaxOrganizationDepth,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	orgUser := &organization.OrganizationUser{
+		OrganizationUserID: shared.UUID[organization.OrganizationUser](row.OrganizationUser.OrganizationUserID),
+		OrganizationID:     orgID,
+		UserID:             shared.UUID[user.User](row.OrganizationUser.UserID),
+		Role:               organization.OrganizationUserRole(row.OrganizationUser.Role),
+	}
+	if row.Depth > 0 {
+		orgUser.InheritedFrom = lo.ToPtr(shared.UUID[organization.Organization](row.OrganizationUser.OrganizationID))
+	}
+	return orgUser, nil
+}
+
 // FindByUserID implements organization.OrganizationUserRepository.
 func (o *organizationUserRepository) FindByUserID(ctx context.Context, userID shared.UUID[user.User]) ([]*organization.OrganizationUser, error) {
 	ctx, span := otel.Tracer("repository").Start(ctx, "organizationUserRepository.FindByUserID")
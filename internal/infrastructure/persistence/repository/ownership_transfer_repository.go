@@ -0,0 +1,201 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"braces.dev/errtrace"
+	"github.com/google/uuid"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/event"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/ownership"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	model "github.com/neko-dream/api/internal/infrastructure/persistence/sqlc/generated"
+	"github.com/neko-dream/api/pkg/utils"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel"
+)
+
+type ownershipTransferRepository struct {
+	*db.DBManager
+	eventStore event.EventStore
+}
+
+func NewOwnershipTransferRepository(
+	dbManager *db.DBManager,
+	eventStore event.EventStore,
+) ownership.OwnershipTransferRepository {
+	return &ownershipTransferRepository{
+		DBManager:  dbManager,
+		eventStore: eventStore,
+	}
+}
+
+// Create 譲渡依頼を保存する
+func (r *ownershipTransferRepository) Create(ctx context.Context, transfer *ownership.OwnershipTransfer) error {
+	ctx, span := otel.Tracer("repository").Start(ctx, "ownershipTransferRepository.Create")
+	defer span.End()
+
+	if err := r.GetQueries(ctx).CreateOwnershipTransfer(ctx, model.CreateOwnershipTransferParams{
+		TransferID:     transfer.TransferID().UUID(),
+		TargetType:     string(transfer.TargetType()),
+		TalkSessionID:  utils.ToNullableSQL[uuid.NullUUID](transfer.TalkSessionID()),
+		OrganizationID: utils.ToNullableSQL[uuid.NullUUID](transfer.OrganizationID()),
+		FromUserID:     transfer.FromUserID().UUID(),
+		ToUserID:       transfer.ToUserID().UUID(),
+		RequestedBy:    transfer.RequestedBy().UUID(),
+		Status:         string(transfer.StoredStatus()),
+		ExpiresAt:      transfer.ExpiresAt(),
+		CreatedAt:      transfer.CreatedAt(),
+	}); err != nil {
+		utils.HandleError(ctx, err, "CreateOwnershipTransfer")
+		return errtrace.Wrap(err)
+	}
+
+	return r.storeEvents(ctx, transfer)
+}
+
+// Update 譲渡依頼の状態を保存する
+func (r *ownershipTransferRepository) Update(ctx context.Context, transfer *ownership.OwnershipTransfer) error {
+	ctx, span := otel.Tracer("repository").Start(ctx, "ownershipTransferRepository.Update")
+	defer span.End()
+
+	var respondedAt sql.NullTime
+	if transfer.RespondedAt() != nil {
+		respondedAt = sql.NullTime{Time: *transfer.RespondedAt(), Valid: true}
+	}
+
+	if err := r.GetQueries(ctx).UpdateOwnershipTransfer(ctx, model.UpdateOwnershipTransferParams{
+		TransferID:  transfer.TransferID().UUID(),
+		Status:      string(transfer.StoredStatus()),
+		RespondedAt: respondedAt,
+		UpdatedAt:   clock.Now(ctx),
+	}); err != nil {
+		utils.HandleError(ctx, err, "UpdateOwnershipTransfer")
+		return errtrace.Wrap(err)
+	}
+
+	return r.storeEvents(ctx, transfer)
+}
+
+// FindByID IDで譲渡依頼を取得する
+func (r *ownershipTransferRepository) FindByID(ctx context.Context, transferID shared.UUID[ownership.OwnershipTransfer]) (*ownership.OwnershipTransfer, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "ownershipTransferRepository.FindByID")
+	defer span.End()
+
+	row, err := r.GetQueries(ctx).FindOwnershipTransferByID(ctx, transferID.UUID())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		utils.HandleError(ctx, err, "FindOwnershipTransferByID")
+		return nil, errtrace.Wrap(err)
+	}
+
+	return r.fromRow(row), nil
+}
+
+// FindByIDForUpdate 承諾・辞退・取り消しが同時に行われないよう、譲渡依頼の行をロックして取得する
+func (r *ownershipTransferRepository) FindByIDForUpdate(ctx context.Context, transferID shared.UUID[ownership.OwnershipTransfer]) (*ownership.OwnershipTransfer, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "ownershipTransferRepository.FindByIDForUpdate")
+	defer span.End()
+
+	row, err := r.GetQueries(ctx).LockOwnershipTransferByID(ctx, transferID.UUID())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		utils.HandleError(ctx, err, "LockOwnershipTransferByID")
+		return nil, errtrace.Wrap(err)
+	}
+
+	return r.fromRow(row), nil
+}
+
+// FindPendingByTalkSessionID セッションの有効期限内の未承諾の譲渡依頼を取得する
+func (r *ownershipTransferRepository) FindPendingByTalkSessionID(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession]) (*ownership.OwnershipTransfer, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "ownershipTransferRepository.FindPendingByTalkSessionID")
+	defer span.End()
+
+	row, err := r.GetQueries(ctx).FindPendingOwnershipTransferByTalkSessionID(ctx, model.FindPendingOwnershipTransferByTalkSessionIDParams{
+		TalkSessionID: uuid.NullUUID{UUID: talkSessionID.UUID(), Valid: true},
+		ExpiresAt:     clock.Now(ctx),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		utils.HandleError(ctx, err, "FindPendingOwnershipTransferByTalkSessionID")
+		return nil, errtrace.Wrap(err)
+	}
+
+	return r.fromRow(row), nil
+}
+
+// FindPendingByOrganizationID 組織の有効期限内の未承諾の譲渡依頼を取得する
+func (r *ownershipTransferRepository) FindPendingByOrganizationID(ctx context.Context, organizationID shared.UUID[organization.Organization]) (*ownership.OwnershipTransfer, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "ownershipTransferRepository.FindPendingByOrganizationID")
+	defer span.End()
+
+	row, err := r.GetQueries(ctx).FindPendingOwnershipTransferByOrganizationID(ctx, model.FindPendingOwnershipTransferByOrganizationIDParams{
+		OrganizationID: uuid.NullUUID{UUID: organizationID.UUID(), Valid: true},
+		ExpiresAt:      clock.Now(ctx),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		utils.HandleError(ctx, err, "FindPendingOwnershipTransferByOrganizationID")
+		return nil, errtrace.Wrap(err)
+	}
+
+	return r.fromRow(row), nil
+}
+
+// storeEvents 通知用に記録されたイベントを保存する
+func (r *ownershipTransferRepository) storeEvents(ctx context.Context, transfer *ownership.OwnershipTransfer) error {
+	events := transfer.GetRecordedEvents()
+	if len(events) == 0 {
+		return nil
+	}
+	if err := r.eventStore.StoreBatch(ctx, events); err != nil {
+		return errtrace.Wrap(err)
+	}
+	transfer.ClearRecordedEvents()
+	return nil
+}
+
+func (r *ownershipTransferRepository) fromRow(row model.OwnershipTransfer) *ownership.OwnershipTransfer {
+	var talkSessionID *shared.UUID[talksession.TalkSession]
+	if row.TalkSessionID.Valid {
+		talkSessionID = lo.ToPtr(shared.UUID[talksession.TalkSession](row.TalkSessionID.UUID))
+	}
+	var organizationID *shared.UUID[organization.Organization]
+	if row.OrganizationID.Valid {
+		organizationID = lo.ToPtr(shared.UUID[organization.Organization](row.OrganizationID.UUID))
+	}
+	var respondedAt *time.Time
+	if row.RespondedAt.Valid {
+		respondedAt = &row.RespondedAt.Time
+	}
+
+	return ownership.ReconstructOwnershipTransfer(
+		shared.UUID[ownership.OwnershipTransfer](row.TransferID),
+		ownership.TransferTargetType(row.TargetType),
+		talkSessionID,
+		organizationID,
+		shared.UUID[user.User](row.FromUserID),
+		shared.UUID[user.User](row.ToUserID),
+		shared.UUID[user.User](row.RequestedBy),
+		ownership.TransferStatus(row.Status),
+		row.ExpiresAt,
+		respondedAt,
+		row.CreatedAt,
+	)
+}
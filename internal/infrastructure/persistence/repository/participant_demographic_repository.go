@@ -0,0 +1,63 @@
+package repository
+
+import (
+	"context"
+
+	"braces.dev/errtrace"
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/crypto"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	ci "github.com/neko-dream/api/internal/infrastructure/crypto"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	model "github.com/neko-dream/api/internal/infrastructure/persistence/sqlc/generated"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type participantDemographicRepository struct {
+	*db.DBManager
+	encryptor crypto.Encryptor
+}
+
+func NewParticipantDemographicRepository(dbManager *db.DBManager, encryptor crypto.Encryptor) analysis.ParticipantDemographicRepository {
+	return &participantDemographicRepository{
+		DBManager: dbManager,
+		encryptor: encryptor,
+	}
+}
+
+// FindByTalkSessionID セッションの参加者の属性を復号して取得する
+func (p *participantDemographicRepository) FindByTalkSessionID(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession]) (map[shared.UUID[user.User]]*user.UserDemographic, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "participantDemographicRepository.FindByTalkSessionID")
+	defer span.End()
+
+	rows, err := p.GetQueries(ctx).GetParticipantDemographicsByTalkSessionID(ctx, talkSessionID.UUID())
+	if err != nil {
+		utils.HandleError(ctx, err, "GetParticipantDemographicsByTalkSessionID")
+		return nil, errtrace.Wrap(err)
+	}
+
+	res := make(map[shared.UUID[user.User]]*user.UserDemographic, len(rows))
+	for _, row := range rows {
+		userID := shared.UUID[user.User](row.UserID)
+		if !row.UserDemographicsID.Valid {
+			res[userID] = nil
+			continue
+		}
+		demographic, err := ci.DecryptUserDemographics(ctx, p.encryptor, &model.UserDemographic{
+			UserDemographicsID: row.UserDemographicsID.UUID,
+			UserID:             row.UserID,
+			DateOfBirth:        row.DateOfBirth,
+			Gender:             row.Gender,
+			City:               row.City,
+			Prefecture:         row.Prefecture,
+		})
+		if err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+		res[userID] = demographic
+	}
+	return res, nil
+}
@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"braces.dev/errtrace"
+	"github.com/google/uuid"
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	model "github.com/neko-dream/api/internal/infrastructure/persistence/sqlc/generated"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type referenceDistributionRepository struct {
+	*db.DBManager
+}
+
+func NewReferenceDistributionRepository(dbManager *db.DBManager) analysis.ReferenceDistributionRepository {
+	return &referenceDistributionRepository{dbManager}
+}
+
+// referenceCategoryJSON categories列に保存する区分
+type referenceCategoryJSON struct {
+	Category string  `json:"category"`
+	Share    float64 `json:"share"`
+}
+
+// FindByOrganizationID 組織が登録した母集団の構成を属性順に取得する
+func (r *referenceDistributionRepository) FindByOrganizationID(ctx context.Context, organizationID shared.UUID[organization.Organization]) ([]analysis.ReferenceDistribution, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "referenceDistributionRepository.FindByOrganizationID")
+	defer span.End()
+
+	rows, err := r.GetQueries(ctx).FindOrganizationReferenceDistributions(ctx, organizationID.UUID())
+	if err != nil {
+		utils.HandleError(ctx, err, "FindOrganizationReferenceDistributions")
+		return nil, errtrace.Wrap(err)
+	}
+
+	res := make([]analysis.ReferenceDistribution, 0, len(rows))
+	for _, row := range rows {
+		distribution, err := toReferenceDistribution(row)
+		if err != nil {
+			utils.HandleError(ctx, err, "json.Unmarshal")
+			return nil, errtrace.Wrap(err)
+		}
+		res = append(res, *distribution)
+	}
+	return res, nil
+}
+
+// FindByDimension 組織が登録した属性の母集団の構成を取得する
+func (r *referenceDistributionRepository) FindByDimension(ctx context.Context, organizationID shared.UUID[organization.Organization], dimension analysis.DemographicDimension) (*analysis.ReferenceDistribution, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "referenceDistributionRepository.FindByDimension")
+	defer span.End()
+
+	row, err := r.GetQueries(ctx).FindOrganizationReferenceDistribution(ctx, model.FindOrganizationReferenceDistributionParams{
+		OrganizationID: organizationID.UUID(),
+		Dimension:      string(dimension),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		utils.HandleError(ctx, err, "FindOrganizationReferenceDistribution")
+		return nil, errtrace.Wrap(err)
+	}
+
+	distribution, err := toReferenceDistribution(row)
+	if err != nil {
+		utils.HandleError(ctx, err, "json.Unmarshal")
+		return nil, errtrace.Wrap(err)
+	}
+	return distribution, nil
+}
+
+// Save 属性の母集団の構成を置き換える
+func (r *referenceDistributionRepository) Save(ctx context.Context, distribution *analysis.ReferenceDistribution) error {
+	ctx, span := otel.Tracer("repository").Start(ctx, "referenceDistributionRepository.Save")
+	defer span.End()
+
+	categories := make([]referenceCategoryJSON, 0, len(distribution.Categories))
+	for _, c := range distribution.Categories {
+		categories = append(categories, referenceCategoryJSON{Category: c.Category, Share: c.Share})
+	}
+	raw, err := json.Marshal(categories)
+	if err != nil {
+		utils.HandleError(ctx, err, "json.Marshal")
+		return errtrace.Wrap(err)
+	}
+
+	if err := r.GetQueries(ctx).SaveOrganizationReferenceDistribution(ctx, model.SaveOrganizationReferenceDistributionParams{
+		OrganizationID: distribution.OrganizationID.UUID(),
+		Dimension:      string(distribution.Dimension),
+		Categories:     raw,
+		UpdatedBy:      utils.ToNullableSQL[uuid.NullUUID](distribution.UpdatedBy),
+		UpdatedAt:      distribution.UpdatedAt,
+	}); err != nil {
+		utils.HandleError(ctx, err, "SaveOrganizationReferenceDistribution")
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+func toReferenceDistribution(row model.OrganizationReferenceDistribution) (*analysis.ReferenceDistribution, error) {
+	var categories []referenceCategoryJSON
+	if err := json.Unmarshal(row.Categories, &categories); err != nil {
+		return nil, err
+	}
+
+	distribution := &analysis.ReferenceDistribution{
+		OrganizationID: shared.UUID[organization.Organization](row.OrganizationID),
+		Dimension:      analysis.DemographicDimension(row.Dimension),
+		Categories:     make([]analysis.ReferenceCategory, 0, len(categories)),
+		UpdatedAt:      row.UpdatedAt,
+	}
+	for _, c := range categories {
+		distribution.Categories = append(distribution.Categories, analysis.ReferenceCategory{Category: c.Category, Share: c.Share})
+	}
+	if row.UpdatedBy.Valid {
+		updatedBy := shared.UUID[user.User](row.UpdatedBy.UUID)
+		distribution.UpdatedBy = &updatedBy
+	}
+	return distribution, nil
+}
@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"braces.dev/errtrace"
+	"github.com/google/uuid"
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	model "github.com/neko-dream/api/internal/infrastructure/persistence/sqlc/generated"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type reportPromptRepository struct {
+	*db.DBManager
+}
+
+func NewReportPromptRepository(dbManager *db.DBManager) analysis.ReportPromptRepository {
+	return &reportPromptRepository{dbManager}
+}
+
+// FindByOrganizationID 組織のレポート生成の指示を取得する
+func (r *reportPromptRepository) FindByOrganizationID(ctx context.Context, organizationID shared.UUID[organization.Organization]) (*analysis.ReportPrompt, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "reportPromptRepository.FindByOrganizationID")
+	defer span.End()
+
+	row, err := r.GetQueries(ctx).FindOrganizationReportPrompt(ctx, organizationID.UUID())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		utils.HandleError(ctx, err, "FindOrganizationReportPrompt")
+		return nil, errtrace.Wrap(err)
+	}
+
+	prompt := &analysis.ReportPrompt{
+		OrganizationID: shared.UUID[organization.Organization](row.OrganizationID),
+		SystemPrompt:   row.SystemPrompt,
+		Instructions:   row.Instructions,
+		UpdatedAt:      row.UpdatedAt,
+	}
+	if row.UpdatedBy.Valid {
+		updatedBy := shared.UUID[user.User](row.UpdatedBy.UUID)
+		prompt.UpdatedBy = &updatedBy
+	}
+	return prompt, nil
+}
+
+// Save 組織のレポート生成の指示を保存する
+func (r *reportPromptRepository) Save(ctx context.Context, prompt *analysis.ReportPrompt) error {
+	ctx, span := otel.Tracer("repository").Start(ctx, "reportPromptRepository.Save")
+	defer span.End()
+
+	if err := r.GetQueries(ctx).SaveOrganizationReportPrompt(ctx, model.SaveOrganizationReportPromptParams{
+		OrganizationID: prompt.OrganizationID.UUID(),
+		SystemPrompt:   prompt.SystemPrompt,
+		Instructions:   prompt.Instructions,
+		UpdatedBy:      utils.ToNullableSQL[uuid.NullUUID](prompt.UpdatedBy),
+		UpdatedAt:      prompt.UpdatedAt,
+	}); err != nil {
+		utils.HandleError(ctx, err, "SaveOrganizationReportPrompt")
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
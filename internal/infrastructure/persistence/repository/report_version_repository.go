@@ -0,0 +1,203 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"braces.dev/errtrace"
+	"github.com/google/uuid"
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	model "github.com/neko-dream/api/internal/infrastructure/persistence/sqlc/generated"
+	"github.com/neko-dream/api/pkg/utils"
+	"github.com/sqlc-dev/pqtype"
+	"go.opentelemetry.io/otel"
+)
+
+type reportVersionRepository struct {
+	*db.DBManager
+}
+
+func NewReportVersionRepository(dbManager *db.DBManager) analysis.ReportVersionRepository {
+	return &reportVersionRepository{dbManager}
+}
+
+// Capture 生成されたレポートを新しいバージョンとして保存する
+func (r *reportVersionRepository) Capture(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession], generator analysis.ReportGenerator, createdAt time.Time) (*analysis.ReportVersion, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "reportVersionRepository.Capture")
+	defer span.End()
+
+	var version *analysis.ReportVersion
+	err := r.ExecTx(ctx, func(ctx context.Context) error {
+		generated, err := r.GetQueries(ctx).GetGeneratedReport(ctx, talkSessionID.UUID())
+		if err != nil {
+			// レポートがまだない
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil
+			}
+			return err
+		}
+
+		latest, err := r.GetQueries(ctx).FindLatestReportVersion(ctx, talkSessionID.UUID())
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return err
+		}
+		if err == nil && latest.Report == generated.Report {
+			return nil
+		}
+
+		version = &analysis.ReportVersion{
+			ReportVersionID: shared.NewUUID[analysis.ReportVersion](),
+			TalkSessionID:   talkSessionID,
+			Version:         int(latest.Version) + 1,
+			Report:          generated.Report,
+			Sections:        toReportSections(generated.Sections),
+			Generator:       generator,
+			CreatedAt:       createdAt,
+		}
+		return r.GetQueries(ctx).CreateReportVersion(ctx, model.CreateReportVersionParams{
+			TalkSessionReportHistoryID: version.ReportVersionID.UUID(),
+			TalkSessionID:              talkSessionID.UUID(),
+			Version:                    int32(version.Version),
+			Report:                     version.Report,
+			Generator:                  generator.Name,
+			Model:                      utils.ToNullableSQL[sql.NullString](generator.Model),
+			Sections:                   generated.Sections,
+			CreatedAt:                  createdAt,
+		})
+	})
+	if err != nil {
+		utils.HandleError(ctx, err, "reportVersionRepository.Capture")
+		return nil, errtrace.Wrap(err)
+	}
+	return version, nil
+}
+
+// FindByID IDでバージョンを取得する
+func (r *reportVersionRepository) FindByID(ctx context.Context, reportVersionID shared.UUID[analysis.ReportVersion]) (*analysis.ReportVersion, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "reportVersionRepository.FindByID")
+	defer span.End()
+
+	row, err := r.GetQueries(ctx).FindReportVersionByID(ctx, reportVersionID.UUID())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		utils.HandleError(ctx, err, "FindReportVersionByID")
+		return nil, errtrace.Wrap(err)
+	}
+	return toReportVersion(row), nil
+}
+
+// FindLatest セッションの最新のバージョンを取得する
+func (r *reportVersionRepository) FindLatest(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession]) (*analysis.ReportVersion, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "reportVersionRepository.FindLatest")
+	defer span.End()
+
+	row, err := r.GetQueries(ctx).FindLatestReportVersion(ctx, talkSessionID.UUID())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		utils.HandleError(ctx, err, "FindLatestReportVersion")
+		return nil, errtrace.Wrap(err)
+	}
+	return toReportVersion(row), nil
+}
+
+// FindPublication セッションで公開するバージョンを取得する
+func (r *reportVersionRepository) FindPublication(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession]) (*analysis.ReportPublication, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "reportVersionRepository.FindPublication")
+	defer span.End()
+
+	row, err := r.GetQueries(ctx).FindReportPublication(ctx, talkSessionID.UUID())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		utils.HandleError(ctx, err, "FindReportPublication")
+		return nil, errtrace.Wrap(err)
+	}
+
+	publication := &analysis.ReportPublication{
+		TalkSessionID:   shared.UUID[talksession.TalkSession](row.TalkSessionID),
+		ReportVersionID: shared.UUID[analysis.ReportVersion](row.TalkSessionReportHistoryID),
+		Pinned:          row.Pinned,
+		PublishedAt:     row.PublishedAt,
+	}
+	if row.PublishedBy.Valid {
+		publishedBy := shared.UUID[user.User](row.PublishedBy.UUID)
+		publication.PublishedBy = &publishedBy
+	}
+	return publication, nil
+}
+
+// SavePublication 公開するバージョンを保存する
+func (r *reportVersionRepository) SavePublication(ctx context.Context, publication *analysis.ReportPublication) error {
+	ctx, span := otel.Tracer("repository").Start(ctx, "reportVersionRepository.SavePublication")
+	defer span.End()
+
+	if err := r.GetQueries(ctx).SaveReportPublication(ctx, model.SaveReportPublicationParams{
+		TalkSessionID:              publication.TalkSessionID.UUID(),
+		TalkSessionReportHistoryID: publication.ReportVersionID.UUID(),
+		Pinned:                     publication.Pinned,
+		PublishedBy:                utils.ToNullableSQL[uuid.NullUUID](publication.PublishedBy),
+		PublishedAt:                publication.PublishedAt,
+	}); err != nil {
+		utils.HandleError(ctx, err, "SaveReportPublication")
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+func (r *reportVersionRepository) ClaimNotification(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession], now time.Time, interval time.Duration) (bool, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "reportVersionRepository.ClaimNotification")
+	defer span.End()
+
+	rows, err := r.GetQueries(ctx).ClaimReportNotification(ctx, model.ClaimReportNotificationParams{
+		TalkSessionID:  talkSessionID.UUID(),
+		NotifiedAt:     now,
+		NotifiedBefore: now.Add(-interval),
+	})
+	if err != nil {
+		utils.HandleError(ctx, err, "ClaimReportNotification")
+		return false, errtrace.Wrap(err)
+	}
+	return rows > 0, nil
+}
+
+func toReportVersion(row model.TalkSessionReportHistory) *analysis.ReportVersion {
+	version := &analysis.ReportVersion{
+		ReportVersionID: shared.UUID[analysis.ReportVersion](row.TalkSessionReportHistoryID),
+		TalkSessionID:   shared.UUID[talksession.TalkSession](row.TalkSessionID),
+		Version:         int(row.Version),
+		Report:          row.Report,
+		Sections:        toReportSections(row.Sections),
+		Generator: analysis.ReportGenerator{
+			Name: row.Generator,
+		},
+		CreatedAt: row.CreatedAt,
+	}
+	if row.Model.Valid {
+		version.Generator.Model = &row.Model.String
+	}
+	return version
+}
+
+// toReportSections 読み取れない場合は構造化されていないレポートとして扱う
+func toReportSections(raw pqtype.NullRawMessage) *analysis.ReportSections {
+	if !raw.Valid {
+		return nil
+	}
+	var sections analysis.ReportSections
+	if err := json.Unmarshal(raw.RawMessage, &sections); err != nil {
+		return nil
+	}
+	return &sections
+}
@@ -0,0 +1,140 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"braces.dev/errtrace"
+	"github.com/neko-dream/api/internal/domain/model/auth/signing_key"
+	"github.com/neko-dream/api/internal/domain/model/crypto"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	model "github.com/neko-dream/api/internal/infrastructure/persistence/sqlc/generated"
+	"github.com/neko-dream/api/pkg/utils"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel"
+)
+
+type signingKeyRepository struct {
+	*db.DBManager
+	encryptor crypto.Encryptor
+}
+
+func NewSigningKeyRepository(
+	dbManager *db.DBManager,
+	encryptor crypto.Encryptor,
+) signing_key.SigningKeyRepository {
+	return &signingKeyRepository{
+		DBManager: dbManager,
+		encryptor: encryptor,
+	}
+}
+
+func (r *signingKeyRepository) Create(ctx context.Context, key signing_key.SigningKey) error {
+	ctx, span := otel.Tracer("repository").Start(ctx, "signingKeyRepository.Create")
+	defer span.End()
+
+	encrypted, err := r.encryptor.EncryptString(ctx, key.PrivateKeyPEM)
+	if err != nil {
+		utils.HandleError(ctx, err, "encryptor.EncryptString")
+		return errtrace.Wrap(err)
+	}
+
+	if err := r.GetQueries(ctx).CreateTokenSigningKey(ctx, model.CreateTokenSigningKeyParams{
+		KeyID:               key.KeyID,
+		Algorithm:           string(key.Algorithm),
+		EncryptedPrivateKey: encrypted,
+		PublicKey:           key.PublicKeyPEM,
+		Status:              string(key.Status),
+		CreatedAt:           key.CreatedAt,
+	}); err != nil {
+		utils.HandleError(ctx, err, "CreateTokenSigningKey")
+		return errtrace.Wrap(err)
+	}
+
+	return nil
+}
+
+func (r *signingKeyRepository) Retire(ctx context.Context, key signing_key.SigningKey) error {
+	ctx, span := otel.Tracer("repository").Start(ctx, "signingKeyRepository.Retire")
+	defer span.End()
+
+	if key.RetiredAt == nil || key.VerifyUntil == nil {
+		return errtrace.Wrap(errors.New("retired key must have retiredAt and verifyUntil"))
+	}
+
+	if err := r.GetQueries(ctx).RetireTokenSigningKey(ctx, model.RetireTokenSigningKeyParams{
+		KeyID:       key.KeyID,
+		RetiredAt:   sql.NullTime{Time: *key.RetiredAt, Valid: true},
+		VerifyUntil: sql.NullTime{Time: *key.VerifyUntil, Valid: true},
+	}); err != nil {
+		utils.HandleError(ctx, err, "RetireTokenSigningKey")
+		return errtrace.Wrap(err)
+	}
+
+	return nil
+}
+
+func (r *signingKeyRepository) FindActiveForUpdate(ctx context.Context) (*signing_key.SigningKey, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "signingKeyRepository.FindActiveForUpdate")
+	defer span.End()
+
+	row, err := r.GetQueries(ctx).FindActiveTokenSigningKeyForUpdate(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		utils.HandleError(ctx, err, "FindActiveTokenSigningKeyForUpdate")
+		return nil, errtrace.Wrap(err)
+	}
+
+	return r.toDomain(ctx, row)
+}
+
+func (r *signingKeyRepository) FindVerifiable(ctx context.Context, now time.Time) ([]signing_key.SigningKey, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "signingKeyRepository.FindVerifiable")
+	defer span.End()
+
+	rows, err := r.GetQueries(ctx).FindVerifiableTokenSigningKeys(ctx, now)
+	if err != nil {
+		utils.HandleError(ctx, err, "FindVerifiableTokenSigningKeys")
+		return nil, errtrace.Wrap(err)
+	}
+
+	keys := make([]signing_key.SigningKey, 0, len(rows))
+	for _, row := range rows {
+		key, err := r.toDomain(ctx, row)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, *key)
+	}
+
+	return keys, nil
+}
+
+func (r *signingKeyRepository) toDomain(ctx context.Context, row model.TokenSigningKey) (*signing_key.SigningKey, error) {
+	privateKey, err := r.encryptor.DecryptString(ctx, row.EncryptedPrivateKey)
+	if err != nil {
+		utils.HandleError(ctx, err, "encryptor.DecryptString")
+		return nil, errtrace.Wrap(err)
+	}
+
+	key := &signing_key.SigningKey{
+		KeyID:         row.KeyID,
+		Algorithm:     signing_key.Algorithm(row.Algorithm),
+		PrivateKeyPEM: privateKey,
+		PublicKeyPEM:  row.PublicKey,
+		Status:        signing_key.Status(row.Status),
+		CreatedAt:     row.CreatedAt,
+	}
+	if row.RetiredAt.Valid {
+		key.RetiredAt = lo.ToPtr(row.RetiredAt.Time)
+	}
+	if row.VerifyUntil.Valid {
+		key.VerifyUntil = lo.ToPtr(row.VerifyUntil.Time)
+	}
+
+	return key, nil
+}
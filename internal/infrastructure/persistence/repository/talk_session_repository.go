@@ -15,6 +15,7 @@ import (
 	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
 	model "github.com/neko-dream/api/internal/infrastructure/persistence/sqlc/generated"
 	"github.com/neko-dream/api/pkg/utils"
+	"github.com/samber/lo"
 	"go.opentelemetry.io/otel"
 )
 
@@ -59,6 +60,7 @@ func (t *talkSessionRepository) Create(ctx context.Context, talkSession *talkses
 		OrganizationAliasID: utils.ToNullableSQL[uuid.NullUUID](talkSession.OrganizationAliasID()),
 		OrganizationID:      utils.ToNullableSQL[uuid.NullUUID](talkSession.OrganizationID()),
 		HideTop:             talkSession.HideTop(),
+		IsDraft:             talkSession.IsDraft(),
 	}); err != nil {
 		return errtrace.Wrap(err)
 	}
@@ -101,16 +103,20 @@ func (t *talkSessionRepository) Update(ctx context.Context, talkSession *talkses
 	}
 
 	if err := t.DBManager.GetQueries(ctx).EditTalkSession(ctx, model.EditTalkSessionParams{
-		TalkSessionID:    talkSession.TalkSessionID().UUID(),
-		Theme:            talkSession.Theme(),
-		ScheduledEndTime: talkSession.ScheduledEndTime(),
-		Description:      utils.ToNullableSQL[sql.NullString](talkSession.Description()),
-		ThumbnailUrl:     utils.ToNullableSQL[sql.NullString](talkSession.ThumbnailURL()),
-		City:             utils.ToNullableSQL[sql.NullString](talkSession.City()),
-		Prefecture:       utils.ToNullableSQL[sql.NullString](talkSession.Prefecture()),
-		Restrictions:     talksession.Restrictions(restrictions),
-		HideReport:       utils.ToNullableSQL[sql.NullBool](talkSession.HideReport()),
-		HideTop:          talkSession.HideTop(),
+		TalkSessionID:       talkSession.TalkSessionID().UUID(),
+		Theme:               talkSession.Theme(),
+		ScheduledEndTime:    talkSession.ScheduledEndTime(),
+		Description:         utils.ToNullableSQL[sql.NullString](talkSession.Description()),
+		ThumbnailUrl:        utils.ToNullableSQL[sql.NullString](talkSession.ThumbnailURL()),
+		City:                utils.ToNullableSQL[sql.NullString](talkSession.City()),
+		Prefecture:          utils.ToNullableSQL[sql.NullString](talkSession.Prefecture()),
+		Restrictions:        talksession.Restrictions(restrictions),
+		HideReport:          utils.ToNullableSQL[sql.NullBool](talkSession.HideReport()),
+		OrganizationID:      utils.ToNullableSQL[uuid.NullUUID](talkSession.OrganizationID()),
+		OrganizationAliasID: utils.ToNullableSQL[uuid.NullUUID](talkSession.OrganizationAliasID()),
+		HideTop:             talkSession.HideTop(),
+		IsDraft:             talkSession.IsDraft(),
+		OwnerID:             talkSession.OwnerUserID().UUID(),
 	}); err != nil {
 		return errtrace.Wrap(err)
 	}
@@ -166,6 +172,14 @@ func (t *talkSessionRepository) FindByID(ctx context.Context, talkSessionID shar
 	if row.TalkSession.ThumbnailUrl.Valid {
 		thumbnailURL = &row.TalkSession.ThumbnailUrl.String
 	}
+	var organizationID *shared.UUID[organization.Organization]
+	if row.TalkSession.OrganizationID.Valid {
+		organizationID = lo.ToPtr(shared.UUID[organization.Organization](row.TalkSession.OrganizationID.UUID))
+	}
+	var organizationAliasID *shared.UUID[organization.OrganizationAlias]
+	if row.TalkSession.OrganizationAliasID.Valid {
+		organizationAliasID = lo.ToPtr(shared.UUID[organization.OrganizationAlias](row.TalkSession.OrganizationAliasID.UUID))
+	}
 
 	ts := talksession.NewTalkSession(
 		talkSessionID,
@@ -179,10 +193,13 @@ func (t *talkSessionRepository) FindByID(ctx context.Context, talkSessionID shar
 		city,
 		prefecture,
 		row.TalkSession.HideTop,
-		nil,
-		nil,
+		organizationID,
+		organizationAliasID,
 	)
 	ts.SetReportVisibility(row.TalkSession.HideReport.Bool)
+	if row.TalkSession.IsDraft {
+		ts.MarkAsDraft()
+	}
 
 	if len(row.TalkSession.Restrictions) > 0 {
 		if err := ts.UpdateRestrictions(ctx, row.TalkSession.Restrictions); err != nil {
@@ -275,3 +292,28 @@ func (t *talkSessionRepository) GetParticipantIDs(ctx context.Context, talkSessi
 
 	return participantIDs, nil
 }
+
+// FindByOrganizationIDAndOwnerID implements talksession.TalkSessionRepository.
+func (t *talkSessionRepository) FindByOrganizationIDAndOwnerID(ctx context.Context, organizationID shared.UUID[organization.Organization], ownerUserID shared.UUID[user.User]) ([]*talksession.TalkSession, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "talkSessionRepository.FindByOrganizationIDAndOwnerID")
+	defer span.End()
+
+	talkSessionIDs, err := t.GetQueries(ctx).FindTalkSessionIDsByOrganizationIDAndOwnerID(ctx, model.FindTalkSessionIDsByOrganizationIDAndOwnerIDParams{
+		OrganizationID: uuid.NullUUID{UUID: organizationID.UUID(), Valid: true},
+		OwnerID:        ownerUserID.UUID(),
+	})
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+
+	sessions := make([]*talksession.TalkSession, 0, len(talkSessionIDs))
+	for _, talkSessionID := range talkSessionIDs {
+		session, err := t.FindByID(ctx, shared.UUID[talksession.TalkSession](talkSessionID))
+		if err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+		sessions = append(sessions, session)
+	}
+
+	return sessions, nil
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"braces.dev/errtrace"
+	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/talksession/talksession_collaborator"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	model "github.com/neko-dream/api/internal/infrastructure/persistence/sqlc/generated"
+	"github.com/neko-dream/api/pkg/utils"
+	"go.opentelemetry.io/otel"
+)
+
+type talkSessionCollaboratorRepository struct {
+	*db.DBManager
+}
+
+func NewTalkSessionCollaboratorRepository(dbManager *db.DBManager) talksession_collaborator.TalkSessionCollaboratorRepository {
+	return &talkSessionCollaboratorRepository{
+		DBManager: dbManager,
+	}
+}
+
+// Save 共同管理者を追加する。既に追加されている場合はロールを更新する
+func (r *talkSessionCollaboratorRepository) Save(ctx context.Context, collaborator *talksession_collaborator.TalkSessionCollaborator) error {
+	ctx, span := otel.Tracer("repository").Start(ctx, "talkSessionCollaboratorRepository.Save")
+	defer span.End()
+
+	if err := r.GetQueries(ctx).UpsertTalkSessionCollaborator(ctx, model.UpsertTalkSessionCollaboratorParams{
+		TalkSessionID: collaborator.TalkSessionID().UUID(),
+		UserID:        collaborator.UserID().UUID(),
+		Role:          string(collaborator.Role()),
+		AddedBy:       collaborator.AddedBy().UUID(),
+		CreatedAt:     clock.Now(ctx),
+	}); err != nil {
+		utils.HandleError(ctx, err, "UpsertTalkSessionCollaborator")
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+// FindByTalkSessionIDAndUserID 存在しない場合はnilを返す
+func (r *talkSessionCollaboratorRepository) FindByTalkSessionIDAndUserID(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession], userID shared.UUID[user.User]) (*talksession_collaborator.TalkSessionCollaborator, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "talkSessionCollaboratorRepository.FindByTalkSessionIDAndUserID")
+	defer span.End()
+
+	row, err := r.GetQueries(ctx).FindTalkSessionCollaborator(ctx, model.FindTalkSessionCollaboratorParams{
+		TalkSessionID: talkSessionID.UUID(),
+		UserID:        userID.UUID(),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		utils.HandleError(ctx, err, "FindTalkSessionCollaborator")
+		return nil, errtrace.Wrap(err)
+	}
+
+	return talksession_collaborator.ReconstructTalkSessionCollaborator(
+		shared.UUID[talksession.TalkSession](row.TalkSessionID),
+		shared.UUID[user.User](row.UserID),
+		talksession_collaborator.CollaboratorRole(row.Role),
+		shared.UUID[user.User](row.AddedBy),
+		row.CreatedAt,
+	), nil
+}
+
+func (r *talkSessionCollaboratorRepository) Delete(ctx context.Context, talkSessionID shared.UUID[talksession.TalkSession], userID shared.UUID[user.User]) error {
+	ctx, span := otel.Tracer("repository").Start(ctx, "talkSessionCollaboratorRepository.Delete")
+	defer span.End()
+
+	if err := r.GetQueries(ctx).DeleteTalkSessionCollaborator(ctx, model.DeleteTalkSessionCollaboratorParams{
+		TalkSessionID: talkSessionID.UUID(),
+		UserID:        userID.UUID(),
+	}); err != nil {
+		utils.HandleError(ctx, err, "DeleteTalkSessionCollaborator")
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
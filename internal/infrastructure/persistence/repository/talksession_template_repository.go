@@ -0,0 +1,163 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"braces.dev/errtrace"
+	"github.com/google/uuid"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/talksession/talksession_template"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	model "github.com/neko-dream/api/internal/infrastructure/persistence/sqlc/generated"
+	"github.com/neko-dream/api/pkg/utils"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel"
+)
+
+type talkSessionTemplateRepository struct {
+	*db.DBManager
+}
+
+func NewTalkSessionTemplateRepository(dbManager *db.DBManager) talksession_template.TalkSessionTemplateRepository {
+	return &talkSessionTemplateRepository{
+		DBManager: dbManager,
+	}
+}
+
+// Create テンプレートを保存する
+func (r *talkSessionTemplateRepository) Create(ctx context.Context, template *talksession_template.TalkSessionTemplate) error {
+	ctx, span := otel.Tracer("repository").Start(ctx, "talkSessionTemplateRepository.Create")
+	defer span.End()
+
+	seedOpinions, err := json.Marshal(template.SeedOpinions())
+	if err != nil {
+		return errtrace.Wrap(err)
+	}
+
+	if err := r.GetQueries(ctx).CreateTalkSessionTemplate(ctx, model.CreateTalkSessionTemplateParams{
+		TemplateID:          template.TemplateID().UUID(),
+		OrganizationID:      template.OrganizationID().UUID(),
+		OrganizationAliasID: utils.ToNullableSQL[uuid.NullUUID](template.OrganizationAliasID()),
+		Name:                template.Name(),
+		Theme:               template.Theme(),
+		Description:         utils.ToNullableSQL[sql.NullString](template.Description()),
+		ThumbnailUrl:        utils.ToNullableSQL[sql.NullString](template.ThumbnailURL()),
+		City:                utils.ToNullableSQL[sql.NullString](template.City()),
+		Prefecture:          utils.ToNullableSQL[sql.NullString](template.Prefecture()),
+		Restrictions:        talksession.Restrictions(template.Restrictions()),
+		HideTop:             template.HideTop(),
+		SeedOpinions:        seedOpinions,
+		CreatedBy:           template.CreatedBy().UUID(),
+		CreatedAt:           template.CreatedAt(),
+	}); err != nil {
+		utils.HandleError(ctx, err, "CreateTalkSessionTemplate")
+		return errtrace.Wrap(err)
+	}
+
+	return nil
+}
+
+// FindByID IDでテンプレートを取得する。存在しない場合はnilを返す
+func (r *talkSessionTemplateRepository) FindByID(ctx context.Context, templateID shared.UUID[talksession_template.TalkSessionTemplate]) (*talksession_template.TalkSessionTemplate, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "talkSessionTemplateRepository.FindByID")
+	defer span.End()
+
+	row, err := r.GetQueries(ctx).FindTalkSessionTemplateByID(ctx, templateID.UUID())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		utils.HandleError(ctx, err, "FindTalkSessionTemplateByID")
+		return nil, errtrace.Wrap(err)
+	}
+
+	return r.fromRow(row)
+}
+
+// FindByOrganizationID 組織のテンプレートを作成日の新しい順に取得する
+func (r *talkSessionTemplateRepository) FindByOrganizationID(ctx context.Context, organizationID shared.UUID[organization.Organization]) ([]*talksession_template.TalkSessionTemplate, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "talkSessionTemplateRepository.FindByOrganizationID")
+	defer span.End()
+
+	rows, err := r.GetQueries(ctx).FindTalkSessionTemplatesByOrganizationID(ctx, organizationID.UUID())
+	if err != nil {
+		utils.HandleError(ctx, err, "FindTalkSessionTemplatesByOrganizationID")
+		return nil, errtrace.Wrap(err)
+	}
+
+	templates := make([]*talksession_template.TalkSessionTemplate, 0, len(rows))
+	for _, row := range rows {
+		template, err := r.fromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, template)
+	}
+	return templates, nil
+}
+
+// Delete テンプレートを削除する
+func (r *talkSessionTemplateRepository) Delete(ctx context.Context, templateID shared.UUID[talksession_template.TalkSessionTemplate]) error {
+	ctx, span := otel.Tracer("repository").Start(ctx, "talkSessionTemplateRepository.Delete")
+	defer span.End()
+
+	if err := r.GetQueries(ctx).DeleteTalkSessionTemplate(ctx, templateID.UUID()); err != nil {
+		utils.HandleError(ctx, err, "DeleteTalkSessionTemplate")
+		return errtrace.Wrap(err)
+	}
+	return nil
+}
+
+func (r *talkSessionTemplateRepository) fromRow(row model.TalkSessionTemplate) (*talksession_template.TalkSessionTemplate, error) {
+	var seedOpinions []talksession_template.SeedOpinion
+	if len(row.SeedOpinions) > 0 {
+		if err := json.Unmarshal(row.SeedOpinions, &seedOpinions); err != nil {
+			return nil, errtrace.Wrap(err)
+		}
+	}
+
+	var organizationAliasID *shared.UUID[organization.OrganizationAlias]
+	if row.OrganizationAliasID.Valid {
+		organizationAliasID = lo.ToPtr(shared.UUID[organization.OrganizationAlias](row.OrganizationAliasID.UUID))
+	}
+	var description, thumbnailURL, city, prefecture *string
+	if row.Description.Valid {
+		description = &row.Description.String
+	}
+	if row.ThumbnailUrl.Valid {
+		thumbnailURL = &row.ThumbnailUrl.String
+	}
+	if row.City.Valid {
+		city = &row.City.String
+	}
+	if row.Prefecture.Valid {
+		prefecture = &row.Prefecture.String
+	}
+
+	template, err := talksession_template.NewTalkSessionTemplate(
+		shared.UUID[talksession_template.TalkSessionTemplate](row.TemplateID),
+		shared.UUID[organization.Organization](row.OrganizationID),
+		organizationAliasID,
+		row.Name,
+		row.Theme,
+		description,
+		thumbnailURL,
+		city,
+		prefecture,
+		row.Restrictions,
+		row.HideTop,
+		seedOpinions,
+		shared.UUID[user.User](row.CreatedBy),
+		row.CreatedAt,
+	)
+	if err != nil {
+		return nil, errtrace.Wrap(err)
+	}
+	return template, nil
+}
@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"context"
+
+	"braces.dev/errtrace"
+	"github.com/neko-dream/api/internal/domain/model/event"
+	"github.com/neko-dream/api/internal/domain/model/vote"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	model "github.com/neko-dream/api/internal/infrastructure/persistence/sqlc/generated"
+	"go.opentelemetry.io/otel"
+)
+
+type voteChangeRepository struct {
+	*db.DBManager
+	eventStore event.EventStore
+}
+
+func NewVoteChangeRepository(
+	dbManager *db.DBManager,
+	eventStore event.EventStore,
+) vote.VoteChangeRepository {
+	return &voteChangeRepository{
+		DBManager:  dbManager,
+		eventStore: eventStore,
+	}
+}
+
+func (r *voteChangeRepository) Create(ctx context.Context, change vote.VoteChange) error {
+	ctx, span := otel.Tracer("repository").Start(ctx, "voteChangeRepository.Create")
+	defer span.End()
+
+	if err := r.GetQueries(ctx).CreateVoteEvent(ctx, model.CreateVoteEventParams{
+		VoteEventID:      change.VoteChangeID.UUID(),
+		VoteID:           change.VoteID.UUID(),
+		OpinionID:        change.OpinionID.UUID(),
+		TalkSessionID:    change.TalkSessionID.UUID(),
+		UserID:           change.UserID.UUID(),
+		PreviousVoteType: int16(change.PreviousVoteType.Int()),
+		VoteType:         int16(change.VoteType.Int()),
+		Reason:           string(change.Reason),
+		CreatedAt:        change.CreatedAt,
+	}); err != nil {
+		return errtrace.Wrap(err)
+	}
+
+	// イベントがある場合は保存
+	events := change.GetRecordedEvents()
+	if len(events) > 0 {
+		if err := r.eventStore.StoreBatch(ctx, events); err != nil {
+			return errtrace.Wrap(err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/opinion"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/internal/domain/model/vote"
+	"github.com/neko-dream/api/internal/infrastructure/persistence/db"
+	model "github.com/neko-dream/api/internal/infrastructure/persistence/sqlc/generated"
+	"go.opentelemetry.io/otel"
+)
+
+type voteReceiptRepository struct {
+	*db.DBManager
+}
+
+func NewVoteReceiptRepository(dbManager *db.DBManager) vote.VoteReceiptRepository {
+	return &voteReceiptRepository{dbManager}
+}
+
+func (r *voteReceiptRepository) Create(ctx context.Context, receipt vote.VoteReceipt) (bool, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "voteReceiptRepository.Create")
+	defer span.End()
+
+	params := model.CreateVoteReceiptParams{
+		UserID:         receipt.UserID.UUID(),
+		IdempotencyKey: receipt.IdempotencyKey,
+		OpinionID:      receipt.OpinionID.UUID(),
+		VoteType:       int16(receipt.VoteType.Int()),
+		ClientVotedAt:  receipt.ClientVotedAt,
+		CreatedAt:      receipt.CreatedAt,
+	}
+	if receipt.Error != nil {
+		params.ErrorCode = sql.NullString{String: receipt.Error.Code, Valid: true}
+		params.ErrorMessage = sql.NullString{String: receipt.Error.Message, Valid: true}
+	}
+	rows, err := r.GetQueries(ctx).CreateVoteReceipt(ctx, params)
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+func (r *voteReceiptRepository) UpdateError(ctx context.Context, receipt vote.VoteReceipt) error {
+	ctx, span := otel.Tracer("repository").Start(ctx, "voteReceiptRepository.UpdateError")
+	defer span.End()
+
+	params := model.UpdateVoteReceiptErrorParams{
+		UserID:         receipt.UserID.UUID(),
+		IdempotencyKey: receipt.IdempotencyKey,
+	}
+	if receipt.Error != nil {
+		params.ErrorCode = sql.NullString{String: receipt.Error.Code, Valid: true}
+		params.ErrorMessage = sql.NullString{String: receipt.Error.Message, Valid: true}
+	}
+	return r.GetQueries(ctx).UpdateVoteReceiptError(ctx, params)
+}
+
+func (r *voteReceiptRepository) FindByKeys(ctx context.Context, userID shared.UUID[user.User], keys []string) ([]vote.VoteReceipt, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "voteReceiptRepository.FindByKeys")
+	defer span.End()
+
+	rows, err := r.GetQueries(ctx).FindVoteReceiptsByKeys(ctx, model.FindVoteReceiptsByKeysParams{
+		UserID:          userID.UUID(),
+		IdempotencyKeys: keys,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	receipts := make([]vote.VoteReceipt, 0, len(rows))
+	for _, row := range rows {
+		receipt := vote.VoteReceipt{
+			UserID:         shared.UUID[user.User](row.UserID),
+			IdempotencyKey: row.IdempotencyKey,
+			OpinionID:      shared.UUID[opinion.Opinion](row.OpinionID),
+			VoteType:       vote.VoteTypeFromInt(int(row.VoteType)),
+			ClientVotedAt:  row.ClientVotedAt,
+			CreatedAt:      row.CreatedAt,
+		}
+		if row.ErrorCode.Valid {
+			receipt.Error = &messages.APIError{
+				StatusCode: http.StatusBadRequest,
+				Code:       row.ErrorCode.String,
+				Message:    row.ErrorMessage.String,
+			}
+		}
+		receipts = append(receipts, receipt)
+	}
+	return receipts, nil
+}
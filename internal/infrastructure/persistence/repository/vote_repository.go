@@ -31,6 +31,7 @@ func (o *voteRepository) Create(ctx context.Context, vote vote.Vote) error {
 		TalkSessionID: vote.TalkSessionID.UUID(),
 		UserID:        vote.UserID.UUID(),
 		VoteType:      int16(vote.VoteType.Int()),
+		IsImportant:   vote.IsImportant,
 		CreatedAt:     vote.CreatedAt,
 	}); err != nil {
 		return err
@@ -44,9 +45,10 @@ func (o *voteRepository) Update(ctx context.Context, vote vote.Vote) error {
 	defer span.End()
 
 	if err := o.GetQueries(ctx).UpdateVote(ctx, model.UpdateVoteParams{
-		UserID:    vote.UserID.UUID(),
-		OpinionID: vote.OpinionID.UUID(),
-		VoteType:  int16(vote.VoteType.Int()),
+		UserID:      vote.UserID.UUID(),
+		OpinionID:   vote.OpinionID.UUID(),
+		VoteType:    int16(vote.VoteType.Int()),
+		IsImportant: vote.IsImportant,
 	}); err != nil {
 		return err
 	}
@@ -66,6 +68,37 @@ func (o *voteRepository) FindByOpinionAndUserID(ctx context.Context, opinionID s
 		return nil, err
 	}
 
+	return voteFromRow(voteRow)
+}
+
+func (o *voteRepository) FindByOpinionID(ctx context.Context, opinionID shared.UUID[opinion.Opinion]) ([]vote.Vote, error) {
+	ctx, span := otel.Tracer("repository").Start(ctx, "voteRepository.FindByOpinionID")
+	defer span.End()
+
+	rows, err := o.GetQueries(ctx).FindVotesByOpinionID(ctx, opinionID.UUID())
+	if err != nil {
+		return nil, err
+	}
+
+	votes := make([]vote.Vote, 0, len(rows))
+	for _, row := range rows {
+		v, err := voteFromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		votes = append(votes, *v)
+	}
+	return votes, nil
+}
+
+func (o *voteRepository) Delete(ctx context.Context, vote vote.Vote) error {
+	ctx, span := otel.Tracer("repository").Start(ctx, "voteRepository.Delete")
+	defer span.End()
+
+	return o.GetQueries(ctx).DeleteVote(ctx, vote.VoteID.UUID())
+}
+
+func voteFromRow(voteRow model.Vote) (*vote.Vote, error) {
 	voteID, err := shared.ParseUUID[vote.Vote](voteRow.VoteID.String())
 	if err != nil {
 		return nil, err
@@ -94,6 +127,7 @@ func (o *voteRepository) FindByOpinionAndUserID(ctx context.Context, opinionID s
 	if err != nil {
 		return nil, err
 	}
+	vote.ChangeImportance(voteRow.IsImportant)
 
 	return vote, nil
 }
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
 )
 
 const addGeneratedImages = `-- name: AddGeneratedImages :exec
@@ -35,33 +36,47 @@ func (q *Queries) AddGeneratedImages(ctx context.Context, arg AddGeneratedImages
 
 const findReportByID = `-- name: FindReportByID :one
 SELECT
-    -- talk_session_report_history_id as analysis_report_history_id,
-    talk_session_id,
-    report,
-    created_at
-FROM talk_session_reports
-WHERE talk_session_id = $1
+    talk_session_report_histories.talk_session_report_history_id,
+    talk_session_report_histories.talk_session_id,
+    talk_session_report_histories.report,
+    talk_session_report_histories.created_at
+FROM talk_session_report_histories
+LEFT JOIN talk_session_report_publications
+    ON talk_session_report_publications.talk_session_report_history_id = talk_session_report_histories.talk_session_report_history_id
+WHERE talk_session_report_histories.talk_session_report_history_id = $1::uuid
+    OR talk_session_report_publications.talk_session_id = $1::uuid
+LIMIT 1
 `
 
 type FindReportByIDRow struct {
-	TalkSessionID uuid.UUID
-	Report        string
-	CreatedAt     time.Time
+	TalkSessionReportHistoryID uuid.UUID
+	TalkSessionID              uuid.UUID
+	Report                     string
+	CreatedAt                  time.Time
 }
 
-// FindReportByID
+// レポートのバージョンを返す。セッションのIDを渡した場合は公開しているバージョンを返す
 //
 //	SELECT
-//	    -- talk_session_report_history_id as analysis_report_history_id,
-//	    talk_session_id,
-//	    report,
-//	    created_at
-//	FROM talk_session_reports
-//	WHERE talk_session_id = $1
-func (q *Queries) FindReportByID(ctx context.Context, talkSessionID uuid.UUID) (FindReportByIDRow, error) {
-	row := q.db.QueryRowContext(ctx, findReportByID, talkSessionID)
+//	    talk_session_report_histories.talk_session_report_history_id,
+//	    talk_session_report_histories.talk_session_id,
+//	    talk_session_report_histories.report,
+//	    talk_session_report_histories.created_at
+//	FROM talk_session_report_histories
+//	LEFT JOIN talk_session_report_publications
+//	    ON talk_session_report_publications.talk_session_report_history_id = talk_session_report_histories.talk_session_report_history_id
+//	WHERE talk_session_report_histories.talk_session_report_history_id = $1::uuid
+//	    OR talk_session_report_publications.talk_session_id = $1::uuid
+//	LIMIT 1
+func (q *Queries) FindReportByID(ctx context.Context, reportID uuid.UUID) (FindReportByIDRow, error) {
+	row := q.db.QueryRowContext(ctx, findReportByID, reportID)
 	var i FindReportByIDRow
-	err := row.Scan(&i.TalkSessionID, &i.Report, &i.CreatedAt)
+	err := row.Scan(
+		&i.TalkSessionReportHistoryID,
+		&i.TalkSessionID,
+		&i.Report,
+		&i.CreatedAt,
+	)
 	return i, err
 }
 
@@ -130,6 +145,14 @@ FROM talk_session_generated_images
 WHERE talk_session_id = $1::uuid
 `
 
+type GetGeneratedImagesRow struct {
+	TalkSessionID uuid.UUID
+	WordmapUrl    string
+	TsncUrl       string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
 // GetGeneratedImages
 //
 //	SELECT
@@ -140,9 +163,9 @@ WHERE talk_session_id = $1::uuid
 //	    updated_at
 //	FROM talk_session_generated_images
 //	WHERE talk_session_id = $1::uuid
-func (q *Queries) GetGeneratedImages(ctx context.Context, dollar_1 uuid.UUID) (TalkSessionGeneratedImage, error) {
+func (q *Queries) GetGeneratedImages(ctx context.Context, dollar_1 uuid.UUID) (GetGeneratedImagesRow, error) {
 	row := q.db.QueryRowContext(ctx, getGeneratedImages, dollar_1)
-	var i TalkSessionGeneratedImage
+	var i GetGeneratedImagesRow
 	err := row.Scan(
 		&i.TalkSessionID,
 		&i.WordmapUrl,
@@ -263,42 +286,694 @@ func (q *Queries) GetGroupListByTalkSessionId(ctx context.Context, talkSessionID
 	return items, nil
 }
 
+const getGroupVoteCountsByTalkSessionID = `-- name: GetGroupVoteCountsByTalkSessionID :many
+SELECT
+    votes.opinion_id,
+    user_group_info.group_id,
+    (COUNT(*) FILTER (WHERE votes.vote_type = 1))::int AS agree_count,
+    (COUNT(*) FILTER (WHERE votes.vote_type = 2))::int AS disagree_count,
+    (COUNT(*) FILTER (WHERE votes.vote_type = 3))::int AS pass_count
+FROM votes
+JOIN user_group_info
+    ON votes.user_id = user_group_info.user_id
+    AND votes.talk_session_id = user_group_info.talk_session_id
+JOIN opinions
+    ON votes.opinion_id = opinions.opinion_id
+WHERE votes.talk_session_id = $1::uuid
+    AND opinions.deleted_at IS NULL
+    AND NOT EXISTS (
+        SELECT 1 FROM opinion_reports
+        WHERE opinion_reports.opinion_id = opinions.opinion_id
+            AND opinion_reports.status = 'deleted'
+    )
+GROUP BY votes.opinion_id, user_group_info.group_id
+ORDER BY votes.opinion_id, user_group_info.group_id
+`
+
+type GetGroupVoteCountsByTalkSessionIDRow struct {
+	OpinionID     uuid.UUID
+	GroupID       int32
+	AgreeCount    int32
+	DisagreeCount int32
+	PassCount     int32
+}
+
+// 意見ごと・グループごとの投票数
+// 投稿者・運営が削除した意見は含めない
+//
+//	SELECT
+//	    votes.opinion_id,
+//	    user_group_info.group_id,
+//	    (COUNT(*) FILTER (WHERE votes.vote_type = 1))::int AS agree_count,
+//	    (COUNT(*) FILTER (WHERE votes.vote_type = 2))::int AS disagree_count,
+//	    (COUNT(*) FILTER (WHERE votes.vote_type = 3))::int AS pass_count
+//	FROM votes
+//	JOIN user_group_info
+//	    ON votes.user_id = user_group_info.user_id
+//	    AND votes.talk_session_id = user_group_info.talk_session_id
+//	JOIN opinions
+//	    ON votes.opinion_id = opinions.opinion_id
+//	WHERE votes.talk_session_id = $1::uuid
+//	    AND opinions.deleted_at IS NULL
+//	    AND NOT EXISTS (
+//	        SELECT 1 FROM opinion_reports
+//	        WHERE opinion_reports.opinion_id = opinions.opinion_id
+//	            AND opinion_reports.status = 'deleted'
+//	    )
+//	GROUP BY votes.opinion_id, user_group_info.group_id
+//	ORDER BY votes.opinion_id, user_group_info.group_id
+func (q *Queries) GetGroupVoteCountsByTalkSessionID(ctx context.Context, talkSessionID uuid.UUID) ([]GetGroupVoteCountsByTalkSessionIDRow, error) {
+	rows, err := q.db.QueryContext(ctx, getGroupVoteCountsByTalkSessionID, talkSessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetGroupVoteCountsByTalkSessionIDRow
+	for rows.Next() {
+		var i GetGroupVoteCountsByTalkSessionIDRow
+		if err := rows.Scan(
+			&i.OpinionID,
+			&i.GroupID,
+			&i.AgreeCount,
+			&i.DisagreeCount,
+			&i.PassCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getGroupVotesByOpinionID = `-- name: GetGroupVotesByOpinionID :many
+SELECT
+    votes.user_id,
+    votes.vote_type,
+    user_group_info.group_id
+FROM votes
+JOIN user_group_info
+    ON votes.user_id = user_group_info.user_id
+    AND votes.talk_session_id = user_group_info.talk_session_id
+WHERE votes.opinion_id = $1::uuid
+ORDER BY user_group_info.group_id
+`
+
+type GetGroupVotesByOpinionIDRow struct {
+	UserID   uuid.UUID
+	VoteType int16
+	GroupID  int32
+}
+
+// 意見への投票とグループ。グループに分類されていないユーザーの投票は含めない
+//
+//	SELECT
+//	    votes.user_id,
+//	    votes.vote_type,
+//	    user_group_info.group_id
+//	FROM votes
+//	JOIN user_group_info
+//	    ON votes.user_id = user_group_info.user_id
+//	    AND votes.talk_session_id = user_group_info.talk_session_id
+//	WHERE votes.opinion_id = $1::uuid
+//	ORDER BY user_group_info.group_id
+func (q *Queries) GetGroupVotesByOpinionID(ctx context.Context, opinionID uuid.UUID) ([]GetGroupVotesByOpinionIDRow, error) {
+	rows, err := q.db.QueryContext(ctx, getGroupVotesByOpinionID, opinionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetGroupVotesByOpinionIDRow
+	for rows.Next() {
+		var i GetGroupVotesByOpinionIDRow
+		if err := rows.Scan(&i.UserID, &i.VoteType, &i.GroupID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getImportantOpinionsByTalkSessionID = `-- name: GetImportantOpinionsByTalkSessionID :many
+SELECT
+    ranked.group_id,
+    ranked.important_count,
+    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url, opinions.revision, opinions.edited_at, opinions.deleted_at,
+    users.user_id, users.display_id, users.display_name, users.icon_url, users.created_at, users.updated_at, users.email, users.email_verified, users.withdrawal_date
+FROM (
+    SELECT
+        user_group_info.group_id,
+        votes.opinion_id,
+        COUNT(*)::int AS important_count,
+        ROW_NUMBER() OVER (
+            PARTITION BY user_group_info.group_id
+            ORDER BY COUNT(*) DESC, votes.opinion_id
+        ) AS rank
+    FROM votes
+    JOIN user_group_info
+        ON votes.user_id = user_group_info.user_id
+        AND votes.talk_session_id = user_group_info.talk_session_id
+    JOIN opinions
+        ON votes.opinion_id = opinions.opinion_id
+    WHERE votes.talk_session_id = $1::uuid
+        AND votes.is_important
+        AND opinions.deleted_at IS NULL
+        AND NOT EXISTS (
+            SELECT 1 FROM opinion_reports
+            WHERE opinion_reports.opinion_id = opinions.opinion_id
+                AND opinion_reports.status = 'deleted'
+        )
+    GROUP BY user_group_info.group_id, votes.opinion_id
+) ranked
+JOIN opinions
+    ON ranked.opinion_id = opinions.opinion_id
+JOIN users
+    ON opinions.user_id = users.user_id
+WHERE ranked.rank <= $2::int
+ORDER BY ranked.group_id, ranked.important_count DESC, opinions.opinion_id
+`
+
+type GetImportantOpinionsByTalkSessionIDParams struct {
+	TalkSessionID uuid.UUID
+	LimitPerGroup int32
+}
+
+type GetImportantOpinionsByTalkSessionIDRow struct {
+	GroupID        int32
+	ImportantCount int32
+	Opinion        Opinion
+	User           User
+}
+
+// グループごとに、グループのメンバーが重要だと印を付けた数が多い意見を返す
+// 投稿者・運営が削除した意見は含めない
+//
+//	SELECT
+//	    ranked.group_id,
+//	    ranked.important_count,
+//	    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url, opinions.revision, opinions.edited_at, opinions.deleted_at,
+//	    users.user_id, users.display_id, users.display_name, users.icon_url, users.created_at, users.updated_at, users.email, users.email_verified, users.withdrawal_date
+//	FROM (
+//	    SELECT
+//	        user_group_info.group_id,
+//	        votes.opinion_id,
+//	        COUNT(*)::int AS important_count,
+//	        ROW_NUMBER() OVER (
+//	            PARTITION BY user_group_info.group_id
+//	            ORDER BY COUNT(*) DESC, votes.opinion_id
+//	        ) AS rank
+//	    FROM votes
+//	    JOIN user_group_info
+//	        ON votes.user_id = user_group_info.user_id
+//	        AND votes.talk_session_id = user_group_info.talk_session_id
+//	    JOIN opinions
+//	        ON votes.opinion_id = opinions.opinion_id
+//	    WHERE votes.talk_session_id = $1::uuid
+//	        AND votes.is_important
+//	        AND opinions.deleted_at IS NULL
+//	        AND NOT EXISTS (
+//	            SELECT 1 FROM opinion_reports
+//	            WHERE opinion_reports.opinion_id = opinions.opinion_id
+//	                AND opinion_reports.status = 'deleted'
+//	        )
+//	    GROUP BY user_group_info.group_id, votes.opinion_id
+//	) ranked
+//	JOIN opinions
+//	    ON ranked.opinion_id = opinions.opinion_id
+//	JOIN users
+//	    ON opinions.user_id = users.user_id
+//	WHERE ranked.rank <= $2::int
+//	ORDER BY ranked.group_id, ranked.important_count DESC, opinions.opinion_id
+func (q *Queries) GetImportantOpinionsByTalkSessionID(ctx context.Context, arg GetImportantOpinionsByTalkSessionIDParams) ([]GetImportantOpinionsByTalkSessionIDRow, error) {
+	rows, err := q.db.QueryContext(ctx, getImportantOpinionsByTalkSessionID, arg.TalkSessionID, arg.LimitPerGroup)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetImportantOpinionsByTalkSessionIDRow
+	for rows.Next() {
+		var i GetImportantOpinionsByTalkSessionIDRow
+		if err := rows.Scan(
+			&i.GroupID,
+			&i.ImportantCount,
+			&i.Opinion.OpinionID,
+			&i.Opinion.TalkSessionID,
+			&i.Opinion.UserID,
+			&i.Opinion.ParentOpinionID,
+			&i.Opinion.Title,
+			&i.Opinion.Content,
+			&i.Opinion.CreatedAt,
+			&i.Opinion.PictureUrl,
+			&i.Opinion.ReferenceUrl,
+			&i.Opinion.Revision,
+			&i.Opinion.EditedAt,
+			&i.Opinion.DeletedAt,
+			&i.User.UserID,
+			&i.User.DisplayID,
+			&i.User.DisplayName,
+			&i.User.IconUrl,
+			&i.User.CreatedAt,
+			&i.User.UpdatedAt,
+			&i.User.Email,
+			&i.User.EmailVerified,
+			&i.User.WithdrawalDate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOpinionTextsWithGroupByTalkSessionID = `-- name: GetOpinionTextsWithGroupByTalkSessionID :many
+SELECT
+    opinions.opinion_id,
+    opinions.title,
+    opinions.content,
+    user_group_info.group_id
+FROM opinions
+LEFT JOIN user_group_info
+    ON opinions.user_id = user_group_info.user_id
+    AND opinions.talk_session_id = user_group_info.talk_session_id
+WHERE opinions.talk_session_id = $1
+    AND opinions.deleted_at IS NULL
+    AND NOT EXISTS (
+        SELECT 1 FROM opinion_reports
+        WHERE opinion_reports.opinion_id = opinions.opinion_id
+            AND opinion_reports.status = 'deleted'
+    )
+ORDER BY opinions.created_at
+`
+
+type GetOpinionTextsWithGroupByTalkSessionIDRow struct {
+	OpinionID uuid.UUID
+	Title     sql.NullString
+	Content   string
+	GroupID   sql.NullInt32
+}
+
+// ワードクラウドの集計に使う意見の本文と投稿者のグループ。グループに属していない投稿者はNULL
+// 投稿者・運営が削除した意見は含めない
+//
+//	SELECT
+//	    opinions.opinion_id,
+//	    opinions.title,
+//	    opinions.content,
+//	    user_group_info.group_id
+//	FROM opinions
+//	LEFT JOIN user_group_info
+//	    ON opinions.user_id = user_group_info.user_id
+//	    AND opinions.talk_session_id = user_group_info.talk_session_id
+//	WHERE opinions.talk_session_id = $1
+//	    AND opinions.deleted_at IS NULL
+//	    AND NOT EXISTS (
+//	        SELECT 1 FROM opinion_reports
+//	        WHERE opinion_reports.opinion_id = opinions.opinion_id
+//	            AND opinion_reports.status = 'deleted'
+//	    )
+//	ORDER BY opinions.created_at
+func (q *Queries) GetOpinionTextsWithGroupByTalkSessionID(ctx context.Context, talkSessionID uuid.UUID) ([]GetOpinionTextsWithGroupByTalkSessionIDRow, error) {
+	rows, err := q.db.QueryContext(ctx, getOpinionTextsWithGroupByTalkSessionID, talkSessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetOpinionTextsWithGroupByTalkSessionIDRow
+	for rows.Next() {
+		var i GetOpinionTextsWithGroupByTalkSessionIDRow
+		if err := rows.Scan(
+			&i.OpinionID,
+			&i.Title,
+			&i.Content,
+			&i.GroupID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOpinionsWithVoteCountsByTalkSessionID = `-- name: GetOpinionsWithVoteCountsByTalkSessionID :many
+SELECT
+    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url, opinions.revision, opinions.edited_at, opinions.deleted_at,
+    users.user_id, users.display_id, users.display_name, users.icon_url, users.created_at, users.updated_at, users.email, users.email_verified, users.withdrawal_date,
+    COALESCE(vc.agree_count, 0)::int AS agree_count,
+    COALESCE(vc.disagree_count, 0)::int AS disagree_count,
+    COALESCE(vc.pass_count, 0)::int AS pass_count
+FROM opinions
+JOIN users
+    ON opinions.user_id = users.user_id
+LEFT JOIN (
+    SELECT
+        votes.opinion_id,
+        COUNT(*) FILTER (WHERE votes.vote_type = 1) AS agree_count,
+        COUNT(*) FILTER (WHERE votes.vote_type = 2) AS disagree_count,
+        COUNT(*) FILTER (WHERE votes.vote_type = 3) AS pass_count
+    FROM votes
+    WHERE votes.talk_session_id = $1::uuid
+    GROUP BY votes.opinion_id
+) vc ON opinions.opinion_id = vc.opinion_id
+WHERE opinions.talk_session_id = $1::uuid
+    AND opinions.deleted_at IS NULL
+    AND NOT EXISTS (
+        SELECT 1 FROM opinion_reports
+        WHERE opinion_reports.opinion_id = opinions.opinion_id
+            AND opinion_reports.status = 'deleted'
+    )
+ORDER BY opinions.created_at, opinions.opinion_id
+`
+
+type GetOpinionsWithVoteCountsByTalkSessionIDRow struct {
+	Opinion       Opinion
+	User          User
+	AgreeCount    int32
+	DisagreeCount int32
+	PassCount     int32
+}
+
+// トピックの集計に使う意見と投票数。返信も含める
+// 投稿者・運営が削除した意見は含めない
+//
+//	SELECT
+//	    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url, opinions.revision, opinions.edited_at, opinions.deleted_at,
+//	    users.user_id, users.display_id, users.display_name, users.icon_url, users.created_at, users.updated_at, users.email, users.email_verified, users.withdrawal_date,
+//	    COALESCE(vc.agree_count, 0)::int AS agree_count,
+//	    COALESCE(vc.disagree_count, 0)::int AS disagree_count,
+//	    COALESCE(vc.pass_count, 0)::int AS pass_count
+//	FROM opinions
+//	JOIN users
+//	    ON opinions.user_id = users.user_id
+//	LEFT JOIN (
+//	    SELECT
+//	        votes.opinion_id,
+//	        COUNT(*) FILTER (WHERE votes.vote_type = 1) AS agree_count,
+//	        COUNT(*) FILTER (WHERE votes.vote_type = 2) AS disagree_count,
+//	        COUNT(*) FILTER (WHERE votes.vote_type = 3) AS pass_count
+//	    FROM votes
+//	    WHERE votes.talk_session_id = $1::uuid
+//	    GROUP BY votes.opinion_id
+//	) vc ON opinions.opinion_id = vc.opinion_id
+//	WHERE opinions.talk_session_id = $1::uuid
+//	    AND opinions.deleted_at IS NULL
+//	    AND NOT EXISTS (
+//	        SELECT 1 FROM opinion_reports
+//	        WHERE opinion_reports.opinion_id = opinions.opinion_id
+//	            AND opinion_reports.status = 'deleted'
+//	    )
+//	ORDER BY opinions.created_at, opinions.opinion_id
+func (q *Queries) GetOpinionsWithVoteCountsByTalkSessionID(ctx context.Context, talkSessionID uuid.UUID) ([]GetOpinionsWithVoteCountsByTalkSessionIDRow, error) {
+	rows, err := q.db.QueryContext(ctx, getOpinionsWithVoteCountsByTalkSessionID, talkSessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetOpinionsWithVoteCountsByTalkSessionIDRow
+	for rows.Next() {
+		var i GetOpinionsWithVoteCountsByTalkSessionIDRow
+		if err := rows.Scan(
+			&i.Opinion.OpinionID,
+			&i.Opinion.TalkSessionID,
+			&i.Opinion.UserID,
+			&i.Opinion.ParentOpinionID,
+			&i.Opinion.Title,
+			&i.Opinion.Content,
+			&i.Opinion.CreatedAt,
+			&i.Opinion.PictureUrl,
+			&i.Opinion.ReferenceUrl,
+			&i.Opinion.Revision,
+			&i.Opinion.EditedAt,
+			&i.Opinion.DeletedAt,
+			&i.User.UserID,
+			&i.User.DisplayID,
+			&i.User.DisplayName,
+			&i.User.IconUrl,
+			&i.User.CreatedAt,
+			&i.User.UpdatedAt,
+			&i.User.Email,
+			&i.User.EmailVerified,
+			&i.User.WithdrawalDate,
+			&i.AgreeCount,
+			&i.DisagreeCount,
+			&i.PassCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getParticipantDemographicsByTalkSessionID = `-- name: GetParticipantDemographicsByTalkSessionID :many
+WITH participants AS (
+    SELECT votes.user_id FROM votes
+    WHERE votes.talk_session_id = $1::uuid
+    UNION
+    SELECT opinions.user_id FROM opinions
+    WHERE opinions.talk_session_id = $1::uuid
+)
+SELECT
+    participants.user_id,
+    user_demographics.user_demographics_id,
+    user_demographics.date_of_birth,
+    user_demographics.gender,
+    user_demographics.city,
+    user_demographics.prefecture
+FROM participants
+LEFT JOIN user_demographics
+    ON participants.user_id = user_demographics.user_id
+ORDER BY participants.user_id
+`
+
+type GetParticipantDemographicsByTalkSessionIDRow struct {
+	UserID             uuid.UUID
+	UserDemographicsID uuid.NullUUID
+	DateOfBirth        sql.NullString
+	Gender             sql.NullString
+	City               sql.NullString
+	Prefecture         sql.NullString
+}
+
+// 意見を投稿したか投票したユーザーの暗号化された属性
+// 属性を登録していないユーザーは属性の列がNULLになる
+//
+//	WITH participants AS (
+//	    SELECT votes.user_id FROM votes
+//	    WHERE votes.talk_session_id = $1::uuid
+//	    UNION
+//	    SELECT opinions.user_id FROM opinions
+//	    WHERE opinions.talk_session_id = $1::uuid
+//	)
+//	SELECT
+//	    participants.user_id,
+//	    user_demographics.user_demographics_id,
+//	    user_demographics.date_of_birth,
+//	    user_demographics.gender,
+//	    user_demographics.city,
+//	    user_demographics.prefecture
+//	FROM participants
+//	LEFT JOIN user_demographics
+//	    ON participants.user_id = user_demographics.user_id
+//	ORDER BY participants.user_id
+func (q *Queries) GetParticipantDemographicsByTalkSessionID(ctx context.Context, talkSessionID uuid.UUID) ([]GetParticipantDemographicsByTalkSessionIDRow, error) {
+	rows, err := q.db.QueryContext(ctx, getParticipantDemographicsByTalkSessionID, talkSessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetParticipantDemographicsByTalkSessionIDRow
+	for rows.Next() {
+		var i GetParticipantDemographicsByTalkSessionIDRow
+		if err := rows.Scan(
+			&i.UserID,
+			&i.UserDemographicsID,
+			&i.DateOfBirth,
+			&i.Gender,
+			&i.City,
+			&i.Prefecture,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getReportByTalkSessionId = `-- name: GetReportByTalkSessionId :one
 SELECT
-    -- talk_session_report_history_id as analysis_report_history_id,
-    talk_session_id,
-    report,
-    created_at
+    talk_session_reports.talk_session_id,
+    talk_session_report_histories.talk_session_report_history_id AS report_version_id,
+    COALESCE(talk_session_report_histories.report, talk_session_reports.report)::text AS report,
+    talk_session_report_histories.sections,
+    talk_session_reports.created_at
 FROM talk_session_reports
-WHERE talk_session_id = $1
+LEFT JOIN talk_session_report_publications
+    ON talk_session_report_publications.talk_session_id = talk_session_reports.talk_session_id
+LEFT JOIN talk_session_report_histories
+    ON talk_session_report_histories.talk_session_report_history_id = talk_session_report_publications.talk_session_report_history_id
+WHERE talk_session_reports.talk_session_id = $1
 `
 
 type GetReportByTalkSessionIdRow struct {
-	TalkSessionID uuid.UUID
-	Report        string
-	CreatedAt     time.Time
+	TalkSessionID   uuid.UUID
+	ReportVersionID uuid.NullUUID
+	Report          string
+	Sections        pqtype.NullRawMessage
+	CreatedAt       time.Time
 }
 
-// GetReportByTalkSessionId
+// 公開するバージョンがあればその内容を返す
 //
 //	SELECT
-//	    -- talk_session_report_history_id as analysis_report_history_id,
-//	    talk_session_id,
-//	    report,
-//	    created_at
+//	    talk_session_reports.talk_session_id,
+//	    talk_session_report_histories.talk_session_report_history_id AS report_version_id,
+//	    COALESCE(talk_session_report_histories.report, talk_session_reports.report)::text AS report,
+//	    talk_session_report_histories.sections,
+//	    talk_session_reports.created_at
 //	FROM talk_session_reports
-//	WHERE talk_session_id = $1
+//	LEFT JOIN talk_session_report_publications
+//	    ON talk_session_report_publications.talk_session_id = talk_session_reports.talk_session_id
+//	LEFT JOIN talk_session_report_histories
+//	    ON talk_session_report_histories.talk_session_report_history_id = talk_session_report_publications.talk_session_report_history_id
+//	WHERE talk_session_reports.talk_session_id = $1
 func (q *Queries) GetReportByTalkSessionId(ctx context.Context, talkSessionID uuid.UUID) (GetReportByTalkSessionIdRow, error) {
 	row := q.db.QueryRowContext(ctx, getReportByTalkSessionId, talkSessionID)
 	var i GetReportByTalkSessionIdRow
-	err := row.Scan(&i.TalkSessionID, &i.Report, &i.CreatedAt)
+	err := row.Scan(
+		&i.TalkSessionID,
+		&i.ReportVersionID,
+		&i.Report,
+		&i.Sections,
+		&i.CreatedAt,
+	)
 	return i, err
 }
 
+const getReportRepresentativeOpinionsByTalkSessionID = `-- name: GetReportRepresentativeOpinionsByTalkSessionID :many
+SELECT
+    representative_opinions.talk_session_id, representative_opinions.opinion_id, representative_opinions.group_id, representative_opinions.rank, representative_opinions.updated_at, representative_opinions.created_at, representative_opinions.agree_count, representative_opinions.disagree_count, representative_opinions.pass_count,
+    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url, opinions.revision, opinions.edited_at, opinions.deleted_at
+FROM representative_opinions
+JOIN opinions
+    ON representative_opinions.opinion_id = opinions.opinion_id
+WHERE representative_opinions.rank < 4
+    AND opinions.talk_session_id = $1
+    AND opinions.deleted_at IS NULL
+    AND NOT EXISTS (
+        SELECT 1 FROM opinion_reports
+        WHERE opinion_reports.opinion_id = opinions.opinion_id
+            AND opinion_reports.status = 'deleted'
+    )
+ORDER BY representative_opinions.group_id, representative_opinions.rank
+`
+
+type GetReportRepresentativeOpinionsByTalkSessionIDRow struct {
+	RepresentativeOpinion RepresentativeOpinion
+	Opinion               Opinion
+}
+
+// レポートの材料にするグループの代表的な意見
+// 投稿者・運営が削除した意見は含めない
+//
+//	SELECT
+//	    representative_opinions.talk_session_id, representative_opinions.opinion_id, representative_opinions.group_id, representative_opinions.rank, representative_opinions.updated_at, representative_opinions.created_at, representative_opinions.agree_count, representative_opinions.disagree_count, representative_opinions.pass_count,
+//	    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url, opinions.revision, opinions.edited_at, opinions.deleted_at
+//	FROM representative_opinions
+//	JOIN opinions
+//	    ON representative_opinions.opinion_id = opinions.opinion_id
+//	WHERE representative_opinions.rank < 4
+//	    AND opinions.talk_session_id = $1
+//	    AND opinions.deleted_at IS NULL
+//	    AND NOT EXISTS (
+//	        SELECT 1 FROM opinion_reports
+//	        WHERE opinion_reports.opinion_id = opinions.opinion_id
+//	            AND opinion_reports.status = 'deleted'
+//	    )
+//	ORDER BY representative_opinions.group_id, representative_opinions.rank
+func (q *Queries) GetReportRepresentativeOpinionsByTalkSessionID(ctx context.Context, talkSessionID uuid.UUID) ([]GetReportRepresentativeOpinionsByTalkSessionIDRow, error) {
+	rows, err := q.db.QueryContext(ctx, getReportRepresentativeOpinionsByTalkSessionID, talkSessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetReportRepresentativeOpinionsByTalkSessionIDRow
+	for rows.Next() {
+		var i GetReportRepresentativeOpinionsByTalkSessionIDRow
+		if err := rows.Scan(
+			&i.RepresentativeOpinion.TalkSessionID,
+			&i.RepresentativeOpinion.OpinionID,
+			&i.RepresentativeOpinion.GroupID,
+			&i.RepresentativeOpinion.Rank,
+			&i.RepresentativeOpinion.UpdatedAt,
+			&i.RepresentativeOpinion.CreatedAt,
+			&i.RepresentativeOpinion.AgreeCount,
+			&i.RepresentativeOpinion.DisagreeCount,
+			&i.RepresentativeOpinion.PassCount,
+			&i.Opinion.OpinionID,
+			&i.Opinion.TalkSessionID,
+			&i.Opinion.UserID,
+			&i.Opinion.ParentOpinionID,
+			&i.Opinion.Title,
+			&i.Opinion.Content,
+			&i.Opinion.CreatedAt,
+			&i.Opinion.PictureUrl,
+			&i.Opinion.ReferenceUrl,
+			&i.Opinion.Revision,
+			&i.Opinion.EditedAt,
+			&i.Opinion.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getRepresentativeOpinionsByTalkSessionId = `-- name: GetRepresentativeOpinionsByTalkSessionId :many
 SELECT
     representative_opinions.talk_session_id, representative_opinions.opinion_id, representative_opinions.group_id, representative_opinions.rank, representative_opinions.updated_at, representative_opinions.created_at, representative_opinions.agree_count, representative_opinions.disagree_count, representative_opinions.pass_count,
-    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url,
+    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url, opinions.revision, opinions.edited_at, opinions.deleted_at,
     users.user_id, users.display_id, users.display_name, users.icon_url, users.created_at, users.updated_at, users.email, users.email_verified, users.withdrawal_date,
     COALESCE(rc.reply_count, 0) AS reply_count
 FROM representative_opinions
@@ -327,7 +1002,7 @@ type GetRepresentativeOpinionsByTalkSessionIdRow struct {
 //
 //	SELECT
 //	    representative_opinions.talk_session_id, representative_opinions.opinion_id, representative_opinions.group_id, representative_opinions.rank, representative_opinions.updated_at, representative_opinions.created_at, representative_opinions.agree_count, representative_opinions.disagree_count, representative_opinions.pass_count,
-//	    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url,
+//	    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url, opinions.revision, opinions.edited_at, opinions.deleted_at,
 //	    users.user_id, users.display_id, users.display_name, users.icon_url, users.created_at, users.updated_at, users.email, users.email_verified, users.withdrawal_date,
 //	    COALESCE(rc.reply_count, 0) AS reply_count
 //	FROM representative_opinions
@@ -371,6 +1046,9 @@ func (q *Queries) GetRepresentativeOpinionsByTalkSessionId(ctx context.Context,
 			&i.Opinion.CreatedAt,
 			&i.Opinion.PictureUrl,
 			&i.Opinion.ReferenceUrl,
+			&i.Opinion.Revision,
+			&i.Opinion.EditedAt,
+			&i.Opinion.DeletedAt,
 			&i.User.UserID,
 			&i.User.DisplayID,
 			&i.User.DisplayName,
@@ -395,6 +1073,172 @@ func (q *Queries) GetRepresentativeOpinionsByTalkSessionId(ctx context.Context,
 	return items, nil
 }
 
+const getTalkSessionOrganizationByOpinionID = `-- name: GetTalkSessionOrganizationByOpinionID :one
+SELECT
+    talk_sessions.talk_session_id,
+    talk_sessions.organization_id
+FROM opinions
+JOIN talk_sessions
+    ON opinions.talk_session_id = talk_sessions.talk_session_id
+WHERE opinions.opinion_id = $1::uuid
+`
+
+type GetTalkSessionOrganizationByOpinionIDRow struct {
+	TalkSessionID  uuid.UUID
+	OrganizationID uuid.NullUUID
+}
+
+// 意見のセッションと、セッションを作成した組織
+//
+//	SELECT
+//	    talk_sessions.talk_session_id,
+//	    talk_sessions.organization_id
+//	FROM opinions
+//	JOIN talk_sessions
+//	    ON opinions.talk_session_id = talk_sessions.talk_session_id
+//	WHERE opinions.opinion_id = $1::uuid
+func (q *Queries) GetTalkSessionOrganizationByOpinionID(ctx context.Context, opinionID uuid.UUID) (GetTalkSessionOrganizationByOpinionIDRow, error) {
+	row := q.db.QueryRowContext(ctx, getTalkSessionOrganizationByOpinionID, opinionID)
+	var i GetTalkSessionOrganizationByOpinionIDRow
+	err := row.Scan(&i.TalkSessionID, &i.OrganizationID)
+	return i, err
+}
+
+const getTermFrequencies = `-- name: GetTermFrequencies :one
+SELECT
+    talk_session_id,
+    wordmap_url,
+    term_frequencies,
+    updated_at
+FROM talk_session_generated_images
+WHERE talk_session_id = $1::uuid
+    AND term_frequencies IS NOT NULL
+`
+
+type GetTermFrequenciesRow struct {
+	TalkSessionID   uuid.UUID
+	WordmapUrl      string
+	TermFrequencies pqtype.NullRawMessage
+	UpdatedAt       time.Time
+}
+
+// GetTermFrequencies
+//
+//	SELECT
+//	    talk_session_id,
+//	    wordmap_url,
+//	    term_frequencies,
+//	    updated_at
+//	FROM talk_session_generated_images
+//	WHERE talk_session_id = $1::uuid
+//	    AND term_frequencies IS NOT NULL
+func (q *Queries) GetTermFrequencies(ctx context.Context, dollar_1 uuid.UUID) (GetTermFrequenciesRow, error) {
+	row := q.db.QueryRowContext(ctx, getTermFrequencies, dollar_1)
+	var i GetTermFrequenciesRow
+	err := row.Scan(
+		&i.TalkSessionID,
+		&i.WordmapUrl,
+		&i.TermFrequencies,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getUserVotesByTalkSessionID = `-- name: GetUserVotesByTalkSessionID :many
+SELECT
+    votes.opinion_id,
+    votes.vote_type
+FROM votes
+JOIN opinions
+    ON votes.opinion_id = opinions.opinion_id
+WHERE votes.talk_session_id = $1::uuid
+    AND votes.user_id = $2::uuid
+    AND opinions.deleted_at IS NULL
+    AND NOT EXISTS (
+        SELECT 1 FROM opinion_reports
+        WHERE opinion_reports.opinion_id = opinions.opinion_id
+            AND opinion_reports.status = 'deleted'
+    )
+`
+
+type GetUserVotesByTalkSessionIDParams struct {
+	TalkSessionID uuid.UUID
+	UserID        uuid.UUID
+}
+
+type GetUserVotesByTalkSessionIDRow struct {
+	OpinionID uuid.UUID
+	VoteType  int16
+}
+
+// ユーザーのセッション内の投票
+// 投稿者・運営が削除した意見は含めない
+//
+//	SELECT
+//	    votes.opinion_id,
+//	    votes.vote_type
+//	FROM votes
+//	JOIN opinions
+//	    ON votes.opinion_id = opinions.opinion_id
+//	WHERE votes.talk_session_id = $1::uuid
+//	    AND votes.user_id = $2::uuid
+//	    AND opinions.deleted_at IS NULL
+//	    AND NOT EXISTS (
+//	        SELECT 1 FROM opinion_reports
+//	        WHERE opinion_reports.opinion_id = opinions.opinion_id
+//	            AND opinion_reports.status = 'deleted'
+//	    )
+func (q *Queries) GetUserVotesByTalkSessionID(ctx context.Context, arg GetUserVotesByTalkSessionIDParams) ([]GetUserVotesByTalkSessionIDRow, error) {
+	rows, err := q.db.QueryContext(ctx, getUserVotesByTalkSessionID, arg.TalkSessionID, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetUserVotesByTalkSessionIDRow
+	for rows.Next() {
+		var i GetUserVotesByTalkSessionIDRow
+		if err := rows.Scan(&i.OpinionID, &i.VoteType); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const saveGeneratedWordCloud = `-- name: SaveGeneratedWordCloud :exec
+INSERT INTO talk_session_generated_images (talk_session_id, wordmap_url, term_frequencies)
+VALUES ($1, $2, $3)
+ON CONFLICT (talk_session_id) DO UPDATE SET
+    wordmap_url = EXCLUDED.wordmap_url,
+    term_frequencies = EXCLUDED.term_frequencies,
+    updated_at = NOW()
+`
+
+type SaveGeneratedWordCloudParams struct {
+	TalkSessionID   uuid.UUID
+	WordmapUrl      string
+	TermFrequencies pqtype.NullRawMessage
+}
+
+// アプリケーション内で生成したワードクラウドを保存する。t-SNEの画像は生成しないため以前のものを残す
+//
+//	INSERT INTO talk_session_generated_images (talk_session_id, wordmap_url, term_frequencies)
+//	VALUES ($1, $2, $3)
+//	ON CONFLICT (talk_session_id) DO UPDATE SET
+//	    wordmap_url = EXCLUDED.wordmap_url,
+//	    term_frequencies = EXCLUDED.term_frequencies,
+//	    updated_at = NOW()
+func (q *Queries) SaveGeneratedWordCloud(ctx context.Context, arg SaveGeneratedWordCloudParams) error {
+	_, err := q.db.ExecContext(ctx, saveGeneratedWordCloud, arg.TalkSessionID, arg.WordmapUrl, arg.TermFrequencies)
+	return err
+}
+
 const saveReportFeedback = `-- name: SaveReportFeedback :exec
 INSERT INTO report_feedback (
     report_feedback_id,
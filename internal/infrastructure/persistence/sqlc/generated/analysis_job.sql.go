@@ -0,0 +1,256 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: analysis_job.sql
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const createAnalysisJob = `-- name: CreateAnalysisJob :execrows
+INSERT INTO analysis_jobs (
+    analysis_job_id,
+    talk_session_id,
+    job_type,
+    status,
+    attempts,
+    run_after,
+    created_at,
+    updated_at
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $7
+)
+ON CONFLICT (talk_session_id, job_type) WHERE status IN ('queued', 'running') DO NOTHING
+`
+
+type CreateAnalysisJobParams struct {
+	AnalysisJobID uuid.UUID
+	TalkSessionID uuid.UUID
+	JobType       string
+	Status        string
+	Attempts      int32
+	RunAfter      time.Time
+	CreatedAt     time.Time
+}
+
+// 同じセッション・種類の待機中・実行中のジョブがある場合は作成しない
+//
+//	INSERT INTO analysis_jobs (
+//	    analysis_job_id,
+//	    talk_session_id,
+//	    job_type,
+//	    status,
+//	    attempts,
+//	    run_after,
+//	    created_at,
+//	    updated_at
+//	) VALUES (
+//	    $1,
+//	    $2,
+//	    $3,
+//	    $4,
+//	    $5,
+//	    $6,
+//	    $7,
+//	    $7
+//	)
+//	ON CONFLICT (talk_session_id, job_type) WHERE status IN ('queued', 'running') DO NOTHING
+func (q *Queries) CreateAnalysisJob(ctx context.Context, arg CreateAnalysisJobParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, createAnalysisJob,
+		arg.AnalysisJobID,
+		arg.TalkSessionID,
+		arg.JobType,
+		arg.Status,
+		arg.Attempts,
+		arg.RunAfter,
+		arg.CreatedAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const findActiveAnalysisJob = `-- name: FindActiveAnalysisJob :one
+SELECT analysis_job_id, talk_session_id, job_type, status, attempts, last_error, run_after, started_at, finished_at, created_at, updated_at FROM analysis_jobs
+WHERE talk_session_id = $1
+    AND job_type = $2
+    AND status IN ('queued', 'running')
+`
+
+type FindActiveAnalysisJobParams struct {
+	TalkSessionID uuid.UUID
+	JobType       string
+}
+
+// FindActiveAnalysisJob
+//
+//	SELECT analysis_job_id, talk_session_id, job_type, status, attempts, last_error, run_after, started_at, finished_at, created_at, updated_at FROM analysis_jobs
+//	WHERE talk_session_id = $1
+//	    AND job_type = $2
+//	    AND status IN ('queued', 'running')
+func (q *Queries) FindActiveAnalysisJob(ctx context.Context, arg FindActiveAnalysisJobParams) (AnalysisJob, error) {
+	row := q.db.QueryRowContext(ctx, findActiveAnalysisJob, arg.TalkSessionID, arg.JobType)
+	var i AnalysisJob
+	err := row.Scan(
+		&i.AnalysisJobID,
+		&i.TalkSessionID,
+		&i.JobType,
+		&i.Status,
+		&i.Attempts,
+		&i.LastError,
+		&i.RunAfter,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const findAnalysisJobByID = `-- name: FindAnalysisJobByID :one
+SELECT analysis_job_id, talk_session_id, job_type, status, attempts, last_error, run_after, started_at, finished_at, created_at, updated_at FROM analysis_jobs
+WHERE analysis_job_id = $1
+`
+
+// FindAnalysisJobByID
+//
+//	SELECT analysis_job_id, talk_session_id, job_type, status, attempts, last_error, run_after, started_at, finished_at, created_at, updated_at FROM analysis_jobs
+//	WHERE analysis_job_id = $1
+func (q *Queries) FindAnalysisJobByID(ctx context.Context, analysisJobID uuid.UUID) (AnalysisJob, error) {
+	row := q.db.QueryRowContext(ctx, findAnalysisJobByID, analysisJobID)
+	var i AnalysisJob
+	err := row.Scan(
+		&i.AnalysisJobID,
+		&i.TalkSessionID,
+		&i.JobType,
+		&i.Status,
+		&i.Attempts,
+		&i.LastError,
+		&i.RunAfter,
+		&i.StartedAt,
+		&i.FinishedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const findRunnableAnalysisJobsForUpdate = `-- name: FindRunnableAnalysisJobsForUpdate :many
+SELECT analysis_job_id, talk_session_id, job_type, status, attempts, last_error, run_after, started_at, finished_at, created_at, updated_at FROM analysis_jobs
+WHERE (status = 'queued' AND run_after <= $1::timestamp)
+    OR (status = 'running' AND started_at <= $2::timestamp)
+ORDER BY run_after ASC
+LIMIT $3::int
+FOR UPDATE SKIP LOCKED
+`
+
+type FindRunnableAnalysisJobsForUpdateParams struct {
+	Now         time.Time
+	StaleBefore time.Time
+	Limit       int32
+}
+
+// 実行を待っているジョブと、実行中のまま止まったジョブを古い順に取得する。他のサーバーが処理中の行は飛ばす
+//
+//	SELECT analysis_job_id, talk_session_id, job_type, status, attempts, last_error, run_after, started_at, finished_at, created_at, updated_at FROM analysis_jobs
+//	WHERE (status = 'queued' AND run_after <= $1::timestamp)
+//	    OR (status = 'running' AND started_at <= $2::timestamp)
+//	ORDER BY run_after ASC
+//	LIMIT $3::int
+//	FOR UPDATE SKIP LOCKED
+func (q *Queries) FindRunnableAnalysisJobsForUpdate(ctx context.Context, arg FindRunnableAnalysisJobsForUpdateParams) ([]AnalysisJob, error) {
+	rows, err := q.db.QueryContext(ctx, findRunnableAnalysisJobsForUpdate, arg.Now, arg.StaleBefore, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AnalysisJob
+	for rows.Next() {
+		var i AnalysisJob
+		if err := rows.Scan(
+			&i.AnalysisJobID,
+			&i.TalkSessionID,
+			&i.JobType,
+			&i.Status,
+			&i.Attempts,
+			&i.LastError,
+			&i.RunAfter,
+			&i.StartedAt,
+			&i.FinishedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateAnalysisJob = `-- name: UpdateAnalysisJob :exec
+UPDATE analysis_jobs SET
+    status = $1,
+    attempts = $2,
+    last_error = $3,
+    run_after = $4,
+    started_at = $5,
+    finished_at = $6,
+    updated_at = $7
+WHERE analysis_job_id = $8
+`
+
+type UpdateAnalysisJobParams struct {
+	Status        string
+	Attempts      int32
+	LastError     sql.NullString
+	RunAfter      time.Time
+	StartedAt     sql.NullTime
+	FinishedAt    sql.NullTime
+	UpdatedAt     time.Time
+	AnalysisJobID uuid.UUID
+}
+
+// UpdateAnalysisJob
+//
+//	UPDATE analysis_jobs SET
+//	    status = $1,
+//	    attempts = $2,
+//	    last_error = $3,
+//	    run_after = $4,
+//	    started_at = $5,
+//	    finished_at = $6,
+//	    updated_at = $7
+//	WHERE analysis_job_id = $8
+func (q *Queries) UpdateAnalysisJob(ctx context.Context, arg UpdateAnalysisJobParams) error {
+	_, err := q.db.ExecContext(ctx, updateAnalysisJob,
+		arg.Status,
+		arg.Attempts,
+		arg.LastError,
+		arg.RunAfter,
+		arg.StartedAt,
+		arg.FinishedAt,
+		arg.UpdatedAt,
+		arg.AnalysisJobID,
+	)
+	return err
+}
@@ -0,0 +1,288 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: analysis_schedule.sql
+
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const completeAnalysisSchedule = `-- name: CompleteAnalysisSchedule :exec
+UPDATE analysis_schedules SET
+    running_since = NULL,
+    last_completed_at = $1::timestamp,
+    updated_at = $1::timestamp
+WHERE talk_session_id = $2
+`
+
+type CompleteAnalysisScheduleParams struct {
+	CompletedAt   time.Time
+	TalkSessionID uuid.UUID
+}
+
+// CompleteAnalysisSchedule
+//
+//	UPDATE analysis_schedules SET
+//	    running_since = NULL,
+//	    last_completed_at = $1::timestamp,
+//	    updated_at = $1::timestamp
+//	WHERE talk_session_id = $2
+func (q *Queries) CompleteAnalysisSchedule(ctx context.Context, arg CompleteAnalysisScheduleParams) error {
+	_, err := q.db.ExecContext(ctx, completeAnalysisSchedule, arg.CompletedAt, arg.TalkSessionID)
+	return err
+}
+
+const failAnalysisSchedule = `-- name: FailAnalysisSchedule :exec
+UPDATE analysis_schedules SET
+    running_since = NULL,
+    pending_since = COALESCE(pending_since, $1::timestamp),
+    last_failed_at = $1::timestamp,
+    last_failure_reason = $2::text,
+    updated_at = $1::timestamp
+WHERE talk_session_id = $3
+`
+
+type FailAnalysisScheduleParams struct {
+	FailedAt      time.Time
+	FailureReason string
+	TalkSessionID uuid.UUID
+}
+
+// 失敗した場合は、分析を待っている活動として残して後で再実行する
+//
+//	UPDATE analysis_schedules SET
+//	    running_since = NULL,
+//	    pending_since = COALESCE(pending_since, $1::timestamp),
+//	    last_failed_at = $1::timestamp,
+//	    last_failure_reason = $2::text,
+//	    updated_at = $1::timestamp
+//	WHERE talk_session_id = $3
+func (q *Queries) FailAnalysisSchedule(ctx context.Context, arg FailAnalysisScheduleParams) error {
+	_, err := q.db.ExecContext(ctx, failAnalysisSchedule, arg.FailedAt, arg.FailureReason, arg.TalkSessionID)
+	return err
+}
+
+const findAnalysisScheduleByTalkSessionID = `-- name: FindAnalysisScheduleByTalkSessionID :one
+SELECT talk_session_id, pending_vote_count, pending_opinion_count, pending_since, running_since, last_completed_at, last_failed_at, last_failure_reason, updated_at FROM analysis_schedules
+WHERE talk_session_id = $1
+`
+
+// FindAnalysisScheduleByTalkSessionID
+//
+//	SELECT talk_session_id, pending_vote_count, pending_opinion_count, pending_since, running_since, last_completed_at, last_failed_at, last_failure_reason, updated_at FROM analysis_schedules
+//	WHERE talk_session_id = $1
+func (q *Queries) FindAnalysisScheduleByTalkSessionID(ctx context.Context, talkSessionID uuid.UUID) (AnalysisSchedule, error) {
+	row := q.db.QueryRowContext(ctx, findAnalysisScheduleByTalkSessionID, talkSessionID)
+	var i AnalysisSchedule
+	err := row.Scan(
+		&i.TalkSessionID,
+		&i.PendingVoteCount,
+		&i.PendingOpinionCount,
+		&i.PendingSince,
+		&i.RunningSince,
+		&i.LastCompletedAt,
+		&i.LastFailedAt,
+		&i.LastFailureReason,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const findDueAnalysisSchedulesForUpdate = `-- name: FindDueAnalysisSchedulesForUpdate :many
+SELECT talk_session_id, pending_vote_count, pending_opinion_count, pending_since, running_since, last_completed_at, last_failed_at, last_failure_reason, updated_at FROM analysis_schedules
+WHERE pending_since IS NOT NULL
+    AND (running_since IS NULL OR running_since <= $1::timestamp)
+    AND (
+        pending_vote_count >= $2::int
+        OR pending_since <= $3::timestamp
+    )
+ORDER BY pending_since ASC
+LIMIT $4::int
+FOR UPDATE SKIP LOCKED
+`
+
+type FindDueAnalysisSchedulesForUpdateParams struct {
+	StaleBefore   time.Time
+	VoteThreshold int32
+	DelayedBefore time.Time
+	BatchSize     int32
+}
+
+// 再計算の条件を満たしたスケジュールを古い順に取得する。実行中のセッションと、他のサーバーが処理中の行は飛ばす
+//
+//	SELECT talk_session_id, pending_vote_count, pending_opinion_count, pending_since, running_since, last_completed_at, last_failed_at, last_failure_reason, updated_at FROM analysis_schedules
+//	WHERE pending_since IS NOT NULL
+//	    AND (running_since IS NULL OR running_since <= $1::timestamp)
+//	    AND (
+//	        pending_vote_count >= $2::int
+//	        OR pending_since <= $3::timestamp
+//	    )
+//	ORDER BY pending_since ASC
+//	LIMIT $4::int
+//	FOR UPDATE SKIP LOCKED
+func (q *Queries) FindDueAnalysisSchedulesForUpdate(ctx context.Context, arg FindDueAnalysisSchedulesForUpdateParams) ([]AnalysisSchedule, error) {
+	rows, err := q.db.QueryContext(ctx, findDueAnalysisSchedulesForUpdate,
+		arg.StaleBefore,
+		arg.VoteThreshold,
+		arg.DelayedBefore,
+		arg.BatchSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AnalysisSchedule
+	for rows.Next() {
+		var i AnalysisSchedule
+		if err := rows.Scan(
+			&i.TalkSessionID,
+			&i.PendingVoteCount,
+			&i.PendingOpinionCount,
+			&i.PendingSince,
+			&i.RunningSince,
+			&i.LastCompletedAt,
+			&i.LastFailedAt,
+			&i.LastFailureReason,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockAnalysisSchedule = `-- name: LockAnalysisSchedule :one
+INSERT INTO analysis_schedules (talk_session_id, updated_at)
+VALUES ($1, $2::timestamp)
+ON CONFLICT (talk_session_id) DO UPDATE SET
+    updated_at = analysis_schedules.updated_at
+RETURNING talk_session_id, pending_vote_count, pending_opinion_count, pending_since, running_since, last_completed_at, last_failed_at, last_failure_reason, updated_at
+`
+
+type LockAnalysisScheduleParams struct {
+	TalkSessionID uuid.UUID
+	Now           time.Time
+}
+
+// スケジュールがない場合は作成し、トランザクションの間ロックする
+//
+//	INSERT INTO analysis_schedules (talk_session_id, updated_at)
+//	VALUES ($1, $2::timestamp)
+//	ON CONFLICT (talk_session_id) DO UPDATE SET
+//	    updated_at = analysis_schedules.updated_at
+//	RETURNING talk_session_id, pending_vote_count, pending_opinion_count, pending_since, running_since, last_completed_at, last_failed_at, last_failure_reason, updated_at
+func (q *Queries) LockAnalysisSchedule(ctx context.Context, arg LockAnalysisScheduleParams) (AnalysisSchedule, error) {
+	row := q.db.QueryRowContext(ctx, lockAnalysisSchedule, arg.TalkSessionID, arg.Now)
+	var i AnalysisSchedule
+	err := row.Scan(
+		&i.TalkSessionID,
+		&i.PendingVoteCount,
+		&i.PendingOpinionCount,
+		&i.PendingSince,
+		&i.RunningSince,
+		&i.LastCompletedAt,
+		&i.LastFailedAt,
+		&i.LastFailureReason,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const recordAnalysisActivity = `-- name: RecordAnalysisActivity :exec
+INSERT INTO analysis_schedules (
+    talk_session_id,
+    pending_vote_count,
+    pending_opinion_count,
+    pending_since,
+    updated_at
+) VALUES (
+    $1,
+    $2::int,
+    $3::int,
+    $4::timestamp,
+    $4::timestamp
+)
+ON CONFLICT (talk_session_id) DO UPDATE SET
+    pending_vote_count = analysis_schedules.pending_vote_count + EXCLUDED.pending_vote_count,
+    pending_opinion_count = analysis_schedules.pending_opinion_count + EXCLUDED.pending_opinion_count,
+    pending_since = LEAST(COALESCE(analysis_schedules.pending_since, EXCLUDED.pending_since), EXCLUDED.pending_since),
+    updated_at = EXCLUDED.updated_at
+`
+
+type RecordAnalysisActivityParams struct {
+	TalkSessionID uuid.UUID
+	VoteCount     int32
+	OpinionCount  int32
+	OccurredAt    time.Time
+}
+
+// RecordAnalysisActivity
+//
+//	INSERT INTO analysis_schedules (
+//	    talk_session_id,
+//	    pending_vote_count,
+//	    pending_opinion_count,
+//	    pending_since,
+//	    updated_at
+//	) VALUES (
+//	    $1,
+//	    $2::int,
+//	    $3::int,
+//	    $4::timestamp,
+//	    $4::timestamp
+//	)
+//	ON CONFLICT (talk_session_id) DO UPDATE SET
+//	    pending_vote_count = analysis_schedules.pending_vote_count + EXCLUDED.pending_vote_count,
+//	    pending_opinion_count = analysis_schedules.pending_opinion_count + EXCLUDED.pending_opinion_count,
+//	    pending_since = LEAST(COALESCE(analysis_schedules.pending_since, EXCLUDED.pending_since), EXCLUDED.pending_since),
+//	    updated_at = EXCLUDED.updated_at
+func (q *Queries) RecordAnalysisActivity(ctx context.Context, arg RecordAnalysisActivityParams) error {
+	_, err := q.db.ExecContext(ctx, recordAnalysisActivity,
+		arg.TalkSessionID,
+		arg.VoteCount,
+		arg.OpinionCount,
+		arg.OccurredAt,
+	)
+	return err
+}
+
+const startAnalysisSchedule = `-- name: StartAnalysisSchedule :exec
+UPDATE analysis_schedules SET
+    pending_vote_count = 0,
+    pending_opinion_count = 0,
+    pending_since = NULL,
+    running_since = $1::timestamp,
+    updated_at = $1::timestamp
+WHERE talk_session_id = $2
+`
+
+type StartAnalysisScheduleParams struct {
+	StartedAt     time.Time
+	TalkSessionID uuid.UUID
+}
+
+// StartAnalysisSchedule
+//
+//	UPDATE analysis_schedules SET
+//	    pending_vote_count = 0,
+//	    pending_opinion_count = 0,
+//	    pending_since = NULL,
+//	    running_since = $1::timestamp,
+//	    updated_at = $1::timestamp
+//	WHERE talk_session_id = $2
+func (q *Queries) StartAnalysisSchedule(ctx context.Context, arg StartAnalysisScheduleParams) error {
+	_, err := q.db.ExecContext(ctx, startAnalysisSchedule, arg.StartedAt, arg.TalkSessionID)
+	return err
+}
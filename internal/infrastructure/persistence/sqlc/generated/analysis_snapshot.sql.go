@@ -0,0 +1,511 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: analysis_snapshot.sql
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const copyRepresentativeOpinionsToAnalysisSnapshot = `-- name: CopyRepresentativeOpinionsToAnalysisSnapshot :exec
+INSERT INTO analysis_snapshot_representative_opinions (
+    analysis_snapshot_id,
+    opinion_id,
+    group_id,
+    rank,
+    agree_count,
+    disagree_count,
+    pass_count
+)
+SELECT
+    $1::uuid,
+    ro.opinion_id,
+    ro.group_id,
+    ro.rank,
+    ro.agree_count,
+    ro.disagree_count,
+    ro.pass_count
+FROM representative_opinions ro
+WHERE ro.talk_session_id = $2::uuid
+`
+
+type CopyRepresentativeOpinionsToAnalysisSnapshotParams struct {
+	AnalysisSnapshotID uuid.UUID
+	TalkSessionID      uuid.UUID
+}
+
+// CopyRepresentativeOpinionsToAnalysisSnapshot
+//
+//	INSERT INTO analysis_snapshot_representative_opinions (
+//	    analysis_snapshot_id,
+//	    opinion_id,
+//	    group_id,
+//	    rank,
+//	    agree_count,
+//	    disagree_count,
+//	    pass_count
+//	)
+//	SELECT
+//	    $1::uuid,
+//	    ro.opinion_id,
+//	    ro.group_id,
+//	    ro.rank,
+//	    ro.agree_count,
+//	    ro.disagree_count,
+//	    ro.pass_count
+//	FROM representative_opinions ro
+//	WHERE ro.talk_session_id = $2::uuid
+func (q *Queries) CopyRepresentativeOpinionsToAnalysisSnapshot(ctx context.Context, arg CopyRepresentativeOpinionsToAnalysisSnapshotParams) error {
+	_, err := q.db.ExecContext(ctx, copyRepresentativeOpinionsToAnalysisSnapshot, arg.AnalysisSnapshotID, arg.TalkSessionID)
+	return err
+}
+
+const copyUserGroupInfoToAnalysisSnapshot = `-- name: CopyUserGroupInfoToAnalysisSnapshot :exec
+INSERT INTO analysis_snapshot_users (
+    analysis_snapshot_id,
+    user_id,
+    group_id,
+    pos_x,
+    pos_y,
+    perimeter_index
+)
+SELECT
+    $1::uuid,
+    ugi.user_id,
+    ugi.group_id,
+    ugi.pos_x,
+    ugi.pos_y,
+    ugi.perimeter_index
+FROM user_group_info ugi
+WHERE ugi.talk_session_id = $2::uuid
+`
+
+type CopyUserGroupInfoToAnalysisSnapshotParams struct {
+	AnalysisSnapshotID uuid.UUID
+	TalkSessionID      uuid.UUID
+}
+
+// CopyUserGroupInfoToAnalysisSnapshot
+//
+//	INSERT INTO analysis_snapshot_users (
+//	    analysis_snapshot_id,
+//	    user_id,
+//	    group_id,
+//	    pos_x,
+//	    pos_y,
+//	    perimeter_index
+//	)
+//	SELECT
+//	    $1::uuid,
+//	    ugi.user_id,
+//	    ugi.group_id,
+//	    ugi.pos_x,
+//	    ugi.pos_y,
+//	    ugi.perimeter_index
+//	FROM user_group_info ugi
+//	WHERE ugi.talk_session_id = $2::uuid
+func (q *Queries) CopyUserGroupInfoToAnalysisSnapshot(ctx context.Context, arg CopyUserGroupInfoToAnalysisSnapshotParams) error {
+	_, err := q.db.ExecContext(ctx, copyUserGroupInfoToAnalysisSnapshot, arg.AnalysisSnapshotID, arg.TalkSessionID)
+	return err
+}
+
+const createAnalysisSnapshot = `-- name: CreateAnalysisSnapshot :exec
+INSERT INTO analysis_snapshots (
+    analysis_snapshot_id,
+    talk_session_id,
+    fingerprint,
+    user_count,
+    group_count,
+    created_at
+) VALUES ($1, $2, $3, $4, $5, $6)
+`
+
+type CreateAnalysisSnapshotParams struct {
+	AnalysisSnapshotID uuid.UUID
+	TalkSessionID      uuid.UUID
+	Fingerprint        string
+	UserCount          int32
+	GroupCount         int32
+	CreatedAt          time.Time
+}
+
+// CreateAnalysisSnapshot
+//
+//	INSERT INTO analysis_snapshots (
+//	    analysis_snapshot_id,
+//	    talk_session_id,
+//	    fingerprint,
+//	    user_count,
+//	    group_count,
+//	    created_at
+//	) VALUES ($1, $2, $3, $4, $5, $6)
+func (q *Queries) CreateAnalysisSnapshot(ctx context.Context, arg CreateAnalysisSnapshotParams) error {
+	_, err := q.db.ExecContext(ctx, createAnalysisSnapshot,
+		arg.AnalysisSnapshotID,
+		arg.TalkSessionID,
+		arg.Fingerprint,
+		arg.UserCount,
+		arg.GroupCount,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const findAnalysisSnapshotByID = `-- name: FindAnalysisSnapshotByID :one
+SELECT analysis_snapshot_id, talk_session_id, fingerprint, user_count, group_count, created_at FROM analysis_snapshots
+WHERE analysis_snapshot_id = $1
+    AND talk_session_id = $2
+`
+
+type FindAnalysisSnapshotByIDParams struct {
+	AnalysisSnapshotID uuid.UUID
+	TalkSessionID      uuid.UUID
+}
+
+// FindAnalysisSnapshotByID
+//
+//	SELECT analysis_snapshot_id, talk_session_id, fingerprint, user_count, group_count, created_at FROM analysis_snapshots
+//	WHERE analysis_snapshot_id = $1
+//	    AND talk_session_id = $2
+func (q *Queries) FindAnalysisSnapshotByID(ctx context.Context, arg FindAnalysisSnapshotByIDParams) (AnalysisSnapshot, error) {
+	row := q.db.QueryRowContext(ctx, findAnalysisSnapshotByID, arg.AnalysisSnapshotID, arg.TalkSessionID)
+	var i AnalysisSnapshot
+	err := row.Scan(
+		&i.AnalysisSnapshotID,
+		&i.TalkSessionID,
+		&i.Fingerprint,
+		&i.UserCount,
+		&i.GroupCount,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const findLatestAnalysisSnapshot = `-- name: FindLatestAnalysisSnapshot :one
+SELECT analysis_snapshot_id, talk_session_id, fingerprint, user_count, group_count, created_at FROM analysis_snapshots
+WHERE talk_session_id = $1
+ORDER BY created_at DESC
+LIMIT 1
+`
+
+// FindLatestAnalysisSnapshot
+//
+//	SELECT analysis_snapshot_id, talk_session_id, fingerprint, user_count, group_count, created_at FROM analysis_snapshots
+//	WHERE talk_session_id = $1
+//	ORDER BY created_at DESC
+//	LIMIT 1
+func (q *Queries) FindLatestAnalysisSnapshot(ctx context.Context, talkSessionID uuid.UUID) (AnalysisSnapshot, error) {
+	row := q.db.QueryRowContext(ctx, findLatestAnalysisSnapshot, talkSessionID)
+	var i AnalysisSnapshot
+	err := row.Scan(
+		&i.AnalysisSnapshotID,
+		&i.TalkSessionID,
+		&i.Fingerprint,
+		&i.UserCount,
+		&i.GroupCount,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getAnalysisSnapshotRepresentativeOpinions = `-- name: GetAnalysisSnapshotRepresentativeOpinions :many
+SELECT
+    analysis_snapshot_representative_opinions.analysis_snapshot_id, analysis_snapshot_representative_opinions.opinion_id, analysis_snapshot_representative_opinions.group_id, analysis_snapshot_representative_opinions.rank, analysis_snapshot_representative_opinions.agree_count, analysis_snapshot_representative_opinions.disagree_count, analysis_snapshot_representative_opinions.pass_count,
+    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url, opinions.revision, opinions.edited_at, opinions.deleted_at,
+    users.user_id, users.display_id, users.display_name, users.icon_url, users.created_at, users.updated_at, users.email, users.email_verified, users.withdrawal_date,
+    COALESCE(rc.reply_count, 0) AS reply_count
+FROM analysis_snapshot_representative_opinions
+LEFT JOIN opinions
+    ON analysis_snapshot_representative_opinions.opinion_id = opinions.opinion_id
+LEFT JOIN users
+    ON opinions.user_id = users.user_id
+LEFT JOIN (
+    SELECT COUNT(opinion_id) AS reply_count, parent_opinion_id
+    FROM opinions
+    GROUP BY parent_opinion_id
+) rc ON opinions.opinion_id = rc.parent_opinion_id
+WHERE analysis_snapshot_representative_opinions.analysis_snapshot_id = $1
+    AND analysis_snapshot_representative_opinions.rank < 4
+ORDER BY analysis_snapshot_representative_opinions.group_id, analysis_snapshot_representative_opinions.rank
+`
+
+type GetAnalysisSnapshotRepresentativeOpinionsRow struct {
+	AnalysisSnapshotRepresentativeOpinion AnalysisSnapshotRepresentativeOpinion
+	Opinion                               Opinion
+	User                                  User
+	ReplyCount                            int64
+}
+
+// GetAnalysisSnapshotRepresentativeOpinions
+//
+//	SELECT
+//	    analysis_snapshot_representative_opinions.analysis_snapshot_id, analysis_snapshot_representative_opinions.opinion_id, analysis_snapshot_representative_opinions.group_id, analysis_snapshot_representative_opinions.rank, analysis_snapshot_representative_opinions.agree_count, analysis_snapshot_representative_opinions.disagree_count, analysis_snapshot_representative_opinions.pass_count,
+//	    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url, opinions.revision, opinions.edited_at, opinions.deleted_at,
+//	    users.user_id, users.display_id, users.display_name, users.icon_url, users.created_at, users.updated_at, users.email, users.email_verified, users.withdrawal_date,
+//	    COALESCE(rc.reply_count, 0) AS reply_count
+//	FROM analysis_snapshot_representative_opinions
+//	LEFT JOIN opinions
+//	    ON analysis_snapshot_representative_opinions.opinion_id = opinions.opinion_id
+//	LEFT JOIN users
+//	    ON opinions.user_id = users.user_id
+//	LEFT JOIN (
+//	    SELECT COUNT(opinion_id) AS reply_count, parent_opinion_id
+//	    FROM opinions
+//	    GROUP BY parent_opinion_id
+//	) rc ON opinions.opinion_id = rc.parent_opinion_id
+//	WHERE analysis_snapshot_representative_opinions.analysis_snapshot_id = $1
+//	    AND analysis_snapshot_representative_opinions.rank < 4
+//	ORDER BY analysis_snapshot_representative_opinions.group_id, analysis_snapshot_representative_opinions.rank
+func (q *Queries) GetAnalysisSnapshotRepresentativeOpinions(ctx context.Context, analysisSnapshotID uuid.UUID) ([]GetAnalysisSnapshotRepresentativeOpinionsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getAnalysisSnapshotRepresentativeOpinions, analysisSnapshotID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetAnalysisSnapshotRepresentativeOpinionsRow
+	for rows.Next() {
+		var i GetAnalysisSnapshotRepresentativeOpinionsRow
+		if err := rows.Scan(
+			&i.AnalysisSnapshotRepresentativeOpinion.AnalysisSnapshotID,
+			&i.AnalysisSnapshotRepresentativeOpinion.OpinionID,
+			&i.AnalysisSnapshotRepresentativeOpinion.GroupID,
+			&i.AnalysisSnapshotRepresentativeOpinion.Rank,
+			&i.AnalysisSnapshotRepresentativeOpinion.AgreeCount,
+			&i.AnalysisSnapshotRepresentativeOpinion.DisagreeCount,
+			&i.AnalysisSnapshotRepresentativeOpinion.PassCount,
+			&i.Opinion.OpinionID,
+			&i.Opinion.TalkSessionID,
+			&i.Opinion.UserID,
+			&i.Opinion.ParentOpinionID,
+			&i.Opinion.Title,
+			&i.Opinion.Content,
+			&i.Opinion.CreatedAt,
+			&i.Opinion.PictureUrl,
+			&i.Opinion.ReferenceUrl,
+			&i.Opinion.Revision,
+			&i.Opinion.EditedAt,
+			&i.Opinion.DeletedAt,
+			&i.User.UserID,
+			&i.User.DisplayID,
+			&i.User.DisplayName,
+			&i.User.IconUrl,
+			&i.User.CreatedAt,
+			&i.User.UpdatedAt,
+			&i.User.Email,
+			&i.User.EmailVerified,
+			&i.User.WithdrawalDate,
+			&i.ReplyCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAnalysisSnapshotUsers = `-- name: GetAnalysisSnapshotUsers :many
+SELECT
+    analysis_snapshot_users.user_id,
+    analysis_snapshot_users.group_id,
+    analysis_snapshot_users.pos_x,
+    analysis_snapshot_users.pos_y,
+    analysis_snapshot_users.perimeter_index,
+    users.display_id AS display_id,
+    users.display_name AS display_name,
+    users.icon_url AS icon_url
+FROM analysis_snapshot_users
+LEFT JOIN users
+    ON analysis_snapshot_users.user_id = users.user_id
+WHERE analysis_snapshot_users.analysis_snapshot_id = $1
+ORDER BY analysis_snapshot_users.user_id
+`
+
+type GetAnalysisSnapshotUsersRow struct {
+	UserID         uuid.UUID
+	GroupID        int32
+	PosX           float64
+	PosY           float64
+	PerimeterIndex sql.NullInt32
+	DisplayID      sql.NullString
+	DisplayName    sql.NullString
+	IconUrl        sql.NullString
+}
+
+// GetAnalysisSnapshotUsers
+//
+//	SELECT
+//	    analysis_snapshot_users.user_id,
+//	    analysis_snapshot_users.group_id,
+//	    analysis_snapshot_users.pos_x,
+//	    analysis_snapshot_users.pos_y,
+//	    analysis_snapshot_users.perimeter_index,
+//	    users.display_id AS display_id,
+//	    users.display_name AS display_name,
+//	    users.icon_url AS icon_url
+//	FROM analysis_snapshot_users
+//	LEFT JOIN users
+//	    ON analysis_snapshot_users.user_id = users.user_id
+//	WHERE analysis_snapshot_users.analysis_snapshot_id = $1
+//	ORDER BY analysis_snapshot_users.user_id
+func (q *Queries) GetAnalysisSnapshotUsers(ctx context.Context, analysisSnapshotID uuid.UUID) ([]GetAnalysisSnapshotUsersRow, error) {
+	rows, err := q.db.QueryContext(ctx, getAnalysisSnapshotUsers, analysisSnapshotID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetAnalysisSnapshotUsersRow
+	for rows.Next() {
+		var i GetAnalysisSnapshotUsersRow
+		if err := rows.Scan(
+			&i.UserID,
+			&i.GroupID,
+			&i.PosX,
+			&i.PosY,
+			&i.PerimeterIndex,
+			&i.DisplayID,
+			&i.DisplayName,
+			&i.IconUrl,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAnalysisSnapshotsByTalkSessionID = `-- name: GetAnalysisSnapshotsByTalkSessionID :many
+SELECT analysis_snapshot_id, talk_session_id, fingerprint, user_count, group_count, created_at FROM analysis_snapshots
+WHERE talk_session_id = $1
+ORDER BY created_at ASC
+`
+
+// GetAnalysisSnapshotsByTalkSessionID
+//
+//	SELECT analysis_snapshot_id, talk_session_id, fingerprint, user_count, group_count, created_at FROM analysis_snapshots
+//	WHERE talk_session_id = $1
+//	ORDER BY created_at ASC
+func (q *Queries) GetAnalysisSnapshotsByTalkSessionID(ctx context.Context, talkSessionID uuid.UUID) ([]AnalysisSnapshot, error) {
+	rows, err := q.db.QueryContext(ctx, getAnalysisSnapshotsByTalkSessionID, talkSessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AnalysisSnapshot
+	for rows.Next() {
+		var i AnalysisSnapshot
+		if err := rows.Scan(
+			&i.AnalysisSnapshotID,
+			&i.TalkSessionID,
+			&i.Fingerprint,
+			&i.UserCount,
+			&i.GroupCount,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCurrentAnalysisFingerprint = `-- name: GetCurrentAnalysisFingerprint :one
+SELECT
+    md5(
+        COALESCE((
+            SELECT string_agg(
+                concat_ws(':', ugi.user_id, ugi.group_id, ugi.pos_x, ugi.pos_y, ugi.perimeter_index),
+                ',' ORDER BY ugi.user_id
+            )
+            FROM user_group_info ugi
+            WHERE ugi.talk_session_id = $1::uuid
+        ), '')
+        || '|' ||
+        COALESCE((
+            SELECT string_agg(
+                concat_ws(':', ro.opinion_id, ro.group_id, ro.rank, ro.agree_count, ro.disagree_count, ro.pass_count),
+                ',' ORDER BY ro.group_id, ro.opinion_id
+            )
+            FROM representative_opinions ro
+            WHERE ro.talk_session_id = $1::uuid
+        ), '')
+    )::text AS fingerprint,
+    (
+        SELECT COUNT(*)
+        FROM user_group_info ugi
+        WHERE ugi.talk_session_id = $1::uuid
+    ) AS user_count,
+    (
+        SELECT COUNT(DISTINCT ugi.group_id)
+        FROM user_group_info ugi
+        WHERE ugi.talk_session_id = $1::uuid
+    ) AS group_count
+`
+
+type GetCurrentAnalysisFingerprintRow struct {
+	Fingerprint string
+	UserCount   int64
+	GroupCount  int64
+}
+
+// 現在のグループ分けと代表意見のハッシュ
+//
+//	SELECT
+//	    md5(
+//	        COALESCE((
+//	            SELECT string_agg(
+//	                concat_ws(':', ugi.user_id, ugi.group_id, ugi.pos_x, ugi.pos_y, ugi.perimeter_index),
+//	                ',' ORDER BY ugi.user_id
+//	            )
+//	            FROM user_group_info ugi
+//	            WHERE ugi.talk_session_id = $1::uuid
+//	        ), '')
+//	        || '|' ||
+//	        COALESCE((
+//	            SELECT string_agg(
+//	                concat_ws(':', ro.opinion_id, ro.group_id, ro.rank, ro.agree_count, ro.disagree_count, ro.pass_count),
+//	                ',' ORDER BY ro.group_id, ro.opinion_id
+//	            )
+//	            FROM representative_opinions ro
+//	            WHERE ro.talk_session_id = $1::uuid
+//	        ), '')
+//	    )::text AS fingerprint,
+//	    (
+//	        SELECT COUNT(*)
+//	        FROM user_group_info ugi
+//	        WHERE ugi.talk_session_id = $1::uuid
+//	    ) AS user_count,
+//	    (
+//	        SELECT COUNT(DISTINCT ugi.group_id)
+//	        FROM user_group_info ugi
+//	        WHERE ugi.talk_session_id = $1::uuid
+//	    ) AS group_count
+func (q *Queries) GetCurrentAnalysisFingerprint(ctx context.Context, talkSessionID uuid.UUID) (GetCurrentAnalysisFingerprintRow, error) {
+	row := q.db.QueryRowContext(ctx, getCurrentAnalysisFingerprint, talkSessionID)
+	var i GetCurrentAnalysisFingerprintRow
+	err := row.Scan(&i.Fingerprint, &i.UserCount, &i.GroupCount)
+	return i, err
+}
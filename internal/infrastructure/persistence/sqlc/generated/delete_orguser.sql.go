@@ -0,0 +1,33 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: delete_orguser.sql
+
+package model
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const deleteOrgUser = `-- name: DeleteOrgUser :exec
+DELETE FROM organization_users
+WHERE organization_id = $1
+  AND user_id = $2
+`
+
+type DeleteOrgUserParams struct {
+	OrganizationID uuid.UUID
+	UserID         uuid.UUID
+}
+
+// DeleteOrgUser
+//
+//	DELETE FROM organization_users
+//	WHERE organization_id = $1
+//	  AND user_id = $2
+func (q *Queries) DeleteOrgUser(ctx context.Context, arg DeleteOrgUserParams) error {
+	_, err := q.db.ExecContext(ctx, deleteOrgUser, arg.OrganizationID, arg.UserID)
+	return err
+}
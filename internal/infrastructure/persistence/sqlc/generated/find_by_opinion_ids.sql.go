@@ -14,7 +14,7 @@ import (
 
 const findOpinionsByOpinionIDs = `-- name: FindOpinionsByOpinionIDs :many
 SELECT
-    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url,
+    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url, opinions.revision, opinions.edited_at, opinions.deleted_at,
     users.user_id, users.display_id, users.display_name, users.icon_url, users.created_at, users.updated_at, users.email, users.email_verified, users.withdrawal_date
 FROM
     opinions
@@ -33,7 +33,7 @@ type FindOpinionsByOpinionIDsRow struct {
 // FindOpinionsByOpinionIDs
 //
 //	SELECT
-//	    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url,
+//	    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url, opinions.revision, opinions.edited_at, opinions.deleted_at,
 //	    users.user_id, users.display_id, users.display_name, users.icon_url, users.created_at, users.updated_at, users.email, users.email_verified, users.withdrawal_date
 //	FROM
 //	    opinions
@@ -71,6 +71,9 @@ func (q *Queries) FindOpinionsByOpinionIDs(ctx context.Context, opinionIds []uui
 			&i.Opinion.CreatedAt,
 			&i.Opinion.PictureUrl,
 			&i.Opinion.ReferenceUrl,
+			&i.Opinion.Revision,
+			&i.Opinion.EditedAt,
+			&i.Opinion.DeletedAt,
 			&i.User.UserID,
 			&i.User.DisplayID,
 			&i.User.DisplayName,
@@ -15,7 +15,7 @@ import (
 const findReportByOpinionIDs = `-- name: FindReportByOpinionIDs :many
 SELECT
     opinion_reports.opinion_report_id, opinion_reports.opinion_id, opinion_reports.talk_session_id, opinion_reports.reporter_id, opinion_reports.reason, opinion_reports.status, opinion_reports.created_at, opinion_reports.updated_at, opinion_reports.reason_text,
-    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url
+    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url, opinions.revision, opinions.edited_at, opinions.deleted_at
 FROM
     opinion_reports
 LEFT JOIN opinions
@@ -40,7 +40,7 @@ type FindReportByOpinionIDsRow struct {
 //
 //	SELECT
 //	    opinion_reports.opinion_report_id, opinion_reports.opinion_id, opinion_reports.talk_session_id, opinion_reports.reporter_id, opinion_reports.reason, opinion_reports.status, opinion_reports.created_at, opinion_reports.updated_at, opinion_reports.reason_text,
-//	    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url
+//	    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url, opinions.revision, opinions.edited_at, opinions.deleted_at
 //	FROM
 //	    opinion_reports
 //	LEFT JOIN opinions
@@ -77,6 +77,9 @@ func (q *Queries) FindReportByOpinionIDs(ctx context.Context, arg FindReportByOp
 			&i.Opinion.CreatedAt,
 			&i.Opinion.PictureUrl,
 			&i.Opinion.ReferenceUrl,
+			&i.Opinion.Revision,
+			&i.Opinion.EditedAt,
+			&i.Opinion.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
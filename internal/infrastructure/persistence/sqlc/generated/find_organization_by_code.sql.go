@@ -11,7 +11,7 @@ import (
 
 const findOrganizationByCode = `-- name: FindOrganizationByCode :one
 SELECT
-    organizations.organization_id, organizations.organization_type, organizations.name, organizations.owner_id, organizations.code, organizations.icon_url
+    organizations.organization_id, organizations.organization_type, organizations.name, organizations.owner_id, organizations.code, organizations.icon_url, organizations.parent_organization_id
 FROM organizations
 WHERE code = $1
 `
@@ -23,7 +23,7 @@ type FindOrganizationByCodeRow struct {
 // FindOrganizationByCode
 //
 //	SELECT
-//	    organizations.organization_id, organizations.organization_type, organizations.name, organizations.owner_id, organizations.code, organizations.icon_url
+//	    organizations.organization_id, organizations.organization_type, organizations.name, organizations.owner_id, organizations.code, organizations.icon_url, organizations.parent_organization_id
 //	FROM organizations
 //	WHERE code = $1
 func (q *Queries) FindOrganizationByCode(ctx context.Context, code string) (FindOrganizationByCodeRow, error) {
@@ -36,6 +36,7 @@ func (q *Queries) FindOrganizationByCode(ctx context.Context, code string) (Find
 		&i.Organization.OwnerID,
 		&i.Organization.Code,
 		&i.Organization.IconUrl,
+		&i.Organization.ParentOrganizationID,
 	)
 	return i, err
 }
@@ -13,7 +13,7 @@ import (
 
 const findOrganizationByID = `-- name: FindOrganizationByID :one
 SELECT
-    organizations.organization_id, organizations.organization_type, organizations.name, organizations.owner_id, organizations.code, organizations.icon_url
+    organizations.organization_id, organizations.organization_type, organizations.name, organizations.owner_id, organizations.code, organizations.icon_url, organizations.parent_organization_id
 FROM organizations
 WHERE organization_id = $1
 `
@@ -25,7 +25,7 @@ type FindOrganizationByIDRow struct {
 // FindOrganizationByID
 //
 //	SELECT
-//	    organizations.organization_id, organizations.organization_type, organizations.name, organizations.owner_id, organizations.code, organizations.icon_url
+//	    organizations.organization_id, organizations.organization_type, organizations.name, organizations.owner_id, organizations.code, organizations.icon_url, organizations.parent_organization_id
 //	FROM organizations
 //	WHERE organization_id = $1
 func (q *Queries) FindOrganizationByID(ctx context.Context, organizationID uuid.UUID) (FindOrganizationByIDRow, error) {
@@ -38,6 +38,7 @@ func (q *Queries) FindOrganizationByID(ctx context.Context, organizationID uuid.
 		&i.Organization.OwnerID,
 		&i.Organization.Code,
 		&i.Organization.IconUrl,
+		&i.Organization.ParentOrganizationID,
 	)
 	return i, err
 }
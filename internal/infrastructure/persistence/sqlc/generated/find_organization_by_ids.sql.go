@@ -14,7 +14,7 @@ import (
 
 const findOrganizationsByIDs = `-- name: FindOrganizationsByIDs :many
 SELECT
-    organizations.organization_id, organizations.organization_type, organizations.name, organizations.owner_id, organizations.code, organizations.icon_url
+    organizations.organization_id, organizations.organization_type, organizations.name, organizations.owner_id, organizations.code, organizations.icon_url, organizations.parent_organization_id
 FROM organizations
 WHERE organization_id = ANY($1::uuid[])
 `
@@ -26,7 +26,7 @@ type FindOrganizationsByIDsRow struct {
 // FindOrganizationsByIDs
 //
 //	SELECT
-//	    organizations.organization_id, organizations.organization_type, organizations.name, organizations.owner_id, organizations.code, organizations.icon_url
+//	    organizations.organization_id, organizations.organization_type, organizations.name, organizations.owner_id, organizations.code, organizations.icon_url, organizations.parent_organization_id
 //	FROM organizations
 //	WHERE organization_id = ANY($1::uuid[])
 func (q *Queries) FindOrganizationsByIDs(ctx context.Context, dollar_1 []uuid.UUID) ([]FindOrganizationsByIDsRow, error) {
@@ -45,6 +45,7 @@ func (q *Queries) FindOrganizationsByIDs(ctx context.Context, dollar_1 []uuid.UU
 			&i.Organization.OwnerID,
 			&i.Organization.Code,
 			&i.Organization.IconUrl,
+			&i.Organization.ParentOrganizationID,
 		); err != nil {
 			return nil, err
 		}
@@ -11,7 +11,7 @@ import (
 
 const findOrganizationByName = `-- name: FindOrganizationByName :one
 SELECT
-    organizations.organization_id, organizations.organization_type, organizations.name, organizations.owner_id, organizations.code, organizations.icon_url
+    organizations.organization_id, organizations.organization_type, organizations.name, organizations.owner_id, organizations.code, organizations.icon_url, organizations.parent_organization_id
 FROM organizations
 WHERE name = $1
 `
@@ -23,7 +23,7 @@ type FindOrganizationByNameRow struct {
 // FindOrganizationByName
 //
 //	SELECT
-//	    organizations.organization_id, organizations.organization_type, organizations.name, organizations.owner_id, organizations.code, organizations.icon_url
+//	    organizations.organization_id, organizations.organization_type, organizations.name, organizations.owner_id, organizations.code, organizations.icon_url, organizations.parent_organization_id
 //	FROM organizations
 //	WHERE name = $1
 func (q *Queries) FindOrganizationByName(ctx context.Context, name string) (FindOrganizationByNameRow, error) {
@@ -36,6 +36,7 @@ func (q *Queries) FindOrganizationByName(ctx context.Context, name string) (Find
 		&i.Organization.OwnerID,
 		&i.Organization.Code,
 		&i.Organization.IconUrl,
+		&i.Organization.ParentOrganizationID,
 	)
 	return i, err
 }
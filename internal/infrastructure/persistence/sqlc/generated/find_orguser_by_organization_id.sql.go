@@ -57,3 +57,61 @@ func (q *Queries) FindOrgUserByOrganizationID(ctx context.Context, organizationI
 	}
 	return items, nil
 }
+
+const lockOrgUsersByOrganizationIDAndRole = `-- name: LockOrgUsersByOrganizationIDAndRole :many
+SELECT
+    organization_users.organization_user_id, organization_users.user_id, organization_users.organization_id, organization_users.created_at, organization_users.updated_at, organization_users.role
+FROM organization_users
+WHERE organization_id = $1
+  AND role = $2
+ORDER BY organization_user_id
+FOR UPDATE
+`
+
+type LockOrgUsersByOrganizationIDAndRoleParams struct {
+	OrganizationID uuid.UUID
+	Role           int32
+}
+
+type LockOrgUsersByOrganizationIDAndRoleRow struct {
+	OrganizationUser OrganizationUser
+}
+
+// 同時に降格・削除してオーナーがいなくならないよう、該当する行を一定の順でロックする
+//
+//	SELECT
+//	    organization_users.organization_user_id, organization_users.user_id, organization_users.organization_id, organization_users.created_at, organization_users.updated_at, organization_users.role
+//	FROM organization_users
+//	WHERE organization_id = $1
+//	  AND role = $2
+//	ORDER BY organization_user_id
+//	FOR UPDATE
+func (q *Queries) LockOrgUsersByOrganizationIDAndRole(ctx context.Context, arg LockOrgUsersByOrganizationIDAndRoleParams) ([]LockOrgUsersByOrganizationIDAndRoleRow, error) {
+	rows, err := q.db.QueryContext(ctx, lockOrgUsersByOrganizationIDAndRole, arg.OrganizationID, arg.Role)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []LockOrgUsersByOrganizationIDAndRoleRow
+	for rows.Next() {
+		var i LockOrgUsersByOrganizationIDAndRoleRow
+		if err := rows.Scan(
+			&i.OrganizationUser.OrganizationUserID,
+			&i.OrganizationUser.UserID,
+			&i.OrganizationUser.OrganizationID,
+			&i.OrganizationUser.CreatedAt,
+			&i.OrganizationUser.UpdatedAt,
+			&i.OrganizationUser.Role,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
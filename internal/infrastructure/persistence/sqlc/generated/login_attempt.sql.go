@@ -0,0 +1,205 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: login_attempt.sql
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
+)
+
+const createLoginAttempt = `-- name: CreateLoginAttempt :exec
+INSERT INTO login_attempts (
+    login_attempt_id,
+    user_id,
+    ip_address,
+    user_agent,
+    succeeded,
+    failure_reason,
+    attempted_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7)
+`
+
+type CreateLoginAttemptParams struct {
+	LoginAttemptID uuid.UUID
+	UserID         uuid.NullUUID
+	IpAddress      pqtype.Inet
+	UserAgent      sql.NullString
+	Succeeded      bool
+	FailureReason  sql.NullString
+	AttemptedAt    time.Time
+}
+
+// CreateLoginAttempt
+//
+//	INSERT INTO login_attempts (
+//	    login_attempt_id,
+//	    user_id,
+//	    ip_address,
+//	    user_agent,
+//	    succeeded,
+//	    failure_reason,
+//	    attempted_at
+//	) VALUES ($1, $2, $3, $4, $5, $6, $7)
+func (q *Queries) CreateLoginAttempt(ctx context.Context, arg CreateLoginAttemptParams) error {
+	_, err := q.db.ExecContext(ctx, createLoginAttempt,
+		arg.LoginAttemptID,
+		arg.UserID,
+		arg.IpAddress,
+		arg.UserAgent,
+		arg.Succeeded,
+		arg.FailureReason,
+		arg.AttemptedAt,
+	)
+	return err
+}
+
+const deleteLoginLockout = `-- name: DeleteLoginLockout :exec
+DELETE FROM login_lockouts WHERE scope = $1 AND lock_key = $2
+`
+
+type DeleteLoginLockoutParams struct {
+	Scope   string
+	LockKey string
+}
+
+// DeleteLoginLockout
+//
+//	DELETE FROM login_lockouts WHERE scope = $1 AND lock_key = $2
+func (q *Queries) DeleteLoginLockout(ctx context.Context, arg DeleteLoginLockoutParams) error {
+	_, err := q.db.ExecContext(ctx, deleteLoginLockout, arg.Scope, arg.LockKey)
+	return err
+}
+
+const findLoginLockout = `-- name: FindLoginLockout :one
+SELECT scope, lock_key, failure_count, lockout_count, last_failed_at, locked_until, updated_at FROM login_lockouts WHERE scope = $1 AND lock_key = $2
+`
+
+type FindLoginLockoutParams struct {
+	Scope   string
+	LockKey string
+}
+
+// FindLoginLockout
+//
+//	SELECT scope, lock_key, failure_count, lockout_count, last_failed_at, locked_until, updated_at FROM login_lockouts WHERE scope = $1 AND lock_key = $2
+func (q *Queries) FindLoginLockout(ctx context.Context, arg FindLoginLockoutParams) (LoginLockout, error) {
+	row := q.db.QueryRowContext(ctx, findLoginLockout, arg.Scope, arg.LockKey)
+	var i LoginLockout
+	err := row.Scan(
+		&i.Scope,
+		&i.LockKey,
+		&i.FailureCount,
+		&i.LockoutCount,
+		&i.LastFailedAt,
+		&i.LockedUntil,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const lockLoginLockout = `-- name: LockLoginLockout :one
+INSERT INTO login_lockouts (
+    scope,
+    lock_key,
+    updated_at
+) VALUES ($1, $2, $3)
+ON CONFLICT (scope, lock_key) DO UPDATE SET
+    updated_at = login_lockouts.updated_at
+RETURNING scope, lock_key, failure_count, lockout_count, last_failed_at, locked_until, updated_at
+`
+
+type LockLoginLockoutParams struct {
+	Scope     string
+	LockKey   string
+	UpdatedAt time.Time
+}
+
+// 行がなければ失敗0回で作成し、トランザクションが終わるまで行をロックして返す
+//
+//	INSERT INTO login_lockouts (
+//	    scope,
+//	    lock_key,
+//	    updated_at
+//	) VALUES ($1, $2, $3)
+//	ON CONFLICT (scope, lock_key) DO UPDATE SET
+//	    updated_at = login_lockouts.updated_at
+//	RETURNING scope, lock_key, failure_count, lockout_count, last_failed_at, locked_until, updated_at
+func (q *Queries) LockLoginLockout(ctx context.Context, arg LockLoginLockoutParams) (LoginLockout, error) {
+	row := q.db.QueryRowContext(ctx, lockLoginLockout, arg.Scope, arg.LockKey, arg.UpdatedAt)
+	var i LoginLockout
+	err := row.Scan(
+		&i.Scope,
+		&i.LockKey,
+		&i.FailureCount,
+		&i.LockoutCount,
+		&i.LastFailedAt,
+		&i.LockedUntil,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const upsertLoginLockout = `-- name: UpsertLoginLockout :exec
+INSERT INTO login_lockouts (
+    scope,
+    lock_key,
+    failure_count,
+    lockout_count,
+    last_failed_at,
+    locked_until,
+    updated_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (scope, lock_key) DO UPDATE SET
+    failure_count = EXCLUDED.failure_count,
+    lockout_count = EXCLUDED.lockout_count,
+    last_failed_at = EXCLUDED.last_failed_at,
+    locked_until = EXCLUDED.locked_until,
+    updated_at = EXCLUDED.updated_at
+`
+
+type UpsertLoginLockoutParams struct {
+	Scope        string
+	LockKey      string
+	FailureCount int32
+	LockoutCount int32
+	LastFailedAt sql.NullTime
+	LockedUntil  sql.NullTime
+	UpdatedAt    time.Time
+}
+
+// UpsertLoginLockout
+//
+//	INSERT INTO login_lockouts (
+//	    scope,
+//	    lock_key,
+//	    failure_count,
+//	    lockout_count,
+//	    last_failed_at,
+//	    locked_until,
+//	    updated_at
+//	) VALUES ($1, $2, $3, $4, $5, $6, $7)
+//	ON CONFLICT (scope, lock_key) DO UPDATE SET
+//	    failure_count = EXCLUDED.failure_count,
+//	    lockout_count = EXCLUDED.lockout_count,
+//	    last_failed_at = EXCLUDED.last_failed_at,
+//	    locked_until = EXCLUDED.locked_until,
+//	    updated_at = EXCLUDED.updated_at
+func (q *Queries) UpsertLoginLockout(ctx context.Context, arg UpsertLoginLockoutParams) error {
+	_, err := q.db.ExecContext(ctx, upsertLoginLockout,
+		arg.Scope,
+		arg.LockKey,
+		arg.FailureCount,
+		arg.LockoutCount,
+		arg.LastFailedAt,
+		arg.LockedUntil,
+		arg.UpdatedAt,
+	)
+	return err
+}
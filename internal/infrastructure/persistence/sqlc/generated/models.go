@@ -24,6 +24,70 @@ type ActionItem struct {
 	UpdatedAt     time.Time
 }
 
+// レポート・ワードクラウドの生成ジョブ
+type AnalysisJob struct {
+	AnalysisJobID uuid.UUID
+	TalkSessionID uuid.UUID
+	// report: レポート, wordcloud: ワードクラウド
+	JobType string
+	// queued: 待機中, running: 実行中, succeeded: 成功, failed: 失敗
+	Status string
+	// 実行した回数。失敗した場合は上限まで再実行する
+	Attempts  int32
+	LastError sql.NullString
+	// この日時以降に実行する。再実行の間隔を空けるのに使う
+	RunAfter   time.Time
+	StartedAt  sql.NullTime
+	FinishedAt sql.NullTime
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// 分析の再計算のスケジュール。最後の分析以降の投票・意見の数を数える
+type AnalysisSchedule struct {
+	TalkSessionID       uuid.UUID
+	PendingVoteCount    int32
+	PendingOpinionCount int32
+	// 最後の分析以降で最初に投票・意見があった日時。分析を待っている活動がなければNULL
+	PendingSince sql.NullTime
+	// 実行中の分析を開始した日時。実行中でなければNULL
+	RunningSince      sql.NullTime
+	LastCompletedAt   sql.NullTime
+	LastFailedAt      sql.NullTime
+	LastFailureReason sql.NullString
+	UpdatedAt         time.Time
+}
+
+// 分析結果のスナップショット。分析のたびにグループ分けと代表意見を残す
+type AnalysisSnapshot struct {
+	AnalysisSnapshotID uuid.UUID
+	TalkSessionID      uuid.UUID
+	// グループ分けと代表意見のハッシュ。前回のスナップショットから変わっていない場合は保存しない
+	Fingerprint string
+	UserCount   int32
+	GroupCount  int32
+	CreatedAt   time.Time
+}
+
+type AnalysisSnapshotRepresentativeOpinion struct {
+	AnalysisSnapshotID uuid.UUID
+	OpinionID          uuid.UUID
+	GroupID            int32
+	Rank               int32
+	AgreeCount         int32
+	DisagreeCount      int32
+	PassCount          int32
+}
+
+type AnalysisSnapshotUser struct {
+	AnalysisSnapshotID uuid.UUID
+	UserID             uuid.UUID
+	GroupID            int32
+	PosX               float64
+	PosY               float64
+	PerimeterIndex     sql.NullInt32
+}
+
 type AuthState struct {
 	ID              int32
 	State           string
@@ -63,6 +127,29 @@ type DomainEvent struct {
 	RetryCount    int32
 }
 
+// パスワードログインの試行履歴
+type LoginAttempt struct {
+	LoginAttemptID uuid.UUID
+	UserID         uuid.NullUUID
+	IpAddress      pqtype.Inet
+	UserAgent      sql.NullString
+	Succeeded      bool
+	FailureReason  sql.NullString
+	AttemptedAt    time.Time
+}
+
+// ログイン失敗回数と指数的ロックアウトの状態
+type LoginLockout struct {
+	Scope        string
+	LockKey      string
+	FailureCount int32
+	// 連続してロックされた回数。ロック時間の算出に使用し、ログイン成功でリセットされる
+	LockoutCount int32
+	LastFailedAt sql.NullTime
+	LockedUntil  sql.NullTime
+	UpdatedAt    time.Time
+}
+
 type NotificationHistory struct {
 	ID               uuid.UUID
 	UserID           uuid.UUID
@@ -96,6 +183,12 @@ type Opinion struct {
 	CreatedAt       time.Time
 	PictureUrl      sql.NullString
 	ReferenceUrl    sql.NullString
+	// 編集のたびに1ずつ増える版番号
+	Revision int32
+	// 最後に編集された日時
+	EditedAt sql.NullTime
+	// 投稿者が削除した日時。運営による削除は通報のステータスで管理する
+	DeletedAt sql.NullTime
 }
 
 type OpinionReport struct {
@@ -110,13 +203,31 @@ type OpinionReport struct {
 	ReasonText      sql.NullString
 }
 
+// 意見の編集履歴。opinionsには常に最新の版が入る
+type OpinionRevision struct {
+	OpinionID    uuid.UUID
+	Revision     int32
+	Title        sql.NullString
+	Content      string
+	ReferenceUrl sql.NullString
+	// 編集された時点での投稿者以外の投票数
+	VoteCount int32
+	// 内容が大きく変わったため、この版への投票をリセットしたか
+	VotesReset bool
+	// この版の内容になった日時
+	CreatedAt time.Time
+	// 次の版に編集された日時
+	ReplacedAt time.Time
+}
+
 type Organization struct {
-	OrganizationID   uuid.UUID
-	OrganizationType int32
-	Name             string
-	OwnerID          uuid.UUID
-	Code             string
-	IconUrl          sql.NullString
+	OrganizationID       uuid.UUID
+	OrganizationType     int32
+	Name                 string
+	OwnerID              uuid.UUID
+	Code                 string
+	IconUrl              sql.NullString
+	ParentOrganizationID uuid.NullUUID
 }
 
 type OrganizationAlias struct {
@@ -130,6 +241,103 @@ type OrganizationAlias struct {
 	DeactivatedBy  uuid.NullUUID
 }
 
+// 組織のAPIキー。キー本体は保存せずハッシュのみ保持する
+type OrganizationApiKey struct {
+	ApiKeyID       uuid.UUID
+	OrganizationID uuid.UUID
+	Name           string
+	// 一覧表示用のキー先頭部分
+	KeyPrefix string
+	KeyHash   string
+	// 許可されたスコープ (sessions:read, sessions:write, results:export)
+	Scopes     []string
+	CreatedBy  uuid.UUID
+	ExpiresAt  sql.NullTime
+	LastUsedAt sql.NullTime
+	RevokedAt  sql.NullTime
+	RevokedBy  uuid.NullUUID
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// 組織の管理操作の監査ログ。追記のみで更新・削除はできない
+type OrganizationAuditLog struct {
+	AuditLogID     uuid.UUID
+	OrganizationID uuid.UUID
+	ActorID        uuid.UUID
+	// 操作の種類（organization.updated など）
+	Action string
+	// 操作対象の種類（organization, user, talksession など）
+	TargetType string
+	TargetID   string
+	// 変更前後の差分。{"項目名": {"before": 変更前, "after": 変更後}}
+	Changes   json.RawMessage
+	CreatedAt time.Time
+}
+
+// 組織ごとの日次の参加者。期間内の参加者数を重複なく数えるために使う
+type OrganizationDailyParticipant struct {
+	OrganizationID uuid.UUID
+	StatDate       time.Time
+	UserID         uuid.UUID
+}
+
+// 組織ごとの日本時間での日次集計。トリガーで逐次更新される
+type OrganizationDailyStat struct {
+	OrganizationID      uuid.UUID
+	StatDate            time.Time
+	TalkSessionsCreated int32
+	Opinions            int32
+	Votes               int32
+	Consents            int32
+	ReportFeedbacks     int32
+}
+
+// 組織への招待。トークンは保存せずハッシュのみ保持する
+type OrganizationInvitation struct {
+	InvitationID   uuid.UUID
+	OrganizationID uuid.UUID
+	// 暗号化された招待先メールアドレス
+	Email string
+	// 招待先メールアドレスのハッシュ（重複確認・承諾時の照合用）
+	EmailHash string
+	Role      int32
+	TokenHash string
+	// pending: 未承諾, accepted: 承諾済み, revoked: 取り消し済み。期限切れはexpires_atで判定する
+	Status     string
+	InvitedBy  uuid.UUID
+	ExpiresAt  time.Time
+	SendCount  int32
+	LastSentAt time.Time
+	AcceptedBy uuid.NullUUID
+	AcceptedAt sql.NullTime
+	RevokedAt  sql.NullTime
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// 組織が国勢調査などから登録した母集団の構成
+type OrganizationReferenceDistribution struct {
+	OrganizationID uuid.UUID
+	// 属性。age_band・gender・prefecture・city
+	Dimension string
+	// 区分と割合の配列。割合の合計は1
+	Categories json.RawMessage
+	UpdatedBy  uuid.NullUUID
+	UpdatedAt  time.Time
+}
+
+// 組織ごとのレポート生成の指示
+type OrganizationReportPrompt struct {
+	OrganizationID uuid.UUID
+	// LLMの役割。空の場合は既定の指示を使う
+	SystemPrompt string
+	// 文体や重視する観点など、組織ごとに追加する指示
+	Instructions string
+	UpdatedBy    uuid.NullUUID
+	UpdatedAt    time.Time
+}
+
 type OrganizationUser struct {
 	OrganizationUserID uuid.UUID
 	UserID             uuid.UUID
@@ -139,6 +347,25 @@ type OrganizationUser struct {
 	Role               int32
 }
 
+// オーナー権限の譲渡依頼。承諾・辞退・取り消しの履歴としても残す
+type OwnershipTransfer struct {
+	TransferID    uuid.UUID
+	TargetType    string
+	TalkSessionID uuid.NullUUID
+	// 譲渡する組織。セッションの譲渡ではセッションが所属する組織
+	OrganizationID uuid.NullUUID
+	FromUserID     uuid.UUID
+	ToUserID       uuid.UUID
+	// 譲渡を依頼したユーザー。退職者のセッションを一括で譲渡する場合は組織の管理者になる
+	RequestedBy uuid.UUID
+	// pending: 未承諾, accepted: 承諾済み, declined: 辞退, cancelled: 取り消し。期限切れはexpires_atで判定する
+	Status      string
+	ExpiresAt   time.Time
+	RespondedAt sql.NullTime
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
 type PasswordAuth struct {
 	PasswordAuthID         uuid.UUID
 	UserID                 uuid.UUID
@@ -211,6 +438,19 @@ type TalkSession struct {
 	OrganizationID      uuid.NullUUID
 	OrganizationAliasID uuid.NullUUID
 	HideTop             bool
+	// 下書きかどうか。複製したセッションは下書きとして作成される
+	IsDraft bool
+}
+
+// セッションの共同管理者
+type TalkSessionCollaborator struct {
+	TalkSessionID uuid.UUID
+	UserID        uuid.UUID
+	// co_owner: 編集・結論・タイムライン・共同管理者の管理, facilitator: 結論・タイムライン, moderator: 通報の対応, viewer: 閲覧のみ
+	Role      string
+	AddedBy   uuid.UUID
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
 
 type TalkSessionConclusion struct {
@@ -227,6 +467,8 @@ type TalkSessionGeneratedImage struct {
 	TsncUrl       string
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
+	// セッション全体の出現回数とグループごとのTF-IDF。分析APIで生成した場合はNULL
+	TermFrequencies pqtype.NullRawMessage
 }
 
 type TalkSessionLocation struct {
@@ -239,6 +481,8 @@ type TalkSessionReport struct {
 	Report        string
 	CreatedAt     time.Time
 	UpdatedAt     time.Time
+	// 要約・グループごとの立場・合意点・残された論点。分析APIで生成した場合はNULL
+	Sections pqtype.NullRawMessage
 }
 
 type TalkSessionReportHistory struct {
@@ -246,6 +490,47 @@ type TalkSessionReportHistory struct {
 	TalkSessionID              uuid.UUID
 	Report                     string
 	CreatedAt                  time.Time
+	// セッションごとに1から始まるバージョン番号
+	Version int32
+	// レポートを生成した実装
+	Generator string
+	// 生成に使ったモデル。分からない場合はNULL
+	Model sql.NullString
+	// 要約・グループごとの立場・合意点・残された論点。分析APIで生成した場合はNULL
+	Sections pqtype.NullRawMessage
+}
+
+// 公開するレポートのバージョン
+type TalkSessionReportPublication struct {
+	TalkSessionID              uuid.UUID
+	TalkSessionReportHistoryID uuid.UUID
+	// TRUEの場合、新しいバージョンが生成されても公開するバージョンを変えない
+	Pinned bool
+	// 管理者が公開するバージョンを選んだ場合の操作者。自動で切り替えた場合はNULL
+	PublishedBy uuid.NullUUID
+	PublishedAt time.Time
+	// 公開するバージョンが変わったことを参加者に最後に通知した日時。通知していなければNULL
+	NotifiedAt sql.NullTime
+}
+
+// 組織のセッションテンプレート。参加制限・シード意見・サムネイルなどを保持し、投票や参加者の情報は含まない
+type TalkSessionTemplate struct {
+	TemplateID          uuid.UUID
+	OrganizationID      uuid.UUID
+	OrganizationAliasID uuid.NullUUID
+	Name                string
+	Theme               string
+	Description         sql.NullString
+	ThumbnailUrl        sql.NullString
+	City                sql.NullString
+	Prefecture          sql.NullString
+	Restrictions        talksession.Restrictions
+	HideTop             bool
+	// シード意見の一覧（title, content, reference_url, picture_url）
+	SeedOpinions json.RawMessage
+	CreatedBy    uuid.UUID
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
 }
 
 type TalksessionConsent struct {
@@ -255,6 +540,19 @@ type TalksessionConsent struct {
 	ConsentedAt   time.Time
 }
 
+// トークン署名鍵。JWKSとして公開鍵を公開する
+type TokenSigningKey struct {
+	KeyID               string
+	Algorithm           string
+	EncryptedPrivateKey string
+	PublicKey           string
+	Status              string
+	CreatedAt           time.Time
+	RetiredAt           sql.NullTime
+	// ローテーション後、この日時まではこの鍵で署名されたトークンを検証できる
+	VerifyUntil sql.NullTime
+}
+
 type User struct {
 	UserID        uuid.UUID
 	DisplayID     sql.NullString
@@ -343,4 +641,36 @@ type Vote struct {
 	VoteType      int16
 	CreatedAt     time.Time
 	TalkSessionID uuid.UUID
+	// 投票したユーザーにとって重要な意見か。投票の種類とは独立して付けられる
+	IsImportant bool
+}
+
+// 投票の変更履歴。追記のみで更新・削除はできない
+type VoteEvent struct {
+	VoteEventID   uuid.UUID
+	VoteID        uuid.UUID
+	OpinionID     uuid.UUID
+	TalkSessionID uuid.UUID
+	UserID        uuid.UUID
+	// 変更前の投票。初めての投票では0（未投票）
+	PreviousVoteType int16
+	// 変更後の投票。投票が取り消された場合は0（未投票）
+	VoteType int16
+	// 変更の理由（voted: ユーザーの投票, opinion_edited: 意見の編集による投票のリセット）
+	Reason    string
+	CreatedAt time.Time
+}
+
+// 一括投票の冪等キーごとの処理結果
+type VoteReceipt struct {
+	UserID         uuid.UUID
+	IdempotencyKey string
+	OpinionID      uuid.UUID
+	VoteType       int16
+	// 投票できなかった場合のエラーコード。反映された場合はNULL
+	ErrorCode    sql.NullString
+	ErrorMessage sql.NullString
+	// 端末で投票した日時
+	ClientVotedAt time.Time
+	CreatedAt     time.Time
 }
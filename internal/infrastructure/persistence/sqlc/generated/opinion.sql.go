@@ -89,6 +89,7 @@ WHERE opinions.talk_session_id = $2
     AND vote_count.opinion_id = opinions.opinion_id
     AND opinions.parent_opinion_id IS NULL
     AND (opr.opinion_id IS NULL OR opr.status != 'deleted')
+    AND opinions.deleted_at IS NULL
 `
 
 type CountSwipeableOpinionsParams struct {
@@ -120,6 +121,7 @@ type CountSwipeableOpinionsParams struct {
 //	    AND vote_count.opinion_id = opinions.opinion_id
 //	    AND opinions.parent_opinion_id IS NULL
 //	    AND (opr.opinion_id IS NULL OR opr.status != 'deleted')
+//	    AND opinions.deleted_at IS NULL
 func (q *Queries) CountSwipeableOpinions(ctx context.Context, arg CountSwipeableOpinionsParams) (int64, error) {
 	row := q.db.QueryRowContext(ctx, countSwipeableOpinions, arg.UserID, arg.TalkSessionID)
 	var random_opinion_count int64
@@ -183,7 +185,7 @@ func (q *Queries) CreateOpinion(ctx context.Context, arg CreateOpinionParams) er
 
 const getOpinionByID = `-- name: GetOpinionByID :one
 SELECT
-    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url,
+    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url, opinions.revision, opinions.edited_at, opinions.deleted_at,
     users.user_id, users.display_id, users.display_name, users.icon_url, users.created_at, users.updated_at, users.email, users.email_verified, users.withdrawal_date,
     COALESCE(cv.vote_type, 0) AS current_vote_type,
     COALESCE(pv.vote_type, 0) AS parent_vote_type,
@@ -227,7 +229,7 @@ type GetOpinionByIDRow struct {
 // ユーザーIDが提供された場合、そのユーザーの投票ステータスを一緒に取得
 //
 //	SELECT
-//	    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url,
+//	    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url, opinions.revision, opinions.edited_at, opinions.deleted_at,
 //	    users.user_id, users.display_id, users.display_name, users.icon_url, users.created_at, users.updated_at, users.email, users.email_verified, users.withdrawal_date,
 //	    COALESCE(cv.vote_type, 0) AS current_vote_type,
 //	    COALESCE(pv.vote_type, 0) AS parent_vote_type,
@@ -264,6 +266,9 @@ func (q *Queries) GetOpinionByID(ctx context.Context, arg GetOpinionByIDParams)
 		&i.Opinion.CreatedAt,
 		&i.Opinion.PictureUrl,
 		&i.Opinion.ReferenceUrl,
+		&i.Opinion.Revision,
+		&i.Opinion.EditedAt,
+		&i.Opinion.DeletedAt,
 		&i.User.UserID,
 		&i.User.DisplayID,
 		&i.User.DisplayName,
@@ -283,7 +288,7 @@ func (q *Queries) GetOpinionByID(ctx context.Context, arg GetOpinionByIDParams)
 const getOpinionReplies = `-- name: GetOpinionReplies :many
 SELECT
     DISTINCT opinions.opinion_id,
-    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url,
+    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url, opinions.revision, opinions.edited_at, opinions.deleted_at,
     users.user_id, users.display_id, users.display_name, users.icon_url, users.created_at, users.updated_at, users.email, users.email_verified, users.withdrawal_date,
     COALESCE(pv.vote_type, 0) AS parent_vote_type,
     COALESCE(cv.vote_type, 0) AS current_vote_type
@@ -324,7 +329,7 @@ type GetOpinionRepliesRow struct {
 //
 //	SELECT
 //	    DISTINCT opinions.opinion_id,
-//	    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url,
+//	    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url, opinions.revision, opinions.edited_at, opinions.deleted_at,
 //	    users.user_id, users.display_id, users.display_name, users.icon_url, users.created_at, users.updated_at, users.email, users.email_verified, users.withdrawal_date,
 //	    COALESCE(pv.vote_type, 0) AS parent_vote_type,
 //	    COALESCE(cv.vote_type, 0) AS current_vote_type
@@ -365,6 +370,9 @@ func (q *Queries) GetOpinionReplies(ctx context.Context, arg GetOpinionRepliesPa
 			&i.Opinion.CreatedAt,
 			&i.Opinion.PictureUrl,
 			&i.Opinion.ReferenceUrl,
+			&i.Opinion.Revision,
+			&i.Opinion.EditedAt,
+			&i.Opinion.DeletedAt,
 			&i.User.UserID,
 			&i.User.DisplayID,
 			&i.User.DisplayName,
@@ -392,7 +400,7 @@ func (q *Queries) GetOpinionReplies(ctx context.Context, arg GetOpinionRepliesPa
 
 const getOpinionsByRank = `-- name: GetOpinionsByRank :many
 SELECT
-    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url,
+    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url, opinions.revision, opinions.edited_at, opinions.deleted_at,
     users.user_id, users.display_id, users.display_name, users.icon_url, users.created_at, users.updated_at, users.email, users.email_verified, users.withdrawal_date,
     COALESCE(rc.reply_count, 0) AS reply_count
 FROM opinions
@@ -433,6 +441,7 @@ WHERE opinions.talk_session_id = $2::uuid
     AND opinions.parent_opinion_id IS NULL
     -- 削除されたものはスワイプ意見から除外
     AND (opr.opinion_id IS NULL OR opr.status != 'deleted')
+    AND opinions.deleted_at IS NULL
     AND representative_opinions.rank = $4::int
 LIMIT $5::int
 `
@@ -457,7 +466,7 @@ type GetOpinionsByRankRow struct {
 // 通報された意見を除外
 //
 //	SELECT
-//	    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url,
+//	    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url, opinions.revision, opinions.edited_at, opinions.deleted_at,
 //	    users.user_id, users.display_id, users.display_name, users.icon_url, users.created_at, users.updated_at, users.email, users.email_verified, users.withdrawal_date,
 //	    COALESCE(rc.reply_count, 0) AS reply_count
 //	FROM opinions
@@ -498,6 +507,7 @@ type GetOpinionsByRankRow struct {
 //	    AND opinions.parent_opinion_id IS NULL
 //	    -- 削除されたものはスワイプ意見から除外
 //	    AND (opr.opinion_id IS NULL OR opr.status != 'deleted')
+//	    AND opinions.deleted_at IS NULL
 //	    AND representative_opinions.rank = $4::int
 //	LIMIT $5::int
 func (q *Queries) GetOpinionsByRank(ctx context.Context, arg GetOpinionsByRankParams) ([]GetOpinionsByRankRow, error) {
@@ -525,6 +535,9 @@ func (q *Queries) GetOpinionsByRank(ctx context.Context, arg GetOpinionsByRankPa
 			&i.Opinion.CreatedAt,
 			&i.Opinion.PictureUrl,
 			&i.Opinion.ReferenceUrl,
+			&i.Opinion.Revision,
+			&i.Opinion.EditedAt,
+			&i.Opinion.DeletedAt,
 			&i.User.UserID,
 			&i.User.DisplayID,
 			&i.User.DisplayName,
@@ -552,12 +565,12 @@ func (q *Queries) GetOpinionsByRank(ctx context.Context, arg GetOpinionsByRankPa
 const getOpinionsByTalkSessionID = `-- name: GetOpinionsByTalkSessionID :many
 WITH unique_opinions AS (
     SELECT DISTINCT ON (opinions.opinion_id)
-        opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url
+        opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url, opinions.revision, opinions.edited_at, opinions.deleted_at
     FROM opinions
     WHERE opinions.talk_session_id = $1
 )
 SELECT
-    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url,
+    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url, opinions.revision, opinions.edited_at, opinions.deleted_at,
     users.user_id, users.display_id, users.display_name, users.icon_url, users.created_at, users.updated_at, users.email, users.email_verified, users.withdrawal_date,
     COALESCE(pv.vote_type, 0) AS parent_vote_type,
     COALESCE(rc.reply_count, 0) AS reply_count,
@@ -617,12 +630,12 @@ type GetOpinionsByTalkSessionIDRow struct {
 //
 //	WITH unique_opinions AS (
 //	    SELECT DISTINCT ON (opinions.opinion_id)
-//	        opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url
+//	        opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url, opinions.revision, opinions.edited_at, opinions.deleted_at
 //	    FROM opinions
 //	    WHERE opinions.talk_session_id = $1
 //	)
 //	SELECT
-//	    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url,
+//	    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url, opinions.revision, opinions.edited_at, opinions.deleted_at,
 //	    users.user_id, users.display_id, users.display_name, users.icon_url, users.created_at, users.updated_at, users.email, users.email_verified, users.withdrawal_date,
 //	    COALESCE(pv.vote_type, 0) AS parent_vote_type,
 //	    COALESCE(rc.reply_count, 0) AS reply_count,
@@ -685,6 +698,9 @@ func (q *Queries) GetOpinionsByTalkSessionID(ctx context.Context, arg GetOpinion
 			&i.Opinion.CreatedAt,
 			&i.Opinion.PictureUrl,
 			&i.Opinion.ReferenceUrl,
+			&i.Opinion.Revision,
+			&i.Opinion.EditedAt,
+			&i.Opinion.DeletedAt,
 			&i.User.UserID,
 			&i.User.DisplayID,
 			&i.User.DisplayName,
@@ -713,7 +729,7 @@ func (q *Queries) GetOpinionsByTalkSessionID(ctx context.Context, arg GetOpinion
 
 const getOpinionsByUserID = `-- name: GetOpinionsByUserID :many
 SELECT
-    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url,
+    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url, opinions.revision, opinions.edited_at, opinions.deleted_at,
     users.user_id, users.display_id, users.display_name, users.icon_url, users.created_at, users.updated_at, users.email, users.email_verified, users.withdrawal_date,
     COALESCE(pv.vote_type, 0) AS parent_vote_type,
     -- 意見に対するリプライ数（再帰）
@@ -758,7 +774,7 @@ type GetOpinionsByUserIDRow struct {
 // latest, mostReply, oldestでソート
 //
 //	SELECT
-//	    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url,
+//	    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url, opinions.revision, opinions.edited_at, opinions.deleted_at,
 //	    users.user_id, users.display_id, users.display_name, users.icon_url, users.created_at, users.updated_at, users.email, users.email_verified, users.withdrawal_date,
 //	    COALESCE(pv.vote_type, 0) AS parent_vote_type,
 //	    -- 意見に対するリプライ数（再帰）
@@ -808,6 +824,9 @@ func (q *Queries) GetOpinionsByUserID(ctx context.Context, arg GetOpinionsByUser
 			&i.Opinion.CreatedAt,
 			&i.Opinion.PictureUrl,
 			&i.Opinion.ReferenceUrl,
+			&i.Opinion.Revision,
+			&i.Opinion.EditedAt,
+			&i.Opinion.DeletedAt,
 			&i.User.UserID,
 			&i.User.DisplayID,
 			&i.User.DisplayName,
@@ -853,7 +872,7 @@ WITH RECURSIVE opinion_tree AS (
     INNER JOIN opinion_tree t ON t.parent_opinion_id = p.opinion_id
 )
 SELECT
-    o.opinion_id, o.talk_session_id, o.user_id, o.parent_opinion_id, o.title, o.content, o.created_at, o.picture_url, o.reference_url,
+    o.opinion_id, o.talk_session_id, o.user_id, o.parent_opinion_id, o.title, o.content, o.created_at, o.picture_url, o.reference_url, o.revision, o.edited_at, o.deleted_at,
     u.user_id, u.display_id, u.display_name, u.icon_url, u.created_at, u.updated_at, u.email, u.email_verified, u.withdrawal_date,
     COALESCE(pv.vote_type, 0) AS parent_vote_type,
     COALESCE(rc.reply_count, 0) AS reply_count,
@@ -915,7 +934,7 @@ type GetParentOpinionsRow struct {
 //	    INNER JOIN opinion_tree t ON t.parent_opinion_id = p.opinion_id
 //	)
 //	SELECT
-//	    o.opinion_id, o.talk_session_id, o.user_id, o.parent_opinion_id, o.title, o.content, o.created_at, o.picture_url, o.reference_url,
+//	    o.opinion_id, o.talk_session_id, o.user_id, o.parent_opinion_id, o.title, o.content, o.created_at, o.picture_url, o.reference_url, o.revision, o.edited_at, o.deleted_at,
 //	    u.user_id, u.display_id, u.display_name, u.icon_url, u.created_at, u.updated_at, u.email, u.email_verified, u.withdrawal_date,
 //	    COALESCE(pv.vote_type, 0) AS parent_vote_type,
 //	    COALESCE(rc.reply_count, 0) AS reply_count,
@@ -959,6 +978,9 @@ func (q *Queries) GetParentOpinions(ctx context.Context, arg GetParentOpinionsPa
 			&i.Opinion.CreatedAt,
 			&i.Opinion.PictureUrl,
 			&i.Opinion.ReferenceUrl,
+			&i.Opinion.Revision,
+			&i.Opinion.EditedAt,
+			&i.Opinion.DeletedAt,
 			&i.User.UserID,
 			&i.User.DisplayID,
 			&i.User.DisplayName,
@@ -988,7 +1010,7 @@ func (q *Queries) GetParentOpinions(ctx context.Context, arg GetParentOpinionsPa
 
 const getRandomOpinions = `-- name: GetRandomOpinions :many
 SELECT
-    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url,
+    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url, opinions.revision, opinions.edited_at, opinions.deleted_at,
     users.user_id, users.display_id, users.display_name, users.icon_url, users.created_at, users.updated_at, users.email, users.email_verified, users.withdrawal_date,
     COALESCE(rc.reply_count, 0) AS reply_count
 FROM opinions
@@ -1025,6 +1047,7 @@ WHERE opinions.talk_session_id = $2
     AND opinions.parent_opinion_id IS NULL
     -- 削除されたものはスワイプ意見から除外
     AND (opr.opinion_id IS NULL OR opr.status != 'deleted')
+    AND opinions.deleted_at IS NULL
 ORDER BY RANDOM()
 LIMIT $3
 `
@@ -1048,7 +1071,7 @@ type GetRandomOpinionsRow struct {
 // トークセッションに紐づく意見のみを取得
 //
 //	SELECT
-//	    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url,
+//	    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url, opinions.revision, opinions.edited_at, opinions.deleted_at,
 //	    users.user_id, users.display_id, users.display_name, users.icon_url, users.created_at, users.updated_at, users.email, users.email_verified, users.withdrawal_date,
 //	    COALESCE(rc.reply_count, 0) AS reply_count
 //	FROM opinions
@@ -1085,6 +1108,7 @@ type GetRandomOpinionsRow struct {
 //	    AND opinions.parent_opinion_id IS NULL
 //	    -- 削除されたものはスワイプ意見から除外
 //	    AND (opr.opinion_id IS NULL OR opr.status != 'deleted')
+//	    AND opinions.deleted_at IS NULL
 //	ORDER BY RANDOM()
 //	LIMIT $3
 func (q *Queries) GetRandomOpinions(ctx context.Context, arg GetRandomOpinionsParams) ([]GetRandomOpinionsRow, error) {
@@ -1111,6 +1135,9 @@ func (q *Queries) GetRandomOpinions(ctx context.Context, arg GetRandomOpinionsPa
 			&i.Opinion.CreatedAt,
 			&i.Opinion.PictureUrl,
 			&i.Opinion.ReferenceUrl,
+			&i.Opinion.Revision,
+			&i.Opinion.EditedAt,
+			&i.Opinion.DeletedAt,
 			&i.User.UserID,
 			&i.User.DisplayID,
 			&i.User.DisplayName,
@@ -0,0 +1,160 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: opinion_revision.sql
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const createOpinionRevision = `-- name: CreateOpinionRevision :exec
+INSERT INTO opinion_revisions (
+    opinion_id,
+    revision,
+    title,
+    content,
+    reference_url,
+    vote_count,
+    votes_reset,
+    created_at,
+    replaced_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+`
+
+type CreateOpinionRevisionParams struct {
+	OpinionID    uuid.UUID
+	Revision     int32
+	Title        sql.NullString
+	Content      string
+	ReferenceUrl sql.NullString
+	VoteCount    int32
+	VotesReset   bool
+	CreatedAt    time.Time
+	ReplacedAt   time.Time
+}
+
+// CreateOpinionRevision
+//
+//	INSERT INTO opinion_revisions (
+//	    opinion_id,
+//	    revision,
+//	    title,
+//	    content,
+//	    reference_url,
+//	    vote_count,
+//	    votes_reset,
+//	    created_at,
+//	    replaced_at
+//	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+func (q *Queries) CreateOpinionRevision(ctx context.Context, arg CreateOpinionRevisionParams) error {
+	_, err := q.db.ExecContext(ctx, createOpinionRevision,
+		arg.OpinionID,
+		arg.Revision,
+		arg.Title,
+		arg.Content,
+		arg.ReferenceUrl,
+		arg.VoteCount,
+		arg.VotesReset,
+		arg.CreatedAt,
+		arg.ReplacedAt,
+	)
+	return err
+}
+
+const findOpinionRevisionsByOpinionID = `-- name: FindOpinionRevisionsByOpinionID :many
+SELECT opinion_id, revision, title, content, reference_url, vote_count, votes_reset, created_at, replaced_at
+FROM opinion_revisions
+WHERE opinion_id = $1
+ORDER BY revision DESC
+`
+
+// FindOpinionRevisionsByOpinionID
+//
+//	SELECT opinion_id, revision, title, content, reference_url, vote_count, votes_reset, created_at, replaced_at
+//	FROM opinion_revisions
+//	WHERE opinion_id = $1
+//	ORDER BY revision DESC
+func (q *Queries) FindOpinionRevisionsByOpinionID(ctx context.Context, opinionID uuid.UUID) ([]OpinionRevision, error) {
+	rows, err := q.db.QueryContext(ctx, findOpinionRevisionsByOpinionID, opinionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []OpinionRevision
+	for rows.Next() {
+		var i OpinionRevision
+		if err := rows.Scan(
+			&i.OpinionID,
+			&i.Revision,
+			&i.Title,
+			&i.Content,
+			&i.ReferenceUrl,
+			&i.VoteCount,
+			&i.VotesReset,
+			&i.CreatedAt,
+			&i.ReplacedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateOpinion = `-- name: UpdateOpinion :exec
+UPDATE opinions
+SET
+    title = $2,
+    content = $3,
+    reference_url = $4,
+    revision = $5,
+    edited_at = $6,
+    deleted_at = $7
+WHERE opinion_id = $1
+`
+
+type UpdateOpinionParams struct {
+	OpinionID    uuid.UUID
+	Title        sql.NullString
+	Content      string
+	ReferenceUrl sql.NullString
+	Revision     int32
+	EditedAt     sql.NullTime
+	DeletedAt    sql.NullTime
+}
+
+// UpdateOpinion
+//
+//	UPDATE opinions
+//	SET
+//	    title = $2,
+//	    content = $3,
+//	    reference_url = $4,
+//	    revision = $5,
+//	    edited_at = $6,
+//	    deleted_at = $7
+//	WHERE opinion_id = $1
+func (q *Queries) UpdateOpinion(ctx context.Context, arg UpdateOpinionParams) error {
+	_, err := q.db.ExecContext(ctx, updateOpinion,
+		arg.OpinionID,
+		arg.Title,
+		arg.Content,
+		arg.ReferenceUrl,
+		arg.Revision,
+		arg.EditedAt,
+		arg.DeletedAt,
+	)
+	return err
+}
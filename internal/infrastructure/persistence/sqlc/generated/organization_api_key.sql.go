@@ -0,0 +1,226 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: organization_api_key.sql
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const createOrganizationAPIKey = `-- name: CreateOrganizationAPIKey :exec
+INSERT INTO organization_api_keys (
+    api_key_id,
+    organization_id,
+    name,
+    key_prefix,
+    key_hash,
+    scopes,
+    created_by,
+    expires_at,
+    created_at,
+    updated_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $9)
+`
+
+type CreateOrganizationAPIKeyParams struct {
+	ApiKeyID       uuid.UUID
+	OrganizationID uuid.UUID
+	Name           string
+	KeyPrefix      string
+	KeyHash        string
+	Scopes         []string
+	CreatedBy      uuid.UUID
+	ExpiresAt      sql.NullTime
+	CreatedAt      time.Time
+}
+
+// CreateOrganizationAPIKey
+//
+//	INSERT INTO organization_api_keys (
+//	    api_key_id,
+//	    organization_id,
+//	    name,
+//	    key_prefix,
+//	    key_hash,
+//	    scopes,
+//	    created_by,
+//	    expires_at,
+//	    created_at,
+//	    updated_at
+//	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $9)
+func (q *Queries) CreateOrganizationAPIKey(ctx context.Context, arg CreateOrganizationAPIKeyParams) error {
+	_, err := q.db.ExecContext(ctx, createOrganizationAPIKey,
+		arg.ApiKeyID,
+		arg.OrganizationID,
+		arg.Name,
+		arg.KeyPrefix,
+		arg.KeyHash,
+		pq.Array(arg.Scopes),
+		arg.CreatedBy,
+		arg.ExpiresAt,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const findOrganizationAPIKeyByHash = `-- name: FindOrganizationAPIKeyByHash :one
+SELECT api_key_id, organization_id, name, key_prefix, key_hash, scopes, created_by, expires_at, last_used_at, revoked_at, revoked_by, created_at, updated_at FROM organization_api_keys
+WHERE key_hash = $1
+`
+
+// FindOrganizationAPIKeyByHash
+//
+//	SELECT api_key_id, organization_id, name, key_prefix, key_hash, scopes, created_by, expires_at, last_used_at, revoked_at, revoked_by, created_at, updated_at FROM organization_api_keys
+//	WHERE key_hash = $1
+func (q *Queries) FindOrganizationAPIKeyByHash(ctx context.Context, keyHash string) (OrganizationApiKey, error) {
+	row := q.db.QueryRowContext(ctx, findOrganizationAPIKeyByHash, keyHash)
+	var i OrganizationApiKey
+	err := row.Scan(
+		&i.ApiKeyID,
+		&i.OrganizationID,
+		&i.Name,
+		&i.KeyPrefix,
+		&i.KeyHash,
+		pq.Array(&i.Scopes),
+		&i.CreatedBy,
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.RevokedAt,
+		&i.RevokedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const findOrganizationAPIKeyByID = `-- name: FindOrganizationAPIKeyByID :one
+SELECT api_key_id, organization_id, name, key_prefix, key_hash, scopes, created_by, expires_at, last_used_at, revoked_at, revoked_by, created_at, updated_at FROM organization_api_keys
+WHERE api_key_id = $1
+`
+
+// FindOrganizationAPIKeyByID
+//
+//	SELECT api_key_id, organization_id, name, key_prefix, key_hash, scopes, created_by, expires_at, last_used_at, revoked_at, revoked_by, created_at, updated_at FROM organization_api_keys
+//	WHERE api_key_id = $1
+func (q *Queries) FindOrganizationAPIKeyByID(ctx context.Context, apiKeyID uuid.UUID) (OrganizationApiKey, error) {
+	row := q.db.QueryRowContext(ctx, findOrganizationAPIKeyByID, apiKeyID)
+	var i OrganizationApiKey
+	err := row.Scan(
+		&i.ApiKeyID,
+		&i.OrganizationID,
+		&i.Name,
+		&i.KeyPrefix,
+		&i.KeyHash,
+		pq.Array(&i.Scopes),
+		&i.CreatedBy,
+		&i.ExpiresAt,
+		&i.LastUsedAt,
+		&i.RevokedAt,
+		&i.RevokedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const findOrganizationAPIKeysByOrganizationID = `-- name: FindOrganizationAPIKeysByOrganizationID :many
+SELECT api_key_id, organization_id, name, key_prefix, key_hash, scopes, created_by, expires_at, last_used_at, revoked_at, revoked_by, created_at, updated_at FROM organization_api_keys
+WHERE organization_id = $1
+ORDER BY created_at DESC
+`
+
+// FindOrganizationAPIKeysByOrganizationID
+//
+//	SELECT api_key_id, organization_id, name, key_prefix, key_hash, scopes, created_by, expires_at, last_used_at, revoked_at, revoked_by, created_at, updated_at FROM organization_api_keys
+//	WHERE organization_id = $1
+//	ORDER BY created_at DESC
+func (q *Queries) FindOrganizationAPIKeysByOrganizationID(ctx context.Context, organizationID uuid.UUID) ([]OrganizationApiKey, error) {
+	rows, err := q.db.QueryContext(ctx, findOrganizationAPIKeysByOrganizationID, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []OrganizationApiKey
+	for rows.Next() {
+		var i OrganizationApiKey
+		if err := rows.Scan(
+			&i.ApiKeyID,
+			&i.OrganizationID,
+			&i.Name,
+			&i.KeyPrefix,
+			&i.KeyHash,
+			pq.Array(&i.Scopes),
+			&i.CreatedBy,
+			&i.ExpiresAt,
+			&i.LastUsedAt,
+			&i.RevokedAt,
+			&i.RevokedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const revokeOrganizationAPIKey = `-- name: RevokeOrganizationAPIKey :exec
+UPDATE organization_api_keys
+SET revoked_at = $2,
+    revoked_by = $3,
+    updated_at = $2
+WHERE api_key_id = $1 AND revoked_at IS NULL
+`
+
+type RevokeOrganizationAPIKeyParams struct {
+	ApiKeyID  uuid.UUID
+	RevokedAt sql.NullTime
+	RevokedBy uuid.NullUUID
+}
+
+// RevokeOrganizationAPIKey
+//
+//	UPDATE organization_api_keys
+//	SET revoked_at = $2,
+//	    revoked_by = $3,
+//	    updated_at = $2
+//	WHERE api_key_id = $1 AND revoked_at IS NULL
+func (q *Queries) RevokeOrganizationAPIKey(ctx context.Context, arg RevokeOrganizationAPIKeyParams) error {
+	_, err := q.db.ExecContext(ctx, revokeOrganizationAPIKey, arg.ApiKeyID, arg.RevokedAt, arg.RevokedBy)
+	return err
+}
+
+const updateOrganizationAPIKeyLastUsed = `-- name: UpdateOrganizationAPIKeyLastUsed :exec
+UPDATE organization_api_keys
+SET last_used_at = $2
+WHERE api_key_id = $1
+`
+
+type UpdateOrganizationAPIKeyLastUsedParams struct {
+	ApiKeyID   uuid.UUID
+	LastUsedAt sql.NullTime
+}
+
+// UpdateOrganizationAPIKeyLastUsed
+//
+//	UPDATE organization_api_keys
+//	SET last_used_at = $2
+//	WHERE api_key_id = $1
+func (q *Queries) UpdateOrganizationAPIKeyLastUsed(ctx context.Context, arg UpdateOrganizationAPIKeyLastUsedParams) error {
+	_, err := q.db.ExecContext(ctx, updateOrganizationAPIKeyLastUsed, arg.ApiKeyID, arg.LastUsedAt)
+	return err
+}
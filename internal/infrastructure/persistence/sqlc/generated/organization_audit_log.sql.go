@@ -0,0 +1,190 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: organization_audit_log.sql
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const countOrganizationAuditLogs = `-- name: CountOrganizationAuditLogs :one
+SELECT COUNT(*) AS count
+FROM organization_audit_logs
+WHERE organization_id = $1::uuid
+  AND ($2::text IS NULL OR action = $2::text)
+  AND ($3::uuid IS NULL OR actor_id = $3::uuid)
+  AND ($4::timestamptz IS NULL OR created_at >= $4::timestamptz)
+  AND ($5::timestamptz IS NULL OR created_at < $5::timestamptz)
+`
+
+type CountOrganizationAuditLogsParams struct {
+	OrganizationID uuid.UUID
+	Action         sql.NullString
+	ActorID        uuid.NullUUID
+	Since          sql.NullTime
+	Until          sql.NullTime
+}
+
+// CountOrganizationAuditLogs
+//
+//	SELECT COUNT(*) AS count
+//	FROM organization_audit_logs
+//	WHERE organization_id = $1::uuid
+//	  AND ($2::text IS NULL OR action = $2::text)
+//	  AND ($3::uuid IS NULL OR actor_id = $3::uuid)
+//	  AND ($4::timestamptz IS NULL OR created_at >= $4::timestamptz)
+//	  AND ($5::timestamptz IS NULL OR created_at < $5::timestamptz)
+func (q *Queries) CountOrganizationAuditLogs(ctx context.Context, arg CountOrganizationAuditLogsParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countOrganizationAuditLogs,
+		arg.OrganizationID,
+		arg.Action,
+		arg.ActorID,
+		arg.Since,
+		arg.Until,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const createOrganizationAuditLog = `-- name: CreateOrganizationAuditLog :exec
+INSERT INTO organization_audit_logs (
+    audit_log_id,
+    organization_id,
+    actor_id,
+    action,
+    target_type,
+    target_id,
+    changes,
+    created_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+`
+
+type CreateOrganizationAuditLogParams struct {
+	AuditLogID     uuid.UUID
+	OrganizationID uuid.UUID
+	ActorID        uuid.UUID
+	Action         string
+	TargetType     string
+	TargetID       string
+	Changes        json.RawMessage
+	CreatedAt      time.Time
+}
+
+// CreateOrganizationAuditLog
+//
+//	INSERT INTO organization_audit_logs (
+//	    audit_log_id,
+//	    organization_id,
+//	    actor_id,
+//	    action,
+//	    target_type,
+//	    target_id,
+//	    changes,
+//	    created_at
+//	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+func (q *Queries) CreateOrganizationAuditLog(ctx context.Context, arg CreateOrganizationAuditLogParams) error {
+	_, err := q.db.ExecContext(ctx, createOrganizationAuditLog,
+		arg.AuditLogID,
+		arg.OrganizationID,
+		arg.ActorID,
+		arg.Action,
+		arg.TargetType,
+		arg.TargetID,
+		arg.Changes,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const findOrganizationAuditLogs = `-- name: FindOrganizationAuditLogs :many
+SELECT audit_log_id, organization_id, actor_id, action, target_type, target_id, changes, created_at
+FROM organization_audit_logs
+WHERE organization_id = $1::uuid
+  AND ($2::text IS NULL OR action = $2::text)
+  AND ($3::uuid IS NULL OR actor_id = $3::uuid)
+  AND ($4::timestamptz IS NULL OR created_at >= $4::timestamptz)
+  AND ($5::timestamptz IS NULL OR created_at < $5::timestamptz)
+  AND (
+    $6::timestamptz IS NULL
+    OR (created_at, audit_log_id) < ($6::timestamptz, $7::uuid)
+  )
+ORDER BY created_at DESC, audit_log_id DESC
+LIMIT $9::int OFFSET $8::int
+`
+
+type FindOrganizationAuditLogsParams struct {
+	OrganizationID   uuid.UUID
+	Action           sql.NullString
+	ActorID          uuid.NullUUID
+	Since            sql.NullTime
+	Until            sql.NullTime
+	BeforeCreatedAt  sql.NullTime
+	BeforeAuditLogID uuid.NullUUID
+	Offset           int32
+	Limit            int32
+}
+
+// FindOrganizationAuditLogs
+//
+//	SELECT audit_log_id, organization_id, actor_id, action, target_type, target_id, changes, created_at
+//	FROM organization_audit_logs
+//	WHERE organization_id = $1::uuid
+//	  AND ($2::text IS NULL OR action = $2::text)
+//	  AND ($3::uuid IS NULL OR actor_id = $3::uuid)
+//	  AND ($4::timestamptz IS NULL OR created_at >= $4::timestamptz)
+//	  AND ($5::timestamptz IS NULL OR created_at < $5::timestamptz)
+//	  AND (
+//	    $6::timestamptz IS NULL
+//	    OR (created_at, audit_log_id) < ($6::timestamptz, $7::uuid)
+//	  )
+//	ORDER BY created_at DESC, audit_log_id DESC
+//	LIMIT $9::int OFFSET $8::int
+func (q *Queries) FindOrganizationAuditLogs(ctx context.Context, arg FindOrganizationAuditLogsParams) ([]OrganizationAuditLog, error) {
+	rows, err := q.db.QueryContext(ctx, findOrganizationAuditLogs,
+		arg.OrganizationID,
+		arg.Action,
+		arg.ActorID,
+		arg.Since,
+		arg.Until,
+		arg.BeforeCreatedAt,
+		arg.BeforeAuditLogID,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []OrganizationAuditLog
+	for rows.Next() {
+		var i OrganizationAuditLog
+		if err := rows.Scan(
+			&i.AuditLogID,
+			&i.OrganizationID,
+			&i.ActorID,
+			&i.Action,
+			&i.TargetType,
+			&i.TargetID,
+			&i.Changes,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,411 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: organization_hierarchy.sql
+
+package model
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+const findAncestorOrganizationIDs = `-- name: FindAncestorOrganizationIDs :many
+WITH RECURSIVE ancestors AS (
+    SELECT
+        organizations.parent_organization_id AS organization_id,
+        1 AS depth
+    FROM organizations
+    WHERE organizations.organization_id = $1
+        AND organizations.parent_organization_id IS NOT NULL
+    UNION ALL
+    SELECT
+        organizations.parent_organization_id,
+        ancestors.depth + 1
+    FROM organizations
+    JOIN ancestors ON organizations.organization_id = ancestors.organization_id
+    WHERE organizations.parent_organization_id IS NOT NULL
+        AND ancestors.depth < $2::int
+)
+SELECT ancestors.organization_id::uuid AS organization_id
+FROM ancestors
+ORDER BY ancestors.depth ASC
+`
+
+type FindAncestorOrganizationIDsParams struct {
+	OrganizationID uuid.UUID
+	MaxDepth       int32
+}
+
+// 近い順に祖先の組織IDを返す（自身は含まない）
+//
+//	WITH RECURSIVE ancestors AS (
+//	    SELECT
+//	        organizations.parent_organization_id AS organization_id,
+//	        1 AS depth
+//	    FROM organizations
+//	    WHERE organizations.organization_id = $1
+//	        AND organizations.parent_organization_id IS NOT NULL
+//	    UNION ALL
+//	    SELECT
+//	        organizations.parent_organization_id,
+//	        ancestors.depth + 1
+//	    FROM organizations
+//	    JOIN ancestors ON organizations.organization_id = ancestors.organization_id
+//	    WHERE organizations.parent_organization_id IS NOT NULL
+//	        AND ancestors.depth < $2::int
+//	)
+//	SELECT ancestors.organization_id::uuid AS organization_id
+//	FROM ancestors
+//	ORDER BY ancestors.depth ASC
+func (q *Queries) FindAncestorOrganizationIDs(ctx context.Context, arg FindAncestorOrganizationIDsParams) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, findAncestorOrganizationIDs, arg.OrganizationID, arg.MaxDepth)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var organization_id uuid.UUID
+		if err := rows.Scan(&organization_id); err != nil {
+			return nil, err
+		}
+		items = append(items, organization_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findChildOrganizations = `-- name: FindChildOrganizations :many
+SELECT
+    organizations.organization_id, organizations.organization_type, organizations.name, organizations.owner_id, organizations.code, organizations.icon_url, organizations.parent_organization_id
+FROM organizations
+WHERE parent_organization_id = $1
+ORDER BY name ASC
+`
+
+type FindChildOrganizationsRow struct {
+	Organization Organization
+}
+
+// FindChildOrganizations
+//
+//	SELECT
+//	    organizations.organization_id, organizations.organization_type, organizations.name, organizations.owner_id, organizations.code, organizations.icon_url, organizations.parent_organization_id
+//	FROM organizations
+//	WHERE parent_organization_id = $1
+//	ORDER BY name ASC
+func (q *Queries) FindChildOrganizations(ctx context.Context, parentOrganizationID uuid.NullUUID) ([]FindChildOrganizationsRow, error) {
+	rows, err := q.db.QueryContext(ctx, findChildOrganizations, parentOrganizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FindChildOrganizationsRow
+	for rows.Next() {
+		var i FindChildOrganizationsRow
+		if err := rows.Scan(
+			&i.Organization.OrganizationID,
+			&i.Organization.OrganizationType,
+			&i.Organization.Name,
+			&i.Organization.OwnerID,
+			&i.Organization.Code,
+			&i.Organization.IconUrl,
+			&i.Organization.ParentOrganizationID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findDescendantOrganizations = `-- name: FindDescendantOrganizations :many
+WITH RECURSIVE descendants AS (
+    SELECT
+        organizations.organization_id,
+        1 AS depth
+    FROM organizations
+    WHERE organizations.parent_organization_id = $1
+    UNION ALL
+    SELECT
+        organizations.organization_id,
+        descendants.depth + 1
+    FROM organizations
+    JOIN descendants ON organizations.parent_organization_id = descendants.organization_id
+    WHERE descendants.depth < $2::int
+)
+SELECT
+    organizations.organization_id, organizations.organization_type, organizations.name, organizations.owner_id, organizations.code, organizations.icon_url, organizations.parent_organization_id
+FROM organizations
+JOIN descendants ON organizations.organization_id = descendants.organization_id
+ORDER BY descendants.depth ASC, organizations.name ASC
+`
+
+type FindDescendantOrganizationsParams struct {
+	OrganizationID uuid.NullUUID
+	MaxDepth       int32
+}
+
+type FindDescendantOrganizationsRow struct {
+	Organization Organization
+}
+
+// 子孫の組織を返す（自身は含まない）
+//
+//	WITH RECURSIVE descendants AS (
+//	    SELECT
+//	        organizations.organization_id,
+//	        1 AS depth
+//	    FROM organizations
+//	    WHERE organizations.parent_organization_id = $1
+//	    UNION ALL
+//	    SELECT
+//	        organizations.organization_id,
+//	        descendants.depth + 1
+//	    FROM organizations
+//	    JOIN descendants ON organizations.parent_organization_id = descendants.organization_id
+//	    WHERE descendants.depth < $2::int
+//	)
+//	SELECT
+//	    organizations.organization_id, organizations.organization_type, organizations.name, organizations.owner_id, organizations.code, organizations.icon_url, organizations.parent_organization_id
+//	FROM organizations
+//	JOIN descendants ON organizations.organization_id = descendants.organization_id
+//	ORDER BY descendants.depth ASC, organizations.name ASC
+func (q *Queries) FindDescendantOrganizations(ctx context.Context, arg FindDescendantOrganizationsParams) ([]FindDescendantOrganizationsRow, error) {
+	rows, err := q.db.QueryContext(ctx, findDescendantOrganizations, arg.OrganizationID, arg.MaxDepth)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FindDescendantOrganizationsRow
+	for rows.Next() {
+		var i FindDescendantOrganizationsRow
+		if err := rows.Scan(
+			&i.Organization.OrganizationID,
+			&i.Organization.OrganizationType,
+			&i.Organization.Name,
+			&i.Organization.OwnerID,
+			&i.Organization.Code,
+			&i.Organization.IconUrl,
+			&i.Organization.ParentOrganizationID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findEffectiveOrgUserByOrganizationIDAndUserID = `-- name: FindEffectiveOrgUserByOrganizationIDAndUserID :one
+WITH RECURSIVE ancestors AS (
+    SELECT
+        organizations.organization_id,
+        organizations.parent_organization_id,
+        0 AS depth
+    FROM organizations
+    WHERE organizations.organization_id = $2
+    UNION ALL
+    SELECT
+        organizations.organization_id,
+        organizations.parent_organization_id,
+        ancestors.depth + 1
+    FROM organizations
+    JOIN ancestors ON organizations.organization_id = ancestors.parent_organization_id
+    WHERE ancestors.depth < $3::int
+)
+SELECT
+    organization_users.organization_user_id, organization_users.user_id, organization_users.organization_id, organization_users.created_at, organization_users.updated_at, organization_users.role,
+    ancestors.depth
+FROM organization_users
+JOIN ancestors ON organization_users.organization_id = ancestors.organization_id
+WHERE organization_users.user_id = $1
+ORDER BY organization_users.role ASC, ancestors.depth ASC
+LIMIT 1
+`
+
+type FindEffectiveOrgUserByOrganizationIDAndUserIDParams struct {
+	UserID         uuid.UUID
+	OrganizationID uuid.UUID
+	MaxDepth       int32
+}
+
+type FindEffectiveOrgUserByOrganizationIDAndUserIDRow struct {
+	OrganizationUser OrganizationUser
+	Depth            int32
+}
+
+// 組織および祖先の組織の所属のうち、最も強いロールを返す
+//
+//	WITH RECURSIVE ancestors AS (
+//	    SELECT
+//	        organizations.organization_id,
+//	        organizations.parent_organization_id,
+//	        0 AS depth
+//	    FROM organizations
+//	    WHERE organizations.organization_id = $2
+//	    UNION ALL
+//	    SELECT
+//	        organizations.organization_id,
+//	        organizations.parent_organization_id,
+//	        ancestors.depth + 1
+//	    FROM organizations
+//	    JOIN ancestors ON organizations.organization_id = ancestors.parent_organization_id
+//	    WHERE ancestors.depth < $3::int
+//	)
+//	SELECT
+//	    organization_users.organization_user_id, organization_users.user_id, organization_users.organization_id, organization_users.created_at, organization_users.updated_at, organization_users.role,
+//	    ancestors.depth
+//	FROM organization_users
+//	JOIN ancestors ON organization_users.organization_id = ancestors.organization_id
+//	WHERE organization_users.user_id = $1
+//	ORDER BY organization_users.role ASC, ancestors.depth ASC
+//	LIMIT 1
+func (q *Queries) FindEffectiveOrgUserByOrganizationIDAndUserID(ctx context.Context, arg FindEffectiveOrgUserByOrganizationIDAndUserIDParams) (FindEffectiveOrgUserByOrganizationIDAndUserIDRow, error) {
+	row := q.db.QueryRowContext(ctx, findEffectiveOrgUserByOrganizationIDAndUserID, arg.UserID, arg.OrganizationID, arg.MaxDepth)
+	var i FindEffectiveOrgUserByOrganizationIDAndUserIDRow
+	err := row.Scan(
+		&i.OrganizationUser.OrganizationUserID,
+		&i.OrganizationUser.UserID,
+		&i.OrganizationUser.OrganizationID,
+		&i.OrganizationUser.CreatedAt,
+		&i.OrganizationUser.UpdatedAt,
+		&i.OrganizationUser.Role,
+		&i.Depth,
+	)
+	return i, err
+}
+
+const findInheritedOrgUserByUserIDWithOrganization = `-- name: FindInheritedOrgUserByUserIDWithOrganization :many
+WITH RECURSIVE subtree AS (
+    SELECT
+        organization_users.organization_user_id,
+        organizations.organization_id,
+        1 AS depth
+    FROM organization_users
+    JOIN organizations ON organizations.parent_organization_id = organization_users.organization_id
+    WHERE organization_users.user_id = $1
+    UNION ALL
+    SELECT
+        subtree.organization_user_id,
+        organizations.organization_id,
+        subtree.depth + 1
+    FROM subtree
+    JOIN organizations ON organizations.parent_organization_id = subtree.organization_id
+    WHERE subtree.depth < $2::int
+)
+SELECT
+    users.user_id, users.display_id, users.display_name, users.icon_url, users.created_at, users.updated_at, users.email, users.email_verified, users.withdrawal_date,
+    organization_users.organization_user_id, organization_users.user_id, organization_users.organization_id, organization_users.created_at, organization_users.updated_at, organization_users.role,
+    organizations.organization_id, organizations.organization_type, organizations.name, organizations.owner_id, organizations.code, organizations.icon_url, organizations.parent_organization_id
+FROM subtree
+JOIN organization_users ON organization_users.organization_user_id = subtree.organization_user_id
+JOIN organizations ON organizations.organization_id = subtree.organization_id
+JOIN users ON organization_users.user_id = users.user_id
+WHERE users.withdrawal_date IS NULL
+ORDER BY organization_users.role ASC, subtree.depth ASC
+`
+
+type FindInheritedOrgUserByUserIDWithOrganizationParams struct {
+	UserID   uuid.UUID
+	MaxDepth int32
+}
+
+type FindInheritedOrgUserByUserIDWithOrganizationRow struct {
+	User             User
+	OrganizationUser OrganizationUser
+	Organization     Organization
+}
+
+// 所属組織の子孫の組織を、継承元の所属とともに返す
+//
+//	WITH RECURSIVE subtree AS (
+//	    SELECT
+//	        organization_users.organization_user_id,
+//	        organizations.organization_id,
+//	        1 AS depth
+//	    FROM organization_users
+//	    JOIN organizations ON organizations.parent_organization_id = organization_users.organization_id
+//	    WHERE organization_users.user_id = $1
+//	    UNION ALL
+//	    SELECT
+//	        subtree.organization_user_id,
+//	        organizations.organization_id,
+//	        subtree.depth + 1
+//	    FROM subtree
+//	    JOIN organizations ON organizations.parent_organization_id = subtree.organization_id
+//	    WHERE subtree.depth < $2::int
+//	)
+//	SELECT
+//	    users.user_id, users.display_id, users.display_name, users.icon_url, users.created_at, users.updated_at, users.email, users.email_verified, users.withdrawal_date,
+//	    organization_users.organization_user_id, organization_users.user_id, organization_users.organization_id, organization_users.created_at, organization_users.updated_at, organization_users.role,
+//	    organizations.organization_id, organizations.organization_type, organizations.name, organizations.owner_id, organizations.code, organizations.icon_url, organizations.parent_organization_id
+//	FROM subtree
+//	JOIN organization_users ON organization_users.organization_user_id = subtree.organization_user_id
+//	JOIN organizations ON organizations.organization_id = subtree.organization_id
+//	JOIN users ON organization_users.user_id = users.user_id
+//	WHERE users.withdrawal_date IS NULL
+//	ORDER BY organization_users.role ASC, subtree.depth ASC
+func (q *Queries) FindInheritedOrgUserByUserIDWithOrganization(ctx context.Context, arg FindInheritedOrgUserByUserIDWithOrganizationParams) ([]FindInheritedOrgUserByUserIDWithOrganizationRow, error) {
+	rows, err := q.db.QueryContext(ctx, findInheritedOrgUserByUserIDWithOrganization, arg.UserID, arg.MaxDepth)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FindInheritedOrgUserByUserIDWithOrganizationRow
+	for rows.Next() {
+		var i FindInheritedOrgUserByUserIDWithOrganizationRow
+		if err := rows.Scan(
+			&i.User.UserID,
+			&i.User.DisplayID,
+			&i.User.DisplayName,
+			&i.User.IconUrl,
+			&i.User.CreatedAt,
+			&i.User.UpdatedAt,
+			&i.User.Email,
+			&i.User.EmailVerified,
+			&i.User.WithdrawalDate,
+			&i.OrganizationUser.OrganizationUserID,
+			&i.OrganizationUser.UserID,
+			&i.OrganizationUser.OrganizationID,
+			&i.OrganizationUser.CreatedAt,
+			&i.OrganizationUser.UpdatedAt,
+			&i.OrganizationUser.Role,
+			&i.Organization.OrganizationID,
+			&i.Organization.OrganizationType,
+			&i.Organization.Name,
+			&i.Organization.OwnerID,
+			&i.Organization.Code,
+			&i.Organization.IconUrl,
+			&i.Organization.ParentOrganizationID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
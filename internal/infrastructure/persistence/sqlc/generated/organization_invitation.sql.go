@@ -0,0 +1,305 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: organization_invitation.sql
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const createOrganizationInvitation = `-- name: CreateOrganizationInvitation :exec
+INSERT INTO organization_invitations (
+    invitation_id,
+    organization_id,
+    email,
+    email_hash,
+    role,
+    token_hash,
+    status,
+    invited_by,
+    expires_at,
+    send_count,
+    last_sent_at,
+    created_at,
+    updated_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $12)
+`
+
+type CreateOrganizationInvitationParams struct {
+	InvitationID   uuid.UUID
+	OrganizationID uuid.UUID
+	Email          string
+	EmailHash      string
+	Role           int32
+	TokenHash      string
+	Status         string
+	InvitedBy      uuid.UUID
+	ExpiresAt      time.Time
+	SendCount      int32
+	LastSentAt     time.Time
+	CreatedAt      time.Time
+}
+
+// CreateOrganizationInvitation
+//
+//	INSERT INTO organization_invitations (
+//	    invitation_id,
+//	    organization_id,
+//	    email,
+//	    email_hash,
+//	    role,
+//	    token_hash,
+//	    status,
+//	    invited_by,
+//	    expires_at,
+//	    send_count,
+//	    last_sent_at,
+//	    created_at,
+//	    updated_at
+//	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $12)
+func (q *Queries) CreateOrganizationInvitation(ctx context.Context, arg CreateOrganizationInvitationParams) error {
+	_, err := q.db.ExecContext(ctx, createOrganizationInvitation,
+		arg.InvitationID,
+		arg.OrganizationID,
+		arg.Email,
+		arg.EmailHash,
+		arg.Role,
+		arg.TokenHash,
+		arg.Status,
+		arg.InvitedBy,
+		arg.ExpiresAt,
+		arg.SendCount,
+		arg.LastSentAt,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const findOrganizationInvitationByID = `-- name: FindOrganizationInvitationByID :one
+SELECT invitation_id, organization_id, email, email_hash, role, token_hash, status, invited_by, expires_at, send_count, last_sent_at, accepted_by, accepted_at, revoked_at, created_at, updated_at
+FROM organization_invitations
+WHERE invitation_id = $1
+`
+
+// FindOrganizationInvitationByID
+//
+//	SELECT invitation_id, organization_id, email, email_hash, role, token_hash, status, invited_by, expires_at, send_count, last_sent_at, accepted_by, accepted_at, revoked_at, created_at, updated_at
+//	FROM organization_invitations
+//	WHERE invitation_id = $1
+func (q *Queries) FindOrganizationInvitationByID(ctx context.Context, invitationID uuid.UUID) (OrganizationInvitation, error) {
+	row := q.db.QueryRowContext(ctx, findOrganizationInvitationByID, invitationID)
+	var i OrganizationInvitation
+	err := row.Scan(
+		&i.InvitationID,
+		&i.OrganizationID,
+		&i.Email,
+		&i.EmailHash,
+		&i.Role,
+		&i.TokenHash,
+		&i.Status,
+		&i.InvitedBy,
+		&i.ExpiresAt,
+		&i.SendCount,
+		&i.LastSentAt,
+		&i.AcceptedBy,
+		&i.AcceptedAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const findOrganizationInvitationByTokenHash = `-- name: FindOrganizationInvitationByTokenHash :one
+SELECT invitation_id, organization_id, email, email_hash, role, token_hash, status, invited_by, expires_at, send_count, last_sent_at, accepted_by, accepted_at, revoked_at, created_at, updated_at
+FROM organization_invitations
+WHERE token_hash = $1
+`
+
+// FindOrganizationInvitationByTokenHash
+//
+//	SELECT invitation_id, organization_id, email, email_hash, role, token_hash, status, invited_by, expires_at, send_count, last_sent_at, accepted_by, accepted_at, revoked_at, created_at, updated_at
+//	FROM organization_invitations
+//	WHERE token_hash = $1
+func (q *Queries) FindOrganizationInvitationByTokenHash(ctx context.Context, tokenHash string) (OrganizationInvitation, error) {
+	row := q.db.QueryRowContext(ctx, findOrganizationInvitationByTokenHash, tokenHash)
+	var i OrganizationInvitation
+	err := row.Scan(
+		&i.InvitationID,
+		&i.OrganizationID,
+		&i.Email,
+		&i.EmailHash,
+		&i.Role,
+		&i.TokenHash,
+		&i.Status,
+		&i.InvitedBy,
+		&i.ExpiresAt,
+		&i.SendCount,
+		&i.LastSentAt,
+		&i.AcceptedBy,
+		&i.AcceptedAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const findOrganizationInvitationsByOrganizationID = `-- name: FindOrganizationInvitationsByOrganizationID :many
+SELECT invitation_id, organization_id, email, email_hash, role, token_hash, status, invited_by, expires_at, send_count, last_sent_at, accepted_by, accepted_at, revoked_at, created_at, updated_at
+FROM organization_invitations
+WHERE organization_id = $1
+ORDER BY created_at DESC
+`
+
+// FindOrganizationInvitationsByOrganizationID
+//
+//	SELECT invitation_id, organization_id, email, email_hash, role, token_hash, status, invited_by, expires_at, send_count, last_sent_at, accepted_by, accepted_at, revoked_at, created_at, updated_at
+//	FROM organization_invitations
+//	WHERE organization_id = $1
+//	ORDER BY created_at DESC
+func (q *Queries) FindOrganizationInvitationsByOrganizationID(ctx context.Context, organizationID uuid.UUID) ([]OrganizationInvitation, error) {
+	rows, err := q.db.QueryContext(ctx, findOrganizationInvitationsByOrganizationID, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []OrganizationInvitation
+	for rows.Next() {
+		var i OrganizationInvitation
+		if err := rows.Scan(
+			&i.InvitationID,
+			&i.OrganizationID,
+			&i.Email,
+			&i.EmailHash,
+			&i.Role,
+			&i.TokenHash,
+			&i.Status,
+			&i.InvitedBy,
+			&i.ExpiresAt,
+			&i.SendCount,
+			&i.LastSentAt,
+			&i.AcceptedBy,
+			&i.AcceptedAt,
+			&i.RevokedAt,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findPendingOrganizationInvitationByEmailHash = `-- name: FindPendingOrganizationInvitationByEmailHash :one
+SELECT invitation_id, organization_id, email, email_hash, role, token_hash, status, invited_by, expires_at, send_count, last_sent_at, accepted_by, accepted_at, revoked_at, created_at, updated_at
+FROM organization_invitations
+WHERE organization_id = $1
+  AND email_hash = $2
+  AND status = 'pending'
+`
+
+type FindPendingOrganizationInvitationByEmailHashParams struct {
+	OrganizationID uuid.UUID
+	EmailHash      string
+}
+
+// FindPendingOrganizationInvitationByEmailHash
+//
+//	SELECT invitation_id, organization_id, email, email_hash, role, token_hash, status, invited_by, expires_at, send_count, last_sent_at, accepted_by, accepted_at, revoked_at, created_at, updated_at
+//	FROM organization_invitations
+//	WHERE organization_id = $1
+//	  AND email_hash = $2
+//	  AND status = 'pending'
+func (q *Queries) FindPendingOrganizationInvitationByEmailHash(ctx context.Context, arg FindPendingOrganizationInvitationByEmailHashParams) (OrganizationInvitation, error) {
+	row := q.db.QueryRowContext(ctx, findPendingOrganizationInvitationByEmailHash, arg.OrganizationID, arg.EmailHash)
+	var i OrganizationInvitation
+	err := row.Scan(
+		&i.InvitationID,
+		&i.OrganizationID,
+		&i.Email,
+		&i.EmailHash,
+		&i.Role,
+		&i.TokenHash,
+		&i.Status,
+		&i.InvitedBy,
+		&i.ExpiresAt,
+		&i.SendCount,
+		&i.LastSentAt,
+		&i.AcceptedBy,
+		&i.AcceptedAt,
+		&i.RevokedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateOrganizationInvitation = `-- name: UpdateOrganizationInvitation :exec
+UPDATE organization_invitations
+SET token_hash = $2,
+    status = $3,
+    expires_at = $4,
+    send_count = $5,
+    last_sent_at = $6,
+    accepted_by = $7,
+    accepted_at = $8,
+    revoked_at = $9,
+    updated_at = $10
+WHERE invitation_id = $1
+`
+
+type UpdateOrganizationInvitationParams struct {
+	InvitationID uuid.UUID
+	TokenHash    string
+	Status       string
+	ExpiresAt    time.Time
+	SendCount    int32
+	LastSentAt   time.Time
+	AcceptedBy   uuid.NullUUID
+	AcceptedAt   sql.NullTime
+	RevokedAt    sql.NullTime
+	UpdatedAt    time.Time
+}
+
+// UpdateOrganizationInvitation
+//
+//	UPDATE organization_invitations
+//	SET token_hash = $2,
+//	    status = $3,
+//	    expires_at = $4,
+//	    send_count = $5,
+//	    last_sent_at = $6,
+//	    accepted_by = $7,
+//	    accepted_at = $8,
+//	    revoked_at = $9,
+//	    updated_at = $10
+//	WHERE invitation_id = $1
+func (q *Queries) UpdateOrganizationInvitation(ctx context.Context, arg UpdateOrganizationInvitationParams) error {
+	_, err := q.db.ExecContext(ctx, updateOrganizationInvitation,
+		arg.InvitationID,
+		arg.TokenHash,
+		arg.Status,
+		arg.ExpiresAt,
+		arg.SendCount,
+		arg.LastSentAt,
+		arg.AcceptedBy,
+		arg.AcceptedAt,
+		arg.RevokedAt,
+		arg.UpdatedAt,
+	)
+	return err
+}
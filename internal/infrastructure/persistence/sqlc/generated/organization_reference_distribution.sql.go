@@ -0,0 +1,139 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: organization_reference_distribution.sql
+
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const findOrganizationReferenceDistribution = `-- name: FindOrganizationReferenceDistribution :one
+SELECT organization_id, dimension, categories, updated_by, updated_at FROM organization_reference_distributions
+WHERE organization_id = $1 AND dimension = $2
+`
+
+type FindOrganizationReferenceDistributionParams struct {
+	OrganizationID uuid.UUID
+	Dimension      string
+}
+
+// FindOrganizationReferenceDistribution
+//
+//	SELECT organization_id, dimension, categories, updated_by, updated_at FROM organization_reference_distributions
+//	WHERE organization_id = $1 AND dimension = $2
+func (q *Queries) FindOrganizationReferenceDistribution(ctx context.Context, arg FindOrganizationReferenceDistributionParams) (OrganizationReferenceDistribution, error) {
+	row := q.db.QueryRowContext(ctx, findOrganizationReferenceDistribution, arg.OrganizationID, arg.Dimension)
+	var i OrganizationReferenceDistribution
+	err := row.Scan(
+		&i.OrganizationID,
+		&i.Dimension,
+		&i.Categories,
+		&i.UpdatedBy,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const findOrganizationReferenceDistributions = `-- name: FindOrganizationReferenceDistributions :many
+SELECT organization_id, dimension, categories, updated_by, updated_at FROM organization_reference_distributions
+WHERE organization_id = $1
+ORDER BY dimension
+`
+
+// FindOrganizationReferenceDistributions
+//
+//	SELECT organization_id, dimension, categories, updated_by, updated_at FROM organization_reference_distributions
+//	WHERE organization_id = $1
+//	ORDER BY dimension
+func (q *Queries) FindOrganizationReferenceDistributions(ctx context.Context, organizationID uuid.UUID) ([]OrganizationReferenceDistribution, error) {
+	rows, err := q.db.QueryContext(ctx, findOrganizationReferenceDistributions, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []OrganizationReferenceDistribution
+	for rows.Next() {
+		var i OrganizationReferenceDistribution
+		if err := rows.Scan(
+			&i.OrganizationID,
+			&i.Dimension,
+			&i.Categories,
+			&i.UpdatedBy,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const saveOrganizationReferenceDistribution = `-- name: SaveOrganizationReferenceDistribution :exec
+INSERT INTO organization_reference_distributions (
+    organization_id,
+    dimension,
+    categories,
+    updated_by,
+    updated_at
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5
+)
+ON CONFLICT (organization_id, dimension) DO UPDATE SET
+    categories = EXCLUDED.categories,
+    updated_by = EXCLUDED.updated_by,
+    updated_at = EXCLUDED.updated_at
+`
+
+type SaveOrganizationReferenceDistributionParams struct {
+	OrganizationID uuid.UUID
+	Dimension      string
+	Categories     json.RawMessage
+	UpdatedBy      uuid.NullUUID
+	UpdatedAt      time.Time
+}
+
+// SaveOrganizationReferenceDistribution
+//
+//	INSERT INTO organization_reference_distributions (
+//	    organization_id,
+//	    dimension,
+//	    categories,
+//	    updated_by,
+//	    updated_at
+//	) VALUES (
+//	    $1,
+//	    $2,
+//	    $3,
+//	    $4,
+//	    $5
+//	)
+//	ON CONFLICT (organization_id, dimension) DO UPDATE SET
+//	    categories = EXCLUDED.categories,
+//	    updated_by = EXCLUDED.updated_by,
+//	    updated_at = EXCLUDED.updated_at
+func (q *Queries) SaveOrganizationReferenceDistribution(ctx context.Context, arg SaveOrganizationReferenceDistributionParams) error {
+	_, err := q.db.ExecContext(ctx, saveOrganizationReferenceDistribution,
+		arg.OrganizationID,
+		arg.Dimension,
+		arg.Categories,
+		arg.UpdatedBy,
+		arg.UpdatedAt,
+	)
+	return err
+}
@@ -0,0 +1,95 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: organization_report_prompt.sql
+
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const findOrganizationReportPrompt = `-- name: FindOrganizationReportPrompt :one
+SELECT organization_id, system_prompt, instructions, updated_by, updated_at FROM organization_report_prompts
+WHERE organization_id = $1
+`
+
+// FindOrganizationReportPrompt
+//
+//	SELECT organization_id, system_prompt, instructions, updated_by, updated_at FROM organization_report_prompts
+//	WHERE organization_id = $1
+func (q *Queries) FindOrganizationReportPrompt(ctx context.Context, organizationID uuid.UUID) (OrganizationReportPrompt, error) {
+	row := q.db.QueryRowContext(ctx, findOrganizationReportPrompt, organizationID)
+	var i OrganizationReportPrompt
+	err := row.Scan(
+		&i.OrganizationID,
+		&i.SystemPrompt,
+		&i.Instructions,
+		&i.UpdatedBy,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const saveOrganizationReportPrompt = `-- name: SaveOrganizationReportPrompt :exec
+INSERT INTO organization_report_prompts (
+    organization_id,
+    system_prompt,
+    instructions,
+    updated_by,
+    updated_at
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5
+)
+ON CONFLICT (organization_id) DO UPDATE SET
+    system_prompt = EXCLUDED.system_prompt,
+    instructions = EXCLUDED.instructions,
+    updated_by = EXCLUDED.updated_by,
+    updated_at = EXCLUDED.updated_at
+`
+
+type SaveOrganizationReportPromptParams struct {
+	OrganizationID uuid.UUID
+	SystemPrompt   string
+	Instructions   string
+	UpdatedBy      uuid.NullUUID
+	UpdatedAt      time.Time
+}
+
+// SaveOrganizationReportPrompt
+//
+//	INSERT INTO organization_report_prompts (
+//	    organization_id,
+//	    system_prompt,
+//	    instructions,
+//	    updated_by,
+//	    updated_at
+//	) VALUES (
+//	    $1,
+//	    $2,
+//	    $3,
+//	    $4,
+//	    $5
+//	)
+//	ON CONFLICT (organization_id) DO UPDATE SET
+//	    system_prompt = EXCLUDED.system_prompt,
+//	    instructions = EXCLUDED.instructions,
+//	    updated_by = EXCLUDED.updated_by,
+//	    updated_at = EXCLUDED.updated_at
+func (q *Queries) SaveOrganizationReportPrompt(ctx context.Context, arg SaveOrganizationReportPromptParams) error {
+	_, err := q.db.ExecContext(ctx, saveOrganizationReportPrompt,
+		arg.OrganizationID,
+		arg.SystemPrompt,
+		arg.Instructions,
+		arg.UpdatedBy,
+		arg.UpdatedAt,
+	)
+	return err
+}
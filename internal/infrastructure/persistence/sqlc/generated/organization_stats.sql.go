@@ -0,0 +1,236 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: organization_stats.sql
+
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const getOrganizationStatsBuckets = `-- name: GetOrganizationStatsBuckets :many
+WITH stats AS (
+    SELECT
+        date_trunc($1::text, organization_daily_stats.stat_date)::date AS bucket_date,
+        SUM(organization_daily_stats.talk_sessions_created)::bigint AS talk_sessions_created,
+        SUM(organization_daily_stats.opinions)::bigint AS opinions,
+        SUM(organization_daily_stats.votes)::bigint AS votes,
+        SUM(organization_daily_stats.consents)::bigint AS consents,
+        SUM(organization_daily_stats.report_feedbacks)::bigint AS report_feedbacks
+    FROM organization_daily_stats
+    WHERE organization_daily_stats.organization_id = ANY($2::uuid[])
+        AND organization_daily_stats.stat_date >= $3::date
+        AND organization_daily_stats.stat_date < $4::date
+    GROUP BY 1
+), participants AS (
+    SELECT
+        date_trunc($1::text, organization_daily_participants.stat_date)::date AS bucket_date,
+        COUNT(DISTINCT organization_daily_participants.user_id)::bigint AS participants
+    FROM organization_daily_participants
+    WHERE organization_daily_participants.organization_id = ANY($2::uuid[])
+        AND organization_daily_participants.stat_date >= $3::date
+        AND organization_daily_participants.stat_date < $4::date
+    GROUP BY 1
+)
+SELECT
+    COALESCE(stats.bucket_date, participants.bucket_date)::date AS bucket_date,
+    COALESCE(stats.talk_sessions_created, 0)::bigint AS talk_sessions_created,
+    COALESCE(participants.participants, 0)::bigint AS participants,
+    COALESCE(stats.opinions, 0)::bigint AS opinions,
+    COALESCE(stats.votes, 0)::bigint AS votes,
+    COALESCE(stats.consents, 0)::bigint AS consents,
+    COALESCE(stats.report_feedbacks, 0)::bigint AS report_feedbacks
+FROM stats
+FULL OUTER JOIN participants ON participants.bucket_date = stats.bucket_date
+ORDER BY 1 ASC
+`
+
+type GetOrganizationStatsBucketsParams struct {
+	Bucket          string
+	OrganizationIds []uuid.UUID
+	Since           time.Time
+	Until           time.Time
+}
+
+type GetOrganizationStatsBucketsRow struct {
+	BucketDate          time.Time
+	TalkSessionsCreated int64
+	Participants        int64
+	Opinions            int64
+	Votes               int64
+	Consents            int64
+	ReportFeedbacks     int64
+}
+
+// 期間内の集計を日・週・月単位で返す。参加者数は単位ごとに重複を除いて数える
+//
+//	WITH stats AS (
+//	    SELECT
+//	        date_trunc($1::text, organization_daily_stats.stat_date)::date AS bucket_date,
+//	        SUM(organization_daily_stats.talk_sessions_created)::bigint AS talk_sessions_created,
+//	        SUM(organization_daily_stats.opinions)::bigint AS opinions,
+//	        SUM(organization_daily_stats.votes)::bigint AS votes,
+//	        SUM(organization_daily_stats.consents)::bigint AS consents,
+//	        SUM(organization_daily_stats.report_feedbacks)::bigint AS report_feedbacks
+//	    FROM organization_daily_stats
+//	    WHERE organization_daily_stats.organization_id = ANY($2::uuid[])
+//	        AND organization_daily_stats.stat_date >= $3::date
+//	        AND organization_daily_stats.stat_date < $4::date
+//	    GROUP BY 1
+//	), participants AS (
+//	    SELECT
+//	        date_trunc($1::text, organization_daily_participants.stat_date)::date AS bucket_date,
+//	        COUNT(DISTINCT organization_daily_participants.user_id)::bigint AS participants
+//	    FROM organization_daily_participants
+//	    WHERE organization_daily_participants.organization_id = ANY($2::uuid[])
+//	        AND organization_daily_participants.stat_date >= $3::date
+//	        AND organization_daily_participants.stat_date < $4::date
+//	    GROUP BY 1
+//	)
+//	SELECT
+//	    COALESCE(stats.bucket_date, participants.bucket_date)::date AS bucket_date,
+//	    COALESCE(stats.talk_sessions_created, 0)::bigint AS talk_sessions_created,
+//	    COALESCE(participants.participants, 0)::bigint AS participants,
+//	    COALESCE(stats.opinions, 0)::bigint AS opinions,
+//	    COALESCE(stats.votes, 0)::bigint AS votes,
+//	    COALESCE(stats.consents, 0)::bigint AS consents,
+//	    COALESCE(stats.report_feedbacks, 0)::bigint AS report_feedbacks
+//	FROM stats
+//	FULL OUTER JOIN participants ON participants.bucket_date = stats.bucket_date
+//	ORDER BY 1 ASC
+func (q *Queries) GetOrganizationStatsBuckets(ctx context.Context, arg GetOrganizationStatsBucketsParams) ([]GetOrganizationStatsBucketsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getOrganizationStatsBuckets,
+		arg.Bucket,
+		pq.Array(arg.OrganizationIds),
+		arg.Since,
+		arg.Until,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetOrganizationStatsBucketsRow
+	for rows.Next() {
+		var i GetOrganizationStatsBucketsRow
+		if err := rows.Scan(
+			&i.BucketDate,
+			&i.TalkSessionsCreated,
+			&i.Participants,
+			&i.Opinions,
+			&i.Votes,
+			&i.Consents,
+			&i.ReportFeedbacks,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOrganizationStatsTotal = `-- name: GetOrganizationStatsTotal :one
+SELECT
+    COALESCE((
+        SELECT SUM(talk_sessions_created) FROM organization_daily_stats
+        WHERE organization_id = ANY($1::uuid[])
+            AND stat_date >= $2::date AND stat_date < $3::date
+    ), 0)::bigint AS talk_sessions_created,
+    (
+        SELECT COUNT(DISTINCT user_id) FROM organization_daily_participants
+        WHERE organization_id = ANY($1::uuid[])
+            AND stat_date >= $2::date AND stat_date < $3::date
+    )::bigint AS participants,
+    COALESCE((
+        SELECT SUM(opinions) FROM organization_daily_stats
+        WHERE organization_id = ANY($1::uuid[])
+            AND stat_date >= $2::date AND stat_date < $3::date
+    ), 0)::bigint AS opinions,
+    COALESCE((
+        SELECT SUM(votes) FROM organization_daily_stats
+        WHERE organization_id = ANY($1::uuid[])
+            AND stat_date >= $2::date AND stat_date < $3::date
+    ), 0)::bigint AS votes,
+    COALESCE((
+        SELECT SUM(consents) FROM organization_daily_stats
+        WHERE organization_id = ANY($1::uuid[])
+            AND stat_date >= $2::date AND stat_date < $3::date
+    ), 0)::bigint AS consents,
+    COALESCE((
+        SELECT SUM(report_feedbacks) FROM organization_daily_stats
+        WHERE organization_id = ANY($1::uuid[])
+            AND stat_date >= $2::date AND stat_date < $3::date
+    ), 0)::bigint AS report_feedbacks
+`
+
+type GetOrganizationStatsTotalParams struct {
+	OrganizationIds []uuid.UUID
+	Since           time.Time
+	Until           time.Time
+}
+
+type GetOrganizationStatsTotalRow struct {
+	TalkSessionsCreated int64
+	Participants        int64
+	Opinions            int64
+	Votes               int64
+	Consents            int64
+	ReportFeedbacks     int64
+}
+
+// 期間全体の集計を返す。参加者数は期間全体で重複を除いて数える
+//
+//	SELECT
+//	    COALESCE((
+//	        SELECT SUM(talk_sessions_created) FROM organization_daily_stats
+//	        WHERE organization_id = ANY($1::uuid[])
+//	            AND stat_date >= $2::date AND stat_date < $3::date
+//	    ), 0)::bigint AS talk_sessions_created,
+//	    (
+//	        SELECT COUNT(DISTINCT user_id) FROM organization_daily_participants
+//	        WHERE organization_id = ANY($1::uuid[])
+//	            AND stat_date >= $2::date AND stat_date < $3::date
+//	    )::bigint AS participants,
+//	    COALESCE((
+//	        SELECT SUM(opinions) FROM organization_daily_stats
+//	        WHERE organization_id = ANY($1::uuid[])
+//	            AND stat_date >= $2::date AND stat_date < $3::date
+//	    ), 0)::bigint AS opinions,
+//	    COALESCE((
+//	        SELECT SUM(votes) FROM organization_daily_stats
+//	        WHERE organization_id = ANY($1::uuid[])
+//	            AND stat_date >= $2::date AND stat_date < $3::date
+//	    ), 0)::bigint AS votes,
+//	    COALESCE((
+//	        SELECT SUM(consents) FROM organization_daily_stats
+//	        WHERE organization_id = ANY($1::uuid[])
+//	            AND stat_date >= $2::date AND stat_date < $3::date
+//	    ), 0)::bigint AS consents,
+//	    COALESCE((
+//	        SELECT SUM(report_feedbacks) FROM organization_daily_stats
+//	        WHERE organization_id = ANY($1::uuid[])
+//	            AND stat_date >= $2::date AND stat_date < $3::date
+//	    ), 0)::bigint AS report_feedbacks
+func (q *Queries) GetOrganizationStatsTotal(ctx context.Context, arg GetOrganizationStatsTotalParams) (GetOrganizationStatsTotalRow, error) {
+	row := q.db.QueryRowContext(ctx, getOrganizationStatsTotal, pq.Array(arg.OrganizationIds), arg.Since, arg.Until)
+	var i GetOrganizationStatsTotalRow
+	err := row.Scan(
+		&i.TalkSessionsCreated,
+		&i.Participants,
+		&i.Opinions,
+		&i.Votes,
+		&i.Consents,
+		&i.ReportFeedbacks,
+	)
+	return i, err
+}
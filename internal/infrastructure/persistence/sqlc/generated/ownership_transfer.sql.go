@@ -0,0 +1,382 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: ownership_transfer.sql
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const createOwnershipTransfer = `-- name: CreateOwnershipTransfer :exec
+INSERT INTO ownership_transfers (
+    transfer_id,
+    target_type,
+    talk_session_id,
+    organization_id,
+    from_user_id,
+    to_user_id,
+    requested_by,
+    status,
+    expires_at,
+    created_at,
+    updated_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $10)
+`
+
+type CreateOwnershipTransferParams struct {
+	TransferID     uuid.UUID
+	TargetType     string
+	TalkSessionID  uuid.NullUUID
+	OrganizationID uuid.NullUUID
+	FromUserID     uuid.UUID
+	ToUserID       uuid.UUID
+	RequestedBy    uuid.UUID
+	Status         string
+	ExpiresAt      time.Time
+	CreatedAt      time.Time
+}
+
+// CreateOwnershipTransfer
+//
+//	INSERT INTO ownership_transfers (
+//	    transfer_id,
+//	    target_type,
+//	    talk_session_id,
+//	    organization_id,
+//	    from_user_id,
+//	    to_user_id,
+//	    requested_by,
+//	    status,
+//	    expires_at,
+//	    created_at,
+//	    updated_at
+//	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $10)
+func (q *Queries) CreateOwnershipTransfer(ctx context.Context, arg CreateOwnershipTransferParams) error {
+	_, err := q.db.ExecContext(ctx, createOwnershipTransfer,
+		arg.TransferID,
+		arg.TargetType,
+		arg.TalkSessionID,
+		arg.OrganizationID,
+		arg.FromUserID,
+		arg.ToUserID,
+		arg.RequestedBy,
+		arg.Status,
+		arg.ExpiresAt,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const findOwnershipTransferByID = `-- name: FindOwnershipTransferByID :one
+SELECT transfer_id, target_type, talk_session_id, organization_id, from_user_id, to_user_id, requested_by, status, expires_at, responded_at, created_at, updated_at
+FROM ownership_transfers
+WHERE transfer_id = $1
+`
+
+// FindOwnershipTransferByID
+//
+//	SELECT transfer_id, target_type, talk_session_id, organization_id, from_user_id, to_user_id, requested_by, status, expires_at, responded_at, created_at, updated_at
+//	FROM ownership_transfers
+//	WHERE transfer_id = $1
+func (q *Queries) FindOwnershipTransferByID(ctx context.Context, transferID uuid.UUID) (OwnershipTransfer, error) {
+	row := q.db.QueryRowContext(ctx, findOwnershipTransferByID, transferID)
+	var i OwnershipTransfer
+	err := row.Scan(
+		&i.TransferID,
+		&i.TargetType,
+		&i.TalkSessionID,
+		&i.OrganizationID,
+		&i.FromUserID,
+		&i.ToUserID,
+		&i.RequestedBy,
+		&i.Status,
+		&i.ExpiresAt,
+		&i.RespondedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const findOwnershipTransferDetails = `-- name: FindOwnershipTransferDetails :many
+SELECT
+    ot.transfer_id, ot.target_type, ot.talk_session_id, ot.organization_id, ot.from_user_id, ot.to_user_id, ot.requested_by, ot.status, ot.expires_at, ot.responded_at, ot.created_at, ot.updated_at,
+    ts.theme AS talk_session_theme,
+    o.name AS organization_name,
+    o.code AS organization_code,
+    fu.display_id AS from_display_id,
+    fu.display_name AS from_display_name,
+    fu.icon_url AS from_icon_url,
+    tu.display_id AS to_display_id,
+    tu.display_name AS to_display_name,
+    tu.icon_url AS to_icon_url
+FROM ownership_transfers ot
+LEFT JOIN talk_sessions ts ON ot.talk_session_id = ts.talk_session_id
+LEFT JOIN organizations o ON ot.organization_id = o.organization_id
+JOIN users fu ON ot.from_user_id = fu.user_id
+JOIN users tu ON ot.to_user_id = tu.user_id
+WHERE
+    CASE WHEN $1::uuid IS NOT NULL
+        THEN ot.transfer_id = $1::uuid
+        ELSE TRUE
+    END
+    AND CASE WHEN $2::uuid IS NOT NULL
+        THEN $2::uuid IN (ot.from_user_id, ot.to_user_id, ot.requested_by)
+        ELSE TRUE
+    END
+ORDER BY ot.created_at DESC
+LIMIT 100
+`
+
+type FindOwnershipTransferDetailsParams struct {
+	TransferID uuid.NullUUID
+	UserID     uuid.NullUUID
+}
+
+type FindOwnershipTransferDetailsRow struct {
+	OwnershipTransfer OwnershipTransfer
+	TalkSessionTheme  sql.NullString
+	OrganizationName  sql.NullString
+	OrganizationCode  sql.NullString
+	FromDisplayID     sql.NullString
+	FromDisplayName   sql.NullString
+	FromIconUrl       sql.NullString
+	ToDisplayID       sql.NullString
+	ToDisplayName     sql.NullString
+	ToIconUrl         sql.NullString
+}
+
+// 譲渡依頼に対象の名前と関係するユーザーの表示情報を付けて取得する
+//
+//	SELECT
+//	    ot.transfer_id, ot.target_type, ot.talk_session_id, ot.organization_id, ot.from_user_id, ot.to_user_id, ot.requested_by, ot.status, ot.expires_at, ot.responded_at, ot.created_at, ot.updated_at,
+//	    ts.theme AS talk_session_theme,
+//	    o.name AS organization_name,
+//	    o.code AS organization_code,
+//	    fu.display_id AS from_display_id,
+//	    fu.display_name AS from_display_name,
+//	    fu.icon_url AS from_icon_url,
+//	    tu.display_id AS to_display_id,
+//	    tu.display_name AS to_display_name,
+//	    tu.icon_url AS to_icon_url
+//	FROM ownership_transfers ot
+//	LEFT JOIN talk_sessions ts ON ot.talk_session_id = ts.talk_session_id
+//	LEFT JOIN organizations o ON ot.organization_id = o.organization_id
+//	JOIN users fu ON ot.from_user_id = fu.user_id
+//	JOIN users tu ON ot.to_user_id = tu.user_id
+//	WHERE
+//	    CASE WHEN $1::uuid IS NOT NULL
+//	        THEN ot.transfer_id = $1::uuid
+//	        ELSE TRUE
+//	    END
+//	    AND CASE WHEN $2::uuid IS NOT NULL
+//	        THEN $2::uuid IN (ot.from_user_id, ot.to_user_id, ot.requested_by)
+//	        ELSE TRUE
+//	    END
+//	ORDER BY ot.created_at DESC
+//	LIMIT 100
+func (q *Queries) FindOwnershipTransferDetails(ctx context.Context, arg FindOwnershipTransferDetailsParams) ([]FindOwnershipTransferDetailsRow, error) {
+	rows, err := q.db.QueryContext(ctx, findOwnershipTransferDetails, arg.TransferID, arg.UserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FindOwnershipTransferDetailsRow
+	for rows.Next() {
+		var i FindOwnershipTransferDetailsRow
+		if err := rows.Scan(
+			&i.OwnershipTransfer.TransferID,
+			&i.OwnershipTransfer.TargetType,
+			&i.OwnershipTransfer.TalkSessionID,
+			&i.OwnershipTransfer.OrganizationID,
+			&i.OwnershipTransfer.FromUserID,
+			&i.OwnershipTransfer.ToUserID,
+			&i.OwnershipTransfer.RequestedBy,
+			&i.OwnershipTransfer.Status,
+			&i.OwnershipTransfer.ExpiresAt,
+			&i.OwnershipTransfer.RespondedAt,
+			&i.OwnershipTransfer.CreatedAt,
+			&i.OwnershipTransfer.UpdatedAt,
+			&i.TalkSessionTheme,
+			&i.OrganizationName,
+			&i.OrganizationCode,
+			&i.FromDisplayID,
+			&i.FromDisplayName,
+			&i.FromIconUrl,
+			&i.ToDisplayID,
+			&i.ToDisplayName,
+			&i.ToIconUrl,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const findPendingOwnershipTransferByOrganizationID = `-- name: FindPendingOwnershipTransferByOrganizationID :one
+SELECT transfer_id, target_type, talk_session_id, organization_id, from_user_id, to_user_id, requested_by, status, expires_at, responded_at, created_at, updated_at
+FROM ownership_transfers
+WHERE organization_id = $1
+  AND target_type = 'organization'
+  AND status = 'pending'
+  AND expires_at > $2
+ORDER BY created_at DESC
+LIMIT 1
+`
+
+type FindPendingOwnershipTransferByOrganizationIDParams struct {
+	OrganizationID uuid.NullUUID
+	ExpiresAt      time.Time
+}
+
+// FindPendingOwnershipTransferByOrganizationID
+//
+//	SELECT transfer_id, target_type, talk_session_id, organization_id, from_user_id, to_user_id, requested_by, status, expires_at, responded_at, created_at, updated_at
+//	FROM ownership_transfers
+//	WHERE organization_id = $1
+//	  AND target_type = 'organization'
+//	  AND status = 'pending'
+//	  AND expires_at > $2
+//	ORDER BY created_at DESC
+//	LIMIT 1
+func (q *Queries) FindPendingOwnershipTransferByOrganizationID(ctx context.Context, arg FindPendingOwnershipTransferByOrganizationIDParams) (OwnershipTransfer, error) {
+	row := q.db.QueryRowContext(ctx, findPendingOwnershipTransferByOrganizationID, arg.OrganizationID, arg.ExpiresAt)
+	var i OwnershipTransfer
+	err := row.Scan(
+		&i.TransferID,
+		&i.TargetType,
+		&i.TalkSessionID,
+		&i.OrganizationID,
+		&i.FromUserID,
+		&i.ToUserID,
+		&i.RequestedBy,
+		&i.Status,
+		&i.ExpiresAt,
+		&i.RespondedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const findPendingOwnershipTransferByTalkSessionID = `-- name: FindPendingOwnershipTransferByTalkSessionID :one
+SELECT transfer_id, target_type, talk_session_id, organization_id, from_user_id, to_user_id, requested_by, status, expires_at, responded_at, created_at, updated_at
+FROM ownership_transfers
+WHERE talk_session_id = $1
+  AND status = 'pending'
+  AND expires_at > $2
+ORDER BY created_at DESC
+LIMIT 1
+`
+
+type FindPendingOwnershipTransferByTalkSessionIDParams struct {
+	TalkSessionID uuid.NullUUID
+	ExpiresAt     time.Time
+}
+
+// FindPendingOwnershipTransferByTalkSessionID
+//
+//	SELECT transfer_id, target_type, talk_session_id, organization_id, from_user_id, to_user_id, requested_by, status, expires_at, responded_at, created_at, updated_at
+//	FROM ownership_transfers
+//	WHERE talk_session_id = $1
+//	  AND status = 'pending'
+//	  AND expires_at > $2
+//	ORDER BY created_at DESC
+//	LIMIT 1
+func (q *Queries) FindPendingOwnershipTransferByTalkSessionID(ctx context.Context, arg FindPendingOwnershipTransferByTalkSessionIDParams) (OwnershipTransfer, error) {
+	row := q.db.QueryRowContext(ctx, findPendingOwnershipTransferByTalkSessionID, arg.TalkSessionID, arg.ExpiresAt)
+	var i OwnershipTransfer
+	err := row.Scan(
+		&i.TransferID,
+		&i.TargetType,
+		&i.TalkSessionID,
+		&i.OrganizationID,
+		&i.FromUserID,
+		&i.ToUserID,
+		&i.RequestedBy,
+		&i.Status,
+		&i.ExpiresAt,
+		&i.RespondedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const lockOwnershipTransferByID = `-- name: LockOwnershipTransferByID :one
+SELECT transfer_id, target_type, talk_session_id, organization_id, from_user_id, to_user_id, requested_by, status, expires_at, responded_at, created_at, updated_at
+FROM ownership_transfers
+WHERE transfer_id = $1
+FOR UPDATE
+`
+
+// LockOwnershipTransferByID
+//
+//	SELECT transfer_id, target_type, talk_session_id, organization_id, from_user_id, to_user_id, requested_by, status, expires_at, responded_at, created_at, updated_at
+//	FROM ownership_transfers
+//	WHERE transfer_id = $1
+//	FOR UPDATE
+func (q *Queries) LockOwnershipTransferByID(ctx context.Context, transferID uuid.UUID) (OwnershipTransfer, error) {
+	row := q.db.QueryRowContext(ctx, lockOwnershipTransferByID, transferID)
+	var i OwnershipTransfer
+	err := row.Scan(
+		&i.TransferID,
+		&i.TargetType,
+		&i.TalkSessionID,
+		&i.OrganizationID,
+		&i.FromUserID,
+		&i.ToUserID,
+		&i.RequestedBy,
+		&i.Status,
+		&i.ExpiresAt,
+		&i.RespondedAt,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const updateOwnershipTransfer = `-- name: UpdateOwnershipTransfer :exec
+UPDATE ownership_transfers
+SET status = $2,
+    responded_at = $3,
+    updated_at = $4
+WHERE transfer_id = $1
+`
+
+type UpdateOwnershipTransferParams struct {
+	TransferID  uuid.UUID
+	Status      string
+	RespondedAt sql.NullTime
+	UpdatedAt   time.Time
+}
+
+// UpdateOwnershipTransfer
+//
+//	UPDATE ownership_transfers
+//	SET status = $2,
+//	    responded_at = $3,
+//	    updated_at = $4
+//	WHERE transfer_id = $1
+func (q *Queries) UpdateOwnershipTransfer(ctx context.Context, arg UpdateOwnershipTransferParams) error {
+	_, err := q.db.ExecContext(ctx, updateOwnershipTransfer,
+		arg.TransferID,
+		arg.Status,
+		arg.RespondedAt,
+		arg.UpdatedAt,
+	)
+	return err
+}
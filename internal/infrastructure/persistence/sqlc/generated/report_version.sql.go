@@ -0,0 +1,437 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: report_version.sql
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sqlc-dev/pqtype"
+)
+
+const claimReportNotification = `-- name: ClaimReportNotification :execrows
+UPDATE talk_session_report_publications
+SET notified_at = $1::timestamp
+WHERE talk_session_id = $2
+    AND (notified_at IS NULL OR notified_at <= $3::timestamp)
+`
+
+type ClaimReportNotificationParams struct {
+	NotifiedAt     time.Time
+	TalkSessionID  uuid.UUID
+	NotifiedBefore time.Time
+}
+
+// 前回の通知から間隔が空いている場合のみ通知日時を記録する。更新した行がなければ通知しない
+//
+//	UPDATE talk_session_report_publications
+//	SET notified_at = $1::timestamp
+//	WHERE talk_session_id = $2
+//	    AND (notified_at IS NULL OR notified_at <= $3::timestamp)
+func (q *Queries) ClaimReportNotification(ctx context.Context, arg ClaimReportNotificationParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, claimReportNotification, arg.NotifiedAt, arg.TalkSessionID, arg.NotifiedBefore)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const createReportVersion = `-- name: CreateReportVersion :exec
+INSERT INTO talk_session_report_histories (
+    talk_session_report_history_id,
+    talk_session_id,
+    version,
+    report,
+    generator,
+    model,
+    sections,
+    created_at
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8
+)
+`
+
+type CreateReportVersionParams struct {
+	TalkSessionReportHistoryID uuid.UUID
+	TalkSessionID              uuid.UUID
+	Version                    int32
+	Report                     string
+	Generator                  string
+	Model                      sql.NullString
+	Sections                   pqtype.NullRawMessage
+	CreatedAt                  time.Time
+}
+
+// CreateReportVersion
+//
+//	INSERT INTO talk_session_report_histories (
+//	    talk_session_report_history_id,
+//	    talk_session_id,
+//	    version,
+//	    report,
+//	    generator,
+//	    model,
+//	    sections,
+//	    created_at
+//	) VALUES (
+//	    $1,
+//	    $2,
+//	    $3,
+//	    $4,
+//	    $5,
+//	    $6,
+//	    $7,
+//	    $8
+//	)
+func (q *Queries) CreateReportVersion(ctx context.Context, arg CreateReportVersionParams) error {
+	_, err := q.db.ExecContext(ctx, createReportVersion,
+		arg.TalkSessionReportHistoryID,
+		arg.TalkSessionID,
+		arg.Version,
+		arg.Report,
+		arg.Generator,
+		arg.Model,
+		arg.Sections,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const findLatestReportVersion = `-- name: FindLatestReportVersion :one
+SELECT talk_session_report_history_id, talk_session_id, report, created_at, version, generator, model, sections FROM talk_session_report_histories
+WHERE talk_session_id = $1
+ORDER BY version DESC
+LIMIT 1
+`
+
+// FindLatestReportVersion
+//
+//	SELECT talk_session_report_history_id, talk_session_id, report, created_at, version, generator, model, sections FROM talk_session_report_histories
+//	WHERE talk_session_id = $1
+//	ORDER BY version DESC
+//	LIMIT 1
+func (q *Queries) FindLatestReportVersion(ctx context.Context, talkSessionID uuid.UUID) (TalkSessionReportHistory, error) {
+	row := q.db.QueryRowContext(ctx, findLatestReportVersion, talkSessionID)
+	var i TalkSessionReportHistory
+	err := row.Scan(
+		&i.TalkSessionReportHistoryID,
+		&i.TalkSessionID,
+		&i.Report,
+		&i.CreatedAt,
+		&i.Version,
+		&i.Generator,
+		&i.Model,
+		&i.Sections,
+	)
+	return i, err
+}
+
+const findReportPublication = `-- name: FindReportPublication :one
+SELECT talk_session_id, talk_session_report_history_id, pinned, published_by, published_at, notified_at FROM talk_session_report_publications
+WHERE talk_session_id = $1
+`
+
+// FindReportPublication
+//
+//	SELECT talk_session_id, talk_session_report_history_id, pinned, published_by, published_at, notified_at FROM talk_session_report_publications
+//	WHERE talk_session_id = $1
+func (q *Queries) FindReportPublication(ctx context.Context, talkSessionID uuid.UUID) (TalkSessionReportPublication, error) {
+	row := q.db.QueryRowContext(ctx, findReportPublication, talkSessionID)
+	var i TalkSessionReportPublication
+	err := row.Scan(
+		&i.TalkSessionID,
+		&i.TalkSessionReportHistoryID,
+		&i.Pinned,
+		&i.PublishedBy,
+		&i.PublishedAt,
+		&i.NotifiedAt,
+	)
+	return i, err
+}
+
+const findReportVersionByID = `-- name: FindReportVersionByID :one
+SELECT talk_session_report_history_id, talk_session_id, report, created_at, version, generator, model, sections FROM talk_session_report_histories
+WHERE talk_session_report_history_id = $1
+`
+
+// FindReportVersionByID
+//
+//	SELECT talk_session_report_history_id, talk_session_id, report, created_at, version, generator, model, sections FROM talk_session_report_histories
+//	WHERE talk_session_report_history_id = $1
+func (q *Queries) FindReportVersionByID(ctx context.Context, talkSessionReportHistoryID uuid.UUID) (TalkSessionReportHistory, error) {
+	row := q.db.QueryRowContext(ctx, findReportVersionByID, talkSessionReportHistoryID)
+	var i TalkSessionReportHistory
+	err := row.Scan(
+		&i.TalkSessionReportHistoryID,
+		&i.TalkSessionID,
+		&i.Report,
+		&i.CreatedAt,
+		&i.Version,
+		&i.Generator,
+		&i.Model,
+		&i.Sections,
+	)
+	return i, err
+}
+
+const getGeneratedReport = `-- name: GetGeneratedReport :one
+SELECT
+    talk_session_id,
+    report,
+    sections,
+    updated_at
+FROM talk_session_reports
+WHERE talk_session_id = $1
+`
+
+type GetGeneratedReportRow struct {
+	TalkSessionID uuid.UUID
+	Report        string
+	Sections      pqtype.NullRawMessage
+	UpdatedAt     time.Time
+}
+
+// 生成されたままのレポート。公開するバージョンに関係なく最後に生成されたものを返す
+//
+//	SELECT
+//	    talk_session_id,
+//	    report,
+//	    sections,
+//	    updated_at
+//	FROM talk_session_reports
+//	WHERE talk_session_id = $1
+func (q *Queries) GetGeneratedReport(ctx context.Context, talkSessionID uuid.UUID) (GetGeneratedReportRow, error) {
+	row := q.db.QueryRowContext(ctx, getGeneratedReport, talkSessionID)
+	var i GetGeneratedReportRow
+	err := row.Scan(
+		&i.TalkSessionID,
+		&i.Report,
+		&i.Sections,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getReportVersionsWithFeedback = `-- name: GetReportVersionsWithFeedback :many
+SELECT
+    talk_session_report_histories.talk_session_report_history_id,
+    talk_session_report_histories.version,
+    talk_session_report_histories.generator,
+    talk_session_report_histories.model,
+    talk_session_report_histories.created_at,
+    COALESCE(feedback.good_count, 0)::int AS good_count,
+    COALESCE(feedback.bad_count, 0)::int AS bad_count,
+    (talk_session_report_publications.talk_session_id IS NOT NULL)::boolean AS published,
+    COALESCE(talk_session_report_publications.pinned, FALSE)::boolean AS pinned
+FROM talk_session_report_histories
+LEFT JOIN (
+    SELECT
+        report_feedback.talk_session_report_history_id,
+        COUNT(*) FILTER (WHERE report_feedback.feedback_type = 1) AS good_count,
+        COUNT(*) FILTER (WHERE report_feedback.feedback_type = 2) AS bad_count
+    FROM report_feedback
+    GROUP BY report_feedback.talk_session_report_history_id
+) feedback ON feedback.talk_session_report_history_id = talk_session_report_histories.talk_session_report_history_id
+LEFT JOIN talk_session_report_publications
+    ON talk_session_report_publications.talk_session_report_history_id = talk_session_report_histories.talk_session_report_history_id
+WHERE talk_session_report_histories.talk_session_id = $1
+ORDER BY talk_session_report_histories.version DESC
+`
+
+type GetReportVersionsWithFeedbackRow struct {
+	TalkSessionReportHistoryID uuid.UUID
+	Version                    int32
+	Generator                  string
+	Model                      sql.NullString
+	CreatedAt                  time.Time
+	GoodCount                  int32
+	BadCount                   int32
+	Published                  bool
+	Pinned                     bool
+}
+
+// セッションのレポートのバージョンを新しい順に、フィードバックの件数とあわせて返す
+//
+//	SELECT
+//	    talk_session_report_histories.talk_session_report_history_id,
+//	    talk_session_report_histories.version,
+//	    talk_session_report_histories.generator,
+//	    talk_session_report_histories.model,
+//	    talk_session_report_histories.created_at,
+//	    COALESCE(feedback.good_count, 0)::int AS good_count,
+//	    COALESCE(feedback.bad_count, 0)::int AS bad_count,
+//	    (talk_session_report_publications.talk_session_id IS NOT NULL)::boolean AS published,
+//	    COALESCE(talk_session_report_publications.pinned, FALSE)::boolean AS pinned
+//	FROM talk_session_report_histories
+//	LEFT JOIN (
+//	    SELECT
+//	        report_feedback.talk_session_report_history_id,
+//	        COUNT(*) FILTER (WHERE report_feedback.feedback_type = 1) AS good_count,
+//	        COUNT(*) FILTER (WHERE report_feedback.feedback_type = 2) AS bad_count
+//	    FROM report_feedback
+//	    GROUP BY report_feedback.talk_session_report_history_id
+//	) feedback ON feedback.talk_session_report_history_id = talk_session_report_histories.talk_session_report_history_id
+//	LEFT JOIN talk_session_report_publications
+//	    ON talk_session_report_publications.talk_session_report_history_id = talk_session_report_histories.talk_session_report_history_id
+//	WHERE talk_session_report_histories.talk_session_id = $1
+//	ORDER BY talk_session_report_histories.version DESC
+func (q *Queries) GetReportVersionsWithFeedback(ctx context.Context, talkSessionID uuid.UUID) ([]GetReportVersionsWithFeedbackRow, error) {
+	rows, err := q.db.QueryContext(ctx, getReportVersionsWithFeedback, talkSessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetReportVersionsWithFeedbackRow
+	for rows.Next() {
+		var i GetReportVersionsWithFeedbackRow
+		if err := rows.Scan(
+			&i.TalkSessionReportHistoryID,
+			&i.Version,
+			&i.Generator,
+			&i.Model,
+			&i.CreatedAt,
+			&i.GoodCount,
+			&i.BadCount,
+			&i.Published,
+			&i.Pinned,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const saveGeneratedReport = `-- name: SaveGeneratedReport :exec
+INSERT INTO talk_session_reports (
+    talk_session_id,
+    report,
+    sections,
+    created_at,
+    updated_at
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $4
+)
+ON CONFLICT (talk_session_id) DO UPDATE SET
+    report = EXCLUDED.report,
+    sections = EXCLUDED.sections,
+    updated_at = EXCLUDED.updated_at
+`
+
+type SaveGeneratedReportParams struct {
+	TalkSessionID uuid.UUID
+	Report        string
+	Sections      pqtype.NullRawMessage
+	GeneratedAt   time.Time
+}
+
+// アプリケーション内で生成したレポートを保存する
+//
+//	INSERT INTO talk_session_reports (
+//	    talk_session_id,
+//	    report,
+//	    sections,
+//	    created_at,
+//	    updated_at
+//	) VALUES (
+//	    $1,
+//	    $2,
+//	    $3,
+//	    $4,
+//	    $4
+//	)
+//	ON CONFLICT (talk_session_id) DO UPDATE SET
+//	    report = EXCLUDED.report,
+//	    sections = EXCLUDED.sections,
+//	    updated_at = EXCLUDED.updated_at
+func (q *Queries) SaveGeneratedReport(ctx context.Context, arg SaveGeneratedReportParams) error {
+	_, err := q.db.ExecContext(ctx, saveGeneratedReport,
+		arg.TalkSessionID,
+		arg.Report,
+		arg.Sections,
+		arg.GeneratedAt,
+	)
+	return err
+}
+
+const saveReportPublication = `-- name: SaveReportPublication :exec
+INSERT INTO talk_session_report_publications (
+    talk_session_id,
+    talk_session_report_history_id,
+    pinned,
+    published_by,
+    published_at
+) VALUES (
+    $1,
+    $2,
+    $3,
+    $4,
+    $5
+)
+ON CONFLICT (talk_session_id) DO UPDATE SET
+    talk_session_report_history_id = EXCLUDED.talk_session_report_history_id,
+    pinned = EXCLUDED.pinned,
+    published_by = EXCLUDED.published_by,
+    published_at = EXCLUDED.published_at
+`
+
+type SaveReportPublicationParams struct {
+	TalkSessionID              uuid.UUID
+	TalkSessionReportHistoryID uuid.UUID
+	Pinned                     bool
+	PublishedBy                uuid.NullUUID
+	PublishedAt                time.Time
+}
+
+// SaveReportPublication
+//
+//	INSERT INTO talk_session_report_publications (
+//	    talk_session_id,
+//	    talk_session_report_history_id,
+//	    pinned,
+//	    published_by,
+//	    published_at
+//	) VALUES (
+//	    $1,
+//	    $2,
+//	    $3,
+//	    $4,
+//	    $5
+//	)
+//	ON CONFLICT (talk_session_id) DO UPDATE SET
+//	    talk_session_report_history_id = EXCLUDED.talk_session_report_history_id,
+//	    pinned = EXCLUDED.pinned,
+//	    published_by = EXCLUDED.published_by,
+//	    published_at = EXCLUDED.published_at
+func (q *Queries) SaveReportPublication(ctx context.Context, arg SaveReportPublicationParams) error {
+	_, err := q.db.ExecContext(ctx, saveReportPublication,
+		arg.TalkSessionID,
+		arg.TalkSessionReportHistoryID,
+		arg.Pinned,
+		arg.PublishedBy,
+		arg.PublishedAt,
+	)
+	return err
+}
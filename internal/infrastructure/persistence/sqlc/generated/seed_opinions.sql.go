@@ -13,7 +13,7 @@ import (
 
 const getSeedOpinions = `-- name: GetSeedOpinions :many
 SELECT
-    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url,
+    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url, opinions.revision, opinions.edited_at, opinions.deleted_at,
     users.user_id, users.display_id, users.display_name, users.icon_url, users.created_at, users.updated_at, users.email, users.email_verified, users.withdrawal_date,
     COALESCE(rc.reply_count, 0) AS reply_count
 FROM opinions
@@ -37,6 +37,7 @@ WHERE opinions.talk_session_id = $1
     AND vote_count.opinion_id = opinions.opinion_id
     AND opinions.parent_opinion_id IS NULL
     AND opinions.user_id = '00000000-0000-0000-0000-000000000001'::uuid
+    AND opinions.deleted_at IS NULL
 LIMIT $3
 `
 
@@ -57,7 +58,7 @@ type GetSeedOpinionsRow struct {
 // トークセッションに紐づく意見のみを取得
 //
 //	SELECT
-//	    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url,
+//	    opinions.opinion_id, opinions.talk_session_id, opinions.user_id, opinions.parent_opinion_id, opinions.title, opinions.content, opinions.created_at, opinions.picture_url, opinions.reference_url, opinions.revision, opinions.edited_at, opinions.deleted_at,
 //	    users.user_id, users.display_id, users.display_name, users.icon_url, users.created_at, users.updated_at, users.email, users.email_verified, users.withdrawal_date,
 //	    COALESCE(rc.reply_count, 0) AS reply_count
 //	FROM opinions
@@ -81,6 +82,7 @@ type GetSeedOpinionsRow struct {
 //	    AND vote_count.opinion_id = opinions.opinion_id
 //	    AND opinions.parent_opinion_id IS NULL
 //	    AND opinions.user_id = '00000000-0000-0000-0000-000000000001'::uuid
+//	    AND opinions.deleted_at IS NULL
 //	LIMIT $3
 func (q *Queries) GetSeedOpinions(ctx context.Context, arg GetSeedOpinionsParams) ([]GetSeedOpinionsRow, error) {
 	rows, err := q.db.QueryContext(ctx, getSeedOpinions, arg.TalkSessionID, arg.UserID, arg.Limit)
@@ -101,6 +103,9 @@ func (q *Queries) GetSeedOpinions(ctx context.Context, arg GetSeedOpinionsParams
 			&i.Opinion.CreatedAt,
 			&i.Opinion.PictureUrl,
 			&i.Opinion.ReferenceUrl,
+			&i.Opinion.Revision,
+			&i.Opinion.EditedAt,
+			&i.Opinion.DeletedAt,
 			&i.User.UserID,
 			&i.User.DisplayID,
 			&i.User.DisplayName,
@@ -124,3 +129,58 @@ func (q *Queries) GetSeedOpinions(ctx context.Context, arg GetSeedOpinionsParams
 	}
 	return items, nil
 }
+
+const getSeedOpinionsByTalkSessionID = `-- name: GetSeedOpinionsByTalkSessionID :many
+SELECT opinion_id, talk_session_id, user_id, parent_opinion_id, title, content, created_at, picture_url, reference_url, revision, edited_at, deleted_at
+FROM opinions
+WHERE opinions.talk_session_id = $1
+    AND opinions.parent_opinion_id IS NULL
+    AND opinions.user_id = '00000000-0000-0000-0000-000000000001'::uuid
+    AND opinions.deleted_at IS NULL
+ORDER BY opinions.created_at ASC
+`
+
+// セッションのシード意見を投稿順に取得する
+//
+//	SELECT opinion_id, talk_session_id, user_id, parent_opinion_id, title, content, created_at, picture_url, reference_url, revision, edited_at, deleted_at
+//	FROM opinions
+//	WHERE opinions.talk_session_id = $1
+//	    AND opinions.parent_opinion_id IS NULL
+//	    AND opinions.user_id = '00000000-0000-0000-0000-000000000001'::uuid
+//	    AND opinions.deleted_at IS NULL
+//	ORDER BY opinions.created_at ASC
+func (q *Queries) GetSeedOpinionsByTalkSessionID(ctx context.Context, talkSessionID uuid.UUID) ([]Opinion, error) {
+	rows, err := q.db.QueryContext(ctx, getSeedOpinionsByTalkSessionID, talkSessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Opinion
+	for rows.Next() {
+		var i Opinion
+		if err := rows.Scan(
+			&i.OpinionID,
+			&i.TalkSessionID,
+			&i.UserID,
+			&i.ParentOpinionID,
+			&i.Title,
+			&i.Content,
+			&i.CreatedAt,
+			&i.PictureUrl,
+			&i.ReferenceUrl,
+			&i.Revision,
+			&i.EditedAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
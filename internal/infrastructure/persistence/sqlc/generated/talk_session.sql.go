@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/neko-dream/api/internal/domain/model/talksession"
 )
 
@@ -25,6 +26,7 @@ LEFT JOIN votes
     ON votes.talk_session_id = talk_sessions.talk_session_id
 WHERE
     talk_sessions.hide_top = FALSE AND
+    talk_sessions.is_draft = FALSE AND
     CASE
         WHEN $2::uuid IS NOT NULL
             THEN votes.user_id = $2::uuid
@@ -67,6 +69,7 @@ type CountTalkSessionsRow struct {
 //	    ON votes.talk_session_id = talk_sessions.talk_session_id
 //	WHERE
 //	    talk_sessions.hide_top = FALSE AND
+//	    talk_sessions.is_draft = FALSE AND
 //	    CASE
 //	        WHEN $2::uuid IS NOT NULL
 //	            THEN votes.user_id = $2::uuid
@@ -92,7 +95,7 @@ func (q *Queries) CountTalkSessions(ctx context.Context, arg CountTalkSessionsPa
 }
 
 const createTalkSession = `-- name: CreateTalkSession :exec
-INSERT INTO talk_sessions (talk_session_id, theme, description, thumbnail_url, owner_id, scheduled_end_time, created_at, city, prefecture, restrictions, hide_report, organization_id, organization_alias_id, hide_top) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+INSERT INTO talk_sessions (talk_session_id, theme, description, thumbnail_url, owner_id, scheduled_end_time, created_at, city, prefecture, restrictions, hide_report, organization_id, organization_alias_id, hide_top, is_draft) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
 `
 
 type CreateTalkSessionParams struct {
@@ -110,11 +113,12 @@ type CreateTalkSessionParams struct {
 	OrganizationID      uuid.NullUUID
 	OrganizationAliasID uuid.NullUUID
 	HideTop             bool
+	IsDraft             bool
 }
 
 // CreateTalkSession
 //
-//	INSERT INTO talk_sessions (talk_session_id, theme, description, thumbnail_url, owner_id, scheduled_end_time, created_at, city, prefecture, restrictions, hide_report, organization_id, organization_alias_id, hide_top) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+//	INSERT INTO talk_sessions (talk_session_id, theme, description, thumbnail_url, owner_id, scheduled_end_time, created_at, city, prefecture, restrictions, hide_report, organization_id, organization_alias_id, hide_top, is_draft) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)
 func (q *Queries) CreateTalkSession(ctx context.Context, arg CreateTalkSessionParams) error {
 	_, err := q.db.ExecContext(ctx, createTalkSession,
 		arg.TalkSessionID,
@@ -131,6 +135,7 @@ func (q *Queries) CreateTalkSession(ctx context.Context, arg CreateTalkSessionPa
 		arg.OrganizationID,
 		arg.OrganizationAliasID,
 		arg.HideTop,
+		arg.IsDraft,
 	)
 	return err
 }
@@ -165,7 +170,9 @@ UPDATE talk_sessions
         updated_at = NOW(),
         organization_id = $10,
         organization_alias_id = $11,
-        hide_top = $12
+        hide_top = $12,
+        is_draft = $13,
+        owner_id = $14
     WHERE talk_session_id = $1
 `
 
@@ -182,6 +189,8 @@ type EditTalkSessionParams struct {
 	OrganizationID      uuid.NullUUID
 	OrganizationAliasID uuid.NullUUID
 	HideTop             bool
+	IsDraft             bool
+	OwnerID             uuid.UUID
 }
 
 // EditTalkSession
@@ -198,7 +207,9 @@ type EditTalkSessionParams struct {
 //	        updated_at = NOW(),
 //	        organization_id = $10,
 //	        organization_alias_id = $11,
-//	        hide_top = $12
+//	        hide_top = $12,
+//	        is_draft = $13,
+//	        owner_id = $14
 //	    WHERE talk_session_id = $1
 func (q *Queries) EditTalkSession(ctx context.Context, arg EditTalkSessionParams) error {
 	_, err := q.db.ExecContext(ctx, editTalkSession,
@@ -214,10 +225,55 @@ func (q *Queries) EditTalkSession(ctx context.Context, arg EditTalkSessionParams
 		arg.OrganizationID,
 		arg.OrganizationAliasID,
 		arg.HideTop,
+		arg.IsDraft,
+		arg.OwnerID,
 	)
 	return err
 }
 
+const findTalkSessionIDsByOrganizationIDAndOwnerID = `-- name: FindTalkSessionIDsByOrganizationIDAndOwnerID :many
+SELECT talk_session_id
+FROM talk_sessions
+WHERE organization_id = $1
+  AND owner_id = $2
+ORDER BY created_at ASC
+`
+
+type FindTalkSessionIDsByOrganizationIDAndOwnerIDParams struct {
+	OrganizationID uuid.NullUUID
+	OwnerID        uuid.UUID
+}
+
+// FindTalkSessionIDsByOrganizationIDAndOwnerID
+//
+//	SELECT talk_session_id
+//	FROM talk_sessions
+//	WHERE organization_id = $1
+//	  AND owner_id = $2
+//	ORDER BY created_at ASC
+func (q *Queries) FindTalkSessionIDsByOrganizationIDAndOwnerID(ctx context.Context, arg FindTalkSessionIDsByOrganizationIDAndOwnerIDParams) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, findTalkSessionIDsByOrganizationIDAndOwnerID, arg.OrganizationID, arg.OwnerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var talk_session_id uuid.UUID
+		if err := rows.Scan(&talk_session_id); err != nil {
+			return nil, err
+		}
+		items = append(items, talk_session_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getAllTalkSessionCount = `-- name: GetAllTalkSessionCount :one
 SELECT
     COUNT(DISTINCT talk_sessions.talk_session_id) AS talk_session_count
@@ -243,6 +299,7 @@ WITH filtered_sessions AS (
     LEFT JOIN users ON ts.owner_id = users.user_id
     WHERE
         users.display_id = $3::text
+        AND ts.is_draft = FALSE
         AND
         CASE $4::text
             WHEN 'finished' THEN ts.scheduled_end_time <= now()
@@ -257,7 +314,7 @@ WITH filtered_sessions AS (
         END
 )
 SELECT
-    ts.talk_session_id, ts.owner_id, ts.theme, ts.scheduled_end_time, ts.created_at, ts.city, ts.prefecture, ts.description, ts.thumbnail_url, ts.restrictions, ts.updated_at, ts.hide_report, ts.organization_id, ts.organization_alias_id, ts.hide_top,
+    ts.talk_session_id, ts.owner_id, ts.theme, ts.scheduled_end_time, ts.created_at, ts.city, ts.prefecture, ts.description, ts.thumbnail_url, ts.restrictions, ts.updated_at, ts.hide_report, ts.organization_id, ts.organization_alias_id, ts.hide_top, ts.is_draft,
     COALESCE(oc.opinion_count, 0) AS opinion_count,
     users.user_id, users.display_id, users.display_name, users.icon_url, users.created_at, users.updated_at, users.email, users.email_verified, users.withdrawal_date,
     COALESCE(organization_aliases.alias_name, '') AS alias_name,
@@ -308,6 +365,7 @@ type GetOwnTalkSessionByDisplayIDWithCountRow struct {
 //	    LEFT JOIN users ON ts.owner_id = users.user_id
 //	    WHERE
 //	        users.display_id = $3::text
+//	        AND ts.is_draft = FALSE
 //	        AND
 //	        CASE $4::text
 //	            WHEN 'finished' THEN ts.scheduled_end_time <= now()
@@ -322,7 +380,7 @@ type GetOwnTalkSessionByDisplayIDWithCountRow struct {
 //	        END
 //	)
 //	SELECT
-//	    ts.talk_session_id, ts.owner_id, ts.theme, ts.scheduled_end_time, ts.created_at, ts.city, ts.prefecture, ts.description, ts.thumbnail_url, ts.restrictions, ts.updated_at, ts.hide_report, ts.organization_id, ts.organization_alias_id, ts.hide_top,
+//	    ts.talk_session_id, ts.owner_id, ts.theme, ts.scheduled_end_time, ts.created_at, ts.city, ts.prefecture, ts.description, ts.thumbnail_url, ts.restrictions, ts.updated_at, ts.hide_report, ts.organization_id, ts.organization_alias_id, ts.hide_top, ts.is_draft,
 //	    COALESCE(oc.opinion_count, 0) AS opinion_count,
 //	    users.user_id, users.display_id, users.display_name, users.icon_url, users.created_at, users.updated_at, users.email, users.email_verified, users.withdrawal_date,
 //	    COALESCE(organization_aliases.alias_name, '') AS alias_name,
@@ -374,6 +432,7 @@ func (q *Queries) GetOwnTalkSessionByDisplayIDWithCount(ctx context.Context, arg
 			&i.TalkSession.OrganizationID,
 			&i.TalkSession.OrganizationAliasID,
 			&i.TalkSession.HideTop,
+			&i.TalkSession.IsDraft,
 			&i.OpinionCount,
 			&i.User.UserID,
 			&i.User.DisplayID,
@@ -406,7 +465,7 @@ func (q *Queries) GetOwnTalkSessionByDisplayIDWithCount(ctx context.Context, arg
 
 const getRespondTalkSessionByUserID = `-- name: GetRespondTalkSessionByUserID :many
 SELECT
-    ts.talk_session_id, ts.owner_id, ts.theme, ts.scheduled_end_time, ts.created_at, ts.city, ts.prefecture, ts.description, ts.thumbnail_url, ts.restrictions, ts.updated_at, ts.hide_report, ts.organization_id, ts.organization_alias_id, ts.hide_top,
+    ts.talk_session_id, ts.owner_id, ts.theme, ts.scheduled_end_time, ts.created_at, ts.city, ts.prefecture, ts.description, ts.thumbnail_url, ts.restrictions, ts.updated_at, ts.hide_report, ts.organization_id, ts.organization_alias_id, ts.hide_top, ts.is_draft,
     COALESCE(oc.opinion_count, 0) AS opinion_count,
     users.user_id, users.display_id, users.display_name, users.icon_url, users.created_at, users.updated_at, users.email, users.email_verified, users.withdrawal_date,
     COALESCE(organization_aliases.alias_name, '') AS alias_name,
@@ -473,7 +532,7 @@ type GetRespondTalkSessionByUserIDRow struct {
 // GetRespondTalkSessionByUserID
 //
 //	SELECT
-//	    ts.talk_session_id, ts.owner_id, ts.theme, ts.scheduled_end_time, ts.created_at, ts.city, ts.prefecture, ts.description, ts.thumbnail_url, ts.restrictions, ts.updated_at, ts.hide_report, ts.organization_id, ts.organization_alias_id, ts.hide_top,
+//	    ts.talk_session_id, ts.owner_id, ts.theme, ts.scheduled_end_time, ts.created_at, ts.city, ts.prefecture, ts.description, ts.thumbnail_url, ts.restrictions, ts.updated_at, ts.hide_report, ts.organization_id, ts.organization_alias_id, ts.hide_top, ts.is_draft,
 //	    COALESCE(oc.opinion_count, 0) AS opinion_count,
 //	    users.user_id, users.display_id, users.display_name, users.icon_url, users.created_at, users.updated_at, users.email, users.email_verified, users.withdrawal_date,
 //	    COALESCE(organization_aliases.alias_name, '') AS alias_name,
@@ -545,6 +604,7 @@ func (q *Queries) GetRespondTalkSessionByUserID(ctx context.Context, arg GetResp
 			&i.TalkSession.OrganizationID,
 			&i.TalkSession.OrganizationAliasID,
 			&i.TalkSession.HideTop,
+			&i.TalkSession.IsDraft,
 			&i.OpinionCount,
 			&i.User.UserID,
 			&i.User.DisplayID,
@@ -578,7 +638,7 @@ func (q *Queries) GetRespondTalkSessionByUserID(ctx context.Context, arg GetResp
 
 const getTalkSessionByID = `-- name: GetTalkSessionByID :one
 SELECT
-    ts.talk_session_id, ts.owner_id, ts.theme, ts.scheduled_end_time, ts.created_at, ts.city, ts.prefecture, ts.description, ts.thumbnail_url, ts.restrictions, ts.updated_at, ts.hide_report, ts.organization_id, ts.organization_alias_id, ts.hide_top,
+    ts.talk_session_id, ts.owner_id, ts.theme, ts.scheduled_end_time, ts.created_at, ts.city, ts.prefecture, ts.description, ts.thumbnail_url, ts.restrictions, ts.updated_at, ts.hide_report, ts.organization_id, ts.organization_alias_id, ts.hide_top, ts.is_draft,
     COALESCE(oc.opinion_count, 0) AS opinion_count,
     users.user_id, users.display_id, users.display_name, users.icon_url, users.created_at, users.updated_at, users.email, users.email_verified, users.withdrawal_date,
     COALESCE(organization_aliases.alias_name, '') AS alias_name,
@@ -617,7 +677,7 @@ type GetTalkSessionByIDRow struct {
 // GetTalkSessionByID
 //
 //	SELECT
-//	    ts.talk_session_id, ts.owner_id, ts.theme, ts.scheduled_end_time, ts.created_at, ts.city, ts.prefecture, ts.description, ts.thumbnail_url, ts.restrictions, ts.updated_at, ts.hide_report, ts.organization_id, ts.organization_alias_id, ts.hide_top,
+//	    ts.talk_session_id, ts.owner_id, ts.theme, ts.scheduled_end_time, ts.created_at, ts.city, ts.prefecture, ts.description, ts.thumbnail_url, ts.restrictions, ts.updated_at, ts.hide_report, ts.organization_id, ts.organization_alias_id, ts.hide_top, ts.is_draft,
 //	    COALESCE(oc.opinion_count, 0) AS opinion_count,
 //	    users.user_id, users.display_id, users.display_name, users.icon_url, users.created_at, users.updated_at, users.email, users.email_verified, users.withdrawal_date,
 //	    COALESCE(organization_aliases.alias_name, '') AS alias_name,
@@ -658,6 +718,7 @@ func (q *Queries) GetTalkSessionByID(ctx context.Context, talkSessionID uuid.UUI
 		&i.TalkSession.OrganizationID,
 		&i.TalkSession.OrganizationAliasID,
 		&i.TalkSession.HideTop,
+		&i.TalkSession.IsDraft,
 		&i.OpinionCount,
 		&i.User.UserID,
 		&i.User.DisplayID,
@@ -714,9 +775,179 @@ func (q *Queries) GetTalkSessionParticipants(ctx context.Context, talkSessionID
 	return items, nil
 }
 
+const getTalkSessionsByOrganizationIDsWithCount = `-- name: GetTalkSessionsByOrganizationIDsWithCount :many
+WITH filtered_sessions AS (
+    SELECT ts.talk_session_id
+    FROM talk_sessions ts
+    WHERE
+        ts.organization_id = ANY($3::uuid[])
+        AND ts.is_draft = FALSE
+        AND
+        CASE $4::text
+            WHEN 'finished' THEN ts.scheduled_end_time <= now()
+            WHEN 'open' THEN ts.scheduled_end_time > now()
+            ELSE TRUE
+        END
+        AND
+        CASE
+            WHEN $5::text IS NOT NULL
+                THEN ts.theme LIKE '%' || $5::text || '%'
+            ELSE TRUE
+        END
+)
+SELECT
+    ts.talk_session_id, ts.owner_id, ts.theme, ts.scheduled_end_time, ts.created_at, ts.city, ts.prefecture, ts.description, ts.thumbnail_url, ts.restrictions, ts.updated_at, ts.hide_report, ts.organization_id, ts.organization_alias_id, ts.hide_top, ts.is_draft,
+    COALESCE(oc.opinion_count, 0) AS opinion_count,
+    users.user_id, users.display_id, users.display_name, users.icon_url, users.created_at, users.updated_at, users.email, users.email_verified, users.withdrawal_date,
+    COALESCE(organization_aliases.alias_name, '') AS alias_name,
+    COALESCE(organization_aliases.alias_id, '00000000-0000-0000-0000-000000000000'::uuid) AS alias_id,
+    COALESCE(organization_aliases.organization_id, '00000000-0000-0000-0000-000000000000'::uuid) AS organization_id,
+    COALESCE(ST_Y(ST_GeomFromWKB(ST_AsBinary(talk_session_locations.location))),0)::float AS latitude,
+    COALESCE(ST_X(ST_GeomFromWKB(ST_AsBinary(talk_session_locations.location))),0)::float AS longitude,
+    (SELECT COUNT(*) FROM filtered_sessions) AS total_count
+FROM talk_sessions ts
+INNER JOIN filtered_sessions fs ON fs.talk_session_id = ts.talk_session_id
+LEFT JOIN (
+    SELECT talk_session_id, COUNT(opinion_id) AS opinion_count
+    FROM opinions
+    GROUP BY talk_session_id
+) oc ON oc.talk_session_id = ts.talk_session_id
+LEFT JOIN users ON ts.owner_id = users.user_id
+LEFT JOIN talk_session_locations ON talk_session_locations.talk_session_id = ts.talk_session_id
+LEFT JOIN organization_aliases ON ts.organization_alias_id = organization_aliases.alias_id
+ORDER BY ts.created_at DESC
+LIMIT $2 OFFSET $1
+`
+
+type GetTalkSessionsByOrganizationIDsWithCountParams struct {
+	Offset          sql.NullInt32
+	Limit           sql.NullInt32
+	OrganizationIds []uuid.UUID
+	Status          sql.NullString
+	Theme           sql.NullString
+}
+
+type GetTalkSessionsByOrganizationIDsWithCountRow struct {
+	TalkSession    TalkSession
+	OpinionCount   int64
+	User           User
+	AliasName      string
+	AliasID        uuid.UUID
+	OrganizationID uuid.UUID
+	Latitude       float64
+	Longitude      float64
+	TotalCount     int64
+}
+
+// GetTalkSessionsByOrganizationIDsWithCount
+//
+//	WITH filtered_sessions AS (
+//	    SELECT ts.talk_session_id
+//	    FROM talk_sessions ts
+//	    WHERE
+//	        ts.organization_id = ANY($3::uuid[])
+//	        AND ts.is_draft = FALSE
+//	        AND
+//	        CASE $4::text
+//	            WHEN 'finished' THEN ts.scheduled_end_time <= now()
+//	            WHEN 'open' THEN ts.scheduled_end_time > now()
+//	            ELSE TRUE
+//	        END
+//	        AND
+//	        CASE
+//	            WHEN $5::text IS NOT NULL
+//	                THEN ts.theme LIKE '%' || $5::text || '%'
+//	            ELSE TRUE
+//	        END
+//	)
+//	SELECT
+//	    ts.talk_session_id, ts.owner_id, ts.theme, ts.scheduled_end_time, ts.created_at, ts.city, ts.prefecture, ts.description, ts.thumbnail_url, ts.restrictions, ts.updated_at, ts.hide_report, ts.organization_id, ts.organization_alias_id, ts.hide_top, ts.is_draft,
+//	    COALESCE(oc.opinion_count, 0) AS opinion_count,
+//	    users.user_id, users.display_id, users.display_name, users.icon_url, users.created_at, users.updated_at, users.email, users.email_verified, users.withdrawal_date,
+//	    COALESCE(organization_aliases.alias_name, '') AS alias_name,
+//	    COALESCE(organization_aliases.alias_id, '00000000-0000-0000-0000-000000000000'::uuid) AS alias_id,
+//	    COALESCE(organization_aliases.organization_id, '00000000-0000-0000-0000-000000000000'::uuid) AS organization_id,
+//	    COALESCE(ST_Y(ST_GeomFromWKB(ST_AsBinary(talk_session_locations.location))),0)::float AS latitude,
+//	    COALESCE(ST_X(ST_GeomFromWKB(ST_AsBinary(talk_session_locations.location))),0)::float AS longitude,
+//	    (SELECT COUNT(*) FROM filtered_sessions) AS total_count
+//	FROM talk_sessions ts
+//	INNER JOIN filtered_sessions fs ON fs.talk_session_id = ts.talk_session_id
+//	LEFT JOIN (
+//	    SELECT talk_session_id, COUNT(opinion_id) AS opinion_count
+//	    FROM opinions
+//	    GROUP BY talk_session_id
+//	) oc ON oc.talk_session_id = ts.talk_session_id
+//	LEFT JOIN users ON ts.owner_id = users.user_id
+//	LEFT JOIN talk_session_locations ON talk_session_locations.talk_session_id = ts.talk_session_id
+//	LEFT JOIN organization_aliases ON ts.organization_alias_id = organization_aliases.alias_id
+//	ORDER BY ts.created_at DESC
+//	LIMIT $2 OFFSET $1
+func (q *Queries) GetTalkSessionsByOrganizationIDsWithCount(ctx context.Context, arg GetTalkSessionsByOrganizationIDsWithCountParams) ([]GetTalkSessionsByOrganizationIDsWithCountRow, error) {
+	rows, err := q.db.QueryContext(ctx, getTalkSessionsByOrganizationIDsWithCount,
+		arg.Offset,
+		arg.Limit,
+		pq.Array(arg.OrganizationIds),
+		arg.Status,
+		arg.Theme,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTalkSessionsByOrganizationIDsWithCountRow
+	for rows.Next() {
+		var i GetTalkSessionsByOrganizationIDsWithCountRow
+		if err := rows.Scan(
+			&i.TalkSession.TalkSessionID,
+			&i.TalkSession.OwnerID,
+			&i.TalkSession.Theme,
+			&i.TalkSession.ScheduledEndTime,
+			&i.TalkSession.CreatedAt,
+			&i.TalkSession.City,
+			&i.TalkSession.Prefecture,
+			&i.TalkSession.Description,
+			&i.TalkSession.ThumbnailUrl,
+			&i.TalkSession.Restrictions,
+			&i.TalkSession.UpdatedAt,
+			&i.TalkSession.HideReport,
+			&i.TalkSession.OrganizationID,
+			&i.TalkSession.OrganizationAliasID,
+			&i.TalkSession.HideTop,
+			&i.TalkSession.IsDraft,
+			&i.OpinionCount,
+			&i.User.UserID,
+			&i.User.DisplayID,
+			&i.User.DisplayName,
+			&i.User.IconUrl,
+			&i.User.CreatedAt,
+			&i.User.UpdatedAt,
+			&i.User.Email,
+			&i.User.EmailVerified,
+			&i.User.WithdrawalDate,
+			&i.AliasName,
+			&i.AliasID,
+			&i.OrganizationID,
+			&i.Latitude,
+			&i.Longitude,
+			&i.TotalCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getUnprocessedEndedSessions = `-- name: GetUnprocessedEndedSessions :many
-SELECT talk_session_id, owner_id, theme, scheduled_end_time, created_at, city, prefecture, description, thumbnail_url, restrictions, updated_at, hide_report, organization_id, organization_alias_id, hide_top FROM talk_sessions
+SELECT talk_session_id, owner_id, theme, scheduled_end_time, created_at, city, prefecture, description, thumbnail_url, restrictions, updated_at, hide_report, organization_id, organization_alias_id, hide_top, is_draft FROM talk_sessions
 WHERE scheduled_end_time < NOW()
+  AND is_draft = FALSE
   AND NOT EXISTS (
     SELECT 1 FROM domain_events
     WHERE aggregate_id = talk_sessions.talk_session_id::text
@@ -730,8 +961,9 @@ FOR UPDATE SKIP LOCKED
 
 // GetUnprocessedEndedSessions
 //
-//	SELECT talk_session_id, owner_id, theme, scheduled_end_time, created_at, city, prefecture, description, thumbnail_url, restrictions, updated_at, hide_report, organization_id, organization_alias_id, hide_top FROM talk_sessions
+//	SELECT talk_session_id, owner_id, theme, scheduled_end_time, created_at, city, prefecture, description, thumbnail_url, restrictions, updated_at, hide_report, organization_id, organization_alias_id, hide_top, is_draft FROM talk_sessions
 //	WHERE scheduled_end_time < NOW()
+//	  AND is_draft = FALSE
 //	  AND NOT EXISTS (
 //	    SELECT 1 FROM domain_events
 //	    WHERE aggregate_id = talk_sessions.talk_session_id::text
@@ -766,6 +998,7 @@ func (q *Queries) GetUnprocessedEndedSessions(ctx context.Context, limit int32)
 			&i.OrganizationID,
 			&i.OrganizationAliasID,
 			&i.HideTop,
+			&i.IsDraft,
 		); err != nil {
 			return nil, err
 		}
@@ -782,7 +1015,7 @@ func (q *Queries) GetUnprocessedEndedSessions(ctx context.Context, limit int32)
 
 const listTalkSessions = `-- name: ListTalkSessions :many
 SELECT
-    ts.talk_session_id, ts.owner_id, ts.theme, ts.scheduled_end_time, ts.created_at, ts.city, ts.prefecture, ts.description, ts.thumbnail_url, ts.restrictions, ts.updated_at, ts.hide_report, ts.organization_id, ts.organization_alias_id, ts.hide_top,
+    ts.talk_session_id, ts.owner_id, ts.theme, ts.scheduled_end_time, ts.created_at, ts.city, ts.prefecture, ts.description, ts.thumbnail_url, ts.restrictions, ts.updated_at, ts.hide_report, ts.organization_id, ts.organization_alias_id, ts.hide_top, ts.is_draft,
     COALESCE(oc.opinion_count, 0) AS opinion_count,
     users.user_id, users.display_id, users.display_name, users.icon_url, users.created_at, users.updated_at, users.email, users.email_verified, users.withdrawal_date,
     COALESCE(organization_aliases.alias_name, '') AS alias_name,
@@ -826,6 +1059,7 @@ LEFT JOIN talk_session_locations
     ON ts.talk_session_id = talk_session_locations.talk_session_id
 WHERE
     ts.hide_top = FALSE AND
+    ts.is_draft = FALSE AND
     CASE $5::text
         WHEN 'finished' THEN ts.scheduled_end_time <= now()
         WHEN 'open' THEN ts.scheduled_end_time > now()
@@ -897,7 +1131,7 @@ type ListTalkSessionsRow struct {
 // ListTalkSessions
 //
 //	SELECT
-//	    ts.talk_session_id, ts.owner_id, ts.theme, ts.scheduled_end_time, ts.created_at, ts.city, ts.prefecture, ts.description, ts.thumbnail_url, ts.restrictions, ts.updated_at, ts.hide_report, ts.organization_id, ts.organization_alias_id, ts.hide_top,
+//	    ts.talk_session_id, ts.owner_id, ts.theme, ts.scheduled_end_time, ts.created_at, ts.city, ts.prefecture, ts.description, ts.thumbnail_url, ts.restrictions, ts.updated_at, ts.hide_report, ts.organization_id, ts.organization_alias_id, ts.hide_top, ts.is_draft,
 //	    COALESCE(oc.opinion_count, 0) AS opinion_count,
 //	    users.user_id, users.display_id, users.display_name, users.icon_url, users.created_at, users.updated_at, users.email, users.email_verified, users.withdrawal_date,
 //	    COALESCE(organization_aliases.alias_name, '') AS alias_name,
@@ -941,6 +1175,7 @@ type ListTalkSessionsRow struct {
 //	    ON ts.talk_session_id = talk_session_locations.talk_session_id
 //	WHERE
 //	    ts.hide_top = FALSE AND
+//	    ts.is_draft = FALSE AND
 //	    CASE $5::text
 //	        WHEN 'finished' THEN ts.scheduled_end_time <= now()
 //	        WHEN 'open' THEN ts.scheduled_end_time > now()
@@ -1015,6 +1250,7 @@ func (q *Queries) ListTalkSessions(ctx context.Context, arg ListTalkSessionsPara
 			&i.TalkSession.OrganizationID,
 			&i.TalkSession.OrganizationAliasID,
 			&i.TalkSession.HideTop,
+			&i.TalkSession.IsDraft,
 			&i.OpinionCount,
 			&i.User.UserID,
 			&i.User.DisplayID,
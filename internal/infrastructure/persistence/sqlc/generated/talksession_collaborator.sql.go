@@ -0,0 +1,171 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: talksession_collaborator.sql
+
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const deleteTalkSessionCollaborator = `-- name: DeleteTalkSessionCollaborator :exec
+DELETE FROM talk_session_collaborators
+WHERE talk_session_id = $1 AND user_id = $2
+`
+
+type DeleteTalkSessionCollaboratorParams struct {
+	TalkSessionID uuid.UUID
+	UserID        uuid.UUID
+}
+
+// DeleteTalkSessionCollaborator
+//
+//	DELETE FROM talk_session_collaborators
+//	WHERE talk_session_id = $1 AND user_id = $2
+func (q *Queries) DeleteTalkSessionCollaborator(ctx context.Context, arg DeleteTalkSessionCollaboratorParams) error {
+	_, err := q.db.ExecContext(ctx, deleteTalkSessionCollaborator, arg.TalkSessionID, arg.UserID)
+	return err
+}
+
+const findTalkSessionCollaborator = `-- name: FindTalkSessionCollaborator :one
+SELECT talk_session_id, user_id, role, added_by, created_at, updated_at FROM talk_session_collaborators
+WHERE talk_session_id = $1 AND user_id = $2
+`
+
+type FindTalkSessionCollaboratorParams struct {
+	TalkSessionID uuid.UUID
+	UserID        uuid.UUID
+}
+
+// FindTalkSessionCollaborator
+//
+//	SELECT talk_session_id, user_id, role, added_by, created_at, updated_at FROM talk_session_collaborators
+//	WHERE talk_session_id = $1 AND user_id = $2
+func (q *Queries) FindTalkSessionCollaborator(ctx context.Context, arg FindTalkSessionCollaboratorParams) (TalkSessionCollaborator, error) {
+	row := q.db.QueryRowContext(ctx, findTalkSessionCollaborator, arg.TalkSessionID, arg.UserID)
+	var i TalkSessionCollaborator
+	err := row.Scan(
+		&i.TalkSessionID,
+		&i.UserID,
+		&i.Role,
+		&i.AddedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const findTalkSessionCollaboratorsByTalkSessionID = `-- name: FindTalkSessionCollaboratorsByTalkSessionID :many
+SELECT
+    talk_session_collaborators.talk_session_id, talk_session_collaborators.user_id, talk_session_collaborators.role, talk_session_collaborators.added_by, talk_session_collaborators.created_at, talk_session_collaborators.updated_at,
+    users.user_id, users.display_id, users.display_name, users.icon_url, users.created_at, users.updated_at, users.email, users.email_verified, users.withdrawal_date
+FROM talk_session_collaborators
+JOIN users ON talk_session_collaborators.user_id = users.user_id
+WHERE talk_session_collaborators.talk_session_id = $1
+ORDER BY talk_session_collaborators.created_at ASC
+`
+
+type FindTalkSessionCollaboratorsByTalkSessionIDRow struct {
+	TalkSessionCollaborator TalkSessionCollaborator
+	User                    User
+}
+
+// FindTalkSessionCollaboratorsByTalkSessionID
+//
+//	SELECT
+//	    talk_session_collaborators.talk_session_id, talk_session_collaborators.user_id, talk_session_collaborators.role, talk_session_collaborators.added_by, talk_session_collaborators.created_at, talk_session_collaborators.updated_at,
+//	    users.user_id, users.display_id, users.display_name, users.icon_url, users.created_at, users.updated_at, users.email, users.email_verified, users.withdrawal_date
+//	FROM talk_session_collaborators
+//	JOIN users ON talk_session_collaborators.user_id = users.user_id
+//	WHERE talk_session_collaborators.talk_session_id = $1
+//	ORDER BY talk_session_collaborators.created_at ASC
+func (q *Queries) FindTalkSessionCollaboratorsByTalkSessionID(ctx context.Context, talkSessionID uuid.UUID) ([]FindTalkSessionCollaboratorsByTalkSessionIDRow, error) {
+	rows, err := q.db.QueryContext(ctx, findTalkSessionCollaboratorsByTalkSessionID, talkSessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FindTalkSessionCollaboratorsByTalkSessionIDRow
+	for rows.Next() {
+		var i FindTalkSessionCollaboratorsByTalkSessionIDRow
+		if err := rows.Scan(
+			&i.TalkSessionCollaborator.TalkSessionID,
+			&i.TalkSessionCollaborator.UserID,
+			&i.TalkSessionCollaborator.Role,
+			&i.TalkSessionCollaborator.AddedBy,
+			&i.TalkSessionCollaborator.CreatedAt,
+			&i.TalkSessionCollaborator.UpdatedAt,
+			&i.User.UserID,
+			&i.User.DisplayID,
+			&i.User.DisplayName,
+			&i.User.IconUrl,
+			&i.User.CreatedAt,
+			&i.User.UpdatedAt,
+			&i.User.Email,
+			&i.User.EmailVerified,
+			&i.User.WithdrawalDate,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const upsertTalkSessionCollaborator = `-- name: UpsertTalkSessionCollaborator :exec
+INSERT INTO talk_session_collaborators (
+    talk_session_id,
+    user_id,
+    role,
+    added_by,
+    created_at,
+    updated_at
+) VALUES ($1, $2, $3, $4, $5, $5)
+ON CONFLICT (talk_session_id, user_id)
+DO UPDATE SET
+    role = EXCLUDED.role,
+    updated_at = EXCLUDED.updated_at
+`
+
+type UpsertTalkSessionCollaboratorParams struct {
+	TalkSessionID uuid.UUID
+	UserID        uuid.UUID
+	Role          string
+	AddedBy       uuid.UUID
+	CreatedAt     time.Time
+}
+
+// UpsertTalkSessionCollaborator
+//
+//	INSERT INTO talk_session_collaborators (
+//	    talk_session_id,
+//	    user_id,
+//	    role,
+//	    added_by,
+//	    created_at,
+//	    updated_at
+//	) VALUES ($1, $2, $3, $4, $5, $5)
+//	ON CONFLICT (talk_session_id, user_id)
+//	DO UPDATE SET
+//	    role = EXCLUDED.role,
+//	    updated_at = EXCLUDED.updated_at
+func (q *Queries) UpsertTalkSessionCollaborator(ctx context.Context, arg UpsertTalkSessionCollaboratorParams) error {
+	_, err := q.db.ExecContext(ctx, upsertTalkSessionCollaborator,
+		arg.TalkSessionID,
+		arg.UserID,
+		arg.Role,
+		arg.AddedBy,
+		arg.CreatedAt,
+	)
+	return err
+}
@@ -0,0 +1,184 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: talksession_template.sql
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+)
+
+const createTalkSessionTemplate = `-- name: CreateTalkSessionTemplate :exec
+INSERT INTO talk_session_templates (
+    template_id,
+    organization_id,
+    organization_alias_id,
+    name,
+    theme,
+    description,
+    thumbnail_url,
+    city,
+    prefecture,
+    restrictions,
+    hide_top,
+    seed_opinions,
+    created_by,
+    created_at,
+    updated_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $14)
+`
+
+type CreateTalkSessionTemplateParams struct {
+	TemplateID          uuid.UUID
+	OrganizationID      uuid.UUID
+	OrganizationAliasID uuid.NullUUID
+	Name                string
+	Theme               string
+	Description         sql.NullString
+	ThumbnailUrl        sql.NullString
+	City                sql.NullString
+	Prefecture          sql.NullString
+	Restrictions        talksession.Restrictions
+	HideTop             bool
+	SeedOpinions        json.RawMessage
+	CreatedBy           uuid.UUID
+	CreatedAt           time.Time
+}
+
+// CreateTalkSessionTemplate
+//
+//	INSERT INTO talk_session_templates (
+//	    template_id,
+//	    organization_id,
+//	    organization_alias_id,
+//	    name,
+//	    theme,
+//	    description,
+//	    thumbnail_url,
+//	    city,
+//	    prefecture,
+//	    restrictions,
+//	    hide_top,
+//	    seed_opinions,
+//	    created_by,
+//	    created_at,
+//	    updated_at
+//	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $14)
+func (q *Queries) CreateTalkSessionTemplate(ctx context.Context, arg CreateTalkSessionTemplateParams) error {
+	_, err := q.db.ExecContext(ctx, createTalkSessionTemplate,
+		arg.TemplateID,
+		arg.OrganizationID,
+		arg.OrganizationAliasID,
+		arg.Name,
+		arg.Theme,
+		arg.Description,
+		arg.ThumbnailUrl,
+		arg.City,
+		arg.Prefecture,
+		arg.Restrictions,
+		arg.HideTop,
+		arg.SeedOpinions,
+		arg.CreatedBy,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const deleteTalkSessionTemplate = `-- name: DeleteTalkSessionTemplate :exec
+DELETE FROM talk_session_templates WHERE template_id = $1
+`
+
+// DeleteTalkSessionTemplate
+//
+//	DELETE FROM talk_session_templates WHERE template_id = $1
+func (q *Queries) DeleteTalkSessionTemplate(ctx context.Context, templateID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteTalkSessionTemplate, templateID)
+	return err
+}
+
+const findTalkSessionTemplateByID = `-- name: FindTalkSessionTemplateByID :one
+SELECT template_id, organization_id, organization_alias_id, name, theme, description, thumbnail_url, city, prefecture, restrictions, hide_top, seed_opinions, created_by, created_at, updated_at FROM talk_session_templates WHERE template_id = $1
+`
+
+// FindTalkSessionTemplateByID
+//
+//	SELECT template_id, organization_id, organization_alias_id, name, theme, description, thumbnail_url, city, prefecture, restrictions, hide_top, seed_opinions, created_by, created_at, updated_at FROM talk_session_templates WHERE template_id = $1
+func (q *Queries) FindTalkSessionTemplateByID(ctx context.Context, templateID uuid.UUID) (TalkSessionTemplate, error) {
+	row := q.db.QueryRowContext(ctx, findTalkSessionTemplateByID, templateID)
+	var i TalkSessionTemplate
+	err := row.Scan(
+		&i.TemplateID,
+		&i.OrganizationID,
+		&i.OrganizationAliasID,
+		&i.Name,
+		&i.Theme,
+		&i.Description,
+		&i.ThumbnailUrl,
+		&i.City,
+		&i.Prefecture,
+		&i.Restrictions,
+		&i.HideTop,
+		&i.SeedOpinions,
+		&i.CreatedBy,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const findTalkSessionTemplatesByOrganizationID = `-- name: FindTalkSessionTemplatesByOrganizationID :many
+SELECT template_id, organization_id, organization_alias_id, name, theme, description, thumbnail_url, city, prefecture, restrictions, hide_top, seed_opinions, created_by, created_at, updated_at FROM talk_session_templates
+WHERE organization_id = $1
+ORDER BY created_at DESC
+`
+
+// FindTalkSessionTemplatesByOrganizationID
+//
+//	SELECT template_id, organization_id, organization_alias_id, name, theme, description, thumbnail_url, city, prefecture, restrictions, hide_top, seed_opinions, created_by, created_at, updated_at FROM talk_session_templates
+//	WHERE organization_id = $1
+//	ORDER BY created_at DESC
+func (q *Queries) FindTalkSessionTemplatesByOrganizationID(ctx context.Context, organizationID uuid.UUID) ([]TalkSessionTemplate, error) {
+	rows, err := q.db.QueryContext(ctx, findTalkSessionTemplatesByOrganizationID, organizationID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TalkSessionTemplate
+	for rows.Next() {
+		var i TalkSessionTemplate
+		if err := rows.Scan(
+			&i.TemplateID,
+			&i.OrganizationID,
+			&i.OrganizationAliasID,
+			&i.Name,
+			&i.Theme,
+			&i.Description,
+			&i.ThumbnailUrl,
+			&i.City,
+			&i.Prefecture,
+			&i.Restrictions,
+			&i.HideTop,
+			&i.SeedOpinions,
+			&i.CreatedBy,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
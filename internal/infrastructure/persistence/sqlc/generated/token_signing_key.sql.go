@@ -0,0 +1,148 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: token_signing_key.sql
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+const createTokenSigningKey = `-- name: CreateTokenSigningKey :exec
+INSERT INTO token_signing_keys (
+    key_id,
+    algorithm,
+    encrypted_private_key,
+    public_key,
+    status,
+    created_at
+) VALUES ($1, $2, $3, $4, $5, $6)
+`
+
+type CreateTokenSigningKeyParams struct {
+	KeyID               string
+	Algorithm           string
+	EncryptedPrivateKey string
+	PublicKey           string
+	Status              string
+	CreatedAt           time.Time
+}
+
+// CreateTokenSigningKey
+//
+//	INSERT INTO token_signing_keys (
+//	    key_id,
+//	    algorithm,
+//	    encrypted_private_key,
+//	    public_key,
+//	    status,
+//	    created_at
+//	) VALUES ($1, $2, $3, $4, $5, $6)
+func (q *Queries) CreateTokenSigningKey(ctx context.Context, arg CreateTokenSigningKeyParams) error {
+	_, err := q.db.ExecContext(ctx, createTokenSigningKey,
+		arg.KeyID,
+		arg.Algorithm,
+		arg.EncryptedPrivateKey,
+		arg.PublicKey,
+		arg.Status,
+		arg.CreatedAt,
+	)
+	return err
+}
+
+const findActiveTokenSigningKeyForUpdate = `-- name: FindActiveTokenSigningKeyForUpdate :one
+SELECT key_id, algorithm, encrypted_private_key, public_key, status, created_at, retired_at, verify_until FROM token_signing_keys WHERE status = 'active' FOR UPDATE
+`
+
+// FindActiveTokenSigningKeyForUpdate
+//
+//	SELECT key_id, algorithm, encrypted_private_key, public_key, status, created_at, retired_at, verify_until FROM token_signing_keys WHERE status = 'active' FOR UPDATE
+func (q *Queries) FindActiveTokenSigningKeyForUpdate(ctx context.Context) (TokenSigningKey, error) {
+	row := q.db.QueryRowContext(ctx, findActiveTokenSigningKeyForUpdate)
+	var i TokenSigningKey
+	err := row.Scan(
+		&i.KeyID,
+		&i.Algorithm,
+		&i.EncryptedPrivateKey,
+		&i.PublicKey,
+		&i.Status,
+		&i.CreatedAt,
+		&i.RetiredAt,
+		&i.VerifyUntil,
+	)
+	return i, err
+}
+
+const findVerifiableTokenSigningKeys = `-- name: FindVerifiableTokenSigningKeys :many
+SELECT key_id, algorithm, encrypted_private_key, public_key, status, created_at, retired_at, verify_until FROM token_signing_keys
+WHERE status = 'active'
+    OR (status = 'retired' AND verify_until > $1::timestamptz)
+ORDER BY created_at DESC
+`
+
+// 署名中の鍵と、猶予期間内のローテーション済みの鍵を返す
+//
+//	SELECT key_id, algorithm, encrypted_private_key, public_key, status, created_at, retired_at, verify_until FROM token_signing_keys
+//	WHERE status = 'active'
+//	    OR (status = 'retired' AND verify_until > $1::timestamptz)
+//	ORDER BY created_at DESC
+func (q *Queries) FindVerifiableTokenSigningKeys(ctx context.Context, now time.Time) ([]TokenSigningKey, error) {
+	rows, err := q.db.QueryContext(ctx, findVerifiableTokenSigningKeys, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []TokenSigningKey
+	for rows.Next() {
+		var i TokenSigningKey
+		if err := rows.Scan(
+			&i.KeyID,
+			&i.Algorithm,
+			&i.EncryptedPrivateKey,
+			&i.PublicKey,
+			&i.Status,
+			&i.CreatedAt,
+			&i.RetiredAt,
+			&i.VerifyUntil,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const retireTokenSigningKey = `-- name: RetireTokenSigningKey :exec
+UPDATE token_signing_keys
+SET status = 'retired',
+    retired_at = $2,
+    verify_until = $3
+WHERE key_id = $1
+`
+
+type RetireTokenSigningKeyParams struct {
+	KeyID       string
+	RetiredAt   sql.NullTime
+	VerifyUntil sql.NullTime
+}
+
+// RetireTokenSigningKey
+//
+//	UPDATE token_signing_keys
+//	SET status = 'retired',
+//	    retired_at = $2,
+//	    verify_until = $3
+//	WHERE key_id = $1
+func (q *Queries) RetireTokenSigningKey(ctx context.Context, arg RetireTokenSigningKeyParams) error {
+	_, err := q.db.ExecContext(ctx, retireTokenSigningKey, arg.KeyID, arg.RetiredAt, arg.VerifyUntil)
+	return err
+}
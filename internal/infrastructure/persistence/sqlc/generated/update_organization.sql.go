@@ -15,23 +15,35 @@ import (
 const updateOrganization = `-- name: UpdateOrganization :exec
 UPDATE organizations SET
     name = $2,
-    icon_url = $3
+    icon_url = $3,
+    parent_organization_id = $4,
+    owner_id = $5
 WHERE organization_id = $1
 `
 
 type UpdateOrganizationParams struct {
-	OrganizationID uuid.UUID
-	Name           string
-	IconUrl        sql.NullString
+	OrganizationID       uuid.UUID
+	Name                 string
+	IconUrl              sql.NullString
+	ParentOrganizationID uuid.NullUUID
+	OwnerID              uuid.UUID
 }
 
 // UpdateOrganization
 //
 //	UPDATE organizations SET
 //	    name = $2,
-//	    icon_url = $3
+//	    icon_url = $3,
+//	    parent_organization_id = $4,
+//	    owner_id = $5
 //	WHERE organization_id = $1
 func (q *Queries) UpdateOrganization(ctx context.Context, arg UpdateOrganizationParams) error {
-	_, err := q.db.ExecContext(ctx, updateOrganization, arg.OrganizationID, arg.Name, arg.IconUrl)
+	_, err := q.db.ExecContext(ctx, updateOrganization,
+		arg.OrganizationID,
+		arg.Name,
+		arg.IconUrl,
+		arg.ParentOrganizationID,
+		arg.OwnerID,
+	)
 	return err
 }
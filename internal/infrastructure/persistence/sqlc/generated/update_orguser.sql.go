@@ -0,0 +1,45 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: update_orguser.sql
+
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const updateOrgUserRole = `-- name: UpdateOrgUserRole :exec
+UPDATE organization_users
+SET role = $3,
+    updated_at = $4
+WHERE organization_id = $1
+  AND user_id = $2
+`
+
+type UpdateOrgUserRoleParams struct {
+	OrganizationID uuid.UUID
+	UserID         uuid.UUID
+	Role           int32
+	UpdatedAt      time.Time
+}
+
+// UpdateOrgUserRole
+//
+//	UPDATE organization_users
+//	SET role = $3,
+//	    updated_at = $4
+//	WHERE organization_id = $1
+//	  AND user_id = $2
+func (q *Queries) UpdateOrgUserRole(ctx context.Context, arg UpdateOrgUserRoleParams) error {
+	_, err := q.db.ExecContext(ctx, updateOrgUserRole,
+		arg.OrganizationID,
+		arg.UserID,
+		arg.Role,
+		arg.UpdatedAt,
+	)
+	return err
+}
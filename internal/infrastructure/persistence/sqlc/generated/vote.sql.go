@@ -19,8 +19,9 @@ INSERT INTO votes (
     talk_session_id,
     user_id,
     vote_type,
+    is_important,
     created_at
-) VALUES ($1, $2, $3, $4, $5, $6)
+) VALUES ($1, $2, $3, $4, $5, $6, $7)
 `
 
 type CreateVoteParams struct {
@@ -29,6 +30,7 @@ type CreateVoteParams struct {
 	TalkSessionID uuid.UUID
 	UserID        uuid.UUID
 	VoteType      int16
+	IsImportant   bool
 	CreatedAt     time.Time
 }
 
@@ -40,8 +42,9 @@ type CreateVoteParams struct {
 //	    talk_session_id,
 //	    user_id,
 //	    vote_type,
+//	    is_important,
 //	    created_at
-//	) VALUES ($1, $2, $3, $4, $5, $6)
+//	) VALUES ($1, $2, $3, $4, $5, $6, $7)
 func (q *Queries) CreateVote(ctx context.Context, arg CreateVoteParams) error {
 	_, err := q.db.ExecContext(ctx, createVote,
 		arg.VoteID,
@@ -49,13 +52,26 @@ func (q *Queries) CreateVote(ctx context.Context, arg CreateVoteParams) error {
 		arg.TalkSessionID,
 		arg.UserID,
 		arg.VoteType,
+		arg.IsImportant,
 		arg.CreatedAt,
 	)
 	return err
 }
 
+const deleteVote = `-- name: DeleteVote :exec
+DELETE FROM votes WHERE vote_id = $1
+`
+
+// DeleteVote
+//
+//	DELETE FROM votes WHERE vote_id = $1
+func (q *Queries) DeleteVote(ctx context.Context, voteID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, deleteVote, voteID)
+	return err
+}
+
 const findVoteByUserIDAndOpinionID = `-- name: FindVoteByUserIDAndOpinionID :one
-SELECT vote_id, opinion_id, user_id, vote_type, created_at, talk_session_id FROM votes WHERE user_id = $1 AND opinion_id = $2
+SELECT vote_id, opinion_id, user_id, vote_type, created_at, talk_session_id, is_important FROM votes WHERE user_id = $1 AND opinion_id = $2
 `
 
 type FindVoteByUserIDAndOpinionIDParams struct {
@@ -65,7 +81,7 @@ type FindVoteByUserIDAndOpinionIDParams struct {
 
 // FindVoteByUserIDAndOpinionID
 //
-//	SELECT vote_id, opinion_id, user_id, vote_type, created_at, talk_session_id FROM votes WHERE user_id = $1 AND opinion_id = $2
+//	SELECT vote_id, opinion_id, user_id, vote_type, created_at, talk_session_id, is_important FROM votes WHERE user_id = $1 AND opinion_id = $2
 func (q *Queries) FindVoteByUserIDAndOpinionID(ctx context.Context, arg FindVoteByUserIDAndOpinionIDParams) (Vote, error) {
 	row := q.db.QueryRowContext(ctx, findVoteByUserIDAndOpinionID, arg.UserID, arg.OpinionID)
 	var i Vote
@@ -76,24 +92,69 @@ func (q *Queries) FindVoteByUserIDAndOpinionID(ctx context.Context, arg FindVote
 		&i.VoteType,
 		&i.CreatedAt,
 		&i.TalkSessionID,
+		&i.IsImportant,
 	)
 	return i, err
 }
 
+const findVotesByOpinionID = `-- name: FindVotesByOpinionID :many
+SELECT vote_id, opinion_id, user_id, vote_type, created_at, talk_session_id, is_important FROM votes WHERE opinion_id = $1
+`
+
+// FindVotesByOpinionID
+//
+//	SELECT vote_id, opinion_id, user_id, vote_type, created_at, talk_session_id, is_important FROM votes WHERE opinion_id = $1
+func (q *Queries) FindVotesByOpinionID(ctx context.Context, opinionID uuid.UUID) ([]Vote, error) {
+	rows, err := q.db.QueryContext(ctx, findVotesByOpinionID, opinionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Vote
+	for rows.Next() {
+		var i Vote
+		if err := rows.Scan(
+			&i.VoteID,
+			&i.OpinionID,
+			&i.UserID,
+			&i.VoteType,
+			&i.CreatedAt,
+			&i.TalkSessionID,
+			&i.IsImportant,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateVote = `-- name: UpdateVote :exec
-UPDATE votes SET vote_type = $3 WHERE user_id = $1 AND opinion_id = $2
+UPDATE votes SET vote_type = $3, is_important = $4 WHERE user_id = $1 AND opinion_id = $2
 `
 
 type UpdateVoteParams struct {
-	UserID    uuid.UUID
-	OpinionID uuid.UUID
-	VoteType  int16
+	UserID      uuid.UUID
+	OpinionID   uuid.UUID
+	VoteType    int16
+	IsImportant bool
 }
 
 // UpdateVote
 //
-//	UPDATE votes SET vote_type = $3 WHERE user_id = $1 AND opinion_id = $2
+//	UPDATE votes SET vote_type = $3, is_important = $4 WHERE user_id = $1 AND opinion_id = $2
 func (q *Queries) UpdateVote(ctx context.Context, arg UpdateVoteParams) error {
-	_, err := q.db.ExecContext(ctx, updateVote, arg.UserID, arg.OpinionID, arg.VoteType)
+	_, err := q.db.ExecContext(ctx, updateVote,
+		arg.UserID,
+		arg.OpinionID,
+		arg.VoteType,
+		arg.IsImportant,
+	)
 	return err
 }
@@ -0,0 +1,150 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: vote_receipt.sql
+
+package model
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const createVoteReceipt = `-- name: CreateVoteReceipt :execrows
+INSERT INTO vote_receipts (
+    user_id,
+    idempotency_key,
+    opinion_id,
+    vote_type,
+    error_code,
+    error_message,
+    client_voted_at,
+    created_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (user_id, idempotency_key) DO NOTHING
+`
+
+type CreateVoteReceiptParams struct {
+	UserID         uuid.UUID
+	IdempotencyKey string
+	OpinionID      uuid.UUID
+	VoteType       int16
+	ErrorCode      sql.NullString
+	ErrorMessage   sql.NullString
+	ClientVotedAt  time.Time
+	CreatedAt      time.Time
+}
+
+// 同じ冪等キーの処理結果がすでにある場合は作成しない。同時に送信された場合は先に作成した側の処理を待つ
+//
+//	INSERT INTO vote_receipts (
+//	    user_id,
+//	    idempotency_key,
+//	    opinion_id,
+//	    vote_type,
+//	    error_code,
+//	    error_message,
+//	    client_voted_at,
+//	    created_at
+//	) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+//	ON CONFLICT (user_id, idempotency_key) DO NOTHING
+func (q *Queries) CreateVoteReceipt(ctx context.Context, arg CreateVoteReceiptParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, createVoteReceipt,
+		arg.UserID,
+		arg.IdempotencyKey,
+		arg.OpinionID,
+		arg.VoteType,
+		arg.ErrorCode,
+		arg.ErrorMessage,
+		arg.ClientVotedAt,
+		arg.CreatedAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const findVoteReceiptsByKeys = `-- name: FindVoteReceiptsByKeys :many
+SELECT user_id, idempotency_key, opinion_id, vote_type, error_code, error_message, client_voted_at, created_at FROM vote_receipts
+WHERE user_id = $1
+    AND idempotency_key = ANY($2::text[])
+`
+
+type FindVoteReceiptsByKeysParams struct {
+	UserID          uuid.UUID
+	IdempotencyKeys []string
+}
+
+// FindVoteReceiptsByKeys
+//
+//	SELECT user_id, idempotency_key, opinion_id, vote_type, error_code, error_message, client_voted_at, created_at FROM vote_receipts
+//	WHERE user_id = $1
+//	    AND idempotency_key = ANY($2::text[])
+func (q *Queries) FindVoteReceiptsByKeys(ctx context.Context, arg FindVoteReceiptsByKeysParams) ([]VoteReceipt, error) {
+	rows, err := q.db.QueryContext(ctx, findVoteReceiptsByKeys, arg.UserID, pq.Array(arg.IdempotencyKeys))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []VoteReceipt
+	for rows.Next() {
+		var i VoteReceipt
+		if err := rows.Scan(
+			&i.UserID,
+			&i.IdempotencyKey,
+			&i.OpinionID,
+			&i.VoteType,
+			&i.ErrorCode,
+			&i.ErrorMessage,
+			&i.ClientVotedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateVoteReceiptError = `-- name: UpdateVoteReceiptError :exec
+UPDATE vote_receipts
+SET error_code = $3,
+    error_message = $4
+WHERE user_id = $1
+    AND idempotency_key = $2
+`
+
+type UpdateVoteReceiptErrorParams struct {
+	UserID         uuid.UUID
+	IdempotencyKey string
+	ErrorCode      sql.NullString
+	ErrorMessage   sql.NullString
+}
+
+// UpdateVoteReceiptError
+//
+//	UPDATE vote_receipts
+//	SET error_code = $3,
+//	    error_message = $4
+//	WHERE user_id = $1
+//	    AND idempotency_key = $2
+func (q *Queries) UpdateVoteReceiptError(ctx context.Context, arg UpdateVoteReceiptErrorParams) error {
+	_, err := q.db.ExecContext(ctx, updateVoteReceiptError,
+		arg.UserID,
+		arg.IdempotencyKey,
+		arg.ErrorCode,
+		arg.ErrorMessage,
+	)
+	return err
+}
@@ -33,6 +33,25 @@ WHERE representative_opinions.rank < 4
     AND opinions.talk_session_id = $1
 ORDER BY representative_opinions.rank;
 
+-- name: GetReportRepresentativeOpinionsByTalkSessionID :many
+-- レポートの材料にするグループの代表的な意見
+-- 投稿者・運営が削除した意見は含めない
+SELECT
+    sqlc.embed(representative_opinions),
+    sqlc.embed(opinions)
+FROM representative_opinions
+JOIN opinions
+    ON representative_opinions.opinion_id = opinions.opinion_id
+WHERE representative_opinions.rank < 4
+    AND opinions.talk_session_id = $1
+    AND opinions.deleted_at IS NULL
+    AND NOT EXISTS (
+        SELECT 1 FROM opinion_reports
+        WHERE opinion_reports.opinion_id = opinions.opinion_id
+            AND opinion_reports.status = 'deleted'
+    )
+ORDER BY representative_opinions.group_id, representative_opinions.rank;
+
 -- name: GetGroupListByTalkSessionId :many
 SELECT
     DISTINCT user_group_info.group_id
@@ -40,22 +59,33 @@ FROM user_group_info
 WHERE talk_session_id = $1;
 
 -- name: GetReportByTalkSessionId :one
+-- 公開するバージョンがあればその内容を返す
 SELECT
-    -- talk_session_report_history_id as analysis_report_history_id,
-    talk_session_id,
-    report,
-    created_at
+    talk_session_reports.talk_session_id,
+    talk_session_report_histories.talk_session_report_history_id AS report_version_id,
+    COALESCE(talk_session_report_histories.report, talk_session_reports.report)::text AS report,
+    talk_session_report_histories.sections,
+    talk_session_reports.created_at
 FROM talk_session_reports
-WHERE talk_session_id = $1;
+LEFT JOIN talk_session_report_publications
+    ON talk_session_report_publications.talk_session_id = talk_session_reports.talk_session_id
+LEFT JOIN talk_session_report_histories
+    ON talk_session_report_histories.talk_session_report_history_id = talk_session_report_publications.talk_session_report_history_id
+WHERE talk_session_reports.talk_session_id = $1;
 
 -- name: FindReportByID :one
+-- レポートのバージョンを返す。セッションのIDを渡した場合は公開しているバージョンを返す
 SELECT
-    -- talk_session_report_history_id as analysis_report_history_id,
-    talk_session_id,
-    report,
-    created_at
-FROM talk_session_reports
-WHERE talk_session_id = $1;
+    talk_session_report_histories.talk_session_report_history_id,
+    talk_session_report_histories.talk_session_id,
+    talk_session_report_histories.report,
+    talk_session_report_histories.created_at
+FROM talk_session_report_histories
+LEFT JOIN talk_session_report_publications
+    ON talk_session_report_publications.talk_session_report_history_id = talk_session_report_histories.talk_session_report_history_id
+WHERE talk_session_report_histories.talk_session_report_history_id = sqlc.arg('report_id')::uuid
+    OR talk_session_report_publications.talk_session_id = sqlc.arg('report_id')::uuid
+LIMIT 1;
 
 -- name: GetFeedbackByReportHistoryID :many
 SELECT
@@ -94,3 +124,202 @@ SELECT
     updated_at
 FROM talk_session_generated_images
 WHERE talk_session_id = $1::uuid;
+
+-- name: SaveGeneratedWordCloud :exec
+-- アプリケーション内で生成したワードクラウドを保存する。t-SNEの画像は生成しないため以前のものを残す
+INSERT INTO talk_session_generated_images (talk_session_id, wordmap_url, term_frequencies)
+VALUES (sqlc.arg('talk_session_id'), sqlc.arg('wordmap_url'), sqlc.arg('term_frequencies'))
+ON CONFLICT (talk_session_id) DO UPDATE SET
+    wordmap_url = EXCLUDED.wordmap_url,
+    term_frequencies = EXCLUDED.term_frequencies,
+    updated_at = NOW();
+
+-- name: GetTermFrequencies :one
+SELECT
+    talk_session_id,
+    wordmap_url,
+    term_frequencies,
+    updated_at
+FROM talk_session_generated_images
+WHERE talk_session_id = $1::uuid
+    AND term_frequencies IS NOT NULL;
+
+-- name: GetOpinionTextsWithGroupByTalkSessionID :many
+-- ワードクラウドの集計に使う意見の本文と投稿者のグループ。グループに属していない投稿者はNULL
+-- 投稿者・運営が削除した意見は含めない
+SELECT
+    opinions.opinion_id,
+    opinions.title,
+    opinions.content,
+    user_group_info.group_id
+FROM opinions
+LEFT JOIN user_group_info
+    ON opinions.user_id = user_group_info.user_id
+    AND opinions.talk_session_id = user_group_info.talk_session_id
+WHERE opinions.talk_session_id = $1
+    AND opinions.deleted_at IS NULL
+    AND NOT EXISTS (
+        SELECT 1 FROM opinion_reports
+        WHERE opinion_reports.opinion_id = opinions.opinion_id
+            AND opinion_reports.status = 'deleted'
+    )
+ORDER BY opinions.created_at;
+
+-- name: GetImportantOpinionsByTalkSessionID :many
+-- グループごとに、グループのメンバーが重要だと印を付けた数が多い意見を返す
+-- 投稿者・運営が削除した意見は含めない
+SELECT
+    ranked.group_id,
+    ranked.important_count,
+    sqlc.embed(opinions),
+    sqlc.embed(users)
+FROM (
+    SELECT
+        user_group_info.group_id,
+        votes.opinion_id,
+        COUNT(*)::int AS important_count,
+        ROW_NUMBER() OVER (
+            PARTITION BY user_group_info.group_id
+            ORDER BY COUNT(*) DESC, votes.opinion_id
+        ) AS rank
+    FROM votes
+    JOIN user_group_info
+        ON votes.user_id = user_group_info.user_id
+        AND votes.talk_session_id = user_group_info.talk_session_id
+    JOIN opinions
+        ON votes.opinion_id = opinions.opinion_id
+    WHERE votes.talk_session_id = sqlc.arg('talk_session_id')::uuid
+        AND votes.is_important
+        AND opinions.deleted_at IS NULL
+        AND NOT EXISTS (
+            SELECT 1 FROM opinion_reports
+            WHERE opinion_reports.opinion_id = opinions.opinion_id
+                AND opinion_reports.status = 'deleted'
+        )
+    GROUP BY user_group_info.group_id, votes.opinion_id
+) ranked
+JOIN opinions
+    ON ranked.opinion_id = opinions.opinion_id
+JOIN users
+    ON opinions.user_id = users.user_id
+WHERE ranked.rank <= sqlc.arg('limit_per_group')::int
+ORDER BY ranked.group_id, ranked.important_count DESC, opinions.opinion_id;
+
+-- name: GetGroupVoteCountsByTalkSessionID :many
+-- 意見ごと・グループごとの投票数
+-- 投稿者・運営が削除した意見は含めない
+SELECT
+    votes.opinion_id,
+    user_group_info.group_id,
+    (COUNT(*) FILTER (WHERE votes.vote_type = 1))::int AS agree_count,
+    (COUNT(*) FILTER (WHERE votes.vote_type = 2))::int AS disagree_count,
+    (COUNT(*) FILTER (WHERE votes.vote_type = 3))::int AS pass_count
+FROM votes
+JOIN user_group_info
+    ON votes.user_id = user_group_info.user_id
+    AND votes.talk_session_id = user_group_info.talk_session_id
+JOIN opinions
+    ON votes.opinion_id = opinions.opinion_id
+WHERE votes.talk_session_id = sqlc.arg('talk_session_id')::uuid
+    AND opinions.deleted_at IS NULL
+    AND NOT EXISTS (
+        SELECT 1 FROM opinion_reports
+        WHERE opinion_reports.opinion_id = opinions.opinion_id
+            AND opinion_reports.status = 'deleted'
+    )
+GROUP BY votes.opinion_id, user_group_info.group_id
+ORDER BY votes.opinion_id, user_group_info.group_id;
+
+-- name: GetOpinionsWithVoteCountsByTalkSessionID :many
+-- トピックの集計に使う意見と投票数。返信も含める
+-- 投稿者・運営が削除した意見は含めない
+SELECT
+    sqlc.embed(opinions),
+    sqlc.embed(users),
+    COALESCE(vc.agree_count, 0)::int AS agree_count,
+    COALESCE(vc.disagree_count, 0)::int AS disagree_count,
+    COALESCE(vc.pass_count, 0)::int AS pass_count
+FROM opinions
+JOIN users
+    ON opinions.user_id = users.user_id
+LEFT JOIN (
+    SELECT
+        votes.opinion_id,
+        COUNT(*) FILTER (WHERE votes.vote_type = 1) AS agree_count,
+        COUNT(*) FILTER (WHERE votes.vote_type = 2) AS disagree_count,
+        COUNT(*) FILTER (WHERE votes.vote_type = 3) AS pass_count
+    FROM votes
+    WHERE votes.talk_session_id = sqlc.arg('talk_session_id')::uuid
+    GROUP BY votes.opinion_id
+) vc ON opinions.opinion_id = vc.opinion_id
+WHERE opinions.talk_session_id = sqlc.arg('talk_session_id')::uuid
+    AND opinions.deleted_at IS NULL
+    AND NOT EXISTS (
+        SELECT 1 FROM opinion_reports
+        WHERE opinion_reports.opinion_id = opinions.opinion_id
+            AND opinion_reports.status = 'deleted'
+    )
+ORDER BY opinions.created_at, opinions.opinion_id;
+
+-- name: GetUserVotesByTalkSessionID :many
+-- ユーザーのセッション内の投票
+-- 投稿者・運営が削除した意見は含めない
+SELECT
+    votes.opinion_id,
+    votes.vote_type
+FROM votes
+JOIN opinions
+    ON votes.opinion_id = opinions.opinion_id
+WHERE votes.talk_session_id = sqlc.arg('talk_session_id')::uuid
+    AND votes.user_id = sqlc.arg('user_id')::uuid
+    AND opinions.deleted_at IS NULL
+    AND NOT EXISTS (
+        SELECT 1 FROM opinion_reports
+        WHERE opinion_reports.opinion_id = opinions.opinion_id
+            AND opinion_reports.status = 'deleted'
+    );
+
+-- name: GetParticipantDemographicsByTalkSessionID :many
+-- 意見を投稿したか投票したユーザーの暗号化された属性
+-- 属性を登録していないユーザーは属性の列がNULLになる
+WITH participants AS (
+    SELECT votes.user_id FROM votes
+    WHERE votes.talk_session_id = sqlc.arg('talk_session_id')::uuid
+    UNION
+    SELECT opinions.user_id FROM opinions
+    WHERE opinions.talk_session_id = sqlc.arg('talk_session_id')::uuid
+)
+SELECT
+    participants.user_id,
+    user_demographics.user_demographics_id,
+    user_demographics.date_of_birth,
+    user_demographics.gender,
+    user_demographics.city,
+    user_demographics.prefecture
+FROM participants
+LEFT JOIN user_demographics
+    ON participants.user_id = user_demographics.user_id
+ORDER BY participants.user_id;
+
+-- name: GetGroupVotesByOpinionID :many
+-- 意見への投票とグループ。グループに分類されていないユーザーの投票は含めない
+SELECT
+    votes.user_id,
+    votes.vote_type,
+    user_group_info.group_id
+FROM votes
+JOIN user_group_info
+    ON votes.user_id = user_group_info.user_id
+    AND votes.talk_session_id = user_group_info.talk_session_id
+WHERE votes.opinion_id = sqlc.arg('opinion_id')::uuid
+ORDER BY user_group_info.group_id;
+
+-- name: GetTalkSessionOrganizationByOpinionID :one
+-- 意見のセッションと、セッションを作成した組織
+SELECT
+    talk_sessions.talk_session_id,
+    talk_sessions.organization_id
+FROM opinions
+JOIN talk_sessions
+    ON opinions.talk_session_id = talk_sessions.talk_session_id
+WHERE opinions.opinion_id = sqlc.arg('opinion_id')::uuid;
@@ -0,0 +1,52 @@
+-- name: CreateAnalysisJob :execrows
+-- 同じセッション・種類の待機中・実行中のジョブがある場合は作成しない
+INSERT INTO analysis_jobs (
+    analysis_job_id,
+    talk_session_id,
+    job_type,
+    status,
+    attempts,
+    run_after,
+    created_at,
+    updated_at
+) VALUES (
+    sqlc.arg('analysis_job_id'),
+    sqlc.arg('talk_session_id'),
+    sqlc.arg('job_type'),
+    sqlc.arg('status'),
+    sqlc.arg('attempts'),
+    sqlc.arg('run_after'),
+    sqlc.arg('created_at'),
+    sqlc.arg('created_at')
+)
+ON CONFLICT (talk_session_id, job_type) WHERE status IN ('queued', 'running') DO NOTHING;
+
+-- name: UpdateAnalysisJob :exec
+UPDATE analysis_jobs SET
+    status = sqlc.arg('status'),
+    attempts = sqlc.arg('attempts'),
+    last_error = sqlc.narg('last_error'),
+    run_after = sqlc.arg('run_after'),
+    started_at = sqlc.narg('started_at'),
+    finished_at = sqlc.narg('finished_at'),
+    updated_at = sqlc.arg('updated_at')
+WHERE analysis_job_id = sqlc.arg('analysis_job_id');
+
+-- name: FindAnalysisJobByID :one
+SELECT * FROM analysis_jobs
+WHERE analysis_job_id = $1;
+
+-- name: FindActiveAnalysisJob :one
+SELECT * FROM analysis_jobs
+WHERE talk_session_id = sqlc.arg('talk_session_id')
+    AND job_type = sqlc.arg('job_type')
+    AND status IN ('queued', 'running');
+
+-- name: FindRunnableAnalysisJobsForUpdate :many
+-- 実行を待っているジョブと、実行中のまま止まったジョブを古い順に取得する。他のサーバーが処理中の行は飛ばす
+SELECT * FROM analysis_jobs
+WHERE (status = 'queued' AND run_after <= sqlc.arg('now')::timestamp)
+    OR (status = 'running' AND started_at <= sqlc.arg('stale_before')::timestamp)
+ORDER BY run_after ASC
+LIMIT sqlc.arg('limit')::int
+FOR UPDATE SKIP LOCKED;
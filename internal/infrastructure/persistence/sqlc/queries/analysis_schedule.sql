@@ -0,0 +1,70 @@
+-- name: RecordAnalysisActivity :exec
+INSERT INTO analysis_schedules (
+    talk_session_id,
+    pending_vote_count,
+    pending_opinion_count,
+    pending_since,
+    updated_at
+) VALUES (
+    sqlc.arg('talk_session_id'),
+    sqlc.arg('vote_count')::int,
+    sqlc.arg('opinion_count')::int,
+    sqlc.arg('occurred_at')::timestamp,
+    sqlc.arg('occurred_at')::timestamp
+)
+ON CONFLICT (talk_session_id) DO UPDATE SET
+    pending_vote_count = analysis_schedules.pending_vote_count + EXCLUDED.pending_vote_count,
+    pending_opinion_count = analysis_schedules.pending_opinion_count + EXCLUDED.pending_opinion_count,
+    pending_since = LEAST(COALESCE(analysis_schedules.pending_since, EXCLUDED.pending_since), EXCLUDED.pending_since),
+    updated_at = EXCLUDED.updated_at;
+
+-- name: FindAnalysisScheduleByTalkSessionID :one
+SELECT * FROM analysis_schedules
+WHERE talk_session_id = $1;
+
+-- name: FindDueAnalysisSchedulesForUpdate :many
+-- 再計算の条件を満たしたスケジュールを古い順に取得する。実行中のセッションと、他のサーバーが処理中の行は飛ばす
+SELECT * FROM analysis_schedules
+WHERE pending_since IS NOT NULL
+    AND (running_since IS NULL OR running_since <= sqlc.arg('stale_before')::timestamp)
+    AND (
+        pending_vote_count >= sqlc.arg('vote_threshold')::int
+        OR pending_since <= sqlc.arg('delayed_before')::timestamp
+    )
+ORDER BY pending_since ASC
+LIMIT sqlc.arg('batch_size')::int
+FOR UPDATE SKIP LOCKED;
+
+-- name: LockAnalysisSchedule :one
+-- スケジュールがない場合は作成し、トランザクションの間ロックする
+INSERT INTO analysis_schedules (talk_session_id, updated_at)
+VALUES (sqlc.arg('talk_session_id'), sqlc.arg('now')::timestamp)
+ON CONFLICT (talk_session_id) DO UPDATE SET
+    updated_at = analysis_schedules.updated_at
+RETURNING *;
+
+-- name: StartAnalysisSchedule :exec
+UPDATE analysis_schedules SET
+    pending_vote_count = 0,
+    pending_opinion_count = 0,
+    pending_since = NULL,
+    running_since = sqlc.arg('started_at')::timestamp,
+    updated_at = sqlc.arg('started_at')::timestamp
+WHERE talk_session_id = sqlc.arg('talk_session_id');
+
+-- name: CompleteAnalysisSchedule :exec
+UPDATE analysis_schedules SET
+    running_since = NULL,
+    last_completed_at = sqlc.arg('completed_at')::timestamp,
+    updated_at = sqlc.arg('completed_at')::timestamp
+WHERE talk_session_id = sqlc.arg('talk_session_id');
+
+-- name: FailAnalysisSchedule :exec
+-- 失敗した場合は、分析を待っている活動として残して後で再実行する
+UPDATE analysis_schedules SET
+    running_since = NULL,
+    pending_since = COALESCE(pending_since, sqlc.arg('failed_at')::timestamp),
+    last_failed_at = sqlc.arg('failed_at')::timestamp,
+    last_failure_reason = sqlc.arg('failure_reason')::text,
+    updated_at = sqlc.arg('failed_at')::timestamp
+WHERE talk_session_id = sqlc.arg('talk_session_id');
@@ -0,0 +1,134 @@
+-- name: GetCurrentAnalysisFingerprint :one
+-- 現在のグループ分けと代表意見のハッシュ
+SELECT
+    md5(
+        COALESCE((
+            SELECT string_agg(
+                concat_ws(':', ugi.user_id, ugi.group_id, ugi.pos_x, ugi.pos_y, ugi.perimeter_index),
+                ',' ORDER BY ugi.user_id
+            )
+            FROM user_group_info ugi
+            WHERE ugi.talk_session_id = sqlc.arg('talk_session_id')::uuid
+        ), '')
+        || '|' ||
+        COALESCE((
+            SELECT string_agg(
+                concat_ws(':', ro.opinion_id, ro.group_id, ro.rank, ro.agree_count, ro.disagree_count, ro.pass_count),
+                ',' ORDER BY ro.group_id, ro.opinion_id
+            )
+            FROM representative_opinions ro
+            WHERE ro.talk_session_id = sqlc.arg('talk_session_id')::uuid
+        ), '')
+    )::text AS fingerprint,
+    (
+        SELECT COUNT(*)
+        FROM user_group_info ugi
+        WHERE ugi.talk_session_id = sqlc.arg('talk_session_id')::uuid
+    ) AS user_count,
+    (
+        SELECT COUNT(DISTINCT ugi.group_id)
+        FROM user_group_info ugi
+        WHERE ugi.talk_session_id = sqlc.arg('talk_session_id')::uuid
+    ) AS group_count;
+
+-- name: FindLatestAnalysisSnapshot :one
+SELECT * FROM analysis_snapshots
+WHERE talk_session_id = $1
+ORDER BY created_at DESC
+LIMIT 1;
+
+-- name: CreateAnalysisSnapshot :exec
+INSERT INTO analysis_snapshots (
+    analysis_snapshot_id,
+    talk_session_id,
+    fingerprint,
+    user_count,
+    group_count,
+    created_at
+) VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: CopyUserGroupInfoToAnalysisSnapshot :exec
+INSERT INTO analysis_snapshot_users (
+    analysis_snapshot_id,
+    user_id,
+    group_id,
+    pos_x,
+    pos_y,
+    perimeter_index
+)
+SELECT
+    sqlc.arg('analysis_snapshot_id')::uuid,
+    ugi.user_id,
+    ugi.group_id,
+    ugi.pos_x,
+    ugi.pos_y,
+    ugi.perimeter_index
+FROM user_group_info ugi
+WHERE ugi.talk_session_id = sqlc.arg('talk_session_id')::uuid;
+
+-- name: CopyRepresentativeOpinionsToAnalysisSnapshot :exec
+INSERT INTO analysis_snapshot_representative_opinions (
+    analysis_snapshot_id,
+    opinion_id,
+    group_id,
+    rank,
+    agree_count,
+    disagree_count,
+    pass_count
+)
+SELECT
+    sqlc.arg('analysis_snapshot_id')::uuid,
+    ro.opinion_id,
+    ro.group_id,
+    ro.rank,
+    ro.agree_count,
+    ro.disagree_count,
+    ro.pass_count
+FROM representative_opinions ro
+WHERE ro.talk_session_id = sqlc.arg('talk_session_id')::uuid;
+
+-- name: GetAnalysisSnapshotsByTalkSessionID :many
+SELECT * FROM analysis_snapshots
+WHERE talk_session_id = $1
+ORDER BY created_at ASC;
+
+-- name: FindAnalysisSnapshotByID :one
+SELECT * FROM analysis_snapshots
+WHERE analysis_snapshot_id = $1
+    AND talk_session_id = $2;
+
+-- name: GetAnalysisSnapshotUsers :many
+SELECT
+    analysis_snapshot_users.user_id,
+    analysis_snapshot_users.group_id,
+    analysis_snapshot_users.pos_x,
+    analysis_snapshot_users.pos_y,
+    analysis_snapshot_users.perimeter_index,
+    users.display_id AS display_id,
+    users.display_name AS display_name,
+    users.icon_url AS icon_url
+FROM analysis_snapshot_users
+LEFT JOIN users
+    ON analysis_snapshot_users.user_id = users.user_id
+WHERE analysis_snapshot_users.analysis_snapshot_id = $1
+ORDER BY analysis_snapshot_users.user_id;
+
+-- name: GetAnalysisSnapshotRepresentativeOpinions :many
+SELECT
+    sqlc.embed(analysis_snapshot_representative_opinions),
+    sqlc.embed(opinions),
+    sqlc.embed(users),
+    COALESCE(rc.reply_count, 0) AS reply_count
+FROM analysis_snapshot_representative_opinions
+LEFT JOIN opinions
+    ON analysis_snapshot_representative_opinions.opinion_id = opinions.opinion_id
+LEFT JOIN users
+    ON opinions.user_id = users.user_id
+LEFT JOIN (
+    SELECT COUNT(opinion_id) AS reply_count, parent_opinion_id
+    FROM opinions
+    GROUP BY parent_opinion_id
+) rc ON opinions.opinion_id = rc.parent_opinion_id
+WHERE analysis_snapshot_representative_opinions.analysis_snapshot_id = $1
+    AND analysis_snapshot_representative_opinions.rank < 4
+ORDER BY analysis_snapshot_representative_opinions.group_id, analysis_snapshot_representative_opinions.rank;
@@ -0,0 +1,44 @@
+-- name: CreateLoginAttempt :exec
+INSERT INTO login_attempts (
+    login_attempt_id,
+    user_id,
+    ip_address,
+    user_agent,
+    succeeded,
+    failure_reason,
+    attempted_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: FindLoginLockout :one
+SELECT * FROM login_lockouts WHERE scope = $1 AND lock_key = $2;
+
+-- name: LockLoginLockout :one
+-- 行がなければ失敗0回で作成し、トランザクションが終わるまで行をロックして返す
+INSERT INTO login_lockouts (
+    scope,
+    lock_key,
+    updated_at
+) VALUES ($1, $2, $3)
+ON CONFLICT (scope, lock_key) DO UPDATE SET
+    updated_at = login_lockouts.updated_at
+RETURNING *;
+
+-- name: UpsertLoginLockout :exec
+INSERT INTO login_lockouts (
+    scope,
+    lock_key,
+    failure_count,
+    lockout_count,
+    last_failed_at,
+    locked_until,
+    updated_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (scope, lock_key) DO UPDATE SET
+    failure_count = EXCLUDED.failure_count,
+    lockout_count = EXCLUDED.lockout_count,
+    last_failed_at = EXCLUDED.last_failed_at,
+    locked_until = EXCLUDED.locked_until,
+    updated_at = EXCLUDED.updated_at;
+
+-- name: DeleteLoginLockout :exec
+DELETE FROM login_lockouts WHERE scope = $1 AND lock_key = $2;
@@ -0,0 +1,26 @@
+-- name: CreateTokenSigningKey :exec
+INSERT INTO token_signing_keys (
+    key_id,
+    algorithm,
+    encrypted_private_key,
+    public_key,
+    status,
+    created_at
+) VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: RetireTokenSigningKey :exec
+UPDATE token_signing_keys
+SET status = 'retired',
+    retired_at = $2,
+    verify_until = $3
+WHERE key_id = $1;
+
+-- name: FindVerifiableTokenSigningKeys :many
+-- 署名中の鍵と、猶予期間内のローテーション済みの鍵を返す
+SELECT * FROM token_signing_keys
+WHERE status = 'active'
+    OR (status = 'retired' AND verify_until > sqlc.arg(now)::timestamptz)
+ORDER BY created_at DESC;
+
+-- name: FindActiveTokenSigningKeyForUpdate :one
+SELECT * FROM token_signing_keys WHERE status = 'active' FOR UPDATE;
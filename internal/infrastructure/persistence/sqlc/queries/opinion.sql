@@ -111,6 +111,7 @@ WHERE opinions.talk_session_id = $2
     AND opinions.parent_opinion_id IS NULL
     -- 削除されたものはスワイプ意見から除外
     AND (opr.opinion_id IS NULL OR opr.status != 'deleted')
+    AND opinions.deleted_at IS NULL
 ORDER BY RANDOM()
 LIMIT $3;
 
@@ -161,6 +162,7 @@ WHERE opinions.talk_session_id = sqlc.arg('talk_session_id')::uuid
     AND opinions.parent_opinion_id IS NULL
     -- 削除されたものはスワイプ意見から除外
     AND (opr.opinion_id IS NULL OR opr.status != 'deleted')
+    AND opinions.deleted_at IS NULL
     AND representative_opinions.rank = sqlc.arg('rank')::int
 LIMIT sqlc.arg('limit')::int
 ;
@@ -188,7 +190,8 @@ LEFT JOIN (
 WHERE opinions.talk_session_id = $2
     AND vote_count.opinion_id = opinions.opinion_id
     AND opinions.parent_opinion_id IS NULL
-    AND (opr.opinion_id IS NULL OR opr.status != 'deleted');
+    AND (opr.opinion_id IS NULL OR opr.status != 'deleted')
+    AND opinions.deleted_at IS NULL;
 
 -- name: GetOpinionsByUserID :many
 SELECT
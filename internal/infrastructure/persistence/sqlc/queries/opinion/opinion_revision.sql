@@ -0,0 +1,29 @@
+-- name: UpdateOpinion :exec
+UPDATE opinions
+SET
+    title = $2,
+    content = $3,
+    reference_url = $4,
+    revision = $5,
+    edited_at = $6,
+    deleted_at = $7
+WHERE opinion_id = $1;
+
+-- name: CreateOpinionRevision :exec
+INSERT INTO opinion_revisions (
+    opinion_id,
+    revision,
+    title,
+    content,
+    reference_url,
+    vote_count,
+    votes_reset,
+    created_at,
+    replaced_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+
+-- name: FindOpinionRevisionsByOpinionID :many
+SELECT *
+FROM opinion_revisions
+WHERE opinion_id = $1
+ORDER BY revision DESC;
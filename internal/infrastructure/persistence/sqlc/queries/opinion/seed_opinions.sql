@@ -27,5 +27,16 @@ WHERE opinions.talk_session_id = $1
     AND vote_count.opinion_id = opinions.opinion_id
     AND opinions.parent_opinion_id IS NULL
     AND opinions.user_id = '00000000-0000-0000-0000-000000000001'::uuid
+    AND opinions.deleted_at IS NULL
 LIMIT $3;
 
+
+-- name: GetSeedOpinionsByTalkSessionID :many
+-- セッションのシード意見を投稿順に取得する
+SELECT *
+FROM opinions
+WHERE opinions.talk_session_id = $1
+    AND opinions.parent_opinion_id IS NULL
+    AND opinions.user_id = '00000000-0000-0000-0000-000000000001'::uuid
+    AND opinions.deleted_at IS NULL
+ORDER BY opinions.created_at ASC;
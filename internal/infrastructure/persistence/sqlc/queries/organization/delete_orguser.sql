@@ -0,0 +1,4 @@
+-- name: DeleteOrgUser :exec
+DELETE FROM organization_users
+WHERE organization_id = $1
+  AND user_id = $2;
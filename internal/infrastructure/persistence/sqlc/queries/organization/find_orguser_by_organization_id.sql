@@ -3,3 +3,13 @@ SELECT
     sqlc.embed(organization_users)
 FROM organization_users
 WHERE organization_id = $1;
+
+-- name: LockOrgUsersByOrganizationIDAndRole :many
+-- 同時に降格・削除してオーナーがいなくならないよう、該当する行を一定の順でロックする
+SELECT
+    sqlc.embed(organization_users)
+FROM organization_users
+WHERE organization_id = $1
+  AND role = $2
+ORDER BY organization_user_id
+FOR UPDATE;
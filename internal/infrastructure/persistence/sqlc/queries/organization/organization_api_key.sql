@@ -0,0 +1,38 @@
+-- name: CreateOrganizationAPIKey :exec
+INSERT INTO organization_api_keys (
+    api_key_id,
+    organization_id,
+    name,
+    key_prefix,
+    key_hash,
+    scopes,
+    created_by,
+    expires_at,
+    created_at,
+    updated_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $9);
+
+-- name: FindOrganizationAPIKeyByID :one
+SELECT * FROM organization_api_keys
+WHERE api_key_id = $1;
+
+-- name: FindOrganizationAPIKeyByHash :one
+SELECT * FROM organization_api_keys
+WHERE key_hash = $1;
+
+-- name: FindOrganizationAPIKeysByOrganizationID :many
+SELECT * FROM organization_api_keys
+WHERE organization_id = $1
+ORDER BY created_at DESC;
+
+-- name: RevokeOrganizationAPIKey :exec
+UPDATE organization_api_keys
+SET revoked_at = $2,
+    revoked_by = $3,
+    updated_at = $2
+WHERE api_key_id = $1 AND revoked_at IS NULL;
+
+-- name: UpdateOrganizationAPIKeyLastUsed :exec
+UPDATE organization_api_keys
+SET last_used_at = $2
+WHERE api_key_id = $1;
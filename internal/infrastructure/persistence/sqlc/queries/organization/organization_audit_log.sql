@@ -0,0 +1,35 @@
+-- name: CreateOrganizationAuditLog :exec
+INSERT INTO organization_audit_logs (
+    audit_log_id,
+    organization_id,
+    actor_id,
+    action,
+    target_type,
+    target_id,
+    changes,
+    created_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: FindOrganizationAuditLogs :many
+SELECT *
+FROM organization_audit_logs
+WHERE organization_id = sqlc.arg('organization_id')::uuid
+  AND (sqlc.narg('action')::text IS NULL OR action = sqlc.narg('action')::text)
+  AND (sqlc.narg('actor_id')::uuid IS NULL OR actor_id = sqlc.narg('actor_id')::uuid)
+  AND (sqlc.narg('since')::timestamptz IS NULL OR created_at >= sqlc.narg('since')::timestamptz)
+  AND (sqlc.narg('until')::timestamptz IS NULL OR created_at < sqlc.narg('until')::timestamptz)
+  AND (
+    sqlc.narg('before_created_at')::timestamptz IS NULL
+    OR (created_at, audit_log_id) < (sqlc.narg('before_created_at')::timestamptz, sqlc.narg('before_audit_log_id')::uuid)
+  )
+ORDER BY created_at DESC, audit_log_id DESC
+LIMIT sqlc.arg('limit')::int OFFSET sqlc.arg('offset')::int;
+
+-- name: CountOrganizationAuditLogs :one
+SELECT COUNT(*) AS count
+FROM organization_audit_logs
+WHERE organization_id = sqlc.arg('organization_id')::uuid
+  AND (sqlc.narg('action')::text IS NULL OR action = sqlc.narg('action')::text)
+  AND (sqlc.narg('actor_id')::uuid IS NULL OR actor_id = sqlc.narg('actor_id')::uuid)
+  AND (sqlc.narg('since')::timestamptz IS NULL OR created_at >= sqlc.narg('since')::timestamptz)
+  AND (sqlc.narg('until')::timestamptz IS NULL OR created_at < sqlc.narg('until')::timestamptz);
@@ -0,0 +1,107 @@
+-- name: FindChildOrganizations :many
+SELECT
+    sqlc.embed(organizations)
+FROM organizations
+WHERE parent_organization_id = $1
+ORDER BY name ASC;
+
+-- name: FindAncestorOrganizationIDs :many
+-- 近い順に祖先の組織IDを返す（自身は含まない）
+WITH RECURSIVE ancestors AS (
+    SELECT
+        organizations.parent_organization_id AS organization_id,
+        1 AS depth
+    FROM organizations
+    WHERE organizations.organization_id = sqlc.arg('organization_id')
+        AND organizations.parent_organization_id IS NOT NULL
+    UNION ALL
+    SELECT
+        organizations.parent_organization_id,
+        ancestors.depth + 1
+    FROM organizations
+    JOIN ancestors ON organizations.organization_id = ancestors.organization_id
+    WHERE organizations.parent_organization_id IS NOT NULL
+        AND ancestors.depth < sqlc.arg('max_depth')::int
+)
+SELECT ancestors.organization_id::uuid AS organization_id
+FROM ancestors
+ORDER BY ancestors.depth ASC;
+
+-- name: FindDescendantOrganizations :many
+-- 子孫の組織を返す（自身は含まない）
+WITH RECURSIVE descendants AS (
+    SELECT
+        organizations.organization_id,
+        1 AS depth
+    FROM organizations
+    WHERE organizations.parent_organization_id = sqlc.arg('organization_id')
+    UNION ALL
+    SELECT
+        organizations.organization_id,
+        descendants.depth + 1
+    FROM organizations
+    JOIN descendants ON organizations.parent_organization_id = descendants.organization_id
+    WHERE descendants.depth < sqlc.arg('max_depth')::int
+)
+SELECT
+    sqlc.embed(organizations)
+FROM organizations
+JOIN descendants ON organizations.organization_id = descendants.organization_id
+ORDER BY descendants.depth ASC, organizations.name ASC;
+
+-- name: FindEffectiveOrgUserByOrganizationIDAndUserID :one
+-- 組織および祖先の組織の所属のうち、最も強いロールを返す
+WITH RECURSIVE ancestors AS (
+    SELECT
+        organizations.organization_id,
+        organizations.parent_organization_id,
+        0 AS depth
+    FROM organizations
+    WHERE organizations.organization_id = sqlc.arg('organization_id')
+    UNION ALL
+    SELECT
+        organizations.organization_id,
+        organizations.parent_organization_id,
+        ancestors.depth + 1
+    FROM organizations
+    JOIN ancestors ON organizations.organization_id = ancestors.parent_organization_id
+    WHERE ancestors.depth < sqlc.arg('max_depth')::int
+)
+SELECT
+    sqlc.embed(organization_users),
+    ancestors.depth
+FROM organization_users
+JOIN ancestors ON organization_users.organization_id = ancestors.organization_id
+WHERE organization_users.user_id = sqlc.arg('user_id')
+ORDER BY organization_users.role ASC, ancestors.depth ASC
+LIMIT 1;
+
+-- name: FindInheritedOrgUserByUserIDWithOrganization :many
+-- 所属組織の子孫の組織を、継承元の所属とともに返す
+WITH RECURSIVE subtree AS (
+    SELECT
+        organization_users.organization_user_id,
+        organizations.organization_id,
+        1 AS depth
+    FROM organization_users
+    JOIN organizations ON organizations.parent_organization_id = organization_users.organization_id
+    WHERE organization_users.user_id = sqlc.arg('user_id')
+    UNION ALL
+    SELECT
+        subtree.organization_user_id,
+        organizations.organization_id,
+        subtree.depth + 1
+    FROM subtree
+    JOIN organizations ON organizations.parent_organization_id = subtree.organization_id
+    WHERE subtree.depth < sqlc.arg('max_depth')::int
+)
+SELECT
+    sqlc.embed(users),
+    sqlc.embed(organization_users),
+    sqlc.embed(organizations)
+FROM subtree
+JOIN organization_users ON organization_users.organization_user_id = subtree.organization_user_id
+JOIN organizations ON organizations.organization_id = subtree.organization_id
+JOIN users ON organization_users.user_id = users.user_id
+WHERE users.withdrawal_date IS NULL
+ORDER BY organization_users.role ASC, subtree.depth ASC;
@@ -0,0 +1,52 @@
+-- name: CreateOrganizationInvitation :exec
+INSERT INTO organization_invitations (
+    invitation_id,
+    organization_id,
+    email,
+    email_hash,
+    role,
+    token_hash,
+    status,
+    invited_by,
+    expires_at,
+    send_count,
+    last_sent_at,
+    created_at,
+    updated_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $12);
+
+-- name: UpdateOrganizationInvitation :exec
+UPDATE organization_invitations
+SET token_hash = $2,
+    status = $3,
+    expires_at = $4,
+    send_count = $5,
+    last_sent_at = $6,
+    accepted_by = $7,
+    accepted_at = $8,
+    revoked_at = $9,
+    updated_at = $10
+WHERE invitation_id = $1;
+
+-- name: FindOrganizationInvitationByID :one
+SELECT *
+FROM organization_invitations
+WHERE invitation_id = $1;
+
+-- name: FindOrganizationInvitationByTokenHash :one
+SELECT *
+FROM organization_invitations
+WHERE token_hash = $1;
+
+-- name: FindPendingOrganizationInvitationByEmailHash :one
+SELECT *
+FROM organization_invitations
+WHERE organization_id = $1
+  AND email_hash = $2
+  AND status = 'pending';
+
+-- name: FindOrganizationInvitationsByOrganizationID :many
+SELECT *
+FROM organization_invitations
+WHERE organization_id = $1
+ORDER BY created_at DESC;
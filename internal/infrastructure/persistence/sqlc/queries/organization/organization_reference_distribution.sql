@@ -0,0 +1,27 @@
+-- name: FindOrganizationReferenceDistributions :many
+SELECT * FROM organization_reference_distributions
+WHERE organization_id = $1
+ORDER BY dimension;
+
+-- name: FindOrganizationReferenceDistribution :one
+SELECT * FROM organization_reference_distributions
+WHERE organization_id = $1 AND dimension = $2;
+
+-- name: SaveOrganizationReferenceDistribution :exec
+INSERT INTO organization_reference_distributions (
+    organization_id,
+    dimension,
+    categories,
+    updated_by,
+    updated_at
+) VALUES (
+    sqlc.arg('organization_id'),
+    sqlc.arg('dimension'),
+    sqlc.arg('categories'),
+    sqlc.narg('updated_by'),
+    sqlc.arg('updated_at')
+)
+ON CONFLICT (organization_id, dimension) DO UPDATE SET
+    categories = EXCLUDED.categories,
+    updated_by = EXCLUDED.updated_by,
+    updated_at = EXCLUDED.updated_at;
@@ -0,0 +1,23 @@
+-- name: FindOrganizationReportPrompt :one
+SELECT * FROM organization_report_prompts
+WHERE organization_id = $1;
+
+-- name: SaveOrganizationReportPrompt :exec
+INSERT INTO organization_report_prompts (
+    organization_id,
+    system_prompt,
+    instructions,
+    updated_by,
+    updated_at
+) VALUES (
+    sqlc.arg('organization_id'),
+    sqlc.arg('system_prompt'),
+    sqlc.arg('instructions'),
+    sqlc.narg('updated_by'),
+    sqlc.arg('updated_at')
+)
+ON CONFLICT (organization_id) DO UPDATE SET
+    system_prompt = EXCLUDED.system_prompt,
+    instructions = EXCLUDED.instructions,
+    updated_by = EXCLUDED.updated_by,
+    updated_at = EXCLUDED.updated_at;
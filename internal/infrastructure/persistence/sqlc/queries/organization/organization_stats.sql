@@ -0,0 +1,70 @@
+-- name: GetOrganizationStatsBuckets :many
+-- 期間内の集計を日・週・月単位で返す。参加者数は単位ごとに重複を除いて数える
+WITH stats AS (
+    SELECT
+        date_trunc(sqlc.arg('bucket')::text, organization_daily_stats.stat_date)::date AS bucket_date,
+        SUM(organization_daily_stats.talk_sessions_created)::bigint AS talk_sessions_created,
+        SUM(organization_daily_stats.opinions)::bigint AS opinions,
+        SUM(organization_daily_stats.votes)::bigint AS votes,
+        SUM(organization_daily_stats.consents)::bigint AS consents,
+        SUM(organization_daily_stats.report_feedbacks)::bigint AS report_feedbacks
+    FROM organization_daily_stats
+    WHERE organization_daily_stats.organization_id = ANY(sqlc.arg('organization_ids')::uuid[])
+        AND organization_daily_stats.stat_date >= sqlc.arg('since')::date
+        AND organization_daily_stats.stat_date < sqlc.arg('until')::date
+    GROUP BY 1
+), participants AS (
+    SELECT
+        date_trunc(sqlc.arg('bucket')::text, organization_daily_participants.stat_date)::date AS bucket_date,
+        COUNT(DISTINCT organization_daily_participants.user_id)::bigint AS participants
+    FROM organization_daily_participants
+    WHERE organization_daily_participants.organization_id = ANY(sqlc.arg('organization_ids')::uuid[])
+        AND organization_daily_participants.stat_date >= sqlc.arg('since')::date
+        AND organization_daily_participants.stat_date < sqlc.arg('until')::date
+    GROUP BY 1
+)
+SELECT
+    COALESCE(stats.bucket_date, participants.bucket_date)::date AS bucket_date,
+    COALESCE(stats.talk_sessions_created, 0)::bigint AS talk_sessions_created,
+    COALESCE(participants.participants, 0)::bigint AS participants,
+    COALESCE(stats.opinions, 0)::bigint AS opinions,
+    COALESCE(stats.votes, 0)::bigint AS votes,
+    COALESCE(stats.consents, 0)::bigint AS consents,
+    COALESCE(stats.report_feedbacks, 0)::bigint AS report_feedbacks
+FROM stats
+FULL OUTER JOIN participants ON participants.bucket_date = stats.bucket_date
+ORDER BY 1 ASC;
+
+-- name: GetOrganizationStatsTotal :one
+-- 期間全体の集計を返す。参加者数は期間全体で重複を除いて数える
+SELECT
+    COALESCE((
+        SELECT SUM(talk_sessions_created) FROM organization_daily_stats
+        WHERE organization_id = ANY(sqlc.arg('organization_ids')::uuid[])
+            AND stat_date >= sqlc.arg('since')::date AND stat_date < sqlc.arg('until')::date
+    ), 0)::bigint AS talk_sessions_created,
+    (
+        SELECT COUNT(DISTINCT user_id) FROM organization_daily_participants
+        WHERE organization_id = ANY(sqlc.arg('organization_ids')::uuid[])
+            AND stat_date >= sqlc.arg('since')::date AND stat_date < sqlc.arg('until')::date
+    )::bigint AS participants,
+    COALESCE((
+        SELECT SUM(opinions) FROM organization_daily_stats
+        WHERE organization_id = ANY(sqlc.arg('organization_ids')::uuid[])
+            AND stat_date >= sqlc.arg('since')::date AND stat_date < sqlc.arg('until')::date
+    ), 0)::bigint AS opinions,
+    COALESCE((
+        SELECT SUM(votes) FROM organization_daily_stats
+        WHERE organization_id = ANY(sqlc.arg('organization_ids')::uuid[])
+            AND stat_date >= sqlc.arg('since')::date AND stat_date < sqlc.arg('until')::date
+    ), 0)::bigint AS votes,
+    COALESCE((
+        SELECT SUM(consents) FROM organization_daily_stats
+        WHERE organization_id = ANY(sqlc.arg('organization_ids')::uuid[])
+            AND stat_date >= sqlc.arg('since')::date AND stat_date < sqlc.arg('until')::date
+    ), 0)::bigint AS consents,
+    COALESCE((
+        SELECT SUM(report_feedbacks) FROM organization_daily_stats
+        WHERE organization_id = ANY(sqlc.arg('organization_ids')::uuid[])
+            AND stat_date >= sqlc.arg('since')::date AND stat_date < sqlc.arg('until')::date
+    ), 0)::bigint AS report_feedbacks;
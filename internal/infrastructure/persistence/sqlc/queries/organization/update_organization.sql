@@ -1,6 +1,7 @@
 -- name: UpdateOrganization :exec
 UPDATE organizations SET
     name = $2,
-    icon_url = $3
+    icon_url = $3,
+    parent_organization_id = $4,
+    owner_id = $5
 WHERE organization_id = $1;
-
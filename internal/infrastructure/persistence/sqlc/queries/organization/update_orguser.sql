@@ -0,0 +1,6 @@
+-- name: UpdateOrgUserRole :exec
+UPDATE organization_users
+SET role = $3,
+    updated_at = $4
+WHERE organization_id = $1
+  AND user_id = $2;
@@ -0,0 +1,81 @@
+-- name: CreateOwnershipTransfer :exec
+INSERT INTO ownership_transfers (
+    transfer_id,
+    target_type,
+    talk_session_id,
+    organization_id,
+    from_user_id,
+    to_user_id,
+    requested_by,
+    status,
+    expires_at,
+    created_at,
+    updated_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $10);
+
+-- name: UpdateOwnershipTransfer :exec
+UPDATE ownership_transfers
+SET status = $2,
+    responded_at = $3,
+    updated_at = $4
+WHERE transfer_id = $1;
+
+-- name: FindOwnershipTransferByID :one
+SELECT *
+FROM ownership_transfers
+WHERE transfer_id = $1;
+
+-- name: LockOwnershipTransferByID :one
+SELECT *
+FROM ownership_transfers
+WHERE transfer_id = $1
+FOR UPDATE;
+
+-- name: FindPendingOwnershipTransferByTalkSessionID :one
+SELECT *
+FROM ownership_transfers
+WHERE talk_session_id = $1
+  AND status = 'pending'
+  AND expires_at > $2
+ORDER BY created_at DESC
+LIMIT 1;
+
+-- name: FindPendingOwnershipTransferByOrganizationID :one
+SELECT *
+FROM ownership_transfers
+WHERE organization_id = $1
+  AND target_type = 'organization'
+  AND status = 'pending'
+  AND expires_at > $2
+ORDER BY created_at DESC
+LIMIT 1;
+
+-- name: FindOwnershipTransferDetails :many
+-- 譲渡依頼に対象の名前と関係するユーザーの表示情報を付けて取得する
+SELECT
+    sqlc.embed(ot),
+    ts.theme AS talk_session_theme,
+    o.name AS organization_name,
+    o.code AS organization_code,
+    fu.display_id AS from_display_id,
+    fu.display_name AS from_display_name,
+    fu.icon_url AS from_icon_url,
+    tu.display_id AS to_display_id,
+    tu.display_name AS to_display_name,
+    tu.icon_url AS to_icon_url
+FROM ownership_transfers ot
+LEFT JOIN talk_sessions ts ON ot.talk_session_id = ts.talk_session_id
+LEFT JOIN organizations o ON ot.organization_id = o.organization_id
+JOIN users fu ON ot.from_user_id = fu.user_id
+JOIN users tu ON ot.to_user_id = tu.user_id
+WHERE
+    CASE WHEN sqlc.narg('transfer_id')::uuid IS NOT NULL
+        THEN ot.transfer_id = sqlc.narg('transfer_id')::uuid
+        ELSE TRUE
+    END
+    AND CASE WHEN sqlc.narg('user_id')::uuid IS NOT NULL
+        THEN sqlc.narg('user_id')::uuid IN (ot.from_user_id, ot.to_user_id, ot.requested_by)
+        ELSE TRUE
+    END
+ORDER BY ot.created_at DESC
+LIMIT 100;
@@ -0,0 +1,117 @@
+-- name: GetGeneratedReport :one
+-- 生成されたままのレポート。公開するバージョンに関係なく最後に生成されたものを返す
+SELECT
+    talk_session_id,
+    report,
+    sections,
+    updated_at
+FROM talk_session_reports
+WHERE talk_session_id = $1;
+
+-- name: SaveGeneratedReport :exec
+-- アプリケーション内で生成したレポートを保存する
+INSERT INTO talk_session_reports (
+    talk_session_id,
+    report,
+    sections,
+    created_at,
+    updated_at
+) VALUES (
+    sqlc.arg('talk_session_id'),
+    sqlc.arg('report'),
+    sqlc.narg('sections'),
+    sqlc.arg('generated_at'),
+    sqlc.arg('generated_at')
+)
+ON CONFLICT (talk_session_id) DO UPDATE SET
+    report = EXCLUDED.report,
+    sections = EXCLUDED.sections,
+    updated_at = EXCLUDED.updated_at;
+
+-- name: FindLatestReportVersion :one
+SELECT * FROM talk_session_report_histories
+WHERE talk_session_id = $1
+ORDER BY version DESC
+LIMIT 1;
+
+-- name: FindReportVersionByID :one
+SELECT * FROM talk_session_report_histories
+WHERE talk_session_report_history_id = $1;
+
+-- name: CreateReportVersion :exec
+INSERT INTO talk_session_report_histories (
+    talk_session_report_history_id,
+    talk_session_id,
+    version,
+    report,
+    generator,
+    model,
+    sections,
+    created_at
+) VALUES (
+    sqlc.arg('talk_session_report_history_id'),
+    sqlc.arg('talk_session_id'),
+    sqlc.arg('version'),
+    sqlc.arg('report'),
+    sqlc.arg('generator'),
+    sqlc.narg('model'),
+    sqlc.narg('sections'),
+    sqlc.arg('created_at')
+);
+
+-- name: GetReportVersionsWithFeedback :many
+-- セッションのレポートのバージョンを新しい順に、フィードバックの件数とあわせて返す
+SELECT
+    talk_session_report_histories.talk_session_report_history_id,
+    talk_session_report_histories.version,
+    talk_session_report_histories.generator,
+    talk_session_report_histories.model,
+    talk_session_report_histories.created_at,
+    COALESCE(feedback.good_count, 0)::int AS good_count,
+    COALESCE(feedback.bad_count, 0)::int AS bad_count,
+    (talk_session_report_publications.talk_session_id IS NOT NULL)::boolean AS published,
+    COALESCE(talk_session_report_publications.pinned, FALSE)::boolean AS pinned
+FROM talk_session_report_histories
+LEFT JOIN (
+    SELECT
+        report_feedback.talk_session_report_history_id,
+        COUNT(*) FILTER (WHERE report_feedback.feedback_type = 1) AS good_count,
+        COUNT(*) FILTER (WHERE report_feedback.feedback_type = 2) AS bad_count
+    FROM report_feedback
+    GROUP BY report_feedback.talk_session_report_history_id
+) feedback ON feedback.talk_session_report_history_id = talk_session_report_histories.talk_session_report_history_id
+LEFT JOIN talk_session_report_publications
+    ON talk_session_report_publications.talk_session_report_history_id = talk_session_report_histories.talk_session_report_history_id
+WHERE talk_session_report_histories.talk_session_id = $1
+ORDER BY talk_session_report_histories.version DESC;
+
+-- name: FindReportPublication :one
+SELECT * FROM talk_session_report_publications
+WHERE talk_session_id = $1;
+
+-- name: SaveReportPublication :exec
+INSERT INTO talk_session_report_publications (
+    talk_session_id,
+    talk_session_report_history_id,
+    pinned,
+    published_by,
+    published_at
+) VALUES (
+    sqlc.arg('talk_session_id'),
+    sqlc.arg('talk_session_report_history_id'),
+    sqlc.arg('pinned'),
+    sqlc.narg('published_by'),
+    sqlc.arg('published_at')
+)
+ON CONFLICT (talk_session_id) DO UPDATE SET
+    talk_session_report_history_id = EXCLUDED.talk_session_report_history_id,
+    pinned = EXCLUDED.pinned,
+    published_by = EXCLUDED.published_by,
+    published_at = EXCLUDED.published_at;
+
+-- name: ClaimReportNotification :execrows
+-- 前回の通知から間隔が空いている場合のみ通知日時を記録する。更新した行がなければ通知しない
+UPDATE talk_session_report_publications
+SET notified_at = sqlc.arg('notified_at')::timestamp
+WHERE talk_session_id = sqlc.arg('talk_session_id')
+    AND (notified_at IS NULL OR notified_at <= sqlc.arg('notified_before')::timestamp);
@@ -1,6 +1,7 @@
 -- name: GetUnprocessedEndedSessions :many
 SELECT * FROM talk_sessions
 WHERE scheduled_end_time < NOW()
+  AND is_draft = FALSE
   AND NOT EXISTS (
     SELECT 1 FROM domain_events
     WHERE aggregate_id = talk_sessions.talk_session_id::text
@@ -18,7 +19,7 @@ INNER JOIN opinions o ON u.user_id = o.user_id
 WHERE o.talk_session_id = $1;
 
 -- name: CreateTalkSession :exec
-INSERT INTO talk_sessions (talk_session_id, theme, description, thumbnail_url, owner_id, scheduled_end_time, created_at, city, prefecture, restrictions, hide_report, organization_id, organization_alias_id, hide_top) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14);
+INSERT INTO talk_sessions (talk_session_id, theme, description, thumbnail_url, owner_id, scheduled_end_time, created_at, city, prefecture, restrictions, hide_report, organization_id, organization_alias_id, hide_top, is_draft) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15);
 
 -- name: CreateTalkSessionLocation :exec
 INSERT INTO talk_session_locations (talk_session_id, location) VALUES ($1, ST_GeographyFromText($2));
@@ -42,7 +43,9 @@ UPDATE talk_sessions
         updated_at = NOW(),
         organization_id = $10,
         organization_alias_id = $11,
-        hide_top = $12
+        hide_top = $12,
+        is_draft = $13,
+        owner_id = $14
     WHERE talk_session_id = $1;
 
 -- name: GetTalkSessionByID :one
@@ -116,6 +119,7 @@ LEFT JOIN talk_session_locations
     ON ts.talk_session_id = talk_session_locations.talk_session_id
 WHERE
     ts.hide_top = FALSE AND
+    ts.is_draft = FALSE AND
     CASE sqlc.narg('status')::text
         WHEN 'finished' THEN ts.scheduled_end_time <= now()
         WHEN 'open' THEN ts.scheduled_end_time > now()
@@ -170,6 +174,7 @@ LEFT JOIN votes
     ON votes.talk_session_id = talk_sessions.talk_session_id
 WHERE
     talk_sessions.hide_top = FALSE AND
+    talk_sessions.is_draft = FALSE AND
     CASE
         WHEN sqlc.narg('user_id')::uuid IS NOT NULL
             THEN votes.user_id = sqlc.narg('user_id')::uuid
@@ -195,6 +200,7 @@ WITH filtered_sessions AS (
     LEFT JOIN users ON ts.owner_id = users.user_id
     WHERE
         users.display_id = sqlc.arg('display_id')::text
+        AND ts.is_draft = FALSE
         AND
         CASE sqlc.narg('status')::text
             WHEN 'finished' THEN ts.scheduled_end_time <= now()
@@ -280,3 +286,53 @@ LIMIT $1 OFFSET $2;
 SELECT
     COUNT(DISTINCT talk_sessions.talk_session_id) AS talk_session_count
 FROM talk_sessions;
+
+-- name: GetTalkSessionsByOrganizationIDsWithCount :many
+WITH filtered_sessions AS (
+    SELECT ts.talk_session_id
+    FROM talk_sessions ts
+    WHERE
+        ts.organization_id = ANY(sqlc.arg('organization_ids')::uuid[])
+        AND ts.is_draft = FALSE
+        AND
+        CASE sqlc.narg('status')::text
+            WHEN 'finished' THEN ts.scheduled_end_time <= now()
+            WHEN 'open' THEN ts.scheduled_end_time > now()
+            ELSE TRUE
+        END
+        AND
+        CASE
+            WHEN sqlc.narg('theme')::text IS NOT NULL
+                THEN ts.theme LIKE '%' || sqlc.narg('theme')::text || '%'
+            ELSE TRUE
+        END
+)
+SELECT
+    sqlc.embed(ts),
+    COALESCE(oc.opinion_count, 0) AS opinion_count,
+    sqlc.embed(users),
+    COALESCE(organization_aliases.alias_name, '') AS alias_name,
+    COALESCE(organization_aliases.alias_id, '00000000-0000-0000-0000-000000000000'::uuid) AS alias_id,
+    COALESCE(organization_aliases.organization_id, '00000000-0000-0000-0000-000000000000'::uuid) AS organization_id,
+    COALESCE(ST_Y(ST_GeomFromWKB(ST_AsBinary(talk_session_locations.location))),0)::float AS latitude,
+    COALESCE(ST_X(ST_GeomFromWKB(ST_AsBinary(talk_session_locations.location))),0)::float AS longitude,
+    (SELECT COUNT(*) FROM filtered_sessions) AS total_count
+FROM talk_sessions ts
+INNER JOIN filtered_sessions fs ON fs.talk_session_id = ts.talk_session_id
+LEFT JOIN (
+    SELECT talk_session_id, COUNT(opinion_id) AS opinion_count
+    FROM opinions
+    GROUP BY talk_session_id
+) oc ON oc.talk_session_id = ts.talk_session_id
+LEFT JOIN users ON ts.owner_id = users.user_id
+LEFT JOIN talk_session_locations ON talk_session_locations.talk_session_id = ts.talk_session_id
+LEFT JOIN organization_aliases ON ts.organization_alias_id = organization_aliases.alias_id
+ORDER BY ts.created_at DESC
+LIMIT sqlc.narg('limit') OFFSET sqlc.narg('offset');
+
+-- name: FindTalkSessionIDsByOrganizationIDAndOwnerID :many
+SELECT talk_session_id
+FROM talk_sessions
+WHERE organization_id = $1
+  AND owner_id = $2
+ORDER BY created_at ASC;
@@ -0,0 +1,30 @@
+-- name: UpsertTalkSessionCollaborator :exec
+INSERT INTO talk_session_collaborators (
+    talk_session_id,
+    user_id,
+    role,
+    added_by,
+    created_at,
+    updated_at
+) VALUES ($1, $2, $3, $4, $5, $5)
+ON CONFLICT (talk_session_id, user_id)
+DO UPDATE SET
+    role = EXCLUDED.role,
+    updated_at = EXCLUDED.updated_at;
+
+-- name: FindTalkSessionCollaborator :one
+SELECT * FROM talk_session_collaborators
+WHERE talk_session_id = $1 AND user_id = $2;
+
+-- name: FindTalkSessionCollaboratorsByTalkSessionID :many
+SELECT
+    sqlc.embed(talk_session_collaborators),
+    sqlc.embed(users)
+FROM talk_session_collaborators
+JOIN users ON talk_session_collaborators.user_id = users.user_id
+WHERE talk_session_collaborators.talk_session_id = $1
+ORDER BY talk_session_collaborators.created_at ASC;
+
+-- name: DeleteTalkSessionCollaborator :exec
+DELETE FROM talk_session_collaborators
+WHERE talk_session_id = $1 AND user_id = $2;
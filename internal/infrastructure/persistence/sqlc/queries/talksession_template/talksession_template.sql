@@ -0,0 +1,29 @@
+-- name: CreateTalkSessionTemplate :exec
+INSERT INTO talk_session_templates (
+    template_id,
+    organization_id,
+    organization_alias_id,
+    name,
+    theme,
+    description,
+    thumbnail_url,
+    city,
+    prefecture,
+    restrictions,
+    hide_top,
+    seed_opinions,
+    created_by,
+    created_at,
+    updated_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $14);
+
+-- name: FindTalkSessionTemplateByID :one
+SELECT * FROM talk_session_templates WHERE template_id = $1;
+
+-- name: FindTalkSessionTemplatesByOrganizationID :many
+SELECT * FROM talk_session_templates
+WHERE organization_id = $1
+ORDER BY created_at DESC;
+
+-- name: DeleteTalkSessionTemplate :exec
+DELETE FROM talk_session_templates WHERE template_id = $1;
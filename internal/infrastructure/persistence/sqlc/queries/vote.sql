@@ -5,11 +5,18 @@ INSERT INTO votes (
     talk_session_id,
     user_id,
     vote_type,
+    is_important,
     created_at
-) VALUES ($1, $2, $3, $4, $5, $6);
+) VALUES ($1, $2, $3, $4, $5, $6, $7);
 
 -- name: FindVoteByUserIDAndOpinionID :one
 SELECT * FROM votes WHERE user_id = $1 AND opinion_id = $2;
 
 -- name: UpdateVote :exec
-UPDATE votes SET vote_type = $3 WHERE user_id = $1 AND opinion_id = $2;
+UPDATE votes SET vote_type = $3, is_important = $4 WHERE user_id = $1 AND opinion_id = $2;
+
+-- name: FindVotesByOpinionID :many
+SELECT * FROM votes WHERE opinion_id = $1;
+
+-- name: DeleteVote :exec
+DELETE FROM votes WHERE vote_id = $1;
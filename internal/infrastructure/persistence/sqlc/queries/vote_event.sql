@@ -0,0 +1,72 @@
+-- name: CreateVoteEvent :exec
+INSERT INTO vote_events (
+    vote_event_id,
+    vote_id,
+    opinion_id,
+    talk_session_id,
+    user_id,
+    previous_vote_type,
+    vote_type,
+    reason,
+    created_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9);
+
+-- name: GetVoteChangeSummaryByTalkSessionID :one
+-- ユーザーの投票のみを対象に、セッション全体の投票の変更を集計する
+SELECT
+    COUNT(DISTINCT user_id)::int AS voter_count,
+    (COUNT(*) FILTER (WHERE previous_vote_type <> 0))::int AS change_count,
+    (COUNT(DISTINCT user_id) FILTER (WHERE previous_vote_type <> 0))::int AS changed_user_count
+FROM vote_events
+WHERE talk_session_id = $1
+    AND reason = 'voted';
+
+-- name: GetVoteShiftsByTalkSessionID :many
+-- 投票を変更したユーザーごとに最初と最後の投票を比べ、意見と変化の組み合わせごとに集計する
+-- 最後の投票より前にその意見への返信に投票していれば、返信を読んでから変更したとみなす
+-- 意見の編集で投票がリセットされた場合は、編集前の意見への投票と比べないよう最後のリセット以降の投票のみを対象にする
+WITH last_resets AS (
+    SELECT
+        vote_events.opinion_id,
+        vote_events.user_id,
+        MAX(vote_events.created_at) AS reset_at
+    FROM vote_events
+    WHERE vote_events.talk_session_id = sqlc.arg('talk_session_id')::uuid
+        AND vote_events.reason = 'opinion_edited'
+    GROUP BY vote_events.opinion_id, vote_events.user_id
+),
+user_votes AS (
+    SELECT
+        vote_events.opinion_id,
+        vote_events.user_id,
+        (ARRAY_AGG(vote_events.vote_type ORDER BY vote_events.created_at ASC))[1]::smallint AS first_vote_type,
+        (ARRAY_AGG(vote_events.vote_type ORDER BY vote_events.created_at DESC))[1]::smallint AS last_vote_type,
+        MAX(vote_events.created_at)::timestamp AS last_voted_at,
+        (COUNT(*) - 1)::int AS change_count
+    FROM vote_events
+    LEFT JOIN last_resets
+        ON last_resets.opinion_id = vote_events.opinion_id
+        AND last_resets.user_id = vote_events.user_id
+    WHERE vote_events.talk_session_id = sqlc.arg('talk_session_id')::uuid
+        AND vote_events.reason = 'voted'
+        AND (last_resets.reset_at IS NULL OR vote_events.created_at > last_resets.reset_at)
+    GROUP BY vote_events.opinion_id, vote_events.user_id
+)
+SELECT
+    user_votes.opinion_id,
+    user_votes.first_vote_type,
+    user_votes.last_vote_type,
+    SUM(user_votes.change_count)::int AS change_count,
+    COUNT(*)::int AS user_count,
+    (COUNT(*) FILTER (WHERE EXISTS (
+        SELECT 1
+        FROM votes
+        JOIN opinions ON opinions.opinion_id = votes.opinion_id
+        WHERE opinions.parent_opinion_id = user_votes.opinion_id
+            AND votes.user_id = user_votes.user_id
+            AND votes.created_at < user_votes.last_voted_at
+    )))::int AS after_replies_user_count
+FROM user_votes
+WHERE user_votes.change_count > 0
+GROUP BY user_votes.opinion_id, user_votes.first_vote_type, user_votes.last_vote_type
+ORDER BY user_votes.opinion_id, user_votes.first_vote_type, user_votes.last_vote_type;
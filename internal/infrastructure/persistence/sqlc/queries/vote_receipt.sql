@@ -0,0 +1,25 @@
+-- name: CreateVoteReceipt :execrows
+-- 同じ冪等キーの処理結果がすでにある場合は作成しない。同時に送信された場合は先に作成した側の処理を待つ
+INSERT INTO vote_receipts (
+    user_id,
+    idempotency_key,
+    opinion_id,
+    vote_type,
+    error_code,
+    error_message,
+    client_voted_at,
+    created_at
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+ON CONFLICT (user_id, idempotency_key) DO NOTHING;
+
+-- name: UpdateVoteReceiptError :exec
+UPDATE vote_receipts
+SET error_code = $3,
+    error_message = $4
+WHERE user_id = $1
+    AND idempotency_key = $2;
+
+-- name: FindVoteReceiptsByKeys :many
+SELECT * FROM vote_receipts
+WHERE user_id = sqlc.arg('user_id')
+    AND idempotency_key = ANY(sqlc.arg('idempotency_keys')::text[]);
@@ -9,7 +9,11 @@ import (
 )
 
 // ProcessReportedOpinions 通報された意見の内容を置き換える関数
+// 投稿者により削除された意見も合わせて置き換える
 func ProcessReportedOpinions(opinions []dto.SwipeOpinion, reports []model.FindReportByOpinionIDsRow) []dto.SwipeOpinion {
+	for i := range opinions {
+		opinions[i].MaskDeleted()
+	}
 	if len(reports) == 0 {
 		return opinions
 	}
@@ -28,6 +32,7 @@ func ProcessReportedOpinions(opinions []dto.SwipeOpinion, reports []model.FindRe
 
 // ProcessSingleReportedOpinion 単一の通報された意見を処理する
 func ProcessSingleReportedOpinion(opinion *dto.SwipeOpinion, reports []model.FindReportByOpinionIDsRow) {
+	opinion.MaskDeleted()
 	if len(reports) == 0 {
 		return
 	}
@@ -41,6 +46,9 @@ func ProcessSingleReportedOpinion(opinion *dto.SwipeOpinion, reports []model.Fin
 
 // ProcessReportedOpinionsWithRepresentative 代表意見のある通報された意見を処理する
 func ProcessReportedOpinionsWithRepresentative(opinions []dto.OpinionWithRepresentative, reports []model.FindReportByOpinionIDsRow) []dto.OpinionWithRepresentative {
+	for i := range opinions {
+		opinions[i].MaskDeleted()
+	}
 	if len(reports) == 0 {
 		return opinions
 	}
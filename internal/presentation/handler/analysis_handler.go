@@ -2,27 +2,61 @@ package handler
 
 import (
 	"context"
+	"time"
 
+	"github.com/neko-dream/api/internal/application/query/analysis_query"
 	"github.com/neko-dream/api/internal/application/usecase/analysis_usecase"
 	"github.com/neko-dream/api/internal/domain/messages"
 	"github.com/neko-dream/api/internal/domain/model/analysis"
 	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
 	"github.com/neko-dream/api/internal/domain/service"
 	"github.com/neko-dream/api/internal/presentation/oas"
+	"github.com/neko-dream/api/pkg/utils"
 	"go.opentelemetry.io/otel"
 )
 
 type analysisHandler struct {
 	applyFeedbackUseCase analysis_usecase.ApplyFeedbackUseCase
+	getVoteShiftsQuery   analysis_query.GetVoteShiftsQuery
+	getSnapshotsQuery    analysis_query.GetAnalysisSnapshotsQuery
+	getSnapshotQuery     analysis_query.GetAnalysisSnapshotQuery
+	getSnapshotDiffQuery analysis_query.GetAnalysisSnapshotDiffQuery
+	getConsensusQuery    analysis_query.GetConsensusQuery
+	getTermsQuery        analysis_query.GetTermFrequenciesQuery
+	getTopicsQuery       analysis_query.GetTopicsQuery
+	getMyPositionQuery   analysis_query.GetMyPositionQuery
+	getStatusQuery       analysis_query.GetAnalysisStatusQuery
+	getJobQuery          analysis_query.GetAnalysisJobQuery
 	authorizationService service.AuthorizationService
 }
 
 func NewAnalysisHandler(
 	applyFeedbackUseCase analysis_usecase.ApplyFeedbackUseCase,
+	getVoteShiftsQuery analysis_query.GetVoteShiftsQuery,
+	getSnapshotsQuery analysis_query.GetAnalysisSnapshotsQuery,
+	getSnapshotQuery analysis_query.GetAnalysisSnapshotQuery,
+	getSnapshotDiffQuery analysis_query.GetAnalysisSnapshotDiffQuery,
+	getConsensusQuery analysis_query.GetConsensusQuery,
+	getTermsQuery analysis_query.GetTermFrequenciesQuery,
+	getTopicsQuery analysis_query.GetTopicsQuery,
+	getMyPositionQuery analysis_query.GetMyPositionQuery,
+	getStatusQuery analysis_query.GetAnalysisStatusQuery,
+	getJobQuery analysis_query.GetAnalysisJobQuery,
 	authorizationService service.AuthorizationService,
 ) oas.AnalysisHandler {
 	return &analysisHandler{
 		applyFeedbackUseCase: applyFeedbackUseCase,
+		getVoteShiftsQuery:   getVoteShiftsQuery,
+		getSnapshotsQuery:    getSnapshotsQuery,
+		getSnapshotQuery:     getSnapshotQuery,
+		getSnapshotDiffQuery: getSnapshotDiffQuery,
+		getConsensusQuery:    getConsensusQuery,
+		getTermsQuery:        getTermsQuery,
+		getTopicsQuery:       getTopicsQuery,
+		getMyPositionQuery:   getMyPositionQuery,
+		getStatusQuery:       getStatusQuery,
+		getJobQuery:          getJobQuery,
 		authorizationService: authorizationService,
 	}
 }
@@ -59,3 +93,374 @@ func (a *analysisHandler) ApplyFeedbackToReport(ctx context.Context, req *oas.Ap
 	res := oas.ApplyFeedbackToReportOK{}
 	return &res, nil
 }
+
+// GetVoteShifts 投票の変化
+func (a *analysisHandler) GetVoteShifts(ctx context.Context, params oas.GetVoteShiftsParams) (oas.GetVoteShiftsRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "analysisHandler.GetVoteShifts")
+	defer span.End()
+
+	talkSessionID, err := shared.ParseUUID[talksession.TalkSession](params.TalkSessionID)
+	if err != nil {
+		return nil, messages.BadRequestError
+	}
+
+	out, err := a.getVoteShiftsQuery.Execute(ctx, analysis_query.GetVoteShiftsInput{
+		TalkSessionID: talkSessionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	shifts := make([]oas.VoteShift, 0, len(out.Shifts))
+	for _, shift := range out.Shifts {
+		shifts = append(shifts, shift.ToResponse())
+	}
+	opinions := make([]oas.OpinionVoteShift, 0, len(out.Opinions))
+	for _, op := range out.Opinions {
+		opinions = append(opinions, op.ToResponse())
+	}
+
+	return &oas.GetVoteShiftsOK{
+		VoterCount:       out.VoterCount,
+		ChangeCount:      out.ChangeCount,
+		ChangedUserCount: out.ChangedUserCount,
+		Shifts:           shifts,
+		Opinions:         opinions,
+	}, nil
+}
+
+// GetAnalysisSnapshots 分析結果のスナップショット一覧
+func (a *analysisHandler) GetAnalysisSnapshots(ctx context.Context, params oas.GetAnalysisSnapshotsParams) (oas.GetAnalysisSnapshotsRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "analysisHandler.GetAnalysisSnapshots")
+	defer span.End()
+
+	talkSessionID, err := shared.ParseUUID[talksession.TalkSession](params.TalkSessionID)
+	if err != nil {
+		return nil, messages.BadRequestError
+	}
+
+	out, err := a.getSnapshotsQuery.Execute(ctx, analysis_query.GetAnalysisSnapshotsInput{
+		TalkSessionID: talkSessionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]oas.AnalysisSnapshot, 0, len(out.Snapshots))
+	for _, snapshot := range out.Snapshots {
+		snapshots = append(snapshots, snapshot.ToResponse())
+	}
+
+	return &oas.GetAnalysisSnapshotsOK{
+		Snapshots: snapshots,
+	}, nil
+}
+
+// GetAnalysisSnapshot 分析結果のスナップショット
+func (a *analysisHandler) GetAnalysisSnapshot(ctx context.Context, params oas.GetAnalysisSnapshotParams) (oas.GetAnalysisSnapshotRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "analysisHandler.GetAnalysisSnapshot")
+	defer span.End()
+
+	talkSessionID, err := shared.ParseUUID[talksession.TalkSession](params.TalkSessionID)
+	if err != nil {
+		return nil, messages.BadRequestError
+	}
+	snapshotID, err := shared.ParseUUID[analysis.AnalysisSnapshot](params.SnapshotID)
+	if err != nil {
+		return nil, messages.BadRequestError
+	}
+
+	out, err := a.getSnapshotQuery.Execute(ctx, analysis_query.GetAnalysisSnapshotInput{
+		TalkSessionID:      talkSessionID,
+		AnalysisSnapshotID: snapshotID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	positions := make([]oas.UserGroupPosition, 0, len(out.Positions))
+	for _, position := range out.Positions {
+		positions = append(positions, position.ToResponse())
+	}
+
+	groupOpinions := make([]oas.AnalysisSnapshotGroup, 0, len(out.GroupOpinions))
+	for _, groupOpinion := range out.GroupOpinions {
+		opinions := make([]oas.AnalysisSnapshotOpinion, 0, len(groupOpinion.Opinions))
+		for _, opinion := range groupOpinion.Opinions {
+			opinions = append(opinions, oas.AnalysisSnapshotOpinion{
+				Opinion: opinion.Opinion.ToResponse(),
+				User: oas.User{
+					DisplayID:   opinion.User.DisplayID,
+					DisplayName: opinion.User.DisplayName,
+					IconURL:     utils.ToOptNil[oas.OptNilString](opinion.User.IconURL),
+				},
+				AgreeCount:    opinion.AgreeCount,
+				DisagreeCount: opinion.DisagreeCount,
+				PassCount:     opinion.PassCount,
+			})
+		}
+		groupOpinions = append(groupOpinions, oas.AnalysisSnapshotGroup{
+			GroupName: groupOpinion.GroupName,
+			GroupID:   groupOpinion.GroupID,
+			Opinions:  opinions,
+		})
+	}
+
+	return &oas.GetAnalysisSnapshotOK{
+		Snapshot:      out.Snapshot.ToResponse(),
+		Positions:     positions,
+		GroupOpinions: groupOpinions,
+	}, nil
+}
+
+// GetAnalysisSnapshotDiff 分析結果のスナップショットの差分
+func (a *analysisHandler) GetAnalysisSnapshotDiff(ctx context.Context, params oas.GetAnalysisSnapshotDiffParams) (oas.GetAnalysisSnapshotDiffRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "analysisHandler.GetAnalysisSnapshotDiff")
+	defer span.End()
+
+	talkSessionID, err := shared.ParseUUID[talksession.TalkSession](params.TalkSessionID)
+	if err != nil {
+		return nil, messages.BadRequestError
+	}
+	from, err := shared.ParseUUID[analysis.AnalysisSnapshot](params.From)
+	if err != nil {
+		return nil, messages.BadRequestError
+	}
+	to, err := shared.ParseUUID[analysis.AnalysisSnapshot](params.To)
+	if err != nil {
+		return nil, messages.BadRequestError
+	}
+
+	out, err := a.getSnapshotDiffQuery.Execute(ctx, analysis_query.GetAnalysisSnapshotDiffInput{
+		TalkSessionID: talkSessionID,
+		From:          from,
+		To:            to,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	moves := make([]oas.GroupMove, 0, len(out.Moves))
+	for _, move := range out.Moves {
+		moves = append(moves, move.ToResponse())
+	}
+
+	return &oas.GetAnalysisSnapshotDiffOK{
+		From:  out.From.ToResponse(),
+		To:    out.To.ToResponse(),
+		Moves: moves,
+	}, nil
+}
+
+// GetConsensus グループ間で合意している意見と賛否が分かれている意見
+func (a *analysisHandler) GetConsensus(ctx context.Context, params oas.GetConsensusParams) (oas.GetConsensusRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "analysisHandler.GetConsensus")
+	defer span.End()
+
+	talkSessionID, err := shared.ParseUUID[talksession.TalkSession](params.TalkSessionID)
+	if err != nil {
+		return nil, messages.BadRequestError
+	}
+
+	out, err := a.getConsensusQuery.Execute(ctx, analysis_query.GetConsensusInput{
+		TalkSessionID: talkSessionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	consensus := make([]oas.OpinionConsensus, 0, len(out.Consensus))
+	for _, op := range out.Consensus {
+		consensus = append(consensus, op.ToResponse())
+	}
+	divisive := make([]oas.OpinionConsensus, 0, len(out.Divisive))
+	for _, op := range out.Divisive {
+		divisive = append(divisive, op.ToResponse())
+	}
+
+	return &oas.GetConsensusOK{
+		Consensus: consensus,
+		Divisive:  divisive,
+	}, nil
+}
+
+// GetTermFrequencies ワードクラウドの単語の集計
+func (a *analysisHandler) GetTermFrequencies(ctx context.Context, params oas.GetTermFrequenciesParams) (oas.GetTermFrequenciesRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "analysisHandler.GetTermFrequencies")
+	defer span.End()
+
+	talkSessionID, err := shared.ParseUUID[talksession.TalkSession](params.TalkSessionID)
+	if err != nil {
+		return nil, messages.BadRequestError
+	}
+
+	out, err := a.getTermsQuery.Execute(ctx, analysis_query.GetTermFrequenciesInput{
+		TalkSessionID: talkSessionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]oas.GroupTerms, 0, len(out.TermFrequencies.Groups))
+	for _, group := range out.TermFrequencies.Groups {
+		groups = append(groups, oas.GroupTerms{
+			GroupID:      int(group.GroupID),
+			GroupName:    group.GroupID.String(),
+			OpinionCount: group.OpinionCount,
+			Terms:        termScoresToResponse(group.Terms),
+		})
+	}
+
+	return &oas.TermFrequencies{
+		WordcloudURL: out.TermFrequencies.WordCloudURL,
+		Overall:      termScoresToResponse(out.TermFrequencies.Overall),
+		Groups:       groups,
+		GeneratedAt:  out.TermFrequencies.GeneratedAt.Format(time.RFC3339),
+	}, nil
+}
+
+func termScoresToResponse(terms []analysis.TermScore) []oas.TermScore {
+	res := make([]oas.TermScore, 0, len(terms))
+	for _, term := range terms {
+		res = append(res, oas.TermScore{
+			Term:  term.Term,
+			Count: term.Count,
+			Score: term.Score,
+		})
+	}
+	return res
+}
+
+// GetTopics 意見のトピック
+func (a *analysisHandler) GetTopics(ctx context.Context, params oas.GetTopicsParams) (oas.GetTopicsRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "analysisHandler.GetTopics")
+	defer span.End()
+
+	talkSessionID, err := shared.ParseUUID[talksession.TalkSession](params.TalkSessionID)
+	if err != nil {
+		return nil, messages.BadRequestError
+	}
+
+	var limit, offset *int
+	if params.OpinionLimit.IsSet() {
+		limit = &params.OpinionLimit.Value
+	}
+	if params.OpinionOffset.IsSet() {
+		offset = &params.OpinionOffset.Value
+	}
+
+	out, err := a.getTopicsQuery.Execute(ctx, analysis_query.GetTopicsInput{
+		TalkSessionID: talkSessionID,
+		OpinionLimit:  limit,
+		OpinionOffset: offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	topics := make([]oas.Topic, 0, len(out.Topics))
+	for _, topic := range out.Topics {
+		topics = append(topics, topic.ToResponse())
+	}
+
+	return &oas.GetTopicsOK{
+		Topics:                 topics,
+		UnassignedOpinionCount: out.UnassignedOpinionCount,
+	}, nil
+}
+
+// GetMyAnalysisPosition 自分の分析結果での位置
+func (a *analysisHandler) GetMyAnalysisPosition(ctx context.Context, params oas.GetMyAnalysisPositionParams) (oas.GetMyAnalysisPositionRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "analysisHandler.GetMyAnalysisPosition")
+	defer span.End()
+
+	authCtx, err := a.authorizationService.RequireAuthentication(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	talkSessionID, err := shared.ParseUUID[talksession.TalkSession](params.TalkSessionID)
+	if err != nil {
+		return nil, messages.BadRequestError
+	}
+
+	out, err := a.getMyPositionQuery.Execute(ctx, analysis_query.GetMyPositionInput{
+		TalkSessionID: talkSessionID,
+		UserID:        authCtx.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	distances := make([]oas.GroupDistance, 0, len(out.Distances))
+	for _, d := range out.Distances {
+		distances = append(distances, d.ToResponse())
+	}
+	agree := make([]oas.OpinionAlignment, 0, len(out.AgreeWithGroup))
+	for _, op := range out.AgreeWithGroup {
+		agree = append(agree, op.ToResponse())
+	}
+	disagree := make([]oas.OpinionAlignment, 0, len(out.DisagreeWithGroup))
+	for _, op := range out.DisagreeWithGroup {
+		disagree = append(disagree, op.ToResponse())
+	}
+	suggestions := make([]oas.OpinionSuggestion, 0, len(out.Suggestions))
+	for _, op := range out.Suggestions {
+		suggestions = append(suggestions, op.ToResponse())
+	}
+
+	return &oas.GetMyAnalysisPositionOK{
+		MyPosition:        out.Position.ToResponse(),
+		GroupDistances:    distances,
+		AgreeWithGroup:    agree,
+		DisagreeWithGroup: disagree,
+		Suggestions:       suggestions,
+	}, nil
+}
+
+// GetAnalysisStatus 分析の再計算の状況
+func (a *analysisHandler) GetAnalysisStatus(ctx context.Context, params oas.GetAnalysisStatusParams) (oas.GetAnalysisStatusRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "analysisHandler.GetAnalysisStatus")
+	defer span.End()
+
+	talkSessionID, err := shared.ParseUUID[talksession.TalkSession](params.TalkSessionID)
+	if err != nil {
+		return nil, messages.BadRequestError
+	}
+
+	out, err := a.getStatusQuery.Execute(ctx, analysis_query.GetAnalysisStatusInput{
+		TalkSessionID: talkSessionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := out.Status.ToResponse()
+	return &res, nil
+}
+
+// GetAnalysisJob レポート・ワードクラウドの生成ジョブの状況
+func (a *analysisHandler) GetAnalysisJob(ctx context.Context, params oas.GetAnalysisJobParams) (oas.GetAnalysisJobRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "analysisHandler.GetAnalysisJob")
+	defer span.End()
+
+	talkSessionID, err := shared.ParseUUID[talksession.TalkSession](params.TalkSessionID)
+	if err != nil {
+		return nil, messages.BadRequestError
+	}
+	jobID, err := shared.ParseUUID[analysis.AnalysisJob](params.JobID)
+	if err != nil {
+		return nil, messages.BadRequestError
+	}
+
+	out, err := a.getJobQuery.Execute(ctx, analysis_query.GetAnalysisJobInput{
+		TalkSessionID: talkSessionID,
+		JobID:         jobID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := out.Job.ToResponse()
+	return &res, nil
+}
@@ -2,15 +2,20 @@ package handler
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
+	"net/netip"
 	"time"
 
 	"github.com/neko-dream/api/internal/application/usecase/auth_usecase"
 	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/auth/signing_key"
 	"github.com/neko-dream/api/internal/domain/model/session"
 	"github.com/neko-dream/api/internal/domain/model/shared"
 	"github.com/neko-dream/api/internal/domain/model/user"
 	"github.com/neko-dream/api/internal/domain/service"
+	"github.com/neko-dream/api/internal/infrastructure/auth/jwt"
+	"github.com/neko-dream/api/internal/infrastructure/config"
 	"github.com/neko-dream/api/internal/infrastructure/http/cookie"
 	"github.com/neko-dream/api/internal/presentation/oas"
 	cookie_utils "github.com/neko-dream/api/pkg/cookie"
@@ -32,8 +37,10 @@ type authHandler struct {
 	changePassword   auth_usecase.ChangePassword
 	reactivate       auth_usecase.Reactivate
 
+	keyRing              signing_key.KeyRing
 	authorizationService service.AuthorizationService
 	cookie.CookieManager
+	trustedProxies []netip.Prefix
 }
 
 func NewAuthHandler(
@@ -48,9 +55,16 @@ func NewAuthHandler(
 	changePassword auth_usecase.ChangePassword,
 	reactivate auth_usecase.Reactivate,
 
+	keyRing signing_key.KeyRing,
 	authorizationService service.AuthorizationService,
 	cookieManger cookie.CookieManager,
+	cfg *config.Config,
 ) oas.AuthHandler {
+	trustedProxies, err := http_utils.ParseTrustedProxies(cfg.TrustedProxies)
+	if err != nil {
+		panic(err)
+	}
+
 	return &authHandler{
 		AuthLogin:            authLogin,
 		AuthCallback:         authCallback,
@@ -63,6 +77,8 @@ func NewAuthHandler(
 		passwordRegister:     register,
 		changePassword:       changePassword,
 		reactivate:           reactivate,
+		keyRing:              keyRing,
+		trustedProxies:       trustedProxies,
 	}
 }
 
@@ -245,9 +261,17 @@ func (a *authHandler) PasswordLogin(ctx context.Context, req *oas.PasswordLoginR
 	ctx, span := otel.Tracer("handler").Start(ctx, "authHandler.PasswordLogin")
 	defer span.End()
 
+	var ipAddress, userAgent *string
+	if request := http_utils.GetHTTPRequest(ctx); request != nil {
+		ipAddress = lo.EmptyableToPtr(http_utils.GetClientIP(request, a.trustedProxies))
+		userAgent = lo.EmptyableToPtr(request.Header.Get("User-Agent"))
+	}
+
 	out, err := a.passwordLogin.Execute(ctx, auth_usecase.PasswordLoginInput{
 		IDorEmail: req.IdOrEmail,
 		Password:  req.Password,
+		IPAddress: ipAddress,
+		UserAgent: userAgent,
 	})
 	if err != nil {
 		return nil, err
@@ -339,3 +363,39 @@ func (a *authHandler) ReactivateUser(ctx context.Context) (oas.ReactivateUserRes
 		User:    output.User.ToResponse(),
 	}, nil
 }
+
+// GetJwks トークン検証用の公開鍵をJWKSとして返す
+func (a *authHandler) GetJwks(ctx context.Context) (oas.GetJwksRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "authHandler.GetJwks")
+	defer span.End()
+
+	keys, err := a.keyRing.VerifiableKeys(ctx)
+	if err != nil {
+		utils.HandleError(ctx, err, "KeyRing.VerifiableKeys")
+		return nil, messages.InternalServerError
+	}
+
+	res := &oas.JsonWebKeySet{
+		Keys: make([]oas.JsonWebKey, 0, len(keys)),
+	}
+	for _, key := range keys {
+		jwkKey, err := jwt.PublicJWK(key)
+		if err != nil {
+			utils.HandleError(ctx, err, "jwt.PublicJWK")
+			return nil, messages.InternalServerError
+		}
+		b, err := json.Marshal(jwkKey)
+		if err != nil {
+			utils.HandleError(ctx, err, "json.Marshal")
+			return nil, messages.InternalServerError
+		}
+		var jsonWebKey oas.JsonWebKey
+		if err := jsonWebKey.UnmarshalJSON(b); err != nil {
+			utils.HandleError(ctx, err, "JsonWebKey.UnmarshalJSON")
+			return nil, messages.InternalServerError
+		}
+		res.Keys = append(res.Keys, jsonWebKey)
+	}
+
+	return res, nil
+}
@@ -19,6 +19,7 @@ type handlers struct {
 	oas.HealthHandler
 	oas.AnalysisHandler
 	oas.NotificationsHandler
+	oas.OwnershipHandler
 }
 
 func NewHandler(
@@ -36,6 +37,7 @@ func NewHandler(
 	healthHandler oas.HealthHandler,
 	analysisHandler oas.AnalysisHandler,
 	notificationsHandler oas.NotificationsHandler,
+	ownershipHandler oas.OwnershipHandler,
 ) oas.Handler {
 	return &handlers{
 		AuthHandler:          authHandler,
@@ -52,5 +54,6 @@ func NewHandler(
 		HealthHandler:        healthHandler,
 		AnalysisHandler:      analysisHandler,
 		NotificationsHandler: notificationsHandler,
+		OwnershipHandler:     ownershipHandler,
 	}
 }
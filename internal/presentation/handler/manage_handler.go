@@ -6,8 +6,12 @@ import (
 	"errors"
 	"time"
 
+	"github.com/neko-dream/api/internal/application/analysis_scheduler"
+	"github.com/neko-dream/api/internal/application/query/analysis_query"
+	"github.com/neko-dream/api/internal/application/usecase/manage_usecase"
 	"github.com/neko-dream/api/internal/domain/messages"
 	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/auth/signing_key"
 	"github.com/neko-dream/api/internal/domain/model/session"
 	"github.com/neko-dream/api/internal/domain/model/shared"
 	"github.com/neko-dream/api/internal/domain/model/talksession"
@@ -20,11 +24,17 @@ import (
 )
 
 type manageHandler struct {
-	analysis.AnalysisService
 	analysis.AnalysisRepository
+	analysis.AnalysisJobService
 	*db.DBManager
 	authorizationService service.AuthorizationService
 	session.TokenManager
+	keyRing                signing_key.KeyRing
+	toggleReportVisibility manage_usecase.ToggleReportVisibilityCommand
+	publishReportVersion   manage_usecase.PublishReportVersionCommand
+	getReportVersions      analysis_query.GetReportVersionsQuery
+	getReportVersionDiff   analysis_query.GetReportVersionDiffQuery
+	analysisScheduler      *analysis_scheduler.AnalysisScheduler
 }
 
 // GetUserListManage implements oas.ManageHandler.
@@ -126,17 +136,29 @@ func (m *manageHandler) GetUserStatsListManage(ctx context.Context, params oas.G
 
 func NewManageHandler(
 	dbm *db.DBManager,
-	ansv analysis.AnalysisService,
 	arep analysis.AnalysisRepository,
+	jobService analysis.AnalysisJobService,
 	authorizationService service.AuthorizationService,
 	tokenManager session.TokenManager,
+	keyRing signing_key.KeyRing,
+	toggleReportVisibility manage_usecase.ToggleReportVisibilityCommand,
+	publishReportVersion manage_usecase.PublishReportVersionCommand,
+	getReportVersions analysis_query.GetReportVersionsQuery,
+	getReportVersionDiff analysis_query.GetReportVersionDiffQuery,
+	analysisScheduler *analysis_scheduler.AnalysisScheduler,
 ) oas.ManageHandler {
 	return &manageHandler{
-		DBManager:            dbm,
-		AnalysisService:      ansv,
-		AnalysisRepository:   arep,
-		authorizationService: authorizationService,
-		TokenManager:         tokenManager,
+		DBManager:              dbm,
+		AnalysisRepository:     arep,
+		AnalysisJobService:     jobService,
+		authorizationService:   authorizationService,
+		TokenManager:           tokenManager,
+		keyRing:                keyRing,
+		toggleReportVisibility: toggleReportVisibility,
+		publishReportVersion:   publishReportVersion,
+		getReportVersions:      getReportVersions,
+		getReportVersionDiff:   getReportVersionDiff,
+		analysisScheduler:      analysisScheduler,
 	}
 }
 
@@ -275,31 +297,32 @@ func (m *manageHandler) ManageRegenerateManage(ctx context.Context, req *oas.Reg
 		return nil, err
 	}
 
+	res := &oas.RegenerateResponse{
+		Message: "success",
+		Status:  "success",
+	}
 	switch tpt {
 	case "group":
-		if err := m.AnalysisService.StartAnalysis(ctx, talkSessionID); err != nil {
-			utils.HandleError(ctx, err, "AnalysisService.StartAnalysis")
+		// スケジューラーによる再計算と同時に実行しないよう、スケジューラーを通して実行する
+		if err := m.analysisScheduler.RunNow(ctx, talkSessionID); err != nil {
+			utils.HandleError(ctx, err, "AnalysisScheduler.RunNow")
 			return nil, err
 		}
-	case "report":
-		if err := m.AnalysisService.GenerateReport(ctx, talkSessionID); err != nil {
-			utils.HandleError(ctx, err, "AnalysisService.GenerateReport")
+	case "report", "image":
+		// レポート・画像の生成は時間がかかるのでジョブとして登録し、状況はジョブIDで確認する
+		jobType := analysis.AnalysisJobTypeReport
+		if tpt == "image" {
+			jobType = analysis.AnalysisJobTypeWordCloud
+		}
+		job, err := m.AnalysisJobService.Enqueue(ctx, talkSessionID, jobType)
+		if err != nil {
+			utils.HandleError(ctx, err, "AnalysisJobService.Enqueue")
 			return nil, err
 		}
-	case "image":
-		// 非同期で画像生成
-		go func() {
-			if _, err := m.AnalysisService.GenerateImage(ctx, talkSessionID); err != nil {
-				utils.HandleError(ctx, err, "AnalysisService.GenerateImage")
-				return
-			}
-		}()
+		res.JobID = oas.NewOptString(job.JobID().String())
 	}
 
-	return &oas.RegenerateResponse{
-		Message: "success",
-		Status:  "success",
-	}, nil
+	return res, nil
 }
 
 // ToggleReportVisibilityManage implements oas.ManageHandler.
@@ -307,20 +330,26 @@ func (m *manageHandler) ToggleReportVisibilityManage(ctx context.Context, req *o
 	ctx, span := otel.Tracer("handler").Start(ctx, "manageHandler.ToggleReportVisibilityManage")
 	defer span.End()
 
-	if !m.authorizationService.IsKotohiro(m.SetSession(ctx)) {
+	ctx = m.SetSession(ctx)
+	if !m.authorizationService.IsKotohiro(ctx) {
 		return nil, messages.ForbiddenError
 	}
+	authCtx, err := m.authorizationService.RequireAuthentication(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	talkSessionID, err := shared.ParseUUID[talksession.TalkSession](params.TalkSessionID)
 	if err != nil {
 		return nil, messages.BadRequestError
 	}
 
-	if err := m.GetQueries(ctx).UpdateTalkSessionHideReport(ctx, model.UpdateTalkSessionHideReportParams{
-		TalkSessionID: talkSessionID.UUID(),
-		HideReport:    sql.NullBool{Bool: req.Hidden, Valid: true},
+	if err := m.toggleReportVisibility.Execute(ctx, manage_usecase.ToggleReportVisibilityInput{
+		UserID:         authCtx.UserID,
+		OrganizationID: *authCtx.OrganizationID,
+		TalkSessionID:  talkSessionID,
+		Hidden:         req.Hidden,
 	}); err != nil {
-
 		return nil, err
 	}
 
@@ -364,6 +393,182 @@ func (m *manageHandler) GetAnalysisReportManage(ctx context.Context, params oas.
 	}
 
 	return &oas.AnalysisReportResponse{
-		Report: oas.OptString{Value: *res.Report, Set: true},
+		Report:          oas.OptString{Value: *res.Report, Set: true},
+		ReportVersionID: oas.NewOptString(res.AnalysisReportID.String()),
+	}, nil
+}
+
+// GetReportVersionsManage レポートのバージョンを新しい順に返す
+func (m *manageHandler) GetReportVersionsManage(ctx context.Context, params oas.GetReportVersionsManageParams) ([]oas.ReportVersionForManage, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "manageHandler.GetReportVersionsManage")
+	defer span.End()
+
+	if !m.authorizationService.IsKotohiro(m.SetSession(ctx)) {
+		return nil, messages.ForbiddenError
+	}
+
+	talkSessionID, err := shared.ParseUUID[talksession.TalkSession](params.TalkSessionID)
+	if err != nil {
+		return nil, messages.BadRequestError
+	}
+
+	out, err := m.getReportVersions.Execute(ctx, analysis_query.GetReportVersionsInput{
+		TalkSessionID: talkSessionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]oas.ReportVersionForManage, 0, len(out.Versions))
+	for _, version := range out.Versions {
+		res = append(res, version.ToResponse())
+	}
+	return res, nil
+}
+
+// GetReportVersionDiffManage 2つのバージョンのレポートを行単位で比べる
+func (m *manageHandler) GetReportVersionDiffManage(ctx context.Context, params oas.GetReportVersionDiffManageParams) (*oas.ReportVersionDiff, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "manageHandler.GetReportVersionDiffManage")
+	defer span.End()
+
+	if !m.authorizationService.IsKotohiro(m.SetSession(ctx)) {
+		return nil, messages.ForbiddenError
+	}
+
+	talkSessionID, err := shared.ParseUUID[talksession.TalkSession](params.TalkSessionID)
+	if err != nil {
+		return nil, messages.BadRequestError
+	}
+	from, err := shared.ParseUUID[analysis.ReportVersion](params.From)
+	if err != nil {
+		return nil, messages.BadRequestError
+	}
+	to, err := shared.ParseUUID[analysis.ReportVersion](params.To)
+	if err != nil {
+		return nil, messages.BadRequestError
+	}
+
+	out, err := m.getReportVersionDiff.Execute(ctx, analysis_query.GetReportVersionDiffInput{
+		TalkSessionID: talkSessionID,
+		From:          from,
+		To:            to,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make([]oas.ReportVersionDiffLine, 0, len(out.Lines))
+	for _, line := range out.Lines {
+		lines = append(lines, oas.ReportVersionDiffLine{
+			Op:   oas.ReportVersionDiffLineOp(line.Op),
+			Text: line.Text,
+		})
+	}
+	return &oas.ReportVersionDiff{
+		From:  out.From.ToResponse(),
+		To:    out.To.ToResponse(),
+		Lines: lines,
 	}, nil
 }
+
+// PublishReportVersionManage 公開するバージョンを固定する。バージョンを省略した場合は固定を解除する
+func (m *manageHandler) PublishReportVersionManage(ctx context.Context, req *oas.PublishReportVersionRequest, params oas.PublishReportVersionManageParams) (*oas.ReportPublicationForManage, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "manageHandler.PublishReportVersionManage")
+	defer span.End()
+
+	ctx = m.SetSession(ctx)
+	if !m.authorizationService.IsKotohiro(ctx) {
+		return nil, messages.ForbiddenError
+	}
+	authCtx, err := m.authorizationService.RequireAuthentication(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	talkSessionID, err := shared.ParseUUID[talksession.TalkSession](params.TalkSessionID)
+	if err != nil {
+		return nil, messages.BadRequestError
+	}
+	var versionID *shared.UUID[analysis.ReportVersion]
+	if v, ok := req.VersionID.Get(); ok {
+		id, err := shared.ParseUUID[analysis.ReportVersion](v)
+		if err != nil {
+			return nil, messages.BadRequestError
+		}
+		versionID = &id
+	}
+
+	out, err := m.publishReportVersion.Execute(ctx, manage_usecase.PublishReportVersionInput{
+		UserID:         authCtx.UserID,
+		OrganizationID: *authCtx.OrganizationID,
+		TalkSessionID:  talkSessionID,
+		VersionID:      versionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &oas.ReportPublicationForManage{
+		VersionID:   out.Version.ReportVersionID.String(),
+		Version:     int32(out.Version.Version),
+		Pinned:      out.Publication.Pinned,
+		PublishedAt: out.Publication.PublishedAt,
+	}, nil
+}
+
+// GetSigningKeysManage 検証に使用できる署名鍵の一覧
+func (m *manageHandler) GetSigningKeysManage(ctx context.Context) ([]oas.SigningKeyForManage, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "manageHandler.GetSigningKeysManage")
+	defer span.End()
+
+	if !m.authorizationService.IsKotohiro(m.SetSession(ctx)) {
+		return nil, messages.ForbiddenError
+	}
+
+	keys, err := m.keyRing.VerifiableKeys(ctx)
+	if err != nil {
+		utils.HandleError(ctx, err, "KeyRing.VerifiableKeys")
+		return nil, err
+	}
+
+	res := make([]oas.SigningKeyForManage, 0, len(keys))
+	for _, key := range keys {
+		res = append(res, signingKeyForManage(key))
+	}
+	return res, nil
+}
+
+// RotateSigningKeyManage 署名鍵をローテーションする
+func (m *manageHandler) RotateSigningKeyManage(ctx context.Context) (*oas.SigningKeyForManage, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "manageHandler.RotateSigningKeyManage")
+	defer span.End()
+
+	if !m.authorizationService.IsKotohiro(m.SetSession(ctx)) {
+		return nil, messages.ForbiddenError
+	}
+
+	key, err := m.keyRing.Rotate(ctx)
+	if err != nil {
+		utils.HandleError(ctx, err, "KeyRing.Rotate")
+		return nil, err
+	}
+
+	res := signingKeyForManage(*key)
+	return &res, nil
+}
+
+func signingKeyForManage(key signing_key.SigningKey) oas.SigningKeyForManage {
+	res := oas.SigningKeyForManage{
+		KeyID:     key.KeyID,
+		Algorithm: string(key.Algorithm),
+		Status:    oas.SigningKeyForManageStatus(key.Status),
+		CreatedAt: key.CreatedAt,
+	}
+	if key.RetiredAt != nil {
+		res.RetiredAt = oas.NewOptDateTime(*key.RetiredAt)
+	}
+	if key.VerifyUntil != nil {
+		res.VerifyUntil = oas.NewOptDateTime(*key.VerifyUntil)
+	}
+	return res
+}
@@ -3,13 +3,16 @@ package handler
 import (
 	"context"
 	"mime/multipart"
+	"time"
 
 	opinion_query "github.com/neko-dream/api/internal/application/query/opinion"
 	"github.com/neko-dream/api/internal/application/query/report_query"
 	"github.com/neko-dream/api/internal/application/usecase/opinion_usecase"
 	"github.com/neko-dream/api/internal/application/usecase/report_usecase"
 	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/analysis"
 	"github.com/neko-dream/api/internal/domain/model/opinion"
+	"github.com/neko-dream/api/internal/domain/model/organization"
 	"github.com/neko-dream/api/internal/domain/model/session"
 	"github.com/neko-dream/api/internal/domain/model/shared"
 	"github.com/neko-dream/api/internal/domain/model/talksession"
@@ -29,8 +32,11 @@ type opinionHandler struct {
 	getReportReasons             opinion_query.GetReportReasons
 	getOpinionGroupRatio         opinion_query.GetOpinionGroupRatioQuery
 	getReportByOpinionID         report_query.GetOpinionReportQuery
+	getOpinionRevisions          opinion_query.GetOpinionRevisionsQuery
 
 	submitOpinionCommand opinion_usecase.SubmitOpinion
+	editOpinionCommand   opinion_usecase.EditOpinion
+	deleteOpinionCommand opinion_usecase.DeleteOpinion
 	reportOpinionCommand opinion_usecase.ReportOpinion
 	solveReportCommand   report_usecase.SolveReportCommand
 
@@ -46,8 +52,11 @@ func NewOpinionHandler(
 	getReportReasons opinion_query.GetReportReasons,
 	getOpinionGroupRatio opinion_query.GetOpinionGroupRatioQuery,
 	getReportByOpinionID report_query.GetOpinionReportQuery,
+	getOpinionRevisions opinion_query.GetOpinionRevisionsQuery,
 
 	submitOpinionCommand opinion_usecase.SubmitOpinion,
+	editOpinionCommand opinion_usecase.EditOpinion,
+	deleteOpinionCommand opinion_usecase.DeleteOpinion,
 	reportOpinionCommand opinion_usecase.ReportOpinion,
 	solveReportCommand report_usecase.SolveReportCommand,
 
@@ -62,8 +71,11 @@ func NewOpinionHandler(
 		getReportReasons:             getReportReasons,
 		getOpinionGroupRatio:         getOpinionGroupRatio,
 		getReportByOpinionID:         getReportByOpinionID,
+		getOpinionRevisions:          getOpinionRevisions,
 
 		submitOpinionCommand: submitOpinionCommand,
+		editOpinionCommand:   editOpinionCommand,
+		deleteOpinionCommand: deleteOpinionCommand,
 		reportOpinionCommand: reportOpinionCommand,
 		solveReportCommand:   solveReportCommand,
 
@@ -347,6 +359,102 @@ func (o *opinionHandler) PostOpinionPost2(ctx context.Context, req *oas.PostOpin
 	return &res, nil
 }
 
+// EditOpinion 投稿者が意見を編集する
+func (o *opinionHandler) EditOpinion(ctx context.Context, req *oas.EditOpinionReq, params oas.EditOpinionParams) (oas.EditOpinionRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "opinionHandler.EditOpinion")
+	defer span.End()
+
+	authCtx, err := o.authorizationService.RequireAuthentication(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, messages.RequiredParameterError
+	}
+
+	opinionID, err := shared.ParseUUID[opinion.Opinion](params.OpinionID)
+	if err != nil {
+		return nil, messages.BadRequestError
+	}
+
+	out, err := o.editOpinionCommand.Execute(ctx, opinion_usecase.EditOpinionInput{
+		OpinionID:    opinionID,
+		UserID:       authCtx.UserID,
+		Title:        utils.ToPtrIfNotNullValue(!req.Title.IsSet(), req.Title.Value),
+		Content:      req.OpinionContent,
+		ReferenceURL: utils.ToPtrIfNotNullValue(!req.ReferenceURL.IsSet(), req.ReferenceURL.Value),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &oas.EditOpinionOK{
+		Opinion:    out.Opinion.ToResponse(),
+		VotesReset: out.VotesReset,
+	}, nil
+}
+
+// DeleteOpinion 投稿者が意見を削除する
+func (o *opinionHandler) DeleteOpinion(ctx context.Context, params oas.DeleteOpinionParams) (oas.DeleteOpinionRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "opinionHandler.DeleteOpinion")
+	defer span.End()
+
+	authCtx, err := o.authorizationService.RequireAuthentication(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	opinionID, err := shared.ParseUUID[opinion.Opinion](params.OpinionID)
+	if err != nil {
+		return nil, messages.BadRequestError
+	}
+
+	if err := o.deleteOpinionCommand.Execute(ctx, opinion_usecase.DeleteOpinionInput{
+		OpinionID: opinionID,
+		UserID:    authCtx.UserID,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &oas.DeleteOpinionOK{}, nil
+}
+
+// GetOpinionRevisions 意見の編集履歴を取得する
+func (o *opinionHandler) GetOpinionRevisions(ctx context.Context, params oas.GetOpinionRevisionsParams) (oas.GetOpinionRevisionsRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "opinionHandler.GetOpinionRevisions")
+	defer span.End()
+
+	authCtx, err := o.authorizationService.RequireAuthentication(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	opinionID, err := shared.ParseUUID[opinion.Opinion](params.OpinionID)
+	if err != nil {
+		return nil, messages.BadRequestError
+	}
+
+	out, err := o.getOpinionRevisions.Execute(ctx, opinion_query.GetOpinionRevisionsInput{
+		OpinionID: opinionID,
+		UserID:    authCtx.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := make([]oas.OpinionRevision, 0, len(out.Revisions))
+	for _, revision := range out.Revisions {
+		revisions = append(revisions, revision.ToResponse())
+	}
+	res := &oas.GetOpinionRevisionsOK{
+		Revisions: revisions,
+	}
+	if out.DeletedAt != nil {
+		res.DeletedAt = oas.NewOptNilString(out.DeletedAt.Format(time.RFC3339))
+	}
+	return res, nil
+}
+
 // ReportOpinion 意見を通報する
 func (o *opinionHandler) ReportOpinion(ctx context.Context, req *oas.ReportOpinionReq, params oas.ReportOpinionParams) (oas.ReportOpinionRes, error) {
 	ctx, span := otel.Tracer("handler").Start(ctx, "opinionHandler.ReportOpinion")
@@ -410,9 +518,29 @@ func (o *opinionHandler) GetOpinionAnalysis(ctx context.Context, params oas.GetO
 		return nil, messages.BadRequestError
 	}
 
-	out, err := o.getOpinionGroupRatio.Execute(ctx, opinion_query.GetOpinionGroupRatioInput{
+	input := opinion_query.GetOpinionGroupRatioInput{
 		OpinionID: opinionID,
-	})
+	}
+	if params.WeightBy.IsSet() {
+		dimension, err := analysis.NewDemographicDimension(string(params.WeightBy.Value))
+		if err != nil {
+			return nil, err
+		}
+		// 参加者の属性から重みを求めるため、セッションを作成した組織のメンバーに限る
+		organizationID, err := o.getOpinionGroupRatio.FindOrganizationID(ctx, opinionID)
+		if err != nil {
+			return nil, err
+		}
+		if organizationID == nil {
+			return nil, messages.ReferenceDistributionNotFound
+		}
+		if _, err := o.authorizationService.RequireOrganizationRoleFor(ctx, *organizationID, organization.OrganizationUserRoleMember); err != nil {
+			return nil, err
+		}
+		input.WeightBy = &dimension
+	}
+
+	out, err := o.getOpinionGroupRatio.Execute(ctx, input)
 	if err != nil {
 		return nil, err
 	}
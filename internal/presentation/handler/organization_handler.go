@@ -1,16 +1,30 @@
 package handler
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"mime/multipart"
 	"net/http"
+	"sort"
+	"time"
 
+	"github.com/go-faster/jx"
+	"github.com/neko-dream/api/internal/application/query/analysis_query"
 	"github.com/neko-dream/api/internal/application/query/organization_query"
+	talksession_query "github.com/neko-dream/api/internal/application/query/talksession"
 	"github.com/neko-dream/api/internal/application/usecase/organization_usecase"
+	"github.com/neko-dream/api/internal/application/usecase/talksession_usecase"
 	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/analysis"
+	"github.com/neko-dream/api/internal/domain/model/clock"
 	"github.com/neko-dream/api/internal/domain/model/organization"
 	"github.com/neko-dream/api/internal/domain/model/session"
 	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/talksession/talksession_template"
+	"github.com/neko-dream/api/internal/domain/model/user"
 	"github.com/neko-dream/api/internal/domain/service"
 	domainservice "github.com/neko-dream/api/internal/domain/service"
 	"github.com/neko-dream/api/internal/infrastructure/http/cookie"
@@ -18,6 +32,7 @@ import (
 	cookie_utils "github.com/neko-dream/api/pkg/cookie"
 	http_utils "github.com/neko-dream/api/pkg/http"
 	"github.com/neko-dream/api/pkg/utils"
+	"github.com/samber/lo"
 	"go.opentelemetry.io/otel"
 )
 
@@ -39,6 +54,31 @@ type organizationHandler struct {
 	sessionTokenManager  session.TokenManager
 	switchOrganization   organization_usecase.SwitchOrganizationUseCase
 	cookieManager        cookie.CookieManager
+	issueAPIKey          organization_usecase.IssueOrganizationAPIKeyCommand
+	revokeAPIKey         organization_usecase.RevokeOrganizationAPIKeyCommand
+	listAPIKeys          organization_query.ListOrganizationAPIKeysQuery
+	changeUserRole       organization_usecase.ChangeOrganizationUserRoleCommand
+	removeUser           organization_usecase.RemoveOrganizationUserCommand
+	createInvitation     organization_usecase.CreateOrganizationInvitationCommand
+	bulkInvitations      organization_usecase.BulkCreateOrganizationInvitationsCommand
+	resendInvitation     organization_usecase.ResendOrganizationInvitationCommand
+	revokeInvitation     organization_usecase.RevokeOrganizationInvitationCommand
+	acceptInvitation     organization_usecase.AcceptOrganizationInvitationCommand
+	listInvitations      organization_query.ListOrganizationInvitationsQuery
+	listAuditLogs        organization_query.ListOrganizationAuditLogsQuery
+	exportAuditLogs      organization_query.ExportOrganizationAuditLogsQuery
+	changeParent         organization_usecase.ChangeParentOrganizationCommand
+	browseTalkSessions   talksession_query.BrowseOrganizationTalkSessionsQuery
+	getStats             organization_query.GetOrganizationStatsQuery
+	listTemplates        organization_query.ListTalkSessionTemplatesQuery
+	deleteTemplate       talksession_usecase.DeleteTalkSessionTemplateUseCase
+	cloneTalkSession     talksession_usecase.CloneTalkSessionUseCase
+	getTalkSessionDetail talksession_query.GetTalkSessionDetailByIDQuery
+	reportPromptRepo     analysis.ReportPromptRepository
+	updateReportPrompt   organization_usecase.UpdateReportPromptCommand
+	distributionRepo     analysis.ReferenceDistributionRepository
+	updateDistribution   organization_usecase.UpdateReferenceDistributionCommand
+	representativeness   analysis_query.GetRepresentativenessQuery
 }
 
 func NewOrganizationHandler(
@@ -59,6 +99,31 @@ func NewOrganizationHandler(
 	sessionTokenManager session.TokenManager,
 	switchOrganization organization_usecase.SwitchOrganizationUseCase,
 	cookieManager cookie.CookieManager,
+	issueAPIKey organization_usecase.IssueOrganizationAPIKeyCommand,
+	revokeAPIKey organization_usecase.RevokeOrganizationAPIKeyCommand,
+	listAPIKeys organization_query.ListOrganizationAPIKeysQuery,
+	changeUserRole organization_usecase.ChangeOrganizationUserRoleCommand,
+	removeUser organization_usecase.RemoveOrganizationUserCommand,
+	createInvitation organization_usecase.CreateOrganizationInvitationCommand,
+	bulkInvitations organization_usecase.BulkCreateOrganizationInvitationsCommand,
+	resendInvitation organization_usecase.ResendOrganizationInvitationCommand,
+	revokeInvitation organization_usecase.RevokeOrganizationInvitationCommand,
+	acceptInvitation organization_usecase.AcceptOrganizationInvitationCommand,
+	listInvitations organization_query.ListOrganizationInvitationsQuery,
+	listAuditLogs organization_query.ListOrganizationAuditLogsQuery,
+	exportAuditLogs organization_query.ExportOrganizationAuditLogsQuery,
+	changeParent organization_usecase.ChangeParentOrganizationCommand,
+	browseTalkSessions talksession_query.BrowseOrganizationTalkSessionsQuery,
+	getStats organization_query.GetOrganizationStatsQuery,
+	listTemplates organization_query.ListTalkSessionTemplatesQuery,
+	deleteTemplate talksession_usecase.DeleteTalkSessionTemplateUseCase,
+	cloneTalkSession talksession_usecase.CloneTalkSessionUseCase,
+	getTalkSessionDetail talksession_query.GetTalkSessionDetailByIDQuery,
+	reportPromptRepo analysis.ReportPromptRepository,
+	updateReportPrompt organization_usecase.UpdateReportPromptCommand,
+	distributionRepo analysis.ReferenceDistributionRepository,
+	updateDistribution organization_usecase.UpdateReferenceDistributionCommand,
+	representativeness analysis_query.GetRepresentativenessQuery,
 ) oas.OrganizationHandler {
 	return &organizationHandler{
 		create:               create,
@@ -78,6 +143,31 @@ func NewOrganizationHandler(
 		sessionTokenManager:  sessionTokenManager,
 		switchOrganization:   switchOrganization,
 		cookieManager:        cookieManager,
+		issueAPIKey:          issueAPIKey,
+		revokeAPIKey:         revokeAPIKey,
+		listAPIKeys:          listAPIKeys,
+		changeUserRole:       changeUserRole,
+		removeUser:           removeUser,
+		createInvitation:     createInvitation,
+		bulkInvitations:      bulkInvitations,
+		resendInvitation:     resendInvitation,
+		revokeInvitation:     revokeInvitation,
+		acceptInvitation:     acceptInvitation,
+		listInvitations:      listInvitations,
+		listAuditLogs:        listAuditLogs,
+		exportAuditLogs:      exportAuditLogs,
+		changeParent:         changeParent,
+		browseTalkSessions:   browseTalkSessions,
+		getStats:             getStats,
+		listTemplates:        listTemplates,
+		deleteTemplate:       deleteTemplate,
+		cloneTalkSession:     cloneTalkSession,
+		getTalkSessionDetail: getTalkSessionDetail,
+		reportPromptRepo:     reportPromptRepo,
+		updateReportPrompt:   updateReportPrompt,
+		distributionRepo:     distributionRepo,
+		updateDistribution:   updateDistribution,
+		representativeness:   representativeness,
 	}
 }
 
@@ -375,6 +465,62 @@ func (o *organizationHandler) GetOrganizationUsers(ctx context.Context) (oas.Get
 	}, nil
 }
 
+// ChangeOrganizationUserRole 組織ユーザーのロール変更
+func (o *organizationHandler) ChangeOrganizationUserRole(ctx context.Context, req *oas.ChangeOrganizationUserRoleReq, params oas.ChangeOrganizationUserRoleParams) (oas.ChangeOrganizationUserRoleRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "organizationHandler.ChangeOrganizationUserRole")
+	defer span.End()
+
+	authCtx, err := o.authorizationService.RequireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, messages.BadRequestError
+	}
+
+	targetUserID, err := shared.ParseUUID[user.User](params.UserID)
+	if err != nil {
+		return nil, messages.BadRequestError
+	}
+
+	if _, err := o.changeUserRole.Execute(ctx, organization_usecase.ChangeOrganizationUserRoleInput{
+		UserID:         authCtx.UserID,
+		OrganizationID: *authCtx.OrganizationID,
+		TargetUserID:   targetUserID,
+		Role:           organization.OrganizationUserRole(int(req.Role)),
+	}); err != nil {
+		return nil, err
+	}
+
+	return &oas.ChangeOrganizationUserRoleOK{}, nil
+}
+
+// RemoveOrganizationUser 組織ユーザー削除
+func (o *organizationHandler) RemoveOrganizationUser(ctx context.Context, params oas.RemoveOrganizationUserParams) (oas.RemoveOrganizationUserRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "organizationHandler.RemoveOrganizationUser")
+	defer span.End()
+
+	authCtx, err := o.authorizationService.RequireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	targetUserID, err := shared.ParseUUID[user.User](params.UserID)
+	if err != nil {
+		return nil, messages.BadRequestError
+	}
+
+	if err := o.removeUser.Execute(ctx, organization_usecase.RemoveOrganizationUserInput{
+		UserID:         authCtx.UserID,
+		OrganizationID: *authCtx.OrganizationID,
+		TargetUserID:   targetUserID,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &oas.RemoveOrganizationUserOK{}, nil
+}
+
 // SwitchOrganization 組織を切り替える
 func (o *organizationHandler) SwitchOrganization(ctx context.Context, params oas.SwitchOrganizationParams) (oas.SwitchOrganizationRes, error) {
 	ctx, span := otel.Tracer("handler").Start(ctx, "organizationHandler.SwitchOrganization")
@@ -398,3 +544,1001 @@ func (o *organizationHandler) SwitchOrganization(ctx context.Context, params oas
 	res.SetSetCookie(cookie_utils.EncodeCookies([]*http.Cookie{o.cookieManager.CreateSessionCookie(output.SessionTokenStr)}))
 	return &res, nil
 }
+
+// GetOrganizationApiKeys 組織APIキー一覧取得
+func (o *organizationHandler) GetOrganizationApiKeys(ctx context.Context) (oas.GetOrganizationApiKeysRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "organizationHandler.GetOrganizationApiKeys")
+	defer span.End()
+
+	authCtx, err := o.authorizationService.RequireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := o.listAPIKeys.Execute(ctx, organization_query.ListOrganizationAPIKeysInput{
+		OrganizationID: *authCtx.OrganizationID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	apiKeys := make([]oas.OrganizationApiKey, 0, len(output.APIKeys))
+	for _, key := range output.APIKeys {
+		apiKeys = append(apiKeys, organizationAPIKeyToResponse(key))
+	}
+
+	return &oas.GetOrganizationApiKeysOK{
+		ApiKeys: apiKeys,
+	}, nil
+}
+
+// CreateOrganizationApiKey 組織APIキー発行
+func (o *organizationHandler) CreateOrganizationApiKey(ctx context.Context, req *oas.CreateOrganizationApiKeyReq) (oas.CreateOrganizationApiKeyRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "organizationHandler.CreateOrganizationApiKey")
+	defer span.End()
+
+	authCtx, err := o.authorizationService.RequireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, messages.BadRequestError
+	}
+
+	var expiresAt *time.Time
+	if v, ok := req.ExpiresAt.Get(); ok {
+		expiresAt = &v
+	}
+
+	output, err := o.issueAPIKey.Execute(ctx, organization_usecase.IssueOrganizationAPIKeyInput{
+		UserID:         authCtx.UserID,
+		OrganizationID: *authCtx.OrganizationID,
+		Name:           req.Name,
+		Scopes:         req.Scopes,
+		ExpiresAt:      expiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	key := organizationAPIKeyToResponse(output.APIKey)
+	return &oas.IssuedOrganizationApiKey{
+		ApiKeyID:   key.ApiKeyID,
+		Name:       key.Name,
+		KeyPrefix:  key.KeyPrefix,
+		Scopes:     key.Scopes,
+		CreatedAt:  key.CreatedAt,
+		ExpiresAt:  key.ExpiresAt,
+		LastUsedAt: key.LastUsedAt,
+		RevokedAt:  key.RevokedAt,
+		Key:        output.RawKey,
+	}, nil
+}
+
+// RevokeOrganizationApiKey 組織APIキー失効
+func (o *organizationHandler) RevokeOrganizationApiKey(ctx context.Context, params oas.RevokeOrganizationApiKeyParams) (oas.RevokeOrganizationApiKeyRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "organizationHandler.RevokeOrganizationApiKey")
+	defer span.End()
+
+	authCtx, err := o.authorizationService.RequireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKeyID, err := shared.ParseUUID[organization.OrganizationAPIKey](params.ApiKeyID)
+	if err != nil {
+		return nil, messages.BadRequestError
+	}
+
+	if err := o.revokeAPIKey.Execute(ctx, organization_usecase.RevokeOrganizationAPIKeyInput{
+		UserID:         authCtx.UserID,
+		OrganizationID: *authCtx.OrganizationID,
+		APIKeyID:       apiKeyID,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &oas.RevokeOrganizationApiKeyOK{}, nil
+}
+
+// GetOrganizationInvitations 組織への招待一覧取得
+func (o *organizationHandler) GetOrganizationInvitations(ctx context.Context) (oas.GetOrganizationInvitationsRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "organizationHandler.GetOrganizationInvitations")
+	defer span.End()
+
+	authCtx, err := o.authorizationService.RequireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := o.listInvitations.Execute(ctx, organization_query.ListOrganizationInvitationsInput{
+		OrganizationID: *authCtx.OrganizationID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := clock.Now(ctx)
+	invitations := make([]oas.OrganizationInvitation, 0, len(output.Invitations))
+	for _, invitation := range output.Invitations {
+		invitations = append(invitations, organizationInvitationToResponse(invitation, now))
+	}
+
+	return &oas.GetOrganizationInvitationsOK{
+		Invitations: invitations,
+	}, nil
+}
+
+// CreateOrganizationInvitation 組織への招待作成
+func (o *organizationHandler) CreateOrganizationInvitation(ctx context.Context, req *oas.CreateOrganizationInvitationReq) (oas.CreateOrganizationInvitationRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "organizationHandler.CreateOrganizationInvitation")
+	defer span.End()
+
+	authCtx, err := o.authorizationService.RequireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, messages.BadRequestError
+	}
+
+	output, err := o.createInvitation.Execute(ctx, organization_usecase.CreateOrganizationInvitationInput{
+		UserID:         authCtx.UserID,
+		OrganizationID: *authCtx.OrganizationID,
+		Email:          req.Email,
+		Role:           organization.OrganizationUserRole(int(req.Role)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := organizationInvitationToResponse(output.Invitation, clock.Now(ctx))
+	return &res, nil
+}
+
+// BulkCreateOrganizationInvitations CSVによる組織への一括招待
+func (o *organizationHandler) BulkCreateOrganizationInvitations(ctx context.Context, req *oas.BulkCreateOrganizationInvitationsReq) (oas.BulkCreateOrganizationInvitationsRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "organizationHandler.BulkCreateOrganizationInvitations")
+	defer span.End()
+
+	authCtx, err := o.authorizationService.RequireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil || req.File.File == nil {
+		return nil, messages.BadRequestError
+	}
+
+	output, err := o.bulkInvitations.Execute(ctx, organization_usecase.BulkCreateOrganizationInvitationsInput{
+		UserID:         authCtx.UserID,
+		OrganizationID: *authCtx.OrganizationID,
+		CSV:            req.File.File,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := clock.Now(ctx)
+	results := make([]oas.OrganizationInvitationBulkResult, 0, len(output.Results))
+	for _, result := range output.Results {
+		res := oas.OrganizationInvitationBulkResult{
+			Line:    result.Line,
+			Email:   result.Email,
+			Success: result.Err == nil,
+		}
+		if result.Invitation != nil {
+			res.Invitation = oas.NewOptOrganizationInvitation(organizationInvitationToResponse(result.Invitation, now))
+		}
+		if result.Err != nil {
+			var apiErr *messages.APIError
+			if errors.As(result.Err, &apiErr) {
+				res.Message = oas.NewOptString(apiErr.Message)
+			} else {
+				res.Message = oas.NewOptString(messages.OrganizationInternalServerError.Message)
+			}
+		}
+		results = append(results, res)
+	}
+
+	return &oas.BulkCreateOrganizationInvitationsOK{
+		Results: results,
+	}, nil
+}
+
+// ResendOrganizationInvitation 組織への招待の再送
+func (o *organizationHandler) ResendOrganizationInvitation(ctx context.Context, params oas.ResendOrganizationInvitationParams) (oas.ResendOrganizationInvitationRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "organizationHandler.ResendOrganizationInvitation")
+	defer span.End()
+
+	authCtx, err := o.authorizationService.RequireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invitationID, err := shared.ParseUUID[organization.OrganizationInvitation](params.InvitationID)
+	if err != nil {
+		return nil, messages.BadRequestError
+	}
+
+	output, err := o.resendInvitation.Execute(ctx, organization_usecase.ResendOrganizationInvitationInput{
+		UserID:         authCtx.UserID,
+		OrganizationID: *authCtx.OrganizationID,
+		InvitationID:   invitationID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := organizationInvitationToResponse(output.Invitation, clock.Now(ctx))
+	return &res, nil
+}
+
+// RevokeOrganizationInvitation 組織への招待の取り消し
+func (o *organizationHandler) RevokeOrganizationInvitation(ctx context.Context, params oas.RevokeOrganizationInvitationParams) (oas.RevokeOrganizationInvitationRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "organizationHandler.RevokeOrganizationInvitation")
+	defer span.End()
+
+	authCtx, err := o.authorizationService.RequireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	invitationID, err := shared.ParseUUID[organization.OrganizationInvitation](params.InvitationID)
+	if err != nil {
+		return nil, messages.BadRequestError
+	}
+
+	if err := o.revokeInvitation.Execute(ctx, organization_usecase.RevokeOrganizationInvitationInput{
+		UserID:         authCtx.UserID,
+		OrganizationID: *authCtx.OrganizationID,
+		InvitationID:   invitationID,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &oas.RevokeOrganizationInvitationOK{}, nil
+}
+
+// AcceptOrganizationInvitation 組織への招待の承諾
+func (o *organizationHandler) AcceptOrganizationInvitation(ctx context.Context, req *oas.AcceptOrganizationInvitationReq) (oas.AcceptOrganizationInvitationRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "organizationHandler.AcceptOrganizationInvitation")
+	defer span.End()
+
+	authCtx, err := o.authorizationService.RequireAuthentication(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil || req.Token == "" {
+		return nil, messages.BadRequestError
+	}
+
+	output, err := o.acceptInvitation.Execute(ctx, organization_usecase.AcceptOrganizationInvitationInput{
+		UserID: authCtx.UserID,
+		Token:  req.Token,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &oas.Organization{
+		ID:       output.Organization.OrganizationID.String(),
+		Name:     output.Organization.Name,
+		Code:     output.Organization.Code,
+		Type:     int(output.Organization.OrganizationType),
+		Role:     int(output.OrganizationUser.Role),
+		RoleName: organization.RoleToName(output.OrganizationUser.Role),
+		IconURL:  utils.ToOptNil[oas.OptNilString](output.Organization.IconURL),
+	}, nil
+}
+
+// GetOrganizationAuditLogs 組織の監査ログ一覧取得
+func (o *organizationHandler) GetOrganizationAuditLogs(ctx context.Context, params oas.GetOrganizationAuditLogsParams) (oas.GetOrganizationAuditLogsRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "organizationHandler.GetOrganizationAuditLogs")
+	defer span.End()
+
+	orgID, filter, err := o.auditLogFilter(ctx, params.OrganizationID, params.Action, params.ActorID, params.Since, params.Until)
+	if err != nil {
+		return nil, err
+	}
+	if params.Limit.IsSet() {
+		filter.Limit = int(params.Limit.Value)
+	}
+	if params.Offset.IsSet() {
+		filter.Offset = int(params.Offset.Value)
+	}
+
+	output, err := o.listAuditLogs.Execute(ctx, organization_query.ListOrganizationAuditLogsInput{
+		OrganizationID: orgID,
+		Filter:         filter,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	auditLogs := make([]oas.OrganizationAuditLog, 0, len(output.AuditLogs))
+	for _, auditLog := range output.AuditLogs {
+		res, err := organizationAuditLogToResponse(auditLog)
+		if err != nil {
+			utils.HandleError(ctx, err, "organizationAuditLogToResponse")
+			return nil, messages.OrganizationInternalServerError
+		}
+		auditLogs = append(auditLogs, res)
+	}
+
+	return &oas.GetOrganizationAuditLogsOK{
+		AuditLogs:  auditLogs,
+		TotalCount: output.TotalCount,
+	}, nil
+}
+
+// DownloadOrganizationAuditLogs 組織の監査ログCSVダウンロード
+func (o *organizationHandler) DownloadOrganizationAuditLogs(ctx context.Context, params oas.DownloadOrganizationAuditLogsParams) (oas.DownloadOrganizationAuditLogsRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "organizationHandler.DownloadOrganizationAuditLogs")
+	defer span.End()
+
+	orgID, filter, err := o.auditLogFilter(ctx, params.OrganizationID, params.Action, params.ActorID, params.Since, params.Until)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := o.exportAuditLogs.Execute(ctx, organization_query.ExportOrganizationAuditLogsInput{
+		OrganizationID: orgID,
+		Filter:         filter,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &oas.DownloadOrganizationAuditLogsOK{
+		Data: bytes.NewReader(output.CSV),
+	}, nil
+}
+
+// auditLogFilter 監査ログを閲覧する組織と絞り込み条件を決める
+// オーナー以上の権限が必要で、他の組織を指定できるのは運営のスーパー管理者のみ
+func (o *organizationHandler) auditLogFilter(
+	ctx context.Context,
+	organizationID oas.OptString,
+	action oas.OptString,
+	actorID oas.OptString,
+	since oas.OptDateTime,
+	until oas.OptDateTime,
+) (shared.UUID[organization.Organization], organization.AuditLogFilter, error) {
+	var filter organization.AuditLogFilter
+
+	authCtx, err := o.authorizationService.RequireOwner(ctx)
+	if err != nil {
+		return shared.UUID[organization.Organization]{}, filter, err
+	}
+
+	orgID := *authCtx.OrganizationID
+	if organizationID.IsSet() {
+		targetID, err := shared.ParseUUID[organization.Organization](organizationID.Value)
+		if err != nil {
+			return orgID, filter, messages.BadRequestError
+		}
+		if targetID != orgID && !(authCtx.IsKotohiro() && authCtx.IsSuperAdmin()) {
+			return orgID, filter, messages.OrganizationPermissionDenied
+		}
+		orgID = targetID
+	}
+
+	if action.IsSet() && action.Value != "" {
+		filter.Action = lo.ToPtr(organization.AuditAction(action.Value))
+	}
+	if actorID.IsSet() {
+		id, err := shared.ParseUUID[user.User](actorID.Value)
+		if err != nil {
+			return orgID, filter, messages.BadRequestError
+		}
+		filter.ActorID = &id
+	}
+	if since.IsSet() {
+		filter.Since = lo.ToPtr(since.Value)
+	}
+	if until.IsSet() {
+		filter.Until = lo.ToPtr(until.Value)
+	}
+
+	return orgID, filter, nil
+}
+
+func organizationInvitationToResponse(invitation *organization.OrganizationInvitation, now time.Time) oas.OrganizationInvitation {
+	res := oas.OrganizationInvitation{
+		InvitationID: invitation.InvitationID().String(),
+		Email:        invitation.Email(),
+		Role:         int(invitation.Role()),
+		RoleName:     organization.RoleToName(invitation.Role()),
+		Status:       oas.OrganizationInvitationStatus(invitation.Status(now)),
+		ExpiresAt:    invitation.ExpiresAt(),
+		SendCount:    invitation.SendCount(),
+		LastSentAt:   invitation.LastSentAt(),
+		CreatedAt:    invitation.CreatedAt(),
+	}
+	if invitation.AcceptedAt() != nil {
+		res.AcceptedAt = oas.NewOptDateTime(*invitation.AcceptedAt())
+	}
+	return res
+}
+
+func organizationAPIKeyToResponse(key *organization.OrganizationAPIKey) oas.OrganizationApiKey {
+	scopes := make([]string, 0, len(key.Scopes()))
+	for _, scope := range key.Scopes() {
+		scopes = append(scopes, string(scope))
+	}
+
+	res := oas.OrganizationApiKey{
+		ApiKeyID:  key.APIKeyID().String(),
+		Name:      key.Name(),
+		KeyPrefix: key.KeyPrefix(),
+		Scopes:    scopes,
+		CreatedAt: key.CreatedAt(),
+	}
+	if key.ExpiresAt() != nil {
+		res.ExpiresAt = oas.NewOptDateTime(*key.ExpiresAt())
+	}
+	if key.LastUsedAt() != nil {
+		res.LastUsedAt = oas.NewOptDateTime(*key.LastUsedAt())
+	}
+	if key.RevokedAt() != nil {
+		res.RevokedAt = oas.NewOptDateTime(*key.RevokedAt())
+	}
+	return res
+}
+
+func organizationAuditLogToResponse(auditLog *organization.OrganizationAuditLog) (oas.OrganizationAuditLog, error) {
+	fields := lo.Keys(auditLog.Changes())
+	sort.Strings(fields)
+
+	changes := make([]oas.OrganizationAuditLogChange, 0, len(fields))
+	for _, field := range fields {
+		change := auditLog.Changes()[field]
+		before, err := json.Marshal(change.Before)
+		if err != nil {
+			return oas.OrganizationAuditLog{}, err
+		}
+		after, err := json.Marshal(change.After)
+		if err != nil {
+			return oas.OrganizationAuditLog{}, err
+		}
+		changes = append(changes, oas.OrganizationAuditLogChange{
+			Field:  field,
+			Before: jx.Raw(before),
+			After:  jx.Raw(after),
+		})
+	}
+
+	return oas.OrganizationAuditLog{
+		AuditLogID: auditLog.AuditLogID().String(),
+		ActorID:    auditLog.ActorID().String(),
+		Action:     string(auditLog.Action()),
+		TargetType: string(auditLog.TargetType()),
+		TargetID:   auditLog.TargetID(),
+		Changes:    changes,
+		CreatedAt:  auditLog.CreatedAt(),
+	}, nil
+}
+
+// UpdateOrganizationParent 親組織の変更
+func (o *organizationHandler) UpdateOrganizationParent(ctx context.Context, req *oas.UpdateOrganizationParentReq, params oas.UpdateOrganizationParentParams) (oas.UpdateOrganizationParentRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "organizationHandler.UpdateOrganizationParent")
+	defer span.End()
+
+	org, err := o.findOrganizationByCode(ctx, params.Code)
+	if err != nil {
+		return nil, err
+	}
+	authCtx, err := o.authorizationService.RequireOrganizationRoleFor(ctx, org.OrganizationID, organization.OrganizationUserRoleOwner)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, messages.BadRequestError
+	}
+
+	var parentID *shared.UUID[organization.Organization]
+	if req.ParentCode.IsSet() && req.ParentCode.Value != "" {
+		parent, err := o.findOrganizationByCode(ctx, req.ParentCode.Value)
+		if err != nil {
+			return nil, err
+		}
+		parentID = &parent.OrganizationID
+	}
+
+	out, err := o.changeParent.Execute(ctx, organization_usecase.ChangeParentOrganizationInput{
+		UserID:               authCtx.UserID,
+		OrganizationID:       org.OrganizationID,
+		ParentOrganizationID: parentID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := o.organizationToResponse(ctx, out.Organization, authCtx.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// GetOrganizationReportPrompt レポート生成の指示
+func (o *organizationHandler) GetOrganizationReportPrompt(ctx context.Context, params oas.GetOrganizationReportPromptParams) (oas.GetOrganizationReportPromptRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "organizationHandler.GetOrganizationReportPrompt")
+	defer span.End()
+
+	org, err := o.findOrganizationByCode(ctx, params.Code)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := o.authorizationService.RequireOrganizationRoleFor(ctx, org.OrganizationID, organization.OrganizationUserRoleAdmin); err != nil {
+		return nil, err
+	}
+
+	prompt, err := o.reportPromptRepo.FindByOrganizationID(ctx, org.OrganizationID)
+	if err != nil {
+		utils.HandleError(ctx, err, "ReportPromptRepository.FindByOrganizationID")
+		return nil, messages.OrganizationInternalServerError
+	}
+	if prompt == nil {
+		return &oas.OrganizationReportPrompt{
+			DefaultSystemPrompt: analysis.DefaultReportSystemPrompt,
+		}, nil
+	}
+
+	res := reportPromptToResponse(prompt)
+	return &res, nil
+}
+
+// UpdateOrganizationReportPrompt レポート生成の指示を更新する
+func (o *organizationHandler) UpdateOrganizationReportPrompt(ctx context.Context, req *oas.UpdateOrganizationReportPromptReq, params oas.UpdateOrganizationReportPromptParams) (oas.UpdateOrganizationReportPromptRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "organizationHandler.UpdateOrganizationReportPrompt")
+	defer span.End()
+
+	org, err := o.findOrganizationByCode(ctx, params.Code)
+	if err != nil {
+		return nil, err
+	}
+	authCtx, err := o.authorizationService.RequireOrganizationRoleFor(ctx, org.OrganizationID, organization.OrganizationUserRoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, messages.BadRequestError
+	}
+
+	out, err := o.updateReportPrompt.Execute(ctx, organization_usecase.UpdateReportPromptInput{
+		UserID:         authCtx.UserID,
+		OrganizationID: org.OrganizationID,
+		SystemPrompt:   req.SystemPrompt.Or(""),
+		Instructions:   req.Instructions.Or(""),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := reportPromptToResponse(out.Prompt)
+	return &res, nil
+}
+
+func reportPromptToResponse(prompt *analysis.ReportPrompt) oas.OrganizationReportPrompt {
+	return oas.OrganizationReportPrompt{
+		SystemPrompt:        prompt.SystemPrompt,
+		Instructions:        prompt.Instructions,
+		DefaultSystemPrompt: analysis.DefaultReportSystemPrompt,
+		UpdatedAt:           oas.NewOptDateTime(prompt.UpdatedAt),
+	}
+}
+
+// GetOrganizationReferenceDemographics 母集団の構成
+func (o *organizationHandler) GetOrganizationReferenceDemographics(ctx context.Context, params oas.GetOrganizationReferenceDemographicsParams) (oas.GetOrganizationReferenceDemographicsRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "organizationHandler.GetOrganizationReferenceDemographics")
+	defer span.End()
+
+	org, err := o.findOrganizationByCode(ctx, params.Code)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := o.authorizationService.RequireOrganizationRoleFor(ctx, org.OrganizationID, organization.OrganizationUserRoleAdmin); err != nil {
+		return nil, err
+	}
+
+	distributions, err := o.distributionRepo.FindByOrganizationID(ctx, org.OrganizationID)
+	if err != nil {
+		utils.HandleError(ctx, err, "ReferenceDistributionRepository.FindByOrganizationID")
+		return nil, messages.OrganizationInternalServerError
+	}
+
+	res := make([]oas.ReferenceDistribution, 0, len(distributions))
+	for _, distribution := range distributions {
+		res = append(res, referenceDistributionToResponse(&distribution))
+	}
+	return &oas.GetOrganizationReferenceDemographicsOK{
+		Distributions: res,
+	}, nil
+}
+
+// UpdateOrganizationReferenceDemographics 母集団の構成をCSVで登録する
+func (o *organizationHandler) UpdateOrganizationReferenceDemographics(ctx context.Context, req *oas.UpdateOrganizationReferenceDemographicsReq, params oas.UpdateOrganizationReferenceDemographicsParams) (oas.UpdateOrganizationReferenceDemographicsRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "organizationHandler.UpdateOrganizationReferenceDemographics")
+	defer span.End()
+
+	org, err := o.findOrganizationByCode(ctx, params.Code)
+	if err != nil {
+		return nil, err
+	}
+	authCtx, err := o.authorizationService.RequireOrganizationRoleFor(ctx, org.OrganizationID, organization.OrganizationUserRoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil || req.File.File == nil {
+		return nil, messages.BadRequestError
+	}
+	dimension, err := analysis.NewDemographicDimension(string(req.Dimension))
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := o.updateDistribution.Execute(ctx, organization_usecase.UpdateReferenceDistributionInput{
+		UserID:         authCtx.UserID,
+		OrganizationID: org.OrganizationID,
+		Dimension:      dimension,
+		CSV:            req.File.File,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := referenceDistributionToResponse(out.Distribution)
+	return &res, nil
+}
+
+// GetTalkSessionRepresentativeness 組織のセッションの参加者の代表性
+func (o *organizationHandler) GetTalkSessionRepresentativeness(ctx context.Context, params oas.GetTalkSessionRepresentativenessParams) (oas.GetTalkSessionRepresentativenessRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "organizationHandler.GetTalkSessionRepresentativeness")
+	defer span.End()
+
+	org, err := o.findOrganizationByCode(ctx, params.Code)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := o.authorizationService.RequireOrganizationRoleFor(ctx, org.OrganizationID, organization.OrganizationUserRoleAdmin); err != nil {
+		return nil, err
+	}
+	talkSessionID, err := shared.ParseUUID[talksession.TalkSession](params.TalkSessionID)
+	if err != nil {
+		return nil, messages.BadRequestError
+	}
+
+	out, err := o.representativeness.Execute(ctx, analysis_query.GetRepresentativenessInput{
+		OrganizationID: org.OrganizationID,
+		TalkSessionID:  talkSessionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	dimensions := make([]oas.Representativeness, 0, len(out.Dimensions))
+	for _, r := range out.Dimensions {
+		categories := make([]oas.CategoryComparison, 0, len(r.Categories))
+		for _, c := range r.Categories {
+			categories = append(categories, oas.CategoryComparison{
+				Category:         c.Category,
+				ReferenceShare:   c.ReferenceShare,
+				ParticipantCount: c.ParticipantCount,
+				ParticipantShare: c.ParticipantShare,
+				Weight:           c.Weight,
+			})
+		}
+		dimensions = append(dimensions, oas.Representativeness{
+			Dimension:              oas.DemographicDimension(r.Dimension),
+			Categories:             categories,
+			ParticipantCount:       r.ParticipantCount,
+			UnknownCount:           r.UnknownCount,
+			TotalVariationDistance: r.TotalVariationDistance,
+			MaxDeviation:           r.MaxDeviation,
+			ChiSquare:              r.ChiSquare,
+			DegreesOfFreedom:       r.DegreesOfFreedom,
+			UncoveredShare:         r.UncoveredShare,
+		})
+	}
+	return &oas.GetTalkSessionRepresentativenessOK{
+		Dimensions: dimensions,
+	}, nil
+}
+
+func referenceDistributionToResponse(distribution *analysis.ReferenceDistribution) oas.ReferenceDistribution {
+	categories := make([]oas.ReferenceCategory, 0, len(distribution.Categories))
+	for _, c := range distribution.Categories {
+		categories = append(categories, oas.ReferenceCategory{
+			Category: c.Category,
+			Share:    c.Share,
+		})
+	}
+	return oas.ReferenceDistribution{
+		Dimension:  oas.DemographicDimension(distribution.Dimension),
+		Categories: categories,
+		UpdatedAt:  distribution.UpdatedAt,
+	}
+}
+
+// GetOrganizationChildren 子組織一覧
+func (o *organizationHandler) GetOrganizationChildren(ctx context.Context, params oas.GetOrganizationChildrenParams) (oas.GetOrganizationChildrenRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "organizationHandler.GetOrganizationChildren")
+	defer span.End()
+
+	org, err := o.findOrganizationByCode(ctx, params.Code)
+	if err != nil {
+		return nil, err
+	}
+	authCtx, err := o.authorizationService.RequireOrganizationRoleFor(ctx, org.OrganizationID, organization.OrganizationUserRoleMember)
+	if err != nil {
+		return nil, err
+	}
+
+	children, err := o.orgRepo.FindChildren(ctx, org.OrganizationID)
+	if err != nil {
+		utils.HandleError(ctx, err, "OrganizationRepository.FindChildren")
+		return nil, messages.OrganizationInternalServerError
+	}
+
+	orgs := make([]oas.Organization, 0, len(children))
+	for _, child := range children {
+		res, err := o.organizationToResponse(ctx, child, authCtx.UserID)
+		if err != nil {
+			return nil, err
+		}
+		orgs = append(orgs, res)
+	}
+
+	return &oas.GetOrganizationChildrenOK{
+		Organizations: orgs,
+	}, nil
+}
+
+// GetOrganizationTalkSessions 組織（および子孫の組織）のセッション一覧
+func (o *organizationHandler) GetOrganizationTalkSessions(ctx context.Context, params oas.GetOrganizationTalkSessionsParams) (oas.GetOrganizationTalkSessionsRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "organizationHandler.GetOrganizationTalkSessions")
+	defer span.End()
+
+	org, err := o.findOrganizationByCode(ctx, params.Code)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := o.authorizationService.RequireOrganizationRoleFor(ctx, org.OrganizationID, organization.OrganizationUserRoleMember); err != nil {
+		return nil, err
+	}
+
+	var limit, offset *int
+	if params.Limit.IsSet() {
+		limit = lo.ToPtr(int(params.Limit.Value))
+	}
+	if params.Offset.IsSet() {
+		offset = lo.ToPtr(int(params.Offset.Value))
+	}
+	status := ""
+	if params.Status.IsSet() {
+		status = string(params.Status.Value)
+	}
+
+	out, err := o.browseTalkSessions.Execute(ctx, talksession_query.BrowseOrganizationTalkSessionsInput{
+		OrganizationID:     org.OrganizationID,
+		IncludeDescendants: params.IncludeDescendants.Or(true),
+		Limit:              limit,
+		Offset:             offset,
+		Status:             talksession_query.Status(status),
+		Theme:              utils.ToPtrIfNotNullValue(!params.Theme.IsSet(), params.Theme.Value),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	talkSessions := make([]oas.GetOrganizationTalkSessionsOKTalkSessionsItem, 0, len(out.TalkSessions))
+	for _, talkSession := range out.TalkSessions {
+		talkSessions = append(talkSessions, oas.GetOrganizationTalkSessionsOKTalkSessionsItem{
+			TalkSession:  talkSession.ToResponse(),
+			OpinionCount: talkSession.OpinionCount,
+		})
+	}
+
+	return &oas.GetOrganizationTalkSessionsOK{
+		TalkSessions: talkSessions,
+		Pagination: oas.OffsetPagination{
+			TotalCount: int(out.TotalCount),
+			Limit:      lo.FromPtrOr(limit, 10),
+			Offset:     lo.FromPtrOr(offset, 0),
+		},
+	}, nil
+}
+
+func (o *organizationHandler) findOrganizationByCode(ctx context.Context, code string) (*organization.Organization, error) {
+	org, err := o.orgRepo.FindByCode(ctx, code)
+	if err != nil || org == nil {
+		return nil, messages.OrganizationNotFound
+	}
+	return org, nil
+}
+
+// organizationToResponse 祖先の組織からの継承を含めたユーザーのロールとともに組織を返す
+func (o *organizationHandler) organizationToResponse(ctx context.Context, org *organization.Organization, userID shared.UUID[user.User]) (oas.Organization, error) {
+	orgUser, err := o.orgUserRepo.FindEffectiveByOrganizationIDAndUserID(ctx, org.OrganizationID, userID)
+	if err != nil {
+		utils.HandleError(ctx, err, "OrganizationUserRepository.FindEffectiveByOrganizationIDAndUserID")
+		return oas.Organization{}, messages.OrganizationInternalServerError
+	}
+
+	var parentID *string
+	if org.ParentOrganizationID != nil {
+		parentID = lo.ToPtr(org.ParentOrganizationID.String())
+	}
+	return oas.Organization{
+		ID:       org.OrganizationID.String(),
+		Name:     org.Name,
+		Code:     org.Code,
+		Type:     int(org.OrganizationType),
+		IconURL:  utils.ToOptNil[oas.OptNilString](org.IconURL),
+		Role:     int(orgUser.Role),
+		RoleName: organization.RoleToName(orgUser.Role),
+		ParentID: utils.ToOptNil[oas.OptNilString](parentID),
+	}, nil
+}
+
+// GetOrganizationStats 組織のダッシュボード
+func (o *organizationHandler) GetOrganizationStats(ctx context.Context, params oas.GetOrganizationStatsParams) (oas.GetOrganizationStatsRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "organizationHandler.GetOrganizationStats")
+	defer span.End()
+
+	org, err := o.findOrganizationByCode(ctx, params.Code)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := o.authorizationService.RequireOrganizationRoleFor(ctx, org.OrganizationID, organization.OrganizationUserRoleOwner); err != nil {
+		return nil, err
+	}
+
+	input := organization_query.GetOrganizationStatsInput{
+		OrganizationID:     org.OrganizationID,
+		IncludeDescendants: params.IncludeDescendants.Or(true),
+		Bucket:             organization_query.StatsBucket(params.Bucket.Or(oas.GetOrganizationStatsBucketDay)),
+		// 集計は日本時間の日付で区切っているため、今日の日付も日本時間で決める
+		Now: clock.Now(ctx).In(time.FixedZone("Asia/Tokyo", 9*60*60)),
+	}
+	if params.Since.IsSet() {
+		input.Since = lo.ToPtr(params.Since.Value)
+	}
+	if params.Until.IsSet() {
+		input.Until = lo.ToPtr(params.Until.Value)
+	}
+
+	out, err := o.getStats.Execute(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	periods := make([]oas.OrganizationStatsPeriod, 0, len(out.Periods))
+	for _, period := range out.Periods {
+		periods = append(periods, oas.OrganizationStatsPeriod{
+			Date:                period.Date,
+			TalkSessionCount:    period.TalkSessionCount,
+			ParticipantCount:    period.ParticipantCount,
+			OpinionCount:        period.OpinionCount,
+			VoteCount:           period.VoteCount,
+			ConsentCount:        period.ConsentCount,
+			ReportFeedbackCount: period.ReportFeedbackCount,
+		})
+	}
+
+	return &oas.OrganizationStats{
+		Bucket: oas.OrganizationStatsBucket(out.Bucket),
+		Since:  out.Since,
+		Until:  out.Until,
+		Totals: oas.OrganizationStatsCounts{
+			TalkSessionCount:    out.Totals.TalkSessionCount,
+			ParticipantCount:    out.Totals.ParticipantCount,
+			OpinionCount:        out.Totals.OpinionCount,
+			VoteCount:           out.Totals.VoteCount,
+			ConsentCount:        out.Totals.ConsentCount,
+			ReportFeedbackCount: out.Totals.ReportFeedbackCount,
+		},
+		Periods: periods,
+	}, nil
+}
+
+// GetTalkSessionTemplates 組織のセッションテンプレート一覧を返す
+func (o *organizationHandler) GetTalkSessionTemplates(ctx context.Context, params oas.GetTalkSessionTemplatesParams) (oas.GetTalkSessionTemplatesRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "organizationHandler.GetTalkSessionTemplates")
+	defer span.End()
+
+	org, err := o.findOrganizationByCode(ctx, params.Code)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := o.authorizationService.RequireOrganizationRoleFor(ctx, org.OrganizationID, organization.OrganizationUserRoleMember); err != nil {
+		return nil, err
+	}
+
+	out, err := o.listTemplates.Execute(ctx, organization_query.ListTalkSessionTemplatesInput{
+		OrganizationID: org.OrganizationID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make([]oas.TalkSessionTemplate, 0, len(out.Templates))
+	for _, template := range out.Templates {
+		templates = append(templates, talkSessionTemplateToResponse(template))
+	}
+	return &oas.GetTalkSessionTemplatesOK{
+		Templates: templates,
+	}, nil
+}
+
+// DeleteTalkSessionTemplate セッションテンプレートを削除する
+func (o *organizationHandler) DeleteTalkSessionTemplate(ctx context.Context, params oas.DeleteTalkSessionTemplateParams) (oas.DeleteTalkSessionTemplateRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "organizationHandler.DeleteTalkSessionTemplate")
+	defer span.End()
+
+	authCtx, err := o.authorizationService.RequireAuthentication(ctx)
+	if err != nil {
+		return nil, err
+	}
+	org, err := o.findOrganizationByCode(ctx, params.Code)
+	if err != nil {
+		return nil, err
+	}
+	templateID, err := shared.ParseUUID[talksession_template.TalkSessionTemplate](params.TemplateID)
+	if err != nil {
+		return nil, messages.BadRequestError
+	}
+
+	if err := o.deleteTemplate.Execute(ctx, talksession_usecase.DeleteTalkSessionTemplateInput{
+		OrganizationID: org.OrganizationID,
+		TemplateID:     templateID,
+		UserID:         authCtx.UserID,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &oas.DeleteTalkSessionTemplateOK{}, nil
+}
+
+// CloneTalkSessionTemplate テンプレートから下書きのセッションを作成する
+func (o *organizationHandler) CloneTalkSessionTemplate(ctx context.Context, req *oas.CloneTalkSessionTemplateReq, params oas.CloneTalkSessionTemplateParams) (oas.CloneTalkSessionTemplateRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "organizationHandler.CloneTalkSessionTemplate")
+	defer span.End()
+
+	authCtx, err := o.authorizationService.RequireAuthentication(ctx)
+	if err != nil {
+		return nil, err
+	}
+	org, err := o.findOrganizationByCode(ctx, params.Code)
+	if err != nil {
+		return nil, err
+	}
+	templateID, err := shared.ParseUUID[talksession_template.TalkSessionTemplate](params.TemplateID)
+	if err != nil {
+		return nil, messages.BadRequestError
+	}
+	if req == nil {
+		return nil, messages.RequiredParameterError
+	}
+
+	out, err := o.cloneTalkSession.Execute(ctx, talksession_usecase.CloneTalkSessionInput{
+		UserID:           authCtx.UserID,
+		TemplateID:       &templateID,
+		OrganizationID:   &org.OrganizationID,
+		Theme:            utils.ToPtrIf(req.Theme.IsSet(), req.Theme.Value),
+		ScheduledEndTime: req.ScheduledEndTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	talkSessionDetail, err := o.getTalkSessionDetail.Execute(ctx, talksession_query.GetTalkSessionDetailInput{
+		TalkSessionID: out.TalkSessionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := talkSessionDetail.ToResponse()
+	return &res, nil
+}
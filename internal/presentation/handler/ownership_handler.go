@@ -0,0 +1,283 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/neko-dream/api/internal/application/query/ownership_query"
+	"github.com/neko-dream/api/internal/application/usecase/ownership_usecase"
+	"github.com/neko-dream/api/internal/domain/messages"
+	"github.com/neko-dream/api/internal/domain/model/organization"
+	"github.com/neko-dream/api/internal/domain/model/ownership"
+	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/user"
+	"github.com/neko-dream/api/internal/domain/service"
+	"github.com/neko-dream/api/internal/presentation/oas"
+	"github.com/neko-dream/api/pkg/utils"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel"
+)
+
+type ownershipHandler struct {
+	requestTalkSessionTransfer  ownership_usecase.RequestTalkSessionTransferCommand
+	requestOrganizationTransfer ownership_usecase.RequestOrganizationTransferCommand
+	bulkTransferTalkSessions    ownership_usecase.BulkTransferTalkSessionsCommand
+	acceptTransfer              ownership_usecase.AcceptTransferCommand
+	declineTransfer             ownership_usecase.DeclineTransferCommand
+	cancelTransfer              ownership_usecase.CancelTransferCommand
+	getTransfer                 ownership_query.GetOwnershipTransferQuery
+	listTransfers               ownership_query.ListOwnershipTransfersQuery
+
+	organizationRepository organization.OrganizationRepository
+	authorizationService   service.AuthorizationService
+}
+
+func NewOwnershipHandler(
+	requestTalkSessionTransfer ownership_usecase.RequestTalkSessionTransferCommand,
+	requestOrganizationTransfer ownership_usecase.RequestOrganizationTransferCommand,
+	bulkTransferTalkSessions ownership_usecase.BulkTransferTalkSessionsCommand,
+	acceptTransfer ownership_usecase.AcceptTransferCommand,
+	declineTransfer ownership_usecase.DeclineTransferCommand,
+	cancelTransfer ownership_usecase.CancelTransferCommand,
+	getTransfer ownership_query.GetOwnershipTransferQuery,
+	listTransfers ownership_query.ListOwnershipTransfersQuery,
+	organizationRepository organization.OrganizationRepository,
+	authorizationService service.AuthorizationService,
+) oas.OwnershipHandler {
+	return &ownershipHandler{
+		requestTalkSessionTransfer:  requestTalkSessionTransfer,
+		requestOrganizationTransfer: requestOrganizationTransfer,
+		bulkTransferTalkSessions:    bulkTransferTalkSessions,
+		acceptTransfer:              acceptTransfer,
+		declineTransfer:             declineTransfer,
+		cancelTransfer:              cancelTransfer,
+		getTransfer:                 getTransfer,
+		listTransfers:               listTransfers,
+		organizationRepository:      organizationRepository,
+		authorizationService:        authorizationService,
+	}
+}
+
+// ListOwnershipTransfers オーナー権限の譲渡依頼一覧
+func (h *ownershipHandler) ListOwnershipTransfers(ctx context.Context) (oas.ListOwnershipTransfersRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "ownershipHandler.ListOwnershipTransfers")
+	defer span.End()
+
+	authCtx, err := h.authorizationService.RequireAuthentication(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := h.listTransfers.Execute(ctx, ownership_query.ListOwnershipTransfersInput{
+		UserID: authCtx.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &oas.ListOwnershipTransfersOK{
+		Received: lo.Map(out.Received, func(transfer ownership_query.OwnershipTransfer, _ int) oas.OwnershipTransfer {
+			return ownershipTransferToResponse(transfer)
+		}),
+		Sent: lo.Map(out.Sent, func(transfer ownership_query.OwnershipTransfer, _ int) oas.OwnershipTransfer {
+			return ownershipTransferToResponse(transfer)
+		}),
+	}, nil
+}
+
+// RequestTalkSessionOwnershipTransfer セッションのオーナー権限の譲渡を依頼
+func (h *ownershipHandler) RequestTalkSessionOwnershipTransfer(ctx context.Context, req *oas.RequestTalkSessionOwnershipTransferReq, params oas.RequestTalkSessionOwnershipTransferParams) (oas.RequestTalkSessionOwnershipTransferRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "ownershipHandler.RequestTalkSessionOwnershipTransfer")
+	defer span.End()
+
+	authCtx, err := h.authorizationService.RequireAuthentication(ctx)
+	if err != nil {
+		return nil, err
+	}
+	talkSessionID, err := shared.ParseUUID[talksession.TalkSession](params.TalkSessionID)
+	if err != nil {
+		return nil, messages.BadRequestError
+	}
+
+	out, err := h.requestTalkSessionTransfer.Execute(ctx, ownership_usecase.RequestTalkSessionTransferInput{
+		UserID:             authCtx.UserID,
+		TalkSessionID:      talkSessionID,
+		RecipientDisplayID: req.RecipientDisplayID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return h.findTransfer(ctx, authCtx.UserID, out.Transfer.TransferID())
+}
+
+// RequestOrganizationOwnershipTransfer 組織のオーナー権限の譲渡を依頼
+func (h *ownershipHandler) RequestOrganizationOwnershipTransfer(ctx context.Context, req *oas.RequestOrganizationOwnershipTransferReq, params oas.RequestOrganizationOwnershipTransferParams) (oas.RequestOrganizationOwnershipTransferRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "ownershipHandler.RequestOrganizationOwnershipTransfer")
+	defer span.End()
+
+	authCtx, err := h.authorizationService.RequireAuthentication(ctx)
+	if err != nil {
+		return nil, err
+	}
+	org, err := h.organizationRepository.FindByCode(ctx, params.Code)
+	if err != nil || org == nil {
+		return nil, messages.OrganizationNotFound
+	}
+
+	out, err := h.requestOrganizationTransfer.Execute(ctx, ownership_usecase.RequestOrganizationTransferInput{
+		UserID:             authCtx.UserID,
+		OrganizationID:     org.OrganizationID,
+		RecipientDisplayID: req.RecipientDisplayID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return h.findTransfer(ctx, authCtx.UserID, out.Transfer.TransferID())
+}
+
+// BulkTransferTalkSessions メンバーのセッションをまとめて譲渡依頼
+func (h *ownershipHandler) BulkTransferTalkSessions(ctx context.Context, req *oas.BulkTransferTalkSessionsReq, params oas.BulkTransferTalkSessionsParams) (oas.BulkTransferTalkSessionsRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "ownershipHandler.BulkTransferTalkSessions")
+	defer span.End()
+
+	authCtx, err := h.authorizationService.RequireAuthentication(ctx)
+	if err != nil {
+		return nil, err
+	}
+	org, err := h.organizationRepository.FindByCode(ctx, params.Code)
+	if err != nil || org == nil {
+		return nil, messages.OrganizationNotFound
+	}
+
+	out, err := h.bulkTransferTalkSessions.Execute(ctx, ownership_usecase.BulkTransferTalkSessionsInput{
+		UserID:             authCtx.UserID,
+		OrganizationID:     org.OrganizationID,
+		FromDisplayID:      req.FromDisplayID,
+		RecipientDisplayID: req.RecipientDisplayID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	transfers := make([]oas.OwnershipTransfer, 0, len(out.Transfers))
+	for _, transfer := range out.Transfers {
+		res, err := h.findTransfer(ctx, authCtx.UserID, transfer.TransferID())
+		if err != nil {
+			return nil, err
+		}
+		transfers = append(transfers, *res)
+	}
+
+	return &oas.BulkTransferTalkSessionsOK{
+		Transfers:    transfers,
+		SkippedCount: int32(out.SkippedCount),
+	}, nil
+}
+
+// AcceptOwnershipTransfer オーナー権限の譲渡を承諾
+func (h *ownershipHandler) AcceptOwnershipTransfer(ctx context.Context, params oas.AcceptOwnershipTransferParams) (oas.AcceptOwnershipTransferRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "ownershipHandler.AcceptOwnershipTransfer")
+	defer span.End()
+
+	authCtx, err := h.authorizationService.RequireAuthentication(ctx)
+	if err != nil {
+		return nil, err
+	}
+	transferID, err := shared.ParseUUID[ownership.OwnershipTransfer](params.TransferID)
+	if err != nil {
+		return nil, messages.BadRequestError
+	}
+
+	if _, err := h.acceptTransfer.Execute(ctx, ownership_usecase.AcceptTransferInput{
+		UserID:     authCtx.UserID,
+		TransferID: transferID,
+	}); err != nil {
+		return nil, err
+	}
+
+	return h.findTransfer(ctx, authCtx.UserID, transferID)
+}
+
+// DeclineOwnershipTransfer オーナー権限の譲渡を辞退
+func (h *ownershipHandler) DeclineOwnershipTransfer(ctx context.Context, params oas.DeclineOwnershipTransferParams) (oas.DeclineOwnershipTransferRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "ownershipHandler.DeclineOwnershipTransfer")
+	defer span.End()
+
+	authCtx, err := h.authorizationService.RequireAuthentication(ctx)
+	if err != nil {
+		return nil, err
+	}
+	transferID, err := shared.ParseUUID[ownership.OwnershipTransfer](params.TransferID)
+	if err != nil {
+		return nil, messages.BadRequestError
+	}
+
+	if _, err := h.declineTransfer.Execute(ctx, ownership_usecase.DeclineTransferInput{
+		UserID:     authCtx.UserID,
+		TransferID: transferID,
+	}); err != nil {
+		return nil, err
+	}
+
+	return h.findTransfer(ctx, authCtx.UserID, transferID)
+}
+
+// CancelOwnershipTransfer オーナー権限の譲渡依頼を取り消し
+func (h *ownershipHandler) CancelOwnershipTransfer(ctx context.Context, params oas.CancelOwnershipTransferParams) (oas.CancelOwnershipTransferRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "ownershipHandler.CancelOwnershipTransfer")
+	defer span.End()
+
+	authCtx, err := h.authorizationService.RequireAuthentication(ctx)
+	if err != nil {
+		return nil, err
+	}
+	transferID, err := shared.ParseUUID[ownership.OwnershipTransfer](params.TransferID)
+	if err != nil {
+		return nil, messages.BadRequestError
+	}
+
+	if _, err := h.cancelTransfer.Execute(ctx, ownership_usecase.CancelTransferInput{
+		UserID:     authCtx.UserID,
+		TransferID: transferID,
+	}); err != nil {
+		return nil, err
+	}
+
+	return h.findTransfer(ctx, authCtx.UserID, transferID)
+}
+
+// findTransfer 表示用の情報を含めた譲渡依頼を取得する
+func (h *ownershipHandler) findTransfer(ctx context.Context, userID shared.UUID[user.User], transferID shared.UUID[ownership.OwnershipTransfer]) (*oas.OwnershipTransfer, error) {
+	transfer, err := h.getTransfer.Execute(ctx, ownership_query.GetOwnershipTransferInput{
+		UserID:     userID,
+		TransferID: transferID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return lo.ToPtr(ownershipTransferToResponse(*transfer)), nil
+}
+
+func ownershipTransferToResponse(transfer ownership_query.OwnershipTransfer) oas.OwnershipTransfer {
+	res := oas.OwnershipTransfer{
+		TransferID:       transfer.TransferID.String(),
+		TargetType:       oas.OwnershipTransferTargetType(transfer.TargetType),
+		OrganizationCode: utils.ToOptNil[oas.OptNilString](transfer.OrganizationCode),
+		TargetName:       transfer.TargetName,
+		FromUser:         oas.User(transfer.FromUser.ToResponse()),
+		ToUser:           oas.User(transfer.ToUser.ToResponse()),
+		Status:           oas.OwnershipTransferStatus(transfer.Status),
+		ExpiresAt:        transfer.ExpiresAt.Format(time.RFC3339),
+		CreatedAt:        transfer.CreatedAt.Format(time.RFC3339),
+	}
+	if transfer.TalkSessionID != nil {
+		res.TalkSessionID = oas.NewOptNilString(transfer.TalkSessionID.String())
+	}
+	if transfer.RespondedAt != nil {
+		res.RespondedAt = oas.NewOptNilString(transfer.RespondedAt.Format(time.RFC3339))
+	}
+	return res
+}
@@ -6,14 +6,18 @@ import (
 
 	"github.com/neko-dream/api/internal/domain/messages"
 	"github.com/neko-dream/api/internal/domain/model/clock"
+	"github.com/neko-dream/api/internal/domain/model/organization"
 	"github.com/neko-dream/api/internal/domain/model/session"
+	"github.com/neko-dream/api/internal/domain/service"
 	"github.com/neko-dream/api/internal/presentation/oas"
+	"github.com/ogen-go/ogen/ogenerrors"
 	"go.opentelemetry.io/otel"
 )
 
 type securityHandler struct {
 	session.TokenManager
 	session.SessionRepository
+	apiKeyAuthenticator service.APIKeyAuthenticator
 }
 
 var skipOperations = []string{
@@ -22,6 +26,36 @@ var skipOperations = []string{
 	"RevokeToken",
 }
 
+// APIキーで呼び出せるOperationと必要なスコープ。ここにないOperationはAPIキーでは呼び出せない
+var apiKeyOperationScopes = map[string]organization.APIKeyScope{
+	oas.GetTalkSessionListOperation:        organization.APIKeyScopeSessionsRead,
+	oas.GetTalkSessionDetailOperation:      organization.APIKeyScopeSessionsRead,
+	oas.GetOpinionsForTalkSessionOperation: organization.APIKeyScopeSessionsRead,
+	oas.GetOpinionDetail2Operation:         organization.APIKeyScopeSessionsRead,
+	oas.OpinionComments2Operation:          organization.APIKeyScopeSessionsRead,
+	oas.GetConclusionOperation:             organization.APIKeyScopeSessionsRead,
+	oas.GetTimeLineOperation:               organization.APIKeyScopeSessionsRead,
+	oas.InitiateTalkSessionOperation:       organization.APIKeyScopeSessionsWrite,
+	oas.EditTalkSessionOperation:           organization.APIKeyScopeSessionsWrite,
+	oas.PostConclusionOperation:            organization.APIKeyScopeSessionsWrite,
+	oas.PostTimeLineItemOperation:          organization.APIKeyScopeSessionsWrite,
+	oas.EditTimeLineOperation:              organization.APIKeyScopeSessionsWrite,
+	oas.TalkSessionAnalysisOperation:       organization.APIKeyScopeResultsExport,
+	oas.GetTalkSessionReportOperation:      organization.APIKeyScopeResultsExport,
+	oas.GetOpinionAnalysisOperation:        organization.APIKeyScopeResultsExport,
+}
+
+// RequiredAPIKeyScope Operationの呼び出しにAPIキーで必要なスコープを返す
+func RequiredAPIKeyScope(operationName string) (organization.APIKeyScope, bool) {
+	scope, ok := apiKeyOperationScopes[operationName]
+	return scope, ok
+}
+
+// Cookieがなくても呼び出せるOperation。Cookieが無効な場合もエラーにせず未ログインとして扱う
+var optionalAuthOperations = []string{
+	oas.GetOpinionAnalysisOperation,
+}
+
 var skipOperationsForWithdrawal = []string{
 	"ReactivateUser",
 	"RevokeToken",
@@ -32,6 +66,15 @@ var skipOperationsForWithdrawal = []string{
 func (s *securityHandler) HandleCookieAuth(ctx context.Context, operationName string, t oas.CookieAuth) (context.Context, error) {
 	ctx, span := otel.Tracer("handler").Start(ctx, "securityHandler.HandleSessionId")
 	defer span.End()
+
+	authCtx, err := s.authenticateCookie(ctx, operationName, t)
+	if err != nil && slices.Contains(optionalAuthOperations, operationName) {
+		return ctx, ogenerrors.ErrSkipServerSecurity
+	}
+	return authCtx, err
+}
+
+func (s *securityHandler) authenticateCookie(ctx context.Context, operationName string, t oas.CookieAuth) (context.Context, error) {
 	// セッションIDを取得
 	claim, err := s.TokenManager.Parse(ctx, t.GetAPIKey())
 	if err != nil {
@@ -80,12 +123,37 @@ func (s *securityHandler) HandleCookieAuth(ctx context.Context, operationName st
 	return session.SetSession(ctx, claim), nil
 }
 
+// HandleOrganizationApiKeyAuth X-API-Keyヘッダーの組織APIキーで認証する
+func (s *securityHandler) HandleOrganizationApiKeyAuth(ctx context.Context, operationName string, t oas.OrganizationApiKeyAuth) (context.Context, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "securityHandler.HandleOrganizationApiKeyAuth")
+	defer span.End()
+
+	// Cookieで認証済みの場合はそちらを優先する
+	if session.GetSession(ctx) != nil {
+		return ctx, nil
+	}
+
+	scope, ok := RequiredAPIKeyScope(operationName)
+	if !ok {
+		return ctx, messages.APIKeyScopeDeniedError
+	}
+
+	claim, err := s.apiKeyAuthenticator.Authenticate(ctx, t.GetAPIKey(), scope)
+	if err != nil {
+		return ctx, err
+	}
+
+	return session.SetSession(ctx, claim), nil
+}
+
 func NewSecurityHandler(
 	tokenManager session.TokenManager,
 	sessRepository session.SessionRepository,
+	apiKeyAuthenticator service.APIKeyAuthenticator,
 ) oas.SecurityHandler {
 	return &securityHandler{
-		TokenManager:      tokenManager,
-		SessionRepository: sessRepository,
+		TokenManager:        tokenManager,
+		SessionRepository:   sessRepository,
+		apiKeyAuthenticator: apiKeyAuthenticator,
 	}
 }
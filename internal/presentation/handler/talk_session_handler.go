@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"strings"
+	"time"
 
 	"braces.dev/errtrace"
 	"github.com/neko-dream/api/internal/application/query/analysis_query"
@@ -15,6 +16,7 @@ import (
 	"github.com/neko-dream/api/internal/domain/model/session"
 	"github.com/neko-dream/api/internal/domain/model/shared"
 	"github.com/neko-dream/api/internal/domain/model/talksession"
+	"github.com/neko-dream/api/internal/domain/model/talksession/talksession_template"
 	"github.com/neko-dream/api/internal/domain/service"
 	"github.com/neko-dream/api/internal/presentation/oas"
 	"github.com/neko-dream/api/pkg/sort"
@@ -35,11 +37,17 @@ type talkSessionHandler struct {
 	getReports                    report_query.GetByTalkSessionQuery
 	getReportCount                report_query.GetCountQuery
 	hasConsent                    talksession_query.HasConsentQuery
+	getCollaborators              talksession_query.GetCollaboratorsQuery
 
 	addConclusionCommand    talksession_usecase.AddConclusionCommand
 	startTalkSessionCommand talksession_usecase.StartTalkSessionUseCase
 	editTalkSessionCommand  talksession_usecase.EditTalkSessionUseCase
 	takeConsentCommand      talksession_usecase.TakeConsentUseCase
+	saveTemplateCommand     talksession_usecase.SaveTalkSessionTemplateUseCase
+	cloneTalkSessionCommand talksession_usecase.CloneTalkSessionUseCase
+	publishCommand          talksession_usecase.PublishTalkSessionUseCase
+	setCollaboratorCommand  talksession_usecase.SetTalkSessionCollaboratorUseCase
+	removeCollaborator      talksession_usecase.RemoveTalkSessionCollaboratorUseCase
 
 	authorizationService service.AuthorizationService
 	session.TokenManager
@@ -57,11 +65,17 @@ func NewTalkSessionHandler(
 	getReports report_query.GetByTalkSessionQuery,
 	getReportCount report_query.GetCountQuery,
 	hasConsent talksession_query.HasConsentQuery,
+	getCollaborators talksession_query.GetCollaboratorsQuery,
 
 	AddConclusionCommand talksession_usecase.AddConclusionCommand,
 	startTalkSessionCommand talksession_usecase.StartTalkSessionUseCase,
 	editTalkSessionCommand talksession_usecase.EditTalkSessionUseCase,
 	takeConsentCommand talksession_usecase.TakeConsentUseCase,
+	saveTemplateCommand talksession_usecase.SaveTalkSessionTemplateUseCase,
+	cloneTalkSessionCommand talksession_usecase.CloneTalkSessionUseCase,
+	publishCommand talksession_usecase.PublishTalkSessionUseCase,
+	setCollaboratorCommand talksession_usecase.SetTalkSessionCollaboratorUseCase,
+	removeCollaborator talksession_usecase.RemoveTalkSessionCollaboratorUseCase,
 
 	authorizationService service.AuthorizationService,
 	tokenManager session.TokenManager,
@@ -78,11 +92,17 @@ func NewTalkSessionHandler(
 		getReports:                    getReports,
 		getReportCount:                getReportCount,
 		hasConsent:                    hasConsent,
+		getCollaborators:              getCollaborators,
 
 		addConclusionCommand:    AddConclusionCommand,
 		startTalkSessionCommand: startTalkSessionCommand,
 		editTalkSessionCommand:  editTalkSessionCommand,
 		takeConsentCommand:      takeConsentCommand,
+		saveTemplateCommand:     saveTemplateCommand,
+		cloneTalkSessionCommand: cloneTalkSessionCommand,
+		publishCommand:          publishCommand,
+		setCollaboratorCommand:  setCollaboratorCommand,
+		removeCollaborator:      removeCollaborator,
 
 		authorizationService: authorizationService,
 		TokenManager:         tokenManager,
@@ -272,9 +292,35 @@ func (t *talkSessionHandler) GetTalkSessionReport(ctx context.Context, params oa
 		}
 	}
 
-	return &oas.GetTalkSessionReportOK{
-		Report: report,
-	}, nil
+	importantOpinions := make([]oas.GroupImportantOpinions, 0, len(out.ImportantOpinions))
+	for _, group := range out.ImportantOpinions {
+		importantOpinions = append(importantOpinions, group.ToResponse())
+	}
+
+	res := &oas.GetTalkSessionReportOK{
+		Report:            report,
+		ImportantOpinions: importantOpinions,
+	}
+	if out.ReportID != nil {
+		res.ReportID = oas.NewOptString(out.ReportID.String())
+	}
+	if out.Sections != nil {
+		stances := make([]oas.GroupStance, 0, len(out.Sections.GroupStances))
+		for _, stance := range out.Sections.GroupStances {
+			stances = append(stances, oas.GroupStance{
+				GroupName: stance.GroupID.String(),
+				GroupID:   int(stance.GroupID),
+				Stance:    stance.Stance,
+			})
+		}
+		res.Sections = oas.NewOptReportSections(oas.ReportSections{
+			Summary:       out.Sections.Summary,
+			GroupStances:  stances,
+			Consensus:     out.Sections.Consensus,
+			OpenQuestions: out.Sections.OpenQuestions,
+		})
+	}
+	return res, nil
 }
 
 // InitiateTalkSession トークセッション作成
@@ -450,10 +496,15 @@ func (t *talkSessionHandler) TalkSessionAnalysis(ctx context.Context, params oas
 				PassCount:     opinion.PassCount,
 			})
 		}
+		importantOpinions := make([]oas.ImportantOpinion, 0, len(groupOpinion.ImportantOpinions))
+		for _, importantOpinion := range groupOpinion.ImportantOpinions {
+			importantOpinions = append(importantOpinions, importantOpinion.ToResponse())
+		}
 		groupOpinions = append(groupOpinions, oas.TalkSessionAnalysisOKGroupOpinionsItem{
-			GroupName: groupOpinion.GroupName,
-			GroupID:   groupOpinion.GroupID,
-			Opinions:  opinions,
+			GroupName:         groupOpinion.GroupName,
+			GroupID:           groupOpinion.GroupID,
+			Opinions:          opinions,
+			ImportantOpinions: importantOpinions,
 		})
 	}
 
@@ -509,6 +560,210 @@ func (t *talkSessionHandler) EditTalkSession(ctx context.Context, req *oas.EditT
 	return &res, nil
 }
 
+// SaveTalkSessionTemplate セッションを組織のテンプレートとして保存する
+func (t *talkSessionHandler) SaveTalkSessionTemplate(ctx context.Context, req *oas.SaveTalkSessionTemplateReq, params oas.SaveTalkSessionTemplateParams) (oas.SaveTalkSessionTemplateRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "talkSessionHandler.SaveTalkSessionTemplate")
+	defer span.End()
+
+	authCtx, err := t.authorizationService.RequireAuthentication(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	talkSessionID, err := shared.ParseUUID[talksession.TalkSession](params.TalkSessionID)
+	if err != nil {
+		return nil, messages.BadRequestError
+	}
+	if req == nil {
+		return nil, messages.RequiredParameterError
+	}
+
+	out, err := t.saveTemplateCommand.Execute(ctx, talksession_usecase.SaveTalkSessionTemplateInput{
+		TalkSessionID: talkSessionID,
+		UserID:        authCtx.UserID,
+		Name:          req.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := talkSessionTemplateToResponse(out.Template)
+	return &res, nil
+}
+
+// CloneTalkSession セッションを複製して下書きを作成する
+func (t *talkSessionHandler) CloneTalkSession(ctx context.Context, req *oas.CloneTalkSessionReq, params oas.CloneTalkSessionParams) (oas.CloneTalkSessionRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "talkSessionHandler.CloneTalkSession")
+	defer span.End()
+
+	authCtx, err := t.authorizationService.RequireAuthentication(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	talkSessionID, err := shared.ParseUUID[talksession.TalkSession](params.TalkSessionID)
+	if err != nil {
+		return nil, messages.BadRequestError
+	}
+	if req == nil {
+		return nil, messages.RequiredParameterError
+	}
+
+	out, err := t.cloneTalkSessionCommand.Execute(ctx, talksession_usecase.CloneTalkSessionInput{
+		UserID:              authCtx.UserID,
+		SourceTalkSessionID: &talkSessionID,
+		Theme:               utils.ToPtrIf(req.Theme.IsSet(), req.Theme.Value),
+		ScheduledEndTime:    req.ScheduledEndTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	talkSessionDetail, err := t.getTalkSessionDetailByIDQuery.Execute(ctx, talksession_query.GetTalkSessionDetailInput{
+		TalkSessionID: out.TalkSessionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := talkSessionDetail.ToResponse()
+	return &res, nil
+}
+
+// PublishTalkSession 下書きのセッションを公開する
+func (t *talkSessionHandler) PublishTalkSession(ctx context.Context, params oas.PublishTalkSessionParams) (oas.PublishTalkSessionRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "talkSessionHandler.PublishTalkSession")
+	defer span.End()
+
+	authCtx, err := t.authorizationService.RequireAuthentication(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	talkSessionID, err := shared.ParseUUID[talksession.TalkSession](params.TalkSessionID)
+	if err != nil {
+		return nil, messages.BadRequestError
+	}
+
+	if err := t.publishCommand.Execute(ctx, talksession_usecase.PublishTalkSessionInput{
+		TalkSessionID: talkSessionID,
+		UserID:        authCtx.UserID,
+	}); err != nil {
+		return nil, err
+	}
+
+	talkSessionDetail, err := t.getTalkSessionDetailByIDQuery.Execute(ctx, talksession_query.GetTalkSessionDetailInput{
+		TalkSessionID: talkSessionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := talkSessionDetail.ToResponse()
+	return &res, nil
+}
+
+// GetTalkSessionCollaborators セッションのオーナーと共同管理者の一覧を取得する
+func (t *talkSessionHandler) GetTalkSessionCollaborators(ctx context.Context, params oas.GetTalkSessionCollaboratorsParams) (oas.GetTalkSessionCollaboratorsRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "talkSessionHandler.GetTalkSessionCollaborators")
+	defer span.End()
+
+	authCtx, err := t.authorizationService.RequireAuthentication(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	talkSessionID, err := shared.ParseUUID[talksession.TalkSession](params.TalkSessionID)
+	if err != nil {
+		return nil, messages.BadRequestError
+	}
+
+	out, err := t.getCollaborators.Execute(ctx, talksession_query.GetCollaboratorsInput{
+		TalkSessionID: talkSessionID,
+		UserID:        authCtx.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	collaborators := make([]oas.TalkSessionCollaborator, 0, len(out.Collaborators))
+	for _, collaborator := range out.Collaborators {
+		collaborators = append(collaborators, oas.TalkSessionCollaborator{
+			User:      oas.User(collaborator.User.ToResponse()),
+			Role:      oas.TalkSessionCollaboratorRole(collaborator.Role),
+			CreatedAt: collaborator.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return &oas.GetTalkSessionCollaboratorsOK{
+		Owner:         oas.User(out.Owner.ToResponse()),
+		Collaborators: collaborators,
+	}, nil
+}
+
+// SetTalkSessionCollaborator セッションの共同管理者を追加・変更する
+func (t *talkSessionHandler) SetTalkSessionCollaborator(ctx context.Context, req *oas.SetTalkSessionCollaboratorReq, params oas.SetTalkSessionCollaboratorParams) (oas.SetTalkSessionCollaboratorRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "talkSessionHandler.SetTalkSessionCollaborator")
+	defer span.End()
+
+	authCtx, err := t.authorizationService.RequireAuthentication(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	talkSessionID, err := shared.ParseUUID[talksession.TalkSession](params.TalkSessionID)
+	if err != nil {
+		return nil, messages.BadRequestError
+	}
+	if req == nil {
+		return nil, messages.RequiredParameterError
+	}
+
+	out, err := t.setCollaboratorCommand.Execute(ctx, talksession_usecase.SetTalkSessionCollaboratorInput{
+		TalkSessionID:   talkSessionID,
+		UserID:          authCtx.UserID,
+		TargetDisplayID: params.DisplayID,
+		Role:            string(req.Role),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &oas.TalkSessionCollaborator{
+		User: oas.User{
+			DisplayID:   lo.FromPtr(out.User.DisplayID()),
+			DisplayName: lo.FromPtr(out.User.DisplayName()),
+			IconURL:     utils.ToOptNil[oas.OptNilString](out.User.IconURL()),
+		},
+		Role:      oas.TalkSessionCollaboratorRole(out.Collaborator.Role()),
+		CreatedAt: out.Collaborator.CreatedAt().Format(time.RFC3339),
+	}, nil
+}
+
+// RemoveTalkSessionCollaborator セッションの共同管理者を外す
+func (t *talkSessionHandler) RemoveTalkSessionCollaborator(ctx context.Context, params oas.RemoveTalkSessionCollaboratorParams) (oas.RemoveTalkSessionCollaboratorRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "talkSessionHandler.RemoveTalkSessionCollaborator")
+	defer span.End()
+
+	authCtx, err := t.authorizationService.RequireAuthentication(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	talkSessionID, err := shared.ParseUUID[talksession.TalkSession](params.TalkSessionID)
+	if err != nil {
+		return nil, messages.BadRequestError
+	}
+
+	if err := t.removeCollaborator.Execute(ctx, talksession_usecase.RemoveTalkSessionCollaboratorInput{
+		TalkSessionID:   talkSessionID,
+		UserID:          authCtx.UserID,
+		TargetDisplayID: params.DisplayID,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &oas.RemoveTalkSessionCollaboratorOK{}, nil
+}
+
 // GetTalkSessionRestrictionKeys implements oas.TalkSessionHandler.
 func (t *talkSessionHandler) GetTalkSessionRestrictionKeys(ctx context.Context) (oas.GetTalkSessionRestrictionKeysRes, error) {
 	ctx, span := otel.Tracer("handler").Start(ctx, "talkSessionHandler.GetTalkSessionRestrictionKeys")
@@ -692,3 +947,39 @@ func (t *talkSessionHandler) HasConsent(ctx context.Context, params oas.HasConse
 		HasConsent: hasConsent,
 	}, nil
 }
+
+func talkSessionTemplateToResponse(template *talksession_template.TalkSessionTemplate) oas.TalkSessionTemplate {
+	restrictions := make([]oas.Restriction, 0, len(template.Restrictions()))
+	for _, restriction := range template.Restrictions() {
+		key := talksession.RestrictionAttributeKey(restriction)
+		attr := key.RestrictionAttribute()
+		restrictions = append(restrictions, oas.Restriction{
+			Key:         string(attr.Key),
+			Description: attr.Description,
+		})
+	}
+
+	seedOpinions := make([]oas.TalkSessionTemplateSeedOpinion, 0, len(template.SeedOpinions()))
+	for _, seed := range template.SeedOpinions() {
+		seedOpinions = append(seedOpinions, oas.TalkSessionTemplateSeedOpinion{
+			Title:        utils.ToOptNil[oas.OptNilString](seed.Title),
+			Content:      seed.Content,
+			ReferenceURL: utils.ToOptNil[oas.OptNilString](seed.ReferenceURL),
+			PictureURL:   utils.ToOptNil[oas.OptNilString](seed.PictureURL),
+		})
+	}
+
+	return oas.TalkSessionTemplate{
+		ID:           template.TemplateID().String(),
+		Name:         template.Name(),
+		Theme:        template.Theme(),
+		Description:  utils.ToOptNil[oas.OptNilString](template.Description()),
+		ThumbnailURL: utils.ToOptNil[oas.OptNilString](template.ThumbnailURL()),
+		City:         utils.ToOptNil[oas.OptNilString](template.City()),
+		Prefecture:   utils.ToOptNil[oas.OptNilString](template.Prefecture()),
+		Restrictions: restrictions,
+		HideTop:      template.HideTop(),
+		SeedOpinions: seedOpinions,
+		CreatedAt:    template.CreatedAt().Format(time.RFC3339),
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/neko-dream/api/internal/domain/messages"
 	"github.com/neko-dream/api/internal/domain/model/opinion"
 	"github.com/neko-dream/api/internal/domain/model/shared"
+	"github.com/neko-dream/api/internal/domain/model/talksession"
 	"github.com/neko-dream/api/internal/domain/service"
 	"github.com/neko-dream/api/internal/presentation/oas"
 	"github.com/neko-dream/api/pkg/utils"
@@ -15,15 +16,18 @@ import (
 
 type voteHandler struct {
 	voteCommand          vote_usecase.Vote
+	batchVoteCommand     vote_usecase.BatchVote
 	authorizationService service.AuthorizationService
 }
 
 func NewVoteHandler(
 	voteCommand vote_usecase.Vote,
+	batchVoteCommand vote_usecase.BatchVote,
 	authorizationService service.AuthorizationService,
 ) oas.VoteHandler {
 	return &voteHandler{
 		voteCommand:          voteCommand,
+		batchVoteCommand:     batchVoteCommand,
 		authorizationService: authorizationService,
 	}
 }
@@ -51,6 +55,7 @@ func (v *voteHandler) Vote2(ctx context.Context, req *oas.Vote2Req, params oas.V
 		TargetOpinionID: targetOpinionID,
 		UserID:          authCtx.UserID,
 		VoteType:        string(value.VoteStatus),
+		IsImportant:     utils.ToPtrIf(value.IsImportant.Set, value.IsImportant.Value),
 	})
 	if err != nil {
 		utils.HandleError(ctx, err, "postVoteUseCase.Execute")
@@ -60,3 +65,67 @@ func (v *voteHandler) Vote2(ctx context.Context, req *oas.Vote2Req, params oas.V
 	res := &oas.Vote2OKApplicationJSON{}
 	return res, nil
 }
+
+// BatchVote 一括投票
+func (v *voteHandler) BatchVote(ctx context.Context, req *oas.BatchVoteReq, params oas.BatchVoteParams) (oas.BatchVoteRes, error) {
+	ctx, span := otel.Tracer("handler").Start(ctx, "voteHandler.BatchVote")
+	defer span.End()
+
+	authCtx, err := v.authorizationService.RequireAuthentication(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req == nil {
+		return nil, messages.RequiredParameterError
+	}
+
+	talkSessionID, err := shared.ParseUUID[talksession.TalkSession](params.TalkSessionID)
+	if err != nil {
+		return nil, messages.BadRequestError
+	}
+
+	votes := make([]vote_usecase.BatchVoteItem, 0, len(req.Votes))
+	for _, item := range req.Votes {
+		opinionID, err := shared.ParseUUID[opinion.Opinion](item.OpinionID)
+		if err != nil {
+			return nil, messages.BadRequestError
+		}
+		votes = append(votes, vote_usecase.BatchVoteItem{
+			IdempotencyKey:  item.IdempotencyKey,
+			TargetOpinionID: opinionID,
+			VoteType:        string(item.VoteStatus),
+			IsImportant:     utils.ToPtrIf(item.IsImportant.Set, item.IsImportant.Value),
+			VotedAt:         item.VotedAt,
+		})
+	}
+
+	out, err := v.batchVoteCommand.Execute(ctx, vote_usecase.BatchVoteInput{
+		TalkSessionID: talkSessionID,
+		UserID:        authCtx.UserID,
+		Votes:         votes,
+	})
+	if err != nil {
+		utils.HandleError(ctx, err, "batchVoteCommand.Execute")
+		return nil, err
+	}
+
+	results := make([]oas.BatchVoteResult, 0, len(out.Results))
+	for _, result := range out.Results {
+		res := oas.BatchVoteResult{
+			IdempotencyKey: result.IdempotencyKey,
+			OpinionID:      result.OpinionID.String(),
+			Status:         oas.BatchVoteResultStatus(result.Status),
+		}
+		if result.Error != nil {
+			res.Error = oas.NewOptBatchVoteResultError(oas.BatchVoteResultError{
+				Code:    result.Error.Code,
+				Message: result.Error.Message,
+			})
+		}
+		results = append(results, res)
+	}
+
+	return &oas.BatchVoteOK{
+		Results: results,
+	}, nil
+}
@@ -30,22 +30,23 @@ func (c *codeRecorder) WriteHeader(status int) {
 	c.ResponseWriter.WriteHeader(status)
 }
 
-// handleApplyFeedbackToReportRequest handles applyFeedbackToReport operation.
+// handleAcceptOrganizationInvitationRequest handles acceptOrganizationInvitation operation.
 //
-// セッションのレポートにフィードバックを適用する.
+// 招待メールに記載されたトークンで招待を承諾し、組織に参加する。
+// 招待されたメールアドレスのアカウントでログインしている必要がある。.
 //
-// POST /report/feedback
-func (s *Server) handleApplyFeedbackToReportRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /organizations/invitations/accept
+func (s *Server) handleAcceptOrganizationInvitationRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("applyFeedbackToReport"),
+		otelogen.OperationID("acceptOrganizationInvitation"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/report/feedback"),
+		semconv.HTTPRouteKey.String("/organizations/invitations/accept"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ApplyFeedbackToReportOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), AcceptOrganizationInvitationOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -100,15 +101,15 @@ func (s *Server) handleApplyFeedbackToReportRequest(args [0]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ApplyFeedbackToReportOperation,
-			ID:   "applyFeedbackToReport",
+			Name: AcceptOrganizationInvitationOperation,
+			ID:   "acceptOrganizationInvitation",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, ApplyFeedbackToReportOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, AcceptOrganizationInvitationOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -124,11 +125,29 @@ func (s *Server) handleApplyFeedbackToReportRequest(args [0]string, argsEscaped
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, AcceptOrganizationInvitationOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -148,7 +167,7 @@ func (s *Server) handleApplyFeedbackToReportRequest(args [0]string, argsEscaped
 			return
 		}
 	}
-	request, close, err := s.decodeApplyFeedbackToReportRequest(r)
+	request, close, err := s.decodeAcceptOrganizationInvitationRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -164,22 +183,22 @@ func (s *Server) handleApplyFeedbackToReportRequest(args [0]string, argsEscaped
 		}
 	}()
 
-	var response ApplyFeedbackToReportRes
+	var response AcceptOrganizationInvitationRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ApplyFeedbackToReportOperation,
-			OperationSummary: "セッションのレポートにフィードバックを適用する",
-			OperationID:      "applyFeedbackToReport",
+			OperationName:    AcceptOrganizationInvitationOperation,
+			OperationSummary: "組織への招待の承諾",
+			OperationID:      "acceptOrganizationInvitation",
 			Body:             request,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = *ApplyFeedbackToReportReq
+			Request  = *AcceptOrganizationInvitationReq
 			Params   = struct{}
-			Response = ApplyFeedbackToReportRes
+			Response = AcceptOrganizationInvitationRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -190,12 +209,12 @@ func (s *Server) handleApplyFeedbackToReportRequest(args [0]string, argsEscaped
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ApplyFeedbackToReport(ctx, request)
+				response, err = s.h.AcceptOrganizationInvitation(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ApplyFeedbackToReport(ctx, request)
+		response, err = s.h.AcceptOrganizationInvitation(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -203,7 +222,7 @@ func (s *Server) handleApplyFeedbackToReportRequest(args [0]string, argsEscaped
 		return
 	}
 
-	if err := encodeApplyFeedbackToReportResponse(response, w, span); err != nil {
+	if err := encodeAcceptOrganizationInvitationResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -212,22 +231,22 @@ func (s *Server) handleApplyFeedbackToReportRequest(args [0]string, argsEscaped
 	}
 }
 
-// handleAuthAccountDetachRequest handles authAccountDetach operation.
+// handleAcceptOwnershipTransferRequest handles acceptOwnershipTransfer operation.
 //
-// そのアカウントには再度ログインできなくなります。ログインしたければ言ってね！.
+// 譲渡先のユーザーのみ承諾できる。承諾するとオーナーが変更される.
 //
-// DELETE /auth/dev/detach
-func (s *Server) handleAuthAccountDetachRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /ownership-transfers/{transferID}/accept
+func (s *Server) handleAcceptOwnershipTransferRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("authAccountDetach"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/auth/dev/detach"),
+		otelogen.OperationID("acceptOwnershipTransfer"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/ownership-transfers/{transferID}/accept"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), AuthAccountDetachOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), AcceptOwnershipTransferOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -282,15 +301,15 @@ func (s *Server) handleAuthAccountDetachRequest(args [0]string, argsEscaped bool
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: AuthAccountDetachOperation,
-			ID:   "authAccountDetach",
+			Name: AcceptOwnershipTransferOperation,
+			ID:   "acceptOwnershipTransfer",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, AuthAccountDetachOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, AcceptOwnershipTransferOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -306,11 +325,29 @@ func (s *Server) handleAuthAccountDetachRequest(args [0]string, argsEscaped bool
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, AcceptOwnershipTransferOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -330,23 +367,38 @@ func (s *Server) handleAuthAccountDetachRequest(args [0]string, argsEscaped bool
 			return
 		}
 	}
+	params, err := decodeAcceptOwnershipTransferParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
-	var response AuthAccountDetachRes
+	var response AcceptOwnershipTransferRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    AuthAccountDetachOperation,
-			OperationSummary: "**開発用** 現在のアカウントを切り離す",
-			OperationID:      "authAccountDetach",
+			OperationName:    AcceptOwnershipTransferOperation,
+			OperationSummary: "オーナー権限の譲渡を承諾",
+			OperationID:      "acceptOwnershipTransfer",
 			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "transferID",
+					In:   "path",
+				}: params.TransferID,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = AuthAccountDetachRes
+			Params   = AcceptOwnershipTransferParams
+			Response = AcceptOwnershipTransferRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -355,14 +407,14 @@ func (s *Server) handleAuthAccountDetachRequest(args [0]string, argsEscaped bool
 		](
 			m,
 			mreq,
-			nil,
+			unpackAcceptOwnershipTransferParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.AuthAccountDetach(ctx)
+				response, err = s.h.AcceptOwnershipTransfer(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.AuthAccountDetach(ctx)
+		response, err = s.h.AcceptOwnershipTransfer(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -370,7 +422,7 @@ func (s *Server) handleAuthAccountDetachRequest(args [0]string, argsEscaped bool
 		return
 	}
 
-	if err := encodeAuthAccountDetachResponse(response, w, span); err != nil {
+	if err := encodeAcceptOwnershipTransferResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -379,22 +431,22 @@ func (s *Server) handleAuthAccountDetachRequest(args [0]string, argsEscaped bool
 	}
 }
 
-// handleAuthorizeRequest handles authorize operation.
+// handleApplyFeedbackToReportRequest handles applyFeedbackToReport operation.
 //
-// ログイン.
+// セッションのレポートにフィードバックを適用する.
 //
-// GET /auth/{provider}/login
-func (s *Server) handleAuthorizeRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /report/feedback
+func (s *Server) handleApplyFeedbackToReportRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("authorize"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/auth/{provider}/login"),
+		otelogen.OperationID("applyFeedbackToReport"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/report/feedback"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), AuthorizeOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ApplyFeedbackToReportOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -449,54 +501,104 @@ func (s *Server) handleAuthorizeRequest(args [1]string, argsEscaped bool, w http
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: AuthorizeOperation,
-			ID:   "authorize",
+			Name: ApplyFeedbackToReportOperation,
+			ID:   "applyFeedbackToReport",
 		}
 	)
-	params, err := decodeAuthorizeParams(args, argsEscaped, r)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, ApplyFeedbackToReportOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, ApplyFeedbackToReportOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	request, close, err := s.decodeApplyFeedbackToReportRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response AuthorizeRes
+	var response ApplyFeedbackToReportRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    AuthorizeOperation,
-			OperationSummary: "ログイン",
-			OperationID:      "authorize",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "provider",
-					In:   "path",
-				}: params.Provider,
-				{
-					Name: "redirect_url",
-					In:   "query",
-				}: params.RedirectURL,
-				{
-					Name: "organization_code",
-					In:   "query",
-				}: params.OrganizationCode,
-				{
-					Name: "registration_url",
-					In:   "query",
-				}: params.RegistrationURL,
-			},
-			Raw: r,
+			OperationName:    ApplyFeedbackToReportOperation,
+			OperationSummary: "セッションのレポートにフィードバックを適用する",
+			OperationID:      "applyFeedbackToReport",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = AuthorizeParams
-			Response = AuthorizeRes
+			Request  = *ApplyFeedbackToReportReq
+			Params   = struct{}
+			Response = ApplyFeedbackToReportRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -505,14 +607,14 @@ func (s *Server) handleAuthorizeRequest(args [1]string, argsEscaped bool, w http
 		](
 			m,
 			mreq,
-			unpackAuthorizeParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.Authorize(ctx, params)
+				response, err = s.h.ApplyFeedbackToReport(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.Authorize(ctx, params)
+		response, err = s.h.ApplyFeedbackToReport(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -520,7 +622,7 @@ func (s *Server) handleAuthorizeRequest(args [1]string, argsEscaped bool, w http
 		return
 	}
 
-	if err := encodeAuthorizeResponse(response, w, span); err != nil {
+	if err := encodeApplyFeedbackToReportResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -529,22 +631,22 @@ func (s *Server) handleAuthorizeRequest(args [1]string, argsEscaped bool, w http
 	}
 }
 
-// handleChangePasswordRequest handles changePassword operation.
+// handleAuthAccountDetachRequest handles authAccountDetach operation.
 //
-// パスワード変更.
+// そのアカウントには再度ログインできなくなります。ログインしたければ言ってね！.
 //
-// PUT /auth/password/change
-func (s *Server) handleChangePasswordRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /auth/dev/detach
+func (s *Server) handleAuthAccountDetachRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("changePassword"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/auth/password/change"),
+		otelogen.OperationID("authAccountDetach"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/auth/dev/detach"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ChangePasswordOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), AuthAccountDetachOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -599,15 +701,15 @@ func (s *Server) handleChangePasswordRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ChangePasswordOperation,
-			ID:   "changePassword",
+			Name: AuthAccountDetachOperation,
+			ID:   "authAccountDetach",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, ChangePasswordOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, AuthAccountDetachOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -623,11 +725,29 @@ func (s *Server) handleChangePasswordRequest(args [0]string, argsEscaped bool, w
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, AuthAccountDetachOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -647,42 +767,23 @@ func (s *Server) handleChangePasswordRequest(args [0]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeChangePasswordParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
-	var response ChangePasswordRes
+	var response AuthAccountDetachRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ChangePasswordOperation,
-			OperationSummary: "パスワード変更",
-			OperationID:      "changePassword",
+			OperationName:    AuthAccountDetachOperation,
+			OperationSummary: "**開発用** 現在のアカウントを切り離す",
+			OperationID:      "authAccountDetach",
 			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "oldPassword",
-					In:   "query",
-				}: params.OldPassword,
-				{
-					Name: "newPassword",
-					In:   "query",
-				}: params.NewPassword,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = ChangePasswordParams
-			Response = ChangePasswordRes
+			Params   = struct{}
+			Response = AuthAccountDetachRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -691,14 +792,14 @@ func (s *Server) handleChangePasswordRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackChangePasswordParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ChangePassword(ctx, params)
+				response, err = s.h.AuthAccountDetach(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ChangePassword(ctx, params)
+		response, err = s.h.AuthAccountDetach(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -706,7 +807,7 @@ func (s *Server) handleChangePasswordRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeChangePasswordResponse(response, w, span); err != nil {
+	if err := encodeAuthAccountDetachResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -715,22 +816,22 @@ func (s *Server) handleChangePasswordRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleCheckDeviceExistsRequest handles checkDeviceExists operation.
+// handleAuthorizeRequest handles authorize operation.
 //
-// デバイストークンが登録されているか確認.
+// ログイン.
 //
-// GET /notifications/devices/exists
-func (s *Server) handleCheckDeviceExistsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /auth/{provider}/login
+func (s *Server) handleAuthorizeRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("checkDeviceExists"),
+		otelogen.OperationID("authorize"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/notifications/devices/exists"),
+		semconv.HTTPRouteKey.String("/auth/{provider}/login"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), CheckDeviceExistsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), AuthorizeOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -785,55 +886,11 @@ func (s *Server) handleCheckDeviceExistsRequest(args [0]string, argsEscaped bool
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CheckDeviceExistsOperation,
-			ID:   "checkDeviceExists",
+			Name: AuthorizeOperation,
+			ID:   "authorize",
 		}
 	)
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			sctx, ok, err := s.securityCookieAuth(ctx, CheckDeviceExistsOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "CookieAuth",
-					Err:              err,
-				}
-				defer recordError("Security:CookieAuth", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 0
-				ctx = sctx
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			err = &ogenerrors.SecurityError{
-				OperationContext: opErrContext,
-				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
-			}
-			defer recordError("Security", err)
-			s.cfg.ErrorHandler(ctx, w, r, err)
-			return
-		}
-	}
-	params, err := decodeCheckDeviceExistsParams(args, argsEscaped, r)
+	params, err := decodeAuthorizeParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -844,27 +901,39 @@ func (s *Server) handleCheckDeviceExistsRequest(args [0]string, argsEscaped bool
 		return
 	}
 
-	var response CheckDeviceExistsRes
+	var response AuthorizeRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CheckDeviceExistsOperation,
-			OperationSummary: "デバイストークンが登録されているか確認",
-			OperationID:      "checkDeviceExists",
+			OperationName:    AuthorizeOperation,
+			OperationSummary: "ログイン",
+			OperationID:      "authorize",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "device_token",
+					Name: "provider",
+					In:   "path",
+				}: params.Provider,
+				{
+					Name: "redirect_url",
 					In:   "query",
-				}: params.DeviceToken,
+				}: params.RedirectURL,
+				{
+					Name: "organization_code",
+					In:   "query",
+				}: params.OrganizationCode,
+				{
+					Name: "registration_url",
+					In:   "query",
+				}: params.RegistrationURL,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = CheckDeviceExistsParams
-			Response = CheckDeviceExistsRes
+			Params   = AuthorizeParams
+			Response = AuthorizeRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -873,14 +942,14 @@ func (s *Server) handleCheckDeviceExistsRequest(args [0]string, argsEscaped bool
 		](
 			m,
 			mreq,
-			unpackCheckDeviceExistsParams,
+			unpackAuthorizeParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CheckDeviceExists(ctx, params)
+				response, err = s.h.Authorize(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CheckDeviceExists(ctx, params)
+		response, err = s.h.Authorize(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -888,7 +957,7 @@ func (s *Server) handleCheckDeviceExistsRequest(args [0]string, argsEscaped bool
 		return
 	}
 
-	if err := encodeCheckDeviceExistsResponse(response, w, span); err != nil {
+	if err := encodeAuthorizeResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -897,22 +966,23 @@ func (s *Server) handleCheckDeviceExistsRequest(args [0]string, argsEscaped bool
 	}
 }
 
-// handleConsentTalkSessionRequest handles consentTalkSession operation.
+// handleBatchVoteRequest handles batchVote operation.
 //
-// セッションへの同意.
+// オフラインの端末でまとめた投票を1つのトランザクションで反映する。投票は端末で投票した順に反映する。
+// 同じ冪等キーで再送された投票は反映せず、処理済みの結果を返す。投票できなかった投票は投票ごとの結果で返し、他の投票は反映する.
 //
-// POST /talksessions/{talkSessionID}/consent
-func (s *Server) handleConsentTalkSessionRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /talksessions/{talkSessionID}/votes:batch
+func (s *Server) handleBatchVoteRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("consentTalkSession"),
+		otelogen.OperationID("batchVote"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/consent"),
+		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/votes:batch"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ConsentTalkSessionOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), BatchVoteOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -967,15 +1037,15 @@ func (s *Server) handleConsentTalkSessionRequest(args [1]string, argsEscaped boo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ConsentTalkSessionOperation,
-			ID:   "consentTalkSession",
+			Name: BatchVoteOperation,
+			ID:   "batchVote",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, ConsentTalkSessionOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, BatchVoteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -991,11 +1061,29 @@ func (s *Server) handleConsentTalkSessionRequest(args [1]string, argsEscaped boo
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, BatchVoteOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -1015,7 +1103,7 @@ func (s *Server) handleConsentTalkSessionRequest(args [1]string, argsEscaped boo
 			return
 		}
 	}
-	params, err := decodeConsentTalkSessionParams(args, argsEscaped, r)
+	params, err := decodeBatchVoteParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1025,15 +1113,30 @@ func (s *Server) handleConsentTalkSessionRequest(args [1]string, argsEscaped boo
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeBatchVoteRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response ConsentTalkSessionRes
+	var response BatchVoteRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ConsentTalkSessionOperation,
-			OperationSummary: "セッションへの同意",
-			OperationID:      "consentTalkSession",
-			Body:             nil,
+			OperationName:    BatchVoteOperation,
+			OperationSummary: "一括投票",
+			OperationID:      "batchVote",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
 					Name: "talkSessionID",
@@ -1044,9 +1147,9 @@ func (s *Server) handleConsentTalkSessionRequest(args [1]string, argsEscaped boo
 		}
 
 		type (
-			Request  = struct{}
-			Params   = ConsentTalkSessionParams
-			Response = ConsentTalkSessionRes
+			Request  = *BatchVoteReq
+			Params   = BatchVoteParams
+			Response = BatchVoteRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1055,14 +1158,14 @@ func (s *Server) handleConsentTalkSessionRequest(args [1]string, argsEscaped boo
 		](
 			m,
 			mreq,
-			unpackConsentTalkSessionParams,
+			unpackBatchVoteParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ConsentTalkSession(ctx, params)
+				response, err = s.h.BatchVote(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ConsentTalkSession(ctx, params)
+		response, err = s.h.BatchVote(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1070,7 +1173,7 @@ func (s *Server) handleConsentTalkSessionRequest(args [1]string, argsEscaped boo
 		return
 	}
 
-	if err := encodeConsentTalkSessionResponse(response, w, span); err != nil {
+	if err := encodeBatchVoteResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1079,22 +1182,25 @@ func (s *Server) handleConsentTalkSessionRequest(args [1]string, argsEscaped boo
 	}
 }
 
-// handleCreateOrganizationAliasRequest handles createOrganizationAlias operation.
+// handleBulkCreateOrganizationInvitationsRequest handles bulkCreateOrganizationInvitations operation.
 //
-// 組織エイリアス作成.
+// CSVファイルで一括招待する。
+// 1列目にメールアドレス、2列目にロール（数値またはロール名。省略時はメンバー）を指定する。
+// 1行目が「email」または「メールアドレス」の場合はヘッダーとして読み飛ばす。
+// 最大500行まで。失敗した行があっても残りの行は処理され、行ごとの結果を返す。.
 //
-// POST /organizations/aliases
-func (s *Server) handleCreateOrganizationAliasRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /organizations/invitations/bulk
+func (s *Server) handleBulkCreateOrganizationInvitationsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("createOrganizationAlias"),
+		otelogen.OperationID("bulkCreateOrganizationInvitations"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/organizations/aliases"),
+		semconv.HTTPRouteKey.String("/organizations/invitations/bulk"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateOrganizationAliasOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), BulkCreateOrganizationInvitationsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1149,15 +1255,15 @@ func (s *Server) handleCreateOrganizationAliasRequest(args [0]string, argsEscape
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CreateOrganizationAliasOperation,
-			ID:   "createOrganizationAlias",
+			Name: BulkCreateOrganizationInvitationsOperation,
+			ID:   "bulkCreateOrganizationInvitations",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, CreateOrganizationAliasOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, BulkCreateOrganizationInvitationsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1173,11 +1279,29 @@ func (s *Server) handleCreateOrganizationAliasRequest(args [0]string, argsEscape
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, BulkCreateOrganizationInvitationsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -1197,7 +1321,7 @@ func (s *Server) handleCreateOrganizationAliasRequest(args [0]string, argsEscape
 			return
 		}
 	}
-	request, close, err := s.decodeCreateOrganizationAliasRequest(r)
+	request, close, err := s.decodeBulkCreateOrganizationInvitationsRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -1213,22 +1337,22 @@ func (s *Server) handleCreateOrganizationAliasRequest(args [0]string, argsEscape
 		}
 	}()
 
-	var response CreateOrganizationAliasRes
+	var response BulkCreateOrganizationInvitationsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CreateOrganizationAliasOperation,
-			OperationSummary: "組織エイリアス作成",
-			OperationID:      "createOrganizationAlias",
+			OperationName:    BulkCreateOrganizationInvitationsOperation,
+			OperationSummary: "CSVによる組織への一括招待",
+			OperationID:      "bulkCreateOrganizationInvitations",
 			Body:             request,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = *CreateOrganizationAliasReq
+			Request  = *BulkCreateOrganizationInvitationsReq
 			Params   = struct{}
-			Response = CreateOrganizationAliasRes
+			Response = BulkCreateOrganizationInvitationsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1239,12 +1363,12 @@ func (s *Server) handleCreateOrganizationAliasRequest(args [0]string, argsEscape
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CreateOrganizationAlias(ctx, request)
+				response, err = s.h.BulkCreateOrganizationInvitations(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CreateOrganizationAlias(ctx, request)
+		response, err = s.h.BulkCreateOrganizationInvitations(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1252,7 +1376,7 @@ func (s *Server) handleCreateOrganizationAliasRequest(args [0]string, argsEscape
 		return
 	}
 
-	if err := encodeCreateOrganizationAliasResponse(response, w, span); err != nil {
+	if err := encodeBulkCreateOrganizationInvitationsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1261,22 +1385,22 @@ func (s *Server) handleCreateOrganizationAliasRequest(args [0]string, argsEscape
 	}
 }
 
-// handleDeleteDeviceRequest handles deleteDevice operation.
+// handleBulkTransferTalkSessionsRequest handles bulkTransferTalkSessions operation.
 //
-// デバイス削除.
+// 指定したメンバーが組織内でオーナーのセッションを、まとめて別のメンバーに譲渡依頼する。組織のオーナー以上のみ実行できる。未承諾の譲渡依頼があるセッションはスキップする.
 //
-// DELETE /notifications/devices/{deviceId}
-func (s *Server) handleDeleteDeviceRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /organizations/{code}/ownership-transfers/bulk
+func (s *Server) handleBulkTransferTalkSessionsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteDevice"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/notifications/devices/{deviceId}"),
+		otelogen.OperationID("bulkTransferTalkSessions"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/organizations/{code}/ownership-transfers/bulk"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteDeviceOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), BulkTransferTalkSessionsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1331,15 +1455,15 @@ func (s *Server) handleDeleteDeviceRequest(args [1]string, argsEscaped bool, w h
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeleteDeviceOperation,
-			ID:   "deleteDevice",
+			Name: BulkTransferTalkSessionsOperation,
+			ID:   "bulkTransferTalkSessions",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, DeleteDeviceOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, BulkTransferTalkSessionsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1355,11 +1479,29 @@ func (s *Server) handleDeleteDeviceRequest(args [1]string, argsEscaped bool, w h
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, BulkTransferTalkSessionsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -1379,7 +1521,7 @@ func (s *Server) handleDeleteDeviceRequest(args [1]string, argsEscaped bool, w h
 			return
 		}
 	}
-	params, err := decodeDeleteDeviceParams(args, argsEscaped, r)
+	params, err := decodeBulkTransferTalkSessionsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1389,28 +1531,43 @@ func (s *Server) handleDeleteDeviceRequest(args [1]string, argsEscaped bool, w h
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-
-	var response DeleteDeviceRes
-	if m := s.cfg.Middleware; m != nil {
-		mreq := middleware.Request{
-			Context:          ctx,
-			OperationName:    DeleteDeviceOperation,
-			OperationSummary: "デバイス削除",
-			OperationID:      "deleteDevice",
-			Body:             nil,
+	request, close, err := s.decodeBulkTransferTalkSessionsRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response BulkTransferTalkSessionsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    BulkTransferTalkSessionsOperation,
+			OperationSummary: "メンバーのセッションをまとめて譲渡依頼",
+			OperationID:      "bulkTransferTalkSessions",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
-					Name: "deviceId",
+					Name: "code",
 					In:   "path",
-				}: params.DeviceId,
+				}: params.Code,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = DeleteDeviceParams
-			Response = DeleteDeviceRes
+			Request  = *BulkTransferTalkSessionsReq
+			Params   = BulkTransferTalkSessionsParams
+			Response = BulkTransferTalkSessionsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1419,14 +1576,14 @@ func (s *Server) handleDeleteDeviceRequest(args [1]string, argsEscaped bool, w h
 		](
 			m,
 			mreq,
-			unpackDeleteDeviceParams,
+			unpackBulkTransferTalkSessionsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DeleteDevice(ctx, params)
+				response, err = s.h.BulkTransferTalkSessions(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DeleteDevice(ctx, params)
+		response, err = s.h.BulkTransferTalkSessions(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1434,7 +1591,7 @@ func (s *Server) handleDeleteDeviceRequest(args [1]string, argsEscaped bool, w h
 		return
 	}
 
-	if err := encodeDeleteDeviceResponse(response, w, span); err != nil {
+	if err := encodeBulkTransferTalkSessionsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1443,22 +1600,22 @@ func (s *Server) handleDeleteDeviceRequest(args [1]string, argsEscaped bool, w h
 	}
 }
 
-// handleDeleteOrganizationAliasRequest handles deleteOrganizationAlias operation.
+// handleCancelOwnershipTransferRequest handles cancelOwnershipTransfer operation.
 //
-// 組織エイリアス削除.
+// 依頼者か譲渡元のユーザーのみ取り消せる.
 //
-// DELETE /organizations/aliases/{aliasID}
-func (s *Server) handleDeleteOrganizationAliasRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /ownership-transfers/{transferID}/cancel
+func (s *Server) handleCancelOwnershipTransferRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("deleteOrganizationAlias"),
-		semconv.HTTPRequestMethodKey.String("DELETE"),
-		semconv.HTTPRouteKey.String("/organizations/aliases/{aliasID}"),
+		otelogen.OperationID("cancelOwnershipTransfer"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/ownership-transfers/{transferID}/cancel"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteOrganizationAliasOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CancelOwnershipTransferOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1513,15 +1670,15 @@ func (s *Server) handleDeleteOrganizationAliasRequest(args [1]string, argsEscape
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeleteOrganizationAliasOperation,
-			ID:   "deleteOrganizationAlias",
+			Name: CancelOwnershipTransferOperation,
+			ID:   "cancelOwnershipTransfer",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, DeleteOrganizationAliasOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, CancelOwnershipTransferOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1537,11 +1694,29 @@ func (s *Server) handleDeleteOrganizationAliasRequest(args [1]string, argsEscape
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, CancelOwnershipTransferOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -1561,7 +1736,7 @@ func (s *Server) handleDeleteOrganizationAliasRequest(args [1]string, argsEscape
 			return
 		}
 	}
-	params, err := decodeDeleteOrganizationAliasParams(args, argsEscaped, r)
+	params, err := decodeCancelOwnershipTransferParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1572,27 +1747,27 @@ func (s *Server) handleDeleteOrganizationAliasRequest(args [1]string, argsEscape
 		return
 	}
 
-	var response DeleteOrganizationAliasRes
+	var response CancelOwnershipTransferRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeleteOrganizationAliasOperation,
-			OperationSummary: "組織エイリアス削除",
-			OperationID:      "deleteOrganizationAlias",
+			OperationName:    CancelOwnershipTransferOperation,
+			OperationSummary: "オーナー権限の譲渡依頼を取り消し",
+			OperationID:      "cancelOwnershipTransfer",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "aliasID",
+					Name: "transferID",
 					In:   "path",
-				}: params.AliasID,
+				}: params.TransferID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = DeleteOrganizationAliasParams
-			Response = DeleteOrganizationAliasRes
+			Params   = CancelOwnershipTransferParams
+			Response = CancelOwnershipTransferRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1601,14 +1776,14 @@ func (s *Server) handleDeleteOrganizationAliasRequest(args [1]string, argsEscape
 		](
 			m,
 			mreq,
-			unpackDeleteOrganizationAliasParams,
+			unpackCancelOwnershipTransferParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DeleteOrganizationAlias(ctx, params)
+				response, err = s.h.CancelOwnershipTransfer(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DeleteOrganizationAlias(ctx, params)
+		response, err = s.h.CancelOwnershipTransfer(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1616,7 +1791,7 @@ func (s *Server) handleDeleteOrganizationAliasRequest(args [1]string, argsEscape
 		return
 	}
 
-	if err := encodeDeleteOrganizationAliasResponse(response, w, span); err != nil {
+	if err := encodeCancelOwnershipTransferResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1625,22 +1800,30 @@ func (s *Server) handleDeleteOrganizationAliasRequest(args [1]string, argsEscape
 	}
 }
 
-// handleDevAuthorizeRequest handles devAuthorize operation.
+// handleChangeOrganizationUserRoleRequest handles changeOrganizationUserRole operation.
 //
-// 開発用登録/ログイン.
+// 組織ユーザーのロールを変更する。
+// 組織のAdmin以上のユーザーが実行可能で、自分と同じかそれ以下のロールのユーザーを、自分と同じかそれ以下のロールにのみ変更できる。
+// 最後のオーナーは降格できない。
+// 変更されたユーザーは組織アカウントから一度ログアウトされる。
+// Role
+// - 10: SuperAdmin
+// - 20: Owner
+// - 30: Admin
+// - 40: Member.
 //
-// GET /auth/dev/login
-func (s *Server) handleDevAuthorizeRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /organizations/users/{userID}/role
+func (s *Server) handleChangeOrganizationUserRoleRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("devAuthorize"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/auth/dev/login"),
+		otelogen.OperationID("changeOrganizationUserRole"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/organizations/users/{userID}/role"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DevAuthorizeOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ChangeOrganizationUserRoleOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1695,11 +1878,73 @@ func (s *Server) handleDevAuthorizeRequest(args [0]string, argsEscaped bool, w h
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DevAuthorizeOperation,
-			ID:   "devAuthorize",
+			Name: ChangeOrganizationUserRoleOperation,
+			ID:   "changeOrganizationUserRole",
 		}
 	)
-	params, err := decodeDevAuthorizeParams(args, argsEscaped, r)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, ChangeOrganizationUserRoleOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, ChangeOrganizationUserRoleOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeChangeOrganizationUserRoleParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1709,36 +1954,43 @@ func (s *Server) handleDevAuthorizeRequest(args [0]string, argsEscaped bool, w h
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeChangeOrganizationUserRoleRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response DevAuthorizeRes
+	var response ChangeOrganizationUserRoleRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DevAuthorizeOperation,
-			OperationSummary: "開発用登録/ログイン",
-			OperationID:      "devAuthorize",
-			Body:             nil,
+			OperationName:    ChangeOrganizationUserRoleOperation,
+			OperationSummary: "組織ユーザーのロール変更",
+			OperationID:      "changeOrganizationUserRole",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
-					Name: "redirect_url",
-					In:   "query",
-				}: params.RedirectURL,
-				{
-					Name: "id",
-					In:   "query",
-				}: params.ID,
-				{
-					Name: "organizationCode",
-					In:   "query",
-				}: params.OrganizationCode,
+					Name: "userID",
+					In:   "path",
+				}: params.UserID,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = DevAuthorizeParams
-			Response = DevAuthorizeRes
+			Request  = *ChangeOrganizationUserRoleReq
+			Params   = ChangeOrganizationUserRoleParams
+			Response = ChangeOrganizationUserRoleRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1747,14 +1999,14 @@ func (s *Server) handleDevAuthorizeRequest(args [0]string, argsEscaped bool, w h
 		](
 			m,
 			mreq,
-			unpackDevAuthorizeParams,
+			unpackChangeOrganizationUserRoleParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DevAuthorize(ctx, params)
+				response, err = s.h.ChangeOrganizationUserRole(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DevAuthorize(ctx, params)
+		response, err = s.h.ChangeOrganizationUserRole(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1762,7 +2014,7 @@ func (s *Server) handleDevAuthorizeRequest(args [0]string, argsEscaped bool, w h
 		return
 	}
 
-	if err := encodeDevAuthorizeResponse(response, w, span); err != nil {
+	if err := encodeChangeOrganizationUserRoleResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1771,22 +2023,22 @@ func (s *Server) handleDevAuthorizeRequest(args [0]string, argsEscaped bool, w h
 	}
 }
 
-// handleDummyInitRequest handles dummyInit operation.
+// handleChangePasswordRequest handles changePassword operation.
 //
-// Init dummy.
+// パスワード変更.
 //
-// POST /test/dummy
-func (s *Server) handleDummyInitRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /auth/password/change
+func (s *Server) handleChangePasswordRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("dummyInit"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/test/dummy"),
+		otelogen.OperationID("changePassword"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/auth/password/change"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), DummyInitOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ChangePasswordOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1841,42 +2093,108 @@ func (s *Server) handleDummyInitRequest(args [0]string, argsEscaped bool, w http
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DummyInitOperation,
-			ID:   "dummyInit",
+			Name: ChangePasswordOperation,
+			ID:   "changePassword",
 		}
 	)
-	request, close, err := s.decodeDummyInitRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, ChangePasswordOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
 		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, ChangePasswordOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
 		}
-	}()
 
-	var response DummyInitRes
-	if m := s.cfg.Middleware; m != nil {
-		mreq := middleware.Request{
-			Context:          ctx,
-			OperationName:    DummyInitOperation,
-			OperationSummary: "init dummy",
-			OperationID:      "dummyInit",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeChangePasswordParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response ChangePasswordRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ChangePasswordOperation,
+			OperationSummary: "パスワード変更",
+			OperationID:      "changePassword",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "oldPassword",
+					In:   "query",
+				}: params.OldPassword,
+				{
+					Name: "newPassword",
+					In:   "query",
+				}: params.NewPassword,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *DummyInitReq
-			Params   = struct{}
-			Response = DummyInitRes
+			Request  = struct{}
+			Params   = ChangePasswordParams
+			Response = ChangePasswordRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1885,14 +2203,14 @@ func (s *Server) handleDummyInitRequest(args [0]string, argsEscaped bool, w http
 		](
 			m,
 			mreq,
-			nil,
+			unpackChangePasswordParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DummyInit(ctx, request)
+				response, err = s.h.ChangePassword(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DummyInit(ctx, request)
+		response, err = s.h.ChangePassword(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1900,7 +2218,7 @@ func (s *Server) handleDummyInitRequest(args [0]string, argsEscaped bool, w http
 		return
 	}
 
-	if err := encodeDummyInitResponse(response, w, span); err != nil {
+	if err := encodeChangePasswordResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1909,22 +2227,22 @@ func (s *Server) handleDummyInitRequest(args [0]string, argsEscaped bool, w http
 	}
 }
 
-// handleEditTalkSessionRequest handles editTalkSession operation.
+// handleCheckDeviceExistsRequest handles checkDeviceExists operation.
 //
-// セッション編集.
+// デバイストークンが登録されているか確認.
 //
-// PUT /talksessions/{talkSessionID}
-func (s *Server) handleEditTalkSessionRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /notifications/devices/exists
+func (s *Server) handleCheckDeviceExistsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("editTalkSession"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}"),
+		otelogen.OperationID("checkDeviceExists"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/notifications/devices/exists"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), EditTalkSessionOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CheckDeviceExistsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -1979,15 +2297,15 @@ func (s *Server) handleEditTalkSessionRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: EditTalkSessionOperation,
-			ID:   "editTalkSession",
+			Name: CheckDeviceExistsOperation,
+			ID:   "checkDeviceExists",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, EditTalkSessionOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, CheckDeviceExistsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2003,11 +2321,29 @@ func (s *Server) handleEditTalkSessionRequest(args [1]string, argsEscaped bool,
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, CheckDeviceExistsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -2027,7 +2363,7 @@ func (s *Server) handleEditTalkSessionRequest(args [1]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeEditTalkSessionParams(args, argsEscaped, r)
+	params, err := decodeCheckDeviceExistsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2037,43 +2373,28 @@ func (s *Server) handleEditTalkSessionRequest(args [1]string, argsEscaped bool,
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeEditTalkSessionRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response EditTalkSessionRes
+	var response CheckDeviceExistsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    EditTalkSessionOperation,
-			OperationSummary: "セッション編集",
-			OperationID:      "editTalkSession",
-			Body:             request,
+			OperationName:    CheckDeviceExistsOperation,
+			OperationSummary: "デバイストークンが登録されているか確認",
+			OperationID:      "checkDeviceExists",
+			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "talkSessionID",
-					In:   "path",
-				}: params.TalkSessionID,
+					Name: "device_token",
+					In:   "query",
+				}: params.DeviceToken,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *EditTalkSessionReq
-			Params   = EditTalkSessionParams
-			Response = EditTalkSessionRes
+			Request  = struct{}
+			Params   = CheckDeviceExistsParams
+			Response = CheckDeviceExistsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2082,14 +2403,14 @@ func (s *Server) handleEditTalkSessionRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackEditTalkSessionParams,
+			unpackCheckDeviceExistsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.EditTalkSession(ctx, request, params)
+				response, err = s.h.CheckDeviceExists(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.EditTalkSession(ctx, request, params)
+		response, err = s.h.CheckDeviceExists(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -2097,7 +2418,7 @@ func (s *Server) handleEditTalkSessionRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeEditTalkSessionResponse(response, w, span); err != nil {
+	if err := encodeCheckDeviceExistsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2106,22 +2427,23 @@ func (s *Server) handleEditTalkSessionRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleEditTimeLineRequest handles editTimeLine operation.
+// handleCloneTalkSessionRequest handles cloneTalkSession operation.
 //
-// タイムライン編集.
+// セッションを複製して下書きを作成する。参加制限・シード意見・サムネイルは引き継ぎ、投票や参加者の情報は引き継がない。
+// 下書きはpublishするまでオーナー以外参加できない。.
 //
-// PUT /talksessions/{talkSessionID}/timelines/{actionItemID}
-func (s *Server) handleEditTimeLineRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /talksessions/{talkSessionID}/clone
+func (s *Server) handleCloneTalkSessionRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("editTimeLine"),
-		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/timelines/{actionItemID}"),
+		otelogen.OperationID("cloneTalkSession"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/clone"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), EditTimeLineOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CloneTalkSessionOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2176,15 +2498,15 @@ func (s *Server) handleEditTimeLineRequest(args [2]string, argsEscaped bool, w h
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: EditTimeLineOperation,
-			ID:   "editTimeLine",
+			Name: CloneTalkSessionOperation,
+			ID:   "cloneTalkSession",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, EditTimeLineOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, CloneTalkSessionOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2200,11 +2522,29 @@ func (s *Server) handleEditTimeLineRequest(args [2]string, argsEscaped bool, w h
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, CloneTalkSessionOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -2224,7 +2564,7 @@ func (s *Server) handleEditTimeLineRequest(args [2]string, argsEscaped bool, w h
 			return
 		}
 	}
-	params, err := decodeEditTimeLineParams(args, argsEscaped, r)
+	params, err := decodeCloneTalkSessionParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2234,7 +2574,7 @@ func (s *Server) handleEditTimeLineRequest(args [2]string, argsEscaped bool, w h
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeEditTimeLineRequest(r)
+	request, close, err := s.decodeCloneTalkSessionRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -2250,31 +2590,27 @@ func (s *Server) handleEditTimeLineRequest(args [2]string, argsEscaped bool, w h
 		}
 	}()
 
-	var response EditTimeLineRes
+	var response CloneTalkSessionRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    EditTimeLineOperation,
-			OperationSummary: "タイムライン編集",
-			OperationID:      "editTimeLine",
+			OperationName:    CloneTalkSessionOperation,
+			OperationSummary: "セッションを複製",
+			OperationID:      "cloneTalkSession",
 			Body:             request,
 			Params: middleware.Parameters{
 				{
 					Name: "talkSessionID",
 					In:   "path",
 				}: params.TalkSessionID,
-				{
-					Name: "actionItemID",
-					In:   "path",
-				}: params.ActionItemID,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *EditTimeLineReq
-			Params   = EditTimeLineParams
-			Response = EditTimeLineRes
+			Request  = *CloneTalkSessionReq
+			Params   = CloneTalkSessionParams
+			Response = CloneTalkSessionRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2283,14 +2619,14 @@ func (s *Server) handleEditTimeLineRequest(args [2]string, argsEscaped bool, w h
 		](
 			m,
 			mreq,
-			unpackEditTimeLineParams,
+			unpackCloneTalkSessionParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.EditTimeLine(ctx, request, params)
+				response, err = s.h.CloneTalkSession(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.EditTimeLine(ctx, request, params)
+		response, err = s.h.CloneTalkSession(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -2298,7 +2634,7 @@ func (s *Server) handleEditTimeLineRequest(args [2]string, argsEscaped bool, w h
 		return
 	}
 
-	if err := encodeEditTimeLineResponse(response, w, span); err != nil {
+	if err := encodeCloneTalkSessionResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2307,23 +2643,22 @@ func (s *Server) handleEditTimeLineRequest(args [2]string, argsEscaped bool, w h
 	}
 }
 
-// handleEstablishOrganizationRequest handles establishOrganization operation.
+// handleCloneTalkSessionTemplateRequest handles cloneTalkSessionTemplate operation.
 //
-// 組織を作成できる。
-// これを作れるユーザーはDBを直接叩いて作るしかない。.
+// テンプレートから下書きのセッションを作成する。組織のメンバーであれば誰でも使える.
 //
-// POST /organizations
-func (s *Server) handleEstablishOrganizationRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /organizations/{code}/talksession-templates/{templateID}/clone
+func (s *Server) handleCloneTalkSessionTemplateRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("establishOrganization"),
+		otelogen.OperationID("cloneTalkSessionTemplate"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/organizations"),
+		semconv.HTTPRouteKey.String("/organizations/{code}/talksession-templates/{templateID}/clone"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), EstablishOrganizationOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CloneTalkSessionTemplateOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2378,15 +2713,15 @@ func (s *Server) handleEstablishOrganizationRequest(args [0]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: EstablishOrganizationOperation,
-			ID:   "establishOrganization",
+			Name: CloneTalkSessionTemplateOperation,
+			ID:   "cloneTalkSessionTemplate",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, EstablishOrganizationOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, CloneTalkSessionTemplateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2402,11 +2737,29 @@ func (s *Server) handleEstablishOrganizationRequest(args [0]string, argsEscaped
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, CloneTalkSessionTemplateOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -2426,7 +2779,17 @@ func (s *Server) handleEstablishOrganizationRequest(args [0]string, argsEscaped
 			return
 		}
 	}
-	request, close, err := s.decodeEstablishOrganizationRequest(r)
+	params, err := decodeCloneTalkSessionTemplateParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeCloneTalkSessionTemplateRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -2442,22 +2805,31 @@ func (s *Server) handleEstablishOrganizationRequest(args [0]string, argsEscaped
 		}
 	}()
 
-	var response EstablishOrganizationRes
+	var response CloneTalkSessionTemplateRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    EstablishOrganizationOperation,
-			OperationSummary: "組織作成（運営ユーザーのみ）",
-			OperationID:      "establishOrganization",
+			OperationName:    CloneTalkSessionTemplateOperation,
+			OperationSummary: "テンプレートからセッションを作成",
+			OperationID:      "cloneTalkSessionTemplate",
 			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "code",
+					In:   "path",
+				}: params.Code,
+				{
+					Name: "templateID",
+					In:   "path",
+				}: params.TemplateID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *EstablishOrganizationReq
-			Params   = struct{}
-			Response = EstablishOrganizationRes
+			Request  = *CloneTalkSessionTemplateReq
+			Params   = CloneTalkSessionTemplateParams
+			Response = CloneTalkSessionTemplateRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2466,14 +2838,14 @@ func (s *Server) handleEstablishOrganizationRequest(args [0]string, argsEscaped
 		](
 			m,
 			mreq,
-			nil,
+			unpackCloneTalkSessionTemplateParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.EstablishOrganization(ctx, request)
+				response, err = s.h.CloneTalkSessionTemplate(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.EstablishOrganization(ctx, request)
+		response, err = s.h.CloneTalkSessionTemplate(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -2481,7 +2853,7 @@ func (s *Server) handleEstablishOrganizationRequest(args [0]string, argsEscaped
 		return
 	}
 
-	if err := encodeEstablishOrganizationResponse(response, w, span); err != nil {
+	if err := encodeCloneTalkSessionTemplateResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2490,22 +2862,22 @@ func (s *Server) handleEstablishOrganizationRequest(args [0]string, argsEscaped
 	}
 }
 
-// handleEstablishUserRequest handles establishUser operation.
+// handleConsentTalkSessionRequest handles consentTalkSession operation.
 //
-// ユーザー作成.
+// セッションへの同意.
 //
-// POST /user
-func (s *Server) handleEstablishUserRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /talksessions/{talkSessionID}/consent
+func (s *Server) handleConsentTalkSessionRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("establishUser"),
+		otelogen.OperationID("consentTalkSession"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/user"),
+		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/consent"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), EstablishUserOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ConsentTalkSessionOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2560,15 +2932,15 @@ func (s *Server) handleEstablishUserRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: EstablishUserOperation,
-			ID:   "establishUser",
+			Name: ConsentTalkSessionOperation,
+			ID:   "consentTalkSession",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, EstablishUserOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, ConsentTalkSessionOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2584,11 +2956,29 @@ func (s *Server) handleEstablishUserRequest(args [0]string, argsEscaped bool, w
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, ConsentTalkSessionOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -2608,38 +2998,38 @@ func (s *Server) handleEstablishUserRequest(args [0]string, argsEscaped bool, w
 			return
 		}
 	}
-	request, close, err := s.decodeEstablishUserRequest(r)
+	params, err := decodeConsentTalkSessionParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeRequest", err)
+		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response EstablishUserRes
+	var response ConsentTalkSessionRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    EstablishUserOperation,
-			OperationSummary: "ユーザー作成",
-			OperationID:      "establishUser",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationName:    ConsentTalkSessionOperation,
+			OperationSummary: "セッションへの同意",
+			OperationID:      "consentTalkSession",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "talkSessionID",
+					In:   "path",
+				}: params.TalkSessionID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *EstablishUserReq
-			Params   = struct{}
-			Response = EstablishUserRes
+			Request  = struct{}
+			Params   = ConsentTalkSessionParams
+			Response = ConsentTalkSessionRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2648,14 +3038,14 @@ func (s *Server) handleEstablishUserRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			nil,
+			unpackConsentTalkSessionParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.EstablishUser(ctx, request)
+				response, err = s.h.ConsentTalkSession(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.EstablishUser(ctx, request)
+		response, err = s.h.ConsentTalkSession(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -2663,7 +3053,7 @@ func (s *Server) handleEstablishUserRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeEstablishUserResponse(response, w, span); err != nil {
+	if err := encodeConsentTalkSessionResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2672,20 +3062,22 @@ func (s *Server) handleEstablishUserRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleGetAnalysisReportManageRequest handles getAnalysisReportManage operation.
+// handleCreateOrganizationAliasRequest handles createOrganizationAlias operation.
 //
-// GET /v1/manage/talksessions/{talkSessionID}/analysis/report
-func (s *Server) handleGetAnalysisReportManageRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// 組織エイリアス作成.
+//
+// POST /organizations/aliases
+func (s *Server) handleCreateOrganizationAliasRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getAnalysisReportManage"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v1/manage/talksessions/{talkSessionID}/analysis/report"),
+		otelogen.OperationID("createOrganizationAlias"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/organizations/aliases"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetAnalysisReportManageOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateOrganizationAliasOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2740,15 +3132,15 @@ func (s *Server) handleGetAnalysisReportManageRequest(args [1]string, argsEscape
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetAnalysisReportManageOperation,
-			ID:   "getAnalysisReportManage",
+			Name: CreateOrganizationAliasOperation,
+			ID:   "createOrganizationAlias",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, GetAnalysisReportManageOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, CreateOrganizationAliasOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2764,11 +3156,29 @@ func (s *Server) handleGetAnalysisReportManageRequest(args [1]string, argsEscape
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, CreateOrganizationAliasOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -2788,38 +3198,38 @@ func (s *Server) handleGetAnalysisReportManageRequest(args [1]string, argsEscape
 			return
 		}
 	}
-	params, err := decodeGetAnalysisReportManageParams(args, argsEscaped, r)
+	request, close, err := s.decodeCreateOrganizationAliasRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *AnalysisReportResponse
+	var response CreateOrganizationAliasRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetAnalysisReportManageOperation,
-			OperationSummary: "",
-			OperationID:      "getAnalysisReportManage",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "talkSessionID",
-					In:   "path",
-				}: params.TalkSessionID,
-			},
-			Raw: r,
+			OperationName:    CreateOrganizationAliasOperation,
+			OperationSummary: "組織エイリアス作成",
+			OperationID:      "createOrganizationAlias",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = GetAnalysisReportManageParams
-			Response = *AnalysisReportResponse
+			Request  = *CreateOrganizationAliasReq
+			Params   = struct{}
+			Response = CreateOrganizationAliasRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2828,14 +3238,14 @@ func (s *Server) handleGetAnalysisReportManageRequest(args [1]string, argsEscape
 		](
 			m,
 			mreq,
-			unpackGetAnalysisReportManageParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetAnalysisReportManage(ctx, params)
+				response, err = s.h.CreateOrganizationAlias(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetAnalysisReportManage(ctx, params)
+		response, err = s.h.CreateOrganizationAlias(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -2843,7 +3253,7 @@ func (s *Server) handleGetAnalysisReportManageRequest(args [1]string, argsEscape
 		return
 	}
 
-	if err := encodeGetAnalysisReportManageResponse(response, w, span); err != nil {
+	if err := encodeCreateOrganizationAliasResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2852,22 +3262,28 @@ func (s *Server) handleGetAnalysisReportManageRequest(args [1]string, argsEscape
 	}
 }
 
-// handleGetConclusionRequest handles getConclusion operation.
+// handleCreateOrganizationApiKeyRequest handles createOrganizationApiKey operation.
 //
-// 結論取得.
+// サーバー間連携用のAPIキーを発行する。
+// 発行したキーはこのレスポンスでのみ返されるため、安全な場所に保管してください。
+// APIキーは`X-API-Key`ヘッダーに指定して使用します。
+// scopesには以下を指定できます。
+// - `sessions:read` セッションの閲覧
+// - `sessions:write` セッションの作成・編集
+// - `results:export` 分析結果・レポートの取得.
 //
-// GET /talksessions/{talkSessionID}/conclusion
-func (s *Server) handleGetConclusionRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /organizations/api-keys
+func (s *Server) handleCreateOrganizationApiKeyRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getConclusion"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/conclusion"),
+		otelogen.OperationID("createOrganizationApiKey"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/organizations/api-keys"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetConclusionOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateOrganizationApiKeyOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -2922,42 +3338,104 @@ func (s *Server) handleGetConclusionRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetConclusionOperation,
-			ID:   "getConclusion",
+			Name: CreateOrganizationApiKeyOperation,
+			ID:   "createOrganizationApiKey",
 		}
 	)
-	params, err := decodeGetConclusionParams(args, argsEscaped, r)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, CreateOrganizationApiKeyOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, CreateOrganizationApiKeyOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	request, close, err := s.decodeCreateOrganizationApiKeyRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response GetConclusionRes
+	var response CreateOrganizationApiKeyRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetConclusionOperation,
-			OperationSummary: "結論取得",
-			OperationID:      "getConclusion",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "talkSessionID",
-					In:   "path",
-				}: params.TalkSessionID,
-			},
-			Raw: r,
+			OperationName:    CreateOrganizationApiKeyOperation,
+			OperationSummary: "組織APIキー発行",
+			OperationID:      "createOrganizationApiKey",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = GetConclusionParams
-			Response = GetConclusionRes
+			Request  = *CreateOrganizationApiKeyReq
+			Params   = struct{}
+			Response = CreateOrganizationApiKeyRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2966,14 +3444,14 @@ func (s *Server) handleGetConclusionRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackGetConclusionParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetConclusion(ctx, params)
+				response, err = s.h.CreateOrganizationApiKey(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetConclusion(ctx, params)
+		response, err = s.h.CreateOrganizationApiKey(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -2981,7 +3459,7 @@ func (s *Server) handleGetConclusionRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGetConclusionResponse(response, w, span); err != nil {
+	if err := encodeCreateOrganizationApiKeyResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2990,22 +3468,29 @@ func (s *Server) handleGetConclusionRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleGetDevicesRequest handles getDevices operation.
+// handleCreateOrganizationInvitationRequest handles createOrganizationInvitation operation.
 //
-// デバイス一覧取得.
+// 招待リンクをメールで送信する。
+// 招待されたユーザーはリンクからログイン（未登録の場合は登録）した後、招待を承諾することで組織に参加できる。
+// 招待リンクの有効期限は7日間。
+// 自分と同じかそれ以下のロールでのみ招待できる。
+// Role
+// - 20: Owner
+// - 30: Admin
+// - 40: Member.
 //
-// GET /notifications/devices
-func (s *Server) handleGetDevicesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /organizations/invitations
+func (s *Server) handleCreateOrganizationInvitationRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getDevices"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/notifications/devices"),
+		otelogen.OperationID("createOrganizationInvitation"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/organizations/invitations"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetDevicesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), CreateOrganizationInvitationOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3060,15 +3545,15 @@ func (s *Server) handleGetDevicesRequest(args [0]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetDevicesOperation,
-			ID:   "getDevices",
+			Name: CreateOrganizationInvitationOperation,
+			ID:   "createOrganizationInvitation",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, GetDevicesOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, CreateOrganizationInvitationOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3084,11 +3569,29 @@ func (s *Server) handleGetDevicesRequest(args [0]string, argsEscaped bool, w htt
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, CreateOrganizationInvitationOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -3108,23 +3611,38 @@ func (s *Server) handleGetDevicesRequest(args [0]string, argsEscaped bool, w htt
 			return
 		}
 	}
+	request, close, err := s.decodeCreateOrganizationInvitationRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response GetDevicesRes
+	var response CreateOrganizationInvitationRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetDevicesOperation,
-			OperationSummary: "デバイス一覧取得",
-			OperationID:      "getDevices",
-			Body:             nil,
+			OperationName:    CreateOrganizationInvitationOperation,
+			OperationSummary: "組織への招待作成",
+			OperationID:      "createOrganizationInvitation",
+			Body:             request,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
+			Request  = *CreateOrganizationInvitationReq
 			Params   = struct{}
-			Response = GetDevicesRes
+			Response = CreateOrganizationInvitationRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3135,12 +3653,12 @@ func (s *Server) handleGetDevicesRequest(args [0]string, argsEscaped bool, w htt
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetDevices(ctx)
+				response, err = s.h.CreateOrganizationInvitation(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetDevices(ctx)
+		response, err = s.h.CreateOrganizationInvitation(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -3148,7 +3666,7 @@ func (s *Server) handleGetDevicesRequest(args [0]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeGetDevicesResponse(response, w, span); err != nil {
+	if err := encodeCreateOrganizationInvitationResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3157,22 +3675,22 @@ func (s *Server) handleGetDevicesRequest(args [0]string, argsEscaped bool, w htt
 	}
 }
 
-// handleGetNotificationPreferencesRequest handles getNotificationPreferences operation.
+// handleDeclineOwnershipTransferRequest handles declineOwnershipTransfer operation.
 //
-// 通知設定取得.
+// 譲渡先のユーザーのみ辞退できる.
 //
-// GET /notifications/preferences
-func (s *Server) handleGetNotificationPreferencesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /ownership-transfers/{transferID}/decline
+func (s *Server) handleDeclineOwnershipTransferRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getNotificationPreferences"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/notifications/preferences"),
+		otelogen.OperationID("declineOwnershipTransfer"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/ownership-transfers/{transferID}/decline"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetNotificationPreferencesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeclineOwnershipTransferOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3227,15 +3745,15 @@ func (s *Server) handleGetNotificationPreferencesRequest(args [0]string, argsEsc
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetNotificationPreferencesOperation,
-			ID:   "getNotificationPreferences",
+			Name: DeclineOwnershipTransferOperation,
+			ID:   "declineOwnershipTransfer",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, GetNotificationPreferencesOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, DeclineOwnershipTransferOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3251,11 +3769,29 @@ func (s *Server) handleGetNotificationPreferencesRequest(args [0]string, argsEsc
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, DeclineOwnershipTransferOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -3275,23 +3811,38 @@ func (s *Server) handleGetNotificationPreferencesRequest(args [0]string, argsEsc
 			return
 		}
 	}
+	params, err := decodeDeclineOwnershipTransferParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
-	var response GetNotificationPreferencesRes
+	var response DeclineOwnershipTransferRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetNotificationPreferencesOperation,
-			OperationSummary: "通知設定取得",
-			OperationID:      "getNotificationPreferences",
+			OperationName:    DeclineOwnershipTransferOperation,
+			OperationSummary: "オーナー権限の譲渡を辞退",
+			OperationID:      "declineOwnershipTransfer",
 			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "transferID",
+					In:   "path",
+				}: params.TransferID,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = GetNotificationPreferencesRes
+			Params   = DeclineOwnershipTransferParams
+			Response = DeclineOwnershipTransferRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3300,14 +3851,14 @@ func (s *Server) handleGetNotificationPreferencesRequest(args [0]string, argsEsc
 		](
 			m,
 			mreq,
-			nil,
+			unpackDeclineOwnershipTransferParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetNotificationPreferences(ctx)
+				response, err = s.h.DeclineOwnershipTransfer(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetNotificationPreferences(ctx)
+		response, err = s.h.DeclineOwnershipTransfer(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -3315,7 +3866,7 @@ func (s *Server) handleGetNotificationPreferencesRequest(args [0]string, argsEsc
 		return
 	}
 
-	if err := encodeGetNotificationPreferencesResponse(response, w, span); err != nil {
+	if err := encodeDeclineOwnershipTransferResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3324,22 +3875,22 @@ func (s *Server) handleGetNotificationPreferencesRequest(args [0]string, argsEsc
 	}
 }
 
-// handleGetOpenedTalkSessionRequest handles getOpenedTalkSession operation.
+// handleDeleteDeviceRequest handles deleteDevice operation.
 //
-// 自分が開いたセッション一覧.
+// デバイス削除.
 //
-// GET /talksessions/opened
-func (s *Server) handleGetOpenedTalkSessionRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /notifications/devices/{deviceId}
+func (s *Server) handleDeleteDeviceRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getOpenedTalkSession"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/talksessions/opened"),
+		otelogen.OperationID("deleteDevice"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/notifications/devices/{deviceId}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetOpenedTalkSessionOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteDeviceOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3394,15 +3945,15 @@ func (s *Server) handleGetOpenedTalkSessionRequest(args [0]string, argsEscaped b
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetOpenedTalkSessionOperation,
-			ID:   "getOpenedTalkSession",
+			Name: DeleteDeviceOperation,
+			ID:   "deleteDevice",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, GetOpenedTalkSessionOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, DeleteDeviceOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -3418,11 +3969,29 @@ func (s *Server) handleGetOpenedTalkSessionRequest(args [0]string, argsEscaped b
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, DeleteDeviceOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -3442,7 +4011,7 @@ func (s *Server) handleGetOpenedTalkSessionRequest(args [0]string, argsEscaped b
 			return
 		}
 	}
-	params, err := decodeGetOpenedTalkSessionParams(args, argsEscaped, r)
+	params, err := decodeDeleteDeviceParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -3453,39 +4022,27 @@ func (s *Server) handleGetOpenedTalkSessionRequest(args [0]string, argsEscaped b
 		return
 	}
 
-	var response GetOpenedTalkSessionRes
+	var response DeleteDeviceRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetOpenedTalkSessionOperation,
-			OperationSummary: "自分が開いたセッション一覧",
-			OperationID:      "getOpenedTalkSession",
+			OperationName:    DeleteDeviceOperation,
+			OperationSummary: "デバイス削除",
+			OperationID:      "deleteDevice",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
-				{
-					Name: "offset",
-					In:   "query",
-				}: params.Offset,
-				{
-					Name: "theme",
-					In:   "query",
-				}: params.Theme,
-				{
-					Name: "status",
-					In:   "query",
-				}: params.Status,
+					Name: "deviceId",
+					In:   "path",
+				}: params.DeviceId,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetOpenedTalkSessionParams
-			Response = GetOpenedTalkSessionRes
+			Params   = DeleteDeviceParams
+			Response = DeleteDeviceRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3494,14 +4051,14 @@ func (s *Server) handleGetOpenedTalkSessionRequest(args [0]string, argsEscaped b
 		](
 			m,
 			mreq,
-			unpackGetOpenedTalkSessionParams,
+			unpackDeleteDeviceParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetOpenedTalkSession(ctx, params)
+				response, err = s.h.DeleteDevice(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetOpenedTalkSession(ctx, params)
+		response, err = s.h.DeleteDevice(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -3509,7 +4066,7 @@ func (s *Server) handleGetOpenedTalkSessionRequest(args [0]string, argsEscaped b
 		return
 	}
 
-	if err := encodeGetOpenedTalkSessionResponse(response, w, span); err != nil {
+	if err := encodeDeleteDeviceResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3518,22 +4075,22 @@ func (s *Server) handleGetOpenedTalkSessionRequest(args [0]string, argsEscaped b
 	}
 }
 
-// handleGetOpinionAnalysisRequest handles getOpinionAnalysis operation.
+// handleDeleteOpinionRequest handles deleteOpinion operation.
 //
-// 意見に投票したグループごとの割合.
+// 投稿者のみ削除できる。削除した意見は「削除されました」と表示される.
 //
-// GET /opinions/{opinionID}/analysis
-func (s *Server) handleGetOpinionAnalysisRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /opinions/{opinionID}
+func (s *Server) handleDeleteOpinionRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getOpinionAnalysis"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/opinions/{opinionID}/analysis"),
+		otelogen.OperationID("deleteOpinion"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/opinions/{opinionID}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetOpinionAnalysisOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteOpinionOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3588,11 +4145,73 @@ func (s *Server) handleGetOpinionAnalysisRequest(args [1]string, argsEscaped boo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetOpinionAnalysisOperation,
-			ID:   "getOpinionAnalysis",
+			Name: DeleteOpinionOperation,
+			ID:   "deleteOpinion",
 		}
 	)
-	params, err := decodeGetOpinionAnalysisParams(args, argsEscaped, r)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, DeleteOpinionOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, DeleteOpinionOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeDeleteOpinionParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -3603,13 +4222,13 @@ func (s *Server) handleGetOpinionAnalysisRequest(args [1]string, argsEscaped boo
 		return
 	}
 
-	var response GetOpinionAnalysisRes
+	var response DeleteOpinionRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetOpinionAnalysisOperation,
-			OperationSummary: "意見に投票したグループごとの割合",
-			OperationID:      "getOpinionAnalysis",
+			OperationName:    DeleteOpinionOperation,
+			OperationSummary: "意見の削除",
+			OperationID:      "deleteOpinion",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
@@ -3622,8 +4241,8 @@ func (s *Server) handleGetOpinionAnalysisRequest(args [1]string, argsEscaped boo
 
 		type (
 			Request  = struct{}
-			Params   = GetOpinionAnalysisParams
-			Response = GetOpinionAnalysisRes
+			Params   = DeleteOpinionParams
+			Response = DeleteOpinionRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3632,14 +4251,14 @@ func (s *Server) handleGetOpinionAnalysisRequest(args [1]string, argsEscaped boo
 		](
 			m,
 			mreq,
-			unpackGetOpinionAnalysisParams,
+			unpackDeleteOpinionParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetOpinionAnalysis(ctx, params)
+				response, err = s.h.DeleteOpinion(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetOpinionAnalysis(ctx, params)
+		response, err = s.h.DeleteOpinion(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -3647,7 +4266,7 @@ func (s *Server) handleGetOpinionAnalysisRequest(args [1]string, argsEscaped boo
 		return
 	}
 
-	if err := encodeGetOpinionAnalysisResponse(response, w, span); err != nil {
+	if err := encodeDeleteOpinionResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3656,22 +4275,22 @@ func (s *Server) handleGetOpinionAnalysisRequest(args [1]string, argsEscaped boo
 	}
 }
 
-// handleGetOpinionDetail2Request handles getOpinionDetail2 operation.
+// handleDeleteOrganizationAliasRequest handles deleteOrganizationAlias operation.
 //
-// 意見詳細.
+// 組織エイリアス削除.
 //
-// GET /opinions/{opinionID}
-func (s *Server) handleGetOpinionDetail2Request(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /organizations/aliases/{aliasID}
+func (s *Server) handleDeleteOrganizationAliasRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getOpinionDetail2"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/opinions/{opinionID}"),
+		otelogen.OperationID("deleteOrganizationAlias"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/organizations/aliases/{aliasID}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetOpinionDetail2Operation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteOrganizationAliasOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3726,11 +4345,73 @@ func (s *Server) handleGetOpinionDetail2Request(args [1]string, argsEscaped bool
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetOpinionDetail2Operation,
-			ID:   "getOpinionDetail2",
+			Name: DeleteOrganizationAliasOperation,
+			ID:   "deleteOrganizationAlias",
 		}
 	)
-	params, err := decodeGetOpinionDetail2Params(args, argsEscaped, r)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, DeleteOrganizationAliasOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, DeleteOrganizationAliasOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeDeleteOrganizationAliasParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -3741,27 +4422,27 @@ func (s *Server) handleGetOpinionDetail2Request(args [1]string, argsEscaped bool
 		return
 	}
 
-	var response GetOpinionDetail2Res
+	var response DeleteOrganizationAliasRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetOpinionDetail2Operation,
-			OperationSummary: "意見詳細",
-			OperationID:      "getOpinionDetail2",
+			OperationName:    DeleteOrganizationAliasOperation,
+			OperationSummary: "組織エイリアス削除",
+			OperationID:      "deleteOrganizationAlias",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "opinionID",
+					Name: "aliasID",
 					In:   "path",
-				}: params.OpinionID,
+				}: params.AliasID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetOpinionDetail2Params
-			Response = GetOpinionDetail2Res
+			Params   = DeleteOrganizationAliasParams
+			Response = DeleteOrganizationAliasRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3770,14 +4451,14 @@ func (s *Server) handleGetOpinionDetail2Request(args [1]string, argsEscaped bool
 		](
 			m,
 			mreq,
-			unpackGetOpinionDetail2Params,
+			unpackDeleteOrganizationAliasParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetOpinionDetail2(ctx, params)
+				response, err = s.h.DeleteOrganizationAlias(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetOpinionDetail2(ctx, params)
+		response, err = s.h.DeleteOrganizationAlias(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -3785,7 +4466,7 @@ func (s *Server) handleGetOpinionDetail2Request(args [1]string, argsEscaped bool
 		return
 	}
 
-	if err := encodeGetOpinionDetail2Response(response, w, span); err != nil {
+	if err := encodeDeleteOrganizationAliasResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3794,22 +4475,22 @@ func (s *Server) handleGetOpinionDetail2Request(args [1]string, argsEscaped bool
 	}
 }
 
-// handleGetOpinionReportReasonsRequest handles getOpinionReportReasons operation.
+// handleDeleteTalkSessionTemplateRequest handles deleteTalkSessionTemplate operation.
 //
-// 意見への通報理由一覧.
+// テンプレートの作成者か組織の管理者のみ削除できる.
 //
-// GET /opinions/report_reasons
-func (s *Server) handleGetOpinionReportReasonsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /organizations/{code}/talksession-templates/{templateID}
+func (s *Server) handleDeleteTalkSessionTemplateRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getOpinionReportReasons"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/opinions/report_reasons"),
+		otelogen.OperationID("deleteTalkSessionTemplate"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/organizations/{code}/talksession-templates/{templateID}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetOpinionReportReasonsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DeleteTalkSessionTemplateOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3862,25 +4543,110 @@ func (s *Server) handleGetOpinionReportReasonsRequest(args [0]string, argsEscape
 
 			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
 		}
-		err error
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: DeleteTalkSessionTemplateOperation,
+			ID:   "deleteTalkSessionTemplate",
+		}
 	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, DeleteTalkSessionTemplateOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, DeleteTalkSessionTemplateOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
-	var response GetOpinionReportReasonsRes
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeDeleteTalkSessionTemplateParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response DeleteTalkSessionTemplateRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetOpinionReportReasonsOperation,
-			OperationSummary: "意見への通報理由一覧",
-			OperationID:      "getOpinionReportReasons",
+			OperationName:    DeleteTalkSessionTemplateOperation,
+			OperationSummary: "セッションテンプレート削除",
+			OperationID:      "deleteTalkSessionTemplate",
 			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "code",
+					In:   "path",
+				}: params.Code,
+				{
+					Name: "templateID",
+					In:   "path",
+				}: params.TemplateID,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = GetOpinionReportReasonsRes
+			Params   = DeleteTalkSessionTemplateParams
+			Response = DeleteTalkSessionTemplateRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -3889,14 +4655,14 @@ func (s *Server) handleGetOpinionReportReasonsRequest(args [0]string, argsEscape
 		](
 			m,
 			mreq,
-			nil,
+			unpackDeleteTalkSessionTemplateParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetOpinionReportReasons(ctx)
+				response, err = s.h.DeleteTalkSessionTemplate(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetOpinionReportReasons(ctx)
+		response, err = s.h.DeleteTalkSessionTemplate(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -3904,7 +4670,7 @@ func (s *Server) handleGetOpinionReportReasonsRequest(args [0]string, argsEscape
 		return
 	}
 
-	if err := encodeGetOpinionReportReasonsResponse(response, w, span); err != nil {
+	if err := encodeDeleteTalkSessionTemplateResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -3913,22 +4679,22 @@ func (s *Server) handleGetOpinionReportReasonsRequest(args [0]string, argsEscape
 	}
 }
 
-// handleGetOpinionReportsRequest handles getOpinionReports operation.
+// handleDevAuthorizeRequest handles devAuthorize operation.
 //
-// セッション作成者しか取得できない.
+// 開発用登録/ログイン.
 //
-// GET /opinions/{opinionID}/reports
-func (s *Server) handleGetOpinionReportsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /auth/dev/login
+func (s *Server) handleDevAuthorizeRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getOpinionReports"),
+		otelogen.OperationID("devAuthorize"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/opinions/{opinionID}/reports"),
+		semconv.HTTPRouteKey.String("/auth/dev/login"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetOpinionReportsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DevAuthorizeOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -3983,86 +4749,50 @@ func (s *Server) handleGetOpinionReportsRequest(args [1]string, argsEscaped bool
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetOpinionReportsOperation,
-			ID:   "getOpinionReports",
+			Name: DevAuthorizeOperation,
+			ID:   "devAuthorize",
 		}
 	)
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			sctx, ok, err := s.securityCookieAuth(ctx, GetOpinionReportsOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "CookieAuth",
-					Err:              err,
-				}
-				defer recordError("Security:CookieAuth", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 0
-				ctx = sctx
-			}
+	params, err := decodeDevAuthorizeParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
 		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			err = &ogenerrors.SecurityError{
-				OperationContext: opErrContext,
-				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
-			}
-			defer recordError("Security", err)
-			s.cfg.ErrorHandler(ctx, w, r, err)
-			return
-		}
-	}
-	params, err := decodeGetOpinionReportsParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-
-	var response GetOpinionReportsRes
+	var response DevAuthorizeRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetOpinionReportsOperation,
-			OperationSummary: "意見に対する通報取得",
-			OperationID:      "getOpinionReports",
+			OperationName:    DevAuthorizeOperation,
+			OperationSummary: "開発用登録/ログイン",
+			OperationID:      "devAuthorize",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "opinionID",
-					In:   "path",
-				}: params.OpinionID,
+					Name: "redirect_url",
+					In:   "query",
+				}: params.RedirectURL,
+				{
+					Name: "id",
+					In:   "query",
+				}: params.ID,
+				{
+					Name: "organizationCode",
+					In:   "query",
+				}: params.OrganizationCode,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetOpinionReportsParams
-			Response = GetOpinionReportsRes
+			Params   = DevAuthorizeParams
+			Response = DevAuthorizeRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4071,14 +4801,14 @@ func (s *Server) handleGetOpinionReportsRequest(args [1]string, argsEscaped bool
 		](
 			m,
 			mreq,
-			unpackGetOpinionReportsParams,
+			unpackDevAuthorizeParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetOpinionReports(ctx, params)
+				response, err = s.h.DevAuthorize(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetOpinionReports(ctx, params)
+		response, err = s.h.DevAuthorize(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -4086,7 +4816,7 @@ func (s *Server) handleGetOpinionReportsRequest(args [1]string, argsEscaped bool
 		return
 	}
 
-	if err := encodeGetOpinionReportsResponse(response, w, span); err != nil {
+	if err := encodeDevAuthorizeResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4095,22 +4825,23 @@ func (s *Server) handleGetOpinionReportsRequest(args [1]string, argsEscaped bool
 	}
 }
 
-// handleGetOpinionsForTalkSessionRequest handles getOpinionsForTalkSession operation.
+// handleDownloadOrganizationAuditLogsRequest handles downloadOrganizationAuditLogs operation.
 //
-// セッションに対する意見一覧.
+// 条件に一致する監査ログをすべてCSV（UTF-8 BOM付き）で出力する。
+// 変更した項目ごとに1行出力する。.
 //
-// GET /talksessions/{talkSessionID}/opinions
-func (s *Server) handleGetOpinionsForTalkSessionRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /organizations/audit-logs/csv
+func (s *Server) handleDownloadOrganizationAuditLogsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getOpinionsForTalkSession"),
+		otelogen.OperationID("downloadOrganizationAuditLogs"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/opinions"),
+		semconv.HTTPRouteKey.String("/organizations/audit-logs/csv"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetOpinionsForTalkSessionOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DownloadOrganizationAuditLogsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4165,11 +4896,73 @@ func (s *Server) handleGetOpinionsForTalkSessionRequest(args [1]string, argsEsca
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetOpinionsForTalkSessionOperation,
-			ID:   "getOpinionsForTalkSession",
+			Name: DownloadOrganizationAuditLogsOperation,
+			ID:   "downloadOrganizationAuditLogs",
 		}
 	)
-	params, err := decodeGetOpinionsForTalkSessionParams(args, argsEscaped, r)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, DownloadOrganizationAuditLogsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, DownloadOrganizationAuditLogsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeDownloadOrganizationAuditLogsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -4180,43 +4973,43 @@ func (s *Server) handleGetOpinionsForTalkSessionRequest(args [1]string, argsEsca
 		return
 	}
 
-	var response GetOpinionsForTalkSessionRes
+	var response DownloadOrganizationAuditLogsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetOpinionsForTalkSessionOperation,
-			OperationSummary: "セッションに対する意見一覧",
-			OperationID:      "getOpinionsForTalkSession",
+			OperationName:    DownloadOrganizationAuditLogsOperation,
+			OperationSummary: "組織の監査ログCSVダウンロード",
+			OperationID:      "downloadOrganizationAuditLogs",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "talkSessionID",
-					In:   "path",
-				}: params.TalkSessionID,
+					Name: "organizationID",
+					In:   "query",
+				}: params.OrganizationID,
 				{
-					Name: "sort",
+					Name: "action",
 					In:   "query",
-				}: params.Sort,
+				}: params.Action,
 				{
-					Name: "limit",
+					Name: "actorID",
 					In:   "query",
-				}: params.Limit,
+				}: params.ActorID,
 				{
-					Name: "offset",
+					Name: "since",
 					In:   "query",
-				}: params.Offset,
+				}: params.Since,
 				{
-					Name: "seed",
+					Name: "until",
 					In:   "query",
-				}: params.Seed,
+				}: params.Until,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetOpinionsForTalkSessionParams
-			Response = GetOpinionsForTalkSessionRes
+			Params   = DownloadOrganizationAuditLogsParams
+			Response = DownloadOrganizationAuditLogsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4225,14 +5018,14 @@ func (s *Server) handleGetOpinionsForTalkSessionRequest(args [1]string, argsEsca
 		](
 			m,
 			mreq,
-			unpackGetOpinionsForTalkSessionParams,
+			unpackDownloadOrganizationAuditLogsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetOpinionsForTalkSession(ctx, params)
+				response, err = s.h.DownloadOrganizationAuditLogs(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetOpinionsForTalkSession(ctx, params)
+		response, err = s.h.DownloadOrganizationAuditLogs(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -4240,7 +5033,7 @@ func (s *Server) handleGetOpinionsForTalkSessionRequest(args [1]string, argsEsca
 		return
 	}
 
-	if err := encodeGetOpinionsForTalkSessionResponse(response, w, span); err != nil {
+	if err := encodeDownloadOrganizationAuditLogsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4249,22 +5042,22 @@ func (s *Server) handleGetOpinionsForTalkSessionRequest(args [1]string, argsEsca
 	}
 }
 
-// handleGetOrganizationAliasesRequest handles getOrganizationAliases operation.
+// handleDummyInitRequest handles dummyInit operation.
 //
-// 組織エイリアス一覧取得.
+// Init dummy.
 //
-// GET /organizations/aliases
-func (s *Server) handleGetOrganizationAliasesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /test/dummy
+func (s *Server) handleDummyInitRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getOrganizationAliases"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/organizations/aliases"),
+		otelogen.OperationID("dummyInit"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/test/dummy"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetOrganizationAliasesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), DummyInitOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4319,71 +5112,42 @@ func (s *Server) handleGetOrganizationAliasesRequest(args [0]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetOrganizationAliasesOperation,
-			ID:   "getOrganizationAliases",
+			Name: DummyInitOperation,
+			ID:   "dummyInit",
 		}
 	)
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			sctx, ok, err := s.securityCookieAuth(ctx, GetOrganizationAliasesOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "CookieAuth",
-					Err:              err,
-				}
-				defer recordError("Security:CookieAuth", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 0
-				ctx = sctx
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			err = &ogenerrors.SecurityError{
-				OperationContext: opErrContext,
-				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
-			}
-			defer recordError("Security", err)
-			s.cfg.ErrorHandler(ctx, w, r, err)
-			return
+	request, close, err := s.decodeDummyInitRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
 		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response GetOrganizationAliasesRes
+	var response DummyInitRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetOrganizationAliasesOperation,
-			OperationSummary: "組織エイリアス一覧取得",
-			OperationID:      "getOrganizationAliases",
-			Body:             nil,
+			OperationName:    DummyInitOperation,
+			OperationSummary: "init dummy",
+			OperationID:      "dummyInit",
+			Body:             request,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
+			Request  = *DummyInitReq
 			Params   = struct{}
-			Response = GetOrganizationAliasesRes
+			Response = DummyInitRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4394,12 +5158,12 @@ func (s *Server) handleGetOrganizationAliasesRequest(args [0]string, argsEscaped
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetOrganizationAliases(ctx)
+				response, err = s.h.DummyInit(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetOrganizationAliases(ctx)
+		response, err = s.h.DummyInit(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -4407,7 +5171,7 @@ func (s *Server) handleGetOrganizationAliasesRequest(args [0]string, argsEscaped
 		return
 	}
 
-	if err := encodeGetOrganizationAliasesResponse(response, w, span); err != nil {
+	if err := encodeDummyInitResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4416,22 +5180,23 @@ func (s *Server) handleGetOrganizationAliasesRequest(args [0]string, argsEscaped
 	}
 }
 
-// handleGetOrganizationUsersRequest handles getOrganizationUsers operation.
+// handleEditOpinionRequest handles editOpinion operation.
 //
-// 現在の組織のユーザー一覧取得.
+// 投稿者のみ編集できる。投稿してから一定期間内で、投稿者以外の投票が一定数以下の場合に限る。
+// 内容が大きく変わった場合は、投稿者以外の投票をリセットする。.
 //
-// GET /organizations/users
-func (s *Server) handleGetOrganizationUsersRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /opinions/{opinionID}
+func (s *Server) handleEditOpinionRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getOrganizationUsers"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/organizations/users"),
+		otelogen.OperationID("editOpinion"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/opinions/{opinionID}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetOrganizationUsersOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), EditOpinionOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4486,15 +5251,15 @@ func (s *Server) handleGetOrganizationUsersRequest(args [0]string, argsEscaped b
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetOrganizationUsersOperation,
-			ID:   "getOrganizationUsers",
+			Name: EditOpinionOperation,
+			ID:   "editOpinion",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, GetOrganizationUsersOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, EditOpinionOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4510,12 +5275,30 @@ func (s *Server) handleGetOrganizationUsersRequest(args [0]string, argsEscaped b
 				ctx = sctx
 			}
 		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-			} {
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, EditOpinionOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
 						continue nextRequirement
@@ -4534,23 +5317,53 @@ func (s *Server) handleGetOrganizationUsersRequest(args [0]string, argsEscaped b
 			return
 		}
 	}
+	params, err := decodeEditOpinionParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeEditOpinionRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response GetOrganizationUsersRes
+	var response EditOpinionRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetOrganizationUsersOperation,
-			OperationSummary: "現在の組織のユーザー一覧取得",
-			OperationID:      "getOrganizationUsers",
-			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationName:    EditOpinionOperation,
+			OperationSummary: "意見の編集",
+			OperationID:      "editOpinion",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "opinionID",
+					In:   "path",
+				}: params.OpinionID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = struct{}
-			Response = GetOrganizationUsersRes
+			Request  = *EditOpinionReq
+			Params   = EditOpinionParams
+			Response = EditOpinionRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4559,14 +5372,14 @@ func (s *Server) handleGetOrganizationUsersRequest(args [0]string, argsEscaped b
 		](
 			m,
 			mreq,
-			nil,
+			unpackEditOpinionParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetOrganizationUsers(ctx)
+				response, err = s.h.EditOpinion(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetOrganizationUsers(ctx)
+		response, err = s.h.EditOpinion(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -4574,7 +5387,7 @@ func (s *Server) handleGetOrganizationUsersRequest(args [0]string, argsEscaped b
 		return
 	}
 
-	if err := encodeGetOrganizationUsersResponse(response, w, span); err != nil {
+	if err := encodeEditOpinionResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4583,22 +5396,22 @@ func (s *Server) handleGetOrganizationUsersRequest(args [0]string, argsEscaped b
 	}
 }
 
-// handleGetOrganizationsRequest handles getOrganizations operation.
+// handleEditTalkSessionRequest handles editTalkSession operation.
 //
-// 所属組織一覧.
+// セッション編集.
 //
-// GET /organizations
-func (s *Server) handleGetOrganizationsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /talksessions/{talkSessionID}
+func (s *Server) handleEditTalkSessionRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getOrganizations"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/organizations"),
+		otelogen.OperationID("editTalkSession"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetOrganizationsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), EditTalkSessionOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4653,15 +5466,15 @@ func (s *Server) handleGetOrganizationsRequest(args [0]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetOrganizationsOperation,
-			ID:   "getOrganizations",
+			Name: EditTalkSessionOperation,
+			ID:   "editTalkSession",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, GetOrganizationsOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, EditTalkSessionOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4677,11 +5490,29 @@ func (s *Server) handleGetOrganizationsRequest(args [0]string, argsEscaped bool,
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, EditTalkSessionOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -4701,23 +5532,53 @@ func (s *Server) handleGetOrganizationsRequest(args [0]string, argsEscaped bool,
 			return
 		}
 	}
+	params, err := decodeEditTalkSessionParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeEditTalkSessionRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response GetOrganizationsRes
+	var response EditTalkSessionRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetOrganizationsOperation,
-			OperationSummary: "所属組織一覧",
-			OperationID:      "getOrganizations",
-			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationName:    EditTalkSessionOperation,
+			OperationSummary: "セッション編集",
+			OperationID:      "editTalkSession",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "talkSessionID",
+					In:   "path",
+				}: params.TalkSessionID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = struct{}
-			Response = GetOrganizationsRes
+			Request  = *EditTalkSessionReq
+			Params   = EditTalkSessionParams
+			Response = EditTalkSessionRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -4726,14 +5587,14 @@ func (s *Server) handleGetOrganizationsRequest(args [0]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			nil,
+			unpackEditTalkSessionParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetOrganizations(ctx)
+				response, err = s.h.EditTalkSession(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetOrganizations(ctx)
+		response, err = s.h.EditTalkSession(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -4741,7 +5602,7 @@ func (s *Server) handleGetOrganizationsRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeGetOrganizationsResponse(response, w, span); err != nil {
+	if err := encodeEditTalkSessionResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -4750,22 +5611,22 @@ func (s *Server) handleGetOrganizationsRequest(args [0]string, argsEscaped bool,
 	}
 }
 
-// handleGetPolicyConsentStatusRequest handles getPolicyConsentStatus operation.
+// handleEditTimeLineRequest handles editTimeLine operation.
 //
-// 最新のポリシーに同意したかを取得.
+// タイムライン編集.
 //
-// GET /policy/consent
-func (s *Server) handleGetPolicyConsentStatusRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /talksessions/{talkSessionID}/timelines/{actionItemID}
+func (s *Server) handleEditTimeLineRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getPolicyConsentStatus"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/policy/consent"),
+		otelogen.OperationID("editTimeLine"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/timelines/{actionItemID}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPolicyConsentStatusOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), EditTimeLineOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4818,73 +5679,174 @@ func (s *Server) handleGetPolicyConsentStatusRequest(args [0]string, argsEscaped
 
 			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
 		}
-		err error
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: EditTimeLineOperation,
+			ID:   "editTimeLine",
+		}
 	)
-
-	var response GetPolicyConsentStatusRes
-	if m := s.cfg.Middleware; m != nil {
-		mreq := middleware.Request{
-			Context:          ctx,
-			OperationName:    GetPolicyConsentStatusOperation,
-			OperationSummary: "最新のポリシーに同意したかを取得",
-			OperationID:      "getPolicyConsentStatus",
-			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, EditTimeLineOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
 		}
-
-		type (
-			Request  = struct{}
-			Params   = struct{}
-			Response = GetPolicyConsentStatusRes
-		)
-		response, err = middleware.HookMiddleware[
-			Request,
-			Params,
-			Response,
-		](
-			m,
-			mreq,
-			nil,
-			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetPolicyConsentStatus(ctx)
-				return response, err
-			},
-		)
-	} else {
-		response, err = s.h.GetPolicyConsentStatus(ctx)
-	}
-	if err != nil {
-		defer recordError("Internal", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-
-	if err := encodeGetPolicyConsentStatusResponse(response, w, span); err != nil {
-		defer recordError("EncodeResponse", err)
-		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
-			s.cfg.ErrorHandler(ctx, w, r, err)
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, EditTimeLineOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
 		}
-		return
-	}
-}
 
-// handleGetReportsForTalkSessionRequest handles getReportsForTalkSession operation.
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeEditTimeLineParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeEditTimeLineRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response EditTimeLineRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    EditTimeLineOperation,
+			OperationSummary: "タイムライン編集",
+			OperationID:      "editTimeLine",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "talkSessionID",
+					In:   "path",
+				}: params.TalkSessionID,
+				{
+					Name: "actionItemID",
+					In:   "path",
+				}: params.ActionItemID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *EditTimeLineReq
+			Params   = EditTimeLineParams
+			Response = EditTimeLineRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackEditTimeLineParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.EditTimeLine(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.EditTimeLine(ctx, request, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeEditTimeLineResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleEstablishOrganizationRequest handles establishOrganization operation.
 //
-// 通報一覧.
+// 組織を作成できる。
+// これを作れるユーザーはDBを直接叩いて作るしかない。.
 //
-// GET /talksessions/{talkSessionID}/reports
-func (s *Server) handleGetReportsForTalkSessionRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /organizations
+func (s *Server) handleEstablishOrganizationRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getReportsForTalkSession"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/reports"),
+		otelogen.OperationID("establishOrganization"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/organizations"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetReportsForTalkSessionOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), EstablishOrganizationOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -4939,15 +5901,15 @@ func (s *Server) handleGetReportsForTalkSessionRequest(args [1]string, argsEscap
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetReportsForTalkSessionOperation,
-			ID:   "getReportsForTalkSession",
+			Name: EstablishOrganizationOperation,
+			ID:   "establishOrganization",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, GetReportsForTalkSessionOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, EstablishOrganizationOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -4963,11 +5925,29 @@ func (s *Server) handleGetReportsForTalkSessionRequest(args [1]string, argsEscap
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, EstablishOrganizationOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -4987,42 +5967,38 @@ func (s *Server) handleGetReportsForTalkSessionRequest(args [1]string, argsEscap
 			return
 		}
 	}
-	params, err := decodeGetReportsForTalkSessionParams(args, argsEscaped, r)
+	request, close, err := s.decodeEstablishOrganizationRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response GetReportsForTalkSessionRes
+	var response EstablishOrganizationRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetReportsForTalkSessionOperation,
-			OperationSummary: "通報一覧",
-			OperationID:      "getReportsForTalkSession",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "talkSessionID",
-					In:   "path",
-				}: params.TalkSessionID,
-				{
-					Name: "status",
-					In:   "query",
-				}: params.Status,
-			},
-			Raw: r,
+			OperationName:    EstablishOrganizationOperation,
+			OperationSummary: "組織作成（運営ユーザーのみ）",
+			OperationID:      "establishOrganization",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = GetReportsForTalkSessionParams
-			Response = GetReportsForTalkSessionRes
+			Request  = *EstablishOrganizationReq
+			Params   = struct{}
+			Response = EstablishOrganizationRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5031,14 +6007,10180 @@ func (s *Server) handleGetReportsForTalkSessionRequest(args [1]string, argsEscap
 		](
 			m,
 			mreq,
-			unpackGetReportsForTalkSessionParams,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.EstablishOrganization(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.EstablishOrganization(ctx, request)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeEstablishOrganizationResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleEstablishUserRequest handles establishUser operation.
+//
+// ユーザー作成.
+//
+// POST /user
+func (s *Server) handleEstablishUserRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("establishUser"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/user"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), EstablishUserOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: EstablishUserOperation,
+			ID:   "establishUser",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, EstablishUserOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, EstablishUserOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	request, close, err := s.decodeEstablishUserRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response EstablishUserRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    EstablishUserOperation,
+			OperationSummary: "ユーザー作成",
+			OperationID:      "establishUser",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *EstablishUserReq
+			Params   = struct{}
+			Response = EstablishUserRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.EstablishUser(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.EstablishUser(ctx, request)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeEstablishUserResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetAnalysisJobRequest handles getAnalysisJob operation.
+//
+// 非同期に実行しているレポート・ワードクラウドの生成ジョブの状況を返す。
+// 失敗したジョブは上限まで間隔を空けて再実行する.
+//
+// GET /talksessions/{talkSessionID}/analysis/jobs/{jobID}
+func (s *Server) handleGetAnalysisJobRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getAnalysisJob"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/analysis/jobs/{jobID}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetAnalysisJobOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetAnalysisJobOperation,
+			ID:   "getAnalysisJob",
+		}
+	)
+	params, err := decodeGetAnalysisJobParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetAnalysisJobRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetAnalysisJobOperation,
+			OperationSummary: "レポート・ワードクラウドの生成ジョブの状況",
+			OperationID:      "getAnalysisJob",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "talkSessionID",
+					In:   "path",
+				}: params.TalkSessionID,
+				{
+					Name: "jobID",
+					In:   "path",
+				}: params.JobID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetAnalysisJobParams
+			Response = GetAnalysisJobRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetAnalysisJobParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetAnalysisJob(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetAnalysisJob(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetAnalysisJobResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetAnalysisReportManageRequest handles getAnalysisReportManage operation.
+//
+// GET /v1/manage/talksessions/{talkSessionID}/analysis/report
+func (s *Server) handleGetAnalysisReportManageRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getAnalysisReportManage"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v1/manage/talksessions/{talkSessionID}/analysis/report"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetAnalysisReportManageOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetAnalysisReportManageOperation,
+			ID:   "getAnalysisReportManage",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetAnalysisReportManageOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, GetAnalysisReportManageOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetAnalysisReportManageParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *AnalysisReportResponse
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetAnalysisReportManageOperation,
+			OperationSummary: "",
+			OperationID:      "getAnalysisReportManage",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "talkSessionID",
+					In:   "path",
+				}: params.TalkSessionID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetAnalysisReportManageParams
+			Response = *AnalysisReportResponse
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetAnalysisReportManageParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetAnalysisReportManage(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetAnalysisReportManage(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetAnalysisReportManageResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetAnalysisSnapshotRequest handles getAnalysisSnapshot operation.
+//
+// スナップショット時点のグループ分け、ポジション、代表意見を返す.
+//
+// GET /talksessions/{talkSessionID}/analysis/snapshots/{snapshotID}
+func (s *Server) handleGetAnalysisSnapshotRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getAnalysisSnapshot"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/analysis/snapshots/{snapshotID}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetAnalysisSnapshotOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetAnalysisSnapshotOperation,
+			ID:   "getAnalysisSnapshot",
+		}
+	)
+	params, err := decodeGetAnalysisSnapshotParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetAnalysisSnapshotRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetAnalysisSnapshotOperation,
+			OperationSummary: "分析結果のスナップショット",
+			OperationID:      "getAnalysisSnapshot",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "talkSessionID",
+					In:   "path",
+				}: params.TalkSessionID,
+				{
+					Name: "snapshotID",
+					In:   "path",
+				}: params.SnapshotID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetAnalysisSnapshotParams
+			Response = GetAnalysisSnapshotRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetAnalysisSnapshotParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetAnalysisSnapshot(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetAnalysisSnapshot(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetAnalysisSnapshotResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetAnalysisSnapshotDiffRequest handles getAnalysisSnapshotDiff operation.
+//
+// 2つのスナップショットを比べ、グループが変わったユーザーを返す.
+//
+// GET /talksessions/{talkSessionID}/analysis/snapshots/diff
+func (s *Server) handleGetAnalysisSnapshotDiffRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getAnalysisSnapshotDiff"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/analysis/snapshots/diff"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetAnalysisSnapshotDiffOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetAnalysisSnapshotDiffOperation,
+			ID:   "getAnalysisSnapshotDiff",
+		}
+	)
+	params, err := decodeGetAnalysisSnapshotDiffParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetAnalysisSnapshotDiffRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetAnalysisSnapshotDiffOperation,
+			OperationSummary: "分析結果のスナップショットの差分",
+			OperationID:      "getAnalysisSnapshotDiff",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "talkSessionID",
+					In:   "path",
+				}: params.TalkSessionID,
+				{
+					Name: "from",
+					In:   "query",
+				}: params.From,
+				{
+					Name: "to",
+					In:   "query",
+				}: params.To,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetAnalysisSnapshotDiffParams
+			Response = GetAnalysisSnapshotDiffRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetAnalysisSnapshotDiffParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetAnalysisSnapshotDiff(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetAnalysisSnapshotDiff(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetAnalysisSnapshotDiffResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetAnalysisSnapshotsRequest handles getAnalysisSnapshots operation.
+//
+// 分析のたびに残したグループ分けと代表意見のスナップショットを古い順に返す.
+//
+// GET /talksessions/{talkSessionID}/analysis/snapshots
+func (s *Server) handleGetAnalysisSnapshotsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getAnalysisSnapshots"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/analysis/snapshots"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetAnalysisSnapshotsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetAnalysisSnapshotsOperation,
+			ID:   "getAnalysisSnapshots",
+		}
+	)
+	params, err := decodeGetAnalysisSnapshotsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetAnalysisSnapshotsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetAnalysisSnapshotsOperation,
+			OperationSummary: "分析結果のスナップショット一覧",
+			OperationID:      "getAnalysisSnapshots",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "talkSessionID",
+					In:   "path",
+				}: params.TalkSessionID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetAnalysisSnapshotsParams
+			Response = GetAnalysisSnapshotsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetAnalysisSnapshotsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetAnalysisSnapshots(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetAnalysisSnapshots(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetAnalysisSnapshotsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetAnalysisStatusRequest handles getAnalysisStatus operation.
+//
+// 投票・意見の投稿に応じた分析の再計算の状況を返す。
+// 最後の分析以降の投票が一定数に達するか、最初の投票・意見から一定時間が経つと再計算する.
+//
+// GET /talksessions/{talkSessionID}/analysis/status
+func (s *Server) handleGetAnalysisStatusRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getAnalysisStatus"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/analysis/status"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetAnalysisStatusOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetAnalysisStatusOperation,
+			ID:   "getAnalysisStatus",
+		}
+	)
+	params, err := decodeGetAnalysisStatusParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetAnalysisStatusRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetAnalysisStatusOperation,
+			OperationSummary: "分析の再計算の状況",
+			OperationID:      "getAnalysisStatus",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "talkSessionID",
+					In:   "path",
+				}: params.TalkSessionID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetAnalysisStatusParams
+			Response = GetAnalysisStatusRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetAnalysisStatusParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetAnalysisStatus(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetAnalysisStatus(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetAnalysisStatusResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetConclusionRequest handles getConclusion operation.
+//
+// 結論取得.
+//
+// GET /talksessions/{talkSessionID}/conclusion
+func (s *Server) handleGetConclusionRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getConclusion"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/conclusion"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetConclusionOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetConclusionOperation,
+			ID:   "getConclusion",
+		}
+	)
+	params, err := decodeGetConclusionParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetConclusionRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetConclusionOperation,
+			OperationSummary: "結論取得",
+			OperationID:      "getConclusion",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "talkSessionID",
+					In:   "path",
+				}: params.TalkSessionID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetConclusionParams
+			Response = GetConclusionRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetConclusionParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetConclusion(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetConclusion(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetConclusionResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetConsensusRequest handles getConsensus operation.
+//
+// 意見ごとにグループの賛成確率を求め、全てのグループが賛成している意見と、グループで賛否が分かれている意見を返す。
+// 賛成確率は投票が少ないグループで極端にならないよう平滑化する.
+//
+// GET /talksessions/{talkSessionID}/analysis/consensus
+func (s *Server) handleGetConsensusRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getConsensus"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/analysis/consensus"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetConsensusOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetConsensusOperation,
+			ID:   "getConsensus",
+		}
+	)
+	params, err := decodeGetConsensusParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetConsensusRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetConsensusOperation,
+			OperationSummary: "グループ間で合意している意見と賛否が分かれている意見",
+			OperationID:      "getConsensus",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "talkSessionID",
+					In:   "path",
+				}: params.TalkSessionID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetConsensusParams
+			Response = GetConsensusRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetConsensusParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetConsensus(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetConsensus(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetConsensusResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetDevicesRequest handles getDevices operation.
+//
+// デバイス一覧取得.
+//
+// GET /notifications/devices
+func (s *Server) handleGetDevicesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getDevices"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/notifications/devices"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetDevicesOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetDevicesOperation,
+			ID:   "getDevices",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetDevicesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, GetDevicesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var response GetDevicesRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetDevicesOperation,
+			OperationSummary: "デバイス一覧取得",
+			OperationID:      "getDevices",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = GetDevicesRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetDevices(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetDevices(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetDevicesResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetJwksRequest handles getJwks operation.
+//
+// トークン検証用の公開鍵一覧.
+//
+// GET /.well-known/jwks.json
+func (s *Server) handleGetJwksRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getJwks"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/.well-known/jwks.json"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetJwksOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err error
+	)
+
+	var response GetJwksRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetJwksOperation,
+			OperationSummary: "トークン検証用の公開鍵一覧",
+			OperationID:      "getJwks",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = GetJwksRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetJwks(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetJwks(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetJwksResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetMyAnalysisPositionRequest handles getMyAnalysisPosition operation.
+//
+// ログインしているユーザーの分析結果での位置を返す。
+// 所属するグループ、各グループの中心までの距離、グループと投票が最も一致・食い違っている意見、
+// まだ投票していない意見のうち同じグループのメンバーが賛成している意見を含む.
+//
+// GET /talksessions/{talkSessionID}/analysis/me
+func (s *Server) handleGetMyAnalysisPositionRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getMyAnalysisPosition"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/analysis/me"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetMyAnalysisPositionOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetMyAnalysisPositionOperation,
+			ID:   "getMyAnalysisPosition",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetMyAnalysisPositionOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, GetMyAnalysisPositionOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetMyAnalysisPositionParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetMyAnalysisPositionRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetMyAnalysisPositionOperation,
+			OperationSummary: "自分の分析結果での位置",
+			OperationID:      "getMyAnalysisPosition",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "talkSessionID",
+					In:   "path",
+				}: params.TalkSessionID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetMyAnalysisPositionParams
+			Response = GetMyAnalysisPositionRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetMyAnalysisPositionParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetMyAnalysisPosition(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetMyAnalysisPosition(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetMyAnalysisPositionResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetNotificationPreferencesRequest handles getNotificationPreferences operation.
+//
+// 通知設定取得.
+//
+// GET /notifications/preferences
+func (s *Server) handleGetNotificationPreferencesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getNotificationPreferences"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/notifications/preferences"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetNotificationPreferencesOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetNotificationPreferencesOperation,
+			ID:   "getNotificationPreferences",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetNotificationPreferencesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, GetNotificationPreferencesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var response GetNotificationPreferencesRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetNotificationPreferencesOperation,
+			OperationSummary: "通知設定取得",
+			OperationID:      "getNotificationPreferences",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = GetNotificationPreferencesRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetNotificationPreferences(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetNotificationPreferences(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetNotificationPreferencesResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetOpenedTalkSessionRequest handles getOpenedTalkSession operation.
+//
+// 自分が開いたセッション一覧.
+//
+// GET /talksessions/opened
+func (s *Server) handleGetOpenedTalkSessionRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getOpenedTalkSession"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/talksessions/opened"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetOpenedTalkSessionOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetOpenedTalkSessionOperation,
+			ID:   "getOpenedTalkSession",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetOpenedTalkSessionOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, GetOpenedTalkSessionOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetOpenedTalkSessionParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetOpenedTalkSessionRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetOpenedTalkSessionOperation,
+			OperationSummary: "自分が開いたセッション一覧",
+			OperationID:      "getOpenedTalkSession",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+				{
+					Name: "theme",
+					In:   "query",
+				}: params.Theme,
+				{
+					Name: "status",
+					In:   "query",
+				}: params.Status,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetOpenedTalkSessionParams
+			Response = GetOpenedTalkSessionRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetOpenedTalkSessionParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetOpenedTalkSession(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetOpenedTalkSession(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetOpenedTalkSessionResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetOpinionAnalysisRequest handles getOpinionAnalysis operation.
+//
+// WeightByを指定すると、セッションを作成した組織が登録した母集団の構成に合わせて重みを付けた割合も返す。
+// 重みはその属性でのセッションの参加者全体の構成から求める。
+// 参加者の属性を使うため、weightByの指定はセッションを作成した組織のメンバーに限る.
+//
+// GET /opinions/{opinionID}/analysis
+func (s *Server) handleGetOpinionAnalysisRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getOpinionAnalysis"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/opinions/{opinionID}/analysis"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetOpinionAnalysisOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetOpinionAnalysisOperation,
+			ID:   "getOpinionAnalysis",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetOpinionAnalysisOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetOpinionAnalysisParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetOpinionAnalysisRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetOpinionAnalysisOperation,
+			OperationSummary: "意見に投票したグループごとの割合",
+			OperationID:      "getOpinionAnalysis",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "opinionID",
+					In:   "path",
+				}: params.OpinionID,
+				{
+					Name: "weightBy",
+					In:   "query",
+				}: params.WeightBy,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetOpinionAnalysisParams
+			Response = GetOpinionAnalysisRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetOpinionAnalysisParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetOpinionAnalysis(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetOpinionAnalysis(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetOpinionAnalysisResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetOpinionDetail2Request handles getOpinionDetail2 operation.
+//
+// 意見詳細.
+//
+// GET /opinions/{opinionID}
+func (s *Server) handleGetOpinionDetail2Request(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getOpinionDetail2"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/opinions/{opinionID}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetOpinionDetail2Operation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetOpinionDetail2Operation,
+			ID:   "getOpinionDetail2",
+		}
+	)
+	params, err := decodeGetOpinionDetail2Params(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetOpinionDetail2Res
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetOpinionDetail2Operation,
+			OperationSummary: "意見詳細",
+			OperationID:      "getOpinionDetail2",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "opinionID",
+					In:   "path",
+				}: params.OpinionID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetOpinionDetail2Params
+			Response = GetOpinionDetail2Res
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetOpinionDetail2Params,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetOpinionDetail2(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetOpinionDetail2(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetOpinionDetail2Response(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetOpinionReportReasonsRequest handles getOpinionReportReasons operation.
+//
+// 意見への通報理由一覧.
+//
+// GET /opinions/report_reasons
+func (s *Server) handleGetOpinionReportReasonsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getOpinionReportReasons"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/opinions/report_reasons"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetOpinionReportReasonsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err error
+	)
+
+	var response GetOpinionReportReasonsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetOpinionReportReasonsOperation,
+			OperationSummary: "意見への通報理由一覧",
+			OperationID:      "getOpinionReportReasons",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = GetOpinionReportReasonsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetOpinionReportReasons(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetOpinionReportReasons(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetOpinionReportReasonsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetOpinionReportsRequest handles getOpinionReports operation.
+//
+// セッション作成者しか取得できない.
+//
+// GET /opinions/{opinionID}/reports
+func (s *Server) handleGetOpinionReportsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getOpinionReports"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/opinions/{opinionID}/reports"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetOpinionReportsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetOpinionReportsOperation,
+			ID:   "getOpinionReports",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetOpinionReportsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, GetOpinionReportsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetOpinionReportsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetOpinionReportsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetOpinionReportsOperation,
+			OperationSummary: "意見に対する通報取得",
+			OperationID:      "getOpinionReports",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "opinionID",
+					In:   "path",
+				}: params.OpinionID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetOpinionReportsParams
+			Response = GetOpinionReportsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetOpinionReportsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetOpinionReports(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetOpinionReports(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetOpinionReportsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetOpinionRevisionsRequest handles getOpinionRevisions operation.
+//
+// 投稿者と、セッションのオーナー・通報に対応できる共同管理者のみ取得できる。削除された意見の内容も返す.
+//
+// GET /opinions/{opinionID}/revisions
+func (s *Server) handleGetOpinionRevisionsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getOpinionRevisions"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/opinions/{opinionID}/revisions"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetOpinionRevisionsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetOpinionRevisionsOperation,
+			ID:   "getOpinionRevisions",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetOpinionRevisionsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, GetOpinionRevisionsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetOpinionRevisionsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetOpinionRevisionsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetOpinionRevisionsOperation,
+			OperationSummary: "意見の編集履歴",
+			OperationID:      "getOpinionRevisions",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "opinionID",
+					In:   "path",
+				}: params.OpinionID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetOpinionRevisionsParams
+			Response = GetOpinionRevisionsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetOpinionRevisionsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetOpinionRevisions(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetOpinionRevisions(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetOpinionRevisionsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetOpinionsForTalkSessionRequest handles getOpinionsForTalkSession operation.
+//
+// セッションに対する意見一覧.
+//
+// GET /talksessions/{talkSessionID}/opinions
+func (s *Server) handleGetOpinionsForTalkSessionRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getOpinionsForTalkSession"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/opinions"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetOpinionsForTalkSessionOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetOpinionsForTalkSessionOperation,
+			ID:   "getOpinionsForTalkSession",
+		}
+	)
+	params, err := decodeGetOpinionsForTalkSessionParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetOpinionsForTalkSessionRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetOpinionsForTalkSessionOperation,
+			OperationSummary: "セッションに対する意見一覧",
+			OperationID:      "getOpinionsForTalkSession",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "talkSessionID",
+					In:   "path",
+				}: params.TalkSessionID,
+				{
+					Name: "sort",
+					In:   "query",
+				}: params.Sort,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+				{
+					Name: "seed",
+					In:   "query",
+				}: params.Seed,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetOpinionsForTalkSessionParams
+			Response = GetOpinionsForTalkSessionRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetOpinionsForTalkSessionParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetOpinionsForTalkSession(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetOpinionsForTalkSession(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetOpinionsForTalkSessionResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetOrganizationAliasesRequest handles getOrganizationAliases operation.
+//
+// 組織エイリアス一覧取得.
+//
+// GET /organizations/aliases
+func (s *Server) handleGetOrganizationAliasesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getOrganizationAliases"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/organizations/aliases"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetOrganizationAliasesOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetOrganizationAliasesOperation,
+			ID:   "getOrganizationAliases",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetOrganizationAliasesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, GetOrganizationAliasesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var response GetOrganizationAliasesRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetOrganizationAliasesOperation,
+			OperationSummary: "組織エイリアス一覧取得",
+			OperationID:      "getOrganizationAliases",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = GetOrganizationAliasesRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetOrganizationAliases(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetOrganizationAliases(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetOrganizationAliasesResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetOrganizationApiKeysRequest handles getOrganizationApiKeys operation.
+//
+// 組織APIキー一覧取得.
+//
+// GET /organizations/api-keys
+func (s *Server) handleGetOrganizationApiKeysRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getOrganizationApiKeys"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/organizations/api-keys"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetOrganizationApiKeysOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetOrganizationApiKeysOperation,
+			ID:   "getOrganizationApiKeys",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetOrganizationApiKeysOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, GetOrganizationApiKeysOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var response GetOrganizationApiKeysRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetOrganizationApiKeysOperation,
+			OperationSummary: "組織APIキー一覧取得",
+			OperationID:      "getOrganizationApiKeys",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = GetOrganizationApiKeysRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetOrganizationApiKeys(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetOrganizationApiKeys(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetOrganizationApiKeysResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetOrganizationAuditLogsRequest handles getOrganizationAuditLogs operation.
+//
+// 組織の監査ログを新しい順に取得する。オーナー以上の権限が必要。
+// 運営（ことひろ組織のスーパー管理者）はorganizationIDを指定して他の組織の監査ログを取得できる。
+// sinceは指定時刻を含み、untilは指定時刻を含まない。.
+//
+// GET /organizations/audit-logs
+func (s *Server) handleGetOrganizationAuditLogsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getOrganizationAuditLogs"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/organizations/audit-logs"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetOrganizationAuditLogsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetOrganizationAuditLogsOperation,
+			ID:   "getOrganizationAuditLogs",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetOrganizationAuditLogsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, GetOrganizationAuditLogsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetOrganizationAuditLogsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetOrganizationAuditLogsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetOrganizationAuditLogsOperation,
+			OperationSummary: "組織の監査ログ一覧取得",
+			OperationID:      "getOrganizationAuditLogs",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "organizationID",
+					In:   "query",
+				}: params.OrganizationID,
+				{
+					Name: "action",
+					In:   "query",
+				}: params.Action,
+				{
+					Name: "actorID",
+					In:   "query",
+				}: params.ActorID,
+				{
+					Name: "since",
+					In:   "query",
+				}: params.Since,
+				{
+					Name: "until",
+					In:   "query",
+				}: params.Until,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetOrganizationAuditLogsParams
+			Response = GetOrganizationAuditLogsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetOrganizationAuditLogsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetOrganizationAuditLogs(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetOrganizationAuditLogs(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetOrganizationAuditLogsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetOrganizationChildrenRequest handles getOrganizationChildren operation.
+//
+// 直下の子組織を取得する。
+// 現在の組織またはその子孫の組織を指定できる。.
+//
+// GET /organizations/{code}/children
+func (s *Server) handleGetOrganizationChildrenRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getOrganizationChildren"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/organizations/{code}/children"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetOrganizationChildrenOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetOrganizationChildrenOperation,
+			ID:   "getOrganizationChildren",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetOrganizationChildrenOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, GetOrganizationChildrenOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetOrganizationChildrenParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetOrganizationChildrenRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetOrganizationChildrenOperation,
+			OperationSummary: "子組織一覧",
+			OperationID:      "getOrganizationChildren",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "code",
+					In:   "path",
+				}: params.Code,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetOrganizationChildrenParams
+			Response = GetOrganizationChildrenRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetOrganizationChildrenParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetOrganizationChildren(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetOrganizationChildren(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetOrganizationChildrenResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetOrganizationInvitationsRequest handles getOrganizationInvitations operation.
+//
+// 組織への招待一覧取得.
+//
+// GET /organizations/invitations
+func (s *Server) handleGetOrganizationInvitationsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getOrganizationInvitations"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/organizations/invitations"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetOrganizationInvitationsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetOrganizationInvitationsOperation,
+			ID:   "getOrganizationInvitations",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetOrganizationInvitationsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, GetOrganizationInvitationsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var response GetOrganizationInvitationsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetOrganizationInvitationsOperation,
+			OperationSummary: "組織への招待一覧取得",
+			OperationID:      "getOrganizationInvitations",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = GetOrganizationInvitationsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetOrganizationInvitations(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetOrganizationInvitations(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetOrganizationInvitationsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetOrganizationReferenceDemographicsRequest handles getOrganizationReferenceDemographics operation.
+//
+// 参加者の代表性を確かめるために組織が登録した、属性ごとの母集団の構成を取得する。管理者以上の権限が必要.
+//
+// GET /organizations/{code}/reference-demographics
+func (s *Server) handleGetOrganizationReferenceDemographicsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getOrganizationReferenceDemographics"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/organizations/{code}/reference-demographics"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetOrganizationReferenceDemographicsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetOrganizationReferenceDemographicsOperation,
+			ID:   "getOrganizationReferenceDemographics",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetOrganizationReferenceDemographicsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, GetOrganizationReferenceDemographicsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetOrganizationReferenceDemographicsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetOrganizationReferenceDemographicsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetOrganizationReferenceDemographicsOperation,
+			OperationSummary: "母集団の構成",
+			OperationID:      "getOrganizationReferenceDemographics",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "code",
+					In:   "path",
+				}: params.Code,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetOrganizationReferenceDemographicsParams
+			Response = GetOrganizationReferenceDemographicsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetOrganizationReferenceDemographicsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetOrganizationReferenceDemographics(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetOrganizationReferenceDemographics(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetOrganizationReferenceDemographicsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetOrganizationReportPromptRequest handles getOrganizationReportPrompt operation.
+//
+// レポートをLLMで生成する際の組織の指示を取得する。管理者以上の権限が必要.
+//
+// GET /organizations/{code}/report-prompt
+func (s *Server) handleGetOrganizationReportPromptRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getOrganizationReportPrompt"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/organizations/{code}/report-prompt"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetOrganizationReportPromptOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetOrganizationReportPromptOperation,
+			ID:   "getOrganizationReportPrompt",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetOrganizationReportPromptOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, GetOrganizationReportPromptOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetOrganizationReportPromptParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetOrganizationReportPromptRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetOrganizationReportPromptOperation,
+			OperationSummary: "レポート生成の指示",
+			OperationID:      "getOrganizationReportPrompt",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "code",
+					In:   "path",
+				}: params.Code,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetOrganizationReportPromptParams
+			Response = GetOrganizationReportPromptRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetOrganizationReportPromptParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetOrganizationReportPrompt(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetOrganizationReportPrompt(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetOrganizationReportPromptResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetOrganizationStatsRequest handles getOrganizationStats operation.
+//
+// 組織のセッション・参加者・意見・投票・同意・レポートへのフィードバックの件数を集計する。
+// bucketで日・週（月曜日始まり）・月単位に集計し、sinceとuntilを省略した場合は直近30日・12週・12ヶ月を返す。
+// オーナー以上の権限が必要で、現在の組織またはその子孫の組織を指定できる。.
+//
+// GET /organizations/{code}/stats
+func (s *Server) handleGetOrganizationStatsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getOrganizationStats"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/organizations/{code}/stats"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetOrganizationStatsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetOrganizationStatsOperation,
+			ID:   "getOrganizationStats",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetOrganizationStatsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, GetOrganizationStatsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetOrganizationStatsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetOrganizationStatsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetOrganizationStatsOperation,
+			OperationSummary: "組織のダッシュボード",
+			OperationID:      "getOrganizationStats",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "code",
+					In:   "path",
+				}: params.Code,
+				{
+					Name: "bucket",
+					In:   "query",
+				}: params.Bucket,
+				{
+					Name: "since",
+					In:   "query",
+				}: params.Since,
+				{
+					Name: "until",
+					In:   "query",
+				}: params.Until,
+				{
+					Name: "includeDescendants",
+					In:   "query",
+				}: params.IncludeDescendants,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetOrganizationStatsParams
+			Response = GetOrganizationStatsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetOrganizationStatsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetOrganizationStats(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetOrganizationStats(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetOrganizationStatsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetOrganizationTalkSessionsRequest handles getOrganizationTalkSessions operation.
+//
+// 組織のセッションを新しい順に取得する。
+// includeDescendantsを指定すると子孫の組織のセッションもまとめて取得する。
+// 現在の組織またはその子孫の組織を指定できる。.
+//
+// GET /organizations/{code}/talksessions
+func (s *Server) handleGetOrganizationTalkSessionsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getOrganizationTalkSessions"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/organizations/{code}/talksessions"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetOrganizationTalkSessionsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetOrganizationTalkSessionsOperation,
+			ID:   "getOrganizationTalkSessions",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetOrganizationTalkSessionsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, GetOrganizationTalkSessionsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetOrganizationTalkSessionsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetOrganizationTalkSessionsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetOrganizationTalkSessionsOperation,
+			OperationSummary: "組織のセッション一覧",
+			OperationID:      "getOrganizationTalkSessions",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "code",
+					In:   "path",
+				}: params.Code,
+				{
+					Name: "includeDescendants",
+					In:   "query",
+				}: params.IncludeDescendants,
+				{
+					Name: "status",
+					In:   "query",
+				}: params.Status,
+				{
+					Name: "theme",
+					In:   "query",
+				}: params.Theme,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetOrganizationTalkSessionsParams
+			Response = GetOrganizationTalkSessionsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetOrganizationTalkSessionsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetOrganizationTalkSessions(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetOrganizationTalkSessions(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetOrganizationTalkSessionsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetOrganizationUsersRequest handles getOrganizationUsers operation.
+//
+// 現在の組織のユーザー一覧取得.
+//
+// GET /organizations/users
+func (s *Server) handleGetOrganizationUsersRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getOrganizationUsers"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/organizations/users"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetOrganizationUsersOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetOrganizationUsersOperation,
+			ID:   "getOrganizationUsers",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetOrganizationUsersOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, GetOrganizationUsersOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var response GetOrganizationUsersRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetOrganizationUsersOperation,
+			OperationSummary: "現在の組織のユーザー一覧取得",
+			OperationID:      "getOrganizationUsers",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = GetOrganizationUsersRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetOrganizationUsers(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetOrganizationUsers(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetOrganizationUsersResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetOrganizationsRequest handles getOrganizations operation.
+//
+// 所属組織一覧.
+//
+// GET /organizations
+func (s *Server) handleGetOrganizationsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getOrganizations"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/organizations"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetOrganizationsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetOrganizationsOperation,
+			ID:   "getOrganizations",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetOrganizationsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, GetOrganizationsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var response GetOrganizationsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetOrganizationsOperation,
+			OperationSummary: "所属組織一覧",
+			OperationID:      "getOrganizations",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = GetOrganizationsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetOrganizations(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetOrganizations(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetOrganizationsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetPolicyConsentStatusRequest handles getPolicyConsentStatus operation.
+//
+// 最新のポリシーに同意したかを取得.
+//
+// GET /policy/consent
+func (s *Server) handleGetPolicyConsentStatusRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getPolicyConsentStatus"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/policy/consent"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetPolicyConsentStatusOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err error
+	)
+
+	var response GetPolicyConsentStatusRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetPolicyConsentStatusOperation,
+			OperationSummary: "最新のポリシーに同意したかを取得",
+			OperationID:      "getPolicyConsentStatus",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = GetPolicyConsentStatusRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetPolicyConsentStatus(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetPolicyConsentStatus(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetPolicyConsentStatusResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetReportVersionDiffManageRequest handles getReportVersionDiffManage operation.
+//
+// GET /v1/manage/talksessions/{talkSessionID}/analysis/report/versions/diff
+func (s *Server) handleGetReportVersionDiffManageRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getReportVersionDiffManage"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v1/manage/talksessions/{talkSessionID}/analysis/report/versions/diff"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetReportVersionDiffManageOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetReportVersionDiffManageOperation,
+			ID:   "getReportVersionDiffManage",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetReportVersionDiffManageOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, GetReportVersionDiffManageOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetReportVersionDiffManageParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *ReportVersionDiff
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetReportVersionDiffManageOperation,
+			OperationSummary: "",
+			OperationID:      "getReportVersionDiffManage",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "talkSessionID",
+					In:   "path",
+				}: params.TalkSessionID,
+				{
+					Name: "from",
+					In:   "query",
+				}: params.From,
+				{
+					Name: "to",
+					In:   "query",
+				}: params.To,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetReportVersionDiffManageParams
+			Response = *ReportVersionDiff
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetReportVersionDiffManageParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetReportVersionDiffManage(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetReportVersionDiffManage(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetReportVersionDiffManageResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetReportVersionsManageRequest handles getReportVersionsManage operation.
+//
+// GET /v1/manage/talksessions/{talkSessionID}/analysis/report/versions
+func (s *Server) handleGetReportVersionsManageRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getReportVersionsManage"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v1/manage/talksessions/{talkSessionID}/analysis/report/versions"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetReportVersionsManageOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetReportVersionsManageOperation,
+			ID:   "getReportVersionsManage",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetReportVersionsManageOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, GetReportVersionsManageOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetReportVersionsManageParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response []ReportVersionForManage
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetReportVersionsManageOperation,
+			OperationSummary: "",
+			OperationID:      "getReportVersionsManage",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "talkSessionID",
+					In:   "path",
+				}: params.TalkSessionID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetReportVersionsManageParams
+			Response = []ReportVersionForManage
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetReportVersionsManageParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetReportVersionsManage(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetReportVersionsManage(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetReportVersionsManageResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetReportsForTalkSessionRequest handles getReportsForTalkSession operation.
+//
+// 通報一覧.
+//
+// GET /talksessions/{talkSessionID}/reports
+func (s *Server) handleGetReportsForTalkSessionRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getReportsForTalkSession"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/reports"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetReportsForTalkSessionOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetReportsForTalkSessionOperation,
+			ID:   "getReportsForTalkSession",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetReportsForTalkSessionOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, GetReportsForTalkSessionOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetReportsForTalkSessionParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetReportsForTalkSessionRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetReportsForTalkSessionOperation,
+			OperationSummary: "通報一覧",
+			OperationID:      "getReportsForTalkSession",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "talkSessionID",
+					In:   "path",
+				}: params.TalkSessionID,
+				{
+					Name: "status",
+					In:   "query",
+				}: params.Status,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetReportsForTalkSessionParams
+			Response = GetReportsForTalkSessionRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetReportsForTalkSessionParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetReportsForTalkSession(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetReportsForTalkSession(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetReportsForTalkSessionResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetSigningKeysManageRequest handles getSigningKeysManage operation.
+//
+// GET /v1/manage/auth/signing-keys
+func (s *Server) handleGetSigningKeysManageRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getSigningKeysManage"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v1/manage/auth/signing-keys"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetSigningKeysManageOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetSigningKeysManageOperation,
+			ID:   "getSigningKeysManage",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetSigningKeysManageOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, GetSigningKeysManageOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var response []SigningKeyForManage
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetSigningKeysManageOperation,
+			OperationSummary: "",
+			OperationID:      "getSigningKeysManage",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = []SigningKeyForManage
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetSigningKeysManage(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetSigningKeysManage(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetSigningKeysManageResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetTalkSessionCollaboratorsRequest handles getTalkSessionCollaborators operation.
+//
+// セッションのオーナーと共同管理者の一覧を返す。オーナーと共同管理者のみ取得できる.
+//
+// GET /talksessions/{talkSessionID}/collaborators
+func (s *Server) handleGetTalkSessionCollaboratorsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getTalkSessionCollaborators"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/collaborators"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetTalkSessionCollaboratorsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetTalkSessionCollaboratorsOperation,
+			ID:   "getTalkSessionCollaborators",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetTalkSessionCollaboratorsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, GetTalkSessionCollaboratorsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetTalkSessionCollaboratorsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetTalkSessionCollaboratorsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetTalkSessionCollaboratorsOperation,
+			OperationSummary: "セッションの共同管理者一覧",
+			OperationID:      "getTalkSessionCollaborators",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "talkSessionID",
+					In:   "path",
+				}: params.TalkSessionID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetTalkSessionCollaboratorsParams
+			Response = GetTalkSessionCollaboratorsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetTalkSessionCollaboratorsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetTalkSessionCollaborators(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetTalkSessionCollaborators(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetTalkSessionCollaboratorsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetTalkSessionDetailRequest handles getTalkSessionDetail operation.
+//
+// トークセッションの詳細.
+//
+// GET /talksessions/{talkSessionID}
+func (s *Server) handleGetTalkSessionDetailRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getTalkSessionDetail"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetTalkSessionDetailOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetTalkSessionDetailOperation,
+			ID:   "getTalkSessionDetail",
+		}
+	)
+	params, err := decodeGetTalkSessionDetailParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetTalkSessionDetailRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetTalkSessionDetailOperation,
+			OperationSummary: "トークセッションの詳細",
+			OperationID:      "getTalkSessionDetail",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "talkSessionID",
+					In:   "path",
+				}: params.TalkSessionID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetTalkSessionDetailParams
+			Response = GetTalkSessionDetailRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetTalkSessionDetailParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetTalkSessionDetail(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetTalkSessionDetail(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetTalkSessionDetailResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetTalkSessionListRequest handles getTalkSessionList operation.
+//
+// セッション一覧.
+//
+// GET /talksessions
+func (s *Server) handleGetTalkSessionListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getTalkSessionList"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/talksessions"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetTalkSessionListOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetTalkSessionListOperation,
+			ID:   "getTalkSessionList",
+		}
+	)
+	params, err := decodeGetTalkSessionListParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetTalkSessionListRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetTalkSessionListOperation,
+			OperationSummary: "セッション一覧",
+			OperationID:      "getTalkSessionList",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+				{
+					Name: "theme",
+					In:   "query",
+				}: params.Theme,
+				{
+					Name: "status",
+					In:   "query",
+				}: params.Status,
+				{
+					Name: "sortKey",
+					In:   "query",
+				}: params.SortKey,
+				{
+					Name: "latitude",
+					In:   "query",
+				}: params.Latitude,
+				{
+					Name: "longitude",
+					In:   "query",
+				}: params.Longitude,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetTalkSessionListParams
+			Response = GetTalkSessionListRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetTalkSessionListParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetTalkSessionList(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetTalkSessionList(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetTalkSessionListResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetTalkSessionListManageRequest handles getTalkSessionListManage operation.
+//
+// GET /v1/manage/talksessions/list
+func (s *Server) handleGetTalkSessionListManageRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getTalkSessionListManage"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v1/manage/talksessions/list"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetTalkSessionListManageOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetTalkSessionListManageOperation,
+			ID:   "getTalkSessionListManage",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetTalkSessionListManageOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, GetTalkSessionListManageOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetTalkSessionListManageParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *TalkSessionListResponse
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetTalkSessionListManageOperation,
+			OperationSummary: "",
+			OperationID:      "getTalkSessionListManage",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "status",
+					In:   "query",
+				}: params.Status,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetTalkSessionListManageParams
+			Response = *TalkSessionListResponse
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetTalkSessionListManageParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetTalkSessionListManage(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetTalkSessionListManage(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetTalkSessionListManageResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetTalkSessionManageRequest handles getTalkSessionManage operation.
+//
+// GET /v1/manage/talksessions/{talkSessionID}
+func (s *Server) handleGetTalkSessionManageRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getTalkSessionManage"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v1/manage/talksessions/{talkSessionID}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetTalkSessionManageOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetTalkSessionManageOperation,
+			ID:   "getTalkSessionManage",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetTalkSessionManageOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, GetTalkSessionManageOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetTalkSessionManageParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response *TalkSessionForManage
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetTalkSessionManageOperation,
+			OperationSummary: "",
+			OperationID:      "getTalkSessionManage",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "talkSessionID",
+					In:   "path",
+				}: params.TalkSessionID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetTalkSessionManageParams
+			Response = *TalkSessionForManage
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetTalkSessionManageParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetTalkSessionManage(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetTalkSessionManage(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetTalkSessionManageResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetTalkSessionReportRequest handles getTalkSessionReport operation.
+//
+// セッションレポートを返す.
+//
+// GET /talksessions/{talkSessionID}/report
+func (s *Server) handleGetTalkSessionReportRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getTalkSessionReport"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/report"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetTalkSessionReportOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetTalkSessionReportOperation,
+			ID:   "getTalkSessionReport",
+		}
+	)
+	params, err := decodeGetTalkSessionReportParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetTalkSessionReportRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetTalkSessionReportOperation,
+			OperationSummary: "セッションレポートを返す",
+			OperationID:      "getTalkSessionReport",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "talkSessionID",
+					In:   "path",
+				}: params.TalkSessionID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetTalkSessionReportParams
+			Response = GetTalkSessionReportRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetTalkSessionReportParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetTalkSessionReport(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetTalkSessionReport(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetTalkSessionReportResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetTalkSessionReportCountRequest handles getTalkSessionReportCount operation.
+//
+// 通報件数.
+//
+// GET /talksessions/{talkSessionID}/reports/count
+func (s *Server) handleGetTalkSessionReportCountRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getTalkSessionReportCount"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/reports/count"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetTalkSessionReportCountOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetTalkSessionReportCountOperation,
+			ID:   "getTalkSessionReportCount",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetTalkSessionReportCountOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, GetTalkSessionReportCountOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetTalkSessionReportCountParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetTalkSessionReportCountRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetTalkSessionReportCountOperation,
+			OperationSummary: "通報件数",
+			OperationID:      "getTalkSessionReportCount",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "talkSessionID",
+					In:   "path",
+				}: params.TalkSessionID,
+				{
+					Name: "status",
+					In:   "query",
+				}: params.Status,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetTalkSessionReportCountParams
+			Response = GetTalkSessionReportCountRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetTalkSessionReportCountParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetTalkSessionReportCount(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetTalkSessionReportCount(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetTalkSessionReportCountResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetTalkSessionRepresentativenessRequest handles getTalkSessionRepresentativeness operation.
+//
+// 組織のセッションの参加者の構成を、組織が登録した母集団の構成と属性ごとに比べる。管理者以上の権限が必要。
+// 参加者は意見を投稿したか投票したユーザーで、属性は登録時のものを使う.
+//
+// GET /organizations/{code}/talksessions/{talkSessionID}/representativeness
+func (s *Server) handleGetTalkSessionRepresentativenessRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getTalkSessionRepresentativeness"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/organizations/{code}/talksessions/{talkSessionID}/representativeness"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetTalkSessionRepresentativenessOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetTalkSessionRepresentativenessOperation,
+			ID:   "getTalkSessionRepresentativeness",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetTalkSessionRepresentativenessOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, GetTalkSessionRepresentativenessOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetTalkSessionRepresentativenessParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetTalkSessionRepresentativenessRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetTalkSessionRepresentativenessOperation,
+			OperationSummary: "参加者の代表性",
+			OperationID:      "getTalkSessionRepresentativeness",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "code",
+					In:   "path",
+				}: params.Code,
+				{
+					Name: "talkSessionID",
+					In:   "path",
+				}: params.TalkSessionID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetTalkSessionRepresentativenessParams
+			Response = GetTalkSessionRepresentativenessRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetTalkSessionRepresentativenessParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetTalkSessionRepresentativeness(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetTalkSessionRepresentativeness(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetTalkSessionRepresentativenessResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetTalkSessionRestrictionKeysRequest handles getTalkSessionRestrictionKeys operation.
+//
+// セッションの投稿制限に使用できるキーの一覧を返す.
+//
+// GET /talksessions/restrictions
+func (s *Server) handleGetTalkSessionRestrictionKeysRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getTalkSessionRestrictionKeys"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/talksessions/restrictions"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetTalkSessionRestrictionKeysOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err error
+	)
+
+	var response GetTalkSessionRestrictionKeysRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetTalkSessionRestrictionKeysOperation,
+			OperationSummary: "セッションで指定可能な制限一覧",
+			OperationID:      "getTalkSessionRestrictionKeys",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = GetTalkSessionRestrictionKeysRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetTalkSessionRestrictionKeys(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetTalkSessionRestrictionKeys(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetTalkSessionRestrictionKeysResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetTalkSessionRestrictionSatisfiedRequest handles getTalkSessionRestrictionSatisfied operation.
+//
+// 特定のセッションで満たしていない条件があれば返す.
+//
+// GET /talksessions/{talkSessionID}/restrictions
+func (s *Server) handleGetTalkSessionRestrictionSatisfiedRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getTalkSessionRestrictionSatisfied"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/restrictions"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetTalkSessionRestrictionSatisfiedOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetTalkSessionRestrictionSatisfiedOperation,
+			ID:   "getTalkSessionRestrictionSatisfied",
+		}
+	)
+	params, err := decodeGetTalkSessionRestrictionSatisfiedParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetTalkSessionRestrictionSatisfiedRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetTalkSessionRestrictionSatisfiedOperation,
+			OperationSummary: "セッションで満たしていない制限",
+			OperationID:      "getTalkSessionRestrictionSatisfied",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "talkSessionID",
+					In:   "path",
+				}: params.TalkSessionID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetTalkSessionRestrictionSatisfiedParams
+			Response = GetTalkSessionRestrictionSatisfiedRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetTalkSessionRestrictionSatisfiedParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetTalkSessionRestrictionSatisfied(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetTalkSessionRestrictionSatisfied(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetTalkSessionRestrictionSatisfiedResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetTalkSessionTemplatesRequest handles getTalkSessionTemplates operation.
+//
+// 組織のセッションテンプレート一覧.
+//
+// GET /organizations/{code}/talksession-templates
+func (s *Server) handleGetTalkSessionTemplatesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getTalkSessionTemplates"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/organizations/{code}/talksession-templates"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetTalkSessionTemplatesOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetTalkSessionTemplatesOperation,
+			ID:   "getTalkSessionTemplates",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetTalkSessionTemplatesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, GetTalkSessionTemplatesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetTalkSessionTemplatesParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetTalkSessionTemplatesRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetTalkSessionTemplatesOperation,
+			OperationSummary: "組織のセッションテンプレート一覧",
+			OperationID:      "getTalkSessionTemplates",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "code",
+					In:   "path",
+				}: params.Code,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetTalkSessionTemplatesParams
+			Response = GetTalkSessionTemplatesRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetTalkSessionTemplatesParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetTalkSessionTemplates(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetTalkSessionTemplates(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetTalkSessionTemplatesResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetTermFrequenciesRequest handles getTermFrequencies operation.
+//
+// 意見を形態素解析して集計した単語を返す。ワードクラウドをアプリケーション内で生成した場合のみ集計がある。
+// グループごとの単語は、グループの意見をまとめて1つの文書とみなしたTF-IDFで並べる.
+//
+// GET /talksessions/{talkSessionID}/analysis/terms
+func (s *Server) handleGetTermFrequenciesRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getTermFrequencies"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/analysis/terms"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetTermFrequenciesOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetTermFrequenciesOperation,
+			ID:   "getTermFrequencies",
+		}
+	)
+	params, err := decodeGetTermFrequenciesParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetTermFrequenciesRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetTermFrequenciesOperation,
+			OperationSummary: "ワードクラウドの単語の集計",
+			OperationID:      "getTermFrequencies",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "talkSessionID",
+					In:   "path",
+				}: params.TalkSessionID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetTermFrequenciesParams
+			Response = GetTermFrequenciesRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetTermFrequenciesParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetTermFrequencies(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetTermFrequencies(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetTermFrequenciesResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetTimeLineRequest handles getTimeLine operation.
+//
+// タイムラインはセッション終了後にセッション作成者が設定できるその後の予定を知らせるもの.
+//
+// GET /talksessions/{talkSessionID}/timelines
+func (s *Server) handleGetTimeLineRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getTimeLine"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/timelines"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetTimeLineOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetTimeLineOperation,
+			ID:   "getTimeLine",
+		}
+	)
+	params, err := decodeGetTimeLineParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetTimeLineRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetTimeLineOperation,
+			OperationSummary: "タイムライン取得",
+			OperationID:      "getTimeLine",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "talkSessionID",
+					In:   "path",
+				}: params.TalkSessionID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetTimeLineParams
+			Response = GetTimeLineRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetTimeLineParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetTimeLine(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetTimeLine(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetTimeLineResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetTokenInfoRequest handles getTokenInfo operation.
+//
+// JWTの内容を返してくれる.
+//
+// GET /auth/token/info
+func (s *Server) handleGetTokenInfoRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getTokenInfo"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/auth/token/info"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetTokenInfoOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetTokenInfoOperation,
+			ID:   "getTokenInfo",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetTokenInfoOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, GetTokenInfoOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var response GetTokenInfoRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetTokenInfoOperation,
+			OperationSummary: "JWTの内容を返してくれる",
+			OperationID:      "getTokenInfo",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = GetTokenInfoRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetTokenInfo(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetTokenInfo(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetTokenInfoResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetTopicsRequest handles getTopics operation.
+//
+// 意見の本文をTF-IDFで重み付けしてk-meansでトピックに分け、トピックごとの投票数を返す。
+// 投票によるグループ分けとは別に、どのような話題の意見があるかを示す.
+//
+// GET /talksessions/{talkSessionID}/topics
+func (s *Server) handleGetTopicsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getTopics"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/topics"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetTopicsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetTopicsOperation,
+			ID:   "getTopics",
+		}
+	)
+	params, err := decodeGetTopicsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetTopicsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetTopicsOperation,
+			OperationSummary: "意見のトピック",
+			OperationID:      "getTopics",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "talkSessionID",
+					In:   "path",
+				}: params.TalkSessionID,
+				{
+					Name: "opinionLimit",
+					In:   "query",
+				}: params.OpinionLimit,
+				{
+					Name: "opinionOffset",
+					In:   "query",
+				}: params.OpinionOffset,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetTopicsParams
+			Response = GetTopicsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetTopicsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetTopics(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetTopics(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetTopicsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetUserByDisplayIDRequest handles getUserByDisplayID operation.
+//
+// 表示IDからユーザー情報の取得.
+//
+// GET /user/{displayID}
+func (s *Server) handleGetUserByDisplayIDRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getUserByDisplayID"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/user/{displayID}"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetUserByDisplayIDOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetUserByDisplayIDOperation,
+			ID:   "getUserByDisplayID",
+		}
+	)
+	params, err := decodeGetUserByDisplayIDParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetUserByDisplayIDRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetUserByDisplayIDOperation,
+			OperationSummary: "表示IDからユーザー情報の取得",
+			OperationID:      "getUserByDisplayID",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "displayID",
+					In:   "path",
+				}: params.DisplayID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetUserByDisplayIDParams
+			Response = GetUserByDisplayIDRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetUserByDisplayIDParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetUserByDisplayID(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetUserByDisplayID(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetUserByDisplayIDResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetUserInfoRequest handles getUserInfo operation.
+//
+// ユーザー情報の取得.
+//
+// GET /user
+func (s *Server) handleGetUserInfoRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getUserInfo"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/user"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetUserInfoOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetUserInfoOperation,
+			ID:   "getUserInfo",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetUserInfoOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, GetUserInfoOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var response GetUserInfoRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetUserInfoOperation,
+			OperationSummary: "ユーザー情報の取得",
+			OperationID:      "getUserInfo",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = GetUserInfoRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetUserInfo(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetUserInfo(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetUserInfoResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetUserListManageRequest handles getUserListManage operation.
+//
+// GET /v1/manage/users/list
+func (s *Server) handleGetUserListManageRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getUserListManage"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v1/manage/users/list"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetUserListManageOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetUserListManageOperation,
+			ID:   "getUserListManage",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetUserListManageOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, GetUserListManageOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetUserListManageParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response []UserForManage
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetUserListManageOperation,
+			OperationSummary: "",
+			OperationID:      "getUserListManage",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "search",
+					In:   "query",
+				}: params.Search,
+				{
+					Name: "orderBy",
+					In:   "query",
+				}: params.OrderBy,
+				{
+					Name: "order",
+					In:   "query",
+				}: params.Order,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetUserListManageParams
+			Response = []UserForManage
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetUserListManageParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetUserListManage(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetUserListManage(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetUserListManageResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetUserStatsListManageRequest handles getUserStatsListManage operation.
+//
+// GET /v1/manage/users/stats/list
+func (s *Server) handleGetUserStatsListManageRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getUserStatsListManage"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v1/manage/users/stats/list"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetUserStatsListManageOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetUserStatsListManageOperation,
+			ID:   "getUserStatsListManage",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetUserStatsListManageOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, GetUserStatsListManageOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetUserStatsListManageParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response []UserStatsResponse
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetUserStatsListManageOperation,
+			OperationSummary: "",
+			OperationID:      "getUserStatsListManage",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "range",
+					In:   "query",
+				}: params.Range,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetUserStatsListManageParams
+			Response = []UserStatsResponse
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetUserStatsListManageParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetUserStatsListManage(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetUserStatsListManage(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetUserStatsListManageResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetUserStatsTotalManageRequest handles getUserStatsTotalManage operation.
+//
+// GET /v1/manage/users/stats/total
+func (s *Server) handleGetUserStatsTotalManageRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getUserStatsTotalManage"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/v1/manage/users/stats/total"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetUserStatsTotalManageOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetUserStatsTotalManageOperation,
+			ID:   "getUserStatsTotalManage",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetUserStatsTotalManageOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, GetUserStatsTotalManageOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var response *UserStatsResponse
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetUserStatsTotalManageOperation,
+			OperationSummary: "",
+			OperationID:      "getUserStatsTotalManage",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = *UserStatsResponse
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetUserStatsTotalManage(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetUserStatsTotalManage(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetUserStatsTotalManageResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetUserTalkSessionsRequest handles getUserTalkSessions operation.
+//
+// 特定ユーザが開いたセッション一覧.
+//
+// GET /users/{displayID}/talksessions
+func (s *Server) handleGetUserTalkSessionsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getUserTalkSessions"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/users/{displayID}/talksessions"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetUserTalkSessionsOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetUserTalkSessionsOperation,
+			ID:   "getUserTalkSessions",
+		}
+	)
+	params, err := decodeGetUserTalkSessionsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response GetUserTalkSessionsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetUserTalkSessionsOperation,
+			OperationSummary: "特定ユーザが開いたセッション一覧",
+			OperationID:      "getUserTalkSessions",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "displayID",
+					In:   "path",
+				}: params.DisplayID,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+				{
+					Name: "status",
+					In:   "query",
+				}: params.Status,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetUserTalkSessionsParams
+			Response = GetUserTalkSessionsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetUserTalkSessionsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetUserTalkSessions(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetUserTalkSessions(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetUserTalkSessionsResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetVapidKeyRequest handles getVapidKey operation.
+//
+// VAPID公開鍵取得.
+//
+// GET /notifications/vapid-key
+func (s *Server) handleGetVapidKeyRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("getVapidKey"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/notifications/vapid-key"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetVapidKeyOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetVapidKeyOperation,
+			ID:   "getVapidKey",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, GetVapidKeyOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, GetVapidKeyOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var response *GetVapidKeyOK
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetVapidKeyOperation,
+			OperationSummary: "VAPID公開鍵取得",
+			OperationID:      "getVapidKey",
+			Body:             nil,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = *GetVapidKeyOK
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetReportsForTalkSession(ctx, params)
+				response, err = s.h.GetVapidKey(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetReportsForTalkSession(ctx, params)
+		response, err = s.h.GetVapidKey(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -5046,7 +16188,7 @@ func (s *Server) handleGetReportsForTalkSessionRequest(args [1]string, argsEscap
 		return
 	}
 
-	if err := encodeGetReportsForTalkSessionResponse(response, w, span); err != nil {
+	if err := encodeGetVapidKeyResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5055,22 +16197,23 @@ func (s *Server) handleGetReportsForTalkSessionRequest(args [1]string, argsEscap
 	}
 }
 
-// handleGetTalkSessionDetailRequest handles getTalkSessionDetail operation.
+// handleGetVoteShiftsRequest handles getVoteShifts operation.
 //
-// トークセッションの詳細.
+// 投票の変更履歴から、ユーザーごとに最初の投票と最後の投票を比べた変化を集計する。
+// 最後の投票より前にその意見への返信に投票していれば、返信を読んでから変化したとみなす.
 //
-// GET /talksessions/{talkSessionID}
-func (s *Server) handleGetTalkSessionDetailRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /talksessions/{talkSessionID}/analysis/vote-shifts
+func (s *Server) handleGetVoteShiftsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getTalkSessionDetail"),
+		otelogen.OperationID("getVoteShifts"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}"),
+		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/analysis/vote-shifts"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetTalkSessionDetailOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), GetVoteShiftsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5125,11 +16268,11 @@ func (s *Server) handleGetTalkSessionDetailRequest(args [1]string, argsEscaped b
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetTalkSessionDetailOperation,
-			ID:   "getTalkSessionDetail",
+			Name: GetVoteShiftsOperation,
+			ID:   "getVoteShifts",
 		}
 	)
-	params, err := decodeGetTalkSessionDetailParams(args, argsEscaped, r)
+	params, err := decodeGetVoteShiftsParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -5140,13 +16283,13 @@ func (s *Server) handleGetTalkSessionDetailRequest(args [1]string, argsEscaped b
 		return
 	}
 
-	var response GetTalkSessionDetailRes
+	var response GetVoteShiftsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetTalkSessionDetailOperation,
-			OperationSummary: "トークセッションの詳細",
-			OperationID:      "getTalkSessionDetail",
+			OperationName:    GetVoteShiftsOperation,
+			OperationSummary: "投票の変化",
+			OperationID:      "getVoteShifts",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
@@ -5159,8 +16302,8 @@ func (s *Server) handleGetTalkSessionDetailRequest(args [1]string, argsEscaped b
 
 		type (
 			Request  = struct{}
-			Params   = GetTalkSessionDetailParams
-			Response = GetTalkSessionDetailRes
+			Params   = GetVoteShiftsParams
+			Response = GetVoteShiftsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5169,14 +16312,14 @@ func (s *Server) handleGetTalkSessionDetailRequest(args [1]string, argsEscaped b
 		](
 			m,
 			mreq,
-			unpackGetTalkSessionDetailParams,
+			unpackGetVoteShiftsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetTalkSessionDetail(ctx, params)
+				response, err = s.h.GetVoteShifts(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetTalkSessionDetail(ctx, params)
+		response, err = s.h.GetVoteShifts(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -5184,7 +16327,7 @@ func (s *Server) handleGetTalkSessionDetailRequest(args [1]string, argsEscaped b
 		return
 	}
 
-	if err := encodeGetTalkSessionDetailResponse(response, w, span); err != nil {
+	if err := encodeGetVoteShiftsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5193,22 +16336,22 @@ func (s *Server) handleGetTalkSessionDetailRequest(args [1]string, argsEscaped b
 	}
 }
 
-// handleGetTalkSessionListRequest handles getTalkSessionList operation.
+// handleHandleAuthCallbackRequest handles handleAuthCallback operation.
 //
-// セッション一覧.
+// Auth Callback.
 //
-// GET /talksessions
-func (s *Server) handleGetTalkSessionListRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /auth/{provider}/callback
+func (s *Server) handleHandleAuthCallbackRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getTalkSessionList"),
+		otelogen.OperationID("handleAuthCallback"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/talksessions"),
+		semconv.HTTPRouteKey.String("/auth/{provider}/callback"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetTalkSessionListOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), HandleAuthCallbackOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5263,11 +16406,11 @@ func (s *Server) handleGetTalkSessionListRequest(args [0]string, argsEscaped boo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetTalkSessionListOperation,
-			ID:   "getTalkSessionList",
+			Name: HandleAuthCallbackOperation,
+			ID:   "handleAuthCallback",
 		}
 	)
-	params, err := decodeGetTalkSessionListParams(args, argsEscaped, r)
+	params, err := decodeHandleAuthCallbackParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -5278,51 +16421,35 @@ func (s *Server) handleGetTalkSessionListRequest(args [0]string, argsEscaped boo
 		return
 	}
 
-	var response GetTalkSessionListRes
+	var response HandleAuthCallbackRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetTalkSessionListOperation,
-			OperationSummary: "セッション一覧",
-			OperationID:      "getTalkSessionList",
+			OperationName:    HandleAuthCallbackOperation,
+			OperationSummary: "Auth Callback",
+			OperationID:      "handleAuthCallback",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
-				{
-					Name: "offset",
-					In:   "query",
-				}: params.Offset,
-				{
-					Name: "theme",
-					In:   "query",
-				}: params.Theme,
-				{
-					Name: "status",
-					In:   "query",
-				}: params.Status,
-				{
-					Name: "sortKey",
-					In:   "query",
-				}: params.SortKey,
+					Name: "provider",
+					In:   "path",
+				}: params.Provider,
 				{
-					Name: "latitude",
+					Name: "code",
 					In:   "query",
-				}: params.Latitude,
+				}: params.Code,
 				{
-					Name: "longitude",
+					Name: "state",
 					In:   "query",
-				}: params.Longitude,
+				}: params.State,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetTalkSessionListParams
-			Response = GetTalkSessionListRes
+			Params   = HandleAuthCallbackParams
+			Response = HandleAuthCallbackRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5331,14 +16458,14 @@ func (s *Server) handleGetTalkSessionListRequest(args [0]string, argsEscaped boo
 		](
 			m,
 			mreq,
-			unpackGetTalkSessionListParams,
+			unpackHandleAuthCallbackParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetTalkSessionList(ctx, params)
+				response, err = s.h.HandleAuthCallback(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetTalkSessionList(ctx, params)
+		response, err = s.h.HandleAuthCallback(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -5346,7 +16473,7 @@ func (s *Server) handleGetTalkSessionListRequest(args [0]string, argsEscaped boo
 		return
 	}
 
-	if err := encodeGetTalkSessionListResponse(response, w, span); err != nil {
+	if err := encodeHandleAuthCallbackResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5355,20 +16482,22 @@ func (s *Server) handleGetTalkSessionListRequest(args [0]string, argsEscaped boo
 	}
 }
 
-// handleGetTalkSessionListManageRequest handles getTalkSessionListManage operation.
+// handleHasConsentRequest handles hasConsent operation.
 //
-// GET /v1/manage/talksessions/list
-func (s *Server) handleGetTalkSessionListManageRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// セッションに同意しているか.
+//
+// GET /talksessions/{talkSessionID}/consent
+func (s *Server) handleHasConsentRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getTalkSessionListManage"),
+		otelogen.OperationID("hasConsent"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v1/manage/talksessions/list"),
+		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/consent"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetTalkSessionListManageOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), HasConsentOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5423,15 +16552,15 @@ func (s *Server) handleGetTalkSessionListManageRequest(args [0]string, argsEscap
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetTalkSessionListManageOperation,
-			ID:   "getTalkSessionListManage",
+			Name: HasConsentOperation,
+			ID:   "hasConsent",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, GetTalkSessionListManageOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, HasConsentOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5447,11 +16576,29 @@ func (s *Server) handleGetTalkSessionListManageRequest(args [0]string, argsEscap
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, HasConsentOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -5466,51 +16613,162 @@ func (s *Server) handleGetTalkSessionListManageRequest(args [0]string, argsEscap
 				OperationContext: opErrContext,
 				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
 			}
-			defer recordError("Security", err)
-			s.cfg.ErrorHandler(ctx, w, r, err)
-			return
-		}
-	}
-	params, err := decodeGetTalkSessionListManageParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeHasConsentParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response HasConsentRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    HasConsentOperation,
+			OperationSummary: "セッションに同意しているか",
+			OperationID:      "hasConsent",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "talkSessionID",
+					In:   "path",
+				}: params.TalkSessionID,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = HasConsentParams
+			Response = HasConsentRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackHasConsentParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.HasConsent(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.HasConsent(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeHasConsentResponse(response, w, span); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleHealthRequest handles health operation.
+//
+// ヘルスチェック.
+//
+// GET /health
+func (s *Server) handleHealthRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	otelAttrs := []attribute.KeyValue{
+		otelogen.OperationID("health"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/health"),
+	}
+
+	// Start a span for this request.
+	ctx, span := s.cfg.Tracer.Start(r.Context(), HealthOperation,
+		trace.WithAttributes(otelAttrs...),
+		serverSpanKind,
+	)
+	defer span.End()
+
+	// Add Labeler to context.
+	labeler := &Labeler{attrs: otelAttrs}
+	ctx = contextWithLabeler(ctx, labeler)
+
+	// Run stopwatch.
+	startTime := time.Now()
+	defer func() {
+		elapsedDuration := time.Since(startTime)
+
+		attrSet := labeler.AttributeSet()
+		attrs := attrSet.ToSlice()
+		code := statusWriter.status
+		if code != 0 {
+			codeAttr := semconv.HTTPResponseStatusCode(code)
+			attrs = append(attrs, codeAttr)
+			span.SetAttributes(codeAttr)
+		}
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
 		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
+		err error
+	)
 
-	var response *TalkSessionListResponse
+	var response HealthRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetTalkSessionListManageOperation,
-			OperationSummary: "",
-			OperationID:      "getTalkSessionListManage",
+			OperationName:    HealthOperation,
+			OperationSummary: "ヘルスチェック",
+			OperationID:      "health",
 			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "status",
-					In:   "query",
-				}: params.Status,
-				{
-					Name: "offset",
-					In:   "query",
-				}: params.Offset,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetTalkSessionListManageParams
-			Response = *TalkSessionListResponse
+			Params   = struct{}
+			Response = HealthRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5519,14 +16777,14 @@ func (s *Server) handleGetTalkSessionListManageRequest(args [0]string, argsEscap
 		](
 			m,
 			mreq,
-			unpackGetTalkSessionListManageParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetTalkSessionListManage(ctx, params)
+				response, err = s.h.Health(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetTalkSessionListManage(ctx, params)
+		response, err = s.h.Health(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -5534,7 +16792,7 @@ func (s *Server) handleGetTalkSessionListManageRequest(args [0]string, argsEscap
 		return
 	}
 
-	if err := encodeGetTalkSessionListManageResponse(response, w, span); err != nil {
+	if err := encodeHealthResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5543,20 +16801,29 @@ func (s *Server) handleGetTalkSessionListManageRequest(args [0]string, argsEscap
 	}
 }
 
-// handleGetTalkSessionManageRequest handles getTalkSessionManage operation.
+// handleInitiateTalkSessionRequest handles initiateTalkSession operation.
 //
-// GET /v1/manage/talksessions/{talkSessionID}
-func (s *Server) handleGetTalkSessionManageRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// ## サムネイル画像について
+// - `Description中に出てくる画像で一番最初のものを使用`。
+// - 画像自体は`POST /images`でサーバにポストしたものを使用してください。
+// ## 投稿制限のキーについて
+// restrictionsに値を入れると一定のデモグラ情報を登録していない限り、セッションへの投稿が制限されるようにできる。
+// restrictionsには [GET /talksessions/restrictions](https://app.apidog.
+// com/link/project/674502/apis/api-14271260)
+// より取れるkeyをカンマ区切りで入力してください。.
+//
+// POST /talksessions
+func (s *Server) handleInitiateTalkSessionRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getTalkSessionManage"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v1/manage/talksessions/{talkSessionID}"),
+		otelogen.OperationID("initiateTalkSession"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/talksessions"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetTalkSessionManageOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), InitiateTalkSessionOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5611,15 +16878,15 @@ func (s *Server) handleGetTalkSessionManageRequest(args [1]string, argsEscaped b
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetTalkSessionManageOperation,
-			ID:   "getTalkSessionManage",
+			Name: InitiateTalkSessionOperation,
+			ID:   "initiateTalkSession",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, GetTalkSessionManageOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, InitiateTalkSessionOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5635,11 +16902,29 @@ func (s *Server) handleGetTalkSessionManageRequest(args [1]string, argsEscaped b
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, InitiateTalkSessionOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -5659,38 +16944,38 @@ func (s *Server) handleGetTalkSessionManageRequest(args [1]string, argsEscaped b
 			return
 		}
 	}
-	params, err := decodeGetTalkSessionManageParams(args, argsEscaped, r)
+	request, close, err := s.decodeInitiateTalkSessionRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *TalkSessionForManage
+	var response InitiateTalkSessionRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetTalkSessionManageOperation,
-			OperationSummary: "",
-			OperationID:      "getTalkSessionManage",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "talkSessionID",
-					In:   "path",
-				}: params.TalkSessionID,
-			},
-			Raw: r,
+			OperationName:    InitiateTalkSessionOperation,
+			OperationSummary: "セッション作成",
+			OperationID:      "initiateTalkSession",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = GetTalkSessionManageParams
-			Response = *TalkSessionForManage
+			Request  = *InitiateTalkSessionReq
+			Params   = struct{}
+			Response = InitiateTalkSessionRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5699,14 +16984,14 @@ func (s *Server) handleGetTalkSessionManageRequest(args [1]string, argsEscaped b
 		](
 			m,
 			mreq,
-			unpackGetTalkSessionManageParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetTalkSessionManage(ctx, params)
+				response, err = s.h.InitiateTalkSession(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetTalkSessionManage(ctx, params)
+		response, err = s.h.InitiateTalkSession(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -5714,7 +16999,7 @@ func (s *Server) handleGetTalkSessionManageRequest(args [1]string, argsEscaped b
 		return
 	}
 
-	if err := encodeGetTalkSessionManageResponse(response, w, span); err != nil {
+	if err := encodeInitiateTalkSessionResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5723,22 +17008,26 @@ func (s *Server) handleGetTalkSessionManageRequest(args [1]string, argsEscaped b
 	}
 }
 
-// handleGetTalkSessionReportRequest handles getTalkSessionReport operation.
+// handleInviteOrganizationRequest handles inviteOrganization operation.
 //
-// セッションレポートを返す.
+// Role
+// - 10: SuperAdmin
+// - 20: Owner
+// - 30: Admin
+// - 40: Member.
 //
-// GET /talksessions/{talkSessionID}/report
-func (s *Server) handleGetTalkSessionReportRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /organizations/invite
+func (s *Server) handleInviteOrganizationRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getTalkSessionReport"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/report"),
+		otelogen.OperationID("inviteOrganization"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/organizations/invite"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetTalkSessionReportOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), InviteOrganizationOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5793,42 +17082,104 @@ func (s *Server) handleGetTalkSessionReportRequest(args [1]string, argsEscaped b
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetTalkSessionReportOperation,
-			ID:   "getTalkSessionReport",
+			Name: InviteOrganizationOperation,
+			ID:   "inviteOrganization",
 		}
 	)
-	params, err := decodeGetTalkSessionReportParams(args, argsEscaped, r)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, InviteOrganizationOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, InviteOrganizationOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	request, close, err := s.decodeInviteOrganizationRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response GetTalkSessionReportRes
-	if m := s.cfg.Middleware; m != nil {
-		mreq := middleware.Request{
-			Context:          ctx,
-			OperationName:    GetTalkSessionReportOperation,
-			OperationSummary: "セッションレポートを返す",
-			OperationID:      "getTalkSessionReport",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "talkSessionID",
-					In:   "path",
-				}: params.TalkSessionID,
-			},
-			Raw: r,
+	var response InviteOrganizationRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    InviteOrganizationOperation,
+			OperationSummary: "組織ユーザー招待（運営ユーザーのみ）",
+			OperationID:      "inviteOrganization",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = GetTalkSessionReportParams
-			Response = GetTalkSessionReportRes
+			Request  = *InviteOrganizationReq
+			Params   = struct{}
+			Response = InviteOrganizationRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -5837,14 +17188,14 @@ func (s *Server) handleGetTalkSessionReportRequest(args [1]string, argsEscaped b
 		](
 			m,
 			mreq,
-			unpackGetTalkSessionReportParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetTalkSessionReport(ctx, params)
+				response, err = s.h.InviteOrganization(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetTalkSessionReport(ctx, params)
+		response, err = s.h.InviteOrganization(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -5852,7 +17203,7 @@ func (s *Server) handleGetTalkSessionReportRequest(args [1]string, argsEscaped b
 		return
 	}
 
-	if err := encodeGetTalkSessionReportResponse(response, w, span); err != nil {
+	if err := encodeInviteOrganizationResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -5861,22 +17212,22 @@ func (s *Server) handleGetTalkSessionReportRequest(args [1]string, argsEscaped b
 	}
 }
 
-// handleGetTalkSessionReportCountRequest handles getTalkSessionReportCount operation.
+// handleInviteOrganizationForUserRequest handles inviteOrganizationForUser operation.
 //
-// 通報件数.
+// 組織にユーザーを追加.
 //
-// GET /talksessions/{talkSessionID}/reports/count
-func (s *Server) handleGetTalkSessionReportCountRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /organizations/invite_user
+func (s *Server) handleInviteOrganizationForUserRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getTalkSessionReportCount"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/reports/count"),
+		otelogen.OperationID("inviteOrganizationForUser"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/organizations/invite_user"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetTalkSessionReportCountOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), InviteOrganizationForUserOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -5931,15 +17282,15 @@ func (s *Server) handleGetTalkSessionReportCountRequest(args [1]string, argsEsca
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetTalkSessionReportCountOperation,
-			ID:   "getTalkSessionReportCount",
+			Name: InviteOrganizationForUserOperation,
+			ID:   "inviteOrganizationForUser",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, GetTalkSessionReportCountOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, InviteOrganizationForUserOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -5955,11 +17306,29 @@ func (s *Server) handleGetTalkSessionReportCountRequest(args [1]string, argsEsca
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, InviteOrganizationForUserOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -5979,42 +17348,38 @@ func (s *Server) handleGetTalkSessionReportCountRequest(args [1]string, argsEsca
 			return
 		}
 	}
-	params, err := decodeGetTalkSessionReportCountParams(args, argsEscaped, r)
+	request, close, err := s.decodeInviteOrganizationForUserRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response GetTalkSessionReportCountRes
+	var response InviteOrganizationForUserRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetTalkSessionReportCountOperation,
-			OperationSummary: "通報件数",
-			OperationID:      "getTalkSessionReportCount",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "talkSessionID",
-					In:   "path",
-				}: params.TalkSessionID,
-				{
-					Name: "status",
-					In:   "query",
-				}: params.Status,
-			},
-			Raw: r,
+			OperationName:    InviteOrganizationForUserOperation,
+			OperationSummary: "組織にユーザーを追加",
+			OperationID:      "inviteOrganizationForUser",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = GetTalkSessionReportCountParams
-			Response = GetTalkSessionReportCountRes
+			Request  = *InviteOrganizationForUserReq
+			Params   = struct{}
+			Response = InviteOrganizationForUserRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6023,14 +17388,14 @@ func (s *Server) handleGetTalkSessionReportCountRequest(args [1]string, argsEsca
 		](
 			m,
 			mreq,
-			unpackGetTalkSessionReportCountParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetTalkSessionReportCount(ctx, params)
+				response, err = s.h.InviteOrganizationForUser(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetTalkSessionReportCount(ctx, params)
+		response, err = s.h.InviteOrganizationForUser(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -6038,7 +17403,7 @@ func (s *Server) handleGetTalkSessionReportCountRequest(args [1]string, argsEsca
 		return
 	}
 
-	if err := encodeGetTalkSessionReportCountResponse(response, w, span); err != nil {
+	if err := encodeInviteOrganizationForUserResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6047,22 +17412,22 @@ func (s *Server) handleGetTalkSessionReportCountRequest(args [1]string, argsEsca
 	}
 }
 
-// handleGetTalkSessionRestrictionKeysRequest handles getTalkSessionRestrictionKeys operation.
+// handleListOwnershipTransfersRequest handles listOwnershipTransfers operation.
 //
-// セッションの投稿制限に使用できるキーの一覧を返す.
+// 自分宛ての譲渡依頼と、自分が依頼した・自分が譲渡元の譲渡依頼を返す.
 //
-// GET /talksessions/restrictions
-func (s *Server) handleGetTalkSessionRestrictionKeysRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /ownership-transfers
+func (s *Server) handleListOwnershipTransfersRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getTalkSessionRestrictionKeys"),
+		otelogen.OperationID("listOwnershipTransfers"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/talksessions/restrictions"),
+		semconv.HTTPRouteKey.String("/ownership-transfers"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetTalkSessionRestrictionKeysOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ListOwnershipTransfersOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6115,16 +17480,82 @@ func (s *Server) handleGetTalkSessionRestrictionKeysRequest(args [0]string, args
 
 			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
 		}
-		err error
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ListOwnershipTransfersOperation,
+			ID:   "listOwnershipTransfers",
+		}
 	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, ListOwnershipTransfersOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, ListOwnershipTransfersOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
-	var response GetTalkSessionRestrictionKeysRes
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var response ListOwnershipTransfersRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetTalkSessionRestrictionKeysOperation,
-			OperationSummary: "セッションで指定可能な制限一覧",
-			OperationID:      "getTalkSessionRestrictionKeys",
+			OperationName:    ListOwnershipTransfersOperation,
+			OperationSummary: "オーナー権限の譲渡依頼一覧",
+			OperationID:      "listOwnershipTransfers",
 			Body:             nil,
 			Params:           middleware.Parameters{},
 			Raw:              r,
@@ -6133,7 +17564,7 @@ func (s *Server) handleGetTalkSessionRestrictionKeysRequest(args [0]string, args
 		type (
 			Request  = struct{}
 			Params   = struct{}
-			Response = GetTalkSessionRestrictionKeysRes
+			Response = ListOwnershipTransfersRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6144,12 +17575,12 @@ func (s *Server) handleGetTalkSessionRestrictionKeysRequest(args [0]string, args
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetTalkSessionRestrictionKeys(ctx)
+				response, err = s.h.ListOwnershipTransfers(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetTalkSessionRestrictionKeys(ctx)
+		response, err = s.h.ListOwnershipTransfers(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -6157,7 +17588,7 @@ func (s *Server) handleGetTalkSessionRestrictionKeysRequest(args [0]string, args
 		return
 	}
 
-	if err := encodeGetTalkSessionRestrictionKeysResponse(response, w, span); err != nil {
+	if err := encodeListOwnershipTransfersResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6166,22 +17597,20 @@ func (s *Server) handleGetTalkSessionRestrictionKeysRequest(args [0]string, args
 	}
 }
 
-// handleGetTalkSessionRestrictionSatisfiedRequest handles getTalkSessionRestrictionSatisfied operation.
-//
-// 特定のセッションで満たしていない条件があれば返す.
+// handleManageRegenerateManageRequest handles manageRegenerateManage operation.
 //
-// GET /talksessions/{talkSessionID}/restrictions
-func (s *Server) handleGetTalkSessionRestrictionSatisfiedRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v1/manage/talksessions/{talkSessionID}/analysis/regenerate
+func (s *Server) handleManageRegenerateManageRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getTalkSessionRestrictionSatisfied"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/restrictions"),
+		otelogen.OperationID("manageRegenerateManage"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/v1/manage/talksessions/{talkSessionID}/analysis/regenerate"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetTalkSessionRestrictionSatisfiedOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ManageRegenerateManageOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6231,16 +17660,78 @@ func (s *Server) handleGetTalkSessionRestrictionSatisfiedRequest(args [1]string,
 			if code != 0 {
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
-
-			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
-		}
-		err          error
-		opErrContext = ogenerrors.OperationContext{
-			Name: GetTalkSessionRestrictionSatisfiedOperation,
-			ID:   "getTalkSessionRestrictionSatisfied",
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ManageRegenerateManageOperation,
+			ID:   "manageRegenerateManage",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, ManageRegenerateManageOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, ManageRegenerateManageOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
 		}
-	)
-	params, err := decodeGetTalkSessionRestrictionSatisfiedParams(args, argsEscaped, r)
+	}
+	params, err := decodeManageRegenerateManageParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -6250,15 +17741,30 @@ func (s *Server) handleGetTalkSessionRestrictionSatisfiedRequest(args [1]string,
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeManageRegenerateManageRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response GetTalkSessionRestrictionSatisfiedRes
+	var response *RegenerateResponse
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetTalkSessionRestrictionSatisfiedOperation,
-			OperationSummary: "セッションで満たしていない制限",
-			OperationID:      "getTalkSessionRestrictionSatisfied",
-			Body:             nil,
+			OperationName:    ManageRegenerateManageOperation,
+			OperationSummary: "",
+			OperationID:      "manageRegenerateManage",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
 					Name: "talkSessionID",
@@ -6269,9 +17775,9 @@ func (s *Server) handleGetTalkSessionRestrictionSatisfiedRequest(args [1]string,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = GetTalkSessionRestrictionSatisfiedParams
-			Response = GetTalkSessionRestrictionSatisfiedRes
+			Request  = *RegenerateRequest
+			Params   = ManageRegenerateManageParams
+			Response = *RegenerateResponse
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6280,14 +17786,14 @@ func (s *Server) handleGetTalkSessionRestrictionSatisfiedRequest(args [1]string,
 		](
 			m,
 			mreq,
-			unpackGetTalkSessionRestrictionSatisfiedParams,
+			unpackManageRegenerateManageParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetTalkSessionRestrictionSatisfied(ctx, params)
+				response, err = s.h.ManageRegenerateManage(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetTalkSessionRestrictionSatisfied(ctx, params)
+		response, err = s.h.ManageRegenerateManage(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -6295,7 +17801,7 @@ func (s *Server) handleGetTalkSessionRestrictionSatisfiedRequest(args [1]string,
 		return
 	}
 
-	if err := encodeGetTalkSessionRestrictionSatisfiedResponse(response, w, span); err != nil {
+	if err := encodeManageRegenerateManageResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6304,22 +17810,22 @@ func (s *Server) handleGetTalkSessionRestrictionSatisfiedRequest(args [1]string,
 	}
 }
 
-// handleGetTimeLineRequest handles getTimeLine operation.
+// handleOpinionComments2Request handles opinionComments2 operation.
 //
-// タイムラインはセッション終了後にセッション作成者が設定できるその後の予定を知らせるもの.
+// 意見に対するリプライ意見一覧.
 //
-// GET /talksessions/{talkSessionID}/timelines
-func (s *Server) handleGetTimeLineRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /opinions/{opinionID}/replies
+func (s *Server) handleOpinionComments2Request(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getTimeLine"),
+		otelogen.OperationID("opinionComments2"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/timelines"),
+		semconv.HTTPRouteKey.String("/opinions/{opinionID}/replies"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetTimeLineOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), OpinionComments2Operation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6374,11 +17880,11 @@ func (s *Server) handleGetTimeLineRequest(args [1]string, argsEscaped bool, w ht
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetTimeLineOperation,
-			ID:   "getTimeLine",
+			Name: OpinionComments2Operation,
+			ID:   "opinionComments2",
 		}
 	)
-	params, err := decodeGetTimeLineParams(args, argsEscaped, r)
+	params, err := decodeOpinionComments2Params(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -6389,27 +17895,27 @@ func (s *Server) handleGetTimeLineRequest(args [1]string, argsEscaped bool, w ht
 		return
 	}
 
-	var response GetTimeLineRes
+	var response OpinionComments2Res
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetTimeLineOperation,
-			OperationSummary: "タイムライン取得",
-			OperationID:      "getTimeLine",
+			OperationName:    OpinionComments2Operation,
+			OperationSummary: "意見に対するリプライ意見一覧",
+			OperationID:      "opinionComments2",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "talkSessionID",
+					Name: "opinionID",
 					In:   "path",
-				}: params.TalkSessionID,
+				}: params.OpinionID,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetTimeLineParams
-			Response = GetTimeLineRes
+			Params   = OpinionComments2Params
+			Response = OpinionComments2Res
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6418,14 +17924,14 @@ func (s *Server) handleGetTimeLineRequest(args [1]string, argsEscaped bool, w ht
 		](
 			m,
 			mreq,
-			unpackGetTimeLineParams,
+			unpackOpinionComments2Params,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetTimeLine(ctx, params)
+				response, err = s.h.OpinionComments2(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetTimeLine(ctx, params)
+		response, err = s.h.OpinionComments2(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -6433,7 +17939,7 @@ func (s *Server) handleGetTimeLineRequest(args [1]string, argsEscaped bool, w ht
 		return
 	}
 
-	if err := encodeGetTimeLineResponse(response, w, span); err != nil {
+	if err := encodeOpinionComments2Response(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6442,22 +17948,22 @@ func (s *Server) handleGetTimeLineRequest(args [1]string, argsEscaped bool, w ht
 	}
 }
 
-// handleGetTokenInfoRequest handles getTokenInfo operation.
+// handleOpinionsHistoryRequest handles opinionsHistory operation.
 //
-// JWTの内容を返してくれる.
+// 今までに投稿した意見.
 //
-// GET /auth/token/info
-func (s *Server) handleGetTokenInfoRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /opinions/histories
+func (s *Server) handleOpinionsHistoryRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getTokenInfo"),
+		otelogen.OperationID("opinionsHistory"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/auth/token/info"),
+		semconv.HTTPRouteKey.String("/opinions/histories"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetTokenInfoOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), OpinionsHistoryOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6512,15 +18018,15 @@ func (s *Server) handleGetTokenInfoRequest(args [0]string, argsEscaped bool, w h
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetTokenInfoOperation,
-			ID:   "getTokenInfo",
+			Name: OpinionsHistoryOperation,
+			ID:   "opinionsHistory",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, GetTokenInfoOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, OpinionsHistoryOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -6536,11 +18042,29 @@ func (s *Server) handleGetTokenInfoRequest(args [0]string, argsEscaped bool, w h
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, OpinionsHistoryOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -6560,130 +18084,7 @@ func (s *Server) handleGetTokenInfoRequest(args [0]string, argsEscaped bool, w h
 			return
 		}
 	}
-
-	var response GetTokenInfoRes
-	if m := s.cfg.Middleware; m != nil {
-		mreq := middleware.Request{
-			Context:          ctx,
-			OperationName:    GetTokenInfoOperation,
-			OperationSummary: "JWTの内容を返してくれる",
-			OperationID:      "getTokenInfo",
-			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
-		}
-
-		type (
-			Request  = struct{}
-			Params   = struct{}
-			Response = GetTokenInfoRes
-		)
-		response, err = middleware.HookMiddleware[
-			Request,
-			Params,
-			Response,
-		](
-			m,
-			mreq,
-			nil,
-			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetTokenInfo(ctx)
-				return response, err
-			},
-		)
-	} else {
-		response, err = s.h.GetTokenInfo(ctx)
-	}
-	if err != nil {
-		defer recordError("Internal", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-
-	if err := encodeGetTokenInfoResponse(response, w, span); err != nil {
-		defer recordError("EncodeResponse", err)
-		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
-			s.cfg.ErrorHandler(ctx, w, r, err)
-		}
-		return
-	}
-}
-
-// handleGetUserByDisplayIDRequest handles getUserByDisplayID operation.
-//
-// 表示IDからユーザー情報の取得.
-//
-// GET /user/{displayID}
-func (s *Server) handleGetUserByDisplayIDRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
-	statusWriter := &codeRecorder{ResponseWriter: w}
-	w = statusWriter
-	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getUserByDisplayID"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/user/{displayID}"),
-	}
-
-	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetUserByDisplayIDOperation,
-		trace.WithAttributes(otelAttrs...),
-		serverSpanKind,
-	)
-	defer span.End()
-
-	// Add Labeler to context.
-	labeler := &Labeler{attrs: otelAttrs}
-	ctx = contextWithLabeler(ctx, labeler)
-
-	// Run stopwatch.
-	startTime := time.Now()
-	defer func() {
-		elapsedDuration := time.Since(startTime)
-
-		attrSet := labeler.AttributeSet()
-		attrs := attrSet.ToSlice()
-		code := statusWriter.status
-		if code != 0 {
-			codeAttr := semconv.HTTPResponseStatusCode(code)
-			attrs = append(attrs, codeAttr)
-			span.SetAttributes(codeAttr)
-		}
-		attrOpt := metric.WithAttributes(attrs...)
-
-		// Increment request counter.
-		s.requests.Add(ctx, 1, attrOpt)
-
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
-	}()
-
-	var (
-		recordError = func(stage string, err error) {
-			span.RecordError(err)
-
-			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
-			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
-			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
-			// max redirects exceeded), in which case status MUST be set to Error.
-			code := statusWriter.status
-			if code >= 100 && code < 500 {
-				span.SetStatus(codes.Error, stage)
-			}
-
-			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
-			if code != 0 {
-				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
-			}
-
-			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
-		}
-		err          error
-		opErrContext = ogenerrors.OperationContext{
-			Name: GetUserByDisplayIDOperation,
-			ID:   "getUserByDisplayID",
-		}
-	)
-	params, err := decodeGetUserByDisplayIDParams(args, argsEscaped, r)
+	params, err := decodeOpinionsHistoryParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -6694,27 +18095,35 @@ func (s *Server) handleGetUserByDisplayIDRequest(args [1]string, argsEscaped boo
 		return
 	}
 
-	var response GetUserByDisplayIDRes
+	var response OpinionsHistoryRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetUserByDisplayIDOperation,
-			OperationSummary: "表示IDからユーザー情報の取得",
-			OperationID:      "getUserByDisplayID",
+			OperationName:    OpinionsHistoryOperation,
+			OperationSummary: "今までに投稿した意見",
+			OperationID:      "opinionsHistory",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "displayID",
-					In:   "path",
-				}: params.DisplayID,
+					Name: "sort",
+					In:   "query",
+				}: params.Sort,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetUserByDisplayIDParams
-			Response = GetUserByDisplayIDRes
+			Params   = OpinionsHistoryParams
+			Response = OpinionsHistoryRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6723,14 +18132,14 @@ func (s *Server) handleGetUserByDisplayIDRequest(args [1]string, argsEscaped boo
 		](
 			m,
 			mreq,
-			unpackGetUserByDisplayIDParams,
+			unpackOpinionsHistoryParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetUserByDisplayID(ctx, params)
+				response, err = s.h.OpinionsHistory(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetUserByDisplayID(ctx, params)
+		response, err = s.h.OpinionsHistory(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -6738,7 +18147,7 @@ func (s *Server) handleGetUserByDisplayIDRequest(args [1]string, argsEscaped boo
 		return
 	}
 
-	if err := encodeGetUserByDisplayIDResponse(response, w, span); err != nil {
+	if err := encodeOpinionsHistoryResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6747,22 +18156,22 @@ func (s *Server) handleGetUserByDisplayIDRequest(args [1]string, argsEscaped boo
 	}
 }
 
-// handleGetUserInfoRequest handles getUserInfo operation.
+// handlePasswordLoginRequest handles passwordLogin operation.
 //
-// ユーザー情報の取得.
+// パスワードによるログイン.
 //
-// GET /user
-func (s *Server) handleGetUserInfoRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /auth/password/login
+func (s *Server) handlePasswordLoginRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getUserInfo"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/user"),
+		otelogen.OperationID("passwordLogin"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/auth/password/login"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetUserInfoOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), PasswordLoginOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6817,71 +18226,42 @@ func (s *Server) handleGetUserInfoRequest(args [0]string, argsEscaped bool, w ht
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetUserInfoOperation,
-			ID:   "getUserInfo",
+			Name: PasswordLoginOperation,
+			ID:   "passwordLogin",
 		}
 	)
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			sctx, ok, err := s.securityCookieAuth(ctx, GetUserInfoOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "CookieAuth",
-					Err:              err,
-				}
-				defer recordError("Security:CookieAuth", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 0
-				ctx = sctx
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			err = &ogenerrors.SecurityError{
-				OperationContext: opErrContext,
-				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
-			}
-			defer recordError("Security", err)
-			s.cfg.ErrorHandler(ctx, w, r, err)
-			return
+	request, close, err := s.decodePasswordLoginRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
 		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response GetUserInfoRes
+	var response PasswordLoginRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetUserInfoOperation,
-			OperationSummary: "ユーザー情報の取得",
-			OperationID:      "getUserInfo",
-			Body:             nil,
+			OperationName:    PasswordLoginOperation,
+			OperationSummary: "パスワードによるログイン",
+			OperationID:      "passwordLogin",
+			Body:             request,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
+			Request  = *PasswordLoginReq
 			Params   = struct{}
-			Response = GetUserInfoRes
+			Response = PasswordLoginRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -6892,12 +18272,12 @@ func (s *Server) handleGetUserInfoRequest(args [0]string, argsEscaped bool, w ht
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetUserInfo(ctx)
+				response, err = s.h.PasswordLogin(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetUserInfo(ctx)
+		response, err = s.h.PasswordLogin(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -6905,7 +18285,7 @@ func (s *Server) handleGetUserInfoRequest(args [0]string, argsEscaped bool, w ht
 		return
 	}
 
-	if err := encodeGetUserInfoResponse(response, w, span); err != nil {
+	if err := encodePasswordLoginResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -6914,20 +18294,22 @@ func (s *Server) handleGetUserInfoRequest(args [0]string, argsEscaped bool, w ht
 	}
 }
 
-// handleGetUserListManageRequest handles getUserListManage operation.
+// handlePasswordRegisterRequest handles passwordRegister operation.
 //
-// GET /v1/manage/users/list
-func (s *Server) handleGetUserListManageRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// パスワードによる登録（devのみ）.
+//
+// POST /auth/password/register
+func (s *Server) handlePasswordRegisterRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getUserListManage"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v1/manage/users/list"),
+		otelogen.OperationID("passwordRegister"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/auth/password/register"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetUserListManageOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), PasswordRegisterOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -6982,102 +18364,42 @@ func (s *Server) handleGetUserListManageRequest(args [0]string, argsEscaped bool
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetUserListManageOperation,
-			ID:   "getUserListManage",
+			Name: PasswordRegisterOperation,
+			ID:   "passwordRegister",
 		}
 	)
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			sctx, ok, err := s.securityCookieAuth(ctx, GetUserListManageOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "CookieAuth",
-					Err:              err,
-				}
-				defer recordError("Security:CookieAuth", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 0
-				ctx = sctx
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			err = &ogenerrors.SecurityError{
-				OperationContext: opErrContext,
-				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
-			}
-			defer recordError("Security", err)
-			s.cfg.ErrorHandler(ctx, w, r, err)
-			return
-		}
-	}
-	params, err := decodeGetUserListManageParams(args, argsEscaped, r)
+	request, close, err := s.decodePasswordRegisterRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response []UserForManage
+	var response PasswordRegisterRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetUserListManageOperation,
-			OperationSummary: "",
-			OperationID:      "getUserListManage",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "offset",
-					In:   "query",
-				}: params.Offset,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
-				{
-					Name: "search",
-					In:   "query",
-				}: params.Search,
-				{
-					Name: "orderBy",
-					In:   "query",
-				}: params.OrderBy,
-				{
-					Name: "order",
-					In:   "query",
-				}: params.Order,
-			},
-			Raw: r,
+			OperationName:    PasswordRegisterOperation,
+			OperationSummary: "パスワードによる登録（devのみ）",
+			OperationID:      "passwordRegister",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = GetUserListManageParams
-			Response = []UserForManage
+			Request  = *PasswordRegisterReq
+			Params   = struct{}
+			Response = PasswordRegisterRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7086,14 +18408,14 @@ func (s *Server) handleGetUserListManageRequest(args [0]string, argsEscaped bool
 		](
 			m,
 			mreq,
-			unpackGetUserListManageParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetUserListManage(ctx, params)
+				response, err = s.h.PasswordRegister(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetUserListManage(ctx, params)
+		response, err = s.h.PasswordRegister(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -7101,7 +18423,7 @@ func (s *Server) handleGetUserListManageRequest(args [0]string, argsEscaped bool
 		return
 	}
 
-	if err := encodeGetUserListManageResponse(response, w, span); err != nil {
+	if err := encodePasswordRegisterResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7110,20 +18432,22 @@ func (s *Server) handleGetUserListManageRequest(args [0]string, argsEscaped bool
 	}
 }
 
-// handleGetUserStatsListManageRequest handles getUserStatsListManage operation.
+// handlePolicyConsentRequest handles policyConsent operation.
 //
-// GET /v1/manage/users/stats/list
-func (s *Server) handleGetUserStatsListManageRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// 最新のポリシーに同意する.
+//
+// POST /policy/consent
+func (s *Server) handlePolicyConsentRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getUserStatsListManage"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v1/manage/users/stats/list"),
+		otelogen.OperationID("policyConsent"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/policy/consent"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetUserStatsListManageOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), PolicyConsentOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7178,15 +18502,15 @@ func (s *Server) handleGetUserStatsListManageRequest(args [0]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetUserStatsListManageOperation,
-			ID:   "getUserStatsListManage",
+			Name: PolicyConsentOperation,
+			ID:   "policyConsent",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, GetUserStatsListManageOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, PolicyConsentOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7202,11 +18526,29 @@ func (s *Server) handleGetUserStatsListManageRequest(args [0]string, argsEscaped
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, PolicyConsentOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -7226,46 +18568,38 @@ func (s *Server) handleGetUserStatsListManageRequest(args [0]string, argsEscaped
 			return
 		}
 	}
-	params, err := decodeGetUserStatsListManageParams(args, argsEscaped, r)
+	request, close, err := s.decodePolicyConsentRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response []UserStatsResponse
+	var response PolicyConsentRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetUserStatsListManageOperation,
-			OperationSummary: "",
-			OperationID:      "getUserStatsListManage",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "range",
-					In:   "query",
-				}: params.Range,
-				{
-					Name: "offset",
-					In:   "query",
-				}: params.Offset,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
-			},
-			Raw: r,
+			OperationName:    PolicyConsentOperation,
+			OperationSummary: "最新のポリシーに同意する",
+			OperationID:      "policyConsent",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = GetUserStatsListManageParams
-			Response = []UserStatsResponse
+			Request  = *PolicyConsentReq
+			Params   = struct{}
+			Response = PolicyConsentRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7274,14 +18608,14 @@ func (s *Server) handleGetUserStatsListManageRequest(args [0]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackGetUserStatsListManageParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetUserStatsListManage(ctx, params)
+				response, err = s.h.PolicyConsent(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetUserStatsListManage(ctx, params)
+		response, err = s.h.PolicyConsent(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -7289,7 +18623,7 @@ func (s *Server) handleGetUserStatsListManageRequest(args [0]string, argsEscaped
 		return
 	}
 
-	if err := encodeGetUserStatsListManageResponse(response, w, span); err != nil {
+	if err := encodePolicyConsentResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7298,20 +18632,23 @@ func (s *Server) handleGetUserStatsListManageRequest(args [0]string, argsEscaped
 	}
 }
 
-// handleGetUserStatsTotalManageRequest handles getUserStatsTotalManage operation.
+// handlePostConclusionRequest handles postConclusion operation.
 //
-// GET /v1/manage/users/stats/total
-func (s *Server) handleGetUserStatsTotalManageRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// 結論（conclusion）はセッションが終了した後にセッっションの作成者が投稿できる文章。
+// セッションの流れやグループの分かれ方などに対するセッション作成者の感想やそれらの意見を受け、これからの方向性などを記入する。.
+//
+// POST /talksessions/{talkSessionID}/conclusion
+func (s *Server) handlePostConclusionRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getUserStatsTotalManage"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/v1/manage/users/stats/total"),
+		otelogen.OperationID("postConclusion"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/conclusion"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetUserStatsTotalManageOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), PostConclusionOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7366,15 +18703,15 @@ func (s *Server) handleGetUserStatsTotalManageRequest(args [0]string, argsEscape
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetUserStatsTotalManageOperation,
-			ID:   "getUserStatsTotalManage",
+			Name: PostConclusionOperation,
+			ID:   "postConclusion",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, GetUserStatsTotalManageOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, PostConclusionOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7390,11 +18727,29 @@ func (s *Server) handleGetUserStatsTotalManageRequest(args [0]string, argsEscape
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, PostConclusionOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -7414,23 +18769,53 @@ func (s *Server) handleGetUserStatsTotalManageRequest(args [0]string, argsEscape
 			return
 		}
 	}
+	params, err := decodePostConclusionParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodePostConclusionRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *UserStatsResponse
+	var response PostConclusionRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetUserStatsTotalManageOperation,
-			OperationSummary: "",
-			OperationID:      "getUserStatsTotalManage",
-			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationName:    PostConclusionOperation,
+			OperationSummary: "結論投稿",
+			OperationID:      "postConclusion",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "talkSessionID",
+					In:   "path",
+				}: params.TalkSessionID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = struct{}
-			Response = *UserStatsResponse
+			Request  = *PostConclusionReq
+			Params   = PostConclusionParams
+			Response = PostConclusionRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7439,14 +18824,14 @@ func (s *Server) handleGetUserStatsTotalManageRequest(args [0]string, argsEscape
 		](
 			m,
 			mreq,
-			nil,
+			unpackPostConclusionParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetUserStatsTotalManage(ctx)
+				response, err = s.h.PostConclusion(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetUserStatsTotalManage(ctx)
+		response, err = s.h.PostConclusion(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -7454,7 +18839,7 @@ func (s *Server) handleGetUserStatsTotalManageRequest(args [0]string, argsEscape
 		return
 	}
 
-	if err := encodeGetUserStatsTotalManageResponse(response, w, span); err != nil {
+	if err := encodePostConclusionResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7463,22 +18848,22 @@ func (s *Server) handleGetUserStatsTotalManageRequest(args [0]string, argsEscape
 	}
 }
 
-// handleGetUserTalkSessionsRequest handles getUserTalkSessions operation.
+// handlePostImageRequest handles postImage operation.
 //
-// 特定ユーザが開いたセッション一覧.
+// 画像を投稿してURLを返すAPI.
 //
-// GET /users/{displayID}/talksessions
-func (s *Server) handleGetUserTalkSessionsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /images
+func (s *Server) handlePostImageRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getUserTalkSessions"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/users/{displayID}/talksessions"),
+		otelogen.OperationID("postImage"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/images"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetUserTalkSessionsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), PostImageOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7501,86 +18886,136 @@ func (s *Server) handleGetUserTalkSessionsRequest(args [1]string, argsEscaped bo
 			attrs = append(attrs, codeAttr)
 			span.SetAttributes(codeAttr)
 		}
-		attrOpt := metric.WithAttributes(attrs...)
-
-		// Increment request counter.
-		s.requests.Add(ctx, 1, attrOpt)
-
-		// Use floating point division here for higher precision (instead of Millisecond method).
-		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
-	}()
+		attrOpt := metric.WithAttributes(attrs...)
+
+		// Increment request counter.
+		s.requests.Add(ctx, 1, attrOpt)
+
+		// Use floating point division here for higher precision (instead of Millisecond method).
+		s.duration.Record(ctx, float64(elapsedDuration)/float64(time.Millisecond), attrOpt)
+	}()
+
+	var (
+		recordError = func(stage string, err error) {
+			span.RecordError(err)
+
+			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
+			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
+			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
+			// max redirects exceeded), in which case status MUST be set to Error.
+			code := statusWriter.status
+			if code >= 100 && code < 500 {
+				span.SetStatus(codes.Error, stage)
+			}
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: PostImageOperation,
+			ID:   "postImage",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, PostImageOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, PostImageOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
-	var (
-		recordError = func(stage string, err error) {
-			span.RecordError(err)
-
-			// https://opentelemetry.io/docs/specs/semconv/http/http-spans/#status
-			// Span Status MUST be left unset if HTTP status code was in the 1xx, 2xx or 3xx ranges,
-			// unless there was another error (e.g., network error receiving the response body; or 3xx codes with
-			// max redirects exceeded), in which case status MUST be set to Error.
-			code := statusWriter.status
-			if code >= 100 && code < 500 {
-				span.SetStatus(codes.Error, stage)
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
 			}
-
-			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
-			if code != 0 {
-				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
 			}
-
-			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
-		}
-		err          error
-		opErrContext = ogenerrors.OperationContext{
-			Name: GetUserTalkSessionsOperation,
-			ID:   "getUserTalkSessions",
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
 		}
-	)
-	params, err := decodeGetUserTalkSessionsParams(args, argsEscaped, r)
+	}
+	request, close, err := s.decodePostImageRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response GetUserTalkSessionsRes
+	var response PostImageRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetUserTalkSessionsOperation,
-			OperationSummary: "特定ユーザが開いたセッション一覧",
-			OperationID:      "getUserTalkSessions",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "displayID",
-					In:   "path",
-				}: params.DisplayID,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
-				{
-					Name: "offset",
-					In:   "query",
-				}: params.Offset,
-				{
-					Name: "status",
-					In:   "query",
-				}: params.Status,
-			},
-			Raw: r,
+			OperationName:    PostImageOperation,
+			OperationSummary: "画像投稿",
+			OperationID:      "postImage",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = GetUserTalkSessionsParams
-			Response = GetUserTalkSessionsRes
+			Request  = *PostImageReq
+			Params   = struct{}
+			Response = PostImageRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7589,14 +19024,14 @@ func (s *Server) handleGetUserTalkSessionsRequest(args [1]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			unpackGetUserTalkSessionsParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetUserTalkSessions(ctx, params)
+				response, err = s.h.PostImage(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetUserTalkSessions(ctx, params)
+		response, err = s.h.PostImage(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -7604,7 +19039,7 @@ func (s *Server) handleGetUserTalkSessionsRequest(args [1]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeGetUserTalkSessionsResponse(response, w, span); err != nil {
+	if err := encodePostImageResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7613,22 +19048,24 @@ func (s *Server) handleGetUserTalkSessionsRequest(args [1]string, argsEscaped bo
 	}
 }
 
-// handleGetVapidKeyRequest handles getVapidKey operation.
+// handlePostOpinionPost2Request handles postOpinionPost2 operation.
 //
-// VAPID公開鍵取得.
+// ParentOpinionIDがなければルートの意見として投稿される
+// parentOpinionIDがない場合はtalkSessionIDが必須
+// セッション管理者はisSeedをtrueにするとシード意見として投稿できる.
 //
-// GET /notifications/vapid-key
-func (s *Server) handleGetVapidKeyRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /opinions
+func (s *Server) handlePostOpinionPost2Request(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("getVapidKey"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/notifications/vapid-key"),
+		otelogen.OperationID("postOpinionPost2"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/opinions"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), GetVapidKeyOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), PostOpinionPost2Operation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7683,15 +19120,15 @@ func (s *Server) handleGetVapidKeyRequest(args [0]string, argsEscaped bool, w ht
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetVapidKeyOperation,
-			ID:   "getVapidKey",
+			Name: PostOpinionPost2Operation,
+			ID:   "postOpinionPost2",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, GetVapidKeyOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, PostOpinionPost2Operation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -7707,11 +19144,29 @@ func (s *Server) handleGetVapidKeyRequest(args [0]string, argsEscaped bool, w ht
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, PostOpinionPost2Operation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -7731,23 +19186,38 @@ func (s *Server) handleGetVapidKeyRequest(args [0]string, argsEscaped bool, w ht
 			return
 		}
 	}
+	request, close, err := s.decodePostOpinionPost2Request(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response *GetVapidKeyOK
+	var response PostOpinionPost2Res
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetVapidKeyOperation,
-			OperationSummary: "VAPID公開鍵取得",
-			OperationID:      "getVapidKey",
-			Body:             nil,
+			OperationName:    PostOpinionPost2Operation,
+			OperationSummary: "セッションに対して意見投稿 or 意見に対するリプライ",
+			OperationID:      "postOpinionPost2",
+			Body:             request,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
+			Request  = *PostOpinionPost2Req
 			Params   = struct{}
-			Response = *GetVapidKeyOK
+			Response = PostOpinionPost2Res
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7758,12 +19228,12 @@ func (s *Server) handleGetVapidKeyRequest(args [0]string, argsEscaped bool, w ht
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetVapidKey(ctx)
+				response, err = s.h.PostOpinionPost2(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetVapidKey(ctx)
+		response, err = s.h.PostOpinionPost2(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -7771,7 +19241,7 @@ func (s *Server) handleGetVapidKeyRequest(args [0]string, argsEscaped bool, w ht
 		return
 	}
 
-	if err := encodeGetVapidKeyResponse(response, w, span); err != nil {
+	if err := encodePostOpinionPost2Response(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7780,22 +19250,22 @@ func (s *Server) handleGetVapidKeyRequest(args [0]string, argsEscaped bool, w ht
 	}
 }
 
-// handleHandleAuthCallbackRequest handles handleAuthCallback operation.
+// handlePostTimeLineItemRequest handles postTimeLineItem operation.
 //
-// Auth Callback.
+// タイムラインアイテム追加.
 //
-// GET /auth/{provider}/callback
-func (s *Server) handleHandleAuthCallbackRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /talksessions/{talkSessionID}/timeline
+func (s *Server) handlePostTimeLineItemRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("handleAuthCallback"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/auth/{provider}/callback"),
+		otelogen.OperationID("postTimeLineItem"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/timeline"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), HandleAuthCallbackOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), PostTimeLineItemOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7850,11 +19320,73 @@ func (s *Server) handleHandleAuthCallbackRequest(args [1]string, argsEscaped boo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: HandleAuthCallbackOperation,
-			ID:   "handleAuthCallback",
+			Name: PostTimeLineItemOperation,
+			ID:   "postTimeLineItem",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, PostTimeLineItemOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, PostTimeLineItemOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
 		}
-	)
-	params, err := decodeHandleAuthCallbackParams(args, argsEscaped, r)
+	}
+	params, err := decodePostTimeLineItemParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -7864,36 +19396,43 @@ func (s *Server) handleHandleAuthCallbackRequest(args [1]string, argsEscaped boo
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodePostTimeLineItemRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response HandleAuthCallbackRes
+	var response PostTimeLineItemRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    HandleAuthCallbackOperation,
-			OperationSummary: "Auth Callback",
-			OperationID:      "handleAuthCallback",
-			Body:             nil,
+			OperationName:    PostTimeLineItemOperation,
+			OperationSummary: "タイムラインアイテム追加",
+			OperationID:      "postTimeLineItem",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
-					Name: "provider",
+					Name: "talkSessionID",
 					In:   "path",
-				}: params.Provider,
-				{
-					Name: "code",
-					In:   "query",
-				}: params.Code,
-				{
-					Name: "state",
-					In:   "query",
-				}: params.State,
+				}: params.TalkSessionID,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = HandleAuthCallbackParams
-			Response = HandleAuthCallbackRes
+			Request  = *PostTimeLineItemReq
+			Params   = PostTimeLineItemParams
+			Response = PostTimeLineItemRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -7902,14 +19441,14 @@ func (s *Server) handleHandleAuthCallbackRequest(args [1]string, argsEscaped boo
 		](
 			m,
 			mreq,
-			unpackHandleAuthCallbackParams,
+			unpackPostTimeLineItemParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.HandleAuthCallback(ctx, params)
+				response, err = s.h.PostTimeLineItem(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.HandleAuthCallback(ctx, params)
+		response, err = s.h.PostTimeLineItem(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -7917,7 +19456,7 @@ func (s *Server) handleHandleAuthCallbackRequest(args [1]string, argsEscaped boo
 		return
 	}
 
-	if err := encodeHandleAuthCallbackResponse(response, w, span); err != nil {
+	if err := encodePostTimeLineItemResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -7926,22 +19465,20 @@ func (s *Server) handleHandleAuthCallbackRequest(args [1]string, argsEscaped boo
 	}
 }
 
-// handleHasConsentRequest handles hasConsent operation.
-//
-// セッションに同意しているか.
+// handlePublishReportVersionManageRequest handles publishReportVersionManage operation.
 //
-// GET /talksessions/{talkSessionID}/consent
-func (s *Server) handleHasConsentRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v1/manage/talksessions/{talkSessionID}/analysis/report/publish
+func (s *Server) handlePublishReportVersionManageRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("hasConsent"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/consent"),
+		otelogen.OperationID("publishReportVersionManage"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/v1/manage/talksessions/{talkSessionID}/analysis/report/publish"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), HasConsentOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), PublishReportVersionManageOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -7996,15 +19533,15 @@ func (s *Server) handleHasConsentRequest(args [1]string, argsEscaped bool, w htt
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: HasConsentOperation,
-			ID:   "hasConsent",
+			Name: PublishReportVersionManageOperation,
+			ID:   "publishReportVersionManage",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, HasConsentOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, PublishReportVersionManageOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8020,11 +19557,29 @@ func (s *Server) handleHasConsentRequest(args [1]string, argsEscaped bool, w htt
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, PublishReportVersionManageOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -8044,7 +19599,7 @@ func (s *Server) handleHasConsentRequest(args [1]string, argsEscaped bool, w htt
 			return
 		}
 	}
-	params, err := decodeHasConsentParams(args, argsEscaped, r)
+	params, err := decodePublishReportVersionManageParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -8054,15 +19609,30 @@ func (s *Server) handleHasConsentRequest(args [1]string, argsEscaped bool, w htt
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodePublishReportVersionManageRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response HasConsentRes
+	var response *ReportPublicationForManage
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    HasConsentOperation,
-			OperationSummary: "セッションに同意しているか",
-			OperationID:      "hasConsent",
-			Body:             nil,
+			OperationName:    PublishReportVersionManageOperation,
+			OperationSummary: "",
+			OperationID:      "publishReportVersionManage",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
 					Name: "talkSessionID",
@@ -8073,9 +19643,9 @@ func (s *Server) handleHasConsentRequest(args [1]string, argsEscaped bool, w htt
 		}
 
 		type (
-			Request  = struct{}
-			Params   = HasConsentParams
-			Response = HasConsentRes
+			Request  = *PublishReportVersionRequest
+			Params   = PublishReportVersionManageParams
+			Response = *ReportPublicationForManage
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8084,14 +19654,14 @@ func (s *Server) handleHasConsentRequest(args [1]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			unpackHasConsentParams,
+			unpackPublishReportVersionManageParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.HasConsent(ctx, params)
+				response, err = s.h.PublishReportVersionManage(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.HasConsent(ctx, params)
+		response, err = s.h.PublishReportVersionManage(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -8099,7 +19669,7 @@ func (s *Server) handleHasConsentRequest(args [1]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeHasConsentResponse(response, w, span); err != nil {
+	if err := encodePublishReportVersionManageResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8108,22 +19678,22 @@ func (s *Server) handleHasConsentRequest(args [1]string, argsEscaped bool, w htt
 	}
 }
 
-// handleHealthRequest handles health operation.
+// handlePublishTalkSessionRequest handles publishTalkSession operation.
 //
-// ヘルスチェック.
+// 下書きのセッションを公開する。オーナーと共同オーナーのみ公開できる.
 //
-// GET /health
-func (s *Server) handleHealthRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /talksessions/{talkSessionID}/publish
+func (s *Server) handlePublishTalkSessionRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("health"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/health"),
+		otelogen.OperationID("publishTalkSession"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/publish"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), HealthOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), PublishTalkSessionOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8176,25 +19746,106 @@ func (s *Server) handleHealthRequest(args [0]string, argsEscaped bool, w http.Re
 
 			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
 		}
-		err error
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: PublishTalkSessionOperation,
+			ID:   "publishTalkSession",
+		}
 	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, PublishTalkSessionOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, PublishTalkSessionOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
-	var response HealthRes
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodePublishTalkSessionParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var response PublishTalkSessionRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    HealthOperation,
-			OperationSummary: "ヘルスチェック",
-			OperationID:      "health",
+			OperationName:    PublishTalkSessionOperation,
+			OperationSummary: "下書きのセッションを公開",
+			OperationID:      "publishTalkSession",
 			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "talkSessionID",
+					In:   "path",
+				}: params.TalkSessionID,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = HealthRes
+			Params   = PublishTalkSessionParams
+			Response = PublishTalkSessionRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8203,14 +19854,14 @@ func (s *Server) handleHealthRequest(args [0]string, argsEscaped bool, w http.Re
 		](
 			m,
 			mreq,
-			nil,
+			unpackPublishTalkSessionParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.Health(ctx)
+				response, err = s.h.PublishTalkSession(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.Health(ctx)
+		response, err = s.h.PublishTalkSession(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -8218,7 +19869,7 @@ func (s *Server) handleHealthRequest(args [0]string, argsEscaped bool, w http.Re
 		return
 	}
 
-	if err := encodeHealthResponse(response, w, span); err != nil {
+	if err := encodePublishTalkSessionResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8227,29 +19878,22 @@ func (s *Server) handleHealthRequest(args [0]string, argsEscaped bool, w http.Re
 	}
 }
 
-// handleInitiateTalkSessionRequest handles initiateTalkSession operation.
+// handleReactivateUserRequest handles reactivateUser operation.
 //
-// ## サムネイル画像について
-// - `Description中に出てくる画像で一番最初のものを使用`。
-// - 画像自体は`POST /images`でサーバにポストしたものを使用してください。
-// ## 投稿制限のキーについて
-// restrictionsに値を入れると一定のデモグラ情報を登録していない限り、セッションへの投稿が制限されるようにできる。
-// restrictionsには [GET /talksessions/restrictions](https://app.apidog.
-// com/link/project/674502/apis/api-14271260)
-// より取れるkeyをカンマ区切りで入力してください。.
+// 退会ユーザーの復活.
 //
-// POST /talksessions
-func (s *Server) handleInitiateTalkSessionRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /auth/reactivate
+func (s *Server) handleReactivateUserRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("initiateTalkSession"),
+		otelogen.OperationID("reactivateUser"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/talksessions"),
+		semconv.HTTPRouteKey.String("/auth/reactivate"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), InitiateTalkSessionOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ReactivateUserOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8304,15 +19948,15 @@ func (s *Server) handleInitiateTalkSessionRequest(args [0]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: InitiateTalkSessionOperation,
-			ID:   "initiateTalkSession",
+			Name: ReactivateUserOperation,
+			ID:   "reactivateUser",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, InitiateTalkSessionOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, ReactivateUserOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8328,11 +19972,29 @@ func (s *Server) handleInitiateTalkSessionRequest(args [0]string, argsEscaped bo
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, ReactivateUserOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -8352,38 +20014,23 @@ func (s *Server) handleInitiateTalkSessionRequest(args [0]string, argsEscaped bo
 			return
 		}
 	}
-	request, close, err := s.decodeInitiateTalkSessionRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response InitiateTalkSessionRes
+	var response ReactivateUserRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    InitiateTalkSessionOperation,
-			OperationSummary: "セッション作成",
-			OperationID:      "initiateTalkSession",
-			Body:             request,
+			OperationName:    ReactivateUserOperation,
+			OperationSummary: "退会ユーザーの復活",
+			OperationID:      "reactivateUser",
+			Body:             nil,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = *InitiateTalkSessionReq
+			Request  = struct{}
 			Params   = struct{}
-			Response = InitiateTalkSessionRes
+			Response = ReactivateUserRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8394,12 +20041,12 @@ func (s *Server) handleInitiateTalkSessionRequest(args [0]string, argsEscaped bo
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.InitiateTalkSession(ctx, request)
+				response, err = s.h.ReactivateUser(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.InitiateTalkSession(ctx, request)
+		response, err = s.h.ReactivateUser(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -8407,7 +20054,7 @@ func (s *Server) handleInitiateTalkSessionRequest(args [0]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeInitiateTalkSessionResponse(response, w, span); err != nil {
+	if err := encodeReactivateUserResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8416,26 +20063,22 @@ func (s *Server) handleInitiateTalkSessionRequest(args [0]string, argsEscaped bo
 	}
 }
 
-// handleInviteOrganizationRequest handles inviteOrganization operation.
+// handleRegisterDeviceRequest handles registerDevice operation.
 //
-// Role
-// - 10: SuperAdmin
-// - 20: Owner
-// - 30: Admin
-// - 40: Member.
+// デバイス登録/更新.
 //
-// POST /organizations/invite
-func (s *Server) handleInviteOrganizationRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /notifications/devices
+func (s *Server) handleRegisterDeviceRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("inviteOrganization"),
+		otelogen.OperationID("registerDevice"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/organizations/invite"),
+		semconv.HTTPRouteKey.String("/notifications/devices"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), InviteOrganizationOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RegisterDeviceOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8490,15 +20133,15 @@ func (s *Server) handleInviteOrganizationRequest(args [0]string, argsEscaped boo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: InviteOrganizationOperation,
-			ID:   "inviteOrganization",
+			Name: RegisterDeviceOperation,
+			ID:   "registerDevice",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, InviteOrganizationOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, RegisterDeviceOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8514,11 +20157,29 @@ func (s *Server) handleInviteOrganizationRequest(args [0]string, argsEscaped boo
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, RegisterDeviceOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -8538,7 +20199,7 @@ func (s *Server) handleInviteOrganizationRequest(args [0]string, argsEscaped boo
 			return
 		}
 	}
-	request, close, err := s.decodeInviteOrganizationRequest(r)
+	request, close, err := s.decodeRegisterDeviceRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -8554,22 +20215,22 @@ func (s *Server) handleInviteOrganizationRequest(args [0]string, argsEscaped boo
 		}
 	}()
 
-	var response InviteOrganizationRes
+	var response RegisterDeviceRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    InviteOrganizationOperation,
-			OperationSummary: "組織ユーザー招待（運営ユーザーのみ）",
-			OperationID:      "inviteOrganization",
+			OperationName:    RegisterDeviceOperation,
+			OperationSummary: "デバイス登録/更新",
+			OperationID:      "registerDevice",
 			Body:             request,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = *InviteOrganizationReq
+			Request  = *RegisterDeviceReq
 			Params   = struct{}
-			Response = InviteOrganizationRes
+			Response = RegisterDeviceRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8580,12 +20241,12 @@ func (s *Server) handleInviteOrganizationRequest(args [0]string, argsEscaped boo
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.InviteOrganization(ctx, request)
+				response, err = s.h.RegisterDevice(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.InviteOrganization(ctx, request)
+		response, err = s.h.RegisterDevice(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -8593,7 +20254,7 @@ func (s *Server) handleInviteOrganizationRequest(args [0]string, argsEscaped boo
 		return
 	}
 
-	if err := encodeInviteOrganizationResponse(response, w, span); err != nil {
+	if err := encodeRegisterDeviceResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8602,22 +20263,24 @@ func (s *Server) handleInviteOrganizationRequest(args [0]string, argsEscaped boo
 	}
 }
 
-// handleInviteOrganizationForUserRequest handles inviteOrganizationForUser operation.
+// handleRemoveOrganizationUserRequest handles removeOrganizationUser operation.
 //
-// 組織にユーザーを追加.
+// 組織からユーザーを削除する。
+// ロール変更と同じ権限ルールが適用され、最後のオーナーは削除できない。
+// 削除されたユーザーの組織アカウントでのセッションは無効化される。.
 //
-// POST /organizations/invite_user
-func (s *Server) handleInviteOrganizationForUserRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /organizations/users/{userID}
+func (s *Server) handleRemoveOrganizationUserRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("inviteOrganizationForUser"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/organizations/invite_user"),
+		otelogen.OperationID("removeOrganizationUser"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/organizations/users/{userID}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), InviteOrganizationForUserOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RemoveOrganizationUserOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8672,15 +20335,15 @@ func (s *Server) handleInviteOrganizationForUserRequest(args [0]string, argsEsca
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: InviteOrganizationForUserOperation,
-			ID:   "inviteOrganizationForUser",
+			Name: RemoveOrganizationUserOperation,
+			ID:   "removeOrganizationUser",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, InviteOrganizationForUserOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, RemoveOrganizationUserOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8696,11 +20359,29 @@ func (s *Server) handleInviteOrganizationForUserRequest(args [0]string, argsEsca
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, RemoveOrganizationUserOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -8720,38 +20401,38 @@ func (s *Server) handleInviteOrganizationForUserRequest(args [0]string, argsEsca
 			return
 		}
 	}
-	request, close, err := s.decodeInviteOrganizationForUserRequest(r)
+	params, err := decodeRemoveOrganizationUserParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeRequest", err)
+		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response InviteOrganizationForUserRes
+	var response RemoveOrganizationUserRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    InviteOrganizationForUserOperation,
-			OperationSummary: "組織にユーザーを追加",
-			OperationID:      "inviteOrganizationForUser",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationName:    RemoveOrganizationUserOperation,
+			OperationSummary: "組織ユーザー削除",
+			OperationID:      "removeOrganizationUser",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "userID",
+					In:   "path",
+				}: params.UserID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *InviteOrganizationForUserReq
-			Params   = struct{}
-			Response = InviteOrganizationForUserRes
+			Request  = struct{}
+			Params   = RemoveOrganizationUserParams
+			Response = RemoveOrganizationUserRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8760,14 +20441,14 @@ func (s *Server) handleInviteOrganizationForUserRequest(args [0]string, argsEsca
 		](
 			m,
 			mreq,
-			nil,
+			unpackRemoveOrganizationUserParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.InviteOrganizationForUser(ctx, request)
+				response, err = s.h.RemoveOrganizationUser(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.InviteOrganizationForUser(ctx, request)
+		response, err = s.h.RemoveOrganizationUser(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -8775,7 +20456,7 @@ func (s *Server) handleInviteOrganizationForUserRequest(args [0]string, argsEsca
 		return
 	}
 
-	if err := encodeInviteOrganizationForUserResponse(response, w, span); err != nil {
+	if err := encodeRemoveOrganizationUserResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8784,20 +20465,22 @@ func (s *Server) handleInviteOrganizationForUserRequest(args [0]string, argsEsca
 	}
 }
 
-// handleManageRegenerateManageRequest handles manageRegenerateManage operation.
+// handleRemoveTalkSessionCollaboratorRequest handles removeTalkSessionCollaborator operation.
 //
-// POST /v1/manage/talksessions/{talkSessionID}/analysis/regenerate
-func (s *Server) handleManageRegenerateManageRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// 共同管理者を外す。オーナーと共同オーナーの他、共同管理者自身も外れることができる.
+//
+// DELETE /talksessions/{talkSessionID}/collaborators/{displayID}
+func (s *Server) handleRemoveTalkSessionCollaboratorRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("manageRegenerateManage"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/v1/manage/talksessions/{talkSessionID}/analysis/regenerate"),
+		otelogen.OperationID("removeTalkSessionCollaborator"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/collaborators/{displayID}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ManageRegenerateManageOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RemoveTalkSessionCollaboratorOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -8852,15 +20535,15 @@ func (s *Server) handleManageRegenerateManageRequest(args [1]string, argsEscaped
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ManageRegenerateManageOperation,
-			ID:   "manageRegenerateManage",
+			Name: RemoveTalkSessionCollaboratorOperation,
+			ID:   "removeTalkSessionCollaborator",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, ManageRegenerateManageOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, RemoveTalkSessionCollaboratorOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -8876,11 +20559,29 @@ func (s *Server) handleManageRegenerateManageRequest(args [1]string, argsEscaped
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, RemoveTalkSessionCollaboratorOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -8900,7 +20601,7 @@ func (s *Server) handleManageRegenerateManageRequest(args [1]string, argsEscaped
 			return
 		}
 	}
-	params, err := decodeManageRegenerateManageParams(args, argsEscaped, r)
+	params, err := decodeRemoveTalkSessionCollaboratorParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -8910,43 +20611,32 @@ func (s *Server) handleManageRegenerateManageRequest(args [1]string, argsEscaped
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeManageRegenerateManageRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response *RegenerateResponse
+	var response RemoveTalkSessionCollaboratorRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ManageRegenerateManageOperation,
-			OperationSummary: "",
-			OperationID:      "manageRegenerateManage",
-			Body:             request,
+			OperationName:    RemoveTalkSessionCollaboratorOperation,
+			OperationSummary: "セッションの共同管理者を削除",
+			OperationID:      "removeTalkSessionCollaborator",
+			Body:             nil,
 			Params: middleware.Parameters{
 				{
 					Name: "talkSessionID",
 					In:   "path",
 				}: params.TalkSessionID,
+				{
+					Name: "displayID",
+					In:   "path",
+				}: params.DisplayID,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *RegenerateRequest
-			Params   = ManageRegenerateManageParams
-			Response = *RegenerateResponse
+			Request  = struct{}
+			Params   = RemoveTalkSessionCollaboratorParams
+			Response = RemoveTalkSessionCollaboratorRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -8955,14 +20645,14 @@ func (s *Server) handleManageRegenerateManageRequest(args [1]string, argsEscaped
 		](
 			m,
 			mreq,
-			unpackManageRegenerateManageParams,
+			unpackRemoveTalkSessionCollaboratorParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ManageRegenerateManage(ctx, request, params)
+				response, err = s.h.RemoveTalkSessionCollaborator(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ManageRegenerateManage(ctx, request, params)
+		response, err = s.h.RemoveTalkSessionCollaborator(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -8970,7 +20660,7 @@ func (s *Server) handleManageRegenerateManageRequest(args [1]string, argsEscaped
 		return
 	}
 
-	if err := encodeManageRegenerateManageResponse(response, w, span); err != nil {
+	if err := encodeRemoveTalkSessionCollaboratorResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -8979,22 +20669,22 @@ func (s *Server) handleManageRegenerateManageRequest(args [1]string, argsEscaped
 	}
 }
 
-// handleOpinionComments2Request handles opinionComments2 operation.
+// handleReportOpinionRequest handles reportOpinion operation.
 //
-// 意見に対するリプライ意見一覧.
+// 意見通報API.
 //
-// GET /opinions/{opinionID}/replies
-func (s *Server) handleOpinionComments2Request(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /opinions/{opinionID}/report
+func (s *Server) handleReportOpinionRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("opinionComments2"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/opinions/{opinionID}/replies"),
+		otelogen.OperationID("reportOpinion"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/opinions/{opinionID}/report"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), OpinionComments2Operation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ReportOpinionOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9038,22 +20728,84 @@ func (s *Server) handleOpinionComments2Request(args [1]string, argsEscaped bool,
 			if code >= 100 && code < 500 {
 				span.SetStatus(codes.Error, stage)
 			}
-
-			attrSet := labeler.AttributeSet()
-			attrs := attrSet.ToSlice()
-			if code != 0 {
-				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+
+			attrSet := labeler.AttributeSet()
+			attrs := attrSet.ToSlice()
+			if code != 0 {
+				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
+			}
+
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ReportOpinionOperation,
+			ID:   "reportOpinion",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, ReportOpinionOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, ReportOpinionOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
 			}
-
-			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
 		}
-		err          error
-		opErrContext = ogenerrors.OperationContext{
-			Name: OpinionComments2Operation,
-			ID:   "opinionComments2",
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
 		}
-	)
-	params, err := decodeOpinionComments2Params(args, argsEscaped, r)
+	}
+	params, err := decodeReportOpinionParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -9063,15 +20815,30 @@ func (s *Server) handleOpinionComments2Request(args [1]string, argsEscaped bool,
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeReportOpinionRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response OpinionComments2Res
+	var response ReportOpinionRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    OpinionComments2Operation,
-			OperationSummary: "意見に対するリプライ意見一覧",
-			OperationID:      "opinionComments2",
-			Body:             nil,
+			OperationName:    ReportOpinionOperation,
+			OperationSummary: "意見通報API",
+			OperationID:      "reportOpinion",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
 					Name: "opinionID",
@@ -9082,9 +20849,9 @@ func (s *Server) handleOpinionComments2Request(args [1]string, argsEscaped bool,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = OpinionComments2Params
-			Response = OpinionComments2Res
+			Request  = *ReportOpinionReq
+			Params   = ReportOpinionParams
+			Response = ReportOpinionRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9093,14 +20860,14 @@ func (s *Server) handleOpinionComments2Request(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackOpinionComments2Params,
+			unpackReportOpinionParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.OpinionComments2(ctx, params)
+				response, err = s.h.ReportOpinion(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.OpinionComments2(ctx, params)
+		response, err = s.h.ReportOpinion(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -9108,7 +20875,7 @@ func (s *Server) handleOpinionComments2Request(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeOpinionComments2Response(response, w, span); err != nil {
+	if err := encodeReportOpinionResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9117,22 +20884,22 @@ func (s *Server) handleOpinionComments2Request(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleOpinionsHistoryRequest handles opinionsHistory operation.
+// handleRequestOrganizationOwnershipTransferRequest handles requestOrganizationOwnershipTransfer operation.
 //
-// 今までに投稿した意見.
+// 組織のオーナーか運営のみ依頼できる。譲渡先は組織に直接所属するメンバーに限る.
 //
-// GET /opinions/histories
-func (s *Server) handleOpinionsHistoryRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /organizations/{code}/ownership-transfers
+func (s *Server) handleRequestOrganizationOwnershipTransferRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("opinionsHistory"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/opinions/histories"),
+		otelogen.OperationID("requestOrganizationOwnershipTransfer"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/organizations/{code}/ownership-transfers"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), OpinionsHistoryOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RequestOrganizationOwnershipTransferOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9187,15 +20954,15 @@ func (s *Server) handleOpinionsHistoryRequest(args [0]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: OpinionsHistoryOperation,
-			ID:   "opinionsHistory",
+			Name: RequestOrganizationOwnershipTransferOperation,
+			ID:   "requestOrganizationOwnershipTransfer",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, OpinionsHistoryOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, RequestOrganizationOwnershipTransferOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9211,11 +20978,29 @@ func (s *Server) handleOpinionsHistoryRequest(args [0]string, argsEscaped bool,
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, RequestOrganizationOwnershipTransferOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -9235,7 +21020,7 @@ func (s *Server) handleOpinionsHistoryRequest(args [0]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeOpinionsHistoryParams(args, argsEscaped, r)
+	params, err := decodeRequestOrganizationOwnershipTransferParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -9245,36 +21030,43 @@ func (s *Server) handleOpinionsHistoryRequest(args [0]string, argsEscaped bool,
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeRequestOrganizationOwnershipTransferRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response OpinionsHistoryRes
+	var response RequestOrganizationOwnershipTransferRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    OpinionsHistoryOperation,
-			OperationSummary: "今までに投稿した意見",
-			OperationID:      "opinionsHistory",
-			Body:             nil,
+			OperationName:    RequestOrganizationOwnershipTransferOperation,
+			OperationSummary: "組織のオーナー権限の譲渡を依頼",
+			OperationID:      "requestOrganizationOwnershipTransfer",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
-					Name: "sort",
-					In:   "query",
-				}: params.Sort,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
-				{
-					Name: "offset",
-					In:   "query",
-				}: params.Offset,
+					Name: "code",
+					In:   "path",
+				}: params.Code,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = OpinionsHistoryParams
-			Response = OpinionsHistoryRes
+			Request  = *RequestOrganizationOwnershipTransferReq
+			Params   = RequestOrganizationOwnershipTransferParams
+			Response = RequestOrganizationOwnershipTransferRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9283,14 +21075,14 @@ func (s *Server) handleOpinionsHistoryRequest(args [0]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackOpinionsHistoryParams,
+			unpackRequestOrganizationOwnershipTransferParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.OpinionsHistory(ctx, params)
+				response, err = s.h.RequestOrganizationOwnershipTransfer(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.OpinionsHistory(ctx, params)
+		response, err = s.h.RequestOrganizationOwnershipTransfer(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -9298,7 +21090,7 @@ func (s *Server) handleOpinionsHistoryRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeOpinionsHistoryResponse(response, w, span); err != nil {
+	if err := encodeRequestOrganizationOwnershipTransferResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9307,22 +21099,22 @@ func (s *Server) handleOpinionsHistoryRequest(args [0]string, argsEscaped bool,
 	}
 }
 
-// handlePasswordLoginRequest handles passwordLogin operation.
+// handleRequestTalkSessionOwnershipTransferRequest handles requestTalkSessionOwnershipTransfer operation.
 //
-// パスワードによるログイン.
+// セッションのオーナーか組織の管理者以上のみ依頼できる。組織のセッションは組織のメンバーにのみ譲渡できる.
 //
-// POST /auth/password/login
-func (s *Server) handlePasswordLoginRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /talksessions/{talkSessionID}/ownership-transfers
+func (s *Server) handleRequestTalkSessionOwnershipTransferRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("passwordLogin"),
+		otelogen.OperationID("requestTalkSessionOwnershipTransfer"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/auth/password/login"),
+		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/ownership-transfers"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), PasswordLoginOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RequestTalkSessionOwnershipTransferOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9377,11 +21169,83 @@ func (s *Server) handlePasswordLoginRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: PasswordLoginOperation,
-			ID:   "passwordLogin",
+			Name: RequestTalkSessionOwnershipTransferOperation,
+			ID:   "requestTalkSessionOwnershipTransfer",
 		}
 	)
-	request, close, err := s.decodePasswordLoginRequest(r)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, RequestTalkSessionOwnershipTransferOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, RequestTalkSessionOwnershipTransferOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeRequestTalkSessionOwnershipTransferParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeRequestTalkSessionOwnershipTransferRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -9397,22 +21261,27 @@ func (s *Server) handlePasswordLoginRequest(args [0]string, argsEscaped bool, w
 		}
 	}()
 
-	var response PasswordLoginRes
+	var response RequestTalkSessionOwnershipTransferRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    PasswordLoginOperation,
-			OperationSummary: "パスワードによるログイン",
-			OperationID:      "passwordLogin",
+			OperationName:    RequestTalkSessionOwnershipTransferOperation,
+			OperationSummary: "セッションのオーナー権限の譲渡を依頼",
+			OperationID:      "requestTalkSessionOwnershipTransfer",
 			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "talkSessionID",
+					In:   "path",
+				}: params.TalkSessionID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *PasswordLoginReq
-			Params   = struct{}
-			Response = PasswordLoginRes
+			Request  = *RequestTalkSessionOwnershipTransferReq
+			Params   = RequestTalkSessionOwnershipTransferParams
+			Response = RequestTalkSessionOwnershipTransferRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9421,14 +21290,14 @@ func (s *Server) handlePasswordLoginRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			nil,
+			unpackRequestTalkSessionOwnershipTransferParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.PasswordLogin(ctx, request)
+				response, err = s.h.RequestTalkSessionOwnershipTransfer(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.PasswordLogin(ctx, request)
+		response, err = s.h.RequestTalkSessionOwnershipTransfer(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -9436,7 +21305,7 @@ func (s *Server) handlePasswordLoginRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodePasswordLoginResponse(response, w, span); err != nil {
+	if err := encodeRequestTalkSessionOwnershipTransferResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9445,22 +21314,23 @@ func (s *Server) handlePasswordLoginRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handlePasswordRegisterRequest handles passwordRegister operation.
+// handleResendOrganizationInvitationRequest handles resendOrganizationInvitation operation.
 //
-// パスワードによる登録（devのみ）.
-//
-// POST /auth/password/register
-func (s *Server) handlePasswordRegisterRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// 招待リンクを再発行してメールを再送する。
+// 以前に送信したリンクは使えなくなり、有効期限は再送時から7日間に延長される。.
+//
+// POST /organizations/invitations/{invitationID}/resend
+func (s *Server) handleResendOrganizationInvitationRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("passwordRegister"),
+		otelogen.OperationID("resendOrganizationInvitation"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/auth/password/register"),
+		semconv.HTTPRouteKey.String("/organizations/invitations/{invitationID}/resend"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), PasswordRegisterOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ResendOrganizationInvitationOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9515,42 +21385,104 @@ func (s *Server) handlePasswordRegisterRequest(args [0]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: PasswordRegisterOperation,
-			ID:   "passwordRegister",
+			Name: ResendOrganizationInvitationOperation,
+			ID:   "resendOrganizationInvitation",
 		}
 	)
-	request, close, err := s.decodePasswordRegisterRequest(r)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, ResendOrganizationInvitationOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, ResendOrganizationInvitationOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeResendOrganizationInvitationParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeRequest", err)
+		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response PasswordRegisterRes
+	var response ResendOrganizationInvitationRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    PasswordRegisterOperation,
-			OperationSummary: "パスワードによる登録（devのみ）",
-			OperationID:      "passwordRegister",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationName:    ResendOrganizationInvitationOperation,
+			OperationSummary: "組織への招待の再送",
+			OperationID:      "resendOrganizationInvitation",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "invitationID",
+					In:   "path",
+				}: params.InvitationID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *PasswordRegisterReq
-			Params   = struct{}
-			Response = PasswordRegisterRes
+			Request  = struct{}
+			Params   = ResendOrganizationInvitationParams
+			Response = ResendOrganizationInvitationRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9559,14 +21491,14 @@ func (s *Server) handlePasswordRegisterRequest(args [0]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			nil,
+			unpackResendOrganizationInvitationParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.PasswordRegister(ctx, request)
+				response, err = s.h.ResendOrganizationInvitation(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.PasswordRegister(ctx, request)
+		response, err = s.h.ResendOrganizationInvitation(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -9574,7 +21506,7 @@ func (s *Server) handlePasswordRegisterRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodePasswordRegisterResponse(response, w, span); err != nil {
+	if err := encodeResendOrganizationInvitationResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9583,22 +21515,22 @@ func (s *Server) handlePasswordRegisterRequest(args [0]string, argsEscaped bool,
 	}
 }
 
-// handlePolicyConsentRequest handles policyConsent operation.
+// handleRevokeOrganizationApiKeyRequest handles revokeOrganizationApiKey operation.
 //
-// 最新のポリシーに同意する.
+// 組織APIキー失効.
 //
-// POST /policy/consent
-func (s *Server) handlePolicyConsentRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /organizations/api-keys/{apiKeyID}
+func (s *Server) handleRevokeOrganizationApiKeyRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("policyConsent"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/policy/consent"),
+		otelogen.OperationID("revokeOrganizationApiKey"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/organizations/api-keys/{apiKeyID}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), PolicyConsentOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RevokeOrganizationApiKeyOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9653,15 +21585,15 @@ func (s *Server) handlePolicyConsentRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: PolicyConsentOperation,
-			ID:   "policyConsent",
+			Name: RevokeOrganizationApiKeyOperation,
+			ID:   "revokeOrganizationApiKey",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, PolicyConsentOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, RevokeOrganizationApiKeyOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9677,11 +21609,29 @@ func (s *Server) handlePolicyConsentRequest(args [0]string, argsEscaped bool, w
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, RevokeOrganizationApiKeyOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -9701,38 +21651,38 @@ func (s *Server) handlePolicyConsentRequest(args [0]string, argsEscaped bool, w
 			return
 		}
 	}
-	request, close, err := s.decodePolicyConsentRequest(r)
+	params, err := decodeRevokeOrganizationApiKeyParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeRequest", err)
+		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response PolicyConsentRes
+	var response RevokeOrganizationApiKeyRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    PolicyConsentOperation,
-			OperationSummary: "最新のポリシーに同意する",
-			OperationID:      "policyConsent",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationName:    RevokeOrganizationApiKeyOperation,
+			OperationSummary: "組織APIキー失効",
+			OperationID:      "revokeOrganizationApiKey",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "apiKeyID",
+					In:   "path",
+				}: params.ApiKeyID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *PolicyConsentReq
-			Params   = struct{}
-			Response = PolicyConsentRes
+			Request  = struct{}
+			Params   = RevokeOrganizationApiKeyParams
+			Response = RevokeOrganizationApiKeyRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9741,14 +21691,14 @@ func (s *Server) handlePolicyConsentRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			nil,
+			unpackRevokeOrganizationApiKeyParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.PolicyConsent(ctx, request)
+				response, err = s.h.RevokeOrganizationApiKey(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.PolicyConsent(ctx, request)
+		response, err = s.h.RevokeOrganizationApiKey(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -9756,7 +21706,7 @@ func (s *Server) handlePolicyConsentRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodePolicyConsentResponse(response, w, span); err != nil {
+	if err := encodeRevokeOrganizationApiKeyResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9765,23 +21715,22 @@ func (s *Server) handlePolicyConsentRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handlePostConclusionRequest handles postConclusion operation.
+// handleRevokeOrganizationInvitationRequest handles revokeOrganizationInvitation operation.
 //
-// 結論（conclusion）はセッションが終了した後にセッっションの作成者が投稿できる文章。
-// セッションの流れやグループの分かれ方などに対するセッション作成者の感想やそれらの意見を受け、これからの方向性などを記入する。.
+// 組織への招待の取り消し.
 //
-// POST /talksessions/{talkSessionID}/conclusion
-func (s *Server) handlePostConclusionRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /organizations/invitations/{invitationID}
+func (s *Server) handleRevokeOrganizationInvitationRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("postConclusion"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/conclusion"),
+		otelogen.OperationID("revokeOrganizationInvitation"),
+		semconv.HTTPRequestMethodKey.String("DELETE"),
+		semconv.HTTPRouteKey.String("/organizations/invitations/{invitationID}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), PostConclusionOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RevokeOrganizationInvitationOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -9836,15 +21785,15 @@ func (s *Server) handlePostConclusionRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: PostConclusionOperation,
-			ID:   "postConclusion",
+			Name: RevokeOrganizationInvitationOperation,
+			ID:   "revokeOrganizationInvitation",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, PostConclusionOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, RevokeOrganizationInvitationOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -9860,11 +21809,29 @@ func (s *Server) handlePostConclusionRequest(args [1]string, argsEscaped bool, w
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, RevokeOrganizationInvitationOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -9884,7 +21851,7 @@ func (s *Server) handlePostConclusionRequest(args [1]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodePostConclusionParams(args, argsEscaped, r)
+	params, err := decodeRevokeOrganizationInvitationParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -9894,43 +21861,28 @@ func (s *Server) handlePostConclusionRequest(args [1]string, argsEscaped bool, w
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodePostConclusionRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response PostConclusionRes
+	var response RevokeOrganizationInvitationRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    PostConclusionOperation,
-			OperationSummary: "結論投稿",
-			OperationID:      "postConclusion",
-			Body:             request,
+			OperationName:    RevokeOrganizationInvitationOperation,
+			OperationSummary: "組織への招待の取り消し",
+			OperationID:      "revokeOrganizationInvitation",
+			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "talkSessionID",
+					Name: "invitationID",
 					In:   "path",
-				}: params.TalkSessionID,
+				}: params.InvitationID,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *PostConclusionReq
-			Params   = PostConclusionParams
-			Response = PostConclusionRes
+			Request  = struct{}
+			Params   = RevokeOrganizationInvitationParams
+			Response = RevokeOrganizationInvitationRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -9939,14 +21891,14 @@ func (s *Server) handlePostConclusionRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackPostConclusionParams,
+			unpackRevokeOrganizationInvitationParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.PostConclusion(ctx, request, params)
+				response, err = s.h.RevokeOrganizationInvitation(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.PostConclusion(ctx, request, params)
+		response, err = s.h.RevokeOrganizationInvitation(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -9954,7 +21906,7 @@ func (s *Server) handlePostConclusionRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodePostConclusionResponse(response, w, span); err != nil {
+	if err := encodeRevokeOrganizationInvitationResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -9963,22 +21915,22 @@ func (s *Server) handlePostConclusionRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handlePostImageRequest handles postImage operation.
+// handleRevokeTokenRequest handles revokeToken operation.
 //
-// 画像を投稿してURLを返すAPI.
+// トークンを失効（ログアウト）.
 //
-// POST /images
-func (s *Server) handlePostImageRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /auth/revoke
+func (s *Server) handleRevokeTokenRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("postImage"),
+		otelogen.OperationID("revokeToken"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/images"),
+		semconv.HTTPRouteKey.String("/auth/revoke"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), PostImageOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RevokeTokenOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10033,15 +21985,15 @@ func (s *Server) handlePostImageRequest(args [0]string, argsEscaped bool, w http
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: PostImageOperation,
-			ID:   "postImage",
+			Name: RevokeTokenOperation,
+			ID:   "revokeToken",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, PostImageOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, RevokeTokenOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10057,11 +22009,29 @@ func (s *Server) handlePostImageRequest(args [0]string, argsEscaped bool, w http
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, RevokeTokenOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -10080,39 +22050,24 @@ func (s *Server) handlePostImageRequest(args [0]string, argsEscaped bool, w http
 			s.cfg.ErrorHandler(ctx, w, r, err)
 			return
 		}
-	}
-	request, close, err := s.decodePostImageRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
+	}
 
-	var response PostImageRes
+	var response RevokeTokenRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    PostImageOperation,
-			OperationSummary: "画像投稿",
-			OperationID:      "postImage",
-			Body:             request,
+			OperationName:    RevokeTokenOperation,
+			OperationSummary: "トークンを失効（ログアウト）",
+			OperationID:      "revokeToken",
+			Body:             nil,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = *PostImageReq
+			Request  = struct{}
 			Params   = struct{}
-			Response = PostImageRes
+			Response = RevokeTokenRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10123,12 +22078,12 @@ func (s *Server) handlePostImageRequest(args [0]string, argsEscaped bool, w http
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.PostImage(ctx, request)
+				response, err = s.h.RevokeToken(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.PostImage(ctx, request)
+		response, err = s.h.RevokeToken(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -10136,7 +22091,7 @@ func (s *Server) handlePostImageRequest(args [0]string, argsEscaped bool, w http
 		return
 	}
 
-	if err := encodePostImageResponse(response, w, span); err != nil {
+	if err := encodeRevokeTokenResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10145,24 +22100,20 @@ func (s *Server) handlePostImageRequest(args [0]string, argsEscaped bool, w http
 	}
 }
 
-// handlePostOpinionPost2Request handles postOpinionPost2 operation.
-//
-// ParentOpinionIDがなければルートの意見として投稿される
-// parentOpinionIDがない場合はtalkSessionIDが必須
-// セッション管理者はisSeedをtrueにするとシード意見として投稿できる.
+// handleRotateSigningKeyManageRequest handles rotateSigningKeyManage operation.
 //
-// POST /opinions
-func (s *Server) handlePostOpinionPost2Request(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v1/manage/auth/signing-keys/rotate
+func (s *Server) handleRotateSigningKeyManageRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("postOpinionPost2"),
+		otelogen.OperationID("rotateSigningKeyManage"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/opinions"),
+		semconv.HTTPRouteKey.String("/v1/manage/auth/signing-keys/rotate"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), PostOpinionPost2Operation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), RotateSigningKeyManageOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10217,15 +22168,15 @@ func (s *Server) handlePostOpinionPost2Request(args [0]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: PostOpinionPost2Operation,
-			ID:   "postOpinionPost2",
+			Name: RotateSigningKeyManageOperation,
+			ID:   "rotateSigningKeyManage",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, PostOpinionPost2Operation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, RotateSigningKeyManageOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10241,11 +22192,29 @@ func (s *Server) handlePostOpinionPost2Request(args [0]string, argsEscaped bool,
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, RotateSigningKeyManageOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -10265,38 +22234,23 @@ func (s *Server) handlePostOpinionPost2Request(args [0]string, argsEscaped bool,
 			return
 		}
 	}
-	request, close, err := s.decodePostOpinionPost2Request(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response PostOpinionPost2Res
+	var response *SigningKeyForManage
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    PostOpinionPost2Operation,
-			OperationSummary: "セッションに対して意見投稿 or 意見に対するリプライ",
-			OperationID:      "postOpinionPost2",
-			Body:             request,
+			OperationName:    RotateSigningKeyManageOperation,
+			OperationSummary: "",
+			OperationID:      "rotateSigningKeyManage",
+			Body:             nil,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = *PostOpinionPost2Req
+			Request  = struct{}
 			Params   = struct{}
-			Response = PostOpinionPost2Res
+			Response = *SigningKeyForManage
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10307,12 +22261,12 @@ func (s *Server) handlePostOpinionPost2Request(args [0]string, argsEscaped bool,
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.PostOpinionPost2(ctx, request)
+				response, err = s.h.RotateSigningKeyManage(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.PostOpinionPost2(ctx, request)
+		response, err = s.h.RotateSigningKeyManage(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -10320,7 +22274,7 @@ func (s *Server) handlePostOpinionPost2Request(args [0]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodePostOpinionPost2Response(response, w, span); err != nil {
+	if err := encodeRotateSigningKeyManageResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10329,22 +22283,23 @@ func (s *Server) handlePostOpinionPost2Request(args [0]string, argsEscaped bool,
 	}
 }
 
-// handlePostTimeLineItemRequest handles postTimeLineItem operation.
+// handleSaveTalkSessionTemplateRequest handles saveTalkSessionTemplate operation.
 //
-// タイムラインアイテム追加.
+// セッションの参加制限・説明・シード意見・サムネイルなどを組織のテンプレートとして保存する。
+// セッションのオーナーか、セッションが属する組織の管理者のみ保存できる。.
 //
-// POST /talksessions/{talkSessionID}/timeline
-func (s *Server) handlePostTimeLineItemRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /talksessions/{talkSessionID}/templates
+func (s *Server) handleSaveTalkSessionTemplateRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("postTimeLineItem"),
+		otelogen.OperationID("saveTalkSessionTemplate"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/timeline"),
+		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/templates"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), PostTimeLineItemOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), SaveTalkSessionTemplateOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10399,15 +22354,15 @@ func (s *Server) handlePostTimeLineItemRequest(args [1]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: PostTimeLineItemOperation,
-			ID:   "postTimeLineItem",
+			Name: SaveTalkSessionTemplateOperation,
+			ID:   "saveTalkSessionTemplate",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, PostTimeLineItemOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, SaveTalkSessionTemplateOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10423,11 +22378,29 @@ func (s *Server) handlePostTimeLineItemRequest(args [1]string, argsEscaped bool,
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, SaveTalkSessionTemplateOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -10447,7 +22420,7 @@ func (s *Server) handlePostTimeLineItemRequest(args [1]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodePostTimeLineItemParams(args, argsEscaped, r)
+	params, err := decodeSaveTalkSessionTemplateParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -10457,7 +22430,7 @@ func (s *Server) handlePostTimeLineItemRequest(args [1]string, argsEscaped bool,
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodePostTimeLineItemRequest(r)
+	request, close, err := s.decodeSaveTalkSessionTemplateRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -10473,13 +22446,13 @@ func (s *Server) handlePostTimeLineItemRequest(args [1]string, argsEscaped bool,
 		}
 	}()
 
-	var response PostTimeLineItemRes
+	var response SaveTalkSessionTemplateRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    PostTimeLineItemOperation,
-			OperationSummary: "タイムラインアイテム追加",
-			OperationID:      "postTimeLineItem",
+			OperationName:    SaveTalkSessionTemplateOperation,
+			OperationSummary: "セッションをテンプレートとして保存",
+			OperationID:      "saveTalkSessionTemplate",
 			Body:             request,
 			Params: middleware.Parameters{
 				{
@@ -10491,9 +22464,9 @@ func (s *Server) handlePostTimeLineItemRequest(args [1]string, argsEscaped bool,
 		}
 
 		type (
-			Request  = *PostTimeLineItemReq
-			Params   = PostTimeLineItemParams
-			Response = PostTimeLineItemRes
+			Request  = *SaveTalkSessionTemplateReq
+			Params   = SaveTalkSessionTemplateParams
+			Response = SaveTalkSessionTemplateRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10502,14 +22475,14 @@ func (s *Server) handlePostTimeLineItemRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackPostTimeLineItemParams,
+			unpackSaveTalkSessionTemplateParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.PostTimeLineItem(ctx, request, params)
+				response, err = s.h.SaveTalkSessionTemplate(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.PostTimeLineItem(ctx, request, params)
+		response, err = s.h.SaveTalkSessionTemplate(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -10517,7 +22490,7 @@ func (s *Server) handlePostTimeLineItemRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodePostTimeLineItemResponse(response, w, span); err != nil {
+	if err := encodeSaveTalkSessionTemplateResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10526,22 +22499,22 @@ func (s *Server) handlePostTimeLineItemRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleReactivateUserRequest handles reactivateUser operation.
+// handleSendTestNotificationRequest handles sendTestNotification operation.
 //
-// 退会ユーザーの復活.
+// テスト通知送信.
 //
-// POST /auth/reactivate
-func (s *Server) handleReactivateUserRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /notifications/test
+func (s *Server) handleSendTestNotificationRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("reactivateUser"),
+		otelogen.OperationID("sendTestNotification"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/auth/reactivate"),
+		semconv.HTTPRouteKey.String("/notifications/test"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ReactivateUserOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), SendTestNotificationOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10596,15 +22569,15 @@ func (s *Server) handleReactivateUserRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ReactivateUserOperation,
-			ID:   "reactivateUser",
+			Name: SendTestNotificationOperation,
+			ID:   "sendTestNotification",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, ReactivateUserOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, SendTestNotificationOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10620,11 +22593,29 @@ func (s *Server) handleReactivateUserRequest(args [0]string, argsEscaped bool, w
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, SendTestNotificationOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -10644,23 +22635,38 @@ func (s *Server) handleReactivateUserRequest(args [0]string, argsEscaped bool, w
 			return
 		}
 	}
+	request, close, err := s.decodeSendTestNotificationRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response ReactivateUserRes
+	var response SendTestNotificationRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ReactivateUserOperation,
-			OperationSummary: "退会ユーザーの復活",
-			OperationID:      "reactivateUser",
-			Body:             nil,
+			OperationName:    SendTestNotificationOperation,
+			OperationSummary: "テスト通知送信",
+			OperationID:      "sendTestNotification",
+			Body:             request,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
+			Request  = *SendTestNotificationReq
 			Params   = struct{}
-			Response = ReactivateUserRes
+			Response = SendTestNotificationRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10671,12 +22677,12 @@ func (s *Server) handleReactivateUserRequest(args [0]string, argsEscaped bool, w
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ReactivateUser(ctx)
+				response, err = s.h.SendTestNotification(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ReactivateUser(ctx)
+		response, err = s.h.SendTestNotification(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -10684,7 +22690,7 @@ func (s *Server) handleReactivateUserRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeReactivateUserResponse(response, w, span); err != nil {
+	if err := encodeSendTestNotificationResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10693,22 +22699,22 @@ func (s *Server) handleReactivateUserRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleRegisterDeviceRequest handles registerDevice operation.
+// handleSessionsHistoryRequest handles sessionsHistory operation.
 //
-// デバイス登録/更新.
+// リアクション済みのセッション一覧.
 //
-// POST /notifications/devices
-func (s *Server) handleRegisterDeviceRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /talksessions/histories
+func (s *Server) handleSessionsHistoryRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("registerDevice"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/notifications/devices"),
+		otelogen.OperationID("sessionsHistory"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/talksessions/histories"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), RegisterDeviceOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), SessionsHistoryOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10763,15 +22769,15 @@ func (s *Server) handleRegisterDeviceRequest(args [0]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: RegisterDeviceOperation,
-			ID:   "registerDevice",
+			Name: SessionsHistoryOperation,
+			ID:   "sessionsHistory",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, RegisterDeviceOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, SessionsHistoryOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10787,11 +22793,29 @@ func (s *Server) handleRegisterDeviceRequest(args [0]string, argsEscaped bool, w
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, SessionsHistoryOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -10811,38 +22835,50 @@ func (s *Server) handleRegisterDeviceRequest(args [0]string, argsEscaped bool, w
 			return
 		}
 	}
-	request, close, err := s.decodeRegisterDeviceRequest(r)
+	params, err := decodeSessionsHistoryParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeRequest", err)
+		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response RegisterDeviceRes
+	var response SessionsHistoryRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    RegisterDeviceOperation,
-			OperationSummary: "デバイス登録/更新",
-			OperationID:      "registerDevice",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationName:    SessionsHistoryOperation,
+			OperationSummary: "リアクション済みのセッション一覧",
+			OperationID:      "sessionsHistory",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "offset",
+					In:   "query",
+				}: params.Offset,
+				{
+					Name: "theme",
+					In:   "query",
+				}: params.Theme,
+				{
+					Name: "status",
+					In:   "query",
+				}: params.Status,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *RegisterDeviceReq
-			Params   = struct{}
-			Response = RegisterDeviceRes
+			Request  = struct{}
+			Params   = SessionsHistoryParams
+			Response = SessionsHistoryRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -10851,14 +22887,14 @@ func (s *Server) handleRegisterDeviceRequest(args [0]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			nil,
+			unpackSessionsHistoryParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.RegisterDevice(ctx, request)
+				response, err = s.h.SessionsHistory(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.RegisterDevice(ctx, request)
+		response, err = s.h.SessionsHistory(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -10866,7 +22902,7 @@ func (s *Server) handleRegisterDeviceRequest(args [0]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeRegisterDeviceResponse(response, w, span); err != nil {
+	if err := encodeSessionsHistoryResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -10875,22 +22911,23 @@ func (s *Server) handleRegisterDeviceRequest(args [0]string, argsEscaped bool, w
 	}
 }
 
-// handleReportOpinionRequest handles reportOpinion operation.
+// handleSetTalkSessionCollaboratorRequest handles setTalkSessionCollaborator operation.
 //
-// 意見通報API.
+// 共同管理者を追加する。既に共同管理者の場合はロールを変更する。
+// オーナーと共同オーナーのみ実行できる。.
 //
-// POST /opinions/{opinionID}/report
-func (s *Server) handleReportOpinionRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /talksessions/{talkSessionID}/collaborators/{displayID}
+func (s *Server) handleSetTalkSessionCollaboratorRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("reportOpinion"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/opinions/{opinionID}/report"),
+		otelogen.OperationID("setTalkSessionCollaborator"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/collaborators/{displayID}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ReportOpinionOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), SetTalkSessionCollaboratorOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -10945,15 +22982,15 @@ func (s *Server) handleReportOpinionRequest(args [1]string, argsEscaped bool, w
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ReportOpinionOperation,
-			ID:   "reportOpinion",
+			Name: SetTalkSessionCollaboratorOperation,
+			ID:   "setTalkSessionCollaborator",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, ReportOpinionOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, SetTalkSessionCollaboratorOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -10969,11 +23006,29 @@ func (s *Server) handleReportOpinionRequest(args [1]string, argsEscaped bool, w
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, SetTalkSessionCollaboratorOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -10993,7 +23048,7 @@ func (s *Server) handleReportOpinionRequest(args [1]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeReportOpinionParams(args, argsEscaped, r)
+	params, err := decodeSetTalkSessionCollaboratorParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -11003,7 +23058,7 @@ func (s *Server) handleReportOpinionRequest(args [1]string, argsEscaped bool, w
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeReportOpinionRequest(r)
+	request, close, err := s.decodeSetTalkSessionCollaboratorRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -11019,27 +23074,31 @@ func (s *Server) handleReportOpinionRequest(args [1]string, argsEscaped bool, w
 		}
 	}()
 
-	var response ReportOpinionRes
+	var response SetTalkSessionCollaboratorRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ReportOpinionOperation,
-			OperationSummary: "意見通報API",
-			OperationID:      "reportOpinion",
+			OperationName:    SetTalkSessionCollaboratorOperation,
+			OperationSummary: "セッションの共同管理者を追加・変更",
+			OperationID:      "setTalkSessionCollaborator",
 			Body:             request,
 			Params: middleware.Parameters{
 				{
-					Name: "opinionID",
+					Name: "talkSessionID",
 					In:   "path",
-				}: params.OpinionID,
+				}: params.TalkSessionID,
+				{
+					Name: "displayID",
+					In:   "path",
+				}: params.DisplayID,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *ReportOpinionReq
-			Params   = ReportOpinionParams
-			Response = ReportOpinionRes
+			Request  = *SetTalkSessionCollaboratorReq
+			Params   = SetTalkSessionCollaboratorParams
+			Response = SetTalkSessionCollaboratorRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -11048,14 +23107,14 @@ func (s *Server) handleReportOpinionRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackReportOpinionParams,
+			unpackSetTalkSessionCollaboratorParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ReportOpinion(ctx, request, params)
+				response, err = s.h.SetTalkSessionCollaborator(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ReportOpinion(ctx, request, params)
+		response, err = s.h.SetTalkSessionCollaborator(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -11063,7 +23122,7 @@ func (s *Server) handleReportOpinionRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeReportOpinionResponse(response, w, span); err != nil {
+	if err := encodeSetTalkSessionCollaboratorResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -11072,22 +23131,22 @@ func (s *Server) handleReportOpinionRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleRevokeTokenRequest handles revokeToken operation.
+// handleSolveOpinionReportRequest handles solveOpinionReport operation.
 //
-// トークンを失効（ログアウト）.
+// 通報を解決.
 //
-// POST /auth/revoke
-func (s *Server) handleRevokeTokenRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /opinions/{opinionID}/reports/solve
+func (s *Server) handleSolveOpinionReportRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("revokeToken"),
+		otelogen.OperationID("solveOpinionReport"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/auth/revoke"),
+		semconv.HTTPRouteKey.String("/opinions/{opinionID}/reports/solve"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), RevokeTokenOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), SolveOpinionReportOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -11142,15 +23201,15 @@ func (s *Server) handleRevokeTokenRequest(args [0]string, argsEscaped bool, w ht
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: RevokeTokenOperation,
-			ID:   "revokeToken",
+			Name: SolveOpinionReportOperation,
+			ID:   "solveOpinionReport",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, RevokeTokenOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, SolveOpinionReportOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11166,11 +23225,29 @@ func (s *Server) handleRevokeTokenRequest(args [0]string, argsEscaped bool, w ht
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, SolveOpinionReportOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -11190,23 +23267,53 @@ func (s *Server) handleRevokeTokenRequest(args [0]string, argsEscaped bool, w ht
 			return
 		}
 	}
+	params, err := decodeSolveOpinionReportParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeSolveOpinionReportRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response RevokeTokenRes
+	var response SolveOpinionReportRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    RevokeTokenOperation,
-			OperationSummary: "トークンを失効（ログアウト）",
-			OperationID:      "revokeToken",
-			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationName:    SolveOpinionReportOperation,
+			OperationSummary: "通報を解決",
+			OperationID:      "solveOpinionReport",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "opinionID",
+					In:   "path",
+				}: params.OpinionID,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = struct{}
-			Response = RevokeTokenRes
+			Request  = *SolveOpinionReportReq
+			Params   = SolveOpinionReportParams
+			Response = SolveOpinionReportRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -11215,14 +23322,14 @@ func (s *Server) handleRevokeTokenRequest(args [0]string, argsEscaped bool, w ht
 		](
 			m,
 			mreq,
-			nil,
+			unpackSolveOpinionReportParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.RevokeToken(ctx)
+				response, err = s.h.SolveOpinionReport(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.RevokeToken(ctx)
+		response, err = s.h.SolveOpinionReport(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -11230,7 +23337,7 @@ func (s *Server) handleRevokeTokenRequest(args [0]string, argsEscaped bool, w ht
 		return
 	}
 
-	if err := encodeRevokeTokenResponse(response, w, span); err != nil {
+	if err := encodeSolveOpinionReportResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -11239,22 +23346,23 @@ func (s *Server) handleRevokeTokenRequest(args [0]string, argsEscaped bool, w ht
 	}
 }
 
-// handleSendTestNotificationRequest handles sendTestNotification operation.
+// handleSwipeOpinionsRequest handles swipeOpinions operation.
 //
-// テスト通知送信.
+// セッションの中からまだ投票していない意見をランダムに取得する
+// remainingCountは取得した意見を含めてスワイプできる意見の総数を返す.
 //
-// POST /notifications/test
-func (s *Server) handleSendTestNotificationRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /talksessions/{talkSessionID}/swipe_opinions
+func (s *Server) handleSwipeOpinionsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("sendTestNotification"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/notifications/test"),
+		otelogen.OperationID("swipeOpinions"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/swipe_opinions"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), SendTestNotificationOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), SwipeOpinionsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -11309,86 +23417,46 @@ func (s *Server) handleSendTestNotificationRequest(args [0]string, argsEscaped b
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: SendTestNotificationOperation,
-			ID:   "sendTestNotification",
+			Name: SwipeOpinionsOperation,
+			ID:   "swipeOpinions",
 		}
 	)
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			sctx, ok, err := s.securityCookieAuth(ctx, SendTestNotificationOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "CookieAuth",
-					Err:              err,
-				}
-				defer recordError("Security:CookieAuth", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 0
-				ctx = sctx
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			err = &ogenerrors.SecurityError{
-				OperationContext: opErrContext,
-				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
-			}
-			defer recordError("Security", err)
-			s.cfg.ErrorHandler(ctx, w, r, err)
-			return
-		}
-	}
-	request, close, err := s.decodeSendTestNotificationRequest(r)
+	params, err := decodeSwipeOpinionsParams(args, argsEscaped, r)
 	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
+		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeRequest", err)
+		defer recordError("DecodeParams", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response SendTestNotificationRes
+	var response SwipeOpinionsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    SendTestNotificationOperation,
-			OperationSummary: "テスト通知送信",
-			OperationID:      "sendTestNotification",
-			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationName:    SwipeOpinionsOperation,
+			OperationSummary: "スワイプ用のエンドポイント",
+			OperationID:      "swipeOpinions",
+			Body:             nil,
+			Params: middleware.Parameters{
+				{
+					Name: "talkSessionID",
+					In:   "path",
+				}: params.TalkSessionID,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *SendTestNotificationReq
-			Params   = struct{}
-			Response = SendTestNotificationRes
+			Request  = struct{}
+			Params   = SwipeOpinionsParams
+			Response = SwipeOpinionsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -11397,14 +23465,14 @@ func (s *Server) handleSendTestNotificationRequest(args [0]string, argsEscaped b
 		](
 			m,
 			mreq,
-			nil,
+			unpackSwipeOpinionsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.SendTestNotification(ctx, request)
+				response, err = s.h.SwipeOpinions(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.SendTestNotification(ctx, request)
+		response, err = s.h.SwipeOpinions(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -11412,7 +23480,7 @@ func (s *Server) handleSendTestNotificationRequest(args [0]string, argsEscaped b
 		return
 	}
 
-	if err := encodeSendTestNotificationResponse(response, w, span); err != nil {
+	if err := encodeSwipeOpinionsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -11421,22 +23489,22 @@ func (s *Server) handleSendTestNotificationRequest(args [0]string, argsEscaped b
 	}
 }
 
-// handleSessionsHistoryRequest handles sessionsHistory operation.
+// handleSwitchOrganizationRequest handles switchOrganization operation.
 //
-// リアクション済みのセッション一覧.
+// 組織切り替え.
 //
-// GET /talksessions/histories
-func (s *Server) handleSessionsHistoryRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /organizations/switch/{code}
+func (s *Server) handleSwitchOrganizationRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("sessionsHistory"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/talksessions/histories"),
+		otelogen.OperationID("switchOrganization"),
+		semconv.HTTPRequestMethodKey.String("POST"),
+		semconv.HTTPRouteKey.String("/organizations/switch/{code}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), SessionsHistoryOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), SwitchOrganizationOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -11491,15 +23559,15 @@ func (s *Server) handleSessionsHistoryRequest(args [0]string, argsEscaped bool,
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: SessionsHistoryOperation,
-			ID:   "sessionsHistory",
+			Name: SwitchOrganizationOperation,
+			ID:   "switchOrganization",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, SessionsHistoryOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, SwitchOrganizationOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -11515,11 +23583,29 @@ func (s *Server) handleSessionsHistoryRequest(args [0]string, argsEscaped bool,
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, SwitchOrganizationOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -11539,7 +23625,7 @@ func (s *Server) handleSessionsHistoryRequest(args [0]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeSessionsHistoryParams(args, argsEscaped, r)
+	params, err := decodeSwitchOrganizationParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -11550,39 +23636,27 @@ func (s *Server) handleSessionsHistoryRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	var response SessionsHistoryRes
+	var response SwitchOrganizationRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    SessionsHistoryOperation,
-			OperationSummary: "リアクション済みのセッション一覧",
-			OperationID:      "sessionsHistory",
+			OperationName:    SwitchOrganizationOperation,
+			OperationSummary: "組織切り替え",
+			OperationID:      "switchOrganization",
 			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
-				{
-					Name: "offset",
-					In:   "query",
-				}: params.Offset,
-				{
-					Name: "theme",
-					In:   "query",
-				}: params.Theme,
-				{
-					Name: "status",
-					In:   "query",
-				}: params.Status,
+					Name: "code",
+					In:   "path",
+				}: params.Code,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = SessionsHistoryParams
-			Response = SessionsHistoryRes
+			Params   = SwitchOrganizationParams
+			Response = SwitchOrganizationRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -11591,14 +23665,14 @@ func (s *Server) handleSessionsHistoryRequest(args [0]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackSessionsHistoryParams,
+			unpackSwitchOrganizationParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.SessionsHistory(ctx, params)
+				response, err = s.h.SwitchOrganization(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.SessionsHistory(ctx, params)
+		response, err = s.h.SwitchOrganization(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -11606,7 +23680,7 @@ func (s *Server) handleSessionsHistoryRequest(args [0]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeSessionsHistoryResponse(response, w, span); err != nil {
+	if err := encodeSwitchOrganizationResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -11615,22 +23689,22 @@ func (s *Server) handleSessionsHistoryRequest(args [0]string, argsEscaped bool,
 	}
 }
 
-// handleSolveOpinionReportRequest handles solveOpinionReport operation.
+// handleTalkSessionAnalysisRequest handles talkSessionAnalysis operation.
 //
-// 通報を解決.
+// 分析結果一覧.
 //
-// POST /opinions/{opinionID}/reports/solve
-func (s *Server) handleSolveOpinionReportRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /talksessions/{talkSessionID}/analysis
+func (s *Server) handleTalkSessionAnalysisRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("solveOpinionReport"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/opinions/{opinionID}/reports/solve"),
+		otelogen.OperationID("talkSessionAnalysis"),
+		semconv.HTTPRequestMethodKey.String("GET"),
+		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/analysis"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), SolveOpinionReportOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), TalkSessionAnalysisOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -11685,55 +23759,11 @@ func (s *Server) handleSolveOpinionReportRequest(args [1]string, argsEscaped boo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: SolveOpinionReportOperation,
-			ID:   "solveOpinionReport",
+			Name: TalkSessionAnalysisOperation,
+			ID:   "talkSessionAnalysis",
 		}
 	)
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
-		{
-			sctx, ok, err := s.securityCookieAuth(ctx, SolveOpinionReportOperation, r)
-			if err != nil {
-				err = &ogenerrors.SecurityError{
-					OperationContext: opErrContext,
-					Security:         "CookieAuth",
-					Err:              err,
-				}
-				defer recordError("Security:CookieAuth", err)
-				s.cfg.ErrorHandler(ctx, w, r, err)
-				return
-			}
-			if ok {
-				satisfied[0] |= 1 << 0
-				ctx = sctx
-			}
-		}
-
-		if ok := func() bool {
-		nextRequirement:
-			for _, requirement := range []bitset{
-				{0b00000001},
-			} {
-				for i, mask := range requirement {
-					if satisfied[i]&mask != mask {
-						continue nextRequirement
-					}
-				}
-				return true
-			}
-			return false
-		}(); !ok {
-			err = &ogenerrors.SecurityError{
-				OperationContext: opErrContext,
-				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
-			}
-			defer recordError("Security", err)
-			s.cfg.ErrorHandler(ctx, w, r, err)
-			return
-		}
-	}
-	params, err := decodeSolveOpinionReportParams(args, argsEscaped, r)
+	params, err := decodeTalkSessionAnalysisParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -11743,43 +23773,28 @@ func (s *Server) handleSolveOpinionReportRequest(args [1]string, argsEscaped boo
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeSolveOpinionReportRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response SolveOpinionReportRes
+	var response TalkSessionAnalysisRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    SolveOpinionReportOperation,
-			OperationSummary: "通報を解決",
-			OperationID:      "solveOpinionReport",
-			Body:             request,
+			OperationName:    TalkSessionAnalysisOperation,
+			OperationSummary: "分析結果一覧",
+			OperationID:      "talkSessionAnalysis",
+			Body:             nil,
 			Params: middleware.Parameters{
 				{
-					Name: "opinionID",
+					Name: "talkSessionID",
 					In:   "path",
-				}: params.OpinionID,
+				}: params.TalkSessionID,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *SolveOpinionReportReq
-			Params   = SolveOpinionReportParams
-			Response = SolveOpinionReportRes
+			Request  = struct{}
+			Params   = TalkSessionAnalysisParams
+			Response = TalkSessionAnalysisRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -11788,14 +23803,14 @@ func (s *Server) handleSolveOpinionReportRequest(args [1]string, argsEscaped boo
 		](
 			m,
 			mreq,
-			unpackSolveOpinionReportParams,
+			unpackTalkSessionAnalysisParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.SolveOpinionReport(ctx, request, params)
+				response, err = s.h.TalkSessionAnalysis(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.SolveOpinionReport(ctx, request, params)
+		response, err = s.h.TalkSessionAnalysis(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -11803,7 +23818,7 @@ func (s *Server) handleSolveOpinionReportRequest(args [1]string, argsEscaped boo
 		return
 	}
 
-	if err := encodeSolveOpinionReportResponse(response, w, span); err != nil {
+	if err := encodeTalkSessionAnalysisResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -11812,23 +23827,22 @@ func (s *Server) handleSolveOpinionReportRequest(args [1]string, argsEscaped boo
 	}
 }
 
-// handleSwipeOpinionsRequest handles swipeOpinions operation.
+// handleTestRequest handles test operation.
 //
-// セッションの中からまだ投票していない意見をランダムに取得する
-// remainingCountは取得した意見を含めてスワイプできる意見の総数を返す.
+// OpenAPIテスト用.
 //
-// GET /talksessions/{talkSessionID}/swipe_opinions
-func (s *Server) handleSwipeOpinionsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /test
+func (s *Server) handleTestRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("swipeOpinions"),
+		otelogen.OperationID("test"),
 		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/swipe_opinions"),
+		semconv.HTTPRouteKey.String("/test"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), SwipeOpinionsOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), TestOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -11881,48 +23895,25 @@ func (s *Server) handleSwipeOpinionsRequest(args [1]string, argsEscaped bool, w
 
 			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
 		}
-		err          error
-		opErrContext = ogenerrors.OperationContext{
-			Name: SwipeOpinionsOperation,
-			ID:   "swipeOpinions",
-		}
+		err error
 	)
-	params, err := decodeSwipeOpinionsParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
-	var response SwipeOpinionsRes
+	var response TestRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    SwipeOpinionsOperation,
-			OperationSummary: "スワイプ用のエンドポイント",
-			OperationID:      "swipeOpinions",
+			OperationName:    TestOperation,
+			OperationSummary: "OpenAPIテスト用",
+			OperationID:      "test",
 			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "talkSessionID",
-					In:   "path",
-				}: params.TalkSessionID,
-				{
-					Name: "limit",
-					In:   "query",
-				}: params.Limit,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = SwipeOpinionsParams
-			Response = SwipeOpinionsRes
+			Params   = struct{}
+			Response = TestRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -11931,14 +23922,14 @@ func (s *Server) handleSwipeOpinionsRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackSwipeOpinionsParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.SwipeOpinions(ctx, params)
+				response, err = s.h.Test(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.SwipeOpinions(ctx, params)
+		response, err = s.h.Test(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -11946,7 +23937,7 @@ func (s *Server) handleSwipeOpinionsRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeSwipeOpinionsResponse(response, w, span); err != nil {
+	if err := encodeTestResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -11955,22 +23946,20 @@ func (s *Server) handleSwipeOpinionsRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleSwitchOrganizationRequest handles switchOrganization operation.
-//
-// 組織切り替え.
+// handleToggleReportVisibilityManageRequest handles toggleReportVisibilityManage operation.
 //
-// POST /organizations/switch/{code}
-func (s *Server) handleSwitchOrganizationRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /v1/manage/talksessions/{talkSessionID}/analysis/report
+func (s *Server) handleToggleReportVisibilityManageRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("switchOrganization"),
+		otelogen.OperationID("toggleReportVisibilityManage"),
 		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/organizations/switch/{code}"),
+		semconv.HTTPRouteKey.String("/v1/manage/talksessions/{talkSessionID}/analysis/report"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), SwitchOrganizationOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), ToggleReportVisibilityManageOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -12025,15 +24014,15 @@ func (s *Server) handleSwitchOrganizationRequest(args [1]string, argsEscaped boo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: SwitchOrganizationOperation,
-			ID:   "switchOrganization",
+			Name: ToggleReportVisibilityManageOperation,
+			ID:   "toggleReportVisibilityManage",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, SwitchOrganizationOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, ToggleReportVisibilityManageOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -12049,11 +24038,29 @@ func (s *Server) handleSwitchOrganizationRequest(args [1]string, argsEscaped boo
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, ToggleReportVisibilityManageOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -12073,7 +24080,7 @@ func (s *Server) handleSwitchOrganizationRequest(args [1]string, argsEscaped boo
 			return
 		}
 	}
-	params, err := decodeSwitchOrganizationParams(args, argsEscaped, r)
+	params, err := decodeToggleReportVisibilityManageParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -12083,28 +24090,43 @@ func (s *Server) handleSwitchOrganizationRequest(args [1]string, argsEscaped boo
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	request, close, err := s.decodeToggleReportVisibilityManageRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response SwitchOrganizationRes
+	var response *ToggleReportVisibilityResponse
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    SwitchOrganizationOperation,
-			OperationSummary: "組織切り替え",
-			OperationID:      "switchOrganization",
-			Body:             nil,
+			OperationName:    ToggleReportVisibilityManageOperation,
+			OperationSummary: "",
+			OperationID:      "toggleReportVisibilityManage",
+			Body:             request,
 			Params: middleware.Parameters{
 				{
-					Name: "code",
+					Name: "talkSessionID",
 					In:   "path",
-				}: params.Code,
+				}: params.TalkSessionID,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = SwitchOrganizationParams
-			Response = SwitchOrganizationRes
+			Request  = *ToggleReportVisibilityRequest
+			Params   = ToggleReportVisibilityManageParams
+			Response = *ToggleReportVisibilityResponse
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -12113,14 +24135,14 @@ func (s *Server) handleSwitchOrganizationRequest(args [1]string, argsEscaped boo
 		](
 			m,
 			mreq,
-			unpackSwitchOrganizationParams,
+			unpackToggleReportVisibilityManageParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.SwitchOrganization(ctx, params)
+				response, err = s.h.ToggleReportVisibilityManage(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.SwitchOrganization(ctx, params)
+		response, err = s.h.ToggleReportVisibilityManage(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -12128,7 +24150,7 @@ func (s *Server) handleSwitchOrganizationRequest(args [1]string, argsEscaped boo
 		return
 	}
 
-	if err := encodeSwitchOrganizationResponse(response, w, span); err != nil {
+	if err := encodeToggleReportVisibilityManageResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -12137,22 +24159,22 @@ func (s *Server) handleSwitchOrganizationRequest(args [1]string, argsEscaped boo
 	}
 }
 
-// handleTalkSessionAnalysisRequest handles talkSessionAnalysis operation.
+// handleUpdateNotificationPreferencesRequest handles updateNotificationPreferences operation.
 //
-// 分析結果一覧.
+// 通知設定更新.
 //
-// GET /talksessions/{talkSessionID}/analysis
-func (s *Server) handleTalkSessionAnalysisRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /notifications/preferences
+func (s *Server) handleUpdateNotificationPreferencesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("talkSessionAnalysis"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/talksessions/{talkSessionID}/analysis"),
+		otelogen.OperationID("updateNotificationPreferences"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/notifications/preferences"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), TalkSessionAnalysisOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateNotificationPreferencesOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -12207,42 +24229,104 @@ func (s *Server) handleTalkSessionAnalysisRequest(args [1]string, argsEscaped bo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: TalkSessionAnalysisOperation,
-			ID:   "talkSessionAnalysis",
+			Name: UpdateNotificationPreferencesOperation,
+			ID:   "updateNotificationPreferences",
 		}
 	)
-	params, err := decodeTalkSessionAnalysisParams(args, argsEscaped, r)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, UpdateNotificationPreferencesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, UpdateNotificationPreferencesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	request, close, err := s.decodeUpdateNotificationPreferencesRequest(r)
 	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
+		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
 			Err:              err,
 		}
-		defer recordError("DecodeParams", err)
+		defer recordError("DecodeRequest", err)
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response TalkSessionAnalysisRes
+	var response UpdateNotificationPreferencesRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    TalkSessionAnalysisOperation,
-			OperationSummary: "分析結果一覧",
-			OperationID:      "talkSessionAnalysis",
-			Body:             nil,
-			Params: middleware.Parameters{
-				{
-					Name: "talkSessionID",
-					In:   "path",
-				}: params.TalkSessionID,
-			},
-			Raw: r,
+			OperationName:    UpdateNotificationPreferencesOperation,
+			OperationSummary: "通知設定更新",
+			OperationID:      "updateNotificationPreferences",
+			Body:             request,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = TalkSessionAnalysisParams
-			Response = TalkSessionAnalysisRes
+			Request  = *UpdateNotificationPreferencesReq
+			Params   = struct{}
+			Response = UpdateNotificationPreferencesRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -12251,14 +24335,14 @@ func (s *Server) handleTalkSessionAnalysisRequest(args [1]string, argsEscaped bo
 		](
 			m,
 			mreq,
-			unpackTalkSessionAnalysisParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.TalkSessionAnalysis(ctx, params)
+				response, err = s.h.UpdateNotificationPreferences(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.TalkSessionAnalysis(ctx, params)
+		response, err = s.h.UpdateNotificationPreferences(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -12266,7 +24350,7 @@ func (s *Server) handleTalkSessionAnalysisRequest(args [1]string, argsEscaped bo
 		return
 	}
 
-	if err := encodeTalkSessionAnalysisResponse(response, w, span); err != nil {
+	if err := encodeUpdateNotificationPreferencesResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -12275,22 +24359,23 @@ func (s *Server) handleTalkSessionAnalysisRequest(args [1]string, argsEscaped bo
 	}
 }
 
-// handleTestRequest handles test operation.
+// handleUpdateOrganizationRequest handles updateOrganization operation.
 //
-// OpenAPIテスト用.
+// 組織を更新できる。
+// 組織のAdmin以上のユーザーが実行可能。.
 //
-// GET /test
-func (s *Server) handleTestRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /organizations/{code}
+func (s *Server) handleUpdateOrganizationRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("test"),
-		semconv.HTTPRequestMethodKey.String("GET"),
-		semconv.HTTPRouteKey.String("/test"),
+		otelogen.OperationID("updateOrganization"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/organizations/{code}"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), TestOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateOrganizationOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -12341,27 +24426,123 @@ func (s *Server) handleTestRequest(args [0]string, argsEscaped bool, w http.Resp
 				attrs = append(attrs, semconv.HTTPResponseStatusCode(code))
 			}
 
-			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+			s.errors.Add(ctx, 1, metric.WithAttributes(attrs...))
+		}
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: UpdateOrganizationOperation,
+			ID:   "updateOrganization",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securityCookieAuth(ctx, UpdateOrganizationOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "CookieAuth",
+					Err:              err,
+				}
+				defer recordError("Security:CookieAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, UpdateOrganizationOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
 		}
-		err error
-	)
+	}
+	params, err := decodeUpdateOrganizationParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeUpdateOrganizationRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response TestRes
+	var response UpdateOrganizationRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    TestOperation,
-			OperationSummary: "OpenAPIテスト用",
-			OperationID:      "test",
-			Body:             nil,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			OperationName:    UpdateOrganizationOperation,
+			OperationSummary: "組織更新（組織のAdmin以上のユーザーのみ）",
+			OperationID:      "updateOrganization",
+			Body:             request,
+			Params: middleware.Parameters{
+				{
+					Name: "code",
+					In:   "path",
+				}: params.Code,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = struct{}
-			Response = TestRes
+			Request  = *UpdateOrganizationReq
+			Params   = UpdateOrganizationParams
+			Response = UpdateOrganizationRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -12370,14 +24551,14 @@ func (s *Server) handleTestRequest(args [0]string, argsEscaped bool, w http.Resp
 		](
 			m,
 			mreq,
-			nil,
+			unpackUpdateOrganizationParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.Test(ctx)
+				response, err = s.h.UpdateOrganization(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.Test(ctx)
+		response, err = s.h.UpdateOrganization(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -12385,7 +24566,7 @@ func (s *Server) handleTestRequest(args [0]string, argsEscaped bool, w http.Resp
 		return
 	}
 
-	if err := encodeTestResponse(response, w, span); err != nil {
+	if err := encodeUpdateOrganizationResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -12394,20 +24575,24 @@ func (s *Server) handleTestRequest(args [0]string, argsEscaped bool, w http.Resp
 	}
 }
 
-// handleToggleReportVisibilityManageRequest handles toggleReportVisibilityManage operation.
+// handleUpdateOrganizationParentRequest handles updateOrganizationParent operation.
 //
-// POST /v1/manage/talksessions/{talkSessionID}/analysis/report
-func (s *Server) handleToggleReportVisibilityManageRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// 組織の親組織を設定する。parentCodeを省略すると親子関係を解除する。
+// 対象の組織・現在の親組織・新しい親組織のすべてでオーナー以上の権限が必要。
+// 親組織のロールは子孫の組織にも継承される。.
+//
+// PUT /organizations/{code}/parent
+func (s *Server) handleUpdateOrganizationParentRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("toggleReportVisibilityManage"),
-		semconv.HTTPRequestMethodKey.String("POST"),
-		semconv.HTTPRouteKey.String("/v1/manage/talksessions/{talkSessionID}/analysis/report"),
+		otelogen.OperationID("updateOrganizationParent"),
+		semconv.HTTPRequestMethodKey.String("PUT"),
+		semconv.HTTPRouteKey.String("/organizations/{code}/parent"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), ToggleReportVisibilityManageOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateOrganizationParentOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -12462,15 +24647,15 @@ func (s *Server) handleToggleReportVisibilityManageRequest(args [1]string, argsE
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ToggleReportVisibilityManageOperation,
-			ID:   "toggleReportVisibilityManage",
+			Name: UpdateOrganizationParentOperation,
+			ID:   "updateOrganizationParent",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, ToggleReportVisibilityManageOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, UpdateOrganizationParentOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -12486,11 +24671,29 @@ func (s *Server) handleToggleReportVisibilityManageRequest(args [1]string, argsE
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, UpdateOrganizationParentOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -12510,7 +24713,7 @@ func (s *Server) handleToggleReportVisibilityManageRequest(args [1]string, argsE
 			return
 		}
 	}
-	params, err := decodeToggleReportVisibilityManageParams(args, argsEscaped, r)
+	params, err := decodeUpdateOrganizationParentParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -12520,7 +24723,7 @@ func (s *Server) handleToggleReportVisibilityManageRequest(args [1]string, argsE
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeToggleReportVisibilityManageRequest(r)
+	request, close, err := s.decodeUpdateOrganizationParentRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -12536,27 +24739,27 @@ func (s *Server) handleToggleReportVisibilityManageRequest(args [1]string, argsE
 		}
 	}()
 
-	var response *ToggleReportVisibilityResponse
+	var response UpdateOrganizationParentRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ToggleReportVisibilityManageOperation,
-			OperationSummary: "",
-			OperationID:      "toggleReportVisibilityManage",
+			OperationName:    UpdateOrganizationParentOperation,
+			OperationSummary: "親組織の変更",
+			OperationID:      "updateOrganizationParent",
 			Body:             request,
 			Params: middleware.Parameters{
 				{
-					Name: "talkSessionID",
+					Name: "code",
 					In:   "path",
-				}: params.TalkSessionID,
+				}: params.Code,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *ToggleReportVisibilityRequest
-			Params   = ToggleReportVisibilityManageParams
-			Response = *ToggleReportVisibilityResponse
+			Request  = *UpdateOrganizationParentReq
+			Params   = UpdateOrganizationParentParams
+			Response = UpdateOrganizationParentRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -12565,14 +24768,14 @@ func (s *Server) handleToggleReportVisibilityManageRequest(args [1]string, argsE
 		](
 			m,
 			mreq,
-			unpackToggleReportVisibilityManageParams,
+			unpackUpdateOrganizationParentParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ToggleReportVisibilityManage(ctx, request, params)
+				response, err = s.h.UpdateOrganizationParent(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ToggleReportVisibilityManage(ctx, request, params)
+		response, err = s.h.UpdateOrganizationParent(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -12580,7 +24783,7 @@ func (s *Server) handleToggleReportVisibilityManageRequest(args [1]string, argsE
 		return
 	}
 
-	if err := encodeToggleReportVisibilityManageResponse(response, w, span); err != nil {
+	if err := encodeUpdateOrganizationParentResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -12589,22 +24792,25 @@ func (s *Server) handleToggleReportVisibilityManageRequest(args [1]string, argsE
 	}
 }
 
-// handleUpdateNotificationPreferencesRequest handles updateNotificationPreferences operation.
+// handleUpdateOrganizationReferenceDemographicsRequest handles updateOrganizationReferenceDemographics operation.
 //
-// 通知設定更新.
+// 属性の母集団の構成をCSVで登録する。登録済みの場合は置き換える。管理者以上の権限が必要。
+// CSVは1列目に区分、2列目に人数または割合（「12.
+// 5%」のような百分率も可）を指定する。
+// 1行目の2列目が数値でない場合はヘッダーとして読み飛ばす.
 //
-// PUT /notifications/preferences
-func (s *Server) handleUpdateNotificationPreferencesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /organizations/{code}/reference-demographics
+func (s *Server) handleUpdateOrganizationReferenceDemographicsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updateNotificationPreferences"),
+		otelogen.OperationID("updateOrganizationReferenceDemographics"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/notifications/preferences"),
+		semconv.HTTPRouteKey.String("/organizations/{code}/reference-demographics"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateNotificationPreferencesOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateOrganizationReferenceDemographicsOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -12659,15 +24865,15 @@ func (s *Server) handleUpdateNotificationPreferencesRequest(args [0]string, args
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UpdateNotificationPreferencesOperation,
-			ID:   "updateNotificationPreferences",
+			Name: UpdateOrganizationReferenceDemographicsOperation,
+			ID:   "updateOrganizationReferenceDemographics",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, UpdateNotificationPreferencesOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, UpdateOrganizationReferenceDemographicsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -12683,11 +24889,29 @@ func (s *Server) handleUpdateNotificationPreferencesRequest(args [0]string, args
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, UpdateOrganizationReferenceDemographicsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -12707,7 +24931,17 @@ func (s *Server) handleUpdateNotificationPreferencesRequest(args [0]string, args
 			return
 		}
 	}
-	request, close, err := s.decodeUpdateNotificationPreferencesRequest(r)
+	params, err := decodeUpdateOrganizationReferenceDemographicsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	request, close, err := s.decodeUpdateOrganizationReferenceDemographicsRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -12723,22 +24957,27 @@ func (s *Server) handleUpdateNotificationPreferencesRequest(args [0]string, args
 		}
 	}()
 
-	var response UpdateNotificationPreferencesRes
+	var response UpdateOrganizationReferenceDemographicsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UpdateNotificationPreferencesOperation,
-			OperationSummary: "通知設定更新",
-			OperationID:      "updateNotificationPreferences",
+			OperationName:    UpdateOrganizationReferenceDemographicsOperation,
+			OperationSummary: "母集団の構成の登録",
+			OperationID:      "updateOrganizationReferenceDemographics",
 			Body:             request,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "code",
+					In:   "path",
+				}: params.Code,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *UpdateNotificationPreferencesReq
-			Params   = struct{}
-			Response = UpdateNotificationPreferencesRes
+			Request  = *UpdateOrganizationReferenceDemographicsReq
+			Params   = UpdateOrganizationReferenceDemographicsParams
+			Response = UpdateOrganizationReferenceDemographicsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -12747,14 +24986,14 @@ func (s *Server) handleUpdateNotificationPreferencesRequest(args [0]string, args
 		](
 			m,
 			mreq,
-			nil,
+			unpackUpdateOrganizationReferenceDemographicsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UpdateNotificationPreferences(ctx, request)
+				response, err = s.h.UpdateOrganizationReferenceDemographics(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UpdateNotificationPreferences(ctx, request)
+		response, err = s.h.UpdateOrganizationReferenceDemographics(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -12762,7 +25001,7 @@ func (s *Server) handleUpdateNotificationPreferencesRequest(args [0]string, args
 		return
 	}
 
-	if err := encodeUpdateNotificationPreferencesResponse(response, w, span); err != nil {
+	if err := encodeUpdateOrganizationReferenceDemographicsResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -12771,23 +25010,23 @@ func (s *Server) handleUpdateNotificationPreferencesRequest(args [0]string, args
 	}
 }
 
-// handleUpdateOrganizationRequest handles updateOrganization operation.
+// handleUpdateOrganizationReportPromptRequest handles updateOrganizationReportPrompt operation.
 //
-// 組織を更新できる。
-// 組織のAdmin以上のユーザーが実行可能。.
+// レポートをLLMで生成する際の組織の指示を更新する。管理者以上の権限が必要。
+// 組織のセッションのレポートを次に生成するときから使われる.
 //
-// PUT /organizations/{code}
-func (s *Server) handleUpdateOrganizationRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /organizations/{code}/report-prompt
+func (s *Server) handleUpdateOrganizationReportPromptRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	otelAttrs := []attribute.KeyValue{
-		otelogen.OperationID("updateOrganization"),
+		otelogen.OperationID("updateOrganizationReportPrompt"),
 		semconv.HTTPRequestMethodKey.String("PUT"),
-		semconv.HTTPRouteKey.String("/organizations/{code}"),
+		semconv.HTTPRouteKey.String("/organizations/{code}/report-prompt"),
 	}
 
 	// Start a span for this request.
-	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateOrganizationOperation,
+	ctx, span := s.cfg.Tracer.Start(r.Context(), UpdateOrganizationReportPromptOperation,
 		trace.WithAttributes(otelAttrs...),
 		serverSpanKind,
 	)
@@ -12842,15 +25081,15 @@ func (s *Server) handleUpdateOrganizationRequest(args [1]string, argsEscaped boo
 		}
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UpdateOrganizationOperation,
-			ID:   "updateOrganization",
+			Name: UpdateOrganizationReportPromptOperation,
+			ID:   "updateOrganizationReportPrompt",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityCookieAuth(ctx, UpdateOrganizationOperation, r)
+			sctx, ok, err := s.securityCookieAuth(ctx, UpdateOrganizationReportPromptOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -12866,11 +25105,29 @@ func (s *Server) handleUpdateOrganizationRequest(args [1]string, argsEscaped boo
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, UpdateOrganizationReportPromptOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -12890,7 +25147,7 @@ func (s *Server) handleUpdateOrganizationRequest(args [1]string, argsEscaped boo
 			return
 		}
 	}
-	params, err := decodeUpdateOrganizationParams(args, argsEscaped, r)
+	params, err := decodeUpdateOrganizationReportPromptParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -12900,7 +25157,7 @@ func (s *Server) handleUpdateOrganizationRequest(args [1]string, argsEscaped boo
 		s.cfg.ErrorHandler(ctx, w, r, err)
 		return
 	}
-	request, close, err := s.decodeUpdateOrganizationRequest(r)
+	request, close, err := s.decodeUpdateOrganizationReportPromptRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -12916,13 +25173,13 @@ func (s *Server) handleUpdateOrganizationRequest(args [1]string, argsEscaped boo
 		}
 	}()
 
-	var response UpdateOrganizationRes
+	var response UpdateOrganizationReportPromptRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UpdateOrganizationOperation,
-			OperationSummary: "組織更新（組織のAdmin以上のユーザーのみ）",
-			OperationID:      "updateOrganization",
+			OperationName:    UpdateOrganizationReportPromptOperation,
+			OperationSummary: "レポート生成の指示の更新",
+			OperationID:      "updateOrganizationReportPrompt",
 			Body:             request,
 			Params: middleware.Parameters{
 				{
@@ -12934,9 +25191,9 @@ func (s *Server) handleUpdateOrganizationRequest(args [1]string, argsEscaped boo
 		}
 
 		type (
-			Request  = *UpdateOrganizationReq
-			Params   = UpdateOrganizationParams
-			Response = UpdateOrganizationRes
+			Request  = *UpdateOrganizationReportPromptReq
+			Params   = UpdateOrganizationReportPromptParams
+			Response = UpdateOrganizationReportPromptRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -12945,14 +25202,14 @@ func (s *Server) handleUpdateOrganizationRequest(args [1]string, argsEscaped boo
 		](
 			m,
 			mreq,
-			unpackUpdateOrganizationParams,
+			unpackUpdateOrganizationReportPromptParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UpdateOrganization(ctx, request, params)
+				response, err = s.h.UpdateOrganizationReportPrompt(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UpdateOrganization(ctx, request, params)
+		response, err = s.h.UpdateOrganizationReportPrompt(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -12960,7 +25217,7 @@ func (s *Server) handleUpdateOrganizationRequest(args [1]string, argsEscaped boo
 		return
 	}
 
-	if err := encodeUpdateOrganizationResponse(response, w, span); err != nil {
+	if err := encodeUpdateOrganizationReportPromptResponse(response, w, span); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -13063,11 +25320,29 @@ func (s *Server) handleUpdateUserProfileRequest(args [0]string, argsEscaped bool
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, UpdateUserProfileOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -13383,11 +25658,29 @@ func (s *Server) handleVote2Request(args [1]string, argsEscaped bool, w http.Res
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, Vote2Operation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -13580,11 +25873,29 @@ func (s *Server) handleWithdrawUserRequest(args [0]string, argsEscaped bool, w h
 				ctx = sctx
 			}
 		}
+		{
+			sctx, ok, err := s.securityOrganizationApiKeyAuth(ctx, WithdrawUserOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "OrganizationApiKeyAuth",
+					Err:              err,
+				}
+				defer recordError("Security:OrganizationApiKeyAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 
 		if ok := func() bool {
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
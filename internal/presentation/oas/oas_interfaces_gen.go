@@ -1,6 +1,14 @@
 // Code generated by ogen, DO NOT EDIT.
 package oas
 
+type AcceptOrganizationInvitationRes interface {
+	acceptOrganizationInvitationRes()
+}
+
+type AcceptOwnershipTransferRes interface {
+	acceptOwnershipTransferRes()
+}
+
 type ApplyFeedbackToReportRes interface {
 	applyFeedbackToReportRes()
 }
@@ -13,6 +21,26 @@ type AuthorizeRes interface {
 	authorizeRes()
 }
 
+type BatchVoteRes interface {
+	batchVoteRes()
+}
+
+type BulkCreateOrganizationInvitationsRes interface {
+	bulkCreateOrganizationInvitationsRes()
+}
+
+type BulkTransferTalkSessionsRes interface {
+	bulkTransferTalkSessionsRes()
+}
+
+type CancelOwnershipTransferRes interface {
+	cancelOwnershipTransferRes()
+}
+
+type ChangeOrganizationUserRoleRes interface {
+	changeOrganizationUserRoleRes()
+}
+
 type ChangePasswordRes interface {
 	changePasswordRes()
 }
@@ -21,6 +49,14 @@ type CheckDeviceExistsRes interface {
 	checkDeviceExistsRes()
 }
 
+type CloneTalkSessionRes interface {
+	cloneTalkSessionRes()
+}
+
+type CloneTalkSessionTemplateRes interface {
+	cloneTalkSessionTemplateRes()
+}
+
 type ConsentTalkSessionRes interface {
 	consentTalkSessionRes()
 }
@@ -29,22 +65,50 @@ type CreateOrganizationAliasRes interface {
 	createOrganizationAliasRes()
 }
 
+type CreateOrganizationApiKeyRes interface {
+	createOrganizationApiKeyRes()
+}
+
+type CreateOrganizationInvitationRes interface {
+	createOrganizationInvitationRes()
+}
+
+type DeclineOwnershipTransferRes interface {
+	declineOwnershipTransferRes()
+}
+
 type DeleteDeviceRes interface {
 	deleteDeviceRes()
 }
 
+type DeleteOpinionRes interface {
+	deleteOpinionRes()
+}
+
 type DeleteOrganizationAliasRes interface {
 	deleteOrganizationAliasRes()
 }
 
+type DeleteTalkSessionTemplateRes interface {
+	deleteTalkSessionTemplateRes()
+}
+
 type DevAuthorizeRes interface {
 	devAuthorizeRes()
 }
 
+type DownloadOrganizationAuditLogsRes interface {
+	downloadOrganizationAuditLogsRes()
+}
+
 type DummyInitRes interface {
 	dummyInitRes()
 }
 
+type EditOpinionRes interface {
+	editOpinionRes()
+}
+
 type EditTalkSessionRes interface {
 	editTalkSessionRes()
 }
@@ -61,14 +125,46 @@ type EstablishUserRes interface {
 	establishUserRes()
 }
 
+type GetAnalysisJobRes interface {
+	getAnalysisJobRes()
+}
+
+type GetAnalysisSnapshotDiffRes interface {
+	getAnalysisSnapshotDiffRes()
+}
+
+type GetAnalysisSnapshotRes interface {
+	getAnalysisSnapshotRes()
+}
+
+type GetAnalysisSnapshotsRes interface {
+	getAnalysisSnapshotsRes()
+}
+
+type GetAnalysisStatusRes interface {
+	getAnalysisStatusRes()
+}
+
 type GetConclusionRes interface {
 	getConclusionRes()
 }
 
+type GetConsensusRes interface {
+	getConsensusRes()
+}
+
 type GetDevicesRes interface {
 	getDevicesRes()
 }
 
+type GetJwksRes interface {
+	getJwksRes()
+}
+
+type GetMyAnalysisPositionRes interface {
+	getMyAnalysisPositionRes()
+}
+
 type GetNotificationPreferencesRes interface {
 	getNotificationPreferencesRes()
 }
@@ -93,6 +189,10 @@ type GetOpinionReportsRes interface {
 	getOpinionReportsRes()
 }
 
+type GetOpinionRevisionsRes interface {
+	getOpinionRevisionsRes()
+}
+
 type GetOpinionsForTalkSessionRes interface {
 	getOpinionsForTalkSessionRes()
 }
@@ -101,6 +201,38 @@ type GetOrganizationAliasesRes interface {
 	getOrganizationAliasesRes()
 }
 
+type GetOrganizationApiKeysRes interface {
+	getOrganizationApiKeysRes()
+}
+
+type GetOrganizationAuditLogsRes interface {
+	getOrganizationAuditLogsRes()
+}
+
+type GetOrganizationChildrenRes interface {
+	getOrganizationChildrenRes()
+}
+
+type GetOrganizationInvitationsRes interface {
+	getOrganizationInvitationsRes()
+}
+
+type GetOrganizationReferenceDemographicsRes interface {
+	getOrganizationReferenceDemographicsRes()
+}
+
+type GetOrganizationReportPromptRes interface {
+	getOrganizationReportPromptRes()
+}
+
+type GetOrganizationStatsRes interface {
+	getOrganizationStatsRes()
+}
+
+type GetOrganizationTalkSessionsRes interface {
+	getOrganizationTalkSessionsRes()
+}
+
 type GetOrganizationUsersRes interface {
 	getOrganizationUsersRes()
 }
@@ -117,6 +249,10 @@ type GetReportsForTalkSessionRes interface {
 	getReportsForTalkSessionRes()
 }
 
+type GetTalkSessionCollaboratorsRes interface {
+	getTalkSessionCollaboratorsRes()
+}
+
 type GetTalkSessionDetailRes interface {
 	getTalkSessionDetailRes()
 }
@@ -133,6 +269,10 @@ type GetTalkSessionReportRes interface {
 	getTalkSessionReportRes()
 }
 
+type GetTalkSessionRepresentativenessRes interface {
+	getTalkSessionRepresentativenessRes()
+}
+
 type GetTalkSessionRestrictionKeysRes interface {
 	getTalkSessionRestrictionKeysRes()
 }
@@ -141,6 +281,14 @@ type GetTalkSessionRestrictionSatisfiedRes interface {
 	getTalkSessionRestrictionSatisfiedRes()
 }
 
+type GetTalkSessionTemplatesRes interface {
+	getTalkSessionTemplatesRes()
+}
+
+type GetTermFrequenciesRes interface {
+	getTermFrequenciesRes()
+}
+
 type GetTimeLineRes interface {
 	getTimeLineRes()
 }
@@ -149,6 +297,10 @@ type GetTokenInfoRes interface {
 	getTokenInfoRes()
 }
 
+type GetTopicsRes interface {
+	getTopicsRes()
+}
+
 type GetUserByDisplayIDRes interface {
 	getUserByDisplayIDRes()
 }
@@ -161,6 +313,10 @@ type GetUserTalkSessionsRes interface {
 	getUserTalkSessionsRes()
 }
 
+type GetVoteShiftsRes interface {
+	getVoteShiftsRes()
+}
+
 type HandleAuthCallbackRes interface {
 	handleAuthCallbackRes()
 }
@@ -185,6 +341,10 @@ type InviteOrganizationRes interface {
 	inviteOrganizationRes()
 }
 
+type ListOwnershipTransfersRes interface {
+	listOwnershipTransfersRes()
+}
+
 type OpinionComments2Res interface {
 	opinionComments2Res()
 }
@@ -221,6 +381,10 @@ type PostTimeLineItemRes interface {
 	postTimeLineItemRes()
 }
 
+type PublishTalkSessionRes interface {
+	publishTalkSessionRes()
+}
+
 type ReactivateUserRes interface {
 	reactivateUserRes()
 }
@@ -229,14 +393,46 @@ type RegisterDeviceRes interface {
 	registerDeviceRes()
 }
 
+type RemoveOrganizationUserRes interface {
+	removeOrganizationUserRes()
+}
+
+type RemoveTalkSessionCollaboratorRes interface {
+	removeTalkSessionCollaboratorRes()
+}
+
 type ReportOpinionRes interface {
 	reportOpinionRes()
 }
 
+type RequestOrganizationOwnershipTransferRes interface {
+	requestOrganizationOwnershipTransferRes()
+}
+
+type RequestTalkSessionOwnershipTransferRes interface {
+	requestTalkSessionOwnershipTransferRes()
+}
+
+type ResendOrganizationInvitationRes interface {
+	resendOrganizationInvitationRes()
+}
+
+type RevokeOrganizationApiKeyRes interface {
+	revokeOrganizationApiKeyRes()
+}
+
+type RevokeOrganizationInvitationRes interface {
+	revokeOrganizationInvitationRes()
+}
+
 type RevokeTokenRes interface {
 	revokeTokenRes()
 }
 
+type SaveTalkSessionTemplateRes interface {
+	saveTalkSessionTemplateRes()
+}
+
 type SendTestNotificationRes interface {
 	sendTestNotificationRes()
 }
@@ -245,6 +441,10 @@ type SessionsHistoryRes interface {
 	sessionsHistoryRes()
 }
 
+type SetTalkSessionCollaboratorRes interface {
+	setTalkSessionCollaboratorRes()
+}
+
 type SolveOpinionReportRes interface {
 	solveOpinionReportRes()
 }
@@ -269,6 +469,18 @@ type UpdateNotificationPreferencesRes interface {
 	updateNotificationPreferencesRes()
 }
 
+type UpdateOrganizationParentRes interface {
+	updateOrganizationParentRes()
+}
+
+type UpdateOrganizationReferenceDemographicsRes interface {
+	updateOrganizationReferenceDemographicsRes()
+}
+
+type UpdateOrganizationReportPromptRes interface {
+	updateOrganizationReportPromptRes()
+}
+
 type UpdateOrganizationRes interface {
 	updateOrganizationRes()
 }
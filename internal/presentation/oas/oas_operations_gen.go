@@ -6,85 +6,143 @@ package oas
 type OperationName = string
 
 const (
-	ApplyFeedbackToReportOperation              OperationName = "ApplyFeedbackToReport"
-	AuthAccountDetachOperation                  OperationName = "AuthAccountDetach"
-	AuthorizeOperation                          OperationName = "Authorize"
-	ChangePasswordOperation                     OperationName = "ChangePassword"
-	CheckDeviceExistsOperation                  OperationName = "CheckDeviceExists"
-	ConsentTalkSessionOperation                 OperationName = "ConsentTalkSession"
-	CreateOrganizationAliasOperation            OperationName = "CreateOrganizationAlias"
-	DeleteDeviceOperation                       OperationName = "DeleteDevice"
-	DeleteOrganizationAliasOperation            OperationName = "DeleteOrganizationAlias"
-	DevAuthorizeOperation                       OperationName = "DevAuthorize"
-	DummyInitOperation                          OperationName = "DummyInit"
-	EditTalkSessionOperation                    OperationName = "EditTalkSession"
-	EditTimeLineOperation                       OperationName = "EditTimeLine"
-	EstablishOrganizationOperation              OperationName = "EstablishOrganization"
-	EstablishUserOperation                      OperationName = "EstablishUser"
-	GetAnalysisReportManageOperation            OperationName = "GetAnalysisReportManage"
-	GetConclusionOperation                      OperationName = "GetConclusion"
-	GetDevicesOperation                         OperationName = "GetDevices"
-	GetNotificationPreferencesOperation         OperationName = "GetNotificationPreferences"
-	GetOpenedTalkSessionOperation               OperationName = "GetOpenedTalkSession"
-	GetOpinionAnalysisOperation                 OperationName = "GetOpinionAnalysis"
-	GetOpinionDetail2Operation                  OperationName = "GetOpinionDetail2"
-	GetOpinionReportReasonsOperation            OperationName = "GetOpinionReportReasons"
-	GetOpinionReportsOperation                  OperationName = "GetOpinionReports"
-	GetOpinionsForTalkSessionOperation          OperationName = "GetOpinionsForTalkSession"
-	GetOrganizationAliasesOperation             OperationName = "GetOrganizationAliases"
-	GetOrganizationUsersOperation               OperationName = "GetOrganizationUsers"
-	GetOrganizationsOperation                   OperationName = "GetOrganizations"
-	GetPolicyConsentStatusOperation             OperationName = "GetPolicyConsentStatus"
-	GetReportsForTalkSessionOperation           OperationName = "GetReportsForTalkSession"
-	GetTalkSessionDetailOperation               OperationName = "GetTalkSessionDetail"
-	GetTalkSessionListOperation                 OperationName = "GetTalkSessionList"
-	GetTalkSessionListManageOperation           OperationName = "GetTalkSessionListManage"
-	GetTalkSessionManageOperation               OperationName = "GetTalkSessionManage"
-	GetTalkSessionReportOperation               OperationName = "GetTalkSessionReport"
-	GetTalkSessionReportCountOperation          OperationName = "GetTalkSessionReportCount"
-	GetTalkSessionRestrictionKeysOperation      OperationName = "GetTalkSessionRestrictionKeys"
-	GetTalkSessionRestrictionSatisfiedOperation OperationName = "GetTalkSessionRestrictionSatisfied"
-	GetTimeLineOperation                        OperationName = "GetTimeLine"
-	GetTokenInfoOperation                       OperationName = "GetTokenInfo"
-	GetUserByDisplayIDOperation                 OperationName = "GetUserByDisplayID"
-	GetUserInfoOperation                        OperationName = "GetUserInfo"
-	GetUserListManageOperation                  OperationName = "GetUserListManage"
-	GetUserStatsListManageOperation             OperationName = "GetUserStatsListManage"
-	GetUserStatsTotalManageOperation            OperationName = "GetUserStatsTotalManage"
-	GetUserTalkSessionsOperation                OperationName = "GetUserTalkSessions"
-	GetVapidKeyOperation                        OperationName = "GetVapidKey"
-	HandleAuthCallbackOperation                 OperationName = "HandleAuthCallback"
-	HasConsentOperation                         OperationName = "HasConsent"
-	HealthOperation                             OperationName = "Health"
-	InitiateTalkSessionOperation                OperationName = "InitiateTalkSession"
-	InviteOrganizationOperation                 OperationName = "InviteOrganization"
-	InviteOrganizationForUserOperation          OperationName = "InviteOrganizationForUser"
-	ManageRegenerateManageOperation             OperationName = "ManageRegenerateManage"
-	OpinionComments2Operation                   OperationName = "OpinionComments2"
-	OpinionsHistoryOperation                    OperationName = "OpinionsHistory"
-	PasswordLoginOperation                      OperationName = "PasswordLogin"
-	PasswordRegisterOperation                   OperationName = "PasswordRegister"
-	PolicyConsentOperation                      OperationName = "PolicyConsent"
-	PostConclusionOperation                     OperationName = "PostConclusion"
-	PostImageOperation                          OperationName = "PostImage"
-	PostOpinionPost2Operation                   OperationName = "PostOpinionPost2"
-	PostTimeLineItemOperation                   OperationName = "PostTimeLineItem"
-	ReactivateUserOperation                     OperationName = "ReactivateUser"
-	RegisterDeviceOperation                     OperationName = "RegisterDevice"
-	ReportOpinionOperation                      OperationName = "ReportOpinion"
-	RevokeTokenOperation                        OperationName = "RevokeToken"
-	SendTestNotificationOperation               OperationName = "SendTestNotification"
-	SessionsHistoryOperation                    OperationName = "SessionsHistory"
-	SolveOpinionReportOperation                 OperationName = "SolveOpinionReport"
-	SwipeOpinionsOperation                      OperationName = "SwipeOpinions"
-	SwitchOrganizationOperation                 OperationName = "SwitchOrganization"
-	TalkSessionAnalysisOperation                OperationName = "TalkSessionAnalysis"
-	TestOperation                               OperationName = "Test"
-	ToggleReportVisibilityManageOperation       OperationName = "ToggleReportVisibilityManage"
-	UpdateNotificationPreferencesOperation      OperationName = "UpdateNotificationPreferences"
-	UpdateOrganizationOperation                 OperationName = "UpdateOrganization"
-	UpdateUserProfileOperation                  OperationName = "UpdateUserProfile"
-	ValidateOrganizationCodeOperation           OperationName = "ValidateOrganizationCode"
-	Vote2Operation                              OperationName = "Vote2"
-	WithdrawUserOperation                       OperationName = "WithdrawUser"
+	AcceptOrganizationInvitationOperation            OperationName = "AcceptOrganizationInvitation"
+	AcceptOwnershipTransferOperation                 OperationName = "AcceptOwnershipTransfer"
+	ApplyFeedbackToReportOperation                   OperationName = "ApplyFeedbackToReport"
+	AuthAccountDetachOperation                       OperationName = "AuthAccountDetach"
+	AuthorizeOperation                               OperationName = "Authorize"
+	BatchVoteOperation                               OperationName = "BatchVote"
+	BulkCreateOrganizationInvitationsOperation       OperationName = "BulkCreateOrganizationInvitations"
+	BulkTransferTalkSessionsOperation                OperationName = "BulkTransferTalkSessions"
+	CancelOwnershipTransferOperation                 OperationName = "CancelOwnershipTransfer"
+	ChangeOrganizationUserRoleOperation              OperationName = "ChangeOrganizationUserRole"
+	ChangePasswordOperation                          OperationName = "ChangePassword"
+	CheckDeviceExistsOperation                       OperationName = "CheckDeviceExists"
+	CloneTalkSessionOperation                        OperationName = "CloneTalkSession"
+	CloneTalkSessionTemplateOperation                OperationName = "CloneTalkSessionTemplate"
+	ConsentTalkSessionOperation                      OperationName = "ConsentTalkSession"
+	CreateOrganizationAliasOperation                 OperationName = "CreateOrganizationAlias"
+	CreateOrganizationApiKeyOperation                OperationName = "CreateOrganizationApiKey"
+	CreateOrganizationInvitationOperation            OperationName = "CreateOrganizationInvitation"
+	DeclineOwnershipTransferOperation                OperationName = "DeclineOwnershipTransfer"
+	DeleteDeviceOperation                            OperationName = "DeleteDevice"
+	DeleteOpinionOperation                           OperationName = "DeleteOpinion"
+	DeleteOrganizationAliasOperation                 OperationName = "DeleteOrganizationAlias"
+	DeleteTalkSessionTemplateOperation               OperationName = "DeleteTalkSessionTemplate"
+	DevAuthorizeOperation                            OperationName = "DevAuthorize"
+	DownloadOrganizationAuditLogsOperation           OperationName = "DownloadOrganizationAuditLogs"
+	DummyInitOperation                               OperationName = "DummyInit"
+	EditOpinionOperation                             OperationName = "EditOpinion"
+	EditTalkSessionOperation                         OperationName = "EditTalkSession"
+	EditTimeLineOperation                            OperationName = "EditTimeLine"
+	EstablishOrganizationOperation                   OperationName = "EstablishOrganization"
+	EstablishUserOperation                           OperationName = "EstablishUser"
+	GetAnalysisJobOperation                          OperationName = "GetAnalysisJob"
+	GetAnalysisReportManageOperation                 OperationName = "GetAnalysisReportManage"
+	GetAnalysisSnapshotOperation                     OperationName = "GetAnalysisSnapshot"
+	GetAnalysisSnapshotDiffOperation                 OperationName = "GetAnalysisSnapshotDiff"
+	GetAnalysisSnapshotsOperation                    OperationName = "GetAnalysisSnapshots"
+	GetAnalysisStatusOperation                       OperationName = "GetAnalysisStatus"
+	GetConclusionOperation                           OperationName = "GetConclusion"
+	GetConsensusOperation                            OperationName = "GetConsensus"
+	GetDevicesOperation                              OperationName = "GetDevices"
+	GetJwksOperation                                 OperationName = "GetJwks"
+	GetMyAnalysisPositionOperation                   OperationName = "GetMyAnalysisPosition"
+	GetNotificationPreferencesOperation              OperationName = "GetNotificationPreferences"
+	GetOpenedTalkSessionOperation                    OperationName = "GetOpenedTalkSession"
+	GetOpinionAnalysisOperation                      OperationName = "GetOpinionAnalysis"
+	GetOpinionDetail2Operation                       OperationName = "GetOpinionDetail2"
+	GetOpinionReportReasonsOperation                 OperationName = "GetOpinionReportReasons"
+	GetOpinionReportsOperation                       OperationName = "GetOpinionReports"
+	GetOpinionRevisionsOperation                     OperationName = "GetOpinionRevisions"
+	GetOpinionsForTalkSessionOperation               OperationName = "GetOpinionsForTalkSession"
+	GetOrganizationAliasesOperation                  OperationName = "GetOrganizationAliases"
+	GetOrganizationApiKeysOperation                  OperationName = "GetOrganizationApiKeys"
+	GetOrganizationAuditLogsOperation                OperationName = "GetOrganizationAuditLogs"
+	GetOrganizationChildrenOperation                 OperationName = "GetOrganizationChildren"
+	GetOrganizationInvitationsOperation              OperationName = "GetOrganizationInvitations"
+	GetOrganizationReferenceDemographicsOperation    OperationName = "GetOrganizationReferenceDemographics"
+	GetOrganizationReportPromptOperation             OperationName = "GetOrganizationReportPrompt"
+	GetOrganizationStatsOperation                    OperationName = "GetOrganizationStats"
+	GetOrganizationTalkSessionsOperation             OperationName = "GetOrganizationTalkSessions"
+	GetOrganizationUsersOperation                    OperationName = "GetOrganizationUsers"
+	GetOrganizationsOperation                        OperationName = "GetOrganizations"
+	GetPolicyConsentStatusOperation                  OperationName = "GetPolicyConsentStatus"
+	GetReportVersionDiffManageOperation              OperationName = "GetReportVersionDiffManage"
+	GetReportVersionsManageOperation                 OperationName = "GetReportVersionsManage"
+	GetReportsForTalkSessionOperation                OperationName = "GetReportsForTalkSession"
+	GetSigningKeysManageOperation                    OperationName = "GetSigningKeysManage"
+	GetTalkSessionCollaboratorsOperation             OperationName = "GetTalkSessionCollaborators"
+	GetTalkSessionDetailOperation                    OperationName = "GetTalkSessionDetail"
+	GetTalkSessionListOperation                      OperationName = "GetTalkSessionList"
+	GetTalkSessionListManageOperation                OperationName = "GetTalkSessionListManage"
+	GetTalkSessionManageOperation                    OperationName = "GetTalkSessionManage"
+	GetTalkSessionReportOperation                    OperationName = "GetTalkSessionReport"
+	GetTalkSessionReportCountOperation               OperationName = "GetTalkSessionReportCount"
+	GetTalkSessionRepresentativenessOperation        OperationName = "GetTalkSessionRepresentativeness"
+	GetTalkSessionRestrictionKeysOperation           OperationName = "GetTalkSessionRestrictionKeys"
+	GetTalkSessionRestrictionSatisfiedOperation      OperationName = "GetTalkSessionRestrictionSatisfied"
+	GetTalkSessionTemplatesOperation                 OperationName = "GetTalkSessionTemplates"
+	GetTermFrequenciesOperation                      OperationName = "GetTermFrequencies"
+	GetTimeLineOperation                             OperationName = "GetTimeLine"
+	GetTokenInfoOperation                            OperationName = "GetTokenInfo"
+	GetTopicsOperation                               OperationName = "GetTopics"
+	GetUserByDisplayIDOperation                      OperationName = "GetUserByDisplayID"
+	GetUserInfoOperation                             OperationName = "GetUserInfo"
+	GetUserListManageOperation                       OperationName = "GetUserListManage"
+	GetUserStatsListManageOperation                  OperationName = "GetUserStatsListManage"
+	GetUserStatsTotalManageOperation                 OperationName = "GetUserStatsTotalManage"
+	GetUserTalkSessionsOperation                     OperationName = "GetUserTalkSessions"
+	GetVapidKeyOperation                             OperationName = "GetVapidKey"
+	GetVoteShiftsOperation                           OperationName = "GetVoteShifts"
+	HandleAuthCallbackOperation                      OperationName = "HandleAuthCallback"
+	HasConsentOperation                              OperationName = "HasConsent"
+	HealthOperation                                  OperationName = "Health"
+	InitiateTalkSessionOperation                     OperationName = "InitiateTalkSession"
+	InviteOrganizationOperation                      OperationName = "InviteOrganization"
+	InviteOrganizationForUserOperation               OperationName = "InviteOrganizationForUser"
+	ListOwnershipTransfersOperation                  OperationName = "ListOwnershipTransfers"
+	ManageRegenerateManageOperation                  OperationName = "ManageRegenerateManage"
+	OpinionComments2Operation                        OperationName = "OpinionComments2"
+	OpinionsHistoryOperation                         OperationName = "OpinionsHistory"
+	PasswordLoginOperation                           OperationName = "PasswordLogin"
+	PasswordRegisterOperation                        OperationName = "PasswordRegister"
+	PolicyConsentOperation                           OperationName = "PolicyConsent"
+	PostConclusionOperation                          OperationName = "PostConclusion"
+	PostImageOperation                               OperationName = "PostImage"
+	PostOpinionPost2Operation                        OperationName = "PostOpinionPost2"
+	PostTimeLineItemOperation                        OperationName = "PostTimeLineItem"
+	PublishReportVersionManageOperation              OperationName = "PublishReportVersionManage"
+	PublishTalkSessionOperation                      OperationName = "PublishTalkSession"
+	ReactivateUserOperation                          OperationName = "ReactivateUser"
+	RegisterDeviceOperation                          OperationName = "RegisterDevice"
+	RemoveOrganizationUserOperation                  OperationName = "RemoveOrganizationUser"
+	RemoveTalkSessionCollaboratorOperation           OperationName = "RemoveTalkSessionCollaborator"
+	ReportOpinionOperation                           OperationName = "ReportOpinion"
+	RequestOrganizationOwnershipTransferOperation    OperationName = "RequestOrganizationOwnershipTransfer"
+	RequestTalkSessionOwnershipTransferOperation     OperationName = "RequestTalkSessionOwnershipTransfer"
+	ResendOrganizationInvitationOperation            OperationName = "ResendOrganizationInvitation"
+	RevokeOrganizationApiKeyOperation                OperationName = "RevokeOrganizationApiKey"
+	RevokeOrganizationInvitationOperation            OperationName = "RevokeOrganizationInvitation"
+	RevokeTokenOperation                             OperationName = "RevokeToken"
+	RotateSigningKeyManageOperation                  OperationName = "RotateSigningKeyManage"
+	SaveTalkSessionTemplateOperation                 OperationName = "SaveTalkSessionTemplate"
+	SendTestNotificationOperation                    OperationName = "SendTestNotification"
+	SessionsHistoryOperation                         OperationName = "SessionsHistory"
+	SetTalkSessionCollaboratorOperation              OperationName = "SetTalkSessionCollaborator"
+	SolveOpinionReportOperation                      OperationName = "SolveOpinionReport"
+	SwipeOpinionsOperation                           OperationName = "SwipeOpinions"
+	SwitchOrganizationOperation                      OperationName = "SwitchOrganization"
+	TalkSessionAnalysisOperation                     OperationName = "TalkSessionAnalysis"
+	TestOperation                                    OperationName = "Test"
+	ToggleReportVisibilityManageOperation            OperationName = "ToggleReportVisibilityManage"
+	UpdateNotificationPreferencesOperation           OperationName = "UpdateNotificationPreferences"
+	UpdateOrganizationOperation                      OperationName = "UpdateOrganization"
+	UpdateOrganizationParentOperation                OperationName = "UpdateOrganizationParent"
+	UpdateOrganizationReferenceDemographicsOperation OperationName = "UpdateOrganizationReferenceDemographics"
+	UpdateOrganizationReportPromptOperation          OperationName = "UpdateOrganizationReportPrompt"
+	UpdateUserProfileOperation                       OperationName = "UpdateUserProfile"
+	ValidateOrganizationCodeOperation                OperationName = "ValidateOrganizationCode"
+	Vote2Operation                                   OperationName = "Vote2"
+	WithdrawUserOperation                            OperationName = "WithdrawUser"
 )
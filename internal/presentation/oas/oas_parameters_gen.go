@@ -5,6 +5,7 @@ package oas
 import (
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/go-faster/errors"
 
@@ -15,6 +16,71 @@ import (
 	"github.com/ogen-go/ogen/validate"
 )
 
+// AcceptOwnershipTransferParams is parameters of acceptOwnershipTransfer operation.
+type AcceptOwnershipTransferParams struct {
+	TransferID string
+}
+
+func unpackAcceptOwnershipTransferParams(packed middleware.Parameters) (params AcceptOwnershipTransferParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "transferID",
+			In:   "path",
+		}
+		params.TransferID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeAcceptOwnershipTransferParams(args [1]string, argsEscaped bool, r *http.Request) (params AcceptOwnershipTransferParams, _ error) {
+	// Decode path: transferID.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "transferID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.TransferID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "transferID",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
 // AuthorizeParams is parameters of authorize operation.
 type AuthorizeParams struct {
 	Provider AuthorizeProvider
@@ -238,138 +304,42 @@ func decodeAuthorizeParams(args [1]string, argsEscaped bool, r *http.Request) (p
 	return params, nil
 }
 
-// ChangePasswordParams is parameters of changePassword operation.
-type ChangePasswordParams struct {
-	// 古いパスワード.
-	OldPassword string
-	// 新たなパスワード.
-	NewPassword string
+// BatchVoteParams is parameters of batchVote operation.
+type BatchVoteParams struct {
+	TalkSessionID string
 }
 
-func unpackChangePasswordParams(packed middleware.Parameters) (params ChangePasswordParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "oldPassword",
-			In:   "query",
-		}
-		params.OldPassword = packed[key].(string)
-	}
+func unpackBatchVoteParams(packed middleware.Parameters) (params BatchVoteParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "newPassword",
-			In:   "query",
+			Name: "talkSessionID",
+			In:   "path",
 		}
-		params.NewPassword = packed[key].(string)
+		params.TalkSessionID = packed[key].(string)
 	}
 	return params
 }
 
-func decodeChangePasswordParams(args [0]string, argsEscaped bool, r *http.Request) (params ChangePasswordParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: oldPassword.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "oldPassword",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
-
-				params.OldPassword = c
-				return nil
-			}); err != nil {
-				return err
-			}
-		} else {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "oldPassword",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Decode query: newPassword.
+func decodeBatchVoteParams(args [1]string, argsEscaped bool, r *http.Request) (params BatchVoteParams, _ error) {
+	// Decode path: talkSessionID.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "newPassword",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
-
-				params.NewPassword = c
-				return nil
-			}); err != nil {
-				return err
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
 			}
-		} else {
-			return err
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "newPassword",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// CheckDeviceExistsParams is parameters of checkDeviceExists operation.
-type CheckDeviceExistsParams struct {
-	// FCMトークンまたはAPNSトークン.
-	DeviceToken string
-}
-
-func unpackCheckDeviceExistsParams(packed middleware.Parameters) (params CheckDeviceExistsParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "device_token",
-			In:   "query",
-		}
-		params.DeviceToken = packed[key].(string)
-	}
-	return params
-}
-
-func decodeCheckDeviceExistsParams(args [0]string, argsEscaped bool, r *http.Request) (params CheckDeviceExistsParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: device_token.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "device_token",
-			Style:   uri.QueryStyleForm,
-			Explode: false,
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "talkSessionID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+			if err := func() error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
@@ -380,43 +350,43 @@ func decodeCheckDeviceExistsParams(args [0]string, argsEscaped bool, r *http.Req
 					return err
 				}
 
-				params.DeviceToken = c
+				params.TalkSessionID = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
 		} else {
-			return err
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "device_token",
-			In:   "query",
+			Name: "talkSessionID",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// ConsentTalkSessionParams is parameters of consentTalkSession operation.
-type ConsentTalkSessionParams struct {
-	TalkSessionID string
+// BulkTransferTalkSessionsParams is parameters of bulkTransferTalkSessions operation.
+type BulkTransferTalkSessionsParams struct {
+	Code string
 }
 
-func unpackConsentTalkSessionParams(packed middleware.Parameters) (params ConsentTalkSessionParams) {
+func unpackBulkTransferTalkSessionsParams(packed middleware.Parameters) (params BulkTransferTalkSessionsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "talkSessionID",
+			Name: "code",
 			In:   "path",
 		}
-		params.TalkSessionID = packed[key].(string)
+		params.Code = packed[key].(string)
 	}
 	return params
 }
 
-func decodeConsentTalkSessionParams(args [1]string, argsEscaped bool, r *http.Request) (params ConsentTalkSessionParams, _ error) {
-	// Decode path: talkSessionID.
+func decodeBulkTransferTalkSessionsParams(args [1]string, argsEscaped bool, r *http.Request) (params BulkTransferTalkSessionsParams, _ error) {
+	// Decode path: code.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -428,7 +398,7 @@ func decodeConsentTalkSessionParams(args [1]string, argsEscaped bool, r *http.Re
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "talkSessionID",
+				Param:   "code",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -445,7 +415,7 @@ func decodeConsentTalkSessionParams(args [1]string, argsEscaped bool, r *http.Re
 					return err
 				}
 
-				params.TalkSessionID = c
+				params.Code = c
 				return nil
 			}(); err != nil {
 				return err
@@ -456,7 +426,7 @@ func decodeConsentTalkSessionParams(args [1]string, argsEscaped bool, r *http.Re
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "talkSessionID",
+			Name: "code",
 			In:   "path",
 			Err:  err,
 		}
@@ -464,24 +434,24 @@ func decodeConsentTalkSessionParams(args [1]string, argsEscaped bool, r *http.Re
 	return params, nil
 }
 
-// DeleteDeviceParams is parameters of deleteDevice operation.
-type DeleteDeviceParams struct {
-	DeviceId string
+// CancelOwnershipTransferParams is parameters of cancelOwnershipTransfer operation.
+type CancelOwnershipTransferParams struct {
+	TransferID string
 }
 
-func unpackDeleteDeviceParams(packed middleware.Parameters) (params DeleteDeviceParams) {
+func unpackCancelOwnershipTransferParams(packed middleware.Parameters) (params CancelOwnershipTransferParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "deviceId",
+			Name: "transferID",
 			In:   "path",
 		}
-		params.DeviceId = packed[key].(string)
+		params.TransferID = packed[key].(string)
 	}
 	return params
 }
 
-func decodeDeleteDeviceParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteDeviceParams, _ error) {
-	// Decode path: deviceId.
+func decodeCancelOwnershipTransferParams(args [1]string, argsEscaped bool, r *http.Request) (params CancelOwnershipTransferParams, _ error) {
+	// Decode path: transferID.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -493,7 +463,7 @@ func decodeDeleteDeviceParams(args [1]string, argsEscaped bool, r *http.Request)
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "deviceId",
+				Param:   "transferID",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -510,7 +480,7 @@ func decodeDeleteDeviceParams(args [1]string, argsEscaped bool, r *http.Request)
 					return err
 				}
 
-				params.DeviceId = c
+				params.TransferID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -521,7 +491,7 @@ func decodeDeleteDeviceParams(args [1]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "deviceId",
+			Name: "transferID",
 			In:   "path",
 			Err:  err,
 		}
@@ -529,24 +499,24 @@ func decodeDeleteDeviceParams(args [1]string, argsEscaped bool, r *http.Request)
 	return params, nil
 }
 
-// DeleteOrganizationAliasParams is parameters of deleteOrganizationAlias operation.
-type DeleteOrganizationAliasParams struct {
-	AliasID string
+// ChangeOrganizationUserRoleParams is parameters of changeOrganizationUserRole operation.
+type ChangeOrganizationUserRoleParams struct {
+	UserID string
 }
 
-func unpackDeleteOrganizationAliasParams(packed middleware.Parameters) (params DeleteOrganizationAliasParams) {
+func unpackChangeOrganizationUserRoleParams(packed middleware.Parameters) (params ChangeOrganizationUserRoleParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "aliasID",
+			Name: "userID",
 			In:   "path",
 		}
-		params.AliasID = packed[key].(string)
+		params.UserID = packed[key].(string)
 	}
 	return params
 }
 
-func decodeDeleteOrganizationAliasParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteOrganizationAliasParams, _ error) {
-	// Decode path: aliasID.
+func decodeChangeOrganizationUserRoleParams(args [1]string, argsEscaped bool, r *http.Request) (params ChangeOrganizationUserRoleParams, _ error) {
+	// Decode path: userID.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -558,7 +528,7 @@ func decodeDeleteOrganizationAliasParams(args [1]string, argsEscaped bool, r *ht
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "aliasID",
+				Param:   "userID",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -575,7 +545,7 @@ func decodeDeleteOrganizationAliasParams(args [1]string, argsEscaped bool, r *ht
 					return err
 				}
 
-				params.AliasID = c
+				params.UserID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -586,7 +556,7 @@ func decodeDeleteOrganizationAliasParams(args [1]string, argsEscaped bool, r *ht
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "aliasID",
+			Name: "userID",
 			In:   "path",
 			Err:  err,
 		}
@@ -594,48 +564,38 @@ func decodeDeleteOrganizationAliasParams(args [1]string, argsEscaped bool, r *ht
 	return params, nil
 }
 
-// DevAuthorizeParams is parameters of devAuthorize operation.
-type DevAuthorizeParams struct {
-	RedirectURL string
-	// Devのみで使用するsubjectです。ここで指定した値はログインした後も確認できないため覚えておいてください。同じ値を指定すると同じアカウントにログインできます。.
-	ID string
-	// 組織コード（組織ログインの場合）.
-	OrganizationCode OptString
+// ChangePasswordParams is parameters of changePassword operation.
+type ChangePasswordParams struct {
+	// 古いパスワード.
+	OldPassword string
+	// 新たなパスワード.
+	NewPassword string
 }
 
-func unpackDevAuthorizeParams(packed middleware.Parameters) (params DevAuthorizeParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "redirect_url",
-			In:   "query",
-		}
-		params.RedirectURL = packed[key].(string)
-	}
+func unpackChangePasswordParams(packed middleware.Parameters) (params ChangePasswordParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "id",
+			Name: "oldPassword",
 			In:   "query",
 		}
-		params.ID = packed[key].(string)
+		params.OldPassword = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "organizationCode",
+			Name: "newPassword",
 			In:   "query",
 		}
-		if v, ok := packed[key]; ok {
-			params.OrganizationCode = v.(OptString)
-		}
+		params.NewPassword = packed[key].(string)
 	}
 	return params
 }
 
-func decodeDevAuthorizeParams(args [0]string, argsEscaped bool, r *http.Request) (params DevAuthorizeParams, _ error) {
+func decodeChangePasswordParams(args [0]string, argsEscaped bool, r *http.Request) (params ChangePasswordParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: redirect_url.
+	// Decode query: oldPassword.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "redirect_url",
+			Name:    "oldPassword",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
@@ -652,7 +612,7 @@ func decodeDevAuthorizeParams(args [0]string, argsEscaped bool, r *http.Request)
 					return err
 				}
 
-				params.RedirectURL = c
+				params.OldPassword = c
 				return nil
 			}); err != nil {
 				return err
@@ -663,15 +623,15 @@ func decodeDevAuthorizeParams(args [0]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "redirect_url",
+			Name: "oldPassword",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: id.
+	// Decode query: newPassword.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "id",
+			Name:    "newPassword",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
@@ -688,7 +648,7 @@ func decodeDevAuthorizeParams(args [0]string, argsEscaped bool, r *http.Request)
 					return err
 				}
 
-				params.ID = c
+				params.NewPassword = c
 				return nil
 			}); err != nil {
 				return err
@@ -699,48 +659,65 @@ func decodeDevAuthorizeParams(args [0]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "id",
+			Name: "newPassword",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: organizationCode.
+	return params, nil
+}
+
+// CheckDeviceExistsParams is parameters of checkDeviceExists operation.
+type CheckDeviceExistsParams struct {
+	// FCMトークンまたはAPNSトークン.
+	DeviceToken string
+}
+
+func unpackCheckDeviceExistsParams(packed middleware.Parameters) (params CheckDeviceExistsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "device_token",
+			In:   "query",
+		}
+		params.DeviceToken = packed[key].(string)
+	}
+	return params
+}
+
+func decodeCheckDeviceExistsParams(args [0]string, argsEscaped bool, r *http.Request) (params CheckDeviceExistsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: device_token.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "organizationCode",
+			Name:    "device_token",
 			Style:   uri.QueryStyleForm,
-			Explode: true,
+			Explode: false,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOrganizationCodeVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotOrganizationCodeVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.OrganizationCode.SetTo(paramsDotOrganizationCodeVal)
+
+				params.DeviceToken = c
 				return nil
 			}); err != nil {
 				return err
 			}
+		} else {
+			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "organizationCode",
+			Name: "device_token",
 			In:   "query",
 			Err:  err,
 		}
@@ -748,12 +725,12 @@ func decodeDevAuthorizeParams(args [0]string, argsEscaped bool, r *http.Request)
 	return params, nil
 }
 
-// EditTalkSessionParams is parameters of editTalkSession operation.
-type EditTalkSessionParams struct {
+// CloneTalkSessionParams is parameters of cloneTalkSession operation.
+type CloneTalkSessionParams struct {
 	TalkSessionID string
 }
 
-func unpackEditTalkSessionParams(packed middleware.Parameters) (params EditTalkSessionParams) {
+func unpackCloneTalkSessionParams(packed middleware.Parameters) (params CloneTalkSessionParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "talkSessionID",
@@ -764,7 +741,7 @@ func unpackEditTalkSessionParams(packed middleware.Parameters) (params EditTalkS
 	return params
 }
 
-func decodeEditTalkSessionParams(args [1]string, argsEscaped bool, r *http.Request) (params EditTalkSessionParams, _ error) {
+func decodeCloneTalkSessionParams(args [1]string, argsEscaped bool, r *http.Request) (params CloneTalkSessionParams, _ error) {
 	// Decode path: talkSessionID.
 	if err := func() error {
 		param := args[0]
@@ -813,32 +790,32 @@ func decodeEditTalkSessionParams(args [1]string, argsEscaped bool, r *http.Reque
 	return params, nil
 }
 
-// EditTimeLineParams is parameters of editTimeLine operation.
-type EditTimeLineParams struct {
-	TalkSessionID string
-	ActionItemID  string
+// CloneTalkSessionTemplateParams is parameters of cloneTalkSessionTemplate operation.
+type CloneTalkSessionTemplateParams struct {
+	Code       string
+	TemplateID string
 }
 
-func unpackEditTimeLineParams(packed middleware.Parameters) (params EditTimeLineParams) {
+func unpackCloneTalkSessionTemplateParams(packed middleware.Parameters) (params CloneTalkSessionTemplateParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "talkSessionID",
+			Name: "code",
 			In:   "path",
 		}
-		params.TalkSessionID = packed[key].(string)
+		params.Code = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "actionItemID",
+			Name: "templateID",
 			In:   "path",
 		}
-		params.ActionItemID = packed[key].(string)
+		params.TemplateID = packed[key].(string)
 	}
 	return params
 }
 
-func decodeEditTimeLineParams(args [2]string, argsEscaped bool, r *http.Request) (params EditTimeLineParams, _ error) {
-	// Decode path: talkSessionID.
+func decodeCloneTalkSessionTemplateParams(args [2]string, argsEscaped bool, r *http.Request) (params CloneTalkSessionTemplateParams, _ error) {
+	// Decode path: code.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -850,7 +827,7 @@ func decodeEditTimeLineParams(args [2]string, argsEscaped bool, r *http.Request)
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "talkSessionID",
+				Param:   "code",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -867,7 +844,7 @@ func decodeEditTimeLineParams(args [2]string, argsEscaped bool, r *http.Request)
 					return err
 				}
 
-				params.TalkSessionID = c
+				params.Code = c
 				return nil
 			}(); err != nil {
 				return err
@@ -878,12 +855,12 @@ func decodeEditTimeLineParams(args [2]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "talkSessionID",
+			Name: "code",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode path: actionItemID.
+	// Decode path: templateID.
 	if err := func() error {
 		param := args[1]
 		if argsEscaped {
@@ -895,7 +872,7 @@ func decodeEditTimeLineParams(args [2]string, argsEscaped bool, r *http.Request)
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "actionItemID",
+				Param:   "templateID",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -912,7 +889,7 @@ func decodeEditTimeLineParams(args [2]string, argsEscaped bool, r *http.Request)
 					return err
 				}
 
-				params.ActionItemID = c
+				params.TemplateID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -923,7 +900,7 @@ func decodeEditTimeLineParams(args [2]string, argsEscaped bool, r *http.Request)
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "actionItemID",
+			Name: "templateID",
 			In:   "path",
 			Err:  err,
 		}
@@ -931,12 +908,12 @@ func decodeEditTimeLineParams(args [2]string, argsEscaped bool, r *http.Request)
 	return params, nil
 }
 
-// GetAnalysisReportManageParams is parameters of getAnalysisReportManage operation.
-type GetAnalysisReportManageParams struct {
+// ConsentTalkSessionParams is parameters of consentTalkSession operation.
+type ConsentTalkSessionParams struct {
 	TalkSessionID string
 }
 
-func unpackGetAnalysisReportManageParams(packed middleware.Parameters) (params GetAnalysisReportManageParams) {
+func unpackConsentTalkSessionParams(packed middleware.Parameters) (params ConsentTalkSessionParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "talkSessionID",
@@ -947,7 +924,7 @@ func unpackGetAnalysisReportManageParams(packed middleware.Parameters) (params G
 	return params
 }
 
-func decodeGetAnalysisReportManageParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAnalysisReportManageParams, _ error) {
+func decodeConsentTalkSessionParams(args [1]string, argsEscaped bool, r *http.Request) (params ConsentTalkSessionParams, _ error) {
 	// Decode path: talkSessionID.
 	if err := func() error {
 		param := args[0]
@@ -996,24 +973,24 @@ func decodeGetAnalysisReportManageParams(args [1]string, argsEscaped bool, r *ht
 	return params, nil
 }
 
-// GetConclusionParams is parameters of getConclusion operation.
-type GetConclusionParams struct {
-	TalkSessionID string
+// DeclineOwnershipTransferParams is parameters of declineOwnershipTransfer operation.
+type DeclineOwnershipTransferParams struct {
+	TransferID string
 }
 
-func unpackGetConclusionParams(packed middleware.Parameters) (params GetConclusionParams) {
+func unpackDeclineOwnershipTransferParams(packed middleware.Parameters) (params DeclineOwnershipTransferParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "talkSessionID",
+			Name: "transferID",
 			In:   "path",
 		}
-		params.TalkSessionID = packed[key].(string)
+		params.TransferID = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGetConclusionParams(args [1]string, argsEscaped bool, r *http.Request) (params GetConclusionParams, _ error) {
-	// Decode path: talkSessionID.
+func decodeDeclineOwnershipTransferParams(args [1]string, argsEscaped bool, r *http.Request) (params DeclineOwnershipTransferParams, _ error) {
+	// Decode path: transferID.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -1025,7 +1002,7 @@ func decodeGetConclusionParams(args [1]string, argsEscaped bool, r *http.Request
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "talkSessionID",
+				Param:   "transferID",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -1042,7 +1019,7 @@ func decodeGetConclusionParams(args [1]string, argsEscaped bool, r *http.Request
 					return err
 				}
 
-				params.TalkSessionID = c
+				params.TransferID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1053,7 +1030,7 @@ func decodeGetConclusionParams(args [1]string, argsEscaped bool, r *http.Request
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "talkSessionID",
+			Name: "transferID",
 			In:   "path",
 			Err:  err,
 		}
@@ -1061,250 +1038,77 @@ func decodeGetConclusionParams(args [1]string, argsEscaped bool, r *http.Request
 	return params, nil
 }
 
-// GetOpenedTalkSessionParams is parameters of getOpenedTalkSession operation.
-type GetOpenedTalkSessionParams struct {
-	Limit  OptInt
-	Offset OptInt
-	// テーマ.
-	Theme  OptString
-	Status OptNilGetOpenedTalkSessionStatus
+// DeleteDeviceParams is parameters of deleteDevice operation.
+type DeleteDeviceParams struct {
+	DeviceId string
 }
 
-func unpackGetOpenedTalkSessionParams(packed middleware.Parameters) (params GetOpenedTalkSessionParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "offset",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptInt)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "theme",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Theme = v.(OptString)
-		}
-	}
+func unpackDeleteDeviceParams(packed middleware.Parameters) (params DeleteDeviceParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "status",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Status = v.(OptNilGetOpenedTalkSessionStatus)
+			Name: "deviceId",
+			In:   "path",
 		}
+		params.DeviceId = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGetOpenedTalkSessionParams(args [0]string, argsEscaped bool, r *http.Request) (params GetOpenedTalkSessionParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Set default value for query: limit.
-	{
-		val := int(10)
-		params.Limit.SetTo(val)
-	}
-	// Decode query: limit.
+func decodeDeleteDeviceParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteDeviceParams, _ error) {
+	// Decode path: deviceId.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "deviceId",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotLimitVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
+
+				params.DeviceId = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
-			In:   "query",
+			Name: "deviceId",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: offset.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "offset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
+	return params, nil
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOffsetVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotOffsetVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Offset.SetTo(paramsDotOffsetVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "offset",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Decode query: theme.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "theme",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotThemeVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotThemeVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Theme.SetTo(paramsDotThemeVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "theme",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	// Decode query: status.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "status",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotStatusVal GetOpenedTalkSessionStatus
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotStatusVal = GetOpenedTalkSessionStatus(c)
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Status.SetTo(paramsDotStatusVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Status.Get(); ok {
-					if err := func() error {
-						if err := value.Validate(); err != nil {
-							return err
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
-				}
-				return nil
-			}(); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "status",
-			In:   "query",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// GetOpinionAnalysisParams is parameters of getOpinionAnalysis operation.
-type GetOpinionAnalysisParams struct {
+// DeleteOpinionParams is parameters of deleteOpinion operation.
+type DeleteOpinionParams struct {
 	OpinionID string
 }
 
-func unpackGetOpinionAnalysisParams(packed middleware.Parameters) (params GetOpinionAnalysisParams) {
+func unpackDeleteOpinionParams(packed middleware.Parameters) (params DeleteOpinionParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "opinionID",
@@ -1315,7 +1119,7 @@ func unpackGetOpinionAnalysisParams(packed middleware.Parameters) (params GetOpi
 	return params
 }
 
-func decodeGetOpinionAnalysisParams(args [1]string, argsEscaped bool, r *http.Request) (params GetOpinionAnalysisParams, _ error) {
+func decodeDeleteOpinionParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteOpinionParams, _ error) {
 	// Decode path: opinionID.
 	if err := func() error {
 		param := args[0]
@@ -1364,24 +1168,24 @@ func decodeGetOpinionAnalysisParams(args [1]string, argsEscaped bool, r *http.Re
 	return params, nil
 }
 
-// GetOpinionDetail2Params is parameters of getOpinionDetail2 operation.
-type GetOpinionDetail2Params struct {
-	OpinionID string
+// DeleteOrganizationAliasParams is parameters of deleteOrganizationAlias operation.
+type DeleteOrganizationAliasParams struct {
+	AliasID string
 }
 
-func unpackGetOpinionDetail2Params(packed middleware.Parameters) (params GetOpinionDetail2Params) {
+func unpackDeleteOrganizationAliasParams(packed middleware.Parameters) (params DeleteOrganizationAliasParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "opinionID",
+			Name: "aliasID",
 			In:   "path",
 		}
-		params.OpinionID = packed[key].(string)
+		params.AliasID = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGetOpinionDetail2Params(args [1]string, argsEscaped bool, r *http.Request) (params GetOpinionDetail2Params, _ error) {
-	// Decode path: opinionID.
+func decodeDeleteOrganizationAliasParams(args [1]string, argsEscaped bool, r *http.Request) (params DeleteOrganizationAliasParams, _ error) {
+	// Decode path: aliasID.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -1393,7 +1197,7 @@ func decodeGetOpinionDetail2Params(args [1]string, argsEscaped bool, r *http.Req
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "opinionID",
+				Param:   "aliasID",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -1410,7 +1214,7 @@ func decodeGetOpinionDetail2Params(args [1]string, argsEscaped bool, r *http.Req
 					return err
 				}
 
-				params.OpinionID = c
+				params.AliasID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1421,7 +1225,7 @@ func decodeGetOpinionDetail2Params(args [1]string, argsEscaped bool, r *http.Req
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "opinionID",
+			Name: "aliasID",
 			In:   "path",
 			Err:  err,
 		}
@@ -1429,24 +1233,32 @@ func decodeGetOpinionDetail2Params(args [1]string, argsEscaped bool, r *http.Req
 	return params, nil
 }
 
-// GetOpinionReportsParams is parameters of getOpinionReports operation.
-type GetOpinionReportsParams struct {
-	OpinionID string
+// DeleteTalkSessionTemplateParams is parameters of deleteTalkSessionTemplate operation.
+type DeleteTalkSessionTemplateParams struct {
+	Code       string
+	TemplateID string
 }
 
-func unpackGetOpinionReportsParams(packed middleware.Parameters) (params GetOpinionReportsParams) {
+func unpackDeleteTalkSessionTemplateParams(packed middleware.Parameters) (params DeleteTalkSessionTemplateParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "opinionID",
+			Name: "code",
 			In:   "path",
 		}
-		params.OpinionID = packed[key].(string)
+		params.Code = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "templateID",
+			In:   "path",
+		}
+		params.TemplateID = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGetOpinionReportsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetOpinionReportsParams, _ error) {
-	// Decode path: opinionID.
+func decodeDeleteTalkSessionTemplateParams(args [2]string, argsEscaped bool, r *http.Request) (params DeleteTalkSessionTemplateParams, _ error) {
+	// Decode path: code.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -1458,7 +1270,7 @@ func decodeGetOpinionReportsParams(args [1]string, argsEscaped bool, r *http.Req
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "opinionID",
+				Param:   "code",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -1475,7 +1287,7 @@ func decodeGetOpinionReportsParams(args [1]string, argsEscaped bool, r *http.Req
 					return err
 				}
 
-				params.OpinionID = c
+				params.Code = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1486,77 +1298,16 @@ func decodeGetOpinionReportsParams(args [1]string, argsEscaped bool, r *http.Req
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "opinionID",
+			Name: "code",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// GetOpinionsForTalkSessionParams is parameters of getOpinionsForTalkSession operation.
-type GetOpinionsForTalkSessionParams struct {
-	TalkSessionID string
-	Sort          OptNilGetOpinionsForTalkSessionSort
-	Limit         OptNilInt
-	Offset        OptNilInt
-	Seed          OptNilBool
-}
-
-func unpackGetOpinionsForTalkSessionParams(packed middleware.Parameters) (params GetOpinionsForTalkSessionParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "talkSessionID",
-			In:   "path",
-		}
-		params.TalkSessionID = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "sort",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Sort = v.(OptNilGetOpinionsForTalkSessionSort)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptNilInt)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "offset",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptNilInt)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "seed",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Seed = v.(OptNilBool)
-		}
-	}
-	return params
-}
-
-func decodeGetOpinionsForTalkSessionParams(args [1]string, argsEscaped bool, r *http.Request) (params GetOpinionsForTalkSessionParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: talkSessionID.
+	// Decode path: templateID.
 	if err := func() error {
-		param := args[0]
+		param := args[1]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
+			unescaped, err := url.PathUnescape(args[1])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -1564,7 +1315,7 @@ func decodeGetOpinionsForTalkSessionParams(args [1]string, argsEscaped bool, r *
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "talkSessionID",
+				Param:   "templateID",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -1581,7 +1332,7 @@ func decodeGetOpinionsForTalkSessionParams(args [1]string, argsEscaped bool, r *
 					return err
 				}
 
-				params.TalkSessionID = c
+				params.TemplateID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -1592,177 +1343,152 @@ func decodeGetOpinionsForTalkSessionParams(args [1]string, argsEscaped bool, r *
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "talkSessionID",
+			Name: "templateID",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: sort.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "sort",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotSortVal GetOpinionsForTalkSessionSort
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+	return params, nil
+}
 
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+// DevAuthorizeParams is parameters of devAuthorize operation.
+type DevAuthorizeParams struct {
+	RedirectURL string
+	// Devのみで使用するsubjectです。ここで指定した値はログインした後も確認できないため覚えておいてください。同じ値を指定すると同じアカウントにログインできます。.
+	ID string
+	// 組織コード（組織ログインの場合）.
+	OrganizationCode OptString
+}
 
-					paramsDotSortVal = GetOpinionsForTalkSessionSort(c)
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Sort.SetTo(paramsDotSortVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Sort.Get(); ok {
-					if err := func() error {
-						if err := value.Validate(); err != nil {
-							return err
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
-				}
-				return nil
-			}(); err != nil {
-				return err
-			}
+func unpackDevAuthorizeParams(packed middleware.Parameters) (params DevAuthorizeParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "redirect_url",
+			In:   "query",
 		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "sort",
+		params.RedirectURL = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "id",
 			In:   "query",
-			Err:  err,
 		}
+		params.ID = packed[key].(string)
 	}
-	// Decode query: limit.
+	{
+		key := middleware.ParameterKey{
+			Name: "organizationCode",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.OrganizationCode = v.(OptString)
+		}
+	}
+	return params
+}
+
+func decodeDevAuthorizeParams(args [0]string, argsEscaped bool, r *http.Request) (params DevAuthorizeParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: redirect_url.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
+			Name:    "redirect_url",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotLimitVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
+
+				params.RedirectURL = c
 				return nil
 			}); err != nil {
 				return err
 			}
+		} else {
+			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
+			Name: "redirect_url",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: offset.
+	// Decode query: id.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "offset",
+			Name:    "id",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOffsetVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotOffsetVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.Offset.SetTo(paramsDotOffsetVal)
+
+				params.ID = c
 				return nil
 			}); err != nil {
 				return err
 			}
+		} else {
+			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "offset",
+			Name: "id",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: seed.
+	// Decode query: organizationCode.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "seed",
+			Name:    "organizationCode",
 			Style:   uri.QueryStyleForm,
 			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotSeedVal bool
+				var paramsDotOrganizationCodeVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToBool(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotSeedVal = c
+					paramsDotOrganizationCodeVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Seed.SetTo(paramsDotSeedVal)
+				params.OrganizationCode.SetTo(paramsDotOrganizationCodeVal)
 				return nil
 			}); err != nil {
 				return err
@@ -1771,7 +1497,7 @@ func decodeGetOpinionsForTalkSessionParams(args [1]string, argsEscaped bool, r *
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "seed",
+			Name: "organizationCode",
 			In:   "query",
 			Err:  err,
 		}
@@ -1779,90 +1505,118 @@ func decodeGetOpinionsForTalkSessionParams(args [1]string, argsEscaped bool, r *
 	return params, nil
 }
 
-// GetReportsForTalkSessionParams is parameters of getReportsForTalkSession operation.
-type GetReportsForTalkSessionParams struct {
-	TalkSessionID string
-	Status        OptGetReportsForTalkSessionStatus
+// DownloadOrganizationAuditLogsParams is parameters of downloadOrganizationAuditLogs operation.
+type DownloadOrganizationAuditLogsParams struct {
+	OrganizationID OptString
+	Action         OptString
+	ActorID        OptString
+	Since          OptDateTime
+	Until          OptDateTime
 }
 
-func unpackGetReportsForTalkSessionParams(packed middleware.Parameters) (params GetReportsForTalkSessionParams) {
+func unpackDownloadOrganizationAuditLogsParams(packed middleware.Parameters) (params DownloadOrganizationAuditLogsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "talkSessionID",
-			In:   "path",
+			Name: "organizationID",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.OrganizationID = v.(OptString)
 		}
-		params.TalkSessionID = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "status",
+			Name: "action",
 			In:   "query",
 		}
 		if v, ok := packed[key]; ok {
-			params.Status = v.(OptGetReportsForTalkSessionStatus)
+			params.Action = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "actorID",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ActorID = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "since",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Since = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "until",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Until = v.(OptDateTime)
 		}
 	}
 	return params
 }
 
-func decodeGetReportsForTalkSessionParams(args [1]string, argsEscaped bool, r *http.Request) (params GetReportsForTalkSessionParams, _ error) {
+func decodeDownloadOrganizationAuditLogsParams(args [0]string, argsEscaped bool, r *http.Request) (params DownloadOrganizationAuditLogsParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: talkSessionID.
+	// Decode query: organizationID.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "organizationID",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "talkSessionID",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOrganizationIDVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOrganizationIDVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.TalkSessionID = c
+				params.OrganizationID.SetTo(paramsDotOrganizationIDVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "talkSessionID",
-			In:   "path",
+			Name: "organizationID",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: status.
+	// Decode query: action.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "status",
+			Name:    "action",
 			Style:   uri.QueryStyleForm,
-			Explode: true,
+			Explode: false,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotStatusVal GetReportsForTalkSessionStatus
+				var paramsDotActionVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
@@ -1874,219 +1628,53 @@ func decodeGetReportsForTalkSessionParams(args [1]string, argsEscaped bool, r *h
 						return err
 					}
 
-					paramsDotStatusVal = GetReportsForTalkSessionStatus(c)
+					paramsDotActionVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Status.SetTo(paramsDotStatusVal)
+				params.Action.SetTo(paramsDotActionVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-			if err := func() error {
-				if value, ok := params.Status.Get(); ok {
-					if err := func() error {
-						if err := value.Validate(); err != nil {
-							return err
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
-				}
-				return nil
-			}(); err != nil {
-				return err
-			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "status",
+			Name: "action",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// GetTalkSessionDetailParams is parameters of getTalkSessionDetail operation.
-type GetTalkSessionDetailParams struct {
-	TalkSessionID string
-}
-
-func unpackGetTalkSessionDetailParams(packed middleware.Parameters) (params GetTalkSessionDetailParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "talkSessionID",
-			In:   "path",
-		}
-		params.TalkSessionID = packed[key].(string)
-	}
-	return params
-}
-
-func decodeGetTalkSessionDetailParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTalkSessionDetailParams, _ error) {
-	// Decode path: talkSessionID.
-	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
-		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "talkSessionID",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
-
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
-
-				params.TalkSessionID = c
-				return nil
-			}(); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "talkSessionID",
-			In:   "path",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// GetTalkSessionListParams is parameters of getTalkSessionList operation.
-type GetTalkSessionListParams struct {
-	// Ir
-	// 1ページあたりの要素数.
-	Limit OptNilInt
-	// どの要素から始めるか.
-	Offset    OptNilInt
-	Theme     OptNilString
-	Status    OptNilGetTalkSessionListStatus
-	SortKey   OptGetTalkSessionListSortKey
-	Latitude  OptNilFloat64
-	Longitude OptNilFloat64
-}
-
-func unpackGetTalkSessionListParams(packed middleware.Parameters) (params GetTalkSessionListParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptNilInt)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "offset",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptNilInt)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "theme",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Theme = v.(OptNilString)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "status",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Status = v.(OptNilGetTalkSessionListStatus)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "sortKey",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.SortKey = v.(OptGetTalkSessionListSortKey)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "latitude",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Latitude = v.(OptNilFloat64)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "longitude",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Longitude = v.(OptNilFloat64)
-		}
-	}
-	return params
-}
-
-func decodeGetTalkSessionListParams(args [0]string, argsEscaped bool, r *http.Request) (params GetTalkSessionListParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: limit.
+	// Decode query: actorID.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
+			Name:    "actorID",
 			Style:   uri.QueryStyleForm,
-			Explode: true,
+			Explode: false,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
+				var paramsDotActorIDVal string
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotLimitVal = c
+					paramsDotActorIDVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
+				params.ActorID.SetTo(paramsDotActorIDVal)
 				return nil
 			}); err != nil {
 				return err
@@ -2095,39 +1683,39 @@ func decodeGetTalkSessionListParams(args [0]string, argsEscaped bool, r *http.Re
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
+			Name: "actorID",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: offset.
+	// Decode query: since.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "offset",
+			Name:    "since",
 			Style:   uri.QueryStyleForm,
-			Explode: true,
+			Explode: false,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOffsetVal int
+				var paramsDotSinceVal time.Time
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToInt(val)
+					c, err := conv.ToDateTime(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotOffsetVal = c
+					paramsDotSinceVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Offset.SetTo(paramsDotOffsetVal)
+				params.Since.SetTo(paramsDotSinceVal)
 				return nil
 			}); err != nil {
 				return err
@@ -2136,39 +1724,39 @@ func decodeGetTalkSessionListParams(args [0]string, argsEscaped bool, r *http.Re
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "offset",
+			Name: "since",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: theme.
+	// Decode query: until.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "theme",
+			Name:    "until",
 			Style:   uri.QueryStyleForm,
-			Explode: true,
+			Explode: false,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotThemeVal string
+				var paramsDotUntilVal time.Time
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToString(val)
+					c, err := conv.ToDateTime(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotThemeVal = c
+					paramsDotUntilVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Theme.SetTo(paramsDotThemeVal)
+				params.Until.SetTo(paramsDotUntilVal)
 				return nil
 			}); err != nil {
 				return err
@@ -2177,165 +1765,5276 @@ func decodeGetTalkSessionListParams(args [0]string, argsEscaped bool, r *http.Re
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "theme",
+			Name: "until",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: status.
+	return params, nil
+}
+
+// EditOpinionParams is parameters of editOpinion operation.
+type EditOpinionParams struct {
+	OpinionID string
+}
+
+func unpackEditOpinionParams(packed middleware.Parameters) (params EditOpinionParams) {
 	{
-		val := GetTalkSessionListStatus("open")
-		params.Status.SetTo(val)
+		key := middleware.ParameterKey{
+			Name: "opinionID",
+			In:   "path",
+		}
+		params.OpinionID = packed[key].(string)
 	}
-	// Decode query: status.
+	return params
+}
+
+func decodeEditOpinionParams(args [1]string, argsEscaped bool, r *http.Request) (params EditOpinionParams, _ error) {
+	// Decode path: opinionID.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "status",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "opinionID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotStatusVal GetTalkSessionListStatus
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotStatusVal = GetTalkSessionListStatus(c)
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.Status.SetTo(paramsDotStatusVal)
+
+				params.OpinionID = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
-			if err := func() error {
-				if value, ok := params.Status.Get(); ok {
-					if err := func() error {
-						if err := value.Validate(); err != nil {
-							return err
-						}
-						return nil
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "opinionID",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// EditTalkSessionParams is parameters of editTalkSession operation.
+type EditTalkSessionParams struct {
+	TalkSessionID string
+}
+
+func unpackEditTalkSessionParams(packed middleware.Parameters) (params EditTalkSessionParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "talkSessionID",
+			In:   "path",
+		}
+		params.TalkSessionID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeEditTalkSessionParams(args [1]string, argsEscaped bool, r *http.Request) (params EditTalkSessionParams, _ error) {
+	// Decode path: talkSessionID.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "talkSessionID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.TalkSessionID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "talkSessionID",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// EditTimeLineParams is parameters of editTimeLine operation.
+type EditTimeLineParams struct {
+	TalkSessionID string
+	ActionItemID  string
+}
+
+func unpackEditTimeLineParams(packed middleware.Parameters) (params EditTimeLineParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "talkSessionID",
+			In:   "path",
+		}
+		params.TalkSessionID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "actionItemID",
+			In:   "path",
+		}
+		params.ActionItemID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeEditTimeLineParams(args [2]string, argsEscaped bool, r *http.Request) (params EditTimeLineParams, _ error) {
+	// Decode path: talkSessionID.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "talkSessionID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.TalkSessionID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "talkSessionID",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: actionItemID.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "actionItemID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.ActionItemID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "actionItemID",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetAnalysisJobParams is parameters of getAnalysisJob operation.
+type GetAnalysisJobParams struct {
+	TalkSessionID string
+	JobID         string
+}
+
+func unpackGetAnalysisJobParams(packed middleware.Parameters) (params GetAnalysisJobParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "talkSessionID",
+			In:   "path",
+		}
+		params.TalkSessionID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "jobID",
+			In:   "path",
+		}
+		params.JobID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetAnalysisJobParams(args [2]string, argsEscaped bool, r *http.Request) (params GetAnalysisJobParams, _ error) {
+	// Decode path: talkSessionID.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "talkSessionID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.TalkSessionID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "talkSessionID",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: jobID.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "jobID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.JobID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "jobID",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetAnalysisReportManageParams is parameters of getAnalysisReportManage operation.
+type GetAnalysisReportManageParams struct {
+	TalkSessionID string
+}
+
+func unpackGetAnalysisReportManageParams(packed middleware.Parameters) (params GetAnalysisReportManageParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "talkSessionID",
+			In:   "path",
+		}
+		params.TalkSessionID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetAnalysisReportManageParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAnalysisReportManageParams, _ error) {
+	// Decode path: talkSessionID.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "talkSessionID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.TalkSessionID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "talkSessionID",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetAnalysisSnapshotParams is parameters of getAnalysisSnapshot operation.
+type GetAnalysisSnapshotParams struct {
+	TalkSessionID string
+	SnapshotID    string
+}
+
+func unpackGetAnalysisSnapshotParams(packed middleware.Parameters) (params GetAnalysisSnapshotParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "talkSessionID",
+			In:   "path",
+		}
+		params.TalkSessionID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "snapshotID",
+			In:   "path",
+		}
+		params.SnapshotID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetAnalysisSnapshotParams(args [2]string, argsEscaped bool, r *http.Request) (params GetAnalysisSnapshotParams, _ error) {
+	// Decode path: talkSessionID.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "talkSessionID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.TalkSessionID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "talkSessionID",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: snapshotID.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "snapshotID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.SnapshotID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "snapshotID",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetAnalysisSnapshotDiffParams is parameters of getAnalysisSnapshotDiff operation.
+type GetAnalysisSnapshotDiffParams struct {
+	TalkSessionID string
+	// 比較元のスナップショットID.
+	From string
+	// 比較先のスナップショットID.
+	To string
+}
+
+func unpackGetAnalysisSnapshotDiffParams(packed middleware.Parameters) (params GetAnalysisSnapshotDiffParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "talkSessionID",
+			In:   "path",
+		}
+		params.TalkSessionID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "from",
+			In:   "query",
+		}
+		params.From = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "to",
+			In:   "query",
+		}
+		params.To = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetAnalysisSnapshotDiffParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAnalysisSnapshotDiffParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: talkSessionID.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "talkSessionID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.TalkSessionID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "talkSessionID",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: from.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "from",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.From = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "from",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: to.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "to",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.To = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "to",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetAnalysisSnapshotsParams is parameters of getAnalysisSnapshots operation.
+type GetAnalysisSnapshotsParams struct {
+	TalkSessionID string
+}
+
+func unpackGetAnalysisSnapshotsParams(packed middleware.Parameters) (params GetAnalysisSnapshotsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "talkSessionID",
+			In:   "path",
+		}
+		params.TalkSessionID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetAnalysisSnapshotsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAnalysisSnapshotsParams, _ error) {
+	// Decode path: talkSessionID.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "talkSessionID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.TalkSessionID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "talkSessionID",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetAnalysisStatusParams is parameters of getAnalysisStatus operation.
+type GetAnalysisStatusParams struct {
+	TalkSessionID string
+}
+
+func unpackGetAnalysisStatusParams(packed middleware.Parameters) (params GetAnalysisStatusParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "talkSessionID",
+			In:   "path",
+		}
+		params.TalkSessionID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetAnalysisStatusParams(args [1]string, argsEscaped bool, r *http.Request) (params GetAnalysisStatusParams, _ error) {
+	// Decode path: talkSessionID.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "talkSessionID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.TalkSessionID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "talkSessionID",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetConclusionParams is parameters of getConclusion operation.
+type GetConclusionParams struct {
+	TalkSessionID string
+}
+
+func unpackGetConclusionParams(packed middleware.Parameters) (params GetConclusionParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "talkSessionID",
+			In:   "path",
+		}
+		params.TalkSessionID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetConclusionParams(args [1]string, argsEscaped bool, r *http.Request) (params GetConclusionParams, _ error) {
+	// Decode path: talkSessionID.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "talkSessionID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.TalkSessionID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "talkSessionID",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetConsensusParams is parameters of getConsensus operation.
+type GetConsensusParams struct {
+	TalkSessionID string
+}
+
+func unpackGetConsensusParams(packed middleware.Parameters) (params GetConsensusParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "talkSessionID",
+			In:   "path",
+		}
+		params.TalkSessionID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetConsensusParams(args [1]string, argsEscaped bool, r *http.Request) (params GetConsensusParams, _ error) {
+	// Decode path: talkSessionID.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "talkSessionID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.TalkSessionID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "talkSessionID",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetMyAnalysisPositionParams is parameters of getMyAnalysisPosition operation.
+type GetMyAnalysisPositionParams struct {
+	TalkSessionID string
+}
+
+func unpackGetMyAnalysisPositionParams(packed middleware.Parameters) (params GetMyAnalysisPositionParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "talkSessionID",
+			In:   "path",
+		}
+		params.TalkSessionID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetMyAnalysisPositionParams(args [1]string, argsEscaped bool, r *http.Request) (params GetMyAnalysisPositionParams, _ error) {
+	// Decode path: talkSessionID.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "talkSessionID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.TalkSessionID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "talkSessionID",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetOpenedTalkSessionParams is parameters of getOpenedTalkSession operation.
+type GetOpenedTalkSessionParams struct {
+	Limit  OptInt
+	Offset OptInt
+	// テーマ.
+	Theme  OptString
+	Status OptNilGetOpenedTalkSessionStatus
+}
+
+func unpackGetOpenedTalkSessionParams(packed middleware.Parameters) (params GetOpenedTalkSessionParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "theme",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Theme = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "status",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Status = v.(OptNilGetOpenedTalkSessionStatus)
+		}
+	}
+	return params
+}
+
+func decodeGetOpenedTalkSessionParams(args [0]string, argsEscaped bool, r *http.Request) (params GetOpenedTalkSessionParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Set default value for query: limit.
+	{
+		val := int(10)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: theme.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "theme",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotThemeVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotThemeVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Theme.SetTo(paramsDotThemeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "theme",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: status.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStatusVal GetOpenedTalkSessionStatus
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStatusVal = GetOpenedTalkSessionStatus(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Status.SetTo(paramsDotStatusVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Status.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "status",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetOpinionAnalysisParams is parameters of getOpinionAnalysis operation.
+type GetOpinionAnalysisParams struct {
+	OpinionID string
+	WeightBy  OptDemographicDimension
+}
+
+func unpackGetOpinionAnalysisParams(packed middleware.Parameters) (params GetOpinionAnalysisParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "opinionID",
+			In:   "path",
+		}
+		params.OpinionID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "weightBy",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.WeightBy = v.(OptDemographicDimension)
+		}
+	}
+	return params
+}
+
+func decodeGetOpinionAnalysisParams(args [1]string, argsEscaped bool, r *http.Request) (params GetOpinionAnalysisParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: opinionID.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "opinionID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.OpinionID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "opinionID",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: weightBy.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "weightBy",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotWeightByVal DemographicDimension
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotWeightByVal = DemographicDimension(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.WeightBy.SetTo(paramsDotWeightByVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.WeightBy.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "weightBy",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetOpinionDetail2Params is parameters of getOpinionDetail2 operation.
+type GetOpinionDetail2Params struct {
+	OpinionID string
+}
+
+func unpackGetOpinionDetail2Params(packed middleware.Parameters) (params GetOpinionDetail2Params) {
+	{
+		key := middleware.ParameterKey{
+			Name: "opinionID",
+			In:   "path",
+		}
+		params.OpinionID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetOpinionDetail2Params(args [1]string, argsEscaped bool, r *http.Request) (params GetOpinionDetail2Params, _ error) {
+	// Decode path: opinionID.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "opinionID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.OpinionID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "opinionID",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetOpinionReportsParams is parameters of getOpinionReports operation.
+type GetOpinionReportsParams struct {
+	OpinionID string
+}
+
+func unpackGetOpinionReportsParams(packed middleware.Parameters) (params GetOpinionReportsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "opinionID",
+			In:   "path",
+		}
+		params.OpinionID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetOpinionReportsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetOpinionReportsParams, _ error) {
+	// Decode path: opinionID.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "opinionID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.OpinionID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "opinionID",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetOpinionRevisionsParams is parameters of getOpinionRevisions operation.
+type GetOpinionRevisionsParams struct {
+	OpinionID string
+}
+
+func unpackGetOpinionRevisionsParams(packed middleware.Parameters) (params GetOpinionRevisionsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "opinionID",
+			In:   "path",
+		}
+		params.OpinionID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetOpinionRevisionsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetOpinionRevisionsParams, _ error) {
+	// Decode path: opinionID.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "opinionID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.OpinionID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "opinionID",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetOpinionsForTalkSessionParams is parameters of getOpinionsForTalkSession operation.
+type GetOpinionsForTalkSessionParams struct {
+	TalkSessionID string
+	Sort          OptNilGetOpinionsForTalkSessionSort
+	Limit         OptNilInt
+	Offset        OptNilInt
+	Seed          OptNilBool
+}
+
+func unpackGetOpinionsForTalkSessionParams(packed middleware.Parameters) (params GetOpinionsForTalkSessionParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "talkSessionID",
+			In:   "path",
+		}
+		params.TalkSessionID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "sort",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Sort = v.(OptNilGetOpinionsForTalkSessionSort)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptNilInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptNilInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "seed",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Seed = v.(OptNilBool)
+		}
+	}
+	return params
+}
+
+func decodeGetOpinionsForTalkSessionParams(args [1]string, argsEscaped bool, r *http.Request) (params GetOpinionsForTalkSessionParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: talkSessionID.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "talkSessionID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.TalkSessionID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "talkSessionID",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: sort.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "sort",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSortVal GetOpinionsForTalkSessionSort
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSortVal = GetOpinionsForTalkSessionSort(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Sort.SetTo(paramsDotSortVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Sort.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "sort",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: seed.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "seed",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSeedVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSeedVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Seed.SetTo(paramsDotSeedVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "seed",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetOrganizationAuditLogsParams is parameters of getOrganizationAuditLogs operation.
+type GetOrganizationAuditLogsParams struct {
+	OrganizationID OptString
+	Action         OptString
+	ActorID        OptString
+	Since          OptDateTime
+	Until          OptDateTime
+	Offset         OptInt32
+	Limit          OptInt32
+}
+
+func unpackGetOrganizationAuditLogsParams(packed middleware.Parameters) (params GetOrganizationAuditLogsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "organizationID",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.OrganizationID = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "action",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Action = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "actorID",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.ActorID = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "since",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Since = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "until",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Until = v.(OptDateTime)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt32)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt32)
+		}
+	}
+	return params
+}
+
+func decodeGetOrganizationAuditLogsParams(args [0]string, argsEscaped bool, r *http.Request) (params GetOrganizationAuditLogsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: organizationID.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "organizationID",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOrganizationIDVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOrganizationIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.OrganizationID.SetTo(paramsDotOrganizationIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "organizationID",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: action.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "action",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotActionVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotActionVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Action.SetTo(paramsDotActionVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "action",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: actorID.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "actorID",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotActorIDVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotActorIDVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.ActorID.SetTo(paramsDotActorIDVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "actorID",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: since.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "since",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSinceVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSinceVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Since.SetTo(paramsDotSinceVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "since",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: until.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "until",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUntilVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDateTime(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotUntilVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Until.SetTo(paramsDotUntilVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "until",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetOrganizationChildrenParams is parameters of getOrganizationChildren operation.
+type GetOrganizationChildrenParams struct {
+	Code string
+}
+
+func unpackGetOrganizationChildrenParams(packed middleware.Parameters) (params GetOrganizationChildrenParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "code",
+			In:   "path",
+		}
+		params.Code = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetOrganizationChildrenParams(args [1]string, argsEscaped bool, r *http.Request) (params GetOrganizationChildrenParams, _ error) {
+	// Decode path: code.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "code",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Code = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "code",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetOrganizationReferenceDemographicsParams is parameters of getOrganizationReferenceDemographics operation.
+type GetOrganizationReferenceDemographicsParams struct {
+	Code string
+}
+
+func unpackGetOrganizationReferenceDemographicsParams(packed middleware.Parameters) (params GetOrganizationReferenceDemographicsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "code",
+			In:   "path",
+		}
+		params.Code = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetOrganizationReferenceDemographicsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetOrganizationReferenceDemographicsParams, _ error) {
+	// Decode path: code.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "code",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Code = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "code",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetOrganizationReportPromptParams is parameters of getOrganizationReportPrompt operation.
+type GetOrganizationReportPromptParams struct {
+	Code string
+}
+
+func unpackGetOrganizationReportPromptParams(packed middleware.Parameters) (params GetOrganizationReportPromptParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "code",
+			In:   "path",
+		}
+		params.Code = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetOrganizationReportPromptParams(args [1]string, argsEscaped bool, r *http.Request) (params GetOrganizationReportPromptParams, _ error) {
+	// Decode path: code.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "code",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Code = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "code",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetOrganizationStatsParams is parameters of getOrganizationStats operation.
+type GetOrganizationStatsParams struct {
+	Code               string
+	Bucket             OptGetOrganizationStatsBucket
+	Since              OptDate
+	Until              OptDate
+	IncludeDescendants OptBool
+}
+
+func unpackGetOrganizationStatsParams(packed middleware.Parameters) (params GetOrganizationStatsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "code",
+			In:   "path",
+		}
+		params.Code = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "bucket",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Bucket = v.(OptGetOrganizationStatsBucket)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "since",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Since = v.(OptDate)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "until",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Until = v.(OptDate)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "includeDescendants",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.IncludeDescendants = v.(OptBool)
+		}
+	}
+	return params
+}
+
+func decodeGetOrganizationStatsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetOrganizationStatsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: code.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "code",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Code = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "code",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for query: bucket.
+	{
+		val := GetOrganizationStatsBucket("day")
+		params.Bucket.SetTo(val)
+	}
+	// Decode query: bucket.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "bucket",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotBucketVal GetOrganizationStatsBucket
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotBucketVal = GetOrganizationStatsBucket(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Bucket.SetTo(paramsDotBucketVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Bucket.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "bucket",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: since.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "since",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSinceVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDate(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSinceVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Since.SetTo(paramsDotSinceVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "since",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: until.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "until",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotUntilVal time.Time
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToDate(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotUntilVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Until.SetTo(paramsDotUntilVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "until",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: includeDescendants.
+	{
+		val := bool(true)
+		params.IncludeDescendants.SetTo(val)
+	}
+	// Decode query: includeDescendants.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "includeDescendants",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIncludeDescendantsVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIncludeDescendantsVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.IncludeDescendants.SetTo(paramsDotIncludeDescendantsVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "includeDescendants",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetOrganizationTalkSessionsParams is parameters of getOrganizationTalkSessions operation.
+type GetOrganizationTalkSessionsParams struct {
+	Code               string
+	IncludeDescendants OptBool
+	Status             OptGetOrganizationTalkSessionsStatus
+	Theme              OptString
+	Offset             OptInt32
+	Limit              OptInt32
+}
+
+func unpackGetOrganizationTalkSessionsParams(packed middleware.Parameters) (params GetOrganizationTalkSessionsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "code",
+			In:   "path",
+		}
+		params.Code = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "includeDescendants",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.IncludeDescendants = v.(OptBool)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "status",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Status = v.(OptGetOrganizationTalkSessionsStatus)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "theme",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Theme = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt32)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt32)
+		}
+	}
+	return params
+}
+
+func decodeGetOrganizationTalkSessionsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetOrganizationTalkSessionsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: code.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "code",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Code = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "code",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Set default value for query: includeDescendants.
+	{
+		val := bool(true)
+		params.IncludeDescendants.SetTo(val)
+	}
+	// Decode query: includeDescendants.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "includeDescendants",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotIncludeDescendantsVal bool
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToBool(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotIncludeDescendantsVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.IncludeDescendants.SetTo(paramsDotIncludeDescendantsVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "includeDescendants",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: status.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStatusVal GetOrganizationTalkSessionsStatus
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStatusVal = GetOrganizationTalkSessionsStatus(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Status.SetTo(paramsDotStatusVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Status.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "status",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: theme.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "theme",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotThemeVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotThemeVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Theme.SetTo(paramsDotThemeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "theme",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetReportVersionDiffManageParams is parameters of getReportVersionDiffManage operation.
+type GetReportVersionDiffManageParams struct {
+	TalkSessionID string
+	From          string
+	To            string
+}
+
+func unpackGetReportVersionDiffManageParams(packed middleware.Parameters) (params GetReportVersionDiffManageParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "talkSessionID",
+			In:   "path",
+		}
+		params.TalkSessionID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "from",
+			In:   "query",
+		}
+		params.From = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "to",
+			In:   "query",
+		}
+		params.To = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetReportVersionDiffManageParams(args [1]string, argsEscaped bool, r *http.Request) (params GetReportVersionDiffManageParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: talkSessionID.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "talkSessionID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.TalkSessionID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "talkSessionID",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: from.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "from",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.From = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "from",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: to.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "to",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.To = c
+				return nil
+			}); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "to",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetReportVersionsManageParams is parameters of getReportVersionsManage operation.
+type GetReportVersionsManageParams struct {
+	TalkSessionID string
+}
+
+func unpackGetReportVersionsManageParams(packed middleware.Parameters) (params GetReportVersionsManageParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "talkSessionID",
+			In:   "path",
+		}
+		params.TalkSessionID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetReportVersionsManageParams(args [1]string, argsEscaped bool, r *http.Request) (params GetReportVersionsManageParams, _ error) {
+	// Decode path: talkSessionID.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "talkSessionID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.TalkSessionID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "talkSessionID",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetReportsForTalkSessionParams is parameters of getReportsForTalkSession operation.
+type GetReportsForTalkSessionParams struct {
+	TalkSessionID string
+	Status        OptGetReportsForTalkSessionStatus
+}
+
+func unpackGetReportsForTalkSessionParams(packed middleware.Parameters) (params GetReportsForTalkSessionParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "talkSessionID",
+			In:   "path",
+		}
+		params.TalkSessionID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "status",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Status = v.(OptGetReportsForTalkSessionStatus)
+		}
+	}
+	return params
+}
+
+func decodeGetReportsForTalkSessionParams(args [1]string, argsEscaped bool, r *http.Request) (params GetReportsForTalkSessionParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: talkSessionID.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "talkSessionID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.TalkSessionID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "talkSessionID",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: status.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStatusVal GetReportsForTalkSessionStatus
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStatusVal = GetReportsForTalkSessionStatus(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Status.SetTo(paramsDotStatusVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Status.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "status",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTalkSessionCollaboratorsParams is parameters of getTalkSessionCollaborators operation.
+type GetTalkSessionCollaboratorsParams struct {
+	TalkSessionID string
+}
+
+func unpackGetTalkSessionCollaboratorsParams(packed middleware.Parameters) (params GetTalkSessionCollaboratorsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "talkSessionID",
+			In:   "path",
+		}
+		params.TalkSessionID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetTalkSessionCollaboratorsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTalkSessionCollaboratorsParams, _ error) {
+	// Decode path: talkSessionID.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "talkSessionID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.TalkSessionID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "talkSessionID",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTalkSessionDetailParams is parameters of getTalkSessionDetail operation.
+type GetTalkSessionDetailParams struct {
+	TalkSessionID string
+}
+
+func unpackGetTalkSessionDetailParams(packed middleware.Parameters) (params GetTalkSessionDetailParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "talkSessionID",
+			In:   "path",
+		}
+		params.TalkSessionID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetTalkSessionDetailParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTalkSessionDetailParams, _ error) {
+	// Decode path: talkSessionID.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "talkSessionID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.TalkSessionID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "talkSessionID",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTalkSessionListParams is parameters of getTalkSessionList operation.
+type GetTalkSessionListParams struct {
+	// Ir
+	// 1ページあたりの要素数.
+	Limit OptNilInt
+	// どの要素から始めるか.
+	Offset    OptNilInt
+	Theme     OptNilString
+	Status    OptNilGetTalkSessionListStatus
+	SortKey   OptGetTalkSessionListSortKey
+	Latitude  OptNilFloat64
+	Longitude OptNilFloat64
+}
+
+func unpackGetTalkSessionListParams(packed middleware.Parameters) (params GetTalkSessionListParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptNilInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptNilInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "theme",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Theme = v.(OptNilString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "status",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Status = v.(OptNilGetTalkSessionListStatus)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "sortKey",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.SortKey = v.(OptGetTalkSessionListSortKey)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "latitude",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Latitude = v.(OptNilFloat64)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "longitude",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Longitude = v.(OptNilFloat64)
+		}
+	}
+	return params
+}
+
+func decodeGetTalkSessionListParams(args [0]string, argsEscaped bool, r *http.Request) (params GetTalkSessionListParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: theme.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "theme",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotThemeVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotThemeVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Theme.SetTo(paramsDotThemeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "theme",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Set default value for query: status.
+	{
+		val := GetTalkSessionListStatus("open")
+		params.Status.SetTo(val)
+	}
+	// Decode query: status.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStatusVal GetTalkSessionListStatus
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStatusVal = GetTalkSessionListStatus(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Status.SetTo(paramsDotStatusVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Status.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "status",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: sortKey.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "sortKey",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSortKeyVal GetTalkSessionListSortKey
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotSortKeyVal = GetTalkSessionListSortKey(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.SortKey.SetTo(paramsDotSortKeyVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.SortKey.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "sortKey",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: latitude.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "latitude",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLatitudeVal float64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToFloat64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLatitudeVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Latitude.SetTo(paramsDotLatitudeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Latitude.Get(); ok {
+					if err := func() error {
+						if err := (validate.Float{}).Validate(float64(value)); err != nil {
+							return errors.Wrap(err, "float")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "latitude",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: longitude.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "longitude",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLongitudeVal float64
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToFloat64(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLongitudeVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Longitude.SetTo(paramsDotLongitudeVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Longitude.Get(); ok {
+					if err := func() error {
+						if err := (validate.Float{}).Validate(float64(value)); err != nil {
+							return errors.Wrap(err, "float")
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "longitude",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTalkSessionListManageParams is parameters of getTalkSessionListManage operation.
+type GetTalkSessionListManageParams struct {
+	Status OptGetTalkSessionListManageStatus
+	Offset OptInt32
+	Limit  OptInt32
+}
+
+func unpackGetTalkSessionListManageParams(packed middleware.Parameters) (params GetTalkSessionListManageParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "status",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Status = v.(OptGetTalkSessionListManageStatus)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt32)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt32)
+		}
+	}
+	return params
+}
+
+func decodeGetTalkSessionListManageParams(args [0]string, argsEscaped bool, r *http.Request) (params GetTalkSessionListManageParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: status.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStatusVal GetTalkSessionListManageStatus
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStatusVal = GetTalkSessionListManageStatus(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Status.SetTo(paramsDotStatusVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Status.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
 					}(); err != nil {
 						return err
 					}
 				}
 				return nil
-			}(); err != nil {
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "status",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTalkSessionManageParams is parameters of getTalkSessionManage operation.
+type GetTalkSessionManageParams struct {
+	TalkSessionID string
+}
+
+func unpackGetTalkSessionManageParams(packed middleware.Parameters) (params GetTalkSessionManageParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "talkSessionID",
+			In:   "path",
+		}
+		params.TalkSessionID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetTalkSessionManageParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTalkSessionManageParams, _ error) {
+	// Decode path: talkSessionID.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "talkSessionID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.TalkSessionID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "talkSessionID",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTalkSessionReportParams is parameters of getTalkSessionReport operation.
+type GetTalkSessionReportParams struct {
+	TalkSessionID string
+}
+
+func unpackGetTalkSessionReportParams(packed middleware.Parameters) (params GetTalkSessionReportParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "talkSessionID",
+			In:   "path",
+		}
+		params.TalkSessionID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetTalkSessionReportParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTalkSessionReportParams, _ error) {
+	// Decode path: talkSessionID.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "talkSessionID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.TalkSessionID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "talkSessionID",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTalkSessionReportCountParams is parameters of getTalkSessionReportCount operation.
+type GetTalkSessionReportCountParams struct {
+	TalkSessionID string
+	Status        GetTalkSessionReportCountStatus
+}
+
+func unpackGetTalkSessionReportCountParams(packed middleware.Parameters) (params GetTalkSessionReportCountParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "talkSessionID",
+			In:   "path",
+		}
+		params.TalkSessionID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "status",
+			In:   "query",
+		}
+		params.Status = packed[key].(GetTalkSessionReportCountStatus)
+	}
+	return params
+}
+
+func decodeGetTalkSessionReportCountParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTalkSessionReportCountParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: talkSessionID.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "talkSessionID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.TalkSessionID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "talkSessionID",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: status.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Status = GetTalkSessionReportCountStatus(c)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if err := params.Status.Validate(); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return err
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "status",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTalkSessionRepresentativenessParams is parameters of getTalkSessionRepresentativeness operation.
+type GetTalkSessionRepresentativenessParams struct {
+	Code          string
+	TalkSessionID string
+}
+
+func unpackGetTalkSessionRepresentativenessParams(packed middleware.Parameters) (params GetTalkSessionRepresentativenessParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "code",
+			In:   "path",
+		}
+		params.Code = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "talkSessionID",
+			In:   "path",
+		}
+		params.TalkSessionID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetTalkSessionRepresentativenessParams(args [2]string, argsEscaped bool, r *http.Request) (params GetTalkSessionRepresentativenessParams, _ error) {
+	// Decode path: code.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "code",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Code = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "code",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode path: talkSessionID.
+	if err := func() error {
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "talkSessionID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.TalkSessionID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "talkSessionID",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTalkSessionRestrictionSatisfiedParams is parameters of getTalkSessionRestrictionSatisfied operation.
+type GetTalkSessionRestrictionSatisfiedParams struct {
+	TalkSessionID string
+}
+
+func unpackGetTalkSessionRestrictionSatisfiedParams(packed middleware.Parameters) (params GetTalkSessionRestrictionSatisfiedParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "talkSessionID",
+			In:   "path",
+		}
+		params.TalkSessionID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetTalkSessionRestrictionSatisfiedParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTalkSessionRestrictionSatisfiedParams, _ error) {
+	// Decode path: talkSessionID.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "talkSessionID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.TalkSessionID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "talkSessionID",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTalkSessionTemplatesParams is parameters of getTalkSessionTemplates operation.
+type GetTalkSessionTemplatesParams struct {
+	Code string
+}
+
+func unpackGetTalkSessionTemplatesParams(packed middleware.Parameters) (params GetTalkSessionTemplatesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "code",
+			In:   "path",
+		}
+		params.Code = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetTalkSessionTemplatesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTalkSessionTemplatesParams, _ error) {
+	// Decode path: code.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "code",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Code = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "code",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTermFrequenciesParams is parameters of getTermFrequencies operation.
+type GetTermFrequenciesParams struct {
+	TalkSessionID string
+}
+
+func unpackGetTermFrequenciesParams(packed middleware.Parameters) (params GetTermFrequenciesParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "talkSessionID",
+			In:   "path",
+		}
+		params.TalkSessionID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetTermFrequenciesParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTermFrequenciesParams, _ error) {
+	// Decode path: talkSessionID.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "talkSessionID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.TalkSessionID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "talkSessionID",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTimeLineParams is parameters of getTimeLine operation.
+type GetTimeLineParams struct {
+	TalkSessionID string
+}
+
+func unpackGetTimeLineParams(packed middleware.Parameters) (params GetTimeLineParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "talkSessionID",
+			In:   "path",
+		}
+		params.TalkSessionID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetTimeLineParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTimeLineParams, _ error) {
+	// Decode path: talkSessionID.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "talkSessionID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.TalkSessionID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "talkSessionID",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetTopicsParams is parameters of getTopics operation.
+type GetTopicsParams struct {
+	TalkSessionID string
+	// トピックごとに返す意見の数。1から100、省略時は10.
+	OpinionLimit OptNilInt
+	// トピックごとの意見の開始位置.
+	OpinionOffset OptNilInt
+}
+
+func unpackGetTopicsParams(packed middleware.Parameters) (params GetTopicsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "talkSessionID",
+			In:   "path",
+		}
+		params.TalkSessionID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "opinionLimit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.OpinionLimit = v.(OptNilInt)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "opinionOffset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.OpinionOffset = v.(OptNilInt)
+		}
+	}
+	return params
+}
+
+func decodeGetTopicsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTopicsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: talkSessionID.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "talkSessionID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.TalkSessionID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "talkSessionID",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	// Decode query: opinionLimit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "opinionLimit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOpinionLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOpinionLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.OpinionLimit.SetTo(paramsDotOpinionLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "opinionLimit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: opinionOffset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "opinionOffset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOpinionOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOpinionOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.OpinionOffset.SetTo(paramsDotOpinionOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "opinionOffset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetUserByDisplayIDParams is parameters of getUserByDisplayID operation.
+type GetUserByDisplayIDParams struct {
+	DisplayID string
+}
+
+func unpackGetUserByDisplayIDParams(packed middleware.Parameters) (params GetUserByDisplayIDParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "displayID",
+			In:   "path",
+		}
+		params.DisplayID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeGetUserByDisplayIDParams(args [1]string, argsEscaped bool, r *http.Request) (params GetUserByDisplayIDParams, _ error) {
+	// Decode path: displayID.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "displayID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.DisplayID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "displayID",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// GetUserListManageParams is parameters of getUserListManage operation.
+type GetUserListManageParams struct {
+	Offset  OptInt32
+	Limit   OptInt32
+	Search  OptString
+	OrderBy OptGetUserListManageOrderBy
+	Order   OptGetUserListManageOrder
+}
+
+func unpackGetUserListManageParams(packed middleware.Parameters) (params GetUserListManageParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt32)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "limit",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt32)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "search",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Search = v.(OptString)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "orderBy",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.OrderBy = v.(OptGetUserListManageOrderBy)
+		}
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "order",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Order = v.(OptGetUserListManageOrder)
+		}
+	}
+	return params
+}
+
+func decodeGetUserListManageParams(args [0]string, argsEscaped bool, r *http.Request) (params GetUserListManageParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode query: offset.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int32
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt32(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
 				return err
 			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "status",
+			Name: "offset",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: sortKey.
+	// Decode query: limit.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "sortKey",
+			Name:    "limit",
 			Style:   uri.QueryStyleForm,
-			Explode: true,
+			Explode: false,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotSortKeyVal GetTalkSessionListSortKey
+				var paramsDotLimitVal int32
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToString(val)
+					c, err := conv.ToInt32(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotSortKeyVal = GetTalkSessionListSortKey(c)
+					paramsDotLimitVal = c
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.SortKey.SetTo(paramsDotSortKeyVal)
+				params.Limit.SetTo(paramsDotLimitVal)
 				return nil
 			}); err != nil {
 				return err
 			}
-			if err := func() error {
-				if value, ok := params.SortKey.Get(); ok {
-					if err := func() error {
-						if err := value.Validate(); err != nil {
-							return err
-						}
-						return nil
-					}(); err != nil {
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: search.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "search",
+			Style:   uri.QueryStyleForm,
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSearchVal string
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
 						return err
 					}
+
+					paramsDotSearchVal = c
+					return nil
+				}(); err != nil {
+					return err
 				}
+				params.Search.SetTo(paramsDotSearchVal)
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "sortKey",
+			Name: "search",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: latitude.
+	// Decode query: orderBy.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "latitude",
+			Name:    "orderBy",
 			Style:   uri.QueryStyleForm,
-			Explode: true,
+			Explode: false,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLatitudeVal float64
+				var paramsDotOrderByVal GetUserListManageOrderBy
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToFloat64(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotLatitudeVal = c
+					paramsDotOrderByVal = GetUserListManageOrderBy(c)
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Latitude.SetTo(paramsDotLatitudeVal)
+				params.OrderBy.SetTo(paramsDotOrderByVal)
 				return nil
 			}); err != nil {
 				return err
 			}
 			if err := func() error {
-				if value, ok := params.Latitude.Get(); ok {
+				if value, ok := params.OrderBy.Get(); ok {
 					if err := func() error {
-						if err := (validate.Float{}).Validate(float64(value)); err != nil {
-							return errors.Wrap(err, "float")
+						if err := value.Validate(); err != nil {
+							return err
 						}
 						return nil
 					}(); err != nil {
@@ -2350,48 +7049,48 @@ func decodeGetTalkSessionListParams(args [0]string, argsEscaped bool, r *http.Re
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "latitude",
+			Name: "orderBy",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: longitude.
+	// Decode query: order.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "longitude",
+			Name:    "order",
 			Style:   uri.QueryStyleForm,
-			Explode: true,
+			Explode: false,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLongitudeVal float64
+				var paramsDotOrderVal GetUserListManageOrder
 				if err := func() error {
 					val, err := d.DecodeValue()
 					if err != nil {
 						return err
 					}
 
-					c, err := conv.ToFloat64(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					paramsDotLongitudeVal = c
+					paramsDotOrderVal = GetUserListManageOrder(c)
 					return nil
 				}(); err != nil {
 					return err
 				}
-				params.Longitude.SetTo(paramsDotLongitudeVal)
+				params.Order.SetTo(paramsDotOrderVal)
 				return nil
 			}); err != nil {
 				return err
 			}
 			if err := func() error {
-				if value, ok := params.Longitude.Get(); ok {
+				if value, ok := params.Order.Get(); ok {
 					if err := func() error {
-						if err := (validate.Float{}).Validate(float64(value)); err != nil {
-							return errors.Wrap(err, "float")
+						if err := value.Validate(); err != nil {
+							return err
 						}
 						return nil
 					}(); err != nil {
@@ -2406,7 +7105,7 @@ func decodeGetTalkSessionListParams(args [0]string, argsEscaped bool, r *http.Re
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "longitude",
+			Name: "order",
 			In:   "query",
 			Err:  err,
 		}
@@ -2414,22 +7113,20 @@ func decodeGetTalkSessionListParams(args [0]string, argsEscaped bool, r *http.Re
 	return params, nil
 }
 
-// GetTalkSessionListManageParams is parameters of getTalkSessionListManage operation.
-type GetTalkSessionListManageParams struct {
-	Status OptGetTalkSessionListManageStatus
+// GetUserStatsListManageParams is parameters of getUserStatsListManage operation.
+type GetUserStatsListManageParams struct {
+	Range  string
 	Offset OptInt32
 	Limit  OptInt32
 }
 
-func unpackGetTalkSessionListManageParams(packed middleware.Parameters) (params GetTalkSessionListManageParams) {
+func unpackGetUserStatsListManageParams(packed middleware.Parameters) (params GetUserStatsListManageParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "status",
+			Name: "range",
 			In:   "query",
 		}
-		if v, ok := packed[key]; ok {
-			params.Status = v.(OptGetTalkSessionListManageStatus)
-		}
+		params.Range = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
@@ -2452,60 +7149,40 @@ func unpackGetTalkSessionListManageParams(packed middleware.Parameters) (params
 	return params
 }
 
-func decodeGetTalkSessionListManageParams(args [0]string, argsEscaped bool, r *http.Request) (params GetTalkSessionListManageParams, _ error) {
+func decodeGetUserStatsListManageParams(args [0]string, argsEscaped bool, r *http.Request) (params GetUserStatsListManageParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: status.
+	// Decode query: range.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "status",
+			Name:    "range",
 			Style:   uri.QueryStyleForm,
-			Explode: false,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotStatusVal GetTalkSessionListManageStatus
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotStatusVal = GetTalkSessionListManageStatus(c)
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Status.SetTo(paramsDotStatusVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Status.Get(); ok {
-					if err := func() error {
-						if err := value.Validate(); err != nil {
-							return err
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+			Explode: false,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
 				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Range = c
 				return nil
-			}(); err != nil {
+			}); err != nil {
 				return err
 			}
+		} else {
+			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "status",
+			Name: "range",
 			In:   "query",
 			Err:  err,
 		}
@@ -2595,272 +7272,55 @@ func decodeGetTalkSessionListManageParams(args [0]string, argsEscaped bool, r *h
 	return params, nil
 }
 
-// GetTalkSessionManageParams is parameters of getTalkSessionManage operation.
-type GetTalkSessionManageParams struct {
-	TalkSessionID string
-}
-
-func unpackGetTalkSessionManageParams(packed middleware.Parameters) (params GetTalkSessionManageParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "talkSessionID",
-			In:   "path",
-		}
-		params.TalkSessionID = packed[key].(string)
-	}
-	return params
-}
-
-func decodeGetTalkSessionManageParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTalkSessionManageParams, _ error) {
-	// Decode path: talkSessionID.
-	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
-		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "talkSessionID",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
-
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
-
-				params.TalkSessionID = c
-				return nil
-			}(); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "talkSessionID",
-			In:   "path",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// GetTalkSessionReportParams is parameters of getTalkSessionReport operation.
-type GetTalkSessionReportParams struct {
-	TalkSessionID string
-}
-
-func unpackGetTalkSessionReportParams(packed middleware.Parameters) (params GetTalkSessionReportParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "talkSessionID",
-			In:   "path",
-		}
-		params.TalkSessionID = packed[key].(string)
-	}
-	return params
-}
-
-func decodeGetTalkSessionReportParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTalkSessionReportParams, _ error) {
-	// Decode path: talkSessionID.
-	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
-		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "talkSessionID",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
-
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
-
-				params.TalkSessionID = c
-				return nil
-			}(); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "talkSessionID",
-			In:   "path",
-			Err:  err,
-		}
-	}
-	return params, nil
-}
-
-// GetTalkSessionReportCountParams is parameters of getTalkSessionReportCount operation.
-type GetTalkSessionReportCountParams struct {
-	TalkSessionID string
-	Status        GetTalkSessionReportCountStatus
+// GetUserTalkSessionsParams is parameters of getUserTalkSessions operation.
+type GetUserTalkSessionsParams struct {
+	DisplayID string
+	Limit     OptInt
+	Offset    OptInt
+	Status    OptNilGetUserTalkSessionsStatus
 }
 
-func unpackGetTalkSessionReportCountParams(packed middleware.Parameters) (params GetTalkSessionReportCountParams) {
+func unpackGetUserTalkSessionsParams(packed middleware.Parameters) (params GetUserTalkSessionsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "talkSessionID",
+			Name: "displayID",
 			In:   "path",
 		}
-		params.TalkSessionID = packed[key].(string)
+		params.DisplayID = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "status",
+			Name: "limit",
 			In:   "query",
 		}
-		params.Status = packed[key].(GetTalkSessionReportCountStatus)
-	}
-	return params
-}
-
-func decodeGetTalkSessionReportCountParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTalkSessionReportCountParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: talkSessionID.
-	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
-		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "talkSessionID",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
-
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
-
-				params.TalkSessionID = c
-				return nil
-			}(); err != nil {
-				return err
-			}
-		} else {
-			return validate.ErrFieldRequired
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "talkSessionID",
-			In:   "path",
-			Err:  err,
-		}
-	}
-	// Decode query: status.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "status",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
-
-				c, err := conv.ToString(val)
-				if err != nil {
-					return err
-				}
-
-				params.Status = GetTalkSessionReportCountStatus(c)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if err := params.Status.Validate(); err != nil {
-					return err
-				}
-				return nil
-			}(); err != nil {
-				return err
-			}
-		} else {
-			return err
+		if v, ok := packed[key]; ok {
+			params.Limit = v.(OptInt)
 		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "status",
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "offset",
 			In:   "query",
-			Err:  err,
+		}
+		if v, ok := packed[key]; ok {
+			params.Offset = v.(OptInt)
 		}
 	}
-	return params, nil
-}
-
-// GetTalkSessionRestrictionSatisfiedParams is parameters of getTalkSessionRestrictionSatisfied operation.
-type GetTalkSessionRestrictionSatisfiedParams struct {
-	TalkSessionID string
-}
-
-func unpackGetTalkSessionRestrictionSatisfiedParams(packed middleware.Parameters) (params GetTalkSessionRestrictionSatisfiedParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "talkSessionID",
-			In:   "path",
+			Name: "status",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Status = v.(OptNilGetUserTalkSessionsStatus)
 		}
-		params.TalkSessionID = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGetTalkSessionRestrictionSatisfiedParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTalkSessionRestrictionSatisfiedParams, _ error) {
-	// Decode path: talkSessionID.
+func decodeGetUserTalkSessionsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetUserTalkSessionsParams, _ error) {
+	q := uri.NewQueryDecoder(r.URL.Query())
+	// Decode path: displayID.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -2872,7 +7332,7 @@ func decodeGetTalkSessionRestrictionSatisfiedParams(args [1]string, argsEscaped
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "talkSessionID",
+				Param:   "displayID",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -2889,7 +7349,7 @@ func decodeGetTalkSessionRestrictionSatisfiedParams(args [1]string, argsEscaped
 					return err
 				}
 
-				params.TalkSessionID = c
+				params.DisplayID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -2900,97 +7360,175 @@ func decodeGetTalkSessionRestrictionSatisfiedParams(args [1]string, argsEscaped
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "talkSessionID",
+			Name: "displayID",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	return params, nil
-}
+	// Set default value for query: limit.
+	{
+		val := int(10)
+		params.Limit.SetTo(val)
+	}
+	// Decode query: limit.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "limit",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-// GetTimeLineParams is parameters of getTimeLine operation.
-type GetTimeLineParams struct {
-	TalkSessionID string
-}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotLimitVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-func unpackGetTimeLineParams(packed middleware.Parameters) (params GetTimeLineParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "talkSessionID",
-			In:   "path",
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotLimitVal = c
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Limit.SetTo(paramsDotLimitVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "limit",
+			In:   "query",
+			Err:  err,
 		}
-		params.TalkSessionID = packed[key].(string)
 	}
-	return params
-}
-
-func decodeGetTimeLineParams(args [1]string, argsEscaped bool, r *http.Request) (params GetTimeLineParams, _ error) {
-	// Decode path: talkSessionID.
+	// Decode query: offset.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "offset",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "talkSessionID",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotOffsetVal int
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToInt(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotOffsetVal = c
+					return nil
+				}(); err != nil {
 					return err
 				}
+				params.Offset.SetTo(paramsDotOffsetVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "offset",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: status.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStatusVal GetUserTalkSessionsStatus
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStatusVal = GetUserTalkSessionsStatus(c)
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.TalkSessionID = c
+				params.Status.SetTo(paramsDotStatusVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Status.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
 				return nil
 			}(); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "talkSessionID",
-			In:   "path",
+			Name: "status",
+			In:   "query",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetUserByDisplayIDParams is parameters of getUserByDisplayID operation.
-type GetUserByDisplayIDParams struct {
-	DisplayID string
+// GetVoteShiftsParams is parameters of getVoteShifts operation.
+type GetVoteShiftsParams struct {
+	TalkSessionID string
 }
 
-func unpackGetUserByDisplayIDParams(packed middleware.Parameters) (params GetUserByDisplayIDParams) {
+func unpackGetVoteShiftsParams(packed middleware.Parameters) (params GetVoteShiftsParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "displayID",
+			Name: "talkSessionID",
 			In:   "path",
 		}
-		params.DisplayID = packed[key].(string)
+		params.TalkSessionID = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGetUserByDisplayIDParams(args [1]string, argsEscaped bool, r *http.Request) (params GetUserByDisplayIDParams, _ error) {
-	// Decode path: displayID.
+func decodeGetVoteShiftsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetVoteShiftsParams, _ error) {
+	// Decode path: talkSessionID.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -3002,7 +7540,7 @@ func decodeGetUserByDisplayIDParams(args [1]string, argsEscaped bool, r *http.Re
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "displayID",
+				Param:   "talkSessionID",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -3019,7 +7557,7 @@ func decodeGetUserByDisplayIDParams(args [1]string, argsEscaped bool, r *http.Re
 					return err
 				}
 
-				params.DisplayID = c
+				params.TalkSessionID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -3030,7 +7568,7 @@ func decodeGetUserByDisplayIDParams(args [1]string, argsEscaped bool, r *http.Re
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "displayID",
+			Name: "talkSessionID",
 			In:   "path",
 			Err:  err,
 		}
@@ -3038,352 +7576,262 @@ func decodeGetUserByDisplayIDParams(args [1]string, argsEscaped bool, r *http.Re
 	return params, nil
 }
 
-// GetUserListManageParams is parameters of getUserListManage operation.
-type GetUserListManageParams struct {
-	Offset  OptInt32
-	Limit   OptInt32
-	Search  OptString
-	OrderBy OptGetUserListManageOrderBy
-	Order   OptGetUserListManageOrder
-}
-
-func unpackGetUserListManageParams(packed middleware.Parameters) (params GetUserListManageParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "offset",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptInt32)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt32)
-		}
-	}
+// HandleAuthCallbackParams is parameters of handleAuthCallback operation.
+type HandleAuthCallbackParams struct {
+	Provider string
+	Code     string
+	// OAuth State from Query.
+	State string
+}
+
+func unpackHandleAuthCallbackParams(packed middleware.Parameters) (params HandleAuthCallbackParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "search",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Search = v.(OptString)
+			Name: "provider",
+			In:   "path",
 		}
+		params.Provider = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "orderBy",
+			Name: "code",
 			In:   "query",
 		}
-		if v, ok := packed[key]; ok {
-			params.OrderBy = v.(OptGetUserListManageOrderBy)
-		}
+		params.Code = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
-			Name: "order",
+			Name: "state",
 			In:   "query",
 		}
-		if v, ok := packed[key]; ok {
-			params.Order = v.(OptGetUserListManageOrder)
-		}
+		params.State = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGetUserListManageParams(args [0]string, argsEscaped bool, r *http.Request) (params GetUserListManageParams, _ error) {
+func decodeHandleAuthCallbackParams(args [1]string, argsEscaped bool, r *http.Request) (params HandleAuthCallbackParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: offset.
+	// Decode path: provider.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "offset",
-			Style:   uri.QueryStyleForm,
-			Explode: false,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "provider",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOffsetVal int32
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt32(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotOffsetVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.Offset.SetTo(paramsDotOffsetVal)
+
+				params.Provider = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "offset",
-			In:   "query",
+			Name: "provider",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: limit.
+	// Decode query: code.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
+			Name:    "code",
 			Style:   uri.QueryStyleForm,
-			Explode: false,
+			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int32
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt32(val)
-					if err != nil {
-						return err
-					}
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotLimitVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
+
+				params.Code = c
 				return nil
 			}); err != nil {
 				return err
 			}
+		} else {
+			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
+			Name: "code",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: search.
+	// Decode query: state.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "search",
+			Name:    "state",
 			Style:   uri.QueryStyleForm,
-			Explode: false,
+			Explode: true,
 		}
 
 		if err := q.HasParam(cfg); err == nil {
 			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotSearchVal string
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotSearchVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.Search.SetTo(paramsDotSearchVal)
+
+				params.State = c
 				return nil
 			}); err != nil {
 				return err
 			}
+		} else {
+			return err
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "search",
+			Name: "state",
 			In:   "query",
 			Err:  err,
 		}
 	}
-	// Decode query: orderBy.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "orderBy",
-			Style:   uri.QueryStyleForm,
-			Explode: false,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOrderByVal GetUserListManageOrderBy
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+	return params, nil
+}
 
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+// HasConsentParams is parameters of hasConsent operation.
+type HasConsentParams struct {
+	TalkSessionID string
+}
 
-					paramsDotOrderByVal = GetUserListManageOrderBy(c)
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.OrderBy.SetTo(paramsDotOrderByVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.OrderBy.Get(); ok {
-					if err := func() error {
-						if err := value.Validate(); err != nil {
-							return err
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
-				}
-				return nil
-			}(); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "orderBy",
-			In:   "query",
-			Err:  err,
+func unpackHasConsentParams(packed middleware.Parameters) (params HasConsentParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "talkSessionID",
+			In:   "path",
 		}
+		params.TalkSessionID = packed[key].(string)
 	}
-	// Decode query: order.
+	return params
+}
+
+func decodeHasConsentParams(args [1]string, argsEscaped bool, r *http.Request) (params HasConsentParams, _ error) {
+	// Decode path: talkSessionID.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "order",
-			Style:   uri.QueryStyleForm,
-			Explode: false,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "talkSessionID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOrderVal GetUserListManageOrder
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotOrderVal = GetUserListManageOrder(c)
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.Order.SetTo(paramsDotOrderVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Order.Get(); ok {
-					if err := func() error {
-						if err := value.Validate(); err != nil {
-							return err
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
-				}
+
+				params.TalkSessionID = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "order",
-			In:   "query",
+			Name: "talkSessionID",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetUserStatsListManageParams is parameters of getUserStatsListManage operation.
-type GetUserStatsListManageParams struct {
-	Range  string
-	Offset OptInt32
-	Limit  OptInt32
+// ManageRegenerateManageParams is parameters of manageRegenerateManage operation.
+type ManageRegenerateManageParams struct {
+	TalkSessionID string
 }
 
-func unpackGetUserStatsListManageParams(packed middleware.Parameters) (params GetUserStatsListManageParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "range",
-			In:   "query",
-		}
-		params.Range = packed[key].(string)
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "offset",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptInt32)
-		}
-	}
+func unpackManageRegenerateManageParams(packed middleware.Parameters) (params ManageRegenerateManageParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt32)
+			Name: "talkSessionID",
+			In:   "path",
 		}
+		params.TalkSessionID = packed[key].(string)
 	}
 	return params
 }
 
-func decodeGetUserStatsListManageParams(args [0]string, argsEscaped bool, r *http.Request) (params GetUserStatsListManageParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: range.
+func decodeManageRegenerateManageParams(args [1]string, argsEscaped bool, r *http.Request) (params ManageRegenerateManageParams, _ error) {
+	// Decode path: talkSessionID.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "range",
-			Style:   uri.QueryStyleForm,
-			Explode: false,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "talkSessionID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+			if err := func() error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
@@ -3394,122 +7842,108 @@ func decodeGetUserStatsListManageParams(args [0]string, argsEscaped bool, r *htt
 					return err
 				}
 
-				params.Range = c
+				params.TalkSessionID = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
 		} else {
-			return err
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "range",
-			In:   "query",
+			Name: "talkSessionID",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: offset.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "offset",
-			Style:   uri.QueryStyleForm,
-			Explode: false,
-		}
-
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOffsetVal int32
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+	return params, nil
+}
 
-					c, err := conv.ToInt32(val)
-					if err != nil {
-						return err
-					}
+// OpinionComments2Params is parameters of opinionComments2 operation.
+type OpinionComments2Params struct {
+	// 親意見のID.
+	OpinionID string
+}
 
-					paramsDotOffsetVal = c
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Offset.SetTo(paramsDotOffsetVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-		}
-		return nil
-	}(); err != nil {
-		return params, &ogenerrors.DecodeParamError{
-			Name: "offset",
-			In:   "query",
-			Err:  err,
+func unpackOpinionComments2Params(packed middleware.Parameters) (params OpinionComments2Params) {
+	{
+		key := middleware.ParameterKey{
+			Name: "opinionID",
+			In:   "path",
 		}
+		params.OpinionID = packed[key].(string)
 	}
-	// Decode query: limit.
+	return params
+}
+
+func decodeOpinionComments2Params(args [1]string, argsEscaped bool, r *http.Request) (params OpinionComments2Params, _ error) {
+	// Decode path: opinionID.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: false,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "opinionID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int32
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToInt32(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotLimitVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
+
+				params.OpinionID = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
-			In:   "query",
+			Name: "opinionID",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// GetUserTalkSessionsParams is parameters of getUserTalkSessions operation.
-type GetUserTalkSessionsParams struct {
-	DisplayID string
-	Limit     OptInt
-	Offset    OptInt
-	Status    OptNilGetUserTalkSessionsStatus
+// OpinionsHistoryParams is parameters of opinionsHistory operation.
+type OpinionsHistoryParams struct {
+	// ソートきー.
+	Sort   OptNilOpinionsHistorySort
+	Limit  OptInt
+	Offset OptInt
 }
 
-func unpackGetUserTalkSessionsParams(packed middleware.Parameters) (params GetUserTalkSessionsParams) {
+func unpackOpinionsHistoryParams(packed middleware.Parameters) (params OpinionsHistoryParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "displayID",
-			In:   "path",
+			Name: "sort",
+			In:   "query",
+		}
+		if v, ok := packed[key]; ok {
+			params.Sort = v.(OptNilOpinionsHistorySort)
 		}
-		params.DisplayID = packed[key].(string)
 	}
 	{
 		key := middleware.ParameterKey{
@@ -3529,70 +7963,67 @@ func unpackGetUserTalkSessionsParams(packed middleware.Parameters) (params GetUs
 			params.Offset = v.(OptInt)
 		}
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "status",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Status = v.(OptNilGetUserTalkSessionsStatus)
-		}
-	}
 	return params
 }
 
-func decodeGetUserTalkSessionsParams(args [1]string, argsEscaped bool, r *http.Request) (params GetUserTalkSessionsParams, _ error) {
+func decodeOpinionsHistoryParams(args [0]string, argsEscaped bool, r *http.Request) (params OpinionsHistoryParams, _ error) {
 	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: displayID.
+	// Decode query: sort.
 	if err := func() error {
-		param := args[0]
-		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
-			if err != nil {
-				return errors.Wrap(err, "unescape path")
-			}
-			param = unescaped
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "sort",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
 		}
-		if len(param) > 0 {
-			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "displayID",
-				Value:   param,
-				Style:   uri.PathStyleSimple,
-				Explode: false,
-			})
 
-			if err := func() error {
-				val, err := d.DecodeValue()
-				if err != nil {
-					return err
-				}
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotSortVal OpinionsHistorySort
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
 
-				c, err := conv.ToString(val)
-				if err != nil {
+					paramsDotSortVal = OpinionsHistorySort(c)
+					return nil
+				}(); err != nil {
 					return err
 				}
-
-				params.DisplayID = c
+				params.Sort.SetTo(paramsDotSortVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Sort.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
 				return nil
 			}(); err != nil {
 				return err
 			}
-		} else {
-			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "displayID",
-			In:   "path",
+			Name: "sort",
+			In:   "query",
 			Err:  err,
 		}
 	}
-	// Set default value for query: limit.
-	{
-		val := int(10)
-		params.Limit.SetTo(val)
-	}
 	// Decode query: limit.
 	if err := func() error {
 		cfg := uri.QueryParameterDecodingConfig{
@@ -3675,101 +8106,157 @@ func decodeGetUserTalkSessionsParams(args [1]string, argsEscaped bool, r *http.R
 			Err:  err,
 		}
 	}
-	// Decode query: status.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "status",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
-		}
+	return params, nil
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotStatusVal GetUserTalkSessionsStatus
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+// PostConclusionParams is parameters of postConclusion operation.
+type PostConclusionParams struct {
+	TalkSessionID string
+}
 
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+func unpackPostConclusionParams(packed middleware.Parameters) (params PostConclusionParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "talkSessionID",
+			In:   "path",
+		}
+		params.TalkSessionID = packed[key].(string)
+	}
+	return params
+}
 
-					paramsDotStatusVal = GetUserTalkSessionsStatus(c)
-					return nil
-				}(); err != nil {
-					return err
-				}
-				params.Status.SetTo(paramsDotStatusVal)
-				return nil
-			}); err != nil {
-				return err
+func decodePostConclusionParams(args [1]string, argsEscaped bool, r *http.Request) (params PostConclusionParams, _ error) {
+	// Decode path: talkSessionID.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
 			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "talkSessionID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
 			if err := func() error {
-				if value, ok := params.Status.Get(); ok {
-					if err := func() error {
-						if err := value.Validate(); err != nil {
-							return err
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
 				}
+
+				params.TalkSessionID = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "status",
-			In:   "query",
+			Name: "talkSessionID",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// HandleAuthCallbackParams is parameters of handleAuthCallback operation.
-type HandleAuthCallbackParams struct {
-	Provider string
-	Code     string
-	// OAuth State from Query.
-	State string
+// PostTimeLineItemParams is parameters of postTimeLineItem operation.
+type PostTimeLineItemParams struct {
+	TalkSessionID string
 }
 
-func unpackHandleAuthCallbackParams(packed middleware.Parameters) (params HandleAuthCallbackParams) {
+func unpackPostTimeLineItemParams(packed middleware.Parameters) (params PostTimeLineItemParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "provider",
+			Name: "talkSessionID",
 			In:   "path",
 		}
-		params.Provider = packed[key].(string)
+		params.TalkSessionID = packed[key].(string)
 	}
-	{
-		key := middleware.ParameterKey{
-			Name: "code",
-			In:   "query",
+	return params
+}
+
+func decodePostTimeLineItemParams(args [1]string, argsEscaped bool, r *http.Request) (params PostTimeLineItemParams, _ error) {
+	// Decode path: talkSessionID.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "talkSessionID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.TalkSessionID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "talkSessionID",
+			In:   "path",
+			Err:  err,
 		}
-		params.Code = packed[key].(string)
 	}
+	return params, nil
+}
+
+// PublishReportVersionManageParams is parameters of publishReportVersionManage operation.
+type PublishReportVersionManageParams struct {
+	TalkSessionID string
+}
+
+func unpackPublishReportVersionManageParams(packed middleware.Parameters) (params PublishReportVersionManageParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "state",
-			In:   "query",
+			Name: "talkSessionID",
+			In:   "path",
 		}
-		params.State = packed[key].(string)
+		params.TalkSessionID = packed[key].(string)
 	}
 	return params
 }
 
-func decodeHandleAuthCallbackParams(args [1]string, argsEscaped bool, r *http.Request) (params HandleAuthCallbackParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode path: provider.
+func decodePublishReportVersionManageParams(args [1]string, argsEscaped bool, r *http.Request) (params PublishReportVersionManageParams, _ error) {
+	// Decode path: talkSessionID.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -3781,7 +8268,7 @@ func decodeHandleAuthCallbackParams(args [1]string, argsEscaped bool, r *http.Re
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "provider",
+				Param:   "talkSessionID",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -3798,7 +8285,7 @@ func decodeHandleAuthCallbackParams(args [1]string, argsEscaped bool, r *http.Re
 					return err
 				}
 
-				params.Provider = c
+				params.TalkSessionID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -3809,21 +8296,50 @@ func decodeHandleAuthCallbackParams(args [1]string, argsEscaped bool, r *http.Re
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "provider",
+			Name: "talkSessionID",
 			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: code.
+	return params, nil
+}
+
+// PublishTalkSessionParams is parameters of publishTalkSession operation.
+type PublishTalkSessionParams struct {
+	TalkSessionID string
+}
+
+func unpackPublishTalkSessionParams(packed middleware.Parameters) (params PublishTalkSessionParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "talkSessionID",
+			In:   "path",
+		}
+		params.TalkSessionID = packed[key].(string)
+	}
+	return params
+}
+
+func decodePublishTalkSessionParams(args [1]string, argsEscaped bool, r *http.Request) (params PublishTalkSessionParams, _ error) {
+	// Decode path: talkSessionID.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "code",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "talkSessionID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+			if err := func() error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
@@ -3834,32 +8350,61 @@ func decodeHandleAuthCallbackParams(args [1]string, argsEscaped bool, r *http.Re
 					return err
 				}
 
-				params.Code = c
+				params.TalkSessionID = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
 		} else {
-			return err
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "code",
-			In:   "query",
+			Name: "talkSessionID",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: state.
+	return params, nil
+}
+
+// RemoveOrganizationUserParams is parameters of removeOrganizationUser operation.
+type RemoveOrganizationUserParams struct {
+	UserID string
+}
+
+func unpackRemoveOrganizationUserParams(packed middleware.Parameters) (params RemoveOrganizationUserParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "userID",
+			In:   "path",
+		}
+		params.UserID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeRemoveOrganizationUserParams(args [1]string, argsEscaped bool, r *http.Request) (params RemoveOrganizationUserParams, _ error) {
+	// Decode path: userID.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "state",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "userID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+			if err := func() error {
 				val, err := d.DecodeValue()
 				if err != nil {
 					return err
@@ -3870,31 +8415,32 @@ func decodeHandleAuthCallbackParams(args [1]string, argsEscaped bool, r *http.Re
 					return err
 				}
 
-				params.State = c
+				params.UserID = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
 		} else {
-			return err
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "state",
-			In:   "query",
+			Name: "userID",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// HasConsentParams is parameters of hasConsent operation.
-type HasConsentParams struct {
+// RemoveTalkSessionCollaboratorParams is parameters of removeTalkSessionCollaborator operation.
+type RemoveTalkSessionCollaboratorParams struct {
 	TalkSessionID string
+	DisplayID     string
 }
 
-func unpackHasConsentParams(packed middleware.Parameters) (params HasConsentParams) {
+func unpackRemoveTalkSessionCollaboratorParams(packed middleware.Parameters) (params RemoveTalkSessionCollaboratorParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "talkSessionID",
@@ -3902,10 +8448,17 @@ func unpackHasConsentParams(packed middleware.Parameters) (params HasConsentPara
 		}
 		params.TalkSessionID = packed[key].(string)
 	}
+	{
+		key := middleware.ParameterKey{
+			Name: "displayID",
+			In:   "path",
+		}
+		params.DisplayID = packed[key].(string)
+	}
 	return params
 }
 
-func decodeHasConsentParams(args [1]string, argsEscaped bool, r *http.Request) (params HasConsentParams, _ error) {
+func decodeRemoveTalkSessionCollaboratorParams(args [2]string, argsEscaped bool, r *http.Request) (params RemoveTalkSessionCollaboratorParams, _ error) {
 	// Decode path: talkSessionID.
 	if err := func() error {
 		param := args[0]
@@ -3951,31 +8504,11 @@ func decodeHasConsentParams(args [1]string, argsEscaped bool, r *http.Request) (
 			Err:  err,
 		}
 	}
-	return params, nil
-}
-
-// ManageRegenerateManageParams is parameters of manageRegenerateManage operation.
-type ManageRegenerateManageParams struct {
-	TalkSessionID string
-}
-
-func unpackManageRegenerateManageParams(packed middleware.Parameters) (params ManageRegenerateManageParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "talkSessionID",
-			In:   "path",
-		}
-		params.TalkSessionID = packed[key].(string)
-	}
-	return params
-}
-
-func decodeManageRegenerateManageParams(args [1]string, argsEscaped bool, r *http.Request) (params ManageRegenerateManageParams, _ error) {
-	// Decode path: talkSessionID.
+	// Decode path: displayID.
 	if err := func() error {
-		param := args[0]
+		param := args[1]
 		if argsEscaped {
-			unescaped, err := url.PathUnescape(args[0])
+			unescaped, err := url.PathUnescape(args[1])
 			if err != nil {
 				return errors.Wrap(err, "unescape path")
 			}
@@ -3983,7 +8516,7 @@ func decodeManageRegenerateManageParams(args [1]string, argsEscaped bool, r *htt
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "talkSessionID",
+				Param:   "displayID",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -4000,7 +8533,7 @@ func decodeManageRegenerateManageParams(args [1]string, argsEscaped bool, r *htt
 					return err
 				}
 
-				params.TalkSessionID = c
+				params.DisplayID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -4011,7 +8544,7 @@ func decodeManageRegenerateManageParams(args [1]string, argsEscaped bool, r *htt
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "talkSessionID",
+			Name: "displayID",
 			In:   "path",
 			Err:  err,
 		}
@@ -4019,13 +8552,12 @@ func decodeManageRegenerateManageParams(args [1]string, argsEscaped bool, r *htt
 	return params, nil
 }
 
-// OpinionComments2Params is parameters of opinionComments2 operation.
-type OpinionComments2Params struct {
-	// 親意見のID.
+// ReportOpinionParams is parameters of reportOpinion operation.
+type ReportOpinionParams struct {
 	OpinionID string
 }
 
-func unpackOpinionComments2Params(packed middleware.Parameters) (params OpinionComments2Params) {
+func unpackReportOpinionParams(packed middleware.Parameters) (params ReportOpinionParams) {
 	{
 		key := middleware.ParameterKey{
 			Name: "opinionID",
@@ -4036,7 +8568,7 @@ func unpackOpinionComments2Params(packed middleware.Parameters) (params OpinionC
 	return params
 }
 
-func decodeOpinionComments2Params(args [1]string, argsEscaped bool, r *http.Request) (params OpinionComments2Params, _ error) {
+func decodeReportOpinionParams(args [1]string, argsEscaped bool, r *http.Request) (params ReportOpinionParams, _ error) {
 	// Decode path: opinionID.
 	if err := func() error {
 		param := args[0]
@@ -4085,206 +8617,219 @@ func decodeOpinionComments2Params(args [1]string, argsEscaped bool, r *http.Requ
 	return params, nil
 }
 
-// OpinionsHistoryParams is parameters of opinionsHistory operation.
-type OpinionsHistoryParams struct {
-	// ソートきー.
-	Sort   OptNilOpinionsHistorySort
-	Limit  OptInt
-	Offset OptInt
+// RequestOrganizationOwnershipTransferParams is parameters of requestOrganizationOwnershipTransfer operation.
+type RequestOrganizationOwnershipTransferParams struct {
+	Code string
 }
 
-func unpackOpinionsHistoryParams(packed middleware.Parameters) (params OpinionsHistoryParams) {
-	{
-		key := middleware.ParameterKey{
-			Name: "sort",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Sort = v.(OptNilOpinionsHistorySort)
-		}
-	}
-	{
-		key := middleware.ParameterKey{
-			Name: "limit",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Limit = v.(OptInt)
-		}
-	}
+func unpackRequestOrganizationOwnershipTransferParams(packed middleware.Parameters) (params RequestOrganizationOwnershipTransferParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "offset",
-			In:   "query",
-		}
-		if v, ok := packed[key]; ok {
-			params.Offset = v.(OptInt)
+			Name: "code",
+			In:   "path",
 		}
+		params.Code = packed[key].(string)
 	}
 	return params
 }
 
-func decodeOpinionsHistoryParams(args [0]string, argsEscaped bool, r *http.Request) (params OpinionsHistoryParams, _ error) {
-	q := uri.NewQueryDecoder(r.URL.Query())
-	// Decode query: sort.
+func decodeRequestOrganizationOwnershipTransferParams(args [1]string, argsEscaped bool, r *http.Request) (params RequestOrganizationOwnershipTransferParams, _ error) {
+	// Decode path: code.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "sort",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "code",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotSortVal OpinionsHistorySort
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotSortVal = OpinionsHistorySort(c)
-					return nil
-				}(); err != nil {
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.Sort.SetTo(paramsDotSortVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Sort.Get(); ok {
-					if err := func() error {
-						if err := value.Validate(); err != nil {
-							return err
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
 				}
+
+				params.Code = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "sort",
-			In:   "query",
+			Name: "code",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: limit.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "limit",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+	return params, nil
+}
+
+// RequestTalkSessionOwnershipTransferParams is parameters of requestTalkSessionOwnershipTransfer operation.
+type RequestTalkSessionOwnershipTransferParams struct {
+	TalkSessionID string
+}
+
+func unpackRequestTalkSessionOwnershipTransferParams(packed middleware.Parameters) (params RequestTalkSessionOwnershipTransferParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "talkSessionID",
+			In:   "path",
 		}
+		params.TalkSessionID = packed[key].(string)
+	}
+	return params
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotLimitVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+func decodeRequestTalkSessionOwnershipTransferParams(args [1]string, argsEscaped bool, r *http.Request) (params RequestTalkSessionOwnershipTransferParams, _ error) {
+	// Decode path: talkSessionID.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "talkSessionID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotLimitVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.Limit.SetTo(paramsDotLimitVal)
+
+				params.TalkSessionID = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "limit",
-			In:   "query",
+			Name: "talkSessionID",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: offset.
-	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "offset",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+	return params, nil
+}
+
+// ResendOrganizationInvitationParams is parameters of resendOrganizationInvitation operation.
+type ResendOrganizationInvitationParams struct {
+	InvitationID string
+}
+
+func unpackResendOrganizationInvitationParams(packed middleware.Parameters) (params ResendOrganizationInvitationParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "invitationID",
+			In:   "path",
 		}
+		params.InvitationID = packed[key].(string)
+	}
+	return params
+}
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotOffsetVal int
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+func decodeResendOrganizationInvitationParams(args [1]string, argsEscaped bool, r *http.Request) (params ResendOrganizationInvitationParams, _ error) {
+	// Decode path: invitationID.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "invitationID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-					c, err := conv.ToInt(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
 
-					paramsDotOffsetVal = c
-					return nil
-				}(); err != nil {
+				c, err := conv.ToString(val)
+				if err != nil {
 					return err
 				}
-				params.Offset.SetTo(paramsDotOffsetVal)
+
+				params.InvitationID = c
 				return nil
-			}); err != nil {
+			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "offset",
-			In:   "query",
+			Name: "invitationID",
+			In:   "path",
 			Err:  err,
 		}
 	}
 	return params, nil
 }
 
-// PostConclusionParams is parameters of postConclusion operation.
-type PostConclusionParams struct {
-	TalkSessionID string
+// RevokeOrganizationApiKeyParams is parameters of revokeOrganizationApiKey operation.
+type RevokeOrganizationApiKeyParams struct {
+	ApiKeyID string
 }
 
-func unpackPostConclusionParams(packed middleware.Parameters) (params PostConclusionParams) {
+func unpackRevokeOrganizationApiKeyParams(packed middleware.Parameters) (params RevokeOrganizationApiKeyParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "talkSessionID",
+			Name: "apiKeyID",
 			In:   "path",
 		}
-		params.TalkSessionID = packed[key].(string)
+		params.ApiKeyID = packed[key].(string)
 	}
 	return params
 }
 
-func decodePostConclusionParams(args [1]string, argsEscaped bool, r *http.Request) (params PostConclusionParams, _ error) {
-	// Decode path: talkSessionID.
+func decodeRevokeOrganizationApiKeyParams(args [1]string, argsEscaped bool, r *http.Request) (params RevokeOrganizationApiKeyParams, _ error) {
+	// Decode path: apiKeyID.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -4296,7 +8841,7 @@ func decodePostConclusionParams(args [1]string, argsEscaped bool, r *http.Reques
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "talkSessionID",
+				Param:   "apiKeyID",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -4313,7 +8858,7 @@ func decodePostConclusionParams(args [1]string, argsEscaped bool, r *http.Reques
 					return err
 				}
 
-				params.TalkSessionID = c
+				params.ApiKeyID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -4324,7 +8869,7 @@ func decodePostConclusionParams(args [1]string, argsEscaped bool, r *http.Reques
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "talkSessionID",
+			Name: "apiKeyID",
 			In:   "path",
 			Err:  err,
 		}
@@ -4332,24 +8877,24 @@ func decodePostConclusionParams(args [1]string, argsEscaped bool, r *http.Reques
 	return params, nil
 }
 
-// PostTimeLineItemParams is parameters of postTimeLineItem operation.
-type PostTimeLineItemParams struct {
-	TalkSessionID string
+// RevokeOrganizationInvitationParams is parameters of revokeOrganizationInvitation operation.
+type RevokeOrganizationInvitationParams struct {
+	InvitationID string
 }
 
-func unpackPostTimeLineItemParams(packed middleware.Parameters) (params PostTimeLineItemParams) {
+func unpackRevokeOrganizationInvitationParams(packed middleware.Parameters) (params RevokeOrganizationInvitationParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "talkSessionID",
+			Name: "invitationID",
 			In:   "path",
 		}
-		params.TalkSessionID = packed[key].(string)
+		params.InvitationID = packed[key].(string)
 	}
 	return params
 }
 
-func decodePostTimeLineItemParams(args [1]string, argsEscaped bool, r *http.Request) (params PostTimeLineItemParams, _ error) {
-	// Decode path: talkSessionID.
+func decodeRevokeOrganizationInvitationParams(args [1]string, argsEscaped bool, r *http.Request) (params RevokeOrganizationInvitationParams, _ error) {
+	// Decode path: invitationID.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -4361,7 +8906,7 @@ func decodePostTimeLineItemParams(args [1]string, argsEscaped bool, r *http.Requ
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "talkSessionID",
+				Param:   "invitationID",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -4378,7 +8923,7 @@ func decodePostTimeLineItemParams(args [1]string, argsEscaped bool, r *http.Requ
 					return err
 				}
 
-				params.TalkSessionID = c
+				params.InvitationID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -4389,7 +8934,7 @@ func decodePostTimeLineItemParams(args [1]string, argsEscaped bool, r *http.Requ
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "talkSessionID",
+			Name: "invitationID",
 			In:   "path",
 			Err:  err,
 		}
@@ -4397,24 +8942,24 @@ func decodePostTimeLineItemParams(args [1]string, argsEscaped bool, r *http.Requ
 	return params, nil
 }
 
-// ReportOpinionParams is parameters of reportOpinion operation.
-type ReportOpinionParams struct {
-	OpinionID string
+// SaveTalkSessionTemplateParams is parameters of saveTalkSessionTemplate operation.
+type SaveTalkSessionTemplateParams struct {
+	TalkSessionID string
 }
 
-func unpackReportOpinionParams(packed middleware.Parameters) (params ReportOpinionParams) {
+func unpackSaveTalkSessionTemplateParams(packed middleware.Parameters) (params SaveTalkSessionTemplateParams) {
 	{
 		key := middleware.ParameterKey{
-			Name: "opinionID",
+			Name: "talkSessionID",
 			In:   "path",
 		}
-		params.OpinionID = packed[key].(string)
+		params.TalkSessionID = packed[key].(string)
 	}
 	return params
 }
 
-func decodeReportOpinionParams(args [1]string, argsEscaped bool, r *http.Request) (params ReportOpinionParams, _ error) {
-	// Decode path: opinionID.
+func decodeSaveTalkSessionTemplateParams(args [1]string, argsEscaped bool, r *http.Request) (params SaveTalkSessionTemplateParams, _ error) {
+	// Decode path: talkSessionID.
 	if err := func() error {
 		param := args[0]
 		if argsEscaped {
@@ -4426,7 +8971,7 @@ func decodeReportOpinionParams(args [1]string, argsEscaped bool, r *http.Request
 		}
 		if len(param) > 0 {
 			d := uri.NewPathDecoder(uri.PathDecoderConfig{
-				Param:   "opinionID",
+				Param:   "talkSessionID",
 				Value:   param,
 				Style:   uri.PathStyleSimple,
 				Explode: false,
@@ -4443,7 +8988,7 @@ func decodeReportOpinionParams(args [1]string, argsEscaped bool, r *http.Request
 					return err
 				}
 
-				params.OpinionID = c
+				params.TalkSessionID = c
 				return nil
 			}(); err != nil {
 				return err
@@ -4454,7 +8999,7 @@ func decodeReportOpinionParams(args [1]string, argsEscaped bool, r *http.Request
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "opinionID",
+			Name: "talkSessionID",
 			In:   "path",
 			Err:  err,
 		}
@@ -4631,64 +9176,182 @@ func decodeSessionsHistoryParams(args [0]string, argsEscaped bool, r *http.Reque
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "theme",
-			In:   "query",
+			Name: "theme",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	// Decode query: status.
+	if err := func() error {
+		cfg := uri.QueryParameterDecodingConfig{
+			Name:    "status",
+			Style:   uri.QueryStyleForm,
+			Explode: true,
+		}
+
+		if err := q.HasParam(cfg); err == nil {
+			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+				var paramsDotStatusVal SessionsHistoryStatus
+				if err := func() error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					paramsDotStatusVal = SessionsHistoryStatus(c)
+					return nil
+				}(); err != nil {
+					return err
+				}
+				params.Status.SetTo(paramsDotStatusVal)
+				return nil
+			}); err != nil {
+				return err
+			}
+			if err := func() error {
+				if value, ok := params.Status.Get(); ok {
+					if err := func() error {
+						if err := value.Validate(); err != nil {
+							return err
+						}
+						return nil
+					}(); err != nil {
+						return err
+					}
+				}
+				return nil
+			}(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "status",
+			In:   "query",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// SetTalkSessionCollaboratorParams is parameters of setTalkSessionCollaborator operation.
+type SetTalkSessionCollaboratorParams struct {
+	TalkSessionID string
+	DisplayID     string
+}
+
+func unpackSetTalkSessionCollaboratorParams(packed middleware.Parameters) (params SetTalkSessionCollaboratorParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "talkSessionID",
+			In:   "path",
+		}
+		params.TalkSessionID = packed[key].(string)
+	}
+	{
+		key := middleware.ParameterKey{
+			Name: "displayID",
+			In:   "path",
+		}
+		params.DisplayID = packed[key].(string)
+	}
+	return params
+}
+
+func decodeSetTalkSessionCollaboratorParams(args [2]string, argsEscaped bool, r *http.Request) (params SetTalkSessionCollaboratorParams, _ error) {
+	// Decode path: talkSessionID.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "talkSessionID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.TalkSessionID = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "talkSessionID",
+			In:   "path",
 			Err:  err,
 		}
 	}
-	// Decode query: status.
+	// Decode path: displayID.
 	if err := func() error {
-		cfg := uri.QueryParameterDecodingConfig{
-			Name:    "status",
-			Style:   uri.QueryStyleForm,
-			Explode: true,
+		param := args[1]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[1])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
 		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "displayID",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
 
-		if err := q.HasParam(cfg); err == nil {
-			if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-				var paramsDotStatusVal SessionsHistoryStatus
-				if err := func() error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
-
-					paramsDotStatusVal = SessionsHistoryStatus(c)
-					return nil
-				}(); err != nil {
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
 					return err
 				}
-				params.Status.SetTo(paramsDotStatusVal)
-				return nil
-			}); err != nil {
-				return err
-			}
-			if err := func() error {
-				if value, ok := params.Status.Get(); ok {
-					if err := func() error {
-						if err := value.Validate(); err != nil {
-							return err
-						}
-						return nil
-					}(); err != nil {
-						return err
-					}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
 				}
+
+				params.DisplayID = c
 				return nil
 			}(); err != nil {
 				return err
 			}
+		} else {
+			return validate.ErrFieldRequired
 		}
 		return nil
 	}(); err != nil {
 		return params, &ogenerrors.DecodeParamError{
-			Name: "status",
-			In:   "query",
+			Name: "displayID",
+			In:   "path",
 			Err:  err,
 		}
 	}
@@ -5142,6 +9805,201 @@ func decodeUpdateOrganizationParams(args [1]string, argsEscaped bool, r *http.Re
 	return params, nil
 }
 
+// UpdateOrganizationParentParams is parameters of updateOrganizationParent operation.
+type UpdateOrganizationParentParams struct {
+	Code string
+}
+
+func unpackUpdateOrganizationParentParams(packed middleware.Parameters) (params UpdateOrganizationParentParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "code",
+			In:   "path",
+		}
+		params.Code = packed[key].(string)
+	}
+	return params
+}
+
+func decodeUpdateOrganizationParentParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateOrganizationParentParams, _ error) {
+	// Decode path: code.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "code",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Code = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "code",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UpdateOrganizationReferenceDemographicsParams is parameters of updateOrganizationReferenceDemographics operation.
+type UpdateOrganizationReferenceDemographicsParams struct {
+	Code string
+}
+
+func unpackUpdateOrganizationReferenceDemographicsParams(packed middleware.Parameters) (params UpdateOrganizationReferenceDemographicsParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "code",
+			In:   "path",
+		}
+		params.Code = packed[key].(string)
+	}
+	return params
+}
+
+func decodeUpdateOrganizationReferenceDemographicsParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateOrganizationReferenceDemographicsParams, _ error) {
+	// Decode path: code.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "code",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Code = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "code",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
+// UpdateOrganizationReportPromptParams is parameters of updateOrganizationReportPrompt operation.
+type UpdateOrganizationReportPromptParams struct {
+	Code string
+}
+
+func unpackUpdateOrganizationReportPromptParams(packed middleware.Parameters) (params UpdateOrganizationReportPromptParams) {
+	{
+		key := middleware.ParameterKey{
+			Name: "code",
+			In:   "path",
+		}
+		params.Code = packed[key].(string)
+	}
+	return params
+}
+
+func decodeUpdateOrganizationReportPromptParams(args [1]string, argsEscaped bool, r *http.Request) (params UpdateOrganizationReportPromptParams, _ error) {
+	// Decode path: code.
+	if err := func() error {
+		param := args[0]
+		if argsEscaped {
+			unescaped, err := url.PathUnescape(args[0])
+			if err != nil {
+				return errors.Wrap(err, "unescape path")
+			}
+			param = unescaped
+		}
+		if len(param) > 0 {
+			d := uri.NewPathDecoder(uri.PathDecoderConfig{
+				Param:   "code",
+				Value:   param,
+				Style:   uri.PathStyleSimple,
+				Explode: false,
+			})
+
+			if err := func() error {
+				val, err := d.DecodeValue()
+				if err != nil {
+					return err
+				}
+
+				c, err := conv.ToString(val)
+				if err != nil {
+					return err
+				}
+
+				params.Code = c
+				return nil
+			}(); err != nil {
+				return err
+			}
+		} else {
+			return validate.ErrFieldRequired
+		}
+		return nil
+	}(); err != nil {
+		return params, &ogenerrors.DecodeParamError{
+			Name: "code",
+			In:   "path",
+			Err:  err,
+		}
+	}
+	return params, nil
+}
+
 // ValidateOrganizationCodeParams is parameters of validateOrganizationCode operation.
 type ValidateOrganizationCodeParams struct {
 	Code string
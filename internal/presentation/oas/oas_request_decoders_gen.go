@@ -3,19 +3,98 @@
 package oas
 
 import (
+	"io"
 	"mime"
 	"net/http"
 	"net/url"
+	"time"
 
 	"github.com/go-faster/errors"
 	"github.com/go-faster/jx"
 
 	"github.com/ogen-go/ogen/conv"
 	ht "github.com/ogen-go/ogen/http"
+	"github.com/ogen-go/ogen/ogenerrors"
 	"github.com/ogen-go/ogen/uri"
 	"github.com/ogen-go/ogen/validate"
 )
 
+func (s *Server) decodeAcceptOrganizationInvitationRequest(r *http.Request) (
+	req *AcceptOrganizationInvitationReq,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = errors.Join(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = errors.Join(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "multipart/form-data":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		if err := r.ParseMultipartForm(s.cfg.MaxMultipartMemory); err != nil {
+			return req, close, errors.Wrap(err, "parse multipart form")
+		}
+		// Remove all temporary files created by ParseMultipartForm when the request is done.
+		//
+		// Notice that the closers are called in reverse order, to match defer behavior, so
+		// any opened file will be closed before RemoveAll call.
+		closers = append(closers, r.MultipartForm.RemoveAll)
+		// Form values may be unused.
+		form := url.Values(r.MultipartForm.Value)
+		_ = form
+
+		var request AcceptOrganizationInvitationReq
+		q := uri.NewQueryDecoder(form)
+		{
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "token",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					request.Token = c
+					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"token\"")
+				}
+			} else {
+				return req, close, errors.Wrap(err, "query")
+			}
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
 func (s *Server) decodeApplyFeedbackToReportRequest(r *http.Request) (
 	req *ApplyFeedbackToReportReq,
 	close func() error,
@@ -119,8 +198,79 @@ func (s *Server) decodeApplyFeedbackToReportRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeCreateOrganizationAliasRequest(r *http.Request) (
-	req *CreateOrganizationAliasReq,
+func (s *Server) decodeBatchVoteRequest(r *http.Request) (
+	req *BatchVoteReq,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = errors.Join(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = errors.Join(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "application/json":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		buf, err := io.ReadAll(r.Body)
+		if err != nil {
+			return req, close, err
+		}
+
+		if len(buf) == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+
+		d := jx.DecodeBytes(buf)
+
+		var request BatchVoteReq
+		if err := func() error {
+			if err := request.Decode(d); err != nil {
+				return err
+			}
+			if err := d.Skip(); err != io.EOF {
+				return errors.New("unexpected trailing data")
+			}
+			return nil
+		}(); err != nil {
+			err = &ogenerrors.DecodeBodyError{
+				ContentType: ct,
+				Body:        buf,
+				Err:         err,
+			}
+			return req, close, err
+		}
+		if err := func() error {
+			if err := request.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return req, close, errors.Wrap(err, "validate")
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodeBulkCreateOrganizationInvitationsRequest(r *http.Request) (
+	req *BulkCreateOrganizationInvitationsReq,
 	close func() error,
 	rerr error,
 ) {
@@ -160,33 +310,29 @@ func (s *Server) decodeCreateOrganizationAliasRequest(r *http.Request) (
 		form := url.Values(r.MultipartForm.Value)
 		_ = form
 
-		var request CreateOrganizationAliasReq
-		q := uri.NewQueryDecoder(form)
+		var request BulkCreateOrganizationInvitationsReq
 		{
-			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "aliasName",
-				Style:   uri.QueryStyleForm,
-				Explode: true,
-			}
-			if err := q.HasParam(cfg); err == nil {
-				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
+			if err := func() error {
+				files, ok := r.MultipartForm.File["file"]
+				if !ok || len(files) < 1 {
+					return validate.ErrFieldRequired
+				}
+				fh := files[0]
 
-					request.AliasName = c
-					return nil
-				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"aliasName\"")
+				f, err := fh.Open()
+				if err != nil {
+					return errors.Wrap(err, "open")
 				}
-			} else {
-				return req, close, errors.Wrap(err, "query")
+				closers = append(closers, f.Close)
+				request.File = ht.MultipartFile{
+					Name:   fh.Filename,
+					File:   f,
+					Size:   fh.Size,
+					Header: fh.Header,
+				}
+				return nil
+			}(); err != nil {
+				return req, close, errors.Wrap(err, "decode \"file\"")
 			}
 		}
 		return &request, close, nil
@@ -195,8 +341,8 @@ func (s *Server) decodeCreateOrganizationAliasRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeDummyInitRequest(r *http.Request) (
-	req *DummyInitReq,
+func (s *Server) decodeBulkTransferTalkSessionsRequest(r *http.Request) (
+	req *BulkTransferTalkSessionsReq,
 	close func() error,
 	rerr error,
 ) {
@@ -236,11 +382,11 @@ func (s *Server) decodeDummyInitRequest(r *http.Request) (
 		form := url.Values(r.MultipartForm.Value)
 		_ = form
 
-		var request DummyInitReq
+		var request BulkTransferTalkSessionsReq
 		q := uri.NewQueryDecoder(form)
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "integerNull",
+				Name:    "fromDisplayID",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
@@ -250,17 +396,16 @@ func (s *Server) decodeDummyInitRequest(r *http.Request) (
 					if err != nil {
 						return err
 					}
-					if err := func(d *jx.Decoder) error {
-						if err := request.IntegerNull.Decode(d); err != nil {
-							return err
-						}
-						return nil
-					}(jx.DecodeStr(val)); err != nil {
+
+					c, err := conv.ToString(val)
+					if err != nil {
 						return err
 					}
+
+					request.FromDisplayID = c
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"integerNull\"")
+					return req, close, errors.Wrap(err, "decode \"fromDisplayID\"")
 				}
 			} else {
 				return req, close, errors.Wrap(err, "query")
@@ -268,7 +413,7 @@ func (s *Server) decodeDummyInitRequest(r *http.Request) (
 		}
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "numericOptionalNull",
+				Name:    "recipientDisplayID",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
@@ -278,39 +423,73 @@ func (s *Server) decodeDummyInitRequest(r *http.Request) (
 					if err != nil {
 						return err
 					}
-					if err := func(d *jx.Decoder) error {
-						request.NumericOptionalNull.Reset()
-						if err := request.NumericOptionalNull.Decode(d); err != nil {
-							return err
-						}
-						return nil
-					}(jx.DecodeStr(val)); err != nil {
+
+					c, err := conv.ToString(val)
+					if err != nil {
 						return err
 					}
+
+					request.RecipientDisplayID = c
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"numericOptionalNull\"")
-				}
-				if err := func() error {
-					if value, ok := request.NumericOptionalNull.Get(); ok {
-						if err := func() error {
-							if err := (validate.Float{}).Validate(float64(value)); err != nil {
-								return errors.Wrap(err, "float")
-							}
-							return nil
-						}(); err != nil {
-							return err
-						}
-					}
-					return nil
-				}(); err != nil {
-					return req, close, errors.Wrap(err, "validate")
+					return req, close, errors.Wrap(err, "decode \"recipientDisplayID\"")
 				}
+			} else {
+				return req, close, errors.Wrap(err, "query")
 			}
 		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodeChangeOrganizationUserRoleRequest(r *http.Request) (
+	req *ChangeOrganizationUserRoleReq,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = errors.Join(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = errors.Join(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "multipart/form-data":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		if err := r.ParseMultipartForm(s.cfg.MaxMultipartMemory); err != nil {
+			return req, close, errors.Wrap(err, "parse multipart form")
+		}
+		// Remove all temporary files created by ParseMultipartForm when the request is done.
+		//
+		// Notice that the closers are called in reverse order, to match defer behavior, so
+		// any opened file will be closed before RemoveAll call.
+		closers = append(closers, r.MultipartForm.RemoveAll)
+		// Form values may be unused.
+		form := url.Values(r.MultipartForm.Value)
+		_ = form
+
+		var request ChangeOrganizationUserRoleReq
+		q := uri.NewQueryDecoder(form)
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "numericNull",
+				Name:    "role",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
@@ -320,28 +499,20 @@ func (s *Server) decodeDummyInitRequest(r *http.Request) (
 					if err != nil {
 						return err
 					}
-					if err := func(d *jx.Decoder) error {
-						if err := request.NumericNull.Decode(d); err != nil {
-							return err
-						}
-						return nil
-					}(jx.DecodeStr(val)); err != nil {
+
+					c, err := conv.ToFloat64(val)
+					if err != nil {
 						return err
 					}
+
+					request.Role = c
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"numericNull\"")
+					return req, close, errors.Wrap(err, "decode \"role\"")
 				}
 				if err := func() error {
-					if value, ok := request.NumericNull.Get(); ok {
-						if err := func() error {
-							if err := (validate.Float{}).Validate(float64(value)); err != nil {
-								return errors.Wrap(err, "float")
-							}
-							return nil
-						}(); err != nil {
-							return err
-						}
+					if err := (validate.Float{}).Validate(float64(request.Role)); err != nil {
+						return errors.Wrap(err, "float")
 					}
 					return nil
 				}(); err != nil {
@@ -351,56 +522,58 @@ func (s *Server) decodeDummyInitRequest(r *http.Request) (
 				return req, close, errors.Wrap(err, "query")
 			}
 		}
-		{
-			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "numericOptional",
-				Style:   uri.QueryStyleForm,
-				Explode: true,
-			}
-			if err := q.HasParam(cfg); err == nil {
-				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-					var requestDotNumericOptionalVal float64
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
-							return err
-						}
-
-						c, err := conv.ToFloat64(val)
-						if err != nil {
-							return err
-						}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
 
-						requestDotNumericOptionalVal = c
-						return nil
-					}(); err != nil {
-						return err
-					}
-					request.NumericOptional.SetTo(requestDotNumericOptionalVal)
-					return nil
-				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"numericOptional\"")
-				}
-				if err := func() error {
-					if value, ok := request.NumericOptional.Get(); ok {
-						if err := func() error {
-							if err := (validate.Float{}).Validate(float64(value)); err != nil {
-								return errors.Wrap(err, "float")
-							}
-							return nil
-						}(); err != nil {
-							return err
-						}
-					}
-					return nil
-				}(); err != nil {
-					return req, close, errors.Wrap(err, "validate")
-				}
-			}
+func (s *Server) decodeCloneTalkSessionRequest(r *http.Request) (
+	req *CloneTalkSessionReq,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = errors.Join(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = errors.Join(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "multipart/form-data":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		if err := r.ParseMultipartForm(s.cfg.MaxMultipartMemory); err != nil {
+			return req, close, errors.Wrap(err, "parse multipart form")
 		}
+		// Remove all temporary files created by ParseMultipartForm when the request is done.
+		//
+		// Notice that the closers are called in reverse order, to match defer behavior, so
+		// any opened file will be closed before RemoveAll call.
+		closers = append(closers, r.MultipartForm.RemoveAll)
+		// Form values may be unused.
+		form := url.Values(r.MultipartForm.Value)
+		_ = form
+
+		var request CloneTalkSessionReq
+		q := uri.NewQueryDecoder(form)
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "numeric",
+				Name:    "scheduledEndTime",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
@@ -411,23 +584,15 @@ func (s *Server) decodeDummyInitRequest(r *http.Request) (
 						return err
 					}
 
-					c, err := conv.ToFloat64(val)
+					c, err := conv.ToDateTime(val)
 					if err != nil {
 						return err
 					}
 
-					request.Numeric = c
+					request.ScheduledEndTime = c
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"numeric\"")
-				}
-				if err := func() error {
-					if err := (validate.Float{}).Validate(float64(request.Numeric)); err != nil {
-						return errors.Wrap(err, "float")
-					}
-					return nil
-				}(); err != nil {
-					return req, close, errors.Wrap(err, "validate")
+					return req, close, errors.Wrap(err, "decode \"scheduledEndTime\"")
 				}
 			} else {
 				return req, close, errors.Wrap(err, "query")
@@ -435,57 +600,34 @@ func (s *Server) decodeDummyInitRequest(r *http.Request) (
 		}
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "booleanOptionalNull",
+				Name:    "theme",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
 			if err := q.HasParam(cfg); err == nil {
 				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-					if err := func(d *jx.Decoder) error {
-						request.BooleanOptionalNull.Reset()
-						if err := request.BooleanOptionalNull.Decode(d); err != nil {
+					var requestDotThemeVal string
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
 							return err
 						}
-						return nil
-					}(jx.DecodeStr(val)); err != nil {
-						return err
-					}
-					return nil
-				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"booleanOptionalNull\"")
-				}
-			}
-		}
-		{
-			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "booleanNull",
-				Style:   uri.QueryStyleForm,
-				Explode: true,
-			}
-			if err := q.HasParam(cfg); err == nil {
-				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-					if err := func(d *jx.Decoder) error {
-						if err := request.BooleanNull.Decode(d); err != nil {
+
+						c, err := conv.ToString(val)
+						if err != nil {
 							return err
 						}
+
+						requestDotThemeVal = c
 						return nil
-					}(jx.DecodeStr(val)); err != nil {
+					}(); err != nil {
 						return err
 					}
+					request.Theme.SetTo(requestDotThemeVal)
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"booleanNull\"")
+					return req, close, errors.Wrap(err, "decode \"theme\"")
 				}
-			} else {
-				return req, close, errors.Wrap(err, "query")
 			}
 		}
 		return &request, close, nil
@@ -494,8 +636,8 @@ func (s *Server) decodeDummyInitRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeEditTalkSessionRequest(r *http.Request) (
-	req *EditTalkSessionReq,
+func (s *Server) decodeCloneTalkSessionTemplateRequest(r *http.Request) (
+	req *CloneTalkSessionTemplateReq,
 	close func() error,
 	rerr error,
 ) {
@@ -535,35 +677,8 @@ func (s *Server) decodeEditTalkSessionRequest(r *http.Request) (
 		form := url.Values(r.MultipartForm.Value)
 		_ = form
 
-		var request EditTalkSessionReq
+		var request CloneTalkSessionTemplateReq
 		q := uri.NewQueryDecoder(form)
-		{
-			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "theme",
-				Style:   uri.QueryStyleForm,
-				Explode: true,
-			}
-			if err := q.HasParam(cfg); err == nil {
-				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
-						return err
-					}
-
-					request.Theme = c
-					return nil
-				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"theme\"")
-				}
-			} else {
-				return req, close, errors.Wrap(err, "query")
-			}
-		}
 		{
 			cfg := uri.QueryParameterDecodingConfig{
 				Name:    "scheduledEndTime",
@@ -593,261 +708,44 @@ func (s *Server) decodeEditTalkSessionRequest(r *http.Request) (
 		}
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "latitude",
+				Name:    "theme",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
 			if err := q.HasParam(cfg); err == nil {
 				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-					var requestDotLatitudeVal float64
+					var requestDotThemeVal string
 					if err := func() error {
 						val, err := d.DecodeValue()
 						if err != nil {
 							return err
 						}
 
-						c, err := conv.ToFloat64(val)
+						c, err := conv.ToString(val)
 						if err != nil {
 							return err
 						}
 
-						requestDotLatitudeVal = c
+						requestDotThemeVal = c
 						return nil
 					}(); err != nil {
 						return err
 					}
-					request.Latitude.SetTo(requestDotLatitudeVal)
+					request.Theme.SetTo(requestDotThemeVal)
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"latitude\"")
-				}
-				if err := func() error {
-					if value, ok := request.Latitude.Get(); ok {
-						if err := func() error {
-							if err := (validate.Float{}).Validate(float64(value)); err != nil {
-								return errors.Wrap(err, "float")
-							}
-							return nil
-						}(); err != nil {
-							return err
-						}
-					}
-					return nil
-				}(); err != nil {
-					return req, close, errors.Wrap(err, "validate")
+					return req, close, errors.Wrap(err, "decode \"theme\"")
 				}
 			}
 		}
-		{
-			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "longitude",
-				Style:   uri.QueryStyleForm,
-				Explode: true,
-			}
-			if err := q.HasParam(cfg); err == nil {
-				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-					var requestDotLongitudeVal float64
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
-							return err
-						}
-
-						c, err := conv.ToFloat64(val)
-						if err != nil {
-							return err
-						}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
 
-						requestDotLongitudeVal = c
-						return nil
-					}(); err != nil {
-						return err
-					}
-					request.Longitude.SetTo(requestDotLongitudeVal)
-					return nil
-				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"longitude\"")
-				}
-				if err := func() error {
-					if value, ok := request.Longitude.Get(); ok {
-						if err := func() error {
-							if err := (validate.Float{}).Validate(float64(value)); err != nil {
-								return errors.Wrap(err, "float")
-							}
-							return nil
-						}(); err != nil {
-							return err
-						}
-					}
-					return nil
-				}(); err != nil {
-					return req, close, errors.Wrap(err, "validate")
-				}
-			}
-		}
-		{
-			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "prefecture",
-				Style:   uri.QueryStyleForm,
-				Explode: true,
-			}
-			if err := q.HasParam(cfg); err == nil {
-				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-					var requestDotPrefectureVal string
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
-							return err
-						}
-
-						c, err := conv.ToString(val)
-						if err != nil {
-							return err
-						}
-
-						requestDotPrefectureVal = c
-						return nil
-					}(); err != nil {
-						return err
-					}
-					request.Prefecture.SetTo(requestDotPrefectureVal)
-					return nil
-				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"prefecture\"")
-				}
-			}
-		}
-		{
-			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "city",
-				Style:   uri.QueryStyleForm,
-				Explode: true,
-			}
-			if err := q.HasParam(cfg); err == nil {
-				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-					var requestDotCityVal string
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
-							return err
-						}
-
-						c, err := conv.ToString(val)
-						if err != nil {
-							return err
-						}
-
-						requestDotCityVal = c
-						return nil
-					}(); err != nil {
-						return err
-					}
-					request.City.SetTo(requestDotCityVal)
-					return nil
-				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"city\"")
-				}
-			}
-		}
-		{
-			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "description",
-				Style:   uri.QueryStyleForm,
-				Explode: true,
-			}
-			if err := q.HasParam(cfg); err == nil {
-				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-					var requestDotDescriptionVal string
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
-							return err
-						}
-
-						c, err := conv.ToString(val)
-						if err != nil {
-							return err
-						}
-
-						requestDotDescriptionVal = c
-						return nil
-					}(); err != nil {
-						return err
-					}
-					request.Description.SetTo(requestDotDescriptionVal)
-					return nil
-				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"description\"")
-				}
-			}
-		}
-		{
-			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "thumbnailURL",
-				Style:   uri.QueryStyleForm,
-				Explode: true,
-			}
-			if err := q.HasParam(cfg); err == nil {
-				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-					var requestDotThumbnailURLVal string
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
-							return err
-						}
-
-						c, err := conv.ToString(val)
-						if err != nil {
-							return err
-						}
-
-						requestDotThumbnailURLVal = c
-						return nil
-					}(); err != nil {
-						return err
-					}
-					request.ThumbnailURL.SetTo(requestDotThumbnailURLVal)
-					return nil
-				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"thumbnailURL\"")
-				}
-			}
-		}
-		{
-			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "hideTop",
-				Style:   uri.QueryStyleForm,
-				Explode: true,
-			}
-			if err := q.HasParam(cfg); err == nil {
-				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-					if err := func(d *jx.Decoder) error {
-						request.HideTop.Reset()
-						if err := request.HideTop.Decode(d); err != nil {
-							return err
-						}
-						return nil
-					}(jx.DecodeStr(val)); err != nil {
-						return err
-					}
-					return nil
-				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"hideTop\"")
-				}
-			}
-		}
-		return &request, close, nil
-	default:
-		return req, close, validate.InvalidContentType(ct)
-	}
-}
-
-func (s *Server) decodeEditTimeLineRequest(r *http.Request) (
-	req *EditTimeLineReq,
+func (s *Server) decodeCreateOrganizationAliasRequest(r *http.Request) (
+	req *CreateOrganizationAliasReq,
 	close func() error,
 	rerr error,
 ) {
@@ -887,11 +785,11 @@ func (s *Server) decodeEditTimeLineRequest(r *http.Request) (
 		form := url.Values(r.MultipartForm.Value)
 		_ = form
 
-		var request EditTimeLineReq
+		var request CreateOrganizationAliasReq
 		q := uri.NewQueryDecoder(form)
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "content",
+				Name:    "aliasName",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
@@ -901,45 +799,16 @@ func (s *Server) decodeEditTimeLineRequest(r *http.Request) (
 					if err != nil {
 						return err
 					}
-					if err := func(d *jx.Decoder) error {
-						if err := request.Content.Decode(d); err != nil {
-							return err
-						}
-						return nil
-					}(jx.DecodeStr(val)); err != nil {
-						return err
-					}
-					return nil
-				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"content\"")
-				}
-			} else {
-				return req, close, errors.Wrap(err, "query")
-			}
-		}
-		{
-			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "status",
-				Style:   uri.QueryStyleForm,
-				Explode: true,
-			}
-			if err := q.HasParam(cfg); err == nil {
-				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-					val, err := d.DecodeValue()
+
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
-					if err := func(d *jx.Decoder) error {
-						if err := request.Status.Decode(d); err != nil {
-							return err
-						}
-						return nil
-					}(jx.DecodeStr(val)); err != nil {
-						return err
-					}
+
+					request.AliasName = c
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"status\"")
+					return req, close, errors.Wrap(err, "decode \"aliasName\"")
 				}
 			} else {
 				return req, close, errors.Wrap(err, "query")
@@ -951,8 +820,8 @@ func (s *Server) decodeEditTimeLineRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeEstablishOrganizationRequest(r *http.Request) (
-	req *EstablishOrganizationReq,
+func (s *Server) decodeCreateOrganizationApiKeyRequest(r *http.Request) (
+	req *CreateOrganizationApiKeyReq,
 	close func() error,
 	rerr error,
 ) {
@@ -992,7 +861,7 @@ func (s *Server) decodeEstablishOrganizationRequest(r *http.Request) (
 		form := url.Values(r.MultipartForm.Value)
 		_ = form
 
-		var request EstablishOrganizationReq
+		var request CreateOrganizationApiKeyReq
 		q := uri.NewQueryDecoder(form)
 		{
 			cfg := uri.QueryParameterDecodingConfig{
@@ -1023,7 +892,7 @@ func (s *Server) decodeEstablishOrganizationRequest(r *http.Request) (
 		}
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "code",
+				Name:    "scopes",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
@@ -1033,16 +902,35 @@ func (s *Server) decodeEstablishOrganizationRequest(r *http.Request) (
 					if err != nil {
 						return err
 					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
+					if err := func(d *jx.Decoder) error {
+						request.Scopes = make([]string, 0)
+						if err := d.Arr(func(d *jx.Decoder) error {
+							var elem string
+							v, err := d.Str()
+							elem = string(v)
+							if err != nil {
+								return err
+							}
+							request.Scopes = append(request.Scopes, elem)
+							return nil
+						}); err != nil {
+							return err
+						}
+						return nil
+					}(jx.DecodeStr(val)); err != nil {
 						return err
 					}
-
-					request.Code = c
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"code\"")
+					return req, close, errors.Wrap(err, "decode \"scopes\"")
+				}
+				if err := func() error {
+					if request.Scopes == nil {
+						return errors.New("nil is invalid value")
+					}
+					return nil
+				}(); err != nil {
+					return req, close, errors.Wrap(err, "validate")
 				}
 			} else {
 				return req, close, errors.Wrap(err, "query")
@@ -1050,61 +938,34 @@ func (s *Server) decodeEstablishOrganizationRequest(r *http.Request) (
 		}
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "orgType",
+				Name:    "expiresAt",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
 			if err := q.HasParam(cfg); err == nil {
 				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+					var requestDotExpiresAtVal time.Time
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
 
-					c, err := conv.ToFloat64(val)
-					if err != nil {
+						c, err := conv.ToDateTime(val)
+						if err != nil {
+							return err
+						}
+
+						requestDotExpiresAtVal = c
+						return nil
+					}(); err != nil {
 						return err
 					}
-
-					request.OrgType = c
+					request.ExpiresAt.SetTo(requestDotExpiresAtVal)
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"orgType\"")
-				}
-				if err := func() error {
-					if err := (validate.Float{}).Validate(float64(request.OrgType)); err != nil {
-						return errors.Wrap(err, "float")
-					}
-					return nil
-				}(); err != nil {
-					return req, close, errors.Wrap(err, "validate")
-				}
-			} else {
-				return req, close, errors.Wrap(err, "query")
-			}
-		}
-		{
-			if err := func() error {
-				files, ok := r.MultipartForm.File["icon"]
-				if !ok || len(files) < 1 {
-					return nil
-				}
-				fh := files[0]
-
-				f, err := fh.Open()
-				if err != nil {
-					return errors.Wrap(err, "open")
+					return req, close, errors.Wrap(err, "decode \"expiresAt\"")
 				}
-				closers = append(closers, f.Close)
-				request.Icon.SetTo(ht.MultipartFile{
-					Name:   fh.Filename,
-					File:   f,
-					Size:   fh.Size,
-					Header: fh.Header,
-				})
-				return nil
-			}(); err != nil {
-				return req, close, errors.Wrap(err, "decode \"icon\"")
 			}
 		}
 		return &request, close, nil
@@ -1113,8 +974,8 @@ func (s *Server) decodeEstablishOrganizationRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeEstablishUserRequest(r *http.Request) (
-	req *EstablishUserReq,
+func (s *Server) decodeCreateOrganizationInvitationRequest(r *http.Request) (
+	req *CreateOrganizationInvitationReq,
 	close func() error,
 	rerr error,
 ) {
@@ -1154,11 +1015,11 @@ func (s *Server) decodeEstablishUserRequest(r *http.Request) (
 		form := url.Values(r.MultipartForm.Value)
 		_ = form
 
-		var request EstablishUserReq
+		var request CreateOrganizationInvitationReq
 		q := uri.NewQueryDecoder(form)
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "displayName",
+				Name:    "email",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
@@ -1174,10 +1035,10 @@ func (s *Server) decodeEstablishUserRequest(r *http.Request) (
 						return err
 					}
 
-					request.DisplayName = c
+					request.Email = c
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"displayName\"")
+					return req, close, errors.Wrap(err, "decode \"email\"")
 				}
 			} else {
 				return req, close, errors.Wrap(err, "query")
@@ -1185,7 +1046,7 @@ func (s *Server) decodeEstablishUserRequest(r *http.Request) (
 		}
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "displayID",
+				Name:    "role",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
@@ -1196,52 +1057,132 @@ func (s *Server) decodeEstablishUserRequest(r *http.Request) (
 						return err
 					}
 
-					c, err := conv.ToString(val)
+					c, err := conv.ToFloat64(val)
 					if err != nil {
 						return err
 					}
 
-					request.DisplayID = c
+					request.Role = c
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"displayID\"")
+					return req, close, errors.Wrap(err, "decode \"role\"")
+				}
+				if err := func() error {
+					if err := (validate.Float{}).Validate(float64(request.Role)); err != nil {
+						return errors.Wrap(err, "float")
+					}
+					return nil
+				}(); err != nil {
+					return req, close, errors.Wrap(err, "validate")
 				}
 			} else {
 				return req, close, errors.Wrap(err, "query")
 			}
 		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodeDummyInitRequest(r *http.Request) (
+	req *DummyInitReq,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = errors.Join(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = errors.Join(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "multipart/form-data":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		if err := r.ParseMultipartForm(s.cfg.MaxMultipartMemory); err != nil {
+			return req, close, errors.Wrap(err, "parse multipart form")
+		}
+		// Remove all temporary files created by ParseMultipartForm when the request is done.
+		//
+		// Notice that the closers are called in reverse order, to match defer behavior, so
+		// any opened file will be closed before RemoveAll call.
+		closers = append(closers, r.MultipartForm.RemoveAll)
+		// Form values may be unused.
+		form := url.Values(r.MultipartForm.Value)
+		_ = form
+
+		var request DummyInitReq
+		q := uri.NewQueryDecoder(form)
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "dateOfBirth",
+				Name:    "integerNull",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
 			if err := q.HasParam(cfg); err == nil {
 				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-					var requestDotDateOfBirthVal float64
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+					if err := func(d *jx.Decoder) error {
+						if err := request.IntegerNull.Decode(d); err != nil {
 							return err
 						}
-
-						c, err := conv.ToFloat64(val)
-						if err != nil {
+						return nil
+					}(jx.DecodeStr(val)); err != nil {
+						return err
+					}
+					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"integerNull\"")
+				}
+			} else {
+				return req, close, errors.Wrap(err, "query")
+			}
+		}
+		{
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "numericOptionalNull",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+					if err := func(d *jx.Decoder) error {
+						request.NumericOptionalNull.Reset()
+						if err := request.NumericOptionalNull.Decode(d); err != nil {
 							return err
 						}
-
-						requestDotDateOfBirthVal = c
 						return nil
-					}(); err != nil {
+					}(jx.DecodeStr(val)); err != nil {
 						return err
 					}
-					request.DateOfBirth.SetTo(requestDotDateOfBirthVal)
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"dateOfBirth\"")
+					return req, close, errors.Wrap(err, "decode \"numericOptionalNull\"")
 				}
 				if err := func() error {
-					if value, ok := request.DateOfBirth.Get(); ok {
+					if value, ok := request.NumericOptionalNull.Get(); ok {
 						if err := func() error {
 							if err := (validate.Float{}).Validate(float64(value)); err != nil {
 								return errors.Wrap(err, "float")
@@ -1259,109 +1200,242 @@ func (s *Server) decodeEstablishUserRequest(r *http.Request) (
 		}
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "gender",
+				Name:    "numericNull",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
 			if err := q.HasParam(cfg); err == nil {
 				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-					var requestDotGenderVal string
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
-							return err
-						}
-
-						c, err := conv.ToString(val)
-						if err != nil {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+					if err := func(d *jx.Decoder) error {
+						if err := request.NumericNull.Decode(d); err != nil {
 							return err
 						}
-
-						requestDotGenderVal = c
 						return nil
-					}(); err != nil {
+					}(jx.DecodeStr(val)); err != nil {
 						return err
 					}
-					request.Gender.SetTo(requestDotGenderVal)
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"gender\"")
+					return req, close, errors.Wrap(err, "decode \"numericNull\"")
+				}
+				if err := func() error {
+					if value, ok := request.NumericNull.Get(); ok {
+						if err := func() error {
+							if err := (validate.Float{}).Validate(float64(value)); err != nil {
+								return errors.Wrap(err, "float")
+							}
+							return nil
+						}(); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return req, close, errors.Wrap(err, "validate")
 				}
+			} else {
+				return req, close, errors.Wrap(err, "query")
 			}
 		}
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "prefecture",
+				Name:    "numericOptional",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
 			if err := q.HasParam(cfg); err == nil {
 				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-					var requestDotPrefectureVal string
+					var requestDotNumericOptionalVal float64
 					if err := func() error {
 						val, err := d.DecodeValue()
 						if err != nil {
 							return err
 						}
 
-						c, err := conv.ToString(val)
+						c, err := conv.ToFloat64(val)
 						if err != nil {
 							return err
 						}
 
-						requestDotPrefectureVal = c
+						requestDotNumericOptionalVal = c
 						return nil
 					}(); err != nil {
 						return err
 					}
-					request.Prefecture.SetTo(requestDotPrefectureVal)
+					request.NumericOptional.SetTo(requestDotNumericOptionalVal)
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"prefecture\"")
+					return req, close, errors.Wrap(err, "decode \"numericOptional\"")
+				}
+				if err := func() error {
+					if value, ok := request.NumericOptional.Get(); ok {
+						if err := func() error {
+							if err := (validate.Float{}).Validate(float64(value)); err != nil {
+								return errors.Wrap(err, "float")
+							}
+							return nil
+						}(); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return req, close, errors.Wrap(err, "validate")
 				}
 			}
 		}
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "city",
+				Name:    "numeric",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
 			if err := q.HasParam(cfg); err == nil {
 				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-					var requestDotCityVal string
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
-							return err
-						}
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-						c, err := conv.ToString(val)
-						if err != nil {
+					c, err := conv.ToFloat64(val)
+					if err != nil {
+						return err
+					}
+
+					request.Numeric = c
+					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"numeric\"")
+				}
+				if err := func() error {
+					if err := (validate.Float{}).Validate(float64(request.Numeric)); err != nil {
+						return errors.Wrap(err, "float")
+					}
+					return nil
+				}(); err != nil {
+					return req, close, errors.Wrap(err, "validate")
+				}
+			} else {
+				return req, close, errors.Wrap(err, "query")
+			}
+		}
+		{
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "booleanOptionalNull",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+					if err := func(d *jx.Decoder) error {
+						request.BooleanOptionalNull.Reset()
+						if err := request.BooleanOptionalNull.Decode(d); err != nil {
 							return err
 						}
-
-						requestDotCityVal = c
 						return nil
-					}(); err != nil {
+					}(jx.DecodeStr(val)); err != nil {
 						return err
 					}
-					request.City.SetTo(requestDotCityVal)
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"city\"")
+					return req, close, errors.Wrap(err, "decode \"booleanOptionalNull\"")
 				}
 			}
 		}
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "email",
+				Name:    "booleanNull",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
 			if err := q.HasParam(cfg); err == nil {
 				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-					var requestDotEmailVal string
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+					if err := func(d *jx.Decoder) error {
+						if err := request.BooleanNull.Decode(d); err != nil {
+							return err
+						}
+						return nil
+					}(jx.DecodeStr(val)); err != nil {
+						return err
+					}
+					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"booleanNull\"")
+				}
+			} else {
+				return req, close, errors.Wrap(err, "query")
+			}
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodeEditOpinionRequest(r *http.Request) (
+	req *EditOpinionReq,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = errors.Join(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = errors.Join(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "multipart/form-data":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		if err := r.ParseMultipartForm(s.cfg.MaxMultipartMemory); err != nil {
+			return req, close, errors.Wrap(err, "parse multipart form")
+		}
+		// Remove all temporary files created by ParseMultipartForm when the request is done.
+		//
+		// Notice that the closers are called in reverse order, to match defer behavior, so
+		// any opened file will be closed before RemoveAll call.
+		closers = append(closers, r.MultipartForm.RemoveAll)
+		// Form values may be unused.
+		form := url.Values(r.MultipartForm.Value)
+		_ = form
+
+		var request EditOpinionReq
+		q := uri.NewQueryDecoder(form)
+		{
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "title",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					var requestDotTitleVal string
 					if err := func() error {
 						val, err := d.DecodeValue()
 						if err != nil {
@@ -1373,40 +1447,75 @@ func (s *Server) decodeEstablishUserRequest(r *http.Request) (
 							return err
 						}
 
-						requestDotEmailVal = c
+						requestDotTitleVal = c
 						return nil
 					}(); err != nil {
 						return err
 					}
-					request.Email.SetTo(requestDotEmailVal)
+					request.Title.SetTo(requestDotTitleVal)
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"email\"")
+					return req, close, errors.Wrap(err, "decode \"title\"")
 				}
 			}
 		}
 		{
-			if err := func() error {
-				files, ok := r.MultipartForm.File["icon"]
-				if !ok || len(files) < 1 {
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "opinionContent",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					request.OpinionContent = c
 					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"opinionContent\"")
 				}
-				fh := files[0]
+			} else {
+				return req, close, errors.Wrap(err, "query")
+			}
+		}
+		{
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "referenceURL",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					var requestDotReferenceURLVal string
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
 
-				f, err := fh.Open()
-				if err != nil {
-					return errors.Wrap(err, "open")
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						requestDotReferenceURLVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					request.ReferenceURL.SetTo(requestDotReferenceURLVal)
+					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"referenceURL\"")
 				}
-				closers = append(closers, f.Close)
-				request.Icon.SetTo(ht.MultipartFile{
-					Name:   fh.Filename,
-					File:   f,
-					Size:   fh.Size,
-					Header: fh.Header,
-				})
-				return nil
-			}(); err != nil {
-				return req, close, errors.Wrap(err, "decode \"icon\"")
 			}
 		}
 		return &request, close, nil
@@ -1415,8 +1524,8 @@ func (s *Server) decodeEstablishUserRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeInitiateTalkSessionRequest(r *http.Request) (
-	req *InitiateTalkSessionReq,
+func (s *Server) decodeEditTalkSessionRequest(r *http.Request) (
+	req *EditTalkSessionReq,
 	close func() error,
 	rerr error,
 ) {
@@ -1456,7 +1565,7 @@ func (s *Server) decodeInitiateTalkSessionRequest(r *http.Request) (
 		form := url.Values(r.MultipartForm.Value)
 		_ = form
 
-		var request InitiateTalkSessionReq
+		var request EditTalkSessionReq
 		q := uri.NewQueryDecoder(form)
 		{
 			cfg := uri.QueryParameterDecodingConfig{
@@ -1608,13 +1717,13 @@ func (s *Server) decodeInitiateTalkSessionRequest(r *http.Request) (
 		}
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "city",
+				Name:    "prefecture",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
 			if err := q.HasParam(cfg); err == nil {
 				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-					var requestDotCityVal string
+					var requestDotPrefectureVal string
 					if err := func() error {
 						val, err := d.DecodeValue()
 						if err != nil {
@@ -1626,27 +1735,27 @@ func (s *Server) decodeInitiateTalkSessionRequest(r *http.Request) (
 							return err
 						}
 
-						requestDotCityVal = c
+						requestDotPrefectureVal = c
 						return nil
 					}(); err != nil {
 						return err
 					}
-					request.City.SetTo(requestDotCityVal)
+					request.Prefecture.SetTo(requestDotPrefectureVal)
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"city\"")
+					return req, close, errors.Wrap(err, "decode \"prefecture\"")
 				}
 			}
 		}
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "prefecture",
+				Name:    "city",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
 			if err := q.HasParam(cfg); err == nil {
 				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-					var requestDotPrefectureVal string
+					var requestDotCityVal string
 					if err := func() error {
 						val, err := d.DecodeValue()
 						if err != nil {
@@ -1658,15 +1767,15 @@ func (s *Server) decodeInitiateTalkSessionRequest(r *http.Request) (
 							return err
 						}
 
-						requestDotPrefectureVal = c
+						requestDotCityVal = c
 						return nil
 					}(); err != nil {
 						return err
 					}
-					request.Prefecture.SetTo(requestDotPrefectureVal)
+					request.City.SetTo(requestDotCityVal)
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"prefecture\"")
+					return req, close, errors.Wrap(err, "decode \"city\"")
 				}
 			}
 		}
@@ -1736,7 +1845,7 @@ func (s *Server) decodeInitiateTalkSessionRequest(r *http.Request) (
 		}
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "restrictions",
+				Name:    "hideTop",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
@@ -1747,17 +1856,8 @@ func (s *Server) decodeInitiateTalkSessionRequest(r *http.Request) (
 						return err
 					}
 					if err := func(d *jx.Decoder) error {
-						request.Restrictions = make([]string, 0)
-						if err := d.Arr(func(d *jx.Decoder) error {
-							var elem string
-							v, err := d.Str()
-							elem = string(v)
-							if err != nil {
-								return err
-							}
-							request.Restrictions = append(request.Restrictions, elem)
-							return nil
-						}); err != nil {
+						request.HideTop.Reset()
+						if err := request.HideTop.Decode(d); err != nil {
 							return err
 						}
 						return nil
@@ -1766,66 +1866,7 @@ func (s *Server) decodeInitiateTalkSessionRequest(r *http.Request) (
 					}
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"restrictions\"")
-				}
-			}
-		}
-		{
-			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "aliasId",
-				Style:   uri.QueryStyleForm,
-				Explode: true,
-			}
-			if err := q.HasParam(cfg); err == nil {
-				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-					var requestDotAliasIdVal string
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
-							return err
-						}
-
-						c, err := conv.ToString(val)
-						if err != nil {
-							return err
-						}
-
-						requestDotAliasIdVal = c
-						return nil
-					}(); err != nil {
-						return err
-					}
-					request.AliasId.SetTo(requestDotAliasIdVal)
-					return nil
-				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"aliasId\"")
-				}
-			}
-		}
-		{
-			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "hideTop",
-				Style:   uri.QueryStyleForm,
-				Explode: true,
-			}
-			if err := q.HasParam(cfg); err == nil {
-				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-					if err := func(d *jx.Decoder) error {
-						request.HideTop.Reset()
-						if err := request.HideTop.Decode(d); err != nil {
-							return err
-						}
-						return nil
-					}(jx.DecodeStr(val)); err != nil {
-						return err
-					}
-					return nil
-				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"hideTop\"")
+					return req, close, errors.Wrap(err, "decode \"hideTop\"")
 				}
 			}
 		}
@@ -1835,8 +1876,8 @@ func (s *Server) decodeInitiateTalkSessionRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeInviteOrganizationRequest(r *http.Request) (
-	req *InviteOrganizationReq,
+func (s *Server) decodeEditTimeLineRequest(r *http.Request) (
+	req *EditTimeLineReq,
 	close func() error,
 	rerr error,
 ) {
@@ -1876,11 +1917,11 @@ func (s *Server) decodeInviteOrganizationRequest(r *http.Request) (
 		form := url.Values(r.MultipartForm.Value)
 		_ = form
 
-		var request InviteOrganizationReq
+		var request EditTimeLineReq
 		q := uri.NewQueryDecoder(form)
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "email",
+				Name:    "content",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
@@ -1890,16 +1931,17 @@ func (s *Server) decodeInviteOrganizationRequest(r *http.Request) (
 					if err != nil {
 						return err
 					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
+					if err := func(d *jx.Decoder) error {
+						if err := request.Content.Decode(d); err != nil {
+							return err
+						}
+						return nil
+					}(jx.DecodeStr(val)); err != nil {
 						return err
 					}
-
-					request.Email = c
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"email\"")
+					return req, close, errors.Wrap(err, "decode \"content\"")
 				}
 			} else {
 				return req, close, errors.Wrap(err, "query")
@@ -1907,7 +1949,7 @@ func (s *Server) decodeInviteOrganizationRequest(r *http.Request) (
 		}
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "role",
+				Name:    "status",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
@@ -1917,24 +1959,17 @@ func (s *Server) decodeInviteOrganizationRequest(r *http.Request) (
 					if err != nil {
 						return err
 					}
-
-					c, err := conv.ToFloat64(val)
-					if err != nil {
+					if err := func(d *jx.Decoder) error {
+						if err := request.Status.Decode(d); err != nil {
+							return err
+						}
+						return nil
+					}(jx.DecodeStr(val)); err != nil {
 						return err
 					}
-
-					request.Role = c
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"role\"")
-				}
-				if err := func() error {
-					if err := (validate.Float{}).Validate(float64(request.Role)); err != nil {
-						return errors.Wrap(err, "float")
-					}
-					return nil
-				}(); err != nil {
-					return req, close, errors.Wrap(err, "validate")
+					return req, close, errors.Wrap(err, "decode \"status\"")
 				}
 			} else {
 				return req, close, errors.Wrap(err, "query")
@@ -1946,8 +1981,8 @@ func (s *Server) decodeInviteOrganizationRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeInviteOrganizationForUserRequest(r *http.Request) (
-	req *InviteOrganizationForUserReq,
+func (s *Server) decodeEstablishOrganizationRequest(r *http.Request) (
+	req *EstablishOrganizationReq,
 	close func() error,
 	rerr error,
 ) {
@@ -1987,11 +2022,11 @@ func (s *Server) decodeInviteOrganizationForUserRequest(r *http.Request) (
 		form := url.Values(r.MultipartForm.Value)
 		_ = form
 
-		var request InviteOrganizationForUserReq
+		var request EstablishOrganizationReq
 		q := uri.NewQueryDecoder(form)
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "displayID",
+				Name:    "name",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
@@ -2007,10 +2042,10 @@ func (s *Server) decodeInviteOrganizationForUserRequest(r *http.Request) (
 						return err
 					}
 
-					request.DisplayID = c
+					request.Name = c
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"displayID\"")
+					return req, close, errors.Wrap(err, "decode \"name\"")
 				}
 			} else {
 				return req, close, errors.Wrap(err, "query")
@@ -2018,7 +2053,7 @@ func (s *Server) decodeInviteOrganizationForUserRequest(r *http.Request) (
 		}
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "role",
+				Name:    "code",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
@@ -2029,73 +2064,23 @@ func (s *Server) decodeInviteOrganizationForUserRequest(r *http.Request) (
 						return err
 					}
 
-					c, err := conv.ToFloat64(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					request.Role = c
+					request.Code = c
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"role\"")
-				}
-				if err := func() error {
-					if err := (validate.Float{}).Validate(float64(request.Role)); err != nil {
-						return errors.Wrap(err, "float")
-					}
-					return nil
-				}(); err != nil {
-					return req, close, errors.Wrap(err, "validate")
+					return req, close, errors.Wrap(err, "decode \"code\"")
 				}
 			} else {
 				return req, close, errors.Wrap(err, "query")
 			}
 		}
-		return &request, close, nil
-	default:
-		return req, close, validate.InvalidContentType(ct)
-	}
-}
-
-func (s *Server) decodeManageRegenerateManageRequest(r *http.Request) (
-	req *RegenerateRequest,
-	close func() error,
-	rerr error,
-) {
-	var closers []func() error
-	close = func() error {
-		var merr error
-		// Close in reverse order, to match defer behavior.
-		for i := len(closers) - 1; i >= 0; i-- {
-			c := closers[i]
-			merr = errors.Join(merr, c())
-		}
-		return merr
-	}
-	defer func() {
-		if rerr != nil {
-			rerr = errors.Join(rerr, close())
-		}
-	}()
-	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
-	if err != nil {
-		return req, close, errors.Wrap(err, "parse media type")
-	}
-	switch {
-	case ct == "application/x-www-form-urlencoded":
-		if r.ContentLength == 0 {
-			return req, close, validate.ErrBodyRequired
-		}
-		form, err := ht.ParseForm(r)
-		if err != nil {
-			return req, close, errors.Wrap(err, "parse form")
-		}
-
-		var request RegenerateRequest
-		q := uri.NewQueryDecoder(form)
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "type",
+				Name:    "orgType",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
@@ -2106,19 +2091,19 @@ func (s *Server) decodeManageRegenerateManageRequest(r *http.Request) (
 						return err
 					}
 
-					c, err := conv.ToString(val)
+					c, err := conv.ToFloat64(val)
 					if err != nil {
 						return err
 					}
 
-					request.Type = RegenerateRequestType(c)
+					request.OrgType = c
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"type\"")
+					return req, close, errors.Wrap(err, "decode \"orgType\"")
 				}
 				if err := func() error {
-					if err := request.Type.Validate(); err != nil {
-						return err
+					if err := (validate.Float{}).Validate(float64(request.OrgType)); err != nil {
+						return errors.Wrap(err, "float")
 					}
 					return nil
 				}(); err != nil {
@@ -2128,14 +2113,38 @@ func (s *Server) decodeManageRegenerateManageRequest(r *http.Request) (
 				return req, close, errors.Wrap(err, "query")
 			}
 		}
+		{
+			if err := func() error {
+				files, ok := r.MultipartForm.File["icon"]
+				if !ok || len(files) < 1 {
+					return nil
+				}
+				fh := files[0]
+
+				f, err := fh.Open()
+				if err != nil {
+					return errors.Wrap(err, "open")
+				}
+				closers = append(closers, f.Close)
+				request.Icon.SetTo(ht.MultipartFile{
+					Name:   fh.Filename,
+					File:   f,
+					Size:   fh.Size,
+					Header: fh.Header,
+				})
+				return nil
+			}(); err != nil {
+				return req, close, errors.Wrap(err, "decode \"icon\"")
+			}
+		}
 		return &request, close, nil
 	default:
 		return req, close, validate.InvalidContentType(ct)
 	}
 }
 
-func (s *Server) decodePasswordLoginRequest(r *http.Request) (
-	req *PasswordLoginReq,
+func (s *Server) decodeEstablishUserRequest(r *http.Request) (
+	req *EstablishUserReq,
 	close func() error,
 	rerr error,
 ) {
@@ -2175,11 +2184,11 @@ func (s *Server) decodePasswordLoginRequest(r *http.Request) (
 		form := url.Values(r.MultipartForm.Value)
 		_ = form
 
-		var request PasswordLoginReq
+		var request EstablishUserReq
 		q := uri.NewQueryDecoder(form)
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "idOrEmail",
+				Name:    "displayName",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
@@ -2195,10 +2204,10 @@ func (s *Server) decodePasswordLoginRequest(r *http.Request) (
 						return err
 					}
 
-					request.IdOrEmail = c
+					request.DisplayName = c
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"idOrEmail\"")
+					return req, close, errors.Wrap(err, "decode \"displayName\"")
 				}
 			} else {
 				return req, close, errors.Wrap(err, "query")
@@ -2206,7 +2215,7 @@ func (s *Server) decodePasswordLoginRequest(r *http.Request) (
 		}
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "password",
+				Name:    "displayID",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
@@ -2222,41 +2231,240 @@ func (s *Server) decodePasswordLoginRequest(r *http.Request) (
 						return err
 					}
 
-					request.Password = c
+					request.DisplayID = c
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"password\"")
+					return req, close, errors.Wrap(err, "decode \"displayID\"")
 				}
 			} else {
 				return req, close, errors.Wrap(err, "query")
 			}
 		}
-		return &request, close, nil
-	default:
-		return req, close, validate.InvalidContentType(ct)
-	}
-}
+		{
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "dateOfBirth",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					var requestDotDateOfBirthVal float64
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
 
-func (s *Server) decodePasswordRegisterRequest(r *http.Request) (
-	req *PasswordRegisterReq,
-	close func() error,
-	rerr error,
-) {
-	var closers []func() error
-	close = func() error {
-		var merr error
-		// Close in reverse order, to match defer behavior.
-		for i := len(closers) - 1; i >= 0; i-- {
-			c := closers[i]
-			merr = errors.Join(merr, c())
-		}
-		return merr
-	}
-	defer func() {
-		if rerr != nil {
-			rerr = errors.Join(rerr, close())
-		}
-	}()
+						c, err := conv.ToFloat64(val)
+						if err != nil {
+							return err
+						}
+
+						requestDotDateOfBirthVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					request.DateOfBirth.SetTo(requestDotDateOfBirthVal)
+					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"dateOfBirth\"")
+				}
+				if err := func() error {
+					if value, ok := request.DateOfBirth.Get(); ok {
+						if err := func() error {
+							if err := (validate.Float{}).Validate(float64(value)); err != nil {
+								return errors.Wrap(err, "float")
+							}
+							return nil
+						}(); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return req, close, errors.Wrap(err, "validate")
+				}
+			}
+		}
+		{
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "gender",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					var requestDotGenderVal string
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						requestDotGenderVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					request.Gender.SetTo(requestDotGenderVal)
+					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"gender\"")
+				}
+			}
+		}
+		{
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "prefecture",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					var requestDotPrefectureVal string
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						requestDotPrefectureVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					request.Prefecture.SetTo(requestDotPrefectureVal)
+					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"prefecture\"")
+				}
+			}
+		}
+		{
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "city",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					var requestDotCityVal string
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						requestDotCityVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					request.City.SetTo(requestDotCityVal)
+					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"city\"")
+				}
+			}
+		}
+		{
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "email",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					var requestDotEmailVal string
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						requestDotEmailVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					request.Email.SetTo(requestDotEmailVal)
+					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"email\"")
+				}
+			}
+		}
+		{
+			if err := func() error {
+				files, ok := r.MultipartForm.File["icon"]
+				if !ok || len(files) < 1 {
+					return nil
+				}
+				fh := files[0]
+
+				f, err := fh.Open()
+				if err != nil {
+					return errors.Wrap(err, "open")
+				}
+				closers = append(closers, f.Close)
+				request.Icon.SetTo(ht.MultipartFile{
+					Name:   fh.Filename,
+					File:   f,
+					Size:   fh.Size,
+					Header: fh.Header,
+				})
+				return nil
+			}(); err != nil {
+				return req, close, errors.Wrap(err, "decode \"icon\"")
+			}
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodeInitiateTalkSessionRequest(r *http.Request) (
+	req *InitiateTalkSessionReq,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = errors.Join(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = errors.Join(rerr, close())
+		}
+	}()
 	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
 	if err != nil {
 		return req, close, errors.Wrap(err, "parse media type")
@@ -2278,11 +2486,11 @@ func (s *Server) decodePasswordRegisterRequest(r *http.Request) (
 		form := url.Values(r.MultipartForm.Value)
 		_ = form
 
-		var request PasswordRegisterReq
+		var request InitiateTalkSessionReq
 		q := uri.NewQueryDecoder(form)
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "password",
+				Name:    "theme",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
@@ -2298,10 +2506,10 @@ func (s *Server) decodePasswordRegisterRequest(r *http.Request) (
 						return err
 					}
 
-					request.Password = c
+					request.Theme = c
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"password\"")
+					return req, close, errors.Wrap(err, "decode \"theme\"")
 				}
 			} else {
 				return req, close, errors.Wrap(err, "query")
@@ -2309,7 +2517,7 @@ func (s *Server) decodePasswordRegisterRequest(r *http.Request) (
 		}
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "email",
+				Name:    "scheduledEndTime",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
@@ -2320,28 +2528,1329 @@ func (s *Server) decodePasswordRegisterRequest(r *http.Request) (
 						return err
 					}
 
-					c, err := conv.ToString(val)
+					c, err := conv.ToDateTime(val)
 					if err != nil {
 						return err
 					}
 
-					request.Email = c
+					request.ScheduledEndTime = c
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"email\"")
+					return req, close, errors.Wrap(err, "decode \"scheduledEndTime\"")
 				}
 			} else {
 				return req, close, errors.Wrap(err, "query")
 			}
 		}
+		{
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "latitude",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					var requestDotLatitudeVal float64
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToFloat64(val)
+						if err != nil {
+							return err
+						}
+
+						requestDotLatitudeVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					request.Latitude.SetTo(requestDotLatitudeVal)
+					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"latitude\"")
+				}
+				if err := func() error {
+					if value, ok := request.Latitude.Get(); ok {
+						if err := func() error {
+							if err := (validate.Float{}).Validate(float64(value)); err != nil {
+								return errors.Wrap(err, "float")
+							}
+							return nil
+						}(); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return req, close, errors.Wrap(err, "validate")
+				}
+			}
+		}
+		{
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "longitude",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					var requestDotLongitudeVal float64
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToFloat64(val)
+						if err != nil {
+							return err
+						}
+
+						requestDotLongitudeVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					request.Longitude.SetTo(requestDotLongitudeVal)
+					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"longitude\"")
+				}
+				if err := func() error {
+					if value, ok := request.Longitude.Get(); ok {
+						if err := func() error {
+							if err := (validate.Float{}).Validate(float64(value)); err != nil {
+								return errors.Wrap(err, "float")
+							}
+							return nil
+						}(); err != nil {
+							return err
+						}
+					}
+					return nil
+				}(); err != nil {
+					return req, close, errors.Wrap(err, "validate")
+				}
+			}
+		}
+		{
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "city",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					var requestDotCityVal string
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						requestDotCityVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					request.City.SetTo(requestDotCityVal)
+					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"city\"")
+				}
+			}
+		}
+		{
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "prefecture",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					var requestDotPrefectureVal string
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						requestDotPrefectureVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					request.Prefecture.SetTo(requestDotPrefectureVal)
+					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"prefecture\"")
+				}
+			}
+		}
+		{
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "description",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					var requestDotDescriptionVal string
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						requestDotDescriptionVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					request.Description.SetTo(requestDotDescriptionVal)
+					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"description\"")
+				}
+			}
+		}
+		{
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "thumbnailURL",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					var requestDotThumbnailURLVal string
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						requestDotThumbnailURLVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					request.ThumbnailURL.SetTo(requestDotThumbnailURLVal)
+					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"thumbnailURL\"")
+				}
+			}
+		}
+		{
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "restrictions",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+					if err := func(d *jx.Decoder) error {
+						request.Restrictions = make([]string, 0)
+						if err := d.Arr(func(d *jx.Decoder) error {
+							var elem string
+							v, err := d.Str()
+							elem = string(v)
+							if err != nil {
+								return err
+							}
+							request.Restrictions = append(request.Restrictions, elem)
+							return nil
+						}); err != nil {
+							return err
+						}
+						return nil
+					}(jx.DecodeStr(val)); err != nil {
+						return err
+					}
+					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"restrictions\"")
+				}
+			}
+		}
+		{
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "aliasId",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					var requestDotAliasIdVal string
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						requestDotAliasIdVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					request.AliasId.SetTo(requestDotAliasIdVal)
+					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"aliasId\"")
+				}
+			}
+		}
+		{
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "hideTop",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+					if err := func(d *jx.Decoder) error {
+						request.HideTop.Reset()
+						if err := request.HideTop.Decode(d); err != nil {
+							return err
+						}
+						return nil
+					}(jx.DecodeStr(val)); err != nil {
+						return err
+					}
+					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"hideTop\"")
+				}
+			}
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodeInviteOrganizationRequest(r *http.Request) (
+	req *InviteOrganizationReq,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = errors.Join(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = errors.Join(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "multipart/form-data":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		if err := r.ParseMultipartForm(s.cfg.MaxMultipartMemory); err != nil {
+			return req, close, errors.Wrap(err, "parse multipart form")
+		}
+		// Remove all temporary files created by ParseMultipartForm when the request is done.
+		//
+		// Notice that the closers are called in reverse order, to match defer behavior, so
+		// any opened file will be closed before RemoveAll call.
+		closers = append(closers, r.MultipartForm.RemoveAll)
+		// Form values may be unused.
+		form := url.Values(r.MultipartForm.Value)
+		_ = form
+
+		var request InviteOrganizationReq
+		q := uri.NewQueryDecoder(form)
+		{
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "email",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					request.Email = c
+					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"email\"")
+				}
+			} else {
+				return req, close, errors.Wrap(err, "query")
+			}
+		}
+		{
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "role",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToFloat64(val)
+					if err != nil {
+						return err
+					}
+
+					request.Role = c
+					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"role\"")
+				}
+				if err := func() error {
+					if err := (validate.Float{}).Validate(float64(request.Role)); err != nil {
+						return errors.Wrap(err, "float")
+					}
+					return nil
+				}(); err != nil {
+					return req, close, errors.Wrap(err, "validate")
+				}
+			} else {
+				return req, close, errors.Wrap(err, "query")
+			}
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodeInviteOrganizationForUserRequest(r *http.Request) (
+	req *InviteOrganizationForUserReq,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = errors.Join(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = errors.Join(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "multipart/form-data":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		if err := r.ParseMultipartForm(s.cfg.MaxMultipartMemory); err != nil {
+			return req, close, errors.Wrap(err, "parse multipart form")
+		}
+		// Remove all temporary files created by ParseMultipartForm when the request is done.
+		//
+		// Notice that the closers are called in reverse order, to match defer behavior, so
+		// any opened file will be closed before RemoveAll call.
+		closers = append(closers, r.MultipartForm.RemoveAll)
+		// Form values may be unused.
+		form := url.Values(r.MultipartForm.Value)
+		_ = form
+
+		var request InviteOrganizationForUserReq
+		q := uri.NewQueryDecoder(form)
+		{
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "displayID",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					request.DisplayID = c
+					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"displayID\"")
+				}
+			} else {
+				return req, close, errors.Wrap(err, "query")
+			}
+		}
+		{
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "role",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToFloat64(val)
+					if err != nil {
+						return err
+					}
+
+					request.Role = c
+					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"role\"")
+				}
+				if err := func() error {
+					if err := (validate.Float{}).Validate(float64(request.Role)); err != nil {
+						return errors.Wrap(err, "float")
+					}
+					return nil
+				}(); err != nil {
+					return req, close, errors.Wrap(err, "validate")
+				}
+			} else {
+				return req, close, errors.Wrap(err, "query")
+			}
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodeManageRegenerateManageRequest(r *http.Request) (
+	req *RegenerateRequest,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = errors.Join(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = errors.Join(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "application/x-www-form-urlencoded":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		form, err := ht.ParseForm(r)
+		if err != nil {
+			return req, close, errors.Wrap(err, "parse form")
+		}
+
+		var request RegenerateRequest
+		q := uri.NewQueryDecoder(form)
+		{
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "type",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					request.Type = RegenerateRequestType(c)
+					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"type\"")
+				}
+				if err := func() error {
+					if err := request.Type.Validate(); err != nil {
+						return err
+					}
+					return nil
+				}(); err != nil {
+					return req, close, errors.Wrap(err, "validate")
+				}
+			} else {
+				return req, close, errors.Wrap(err, "query")
+			}
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodePasswordLoginRequest(r *http.Request) (
+	req *PasswordLoginReq,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = errors.Join(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = errors.Join(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "multipart/form-data":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		if err := r.ParseMultipartForm(s.cfg.MaxMultipartMemory); err != nil {
+			return req, close, errors.Wrap(err, "parse multipart form")
+		}
+		// Remove all temporary files created by ParseMultipartForm when the request is done.
+		//
+		// Notice that the closers are called in reverse order, to match defer behavior, so
+		// any opened file will be closed before RemoveAll call.
+		closers = append(closers, r.MultipartForm.RemoveAll)
+		// Form values may be unused.
+		form := url.Values(r.MultipartForm.Value)
+		_ = form
+
+		var request PasswordLoginReq
+		q := uri.NewQueryDecoder(form)
+		{
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "idOrEmail",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					request.IdOrEmail = c
+					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"idOrEmail\"")
+				}
+			} else {
+				return req, close, errors.Wrap(err, "query")
+			}
+		}
+		{
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "password",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					request.Password = c
+					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"password\"")
+				}
+			} else {
+				return req, close, errors.Wrap(err, "query")
+			}
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodePasswordRegisterRequest(r *http.Request) (
+	req *PasswordRegisterReq,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = errors.Join(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = errors.Join(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "multipart/form-data":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		if err := r.ParseMultipartForm(s.cfg.MaxMultipartMemory); err != nil {
+			return req, close, errors.Wrap(err, "parse multipart form")
+		}
+		// Remove all temporary files created by ParseMultipartForm when the request is done.
+		//
+		// Notice that the closers are called in reverse order, to match defer behavior, so
+		// any opened file will be closed before RemoveAll call.
+		closers = append(closers, r.MultipartForm.RemoveAll)
+		// Form values may be unused.
+		form := url.Values(r.MultipartForm.Value)
+		_ = form
+
+		var request PasswordRegisterReq
+		q := uri.NewQueryDecoder(form)
+		{
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "password",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					request.Password = c
+					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"password\"")
+				}
+			} else {
+				return req, close, errors.Wrap(err, "query")
+			}
+		}
+		{
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "email",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					request.Email = c
+					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"email\"")
+				}
+			} else {
+				return req, close, errors.Wrap(err, "query")
+			}
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodePolicyConsentRequest(r *http.Request) (
+	req *PolicyConsentReq,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = errors.Join(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = errors.Join(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "multipart/form-data":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		if err := r.ParseMultipartForm(s.cfg.MaxMultipartMemory); err != nil {
+			return req, close, errors.Wrap(err, "parse multipart form")
+		}
+		// Remove all temporary files created by ParseMultipartForm when the request is done.
+		//
+		// Notice that the closers are called in reverse order, to match defer behavior, so
+		// any opened file will be closed before RemoveAll call.
+		closers = append(closers, r.MultipartForm.RemoveAll)
+		// Form values may be unused.
+		form := url.Values(r.MultipartForm.Value)
+		_ = form
+
+		var request PolicyConsentReq
+		q := uri.NewQueryDecoder(form)
+		{
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "policyVersion",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					request.PolicyVersion = c
+					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"policyVersion\"")
+				}
+			} else {
+				return req, close, errors.Wrap(err, "query")
+			}
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodePostConclusionRequest(r *http.Request) (
+	req *PostConclusionReq,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = errors.Join(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = errors.Join(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "multipart/form-data":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		if err := r.ParseMultipartForm(s.cfg.MaxMultipartMemory); err != nil {
+			return req, close, errors.Wrap(err, "parse multipart form")
+		}
+		// Remove all temporary files created by ParseMultipartForm when the request is done.
+		//
+		// Notice that the closers are called in reverse order, to match defer behavior, so
+		// any opened file will be closed before RemoveAll call.
+		closers = append(closers, r.MultipartForm.RemoveAll)
+		// Form values may be unused.
+		form := url.Values(r.MultipartForm.Value)
+		_ = form
+
+		var request PostConclusionReq
+		q := uri.NewQueryDecoder(form)
+		{
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "content",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					request.Content = c
+					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"content\"")
+				}
+			} else {
+				return req, close, errors.Wrap(err, "query")
+			}
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodePostImageRequest(r *http.Request) (
+	req *PostImageReq,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = errors.Join(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = errors.Join(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "multipart/form-data":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		if err := r.ParseMultipartForm(s.cfg.MaxMultipartMemory); err != nil {
+			return req, close, errors.Wrap(err, "parse multipart form")
+		}
+		// Remove all temporary files created by ParseMultipartForm when the request is done.
+		//
+		// Notice that the closers are called in reverse order, to match defer behavior, so
+		// any opened file will be closed before RemoveAll call.
+		closers = append(closers, r.MultipartForm.RemoveAll)
+		// Form values may be unused.
+		form := url.Values(r.MultipartForm.Value)
+		_ = form
+
+		var request PostImageReq
+		{
+			if err := func() error {
+				files, ok := r.MultipartForm.File["image"]
+				if !ok || len(files) < 1 {
+					return validate.ErrFieldRequired
+				}
+				fh := files[0]
+
+				f, err := fh.Open()
+				if err != nil {
+					return errors.Wrap(err, "open")
+				}
+				closers = append(closers, f.Close)
+				request.Image = ht.MultipartFile{
+					Name:   fh.Filename,
+					File:   f,
+					Size:   fh.Size,
+					Header: fh.Header,
+				}
+				return nil
+			}(); err != nil {
+				return req, close, errors.Wrap(err, "decode \"image\"")
+			}
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodePostOpinionPost2Request(r *http.Request) (
+	req *PostOpinionPost2Req,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = errors.Join(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = errors.Join(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "multipart/form-data":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		if err := r.ParseMultipartForm(s.cfg.MaxMultipartMemory); err != nil {
+			return req, close, errors.Wrap(err, "parse multipart form")
+		}
+		// Remove all temporary files created by ParseMultipartForm when the request is done.
+		//
+		// Notice that the closers are called in reverse order, to match defer behavior, so
+		// any opened file will be closed before RemoveAll call.
+		closers = append(closers, r.MultipartForm.RemoveAll)
+		// Form values may be unused.
+		form := url.Values(r.MultipartForm.Value)
+		_ = form
+
+		var request PostOpinionPost2Req
+		q := uri.NewQueryDecoder(form)
+		{
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "talkSessionID",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					var requestDotTalkSessionIDVal string
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						requestDotTalkSessionIDVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					request.TalkSessionID.SetTo(requestDotTalkSessionIDVal)
+					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"talkSessionID\"")
+				}
+			}
+		}
+		{
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "parentOpinionID",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					var requestDotParentOpinionIDVal string
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						requestDotParentOpinionIDVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					request.ParentOpinionID.SetTo(requestDotParentOpinionIDVal)
+					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"parentOpinionID\"")
+				}
+			}
+		}
+		{
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "title",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					var requestDotTitleVal string
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						requestDotTitleVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					request.Title.SetTo(requestDotTitleVal)
+					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"title\"")
+				}
+			}
+		}
+		{
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "opinionContent",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+
+					c, err := conv.ToString(val)
+					if err != nil {
+						return err
+					}
+
+					request.OpinionContent = c
+					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"opinionContent\"")
+				}
+			} else {
+				return req, close, errors.Wrap(err, "query")
+			}
+		}
+		{
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "referenceURL",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					var requestDotReferenceURLVal string
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						requestDotReferenceURLVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					request.ReferenceURL.SetTo(requestDotReferenceURLVal)
+					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"referenceURL\"")
+				}
+			}
+		}
+		{
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "isSeed",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+					if err := func(d *jx.Decoder) error {
+						request.IsSeed.Reset()
+						if err := request.IsSeed.Decode(d); err != nil {
+							return err
+						}
+						return nil
+					}(jx.DecodeStr(val)); err != nil {
+						return err
+					}
+					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"isSeed\"")
+				}
+			}
+		}
+		{
+			if err := func() error {
+				files, ok := r.MultipartForm.File["picture"]
+				if !ok || len(files) < 1 {
+					return nil
+				}
+				fh := files[0]
+
+				f, err := fh.Open()
+				if err != nil {
+					return errors.Wrap(err, "open")
+				}
+				closers = append(closers, f.Close)
+				request.Picture.SetTo(ht.MultipartFile{
+					Name:   fh.Filename,
+					File:   f,
+					Size:   fh.Size,
+					Header: fh.Header,
+				})
+				return nil
+			}(); err != nil {
+				return req, close, errors.Wrap(err, "decode \"picture\"")
+			}
+		}
 		return &request, close, nil
 	default:
 		return req, close, validate.InvalidContentType(ct)
 	}
 }
 
-func (s *Server) decodePolicyConsentRequest(r *http.Request) (
-	req *PolicyConsentReq,
+func (s *Server) decodePostTimeLineItemRequest(r *http.Request) (
+	req *PostTimeLineItemReq,
 	close func() error,
 	rerr error,
 ) {
@@ -2381,11 +3890,11 @@ func (s *Server) decodePolicyConsentRequest(r *http.Request) (
 		form := url.Values(r.MultipartForm.Value)
 		_ = form
 
-		var request PolicyConsentReq
+		var request PostTimeLineItemReq
 		q := uri.NewQueryDecoder(form)
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "policyVersion",
+				Name:    "content",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
@@ -2401,67 +3910,18 @@ func (s *Server) decodePolicyConsentRequest(r *http.Request) (
 						return err
 					}
 
-					request.PolicyVersion = c
+					request.Content = c
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"policyVersion\"")
+					return req, close, errors.Wrap(err, "decode \"content\"")
 				}
 			} else {
 				return req, close, errors.Wrap(err, "query")
 			}
 		}
-		return &request, close, nil
-	default:
-		return req, close, validate.InvalidContentType(ct)
-	}
-}
-
-func (s *Server) decodePostConclusionRequest(r *http.Request) (
-	req *PostConclusionReq,
-	close func() error,
-	rerr error,
-) {
-	var closers []func() error
-	close = func() error {
-		var merr error
-		// Close in reverse order, to match defer behavior.
-		for i := len(closers) - 1; i >= 0; i-- {
-			c := closers[i]
-			merr = errors.Join(merr, c())
-		}
-		return merr
-	}
-	defer func() {
-		if rerr != nil {
-			rerr = errors.Join(rerr, close())
-		}
-	}()
-	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
-	if err != nil {
-		return req, close, errors.Wrap(err, "parse media type")
-	}
-	switch {
-	case ct == "multipart/form-data":
-		if r.ContentLength == 0 {
-			return req, close, validate.ErrBodyRequired
-		}
-		if err := r.ParseMultipartForm(s.cfg.MaxMultipartMemory); err != nil {
-			return req, close, errors.Wrap(err, "parse multipart form")
-		}
-		// Remove all temporary files created by ParseMultipartForm when the request is done.
-		//
-		// Notice that the closers are called in reverse order, to match defer behavior, so
-		// any opened file will be closed before RemoveAll call.
-		closers = append(closers, r.MultipartForm.RemoveAll)
-		// Form values may be unused.
-		form := url.Values(r.MultipartForm.Value)
-		_ = form
-
-		var request PostConclusionReq
-		q := uri.NewQueryDecoder(form)
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "content",
+				Name:    "status",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
@@ -2477,23 +3937,55 @@ func (s *Server) decodePostConclusionRequest(r *http.Request) (
 						return err
 					}
 
-					request.Content = c
+					request.Status = c
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"content\"")
+					return req, close, errors.Wrap(err, "decode \"status\"")
 				}
 			} else {
 				return req, close, errors.Wrap(err, "query")
 			}
 		}
+		{
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "parentActionItemID",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					var requestDotParentActionItemIDVal string
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						requestDotParentActionItemIDVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					request.ParentActionItemID.SetTo(requestDotParentActionItemIDVal)
+					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"parentActionItemID\"")
+				}
+			}
+		}
 		return &request, close, nil
 	default:
 		return req, close, validate.InvalidContentType(ct)
 	}
 }
 
-func (s *Server) decodePostImageRequest(r *http.Request) (
-	req *PostImageReq,
+func (s *Server) decodePublishReportVersionManageRequest(r *http.Request) (
+	req *PublishReportVersionRequest,
 	close func() error,
 	rerr error,
 ) {
@@ -2517,45 +4009,47 @@ func (s *Server) decodePostImageRequest(r *http.Request) (
 		return req, close, errors.Wrap(err, "parse media type")
 	}
 	switch {
-	case ct == "multipart/form-data":
+	case ct == "application/x-www-form-urlencoded":
 		if r.ContentLength == 0 {
 			return req, close, validate.ErrBodyRequired
 		}
-		if err := r.ParseMultipartForm(s.cfg.MaxMultipartMemory); err != nil {
-			return req, close, errors.Wrap(err, "parse multipart form")
+		form, err := ht.ParseForm(r)
+		if err != nil {
+			return req, close, errors.Wrap(err, "parse form")
 		}
-		// Remove all temporary files created by ParseMultipartForm when the request is done.
-		//
-		// Notice that the closers are called in reverse order, to match defer behavior, so
-		// any opened file will be closed before RemoveAll call.
-		closers = append(closers, r.MultipartForm.RemoveAll)
-		// Form values may be unused.
-		form := url.Values(r.MultipartForm.Value)
-		_ = form
 
-		var request PostImageReq
+		var request PublishReportVersionRequest
+		q := uri.NewQueryDecoder(form)
 		{
-			if err := func() error {
-				files, ok := r.MultipartForm.File["image"]
-				if !ok || len(files) < 1 {
-					return validate.ErrFieldRequired
-				}
-				fh := files[0]
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "versionID",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					var requestDotVersionIDVal string
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
 
-				f, err := fh.Open()
-				if err != nil {
-					return errors.Wrap(err, "open")
-				}
-				closers = append(closers, f.Close)
-				request.Image = ht.MultipartFile{
-					Name:   fh.Filename,
-					File:   f,
-					Size:   fh.Size,
-					Header: fh.Header,
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						requestDotVersionIDVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					request.VersionID.SetTo(requestDotVersionIDVal)
+					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"versionID\"")
 				}
-				return nil
-			}(); err != nil {
-				return req, close, errors.Wrap(err, "decode \"image\"")
 			}
 		}
 		return &request, close, nil
@@ -2564,8 +4058,8 @@ func (s *Server) decodePostImageRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodePostOpinionPost2Request(r *http.Request) (
-	req *PostOpinionPost2Req,
+func (s *Server) decodeRegisterDeviceRequest(r *http.Request) (
+	req *RegisterDeviceReq,
 	close func() error,
 	rerr error,
 ) {
@@ -2605,81 +4099,79 @@ func (s *Server) decodePostOpinionPost2Request(r *http.Request) (
 		form := url.Values(r.MultipartForm.Value)
 		_ = form
 
-		var request PostOpinionPost2Req
+		var request RegisterDeviceReq
 		q := uri.NewQueryDecoder(form)
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "talkSessionID",
+				Name:    "device_token",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
 			if err := q.HasParam(cfg); err == nil {
 				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-					var requestDotTalkSessionIDVal string
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
-							return err
-						}
-
-						c, err := conv.ToString(val)
-						if err != nil {
-							return err
-						}
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-						requestDotTalkSessionIDVal = c
-						return nil
-					}(); err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
 						return err
 					}
-					request.TalkSessionID.SetTo(requestDotTalkSessionIDVal)
+
+					request.DeviceToken = c
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"talkSessionID\"")
+					return req, close, errors.Wrap(err, "decode \"device_token\"")
 				}
+			} else {
+				return req, close, errors.Wrap(err, "query")
 			}
 		}
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "parentOpinionID",
+				Name:    "platform",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
 			if err := q.HasParam(cfg); err == nil {
 				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-					var requestDotParentOpinionIDVal string
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
-							return err
-						}
-
-						c, err := conv.ToString(val)
-						if err != nil {
-							return err
-						}
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-						requestDotParentOpinionIDVal = c
-						return nil
-					}(); err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
 						return err
 					}
-					request.ParentOpinionID.SetTo(requestDotParentOpinionIDVal)
+
+					request.Platform = RegisterDeviceReqPlatform(c)
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"parentOpinionID\"")
+					return req, close, errors.Wrap(err, "decode \"platform\"")
+				}
+				if err := func() error {
+					if err := request.Platform.Validate(); err != nil {
+						return err
+					}
+					return nil
+				}(); err != nil {
+					return req, close, errors.Wrap(err, "validate")
 				}
+			} else {
+				return req, close, errors.Wrap(err, "query")
 			}
 		}
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "title",
+				Name:    "device_name",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
 			if err := q.HasParam(cfg); err == nil {
 				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-					var requestDotTitleVal string
+					var requestDotDeviceNameVal string
 					if err := func() error {
 						val, err := d.DecodeValue()
 						if err != nil {
@@ -2691,54 +4183,59 @@ func (s *Server) decodePostOpinionPost2Request(r *http.Request) (
 							return err
 						}
 
-						requestDotTitleVal = c
+						requestDotDeviceNameVal = c
 						return nil
 					}(); err != nil {
 						return err
 					}
-					request.Title.SetTo(requestDotTitleVal)
+					request.DeviceName.SetTo(requestDotDeviceNameVal)
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"title\"")
+					return req, close, errors.Wrap(err, "decode \"device_name\"")
 				}
 			}
 		}
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "opinionContent",
+				Name:    "app_version",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
 			if err := q.HasParam(cfg); err == nil {
 				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
+					var requestDotAppVersionVal string
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
 
-					c, err := conv.ToString(val)
-					if err != nil {
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						requestDotAppVersionVal = c
+						return nil
+					}(); err != nil {
 						return err
 					}
-
-					request.OpinionContent = c
+					request.AppVersion.SetTo(requestDotAppVersionVal)
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"opinionContent\"")
+					return req, close, errors.Wrap(err, "decode \"app_version\"")
 				}
-			} else {
-				return req, close, errors.Wrap(err, "query")
 			}
 		}
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "referenceURL",
+				Name:    "os_version",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
 			if err := q.HasParam(cfg); err == nil {
 				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-					var requestDotReferenceURLVal string
+					var requestDotOsVersionVal string
 					if err := func() error {
 						val, err := d.DecodeValue()
 						if err != nil {
@@ -2750,21 +4247,70 @@ func (s *Server) decodePostOpinionPost2Request(r *http.Request) (
 							return err
 						}
 
-						requestDotReferenceURLVal = c
+						requestDotOsVersionVal = c
 						return nil
 					}(); err != nil {
 						return err
 					}
-					request.ReferenceURL.SetTo(requestDotReferenceURLVal)
+					request.OsVersion.SetTo(requestDotOsVersionVal)
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"referenceURL\"")
+					return req, close, errors.Wrap(err, "decode \"os_version\"")
 				}
 			}
 		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodeReportOpinionRequest(r *http.Request) (
+	req *ReportOpinionReq,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = errors.Join(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = errors.Join(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "multipart/form-data":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		if err := r.ParseMultipartForm(s.cfg.MaxMultipartMemory); err != nil {
+			return req, close, errors.Wrap(err, "parse multipart form")
+		}
+		// Remove all temporary files created by ParseMultipartForm when the request is done.
+		//
+		// Notice that the closers are called in reverse order, to match defer behavior, so
+		// any opened file will be closed before RemoveAll call.
+		closers = append(closers, r.MultipartForm.RemoveAll)
+		// Form values may be unused.
+		form := url.Values(r.MultipartForm.Value)
+		_ = form
+
+		var request ReportOpinionReq
+		q := uri.NewQueryDecoder(form)
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "isSeed",
+				Name:    "reason",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
@@ -2775,8 +4321,7 @@ func (s *Server) decodePostOpinionPost2Request(r *http.Request) (
 						return err
 					}
 					if err := func(d *jx.Decoder) error {
-						request.IsSeed.Reset()
-						if err := request.IsSeed.Decode(d); err != nil {
+						if err := request.Reason.Decode(d); err != nil {
 							return err
 						}
 						return nil
@@ -2785,32 +4330,38 @@ func (s *Server) decodePostOpinionPost2Request(r *http.Request) (
 					}
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"isSeed\"")
+					return req, close, errors.Wrap(err, "decode \"reason\"")
 				}
+			} else {
+				return req, close, errors.Wrap(err, "query")
 			}
 		}
 		{
-			if err := func() error {
-				files, ok := r.MultipartForm.File["picture"]
-				if !ok || len(files) < 1 {
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "content",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
+					if err := func(d *jx.Decoder) error {
+						if err := request.Content.Decode(d); err != nil {
+							return err
+						}
+						return nil
+					}(jx.DecodeStr(val)); err != nil {
+						return err
+					}
 					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"content\"")
 				}
-				fh := files[0]
-
-				f, err := fh.Open()
-				if err != nil {
-					return errors.Wrap(err, "open")
-				}
-				closers = append(closers, f.Close)
-				request.Picture.SetTo(ht.MultipartFile{
-					Name:   fh.Filename,
-					File:   f,
-					Size:   fh.Size,
-					Header: fh.Header,
-				})
-				return nil
-			}(); err != nil {
-				return req, close, errors.Wrap(err, "decode \"picture\"")
+			} else {
+				return req, close, errors.Wrap(err, "query")
 			}
 		}
 		return &request, close, nil
@@ -2819,8 +4370,8 @@ func (s *Server) decodePostOpinionPost2Request(r *http.Request) (
 	}
 }
 
-func (s *Server) decodePostTimeLineItemRequest(r *http.Request) (
-	req *PostTimeLineItemReq,
+func (s *Server) decodeRequestOrganizationOwnershipTransferRequest(r *http.Request) (
+	req *RequestOrganizationOwnershipTransferReq,
 	close func() error,
 	rerr error,
 ) {
@@ -2860,11 +4411,11 @@ func (s *Server) decodePostTimeLineItemRequest(r *http.Request) (
 		form := url.Values(r.MultipartForm.Value)
 		_ = form
 
-		var request PostTimeLineItemReq
+		var request RequestOrganizationOwnershipTransferReq
 		q := uri.NewQueryDecoder(form)
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "content",
+				Name:    "recipientDisplayID",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
@@ -2880,18 +4431,67 @@ func (s *Server) decodePostTimeLineItemRequest(r *http.Request) (
 						return err
 					}
 
-					request.Content = c
+					request.RecipientDisplayID = c
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"content\"")
+					return req, close, errors.Wrap(err, "decode \"recipientDisplayID\"")
 				}
 			} else {
 				return req, close, errors.Wrap(err, "query")
 			}
 		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodeRequestTalkSessionOwnershipTransferRequest(r *http.Request) (
+	req *RequestTalkSessionOwnershipTransferReq,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = errors.Join(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = errors.Join(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "multipart/form-data":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		if err := r.ParseMultipartForm(s.cfg.MaxMultipartMemory); err != nil {
+			return req, close, errors.Wrap(err, "parse multipart form")
+		}
+		// Remove all temporary files created by ParseMultipartForm when the request is done.
+		//
+		// Notice that the closers are called in reverse order, to match defer behavior, so
+		// any opened file will be closed before RemoveAll call.
+		closers = append(closers, r.MultipartForm.RemoveAll)
+		// Form values may be unused.
+		form := url.Values(r.MultipartForm.Value)
+		_ = form
+
+		var request RequestTalkSessionOwnershipTransferReq
+		q := uri.NewQueryDecoder(form)
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "status",
+				Name:    "recipientDisplayID",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
@@ -2907,45 +4507,89 @@ func (s *Server) decodePostTimeLineItemRequest(r *http.Request) (
 						return err
 					}
 
-					request.Status = c
+					request.RecipientDisplayID = c
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"status\"")
+					return req, close, errors.Wrap(err, "decode \"recipientDisplayID\"")
 				}
 			} else {
 				return req, close, errors.Wrap(err, "query")
 			}
 		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodeSaveTalkSessionTemplateRequest(r *http.Request) (
+	req *SaveTalkSessionTemplateReq,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = errors.Join(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = errors.Join(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "multipart/form-data":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		if err := r.ParseMultipartForm(s.cfg.MaxMultipartMemory); err != nil {
+			return req, close, errors.Wrap(err, "parse multipart form")
+		}
+		// Remove all temporary files created by ParseMultipartForm when the request is done.
+		//
+		// Notice that the closers are called in reverse order, to match defer behavior, so
+		// any opened file will be closed before RemoveAll call.
+		closers = append(closers, r.MultipartForm.RemoveAll)
+		// Form values may be unused.
+		form := url.Values(r.MultipartForm.Value)
+		_ = form
+
+		var request SaveTalkSessionTemplateReq
+		q := uri.NewQueryDecoder(form)
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "parentActionItemID",
+				Name:    "name",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
 			if err := q.HasParam(cfg); err == nil {
 				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-					var requestDotParentActionItemIDVal string
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
-							return err
-						}
-
-						c, err := conv.ToString(val)
-						if err != nil {
-							return err
-						}
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-						requestDotParentActionItemIDVal = c
-						return nil
-					}(); err != nil {
+					c, err := conv.ToString(val)
+					if err != nil {
 						return err
 					}
-					request.ParentActionItemID.SetTo(requestDotParentActionItemIDVal)
+
+					request.Name = c
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"parentActionItemID\"")
+					return req, close, errors.Wrap(err, "decode \"name\"")
 				}
+			} else {
+				return req, close, errors.Wrap(err, "query")
 			}
 		}
 		return &request, close, nil
@@ -2954,8 +4598,8 @@ func (s *Server) decodePostTimeLineItemRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeRegisterDeviceRequest(r *http.Request) (
-	req *RegisterDeviceReq,
+func (s *Server) decodeSendTestNotificationRequest(r *http.Request) (
+	req *SendTestNotificationReq,
 	close func() error,
 	rerr error,
 ) {
@@ -2995,11 +4639,11 @@ func (s *Server) decodeRegisterDeviceRequest(r *http.Request) (
 		form := url.Values(r.MultipartForm.Value)
 		_ = form
 
-		var request RegisterDeviceReq
+		var request SendTestNotificationReq
 		q := uri.NewQueryDecoder(form)
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "device_token",
+				Name:    "title",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
@@ -3015,10 +4659,10 @@ func (s *Server) decodeRegisterDeviceRequest(r *http.Request) (
 						return err
 					}
 
-					request.DeviceToken = c
+					request.Title = c
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"device_token\"")
+					return req, close, errors.Wrap(err, "decode \"title\"")
 				}
 			} else {
 				return req, close, errors.Wrap(err, "query")
@@ -3026,7 +4670,7 @@ func (s *Server) decodeRegisterDeviceRequest(r *http.Request) (
 		}
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "platform",
+				Name:    "body",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
@@ -3042,18 +4686,10 @@ func (s *Server) decodeRegisterDeviceRequest(r *http.Request) (
 						return err
 					}
 
-					request.Platform = RegisterDeviceReqPlatform(c)
+					request.Body = c
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"platform\"")
-				}
-				if err := func() error {
-					if err := request.Platform.Validate(); err != nil {
-						return err
-					}
-					return nil
-				}(); err != nil {
-					return req, close, errors.Wrap(err, "validate")
+					return req, close, errors.Wrap(err, "decode \"body\"")
 				}
 			} else {
 				return req, close, errors.Wrap(err, "query")
@@ -3061,77 +4697,13 @@ func (s *Server) decodeRegisterDeviceRequest(r *http.Request) (
 		}
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "device_name",
-				Style:   uri.QueryStyleForm,
-				Explode: true,
-			}
-			if err := q.HasParam(cfg); err == nil {
-				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-					var requestDotDeviceNameVal string
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
-							return err
-						}
-
-						c, err := conv.ToString(val)
-						if err != nil {
-							return err
-						}
-
-						requestDotDeviceNameVal = c
-						return nil
-					}(); err != nil {
-						return err
-					}
-					request.DeviceName.SetTo(requestDotDeviceNameVal)
-					return nil
-				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"device_name\"")
-				}
-			}
-		}
-		{
-			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "app_version",
-				Style:   uri.QueryStyleForm,
-				Explode: true,
-			}
-			if err := q.HasParam(cfg); err == nil {
-				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-					var requestDotAppVersionVal string
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
-							return err
-						}
-
-						c, err := conv.ToString(val)
-						if err != nil {
-							return err
-						}
-
-						requestDotAppVersionVal = c
-						return nil
-					}(); err != nil {
-						return err
-					}
-					request.AppVersion.SetTo(requestDotAppVersionVal)
-					return nil
-				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"app_version\"")
-				}
-			}
-		}
-		{
-			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "os_version",
+				Name:    "device_id",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
 			if err := q.HasParam(cfg); err == nil {
 				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-					var requestDotOsVersionVal string
+					var requestDotDeviceIDVal string
 					if err := func() error {
 						val, err := d.DecodeValue()
 						if err != nil {
@@ -3143,15 +4715,15 @@ func (s *Server) decodeRegisterDeviceRequest(r *http.Request) (
 							return err
 						}
 
-						requestDotOsVersionVal = c
+						requestDotDeviceIDVal = c
 						return nil
 					}(); err != nil {
 						return err
 					}
-					request.OsVersion.SetTo(requestDotOsVersionVal)
+					request.DeviceID.SetTo(requestDotDeviceIDVal)
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"os_version\"")
+					return req, close, errors.Wrap(err, "decode \"device_id\"")
 				}
 			}
 		}
@@ -3161,8 +4733,8 @@ func (s *Server) decodeRegisterDeviceRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeReportOpinionRequest(r *http.Request) (
-	req *ReportOpinionReq,
+func (s *Server) decodeSetTalkSessionCollaboratorRequest(r *http.Request) (
+	req *SetTalkSessionCollaboratorReq,
 	close func() error,
 	rerr error,
 ) {
@@ -3202,11 +4774,11 @@ func (s *Server) decodeReportOpinionRequest(r *http.Request) (
 		form := url.Values(r.MultipartForm.Value)
 		_ = form
 
-		var request ReportOpinionReq
+		var request SetTalkSessionCollaboratorReq
 		q := uri.NewQueryDecoder(form)
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "reason",
+				Name:    "role",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
@@ -3216,45 +4788,24 @@ func (s *Server) decodeReportOpinionRequest(r *http.Request) (
 					if err != nil {
 						return err
 					}
-					if err := func(d *jx.Decoder) error {
-						if err := request.Reason.Decode(d); err != nil {
-							return err
-						}
-						return nil
-					}(jx.DecodeStr(val)); err != nil {
+
+					c, err := conv.ToString(val)
+					if err != nil {
 						return err
 					}
+
+					request.Role = SetTalkSessionCollaboratorReqRole(c)
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"reason\"")
+					return req, close, errors.Wrap(err, "decode \"role\"")
 				}
-			} else {
-				return req, close, errors.Wrap(err, "query")
-			}
-		}
-		{
-			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "content",
-				Style:   uri.QueryStyleForm,
-				Explode: true,
-			}
-			if err := q.HasParam(cfg); err == nil {
-				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-					if err := func(d *jx.Decoder) error {
-						if err := request.Content.Decode(d); err != nil {
-							return err
-						}
-						return nil
-					}(jx.DecodeStr(val)); err != nil {
+				if err := func() error {
+					if err := request.Role.Validate(); err != nil {
 						return err
 					}
 					return nil
-				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"content\"")
+				}(); err != nil {
+					return req, close, errors.Wrap(err, "validate")
 				}
 			} else {
 				return req, close, errors.Wrap(err, "query")
@@ -3266,8 +4817,8 @@ func (s *Server) decodeReportOpinionRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeSendTestNotificationRequest(r *http.Request) (
-	req *SendTestNotificationReq,
+func (s *Server) decodeSolveOpinionReportRequest(r *http.Request) (
+	req *SolveOpinionReportReq,
 	close func() error,
 	rerr error,
 ) {
@@ -3307,11 +4858,11 @@ func (s *Server) decodeSendTestNotificationRequest(r *http.Request) (
 		form := url.Values(r.MultipartForm.Value)
 		_ = form
 
-		var request SendTestNotificationReq
+		var request SolveOpinionReportReq
 		q := uri.NewQueryDecoder(form)
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "title",
+				Name:    "action",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
@@ -3321,78 +4872,97 @@ func (s *Server) decodeSendTestNotificationRequest(r *http.Request) (
 					if err != nil {
 						return err
 					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
+					if err := func(d *jx.Decoder) error {
+						if err := request.Action.Decode(d); err != nil {
+							return err
+						}
+						return nil
+					}(jx.DecodeStr(val)); err != nil {
 						return err
 					}
-
-					request.Title = c
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"title\"")
+					return req, close, errors.Wrap(err, "decode \"action\"")
 				}
-			} else {
-				return req, close, errors.Wrap(err, "query")
-			}
-		}
-		{
-			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "body",
-				Style:   uri.QueryStyleForm,
-				Explode: true,
-			}
-			if err := q.HasParam(cfg); err == nil {
-				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-
-					c, err := conv.ToString(val)
-					if err != nil {
+				if err := func() error {
+					if err := request.Action.Validate(); err != nil {
 						return err
 					}
-
-					request.Body = c
 					return nil
-				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"body\"")
+				}(); err != nil {
+					return req, close, errors.Wrap(err, "validate")
 				}
 			} else {
 				return req, close, errors.Wrap(err, "query")
 			}
 		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodeToggleReportVisibilityManageRequest(r *http.Request) (
+	req *ToggleReportVisibilityRequest,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = errors.Join(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = errors.Join(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "application/x-www-form-urlencoded":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		form, err := ht.ParseForm(r)
+		if err != nil {
+			return req, close, errors.Wrap(err, "parse form")
+		}
+
+		var request ToggleReportVisibilityRequest
+		q := uri.NewQueryDecoder(form)
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "device_id",
+				Name:    "hidden",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
 			if err := q.HasParam(cfg); err == nil {
 				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-					var requestDotDeviceIDVal string
-					if err := func() error {
-						val, err := d.DecodeValue()
-						if err != nil {
-							return err
-						}
-
-						c, err := conv.ToString(val)
-						if err != nil {
-							return err
-						}
+					val, err := d.DecodeValue()
+					if err != nil {
+						return err
+					}
 
-						requestDotDeviceIDVal = c
-						return nil
-					}(); err != nil {
+					c, err := conv.ToBool(val)
+					if err != nil {
 						return err
 					}
-					request.DeviceID.SetTo(requestDotDeviceIDVal)
+
+					request.Hidden = c
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"device_id\"")
+					return req, close, errors.Wrap(err, "decode \"hidden\"")
 				}
+			} else {
+				return req, close, errors.Wrap(err, "query")
 			}
 		}
 		return &request, close, nil
@@ -3401,8 +4971,8 @@ func (s *Server) decodeSendTestNotificationRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeSolveOpinionReportRequest(r *http.Request) (
-	req *SolveOpinionReportReq,
+func (s *Server) decodeUpdateNotificationPreferencesRequest(r *http.Request) (
+	req *UpdateNotificationPreferencesReq,
 	close func() error,
 	rerr error,
 ) {
@@ -3442,11 +5012,11 @@ func (s *Server) decodeSolveOpinionReportRequest(r *http.Request) (
 		form := url.Values(r.MultipartForm.Value)
 		_ = form
 
-		var request SolveOpinionReportReq
+		var request UpdateNotificationPreferencesReq
 		q := uri.NewQueryDecoder(form)
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "action",
+				Name:    "push_notification_enabled",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
@@ -3457,7 +5027,7 @@ func (s *Server) decodeSolveOpinionReportRequest(r *http.Request) (
 						return err
 					}
 					if err := func(d *jx.Decoder) error {
-						if err := request.Action.Decode(d); err != nil {
+						if err := request.PushNotificationEnabled.Decode(d); err != nil {
 							return err
 						}
 						return nil
@@ -3466,15 +5036,7 @@ func (s *Server) decodeSolveOpinionReportRequest(r *http.Request) (
 					}
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"action\"")
-				}
-				if err := func() error {
-					if err := request.Action.Validate(); err != nil {
-						return err
-					}
-					return nil
-				}(); err != nil {
-					return req, close, errors.Wrap(err, "validate")
+					return req, close, errors.Wrap(err, "decode \"push_notification_enabled\"")
 				}
 			} else {
 				return req, close, errors.Wrap(err, "query")
@@ -3486,8 +5048,8 @@ func (s *Server) decodeSolveOpinionReportRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeToggleReportVisibilityManageRequest(r *http.Request) (
-	req *ToggleReportVisibilityRequest,
+func (s *Server) decodeUpdateOrganizationRequest(r *http.Request) (
+	req *UpdateOrganizationReq,
 	close func() error,
 	rerr error,
 ) {
@@ -3511,20 +5073,27 @@ func (s *Server) decodeToggleReportVisibilityManageRequest(r *http.Request) (
 		return req, close, errors.Wrap(err, "parse media type")
 	}
 	switch {
-	case ct == "application/x-www-form-urlencoded":
+	case ct == "multipart/form-data":
 		if r.ContentLength == 0 {
 			return req, close, validate.ErrBodyRequired
 		}
-		form, err := ht.ParseForm(r)
-		if err != nil {
-			return req, close, errors.Wrap(err, "parse form")
+		if err := r.ParseMultipartForm(s.cfg.MaxMultipartMemory); err != nil {
+			return req, close, errors.Wrap(err, "parse multipart form")
 		}
+		// Remove all temporary files created by ParseMultipartForm when the request is done.
+		//
+		// Notice that the closers are called in reverse order, to match defer behavior, so
+		// any opened file will be closed before RemoveAll call.
+		closers = append(closers, r.MultipartForm.RemoveAll)
+		// Form values may be unused.
+		form := url.Values(r.MultipartForm.Value)
+		_ = form
 
-		var request ToggleReportVisibilityRequest
+		var request UpdateOrganizationReq
 		q := uri.NewQueryDecoder(form)
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "hidden",
+				Name:    "name",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
@@ -3535,28 +5104,52 @@ func (s *Server) decodeToggleReportVisibilityManageRequest(r *http.Request) (
 						return err
 					}
 
-					c, err := conv.ToBool(val)
+					c, err := conv.ToString(val)
 					if err != nil {
 						return err
 					}
 
-					request.Hidden = c
+					request.Name = c
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"hidden\"")
+					return req, close, errors.Wrap(err, "decode \"name\"")
 				}
 			} else {
 				return req, close, errors.Wrap(err, "query")
 			}
 		}
+		{
+			if err := func() error {
+				files, ok := r.MultipartForm.File["icon"]
+				if !ok || len(files) < 1 {
+					return nil
+				}
+				fh := files[0]
+
+				f, err := fh.Open()
+				if err != nil {
+					return errors.Wrap(err, "open")
+				}
+				closers = append(closers, f.Close)
+				request.Icon.SetTo(ht.MultipartFile{
+					Name:   fh.Filename,
+					File:   f,
+					Size:   fh.Size,
+					Header: fh.Header,
+				})
+				return nil
+			}(); err != nil {
+				return req, close, errors.Wrap(err, "decode \"icon\"")
+			}
+		}
 		return &request, close, nil
 	default:
 		return req, close, validate.InvalidContentType(ct)
 	}
 }
 
-func (s *Server) decodeUpdateNotificationPreferencesRequest(r *http.Request) (
-	req *UpdateNotificationPreferencesReq,
+func (s *Server) decodeUpdateOrganizationParentRequest(r *http.Request) (
+	req *UpdateOrganizationParentReq,
 	close func() error,
 	rerr error,
 ) {
@@ -3596,34 +5189,38 @@ func (s *Server) decodeUpdateNotificationPreferencesRequest(r *http.Request) (
 		form := url.Values(r.MultipartForm.Value)
 		_ = form
 
-		var request UpdateNotificationPreferencesReq
+		var request UpdateOrganizationParentReq
 		q := uri.NewQueryDecoder(form)
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "push_notification_enabled",
+				Name:    "parentCode",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
 			if err := q.HasParam(cfg); err == nil {
 				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
-					val, err := d.DecodeValue()
-					if err != nil {
-						return err
-					}
-					if err := func(d *jx.Decoder) error {
-						if err := request.PushNotificationEnabled.Decode(d); err != nil {
+					var requestDotParentCodeVal string
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
 							return err
 						}
+
+						requestDotParentCodeVal = c
 						return nil
-					}(jx.DecodeStr(val)); err != nil {
+					}(); err != nil {
 						return err
 					}
+					request.ParentCode.SetTo(requestDotParentCodeVal)
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"push_notification_enabled\"")
+					return req, close, errors.Wrap(err, "decode \"parentCode\"")
 				}
-			} else {
-				return req, close, errors.Wrap(err, "query")
 			}
 		}
 		return &request, close, nil
@@ -3632,8 +5229,8 @@ func (s *Server) decodeUpdateNotificationPreferencesRequest(r *http.Request) (
 	}
 }
 
-func (s *Server) decodeUpdateOrganizationRequest(r *http.Request) (
-	req *UpdateOrganizationReq,
+func (s *Server) decodeUpdateOrganizationReferenceDemographicsRequest(r *http.Request) (
+	req *UpdateOrganizationReferenceDemographicsReq,
 	close func() error,
 	rerr error,
 ) {
@@ -3673,11 +5270,11 @@ func (s *Server) decodeUpdateOrganizationRequest(r *http.Request) (
 		form := url.Values(r.MultipartForm.Value)
 		_ = form
 
-		var request UpdateOrganizationReq
+		var request UpdateOrganizationReferenceDemographicsReq
 		q := uri.NewQueryDecoder(form)
 		{
 			cfg := uri.QueryParameterDecodingConfig{
-				Name:    "name",
+				Name:    "dimension",
 				Style:   uri.QueryStyleForm,
 				Explode: true,
 			}
@@ -3693,10 +5290,18 @@ func (s *Server) decodeUpdateOrganizationRequest(r *http.Request) (
 						return err
 					}
 
-					request.Name = c
+					request.Dimension = DemographicDimension(c)
 					return nil
 				}); err != nil {
-					return req, close, errors.Wrap(err, "decode \"name\"")
+					return req, close, errors.Wrap(err, "decode \"dimension\"")
+				}
+				if err := func() error {
+					if err := request.Dimension.Validate(); err != nil {
+						return err
+					}
+					return nil
+				}(); err != nil {
+					return req, close, errors.Wrap(err, "validate")
 				}
 			} else {
 				return req, close, errors.Wrap(err, "query")
@@ -3704,9 +5309,9 @@ func (s *Server) decodeUpdateOrganizationRequest(r *http.Request) (
 		}
 		{
 			if err := func() error {
-				files, ok := r.MultipartForm.File["icon"]
+				files, ok := r.MultipartForm.File["file"]
 				if !ok || len(files) < 1 {
-					return nil
+					return validate.ErrFieldRequired
 				}
 				fh := files[0]
 
@@ -3715,15 +5320,128 @@ func (s *Server) decodeUpdateOrganizationRequest(r *http.Request) (
 					return errors.Wrap(err, "open")
 				}
 				closers = append(closers, f.Close)
-				request.Icon.SetTo(ht.MultipartFile{
+				request.File = ht.MultipartFile{
 					Name:   fh.Filename,
 					File:   f,
 					Size:   fh.Size,
 					Header: fh.Header,
-				})
+				}
 				return nil
 			}(); err != nil {
-				return req, close, errors.Wrap(err, "decode \"icon\"")
+				return req, close, errors.Wrap(err, "decode \"file\"")
+			}
+		}
+		return &request, close, nil
+	default:
+		return req, close, validate.InvalidContentType(ct)
+	}
+}
+
+func (s *Server) decodeUpdateOrganizationReportPromptRequest(r *http.Request) (
+	req *UpdateOrganizationReportPromptReq,
+	close func() error,
+	rerr error,
+) {
+	var closers []func() error
+	close = func() error {
+		var merr error
+		// Close in reverse order, to match defer behavior.
+		for i := len(closers) - 1; i >= 0; i-- {
+			c := closers[i]
+			merr = errors.Join(merr, c())
+		}
+		return merr
+	}
+	defer func() {
+		if rerr != nil {
+			rerr = errors.Join(rerr, close())
+		}
+	}()
+	ct, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil {
+		return req, close, errors.Wrap(err, "parse media type")
+	}
+	switch {
+	case ct == "multipart/form-data":
+		if r.ContentLength == 0 {
+			return req, close, validate.ErrBodyRequired
+		}
+		if err := r.ParseMultipartForm(s.cfg.MaxMultipartMemory); err != nil {
+			return req, close, errors.Wrap(err, "parse multipart form")
+		}
+		// Remove all temporary files created by ParseMultipartForm when the request is done.
+		//
+		// Notice that the closers are called in reverse order, to match defer behavior, so
+		// any opened file will be closed before RemoveAll call.
+		closers = append(closers, r.MultipartForm.RemoveAll)
+		// Form values may be unused.
+		form := url.Values(r.MultipartForm.Value)
+		_ = form
+
+		var request UpdateOrganizationReportPromptReq
+		q := uri.NewQueryDecoder(form)
+		{
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "systemPrompt",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					var requestDotSystemPromptVal string
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						requestDotSystemPromptVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					request.SystemPrompt.SetTo(requestDotSystemPromptVal)
+					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"systemPrompt\"")
+				}
+			}
+		}
+		{
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "instructions",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					var requestDotInstructionsVal string
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToString(val)
+						if err != nil {
+							return err
+						}
+
+						requestDotInstructionsVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					request.Instructions.SetTo(requestDotInstructionsVal)
+					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"instructions\"")
+				}
 			}
 		}
 		return &request, close, nil
@@ -4090,6 +5808,38 @@ func (s *Server) decodeVote2Request(r *http.Request) (
 				return req, close, errors.Wrap(err, "query")
 			}
 		}
+		{
+			cfg := uri.QueryParameterDecodingConfig{
+				Name:    "isImportant",
+				Style:   uri.QueryStyleForm,
+				Explode: true,
+			}
+			if err := q.HasParam(cfg); err == nil {
+				if err := q.DecodeParam(cfg, func(d uri.Decoder) error {
+					var requestDotIsImportantVal bool
+					if err := func() error {
+						val, err := d.DecodeValue()
+						if err != nil {
+							return err
+						}
+
+						c, err := conv.ToBool(val)
+						if err != nil {
+							return err
+						}
+
+						requestDotIsImportantVal = c
+						return nil
+					}(); err != nil {
+						return err
+					}
+					request.IsImportant.SetTo(requestDotIsImportantVal)
+					return nil
+				}); err != nil {
+					return req, close, errors.Wrap(err, "decode \"isImportant\"")
+				}
+			}
+		}
 		return &request, close, nil
 	default:
 		return req, close, validate.InvalidContentType(ct)
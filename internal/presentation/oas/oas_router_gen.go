@@ -61,6 +61,26 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				break
 			}
 			switch elem[0] {
+			case '.': // Prefix: ".well-known/jwks.json"
+
+				if l := len(".well-known/jwks.json"); len(elem) >= l && elem[0:l] == ".well-known/jwks.json" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch r.Method {
+					case "GET":
+						s.handleGetJwksRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET")
+					}
+
+					return
+				}
+
 			case 'a': // Prefix: "auth/"
 
 				if l := len("auth/"); len(elem) >= l && elem[0:l] == "auth/" {
@@ -646,12 +666,20 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 						if len(elem) == 0 {
 							switch r.Method {
+							case "DELETE":
+								s.handleDeleteOpinionRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
 							case "GET":
 								s.handleGetOpinionDetail2Request([1]string{
 									args[0],
 								}, elemIsEscaped, w, r)
+							case "PUT":
+								s.handleEditOpinionRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
 							default:
-								s.notAllowed(w, r, "GET")
+								s.notAllowed(w, r, "DELETE,GET,PUT")
 							}
 
 							return
@@ -691,9 +719,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									return
 								}
 
-							case 'r': // Prefix: "rep"
+							case 'r': // Prefix: "re"
 
-								if l := len("rep"); len(elem) >= l && elem[0:l] == "rep" {
+								if l := len("re"); len(elem) >= l && elem[0:l] == "re" {
 									elem = elem[l:]
 								} else {
 									break
@@ -703,52 +731,43 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									break
 								}
 								switch elem[0] {
-								case 'l': // Prefix: "lies"
+								case 'p': // Prefix: "p"
 
-									if l := len("lies"); len(elem) >= l && elem[0:l] == "lies" {
+									if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
 										elem = elem[l:]
 									} else {
 										break
 									}
 
 									if len(elem) == 0 {
-										// Leaf node.
-										switch r.Method {
-										case "GET":
-											s.handleOpinionComments2Request([1]string{
-												args[0],
-											}, elemIsEscaped, w, r)
-										default:
-											s.notAllowed(w, r, "GET")
-										}
-
-										return
+										break
 									}
+									switch elem[0] {
+									case 'l': // Prefix: "lies"
 
-								case 'o': // Prefix: "ort"
+										if l := len("lies"); len(elem) >= l && elem[0:l] == "lies" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-									if l := len("ort"); len(elem) >= l && elem[0:l] == "ort" {
-										elem = elem[l:]
-									} else {
-										break
-									}
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleOpinionComments2Request([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
 
-									if len(elem) == 0 {
-										switch r.Method {
-										case "POST":
-											s.handleReportOpinionRequest([1]string{
-												args[0],
-											}, elemIsEscaped, w, r)
-										default:
-											s.notAllowed(w, r, "POST")
+											return
 										}
 
-										return
-									}
-									switch elem[0] {
-									case 's': // Prefix: "s"
+									case 'o': // Prefix: "ort"
 
-										if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+										if l := len("ort"); len(elem) >= l && elem[0:l] == "ort" {
 											elem = elem[l:]
 										} else {
 											break
@@ -756,41 +775,86 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 										if len(elem) == 0 {
 											switch r.Method {
-											case "GET":
-												s.handleGetOpinionReportsRequest([1]string{
+											case "POST":
+												s.handleReportOpinionRequest([1]string{
 													args[0],
 												}, elemIsEscaped, w, r)
 											default:
-												s.notAllowed(w, r, "GET")
+												s.notAllowed(w, r, "POST")
 											}
 
 											return
 										}
 										switch elem[0] {
-										case '/': // Prefix: "/solve"
+										case 's': // Prefix: "s"
 
-											if l := len("/solve"); len(elem) >= l && elem[0:l] == "/solve" {
+											if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
 												elem = elem[l:]
 											} else {
 												break
 											}
 
 											if len(elem) == 0 {
-												// Leaf node.
 												switch r.Method {
-												case "POST":
-													s.handleSolveOpinionReportRequest([1]string{
+												case "GET":
+													s.handleGetOpinionReportsRequest([1]string{
 														args[0],
 													}, elemIsEscaped, w, r)
 												default:
-													s.notAllowed(w, r, "POST")
+													s.notAllowed(w, r, "GET")
 												}
 
 												return
 											}
+											switch elem[0] {
+											case '/': // Prefix: "/solve"
+
+												if l := len("/solve"); len(elem) >= l && elem[0:l] == "/solve" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch r.Method {
+													case "POST":
+														s.handleSolveOpinionReportRequest([1]string{
+															args[0],
+														}, elemIsEscaped, w, r)
+													default:
+														s.notAllowed(w, r, "POST")
+													}
+
+													return
+												}
+
+											}
+
+										}
+
+									}
+
+								case 'v': // Prefix: "visions"
 
+									if l := len("visions"); len(elem) >= l && elem[0:l] == "visions" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetOpinionRevisionsRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
 										}
 
+										return
 									}
 
 								}
@@ -913,400 +977,484 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								break
 							}
 							switch elem[0] {
-							case 'a': // Prefix: "aliases"
+							case 'a': // Prefix: "a"
 								origElem := elem
-								if l := len("aliases"); len(elem) >= l && elem[0:l] == "aliases" {
+								if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
 								if len(elem) == 0 {
-									switch r.Method {
-									case "GET":
-										s.handleGetOrganizationAliasesRequest([0]string{}, elemIsEscaped, w, r)
-									case "POST":
-										s.handleCreateOrganizationAliasRequest([0]string{}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "GET,POST")
-									}
-
-									return
+									break
 								}
 								switch elem[0] {
-								case '/': // Prefix: "/"
+								case 'l': // Prefix: "liases"
 
-									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									if l := len("liases"); len(elem) >= l && elem[0:l] == "liases" {
 										elem = elem[l:]
 									} else {
 										break
 									}
 
-									// Param: "aliasID"
-									// Leaf parameter, slashes are prohibited
-									idx := strings.IndexByte(elem, '/')
-									if idx >= 0 {
+									if len(elem) == 0 {
+										switch r.Method {
+										case "GET":
+											s.handleGetOrganizationAliasesRequest([0]string{}, elemIsEscaped, w, r)
+										case "POST":
+											s.handleCreateOrganizationAliasRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET,POST")
+										}
+
+										return
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										// Param: "aliasID"
+										// Leaf parameter, slashes are prohibited
+										idx := strings.IndexByte(elem, '/')
+										if idx >= 0 {
+											break
+										}
+										args[0] = elem
+										elem = ""
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "DELETE":
+												s.handleDeleteOrganizationAliasRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "DELETE")
+											}
+
+											return
+										}
+
+									}
+
+								case 'p': // Prefix: "pi-keys"
+
+									if l := len("pi-keys"); len(elem) >= l && elem[0:l] == "pi-keys" {
+										elem = elem[l:]
+									} else {
 										break
 									}
-									args[0] = elem
-									elem = ""
 
 									if len(elem) == 0 {
-										// Leaf node.
 										switch r.Method {
-										case "DELETE":
-											s.handleDeleteOrganizationAliasRequest([1]string{
-												args[0],
-											}, elemIsEscaped, w, r)
+										case "GET":
+											s.handleGetOrganizationApiKeysRequest([0]string{}, elemIsEscaped, w, r)
+										case "POST":
+											s.handleCreateOrganizationApiKeyRequest([0]string{}, elemIsEscaped, w, r)
 										default:
-											s.notAllowed(w, r, "DELETE")
+											s.notAllowed(w, r, "GET,POST")
 										}
 
 										return
 									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
 
-								}
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-								elem = origElem
-							case 'i': // Prefix: "invite"
-								origElem := elem
-								if l := len("invite"); len(elem) >= l && elem[0:l] == "invite" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+										// Param: "apiKeyID"
+										// Leaf parameter, slashes are prohibited
+										idx := strings.IndexByte(elem, '/')
+										if idx >= 0 {
+											break
+										}
+										args[0] = elem
+										elem = ""
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "DELETE":
+												s.handleRevokeOrganizationApiKeyRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "DELETE")
+											}
+
+											return
+										}
 
-								if len(elem) == 0 {
-									switch r.Method {
-									case "POST":
-										s.handleInviteOrganizationRequest([0]string{}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "POST")
 									}
 
-									return
-								}
-								switch elem[0] {
-								case '_': // Prefix: "_user"
+								case 'u': // Prefix: "udit-logs"
 
-									if l := len("_user"); len(elem) >= l && elem[0:l] == "_user" {
+									if l := len("udit-logs"); len(elem) >= l && elem[0:l] == "udit-logs" {
 										elem = elem[l:]
 									} else {
 										break
 									}
 
 									if len(elem) == 0 {
-										// Leaf node.
 										switch r.Method {
-										case "POST":
-											s.handleInviteOrganizationForUserRequest([0]string{}, elemIsEscaped, w, r)
+										case "GET":
+											s.handleGetOrganizationAuditLogsRequest([0]string{}, elemIsEscaped, w, r)
 										default:
-											s.notAllowed(w, r, "POST")
+											s.notAllowed(w, r, "GET")
 										}
 
 										return
 									}
+									switch elem[0] {
+									case '/': // Prefix: "/csv"
 
-								}
+										if l := len("/csv"); len(elem) >= l && elem[0:l] == "/csv" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-								elem = origElem
-							case 's': // Prefix: "switch/"
-								origElem := elem
-								if l := len("switch/"); len(elem) >= l && elem[0:l] == "switch/" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleDownloadOrganizationAuditLogsRequest([0]string{}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
 
-								// Param: "code"
-								// Leaf parameter, slashes are prohibited
-								idx := strings.IndexByte(elem, '/')
-								if idx >= 0 {
-									break
-								}
-								args[0] = elem
-								elem = ""
+											return
+										}
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch r.Method {
-									case "POST":
-										s.handleSwitchOrganizationRequest([1]string{
-											args[0],
-										}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "POST")
 									}
 
-									return
 								}
 
 								elem = origElem
-							case 'u': // Prefix: "users"
+							case 'i': // Prefix: "invit"
 								origElem := elem
-								if l := len("users"); len(elem) >= l && elem[0:l] == "users" {
+								if l := len("invit"); len(elem) >= l && elem[0:l] == "invit" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
 								if len(elem) == 0 {
-									// Leaf node.
-									switch r.Method {
-									case "GET":
-										s.handleGetOrganizationUsersRequest([0]string{}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "GET")
+									break
+								}
+								switch elem[0] {
+								case 'a': // Prefix: "ations"
+
+									if l := len("ations"); len(elem) >= l && elem[0:l] == "ations" {
+										elem = elem[l:]
+									} else {
+										break
 									}
 
-									return
-								}
+									if len(elem) == 0 {
+										switch r.Method {
+										case "GET":
+											s.handleGetOrganizationInvitationsRequest([0]string{}, elemIsEscaped, w, r)
+										case "POST":
+											s.handleCreateOrganizationInvitationRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET,POST")
+										}
 
-								elem = origElem
-							}
-							// Param: "code"
-							// Leaf parameter, slashes are prohibited
-							idx := strings.IndexByte(elem, '/')
-							if idx >= 0 {
-								break
-							}
-							args[0] = elem
-							elem = ""
+										return
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "PUT":
-									s.handleUpdateOrganizationRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "PUT")
-								}
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-								return
-							}
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case 'a': // Prefix: "accept"
+											origElem := elem
+											if l := len("accept"); len(elem) >= l && elem[0:l] == "accept" {
+												elem = elem[l:]
+											} else {
+												break
+											}
 
-						}
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "POST":
+													s.handleAcceptOrganizationInvitationRequest([0]string{}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "POST")
+												}
 
-					}
+												return
+											}
 
-				}
+											elem = origElem
+										case 'b': // Prefix: "bulk"
+											origElem := elem
+											if l := len("bulk"); len(elem) >= l && elem[0:l] == "bulk" {
+												elem = elem[l:]
+											} else {
+												break
+											}
 
-			case 'p': // Prefix: "policy/consent"
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "POST":
+													s.handleBulkCreateOrganizationInvitationsRequest([0]string{}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "POST")
+												}
 
-				if l := len("policy/consent"); len(elem) >= l && elem[0:l] == "policy/consent" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+												return
+											}
 
-				if len(elem) == 0 {
-					// Leaf node.
-					switch r.Method {
-					case "GET":
-						s.handleGetPolicyConsentStatusRequest([0]string{}, elemIsEscaped, w, r)
-					case "POST":
-						s.handlePolicyConsentRequest([0]string{}, elemIsEscaped, w, r)
-					default:
-						s.notAllowed(w, r, "GET,POST")
-					}
+											elem = origElem
+										}
+										// Param: "invitationID"
+										// Match until "/"
+										idx := strings.IndexByte(elem, '/')
+										if idx < 0 {
+											idx = len(elem)
+										}
+										args[0] = elem[:idx]
+										elem = elem[idx:]
 
-					return
-				}
+										if len(elem) == 0 {
+											switch r.Method {
+											case "DELETE":
+												s.handleRevokeOrganizationInvitationRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "DELETE")
+											}
 
-			case 'r': // Prefix: "report/feedback"
+											return
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/resend"
 
-				if l := len("report/feedback"); len(elem) >= l && elem[0:l] == "report/feedback" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+											if l := len("/resend"); len(elem) >= l && elem[0:l] == "/resend" {
+												elem = elem[l:]
+											} else {
+												break
+											}
 
-				if len(elem) == 0 {
-					// Leaf node.
-					switch r.Method {
-					case "POST":
-						s.handleApplyFeedbackToReportRequest([0]string{}, elemIsEscaped, w, r)
-					default:
-						s.notAllowed(w, r, "POST")
-					}
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "POST":
+													s.handleResendOrganizationInvitationRequest([1]string{
+														args[0],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "POST")
+												}
 
-					return
-				}
+												return
+											}
 
-			case 't': // Prefix: "t"
+										}
 
-				if l := len("t"); len(elem) >= l && elem[0:l] == "t" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+									}
 
-				if len(elem) == 0 {
-					break
-				}
-				switch elem[0] {
-				case 'a': // Prefix: "alksessions"
+								case 'e': // Prefix: "e"
 
-					if l := len("alksessions"); len(elem) >= l && elem[0:l] == "alksessions" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+									if l := len("e"); len(elem) >= l && elem[0:l] == "e" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-					if len(elem) == 0 {
-						switch r.Method {
-						case "GET":
-							s.handleGetTalkSessionListRequest([0]string{}, elemIsEscaped, w, r)
-						case "POST":
-							s.handleInitiateTalkSessionRequest([0]string{}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "GET,POST")
-						}
+									if len(elem) == 0 {
+										switch r.Method {
+										case "POST":
+											s.handleInviteOrganizationRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
 
-						return
-					}
-					switch elem[0] {
-					case '/': // Prefix: "/"
+										return
+									}
+									switch elem[0] {
+									case '_': // Prefix: "_user"
 
-						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+										if l := len("_user"); len(elem) >= l && elem[0:l] == "_user" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-						if len(elem) == 0 {
-							break
-						}
-						switch elem[0] {
-						case 'h': // Prefix: "histories"
-							origElem := elem
-							if l := len("histories"); len(elem) >= l && elem[0:l] == "histories" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "POST":
+												s.handleInviteOrganizationForUserRequest([0]string{}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "POST")
+											}
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "GET":
-									s.handleSessionsHistoryRequest([0]string{}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "GET")
-								}
+											return
+										}
 
-								return
-							}
+									}
 
-							elem = origElem
-						case 'o': // Prefix: "opened"
-							origElem := elem
-							if l := len("opened"); len(elem) >= l && elem[0:l] == "opened" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+								}
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "GET":
-									s.handleGetOpenedTalkSessionRequest([0]string{}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "GET")
+								elem = origElem
+							case 's': // Prefix: "switch/"
+								origElem := elem
+								if l := len("switch/"); len(elem) >= l && elem[0:l] == "switch/" {
+									elem = elem[l:]
+								} else {
+									break
 								}
 
-								return
-							}
+								// Param: "code"
+								// Leaf parameter, slashes are prohibited
+								idx := strings.IndexByte(elem, '/')
+								if idx >= 0 {
+									break
+								}
+								args[0] = elem
+								elem = ""
 
-							elem = origElem
-						case 'r': // Prefix: "restrictions"
-							origElem := elem
-							if l := len("restrictions"); len(elem) >= l && elem[0:l] == "restrictions" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleSwitchOrganizationRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "GET":
-									s.handleGetTalkSessionRestrictionKeysRequest([0]string{}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "GET")
+									return
 								}
 
-								return
-							}
-
-							elem = origElem
-						}
-						// Param: "talkSessionID"
-						// Match until "/"
-						idx := strings.IndexByte(elem, '/')
-						if idx < 0 {
-							idx = len(elem)
-						}
-						args[0] = elem[:idx]
-						elem = elem[idx:]
-
-						if len(elem) == 0 {
-							switch r.Method {
-							case "GET":
-								s.handleGetTalkSessionDetailRequest([1]string{
-									args[0],
-								}, elemIsEscaped, w, r)
-							case "PUT":
-								s.handleEditTalkSessionRequest([1]string{
-									args[0],
-								}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "GET,PUT")
-							}
-
-							return
-						}
-						switch elem[0] {
-						case '/': // Prefix: "/"
-
-							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-								elem = elem[l:]
-							} else {
-								break
-							}
-
-							if len(elem) == 0 {
-								break
-							}
-							switch elem[0] {
-							case 'a': // Prefix: "analysis"
-
-								if l := len("analysis"); len(elem) >= l && elem[0:l] == "analysis" {
+								elem = origElem
+							case 'u': // Prefix: "users"
+								origElem := elem
+								if l := len("users"); len(elem) >= l && elem[0:l] == "users" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
 								if len(elem) == 0 {
-									// Leaf node.
 									switch r.Method {
 									case "GET":
-										s.handleTalkSessionAnalysisRequest([1]string{
-											args[0],
-										}, elemIsEscaped, w, r)
+										s.handleGetOrganizationUsersRequest([0]string{}, elemIsEscaped, w, r)
 									default:
 										s.notAllowed(w, r, "GET")
 									}
 
 									return
 								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "userID"
+									// Match until "/"
+									idx := strings.IndexByte(elem, '/')
+									if idx < 0 {
+										idx = len(elem)
+									}
+									args[0] = elem[:idx]
+									elem = elem[idx:]
+
+									if len(elem) == 0 {
+										switch r.Method {
+										case "DELETE":
+											s.handleRemoveOrganizationUserRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "DELETE")
+										}
+
+										return
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/role"
+
+										if l := len("/role"); len(elem) >= l && elem[0:l] == "/role" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "PUT":
+												s.handleChangeOrganizationUserRoleRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "PUT")
+											}
+
+											return
+										}
+
+									}
+
+								}
+
+								elem = origElem
+							}
+							// Param: "code"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "PUT":
+									s.handleUpdateOrganizationRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "PUT")
+								}
 
-							case 'c': // Prefix: "con"
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
 
-								if l := len("con"); len(elem) >= l && elem[0:l] == "con" {
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 									elem = elem[l:]
 								} else {
 									break
@@ -1316,9 +1464,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 									break
 								}
 								switch elem[0] {
-								case 'c': // Prefix: "clusion"
+								case 'c': // Prefix: "children"
 
-									if l := len("clusion"); len(elem) >= l && elem[0:l] == "clusion" {
+									if l := len("children"); len(elem) >= l && elem[0:l] == "children" {
 										elem = elem[l:]
 									} else {
 										break
@@ -1328,153 +1476,152 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										// Leaf node.
 										switch r.Method {
 										case "GET":
-											s.handleGetConclusionRequest([1]string{
-												args[0],
-											}, elemIsEscaped, w, r)
-										case "POST":
-											s.handlePostConclusionRequest([1]string{
+											s.handleGetOrganizationChildrenRequest([1]string{
 												args[0],
 											}, elemIsEscaped, w, r)
 										default:
-											s.notAllowed(w, r, "GET,POST")
+											s.notAllowed(w, r, "GET")
 										}
 
 										return
 									}
 
-								case 's': // Prefix: "sent"
+								case 'o': // Prefix: "ownership-transfers"
 
-									if l := len("sent"); len(elem) >= l && elem[0:l] == "sent" {
+									if l := len("ownership-transfers"); len(elem) >= l && elem[0:l] == "ownership-transfers" {
 										elem = elem[l:]
 									} else {
 										break
 									}
 
 									if len(elem) == 0 {
-										// Leaf node.
 										switch r.Method {
-										case "GET":
-											s.handleHasConsentRequest([1]string{
-												args[0],
-											}, elemIsEscaped, w, r)
 										case "POST":
-											s.handleConsentTalkSessionRequest([1]string{
+											s.handleRequestOrganizationOwnershipTransferRequest([1]string{
 												args[0],
 											}, elemIsEscaped, w, r)
 										default:
-											s.notAllowed(w, r, "GET,POST")
+											s.notAllowed(w, r, "POST")
 										}
 
 										return
 									}
+									switch elem[0] {
+									case '/': // Prefix: "/bulk"
 
-								}
+										if l := len("/bulk"); len(elem) >= l && elem[0:l] == "/bulk" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-							case 'o': // Prefix: "opinions"
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "POST":
+												s.handleBulkTransferTalkSessionsRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "POST")
+											}
 
-								if l := len("opinions"); len(elem) >= l && elem[0:l] == "opinions" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+											return
+										}
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch r.Method {
-									case "GET":
-										s.handleGetOpinionsForTalkSessionRequest([1]string{
-											args[0],
-										}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "GET")
 									}
 
-									return
-								}
-
-							case 'r': // Prefix: "re"
-
-								if l := len("re"); len(elem) >= l && elem[0:l] == "re" {
-									elem = elem[l:]
-								} else {
-									break
-								}
-
-								if len(elem) == 0 {
-									break
-								}
-								switch elem[0] {
-								case 'p': // Prefix: "port"
+								case 'p': // Prefix: "parent"
 
-									if l := len("port"); len(elem) >= l && elem[0:l] == "port" {
+									if l := len("parent"); len(elem) >= l && elem[0:l] == "parent" {
 										elem = elem[l:]
 									} else {
 										break
 									}
 
 									if len(elem) == 0 {
+										// Leaf node.
 										switch r.Method {
-										case "GET":
-											s.handleGetTalkSessionReportRequest([1]string{
+										case "PUT":
+											s.handleUpdateOrganizationParentRequest([1]string{
 												args[0],
 											}, elemIsEscaped, w, r)
 										default:
-											s.notAllowed(w, r, "GET")
+											s.notAllowed(w, r, "PUT")
 										}
 
 										return
 									}
+
+								case 'r': // Prefix: "re"
+
+									if l := len("re"); len(elem) >= l && elem[0:l] == "re" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
 									switch elem[0] {
-									case 's': // Prefix: "s"
+									case 'f': // Prefix: "ference-demographics"
 
-										if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+										if l := len("ference-demographics"); len(elem) >= l && elem[0:l] == "ference-demographics" {
 											elem = elem[l:]
 										} else {
 											break
 										}
 
 										if len(elem) == 0 {
+											// Leaf node.
 											switch r.Method {
 											case "GET":
-												s.handleGetReportsForTalkSessionRequest([1]string{
+												s.handleGetOrganizationReferenceDemographicsRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											case "PUT":
+												s.handleUpdateOrganizationReferenceDemographicsRequest([1]string{
 													args[0],
 												}, elemIsEscaped, w, r)
 											default:
-												s.notAllowed(w, r, "GET")
+												s.notAllowed(w, r, "GET,PUT")
 											}
 
 											return
 										}
-										switch elem[0] {
-										case '/': // Prefix: "/count"
 
-											if l := len("/count"); len(elem) >= l && elem[0:l] == "/count" {
-												elem = elem[l:]
-											} else {
-												break
-											}
+									case 'p': // Prefix: "port-prompt"
 
-											if len(elem) == 0 {
-												// Leaf node.
-												switch r.Method {
-												case "GET":
-													s.handleGetTalkSessionReportCountRequest([1]string{
-														args[0],
-													}, elemIsEscaped, w, r)
-												default:
-													s.notAllowed(w, r, "GET")
-												}
+										if l := len("port-prompt"); len(elem) >= l && elem[0:l] == "port-prompt" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-												return
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleGetOrganizationReportPromptRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											case "PUT":
+												s.handleUpdateOrganizationReportPromptRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET,PUT")
 											}
 
+											return
 										}
 
 									}
 
-								case 's': // Prefix: "strictions"
+								case 's': // Prefix: "stats"
 
-									if l := len("strictions"); len(elem) >= l && elem[0:l] == "strictions" {
+									if l := len("stats"); len(elem) >= l && elem[0:l] == "stats" {
 										elem = elem[l:]
 									} else {
 										break
@@ -1484,7 +1631,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										// Leaf node.
 										switch r.Method {
 										case "GET":
-											s.handleGetTalkSessionRestrictionSatisfiedRequest([1]string{
+											s.handleGetOrganizationStatsRequest([1]string{
 												args[0],
 											}, elemIsEscaped, w, r)
 										default:
@@ -1494,103 +1641,165 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										return
 									}
 
-								}
+								case 't': // Prefix: "talksession"
 
-							case 's': // Prefix: "swipe_opinions"
-
-								if l := len("swipe_opinions"); len(elem) >= l && elem[0:l] == "swipe_opinions" {
-									elem = elem[l:]
-								} else {
-									break
-								}
-
-								if len(elem) == 0 {
-									// Leaf node.
-									switch r.Method {
-									case "GET":
-										s.handleSwipeOpinionsRequest([1]string{
-											args[0],
-										}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "GET")
-									}
-
-									return
-								}
-
-							case 't': // Prefix: "timeline"
-
-								if l := len("timeline"); len(elem) >= l && elem[0:l] == "timeline" {
-									elem = elem[l:]
-								} else {
-									break
-								}
-
-								if len(elem) == 0 {
-									switch r.Method {
-									case "POST":
-										s.handlePostTimeLineItemRequest([1]string{
-											args[0],
-										}, elemIsEscaped, w, r)
-									default:
-										s.notAllowed(w, r, "POST")
-									}
-
-									return
-								}
-								switch elem[0] {
-								case 's': // Prefix: "s"
-
-									if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+									if l := len("talksession"); len(elem) >= l && elem[0:l] == "talksession" {
 										elem = elem[l:]
 									} else {
 										break
 									}
 
 									if len(elem) == 0 {
-										switch r.Method {
-										case "GET":
-											s.handleGetTimeLineRequest([1]string{
-												args[0],
-											}, elemIsEscaped, w, r)
-										default:
-											s.notAllowed(w, r, "GET")
-										}
-
-										return
+										break
 									}
 									switch elem[0] {
-									case '/': // Prefix: "/"
+									case '-': // Prefix: "-templates"
 
-										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										if l := len("-templates"); len(elem) >= l && elem[0:l] == "-templates" {
 											elem = elem[l:]
 										} else {
 											break
 										}
 
-										// Param: "actionItemID"
-										// Leaf parameter, slashes are prohibited
-										idx := strings.IndexByte(elem, '/')
-										if idx >= 0 {
+										if len(elem) == 0 {
+											switch r.Method {
+											case "GET":
+												s.handleGetTalkSessionTemplatesRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
+
+											return
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/"
+
+											if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											// Param: "templateID"
+											// Match until "/"
+											idx := strings.IndexByte(elem, '/')
+											if idx < 0 {
+												idx = len(elem)
+											}
+											args[1] = elem[:idx]
+											elem = elem[idx:]
+
+											if len(elem) == 0 {
+												switch r.Method {
+												case "DELETE":
+													s.handleDeleteTalkSessionTemplateRequest([2]string{
+														args[0],
+														args[1],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "DELETE")
+												}
+
+												return
+											}
+											switch elem[0] {
+											case '/': // Prefix: "/clone"
+
+												if l := len("/clone"); len(elem) >= l && elem[0:l] == "/clone" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch r.Method {
+													case "POST":
+														s.handleCloneTalkSessionTemplateRequest([2]string{
+															args[0],
+															args[1],
+														}, elemIsEscaped, w, r)
+													default:
+														s.notAllowed(w, r, "POST")
+													}
+
+													return
+												}
+
+											}
+
+										}
+
+									case 's': // Prefix: "s"
+
+										if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+											elem = elem[l:]
+										} else {
 											break
 										}
-										args[1] = elem
-										elem = ""
 
 										if len(elem) == 0 {
-											// Leaf node.
 											switch r.Method {
-											case "PUT":
-												s.handleEditTimeLineRequest([2]string{
+											case "GET":
+												s.handleGetOrganizationTalkSessionsRequest([1]string{
 													args[0],
-													args[1],
 												}, elemIsEscaped, w, r)
 											default:
-												s.notAllowed(w, r, "PUT")
+												s.notAllowed(w, r, "GET")
 											}
 
 											return
 										}
+										switch elem[0] {
+										case '/': // Prefix: "/"
+
+											if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											// Param: "talkSessionID"
+											// Match until "/"
+											idx := strings.IndexByte(elem, '/')
+											if idx < 0 {
+												idx = len(elem)
+											}
+											args[1] = elem[:idx]
+											elem = elem[idx:]
+
+											if len(elem) == 0 {
+												break
+											}
+											switch elem[0] {
+											case '/': // Prefix: "/representativeness"
+
+												if l := len("/representativeness"); len(elem) >= l && elem[0:l] == "/representativeness" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch r.Method {
+													case "GET":
+														s.handleGetTalkSessionRepresentativenessRequest([2]string{
+															args[0],
+															args[1],
+														}, elemIsEscaped, w, r)
+													default:
+														s.notAllowed(w, r, "GET")
+													}
+
+													return
+												}
+
+											}
+
+										}
 
 									}
 
@@ -1602,9 +1811,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 					}
 
-				case 'e': // Prefix: "est"
+				case 'w': // Prefix: "wnership-transfers"
 
-					if l := len("est"); len(elem) >= l && elem[0:l] == "est" {
+					if l := len("wnership-transfers"); len(elem) >= l && elem[0:l] == "wnership-transfers" {
 						elem = elem[l:]
 					} else {
 						break
@@ -1613,7 +1822,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					if len(elem) == 0 {
 						switch r.Method {
 						case "GET":
-							s.handleTestRequest([0]string{}, elemIsEscaped, w, r)
+							s.handleListOwnershipTransfersRequest([0]string{}, elemIsEscaped, w, r)
 						default:
 							s.notAllowed(w, r, "GET")
 						}
@@ -1621,136 +1830,158 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						return
 					}
 					switch elem[0] {
-					case '/': // Prefix: "/dummy"
+					case '/': // Prefix: "/"
 
-						if l := len("/dummy"); len(elem) >= l && elem[0:l] == "/dummy" {
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
+						// Param: "transferID"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
 						if len(elem) == 0 {
-							// Leaf node.
-							switch r.Method {
-							case "POST":
-								s.handleDummyInitRequest([0]string{}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "POST")
+							break
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'a': // Prefix: "accept"
+
+								if l := len("accept"); len(elem) >= l && elem[0:l] == "accept" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleAcceptOwnershipTransferRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							case 'c': // Prefix: "cancel"
+
+								if l := len("cancel"); len(elem) >= l && elem[0:l] == "cancel" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleCancelOwnershipTransferRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							case 'd': // Prefix: "decline"
+
+								if l := len("decline"); len(elem) >= l && elem[0:l] == "decline" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleDeclineOwnershipTransferRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
 							}
 
-							return
 						}
 
 					}
 
 				}
 
-			case 'u': // Prefix: "user"
+			case 'p': // Prefix: "policy/consent"
 
-				if l := len("user"); len(elem) >= l && elem[0:l] == "user" {
+				if l := len("policy/consent"); len(elem) >= l && elem[0:l] == "policy/consent" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
 				if len(elem) == 0 {
+					// Leaf node.
 					switch r.Method {
-					case "DELETE":
-						s.handleWithdrawUserRequest([0]string{}, elemIsEscaped, w, r)
 					case "GET":
-						s.handleGetUserInfoRequest([0]string{}, elemIsEscaped, w, r)
+						s.handleGetPolicyConsentStatusRequest([0]string{}, elemIsEscaped, w, r)
 					case "POST":
-						s.handleEstablishUserRequest([0]string{}, elemIsEscaped, w, r)
-					case "PUT":
-						s.handleUpdateUserProfileRequest([0]string{}, elemIsEscaped, w, r)
+						s.handlePolicyConsentRequest([0]string{}, elemIsEscaped, w, r)
 					default:
-						s.notAllowed(w, r, "DELETE,GET,POST,PUT")
+						s.notAllowed(w, r, "GET,POST")
 					}
 
 					return
 				}
-				switch elem[0] {
-				case '/': // Prefix: "/"
 
-					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+			case 'r': // Prefix: "report/feedback"
 
-					// Param: "displayID"
-					// Leaf parameter, slashes are prohibited
-					idx := strings.IndexByte(elem, '/')
-					if idx >= 0 {
-						break
-					}
-					args[0] = elem
-					elem = ""
-
-					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
-						case "GET":
-							s.handleGetUserByDisplayIDRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "GET")
-						}
-
-						return
-					}
-
-				case 's': // Prefix: "s/"
-
-					if l := len("s/"); len(elem) >= l && elem[0:l] == "s/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					// Param: "displayID"
-					// Match until "/"
-					idx := strings.IndexByte(elem, '/')
-					if idx < 0 {
-						idx = len(elem)
-					}
-					args[0] = elem[:idx]
-					elem = elem[idx:]
-
-					if len(elem) == 0 {
-						break
-					}
-					switch elem[0] {
-					case '/': // Prefix: "/talksessions"
-
-						if l := len("/talksessions"); len(elem) >= l && elem[0:l] == "/talksessions" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						if len(elem) == 0 {
-							// Leaf node.
-							switch r.Method {
-							case "GET":
-								s.handleGetUserTalkSessionsRequest([1]string{
-									args[0],
-								}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "GET")
-							}
-
-							return
-						}
+				if l := len("report/feedback"); len(elem) >= l && elem[0:l] == "report/feedback" {
+					elem = elem[l:]
+				} else {
+					break
+				}
 
+				if len(elem) == 0 {
+					// Leaf node.
+					switch r.Method {
+					case "POST":
+						s.handleApplyFeedbackToReportRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "POST")
 					}
 
+					return
 				}
 
-			case 'v': // Prefix: "v1/manage/"
+			case 't': // Prefix: "t"
 
-				if l := len("v1/manage/"); len(elem) >= l && elem[0:l] == "v1/manage/" {
+				if l := len("t"); len(elem) >= l && elem[0:l] == "t" {
 					elem = elem[l:]
 				} else {
 					break
@@ -1760,65 +1991,30 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					break
 				}
 				switch elem[0] {
-				case 't': // Prefix: "talksessions/"
+				case 'a': // Prefix: "alksessions"
 
-					if l := len("talksessions/"); len(elem) >= l && elem[0:l] == "talksessions/" {
+					if l := len("alksessions"); len(elem) >= l && elem[0:l] == "alksessions" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
-					if len(elem) == 0 {
-						break
-					}
-					switch elem[0] {
-					case 'l': // Prefix: "list"
-						origElem := elem
-						if l := len("list"); len(elem) >= l && elem[0:l] == "list" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						if len(elem) == 0 {
-							// Leaf node.
-							switch r.Method {
-							case "GET":
-								s.handleGetTalkSessionListManageRequest([0]string{}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "GET")
-							}
-
-							return
-						}
-
-						elem = origElem
-					}
-					// Param: "talkSessionID"
-					// Match until "/"
-					idx := strings.IndexByte(elem, '/')
-					if idx < 0 {
-						idx = len(elem)
-					}
-					args[0] = elem[:idx]
-					elem = elem[idx:]
-
 					if len(elem) == 0 {
 						switch r.Method {
 						case "GET":
-							s.handleGetTalkSessionManageRequest([1]string{
-								args[0],
-							}, elemIsEscaped, w, r)
+							s.handleGetTalkSessionListRequest([0]string{}, elemIsEscaped, w, r)
+						case "POST":
+							s.handleInitiateTalkSessionRequest([0]string{}, elemIsEscaped, w, r)
 						default:
-							s.notAllowed(w, r, "GET")
+							s.notAllowed(w, r, "GET,POST")
 						}
 
 						return
 					}
 					switch elem[0] {
-					case '/': // Prefix: "/analysis/re"
+					case '/': // Prefix: "/"
 
-						if l := len("/analysis/re"); len(elem) >= l && elem[0:l] == "/analysis/re" {
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 							elem = elem[l:]
 						} else {
 							break
@@ -1828,9 +2024,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							break
 						}
 						switch elem[0] {
-						case 'g': // Prefix: "generate"
-
-							if l := len("generate"); len(elem) >= l && elem[0:l] == "generate" {
+						case 'h': // Prefix: "histories"
+							origElem := elem
+							if l := len("histories"); len(elem) >= l && elem[0:l] == "histories" {
 								elem = elem[l:]
 							} else {
 								break
@@ -1839,20 +2035,19 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							if len(elem) == 0 {
 								// Leaf node.
 								switch r.Method {
-								case "POST":
-									s.handleManageRegenerateManageRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
+								case "GET":
+									s.handleSessionsHistoryRequest([0]string{}, elemIsEscaped, w, r)
 								default:
-									s.notAllowed(w, r, "POST")
+									s.notAllowed(w, r, "GET")
 								}
 
 								return
 							}
 
-						case 'p': // Prefix: "port"
-
-							if l := len("port"); len(elem) >= l && elem[0:l] == "port" {
+							elem = origElem
+						case 'o': // Prefix: "opened"
+							origElem := elem
+							if l := len("opened"); len(elem) >= l && elem[0:l] == "opened" {
 								elem = elem[l:]
 							} else {
 								break
@@ -1862,71 +2057,18 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								// Leaf node.
 								switch r.Method {
 								case "GET":
-									s.handleGetAnalysisReportManageRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
-								case "POST":
-									s.handleToggleReportVisibilityManageRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
+									s.handleGetOpenedTalkSessionRequest([0]string{}, elemIsEscaped, w, r)
 								default:
-									s.notAllowed(w, r, "GET,POST")
+									s.notAllowed(w, r, "GET")
 								}
 
 								return
 							}
 
-						}
-
-					}
-
-				case 'u': // Prefix: "users/"
-
-					if l := len("users/"); len(elem) >= l && elem[0:l] == "users/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					if len(elem) == 0 {
-						break
-					}
-					switch elem[0] {
-					case 'l': // Prefix: "list"
-
-						if l := len("list"); len(elem) >= l && elem[0:l] == "list" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						if len(elem) == 0 {
-							// Leaf node.
-							switch r.Method {
-							case "GET":
-								s.handleGetUserListManageRequest([0]string{}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "GET")
-							}
-
-							return
-						}
-
-					case 's': // Prefix: "stats/"
-
-						if l := len("stats/"); len(elem) >= l && elem[0:l] == "stats/" {
-							elem = elem[l:]
-						} else {
-							break
-						}
-
-						if len(elem) == 0 {
-							break
-						}
-						switch elem[0] {
-						case 'l': // Prefix: "list"
-
-							if l := len("list"); len(elem) >= l && elem[0:l] == "list" {
+							elem = origElem
+						case 'r': // Prefix: "restrictions"
+							origElem := elem
+							if l := len("restrictions"); len(elem) >= l && elem[0:l] == "restrictions" {
 								elem = elem[l:]
 							} else {
 								break
@@ -1936,7 +2078,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								// Leaf node.
 								switch r.Method {
 								case "GET":
-									s.handleGetUserStatsListManageRequest([0]string{}, elemIsEscaped, w, r)
+									s.handleGetTalkSessionRestrictionKeysRequest([0]string{}, elemIsEscaped, w, r)
 								default:
 									s.notAllowed(w, r, "GET")
 								}
@@ -1944,829 +2086,685 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								return
 							}
 
-						case 't': // Prefix: "total"
+							elem = origElem
+						}
+						// Param: "talkSessionID"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
 
-							if l := len("total"); len(elem) >= l && elem[0:l] == "total" {
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleGetTalkSessionDetailRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "PUT":
+								s.handleEditTalkSessionRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET,PUT")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "GET":
-									s.handleGetUserStatsTotalManageRequest([0]string{}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "GET")
-								}
-
-								return
+								break
 							}
+							switch elem[0] {
+							case 'a': // Prefix: "analysis"
 
-						}
-
-					}
-
-				}
+								if l := len("analysis"); len(elem) >= l && elem[0:l] == "analysis" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-			}
+								if len(elem) == 0 {
+									switch r.Method {
+									case "GET":
+										s.handleTalkSessionAnalysisRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
 
-		}
-	}
-	s.notFound(w, r)
-}
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
 
-// Route is route object.
-type Route struct {
-	name        string
-	summary     string
-	operationID string
-	pathPattern string
-	count       int
-	args        [2]string
-}
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-// Name returns ogen operation name.
-//
-// It is guaranteed to be unique and not empty.
-func (r Route) Name() string {
-	return r.name
-}
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'c': // Prefix: "consensus"
 
-// Summary returns OpenAPI summary.
-func (r Route) Summary() string {
-	return r.summary
-}
+										if l := len("consensus"); len(elem) >= l && elem[0:l] == "consensus" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-// OperationID returns OpenAPI operationId.
-func (r Route) OperationID() string {
-	return r.operationID
-}
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleGetConsensusRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
 
-// PathPattern returns OpenAPI path.
-func (r Route) PathPattern() string {
-	return r.pathPattern
-}
+											return
+										}
 
-// Args returns parsed arguments.
-func (r Route) Args() []string {
-	return r.args[:r.count]
-}
+									case 'j': // Prefix: "jobs/"
 
-// FindRoute finds Route for given method and path.
-//
-// Note: this method does not unescape path or handle reserved characters in path properly. Use FindPath instead.
-func (s *Server) FindRoute(method, path string) (Route, bool) {
-	return s.FindPath(method, &url.URL{Path: path})
-}
+										if l := len("jobs/"); len(elem) >= l && elem[0:l] == "jobs/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-// FindPath finds Route for given method and URL.
-func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
-	var (
-		elem = u.Path
-		args = r.args
-	)
-	if rawPath := u.RawPath; rawPath != "" {
-		if normalized, ok := uri.NormalizeEscapedPath(rawPath); ok {
-			elem = normalized
-		}
-		defer func() {
-			for i, arg := range r.args[:r.count] {
-				if unescaped, err := url.PathUnescape(arg); err == nil {
-					r.args[i] = unescaped
-				}
-			}
-		}()
-	}
+										// Param: "jobID"
+										// Leaf parameter, slashes are prohibited
+										idx := strings.IndexByte(elem, '/')
+										if idx >= 0 {
+											break
+										}
+										args[1] = elem
+										elem = ""
 
-	elem, ok := s.cutPrefix(elem)
-	if !ok {
-		return r, false
-	}
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleGetAnalysisJobRequest([2]string{
+													args[0],
+													args[1],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
 
-	// Static code generated router with unwrapped path search.
-	switch {
-	default:
-		if len(elem) == 0 {
-			break
-		}
-		switch elem[0] {
-		case '/': // Prefix: "/"
+											return
+										}
 
-			if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-				elem = elem[l:]
-			} else {
-				break
-			}
+									case 'm': // Prefix: "me"
 
-			if len(elem) == 0 {
-				break
-			}
-			switch elem[0] {
-			case 'a': // Prefix: "auth/"
+										if l := len("me"); len(elem) >= l && elem[0:l] == "me" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-				if l := len("auth/"); len(elem) >= l && elem[0:l] == "auth/" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleGetMyAnalysisPositionRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
 
-				if len(elem) == 0 {
-					break
-				}
-				switch elem[0] {
-				case 'd': // Prefix: "dev/"
-					origElem := elem
-					if l := len("dev/"); len(elem) >= l && elem[0:l] == "dev/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+											return
+										}
 
-					if len(elem) == 0 {
-						break
-					}
-					switch elem[0] {
-					case 'd': // Prefix: "detach"
+									case 's': // Prefix: "s"
 
-						if l := len("detach"); len(elem) >= l && elem[0:l] == "detach" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+										if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-						if len(elem) == 0 {
-							// Leaf node.
-							switch method {
-							case "DELETE":
-								r.name = AuthAccountDetachOperation
-								r.summary = "**開発用** 現在のアカウントを切り離す"
-								r.operationID = "authAccountDetach"
-								r.pathPattern = "/auth/dev/detach"
-								r.args = args
-								r.count = 0
-								return r, true
-							default:
-								return
-							}
-						}
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case 'n': // Prefix: "napshots"
 
-					case 'l': // Prefix: "login"
+											if l := len("napshots"); len(elem) >= l && elem[0:l] == "napshots" {
+												elem = elem[l:]
+											} else {
+												break
+											}
 
-						if l := len("login"); len(elem) >= l && elem[0:l] == "login" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+											if len(elem) == 0 {
+												switch r.Method {
+												case "GET":
+													s.handleGetAnalysisSnapshotsRequest([1]string{
+														args[0],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "GET")
+												}
 
-						if len(elem) == 0 {
-							// Leaf node.
-							switch method {
-							case "GET":
-								r.name = DevAuthorizeOperation
-								r.summary = "開発用登録/ログイン"
-								r.operationID = "devAuthorize"
-								r.pathPattern = "/auth/dev/login"
-								r.args = args
-								r.count = 0
-								return r, true
-							default:
-								return
-							}
-						}
+												return
+											}
+											switch elem[0] {
+											case '/': // Prefix: "/"
 
-					}
+												if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+													elem = elem[l:]
+												} else {
+													break
+												}
 
-					elem = origElem
-				case 'p': // Prefix: "password/"
-					origElem := elem
-					if l := len("password/"); len(elem) >= l && elem[0:l] == "password/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+												if len(elem) == 0 {
+													break
+												}
+												switch elem[0] {
+												case 'd': // Prefix: "diff"
+													origElem := elem
+													if l := len("diff"); len(elem) >= l && elem[0:l] == "diff" {
+														elem = elem[l:]
+													} else {
+														break
+													}
+
+													if len(elem) == 0 {
+														// Leaf node.
+														switch r.Method {
+														case "GET":
+															s.handleGetAnalysisSnapshotDiffRequest([1]string{
+																args[0],
+															}, elemIsEscaped, w, r)
+														default:
+															s.notAllowed(w, r, "GET")
+														}
+
+														return
+													}
+
+													elem = origElem
+												}
+												// Param: "snapshotID"
+												// Leaf parameter, slashes are prohibited
+												idx := strings.IndexByte(elem, '/')
+												if idx >= 0 {
+													break
+												}
+												args[1] = elem
+												elem = ""
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch r.Method {
+													case "GET":
+														s.handleGetAnalysisSnapshotRequest([2]string{
+															args[0],
+															args[1],
+														}, elemIsEscaped, w, r)
+													default:
+														s.notAllowed(w, r, "GET")
+													}
 
-					if len(elem) == 0 {
-						break
-					}
-					switch elem[0] {
-					case 'c': // Prefix: "change"
+													return
+												}
 
-						if l := len("change"); len(elem) >= l && elem[0:l] == "change" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+											}
 
-						if len(elem) == 0 {
-							// Leaf node.
-							switch method {
-							case "PUT":
-								r.name = ChangePasswordOperation
-								r.summary = "パスワード変更"
-								r.operationID = "changePassword"
-								r.pathPattern = "/auth/password/change"
-								r.args = args
-								r.count = 0
-								return r, true
-							default:
-								return
-							}
-						}
+										case 't': // Prefix: "tatus"
 
-					case 'l': // Prefix: "login"
+											if l := len("tatus"); len(elem) >= l && elem[0:l] == "tatus" {
+												elem = elem[l:]
+											} else {
+												break
+											}
 
-						if l := len("login"); len(elem) >= l && elem[0:l] == "login" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "GET":
+													s.handleGetAnalysisStatusRequest([1]string{
+														args[0],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "GET")
+												}
 
-						if len(elem) == 0 {
-							// Leaf node.
-							switch method {
-							case "POST":
-								r.name = PasswordLoginOperation
-								r.summary = "パスワードによるログイン"
-								r.operationID = "passwordLogin"
-								r.pathPattern = "/auth/password/login"
-								r.args = args
-								r.count = 0
-								return r, true
-							default:
-								return
-							}
-						}
+												return
+											}
 
-					case 'r': // Prefix: "register"
+										}
 
-						if l := len("register"); len(elem) >= l && elem[0:l] == "register" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+									case 't': // Prefix: "terms"
 
-						if len(elem) == 0 {
-							// Leaf node.
-							switch method {
-							case "POST":
-								r.name = PasswordRegisterOperation
-								r.summary = "パスワードによる登録（devのみ）"
-								r.operationID = "passwordRegister"
-								r.pathPattern = "/auth/password/register"
-								r.args = args
-								r.count = 0
-								return r, true
-							default:
-								return
-							}
-						}
+										if l := len("terms"); len(elem) >= l && elem[0:l] == "terms" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-					}
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleGetTermFrequenciesRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
 
-					elem = origElem
-				case 'r': // Prefix: "re"
-					origElem := elem
-					if l := len("re"); len(elem) >= l && elem[0:l] == "re" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+											return
+										}
 
-					if len(elem) == 0 {
-						break
-					}
-					switch elem[0] {
-					case 'a': // Prefix: "activate"
+									case 'v': // Prefix: "vote-shifts"
 
-						if l := len("activate"); len(elem) >= l && elem[0:l] == "activate" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+										if l := len("vote-shifts"); len(elem) >= l && elem[0:l] == "vote-shifts" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-						if len(elem) == 0 {
-							// Leaf node.
-							switch method {
-							case "POST":
-								r.name = ReactivateUserOperation
-								r.summary = "退会ユーザーの復活"
-								r.operationID = "reactivateUser"
-								r.pathPattern = "/auth/reactivate"
-								r.args = args
-								r.count = 0
-								return r, true
-							default:
-								return
-							}
-						}
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleGetVoteShiftsRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
 
-					case 'v': // Prefix: "voke"
+											return
+										}
 
-						if l := len("voke"); len(elem) >= l && elem[0:l] == "voke" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+									}
 
-						if len(elem) == 0 {
-							// Leaf node.
-							switch method {
-							case "POST":
-								r.name = RevokeTokenOperation
-								r.summary = "トークンを失効（ログアウト）"
-								r.operationID = "revokeToken"
-								r.pathPattern = "/auth/revoke"
-								r.args = args
-								r.count = 0
-								return r, true
-							default:
-								return
-							}
-						}
+								}
 
-					}
+							case 'c': // Prefix: "c"
 
-					elem = origElem
-				case 't': // Prefix: "token/info"
-					origElem := elem
-					if l := len("token/info"); len(elem) >= l && elem[0:l] == "token/info" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+								if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch method {
-						case "GET":
-							r.name = GetTokenInfoOperation
-							r.summary = "JWTの内容を返してくれる"
-							r.operationID = "getTokenInfo"
-							r.pathPattern = "/auth/token/info"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
-						}
-					}
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'l': // Prefix: "lone"
 
-					elem = origElem
-				}
-				// Param: "provider"
-				// Match until "/"
-				idx := strings.IndexByte(elem, '/')
-				if idx < 0 {
-					idx = len(elem)
-				}
-				args[0] = elem[:idx]
-				elem = elem[idx:]
+									if l := len("lone"); len(elem) >= l && elem[0:l] == "lone" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-				if len(elem) == 0 {
-					break
-				}
-				switch elem[0] {
-				case '/': // Prefix: "/"
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleCloneTalkSessionRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
 
-					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+										return
+									}
 
-					if len(elem) == 0 {
-						break
-					}
-					switch elem[0] {
-					case 'c': // Prefix: "callback"
+								case 'o': // Prefix: "o"
 
-						if l := len("callback"); len(elem) >= l && elem[0:l] == "callback" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+									if l := len("o"); len(elem) >= l && elem[0:l] == "o" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-						if len(elem) == 0 {
-							// Leaf node.
-							switch method {
-							case "GET":
-								r.name = HandleAuthCallbackOperation
-								r.summary = "Auth Callback"
-								r.operationID = "handleAuthCallback"
-								r.pathPattern = "/auth/{provider}/callback"
-								r.args = args
-								r.count = 1
-								return r, true
-							default:
-								return
-							}
-						}
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'l': // Prefix: "llaborators"
 
-					case 'l': // Prefix: "login"
+										if l := len("llaborators"); len(elem) >= l && elem[0:l] == "llaborators" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-						if l := len("login"); len(elem) >= l && elem[0:l] == "login" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+										if len(elem) == 0 {
+											switch r.Method {
+											case "GET":
+												s.handleGetTalkSessionCollaboratorsRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
 
-						if len(elem) == 0 {
-							// Leaf node.
-							switch method {
-							case "GET":
-								r.name = AuthorizeOperation
-								r.summary = "ログイン"
-								r.operationID = "authorize"
-								r.pathPattern = "/auth/{provider}/login"
-								r.args = args
-								r.count = 1
-								return r, true
-							default:
-								return
-							}
-						}
+											return
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/"
 
-					}
+											if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+												elem = elem[l:]
+											} else {
+												break
+											}
 
-				}
+											// Param: "displayID"
+											// Leaf parameter, slashes are prohibited
+											idx := strings.IndexByte(elem, '/')
+											if idx >= 0 {
+												break
+											}
+											args[1] = elem
+											elem = ""
 
-			case 'h': // Prefix: "health"
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "DELETE":
+													s.handleRemoveTalkSessionCollaboratorRequest([2]string{
+														args[0],
+														args[1],
+													}, elemIsEscaped, w, r)
+												case "PUT":
+													s.handleSetTalkSessionCollaboratorRequest([2]string{
+														args[0],
+														args[1],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "DELETE,PUT")
+												}
 
-				if l := len("health"); len(elem) >= l && elem[0:l] == "health" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+												return
+											}
 
-				if len(elem) == 0 {
-					// Leaf node.
-					switch method {
-					case "GET":
-						r.name = HealthOperation
-						r.summary = "ヘルスチェック"
-						r.operationID = "health"
-						r.pathPattern = "/health"
-						r.args = args
-						r.count = 0
-						return r, true
-					default:
-						return
-					}
-				}
+										}
 
-			case 'i': // Prefix: "images"
+									case 'n': // Prefix: "n"
 
-				if l := len("images"); len(elem) >= l && elem[0:l] == "images" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+										if l := len("n"); len(elem) >= l && elem[0:l] == "n" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-				if len(elem) == 0 {
-					// Leaf node.
-					switch method {
-					case "POST":
-						r.name = PostImageOperation
-						r.summary = "画像投稿"
-						r.operationID = "postImage"
-						r.pathPattern = "/images"
-						r.args = args
-						r.count = 0
-						return r, true
-					default:
-						return
-					}
-				}
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case 'c': // Prefix: "clusion"
 
-			case 'n': // Prefix: "notifications/"
+											if l := len("clusion"); len(elem) >= l && elem[0:l] == "clusion" {
+												elem = elem[l:]
+											} else {
+												break
+											}
 
-				if l := len("notifications/"); len(elem) >= l && elem[0:l] == "notifications/" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "GET":
+													s.handleGetConclusionRequest([1]string{
+														args[0],
+													}, elemIsEscaped, w, r)
+												case "POST":
+													s.handlePostConclusionRequest([1]string{
+														args[0],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "GET,POST")
+												}
 
-				if len(elem) == 0 {
-					break
-				}
-				switch elem[0] {
-				case 'd': // Prefix: "devices"
+												return
+											}
 
-					if l := len("devices"); len(elem) >= l && elem[0:l] == "devices" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+										case 's': // Prefix: "sent"
 
-					if len(elem) == 0 {
-						switch method {
-						case "GET":
-							r.name = GetDevicesOperation
-							r.summary = "デバイス一覧取得"
-							r.operationID = "getDevices"
-							r.pathPattern = "/notifications/devices"
-							r.args = args
-							r.count = 0
-							return r, true
-						case "POST":
-							r.name = RegisterDeviceOperation
-							r.summary = "デバイス登録/更新"
-							r.operationID = "registerDevice"
-							r.pathPattern = "/notifications/devices"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
-						}
-					}
-					switch elem[0] {
-					case '/': // Prefix: "/"
+											if l := len("sent"); len(elem) >= l && elem[0:l] == "sent" {
+												elem = elem[l:]
+											} else {
+												break
+											}
 
-						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "GET":
+													s.handleHasConsentRequest([1]string{
+														args[0],
+													}, elemIsEscaped, w, r)
+												case "POST":
+													s.handleConsentTalkSessionRequest([1]string{
+														args[0],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "GET,POST")
+												}
 
-						if len(elem) == 0 {
-							break
-						}
-						switch elem[0] {
-						case 'e': // Prefix: "exists"
-							origElem := elem
-							if l := len("exists"); len(elem) >= l && elem[0:l] == "exists" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+												return
+											}
+
+										}
+
+									}
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch method {
-								case "GET":
-									r.name = CheckDeviceExistsOperation
-									r.summary = "デバイストークンが登録されているか確認"
-									r.operationID = "checkDeviceExists"
-									r.pathPattern = "/notifications/devices/exists"
-									r.args = args
-									r.count = 0
-									return r, true
-								default:
-									return
 								}
-							}
 
-							elem = origElem
-						}
-						// Param: "deviceId"
-						// Leaf parameter, slashes are prohibited
-						idx := strings.IndexByte(elem, '/')
-						if idx >= 0 {
-							break
-						}
-						args[0] = elem
-						elem = ""
+							case 'o': // Prefix: "o"
 
-						if len(elem) == 0 {
-							// Leaf node.
-							switch method {
-							case "DELETE":
-								r.name = DeleteDeviceOperation
-								r.summary = "デバイス削除"
-								r.operationID = "deleteDevice"
-								r.pathPattern = "/notifications/devices/{deviceId}"
-								r.args = args
-								r.count = 1
-								return r, true
-							default:
-								return
-							}
-						}
+								if l := len("o"); len(elem) >= l && elem[0:l] == "o" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-					}
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'p': // Prefix: "pinions"
 
-				case 'p': // Prefix: "preferences"
+									if l := len("pinions"); len(elem) >= l && elem[0:l] == "pinions" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-					if l := len("preferences"); len(elem) >= l && elem[0:l] == "preferences" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetOpinionsForTalkSessionRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch method {
-						case "GET":
-							r.name = GetNotificationPreferencesOperation
-							r.summary = "通知設定取得"
-							r.operationID = "getNotificationPreferences"
-							r.pathPattern = "/notifications/preferences"
-							r.args = args
-							r.count = 0
-							return r, true
-						case "PUT":
-							r.name = UpdateNotificationPreferencesOperation
-							r.summary = "通知設定更新"
-							r.operationID = "updateNotificationPreferences"
-							r.pathPattern = "/notifications/preferences"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
-						}
-					}
+										return
+									}
 
-				case 't': // Prefix: "test"
+								case 'w': // Prefix: "wnership-transfers"
 
-					if l := len("test"); len(elem) >= l && elem[0:l] == "test" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+									if l := len("wnership-transfers"); len(elem) >= l && elem[0:l] == "wnership-transfers" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch method {
-						case "POST":
-							r.name = SendTestNotificationOperation
-							r.summary = "テスト通知送信"
-							r.operationID = "sendTestNotification"
-							r.pathPattern = "/notifications/test"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
-						}
-					}
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleRequestTalkSessionOwnershipTransferRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
 
-				case 'v': // Prefix: "vapid-key"
+										return
+									}
 
-					if l := len("vapid-key"); len(elem) >= l && elem[0:l] == "vapid-key" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+								}
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch method {
-						case "GET":
-							r.name = GetVapidKeyOperation
-							r.summary = "VAPID公開鍵取得"
-							r.operationID = "getVapidKey"
-							r.pathPattern = "/notifications/vapid-key"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
-						}
-					}
+							case 'p': // Prefix: "publish"
 
-				}
+								if l := len("publish"); len(elem) >= l && elem[0:l] == "publish" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-			case 'o': // Prefix: "o"
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handlePublishTalkSessionRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
 
-				if l := len("o"); len(elem) >= l && elem[0:l] == "o" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+									return
+								}
 
-				if len(elem) == 0 {
-					break
-				}
-				switch elem[0] {
-				case 'p': // Prefix: "pinions"
+							case 'r': // Prefix: "re"
 
-					if l := len("pinions"); len(elem) >= l && elem[0:l] == "pinions" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+								if l := len("re"); len(elem) >= l && elem[0:l] == "re" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-					if len(elem) == 0 {
-						switch method {
-						case "POST":
-							r.name = PostOpinionPost2Operation
-							r.summary = "セッションに対して意見投稿 or 意見に対するリプライ"
-							r.operationID = "postOpinionPost2"
-							r.pathPattern = "/opinions"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
-						}
-					}
-					switch elem[0] {
-					case '/': // Prefix: "/"
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'p': // Prefix: "port"
 
-						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-							elem = elem[l:]
-						} else {
-							break
-						}
+									if l := len("port"); len(elem) >= l && elem[0:l] == "port" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
-						if len(elem) == 0 {
-							break
-						}
-						switch elem[0] {
-						case 'h': // Prefix: "histories"
-							origElem := elem
-							if l := len("histories"); len(elem) >= l && elem[0:l] == "histories" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+									if len(elem) == 0 {
+										switch r.Method {
+										case "GET":
+											s.handleGetTalkSessionReportRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch method {
-								case "GET":
-									r.name = OpinionsHistoryOperation
-									r.summary = "今までに投稿した意見"
-									r.operationID = "opinionsHistory"
-									r.pathPattern = "/opinions/histories"
-									r.args = args
-									r.count = 0
-									return r, true
-								default:
-									return
-								}
-							}
+										return
+									}
+									switch elem[0] {
+									case 's': // Prefix: "s"
 
-							elem = origElem
-						case 'r': // Prefix: "report_reasons"
-							origElem := elem
-							if l := len("report_reasons"); len(elem) >= l && elem[0:l] == "report_reasons" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+										if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+											elem = elem[l:]
+										} else {
+											break
+										}
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch method {
-								case "GET":
-									r.name = GetOpinionReportReasonsOperation
-									r.summary = "意見への通報理由一覧"
-									r.operationID = "getOpinionReportReasons"
-									r.pathPattern = "/opinions/report_reasons"
-									r.args = args
-									r.count = 0
-									return r, true
-								default:
-									return
-								}
-							}
+										if len(elem) == 0 {
+											switch r.Method {
+											case "GET":
+												s.handleGetReportsForTalkSessionRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
 
-							elem = origElem
-						}
-						// Param: "opinionID"
-						// Match until "/"
-						idx := strings.IndexByte(elem, '/')
-						if idx < 0 {
-							idx = len(elem)
-						}
-						args[0] = elem[:idx]
-						elem = elem[idx:]
+											return
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/count"
 
-						if len(elem) == 0 {
-							switch method {
-							case "GET":
-								r.name = GetOpinionDetail2Operation
-								r.summary = "意見詳細"
-								r.operationID = "getOpinionDetail2"
-								r.pathPattern = "/opinions/{opinionID}"
-								r.args = args
-								r.count = 1
-								return r, true
-							default:
-								return
-							}
-						}
-						switch elem[0] {
-						case '/': // Prefix: "/"
+											if l := len("/count"); len(elem) >= l && elem[0:l] == "/count" {
+												elem = elem[l:]
+											} else {
+												break
+											}
 
-							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "GET":
+													s.handleGetTalkSessionReportCountRequest([1]string{
+														args[0],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "GET")
+												}
 
-							if len(elem) == 0 {
-								break
-							}
-							switch elem[0] {
-							case 'a': // Prefix: "analysis"
+												return
+											}
 
-								if l := len("analysis"); len(elem) >= l && elem[0:l] == "analysis" {
+										}
+
+									}
+
+								case 's': // Prefix: "strictions"
+
+									if l := len("strictions"); len(elem) >= l && elem[0:l] == "strictions" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetTalkSessionRestrictionSatisfiedRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
+								}
+
+							case 's': // Prefix: "swipe_opinions"
+
+								if l := len("swipe_opinions"); len(elem) >= l && elem[0:l] == "swipe_opinions" {
 									elem = elem[l:]
 								} else {
 									break
@@ -2774,23 +2772,21 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 								if len(elem) == 0 {
 									// Leaf node.
-									switch method {
+									switch r.Method {
 									case "GET":
-										r.name = GetOpinionAnalysisOperation
-										r.summary = "意見に投票したグループごとの割合"
-										r.operationID = "getOpinionAnalysis"
-										r.pathPattern = "/opinions/{opinionID}/analysis"
-										r.args = args
-										r.count = 1
-										return r, true
+										s.handleSwipeOpinionsRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
 									default:
-										return
+										s.notAllowed(w, r, "GET")
 									}
+
+									return
 								}
 
-							case 'r': // Prefix: "rep"
+							case 't': // Prefix: "t"
 
-								if l := len("rep"); len(elem) >= l && elem[0:l] == "rep" {
+								if l := len("t"); len(elem) >= l && elem[0:l] == "t" {
 									elem = elem[l:]
 								} else {
 									break
@@ -2800,9 +2796,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									break
 								}
 								switch elem[0] {
-								case 'l': // Prefix: "lies"
+								case 'e': // Prefix: "emplates"
 
-									if l := len("lies"); len(elem) >= l && elem[0:l] == "lies" {
+									if l := len("emplates"); len(elem) >= l && elem[0:l] == "emplates" {
 										elem = elem[l:]
 									} else {
 										break
@@ -2810,41 +2806,37 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 									if len(elem) == 0 {
 										// Leaf node.
-										switch method {
-										case "GET":
-											r.name = OpinionComments2Operation
-											r.summary = "意見に対するリプライ意見一覧"
-											r.operationID = "opinionComments2"
-											r.pathPattern = "/opinions/{opinionID}/replies"
-											r.args = args
-											r.count = 1
-											return r, true
+										switch r.Method {
+										case "POST":
+											s.handleSaveTalkSessionTemplateRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
 										default:
-											return
+											s.notAllowed(w, r, "POST")
 										}
+
+										return
 									}
 
-								case 'o': // Prefix: "ort"
+								case 'i': // Prefix: "imeline"
 
-									if l := len("ort"); len(elem) >= l && elem[0:l] == "ort" {
+									if l := len("imeline"); len(elem) >= l && elem[0:l] == "imeline" {
 										elem = elem[l:]
 									} else {
 										break
 									}
 
 									if len(elem) == 0 {
-										switch method {
+										switch r.Method {
 										case "POST":
-											r.name = ReportOpinionOperation
-											r.summary = "意見通報API"
-											r.operationID = "reportOpinion"
-											r.pathPattern = "/opinions/{opinionID}/report"
-											r.args = args
-											r.count = 1
-											return r, true
+											s.handlePostTimeLineItemRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
 										default:
-											return
+											s.notAllowed(w, r, "POST")
 										}
+
+										return
 									}
 									switch elem[0] {
 									case 's': // Prefix: "s"
@@ -2856,53 +2848,81 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 										}
 
 										if len(elem) == 0 {
-											switch method {
+											switch r.Method {
 											case "GET":
-												r.name = GetOpinionReportsOperation
-												r.summary = "意見に対する通報取得"
-												r.operationID = "getOpinionReports"
-												r.pathPattern = "/opinions/{opinionID}/reports"
-												r.args = args
-												r.count = 1
-												return r, true
+												s.handleGetTimeLineRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
 											default:
-												return
+												s.notAllowed(w, r, "GET")
 											}
+
+											return
 										}
 										switch elem[0] {
-										case '/': // Prefix: "/solve"
+										case '/': // Prefix: "/"
 
-											if l := len("/solve"); len(elem) >= l && elem[0:l] == "/solve" {
+											if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 												elem = elem[l:]
 											} else {
 												break
 											}
 
+											// Param: "actionItemID"
+											// Leaf parameter, slashes are prohibited
+											idx := strings.IndexByte(elem, '/')
+											if idx >= 0 {
+												break
+											}
+											args[1] = elem
+											elem = ""
+
 											if len(elem) == 0 {
 												// Leaf node.
-												switch method {
-												case "POST":
-													r.name = SolveOpinionReportOperation
-													r.summary = "通報を解決"
-													r.operationID = "solveOpinionReport"
-													r.pathPattern = "/opinions/{opinionID}/reports/solve"
-													r.args = args
-													r.count = 1
-													return r, true
+												switch r.Method {
+												case "PUT":
+													s.handleEditTimeLineRequest([2]string{
+														args[0],
+														args[1],
+													}, elemIsEscaped, w, r)
 												default:
-													return
+													s.notAllowed(w, r, "PUT")
 												}
+
+												return
 											}
 
 										}
 
 									}
 
+								case 'o': // Prefix: "opics"
+
+									if l := len("opics"); len(elem) >= l && elem[0:l] == "opics" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleGetTopicsRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+
 								}
 
-							case 'v': // Prefix: "votes"
+							case 'v': // Prefix: "votes:batch"
 
-								if l := len("votes"); len(elem) >= l && elem[0:l] == "votes" {
+								if l := len("votes:batch"); len(elem) >= l && elem[0:l] == "votes:batch" {
 									elem = elem[l:]
 								} else {
 									break
@@ -2910,18 +2930,16 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 								if len(elem) == 0 {
 									// Leaf node.
-									switch method {
+									switch r.Method {
 									case "POST":
-										r.name = Vote2Operation
-										r.summary = "意思表明API"
-										r.operationID = "vote2"
-										r.pathPattern = "/opinions/{opinionID}/votes"
-										r.args = args
-										r.count = 1
-										return r, true
+										s.handleBatchVoteRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
 									default:
-										return
+										s.notAllowed(w, r, "POST")
 									}
+
+									return
 								}
 
 							}
@@ -2930,418 +2948,264 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 					}
 
-				case 'r': // Prefix: "rganization"
+				case 'e': // Prefix: "est"
 
-					if l := len("rganization"); len(elem) >= l && elem[0:l] == "rganization" {
+					if l := len("est"); len(elem) >= l && elem[0:l] == "est" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
 					if len(elem) == 0 {
-						break
+						switch r.Method {
+						case "GET":
+							s.handleTestRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
 					}
 					switch elem[0] {
-					case '/': // Prefix: "/"
+					case '/': // Prefix: "/dummy"
 
-						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						if l := len("/dummy"); len(elem) >= l && elem[0:l] == "/dummy" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
-						// Param: "code"
-						// Match until "/"
-						idx := strings.IndexByte(elem, '/')
-						if idx < 0 {
-							idx = len(elem)
-						}
-						args[0] = elem[:idx]
-						elem = elem[idx:]
-
 						if len(elem) == 0 {
-							break
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleDummyInitRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
 						}
-						switch elem[0] {
-						case '/': // Prefix: "/validate"
 
-							if l := len("/validate"); len(elem) >= l && elem[0:l] == "/validate" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+					}
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch method {
-								case "GET":
-									r.name = ValidateOrganizationCodeOperation
-									r.summary = "組織コード検証"
-									r.operationID = "validateOrganizationCode"
-									r.pathPattern = "/organization/{code}/validate"
-									r.args = args
-									r.count = 1
-									return r, true
-								default:
-									return
-								}
-							}
+				}
+
+			case 'u': // Prefix: "user"
+
+				if l := len("user"); len(elem) >= l && elem[0:l] == "user" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch r.Method {
+					case "DELETE":
+						s.handleWithdrawUserRequest([0]string{}, elemIsEscaped, w, r)
+					case "GET":
+						s.handleGetUserInfoRequest([0]string{}, elemIsEscaped, w, r)
+					case "POST":
+						s.handleEstablishUserRequest([0]string{}, elemIsEscaped, w, r)
+					case "PUT":
+						s.handleUpdateUserProfileRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "DELETE,GET,POST,PUT")
+					}
+
+					return
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
 
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "displayID"
+					// Leaf parameter, slashes are prohibited
+					idx := strings.IndexByte(elem, '/')
+					if idx >= 0 {
+						break
+					}
+					args[0] = elem
+					elem = ""
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleGetUserByDisplayIDRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
 						}
 
-					case 's': // Prefix: "s"
+						return
+					}
 
-						if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+				case 's': // Prefix: "s/"
+
+					if l := len("s/"); len(elem) >= l && elem[0:l] == "s/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "displayID"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/talksessions"
+
+						if l := len("/talksessions"); len(elem) >= l && elem[0:l] == "/talksessions" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							switch method {
+							// Leaf node.
+							switch r.Method {
 							case "GET":
-								r.name = GetOrganizationsOperation
-								r.summary = "所属組織一覧"
-								r.operationID = "getOrganizations"
-								r.pathPattern = "/organizations"
-								r.args = args
-								r.count = 0
-								return r, true
-							case "POST":
-								r.name = EstablishOrganizationOperation
-								r.summary = "組織作成（運営ユーザーのみ）"
-								r.operationID = "establishOrganization"
-								r.pathPattern = "/organizations"
-								r.args = args
-								r.count = 0
-								return r, true
+								s.handleGetUserTalkSessionsRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
 							default:
-								return
+								s.notAllowed(w, r, "GET")
 							}
+
+							return
 						}
-						switch elem[0] {
-						case '/': // Prefix: "/"
 
-							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-								elem = elem[l:]
-							} else {
-								break
-							}
+					}
 
-							if len(elem) == 0 {
-								break
-							}
-							switch elem[0] {
-							case 'a': // Prefix: "aliases"
-								origElem := elem
-								if l := len("aliases"); len(elem) >= l && elem[0:l] == "aliases" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+				}
 
-								if len(elem) == 0 {
-									switch method {
-									case "GET":
-										r.name = GetOrganizationAliasesOperation
-										r.summary = "組織エイリアス一覧取得"
-										r.operationID = "getOrganizationAliases"
-										r.pathPattern = "/organizations/aliases"
-										r.args = args
-										r.count = 0
-										return r, true
-									case "POST":
-										r.name = CreateOrganizationAliasOperation
-										r.summary = "組織エイリアス作成"
-										r.operationID = "createOrganizationAlias"
-										r.pathPattern = "/organizations/aliases"
-										r.args = args
-										r.count = 0
-										return r, true
-									default:
-										return
-									}
-								}
-								switch elem[0] {
-								case '/': // Prefix: "/"
+			case 'v': // Prefix: "v1/manage/"
 
-									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-										elem = elem[l:]
-									} else {
-										break
-									}
+				if l := len("v1/manage/"); len(elem) >= l && elem[0:l] == "v1/manage/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
 
-									// Param: "aliasID"
-									// Leaf parameter, slashes are prohibited
-									idx := strings.IndexByte(elem, '/')
-									if idx >= 0 {
-										break
-									}
-									args[0] = elem
-									elem = ""
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'a': // Prefix: "auth/signing-keys"
 
-									if len(elem) == 0 {
-										// Leaf node.
-										switch method {
-										case "DELETE":
-											r.name = DeleteOrganizationAliasOperation
-											r.summary = "組織エイリアス削除"
-											r.operationID = "deleteOrganizationAlias"
-											r.pathPattern = "/organizations/aliases/{aliasID}"
-											r.args = args
-											r.count = 1
-											return r, true
-										default:
-											return
-										}
-									}
+					if l := len("auth/signing-keys"); len(elem) >= l && elem[0:l] == "auth/signing-keys" {
+						elem = elem[l:]
+					} else {
+						break
+					}
 
-								}
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleGetSigningKeysManageRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
 
-								elem = origElem
-							case 'i': // Prefix: "invite"
-								origElem := elem
-								if l := len("invite"); len(elem) >= l && elem[0:l] == "invite" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/rotate"
 
-								if len(elem) == 0 {
-									switch method {
-									case "POST":
-										r.name = InviteOrganizationOperation
-										r.summary = "組織ユーザー招待（運営ユーザーのみ）"
-										r.operationID = "inviteOrganization"
-										r.pathPattern = "/organizations/invite"
-										r.args = args
-										r.count = 0
-										return r, true
-									default:
-										return
-									}
-								}
-								switch elem[0] {
-								case '_': // Prefix: "_user"
+						if l := len("/rotate"); len(elem) >= l && elem[0:l] == "/rotate" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-									if l := len("_user"); len(elem) >= l && elem[0:l] == "_user" {
-										elem = elem[l:]
-									} else {
-										break
-									}
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleRotateSigningKeyManageRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
 
-									if len(elem) == 0 {
-										// Leaf node.
-										switch method {
-										case "POST":
-											r.name = InviteOrganizationForUserOperation
-											r.summary = "組織にユーザーを追加"
-											r.operationID = "inviteOrganizationForUser"
-											r.pathPattern = "/organizations/invite_user"
-											r.args = args
-											r.count = 0
-											return r, true
-										default:
-											return
-										}
-									}
+							return
+						}
 
-								}
+					}
 
-								elem = origElem
-							case 's': // Prefix: "switch/"
-								origElem := elem
-								if l := len("switch/"); len(elem) >= l && elem[0:l] == "switch/" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+				case 't': // Prefix: "talksessions/"
 
-								// Param: "code"
-								// Leaf parameter, slashes are prohibited
-								idx := strings.IndexByte(elem, '/')
-								if idx >= 0 {
-									break
-								}
-								args[0] = elem
-								elem = ""
+					if l := len("talksessions/"); len(elem) >= l && elem[0:l] == "talksessions/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch method {
-									case "POST":
-										r.name = SwitchOrganizationOperation
-										r.summary = "組織切り替え"
-										r.operationID = "switchOrganization"
-										r.pathPattern = "/organizations/switch/{code}"
-										r.args = args
-										r.count = 1
-										return r, true
-									default:
-										return
-									}
-								}
-
-								elem = origElem
-							case 'u': // Prefix: "users"
-								origElem := elem
-								if l := len("users"); len(elem) >= l && elem[0:l] == "users" {
-									elem = elem[l:]
-								} else {
-									break
-								}
-
-								if len(elem) == 0 {
-									// Leaf node.
-									switch method {
-									case "GET":
-										r.name = GetOrganizationUsersOperation
-										r.summary = "現在の組織のユーザー一覧取得"
-										r.operationID = "getOrganizationUsers"
-										r.pathPattern = "/organizations/users"
-										r.args = args
-										r.count = 0
-										return r, true
-									default:
-										return
-									}
-								}
-
-								elem = origElem
-							}
-							// Param: "code"
-							// Leaf parameter, slashes are prohibited
-							idx := strings.IndexByte(elem, '/')
-							if idx >= 0 {
-								break
-							}
-							args[0] = elem
-							elem = ""
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'l': // Prefix: "list"
+						origElem := elem
+						if l := len("list"); len(elem) >= l && elem[0:l] == "list" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch method {
-								case "PUT":
-									r.name = UpdateOrganizationOperation
-									r.summary = "組織更新（組織のAdmin以上のユーザーのみ）"
-									r.operationID = "updateOrganization"
-									r.pathPattern = "/organizations/{code}"
-									r.args = args
-									r.count = 1
-									return r, true
-								default:
-									return
-								}
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetTalkSessionListManageRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
 							}
 
+							return
 						}
 
+						elem = origElem
 					}
-
-				}
-
-			case 'p': // Prefix: "policy/consent"
-
-				if l := len("policy/consent"); len(elem) >= l && elem[0:l] == "policy/consent" {
-					elem = elem[l:]
-				} else {
-					break
-				}
-
-				if len(elem) == 0 {
-					// Leaf node.
-					switch method {
-					case "GET":
-						r.name = GetPolicyConsentStatusOperation
-						r.summary = "最新のポリシーに同意したかを取得"
-						r.operationID = "getPolicyConsentStatus"
-						r.pathPattern = "/policy/consent"
-						r.args = args
-						r.count = 0
-						return r, true
-					case "POST":
-						r.name = PolicyConsentOperation
-						r.summary = "最新のポリシーに同意する"
-						r.operationID = "policyConsent"
-						r.pathPattern = "/policy/consent"
-						r.args = args
-						r.count = 0
-						return r, true
-					default:
-						return
-					}
-				}
-
-			case 'r': // Prefix: "report/feedback"
-
-				if l := len("report/feedback"); len(elem) >= l && elem[0:l] == "report/feedback" {
-					elem = elem[l:]
-				} else {
-					break
-				}
-
-				if len(elem) == 0 {
-					// Leaf node.
-					switch method {
-					case "POST":
-						r.name = ApplyFeedbackToReportOperation
-						r.summary = "セッションのレポートにフィードバックを適用する"
-						r.operationID = "applyFeedbackToReport"
-						r.pathPattern = "/report/feedback"
-						r.args = args
-						r.count = 0
-						return r, true
-					default:
-						return
-					}
-				}
-
-			case 't': // Prefix: "t"
-
-				if l := len("t"); len(elem) >= l && elem[0:l] == "t" {
-					elem = elem[l:]
-				} else {
-					break
-				}
-
-				if len(elem) == 0 {
-					break
-				}
-				switch elem[0] {
-				case 'a': // Prefix: "alksessions"
-
-					if l := len("alksessions"); len(elem) >= l && elem[0:l] == "alksessions" {
-						elem = elem[l:]
-					} else {
-						break
+					// Param: "talkSessionID"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
 					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
 
 					if len(elem) == 0 {
-						switch method {
+						switch r.Method {
 						case "GET":
-							r.name = GetTalkSessionListOperation
-							r.summary = "セッション一覧"
-							r.operationID = "getTalkSessionList"
-							r.pathPattern = "/talksessions"
-							r.args = args
-							r.count = 0
-							return r, true
-						case "POST":
-							r.name = InitiateTalkSessionOperation
-							r.summary = "セッション作成"
-							r.operationID = "initiateTalkSession"
-							r.pathPattern = "/talksessions"
-							r.args = args
-							r.count = 0
-							return r, true
+							s.handleGetTalkSessionManageRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
 						default:
-							return
+							s.notAllowed(w, r, "GET")
 						}
+
+						return
 					}
 					switch elem[0] {
-					case '/': // Prefix: "/"
+					case '/': // Prefix: "/analysis/re"
 
-						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						if l := len("/analysis/re"); len(elem) >= l && elem[0:l] == "/analysis/re" {
 							elem = elem[l:]
 						} else {
 							break
@@ -3351,9 +3215,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							break
 						}
 						switch elem[0] {
-						case 'h': // Prefix: "histories"
-							origElem := elem
-							if l := len("histories"); len(elem) >= l && elem[0:l] == "histories" {
+						case 'g': // Prefix: "generate"
+
+							if l := len("generate"); len(elem) >= l && elem[0:l] == "generate" {
 								elem = elem[l:]
 							} else {
 								break
@@ -3361,143 +3225,3157 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 							if len(elem) == 0 {
 								// Leaf node.
-								switch method {
-								case "GET":
-									r.name = SessionsHistoryOperation
-									r.summary = "リアクション済みのセッション一覧"
-									r.operationID = "sessionsHistory"
-									r.pathPattern = "/talksessions/histories"
-									r.args = args
-									r.count = 0
-									return r, true
+								switch r.Method {
+								case "POST":
+									s.handleManageRegenerateManageRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
 								default:
-									return
+									s.notAllowed(w, r, "POST")
 								}
+
+								return
 							}
 
-							elem = origElem
-						case 'o': // Prefix: "opened"
-							origElem := elem
-							if l := len("opened"); len(elem) >= l && elem[0:l] == "opened" {
+						case 'p': // Prefix: "port"
+
+							if l := len("port"); len(elem) >= l && elem[0:l] == "port" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
-								// Leaf node.
-								switch method {
+								switch r.Method {
 								case "GET":
-									r.name = GetOpenedTalkSessionOperation
-									r.summary = "自分が開いたセッション一覧"
-									r.operationID = "getOpenedTalkSession"
-									r.pathPattern = "/talksessions/opened"
-									r.args = args
-									r.count = 0
-									return r, true
+									s.handleGetAnalysisReportManageRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "POST":
+									s.handleToggleReportVisibilityManageRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
 								default:
-									return
+									s.notAllowed(w, r, "GET,POST")
 								}
-							}
 
-							elem = origElem
-						case 'r': // Prefix: "restrictions"
-							origElem := elem
-							if l := len("restrictions"); len(elem) >= l && elem[0:l] == "restrictions" {
-								elem = elem[l:]
-							} else {
-								break
+								return
 							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'p': // Prefix: "publish"
+
+									if l := len("publish"); len(elem) >= l && elem[0:l] == "publish" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handlePublishReportVersionManageRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+								case 'v': // Prefix: "versions"
+
+									if l := len("versions"); len(elem) >= l && elem[0:l] == "versions" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch r.Method {
+										case "GET":
+											s.handleGetReportVersionsManageRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET")
+										}
+
+										return
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/diff"
+
+										if l := len("/diff"); len(elem) >= l && elem[0:l] == "/diff" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "GET":
+												s.handleGetReportVersionDiffManageRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "GET")
+											}
+
+											return
+										}
+
+									}
 
-							if len(elem) == 0 {
-								// Leaf node.
-								switch method {
-								case "GET":
-									r.name = GetTalkSessionRestrictionKeysOperation
-									r.summary = "セッションで指定可能な制限一覧"
-									r.operationID = "getTalkSessionRestrictionKeys"
-									r.pathPattern = "/talksessions/restrictions"
-									r.args = args
-									r.count = 0
-									return r, true
-								default:
-									return
 								}
+
 							}
 
-							elem = origElem
 						}
-						// Param: "talkSessionID"
-						// Match until "/"
-						idx := strings.IndexByte(elem, '/')
-						if idx < 0 {
-							idx = len(elem)
+
+					}
+
+				case 'u': // Prefix: "users/"
+
+					if l := len("users/"); len(elem) >= l && elem[0:l] == "users/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'l': // Prefix: "list"
+
+						if l := len("list"); len(elem) >= l && elem[0:l] == "list" {
+							elem = elem[l:]
+						} else {
+							break
 						}
-						args[0] = elem[:idx]
-						elem = elem[idx:]
 
 						if len(elem) == 0 {
-							switch method {
+							// Leaf node.
+							switch r.Method {
 							case "GET":
-								r.name = GetTalkSessionDetailOperation
-								r.summary = "トークセッションの詳細"
-								r.operationID = "getTalkSessionDetail"
-								r.pathPattern = "/talksessions/{talkSessionID}"
-								r.args = args
-								r.count = 1
-								return r, true
-							case "PUT":
-								r.name = EditTalkSessionOperation
-								r.summary = "セッション編集"
-								r.operationID = "editTalkSession"
-								r.pathPattern = "/talksessions/{talkSessionID}"
-								r.args = args
-								r.count = 1
-								return r, true
+								s.handleGetUserListManageRequest([0]string{}, elemIsEscaped, w, r)
 							default:
-								return
+								s.notAllowed(w, r, "GET")
 							}
+
+							return
+						}
+
+					case 's': // Prefix: "stats/"
+
+						if l := len("stats/"); len(elem) >= l && elem[0:l] == "stats/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
 						}
 						switch elem[0] {
-						case '/': // Prefix: "/"
+						case 'l': // Prefix: "list"
 
-							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							if l := len("list"); len(elem) >= l && elem[0:l] == "list" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetUserStatsListManageRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+						case 't': // Prefix: "total"
+
+							if l := len("total"); len(elem) >= l && elem[0:l] == "total" {
+								elem = elem[l:]
+							} else {
 								break
 							}
-							switch elem[0] {
-							case 'a': // Prefix: "analysis"
 
-								if l := len("analysis"); len(elem) >= l && elem[0:l] == "analysis" {
-									elem = elem[l:]
-								} else {
-									break
-								}
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetUserStatsTotalManageRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+						}
+
+					}
+
+				}
+
+			}
+
+		}
+	}
+	s.notFound(w, r)
+}
+
+// Route is route object.
+type Route struct {
+	name        string
+	summary     string
+	operationID string
+	pathPattern string
+	count       int
+	args        [2]string
+}
+
+// Name returns ogen operation name.
+//
+// It is guaranteed to be unique and not empty.
+func (r Route) Name() string {
+	return r.name
+}
+
+// Summary returns OpenAPI summary.
+func (r Route) Summary() string {
+	return r.summary
+}
+
+// OperationID returns OpenAPI operationId.
+func (r Route) OperationID() string {
+	return r.operationID
+}
+
+// PathPattern returns OpenAPI path.
+func (r Route) PathPattern() string {
+	return r.pathPattern
+}
+
+// Args returns parsed arguments.
+func (r Route) Args() []string {
+	return r.args[:r.count]
+}
+
+// FindRoute finds Route for given method and path.
+//
+// Note: this method does not unescape path or handle reserved characters in path properly. Use FindPath instead.
+func (s *Server) FindRoute(method, path string) (Route, bool) {
+	return s.FindPath(method, &url.URL{Path: path})
+}
+
+// FindPath finds Route for given method and URL.
+func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
+	var (
+		elem = u.Path
+		args = r.args
+	)
+	if rawPath := u.RawPath; rawPath != "" {
+		if normalized, ok := uri.NormalizeEscapedPath(rawPath); ok {
+			elem = normalized
+		}
+		defer func() {
+			for i, arg := range r.args[:r.count] {
+				if unescaped, err := url.PathUnescape(arg); err == nil {
+					r.args[i] = unescaped
+				}
+			}
+		}()
+	}
+
+	elem, ok := s.cutPrefix(elem)
+	if !ok {
+		return r, false
+	}
+
+	// Static code generated router with unwrapped path search.
+	switch {
+	default:
+		if len(elem) == 0 {
+			break
+		}
+		switch elem[0] {
+		case '/': // Prefix: "/"
+
+			if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+				elem = elem[l:]
+			} else {
+				break
+			}
+
+			if len(elem) == 0 {
+				break
+			}
+			switch elem[0] {
+			case '.': // Prefix: ".well-known/jwks.json"
+
+				if l := len(".well-known/jwks.json"); len(elem) >= l && elem[0:l] == ".well-known/jwks.json" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch method {
+					case "GET":
+						r.name = GetJwksOperation
+						r.summary = "トークン検証用の公開鍵一覧"
+						r.operationID = "getJwks"
+						r.pathPattern = "/.well-known/jwks.json"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+
+			case 'a': // Prefix: "auth/"
+
+				if l := len("auth/"); len(elem) >= l && elem[0:l] == "auth/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'd': // Prefix: "dev/"
+					origElem := elem
+					if l := len("dev/"); len(elem) >= l && elem[0:l] == "dev/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'd': // Prefix: "detach"
+
+						if l := len("detach"); len(elem) >= l && elem[0:l] == "detach" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "DELETE":
+								r.name = AuthAccountDetachOperation
+								r.summary = "**開発用** 現在のアカウントを切り離す"
+								r.operationID = "authAccountDetach"
+								r.pathPattern = "/auth/dev/detach"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 'l': // Prefix: "login"
+
+						if l := len("login"); len(elem) >= l && elem[0:l] == "login" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = DevAuthorizeOperation
+								r.summary = "開発用登録/ログイン"
+								r.operationID = "devAuthorize"
+								r.pathPattern = "/auth/dev/login"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+					elem = origElem
+				case 'p': // Prefix: "password/"
+					origElem := elem
+					if l := len("password/"); len(elem) >= l && elem[0:l] == "password/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'c': // Prefix: "change"
+
+						if l := len("change"); len(elem) >= l && elem[0:l] == "change" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "PUT":
+								r.name = ChangePasswordOperation
+								r.summary = "パスワード変更"
+								r.operationID = "changePassword"
+								r.pathPattern = "/auth/password/change"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 'l': // Prefix: "login"
+
+						if l := len("login"); len(elem) >= l && elem[0:l] == "login" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "POST":
+								r.name = PasswordLoginOperation
+								r.summary = "パスワードによるログイン"
+								r.operationID = "passwordLogin"
+								r.pathPattern = "/auth/password/login"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 'r': // Prefix: "register"
+
+						if l := len("register"); len(elem) >= l && elem[0:l] == "register" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "POST":
+								r.name = PasswordRegisterOperation
+								r.summary = "パスワードによる登録（devのみ）"
+								r.operationID = "passwordRegister"
+								r.pathPattern = "/auth/password/register"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+					elem = origElem
+				case 'r': // Prefix: "re"
+					origElem := elem
+					if l := len("re"); len(elem) >= l && elem[0:l] == "re" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'a': // Prefix: "activate"
+
+						if l := len("activate"); len(elem) >= l && elem[0:l] == "activate" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "POST":
+								r.name = ReactivateUserOperation
+								r.summary = "退会ユーザーの復活"
+								r.operationID = "reactivateUser"
+								r.pathPattern = "/auth/reactivate"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 'v': // Prefix: "voke"
+
+						if l := len("voke"); len(elem) >= l && elem[0:l] == "voke" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "POST":
+								r.name = RevokeTokenOperation
+								r.summary = "トークンを失効（ログアウト）"
+								r.operationID = "revokeToken"
+								r.pathPattern = "/auth/revoke"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+					elem = origElem
+				case 't': // Prefix: "token/info"
+					origElem := elem
+					if l := len("token/info"); len(elem) >= l && elem[0:l] == "token/info" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = GetTokenInfoOperation
+							r.summary = "JWTの内容を返してくれる"
+							r.operationID = "getTokenInfo"
+							r.pathPattern = "/auth/token/info"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+					elem = origElem
+				}
+				// Param: "provider"
+				// Match until "/"
+				idx := strings.IndexByte(elem, '/')
+				if idx < 0 {
+					idx = len(elem)
+				}
+				args[0] = elem[:idx]
+				elem = elem[idx:]
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'c': // Prefix: "callback"
+
+						if l := len("callback"); len(elem) >= l && elem[0:l] == "callback" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = HandleAuthCallbackOperation
+								r.summary = "Auth Callback"
+								r.operationID = "handleAuthCallback"
+								r.pathPattern = "/auth/{provider}/callback"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 'l': // Prefix: "login"
+
+						if l := len("login"); len(elem) >= l && elem[0:l] == "login" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = AuthorizeOperation
+								r.summary = "ログイン"
+								r.operationID = "authorize"
+								r.pathPattern = "/auth/{provider}/login"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+				}
+
+			case 'h': // Prefix: "health"
+
+				if l := len("health"); len(elem) >= l && elem[0:l] == "health" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch method {
+					case "GET":
+						r.name = HealthOperation
+						r.summary = "ヘルスチェック"
+						r.operationID = "health"
+						r.pathPattern = "/health"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+
+			case 'i': // Prefix: "images"
+
+				if l := len("images"); len(elem) >= l && elem[0:l] == "images" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch method {
+					case "POST":
+						r.name = PostImageOperation
+						r.summary = "画像投稿"
+						r.operationID = "postImage"
+						r.pathPattern = "/images"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+
+			case 'n': // Prefix: "notifications/"
+
+				if l := len("notifications/"); len(elem) >= l && elem[0:l] == "notifications/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'd': // Prefix: "devices"
+
+					if l := len("devices"); len(elem) >= l && elem[0:l] == "devices" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = GetDevicesOperation
+							r.summary = "デバイス一覧取得"
+							r.operationID = "getDevices"
+							r.pathPattern = "/notifications/devices"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							r.name = RegisterDeviceOperation
+							r.summary = "デバイス登録/更新"
+							r.operationID = "registerDevice"
+							r.pathPattern = "/notifications/devices"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'e': // Prefix: "exists"
+							origElem := elem
+							if l := len("exists"); len(elem) >= l && elem[0:l] == "exists" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = CheckDeviceExistsOperation
+									r.summary = "デバイストークンが登録されているか確認"
+									r.operationID = "checkDeviceExists"
+									r.pathPattern = "/notifications/devices/exists"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						}
+						// Param: "deviceId"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "DELETE":
+								r.name = DeleteDeviceOperation
+								r.summary = "デバイス削除"
+								r.operationID = "deleteDevice"
+								r.pathPattern = "/notifications/devices/{deviceId}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+				case 'p': // Prefix: "preferences"
+
+					if l := len("preferences"); len(elem) >= l && elem[0:l] == "preferences" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = GetNotificationPreferencesOperation
+							r.summary = "通知設定取得"
+							r.operationID = "getNotificationPreferences"
+							r.pathPattern = "/notifications/preferences"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "PUT":
+							r.name = UpdateNotificationPreferencesOperation
+							r.summary = "通知設定更新"
+							r.operationID = "updateNotificationPreferences"
+							r.pathPattern = "/notifications/preferences"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+				case 't': // Prefix: "test"
+
+					if l := len("test"); len(elem) >= l && elem[0:l] == "test" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "POST":
+							r.name = SendTestNotificationOperation
+							r.summary = "テスト通知送信"
+							r.operationID = "sendTestNotification"
+							r.pathPattern = "/notifications/test"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+				case 'v': // Prefix: "vapid-key"
+
+					if l := len("vapid-key"); len(elem) >= l && elem[0:l] == "vapid-key" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = GetVapidKeyOperation
+							r.summary = "VAPID公開鍵取得"
+							r.operationID = "getVapidKey"
+							r.pathPattern = "/notifications/vapid-key"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+				}
+
+			case 'o': // Prefix: "o"
+
+				if l := len("o"); len(elem) >= l && elem[0:l] == "o" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'p': // Prefix: "pinions"
+
+					if l := len("pinions"); len(elem) >= l && elem[0:l] == "pinions" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "POST":
+							r.name = PostOpinionPost2Operation
+							r.summary = "セッションに対して意見投稿 or 意見に対するリプライ"
+							r.operationID = "postOpinionPost2"
+							r.pathPattern = "/opinions"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'h': // Prefix: "histories"
+							origElem := elem
+							if l := len("histories"); len(elem) >= l && elem[0:l] == "histories" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = OpinionsHistoryOperation
+									r.summary = "今までに投稿した意見"
+									r.operationID = "opinionsHistory"
+									r.pathPattern = "/opinions/histories"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						case 'r': // Prefix: "report_reasons"
+							origElem := elem
+							if l := len("report_reasons"); len(elem) >= l && elem[0:l] == "report_reasons" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = GetOpinionReportReasonsOperation
+									r.summary = "意見への通報理由一覧"
+									r.operationID = "getOpinionReportReasons"
+									r.pathPattern = "/opinions/report_reasons"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						}
+						// Param: "opinionID"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							switch method {
+							case "DELETE":
+								r.name = DeleteOpinionOperation
+								r.summary = "意見の削除"
+								r.operationID = "deleteOpinion"
+								r.pathPattern = "/opinions/{opinionID}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "GET":
+								r.name = GetOpinionDetail2Operation
+								r.summary = "意見詳細"
+								r.operationID = "getOpinionDetail2"
+								r.pathPattern = "/opinions/{opinionID}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "PUT":
+								r.name = EditOpinionOperation
+								r.summary = "意見の編集"
+								r.operationID = "editOpinion"
+								r.pathPattern = "/opinions/{opinionID}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'a': // Prefix: "analysis"
+
+								if l := len("analysis"); len(elem) >= l && elem[0:l] == "analysis" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = GetOpinionAnalysisOperation
+										r.summary = "意見に投票したグループごとの割合"
+										r.operationID = "getOpinionAnalysis"
+										r.pathPattern = "/opinions/{opinionID}/analysis"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'r': // Prefix: "re"
+
+								if l := len("re"); len(elem) >= l && elem[0:l] == "re" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'p': // Prefix: "p"
+
+									if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'l': // Prefix: "lies"
+
+										if l := len("lies"); len(elem) >= l && elem[0:l] == "lies" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = OpinionComments2Operation
+												r.summary = "意見に対するリプライ意見一覧"
+												r.operationID = "opinionComments2"
+												r.pathPattern = "/opinions/{opinionID}/replies"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									case 'o': // Prefix: "ort"
+
+										if l := len("ort"); len(elem) >= l && elem[0:l] == "ort" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											switch method {
+											case "POST":
+												r.name = ReportOpinionOperation
+												r.summary = "意見通報API"
+												r.operationID = "reportOpinion"
+												r.pathPattern = "/opinions/{opinionID}/report"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+										switch elem[0] {
+										case 's': // Prefix: "s"
+
+											if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												switch method {
+												case "GET":
+													r.name = GetOpinionReportsOperation
+													r.summary = "意見に対する通報取得"
+													r.operationID = "getOpinionReports"
+													r.pathPattern = "/opinions/{opinionID}/reports"
+													r.args = args
+													r.count = 1
+													return r, true
+												default:
+													return
+												}
+											}
+											switch elem[0] {
+											case '/': // Prefix: "/solve"
+
+												if l := len("/solve"); len(elem) >= l && elem[0:l] == "/solve" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch method {
+													case "POST":
+														r.name = SolveOpinionReportOperation
+														r.summary = "通報を解決"
+														r.operationID = "solveOpinionReport"
+														r.pathPattern = "/opinions/{opinionID}/reports/solve"
+														r.args = args
+														r.count = 1
+														return r, true
+													default:
+														return
+													}
+												}
+
+											}
+
+										}
+
+									}
+
+								case 'v': // Prefix: "visions"
+
+									if l := len("visions"); len(elem) >= l && elem[0:l] == "visions" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetOpinionRevisionsOperation
+											r.summary = "意見の編集履歴"
+											r.operationID = "getOpinionRevisions"
+											r.pathPattern = "/opinions/{opinionID}/revisions"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							case 'v': // Prefix: "votes"
+
+								if l := len("votes"); len(elem) >= l && elem[0:l] == "votes" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = Vote2Operation
+										r.summary = "意思表明API"
+										r.operationID = "vote2"
+										r.pathPattern = "/opinions/{opinionID}/votes"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						}
+
+					}
+
+				case 'r': // Prefix: "rganization"
+
+					if l := len("rganization"); len(elem) >= l && elem[0:l] == "rganization" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "code"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/validate"
+
+							if l := len("/validate"); len(elem) >= l && elem[0:l] == "/validate" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = ValidateOrganizationCodeOperation
+									r.summary = "組織コード検証"
+									r.operationID = "validateOrganizationCode"
+									r.pathPattern = "/organization/{code}/validate"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					case 's': // Prefix: "s"
+
+						if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = GetOrganizationsOperation
+								r.summary = "所属組織一覧"
+								r.operationID = "getOrganizations"
+								r.pathPattern = "/organizations"
+								r.args = args
+								r.count = 0
+								return r, true
+							case "POST":
+								r.name = EstablishOrganizationOperation
+								r.summary = "組織作成（運営ユーザーのみ）"
+								r.operationID = "establishOrganization"
+								r.pathPattern = "/organizations"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'a': // Prefix: "a"
+								origElem := elem
+								if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'l': // Prefix: "liases"
+
+									if l := len("liases"); len(elem) >= l && elem[0:l] == "liases" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											r.name = GetOrganizationAliasesOperation
+											r.summary = "組織エイリアス一覧取得"
+											r.operationID = "getOrganizationAliases"
+											r.pathPattern = "/organizations/aliases"
+											r.args = args
+											r.count = 0
+											return r, true
+										case "POST":
+											r.name = CreateOrganizationAliasOperation
+											r.summary = "組織エイリアス作成"
+											r.operationID = "createOrganizationAlias"
+											r.pathPattern = "/organizations/aliases"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										// Param: "aliasID"
+										// Leaf parameter, slashes are prohibited
+										idx := strings.IndexByte(elem, '/')
+										if idx >= 0 {
+											break
+										}
+										args[0] = elem
+										elem = ""
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "DELETE":
+												r.name = DeleteOrganizationAliasOperation
+												r.summary = "組織エイリアス削除"
+												r.operationID = "deleteOrganizationAlias"
+												r.pathPattern = "/organizations/aliases/{aliasID}"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								case 'p': // Prefix: "pi-keys"
+
+									if l := len("pi-keys"); len(elem) >= l && elem[0:l] == "pi-keys" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											r.name = GetOrganizationApiKeysOperation
+											r.summary = "組織APIキー一覧取得"
+											r.operationID = "getOrganizationApiKeys"
+											r.pathPattern = "/organizations/api-keys"
+											r.args = args
+											r.count = 0
+											return r, true
+										case "POST":
+											r.name = CreateOrganizationApiKeyOperation
+											r.summary = "組織APIキー発行"
+											r.operationID = "createOrganizationApiKey"
+											r.pathPattern = "/organizations/api-keys"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										// Param: "apiKeyID"
+										// Leaf parameter, slashes are prohibited
+										idx := strings.IndexByte(elem, '/')
+										if idx >= 0 {
+											break
+										}
+										args[0] = elem
+										elem = ""
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "DELETE":
+												r.name = RevokeOrganizationApiKeyOperation
+												r.summary = "組織APIキー失効"
+												r.operationID = "revokeOrganizationApiKey"
+												r.pathPattern = "/organizations/api-keys/{apiKeyID}"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								case 'u': // Prefix: "udit-logs"
+
+									if l := len("udit-logs"); len(elem) >= l && elem[0:l] == "udit-logs" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											r.name = GetOrganizationAuditLogsOperation
+											r.summary = "組織の監査ログ一覧取得"
+											r.operationID = "getOrganizationAuditLogs"
+											r.pathPattern = "/organizations/audit-logs"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/csv"
+
+										if l := len("/csv"); len(elem) >= l && elem[0:l] == "/csv" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = DownloadOrganizationAuditLogsOperation
+												r.summary = "組織の監査ログCSVダウンロード"
+												r.operationID = "downloadOrganizationAuditLogs"
+												r.pathPattern = "/organizations/audit-logs/csv"
+												r.args = args
+												r.count = 0
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								}
+
+								elem = origElem
+							case 'i': // Prefix: "invit"
+								origElem := elem
+								if l := len("invit"); len(elem) >= l && elem[0:l] == "invit" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'a': // Prefix: "ations"
+
+									if l := len("ations"); len(elem) >= l && elem[0:l] == "ations" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											r.name = GetOrganizationInvitationsOperation
+											r.summary = "組織への招待一覧取得"
+											r.operationID = "getOrganizationInvitations"
+											r.pathPattern = "/organizations/invitations"
+											r.args = args
+											r.count = 0
+											return r, true
+										case "POST":
+											r.name = CreateOrganizationInvitationOperation
+											r.summary = "組織への招待作成"
+											r.operationID = "createOrganizationInvitation"
+											r.pathPattern = "/organizations/invitations"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/"
+
+										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case 'a': // Prefix: "accept"
+											origElem := elem
+											if l := len("accept"); len(elem) >= l && elem[0:l] == "accept" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "POST":
+													r.name = AcceptOrganizationInvitationOperation
+													r.summary = "組織への招待の承諾"
+													r.operationID = "acceptOrganizationInvitation"
+													r.pathPattern = "/organizations/invitations/accept"
+													r.args = args
+													r.count = 0
+													return r, true
+												default:
+													return
+												}
+											}
+
+											elem = origElem
+										case 'b': // Prefix: "bulk"
+											origElem := elem
+											if l := len("bulk"); len(elem) >= l && elem[0:l] == "bulk" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "POST":
+													r.name = BulkCreateOrganizationInvitationsOperation
+													r.summary = "CSVによる組織への一括招待"
+													r.operationID = "bulkCreateOrganizationInvitations"
+													r.pathPattern = "/organizations/invitations/bulk"
+													r.args = args
+													r.count = 0
+													return r, true
+												default:
+													return
+												}
+											}
+
+											elem = origElem
+										}
+										// Param: "invitationID"
+										// Match until "/"
+										idx := strings.IndexByte(elem, '/')
+										if idx < 0 {
+											idx = len(elem)
+										}
+										args[0] = elem[:idx]
+										elem = elem[idx:]
+
+										if len(elem) == 0 {
+											switch method {
+											case "DELETE":
+												r.name = RevokeOrganizationInvitationOperation
+												r.summary = "組織への招待の取り消し"
+												r.operationID = "revokeOrganizationInvitation"
+												r.pathPattern = "/organizations/invitations/{invitationID}"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/resend"
+
+											if l := len("/resend"); len(elem) >= l && elem[0:l] == "/resend" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "POST":
+													r.name = ResendOrganizationInvitationOperation
+													r.summary = "組織への招待の再送"
+													r.operationID = "resendOrganizationInvitation"
+													r.pathPattern = "/organizations/invitations/{invitationID}/resend"
+													r.args = args
+													r.count = 1
+													return r, true
+												default:
+													return
+												}
+											}
+
+										}
+
+									}
+
+								case 'e': // Prefix: "e"
+
+									if l := len("e"); len(elem) >= l && elem[0:l] == "e" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "POST":
+											r.name = InviteOrganizationOperation
+											r.summary = "組織ユーザー招待（運営ユーザーのみ）"
+											r.operationID = "inviteOrganization"
+											r.pathPattern = "/organizations/invite"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case '_': // Prefix: "_user"
+
+										if l := len("_user"); len(elem) >= l && elem[0:l] == "_user" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "POST":
+												r.name = InviteOrganizationForUserOperation
+												r.summary = "組織にユーザーを追加"
+												r.operationID = "inviteOrganizationForUser"
+												r.pathPattern = "/organizations/invite_user"
+												r.args = args
+												r.count = 0
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								}
+
+								elem = origElem
+							case 's': // Prefix: "switch/"
+								origElem := elem
+								if l := len("switch/"); len(elem) >= l && elem[0:l] == "switch/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "code"
+								// Leaf parameter, slashes are prohibited
+								idx := strings.IndexByte(elem, '/')
+								if idx >= 0 {
+									break
+								}
+								args[0] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = SwitchOrganizationOperation
+										r.summary = "組織切り替え"
+										r.operationID = "switchOrganization"
+										r.pathPattern = "/organizations/switch/{code}"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							case 'u': // Prefix: "users"
+								origElem := elem
+								if l := len("users"); len(elem) >= l && elem[0:l] == "users" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = GetOrganizationUsersOperation
+										r.summary = "現在の組織のユーザー一覧取得"
+										r.operationID = "getOrganizationUsers"
+										r.pathPattern = "/organizations/users"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "userID"
+									// Match until "/"
+									idx := strings.IndexByte(elem, '/')
+									if idx < 0 {
+										idx = len(elem)
+									}
+									args[0] = elem[:idx]
+									elem = elem[idx:]
+
+									if len(elem) == 0 {
+										switch method {
+										case "DELETE":
+											r.name = RemoveOrganizationUserOperation
+											r.summary = "組織ユーザー削除"
+											r.operationID = "removeOrganizationUser"
+											r.pathPattern = "/organizations/users/{userID}"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/role"
+
+										if l := len("/role"); len(elem) >= l && elem[0:l] == "/role" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "PUT":
+												r.name = ChangeOrganizationUserRoleOperation
+												r.summary = "組織ユーザーのロール変更"
+												r.operationID = "changeOrganizationUserRole"
+												r.pathPattern = "/organizations/users/{userID}/role"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								}
+
+								elem = origElem
+							}
+							// Param: "code"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch method {
+								case "PUT":
+									r.name = UpdateOrganizationOperation
+									r.summary = "組織更新（組織のAdmin以上のユーザーのみ）"
+									r.operationID = "updateOrganization"
+									r.pathPattern = "/organizations/{code}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'c': // Prefix: "children"
+
+									if l := len("children"); len(elem) >= l && elem[0:l] == "children" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetOrganizationChildrenOperation
+											r.summary = "子組織一覧"
+											r.operationID = "getOrganizationChildren"
+											r.pathPattern = "/organizations/{code}/children"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'o': // Prefix: "ownership-transfers"
+
+									if l := len("ownership-transfers"); len(elem) >= l && elem[0:l] == "ownership-transfers" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "POST":
+											r.name = RequestOrganizationOwnershipTransferOperation
+											r.summary = "組織のオーナー権限の譲渡を依頼"
+											r.operationID = "requestOrganizationOwnershipTransfer"
+											r.pathPattern = "/organizations/{code}/ownership-transfers"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/bulk"
+
+										if l := len("/bulk"); len(elem) >= l && elem[0:l] == "/bulk" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "POST":
+												r.name = BulkTransferTalkSessionsOperation
+												r.summary = "メンバーのセッションをまとめて譲渡依頼"
+												r.operationID = "bulkTransferTalkSessions"
+												r.pathPattern = "/organizations/{code}/ownership-transfers/bulk"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								case 'p': // Prefix: "parent"
+
+									if l := len("parent"); len(elem) >= l && elem[0:l] == "parent" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "PUT":
+											r.name = UpdateOrganizationParentOperation
+											r.summary = "親組織の変更"
+											r.operationID = "updateOrganizationParent"
+											r.pathPattern = "/organizations/{code}/parent"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'r': // Prefix: "re"
+
+									if l := len("re"); len(elem) >= l && elem[0:l] == "re" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'f': // Prefix: "ference-demographics"
+
+										if l := len("ference-demographics"); len(elem) >= l && elem[0:l] == "ference-demographics" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = GetOrganizationReferenceDemographicsOperation
+												r.summary = "母集団の構成"
+												r.operationID = "getOrganizationReferenceDemographics"
+												r.pathPattern = "/organizations/{code}/reference-demographics"
+												r.args = args
+												r.count = 1
+												return r, true
+											case "PUT":
+												r.name = UpdateOrganizationReferenceDemographicsOperation
+												r.summary = "母集団の構成の登録"
+												r.operationID = "updateOrganizationReferenceDemographics"
+												r.pathPattern = "/organizations/{code}/reference-demographics"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									case 'p': // Prefix: "port-prompt"
+
+										if l := len("port-prompt"); len(elem) >= l && elem[0:l] == "port-prompt" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = GetOrganizationReportPromptOperation
+												r.summary = "レポート生成の指示"
+												r.operationID = "getOrganizationReportPrompt"
+												r.pathPattern = "/organizations/{code}/report-prompt"
+												r.args = args
+												r.count = 1
+												return r, true
+											case "PUT":
+												r.name = UpdateOrganizationReportPromptOperation
+												r.summary = "レポート生成の指示の更新"
+												r.operationID = "updateOrganizationReportPrompt"
+												r.pathPattern = "/organizations/{code}/report-prompt"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								case 's': // Prefix: "stats"
+
+									if l := len("stats"); len(elem) >= l && elem[0:l] == "stats" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetOrganizationStatsOperation
+											r.summary = "組織のダッシュボード"
+											r.operationID = "getOrganizationStats"
+											r.pathPattern = "/organizations/{code}/stats"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 't': // Prefix: "talksession"
+
+									if l := len("talksession"); len(elem) >= l && elem[0:l] == "talksession" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case '-': // Prefix: "-templates"
+
+										if l := len("-templates"); len(elem) >= l && elem[0:l] == "-templates" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											switch method {
+											case "GET":
+												r.name = GetTalkSessionTemplatesOperation
+												r.summary = "組織のセッションテンプレート一覧"
+												r.operationID = "getTalkSessionTemplates"
+												r.pathPattern = "/organizations/{code}/talksession-templates"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/"
+
+											if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											// Param: "templateID"
+											// Match until "/"
+											idx := strings.IndexByte(elem, '/')
+											if idx < 0 {
+												idx = len(elem)
+											}
+											args[1] = elem[:idx]
+											elem = elem[idx:]
+
+											if len(elem) == 0 {
+												switch method {
+												case "DELETE":
+													r.name = DeleteTalkSessionTemplateOperation
+													r.summary = "セッションテンプレート削除"
+													r.operationID = "deleteTalkSessionTemplate"
+													r.pathPattern = "/organizations/{code}/talksession-templates/{templateID}"
+													r.args = args
+													r.count = 2
+													return r, true
+												default:
+													return
+												}
+											}
+											switch elem[0] {
+											case '/': // Prefix: "/clone"
+
+												if l := len("/clone"); len(elem) >= l && elem[0:l] == "/clone" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch method {
+													case "POST":
+														r.name = CloneTalkSessionTemplateOperation
+														r.summary = "テンプレートからセッションを作成"
+														r.operationID = "cloneTalkSessionTemplate"
+														r.pathPattern = "/organizations/{code}/talksession-templates/{templateID}/clone"
+														r.args = args
+														r.count = 2
+														return r, true
+													default:
+														return
+													}
+												}
+
+											}
+
+										}
+
+									case 's': // Prefix: "s"
+
+										if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											switch method {
+											case "GET":
+												r.name = GetOrganizationTalkSessionsOperation
+												r.summary = "組織のセッション一覧"
+												r.operationID = "getOrganizationTalkSessions"
+												r.pathPattern = "/organizations/{code}/talksessions"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/"
+
+											if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											// Param: "talkSessionID"
+											// Match until "/"
+											idx := strings.IndexByte(elem, '/')
+											if idx < 0 {
+												idx = len(elem)
+											}
+											args[1] = elem[:idx]
+											elem = elem[idx:]
+
+											if len(elem) == 0 {
+												break
+											}
+											switch elem[0] {
+											case '/': // Prefix: "/representativeness"
+
+												if l := len("/representativeness"); len(elem) >= l && elem[0:l] == "/representativeness" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch method {
+													case "GET":
+														r.name = GetTalkSessionRepresentativenessOperation
+														r.summary = "参加者の代表性"
+														r.operationID = "getTalkSessionRepresentativeness"
+														r.pathPattern = "/organizations/{code}/talksessions/{talkSessionID}/representativeness"
+														r.args = args
+														r.count = 2
+														return r, true
+													default:
+														return
+													}
+												}
+
+											}
+
+										}
+
+									}
+
+								}
+
+							}
+
+						}
+
+					}
+
+				case 'w': // Prefix: "wnership-transfers"
+
+					if l := len("wnership-transfers"); len(elem) >= l && elem[0:l] == "wnership-transfers" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = ListOwnershipTransfersOperation
+							r.summary = "オーナー権限の譲渡依頼一覧"
+							r.operationID = "listOwnershipTransfers"
+							r.pathPattern = "/ownership-transfers"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "transferID"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'a': // Prefix: "accept"
+
+								if l := len("accept"); len(elem) >= l && elem[0:l] == "accept" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = AcceptOwnershipTransferOperation
+										r.summary = "オーナー権限の譲渡を承諾"
+										r.operationID = "acceptOwnershipTransfer"
+										r.pathPattern = "/ownership-transfers/{transferID}/accept"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'c': // Prefix: "cancel"
+
+								if l := len("cancel"); len(elem) >= l && elem[0:l] == "cancel" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = CancelOwnershipTransferOperation
+										r.summary = "オーナー権限の譲渡依頼を取り消し"
+										r.operationID = "cancelOwnershipTransfer"
+										r.pathPattern = "/ownership-transfers/{transferID}/cancel"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'd': // Prefix: "decline"
+
+								if l := len("decline"); len(elem) >= l && elem[0:l] == "decline" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = DeclineOwnershipTransferOperation
+										r.summary = "オーナー権限の譲渡を辞退"
+										r.operationID = "declineOwnershipTransfer"
+										r.pathPattern = "/ownership-transfers/{transferID}/decline"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						}
+
+					}
+
+				}
+
+			case 'p': // Prefix: "policy/consent"
+
+				if l := len("policy/consent"); len(elem) >= l && elem[0:l] == "policy/consent" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch method {
+					case "GET":
+						r.name = GetPolicyConsentStatusOperation
+						r.summary = "最新のポリシーに同意したかを取得"
+						r.operationID = "getPolicyConsentStatus"
+						r.pathPattern = "/policy/consent"
+						r.args = args
+						r.count = 0
+						return r, true
+					case "POST":
+						r.name = PolicyConsentOperation
+						r.summary = "最新のポリシーに同意する"
+						r.operationID = "policyConsent"
+						r.pathPattern = "/policy/consent"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+
+			case 'r': // Prefix: "report/feedback"
+
+				if l := len("report/feedback"); len(elem) >= l && elem[0:l] == "report/feedback" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch method {
+					case "POST":
+						r.name = ApplyFeedbackToReportOperation
+						r.summary = "セッションのレポートにフィードバックを適用する"
+						r.operationID = "applyFeedbackToReport"
+						r.pathPattern = "/report/feedback"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+
+			case 't': // Prefix: "t"
+
+				if l := len("t"); len(elem) >= l && elem[0:l] == "t" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'a': // Prefix: "alksessions"
+
+					if l := len("alksessions"); len(elem) >= l && elem[0:l] == "alksessions" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = GetTalkSessionListOperation
+							r.summary = "セッション一覧"
+							r.operationID = "getTalkSessionList"
+							r.pathPattern = "/talksessions"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							r.name = InitiateTalkSessionOperation
+							r.summary = "セッション作成"
+							r.operationID = "initiateTalkSession"
+							r.pathPattern = "/talksessions"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'h': // Prefix: "histories"
+							origElem := elem
+							if l := len("histories"); len(elem) >= l && elem[0:l] == "histories" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = SessionsHistoryOperation
+									r.summary = "リアクション済みのセッション一覧"
+									r.operationID = "sessionsHistory"
+									r.pathPattern = "/talksessions/histories"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						case 'o': // Prefix: "opened"
+							origElem := elem
+							if l := len("opened"); len(elem) >= l && elem[0:l] == "opened" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = GetOpenedTalkSessionOperation
+									r.summary = "自分が開いたセッション一覧"
+									r.operationID = "getOpenedTalkSession"
+									r.pathPattern = "/talksessions/opened"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						case 'r': // Prefix: "restrictions"
+							origElem := elem
+							if l := len("restrictions"); len(elem) >= l && elem[0:l] == "restrictions" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = GetTalkSessionRestrictionKeysOperation
+									r.summary = "セッションで指定可能な制限一覧"
+									r.operationID = "getTalkSessionRestrictionKeys"
+									r.pathPattern = "/talksessions/restrictions"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						}
+						// Param: "talkSessionID"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = GetTalkSessionDetailOperation
+								r.summary = "トークセッションの詳細"
+								r.operationID = "getTalkSessionDetail"
+								r.pathPattern = "/talksessions/{talkSessionID}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "PUT":
+								r.name = EditTalkSessionOperation
+								r.summary = "セッション編集"
+								r.operationID = "editTalkSession"
+								r.pathPattern = "/talksessions/{talkSessionID}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'a': // Prefix: "analysis"
+
+								if l := len("analysis"); len(elem) >= l && elem[0:l] == "analysis" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = TalkSessionAnalysisOperation
+										r.summary = "分析結果一覧"
+										r.operationID = "talkSessionAnalysis"
+										r.pathPattern = "/talksessions/{talkSessionID}/analysis"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'c': // Prefix: "consensus"
+
+										if l := len("consensus"); len(elem) >= l && elem[0:l] == "consensus" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = GetConsensusOperation
+												r.summary = "グループ間で合意している意見と賛否が分かれている意見"
+												r.operationID = "getConsensus"
+												r.pathPattern = "/talksessions/{talkSessionID}/analysis/consensus"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									case 'j': // Prefix: "jobs/"
+
+										if l := len("jobs/"); len(elem) >= l && elem[0:l] == "jobs/" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										// Param: "jobID"
+										// Leaf parameter, slashes are prohibited
+										idx := strings.IndexByte(elem, '/')
+										if idx >= 0 {
+											break
+										}
+										args[1] = elem
+										elem = ""
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = GetAnalysisJobOperation
+												r.summary = "レポート・ワードクラウドの生成ジョブの状況"
+												r.operationID = "getAnalysisJob"
+												r.pathPattern = "/talksessions/{talkSessionID}/analysis/jobs/{jobID}"
+												r.args = args
+												r.count = 2
+												return r, true
+											default:
+												return
+											}
+										}
+
+									case 'm': // Prefix: "me"
+
+										if l := len("me"); len(elem) >= l && elem[0:l] == "me" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = GetMyAnalysisPositionOperation
+												r.summary = "自分の分析結果での位置"
+												r.operationID = "getMyAnalysisPosition"
+												r.pathPattern = "/talksessions/{talkSessionID}/analysis/me"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									case 's': // Prefix: "s"
+
+										if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case 'n': // Prefix: "napshots"
+
+											if l := len("napshots"); len(elem) >= l && elem[0:l] == "napshots" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												switch method {
+												case "GET":
+													r.name = GetAnalysisSnapshotsOperation
+													r.summary = "分析結果のスナップショット一覧"
+													r.operationID = "getAnalysisSnapshots"
+													r.pathPattern = "/talksessions/{talkSessionID}/analysis/snapshots"
+													r.args = args
+													r.count = 1
+													return r, true
+												default:
+													return
+												}
+											}
+											switch elem[0] {
+											case '/': // Prefix: "/"
+
+												if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													break
+												}
+												switch elem[0] {
+												case 'd': // Prefix: "diff"
+													origElem := elem
+													if l := len("diff"); len(elem) >= l && elem[0:l] == "diff" {
+														elem = elem[l:]
+													} else {
+														break
+													}
+
+													if len(elem) == 0 {
+														// Leaf node.
+														switch method {
+														case "GET":
+															r.name = GetAnalysisSnapshotDiffOperation
+															r.summary = "分析結果のスナップショットの差分"
+															r.operationID = "getAnalysisSnapshotDiff"
+															r.pathPattern = "/talksessions/{talkSessionID}/analysis/snapshots/diff"
+															r.args = args
+															r.count = 1
+															return r, true
+														default:
+															return
+														}
+													}
+
+													elem = origElem
+												}
+												// Param: "snapshotID"
+												// Leaf parameter, slashes are prohibited
+												idx := strings.IndexByte(elem, '/')
+												if idx >= 0 {
+													break
+												}
+												args[1] = elem
+												elem = ""
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch method {
+													case "GET":
+														r.name = GetAnalysisSnapshotOperation
+														r.summary = "分析結果のスナップショット"
+														r.operationID = "getAnalysisSnapshot"
+														r.pathPattern = "/talksessions/{talkSessionID}/analysis/snapshots/{snapshotID}"
+														r.args = args
+														r.count = 2
+														return r, true
+													default:
+														return
+													}
+												}
+
+											}
+
+										case 't': // Prefix: "tatus"
+
+											if l := len("tatus"); len(elem) >= l && elem[0:l] == "tatus" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "GET":
+													r.name = GetAnalysisStatusOperation
+													r.summary = "分析の再計算の状況"
+													r.operationID = "getAnalysisStatus"
+													r.pathPattern = "/talksessions/{talkSessionID}/analysis/status"
+													r.args = args
+													r.count = 1
+													return r, true
+												default:
+													return
+												}
+											}
+
+										}
+
+									case 't': // Prefix: "terms"
+
+										if l := len("terms"); len(elem) >= l && elem[0:l] == "terms" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = GetTermFrequenciesOperation
+												r.summary = "ワードクラウドの単語の集計"
+												r.operationID = "getTermFrequencies"
+												r.pathPattern = "/talksessions/{talkSessionID}/analysis/terms"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									case 'v': // Prefix: "vote-shifts"
+
+										if l := len("vote-shifts"); len(elem) >= l && elem[0:l] == "vote-shifts" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = GetVoteShiftsOperation
+												r.summary = "投票の変化"
+												r.operationID = "getVoteShifts"
+												r.pathPattern = "/talksessions/{talkSessionID}/analysis/vote-shifts"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								}
+
+							case 'c': // Prefix: "c"
+
+								if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'l': // Prefix: "lone"
+
+									if l := len("lone"); len(elem) >= l && elem[0:l] == "lone" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = CloneTalkSessionOperation
+											r.summary = "セッションを複製"
+											r.operationID = "cloneTalkSession"
+											r.pathPattern = "/talksessions/{talkSessionID}/clone"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'o': // Prefix: "o"
+
+									if l := len("o"); len(elem) >= l && elem[0:l] == "o" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'l': // Prefix: "llaborators"
+
+										if l := len("llaborators"); len(elem) >= l && elem[0:l] == "llaborators" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											switch method {
+											case "GET":
+												r.name = GetTalkSessionCollaboratorsOperation
+												r.summary = "セッションの共同管理者一覧"
+												r.operationID = "getTalkSessionCollaborators"
+												r.pathPattern = "/talksessions/{talkSessionID}/collaborators"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/"
+
+											if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											// Param: "displayID"
+											// Leaf parameter, slashes are prohibited
+											idx := strings.IndexByte(elem, '/')
+											if idx >= 0 {
+												break
+											}
+											args[1] = elem
+											elem = ""
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "DELETE":
+													r.name = RemoveTalkSessionCollaboratorOperation
+													r.summary = "セッションの共同管理者を削除"
+													r.operationID = "removeTalkSessionCollaborator"
+													r.pathPattern = "/talksessions/{talkSessionID}/collaborators/{displayID}"
+													r.args = args
+													r.count = 2
+													return r, true
+												case "PUT":
+													r.name = SetTalkSessionCollaboratorOperation
+													r.summary = "セッションの共同管理者を追加・変更"
+													r.operationID = "setTalkSessionCollaborator"
+													r.pathPattern = "/talksessions/{talkSessionID}/collaborators/{displayID}"
+													r.args = args
+													r.count = 2
+													return r, true
+												default:
+													return
+												}
+											}
+
+										}
+
+									case 'n': // Prefix: "n"
+
+										if l := len("n"); len(elem) >= l && elem[0:l] == "n" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case 'c': // Prefix: "clusion"
+
+											if l := len("clusion"); len(elem) >= l && elem[0:l] == "clusion" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "GET":
+													r.name = GetConclusionOperation
+													r.summary = "結論取得"
+													r.operationID = "getConclusion"
+													r.pathPattern = "/talksessions/{talkSessionID}/conclusion"
+													r.args = args
+													r.count = 1
+													return r, true
+												case "POST":
+													r.name = PostConclusionOperation
+													r.summary = "結論投稿"
+													r.operationID = "postConclusion"
+													r.pathPattern = "/talksessions/{talkSessionID}/conclusion"
+													r.args = args
+													r.count = 1
+													return r, true
+												default:
+													return
+												}
+											}
+
+										case 's': // Prefix: "sent"
+
+											if l := len("sent"); len(elem) >= l && elem[0:l] == "sent" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "GET":
+													r.name = HasConsentOperation
+													r.summary = "セッションに同意しているか"
+													r.operationID = "hasConsent"
+													r.pathPattern = "/talksessions/{talkSessionID}/consent"
+													r.args = args
+													r.count = 1
+													return r, true
+												case "POST":
+													r.name = ConsentTalkSessionOperation
+													r.summary = "セッションへの同意"
+													r.operationID = "consentTalkSession"
+													r.pathPattern = "/talksessions/{talkSessionID}/consent"
+													r.args = args
+													r.count = 1
+													return r, true
+												default:
+													return
+												}
+											}
+
+										}
 
-								if len(elem) == 0 {
-									// Leaf node.
-									switch method {
-									case "GET":
-										r.name = TalkSessionAnalysisOperation
-										r.summary = "分析結果一覧"
-										r.operationID = "talkSessionAnalysis"
-										r.pathPattern = "/talksessions/{talkSessionID}/analysis"
-										r.args = args
-										r.count = 1
-										return r, true
-									default:
-										return
 									}
+
 								}
 
-							case 'c': // Prefix: "con"
+							case 'o': // Prefix: "o"
 
-								if l := len("con"); len(elem) >= l && elem[0:l] == "con" {
+								if l := len("o"); len(elem) >= l && elem[0:l] == "o" {
 									elem = elem[l:]
 								} else {
 									break
@@ -3507,9 +6385,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									break
 								}
 								switch elem[0] {
-								case 'c': // Prefix: "clusion"
+								case 'p': // Prefix: "pinions"
 
-									if l := len("clusion"); len(elem) >= l && elem[0:l] == "clusion" {
+									if l := len("pinions"); len(elem) >= l && elem[0:l] == "pinions" {
 										elem = elem[l:]
 									} else {
 										break
@@ -3519,18 +6397,10 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 										// Leaf node.
 										switch method {
 										case "GET":
-											r.name = GetConclusionOperation
-											r.summary = "結論取得"
-											r.operationID = "getConclusion"
-											r.pathPattern = "/talksessions/{talkSessionID}/conclusion"
-											r.args = args
-											r.count = 1
-											return r, true
-										case "POST":
-											r.name = PostConclusionOperation
-											r.summary = "結論投稿"
-											r.operationID = "postConclusion"
-											r.pathPattern = "/talksessions/{talkSessionID}/conclusion"
+											r.name = GetOpinionsForTalkSessionOperation
+											r.summary = "セッションに対する意見一覧"
+											r.operationID = "getOpinionsForTalkSession"
+											r.pathPattern = "/talksessions/{talkSessionID}/opinions"
 											r.args = args
 											r.count = 1
 											return r, true
@@ -3539,9 +6409,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 										}
 									}
 
-								case 's': // Prefix: "sent"
+								case 'w': // Prefix: "wnership-transfers"
 
-									if l := len("sent"); len(elem) >= l && elem[0:l] == "sent" {
+									if l := len("wnership-transfers"); len(elem) >= l && elem[0:l] == "wnership-transfers" {
 										elem = elem[l:]
 									} else {
 										break
@@ -3550,19 +6420,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									if len(elem) == 0 {
 										// Leaf node.
 										switch method {
-										case "GET":
-											r.name = HasConsentOperation
-											r.summary = "セッションに同意しているか"
-											r.operationID = "hasConsent"
-											r.pathPattern = "/talksessions/{talkSessionID}/consent"
-											r.args = args
-											r.count = 1
-											return r, true
 										case "POST":
-											r.name = ConsentTalkSessionOperation
-											r.summary = "セッションへの同意"
-											r.operationID = "consentTalkSession"
-											r.pathPattern = "/talksessions/{talkSessionID}/consent"
+											r.name = RequestTalkSessionOwnershipTransferOperation
+											r.summary = "セッションのオーナー権限の譲渡を依頼"
+											r.operationID = "requestTalkSessionOwnershipTransfer"
+											r.pathPattern = "/talksessions/{talkSessionID}/ownership-transfers"
 											r.args = args
 											r.count = 1
 											return r, true
@@ -3573,9 +6435,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 								}
 
-							case 'o': // Prefix: "opinions"
+							case 'p': // Prefix: "publish"
 
-								if l := len("opinions"); len(elem) >= l && elem[0:l] == "opinions" {
+								if l := len("publish"); len(elem) >= l && elem[0:l] == "publish" {
 									elem = elem[l:]
 								} else {
 									break
@@ -3584,11 +6446,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								if len(elem) == 0 {
 									// Leaf node.
 									switch method {
-									case "GET":
-										r.name = GetOpinionsForTalkSessionOperation
-										r.summary = "セッションに対する意見一覧"
-										r.operationID = "getOpinionsForTalkSession"
-										r.pathPattern = "/talksessions/{talkSessionID}/opinions"
+									case "POST":
+										r.name = PublishTalkSessionOperation
+										r.summary = "下書きのセッションを公開"
+										r.operationID = "publishTalkSession"
+										r.pathPattern = "/talksessions/{talkSessionID}/publish"
 										r.args = args
 										r.count = 1
 										return r, true
@@ -3733,44 +6595,57 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									}
 								}
 
-							case 't': // Prefix: "timeline"
+							case 't': // Prefix: "t"
 
-								if l := len("timeline"); len(elem) >= l && elem[0:l] == "timeline" {
+								if l := len("t"); len(elem) >= l && elem[0:l] == "t" {
 									elem = elem[l:]
 								} else {
 									break
 								}
 
 								if len(elem) == 0 {
-									switch method {
-									case "POST":
-										r.name = PostTimeLineItemOperation
-										r.summary = "タイムラインアイテム追加"
-										r.operationID = "postTimeLineItem"
-										r.pathPattern = "/talksessions/{talkSessionID}/timeline"
-										r.args = args
-										r.count = 1
-										return r, true
-									default:
-										return
-									}
+									break
 								}
 								switch elem[0] {
-								case 's': // Prefix: "s"
+								case 'e': // Prefix: "emplates"
 
-									if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+									if l := len("emplates"); len(elem) >= l && elem[0:l] == "emplates" {
 										elem = elem[l:]
 									} else {
 										break
 									}
 
 									if len(elem) == 0 {
+										// Leaf node.
 										switch method {
-										case "GET":
-											r.name = GetTimeLineOperation
-											r.summary = "タイムライン取得"
-											r.operationID = "getTimeLine"
-											r.pathPattern = "/talksessions/{talkSessionID}/timelines"
+										case "POST":
+											r.name = SaveTalkSessionTemplateOperation
+											r.summary = "セッションをテンプレートとして保存"
+											r.operationID = "saveTalkSessionTemplate"
+											r.pathPattern = "/talksessions/{talkSessionID}/templates"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'i': // Prefix: "imeline"
+
+									if l := len("imeline"); len(elem) >= l && elem[0:l] == "imeline" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "POST":
+											r.name = PostTimeLineItemOperation
+											r.summary = "タイムラインアイテム追加"
+											r.operationID = "postTimeLineItem"
+											r.pathPattern = "/talksessions/{talkSessionID}/timeline"
 											r.args = args
 											r.count = 1
 											return r, true
@@ -3779,43 +6654,116 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 										}
 									}
 									switch elem[0] {
-									case '/': // Prefix: "/"
+									case 's': // Prefix: "s"
 
-										if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
 											elem = elem[l:]
 										} else {
 											break
 										}
 
-										// Param: "actionItemID"
-										// Leaf parameter, slashes are prohibited
-										idx := strings.IndexByte(elem, '/')
-										if idx >= 0 {
-											break
-										}
-										args[1] = elem
-										elem = ""
-
 										if len(elem) == 0 {
-											// Leaf node.
 											switch method {
-											case "PUT":
-												r.name = EditTimeLineOperation
-												r.summary = "タイムライン編集"
-												r.operationID = "editTimeLine"
-												r.pathPattern = "/talksessions/{talkSessionID}/timelines/{actionItemID}"
+											case "GET":
+												r.name = GetTimeLineOperation
+												r.summary = "タイムライン取得"
+												r.operationID = "getTimeLine"
+												r.pathPattern = "/talksessions/{talkSessionID}/timelines"
 												r.args = args
-												r.count = 2
+												r.count = 1
 												return r, true
 											default:
 												return
 											}
 										}
+										switch elem[0] {
+										case '/': // Prefix: "/"
+
+											if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											// Param: "actionItemID"
+											// Leaf parameter, slashes are prohibited
+											idx := strings.IndexByte(elem, '/')
+											if idx >= 0 {
+												break
+											}
+											args[1] = elem
+											elem = ""
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "PUT":
+													r.name = EditTimeLineOperation
+													r.summary = "タイムライン編集"
+													r.operationID = "editTimeLine"
+													r.pathPattern = "/talksessions/{talkSessionID}/timelines/{actionItemID}"
+													r.args = args
+													r.count = 2
+													return r, true
+												default:
+													return
+												}
+											}
+
+										}
+
+									}
+
+								case 'o': // Prefix: "opics"
+
+									if l := len("opics"); len(elem) >= l && elem[0:l] == "opics" {
+										elem = elem[l:]
+									} else {
+										break
+									}
 
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "GET":
+											r.name = GetTopicsOperation
+											r.summary = "意見のトピック"
+											r.operationID = "getTopics"
+											r.pathPattern = "/talksessions/{talkSessionID}/topics"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
 									}
 
 								}
 
+							case 'v': // Prefix: "votes:batch"
+
+								if l := len("votes:batch"); len(elem) >= l && elem[0:l] == "votes:batch" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = BatchVoteOperation
+										r.summary = "一括投票"
+										r.operationID = "batchVote"
+										r.pathPattern = "/talksessions/{talkSessionID}/votes:batch"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
 							}
 
 						}
@@ -4014,6 +6962,55 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					break
 				}
 				switch elem[0] {
+				case 'a': // Prefix: "auth/signing-keys"
+
+					if l := len("auth/signing-keys"); len(elem) >= l && elem[0:l] == "auth/signing-keys" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = GetSigningKeysManageOperation
+							r.summary = ""
+							r.operationID = "getSigningKeysManage"
+							r.pathPattern = "/v1/manage/auth/signing-keys"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/rotate"
+
+						if l := len("/rotate"); len(elem) >= l && elem[0:l] == "/rotate" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "POST":
+								r.name = RotateSigningKeyManageOperation
+								r.summary = ""
+								r.operationID = "rotateSigningKeyManage"
+								r.pathPattern = "/v1/manage/auth/signing-keys/rotate"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
 				case 't': // Prefix: "talksessions/"
 
 					if l := len("talksessions/"); len(elem) >= l && elem[0:l] == "talksessions/" {
@@ -4121,7 +7118,6 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							}
 
 							if len(elem) == 0 {
-								// Leaf node.
 								switch method {
 								case "GET":
 									r.name = GetAnalysisReportManageOperation
@@ -4143,6 +7139,95 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 									return
 								}
 							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'p': // Prefix: "publish"
+
+									if l := len("publish"); len(elem) >= l && elem[0:l] == "publish" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = PublishReportVersionManageOperation
+											r.summary = ""
+											r.operationID = "publishReportVersionManage"
+											r.pathPattern = "/v1/manage/talksessions/{talkSessionID}/analysis/report/publish"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+								case 'v': // Prefix: "versions"
+
+									if l := len("versions"); len(elem) >= l && elem[0:l] == "versions" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											r.name = GetReportVersionsManageOperation
+											r.summary = ""
+											r.operationID = "getReportVersionsManage"
+											r.pathPattern = "/v1/manage/talksessions/{talkSessionID}/analysis/report/versions"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+									switch elem[0] {
+									case '/': // Prefix: "/diff"
+
+										if l := len("/diff"); len(elem) >= l && elem[0:l] == "/diff" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "GET":
+												r.name = GetReportVersionDiffManageOperation
+												r.summary = ""
+												r.operationID = "getReportVersionDiffManage"
+												r.pathPattern = "/v1/manage/talksessions/{talkSessionID}/analysis/report/versions/diff"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+									}
+
+								}
+
+							}
 
 						}
 
@@ -3,14 +3,58 @@
 package oas
 
 import (
+	"io"
 	"net/url"
 	"time"
 
 	"github.com/go-faster/errors"
+	"github.com/go-faster/jx"
 
 	ht "github.com/ogen-go/ogen/http"
 )
 
+type AcceptOrganizationInvitationBadRequest struct{}
+
+func (*AcceptOrganizationInvitationBadRequest) acceptOrganizationInvitationRes() {}
+
+type AcceptOrganizationInvitationForbidden struct{}
+
+func (*AcceptOrganizationInvitationForbidden) acceptOrganizationInvitationRes() {}
+
+type AcceptOrganizationInvitationInternalServerError struct{}
+
+func (*AcceptOrganizationInvitationInternalServerError) acceptOrganizationInvitationRes() {}
+
+type AcceptOrganizationInvitationNotFound struct{}
+
+func (*AcceptOrganizationInvitationNotFound) acceptOrganizationInvitationRes() {}
+
+type AcceptOrganizationInvitationReq struct {
+	Token string `json:"token"`
+}
+
+// GetToken returns the value of Token.
+func (s *AcceptOrganizationInvitationReq) GetToken() string {
+	return s.Token
+}
+
+// SetToken sets the value of Token.
+func (s *AcceptOrganizationInvitationReq) SetToken(val string) {
+	s.Token = val
+}
+
+type AcceptOwnershipTransferBadRequest struct{}
+
+func (*AcceptOwnershipTransferBadRequest) acceptOwnershipTransferRes() {}
+
+type AcceptOwnershipTransferForbidden struct{}
+
+func (*AcceptOwnershipTransferForbidden) acceptOwnershipTransferRes() {}
+
+type AcceptOwnershipTransferInternalServerError struct{}
+
+func (*AcceptOwnershipTransferInternalServerError) acceptOwnershipTransferRes() {}
+
 // Ref: #/components/schemas/ActionItem
 type ActionItem struct {
 	ActionItemID string `json:"actionItemID"`
@@ -83,163 +127,151 @@ func (s *ActionItem) SetUpdatedAt(val string) {
 
 func (*ActionItem) editTimeLineRes() {}
 
-// Ref: #/components/schemas/AnalysisReportResponse
-type AnalysisReportResponse struct {
-	// レポート本文.
-	Report OptString `json:"report"`
-}
-
-// GetReport returns the value of Report.
-func (s *AnalysisReportResponse) GetReport() OptString {
-	return s.Report
-}
-
-// SetReport sets the value of Report.
-func (s *AnalysisReportResponse) SetReport(val OptString) {
-	s.Report = val
+// レポート・ワードクラウドの生成ジョブ.
+// Ref: #/components/schemas/AnalysisJob
+type AnalysisJob struct {
+	JobID string `json:"jobID"`
+	// ジョブの種類。report はレポート、wordcloud はワードクラウド.
+	Type AnalysisJobType `json:"type"`
+	// ジョブの状態。queued は実行待ち、running は実行中、succeeded は成功、failed
+	// は再実行の上限まで失敗した.
+	Status AnalysisJobStatus `json:"status"`
+	// 実行した回数.
+	Attempts int `json:"attempts"`
+	// 再実行を含めて実行する上限の回数.
+	MaxAttempts int `json:"maxAttempts"`
+	// 実行待ちの場合に実行する日時.
+	NextRunAt OptString `json:"nextRunAt"`
+	// 最後に実行を開始した日時.
+	StartedAt OptString `json:"startedAt"`
+	// 成功・失敗した日時.
+	FinishedAt OptString `json:"finishedAt"`
+	CreatedAt  string    `json:"createdAt"`
+}
+
+// GetJobID returns the value of JobID.
+func (s *AnalysisJob) GetJobID() string {
+	return s.JobID
 }
 
-type ApplyFeedbackToReportInternalServerError struct{}
-
-func (*ApplyFeedbackToReportInternalServerError) applyFeedbackToReportRes() {}
-
-type ApplyFeedbackToReportOK struct{}
-
-func (*ApplyFeedbackToReportOK) applyFeedbackToReportRes() {}
-
-type ApplyFeedbackToReportReq struct {
-	ReportID     string `json:"reportID"`
-	FeedbackType string `json:"feedbackType"`
+// GetType returns the value of Type.
+func (s *AnalysisJob) GetType() AnalysisJobType {
+	return s.Type
 }
 
-// GetReportID returns the value of ReportID.
-func (s *ApplyFeedbackToReportReq) GetReportID() string {
-	return s.ReportID
+// GetStatus returns the value of Status.
+func (s *AnalysisJob) GetStatus() AnalysisJobStatus {
+	return s.Status
 }
 
-// GetFeedbackType returns the value of FeedbackType.
-func (s *ApplyFeedbackToReportReq) GetFeedbackType() string {
-	return s.FeedbackType
+// GetAttempts returns the value of Attempts.
+func (s *AnalysisJob) GetAttempts() int {
+	return s.Attempts
 }
 
-// SetReportID sets the value of ReportID.
-func (s *ApplyFeedbackToReportReq) SetReportID(val string) {
-	s.ReportID = val
+// GetMaxAttempts returns the value of MaxAttempts.
+func (s *AnalysisJob) GetMaxAttempts() int {
+	return s.MaxAttempts
 }
 
-// SetFeedbackType sets the value of FeedbackType.
-func (s *ApplyFeedbackToReportReq) SetFeedbackType(val string) {
-	s.FeedbackType = val
+// GetNextRunAt returns the value of NextRunAt.
+func (s *AnalysisJob) GetNextRunAt() OptString {
+	return s.NextRunAt
 }
 
-type AuthAccountDetachBadRequest struct{}
-
-func (*AuthAccountDetachBadRequest) authAccountDetachRes() {}
-
-type AuthAccountDetachInternalServerError struct{}
-
-func (*AuthAccountDetachInternalServerError) authAccountDetachRes() {}
-
-type AuthAccountDetachOK struct{}
-
-// AuthAccountDetachOKHeaders wraps AuthAccountDetachOK with response headers.
-type AuthAccountDetachOKHeaders struct {
-	SetCookie []string
-	Response  AuthAccountDetachOK
+// GetStartedAt returns the value of StartedAt.
+func (s *AnalysisJob) GetStartedAt() OptString {
+	return s.StartedAt
 }
 
-// GetSetCookie returns the value of SetCookie.
-func (s *AuthAccountDetachOKHeaders) GetSetCookie() []string {
-	return s.SetCookie
+// GetFinishedAt returns the value of FinishedAt.
+func (s *AnalysisJob) GetFinishedAt() OptString {
+	return s.FinishedAt
 }
 
-// GetResponse returns the value of Response.
-func (s *AuthAccountDetachOKHeaders) GetResponse() AuthAccountDetachOK {
-	return s.Response
+// GetCreatedAt returns the value of CreatedAt.
+func (s *AnalysisJob) GetCreatedAt() string {
+	return s.CreatedAt
 }
 
-// SetSetCookie sets the value of SetCookie.
-func (s *AuthAccountDetachOKHeaders) SetSetCookie(val []string) {
-	s.SetCookie = val
+// SetJobID sets the value of JobID.
+func (s *AnalysisJob) SetJobID(val string) {
+	s.JobID = val
 }
 
-// SetResponse sets the value of Response.
-func (s *AuthAccountDetachOKHeaders) SetResponse(val AuthAccountDetachOK) {
-	s.Response = val
+// SetType sets the value of Type.
+func (s *AnalysisJob) SetType(val AnalysisJobType) {
+	s.Type = val
 }
 
-func (*AuthAccountDetachOKHeaders) authAccountDetachRes() {}
-
-type AuthorizeBadRequest struct{}
-
-func (*AuthorizeBadRequest) authorizeRes() {}
-
-type AuthorizeFound struct{}
-
-// AuthorizeFoundHeaders wraps AuthorizeFound with response headers.
-type AuthorizeFoundHeaders struct {
-	Location  string
-	SetCookie []string
-	Response  AuthorizeFound
+// SetStatus sets the value of Status.
+func (s *AnalysisJob) SetStatus(val AnalysisJobStatus) {
+	s.Status = val
 }
 
-// GetLocation returns the value of Location.
-func (s *AuthorizeFoundHeaders) GetLocation() string {
-	return s.Location
+// SetAttempts sets the value of Attempts.
+func (s *AnalysisJob) SetAttempts(val int) {
+	s.Attempts = val
 }
 
-// GetSetCookie returns the value of SetCookie.
-func (s *AuthorizeFoundHeaders) GetSetCookie() []string {
-	return s.SetCookie
+// SetMaxAttempts sets the value of MaxAttempts.
+func (s *AnalysisJob) SetMaxAttempts(val int) {
+	s.MaxAttempts = val
 }
 
-// GetResponse returns the value of Response.
-func (s *AuthorizeFoundHeaders) GetResponse() AuthorizeFound {
-	return s.Response
+// SetNextRunAt sets the value of NextRunAt.
+func (s *AnalysisJob) SetNextRunAt(val OptString) {
+	s.NextRunAt = val
 }
 
-// SetLocation sets the value of Location.
-func (s *AuthorizeFoundHeaders) SetLocation(val string) {
-	s.Location = val
+// SetStartedAt sets the value of StartedAt.
+func (s *AnalysisJob) SetStartedAt(val OptString) {
+	s.StartedAt = val
 }
 
-// SetSetCookie sets the value of SetCookie.
-func (s *AuthorizeFoundHeaders) SetSetCookie(val []string) {
-	s.SetCookie = val
+// SetFinishedAt sets the value of FinishedAt.
+func (s *AnalysisJob) SetFinishedAt(val OptString) {
+	s.FinishedAt = val
 }
 
-// SetResponse sets the value of Response.
-func (s *AuthorizeFoundHeaders) SetResponse(val AuthorizeFound) {
-	s.Response = val
+// SetCreatedAt sets the value of CreatedAt.
+func (s *AnalysisJob) SetCreatedAt(val string) {
+	s.CreatedAt = val
 }
 
-func (*AuthorizeFoundHeaders) authorizeRes() {}
-
-type AuthorizeInternalServerError struct{}
-
-func (*AuthorizeInternalServerError) authorizeRes() {}
+func (*AnalysisJob) getAnalysisJobRes() {}
 
-type AuthorizeProvider string
+// ジョブの状態。queued は実行待ち、running は実行中、succeeded は成功、failed
+// は再実行の上限まで失敗した.
+type AnalysisJobStatus string
 
 const (
-	AuthorizeProviderGoogle AuthorizeProvider = "google"
-	AuthorizeProviderLine   AuthorizeProvider = "line"
+	AnalysisJobStatusQueued    AnalysisJobStatus = "queued"
+	AnalysisJobStatusRunning   AnalysisJobStatus = "running"
+	AnalysisJobStatusSucceeded AnalysisJobStatus = "succeeded"
+	AnalysisJobStatusFailed    AnalysisJobStatus = "failed"
 )
 
-// AllValues returns all AuthorizeProvider values.
-func (AuthorizeProvider) AllValues() []AuthorizeProvider {
-	return []AuthorizeProvider{
-		AuthorizeProviderGoogle,
-		AuthorizeProviderLine,
+// AllValues returns all AnalysisJobStatus values.
+func (AnalysisJobStatus) AllValues() []AnalysisJobStatus {
+	return []AnalysisJobStatus{
+		AnalysisJobStatusQueued,
+		AnalysisJobStatusRunning,
+		AnalysisJobStatusSucceeded,
+		AnalysisJobStatusFailed,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s AuthorizeProvider) MarshalText() ([]byte, error) {
+func (s AnalysisJobStatus) MarshalText() ([]byte, error) {
 	switch s {
-	case AuthorizeProviderGoogle:
+	case AnalysisJobStatusQueued:
 		return []byte(s), nil
-	case AuthorizeProviderLine:
+	case AnalysisJobStatusRunning:
+		return []byte(s), nil
+	case AnalysisJobStatusSucceeded:
+		return []byte(s), nil
+	case AnalysisJobStatusFailed:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -247,339 +279,375 @@ func (s AuthorizeProvider) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *AuthorizeProvider) UnmarshalText(data []byte) error {
-	switch AuthorizeProvider(data) {
-	case AuthorizeProviderGoogle:
-		*s = AuthorizeProviderGoogle
+func (s *AnalysisJobStatus) UnmarshalText(data []byte) error {
+	switch AnalysisJobStatus(data) {
+	case AnalysisJobStatusQueued:
+		*s = AnalysisJobStatusQueued
 		return nil
-	case AuthorizeProviderLine:
-		*s = AuthorizeProviderLine
+	case AnalysisJobStatusRunning:
+		*s = AnalysisJobStatusRunning
+		return nil
+	case AnalysisJobStatusSucceeded:
+		*s = AnalysisJobStatusSucceeded
+		return nil
+	case AnalysisJobStatusFailed:
+		*s = AnalysisJobStatusFailed
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-type ChangePasswordBadRequest struct{}
+// ジョブの種類。report はレポート、wordcloud はワードクラウド.
+type AnalysisJobType string
 
-func (*ChangePasswordBadRequest) changePasswordRes() {}
+const (
+	AnalysisJobTypeReport    AnalysisJobType = "report"
+	AnalysisJobTypeWordcloud AnalysisJobType = "wordcloud"
+)
 
-type ChangePasswordInternalServerError struct{}
+// AllValues returns all AnalysisJobType values.
+func (AnalysisJobType) AllValues() []AnalysisJobType {
+	return []AnalysisJobType{
+		AnalysisJobTypeReport,
+		AnalysisJobTypeWordcloud,
+	}
+}
 
-func (*ChangePasswordInternalServerError) changePasswordRes() {}
+// MarshalText implements encoding.TextMarshaler.
+func (s AnalysisJobType) MarshalText() ([]byte, error) {
+	switch s {
+	case AnalysisJobTypeReport:
+		return []byte(s), nil
+	case AnalysisJobTypeWordcloud:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
 
-type ChangePasswordOK struct{}
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *AnalysisJobType) UnmarshalText(data []byte) error {
+	switch AnalysisJobType(data) {
+	case AnalysisJobTypeReport:
+		*s = AnalysisJobTypeReport
+		return nil
+	case AnalysisJobTypeWordcloud:
+		*s = AnalysisJobTypeWordcloud
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
 
-func (*ChangePasswordOK) changePasswordRes() {}
+// Ref: #/components/schemas/AnalysisReportResponse
+type AnalysisReportResponse struct {
+	// レポート本文.
+	Report OptString `json:"report"`
+	// 公開しているバージョンのID.
+	ReportVersionID OptString `json:"reportVersionID"`
+}
 
-type CheckDeviceExistsNotFound struct{}
+// GetReport returns the value of Report.
+func (s *AnalysisReportResponse) GetReport() OptString {
+	return s.Report
+}
 
-func (*CheckDeviceExistsNotFound) checkDeviceExistsRes() {}
+// GetReportVersionID returns the value of ReportVersionID.
+func (s *AnalysisReportResponse) GetReportVersionID() OptString {
+	return s.ReportVersionID
+}
 
-type CheckDeviceExistsOK struct {
-	Exists bool `json:"exists"`
+// SetReport sets the value of Report.
+func (s *AnalysisReportResponse) SetReport(val OptString) {
+	s.Report = val
 }
 
-// GetExists returns the value of Exists.
-func (s *CheckDeviceExistsOK) GetExists() bool {
-	return s.Exists
+// SetReportVersionID sets the value of ReportVersionID.
+func (s *AnalysisReportResponse) SetReportVersionID(val OptString) {
+	s.ReportVersionID = val
 }
 
-// SetExists sets the value of Exists.
-func (s *CheckDeviceExistsOK) SetExists(val bool) {
-	s.Exists = val
+// ある時点の分析結果.
+// Ref: #/components/schemas/AnalysisSnapshot
+type AnalysisSnapshot struct {
+	SnapshotID string `json:"snapshotID"`
+	// 分類されたユーザー数.
+	UserCount int `json:"userCount"`
+	// グループ数.
+	GroupCount int `json:"groupCount"`
+	// 分析した日時.
+	CreatedAt string `json:"createdAt"`
 }
 
-func (*CheckDeviceExistsOK) checkDeviceExistsRes() {}
+// GetSnapshotID returns the value of SnapshotID.
+func (s *AnalysisSnapshot) GetSnapshotID() string {
+	return s.SnapshotID
+}
 
-type CheckDeviceExistsUnauthorized struct{}
+// GetUserCount returns the value of UserCount.
+func (s *AnalysisSnapshot) GetUserCount() int {
+	return s.UserCount
+}
 
-func (*CheckDeviceExistsUnauthorized) checkDeviceExistsRes() {}
+// GetGroupCount returns the value of GroupCount.
+func (s *AnalysisSnapshot) GetGroupCount() int {
+	return s.GroupCount
+}
 
-// Ref: #/components/schemas/Conclusion
-type Conclusion struct {
-	// 作成ユーザー.
-	User User `json:"user"`
-	// 結論本文.
-	Content string `json:"content"`
+// GetCreatedAt returns the value of CreatedAt.
+func (s *AnalysisSnapshot) GetCreatedAt() string {
+	return s.CreatedAt
 }
 
-// GetUser returns the value of User.
-func (s *Conclusion) GetUser() User {
-	return s.User
+// SetSnapshotID sets the value of SnapshotID.
+func (s *AnalysisSnapshot) SetSnapshotID(val string) {
+	s.SnapshotID = val
 }
 
-// GetContent returns the value of Content.
-func (s *Conclusion) GetContent() string {
-	return s.Content
+// SetUserCount sets the value of UserCount.
+func (s *AnalysisSnapshot) SetUserCount(val int) {
+	s.UserCount = val
 }
 
-// SetUser sets the value of User.
-func (s *Conclusion) SetUser(val User) {
-	s.User = val
+// SetGroupCount sets the value of GroupCount.
+func (s *AnalysisSnapshot) SetGroupCount(val int) {
+	s.GroupCount = val
 }
 
-// SetContent sets the value of Content.
-func (s *Conclusion) SetContent(val string) {
-	s.Content = val
+// SetCreatedAt sets the value of CreatedAt.
+func (s *AnalysisSnapshot) SetCreatedAt(val string) {
+	s.CreatedAt = val
 }
 
-func (*Conclusion) getConclusionRes()  {}
-func (*Conclusion) postConclusionRes() {}
+// スナップショット時点のグループの代表意見.
+// Ref: #/components/schemas/AnalysisSnapshotGroup
+type AnalysisSnapshotGroup struct {
+	GroupName string                    `json:"groupName"`
+	GroupID   int                       `json:"groupID"`
+	Opinions  []AnalysisSnapshotOpinion `json:"opinions"`
+}
 
-type ConsentTalkSessionBadRequest struct{}
+// GetGroupName returns the value of GroupName.
+func (s *AnalysisSnapshotGroup) GetGroupName() string {
+	return s.GroupName
+}
 
-func (*ConsentTalkSessionBadRequest) consentTalkSessionRes() {}
+// GetGroupID returns the value of GroupID.
+func (s *AnalysisSnapshotGroup) GetGroupID() int {
+	return s.GroupID
+}
 
-type ConsentTalkSessionInternalServerError struct{}
+// GetOpinions returns the value of Opinions.
+func (s *AnalysisSnapshotGroup) GetOpinions() []AnalysisSnapshotOpinion {
+	return s.Opinions
+}
 
-func (*ConsentTalkSessionInternalServerError) consentTalkSessionRes() {}
+// SetGroupName sets the value of GroupName.
+func (s *AnalysisSnapshotGroup) SetGroupName(val string) {
+	s.GroupName = val
+}
 
-type ConsentTalkSessionOK struct{}
+// SetGroupID sets the value of GroupID.
+func (s *AnalysisSnapshotGroup) SetGroupID(val int) {
+	s.GroupID = val
+}
 
-func (*ConsentTalkSessionOK) consentTalkSessionRes() {}
-
-type CookieAuth struct {
-	APIKey string
-	Roles  []string
-}
-
-// GetAPIKey returns the value of APIKey.
-func (s *CookieAuth) GetAPIKey() string {
-	return s.APIKey
-}
-
-// GetRoles returns the value of Roles.
-func (s *CookieAuth) GetRoles() []string {
-	return s.Roles
-}
-
-// SetAPIKey sets the value of APIKey.
-func (s *CookieAuth) SetAPIKey(val string) {
-	s.APIKey = val
+// SetOpinions sets the value of Opinions.
+func (s *AnalysisSnapshotGroup) SetOpinions(val []AnalysisSnapshotOpinion) {
+	s.Opinions = val
 }
 
-// SetRoles sets the value of Roles.
-func (s *CookieAuth) SetRoles(val []string) {
-	s.Roles = val
+// Ref: #/components/schemas/AnalysisSnapshotOpinion
+type AnalysisSnapshotOpinion struct {
+	Opinion       Opinion `json:"opinion"`
+	User          User    `json:"user"`
+	AgreeCount    int     `json:"agreeCount"`
+	DisagreeCount int     `json:"disagreeCount"`
+	PassCount     int     `json:"passCount"`
 }
 
-type CreateOrganizationAliasBadRequest struct{}
-
-func (*CreateOrganizationAliasBadRequest) createOrganizationAliasRes() {}
-
-type CreateOrganizationAliasInternalServerError struct{}
-
-func (*CreateOrganizationAliasInternalServerError) createOrganizationAliasRes() {}
-
-type CreateOrganizationAliasReq struct {
-	AliasName string `json:"aliasName"`
+// GetOpinion returns the value of Opinion.
+func (s *AnalysisSnapshotOpinion) GetOpinion() Opinion {
+	return s.Opinion
 }
 
-// GetAliasName returns the value of AliasName.
-func (s *CreateOrganizationAliasReq) GetAliasName() string {
-	return s.AliasName
+// GetUser returns the value of User.
+func (s *AnalysisSnapshotOpinion) GetUser() User {
+	return s.User
 }
 
-// SetAliasName sets the value of AliasName.
-func (s *CreateOrganizationAliasReq) SetAliasName(val string) {
-	s.AliasName = val
+// GetAgreeCount returns the value of AgreeCount.
+func (s *AnalysisSnapshotOpinion) GetAgreeCount() int {
+	return s.AgreeCount
 }
 
-// DeleteDeviceNoContent is response for DeleteDevice operation.
-type DeleteDeviceNoContent struct{}
-
-func (*DeleteDeviceNoContent) deleteDeviceRes() {}
-
-type DeleteDeviceNotFound struct{}
-
-func (*DeleteDeviceNotFound) deleteDeviceRes() {}
-
-type DeleteDeviceUnauthorized struct{}
-
-func (*DeleteDeviceUnauthorized) deleteDeviceRes() {}
-
-type DeleteOrganizationAliasBadRequest struct{}
-
-func (*DeleteOrganizationAliasBadRequest) deleteOrganizationAliasRes() {}
-
-type DeleteOrganizationAliasInternalServerError struct{}
-
-func (*DeleteOrganizationAliasInternalServerError) deleteOrganizationAliasRes() {}
-
-type DeleteOrganizationAliasOK struct{}
-
-func (*DeleteOrganizationAliasOK) deleteOrganizationAliasRes() {}
-
-type DevAuthorizeBadRequest struct{}
-
-func (*DevAuthorizeBadRequest) devAuthorizeRes() {}
-
-type DevAuthorizeFound struct{}
-
-// DevAuthorizeFoundHeaders wraps DevAuthorizeFound with response headers.
-type DevAuthorizeFoundHeaders struct {
-	Location  OptString
-	SetCookie []string
-	Response  DevAuthorizeFound
+// GetDisagreeCount returns the value of DisagreeCount.
+func (s *AnalysisSnapshotOpinion) GetDisagreeCount() int {
+	return s.DisagreeCount
 }
 
-// GetLocation returns the value of Location.
-func (s *DevAuthorizeFoundHeaders) GetLocation() OptString {
-	return s.Location
+// GetPassCount returns the value of PassCount.
+func (s *AnalysisSnapshotOpinion) GetPassCount() int {
+	return s.PassCount
 }
 
-// GetSetCookie returns the value of SetCookie.
-func (s *DevAuthorizeFoundHeaders) GetSetCookie() []string {
-	return s.SetCookie
+// SetOpinion sets the value of Opinion.
+func (s *AnalysisSnapshotOpinion) SetOpinion(val Opinion) {
+	s.Opinion = val
 }
 
-// GetResponse returns the value of Response.
-func (s *DevAuthorizeFoundHeaders) GetResponse() DevAuthorizeFound {
-	return s.Response
+// SetUser sets the value of User.
+func (s *AnalysisSnapshotOpinion) SetUser(val User) {
+	s.User = val
 }
 
-// SetLocation sets the value of Location.
-func (s *DevAuthorizeFoundHeaders) SetLocation(val OptString) {
-	s.Location = val
+// SetAgreeCount sets the value of AgreeCount.
+func (s *AnalysisSnapshotOpinion) SetAgreeCount(val int) {
+	s.AgreeCount = val
 }
 
-// SetSetCookie sets the value of SetCookie.
-func (s *DevAuthorizeFoundHeaders) SetSetCookie(val []string) {
-	s.SetCookie = val
+// SetDisagreeCount sets the value of DisagreeCount.
+func (s *AnalysisSnapshotOpinion) SetDisagreeCount(val int) {
+	s.DisagreeCount = val
 }
 
-// SetResponse sets the value of Response.
-func (s *DevAuthorizeFoundHeaders) SetResponse(val DevAuthorizeFound) {
-	s.Response = val
+// SetPassCount sets the value of PassCount.
+func (s *AnalysisSnapshotOpinion) SetPassCount(val int) {
+	s.PassCount = val
 }
 
-func (*DevAuthorizeFoundHeaders) devAuthorizeRes() {}
-
-type DevAuthorizeInternalServerError struct{}
-
-func (*DevAuthorizeInternalServerError) devAuthorizeRes() {}
-
-// デバイス情報.
-// Ref: #/components/schemas/Device
-type Device struct {
-	DeviceID     string         `json:"device_id"`
-	UserID       string         `json:"user_id"`
-	Platform     DevicePlatform `json:"platform"`
-	DeviceName   OptString      `json:"device_name"`
-	Enabled      bool           `json:"enabled"`
-	LastActiveAt OptString      `json:"last_active_at"`
-	CreatedAt    string         `json:"created_at"`
-	UpdatedAt    string         `json:"updated_at"`
+// 分析の再計算の状況.
+// Ref: #/components/schemas/AnalysisStatus
+type AnalysisStatus struct {
+	// 再計算の状況。idle は待っている投票・意見がない、pending
+	// は再計算を待っている、running は再計算中.
+	Status AnalysisStatusStatus `json:"status"`
+	// 最後の分析以降の投票数.
+	PendingVoteCount int `json:"pendingVoteCount"`
+	// 最後の分析以降の意見数.
+	PendingOpinionCount int `json:"pendingOpinionCount"`
+	// 最後の分析以降で最初に投票・意見があった日時.
+	PendingSince OptString `json:"pendingSince"`
+	// 投票数が足りない場合に再計算する日時.
+	NextRunAt OptString `json:"nextRunAt"`
+	// 実行中の再計算を開始した日時.
+	RunningSince OptString `json:"runningSince"`
+	// 最後に再計算が完了した日時.
+	LastCompletedAt OptString `json:"lastCompletedAt"`
+	// 最後に再計算が失敗した日時.
+	LastFailedAt OptString `json:"lastFailedAt"`
 }
 
-// GetDeviceID returns the value of DeviceID.
-func (s *Device) GetDeviceID() string {
-	return s.DeviceID
+// GetStatus returns the value of Status.
+func (s *AnalysisStatus) GetStatus() AnalysisStatusStatus {
+	return s.Status
 }
 
-// GetUserID returns the value of UserID.
-func (s *Device) GetUserID() string {
-	return s.UserID
+// GetPendingVoteCount returns the value of PendingVoteCount.
+func (s *AnalysisStatus) GetPendingVoteCount() int {
+	return s.PendingVoteCount
 }
 
-// GetPlatform returns the value of Platform.
-func (s *Device) GetPlatform() DevicePlatform {
-	return s.Platform
+// GetPendingOpinionCount returns the value of PendingOpinionCount.
+func (s *AnalysisStatus) GetPendingOpinionCount() int {
+	return s.PendingOpinionCount
 }
 
-// GetDeviceName returns the value of DeviceName.
-func (s *Device) GetDeviceName() OptString {
-	return s.DeviceName
+// GetPendingSince returns the value of PendingSince.
+func (s *AnalysisStatus) GetPendingSince() OptString {
+	return s.PendingSince
 }
 
-// GetEnabled returns the value of Enabled.
-func (s *Device) GetEnabled() bool {
-	return s.Enabled
+// GetNextRunAt returns the value of NextRunAt.
+func (s *AnalysisStatus) GetNextRunAt() OptString {
+	return s.NextRunAt
 }
 
-// GetLastActiveAt returns the value of LastActiveAt.
-func (s *Device) GetLastActiveAt() OptString {
-	return s.LastActiveAt
+// GetRunningSince returns the value of RunningSince.
+func (s *AnalysisStatus) GetRunningSince() OptString {
+	return s.RunningSince
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *Device) GetCreatedAt() string {
-	return s.CreatedAt
+// GetLastCompletedAt returns the value of LastCompletedAt.
+func (s *AnalysisStatus) GetLastCompletedAt() OptString {
+	return s.LastCompletedAt
 }
 
-// GetUpdatedAt returns the value of UpdatedAt.
-func (s *Device) GetUpdatedAt() string {
-	return s.UpdatedAt
+// GetLastFailedAt returns the value of LastFailedAt.
+func (s *AnalysisStatus) GetLastFailedAt() OptString {
+	return s.LastFailedAt
 }
 
-// SetDeviceID sets the value of DeviceID.
-func (s *Device) SetDeviceID(val string) {
-	s.DeviceID = val
+// SetStatus sets the value of Status.
+func (s *AnalysisStatus) SetStatus(val AnalysisStatusStatus) {
+	s.Status = val
 }
 
-// SetUserID sets the value of UserID.
-func (s *Device) SetUserID(val string) {
-	s.UserID = val
+// SetPendingVoteCount sets the value of PendingVoteCount.
+func (s *AnalysisStatus) SetPendingVoteCount(val int) {
+	s.PendingVoteCount = val
 }
 
-// SetPlatform sets the value of Platform.
-func (s *Device) SetPlatform(val DevicePlatform) {
-	s.Platform = val
+// SetPendingOpinionCount sets the value of PendingOpinionCount.
+func (s *AnalysisStatus) SetPendingOpinionCount(val int) {
+	s.PendingOpinionCount = val
 }
 
-// SetDeviceName sets the value of DeviceName.
-func (s *Device) SetDeviceName(val OptString) {
-	s.DeviceName = val
+// SetPendingSince sets the value of PendingSince.
+func (s *AnalysisStatus) SetPendingSince(val OptString) {
+	s.PendingSince = val
 }
 
-// SetEnabled sets the value of Enabled.
-func (s *Device) SetEnabled(val bool) {
-	s.Enabled = val
+// SetNextRunAt sets the value of NextRunAt.
+func (s *AnalysisStatus) SetNextRunAt(val OptString) {
+	s.NextRunAt = val
 }
 
-// SetLastActiveAt sets the value of LastActiveAt.
-func (s *Device) SetLastActiveAt(val OptString) {
-	s.LastActiveAt = val
+// SetRunningSince sets the value of RunningSince.
+func (s *AnalysisStatus) SetRunningSince(val OptString) {
+	s.RunningSince = val
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *Device) SetCreatedAt(val string) {
-	s.CreatedAt = val
+// SetLastCompletedAt sets the value of LastCompletedAt.
+func (s *AnalysisStatus) SetLastCompletedAt(val OptString) {
+	s.LastCompletedAt = val
 }
 
-// SetUpdatedAt sets the value of UpdatedAt.
-func (s *Device) SetUpdatedAt(val string) {
-	s.UpdatedAt = val
+// SetLastFailedAt sets the value of LastFailedAt.
+func (s *AnalysisStatus) SetLastFailedAt(val OptString) {
+	s.LastFailedAt = val
 }
 
-func (*Device) registerDeviceRes() {}
+func (*AnalysisStatus) getAnalysisStatusRes() {}
 
-type DevicePlatform string
+// 再計算の状況。idle は待っている投票・意見がない、pending
+// は再計算を待っている、running は再計算中.
+type AnalysisStatusStatus string
 
 const (
-	DevicePlatformIos     DevicePlatform = "ios"
-	DevicePlatformAndroid DevicePlatform = "android"
-	DevicePlatformWeb     DevicePlatform = "web"
+	AnalysisStatusStatusIdle    AnalysisStatusStatus = "idle"
+	AnalysisStatusStatusPending AnalysisStatusStatus = "pending"
+	AnalysisStatusStatusRunning AnalysisStatusStatus = "running"
 )
 
-// AllValues returns all DevicePlatform values.
-func (DevicePlatform) AllValues() []DevicePlatform {
-	return []DevicePlatform{
-		DevicePlatformIos,
-		DevicePlatformAndroid,
-		DevicePlatformWeb,
+// AllValues returns all AnalysisStatusStatus values.
+func (AnalysisStatusStatus) AllValues() []AnalysisStatusStatus {
+	return []AnalysisStatusStatus{
+		AnalysisStatusStatusIdle,
+		AnalysisStatusStatusPending,
+		AnalysisStatusStatusRunning,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s DevicePlatform) MarshalText() ([]byte, error) {
+func (s AnalysisStatusStatus) MarshalText() ([]byte, error) {
 	switch s {
-	case DevicePlatformIos:
+	case AnalysisStatusStatusIdle:
 		return []byte(s), nil
-	case DevicePlatformAndroid:
+	case AnalysisStatusStatusPending:
 		return []byte(s), nil
-	case DevicePlatformWeb:
+	case AnalysisStatusStatusRunning:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -587,980 +655,1060 @@ func (s DevicePlatform) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *DevicePlatform) UnmarshalText(data []byte) error {
-	switch DevicePlatform(data) {
-	case DevicePlatformIos:
-		*s = DevicePlatformIos
+func (s *AnalysisStatusStatus) UnmarshalText(data []byte) error {
+	switch AnalysisStatusStatus(data) {
+	case AnalysisStatusStatusIdle:
+		*s = AnalysisStatusStatusIdle
 		return nil
-	case DevicePlatformAndroid:
-		*s = DevicePlatformAndroid
+	case AnalysisStatusStatusPending:
+		*s = AnalysisStatusStatusPending
 		return nil
-	case DevicePlatformWeb:
-		*s = DevicePlatformWeb
+	case AnalysisStatusStatusRunning:
+		*s = AnalysisStatusStatusRunning
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-type DummyInitBadRequest struct{}
-
-func (*DummyInitBadRequest) dummyInitRes() {}
+type ApplyFeedbackToReportInternalServerError struct{}
 
-type DummyInitInternalServerError struct{}
+func (*ApplyFeedbackToReportInternalServerError) applyFeedbackToReportRes() {}
 
-func (*DummyInitInternalServerError) dummyInitRes() {}
+type ApplyFeedbackToReportOK struct{}
 
-type DummyInitOK struct{}
+func (*ApplyFeedbackToReportOK) applyFeedbackToReportRes() {}
 
-func (*DummyInitOK) dummyInitRes() {}
+type ApplyFeedbackToReportReq struct {
+	ReportID     string `json:"reportID"`
+	FeedbackType string `json:"feedbackType"`
+}
 
-type DummyInitReq struct {
-	IntegerNull         NilInt        `json:"integerNull"`
-	NumericOptionalNull OptNilFloat64 `json:"numericOptionalNull"`
-	NumericNull         NilFloat64    `json:"numericNull"`
-	NumericOptional     OptFloat64    `json:"numericOptional"`
-	Numeric             float64       `json:"numeric"`
-	BooleanOptionalNull OptNilBool    `json:"booleanOptionalNull"`
-	BooleanNull         NilBool       `json:"booleanNull"`
+// GetReportID returns the value of ReportID.
+func (s *ApplyFeedbackToReportReq) GetReportID() string {
+	return s.ReportID
 }
 
-// GetIntegerNull returns the value of IntegerNull.
-func (s *DummyInitReq) GetIntegerNull() NilInt {
-	return s.IntegerNull
+// GetFeedbackType returns the value of FeedbackType.
+func (s *ApplyFeedbackToReportReq) GetFeedbackType() string {
+	return s.FeedbackType
 }
 
-// GetNumericOptionalNull returns the value of NumericOptionalNull.
-func (s *DummyInitReq) GetNumericOptionalNull() OptNilFloat64 {
-	return s.NumericOptionalNull
+// SetReportID sets the value of ReportID.
+func (s *ApplyFeedbackToReportReq) SetReportID(val string) {
+	s.ReportID = val
 }
 
-// GetNumericNull returns the value of NumericNull.
-func (s *DummyInitReq) GetNumericNull() NilFloat64 {
-	return s.NumericNull
+// SetFeedbackType sets the value of FeedbackType.
+func (s *ApplyFeedbackToReportReq) SetFeedbackType(val string) {
+	s.FeedbackType = val
 }
 
-// GetNumericOptional returns the value of NumericOptional.
-func (s *DummyInitReq) GetNumericOptional() OptFloat64 {
-	return s.NumericOptional
+type AuthAccountDetachBadRequest struct{}
+
+func (*AuthAccountDetachBadRequest) authAccountDetachRes() {}
+
+type AuthAccountDetachInternalServerError struct{}
+
+func (*AuthAccountDetachInternalServerError) authAccountDetachRes() {}
+
+type AuthAccountDetachOK struct{}
+
+// AuthAccountDetachOKHeaders wraps AuthAccountDetachOK with response headers.
+type AuthAccountDetachOKHeaders struct {
+	SetCookie []string
+	Response  AuthAccountDetachOK
 }
 
-// GetNumeric returns the value of Numeric.
-func (s *DummyInitReq) GetNumeric() float64 {
-	return s.Numeric
+// GetSetCookie returns the value of SetCookie.
+func (s *AuthAccountDetachOKHeaders) GetSetCookie() []string {
+	return s.SetCookie
 }
 
-// GetBooleanOptionalNull returns the value of BooleanOptionalNull.
-func (s *DummyInitReq) GetBooleanOptionalNull() OptNilBool {
-	return s.BooleanOptionalNull
+// GetResponse returns the value of Response.
+func (s *AuthAccountDetachOKHeaders) GetResponse() AuthAccountDetachOK {
+	return s.Response
 }
 
-// GetBooleanNull returns the value of BooleanNull.
-func (s *DummyInitReq) GetBooleanNull() NilBool {
-	return s.BooleanNull
+// SetSetCookie sets the value of SetCookie.
+func (s *AuthAccountDetachOKHeaders) SetSetCookie(val []string) {
+	s.SetCookie = val
 }
 
-// SetIntegerNull sets the value of IntegerNull.
-func (s *DummyInitReq) SetIntegerNull(val NilInt) {
-	s.IntegerNull = val
+// SetResponse sets the value of Response.
+func (s *AuthAccountDetachOKHeaders) SetResponse(val AuthAccountDetachOK) {
+	s.Response = val
 }
 
-// SetNumericOptionalNull sets the value of NumericOptionalNull.
-func (s *DummyInitReq) SetNumericOptionalNull(val OptNilFloat64) {
-	s.NumericOptionalNull = val
+func (*AuthAccountDetachOKHeaders) authAccountDetachRes() {}
+
+type AuthorizeBadRequest struct{}
+
+func (*AuthorizeBadRequest) authorizeRes() {}
+
+type AuthorizeFound struct{}
+
+// AuthorizeFoundHeaders wraps AuthorizeFound with response headers.
+type AuthorizeFoundHeaders struct {
+	Location  string
+	SetCookie []string
+	Response  AuthorizeFound
 }
 
-// SetNumericNull sets the value of NumericNull.
-func (s *DummyInitReq) SetNumericNull(val NilFloat64) {
-	s.NumericNull = val
+// GetLocation returns the value of Location.
+func (s *AuthorizeFoundHeaders) GetLocation() string {
+	return s.Location
 }
 
-// SetNumericOptional sets the value of NumericOptional.
-func (s *DummyInitReq) SetNumericOptional(val OptFloat64) {
-	s.NumericOptional = val
+// GetSetCookie returns the value of SetCookie.
+func (s *AuthorizeFoundHeaders) GetSetCookie() []string {
+	return s.SetCookie
 }
 
-// SetNumeric sets the value of Numeric.
-func (s *DummyInitReq) SetNumeric(val float64) {
-	s.Numeric = val
+// GetResponse returns the value of Response.
+func (s *AuthorizeFoundHeaders) GetResponse() AuthorizeFound {
+	return s.Response
 }
 
-// SetBooleanOptionalNull sets the value of BooleanOptionalNull.
-func (s *DummyInitReq) SetBooleanOptionalNull(val OptNilBool) {
-	s.BooleanOptionalNull = val
+// SetLocation sets the value of Location.
+func (s *AuthorizeFoundHeaders) SetLocation(val string) {
+	s.Location = val
 }
 
-// SetBooleanNull sets the value of BooleanNull.
-func (s *DummyInitReq) SetBooleanNull(val NilBool) {
-	s.BooleanNull = val
+// SetSetCookie sets the value of SetCookie.
+func (s *AuthorizeFoundHeaders) SetSetCookie(val []string) {
+	s.SetCookie = val
 }
 
-type EditTalkSessionBadRequest struct{}
+// SetResponse sets the value of Response.
+func (s *AuthorizeFoundHeaders) SetResponse(val AuthorizeFound) {
+	s.Response = val
+}
 
-func (*EditTalkSessionBadRequest) editTalkSessionRes() {}
+func (*AuthorizeFoundHeaders) authorizeRes() {}
 
-type EditTalkSessionInternalServerError struct{}
+type AuthorizeInternalServerError struct{}
 
-func (*EditTalkSessionInternalServerError) editTalkSessionRes() {}
+func (*AuthorizeInternalServerError) authorizeRes() {}
 
-type EditTalkSessionReq struct {
-	Theme            string     `json:"theme"`
-	ScheduledEndTime time.Time  `json:"scheduledEndTime"`
-	Latitude         OptFloat64 `json:"latitude"`
-	Longitude        OptFloat64 `json:"longitude"`
-	Prefecture       OptString  `json:"prefecture"`
-	City             OptString  `json:"city"`
-	Description      OptString  `json:"description"`
-	ThumbnailURL     OptString  `json:"thumbnailURL"`
-	HideTop          OptNilBool `json:"hideTop"`
+type AuthorizeProvider string
+
+const (
+	AuthorizeProviderGoogle AuthorizeProvider = "google"
+	AuthorizeProviderLine   AuthorizeProvider = "line"
+)
+
+// AllValues returns all AuthorizeProvider values.
+func (AuthorizeProvider) AllValues() []AuthorizeProvider {
+	return []AuthorizeProvider{
+		AuthorizeProviderGoogle,
+		AuthorizeProviderLine,
+	}
 }
 
-// GetTheme returns the value of Theme.
-func (s *EditTalkSessionReq) GetTheme() string {
-	return s.Theme
+// MarshalText implements encoding.TextMarshaler.
+func (s AuthorizeProvider) MarshalText() ([]byte, error) {
+	switch s {
+	case AuthorizeProviderGoogle:
+		return []byte(s), nil
+	case AuthorizeProviderLine:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// GetScheduledEndTime returns the value of ScheduledEndTime.
-func (s *EditTalkSessionReq) GetScheduledEndTime() time.Time {
-	return s.ScheduledEndTime
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *AuthorizeProvider) UnmarshalText(data []byte) error {
+	switch AuthorizeProvider(data) {
+	case AuthorizeProviderGoogle:
+		*s = AuthorizeProviderGoogle
+		return nil
+	case AuthorizeProviderLine:
+		*s = AuthorizeProviderLine
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// GetLatitude returns the value of Latitude.
-func (s *EditTalkSessionReq) GetLatitude() OptFloat64 {
-	return s.Latitude
+type BatchVoteBadRequest struct{}
+
+func (*BatchVoteBadRequest) batchVoteRes() {}
+
+type BatchVoteInternalServerError struct{}
+
+func (*BatchVoteInternalServerError) batchVoteRes() {}
+
+// 一括投票の投票.
+// Ref: #/components/schemas/BatchVoteItem
+type BatchVoteItem struct {
+	// 端末で投票ごとに発行するキー。同じキーの投票は一度だけ反映する.
+	IdempotencyKey string                  `json:"idempotencyKey"`
+	OpinionID      string                  `json:"opinionID"`
+	VoteStatus     BatchVoteItemVoteStatus `json:"voteStatus"`
+	// 重要な意見として印を付けるか。省略した場合は変更しない.
+	IsImportant OptBool `json:"isImportant"`
+	// 端末で投票した日時.
+	VotedAt time.Time `json:"votedAt"`
 }
 
-// GetLongitude returns the value of Longitude.
-func (s *EditTalkSessionReq) GetLongitude() OptFloat64 {
-	return s.Longitude
+// GetIdempotencyKey returns the value of IdempotencyKey.
+func (s *BatchVoteItem) GetIdempotencyKey() string {
+	return s.IdempotencyKey
 }
 
-// GetPrefecture returns the value of Prefecture.
-func (s *EditTalkSessionReq) GetPrefecture() OptString {
-	return s.Prefecture
+// GetOpinionID returns the value of OpinionID.
+func (s *BatchVoteItem) GetOpinionID() string {
+	return s.OpinionID
 }
 
-// GetCity returns the value of City.
-func (s *EditTalkSessionReq) GetCity() OptString {
-	return s.City
+// GetVoteStatus returns the value of VoteStatus.
+func (s *BatchVoteItem) GetVoteStatus() BatchVoteItemVoteStatus {
+	return s.VoteStatus
 }
 
-// GetDescription returns the value of Description.
-func (s *EditTalkSessionReq) GetDescription() OptString {
-	return s.Description
+// GetIsImportant returns the value of IsImportant.
+func (s *BatchVoteItem) GetIsImportant() OptBool {
+	return s.IsImportant
 }
 
-// GetThumbnailURL returns the value of ThumbnailURL.
-func (s *EditTalkSessionReq) GetThumbnailURL() OptString {
-	return s.ThumbnailURL
+// GetVotedAt returns the value of VotedAt.
+func (s *BatchVoteItem) GetVotedAt() time.Time {
+	return s.VotedAt
 }
 
-// GetHideTop returns the value of HideTop.
-func (s *EditTalkSessionReq) GetHideTop() OptNilBool {
-	return s.HideTop
+// SetIdempotencyKey sets the value of IdempotencyKey.
+func (s *BatchVoteItem) SetIdempotencyKey(val string) {
+	s.IdempotencyKey = val
 }
 
-// SetTheme sets the value of Theme.
-func (s *EditTalkSessionReq) SetTheme(val string) {
-	s.Theme = val
+// SetOpinionID sets the value of OpinionID.
+func (s *BatchVoteItem) SetOpinionID(val string) {
+	s.OpinionID = val
 }
 
-// SetScheduledEndTime sets the value of ScheduledEndTime.
-func (s *EditTalkSessionReq) SetScheduledEndTime(val time.Time) {
-	s.ScheduledEndTime = val
+// SetVoteStatus sets the value of VoteStatus.
+func (s *BatchVoteItem) SetVoteStatus(val BatchVoteItemVoteStatus) {
+	s.VoteStatus = val
 }
 
-// SetLatitude sets the value of Latitude.
-func (s *EditTalkSessionReq) SetLatitude(val OptFloat64) {
-	s.Latitude = val
+// SetIsImportant sets the value of IsImportant.
+func (s *BatchVoteItem) SetIsImportant(val OptBool) {
+	s.IsImportant = val
 }
 
-// SetLongitude sets the value of Longitude.
-func (s *EditTalkSessionReq) SetLongitude(val OptFloat64) {
-	s.Longitude = val
+// SetVotedAt sets the value of VotedAt.
+func (s *BatchVoteItem) SetVotedAt(val time.Time) {
+	s.VotedAt = val
 }
 
-// SetPrefecture sets the value of Prefecture.
-func (s *EditTalkSessionReq) SetPrefecture(val OptString) {
-	s.Prefecture = val
+type BatchVoteItemVoteStatus string
+
+const (
+	BatchVoteItemVoteStatusAgree    BatchVoteItemVoteStatus = "agree"
+	BatchVoteItemVoteStatusDisagree BatchVoteItemVoteStatus = "disagree"
+	BatchVoteItemVoteStatusPass     BatchVoteItemVoteStatus = "pass"
+)
+
+// AllValues returns all BatchVoteItemVoteStatus values.
+func (BatchVoteItemVoteStatus) AllValues() []BatchVoteItemVoteStatus {
+	return []BatchVoteItemVoteStatus{
+		BatchVoteItemVoteStatusAgree,
+		BatchVoteItemVoteStatusDisagree,
+		BatchVoteItemVoteStatusPass,
+	}
 }
 
-// SetCity sets the value of City.
-func (s *EditTalkSessionReq) SetCity(val OptString) {
-	s.City = val
+// MarshalText implements encoding.TextMarshaler.
+func (s BatchVoteItemVoteStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case BatchVoteItemVoteStatusAgree:
+		return []byte(s), nil
+	case BatchVoteItemVoteStatusDisagree:
+		return []byte(s), nil
+	case BatchVoteItemVoteStatusPass:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetDescription sets the value of Description.
-func (s *EditTalkSessionReq) SetDescription(val OptString) {
-	s.Description = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *BatchVoteItemVoteStatus) UnmarshalText(data []byte) error {
+	switch BatchVoteItemVoteStatus(data) {
+	case BatchVoteItemVoteStatusAgree:
+		*s = BatchVoteItemVoteStatusAgree
+		return nil
+	case BatchVoteItemVoteStatusDisagree:
+		*s = BatchVoteItemVoteStatusDisagree
+		return nil
+	case BatchVoteItemVoteStatusPass:
+		*s = BatchVoteItemVoteStatusPass
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// SetThumbnailURL sets the value of ThumbnailURL.
-func (s *EditTalkSessionReq) SetThumbnailURL(val OptString) {
-	s.ThumbnailURL = val
+type BatchVoteOK struct {
+	// 送信された順の投票ごとの結果.
+	Results []BatchVoteResult `json:"results"`
 }
 
-// SetHideTop sets the value of HideTop.
-func (s *EditTalkSessionReq) SetHideTop(val OptNilBool) {
-	s.HideTop = val
+// GetResults returns the value of Results.
+func (s *BatchVoteOK) GetResults() []BatchVoteResult {
+	return s.Results
 }
 
-type EditTimeLineBadRequest struct{}
+// SetResults sets the value of Results.
+func (s *BatchVoteOK) SetResults(val []BatchVoteResult) {
+	s.Results = val
+}
 
-func (*EditTimeLineBadRequest) editTimeLineRes() {}
+func (*BatchVoteOK) batchVoteRes() {}
 
-type EditTimeLineInternalServerError struct{}
+type BatchVoteReq struct {
+	// 1件以上100件以下.
+	Votes []BatchVoteItem `json:"votes"`
+}
 
-func (*EditTimeLineInternalServerError) editTimeLineRes() {}
+// GetVotes returns the value of Votes.
+func (s *BatchVoteReq) GetVotes() []BatchVoteItem {
+	return s.Votes
+}
 
-type EditTimeLineReq struct {
-	Content NilString `json:"content"`
-	Status  NilString `json:"status"`
+// SetVotes sets the value of Votes.
+func (s *BatchVoteReq) SetVotes(val []BatchVoteItem) {
+	s.Votes = val
 }
 
-// GetContent returns the value of Content.
-func (s *EditTimeLineReq) GetContent() NilString {
-	return s.Content
+// 一括投票の投票ごとの結果.
+// Ref: #/components/schemas/BatchVoteResult
+type BatchVoteResult struct {
+	IdempotencyKey string `json:"idempotencyKey"`
+	OpinionID      string `json:"opinionID"`
+	// 処理結果。applied は反映済み、duplicate
+	// は同じ冪等キーの投票を処理済み、failed は投票できなかった.
+	Status BatchVoteResultStatus `json:"status"`
+	// 投票できなかった場合のエラー。処理済みの投票が投票できなかった場合も返す.
+	Error OptBatchVoteResultError `json:"error"`
+}
+
+// GetIdempotencyKey returns the value of IdempotencyKey.
+func (s *BatchVoteResult) GetIdempotencyKey() string {
+	return s.IdempotencyKey
+}
+
+// GetOpinionID returns the value of OpinionID.
+func (s *BatchVoteResult) GetOpinionID() string {
+	return s.OpinionID
 }
 
 // GetStatus returns the value of Status.
-func (s *EditTimeLineReq) GetStatus() NilString {
+func (s *BatchVoteResult) GetStatus() BatchVoteResultStatus {
 	return s.Status
 }
 
-// SetContent sets the value of Content.
-func (s *EditTimeLineReq) SetContent(val NilString) {
-	s.Content = val
+// GetError returns the value of Error.
+func (s *BatchVoteResult) GetError() OptBatchVoteResultError {
+	return s.Error
+}
+
+// SetIdempotencyKey sets the value of IdempotencyKey.
+func (s *BatchVoteResult) SetIdempotencyKey(val string) {
+	s.IdempotencyKey = val
+}
+
+// SetOpinionID sets the value of OpinionID.
+func (s *BatchVoteResult) SetOpinionID(val string) {
+	s.OpinionID = val
 }
 
 // SetStatus sets the value of Status.
-func (s *EditTimeLineReq) SetStatus(val NilString) {
+func (s *BatchVoteResult) SetStatus(val BatchVoteResultStatus) {
 	s.Status = val
 }
 
-type EstablishOrganizationBadRequest struct{}
-
-func (*EstablishOrganizationBadRequest) establishOrganizationRes() {}
+// SetError sets the value of Error.
+func (s *BatchVoteResult) SetError(val OptBatchVoteResultError) {
+	s.Error = val
+}
 
-type EstablishOrganizationInternalServerError struct{}
-
-func (*EstablishOrganizationInternalServerError) establishOrganizationRes() {}
-
-type EstablishOrganizationOK struct{}
-
-func (*EstablishOrganizationOK) establishOrganizationRes() {}
-
-type EstablishOrganizationReq struct {
-	// 組織名.
-	Name string `json:"name"`
-	// 組織コード（ログイン時に使用）
-	// 4文字以上127文字以下の英数字 _- のみ使用可能.
-	Code string `json:"code"`
-	// 組織タイプ
-	// - 1: 通常（基本これ）.
-	OrgType float64 `json:"orgType"`
-	// 組織アイコン.
-	Icon OptMultipartFile `json:"icon"`
-}
-
-// GetName returns the value of Name.
-func (s *EstablishOrganizationReq) GetName() string {
-	return s.Name
+// 投票できなかった場合のエラー。処理済みの投票が投票できなかった場合も返す.
+type BatchVoteResultError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
 }
 
 // GetCode returns the value of Code.
-func (s *EstablishOrganizationReq) GetCode() string {
+func (s *BatchVoteResultError) GetCode() string {
 	return s.Code
 }
 
-// GetOrgType returns the value of OrgType.
-func (s *EstablishOrganizationReq) GetOrgType() float64 {
-	return s.OrgType
-}
-
-// GetIcon returns the value of Icon.
-func (s *EstablishOrganizationReq) GetIcon() OptMultipartFile {
-	return s.Icon
-}
-
-// SetName sets the value of Name.
-func (s *EstablishOrganizationReq) SetName(val string) {
-	s.Name = val
+// GetMessage returns the value of Message.
+func (s *BatchVoteResultError) GetMessage() string {
+	return s.Message
 }
 
 // SetCode sets the value of Code.
-func (s *EstablishOrganizationReq) SetCode(val string) {
+func (s *BatchVoteResultError) SetCode(val string) {
 	s.Code = val
 }
 
-// SetOrgType sets the value of OrgType.
-func (s *EstablishOrganizationReq) SetOrgType(val float64) {
-	s.OrgType = val
+// SetMessage sets the value of Message.
+func (s *BatchVoteResultError) SetMessage(val string) {
+	s.Message = val
 }
 
-// SetIcon sets the value of Icon.
-func (s *EstablishOrganizationReq) SetIcon(val OptMultipartFile) {
-	s.Icon = val
-}
+// 処理結果。applied は反映済み、duplicate
+// は同じ冪等キーの投票を処理済み、failed は投票できなかった.
+type BatchVoteResultStatus string
 
-type EstablishUserBadRequest struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
-}
+const (
+	BatchVoteResultStatusApplied   BatchVoteResultStatus = "applied"
+	BatchVoteResultStatusDuplicate BatchVoteResultStatus = "duplicate"
+	BatchVoteResultStatusFailed    BatchVoteResultStatus = "failed"
+)
 
-// GetCode returns the value of Code.
-func (s *EstablishUserBadRequest) GetCode() string {
-	return s.Code
+// AllValues returns all BatchVoteResultStatus values.
+func (BatchVoteResultStatus) AllValues() []BatchVoteResultStatus {
+	return []BatchVoteResultStatus{
+		BatchVoteResultStatusApplied,
+		BatchVoteResultStatusDuplicate,
+		BatchVoteResultStatusFailed,
+	}
 }
 
-// GetMessage returns the value of Message.
-func (s *EstablishUserBadRequest) GetMessage() string {
-	return s.Message
+// MarshalText implements encoding.TextMarshaler.
+func (s BatchVoteResultStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case BatchVoteResultStatusApplied:
+		return []byte(s), nil
+	case BatchVoteResultStatusDuplicate:
+		return []byte(s), nil
+	case BatchVoteResultStatusFailed:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetCode sets the value of Code.
-func (s *EstablishUserBadRequest) SetCode(val string) {
-	s.Code = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *BatchVoteResultStatus) UnmarshalText(data []byte) error {
+	switch BatchVoteResultStatus(data) {
+	case BatchVoteResultStatusApplied:
+		*s = BatchVoteResultStatusApplied
+		return nil
+	case BatchVoteResultStatusDuplicate:
+		*s = BatchVoteResultStatusDuplicate
+		return nil
+	case BatchVoteResultStatusFailed:
+		*s = BatchVoteResultStatusFailed
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// SetMessage sets the value of Message.
-func (s *EstablishUserBadRequest) SetMessage(val string) {
-	s.Message = val
-}
+type BatchVoteUnauthorized struct{}
 
-func (*EstablishUserBadRequest) establishUserRes() {}
+func (*BatchVoteUnauthorized) batchVoteRes() {}
 
-type EstablishUserInternalServerError struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
-}
+type BulkCreateOrganizationInvitationsBadRequest struct{}
 
-// GetCode returns the value of Code.
-func (s *EstablishUserInternalServerError) GetCode() string {
-	return s.Code
-}
+func (*BulkCreateOrganizationInvitationsBadRequest) bulkCreateOrganizationInvitationsRes() {}
 
-// GetMessage returns the value of Message.
-func (s *EstablishUserInternalServerError) GetMessage() string {
-	return s.Message
+type BulkCreateOrganizationInvitationsInternalServerError struct{}
+
+func (*BulkCreateOrganizationInvitationsInternalServerError) bulkCreateOrganizationInvitationsRes() {}
+
+type BulkCreateOrganizationInvitationsOK struct {
+	Results []OrganizationInvitationBulkResult `json:"results"`
 }
 
-// SetCode sets the value of Code.
-func (s *EstablishUserInternalServerError) SetCode(val string) {
-	s.Code = val
+// GetResults returns the value of Results.
+func (s *BulkCreateOrganizationInvitationsOK) GetResults() []OrganizationInvitationBulkResult {
+	return s.Results
 }
 
-// SetMessage sets the value of Message.
-func (s *EstablishUserInternalServerError) SetMessage(val string) {
-	s.Message = val
+// SetResults sets the value of Results.
+func (s *BulkCreateOrganizationInvitationsOK) SetResults(val []OrganizationInvitationBulkResult) {
+	s.Results = val
 }
 
-func (*EstablishUserInternalServerError) establishUserRes() {}
+func (*BulkCreateOrganizationInvitationsOK) bulkCreateOrganizationInvitationsRes() {}
 
-type EstablishUserReq struct {
-	DisplayName string           `json:"displayName"`
-	DisplayID   string           `json:"displayID"`
-	Icon        OptMultipartFile `json:"icon"`
-	DateOfBirth OptFloat64       `json:"dateOfBirth"`
-	Gender      OptString        `json:"gender"`
-	Prefecture  OptString        `json:"prefecture"`
-	City        OptString        `json:"city"`
-	Email       OptString        `json:"email"`
+type BulkCreateOrganizationInvitationsReq struct {
+	File ht.MultipartFile `json:"file"`
 }
 
-// GetDisplayName returns the value of DisplayName.
-func (s *EstablishUserReq) GetDisplayName() string {
-	return s.DisplayName
+// GetFile returns the value of File.
+func (s *BulkCreateOrganizationInvitationsReq) GetFile() ht.MultipartFile {
+	return s.File
 }
 
-// GetDisplayID returns the value of DisplayID.
-func (s *EstablishUserReq) GetDisplayID() string {
-	return s.DisplayID
+// SetFile sets the value of File.
+func (s *BulkCreateOrganizationInvitationsReq) SetFile(val ht.MultipartFile) {
+	s.File = val
 }
 
-// GetIcon returns the value of Icon.
-func (s *EstablishUserReq) GetIcon() OptMultipartFile {
-	return s.Icon
+type BulkTransferTalkSessionsBadRequest struct{}
+
+func (*BulkTransferTalkSessionsBadRequest) bulkTransferTalkSessionsRes() {}
+
+type BulkTransferTalkSessionsForbidden struct{}
+
+func (*BulkTransferTalkSessionsForbidden) bulkTransferTalkSessionsRes() {}
+
+type BulkTransferTalkSessionsInternalServerError struct{}
+
+func (*BulkTransferTalkSessionsInternalServerError) bulkTransferTalkSessionsRes() {}
+
+type BulkTransferTalkSessionsOK struct {
+	Transfers    []OwnershipTransfer `json:"transfers"`
+	SkippedCount int32               `json:"skippedCount"`
 }
 
-// GetDateOfBirth returns the value of DateOfBirth.
-func (s *EstablishUserReq) GetDateOfBirth() OptFloat64 {
-	return s.DateOfBirth
+// GetTransfers returns the value of Transfers.
+func (s *BulkTransferTalkSessionsOK) GetTransfers() []OwnershipTransfer {
+	return s.Transfers
 }
 
-// GetGender returns the value of Gender.
-func (s *EstablishUserReq) GetGender() OptString {
-	return s.Gender
+// GetSkippedCount returns the value of SkippedCount.
+func (s *BulkTransferTalkSessionsOK) GetSkippedCount() int32 {
+	return s.SkippedCount
 }
 
-// GetPrefecture returns the value of Prefecture.
-func (s *EstablishUserReq) GetPrefecture() OptString {
-	return s.Prefecture
+// SetTransfers sets the value of Transfers.
+func (s *BulkTransferTalkSessionsOK) SetTransfers(val []OwnershipTransfer) {
+	s.Transfers = val
 }
 
-// GetCity returns the value of City.
-func (s *EstablishUserReq) GetCity() OptString {
-	return s.City
+// SetSkippedCount sets the value of SkippedCount.
+func (s *BulkTransferTalkSessionsOK) SetSkippedCount(val int32) {
+	s.SkippedCount = val
 }
 
-// GetEmail returns the value of Email.
-func (s *EstablishUserReq) GetEmail() OptString {
-	return s.Email
+func (*BulkTransferTalkSessionsOK) bulkTransferTalkSessionsRes() {}
+
+type BulkTransferTalkSessionsReq struct {
+	FromDisplayID      string `json:"fromDisplayID"`
+	RecipientDisplayID string `json:"recipientDisplayID"`
 }
 
-// SetDisplayName sets the value of DisplayName.
-func (s *EstablishUserReq) SetDisplayName(val string) {
-	s.DisplayName = val
+// GetFromDisplayID returns the value of FromDisplayID.
+func (s *BulkTransferTalkSessionsReq) GetFromDisplayID() string {
+	return s.FromDisplayID
 }
 
-// SetDisplayID sets the value of DisplayID.
-func (s *EstablishUserReq) SetDisplayID(val string) {
-	s.DisplayID = val
+// GetRecipientDisplayID returns the value of RecipientDisplayID.
+func (s *BulkTransferTalkSessionsReq) GetRecipientDisplayID() string {
+	return s.RecipientDisplayID
 }
 
-// SetIcon sets the value of Icon.
-func (s *EstablishUserReq) SetIcon(val OptMultipartFile) {
-	s.Icon = val
+// SetFromDisplayID sets the value of FromDisplayID.
+func (s *BulkTransferTalkSessionsReq) SetFromDisplayID(val string) {
+	s.FromDisplayID = val
 }
 
-// SetDateOfBirth sets the value of DateOfBirth.
-func (s *EstablishUserReq) SetDateOfBirth(val OptFloat64) {
-	s.DateOfBirth = val
+// SetRecipientDisplayID sets the value of RecipientDisplayID.
+func (s *BulkTransferTalkSessionsReq) SetRecipientDisplayID(val string) {
+	s.RecipientDisplayID = val
 }
 
-// SetGender sets the value of Gender.
-func (s *EstablishUserReq) SetGender(val OptString) {
-	s.Gender = val
+type CancelOwnershipTransferBadRequest struct{}
+
+func (*CancelOwnershipTransferBadRequest) cancelOwnershipTransferRes() {}
+
+type CancelOwnershipTransferForbidden struct{}
+
+func (*CancelOwnershipTransferForbidden) cancelOwnershipTransferRes() {}
+
+type CancelOwnershipTransferInternalServerError struct{}
+
+func (*CancelOwnershipTransferInternalServerError) cancelOwnershipTransferRes() {}
+
+// 区分ごとの母集団と参加者の割合.
+// Ref: #/components/schemas/CategoryComparison
+type CategoryComparison struct {
+	// 区分.
+	Category string `json:"category"`
+	// 母集団での割合.
+	ReferenceShare float64 `json:"referenceShare"`
+	// 区分に当てはまる参加者の数.
+	ParticipantCount int `json:"participantCount"`
+	// 属性を登録した参加者に占める割合.
+	ParticipantShare float64 `json:"participantShare"`
+	// 事後層化の重み。参加者がいない区分と母集団にない区分は0.
+	Weight float64 `json:"weight"`
 }
 
-// SetPrefecture sets the value of Prefecture.
-func (s *EstablishUserReq) SetPrefecture(val OptString) {
-	s.Prefecture = val
+// GetCategory returns the value of Category.
+func (s *CategoryComparison) GetCategory() string {
+	return s.Category
 }
 
-// SetCity sets the value of City.
-func (s *EstablishUserReq) SetCity(val OptString) {
-	s.City = val
+// GetReferenceShare returns the value of ReferenceShare.
+func (s *CategoryComparison) GetReferenceShare() float64 {
+	return s.ReferenceShare
 }
 
-// SetEmail sets the value of Email.
-func (s *EstablishUserReq) SetEmail(val OptString) {
-	s.Email = val
+// GetParticipantCount returns the value of ParticipantCount.
+func (s *CategoryComparison) GetParticipantCount() int {
+	return s.ParticipantCount
 }
 
-type GetConclusionBadRequest struct{}
+// GetParticipantShare returns the value of ParticipantShare.
+func (s *CategoryComparison) GetParticipantShare() float64 {
+	return s.ParticipantShare
+}
 
-func (*GetConclusionBadRequest) getConclusionRes() {}
+// GetWeight returns the value of Weight.
+func (s *CategoryComparison) GetWeight() float64 {
+	return s.Weight
+}
 
-type GetConclusionInternalServerError struct{}
+// SetCategory sets the value of Category.
+func (s *CategoryComparison) SetCategory(val string) {
+	s.Category = val
+}
 
-func (*GetConclusionInternalServerError) getConclusionRes() {}
+// SetReferenceShare sets the value of ReferenceShare.
+func (s *CategoryComparison) SetReferenceShare(val float64) {
+	s.ReferenceShare = val
+}
 
-type GetDevicesOK struct {
-	Devices []Device `json:"devices"`
+// SetParticipantCount sets the value of ParticipantCount.
+func (s *CategoryComparison) SetParticipantCount(val int) {
+	s.ParticipantCount = val
 }
 
-// GetDevices returns the value of Devices.
-func (s *GetDevicesOK) GetDevices() []Device {
-	return s.Devices
+// SetParticipantShare sets the value of ParticipantShare.
+func (s *CategoryComparison) SetParticipantShare(val float64) {
+	s.ParticipantShare = val
 }
 
-// SetDevices sets the value of Devices.
-func (s *GetDevicesOK) SetDevices(val []Device) {
-	s.Devices = val
+// SetWeight sets the value of Weight.
+func (s *CategoryComparison) SetWeight(val float64) {
+	s.Weight = val
 }
 
-func (*GetDevicesOK) getDevicesRes() {}
+type ChangeOrganizationUserRoleBadRequest struct{}
 
-type GetDevicesUnauthorized struct{}
+func (*ChangeOrganizationUserRoleBadRequest) changeOrganizationUserRoleRes() {}
 
-func (*GetDevicesUnauthorized) getDevicesRes() {}
+type ChangeOrganizationUserRoleForbidden struct{}
 
-type GetNotificationPreferencesUnauthorized struct{}
+func (*ChangeOrganizationUserRoleForbidden) changeOrganizationUserRoleRes() {}
 
-func (*GetNotificationPreferencesUnauthorized) getNotificationPreferencesRes() {}
+type ChangeOrganizationUserRoleInternalServerError struct{}
 
-type GetOpenedTalkSessionBadRequest struct{}
+func (*ChangeOrganizationUserRoleInternalServerError) changeOrganizationUserRoleRes() {}
 
-func (*GetOpenedTalkSessionBadRequest) getOpenedTalkSessionRes() {}
+type ChangeOrganizationUserRoleNotFound struct{}
 
-type GetOpenedTalkSessionInternalServerError struct{}
+func (*ChangeOrganizationUserRoleNotFound) changeOrganizationUserRoleRes() {}
 
-func (*GetOpenedTalkSessionInternalServerError) getOpenedTalkSessionRes() {}
+type ChangeOrganizationUserRoleOK struct{}
 
-type GetOpenedTalkSessionOK struct {
-	TalkSessions []GetOpenedTalkSessionOKTalkSessionsItem `json:"talkSessions"`
+func (*ChangeOrganizationUserRoleOK) changeOrganizationUserRoleRes() {}
+
+type ChangeOrganizationUserRoleReq struct {
+	Role float64 `json:"role"`
 }
 
-// GetTalkSessions returns the value of TalkSessions.
-func (s *GetOpenedTalkSessionOK) GetTalkSessions() []GetOpenedTalkSessionOKTalkSessionsItem {
-	return s.TalkSessions
+// GetRole returns the value of Role.
+func (s *ChangeOrganizationUserRoleReq) GetRole() float64 {
+	return s.Role
 }
 
-// SetTalkSessions sets the value of TalkSessions.
-func (s *GetOpenedTalkSessionOK) SetTalkSessions(val []GetOpenedTalkSessionOKTalkSessionsItem) {
-	s.TalkSessions = val
+// SetRole sets the value of Role.
+func (s *ChangeOrganizationUserRoleReq) SetRole(val float64) {
+	s.Role = val
 }
 
-func (*GetOpenedTalkSessionOK) getOpenedTalkSessionRes() {}
+type ChangePasswordBadRequest struct{}
 
-type GetOpenedTalkSessionOKTalkSessionsItem struct {
-	TalkSession  TalkSession `json:"talkSession"`
-	OpinionCount int         `json:"opinionCount"`
-}
+func (*ChangePasswordBadRequest) changePasswordRes() {}
 
-// GetTalkSession returns the value of TalkSession.
-func (s *GetOpenedTalkSessionOKTalkSessionsItem) GetTalkSession() TalkSession {
-	return s.TalkSession
-}
+type ChangePasswordInternalServerError struct{}
 
-// GetOpinionCount returns the value of OpinionCount.
-func (s *GetOpenedTalkSessionOKTalkSessionsItem) GetOpinionCount() int {
-	return s.OpinionCount
+func (*ChangePasswordInternalServerError) changePasswordRes() {}
+
+type ChangePasswordOK struct{}
+
+func (*ChangePasswordOK) changePasswordRes() {}
+
+type CheckDeviceExistsNotFound struct{}
+
+func (*CheckDeviceExistsNotFound) checkDeviceExistsRes() {}
+
+type CheckDeviceExistsOK struct {
+	Exists bool `json:"exists"`
 }
 
-// SetTalkSession sets the value of TalkSession.
-func (s *GetOpenedTalkSessionOKTalkSessionsItem) SetTalkSession(val TalkSession) {
-	s.TalkSession = val
+// GetExists returns the value of Exists.
+func (s *CheckDeviceExistsOK) GetExists() bool {
+	return s.Exists
 }
 
-// SetOpinionCount sets the value of OpinionCount.
-func (s *GetOpenedTalkSessionOKTalkSessionsItem) SetOpinionCount(val int) {
-	s.OpinionCount = val
+// SetExists sets the value of Exists.
+func (s *CheckDeviceExistsOK) SetExists(val bool) {
+	s.Exists = val
 }
 
-type GetOpenedTalkSessionStatus string
+func (*CheckDeviceExistsOK) checkDeviceExistsRes() {}
 
-const (
-	GetOpenedTalkSessionStatusFinished GetOpenedTalkSessionStatus = "finished"
-	GetOpenedTalkSessionStatusOpen     GetOpenedTalkSessionStatus = "open"
-)
-
-// AllValues returns all GetOpenedTalkSessionStatus values.
-func (GetOpenedTalkSessionStatus) AllValues() []GetOpenedTalkSessionStatus {
-	return []GetOpenedTalkSessionStatus{
-		GetOpenedTalkSessionStatusFinished,
-		GetOpenedTalkSessionStatusOpen,
-	}
-}
-
-// MarshalText implements encoding.TextMarshaler.
-func (s GetOpenedTalkSessionStatus) MarshalText() ([]byte, error) {
-	switch s {
-	case GetOpenedTalkSessionStatusFinished:
-		return []byte(s), nil
-	case GetOpenedTalkSessionStatusOpen:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
-}
+type CheckDeviceExistsUnauthorized struct{}
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *GetOpenedTalkSessionStatus) UnmarshalText(data []byte) error {
-	switch GetOpenedTalkSessionStatus(data) {
-	case GetOpenedTalkSessionStatusFinished:
-		*s = GetOpenedTalkSessionStatusFinished
-		return nil
-	case GetOpenedTalkSessionStatusOpen:
-		*s = GetOpenedTalkSessionStatusOpen
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
-}
+func (*CheckDeviceExistsUnauthorized) checkDeviceExistsRes() {}
 
-type GetOpinionAnalysisBadRequest struct{}
+type CloneTalkSessionBadRequest struct{}
 
-func (*GetOpinionAnalysisBadRequest) getOpinionAnalysisRes() {}
+func (*CloneTalkSessionBadRequest) cloneTalkSessionRes() {}
 
-type GetOpinionAnalysisInternalServerError struct{}
+type CloneTalkSessionForbidden struct{}
 
-func (*GetOpinionAnalysisInternalServerError) getOpinionAnalysisRes() {}
+func (*CloneTalkSessionForbidden) cloneTalkSessionRes() {}
 
-type GetOpinionAnalysisOKApplicationJSON []OpinionGroupRatio
+type CloneTalkSessionInternalServerError struct{}
 
-func (*GetOpinionAnalysisOKApplicationJSON) getOpinionAnalysisRes() {}
+func (*CloneTalkSessionInternalServerError) cloneTalkSessionRes() {}
 
-type GetOpinionDetail2InternalServerError struct{}
+type CloneTalkSessionReq struct {
+	ScheduledEndTime time.Time `json:"scheduledEndTime"`
+	Theme            OptString `json:"theme"`
+}
 
-func (*GetOpinionDetail2InternalServerError) getOpinionDetail2Res() {}
+// GetScheduledEndTime returns the value of ScheduledEndTime.
+func (s *CloneTalkSessionReq) GetScheduledEndTime() time.Time {
+	return s.ScheduledEndTime
+}
 
-type GetOpinionReportReasonsBadRequest struct{}
+// GetTheme returns the value of Theme.
+func (s *CloneTalkSessionReq) GetTheme() OptString {
+	return s.Theme
+}
 
-func (*GetOpinionReportReasonsBadRequest) getOpinionReportReasonsRes() {}
+// SetScheduledEndTime sets the value of ScheduledEndTime.
+func (s *CloneTalkSessionReq) SetScheduledEndTime(val time.Time) {
+	s.ScheduledEndTime = val
+}
 
-type GetOpinionReportReasonsInternalServerError struct{}
+// SetTheme sets the value of Theme.
+func (s *CloneTalkSessionReq) SetTheme(val OptString) {
+	s.Theme = val
+}
 
-func (*GetOpinionReportReasonsInternalServerError) getOpinionReportReasonsRes() {}
+type CloneTalkSessionTemplateBadRequest struct{}
 
-type GetOpinionReportReasonsOKApplicationJSON []ReportReason
+func (*CloneTalkSessionTemplateBadRequest) cloneTalkSessionTemplateRes() {}
 
-func (*GetOpinionReportReasonsOKApplicationJSON) getOpinionReportReasonsRes() {}
+type CloneTalkSessionTemplateForbidden struct{}
 
-type GetOpinionReportsBadRequest struct{}
+func (*CloneTalkSessionTemplateForbidden) cloneTalkSessionTemplateRes() {}
 
-func (*GetOpinionReportsBadRequest) getOpinionReportsRes() {}
+type CloneTalkSessionTemplateInternalServerError struct{}
 
-type GetOpinionReportsInternalServerError struct{}
+func (*CloneTalkSessionTemplateInternalServerError) cloneTalkSessionTemplateRes() {}
 
-func (*GetOpinionReportsInternalServerError) getOpinionReportsRes() {}
+type CloneTalkSessionTemplateReq struct {
+	ScheduledEndTime time.Time `json:"scheduledEndTime"`
+	Theme            OptString `json:"theme"`
+}
 
-type GetOpinionsForTalkSessionBadRequest struct{}
+// GetScheduledEndTime returns the value of ScheduledEndTime.
+func (s *CloneTalkSessionTemplateReq) GetScheduledEndTime() time.Time {
+	return s.ScheduledEndTime
+}
 
-func (*GetOpinionsForTalkSessionBadRequest) getOpinionsForTalkSessionRes() {}
+// GetTheme returns the value of Theme.
+func (s *CloneTalkSessionTemplateReq) GetTheme() OptString {
+	return s.Theme
+}
 
-type GetOpinionsForTalkSessionInternalServerError struct{}
+// SetScheduledEndTime sets the value of ScheduledEndTime.
+func (s *CloneTalkSessionTemplateReq) SetScheduledEndTime(val time.Time) {
+	s.ScheduledEndTime = val
+}
 
-func (*GetOpinionsForTalkSessionInternalServerError) getOpinionsForTalkSessionRes() {}
+// SetTheme sets the value of Theme.
+func (s *CloneTalkSessionTemplateReq) SetTheme(val OptString) {
+	s.Theme = val
+}
 
-type GetOpinionsForTalkSessionOK struct {
-	Opinions   []OpinionWithReplyAndVote             `json:"opinions"`
-	Pagination GetOpinionsForTalkSessionOKPagination `json:"pagination"`
+// Ref: #/components/schemas/Conclusion
+type Conclusion struct {
+	// 作成ユーザー.
+	User User `json:"user"`
+	// 結論本文.
+	Content string `json:"content"`
 }
 
-// GetOpinions returns the value of Opinions.
-func (s *GetOpinionsForTalkSessionOK) GetOpinions() []OpinionWithReplyAndVote {
-	return s.Opinions
+// GetUser returns the value of User.
+func (s *Conclusion) GetUser() User {
+	return s.User
 }
 
-// GetPagination returns the value of Pagination.
-func (s *GetOpinionsForTalkSessionOK) GetPagination() GetOpinionsForTalkSessionOKPagination {
-	return s.Pagination
+// GetContent returns the value of Content.
+func (s *Conclusion) GetContent() string {
+	return s.Content
 }
 
-// SetOpinions sets the value of Opinions.
-func (s *GetOpinionsForTalkSessionOK) SetOpinions(val []OpinionWithReplyAndVote) {
-	s.Opinions = val
+// SetUser sets the value of User.
+func (s *Conclusion) SetUser(val User) {
+	s.User = val
 }
 
-// SetPagination sets the value of Pagination.
-func (s *GetOpinionsForTalkSessionOK) SetPagination(val GetOpinionsForTalkSessionOKPagination) {
-	s.Pagination = val
+// SetContent sets the value of Content.
+func (s *Conclusion) SetContent(val string) {
+	s.Content = val
 }
 
-func (*GetOpinionsForTalkSessionOK) getOpinionsForTalkSessionRes() {}
+func (*Conclusion) getConclusionRes()  {}
+func (*Conclusion) postConclusionRes() {}
 
-type GetOpinionsForTalkSessionOKPagination struct {
-	TotalCount int `json:"totalCount"`
-}
+type ConsentTalkSessionBadRequest struct{}
 
-// GetTotalCount returns the value of TotalCount.
-func (s *GetOpinionsForTalkSessionOKPagination) GetTotalCount() int {
-	return s.TotalCount
-}
+func (*ConsentTalkSessionBadRequest) consentTalkSessionRes() {}
 
-// SetTotalCount sets the value of TotalCount.
-func (s *GetOpinionsForTalkSessionOKPagination) SetTotalCount(val int) {
-	s.TotalCount = val
-}
+type ConsentTalkSessionInternalServerError struct{}
 
-type GetOpinionsForTalkSessionSort string
+func (*ConsentTalkSessionInternalServerError) consentTalkSessionRes() {}
 
-const (
-	GetOpinionsForTalkSessionSortLatest      GetOpinionsForTalkSessionSort = "latest"
-	GetOpinionsForTalkSessionSortMostReplies GetOpinionsForTalkSessionSort = "mostReplies"
-	GetOpinionsForTalkSessionSortOldest      GetOpinionsForTalkSessionSort = "oldest"
-)
+type ConsentTalkSessionOK struct{}
 
-// AllValues returns all GetOpinionsForTalkSessionSort values.
-func (GetOpinionsForTalkSessionSort) AllValues() []GetOpinionsForTalkSessionSort {
-	return []GetOpinionsForTalkSessionSort{
-		GetOpinionsForTalkSessionSortLatest,
-		GetOpinionsForTalkSessionSortMostReplies,
-		GetOpinionsForTalkSessionSortOldest,
-	}
+func (*ConsentTalkSessionOK) consentTalkSessionRes() {}
+
+type CookieAuth struct {
+	APIKey string
+	Roles  []string
 }
 
-// MarshalText implements encoding.TextMarshaler.
-func (s GetOpinionsForTalkSessionSort) MarshalText() ([]byte, error) {
-	switch s {
-	case GetOpinionsForTalkSessionSortLatest:
-		return []byte(s), nil
-	case GetOpinionsForTalkSessionSortMostReplies:
-		return []byte(s), nil
-	case GetOpinionsForTalkSessionSortOldest:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
+// GetAPIKey returns the value of APIKey.
+func (s *CookieAuth) GetAPIKey() string {
+	return s.APIKey
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *GetOpinionsForTalkSessionSort) UnmarshalText(data []byte) error {
-	switch GetOpinionsForTalkSessionSort(data) {
-	case GetOpinionsForTalkSessionSortLatest:
-		*s = GetOpinionsForTalkSessionSortLatest
-		return nil
-	case GetOpinionsForTalkSessionSortMostReplies:
-		*s = GetOpinionsForTalkSessionSortMostReplies
-		return nil
-	case GetOpinionsForTalkSessionSortOldest:
-		*s = GetOpinionsForTalkSessionSortOldest
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
+// GetRoles returns the value of Roles.
+func (s *CookieAuth) GetRoles() []string {
+	return s.Roles
 }
 
-type GetOrganizationAliasesBadRequest struct{}
+// SetAPIKey sets the value of APIKey.
+func (s *CookieAuth) SetAPIKey(val string) {
+	s.APIKey = val
+}
 
-func (*GetOrganizationAliasesBadRequest) getOrganizationAliasesRes() {}
+// SetRoles sets the value of Roles.
+func (s *CookieAuth) SetRoles(val []string) {
+	s.Roles = val
+}
 
-type GetOrganizationAliasesInternalServerError struct{}
+type CreateOrganizationAliasBadRequest struct{}
 
-func (*GetOrganizationAliasesInternalServerError) getOrganizationAliasesRes() {}
+func (*CreateOrganizationAliasBadRequest) createOrganizationAliasRes() {}
 
-type GetOrganizationAliasesOK struct {
-	Aliases []OrganizationAlias `json:"aliases"`
+type CreateOrganizationAliasInternalServerError struct{}
+
+func (*CreateOrganizationAliasInternalServerError) createOrganizationAliasRes() {}
+
+type CreateOrganizationAliasReq struct {
+	AliasName string `json:"aliasName"`
 }
 
-// GetAliases returns the value of Aliases.
-func (s *GetOrganizationAliasesOK) GetAliases() []OrganizationAlias {
-	return s.Aliases
+// GetAliasName returns the value of AliasName.
+func (s *CreateOrganizationAliasReq) GetAliasName() string {
+	return s.AliasName
 }
 
-// SetAliases sets the value of Aliases.
-func (s *GetOrganizationAliasesOK) SetAliases(val []OrganizationAlias) {
-	s.Aliases = val
+// SetAliasName sets the value of AliasName.
+func (s *CreateOrganizationAliasReq) SetAliasName(val string) {
+	s.AliasName = val
 }
 
-func (*GetOrganizationAliasesOK) getOrganizationAliasesRes() {}
+type CreateOrganizationApiKeyBadRequest struct{}
 
-type GetOrganizationUsersBadRequest struct{}
+func (*CreateOrganizationApiKeyBadRequest) createOrganizationApiKeyRes() {}
 
-func (*GetOrganizationUsersBadRequest) getOrganizationUsersRes() {}
+type CreateOrganizationApiKeyInternalServerError struct{}
 
-type GetOrganizationUsersInternalServerError struct{}
+func (*CreateOrganizationApiKeyInternalServerError) createOrganizationApiKeyRes() {}
 
-func (*GetOrganizationUsersInternalServerError) getOrganizationUsersRes() {}
+type CreateOrganizationApiKeyReq struct {
+	Name      string      `json:"name"`
+	Scopes    []string    `json:"scopes"`
+	ExpiresAt OptDateTime `json:"expiresAt"`
+}
 
-type GetOrganizationUsersOK struct {
-	Users []OrganizationUser `json:"users"`
+// GetName returns the value of Name.
+func (s *CreateOrganizationApiKeyReq) GetName() string {
+	return s.Name
 }
 
-// GetUsers returns the value of Users.
-func (s *GetOrganizationUsersOK) GetUsers() []OrganizationUser {
-	return s.Users
+// GetScopes returns the value of Scopes.
+func (s *CreateOrganizationApiKeyReq) GetScopes() []string {
+	return s.Scopes
 }
 
-// SetUsers sets the value of Users.
-func (s *GetOrganizationUsersOK) SetUsers(val []OrganizationUser) {
-	s.Users = val
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *CreateOrganizationApiKeyReq) GetExpiresAt() OptDateTime {
+	return s.ExpiresAt
 }
 
-func (*GetOrganizationUsersOK) getOrganizationUsersRes() {}
+// SetName sets the value of Name.
+func (s *CreateOrganizationApiKeyReq) SetName(val string) {
+	s.Name = val
+}
 
-type GetOrganizationUsersUnauthorized struct{}
+// SetScopes sets the value of Scopes.
+func (s *CreateOrganizationApiKeyReq) SetScopes(val []string) {
+	s.Scopes = val
+}
 
-func (*GetOrganizationUsersUnauthorized) getOrganizationUsersRes() {}
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *CreateOrganizationApiKeyReq) SetExpiresAt(val OptDateTime) {
+	s.ExpiresAt = val
+}
 
-type GetOrganizationsBadRequest struct{}
+type CreateOrganizationInvitationBadRequest struct{}
 
-func (*GetOrganizationsBadRequest) getOrganizationsRes() {}
+func (*CreateOrganizationInvitationBadRequest) createOrganizationInvitationRes() {}
 
-type GetOrganizationsInternalServerError struct{}
+type CreateOrganizationInvitationForbidden struct{}
 
-func (*GetOrganizationsInternalServerError) getOrganizationsRes() {}
+func (*CreateOrganizationInvitationForbidden) createOrganizationInvitationRes() {}
 
-type GetOrganizationsOK struct {
-	Organizations []Organization `json:"organizations"`
-}
+type CreateOrganizationInvitationInternalServerError struct{}
 
-// GetOrganizations returns the value of Organizations.
-func (s *GetOrganizationsOK) GetOrganizations() []Organization {
-	return s.Organizations
+func (*CreateOrganizationInvitationInternalServerError) createOrganizationInvitationRes() {}
+
+type CreateOrganizationInvitationReq struct {
+	Email string  `json:"email"`
+	Role  float64 `json:"role"`
 }
 
-// SetOrganizations sets the value of Organizations.
-func (s *GetOrganizationsOK) SetOrganizations(val []Organization) {
-	s.Organizations = val
+// GetEmail returns the value of Email.
+func (s *CreateOrganizationInvitationReq) GetEmail() string {
+	return s.Email
 }
 
-func (*GetOrganizationsOK) getOrganizationsRes() {}
+// GetRole returns the value of Role.
+func (s *CreateOrganizationInvitationReq) GetRole() float64 {
+	return s.Role
+}
 
-type GetPolicyConsentStatusBadRequest struct{}
+// SetEmail sets the value of Email.
+func (s *CreateOrganizationInvitationReq) SetEmail(val string) {
+	s.Email = val
+}
 
-func (*GetPolicyConsentStatusBadRequest) getPolicyConsentStatusRes() {}
+// SetRole sets the value of Role.
+func (s *CreateOrganizationInvitationReq) SetRole(val float64) {
+	s.Role = val
+}
 
-type GetPolicyConsentStatusInternalServerError struct{}
+type DeclineOwnershipTransferBadRequest struct{}
 
-func (*GetPolicyConsentStatusInternalServerError) getPolicyConsentStatusRes() {}
+func (*DeclineOwnershipTransferBadRequest) declineOwnershipTransferRes() {}
 
-type GetReportsForTalkSessionBadRequest struct{}
+type DeclineOwnershipTransferForbidden struct{}
 
-func (*GetReportsForTalkSessionBadRequest) getReportsForTalkSessionRes() {}
+func (*DeclineOwnershipTransferForbidden) declineOwnershipTransferRes() {}
 
-type GetReportsForTalkSessionInternalServerError struct{}
+type DeclineOwnershipTransferInternalServerError struct{}
 
-func (*GetReportsForTalkSessionInternalServerError) getReportsForTalkSessionRes() {}
+func (*DeclineOwnershipTransferInternalServerError) declineOwnershipTransferRes() {}
 
-type GetReportsForTalkSessionOK struct {
-	Reports []ReportDetail `json:"reports"`
-}
+// DeleteDeviceNoContent is response for DeleteDevice operation.
+type DeleteDeviceNoContent struct{}
 
-// GetReports returns the value of Reports.
-func (s *GetReportsForTalkSessionOK) GetReports() []ReportDetail {
-	return s.Reports
-}
+func (*DeleteDeviceNoContent) deleteDeviceRes() {}
 
-// SetReports sets the value of Reports.
-func (s *GetReportsForTalkSessionOK) SetReports(val []ReportDetail) {
-	s.Reports = val
-}
+type DeleteDeviceNotFound struct{}
 
-func (*GetReportsForTalkSessionOK) getReportsForTalkSessionRes() {}
+func (*DeleteDeviceNotFound) deleteDeviceRes() {}
 
-type GetReportsForTalkSessionStatus string
+type DeleteDeviceUnauthorized struct{}
 
-const (
-	GetReportsForTalkSessionStatusUnsolved GetReportsForTalkSessionStatus = "unsolved"
-	GetReportsForTalkSessionStatusDeleted  GetReportsForTalkSessionStatus = "deleted"
-	GetReportsForTalkSessionStatusHold     GetReportsForTalkSessionStatus = "hold"
-)
+func (*DeleteDeviceUnauthorized) deleteDeviceRes() {}
 
-// AllValues returns all GetReportsForTalkSessionStatus values.
-func (GetReportsForTalkSessionStatus) AllValues() []GetReportsForTalkSessionStatus {
-	return []GetReportsForTalkSessionStatus{
-		GetReportsForTalkSessionStatusUnsolved,
-		GetReportsForTalkSessionStatusDeleted,
-		GetReportsForTalkSessionStatusHold,
-	}
-}
+type DeleteOpinionBadRequest struct{}
 
-// MarshalText implements encoding.TextMarshaler.
-func (s GetReportsForTalkSessionStatus) MarshalText() ([]byte, error) {
-	switch s {
-	case GetReportsForTalkSessionStatusUnsolved:
-		return []byte(s), nil
-	case GetReportsForTalkSessionStatusDeleted:
-		return []byte(s), nil
-	case GetReportsForTalkSessionStatusHold:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
-}
+func (*DeleteOpinionBadRequest) deleteOpinionRes() {}
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *GetReportsForTalkSessionStatus) UnmarshalText(data []byte) error {
-	switch GetReportsForTalkSessionStatus(data) {
-	case GetReportsForTalkSessionStatusUnsolved:
-		*s = GetReportsForTalkSessionStatusUnsolved
-		return nil
-	case GetReportsForTalkSessionStatusDeleted:
-		*s = GetReportsForTalkSessionStatusDeleted
-		return nil
-	case GetReportsForTalkSessionStatusHold:
-		*s = GetReportsForTalkSessionStatusHold
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
-}
+type DeleteOpinionForbidden struct{}
 
-type GetTalkSessionDetailBadRequest struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
-}
+func (*DeleteOpinionForbidden) deleteOpinionRes() {}
 
-// GetCode returns the value of Code.
-func (s *GetTalkSessionDetailBadRequest) GetCode() string {
-	return s.Code
-}
+type DeleteOpinionInternalServerError struct{}
 
-// GetMessage returns the value of Message.
-func (s *GetTalkSessionDetailBadRequest) GetMessage() string {
-	return s.Message
-}
+func (*DeleteOpinionInternalServerError) deleteOpinionRes() {}
 
-// SetCode sets the value of Code.
-func (s *GetTalkSessionDetailBadRequest) SetCode(val string) {
-	s.Code = val
-}
+type DeleteOpinionOK struct{}
 
-// SetMessage sets the value of Message.
-func (s *GetTalkSessionDetailBadRequest) SetMessage(val string) {
-	s.Message = val
-}
+func (*DeleteOpinionOK) deleteOpinionRes() {}
 
-func (*GetTalkSessionDetailBadRequest) getTalkSessionDetailRes() {}
+type DeleteOrganizationAliasBadRequest struct{}
 
-type GetTalkSessionListBadRequest struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
-}
+func (*DeleteOrganizationAliasBadRequest) deleteOrganizationAliasRes() {}
 
-// GetCode returns the value of Code.
-func (s *GetTalkSessionListBadRequest) GetCode() string {
-	return s.Code
-}
+type DeleteOrganizationAliasInternalServerError struct{}
 
-// GetMessage returns the value of Message.
-func (s *GetTalkSessionListBadRequest) GetMessage() string {
-	return s.Message
-}
+func (*DeleteOrganizationAliasInternalServerError) deleteOrganizationAliasRes() {}
 
-// SetCode sets the value of Code.
-func (s *GetTalkSessionListBadRequest) SetCode(val string) {
-	s.Code = val
-}
+type DeleteOrganizationAliasOK struct{}
 
-// SetMessage sets the value of Message.
-func (s *GetTalkSessionListBadRequest) SetMessage(val string) {
-	s.Message = val
-}
+func (*DeleteOrganizationAliasOK) deleteOrganizationAliasRes() {}
 
-func (*GetTalkSessionListBadRequest) getTalkSessionListRes() {}
+type DeleteTalkSessionTemplateBadRequest struct{}
 
-type GetTalkSessionListInternalServerError struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
-}
+func (*DeleteTalkSessionTemplateBadRequest) deleteTalkSessionTemplateRes() {}
 
-// GetCode returns the value of Code.
-func (s *GetTalkSessionListInternalServerError) GetCode() string {
-	return s.Code
-}
+type DeleteTalkSessionTemplateForbidden struct{}
 
-// GetMessage returns the value of Message.
-func (s *GetTalkSessionListInternalServerError) GetMessage() string {
-	return s.Message
-}
+func (*DeleteTalkSessionTemplateForbidden) deleteTalkSessionTemplateRes() {}
 
-// SetCode sets the value of Code.
-func (s *GetTalkSessionListInternalServerError) SetCode(val string) {
-	s.Code = val
-}
+type DeleteTalkSessionTemplateInternalServerError struct{}
 
-// SetMessage sets the value of Message.
-func (s *GetTalkSessionListInternalServerError) SetMessage(val string) {
-	s.Message = val
-}
+func (*DeleteTalkSessionTemplateInternalServerError) deleteTalkSessionTemplateRes() {}
 
-func (*GetTalkSessionListInternalServerError) getTalkSessionListRes() {}
+type DeleteTalkSessionTemplateOK struct{}
 
-type GetTalkSessionListManageStatus string
+func (*DeleteTalkSessionTemplateOK) deleteTalkSessionTemplateRes() {}
+
+// 参加者と母集団を比べる属性。年齢は0-19・20-29・…・60-69・70-の区分、性別は男性・女性・その他.
+// Ref: #/components/schemas/DemographicDimension
+type DemographicDimension string
 
 const (
-	GetTalkSessionListManageStatusActive   GetTalkSessionListManageStatus = "active"
-	GetTalkSessionListManageStatusInactive GetTalkSessionListManageStatus = "inactive"
+	DemographicDimensionAgeBand    DemographicDimension = "age_band"
+	DemographicDimensionGender     DemographicDimension = "gender"
+	DemographicDimensionPrefecture DemographicDimension = "prefecture"
+	DemographicDimensionCity       DemographicDimension = "city"
 )
 
-// AllValues returns all GetTalkSessionListManageStatus values.
-func (GetTalkSessionListManageStatus) AllValues() []GetTalkSessionListManageStatus {
-	return []GetTalkSessionListManageStatus{
-		GetTalkSessionListManageStatusActive,
-		GetTalkSessionListManageStatusInactive,
+// AllValues returns all DemographicDimension values.
+func (DemographicDimension) AllValues() []DemographicDimension {
+	return []DemographicDimension{
+		DemographicDimensionAgeBand,
+		DemographicDimensionGender,
+		DemographicDimensionPrefecture,
+		DemographicDimensionCity,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s GetTalkSessionListManageStatus) MarshalText() ([]byte, error) {
+func (s DemographicDimension) MarshalText() ([]byte, error) {
 	switch s {
-	case GetTalkSessionListManageStatusActive:
+	case DemographicDimensionAgeBand:
 		return []byte(s), nil
-	case GetTalkSessionListManageStatusInactive:
+	case DemographicDimensionGender:
+		return []byte(s), nil
+	case DemographicDimensionPrefecture:
+		return []byte(s), nil
+	case DemographicDimensionCity:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -1568,216 +1716,194 @@ func (s GetTalkSessionListManageStatus) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *GetTalkSessionListManageStatus) UnmarshalText(data []byte) error {
-	switch GetTalkSessionListManageStatus(data) {
-	case GetTalkSessionListManageStatusActive:
-		*s = GetTalkSessionListManageStatusActive
+func (s *DemographicDimension) UnmarshalText(data []byte) error {
+	switch DemographicDimension(data) {
+	case DemographicDimensionAgeBand:
+		*s = DemographicDimensionAgeBand
 		return nil
-	case GetTalkSessionListManageStatusInactive:
-		*s = GetTalkSessionListManageStatusInactive
+	case DemographicDimensionGender:
+		*s = DemographicDimensionGender
+		return nil
+	case DemographicDimensionPrefecture:
+		*s = DemographicDimensionPrefecture
+		return nil
+	case DemographicDimensionCity:
+		*s = DemographicDimensionCity
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-type GetTalkSessionListOK struct {
-	TalkSessions []GetTalkSessionListOKTalkSessionsItem `json:"talkSessions"`
-	Pagination   OffsetPagination                       `json:"pagination"`
-}
+type DevAuthorizeBadRequest struct{}
 
-// GetTalkSessions returns the value of TalkSessions.
-func (s *GetTalkSessionListOK) GetTalkSessions() []GetTalkSessionListOKTalkSessionsItem {
-	return s.TalkSessions
-}
+func (*DevAuthorizeBadRequest) devAuthorizeRes() {}
 
-// GetPagination returns the value of Pagination.
-func (s *GetTalkSessionListOK) GetPagination() OffsetPagination {
-	return s.Pagination
-}
+type DevAuthorizeFound struct{}
 
-// SetTalkSessions sets the value of TalkSessions.
-func (s *GetTalkSessionListOK) SetTalkSessions(val []GetTalkSessionListOKTalkSessionsItem) {
-	s.TalkSessions = val
+// DevAuthorizeFoundHeaders wraps DevAuthorizeFound with response headers.
+type DevAuthorizeFoundHeaders struct {
+	Location  OptString
+	SetCookie []string
+	Response  DevAuthorizeFound
 }
 
-// SetPagination sets the value of Pagination.
-func (s *GetTalkSessionListOK) SetPagination(val OffsetPagination) {
-	s.Pagination = val
+// GetLocation returns the value of Location.
+func (s *DevAuthorizeFoundHeaders) GetLocation() OptString {
+	return s.Location
 }
 
-func (*GetTalkSessionListOK) getTalkSessionListRes() {}
-
-type GetTalkSessionListOKTalkSessionsItem struct {
-	TalkSession  TalkSession `json:"talkSession"`
-	OpinionCount int         `json:"opinionCount"`
+// GetSetCookie returns the value of SetCookie.
+func (s *DevAuthorizeFoundHeaders) GetSetCookie() []string {
+	return s.SetCookie
 }
 
-// GetTalkSession returns the value of TalkSession.
-func (s *GetTalkSessionListOKTalkSessionsItem) GetTalkSession() TalkSession {
-	return s.TalkSession
+// GetResponse returns the value of Response.
+func (s *DevAuthorizeFoundHeaders) GetResponse() DevAuthorizeFound {
+	return s.Response
 }
 
-// GetOpinionCount returns the value of OpinionCount.
-func (s *GetTalkSessionListOKTalkSessionsItem) GetOpinionCount() int {
-	return s.OpinionCount
+// SetLocation sets the value of Location.
+func (s *DevAuthorizeFoundHeaders) SetLocation(val OptString) {
+	s.Location = val
 }
 
-// SetTalkSession sets the value of TalkSession.
-func (s *GetTalkSessionListOKTalkSessionsItem) SetTalkSession(val TalkSession) {
-	s.TalkSession = val
+// SetSetCookie sets the value of SetCookie.
+func (s *DevAuthorizeFoundHeaders) SetSetCookie(val []string) {
+	s.SetCookie = val
 }
 
-// SetOpinionCount sets the value of OpinionCount.
-func (s *GetTalkSessionListOKTalkSessionsItem) SetOpinionCount(val int) {
-	s.OpinionCount = val
+// SetResponse sets the value of Response.
+func (s *DevAuthorizeFoundHeaders) SetResponse(val DevAuthorizeFound) {
+	s.Response = val
 }
 
-type GetTalkSessionListSortKey string
+func (*DevAuthorizeFoundHeaders) devAuthorizeRes() {}
 
-const (
-	GetTalkSessionListSortKeyLatest      GetTalkSessionListSortKey = "latest"
-	GetTalkSessionListSortKeyOldest      GetTalkSessionListSortKey = "oldest"
-	GetTalkSessionListSortKeyMostReplies GetTalkSessionListSortKey = "mostReplies"
-	GetTalkSessionListSortKeyNearest     GetTalkSessionListSortKey = "nearest"
-)
+type DevAuthorizeInternalServerError struct{}
 
-// AllValues returns all GetTalkSessionListSortKey values.
-func (GetTalkSessionListSortKey) AllValues() []GetTalkSessionListSortKey {
-	return []GetTalkSessionListSortKey{
-		GetTalkSessionListSortKeyLatest,
-		GetTalkSessionListSortKeyOldest,
-		GetTalkSessionListSortKeyMostReplies,
-		GetTalkSessionListSortKeyNearest,
-	}
+func (*DevAuthorizeInternalServerError) devAuthorizeRes() {}
+
+// デバイス情報.
+// Ref: #/components/schemas/Device
+type Device struct {
+	DeviceID     string         `json:"device_id"`
+	UserID       string         `json:"user_id"`
+	Platform     DevicePlatform `json:"platform"`
+	DeviceName   OptString      `json:"device_name"`
+	Enabled      bool           `json:"enabled"`
+	LastActiveAt OptString      `json:"last_active_at"`
+	CreatedAt    string         `json:"created_at"`
+	UpdatedAt    string         `json:"updated_at"`
 }
 
-// MarshalText implements encoding.TextMarshaler.
-func (s GetTalkSessionListSortKey) MarshalText() ([]byte, error) {
-	switch s {
-	case GetTalkSessionListSortKeyLatest:
-		return []byte(s), nil
-	case GetTalkSessionListSortKeyOldest:
-		return []byte(s), nil
-	case GetTalkSessionListSortKeyMostReplies:
-		return []byte(s), nil
-	case GetTalkSessionListSortKeyNearest:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
+// GetDeviceID returns the value of DeviceID.
+func (s *Device) GetDeviceID() string {
+	return s.DeviceID
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *GetTalkSessionListSortKey) UnmarshalText(data []byte) error {
-	switch GetTalkSessionListSortKey(data) {
-	case GetTalkSessionListSortKeyLatest:
-		*s = GetTalkSessionListSortKeyLatest
-		return nil
-	case GetTalkSessionListSortKeyOldest:
-		*s = GetTalkSessionListSortKeyOldest
-		return nil
-	case GetTalkSessionListSortKeyMostReplies:
-		*s = GetTalkSessionListSortKeyMostReplies
-		return nil
-	case GetTalkSessionListSortKeyNearest:
-		*s = GetTalkSessionListSortKeyNearest
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
+// GetUserID returns the value of UserID.
+func (s *Device) GetUserID() string {
+	return s.UserID
 }
 
-type GetTalkSessionListStatus string
+// GetPlatform returns the value of Platform.
+func (s *Device) GetPlatform() DevicePlatform {
+	return s.Platform
+}
 
-const (
-	GetTalkSessionListStatusOpen     GetTalkSessionListStatus = "open"
-	GetTalkSessionListStatusFinished GetTalkSessionListStatus = "finished"
-)
+// GetDeviceName returns the value of DeviceName.
+func (s *Device) GetDeviceName() OptString {
+	return s.DeviceName
+}
 
-// AllValues returns all GetTalkSessionListStatus values.
-func (GetTalkSessionListStatus) AllValues() []GetTalkSessionListStatus {
-	return []GetTalkSessionListStatus{
-		GetTalkSessionListStatusOpen,
-		GetTalkSessionListStatusFinished,
-	}
+// GetEnabled returns the value of Enabled.
+func (s *Device) GetEnabled() bool {
+	return s.Enabled
 }
 
-// MarshalText implements encoding.TextMarshaler.
-func (s GetTalkSessionListStatus) MarshalText() ([]byte, error) {
-	switch s {
-	case GetTalkSessionListStatusOpen:
-		return []byte(s), nil
-	case GetTalkSessionListStatusFinished:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
+// GetLastActiveAt returns the value of LastActiveAt.
+func (s *Device) GetLastActiveAt() OptString {
+	return s.LastActiveAt
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *GetTalkSessionListStatus) UnmarshalText(data []byte) error {
-	switch GetTalkSessionListStatus(data) {
-	case GetTalkSessionListStatusOpen:
-		*s = GetTalkSessionListStatusOpen
-		return nil
-	case GetTalkSessionListStatusFinished:
-		*s = GetTalkSessionListStatusFinished
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Device) GetCreatedAt() string {
+	return s.CreatedAt
 }
 
-type GetTalkSessionReportCountBadRequest struct{}
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *Device) GetUpdatedAt() string {
+	return s.UpdatedAt
+}
 
-func (*GetTalkSessionReportCountBadRequest) getTalkSessionReportCountRes() {}
+// SetDeviceID sets the value of DeviceID.
+func (s *Device) SetDeviceID(val string) {
+	s.DeviceID = val
+}
 
-type GetTalkSessionReportCountInternalServerError struct{}
+// SetUserID sets the value of UserID.
+func (s *Device) SetUserID(val string) {
+	s.UserID = val
+}
 
-func (*GetTalkSessionReportCountInternalServerError) getTalkSessionReportCountRes() {}
+// SetPlatform sets the value of Platform.
+func (s *Device) SetPlatform(val DevicePlatform) {
+	s.Platform = val
+}
 
-type GetTalkSessionReportCountOK struct {
-	Count int `json:"count"`
+// SetDeviceName sets the value of DeviceName.
+func (s *Device) SetDeviceName(val OptString) {
+	s.DeviceName = val
 }
 
-// GetCount returns the value of Count.
-func (s *GetTalkSessionReportCountOK) GetCount() int {
-	return s.Count
+// SetEnabled sets the value of Enabled.
+func (s *Device) SetEnabled(val bool) {
+	s.Enabled = val
 }
 
-// SetCount sets the value of Count.
-func (s *GetTalkSessionReportCountOK) SetCount(val int) {
-	s.Count = val
+// SetLastActiveAt sets the value of LastActiveAt.
+func (s *Device) SetLastActiveAt(val OptString) {
+	s.LastActiveAt = val
 }
 
-func (*GetTalkSessionReportCountOK) getTalkSessionReportCountRes() {}
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Device) SetCreatedAt(val string) {
+	s.CreatedAt = val
+}
 
-type GetTalkSessionReportCountStatus string
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *Device) SetUpdatedAt(val string) {
+	s.UpdatedAt = val
+}
+
+func (*Device) registerDeviceRes() {}
+
+type DevicePlatform string
 
 const (
-	GetTalkSessionReportCountStatusUnsolved GetTalkSessionReportCountStatus = "unsolved"
-	GetTalkSessionReportCountStatusDeleted  GetTalkSessionReportCountStatus = "deleted"
-	GetTalkSessionReportCountStatusHold     GetTalkSessionReportCountStatus = "hold"
+	DevicePlatformIos     DevicePlatform = "ios"
+	DevicePlatformAndroid DevicePlatform = "android"
+	DevicePlatformWeb     DevicePlatform = "web"
 )
 
-// AllValues returns all GetTalkSessionReportCountStatus values.
-func (GetTalkSessionReportCountStatus) AllValues() []GetTalkSessionReportCountStatus {
-	return []GetTalkSessionReportCountStatus{
-		GetTalkSessionReportCountStatusUnsolved,
-		GetTalkSessionReportCountStatusDeleted,
-		GetTalkSessionReportCountStatusHold,
+// AllValues returns all DevicePlatform values.
+func (DevicePlatform) AllValues() []DevicePlatform {
+	return []DevicePlatform{
+		DevicePlatformIos,
+		DevicePlatformAndroid,
+		DevicePlatformWeb,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s GetTalkSessionReportCountStatus) MarshalText() ([]byte, error) {
+func (s DevicePlatform) MarshalText() ([]byte, error) {
 	switch s {
-	case GetTalkSessionReportCountStatusUnsolved:
+	case DevicePlatformIos:
 		return []byte(s), nil
-	case GetTalkSessionReportCountStatusDeleted:
+	case DevicePlatformAndroid:
 		return []byte(s), nil
-	case GetTalkSessionReportCountStatusHold:
+	case DevicePlatformWeb:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -1785,1452 +1911,1373 @@ func (s GetTalkSessionReportCountStatus) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *GetTalkSessionReportCountStatus) UnmarshalText(data []byte) error {
-	switch GetTalkSessionReportCountStatus(data) {
-	case GetTalkSessionReportCountStatusUnsolved:
-		*s = GetTalkSessionReportCountStatusUnsolved
+func (s *DevicePlatform) UnmarshalText(data []byte) error {
+	switch DevicePlatform(data) {
+	case DevicePlatformIos:
+		*s = DevicePlatformIos
 		return nil
-	case GetTalkSessionReportCountStatusDeleted:
-		*s = GetTalkSessionReportCountStatusDeleted
+	case DevicePlatformAndroid:
+		*s = DevicePlatformAndroid
 		return nil
-	case GetTalkSessionReportCountStatusHold:
-		*s = GetTalkSessionReportCountStatusHold
+	case DevicePlatformWeb:
+		*s = DevicePlatformWeb
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-type GetTalkSessionReportInternalServerError struct{}
+type DownloadOrganizationAuditLogsBadRequest struct{}
 
-func (*GetTalkSessionReportInternalServerError) getTalkSessionReportRes() {}
+func (*DownloadOrganizationAuditLogsBadRequest) downloadOrganizationAuditLogsRes() {}
 
-type GetTalkSessionReportOK struct {
-	Report OptNilString `json:"report"`
-}
+type DownloadOrganizationAuditLogsForbidden struct{}
 
-// GetReport returns the value of Report.
-func (s *GetTalkSessionReportOK) GetReport() OptNilString {
-	return s.Report
-}
+func (*DownloadOrganizationAuditLogsForbidden) downloadOrganizationAuditLogsRes() {}
 
-// SetReport sets the value of Report.
-func (s *GetTalkSessionReportOK) SetReport(val OptNilString) {
-	s.Report = val
-}
+type DownloadOrganizationAuditLogsInternalServerError struct{}
 
-func (*GetTalkSessionReportOK) getTalkSessionReportRes() {}
+func (*DownloadOrganizationAuditLogsInternalServerError) downloadOrganizationAuditLogsRes() {}
 
-type GetTalkSessionRestrictionKeysBadRequest struct{}
+type DownloadOrganizationAuditLogsOK struct {
+	Data io.Reader
+}
 
-func (*GetTalkSessionRestrictionKeysBadRequest) getTalkSessionRestrictionKeysRes() {}
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s DownloadOrganizationAuditLogsOK) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
 
-type GetTalkSessionRestrictionKeysInternalServerError struct{}
+func (*DownloadOrganizationAuditLogsOK) downloadOrganizationAuditLogsRes() {}
 
-func (*GetTalkSessionRestrictionKeysInternalServerError) getTalkSessionRestrictionKeysRes() {}
+type DummyInitBadRequest struct{}
 
-type GetTalkSessionRestrictionKeysOKApplicationJSON []Restriction
+func (*DummyInitBadRequest) dummyInitRes() {}
 
-func (*GetTalkSessionRestrictionKeysOKApplicationJSON) getTalkSessionRestrictionKeysRes() {}
+type DummyInitInternalServerError struct{}
 
-type GetTalkSessionRestrictionSatisfiedBadRequest struct{}
+func (*DummyInitInternalServerError) dummyInitRes() {}
 
-func (*GetTalkSessionRestrictionSatisfiedBadRequest) getTalkSessionRestrictionSatisfiedRes() {}
+type DummyInitOK struct{}
 
-type GetTalkSessionRestrictionSatisfiedInternalServerError struct{}
+func (*DummyInitOK) dummyInitRes() {}
 
-func (*GetTalkSessionRestrictionSatisfiedInternalServerError) getTalkSessionRestrictionSatisfiedRes() {
+type DummyInitReq struct {
+	IntegerNull         NilInt        `json:"integerNull"`
+	NumericOptionalNull OptNilFloat64 `json:"numericOptionalNull"`
+	NumericNull         NilFloat64    `json:"numericNull"`
+	NumericOptional     OptFloat64    `json:"numericOptional"`
+	Numeric             float64       `json:"numeric"`
+	BooleanOptionalNull OptNilBool    `json:"booleanOptionalNull"`
+	BooleanNull         NilBool       `json:"booleanNull"`
 }
 
-type GetTalkSessionRestrictionSatisfiedOKApplicationJSON []Restriction
-
-func (*GetTalkSessionRestrictionSatisfiedOKApplicationJSON) getTalkSessionRestrictionSatisfiedRes() {}
+// GetIntegerNull returns the value of IntegerNull.
+func (s *DummyInitReq) GetIntegerNull() NilInt {
+	return s.IntegerNull
+}
 
-type GetTimeLineBadRequest struct{}
+// GetNumericOptionalNull returns the value of NumericOptionalNull.
+func (s *DummyInitReq) GetNumericOptionalNull() OptNilFloat64 {
+	return s.NumericOptionalNull
+}
 
-func (*GetTimeLineBadRequest) getTimeLineRes() {}
+// GetNumericNull returns the value of NumericNull.
+func (s *DummyInitReq) GetNumericNull() NilFloat64 {
+	return s.NumericNull
+}
 
-type GetTimeLineInternalServerError struct{}
+// GetNumericOptional returns the value of NumericOptional.
+func (s *DummyInitReq) GetNumericOptional() OptFloat64 {
+	return s.NumericOptional
+}
 
-func (*GetTimeLineInternalServerError) getTimeLineRes() {}
+// GetNumeric returns the value of Numeric.
+func (s *DummyInitReq) GetNumeric() float64 {
+	return s.Numeric
+}
 
-type GetTimeLineOK struct {
-	Items []ActionItem `json:"items"`
+// GetBooleanOptionalNull returns the value of BooleanOptionalNull.
+func (s *DummyInitReq) GetBooleanOptionalNull() OptNilBool {
+	return s.BooleanOptionalNull
 }
 
-// GetItems returns the value of Items.
-func (s *GetTimeLineOK) GetItems() []ActionItem {
-	return s.Items
+// GetBooleanNull returns the value of BooleanNull.
+func (s *DummyInitReq) GetBooleanNull() NilBool {
+	return s.BooleanNull
 }
 
-// SetItems sets the value of Items.
-func (s *GetTimeLineOK) SetItems(val []ActionItem) {
-	s.Items = val
+// SetIntegerNull sets the value of IntegerNull.
+func (s *DummyInitReq) SetIntegerNull(val NilInt) {
+	s.IntegerNull = val
 }
 
-func (*GetTimeLineOK) getTimeLineRes() {}
+// SetNumericOptionalNull sets the value of NumericOptionalNull.
+func (s *DummyInitReq) SetNumericOptionalNull(val OptNilFloat64) {
+	s.NumericOptionalNull = val
+}
 
-type GetTokenInfoBadRequest struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
+// SetNumericNull sets the value of NumericNull.
+func (s *DummyInitReq) SetNumericNull(val NilFloat64) {
+	s.NumericNull = val
 }
 
-// GetCode returns the value of Code.
-func (s *GetTokenInfoBadRequest) GetCode() string {
-	return s.Code
+// SetNumericOptional sets the value of NumericOptional.
+func (s *DummyInitReq) SetNumericOptional(val OptFloat64) {
+	s.NumericOptional = val
 }
 
-// GetMessage returns the value of Message.
-func (s *GetTokenInfoBadRequest) GetMessage() string {
-	return s.Message
+// SetNumeric sets the value of Numeric.
+func (s *DummyInitReq) SetNumeric(val float64) {
+	s.Numeric = val
 }
 
-// SetCode sets the value of Code.
-func (s *GetTokenInfoBadRequest) SetCode(val string) {
-	s.Code = val
+// SetBooleanOptionalNull sets the value of BooleanOptionalNull.
+func (s *DummyInitReq) SetBooleanOptionalNull(val OptNilBool) {
+	s.BooleanOptionalNull = val
 }
 
-// SetMessage sets the value of Message.
-func (s *GetTokenInfoBadRequest) SetMessage(val string) {
-	s.Message = val
+// SetBooleanNull sets the value of BooleanNull.
+func (s *DummyInitReq) SetBooleanNull(val NilBool) {
+	s.BooleanNull = val
 }
 
-func (*GetTokenInfoBadRequest) getTokenInfoRes() {}
+type EditOpinionBadRequest struct{}
 
-type GetTokenInfoInternalServerError struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
+func (*EditOpinionBadRequest) editOpinionRes() {}
+
+type EditOpinionForbidden struct{}
+
+func (*EditOpinionForbidden) editOpinionRes() {}
+
+type EditOpinionInternalServerError struct{}
+
+func (*EditOpinionInternalServerError) editOpinionRes() {}
+
+type EditOpinionOK struct {
+	Opinion Opinion `json:"opinion"`
+	// 投票をリセットしたか.
+	VotesReset bool `json:"votesReset"`
 }
 
-// GetCode returns the value of Code.
-func (s *GetTokenInfoInternalServerError) GetCode() string {
-	return s.Code
+// GetOpinion returns the value of Opinion.
+func (s *EditOpinionOK) GetOpinion() Opinion {
+	return s.Opinion
 }
 
-// GetMessage returns the value of Message.
-func (s *GetTokenInfoInternalServerError) GetMessage() string {
-	return s.Message
+// GetVotesReset returns the value of VotesReset.
+func (s *EditOpinionOK) GetVotesReset() bool {
+	return s.VotesReset
 }
 
-// SetCode sets the value of Code.
-func (s *GetTokenInfoInternalServerError) SetCode(val string) {
-	s.Code = val
+// SetOpinion sets the value of Opinion.
+func (s *EditOpinionOK) SetOpinion(val Opinion) {
+	s.Opinion = val
 }
 
-// SetMessage sets the value of Message.
-func (s *GetTokenInfoInternalServerError) SetMessage(val string) {
-	s.Message = val
+// SetVotesReset sets the value of VotesReset.
+func (s *EditOpinionOK) SetVotesReset(val bool) {
+	s.VotesReset = val
 }
 
-func (*GetTokenInfoInternalServerError) getTokenInfoRes() {}
+func (*EditOpinionOK) editOpinionRes() {}
 
-type GetUserByDisplayIDInternalServerError struct{}
+type EditOpinionReq struct {
+	Title          OptString `json:"title"`
+	OpinionContent string    `json:"opinionContent"`
+	ReferenceURL   OptString `json:"referenceURL"`
+}
 
-func (*GetUserByDisplayIDInternalServerError) getUserByDisplayIDRes() {}
+// GetTitle returns the value of Title.
+func (s *EditOpinionReq) GetTitle() OptString {
+	return s.Title
+}
 
-type GetUserByDisplayIDNotFound struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
+// GetOpinionContent returns the value of OpinionContent.
+func (s *EditOpinionReq) GetOpinionContent() string {
+	return s.OpinionContent
 }
 
-// GetCode returns the value of Code.
-func (s *GetUserByDisplayIDNotFound) GetCode() string {
-	return s.Code
+// GetReferenceURL returns the value of ReferenceURL.
+func (s *EditOpinionReq) GetReferenceURL() OptString {
+	return s.ReferenceURL
 }
 
-// GetMessage returns the value of Message.
-func (s *GetUserByDisplayIDNotFound) GetMessage() string {
-	return s.Message
+// SetTitle sets the value of Title.
+func (s *EditOpinionReq) SetTitle(val OptString) {
+	s.Title = val
 }
 
-// SetCode sets the value of Code.
-func (s *GetUserByDisplayIDNotFound) SetCode(val string) {
-	s.Code = val
+// SetOpinionContent sets the value of OpinionContent.
+func (s *EditOpinionReq) SetOpinionContent(val string) {
+	s.OpinionContent = val
 }
 
-// SetMessage sets the value of Message.
-func (s *GetUserByDisplayIDNotFound) SetMessage(val string) {
-	s.Message = val
+// SetReferenceURL sets the value of ReferenceURL.
+func (s *EditOpinionReq) SetReferenceURL(val OptString) {
+	s.ReferenceURL = val
 }
 
-func (*GetUserByDisplayIDNotFound) getUserByDisplayIDRes() {}
+type EditTalkSessionBadRequest struct{}
 
-type GetUserInfoInternalServerError struct{}
+func (*EditTalkSessionBadRequest) editTalkSessionRes() {}
 
-func (*GetUserInfoInternalServerError) getUserInfoRes() {}
+type EditTalkSessionInternalServerError struct{}
 
-type GetUserInfoOK struct {
-	User         User             `json:"user"`
-	Demographics UserDemographics `json:"demographics"`
-	Email        OptNilString     `json:"email"`
-}
+func (*EditTalkSessionInternalServerError) editTalkSessionRes() {}
 
-// GetUser returns the value of User.
-func (s *GetUserInfoOK) GetUser() User {
-	return s.User
+type EditTalkSessionReq struct {
+	Theme            string     `json:"theme"`
+	ScheduledEndTime time.Time  `json:"scheduledEndTime"`
+	Latitude         OptFloat64 `json:"latitude"`
+	Longitude        OptFloat64 `json:"longitude"`
+	Prefecture       OptString  `json:"prefecture"`
+	City             OptString  `json:"city"`
+	Description      OptString  `json:"description"`
+	ThumbnailURL     OptString  `json:"thumbnailURL"`
+	HideTop          OptNilBool `json:"hideTop"`
 }
 
-// GetDemographics returns the value of Demographics.
-func (s *GetUserInfoOK) GetDemographics() UserDemographics {
-	return s.Demographics
+// GetTheme returns the value of Theme.
+func (s *EditTalkSessionReq) GetTheme() string {
+	return s.Theme
 }
 
-// GetEmail returns the value of Email.
-func (s *GetUserInfoOK) GetEmail() OptNilString {
-	return s.Email
+// GetScheduledEndTime returns the value of ScheduledEndTime.
+func (s *EditTalkSessionReq) GetScheduledEndTime() time.Time {
+	return s.ScheduledEndTime
 }
 
-// SetUser sets the value of User.
-func (s *GetUserInfoOK) SetUser(val User) {
-	s.User = val
+// GetLatitude returns the value of Latitude.
+func (s *EditTalkSessionReq) GetLatitude() OptFloat64 {
+	return s.Latitude
 }
 
-// SetDemographics sets the value of Demographics.
-func (s *GetUserInfoOK) SetDemographics(val UserDemographics) {
-	s.Demographics = val
+// GetLongitude returns the value of Longitude.
+func (s *EditTalkSessionReq) GetLongitude() OptFloat64 {
+	return s.Longitude
 }
 
-// SetEmail sets the value of Email.
-func (s *GetUserInfoOK) SetEmail(val OptNilString) {
-	s.Email = val
+// GetPrefecture returns the value of Prefecture.
+func (s *EditTalkSessionReq) GetPrefecture() OptString {
+	return s.Prefecture
 }
 
-func (*GetUserInfoOK) getUserInfoRes() {}
+// GetCity returns the value of City.
+func (s *EditTalkSessionReq) GetCity() OptString {
+	return s.City
+}
 
-type GetUserListManageOrder string
+// GetDescription returns the value of Description.
+func (s *EditTalkSessionReq) GetDescription() OptString {
+	return s.Description
+}
 
-const (
-	GetUserListManageOrderAsc  GetUserListManageOrder = "asc"
-	GetUserListManageOrderDesc GetUserListManageOrder = "desc"
-)
+// GetThumbnailURL returns the value of ThumbnailURL.
+func (s *EditTalkSessionReq) GetThumbnailURL() OptString {
+	return s.ThumbnailURL
+}
 
-// AllValues returns all GetUserListManageOrder values.
-func (GetUserListManageOrder) AllValues() []GetUserListManageOrder {
-	return []GetUserListManageOrder{
-		GetUserListManageOrderAsc,
-		GetUserListManageOrderDesc,
-	}
+// GetHideTop returns the value of HideTop.
+func (s *EditTalkSessionReq) GetHideTop() OptNilBool {
+	return s.HideTop
 }
 
-// MarshalText implements encoding.TextMarshaler.
-func (s GetUserListManageOrder) MarshalText() ([]byte, error) {
-	switch s {
-	case GetUserListManageOrderAsc:
-		return []byte(s), nil
-	case GetUserListManageOrderDesc:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
+// SetTheme sets the value of Theme.
+func (s *EditTalkSessionReq) SetTheme(val string) {
+	s.Theme = val
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *GetUserListManageOrder) UnmarshalText(data []byte) error {
-	switch GetUserListManageOrder(data) {
-	case GetUserListManageOrderAsc:
-		*s = GetUserListManageOrderAsc
-		return nil
-	case GetUserListManageOrderDesc:
-		*s = GetUserListManageOrderDesc
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
+// SetScheduledEndTime sets the value of ScheduledEndTime.
+func (s *EditTalkSessionReq) SetScheduledEndTime(val time.Time) {
+	s.ScheduledEndTime = val
 }
 
-type GetUserListManageOrderBy string
+// SetLatitude sets the value of Latitude.
+func (s *EditTalkSessionReq) SetLatitude(val OptFloat64) {
+	s.Latitude = val
+}
 
-const (
-	GetUserListManageOrderByCreatedAt   GetUserListManageOrderBy = "createdAt"
-	GetUserListManageOrderByUpdatedAt   GetUserListManageOrderBy = "updatedAt"
-	GetUserListManageOrderByDisplayName GetUserListManageOrderBy = "displayName"
-	GetUserListManageOrderByLastLoginAt GetUserListManageOrderBy = "lastLoginAt"
-)
+// SetLongitude sets the value of Longitude.
+func (s *EditTalkSessionReq) SetLongitude(val OptFloat64) {
+	s.Longitude = val
+}
 
-// AllValues returns all GetUserListManageOrderBy values.
-func (GetUserListManageOrderBy) AllValues() []GetUserListManageOrderBy {
-	return []GetUserListManageOrderBy{
-		GetUserListManageOrderByCreatedAt,
-		GetUserListManageOrderByUpdatedAt,
-		GetUserListManageOrderByDisplayName,
-		GetUserListManageOrderByLastLoginAt,
-	}
+// SetPrefecture sets the value of Prefecture.
+func (s *EditTalkSessionReq) SetPrefecture(val OptString) {
+	s.Prefecture = val
 }
 
-// MarshalText implements encoding.TextMarshaler.
-func (s GetUserListManageOrderBy) MarshalText() ([]byte, error) {
-	switch s {
-	case GetUserListManageOrderByCreatedAt:
-		return []byte(s), nil
-	case GetUserListManageOrderByUpdatedAt:
-		return []byte(s), nil
-	case GetUserListManageOrderByDisplayName:
-		return []byte(s), nil
-	case GetUserListManageOrderByLastLoginAt:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
+// SetCity sets the value of City.
+func (s *EditTalkSessionReq) SetCity(val OptString) {
+	s.City = val
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *GetUserListManageOrderBy) UnmarshalText(data []byte) error {
-	switch GetUserListManageOrderBy(data) {
-	case GetUserListManageOrderByCreatedAt:
-		*s = GetUserListManageOrderByCreatedAt
-		return nil
-	case GetUserListManageOrderByUpdatedAt:
-		*s = GetUserListManageOrderByUpdatedAt
-		return nil
-	case GetUserListManageOrderByDisplayName:
-		*s = GetUserListManageOrderByDisplayName
-		return nil
-	case GetUserListManageOrderByLastLoginAt:
-		*s = GetUserListManageOrderByLastLoginAt
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
+// SetDescription sets the value of Description.
+func (s *EditTalkSessionReq) SetDescription(val OptString) {
+	s.Description = val
 }
 
-type GetUserTalkSessionsBadRequest struct{}
-
-func (*GetUserTalkSessionsBadRequest) getUserTalkSessionsRes() {}
-
-type GetUserTalkSessionsOK struct {
-	TalkSessions []GetUserTalkSessionsOKTalkSessionsItem `json:"talkSessions"`
-	Pagination   OffsetPagination                        `json:"pagination"`
+// SetThumbnailURL sets the value of ThumbnailURL.
+func (s *EditTalkSessionReq) SetThumbnailURL(val OptString) {
+	s.ThumbnailURL = val
 }
 
-// GetTalkSessions returns the value of TalkSessions.
-func (s *GetUserTalkSessionsOK) GetTalkSessions() []GetUserTalkSessionsOKTalkSessionsItem {
-	return s.TalkSessions
+// SetHideTop sets the value of HideTop.
+func (s *EditTalkSessionReq) SetHideTop(val OptNilBool) {
+	s.HideTop = val
 }
 
-// GetPagination returns the value of Pagination.
-func (s *GetUserTalkSessionsOK) GetPagination() OffsetPagination {
-	return s.Pagination
-}
+type EditTimeLineBadRequest struct{}
 
-// SetTalkSessions sets the value of TalkSessions.
-func (s *GetUserTalkSessionsOK) SetTalkSessions(val []GetUserTalkSessionsOKTalkSessionsItem) {
-	s.TalkSessions = val
-}
+func (*EditTimeLineBadRequest) editTimeLineRes() {}
 
-// SetPagination sets the value of Pagination.
-func (s *GetUserTalkSessionsOK) SetPagination(val OffsetPagination) {
-	s.Pagination = val
-}
+type EditTimeLineInternalServerError struct{}
 
-func (*GetUserTalkSessionsOK) getUserTalkSessionsRes() {}
+func (*EditTimeLineInternalServerError) editTimeLineRes() {}
 
-type GetUserTalkSessionsOKTalkSessionsItem struct {
-	TalkSession  TalkSession `json:"talkSession"`
-	OpinionCount int         `json:"opinionCount"`
+type EditTimeLineReq struct {
+	Content NilString `json:"content"`
+	Status  NilString `json:"status"`
 }
 
-// GetTalkSession returns the value of TalkSession.
-func (s *GetUserTalkSessionsOKTalkSessionsItem) GetTalkSession() TalkSession {
-	return s.TalkSession
+// GetContent returns the value of Content.
+func (s *EditTimeLineReq) GetContent() NilString {
+	return s.Content
 }
 
-// GetOpinionCount returns the value of OpinionCount.
-func (s *GetUserTalkSessionsOKTalkSessionsItem) GetOpinionCount() int {
-	return s.OpinionCount
+// GetStatus returns the value of Status.
+func (s *EditTimeLineReq) GetStatus() NilString {
+	return s.Status
 }
 
-// SetTalkSession sets the value of TalkSession.
-func (s *GetUserTalkSessionsOKTalkSessionsItem) SetTalkSession(val TalkSession) {
-	s.TalkSession = val
+// SetContent sets the value of Content.
+func (s *EditTimeLineReq) SetContent(val NilString) {
+	s.Content = val
 }
 
-// SetOpinionCount sets the value of OpinionCount.
-func (s *GetUserTalkSessionsOKTalkSessionsItem) SetOpinionCount(val int) {
-	s.OpinionCount = val
+// SetStatus sets the value of Status.
+func (s *EditTimeLineReq) SetStatus(val NilString) {
+	s.Status = val
 }
 
-type GetUserTalkSessionsStatus string
+type EstablishOrganizationBadRequest struct{}
 
-const (
-	GetUserTalkSessionsStatusFinished GetUserTalkSessionsStatus = "finished"
-	GetUserTalkSessionsStatusOpen     GetUserTalkSessionsStatus = "open"
-)
+func (*EstablishOrganizationBadRequest) establishOrganizationRes() {}
 
-// AllValues returns all GetUserTalkSessionsStatus values.
-func (GetUserTalkSessionsStatus) AllValues() []GetUserTalkSessionsStatus {
-	return []GetUserTalkSessionsStatus{
-		GetUserTalkSessionsStatusFinished,
-		GetUserTalkSessionsStatusOpen,
-	}
+type EstablishOrganizationInternalServerError struct{}
+
+func (*EstablishOrganizationInternalServerError) establishOrganizationRes() {}
+
+type EstablishOrganizationOK struct{}
+
+func (*EstablishOrganizationOK) establishOrganizationRes() {}
+
+type EstablishOrganizationReq struct {
+	// 組織名.
+	Name string `json:"name"`
+	// 組織コード（ログイン時に使用）
+	// 4文字以上127文字以下の英数字 _- のみ使用可能.
+	Code string `json:"code"`
+	// 組織タイプ
+	// - 1: 通常（基本これ）.
+	OrgType float64 `json:"orgType"`
+	// 組織アイコン.
+	Icon OptMultipartFile `json:"icon"`
 }
 
-// MarshalText implements encoding.TextMarshaler.
-func (s GetUserTalkSessionsStatus) MarshalText() ([]byte, error) {
-	switch s {
-	case GetUserTalkSessionsStatusFinished:
-		return []byte(s), nil
-	case GetUserTalkSessionsStatusOpen:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
+// GetName returns the value of Name.
+func (s *EstablishOrganizationReq) GetName() string {
+	return s.Name
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *GetUserTalkSessionsStatus) UnmarshalText(data []byte) error {
-	switch GetUserTalkSessionsStatus(data) {
-	case GetUserTalkSessionsStatusFinished:
-		*s = GetUserTalkSessionsStatusFinished
-		return nil
-	case GetUserTalkSessionsStatusOpen:
-		*s = GetUserTalkSessionsStatusOpen
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
+// GetCode returns the value of Code.
+func (s *EstablishOrganizationReq) GetCode() string {
+	return s.Code
 }
 
-type GetVapidKeyOK struct {
-	VapidKey string `json:"vapid_key"`
+// GetOrgType returns the value of OrgType.
+func (s *EstablishOrganizationReq) GetOrgType() float64 {
+	return s.OrgType
 }
 
-// GetVapidKey returns the value of VapidKey.
-func (s *GetVapidKeyOK) GetVapidKey() string {
-	return s.VapidKey
+// GetIcon returns the value of Icon.
+func (s *EstablishOrganizationReq) GetIcon() OptMultipartFile {
+	return s.Icon
 }
 
-// SetVapidKey sets the value of VapidKey.
-func (s *GetVapidKeyOK) SetVapidKey(val string) {
-	s.VapidKey = val
+// SetName sets the value of Name.
+func (s *EstablishOrganizationReq) SetName(val string) {
+	s.Name = val
 }
 
-type HandleAuthCallbackBadRequest struct{}
+// SetCode sets the value of Code.
+func (s *EstablishOrganizationReq) SetCode(val string) {
+	s.Code = val
+}
 
-func (*HandleAuthCallbackBadRequest) handleAuthCallbackRes() {}
+// SetOrgType sets the value of OrgType.
+func (s *EstablishOrganizationReq) SetOrgType(val float64) {
+	s.OrgType = val
+}
 
-type HandleAuthCallbackFound struct{}
+// SetIcon sets the value of Icon.
+func (s *EstablishOrganizationReq) SetIcon(val OptMultipartFile) {
+	s.Icon = val
+}
 
-// HandleAuthCallbackFoundHeaders wraps HandleAuthCallbackFound with response headers.
-type HandleAuthCallbackFoundHeaders struct {
-	Location  string
-	SetCookie []string
-	Response  HandleAuthCallbackFound
+type EstablishUserBadRequest struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
 }
 
-// GetLocation returns the value of Location.
-func (s *HandleAuthCallbackFoundHeaders) GetLocation() string {
-	return s.Location
+// GetCode returns the value of Code.
+func (s *EstablishUserBadRequest) GetCode() string {
+	return s.Code
 }
 
-// GetSetCookie returns the value of SetCookie.
-func (s *HandleAuthCallbackFoundHeaders) GetSetCookie() []string {
-	return s.SetCookie
+// GetMessage returns the value of Message.
+func (s *EstablishUserBadRequest) GetMessage() string {
+	return s.Message
 }
 
-// GetResponse returns the value of Response.
-func (s *HandleAuthCallbackFoundHeaders) GetResponse() HandleAuthCallbackFound {
-	return s.Response
+// SetCode sets the value of Code.
+func (s *EstablishUserBadRequest) SetCode(val string) {
+	s.Code = val
 }
 
-// SetLocation sets the value of Location.
-func (s *HandleAuthCallbackFoundHeaders) SetLocation(val string) {
-	s.Location = val
+// SetMessage sets the value of Message.
+func (s *EstablishUserBadRequest) SetMessage(val string) {
+	s.Message = val
 }
 
-// SetSetCookie sets the value of SetCookie.
-func (s *HandleAuthCallbackFoundHeaders) SetSetCookie(val []string) {
-	s.SetCookie = val
+func (*EstablishUserBadRequest) establishUserRes() {}
+
+type EstablishUserInternalServerError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
 }
 
-// SetResponse sets the value of Response.
-func (s *HandleAuthCallbackFoundHeaders) SetResponse(val HandleAuthCallbackFound) {
-	s.Response = val
+// GetCode returns the value of Code.
+func (s *EstablishUserInternalServerError) GetCode() string {
+	return s.Code
 }
 
-func (*HandleAuthCallbackFoundHeaders) handleAuthCallbackRes() {}
+// GetMessage returns the value of Message.
+func (s *EstablishUserInternalServerError) GetMessage() string {
+	return s.Message
+}
 
-type HandleAuthCallbackInternalServerError struct{}
+// SetCode sets the value of Code.
+func (s *EstablishUserInternalServerError) SetCode(val string) {
+	s.Code = val
+}
 
-func (*HandleAuthCallbackInternalServerError) handleAuthCallbackRes() {}
+// SetMessage sets the value of Message.
+func (s *EstablishUserInternalServerError) SetMessage(val string) {
+	s.Message = val
+}
 
-type HasConsentBadRequest struct{}
+func (*EstablishUserInternalServerError) establishUserRes() {}
 
-func (*HasConsentBadRequest) hasConsentRes() {}
-
-type HasConsentInternalServerError struct{}
+type EstablishUserReq struct {
+	DisplayName string           `json:"displayName"`
+	DisplayID   string           `json:"displayID"`
+	Icon        OptMultipartFile `json:"icon"`
+	DateOfBirth OptFloat64       `json:"dateOfBirth"`
+	Gender      OptString        `json:"gender"`
+	Prefecture  OptString        `json:"prefecture"`
+	City        OptString        `json:"city"`
+	Email       OptString        `json:"email"`
+}
 
-func (*HasConsentInternalServerError) hasConsentRes() {}
+// GetDisplayName returns the value of DisplayName.
+func (s *EstablishUserReq) GetDisplayName() string {
+	return s.DisplayName
+}
 
-type HasConsentOK struct {
-	HasConsent bool `json:"hasConsent"`
+// GetDisplayID returns the value of DisplayID.
+func (s *EstablishUserReq) GetDisplayID() string {
+	return s.DisplayID
 }
 
-// GetHasConsent returns the value of HasConsent.
-func (s *HasConsentOK) GetHasConsent() bool {
-	return s.HasConsent
+// GetIcon returns the value of Icon.
+func (s *EstablishUserReq) GetIcon() OptMultipartFile {
+	return s.Icon
 }
 
-// SetHasConsent sets the value of HasConsent.
-func (s *HasConsentOK) SetHasConsent(val bool) {
-	s.HasConsent = val
+// GetDateOfBirth returns the value of DateOfBirth.
+func (s *EstablishUserReq) GetDateOfBirth() OptFloat64 {
+	return s.DateOfBirth
 }
 
-func (*HasConsentOK) hasConsentRes() {}
+// GetGender returns the value of Gender.
+func (s *EstablishUserReq) GetGender() OptString {
+	return s.Gender
+}
 
-type HealthBadRequest struct{}
+// GetPrefecture returns the value of Prefecture.
+func (s *EstablishUserReq) GetPrefecture() OptString {
+	return s.Prefecture
+}
 
-func (*HealthBadRequest) healthRes() {}
+// GetCity returns the value of City.
+func (s *EstablishUserReq) GetCity() OptString {
+	return s.City
+}
 
-type HealthInternalServerError struct{}
+// GetEmail returns the value of Email.
+func (s *EstablishUserReq) GetEmail() OptString {
+	return s.Email
+}
 
-func (*HealthInternalServerError) healthRes() {}
+// SetDisplayName sets the value of DisplayName.
+func (s *EstablishUserReq) SetDisplayName(val string) {
+	s.DisplayName = val
+}
 
-type HealthOK struct{}
+// SetDisplayID sets the value of DisplayID.
+func (s *EstablishUserReq) SetDisplayID(val string) {
+	s.DisplayID = val
+}
 
-func (*HealthOK) healthRes() {}
+// SetIcon sets the value of Icon.
+func (s *EstablishUserReq) SetIcon(val OptMultipartFile) {
+	s.Icon = val
+}
 
-type InitiateTalkSessionBadRequest struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
+// SetDateOfBirth sets the value of DateOfBirth.
+func (s *EstablishUserReq) SetDateOfBirth(val OptFloat64) {
+	s.DateOfBirth = val
 }
 
-// GetCode returns the value of Code.
-func (s *InitiateTalkSessionBadRequest) GetCode() string {
-	return s.Code
+// SetGender sets the value of Gender.
+func (s *EstablishUserReq) SetGender(val OptString) {
+	s.Gender = val
 }
 
-// GetMessage returns the value of Message.
-func (s *InitiateTalkSessionBadRequest) GetMessage() string {
-	return s.Message
+// SetPrefecture sets the value of Prefecture.
+func (s *EstablishUserReq) SetPrefecture(val OptString) {
+	s.Prefecture = val
 }
 
-// SetCode sets the value of Code.
-func (s *InitiateTalkSessionBadRequest) SetCode(val string) {
-	s.Code = val
+// SetCity sets the value of City.
+func (s *EstablishUserReq) SetCity(val OptString) {
+	s.City = val
 }
 
-// SetMessage sets the value of Message.
-func (s *InitiateTalkSessionBadRequest) SetMessage(val string) {
-	s.Message = val
+// SetEmail sets the value of Email.
+func (s *EstablishUserReq) SetEmail(val OptString) {
+	s.Email = val
 }
 
-func (*InitiateTalkSessionBadRequest) initiateTalkSessionRes() {}
+type GetAnalysisJobBadRequest struct{}
 
-type InitiateTalkSessionReq struct {
-	Theme            string     `json:"theme"`
-	ScheduledEndTime time.Time  `json:"scheduledEndTime"`
-	Latitude         OptFloat64 `json:"latitude"`
-	Longitude        OptFloat64 `json:"longitude"`
-	City             OptString  `json:"city"`
-	Prefecture       OptString  `json:"prefecture"`
-	Description      OptString  `json:"description"`
-	ThumbnailURL     OptString  `json:"thumbnailURL"`
-	Restrictions     []string   `json:"restrictions"`
-	AliasId          OptString  `json:"aliasId"`
-	HideTop          OptNilBool `json:"hideTop"`
-}
+func (*GetAnalysisJobBadRequest) getAnalysisJobRes() {}
 
-// GetTheme returns the value of Theme.
-func (s *InitiateTalkSessionReq) GetTheme() string {
-	return s.Theme
-}
+type GetAnalysisJobInternalServerError struct{}
 
-// GetScheduledEndTime returns the value of ScheduledEndTime.
-func (s *InitiateTalkSessionReq) GetScheduledEndTime() time.Time {
-	return s.ScheduledEndTime
-}
+func (*GetAnalysisJobInternalServerError) getAnalysisJobRes() {}
 
-// GetLatitude returns the value of Latitude.
-func (s *InitiateTalkSessionReq) GetLatitude() OptFloat64 {
-	return s.Latitude
-}
+type GetAnalysisJobNotFound struct{}
 
-// GetLongitude returns the value of Longitude.
-func (s *InitiateTalkSessionReq) GetLongitude() OptFloat64 {
-	return s.Longitude
-}
+func (*GetAnalysisJobNotFound) getAnalysisJobRes() {}
 
-// GetCity returns the value of City.
-func (s *InitiateTalkSessionReq) GetCity() OptString {
-	return s.City
-}
+type GetAnalysisSnapshotBadRequest struct{}
 
-// GetPrefecture returns the value of Prefecture.
-func (s *InitiateTalkSessionReq) GetPrefecture() OptString {
-	return s.Prefecture
+func (*GetAnalysisSnapshotBadRequest) getAnalysisSnapshotRes() {}
+
+type GetAnalysisSnapshotDiffBadRequest struct{}
+
+func (*GetAnalysisSnapshotDiffBadRequest) getAnalysisSnapshotDiffRes() {}
+
+type GetAnalysisSnapshotDiffInternalServerError struct{}
+
+func (*GetAnalysisSnapshotDiffInternalServerError) getAnalysisSnapshotDiffRes() {}
+
+type GetAnalysisSnapshotDiffNotFound struct{}
+
+func (*GetAnalysisSnapshotDiffNotFound) getAnalysisSnapshotDiffRes() {}
+
+type GetAnalysisSnapshotDiffOK struct {
+	From AnalysisSnapshot `json:"from"`
+	To   AnalysisSnapshot `json:"to"`
+	// グループが変わったユーザー.
+	Moves []GroupMove `json:"moves"`
 }
 
-// GetDescription returns the value of Description.
-func (s *InitiateTalkSessionReq) GetDescription() OptString {
-	return s.Description
+// GetFrom returns the value of From.
+func (s *GetAnalysisSnapshotDiffOK) GetFrom() AnalysisSnapshot {
+	return s.From
 }
 
-// GetThumbnailURL returns the value of ThumbnailURL.
-func (s *InitiateTalkSessionReq) GetThumbnailURL() OptString {
-	return s.ThumbnailURL
+// GetTo returns the value of To.
+func (s *GetAnalysisSnapshotDiffOK) GetTo() AnalysisSnapshot {
+	return s.To
 }
 
-// GetRestrictions returns the value of Restrictions.
-func (s *InitiateTalkSessionReq) GetRestrictions() []string {
-	return s.Restrictions
+// GetMoves returns the value of Moves.
+func (s *GetAnalysisSnapshotDiffOK) GetMoves() []GroupMove {
+	return s.Moves
 }
 
-// GetAliasId returns the value of AliasId.
-func (s *InitiateTalkSessionReq) GetAliasId() OptString {
-	return s.AliasId
+// SetFrom sets the value of From.
+func (s *GetAnalysisSnapshotDiffOK) SetFrom(val AnalysisSnapshot) {
+	s.From = val
 }
 
-// GetHideTop returns the value of HideTop.
-func (s *InitiateTalkSessionReq) GetHideTop() OptNilBool {
-	return s.HideTop
+// SetTo sets the value of To.
+func (s *GetAnalysisSnapshotDiffOK) SetTo(val AnalysisSnapshot) {
+	s.To = val
 }
 
-// SetTheme sets the value of Theme.
-func (s *InitiateTalkSessionReq) SetTheme(val string) {
-	s.Theme = val
+// SetMoves sets the value of Moves.
+func (s *GetAnalysisSnapshotDiffOK) SetMoves(val []GroupMove) {
+	s.Moves = val
 }
 
-// SetScheduledEndTime sets the value of ScheduledEndTime.
-func (s *InitiateTalkSessionReq) SetScheduledEndTime(val time.Time) {
-	s.ScheduledEndTime = val
+func (*GetAnalysisSnapshotDiffOK) getAnalysisSnapshotDiffRes() {}
+
+type GetAnalysisSnapshotInternalServerError struct{}
+
+func (*GetAnalysisSnapshotInternalServerError) getAnalysisSnapshotRes() {}
+
+type GetAnalysisSnapshotNotFound struct{}
+
+func (*GetAnalysisSnapshotNotFound) getAnalysisSnapshotRes() {}
+
+type GetAnalysisSnapshotOK struct {
+	Snapshot      AnalysisSnapshot        `json:"snapshot"`
+	Positions     []UserGroupPosition     `json:"positions"`
+	GroupOpinions []AnalysisSnapshotGroup `json:"groupOpinions"`
 }
 
-// SetLatitude sets the value of Latitude.
-func (s *InitiateTalkSessionReq) SetLatitude(val OptFloat64) {
-	s.Latitude = val
+// GetSnapshot returns the value of Snapshot.
+func (s *GetAnalysisSnapshotOK) GetSnapshot() AnalysisSnapshot {
+	return s.Snapshot
 }
 
-// SetLongitude sets the value of Longitude.
-func (s *InitiateTalkSessionReq) SetLongitude(val OptFloat64) {
-	s.Longitude = val
+// GetPositions returns the value of Positions.
+func (s *GetAnalysisSnapshotOK) GetPositions() []UserGroupPosition {
+	return s.Positions
 }
 
-// SetCity sets the value of City.
-func (s *InitiateTalkSessionReq) SetCity(val OptString) {
-	s.City = val
+// GetGroupOpinions returns the value of GroupOpinions.
+func (s *GetAnalysisSnapshotOK) GetGroupOpinions() []AnalysisSnapshotGroup {
+	return s.GroupOpinions
 }
 
-// SetPrefecture sets the value of Prefecture.
-func (s *InitiateTalkSessionReq) SetPrefecture(val OptString) {
-	s.Prefecture = val
+// SetSnapshot sets the value of Snapshot.
+func (s *GetAnalysisSnapshotOK) SetSnapshot(val AnalysisSnapshot) {
+	s.Snapshot = val
 }
 
-// SetDescription sets the value of Description.
-func (s *InitiateTalkSessionReq) SetDescription(val OptString) {
-	s.Description = val
+// SetPositions sets the value of Positions.
+func (s *GetAnalysisSnapshotOK) SetPositions(val []UserGroupPosition) {
+	s.Positions = val
 }
 
-// SetThumbnailURL sets the value of ThumbnailURL.
-func (s *InitiateTalkSessionReq) SetThumbnailURL(val OptString) {
-	s.ThumbnailURL = val
+// SetGroupOpinions sets the value of GroupOpinions.
+func (s *GetAnalysisSnapshotOK) SetGroupOpinions(val []AnalysisSnapshotGroup) {
+	s.GroupOpinions = val
 }
 
-// SetRestrictions sets the value of Restrictions.
-func (s *InitiateTalkSessionReq) SetRestrictions(val []string) {
-	s.Restrictions = val
+func (*GetAnalysisSnapshotOK) getAnalysisSnapshotRes() {}
+
+type GetAnalysisSnapshotsBadRequest struct{}
+
+func (*GetAnalysisSnapshotsBadRequest) getAnalysisSnapshotsRes() {}
+
+type GetAnalysisSnapshotsInternalServerError struct{}
+
+func (*GetAnalysisSnapshotsInternalServerError) getAnalysisSnapshotsRes() {}
+
+type GetAnalysisSnapshotsOK struct {
+	Snapshots []AnalysisSnapshot `json:"snapshots"`
 }
 
-// SetAliasId sets the value of AliasId.
-func (s *InitiateTalkSessionReq) SetAliasId(val OptString) {
-	s.AliasId = val
+// GetSnapshots returns the value of Snapshots.
+func (s *GetAnalysisSnapshotsOK) GetSnapshots() []AnalysisSnapshot {
+	return s.Snapshots
 }
 
-// SetHideTop sets the value of HideTop.
-func (s *InitiateTalkSessionReq) SetHideTop(val OptNilBool) {
-	s.HideTop = val
+// SetSnapshots sets the value of Snapshots.
+func (s *GetAnalysisSnapshotsOK) SetSnapshots(val []AnalysisSnapshot) {
+	s.Snapshots = val
 }
 
-type InviteOrganizationBadRequest struct{}
+func (*GetAnalysisSnapshotsOK) getAnalysisSnapshotsRes() {}
 
-func (*InviteOrganizationBadRequest) inviteOrganizationRes() {}
+type GetAnalysisStatusBadRequest struct{}
 
-type InviteOrganizationForUserBadRequest struct{}
+func (*GetAnalysisStatusBadRequest) getAnalysisStatusRes() {}
 
-func (*InviteOrganizationForUserBadRequest) inviteOrganizationForUserRes() {}
+type GetAnalysisStatusInternalServerError struct{}
 
-type InviteOrganizationForUserInternalServerError struct{}
+func (*GetAnalysisStatusInternalServerError) getAnalysisStatusRes() {}
 
-func (*InviteOrganizationForUserInternalServerError) inviteOrganizationForUserRes() {}
+type GetConclusionBadRequest struct{}
 
-type InviteOrganizationForUserOK struct {
-	Success bool `json:"success"`
-}
+func (*GetConclusionBadRequest) getConclusionRes() {}
 
-// GetSuccess returns the value of Success.
-func (s *InviteOrganizationForUserOK) GetSuccess() bool {
-	return s.Success
-}
+type GetConclusionInternalServerError struct{}
 
-// SetSuccess sets the value of Success.
-func (s *InviteOrganizationForUserOK) SetSuccess(val bool) {
-	s.Success = val
-}
+func (*GetConclusionInternalServerError) getConclusionRes() {}
 
-func (*InviteOrganizationForUserOK) inviteOrganizationForUserRes() {}
+type GetConsensusBadRequest struct{}
 
-type InviteOrganizationForUserReq struct {
-	DisplayID string  `json:"displayID"`
-	Role      float64 `json:"role"`
-}
+func (*GetConsensusBadRequest) getConsensusRes() {}
 
-// GetDisplayID returns the value of DisplayID.
-func (s *InviteOrganizationForUserReq) GetDisplayID() string {
-	return s.DisplayID
+type GetConsensusInternalServerError struct{}
+
+func (*GetConsensusInternalServerError) getConsensusRes() {}
+
+type GetConsensusOK struct {
+	// 全てのグループが賛成している順の意見.
+	Consensus []OpinionConsensus `json:"consensus"`
+	// グループで賛否が分かれている順の意見。グループが1つ以下の場合は空.
+	Divisive []OpinionConsensus `json:"divisive"`
 }
 
-// GetRole returns the value of Role.
-func (s *InviteOrganizationForUserReq) GetRole() float64 {
-	return s.Role
+// GetConsensus returns the value of Consensus.
+func (s *GetConsensusOK) GetConsensus() []OpinionConsensus {
+	return s.Consensus
 }
 
-// SetDisplayID sets the value of DisplayID.
-func (s *InviteOrganizationForUserReq) SetDisplayID(val string) {
-	s.DisplayID = val
+// GetDivisive returns the value of Divisive.
+func (s *GetConsensusOK) GetDivisive() []OpinionConsensus {
+	return s.Divisive
 }
 
-// SetRole sets the value of Role.
-func (s *InviteOrganizationForUserReq) SetRole(val float64) {
-	s.Role = val
+// SetConsensus sets the value of Consensus.
+func (s *GetConsensusOK) SetConsensus(val []OpinionConsensus) {
+	s.Consensus = val
 }
 
-type InviteOrganizationInternalServerError struct{}
+// SetDivisive sets the value of Divisive.
+func (s *GetConsensusOK) SetDivisive(val []OpinionConsensus) {
+	s.Divisive = val
+}
 
-func (*InviteOrganizationInternalServerError) inviteOrganizationRes() {}
+func (*GetConsensusOK) getConsensusRes() {}
 
-type InviteOrganizationOK struct{}
+type GetDevicesOK struct {
+	Devices []Device `json:"devices"`
+}
 
-func (*InviteOrganizationOK) inviteOrganizationRes() {}
-
-type InviteOrganizationReq struct {
-	Email string  `json:"email"`
-	Role  float64 `json:"role"`
+// GetDevices returns the value of Devices.
+func (s *GetDevicesOK) GetDevices() []Device {
+	return s.Devices
 }
 
-// GetEmail returns the value of Email.
-func (s *InviteOrganizationReq) GetEmail() string {
-	return s.Email
+// SetDevices sets the value of Devices.
+func (s *GetDevicesOK) SetDevices(val []Device) {
+	s.Devices = val
 }
 
-// GetRole returns the value of Role.
-func (s *InviteOrganizationReq) GetRole() float64 {
-	return s.Role
-}
+func (*GetDevicesOK) getDevicesRes() {}
 
-// SetEmail sets the value of Email.
-func (s *InviteOrganizationReq) SetEmail(val string) {
-	s.Email = val
-}
+type GetDevicesUnauthorized struct{}
 
-// SetRole sets the value of Role.
-func (s *InviteOrganizationReq) SetRole(val float64) {
-	s.Role = val
-}
+func (*GetDevicesUnauthorized) getDevicesRes() {}
 
-// Ref: #/components/schemas/Location
-type Location struct {
-	// 緯度.
-	Latitude OptFloat64 `json:"latitude"`
-	// 経度.
-	Longitude OptFloat64 `json:"longitude"`
+type GetJwksInternalServerError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
 }
 
-// GetLatitude returns the value of Latitude.
-func (s *Location) GetLatitude() OptFloat64 {
-	return s.Latitude
+// GetCode returns the value of Code.
+func (s *GetJwksInternalServerError) GetCode() string {
+	return s.Code
 }
 
-// GetLongitude returns the value of Longitude.
-func (s *Location) GetLongitude() OptFloat64 {
-	return s.Longitude
+// GetMessage returns the value of Message.
+func (s *GetJwksInternalServerError) GetMessage() string {
+	return s.Message
 }
 
-// SetLatitude sets the value of Latitude.
-func (s *Location) SetLatitude(val OptFloat64) {
-	s.Latitude = val
+// SetCode sets the value of Code.
+func (s *GetJwksInternalServerError) SetCode(val string) {
+	s.Code = val
 }
 
-// SetLongitude sets the value of Longitude.
-func (s *Location) SetLongitude(val OptFloat64) {
-	s.Longitude = val
+// SetMessage sets the value of Message.
+func (s *GetJwksInternalServerError) SetMessage(val string) {
+	s.Message = val
 }
 
-// NewNilBool returns new NilBool with value set to v.
-func NewNilBool(v bool) NilBool {
-	return NilBool{
-		Value: v,
-	}
-}
+func (*GetJwksInternalServerError) getJwksRes() {}
 
-// NilBool is nullable bool.
-type NilBool struct {
-	Value bool
-	Null  bool
-}
+type GetMyAnalysisPositionBadRequest struct{}
 
-// SetTo sets value to v.
-func (o *NilBool) SetTo(v bool) {
-	o.Null = false
-	o.Value = v
-}
+func (*GetMyAnalysisPositionBadRequest) getMyAnalysisPositionRes() {}
 
-// IsNull returns true if value is Null.
-func (o NilBool) IsNull() bool { return o.Null }
+type GetMyAnalysisPositionInternalServerError struct{}
 
-// SetToNull sets value to null.
-func (o *NilBool) SetToNull() {
-	o.Null = true
-	var v bool
-	o.Value = v
-}
+func (*GetMyAnalysisPositionInternalServerError) getMyAnalysisPositionRes() {}
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilBool) Get() (v bool, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	return o.Value, true
-}
+type GetMyAnalysisPositionNotFound struct{}
 
-// Or returns value if set, or given parameter if does not.
-func (o NilBool) Or(d bool) bool {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+func (*GetMyAnalysisPositionNotFound) getMyAnalysisPositionRes() {}
+
+type GetMyAnalysisPositionOK struct {
+	MyPosition UserGroupPosition `json:"myPosition"`
+	// 各グループの中心までの距離。近い順.
+	GroupDistances []GroupDistance `json:"groupDistances"`
+	// 自分の投票がグループと最も一致している意見.
+	AgreeWithGroup []OpinionAlignment `json:"agreeWithGroup"`
+	// 自分の投票がグループと最も食い違っている意見.
+	DisagreeWithGroup []OpinionAlignment `json:"disagreeWithGroup"`
+	// 同じグループのメンバーが賛成している、まだ投票していない意見.
+	Suggestions []OpinionSuggestion `json:"suggestions"`
 }
 
-// NewNilFloat64 returns new NilFloat64 with value set to v.
-func NewNilFloat64(v float64) NilFloat64 {
-	return NilFloat64{
-		Value: v,
-	}
+// GetMyPosition returns the value of MyPosition.
+func (s *GetMyAnalysisPositionOK) GetMyPosition() UserGroupPosition {
+	return s.MyPosition
 }
 
-// NilFloat64 is nullable float64.
-type NilFloat64 struct {
-	Value float64
-	Null  bool
+// GetGroupDistances returns the value of GroupDistances.
+func (s *GetMyAnalysisPositionOK) GetGroupDistances() []GroupDistance {
+	return s.GroupDistances
 }
 
-// SetTo sets value to v.
-func (o *NilFloat64) SetTo(v float64) {
-	o.Null = false
-	o.Value = v
+// GetAgreeWithGroup returns the value of AgreeWithGroup.
+func (s *GetMyAnalysisPositionOK) GetAgreeWithGroup() []OpinionAlignment {
+	return s.AgreeWithGroup
 }
 
-// IsNull returns true if value is Null.
-func (o NilFloat64) IsNull() bool { return o.Null }
+// GetDisagreeWithGroup returns the value of DisagreeWithGroup.
+func (s *GetMyAnalysisPositionOK) GetDisagreeWithGroup() []OpinionAlignment {
+	return s.DisagreeWithGroup
+}
 
-// SetToNull sets value to null.
-func (o *NilFloat64) SetToNull() {
-	o.Null = true
-	var v float64
-	o.Value = v
+// GetSuggestions returns the value of Suggestions.
+func (s *GetMyAnalysisPositionOK) GetSuggestions() []OpinionSuggestion {
+	return s.Suggestions
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilFloat64) Get() (v float64, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	return o.Value, true
+// SetMyPosition sets the value of MyPosition.
+func (s *GetMyAnalysisPositionOK) SetMyPosition(val UserGroupPosition) {
+	s.MyPosition = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o NilFloat64) Or(d float64) float64 {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetGroupDistances sets the value of GroupDistances.
+func (s *GetMyAnalysisPositionOK) SetGroupDistances(val []GroupDistance) {
+	s.GroupDistances = val
 }
 
-// NewNilInt returns new NilInt with value set to v.
-func NewNilInt(v int) NilInt {
-	return NilInt{
-		Value: v,
-	}
+// SetAgreeWithGroup sets the value of AgreeWithGroup.
+func (s *GetMyAnalysisPositionOK) SetAgreeWithGroup(val []OpinionAlignment) {
+	s.AgreeWithGroup = val
 }
 
-// NilInt is nullable int.
-type NilInt struct {
-	Value int
-	Null  bool
+// SetDisagreeWithGroup sets the value of DisagreeWithGroup.
+func (s *GetMyAnalysisPositionOK) SetDisagreeWithGroup(val []OpinionAlignment) {
+	s.DisagreeWithGroup = val
 }
 
-// SetTo sets value to v.
-func (o *NilInt) SetTo(v int) {
-	o.Null = false
-	o.Value = v
+// SetSuggestions sets the value of Suggestions.
+func (s *GetMyAnalysisPositionOK) SetSuggestions(val []OpinionSuggestion) {
+	s.Suggestions = val
 }
 
-// IsNull returns true if value is Null.
-func (o NilInt) IsNull() bool { return o.Null }
+func (*GetMyAnalysisPositionOK) getMyAnalysisPositionRes() {}
 
-// SetToNull sets value to null.
-func (o *NilInt) SetToNull() {
-	o.Null = true
-	var v int
-	o.Value = v
-}
+type GetMyAnalysisPositionUnauthorized struct{}
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilInt) Get() (v int, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	return o.Value, true
-}
+func (*GetMyAnalysisPositionUnauthorized) getMyAnalysisPositionRes() {}
 
-// Or returns value if set, or given parameter if does not.
-func (o NilInt) Or(d int) int {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
-}
+type GetNotificationPreferencesUnauthorized struct{}
 
-// NewNilString returns new NilString with value set to v.
-func NewNilString(v string) NilString {
-	return NilString{
-		Value: v,
-	}
-}
+func (*GetNotificationPreferencesUnauthorized) getNotificationPreferencesRes() {}
 
-// NilString is nullable string.
-type NilString struct {
-	Value string
-	Null  bool
-}
+type GetOpenedTalkSessionBadRequest struct{}
 
-// SetTo sets value to v.
-func (o *NilString) SetTo(v string) {
-	o.Null = false
-	o.Value = v
-}
+func (*GetOpenedTalkSessionBadRequest) getOpenedTalkSessionRes() {}
 
-// IsNull returns true if value is Null.
-func (o NilString) IsNull() bool { return o.Null }
+type GetOpenedTalkSessionInternalServerError struct{}
 
-// SetToNull sets value to null.
-func (o *NilString) SetToNull() {
-	o.Null = true
-	var v string
-	o.Value = v
-}
+func (*GetOpenedTalkSessionInternalServerError) getOpenedTalkSessionRes() {}
 
-// Get returns value and boolean that denotes whether value was set.
-func (o NilString) Get() (v string, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	return o.Value, true
+type GetOpenedTalkSessionOK struct {
+	TalkSessions []GetOpenedTalkSessionOKTalkSessionsItem `json:"talkSessions"`
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o NilString) Or(d string) string {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetTalkSessions returns the value of TalkSessions.
+func (s *GetOpenedTalkSessionOK) GetTalkSessions() []GetOpenedTalkSessionOKTalkSessionsItem {
+	return s.TalkSessions
 }
 
-// 通知設定.
-// Ref: #/components/schemas/NotificationPreferences
-type NotificationPreferences struct {
-	PushNotificationEnabled bool `json:"push_notification_enabled"`
+// SetTalkSessions sets the value of TalkSessions.
+func (s *GetOpenedTalkSessionOK) SetTalkSessions(val []GetOpenedTalkSessionOKTalkSessionsItem) {
+	s.TalkSessions = val
 }
 
-// GetPushNotificationEnabled returns the value of PushNotificationEnabled.
-func (s *NotificationPreferences) GetPushNotificationEnabled() bool {
-	return s.PushNotificationEnabled
-}
+func (*GetOpenedTalkSessionOK) getOpenedTalkSessionRes() {}
 
-// SetPushNotificationEnabled sets the value of PushNotificationEnabled.
-func (s *NotificationPreferences) SetPushNotificationEnabled(val bool) {
-	s.PushNotificationEnabled = val
+type GetOpenedTalkSessionOKTalkSessionsItem struct {
+	TalkSession  TalkSession `json:"talkSession"`
+	OpinionCount int         `json:"opinionCount"`
 }
 
-func (*NotificationPreferences) getNotificationPreferencesRes()    {}
-func (*NotificationPreferences) updateNotificationPreferencesRes() {}
-
-// Ref: #/components/schemas/OffsetPagination
-type OffsetPagination struct {
-	TotalCount int `json:"totalCount"`
-	Offset     int `json:"offset"`
-	Limit      int `json:"limit"`
+// GetTalkSession returns the value of TalkSession.
+func (s *GetOpenedTalkSessionOKTalkSessionsItem) GetTalkSession() TalkSession {
+	return s.TalkSession
 }
 
-// GetTotalCount returns the value of TotalCount.
-func (s *OffsetPagination) GetTotalCount() int {
-	return s.TotalCount
+// GetOpinionCount returns the value of OpinionCount.
+func (s *GetOpenedTalkSessionOKTalkSessionsItem) GetOpinionCount() int {
+	return s.OpinionCount
 }
 
-// GetOffset returns the value of Offset.
-func (s *OffsetPagination) GetOffset() int {
-	return s.Offset
+// SetTalkSession sets the value of TalkSession.
+func (s *GetOpenedTalkSessionOKTalkSessionsItem) SetTalkSession(val TalkSession) {
+	s.TalkSession = val
 }
 
-// GetLimit returns the value of Limit.
-func (s *OffsetPagination) GetLimit() int {
-	return s.Limit
+// SetOpinionCount sets the value of OpinionCount.
+func (s *GetOpenedTalkSessionOKTalkSessionsItem) SetOpinionCount(val int) {
+	s.OpinionCount = val
 }
 
-// SetTotalCount sets the value of TotalCount.
-func (s *OffsetPagination) SetTotalCount(val int) {
-	s.TotalCount = val
-}
+type GetOpenedTalkSessionStatus string
 
-// SetOffset sets the value of Offset.
-func (s *OffsetPagination) SetOffset(val int) {
-	s.Offset = val
-}
+const (
+	GetOpenedTalkSessionStatusFinished GetOpenedTalkSessionStatus = "finished"
+	GetOpenedTalkSessionStatusOpen     GetOpenedTalkSessionStatus = "open"
+)
 
-// SetLimit sets the value of Limit.
-func (s *OffsetPagination) SetLimit(val int) {
-	s.Limit = val
+// AllValues returns all GetOpenedTalkSessionStatus values.
+func (GetOpenedTalkSessionStatus) AllValues() []GetOpenedTalkSessionStatus {
+	return []GetOpenedTalkSessionStatus{
+		GetOpenedTalkSessionStatusFinished,
+		GetOpenedTalkSessionStatusOpen,
+	}
 }
 
-// Ref: #/components/schemas/Opinion
-type Opinion struct {
-	// 意見ID.
-	ID    string    `json:"id"`
-	Title OptString `json:"title"`
-	// 意見のテキスト.
-	Content string `json:"content"`
-	// 親の意見ID。ルートならば無し.
-	ParentID OptString `json:"parentID"`
-	// 意見投稿主の意見。ルート意見の場合はここには何も入らない.
-	VoteType OptNilVoteType `json:"voteType"`
-	// 画像が返る場合もある.
-	PictureURL OptNilString `json:"pictureURL"`
-	// 参考文献URL.
-	ReferenceURL OptString `json:"referenceURL"`
-	PostedAt     string    `json:"postedAt"`
-	IsDeleted    bool      `json:"isDeleted"`
+// MarshalText implements encoding.TextMarshaler.
+func (s GetOpenedTalkSessionStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case GetOpenedTalkSessionStatusFinished:
+		return []byte(s), nil
+	case GetOpenedTalkSessionStatusOpen:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// GetID returns the value of ID.
-func (s *Opinion) GetID() string {
-	return s.ID
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GetOpenedTalkSessionStatus) UnmarshalText(data []byte) error {
+	switch GetOpenedTalkSessionStatus(data) {
+	case GetOpenedTalkSessionStatusFinished:
+		*s = GetOpenedTalkSessionStatusFinished
+		return nil
+	case GetOpenedTalkSessionStatusOpen:
+		*s = GetOpenedTalkSessionStatusOpen
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// GetTitle returns the value of Title.
-func (s *Opinion) GetTitle() OptString {
-	return s.Title
-}
+type GetOpinionAnalysisBadRequest struct{}
 
-// GetContent returns the value of Content.
-func (s *Opinion) GetContent() string {
-	return s.Content
-}
+func (*GetOpinionAnalysisBadRequest) getOpinionAnalysisRes() {}
 
-// GetParentID returns the value of ParentID.
-func (s *Opinion) GetParentID() OptString {
-	return s.ParentID
-}
+type GetOpinionAnalysisForbidden struct{}
 
-// GetVoteType returns the value of VoteType.
-func (s *Opinion) GetVoteType() OptNilVoteType {
-	return s.VoteType
-}
+func (*GetOpinionAnalysisForbidden) getOpinionAnalysisRes() {}
 
-// GetPictureURL returns the value of PictureURL.
-func (s *Opinion) GetPictureURL() OptNilString {
-	return s.PictureURL
-}
+type GetOpinionAnalysisInternalServerError struct{}
 
-// GetReferenceURL returns the value of ReferenceURL.
-func (s *Opinion) GetReferenceURL() OptString {
-	return s.ReferenceURL
-}
+func (*GetOpinionAnalysisInternalServerError) getOpinionAnalysisRes() {}
 
-// GetPostedAt returns the value of PostedAt.
-func (s *Opinion) GetPostedAt() string {
-	return s.PostedAt
-}
+type GetOpinionAnalysisNotFound struct{}
 
-// GetIsDeleted returns the value of IsDeleted.
-func (s *Opinion) GetIsDeleted() bool {
-	return s.IsDeleted
-}
+func (*GetOpinionAnalysisNotFound) getOpinionAnalysisRes() {}
 
-// SetID sets the value of ID.
-func (s *Opinion) SetID(val string) {
-	s.ID = val
-}
+type GetOpinionAnalysisOKApplicationJSON []OpinionGroupRatio
 
-// SetTitle sets the value of Title.
-func (s *Opinion) SetTitle(val OptString) {
-	s.Title = val
-}
+func (*GetOpinionAnalysisOKApplicationJSON) getOpinionAnalysisRes() {}
 
-// SetContent sets the value of Content.
-func (s *Opinion) SetContent(val string) {
-	s.Content = val
-}
+type GetOpinionDetail2InternalServerError struct{}
 
-// SetParentID sets the value of ParentID.
-func (s *Opinion) SetParentID(val OptString) {
-	s.ParentID = val
-}
+func (*GetOpinionDetail2InternalServerError) getOpinionDetail2Res() {}
 
-// SetVoteType sets the value of VoteType.
-func (s *Opinion) SetVoteType(val OptNilVoteType) {
-	s.VoteType = val
-}
+type GetOpinionReportReasonsBadRequest struct{}
 
-// SetPictureURL sets the value of PictureURL.
-func (s *Opinion) SetPictureURL(val OptNilString) {
-	s.PictureURL = val
-}
+func (*GetOpinionReportReasonsBadRequest) getOpinionReportReasonsRes() {}
 
-// SetReferenceURL sets the value of ReferenceURL.
-func (s *Opinion) SetReferenceURL(val OptString) {
-	s.ReferenceURL = val
-}
+type GetOpinionReportReasonsInternalServerError struct{}
 
-// SetPostedAt sets the value of PostedAt.
-func (s *Opinion) SetPostedAt(val string) {
-	s.PostedAt = val
-}
+func (*GetOpinionReportReasonsInternalServerError) getOpinionReportReasonsRes() {}
 
-// SetIsDeleted sets the value of IsDeleted.
-func (s *Opinion) SetIsDeleted(val bool) {
-	s.IsDeleted = val
-}
+type GetOpinionReportReasonsOKApplicationJSON []ReportReason
 
-func (*Opinion) postOpinionPost2Res() {}
+func (*GetOpinionReportReasonsOKApplicationJSON) getOpinionReportReasonsRes() {}
 
-type OpinionComments2BadRequest struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
-}
+type GetOpinionReportsBadRequest struct{}
 
-// GetCode returns the value of Code.
-func (s *OpinionComments2BadRequest) GetCode() string {
-	return s.Code
-}
+func (*GetOpinionReportsBadRequest) getOpinionReportsRes() {}
 
-// GetMessage returns the value of Message.
-func (s *OpinionComments2BadRequest) GetMessage() string {
-	return s.Message
-}
+type GetOpinionReportsInternalServerError struct{}
 
-// SetCode sets the value of Code.
-func (s *OpinionComments2BadRequest) SetCode(val string) {
-	s.Code = val
-}
+func (*GetOpinionReportsInternalServerError) getOpinionReportsRes() {}
 
-// SetMessage sets the value of Message.
-func (s *OpinionComments2BadRequest) SetMessage(val string) {
-	s.Message = val
-}
+type GetOpinionRevisionsBadRequest struct{}
 
-func (*OpinionComments2BadRequest) opinionComments2Res() {}
+func (*GetOpinionRevisionsBadRequest) getOpinionRevisionsRes() {}
 
-type OpinionComments2InternalServerError struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
+type GetOpinionRevisionsForbidden struct{}
+
+func (*GetOpinionRevisionsForbidden) getOpinionRevisionsRes() {}
+
+type GetOpinionRevisionsInternalServerError struct{}
+
+func (*GetOpinionRevisionsInternalServerError) getOpinionRevisionsRes() {}
+
+type GetOpinionRevisionsOK struct {
+	// 投稿者が削除した日時.
+	DeletedAt OptNilString `json:"deletedAt"`
+	// 新しい順。先頭は現在の内容.
+	Revisions []OpinionRevision `json:"revisions"`
 }
 
-// GetCode returns the value of Code.
-func (s *OpinionComments2InternalServerError) GetCode() string {
-	return s.Code
+// GetDeletedAt returns the value of DeletedAt.
+func (s *GetOpinionRevisionsOK) GetDeletedAt() OptNilString {
+	return s.DeletedAt
 }
 
-// GetMessage returns the value of Message.
-func (s *OpinionComments2InternalServerError) GetMessage() string {
-	return s.Message
+// GetRevisions returns the value of Revisions.
+func (s *GetOpinionRevisionsOK) GetRevisions() []OpinionRevision {
+	return s.Revisions
 }
 
-// SetCode sets the value of Code.
-func (s *OpinionComments2InternalServerError) SetCode(val string) {
-	s.Code = val
+// SetDeletedAt sets the value of DeletedAt.
+func (s *GetOpinionRevisionsOK) SetDeletedAt(val OptNilString) {
+	s.DeletedAt = val
 }
 
-// SetMessage sets the value of Message.
-func (s *OpinionComments2InternalServerError) SetMessage(val string) {
-	s.Message = val
+// SetRevisions sets the value of Revisions.
+func (s *GetOpinionRevisionsOK) SetRevisions(val []OpinionRevision) {
+	s.Revisions = val
 }
 
-func (*OpinionComments2InternalServerError) opinionComments2Res() {}
+func (*GetOpinionRevisionsOK) getOpinionRevisionsRes() {}
 
-type OpinionComments2OK struct {
-	Opinions []OpinionWithVote `json:"opinions"`
+type GetOpinionsForTalkSessionBadRequest struct{}
+
+func (*GetOpinionsForTalkSessionBadRequest) getOpinionsForTalkSessionRes() {}
+
+type GetOpinionsForTalkSessionInternalServerError struct{}
+
+func (*GetOpinionsForTalkSessionInternalServerError) getOpinionsForTalkSessionRes() {}
+
+type GetOpinionsForTalkSessionOK struct {
+	Opinions   []OpinionWithReplyAndVote             `json:"opinions"`
+	Pagination GetOpinionsForTalkSessionOKPagination `json:"pagination"`
 }
 
 // GetOpinions returns the value of Opinions.
-func (s *OpinionComments2OK) GetOpinions() []OpinionWithVote {
+func (s *GetOpinionsForTalkSessionOK) GetOpinions() []OpinionWithReplyAndVote {
 	return s.Opinions
 }
 
+// GetPagination returns the value of Pagination.
+func (s *GetOpinionsForTalkSessionOK) GetPagination() GetOpinionsForTalkSessionOKPagination {
+	return s.Pagination
+}
+
 // SetOpinions sets the value of Opinions.
-func (s *OpinionComments2OK) SetOpinions(val []OpinionWithVote) {
+func (s *GetOpinionsForTalkSessionOK) SetOpinions(val []OpinionWithReplyAndVote) {
 	s.Opinions = val
 }
 
-func (*OpinionComments2OK) opinionComments2Res() {}
-
-// Ref: #/components/schemas/OpinionGroupRatio
-type OpinionGroupRatio struct {
-	AgreeCount    int    `json:"agreeCount"`
-	DisagreeCount int    `json:"disagreeCount"`
-	PassCount     int    `json:"passCount"`
-	GroupID       int    `json:"groupID"`
-	GroupName     string `json:"groupName"`
+// SetPagination sets the value of Pagination.
+func (s *GetOpinionsForTalkSessionOK) SetPagination(val GetOpinionsForTalkSessionOKPagination) {
+	s.Pagination = val
 }
 
-// GetAgreeCount returns the value of AgreeCount.
-func (s *OpinionGroupRatio) GetAgreeCount() int {
-	return s.AgreeCount
-}
+func (*GetOpinionsForTalkSessionOK) getOpinionsForTalkSessionRes() {}
 
-// GetDisagreeCount returns the value of DisagreeCount.
-func (s *OpinionGroupRatio) GetDisagreeCount() int {
-	return s.DisagreeCount
+type GetOpinionsForTalkSessionOKPagination struct {
+	TotalCount int `json:"totalCount"`
 }
 
-// GetPassCount returns the value of PassCount.
-func (s *OpinionGroupRatio) GetPassCount() int {
-	return s.PassCount
+// GetTotalCount returns the value of TotalCount.
+func (s *GetOpinionsForTalkSessionOKPagination) GetTotalCount() int {
+	return s.TotalCount
 }
 
-// GetGroupID returns the value of GroupID.
-func (s *OpinionGroupRatio) GetGroupID() int {
-	return s.GroupID
+// SetTotalCount sets the value of TotalCount.
+func (s *GetOpinionsForTalkSessionOKPagination) SetTotalCount(val int) {
+	s.TotalCount = val
 }
 
-// GetGroupName returns the value of GroupName.
-func (s *OpinionGroupRatio) GetGroupName() string {
-	return s.GroupName
-}
+type GetOpinionsForTalkSessionSort string
 
-// SetAgreeCount sets the value of AgreeCount.
-func (s *OpinionGroupRatio) SetAgreeCount(val int) {
-	s.AgreeCount = val
-}
+const (
+	GetOpinionsForTalkSessionSortLatest      GetOpinionsForTalkSessionSort = "latest"
+	GetOpinionsForTalkSessionSortMostReplies GetOpinionsForTalkSessionSort = "mostReplies"
+	GetOpinionsForTalkSessionSortOldest      GetOpinionsForTalkSessionSort = "oldest"
+)
 
-// SetDisagreeCount sets the value of DisagreeCount.
-func (s *OpinionGroupRatio) SetDisagreeCount(val int) {
-	s.DisagreeCount = val
+// AllValues returns all GetOpinionsForTalkSessionSort values.
+func (GetOpinionsForTalkSessionSort) AllValues() []GetOpinionsForTalkSessionSort {
+	return []GetOpinionsForTalkSessionSort{
+		GetOpinionsForTalkSessionSortLatest,
+		GetOpinionsForTalkSessionSortMostReplies,
+		GetOpinionsForTalkSessionSortOldest,
+	}
 }
 
-// SetPassCount sets the value of PassCount.
-func (s *OpinionGroupRatio) SetPassCount(val int) {
-	s.PassCount = val
+// MarshalText implements encoding.TextMarshaler.
+func (s GetOpinionsForTalkSessionSort) MarshalText() ([]byte, error) {
+	switch s {
+	case GetOpinionsForTalkSessionSortLatest:
+		return []byte(s), nil
+	case GetOpinionsForTalkSessionSortMostReplies:
+		return []byte(s), nil
+	case GetOpinionsForTalkSessionSortOldest:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetGroupID sets the value of GroupID.
-func (s *OpinionGroupRatio) SetGroupID(val int) {
-	s.GroupID = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GetOpinionsForTalkSessionSort) UnmarshalText(data []byte) error {
+	switch GetOpinionsForTalkSessionSort(data) {
+	case GetOpinionsForTalkSessionSortLatest:
+		*s = GetOpinionsForTalkSessionSortLatest
+		return nil
+	case GetOpinionsForTalkSessionSortMostReplies:
+		*s = GetOpinionsForTalkSessionSortMostReplies
+		return nil
+	case GetOpinionsForTalkSessionSortOldest:
+		*s = GetOpinionsForTalkSessionSortOldest
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// SetGroupName sets the value of GroupName.
-func (s *OpinionGroupRatio) SetGroupName(val string) {
-	s.GroupName = val
-}
+type GetOrganizationAliasesBadRequest struct{}
 
-// 意見とリプライ数と投票情報を含むレスポンス.
-// Ref: #/components/schemas/OpinionWithReplyAndVote
-type OpinionWithReplyAndVote struct {
-	Opinion    Opinion        `json:"opinion"`
-	User       User           `json:"user"`
-	ReplyCount int            `json:"replyCount"`
-	MyVoteType OptNilVoteType `json:"myVoteType"`
-}
+func (*GetOrganizationAliasesBadRequest) getOrganizationAliasesRes() {}
 
-// GetOpinion returns the value of Opinion.
-func (s *OpinionWithReplyAndVote) GetOpinion() Opinion {
-	return s.Opinion
-}
+type GetOrganizationAliasesInternalServerError struct{}
 
-// GetUser returns the value of User.
-func (s *OpinionWithReplyAndVote) GetUser() User {
-	return s.User
-}
+func (*GetOrganizationAliasesInternalServerError) getOrganizationAliasesRes() {}
 
-// GetReplyCount returns the value of ReplyCount.
-func (s *OpinionWithReplyAndVote) GetReplyCount() int {
-	return s.ReplyCount
+type GetOrganizationAliasesOK struct {
+	Aliases []OrganizationAlias `json:"aliases"`
 }
 
-// GetMyVoteType returns the value of MyVoteType.
-func (s *OpinionWithReplyAndVote) GetMyVoteType() OptNilVoteType {
-	return s.MyVoteType
+// GetAliases returns the value of Aliases.
+func (s *GetOrganizationAliasesOK) GetAliases() []OrganizationAlias {
+	return s.Aliases
 }
 
-// SetOpinion sets the value of Opinion.
-func (s *OpinionWithReplyAndVote) SetOpinion(val Opinion) {
-	s.Opinion = val
+// SetAliases sets the value of Aliases.
+func (s *GetOrganizationAliasesOK) SetAliases(val []OrganizationAlias) {
+	s.Aliases = val
 }
 
-// SetUser sets the value of User.
-func (s *OpinionWithReplyAndVote) SetUser(val User) {
-	s.User = val
-}
+func (*GetOrganizationAliasesOK) getOrganizationAliasesRes() {}
 
-// SetReplyCount sets the value of ReplyCount.
-func (s *OpinionWithReplyAndVote) SetReplyCount(val int) {
-	s.ReplyCount = val
-}
+type GetOrganizationApiKeysBadRequest struct{}
 
-// SetMyVoteType sets the value of MyVoteType.
-func (s *OpinionWithReplyAndVote) SetMyVoteType(val OptNilVoteType) {
-	s.MyVoteType = val
-}
+func (*GetOrganizationApiKeysBadRequest) getOrganizationApiKeysRes() {}
 
-// 意見とリプライ数を含むレスポンス.
-// Ref: #/components/schemas/OpinionWithReplyCount
-type OpinionWithReplyCount struct {
-	Opinion    Opinion `json:"opinion"`
-	User       User    `json:"user"`
-	ReplyCount int     `json:"replyCount"`
+type GetOrganizationApiKeysInternalServerError struct{}
+
+func (*GetOrganizationApiKeysInternalServerError) getOrganizationApiKeysRes() {}
+
+type GetOrganizationApiKeysOK struct {
+	ApiKeys []OrganizationApiKey `json:"apiKeys"`
 }
 
-// GetOpinion returns the value of Opinion.
-func (s *OpinionWithReplyCount) GetOpinion() Opinion {
-	return s.Opinion
+// GetApiKeys returns the value of ApiKeys.
+func (s *GetOrganizationApiKeysOK) GetApiKeys() []OrganizationApiKey {
+	return s.ApiKeys
 }
 
-// GetUser returns the value of User.
-func (s *OpinionWithReplyCount) GetUser() User {
-	return s.User
+// SetApiKeys sets the value of ApiKeys.
+func (s *GetOrganizationApiKeysOK) SetApiKeys(val []OrganizationApiKey) {
+	s.ApiKeys = val
 }
 
-// GetReplyCount returns the value of ReplyCount.
-func (s *OpinionWithReplyCount) GetReplyCount() int {
-	return s.ReplyCount
+func (*GetOrganizationApiKeysOK) getOrganizationApiKeysRes() {}
+
+type GetOrganizationAuditLogsBadRequest struct{}
+
+func (*GetOrganizationAuditLogsBadRequest) getOrganizationAuditLogsRes() {}
+
+type GetOrganizationAuditLogsForbidden struct{}
+
+func (*GetOrganizationAuditLogsForbidden) getOrganizationAuditLogsRes() {}
+
+type GetOrganizationAuditLogsInternalServerError struct{}
+
+func (*GetOrganizationAuditLogsInternalServerError) getOrganizationAuditLogsRes() {}
+
+type GetOrganizationAuditLogsOK struct {
+	AuditLogs  []OrganizationAuditLog `json:"auditLogs"`
+	TotalCount int                    `json:"totalCount"`
 }
 
-// SetOpinion sets the value of Opinion.
-func (s *OpinionWithReplyCount) SetOpinion(val Opinion) {
-	s.Opinion = val
+// GetAuditLogs returns the value of AuditLogs.
+func (s *GetOrganizationAuditLogsOK) GetAuditLogs() []OrganizationAuditLog {
+	return s.AuditLogs
 }
 
-// SetUser sets the value of User.
-func (s *OpinionWithReplyCount) SetUser(val User) {
-	s.User = val
+// GetTotalCount returns the value of TotalCount.
+func (s *GetOrganizationAuditLogsOK) GetTotalCount() int {
+	return s.TotalCount
 }
 
-// SetReplyCount sets the value of ReplyCount.
-func (s *OpinionWithReplyCount) SetReplyCount(val int) {
-	s.ReplyCount = val
+// SetAuditLogs sets the value of AuditLogs.
+func (s *GetOrganizationAuditLogsOK) SetAuditLogs(val []OrganizationAuditLog) {
+	s.AuditLogs = val
 }
 
-// 意見と投票情報を含むレスポンス.
-// Ref: #/components/schemas/OpinionWithVote
-type OpinionWithVote struct {
-	Opinion    Opinion        `json:"opinion"`
-	User       User           `json:"user"`
-	MyVoteType OptNilVoteType `json:"myVoteType"`
+// SetTotalCount sets the value of TotalCount.
+func (s *GetOrganizationAuditLogsOK) SetTotalCount(val int) {
+	s.TotalCount = val
 }
 
-// GetOpinion returns the value of Opinion.
-func (s *OpinionWithVote) GetOpinion() Opinion {
-	return s.Opinion
+func (*GetOrganizationAuditLogsOK) getOrganizationAuditLogsRes() {}
+
+type GetOrganizationChildrenBadRequest struct{}
+
+func (*GetOrganizationChildrenBadRequest) getOrganizationChildrenRes() {}
+
+type GetOrganizationChildrenForbidden struct{}
+
+func (*GetOrganizationChildrenForbidden) getOrganizationChildrenRes() {}
+
+type GetOrganizationChildrenInternalServerError struct{}
+
+func (*GetOrganizationChildrenInternalServerError) getOrganizationChildrenRes() {}
+
+type GetOrganizationChildrenOK struct {
+	Organizations []Organization `json:"organizations"`
 }
 
-// GetUser returns the value of User.
-func (s *OpinionWithVote) GetUser() User {
-	return s.User
+// GetOrganizations returns the value of Organizations.
+func (s *GetOrganizationChildrenOK) GetOrganizations() []Organization {
+	return s.Organizations
 }
 
-// GetMyVoteType returns the value of MyVoteType.
-func (s *OpinionWithVote) GetMyVoteType() OptNilVoteType {
-	return s.MyVoteType
+// SetOrganizations sets the value of Organizations.
+func (s *GetOrganizationChildrenOK) SetOrganizations(val []Organization) {
+	s.Organizations = val
 }
 
-// SetOpinion sets the value of Opinion.
-func (s *OpinionWithVote) SetOpinion(val Opinion) {
-	s.Opinion = val
+func (*GetOrganizationChildrenOK) getOrganizationChildrenRes() {}
+
+type GetOrganizationInvitationsBadRequest struct{}
+
+func (*GetOrganizationInvitationsBadRequest) getOrganizationInvitationsRes() {}
+
+type GetOrganizationInvitationsInternalServerError struct{}
+
+func (*GetOrganizationInvitationsInternalServerError) getOrganizationInvitationsRes() {}
+
+type GetOrganizationInvitationsOK struct {
+	Invitations []OrganizationInvitation `json:"invitations"`
 }
 
-// SetUser sets the value of User.
-func (s *OpinionWithVote) SetUser(val User) {
-	s.User = val
+// GetInvitations returns the value of Invitations.
+func (s *GetOrganizationInvitationsOK) GetInvitations() []OrganizationInvitation {
+	return s.Invitations
 }
 
-// SetMyVoteType sets the value of MyVoteType.
-func (s *OpinionWithVote) SetMyVoteType(val OptNilVoteType) {
-	s.MyVoteType = val
+// SetInvitations sets the value of Invitations.
+func (s *GetOrganizationInvitationsOK) SetInvitations(val []OrganizationInvitation) {
+	s.Invitations = val
 }
 
-func (*OpinionWithVote) getOpinionDetail2Res() {}
+func (*GetOrganizationInvitationsOK) getOrganizationInvitationsRes() {}
 
-type OpinionsHistoryBadRequest struct{}
+type GetOrganizationReferenceDemographicsBadRequest struct{}
 
-func (*OpinionsHistoryBadRequest) opinionsHistoryRes() {}
+func (*GetOrganizationReferenceDemographicsBadRequest) getOrganizationReferenceDemographicsRes() {}
 
-type OpinionsHistoryInternalServerError struct{}
+type GetOrganizationReferenceDemographicsForbidden struct{}
 
-func (*OpinionsHistoryInternalServerError) opinionsHistoryRes() {}
+func (*GetOrganizationReferenceDemographicsForbidden) getOrganizationReferenceDemographicsRes() {}
 
-type OpinionsHistoryOK struct {
-	Opinions   []OpinionWithReplyCount     `json:"opinions"`
-	Pagination OpinionsHistoryOKPagination `json:"pagination"`
-}
+type GetOrganizationReferenceDemographicsInternalServerError struct{}
 
-// GetOpinions returns the value of Opinions.
-func (s *OpinionsHistoryOK) GetOpinions() []OpinionWithReplyCount {
-	return s.Opinions
+func (*GetOrganizationReferenceDemographicsInternalServerError) getOrganizationReferenceDemographicsRes() {
 }
 
-// GetPagination returns the value of Pagination.
-func (s *OpinionsHistoryOK) GetPagination() OpinionsHistoryOKPagination {
-	return s.Pagination
+type GetOrganizationReferenceDemographicsOK struct {
+	Distributions []ReferenceDistribution `json:"distributions"`
 }
 
-// SetOpinions sets the value of Opinions.
-func (s *OpinionsHistoryOK) SetOpinions(val []OpinionWithReplyCount) {
-	s.Opinions = val
+// GetDistributions returns the value of Distributions.
+func (s *GetOrganizationReferenceDemographicsOK) GetDistributions() []ReferenceDistribution {
+	return s.Distributions
 }
 
-// SetPagination sets the value of Pagination.
-func (s *OpinionsHistoryOK) SetPagination(val OpinionsHistoryOKPagination) {
-	s.Pagination = val
+// SetDistributions sets the value of Distributions.
+func (s *GetOrganizationReferenceDemographicsOK) SetDistributions(val []ReferenceDistribution) {
+	s.Distributions = val
 }
 
-func (*OpinionsHistoryOK) opinionsHistoryRes() {}
+func (*GetOrganizationReferenceDemographicsOK) getOrganizationReferenceDemographicsRes() {}
 
-type OpinionsHistoryOKPagination struct {
-	TotalCount int `json:"totalCount"`
-}
+type GetOrganizationReportPromptBadRequest struct{}
 
-// GetTotalCount returns the value of TotalCount.
-func (s *OpinionsHistoryOKPagination) GetTotalCount() int {
-	return s.TotalCount
-}
+func (*GetOrganizationReportPromptBadRequest) getOrganizationReportPromptRes() {}
 
-// SetTotalCount sets the value of TotalCount.
-func (s *OpinionsHistoryOKPagination) SetTotalCount(val int) {
-	s.TotalCount = val
-}
+type GetOrganizationReportPromptForbidden struct{}
 
-type OpinionsHistorySort string
+func (*GetOrganizationReportPromptForbidden) getOrganizationReportPromptRes() {}
+
+type GetOrganizationReportPromptInternalServerError struct{}
+
+func (*GetOrganizationReportPromptInternalServerError) getOrganizationReportPromptRes() {}
+
+type GetOrganizationStatsBadRequest struct{}
+
+func (*GetOrganizationStatsBadRequest) getOrganizationStatsRes() {}
+
+type GetOrganizationStatsBucket string
 
 const (
-	OpinionsHistorySortLatest      OpinionsHistorySort = "latest"
-	OpinionsHistorySortMostReplies OpinionsHistorySort = "mostReplies"
-	OpinionsHistorySortOldest      OpinionsHistorySort = "oldest"
+	GetOrganizationStatsBucketDay   GetOrganizationStatsBucket = "day"
+	GetOrganizationStatsBucketWeek  GetOrganizationStatsBucket = "week"
+	GetOrganizationStatsBucketMonth GetOrganizationStatsBucket = "month"
 )
 
-// AllValues returns all OpinionsHistorySort values.
-func (OpinionsHistorySort) AllValues() []OpinionsHistorySort {
-	return []OpinionsHistorySort{
-		OpinionsHistorySortLatest,
-		OpinionsHistorySortMostReplies,
-		OpinionsHistorySortOldest,
+// AllValues returns all GetOrganizationStatsBucket values.
+func (GetOrganizationStatsBucket) AllValues() []GetOrganizationStatsBucket {
+	return []GetOrganizationStatsBucket{
+		GetOrganizationStatsBucketDay,
+		GetOrganizationStatsBucketWeek,
+		GetOrganizationStatsBucketMonth,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s OpinionsHistorySort) MarshalText() ([]byte, error) {
+func (s GetOrganizationStatsBucket) MarshalText() ([]byte, error) {
 	switch s {
-	case OpinionsHistorySortLatest:
+	case GetOrganizationStatsBucketDay:
 		return []byte(s), nil
-	case OpinionsHistorySortMostReplies:
+	case GetOrganizationStatsBucketWeek:
 		return []byte(s), nil
-	case OpinionsHistorySortOldest:
+	case GetOrganizationStatsBucketMonth:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -3238,2265 +3285,7889 @@ func (s OpinionsHistorySort) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *OpinionsHistorySort) UnmarshalText(data []byte) error {
-	switch OpinionsHistorySort(data) {
-	case OpinionsHistorySortLatest:
-		*s = OpinionsHistorySortLatest
+func (s *GetOrganizationStatsBucket) UnmarshalText(data []byte) error {
+	switch GetOrganizationStatsBucket(data) {
+	case GetOrganizationStatsBucketDay:
+		*s = GetOrganizationStatsBucketDay
 		return nil
-	case OpinionsHistorySortMostReplies:
-		*s = OpinionsHistorySortMostReplies
+	case GetOrganizationStatsBucketWeek:
+		*s = GetOrganizationStatsBucketWeek
 		return nil
-	case OpinionsHistorySortOldest:
-		*s = OpinionsHistorySortOldest
+	case GetOrganizationStatsBucketMonth:
+		*s = GetOrganizationStatsBucketMonth
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// NewOptBool returns new OptBool with value set to v.
-func NewOptBool(v bool) OptBool {
-	return OptBool{
-		Value: v,
-		Set:   true,
-	}
-}
+type GetOrganizationStatsForbidden struct{}
 
-// OptBool is optional bool.
-type OptBool struct {
-	Value bool
-	Set   bool
-}
+func (*GetOrganizationStatsForbidden) getOrganizationStatsRes() {}
 
-// IsSet returns true if OptBool was set.
-func (o OptBool) IsSet() bool { return o.Set }
+type GetOrganizationStatsInternalServerError struct{}
 
-// Reset unsets value.
-func (o *OptBool) Reset() {
-	var v bool
-	o.Value = v
-	o.Set = false
-}
+func (*GetOrganizationStatsInternalServerError) getOrganizationStatsRes() {}
 
-// SetTo sets value to v.
-func (o *OptBool) SetTo(v bool) {
-	o.Set = true
-	o.Value = v
-}
+type GetOrganizationTalkSessionsBadRequest struct{}
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptBool) Get() (v bool, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
-}
+func (*GetOrganizationTalkSessionsBadRequest) getOrganizationTalkSessionsRes() {}
 
-// Or returns value if set, or given parameter if does not.
-func (o OptBool) Or(d bool) bool {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
-}
+type GetOrganizationTalkSessionsForbidden struct{}
 
-// NewOptFloat64 returns new OptFloat64 with value set to v.
-func NewOptFloat64(v float64) OptFloat64 {
-	return OptFloat64{
-		Value: v,
-		Set:   true,
-	}
-}
+func (*GetOrganizationTalkSessionsForbidden) getOrganizationTalkSessionsRes() {}
 
-// OptFloat64 is optional float64.
-type OptFloat64 struct {
-	Value float64
-	Set   bool
-}
+type GetOrganizationTalkSessionsInternalServerError struct{}
 
-// IsSet returns true if OptFloat64 was set.
-func (o OptFloat64) IsSet() bool { return o.Set }
+func (*GetOrganizationTalkSessionsInternalServerError) getOrganizationTalkSessionsRes() {}
 
-// Reset unsets value.
-func (o *OptFloat64) Reset() {
-	var v float64
-	o.Value = v
-	o.Set = false
+type GetOrganizationTalkSessionsOK struct {
+	TalkSessions []GetOrganizationTalkSessionsOKTalkSessionsItem `json:"talkSessions"`
+	Pagination   OffsetPagination                                `json:"pagination"`
 }
 
-// SetTo sets value to v.
-func (o *OptFloat64) SetTo(v float64) {
-	o.Set = true
-	o.Value = v
+// GetTalkSessions returns the value of TalkSessions.
+func (s *GetOrganizationTalkSessionsOK) GetTalkSessions() []GetOrganizationTalkSessionsOKTalkSessionsItem {
+	return s.TalkSessions
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptFloat64) Get() (v float64, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetPagination returns the value of Pagination.
+func (s *GetOrganizationTalkSessionsOK) GetPagination() OffsetPagination {
+	return s.Pagination
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptFloat64) Or(d float64) float64 {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetTalkSessions sets the value of TalkSessions.
+func (s *GetOrganizationTalkSessionsOK) SetTalkSessions(val []GetOrganizationTalkSessionsOKTalkSessionsItem) {
+	s.TalkSessions = val
 }
 
-// NewOptGetReportsForTalkSessionStatus returns new OptGetReportsForTalkSessionStatus with value set to v.
-func NewOptGetReportsForTalkSessionStatus(v GetReportsForTalkSessionStatus) OptGetReportsForTalkSessionStatus {
-	return OptGetReportsForTalkSessionStatus{
-		Value: v,
-		Set:   true,
-	}
+// SetPagination sets the value of Pagination.
+func (s *GetOrganizationTalkSessionsOK) SetPagination(val OffsetPagination) {
+	s.Pagination = val
 }
 
-// OptGetReportsForTalkSessionStatus is optional GetReportsForTalkSessionStatus.
-type OptGetReportsForTalkSessionStatus struct {
-	Value GetReportsForTalkSessionStatus
-	Set   bool
+func (*GetOrganizationTalkSessionsOK) getOrganizationTalkSessionsRes() {}
+
+type GetOrganizationTalkSessionsOKTalkSessionsItem struct {
+	TalkSession  TalkSession `json:"talkSession"`
+	OpinionCount int         `json:"opinionCount"`
 }
 
-// IsSet returns true if OptGetReportsForTalkSessionStatus was set.
-func (o OptGetReportsForTalkSessionStatus) IsSet() bool { return o.Set }
+// GetTalkSession returns the value of TalkSession.
+func (s *GetOrganizationTalkSessionsOKTalkSessionsItem) GetTalkSession() TalkSession {
+	return s.TalkSession
+}
 
-// Reset unsets value.
-func (o *OptGetReportsForTalkSessionStatus) Reset() {
-	var v GetReportsForTalkSessionStatus
-	o.Value = v
-	o.Set = false
+// GetOpinionCount returns the value of OpinionCount.
+func (s *GetOrganizationTalkSessionsOKTalkSessionsItem) GetOpinionCount() int {
+	return s.OpinionCount
 }
 
-// SetTo sets value to v.
-func (o *OptGetReportsForTalkSessionStatus) SetTo(v GetReportsForTalkSessionStatus) {
-	o.Set = true
-	o.Value = v
+// SetTalkSession sets the value of TalkSession.
+func (s *GetOrganizationTalkSessionsOKTalkSessionsItem) SetTalkSession(val TalkSession) {
+	s.TalkSession = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptGetReportsForTalkSessionStatus) Get() (v GetReportsForTalkSessionStatus, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetOpinionCount sets the value of OpinionCount.
+func (s *GetOrganizationTalkSessionsOKTalkSessionsItem) SetOpinionCount(val int) {
+	s.OpinionCount = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptGetReportsForTalkSessionStatus) Or(d GetReportsForTalkSessionStatus) GetReportsForTalkSessionStatus {
-	if v, ok := o.Get(); ok {
-		return v
+type GetOrganizationTalkSessionsStatus string
+
+const (
+	GetOrganizationTalkSessionsStatusOpen     GetOrganizationTalkSessionsStatus = "open"
+	GetOrganizationTalkSessionsStatusFinished GetOrganizationTalkSessionsStatus = "finished"
+)
+
+// AllValues returns all GetOrganizationTalkSessionsStatus values.
+func (GetOrganizationTalkSessionsStatus) AllValues() []GetOrganizationTalkSessionsStatus {
+	return []GetOrganizationTalkSessionsStatus{
+		GetOrganizationTalkSessionsStatusOpen,
+		GetOrganizationTalkSessionsStatusFinished,
 	}
-	return d
 }
 
-// NewOptGetTalkSessionListManageStatus returns new OptGetTalkSessionListManageStatus with value set to v.
-func NewOptGetTalkSessionListManageStatus(v GetTalkSessionListManageStatus) OptGetTalkSessionListManageStatus {
-	return OptGetTalkSessionListManageStatus{
-		Value: v,
-		Set:   true,
+// MarshalText implements encoding.TextMarshaler.
+func (s GetOrganizationTalkSessionsStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case GetOrganizationTalkSessionsStatusOpen:
+		return []byte(s), nil
+	case GetOrganizationTalkSessionsStatusFinished:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
 }
 
-// OptGetTalkSessionListManageStatus is optional GetTalkSessionListManageStatus.
-type OptGetTalkSessionListManageStatus struct {
-	Value GetTalkSessionListManageStatus
-	Set   bool
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GetOrganizationTalkSessionsStatus) UnmarshalText(data []byte) error {
+	switch GetOrganizationTalkSessionsStatus(data) {
+	case GetOrganizationTalkSessionsStatusOpen:
+		*s = GetOrganizationTalkSessionsStatusOpen
+		return nil
+	case GetOrganizationTalkSessionsStatusFinished:
+		*s = GetOrganizationTalkSessionsStatusFinished
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// IsSet returns true if OptGetTalkSessionListManageStatus was set.
-func (o OptGetTalkSessionListManageStatus) IsSet() bool { return o.Set }
+type GetOrganizationUsersBadRequest struct{}
 
-// Reset unsets value.
-func (o *OptGetTalkSessionListManageStatus) Reset() {
-	var v GetTalkSessionListManageStatus
-	o.Value = v
-	o.Set = false
-}
+func (*GetOrganizationUsersBadRequest) getOrganizationUsersRes() {}
 
-// SetTo sets value to v.
-func (o *OptGetTalkSessionListManageStatus) SetTo(v GetTalkSessionListManageStatus) {
-	o.Set = true
-	o.Value = v
-}
+type GetOrganizationUsersInternalServerError struct{}
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptGetTalkSessionListManageStatus) Get() (v GetTalkSessionListManageStatus, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
-}
+func (*GetOrganizationUsersInternalServerError) getOrganizationUsersRes() {}
 
-// Or returns value if set, or given parameter if does not.
-func (o OptGetTalkSessionListManageStatus) Or(d GetTalkSessionListManageStatus) GetTalkSessionListManageStatus {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+type GetOrganizationUsersOK struct {
+	Users []OrganizationUser `json:"users"`
 }
 
-// NewOptGetTalkSessionListSortKey returns new OptGetTalkSessionListSortKey with value set to v.
-func NewOptGetTalkSessionListSortKey(v GetTalkSessionListSortKey) OptGetTalkSessionListSortKey {
-	return OptGetTalkSessionListSortKey{
-		Value: v,
-		Set:   true,
-	}
+// GetUsers returns the value of Users.
+func (s *GetOrganizationUsersOK) GetUsers() []OrganizationUser {
+	return s.Users
 }
 
-// OptGetTalkSessionListSortKey is optional GetTalkSessionListSortKey.
-type OptGetTalkSessionListSortKey struct {
-	Value GetTalkSessionListSortKey
-	Set   bool
+// SetUsers sets the value of Users.
+func (s *GetOrganizationUsersOK) SetUsers(val []OrganizationUser) {
+	s.Users = val
 }
 
-// IsSet returns true if OptGetTalkSessionListSortKey was set.
-func (o OptGetTalkSessionListSortKey) IsSet() bool { return o.Set }
+func (*GetOrganizationUsersOK) getOrganizationUsersRes() {}
 
-// Reset unsets value.
-func (o *OptGetTalkSessionListSortKey) Reset() {
-	var v GetTalkSessionListSortKey
-	o.Value = v
-	o.Set = false
-}
+type GetOrganizationUsersUnauthorized struct{}
 
-// SetTo sets value to v.
-func (o *OptGetTalkSessionListSortKey) SetTo(v GetTalkSessionListSortKey) {
-	o.Set = true
-	o.Value = v
-}
+func (*GetOrganizationUsersUnauthorized) getOrganizationUsersRes() {}
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptGetTalkSessionListSortKey) Get() (v GetTalkSessionListSortKey, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
-}
+type GetOrganizationsBadRequest struct{}
 
-// Or returns value if set, or given parameter if does not.
-func (o OptGetTalkSessionListSortKey) Or(d GetTalkSessionListSortKey) GetTalkSessionListSortKey {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
-}
+func (*GetOrganizationsBadRequest) getOrganizationsRes() {}
 
-// NewOptGetUserListManageOrder returns new OptGetUserListManageOrder with value set to v.
-func NewOptGetUserListManageOrder(v GetUserListManageOrder) OptGetUserListManageOrder {
-	return OptGetUserListManageOrder{
-		Value: v,
-		Set:   true,
-	}
-}
+type GetOrganizationsInternalServerError struct{}
 
-// OptGetUserListManageOrder is optional GetUserListManageOrder.
-type OptGetUserListManageOrder struct {
-	Value GetUserListManageOrder
-	Set   bool
-}
+func (*GetOrganizationsInternalServerError) getOrganizationsRes() {}
 
-// IsSet returns true if OptGetUserListManageOrder was set.
-func (o OptGetUserListManageOrder) IsSet() bool { return o.Set }
+type GetOrganizationsOK struct {
+	Organizations []Organization `json:"organizations"`
+}
 
-// Reset unsets value.
-func (o *OptGetUserListManageOrder) Reset() {
-	var v GetUserListManageOrder
-	o.Value = v
-	o.Set = false
+// GetOrganizations returns the value of Organizations.
+func (s *GetOrganizationsOK) GetOrganizations() []Organization {
+	return s.Organizations
 }
 
-// SetTo sets value to v.
-func (o *OptGetUserListManageOrder) SetTo(v GetUserListManageOrder) {
-	o.Set = true
-	o.Value = v
+// SetOrganizations sets the value of Organizations.
+func (s *GetOrganizationsOK) SetOrganizations(val []Organization) {
+	s.Organizations = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptGetUserListManageOrder) Get() (v GetUserListManageOrder, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
-}
+func (*GetOrganizationsOK) getOrganizationsRes() {}
 
-// Or returns value if set, or given parameter if does not.
-func (o OptGetUserListManageOrder) Or(d GetUserListManageOrder) GetUserListManageOrder {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
-}
+type GetPolicyConsentStatusBadRequest struct{}
 
-// NewOptGetUserListManageOrderBy returns new OptGetUserListManageOrderBy with value set to v.
-func NewOptGetUserListManageOrderBy(v GetUserListManageOrderBy) OptGetUserListManageOrderBy {
-	return OptGetUserListManageOrderBy{
-		Value: v,
-		Set:   true,
-	}
-}
+func (*GetPolicyConsentStatusBadRequest) getPolicyConsentStatusRes() {}
 
-// OptGetUserListManageOrderBy is optional GetUserListManageOrderBy.
-type OptGetUserListManageOrderBy struct {
-	Value GetUserListManageOrderBy
-	Set   bool
-}
+type GetPolicyConsentStatusInternalServerError struct{}
 
-// IsSet returns true if OptGetUserListManageOrderBy was set.
-func (o OptGetUserListManageOrderBy) IsSet() bool { return o.Set }
+func (*GetPolicyConsentStatusInternalServerError) getPolicyConsentStatusRes() {}
 
-// Reset unsets value.
-func (o *OptGetUserListManageOrderBy) Reset() {
-	var v GetUserListManageOrderBy
-	o.Value = v
-	o.Set = false
-}
+type GetReportsForTalkSessionBadRequest struct{}
 
-// SetTo sets value to v.
-func (o *OptGetUserListManageOrderBy) SetTo(v GetUserListManageOrderBy) {
-	o.Set = true
-	o.Value = v
-}
+func (*GetReportsForTalkSessionBadRequest) getReportsForTalkSessionRes() {}
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptGetUserListManageOrderBy) Get() (v GetUserListManageOrderBy, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
-}
+type GetReportsForTalkSessionInternalServerError struct{}
 
-// Or returns value if set, or given parameter if does not.
-func (o OptGetUserListManageOrderBy) Or(d GetUserListManageOrderBy) GetUserListManageOrderBy {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+func (*GetReportsForTalkSessionInternalServerError) getReportsForTalkSessionRes() {}
+
+type GetReportsForTalkSessionOK struct {
+	Reports []ReportDetail `json:"reports"`
 }
 
-// NewOptInt returns new OptInt with value set to v.
-func NewOptInt(v int) OptInt {
-	return OptInt{
-		Value: v,
-		Set:   true,
-	}
+// GetReports returns the value of Reports.
+func (s *GetReportsForTalkSessionOK) GetReports() []ReportDetail {
+	return s.Reports
 }
 
-// OptInt is optional int.
-type OptInt struct {
-	Value int
-	Set   bool
+// SetReports sets the value of Reports.
+func (s *GetReportsForTalkSessionOK) SetReports(val []ReportDetail) {
+	s.Reports = val
 }
 
-// IsSet returns true if OptInt was set.
-func (o OptInt) IsSet() bool { return o.Set }
+func (*GetReportsForTalkSessionOK) getReportsForTalkSessionRes() {}
 
-// Reset unsets value.
-func (o *OptInt) Reset() {
-	var v int
-	o.Value = v
-	o.Set = false
-}
+type GetReportsForTalkSessionStatus string
 
-// SetTo sets value to v.
-func (o *OptInt) SetTo(v int) {
-	o.Set = true
-	o.Value = v
-}
+const (
+	GetReportsForTalkSessionStatusUnsolved GetReportsForTalkSessionStatus = "unsolved"
+	GetReportsForTalkSessionStatusDeleted  GetReportsForTalkSessionStatus = "deleted"
+	GetReportsForTalkSessionStatusHold     GetReportsForTalkSessionStatus = "hold"
+)
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptInt) Get() (v int, ok bool) {
-	if !o.Set {
-		return v, false
+// AllValues returns all GetReportsForTalkSessionStatus values.
+func (GetReportsForTalkSessionStatus) AllValues() []GetReportsForTalkSessionStatus {
+	return []GetReportsForTalkSessionStatus{
+		GetReportsForTalkSessionStatusUnsolved,
+		GetReportsForTalkSessionStatusDeleted,
+		GetReportsForTalkSessionStatusHold,
 	}
-	return o.Value, true
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptInt) Or(d int) int {
-	if v, ok := o.Get(); ok {
-		return v
+// MarshalText implements encoding.TextMarshaler.
+func (s GetReportsForTalkSessionStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case GetReportsForTalkSessionStatusUnsolved:
+		return []byte(s), nil
+	case GetReportsForTalkSessionStatusDeleted:
+		return []byte(s), nil
+	case GetReportsForTalkSessionStatusHold:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
-	return d
 }
 
-// NewOptInt32 returns new OptInt32 with value set to v.
-func NewOptInt32(v int32) OptInt32 {
-	return OptInt32{
-		Value: v,
-		Set:   true,
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GetReportsForTalkSessionStatus) UnmarshalText(data []byte) error {
+	switch GetReportsForTalkSessionStatus(data) {
+	case GetReportsForTalkSessionStatusUnsolved:
+		*s = GetReportsForTalkSessionStatusUnsolved
+		return nil
+	case GetReportsForTalkSessionStatusDeleted:
+		*s = GetReportsForTalkSessionStatusDeleted
+		return nil
+	case GetReportsForTalkSessionStatusHold:
+		*s = GetReportsForTalkSessionStatusHold
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// OptInt32 is optional int32.
-type OptInt32 struct {
-	Value int32
-	Set   bool
-}
+type GetTalkSessionCollaboratorsBadRequest struct{}
 
-// IsSet returns true if OptInt32 was set.
-func (o OptInt32) IsSet() bool { return o.Set }
+func (*GetTalkSessionCollaboratorsBadRequest) getTalkSessionCollaboratorsRes() {}
 
-// Reset unsets value.
-func (o *OptInt32) Reset() {
-	var v int32
-	o.Value = v
-	o.Set = false
-}
+type GetTalkSessionCollaboratorsForbidden struct{}
 
-// SetTo sets value to v.
-func (o *OptInt32) SetTo(v int32) {
-	o.Set = true
-	o.Value = v
+func (*GetTalkSessionCollaboratorsForbidden) getTalkSessionCollaboratorsRes() {}
+
+type GetTalkSessionCollaboratorsInternalServerError struct{}
+
+func (*GetTalkSessionCollaboratorsInternalServerError) getTalkSessionCollaboratorsRes() {}
+
+type GetTalkSessionCollaboratorsOK struct {
+	Owner         User                      `json:"owner"`
+	Collaborators []TalkSessionCollaborator `json:"collaborators"`
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptInt32) Get() (v int32, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetOwner returns the value of Owner.
+func (s *GetTalkSessionCollaboratorsOK) GetOwner() User {
+	return s.Owner
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptInt32) Or(d int32) int32 {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetCollaborators returns the value of Collaborators.
+func (s *GetTalkSessionCollaboratorsOK) GetCollaborators() []TalkSessionCollaborator {
+	return s.Collaborators
 }
 
-// NewOptLocation returns new OptLocation with value set to v.
-func NewOptLocation(v Location) OptLocation {
-	return OptLocation{
-		Value: v,
-		Set:   true,
-	}
+// SetOwner sets the value of Owner.
+func (s *GetTalkSessionCollaboratorsOK) SetOwner(val User) {
+	s.Owner = val
 }
 
-// OptLocation is optional Location.
-type OptLocation struct {
-	Value Location
-	Set   bool
+// SetCollaborators sets the value of Collaborators.
+func (s *GetTalkSessionCollaboratorsOK) SetCollaborators(val []TalkSessionCollaborator) {
+	s.Collaborators = val
 }
 
-// IsSet returns true if OptLocation was set.
-func (o OptLocation) IsSet() bool { return o.Set }
+func (*GetTalkSessionCollaboratorsOK) getTalkSessionCollaboratorsRes() {}
 
-// Reset unsets value.
-func (o *OptLocation) Reset() {
-	var v Location
-	o.Value = v
-	o.Set = false
+type GetTalkSessionDetailBadRequest struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
 }
 
-// SetTo sets value to v.
-func (o *OptLocation) SetTo(v Location) {
-	o.Set = true
-	o.Value = v
+// GetCode returns the value of Code.
+func (s *GetTalkSessionDetailBadRequest) GetCode() string {
+	return s.Code
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptLocation) Get() (v Location, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetMessage returns the value of Message.
+func (s *GetTalkSessionDetailBadRequest) GetMessage() string {
+	return s.Message
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptLocation) Or(d Location) Location {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetCode sets the value of Code.
+func (s *GetTalkSessionDetailBadRequest) SetCode(val string) {
+	s.Code = val
 }
 
-// NewOptMultipartFile returns new OptMultipartFile with value set to v.
-func NewOptMultipartFile(v ht.MultipartFile) OptMultipartFile {
-	return OptMultipartFile{
-		Value: v,
-		Set:   true,
-	}
+// SetMessage sets the value of Message.
+func (s *GetTalkSessionDetailBadRequest) SetMessage(val string) {
+	s.Message = val
 }
 
-// OptMultipartFile is optional ht.MultipartFile.
-type OptMultipartFile struct {
-	Value ht.MultipartFile
-	Set   bool
-}
+func (*GetTalkSessionDetailBadRequest) getTalkSessionDetailRes() {}
 
-// IsSet returns true if OptMultipartFile was set.
-func (o OptMultipartFile) IsSet() bool { return o.Set }
+type GetTalkSessionListBadRequest struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
 
-// Reset unsets value.
-func (o *OptMultipartFile) Reset() {
-	var v ht.MultipartFile
-	o.Value = v
-	o.Set = false
+// GetCode returns the value of Code.
+func (s *GetTalkSessionListBadRequest) GetCode() string {
+	return s.Code
 }
 
-// SetTo sets value to v.
-func (o *OptMultipartFile) SetTo(v ht.MultipartFile) {
-	o.Set = true
-	o.Value = v
+// GetMessage returns the value of Message.
+func (s *GetTalkSessionListBadRequest) GetMessage() string {
+	return s.Message
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptMultipartFile) Get() (v ht.MultipartFile, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetCode sets the value of Code.
+func (s *GetTalkSessionListBadRequest) SetCode(val string) {
+	s.Code = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptMultipartFile) Or(d ht.MultipartFile) ht.MultipartFile {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetMessage sets the value of Message.
+func (s *GetTalkSessionListBadRequest) SetMessage(val string) {
+	s.Message = val
 }
 
-// NewOptNilBool returns new OptNilBool with value set to v.
-func NewOptNilBool(v bool) OptNilBool {
-	return OptNilBool{
-		Value: v,
-		Set:   true,
-	}
+func (*GetTalkSessionListBadRequest) getTalkSessionListRes() {}
+
+type GetTalkSessionListInternalServerError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
 }
 
-// OptNilBool is optional nullable bool.
-type OptNilBool struct {
-	Value bool
-	Set   bool
-	Null  bool
+// GetCode returns the value of Code.
+func (s *GetTalkSessionListInternalServerError) GetCode() string {
+	return s.Code
 }
 
-// IsSet returns true if OptNilBool was set.
-func (o OptNilBool) IsSet() bool { return o.Set }
+// GetMessage returns the value of Message.
+func (s *GetTalkSessionListInternalServerError) GetMessage() string {
+	return s.Message
+}
 
-// Reset unsets value.
-func (o *OptNilBool) Reset() {
-	var v bool
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// SetCode sets the value of Code.
+func (s *GetTalkSessionListInternalServerError) SetCode(val string) {
+	s.Code = val
 }
 
-// SetTo sets value to v.
-func (o *OptNilBool) SetTo(v bool) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// SetMessage sets the value of Message.
+func (s *GetTalkSessionListInternalServerError) SetMessage(val string) {
+	s.Message = val
 }
 
-// IsNull returns true if value is Null.
-func (o OptNilBool) IsNull() bool { return o.Null }
+func (*GetTalkSessionListInternalServerError) getTalkSessionListRes() {}
 
-// SetToNull sets value to null.
-func (o *OptNilBool) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v bool
-	o.Value = v
-}
+type GetTalkSessionListManageStatus string
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilBool) Get() (v bool, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
+const (
+	GetTalkSessionListManageStatusActive   GetTalkSessionListManageStatus = "active"
+	GetTalkSessionListManageStatusInactive GetTalkSessionListManageStatus = "inactive"
+)
+
+// AllValues returns all GetTalkSessionListManageStatus values.
+func (GetTalkSessionListManageStatus) AllValues() []GetTalkSessionListManageStatus {
+	return []GetTalkSessionListManageStatus{
+		GetTalkSessionListManageStatusActive,
+		GetTalkSessionListManageStatusInactive,
 	}
-	return o.Value, true
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilBool) Or(d bool) bool {
-	if v, ok := o.Get(); ok {
-		return v
+// MarshalText implements encoding.TextMarshaler.
+func (s GetTalkSessionListManageStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case GetTalkSessionListManageStatusActive:
+		return []byte(s), nil
+	case GetTalkSessionListManageStatusInactive:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
-	return d
 }
 
-// NewOptNilFloat64 returns new OptNilFloat64 with value set to v.
-func NewOptNilFloat64(v float64) OptNilFloat64 {
-	return OptNilFloat64{
-		Value: v,
-		Set:   true,
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GetTalkSessionListManageStatus) UnmarshalText(data []byte) error {
+	switch GetTalkSessionListManageStatus(data) {
+	case GetTalkSessionListManageStatusActive:
+		*s = GetTalkSessionListManageStatusActive
+		return nil
+	case GetTalkSessionListManageStatusInactive:
+		*s = GetTalkSessionListManageStatusInactive
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// OptNilFloat64 is optional nullable float64.
-type OptNilFloat64 struct {
-	Value float64
-	Set   bool
-	Null  bool
+type GetTalkSessionListOK struct {
+	TalkSessions []GetTalkSessionListOKTalkSessionsItem `json:"talkSessions"`
+	Pagination   OffsetPagination                       `json:"pagination"`
 }
 
-// IsSet returns true if OptNilFloat64 was set.
-func (o OptNilFloat64) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptNilFloat64) Reset() {
-	var v float64
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// GetTalkSessions returns the value of TalkSessions.
+func (s *GetTalkSessionListOK) GetTalkSessions() []GetTalkSessionListOKTalkSessionsItem {
+	return s.TalkSessions
 }
 
-// SetTo sets value to v.
-func (o *OptNilFloat64) SetTo(v float64) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// GetPagination returns the value of Pagination.
+func (s *GetTalkSessionListOK) GetPagination() OffsetPagination {
+	return s.Pagination
 }
 
-// IsNull returns true if value is Null.
-func (o OptNilFloat64) IsNull() bool { return o.Null }
-
-// SetToNull sets value to null.
-func (o *OptNilFloat64) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v float64
-	o.Value = v
+// SetTalkSessions sets the value of TalkSessions.
+func (s *GetTalkSessionListOK) SetTalkSessions(val []GetTalkSessionListOKTalkSessionsItem) {
+	s.TalkSessions = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilFloat64) Get() (v float64, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetPagination sets the value of Pagination.
+func (s *GetTalkSessionListOK) SetPagination(val OffsetPagination) {
+	s.Pagination = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilFloat64) Or(d float64) float64 {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
-}
+func (*GetTalkSessionListOK) getTalkSessionListRes() {}
 
-// NewOptNilGetOpenedTalkSessionStatus returns new OptNilGetOpenedTalkSessionStatus with value set to v.
-func NewOptNilGetOpenedTalkSessionStatus(v GetOpenedTalkSessionStatus) OptNilGetOpenedTalkSessionStatus {
-	return OptNilGetOpenedTalkSessionStatus{
-		Value: v,
-		Set:   true,
-	}
+type GetTalkSessionListOKTalkSessionsItem struct {
+	TalkSession  TalkSession `json:"talkSession"`
+	OpinionCount int         `json:"opinionCount"`
 }
 
-// OptNilGetOpenedTalkSessionStatus is optional nullable GetOpenedTalkSessionStatus.
-type OptNilGetOpenedTalkSessionStatus struct {
-	Value GetOpenedTalkSessionStatus
-	Set   bool
-	Null  bool
+// GetTalkSession returns the value of TalkSession.
+func (s *GetTalkSessionListOKTalkSessionsItem) GetTalkSession() TalkSession {
+	return s.TalkSession
 }
 
-// IsSet returns true if OptNilGetOpenedTalkSessionStatus was set.
-func (o OptNilGetOpenedTalkSessionStatus) IsSet() bool { return o.Set }
+// GetOpinionCount returns the value of OpinionCount.
+func (s *GetTalkSessionListOKTalkSessionsItem) GetOpinionCount() int {
+	return s.OpinionCount
+}
 
-// Reset unsets value.
-func (o *OptNilGetOpenedTalkSessionStatus) Reset() {
-	var v GetOpenedTalkSessionStatus
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// SetTalkSession sets the value of TalkSession.
+func (s *GetTalkSessionListOKTalkSessionsItem) SetTalkSession(val TalkSession) {
+	s.TalkSession = val
 }
 
-// SetTo sets value to v.
-func (o *OptNilGetOpenedTalkSessionStatus) SetTo(v GetOpenedTalkSessionStatus) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// SetOpinionCount sets the value of OpinionCount.
+func (s *GetTalkSessionListOKTalkSessionsItem) SetOpinionCount(val int) {
+	s.OpinionCount = val
 }
 
-// IsNull returns true if value is Null.
-func (o OptNilGetOpenedTalkSessionStatus) IsNull() bool { return o.Null }
+type GetTalkSessionListSortKey string
 
-// SetToNull sets value to null.
-func (o *OptNilGetOpenedTalkSessionStatus) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v GetOpenedTalkSessionStatus
-	o.Value = v
-}
+const (
+	GetTalkSessionListSortKeyLatest      GetTalkSessionListSortKey = "latest"
+	GetTalkSessionListSortKeyOldest      GetTalkSessionListSortKey = "oldest"
+	GetTalkSessionListSortKeyMostReplies GetTalkSessionListSortKey = "mostReplies"
+	GetTalkSessionListSortKeyNearest     GetTalkSessionListSortKey = "nearest"
+)
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilGetOpenedTalkSessionStatus) Get() (v GetOpenedTalkSessionStatus, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
+// AllValues returns all GetTalkSessionListSortKey values.
+func (GetTalkSessionListSortKey) AllValues() []GetTalkSessionListSortKey {
+	return []GetTalkSessionListSortKey{
+		GetTalkSessionListSortKeyLatest,
+		GetTalkSessionListSortKeyOldest,
+		GetTalkSessionListSortKeyMostReplies,
+		GetTalkSessionListSortKeyNearest,
 	}
-	return o.Value, true
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s GetTalkSessionListSortKey) MarshalText() ([]byte, error) {
+	switch s {
+	case GetTalkSessionListSortKeyLatest:
+		return []byte(s), nil
+	case GetTalkSessionListSortKeyOldest:
+		return []byte(s), nil
+	case GetTalkSessionListSortKeyMostReplies:
+		return []byte(s), nil
+	case GetTalkSessionListSortKeyNearest:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GetTalkSessionListSortKey) UnmarshalText(data []byte) error {
+	switch GetTalkSessionListSortKey(data) {
+	case GetTalkSessionListSortKeyLatest:
+		*s = GetTalkSessionListSortKeyLatest
+		return nil
+	case GetTalkSessionListSortKeyOldest:
+		*s = GetTalkSessionListSortKeyOldest
+		return nil
+	case GetTalkSessionListSortKeyMostReplies:
+		*s = GetTalkSessionListSortKeyMostReplies
+		return nil
+	case GetTalkSessionListSortKeyNearest:
+		*s = GetTalkSessionListSortKeyNearest
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type GetTalkSessionListStatus string
+
+const (
+	GetTalkSessionListStatusOpen     GetTalkSessionListStatus = "open"
+	GetTalkSessionListStatusFinished GetTalkSessionListStatus = "finished"
+)
+
+// AllValues returns all GetTalkSessionListStatus values.
+func (GetTalkSessionListStatus) AllValues() []GetTalkSessionListStatus {
+	return []GetTalkSessionListStatus{
+		GetTalkSessionListStatusOpen,
+		GetTalkSessionListStatusFinished,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s GetTalkSessionListStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case GetTalkSessionListStatusOpen:
+		return []byte(s), nil
+	case GetTalkSessionListStatusFinished:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GetTalkSessionListStatus) UnmarshalText(data []byte) error {
+	switch GetTalkSessionListStatus(data) {
+	case GetTalkSessionListStatusOpen:
+		*s = GetTalkSessionListStatusOpen
+		return nil
+	case GetTalkSessionListStatusFinished:
+		*s = GetTalkSessionListStatusFinished
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type GetTalkSessionReportCountBadRequest struct{}
+
+func (*GetTalkSessionReportCountBadRequest) getTalkSessionReportCountRes() {}
+
+type GetTalkSessionReportCountInternalServerError struct{}
+
+func (*GetTalkSessionReportCountInternalServerError) getTalkSessionReportCountRes() {}
+
+type GetTalkSessionReportCountOK struct {
+	Count int `json:"count"`
+}
+
+// GetCount returns the value of Count.
+func (s *GetTalkSessionReportCountOK) GetCount() int {
+	return s.Count
+}
+
+// SetCount sets the value of Count.
+func (s *GetTalkSessionReportCountOK) SetCount(val int) {
+	s.Count = val
+}
+
+func (*GetTalkSessionReportCountOK) getTalkSessionReportCountRes() {}
+
+type GetTalkSessionReportCountStatus string
+
+const (
+	GetTalkSessionReportCountStatusUnsolved GetTalkSessionReportCountStatus = "unsolved"
+	GetTalkSessionReportCountStatusDeleted  GetTalkSessionReportCountStatus = "deleted"
+	GetTalkSessionReportCountStatusHold     GetTalkSessionReportCountStatus = "hold"
+)
+
+// AllValues returns all GetTalkSessionReportCountStatus values.
+func (GetTalkSessionReportCountStatus) AllValues() []GetTalkSessionReportCountStatus {
+	return []GetTalkSessionReportCountStatus{
+		GetTalkSessionReportCountStatusUnsolved,
+		GetTalkSessionReportCountStatusDeleted,
+		GetTalkSessionReportCountStatusHold,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s GetTalkSessionReportCountStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case GetTalkSessionReportCountStatusUnsolved:
+		return []byte(s), nil
+	case GetTalkSessionReportCountStatusDeleted:
+		return []byte(s), nil
+	case GetTalkSessionReportCountStatusHold:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GetTalkSessionReportCountStatus) UnmarshalText(data []byte) error {
+	switch GetTalkSessionReportCountStatus(data) {
+	case GetTalkSessionReportCountStatusUnsolved:
+		*s = GetTalkSessionReportCountStatusUnsolved
+		return nil
+	case GetTalkSessionReportCountStatusDeleted:
+		*s = GetTalkSessionReportCountStatusDeleted
+		return nil
+	case GetTalkSessionReportCountStatusHold:
+		*s = GetTalkSessionReportCountStatusHold
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type GetTalkSessionReportInternalServerError struct{}
+
+func (*GetTalkSessionReportInternalServerError) getTalkSessionReportRes() {}
+
+type GetTalkSessionReportOK struct {
+	Report OptNilString `json:"report"`
+	// フィードバックを送る際に指定するレポートのID.
+	ReportID OptString `json:"reportID"`
+	// 構造化されたレポート。分析APIで生成した場合は省略.
+	Sections OptReportSections `json:"sections"`
+	// グループごとの重要だと印を付けられた意見。グループID順.
+	ImportantOpinions []GroupImportantOpinions `json:"importantOpinions"`
+}
+
+// GetReport returns the value of Report.
+func (s *GetTalkSessionReportOK) GetReport() OptNilString {
+	return s.Report
+}
+
+// GetReportID returns the value of ReportID.
+func (s *GetTalkSessionReportOK) GetReportID() OptString {
+	return s.ReportID
+}
+
+// GetSections returns the value of Sections.
+func (s *GetTalkSessionReportOK) GetSections() OptReportSections {
+	return s.Sections
+}
+
+// GetImportantOpinions returns the value of ImportantOpinions.
+func (s *GetTalkSessionReportOK) GetImportantOpinions() []GroupImportantOpinions {
+	return s.ImportantOpinions
+}
+
+// SetReport sets the value of Report.
+func (s *GetTalkSessionReportOK) SetReport(val OptNilString) {
+	s.Report = val
+}
+
+// SetReportID sets the value of ReportID.
+func (s *GetTalkSessionReportOK) SetReportID(val OptString) {
+	s.ReportID = val
+}
+
+// SetSections sets the value of Sections.
+func (s *GetTalkSessionReportOK) SetSections(val OptReportSections) {
+	s.Sections = val
+}
+
+// SetImportantOpinions sets the value of ImportantOpinions.
+func (s *GetTalkSessionReportOK) SetImportantOpinions(val []GroupImportantOpinions) {
+	s.ImportantOpinions = val
+}
+
+func (*GetTalkSessionReportOK) getTalkSessionReportRes() {}
+
+type GetTalkSessionRepresentativenessBadRequest struct{}
+
+func (*GetTalkSessionRepresentativenessBadRequest) getTalkSessionRepresentativenessRes() {}
+
+type GetTalkSessionRepresentativenessForbidden struct{}
+
+func (*GetTalkSessionRepresentativenessForbidden) getTalkSessionRepresentativenessRes() {}
+
+type GetTalkSessionRepresentativenessInternalServerError struct{}
+
+func (*GetTalkSessionRepresentativenessInternalServerError) getTalkSessionRepresentativenessRes() {}
+
+type GetTalkSessionRepresentativenessNotFound struct{}
+
+func (*GetTalkSessionRepresentativenessNotFound) getTalkSessionRepresentativenessRes() {}
+
+type GetTalkSessionRepresentativenessOK struct {
+	Dimensions []Representativeness `json:"dimensions"`
+}
+
+// GetDimensions returns the value of Dimensions.
+func (s *GetTalkSessionRepresentativenessOK) GetDimensions() []Representativeness {
+	return s.Dimensions
+}
+
+// SetDimensions sets the value of Dimensions.
+func (s *GetTalkSessionRepresentativenessOK) SetDimensions(val []Representativeness) {
+	s.Dimensions = val
+}
+
+func (*GetTalkSessionRepresentativenessOK) getTalkSessionRepresentativenessRes() {}
+
+type GetTalkSessionRestrictionKeysBadRequest struct{}
+
+func (*GetTalkSessionRestrictionKeysBadRequest) getTalkSessionRestrictionKeysRes() {}
+
+type GetTalkSessionRestrictionKeysInternalServerError struct{}
+
+func (*GetTalkSessionRestrictionKeysInternalServerError) getTalkSessionRestrictionKeysRes() {}
+
+type GetTalkSessionRestrictionKeysOKApplicationJSON []Restriction
+
+func (*GetTalkSessionRestrictionKeysOKApplicationJSON) getTalkSessionRestrictionKeysRes() {}
+
+type GetTalkSessionRestrictionSatisfiedBadRequest struct{}
+
+func (*GetTalkSessionRestrictionSatisfiedBadRequest) getTalkSessionRestrictionSatisfiedRes() {}
+
+type GetTalkSessionRestrictionSatisfiedInternalServerError struct{}
+
+func (*GetTalkSessionRestrictionSatisfiedInternalServerError) getTalkSessionRestrictionSatisfiedRes() {
+}
+
+type GetTalkSessionRestrictionSatisfiedOKApplicationJSON []Restriction
+
+func (*GetTalkSessionRestrictionSatisfiedOKApplicationJSON) getTalkSessionRestrictionSatisfiedRes() {}
+
+type GetTalkSessionTemplatesBadRequest struct{}
+
+func (*GetTalkSessionTemplatesBadRequest) getTalkSessionTemplatesRes() {}
+
+type GetTalkSessionTemplatesForbidden struct{}
+
+func (*GetTalkSessionTemplatesForbidden) getTalkSessionTemplatesRes() {}
+
+type GetTalkSessionTemplatesInternalServerError struct{}
+
+func (*GetTalkSessionTemplatesInternalServerError) getTalkSessionTemplatesRes() {}
+
+type GetTalkSessionTemplatesOK struct {
+	Templates []TalkSessionTemplate `json:"templates"`
+}
+
+// GetTemplates returns the value of Templates.
+func (s *GetTalkSessionTemplatesOK) GetTemplates() []TalkSessionTemplate {
+	return s.Templates
+}
+
+// SetTemplates sets the value of Templates.
+func (s *GetTalkSessionTemplatesOK) SetTemplates(val []TalkSessionTemplate) {
+	s.Templates = val
+}
+
+func (*GetTalkSessionTemplatesOK) getTalkSessionTemplatesRes() {}
+
+type GetTermFrequenciesBadRequest struct{}
+
+func (*GetTermFrequenciesBadRequest) getTermFrequenciesRes() {}
+
+type GetTermFrequenciesInternalServerError struct{}
+
+func (*GetTermFrequenciesInternalServerError) getTermFrequenciesRes() {}
+
+type GetTermFrequenciesNotFound struct{}
+
+func (*GetTermFrequenciesNotFound) getTermFrequenciesRes() {}
+
+type GetTimeLineBadRequest struct{}
+
+func (*GetTimeLineBadRequest) getTimeLineRes() {}
+
+type GetTimeLineInternalServerError struct{}
+
+func (*GetTimeLineInternalServerError) getTimeLineRes() {}
+
+type GetTimeLineOK struct {
+	Items []ActionItem `json:"items"`
+}
+
+// GetItems returns the value of Items.
+func (s *GetTimeLineOK) GetItems() []ActionItem {
+	return s.Items
+}
+
+// SetItems sets the value of Items.
+func (s *GetTimeLineOK) SetItems(val []ActionItem) {
+	s.Items = val
+}
+
+func (*GetTimeLineOK) getTimeLineRes() {}
+
+type GetTokenInfoBadRequest struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// GetCode returns the value of Code.
+func (s *GetTokenInfoBadRequest) GetCode() string {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *GetTokenInfoBadRequest) GetMessage() string {
+	return s.Message
+}
+
+// SetCode sets the value of Code.
+func (s *GetTokenInfoBadRequest) SetCode(val string) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *GetTokenInfoBadRequest) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*GetTokenInfoBadRequest) getTokenInfoRes() {}
+
+type GetTokenInfoInternalServerError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// GetCode returns the value of Code.
+func (s *GetTokenInfoInternalServerError) GetCode() string {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *GetTokenInfoInternalServerError) GetMessage() string {
+	return s.Message
+}
+
+// SetCode sets the value of Code.
+func (s *GetTokenInfoInternalServerError) SetCode(val string) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *GetTokenInfoInternalServerError) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*GetTokenInfoInternalServerError) getTokenInfoRes() {}
+
+type GetTopicsBadRequest struct{}
+
+func (*GetTopicsBadRequest) getTopicsRes() {}
+
+type GetTopicsInternalServerError struct{}
+
+func (*GetTopicsInternalServerError) getTopicsRes() {}
+
+type GetTopicsOK struct {
+	// 意見が多い順のトピック.
+	Topics []Topic `json:"topics"`
+	// 内容を表す単語がなく、どのトピックにも入らなかった意見の数.
+	UnassignedOpinionCount int `json:"unassignedOpinionCount"`
+}
+
+// GetTopics returns the value of Topics.
+func (s *GetTopicsOK) GetTopics() []Topic {
+	return s.Topics
+}
+
+// GetUnassignedOpinionCount returns the value of UnassignedOpinionCount.
+func (s *GetTopicsOK) GetUnassignedOpinionCount() int {
+	return s.UnassignedOpinionCount
+}
+
+// SetTopics sets the value of Topics.
+func (s *GetTopicsOK) SetTopics(val []Topic) {
+	s.Topics = val
+}
+
+// SetUnassignedOpinionCount sets the value of UnassignedOpinionCount.
+func (s *GetTopicsOK) SetUnassignedOpinionCount(val int) {
+	s.UnassignedOpinionCount = val
+}
+
+func (*GetTopicsOK) getTopicsRes() {}
+
+type GetUserByDisplayIDInternalServerError struct{}
+
+func (*GetUserByDisplayIDInternalServerError) getUserByDisplayIDRes() {}
+
+type GetUserByDisplayIDNotFound struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// GetCode returns the value of Code.
+func (s *GetUserByDisplayIDNotFound) GetCode() string {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *GetUserByDisplayIDNotFound) GetMessage() string {
+	return s.Message
+}
+
+// SetCode sets the value of Code.
+func (s *GetUserByDisplayIDNotFound) SetCode(val string) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *GetUserByDisplayIDNotFound) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*GetUserByDisplayIDNotFound) getUserByDisplayIDRes() {}
+
+type GetUserInfoInternalServerError struct{}
+
+func (*GetUserInfoInternalServerError) getUserInfoRes() {}
+
+type GetUserInfoOK struct {
+	User         User             `json:"user"`
+	Demographics UserDemographics `json:"demographics"`
+	Email        OptNilString     `json:"email"`
+}
+
+// GetUser returns the value of User.
+func (s *GetUserInfoOK) GetUser() User {
+	return s.User
+}
+
+// GetDemographics returns the value of Demographics.
+func (s *GetUserInfoOK) GetDemographics() UserDemographics {
+	return s.Demographics
+}
+
+// GetEmail returns the value of Email.
+func (s *GetUserInfoOK) GetEmail() OptNilString {
+	return s.Email
+}
+
+// SetUser sets the value of User.
+func (s *GetUserInfoOK) SetUser(val User) {
+	s.User = val
+}
+
+// SetDemographics sets the value of Demographics.
+func (s *GetUserInfoOK) SetDemographics(val UserDemographics) {
+	s.Demographics = val
+}
+
+// SetEmail sets the value of Email.
+func (s *GetUserInfoOK) SetEmail(val OptNilString) {
+	s.Email = val
+}
+
+func (*GetUserInfoOK) getUserInfoRes() {}
+
+type GetUserListManageOrder string
+
+const (
+	GetUserListManageOrderAsc  GetUserListManageOrder = "asc"
+	GetUserListManageOrderDesc GetUserListManageOrder = "desc"
+)
+
+// AllValues returns all GetUserListManageOrder values.
+func (GetUserListManageOrder) AllValues() []GetUserListManageOrder {
+	return []GetUserListManageOrder{
+		GetUserListManageOrderAsc,
+		GetUserListManageOrderDesc,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s GetUserListManageOrder) MarshalText() ([]byte, error) {
+	switch s {
+	case GetUserListManageOrderAsc:
+		return []byte(s), nil
+	case GetUserListManageOrderDesc:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GetUserListManageOrder) UnmarshalText(data []byte) error {
+	switch GetUserListManageOrder(data) {
+	case GetUserListManageOrderAsc:
+		*s = GetUserListManageOrderAsc
+		return nil
+	case GetUserListManageOrderDesc:
+		*s = GetUserListManageOrderDesc
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type GetUserListManageOrderBy string
+
+const (
+	GetUserListManageOrderByCreatedAt   GetUserListManageOrderBy = "createdAt"
+	GetUserListManageOrderByUpdatedAt   GetUserListManageOrderBy = "updatedAt"
+	GetUserListManageOrderByDisplayName GetUserListManageOrderBy = "displayName"
+	GetUserListManageOrderByLastLoginAt GetUserListManageOrderBy = "lastLoginAt"
+)
+
+// AllValues returns all GetUserListManageOrderBy values.
+func (GetUserListManageOrderBy) AllValues() []GetUserListManageOrderBy {
+	return []GetUserListManageOrderBy{
+		GetUserListManageOrderByCreatedAt,
+		GetUserListManageOrderByUpdatedAt,
+		GetUserListManageOrderByDisplayName,
+		GetUserListManageOrderByLastLoginAt,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s GetUserListManageOrderBy) MarshalText() ([]byte, error) {
+	switch s {
+	case GetUserListManageOrderByCreatedAt:
+		return []byte(s), nil
+	case GetUserListManageOrderByUpdatedAt:
+		return []byte(s), nil
+	case GetUserListManageOrderByDisplayName:
+		return []byte(s), nil
+	case GetUserListManageOrderByLastLoginAt:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GetUserListManageOrderBy) UnmarshalText(data []byte) error {
+	switch GetUserListManageOrderBy(data) {
+	case GetUserListManageOrderByCreatedAt:
+		*s = GetUserListManageOrderByCreatedAt
+		return nil
+	case GetUserListManageOrderByUpdatedAt:
+		*s = GetUserListManageOrderByUpdatedAt
+		return nil
+	case GetUserListManageOrderByDisplayName:
+		*s = GetUserListManageOrderByDisplayName
+		return nil
+	case GetUserListManageOrderByLastLoginAt:
+		*s = GetUserListManageOrderByLastLoginAt
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type GetUserTalkSessionsBadRequest struct{}
+
+func (*GetUserTalkSessionsBadRequest) getUserTalkSessionsRes() {}
+
+type GetUserTalkSessionsOK struct {
+	TalkSessions []GetUserTalkSessionsOKTalkSessionsItem `json:"talkSessions"`
+	Pagination   OffsetPagination                        `json:"pagination"`
+}
+
+// GetTalkSessions returns the value of TalkSessions.
+func (s *GetUserTalkSessionsOK) GetTalkSessions() []GetUserTalkSessionsOKTalkSessionsItem {
+	return s.TalkSessions
+}
+
+// GetPagination returns the value of Pagination.
+func (s *GetUserTalkSessionsOK) GetPagination() OffsetPagination {
+	return s.Pagination
+}
+
+// SetTalkSessions sets the value of TalkSessions.
+func (s *GetUserTalkSessionsOK) SetTalkSessions(val []GetUserTalkSessionsOKTalkSessionsItem) {
+	s.TalkSessions = val
+}
+
+// SetPagination sets the value of Pagination.
+func (s *GetUserTalkSessionsOK) SetPagination(val OffsetPagination) {
+	s.Pagination = val
+}
+
+func (*GetUserTalkSessionsOK) getUserTalkSessionsRes() {}
+
+type GetUserTalkSessionsOKTalkSessionsItem struct {
+	TalkSession  TalkSession `json:"talkSession"`
+	OpinionCount int         `json:"opinionCount"`
+}
+
+// GetTalkSession returns the value of TalkSession.
+func (s *GetUserTalkSessionsOKTalkSessionsItem) GetTalkSession() TalkSession {
+	return s.TalkSession
+}
+
+// GetOpinionCount returns the value of OpinionCount.
+func (s *GetUserTalkSessionsOKTalkSessionsItem) GetOpinionCount() int {
+	return s.OpinionCount
+}
+
+// SetTalkSession sets the value of TalkSession.
+func (s *GetUserTalkSessionsOKTalkSessionsItem) SetTalkSession(val TalkSession) {
+	s.TalkSession = val
+}
+
+// SetOpinionCount sets the value of OpinionCount.
+func (s *GetUserTalkSessionsOKTalkSessionsItem) SetOpinionCount(val int) {
+	s.OpinionCount = val
+}
+
+type GetUserTalkSessionsStatus string
+
+const (
+	GetUserTalkSessionsStatusFinished GetUserTalkSessionsStatus = "finished"
+	GetUserTalkSessionsStatusOpen     GetUserTalkSessionsStatus = "open"
+)
+
+// AllValues returns all GetUserTalkSessionsStatus values.
+func (GetUserTalkSessionsStatus) AllValues() []GetUserTalkSessionsStatus {
+	return []GetUserTalkSessionsStatus{
+		GetUserTalkSessionsStatusFinished,
+		GetUserTalkSessionsStatusOpen,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s GetUserTalkSessionsStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case GetUserTalkSessionsStatusFinished:
+		return []byte(s), nil
+	case GetUserTalkSessionsStatusOpen:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GetUserTalkSessionsStatus) UnmarshalText(data []byte) error {
+	switch GetUserTalkSessionsStatus(data) {
+	case GetUserTalkSessionsStatusFinished:
+		*s = GetUserTalkSessionsStatusFinished
+		return nil
+	case GetUserTalkSessionsStatusOpen:
+		*s = GetUserTalkSessionsStatusOpen
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type GetVapidKeyOK struct {
+	VapidKey string `json:"vapid_key"`
+}
+
+// GetVapidKey returns the value of VapidKey.
+func (s *GetVapidKeyOK) GetVapidKey() string {
+	return s.VapidKey
+}
+
+// SetVapidKey sets the value of VapidKey.
+func (s *GetVapidKeyOK) SetVapidKey(val string) {
+	s.VapidKey = val
+}
+
+type GetVoteShiftsBadRequest struct{}
+
+func (*GetVoteShiftsBadRequest) getVoteShiftsRes() {}
+
+type GetVoteShiftsInternalServerError struct{}
+
+func (*GetVoteShiftsInternalServerError) getVoteShiftsRes() {}
+
+type GetVoteShiftsOK struct {
+	// 投票したユーザー数.
+	VoterCount int `json:"voterCount"`
+	// 投票が変更された回数.
+	ChangeCount int `json:"changeCount"`
+	// 投票を変更したユーザー数.
+	ChangedUserCount int `json:"changedUserCount"`
+	// セッション全体の投票の変化。意見ごとの変化を合計したもの.
+	Shifts []VoteShift `json:"shifts"`
+	// 投票が変更された意見ごとの変化。変更したユーザーが多い順.
+	Opinions []OpinionVoteShift `json:"opinions"`
+}
+
+// GetVoterCount returns the value of VoterCount.
+func (s *GetVoteShiftsOK) GetVoterCount() int {
+	return s.VoterCount
+}
+
+// GetChangeCount returns the value of ChangeCount.
+func (s *GetVoteShiftsOK) GetChangeCount() int {
+	return s.ChangeCount
+}
+
+// GetChangedUserCount returns the value of ChangedUserCount.
+func (s *GetVoteShiftsOK) GetChangedUserCount() int {
+	return s.ChangedUserCount
+}
+
+// GetShifts returns the value of Shifts.
+func (s *GetVoteShiftsOK) GetShifts() []VoteShift {
+	return s.Shifts
+}
+
+// GetOpinions returns the value of Opinions.
+func (s *GetVoteShiftsOK) GetOpinions() []OpinionVoteShift {
+	return s.Opinions
+}
+
+// SetVoterCount sets the value of VoterCount.
+func (s *GetVoteShiftsOK) SetVoterCount(val int) {
+	s.VoterCount = val
+}
+
+// SetChangeCount sets the value of ChangeCount.
+func (s *GetVoteShiftsOK) SetChangeCount(val int) {
+	s.ChangeCount = val
+}
+
+// SetChangedUserCount sets the value of ChangedUserCount.
+func (s *GetVoteShiftsOK) SetChangedUserCount(val int) {
+	s.ChangedUserCount = val
+}
+
+// SetShifts sets the value of Shifts.
+func (s *GetVoteShiftsOK) SetShifts(val []VoteShift) {
+	s.Shifts = val
+}
+
+// SetOpinions sets the value of Opinions.
+func (s *GetVoteShiftsOK) SetOpinions(val []OpinionVoteShift) {
+	s.Opinions = val
+}
+
+func (*GetVoteShiftsOK) getVoteShiftsRes() {}
+
+// グループごとの意見への投票数と賛成確率.
+// Ref: #/components/schemas/GroupAgreement
+type GroupAgreement struct {
+	GroupName     string `json:"groupName"`
+	GroupID       int    `json:"groupID"`
+	AgreeCount    int    `json:"agreeCount"`
+	DisagreeCount int    `json:"disagreeCount"`
+	PassCount     int    `json:"passCount"`
+	// 平滑化したグループの賛成確率.
+	AgreeProbability float64 `json:"agreeProbability"`
+}
+
+// GetGroupName returns the value of GroupName.
+func (s *GroupAgreement) GetGroupName() string {
+	return s.GroupName
+}
+
+// GetGroupID returns the value of GroupID.
+func (s *GroupAgreement) GetGroupID() int {
+	return s.GroupID
+}
+
+// GetAgreeCount returns the value of AgreeCount.
+func (s *GroupAgreement) GetAgreeCount() int {
+	return s.AgreeCount
+}
+
+// GetDisagreeCount returns the value of DisagreeCount.
+func (s *GroupAgreement) GetDisagreeCount() int {
+	return s.DisagreeCount
+}
+
+// GetPassCount returns the value of PassCount.
+func (s *GroupAgreement) GetPassCount() int {
+	return s.PassCount
+}
+
+// GetAgreeProbability returns the value of AgreeProbability.
+func (s *GroupAgreement) GetAgreeProbability() float64 {
+	return s.AgreeProbability
+}
+
+// SetGroupName sets the value of GroupName.
+func (s *GroupAgreement) SetGroupName(val string) {
+	s.GroupName = val
+}
+
+// SetGroupID sets the value of GroupID.
+func (s *GroupAgreement) SetGroupID(val int) {
+	s.GroupID = val
+}
+
+// SetAgreeCount sets the value of AgreeCount.
+func (s *GroupAgreement) SetAgreeCount(val int) {
+	s.AgreeCount = val
+}
+
+// SetDisagreeCount sets the value of DisagreeCount.
+func (s *GroupAgreement) SetDisagreeCount(val int) {
+	s.DisagreeCount = val
+}
+
+// SetPassCount sets the value of PassCount.
+func (s *GroupAgreement) SetPassCount(val int) {
+	s.PassCount = val
+}
+
+// SetAgreeProbability sets the value of AgreeProbability.
+func (s *GroupAgreement) SetAgreeProbability(val float64) {
+	s.AgreeProbability = val
+}
+
+// 自分の位置からグループの中心までの距離.
+// Ref: #/components/schemas/GroupDistance
+type GroupDistance struct {
+	GroupID     int    `json:"groupID"`
+	GroupName   string `json:"groupName"`
+	MemberCount int    `json:"memberCount"`
+	// グループに属するユーザーの位置の平均.
+	CentroidX float64 `json:"centroidX"`
+	CentroidY float64 `json:"centroidY"`
+	Distance  float64 `json:"distance"`
+}
+
+// GetGroupID returns the value of GroupID.
+func (s *GroupDistance) GetGroupID() int {
+	return s.GroupID
+}
+
+// GetGroupName returns the value of GroupName.
+func (s *GroupDistance) GetGroupName() string {
+	return s.GroupName
+}
+
+// GetMemberCount returns the value of MemberCount.
+func (s *GroupDistance) GetMemberCount() int {
+	return s.MemberCount
+}
+
+// GetCentroidX returns the value of CentroidX.
+func (s *GroupDistance) GetCentroidX() float64 {
+	return s.CentroidX
+}
+
+// GetCentroidY returns the value of CentroidY.
+func (s *GroupDistance) GetCentroidY() float64 {
+	return s.CentroidY
+}
+
+// GetDistance returns the value of Distance.
+func (s *GroupDistance) GetDistance() float64 {
+	return s.Distance
+}
+
+// SetGroupID sets the value of GroupID.
+func (s *GroupDistance) SetGroupID(val int) {
+	s.GroupID = val
+}
+
+// SetGroupName sets the value of GroupName.
+func (s *GroupDistance) SetGroupName(val string) {
+	s.GroupName = val
+}
+
+// SetMemberCount sets the value of MemberCount.
+func (s *GroupDistance) SetMemberCount(val int) {
+	s.MemberCount = val
+}
+
+// SetCentroidX sets the value of CentroidX.
+func (s *GroupDistance) SetCentroidX(val float64) {
+	s.CentroidX = val
+}
+
+// SetCentroidY sets the value of CentroidY.
+func (s *GroupDistance) SetCentroidY(val float64) {
+	s.CentroidY = val
+}
+
+// SetDistance sets the value of Distance.
+func (s *GroupDistance) SetDistance(val float64) {
+	s.Distance = val
+}
+
+// グループのメンバーが重要だと印を付けた意見.
+// Ref: #/components/schemas/GroupImportantOpinions
+type GroupImportantOpinions struct {
+	GroupName string `json:"groupName"`
+	GroupID   int    `json:"groupID"`
+	// 重要だと印を付けた数が多い順.
+	Opinions []ImportantOpinion `json:"opinions"`
+}
+
+// GetGroupName returns the value of GroupName.
+func (s *GroupImportantOpinions) GetGroupName() string {
+	return s.GroupName
+}
+
+// GetGroupID returns the value of GroupID.
+func (s *GroupImportantOpinions) GetGroupID() int {
+	return s.GroupID
+}
+
+// GetOpinions returns the value of Opinions.
+func (s *GroupImportantOpinions) GetOpinions() []ImportantOpinion {
+	return s.Opinions
+}
+
+// SetGroupName sets the value of GroupName.
+func (s *GroupImportantOpinions) SetGroupName(val string) {
+	s.GroupName = val
+}
+
+// SetGroupID sets the value of GroupID.
+func (s *GroupImportantOpinions) SetGroupID(val int) {
+	s.GroupID = val
+}
+
+// SetOpinions sets the value of Opinions.
+func (s *GroupImportantOpinions) SetOpinions(val []ImportantOpinion) {
+	s.Opinions = val
+}
+
+// スナップショット間でのユーザーのグループの移動.
+// Ref: #/components/schemas/GroupMove
+type GroupMove struct {
+	User User `json:"user"`
+	// 移動前のグループ。比較元で分類されていない場合はない.
+	FromGroupID   OptInt    `json:"fromGroupID"`
+	FromGroupName OptString `json:"fromGroupName"`
+	// 移動後のグループ。比較先で分類されていない場合はない.
+	ToGroupID   OptInt    `json:"toGroupID"`
+	ToGroupName OptString `json:"toGroupName"`
+}
+
+// GetUser returns the value of User.
+func (s *GroupMove) GetUser() User {
+	return s.User
+}
+
+// GetFromGroupID returns the value of FromGroupID.
+func (s *GroupMove) GetFromGroupID() OptInt {
+	return s.FromGroupID
+}
+
+// GetFromGroupName returns the value of FromGroupName.
+func (s *GroupMove) GetFromGroupName() OptString {
+	return s.FromGroupName
+}
+
+// GetToGroupID returns the value of ToGroupID.
+func (s *GroupMove) GetToGroupID() OptInt {
+	return s.ToGroupID
+}
+
+// GetToGroupName returns the value of ToGroupName.
+func (s *GroupMove) GetToGroupName() OptString {
+	return s.ToGroupName
+}
+
+// SetUser sets the value of User.
+func (s *GroupMove) SetUser(val User) {
+	s.User = val
+}
+
+// SetFromGroupID sets the value of FromGroupID.
+func (s *GroupMove) SetFromGroupID(val OptInt) {
+	s.FromGroupID = val
+}
+
+// SetFromGroupName sets the value of FromGroupName.
+func (s *GroupMove) SetFromGroupName(val OptString) {
+	s.FromGroupName = val
+}
+
+// SetToGroupID sets the value of ToGroupID.
+func (s *GroupMove) SetToGroupID(val OptInt) {
+	s.ToGroupID = val
+}
+
+// SetToGroupName sets the value of ToGroupName.
+func (s *GroupMove) SetToGroupName(val OptString) {
+	s.ToGroupName = val
+}
+
+// Ref: #/components/schemas/GroupStance
+type GroupStance struct {
+	GroupName string `json:"groupName"`
+	GroupID   int    `json:"groupID"`
+	Stance    string `json:"stance"`
+}
+
+// GetGroupName returns the value of GroupName.
+func (s *GroupStance) GetGroupName() string {
+	return s.GroupName
+}
+
+// GetGroupID returns the value of GroupID.
+func (s *GroupStance) GetGroupID() int {
+	return s.GroupID
+}
+
+// GetStance returns the value of Stance.
+func (s *GroupStance) GetStance() string {
+	return s.Stance
+}
+
+// SetGroupName sets the value of GroupName.
+func (s *GroupStance) SetGroupName(val string) {
+	s.GroupName = val
+}
+
+// SetGroupID sets the value of GroupID.
+func (s *GroupStance) SetGroupID(val int) {
+	s.GroupID = val
+}
+
+// SetStance sets the value of Stance.
+func (s *GroupStance) SetStance(val string) {
+	s.Stance = val
+}
+
+// グループの意見で特徴的な単語.
+// Ref: #/components/schemas/GroupTerms
+type GroupTerms struct {
+	GroupID   int    `json:"groupID"`
+	GroupName string `json:"groupName"`
+	// グループのメンバーが投稿した意見の数.
+	OpinionCount int `json:"opinionCount"`
+	// TF-IDFが高い順の単語.
+	Terms []TermScore `json:"terms"`
+}
+
+// GetGroupID returns the value of GroupID.
+func (s *GroupTerms) GetGroupID() int {
+	return s.GroupID
+}
+
+// GetGroupName returns the value of GroupName.
+func (s *GroupTerms) GetGroupName() string {
+	return s.GroupName
+}
+
+// GetOpinionCount returns the value of OpinionCount.
+func (s *GroupTerms) GetOpinionCount() int {
+	return s.OpinionCount
+}
+
+// GetTerms returns the value of Terms.
+func (s *GroupTerms) GetTerms() []TermScore {
+	return s.Terms
+}
+
+// SetGroupID sets the value of GroupID.
+func (s *GroupTerms) SetGroupID(val int) {
+	s.GroupID = val
+}
+
+// SetGroupName sets the value of GroupName.
+func (s *GroupTerms) SetGroupName(val string) {
+	s.GroupName = val
+}
+
+// SetOpinionCount sets the value of OpinionCount.
+func (s *GroupTerms) SetOpinionCount(val int) {
+	s.OpinionCount = val
+}
+
+// SetTerms sets the value of Terms.
+func (s *GroupTerms) SetTerms(val []TermScore) {
+	s.Terms = val
+}
+
+type HandleAuthCallbackBadRequest struct{}
+
+func (*HandleAuthCallbackBadRequest) handleAuthCallbackRes() {}
+
+type HandleAuthCallbackFound struct{}
+
+// HandleAuthCallbackFoundHeaders wraps HandleAuthCallbackFound with response headers.
+type HandleAuthCallbackFoundHeaders struct {
+	Location  string
+	SetCookie []string
+	Response  HandleAuthCallbackFound
+}
+
+// GetLocation returns the value of Location.
+func (s *HandleAuthCallbackFoundHeaders) GetLocation() string {
+	return s.Location
+}
+
+// GetSetCookie returns the value of SetCookie.
+func (s *HandleAuthCallbackFoundHeaders) GetSetCookie() []string {
+	return s.SetCookie
+}
+
+// GetResponse returns the value of Response.
+func (s *HandleAuthCallbackFoundHeaders) GetResponse() HandleAuthCallbackFound {
+	return s.Response
+}
+
+// SetLocation sets the value of Location.
+func (s *HandleAuthCallbackFoundHeaders) SetLocation(val string) {
+	s.Location = val
+}
+
+// SetSetCookie sets the value of SetCookie.
+func (s *HandleAuthCallbackFoundHeaders) SetSetCookie(val []string) {
+	s.SetCookie = val
+}
+
+// SetResponse sets the value of Response.
+func (s *HandleAuthCallbackFoundHeaders) SetResponse(val HandleAuthCallbackFound) {
+	s.Response = val
+}
+
+func (*HandleAuthCallbackFoundHeaders) handleAuthCallbackRes() {}
+
+type HandleAuthCallbackInternalServerError struct{}
+
+func (*HandleAuthCallbackInternalServerError) handleAuthCallbackRes() {}
+
+type HasConsentBadRequest struct{}
+
+func (*HasConsentBadRequest) hasConsentRes() {}
+
+type HasConsentInternalServerError struct{}
+
+func (*HasConsentInternalServerError) hasConsentRes() {}
+
+type HasConsentOK struct {
+	HasConsent bool `json:"hasConsent"`
+}
+
+// GetHasConsent returns the value of HasConsent.
+func (s *HasConsentOK) GetHasConsent() bool {
+	return s.HasConsent
+}
+
+// SetHasConsent sets the value of HasConsent.
+func (s *HasConsentOK) SetHasConsent(val bool) {
+	s.HasConsent = val
+}
+
+func (*HasConsentOK) hasConsentRes() {}
+
+type HealthBadRequest struct{}
+
+func (*HealthBadRequest) healthRes() {}
+
+type HealthInternalServerError struct{}
+
+func (*HealthInternalServerError) healthRes() {}
+
+type HealthOK struct{}
+
+func (*HealthOK) healthRes() {}
+
+// 重要だと印を付けられた意見.
+// Ref: #/components/schemas/ImportantOpinion
+type ImportantOpinion struct {
+	Opinion Opinion `json:"opinion"`
+	User    User    `json:"user"`
+	// グループのメンバーが重要だと印を付けた数.
+	ImportantCount int `json:"importantCount"`
+}
+
+// GetOpinion returns the value of Opinion.
+func (s *ImportantOpinion) GetOpinion() Opinion {
+	return s.Opinion
+}
+
+// GetUser returns the value of User.
+func (s *ImportantOpinion) GetUser() User {
+	return s.User
+}
+
+// GetImportantCount returns the value of ImportantCount.
+func (s *ImportantOpinion) GetImportantCount() int {
+	return s.ImportantCount
+}
+
+// SetOpinion sets the value of Opinion.
+func (s *ImportantOpinion) SetOpinion(val Opinion) {
+	s.Opinion = val
+}
+
+// SetUser sets the value of User.
+func (s *ImportantOpinion) SetUser(val User) {
+	s.User = val
+}
+
+// SetImportantCount sets the value of ImportantCount.
+func (s *ImportantOpinion) SetImportantCount(val int) {
+	s.ImportantCount = val
+}
+
+type InitiateTalkSessionBadRequest struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// GetCode returns the value of Code.
+func (s *InitiateTalkSessionBadRequest) GetCode() string {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *InitiateTalkSessionBadRequest) GetMessage() string {
+	return s.Message
+}
+
+// SetCode sets the value of Code.
+func (s *InitiateTalkSessionBadRequest) SetCode(val string) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *InitiateTalkSessionBadRequest) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*InitiateTalkSessionBadRequest) initiateTalkSessionRes() {}
+
+type InitiateTalkSessionReq struct {
+	Theme            string     `json:"theme"`
+	ScheduledEndTime time.Time  `json:"scheduledEndTime"`
+	Latitude         OptFloat64 `json:"latitude"`
+	Longitude        OptFloat64 `json:"longitude"`
+	City             OptString  `json:"city"`
+	Prefecture       OptString  `json:"prefecture"`
+	Description      OptString  `json:"description"`
+	ThumbnailURL     OptString  `json:"thumbnailURL"`
+	Restrictions     []string   `json:"restrictions"`
+	AliasId          OptString  `json:"aliasId"`
+	HideTop          OptNilBool `json:"hideTop"`
+}
+
+// GetTheme returns the value of Theme.
+func (s *InitiateTalkSessionReq) GetTheme() string {
+	return s.Theme
+}
+
+// GetScheduledEndTime returns the value of ScheduledEndTime.
+func (s *InitiateTalkSessionReq) GetScheduledEndTime() time.Time {
+	return s.ScheduledEndTime
+}
+
+// GetLatitude returns the value of Latitude.
+func (s *InitiateTalkSessionReq) GetLatitude() OptFloat64 {
+	return s.Latitude
+}
+
+// GetLongitude returns the value of Longitude.
+func (s *InitiateTalkSessionReq) GetLongitude() OptFloat64 {
+	return s.Longitude
+}
+
+// GetCity returns the value of City.
+func (s *InitiateTalkSessionReq) GetCity() OptString {
+	return s.City
+}
+
+// GetPrefecture returns the value of Prefecture.
+func (s *InitiateTalkSessionReq) GetPrefecture() OptString {
+	return s.Prefecture
+}
+
+// GetDescription returns the value of Description.
+func (s *InitiateTalkSessionReq) GetDescription() OptString {
+	return s.Description
+}
+
+// GetThumbnailURL returns the value of ThumbnailURL.
+func (s *InitiateTalkSessionReq) GetThumbnailURL() OptString {
+	return s.ThumbnailURL
+}
+
+// GetRestrictions returns the value of Restrictions.
+func (s *InitiateTalkSessionReq) GetRestrictions() []string {
+	return s.Restrictions
+}
+
+// GetAliasId returns the value of AliasId.
+func (s *InitiateTalkSessionReq) GetAliasId() OptString {
+	return s.AliasId
+}
+
+// GetHideTop returns the value of HideTop.
+func (s *InitiateTalkSessionReq) GetHideTop() OptNilBool {
+	return s.HideTop
+}
+
+// SetTheme sets the value of Theme.
+func (s *InitiateTalkSessionReq) SetTheme(val string) {
+	s.Theme = val
+}
+
+// SetScheduledEndTime sets the value of ScheduledEndTime.
+func (s *InitiateTalkSessionReq) SetScheduledEndTime(val time.Time) {
+	s.ScheduledEndTime = val
+}
+
+// SetLatitude sets the value of Latitude.
+func (s *InitiateTalkSessionReq) SetLatitude(val OptFloat64) {
+	s.Latitude = val
+}
+
+// SetLongitude sets the value of Longitude.
+func (s *InitiateTalkSessionReq) SetLongitude(val OptFloat64) {
+	s.Longitude = val
+}
+
+// SetCity sets the value of City.
+func (s *InitiateTalkSessionReq) SetCity(val OptString) {
+	s.City = val
+}
+
+// SetPrefecture sets the value of Prefecture.
+func (s *InitiateTalkSessionReq) SetPrefecture(val OptString) {
+	s.Prefecture = val
+}
+
+// SetDescription sets the value of Description.
+func (s *InitiateTalkSessionReq) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetThumbnailURL sets the value of ThumbnailURL.
+func (s *InitiateTalkSessionReq) SetThumbnailURL(val OptString) {
+	s.ThumbnailURL = val
+}
+
+// SetRestrictions sets the value of Restrictions.
+func (s *InitiateTalkSessionReq) SetRestrictions(val []string) {
+	s.Restrictions = val
+}
+
+// SetAliasId sets the value of AliasId.
+func (s *InitiateTalkSessionReq) SetAliasId(val OptString) {
+	s.AliasId = val
+}
+
+// SetHideTop sets the value of HideTop.
+func (s *InitiateTalkSessionReq) SetHideTop(val OptNilBool) {
+	s.HideTop = val
+}
+
+type InviteOrganizationBadRequest struct{}
+
+func (*InviteOrganizationBadRequest) inviteOrganizationRes() {}
+
+type InviteOrganizationForUserBadRequest struct{}
+
+func (*InviteOrganizationForUserBadRequest) inviteOrganizationForUserRes() {}
+
+type InviteOrganizationForUserInternalServerError struct{}
+
+func (*InviteOrganizationForUserInternalServerError) inviteOrganizationForUserRes() {}
+
+type InviteOrganizationForUserOK struct {
+	Success bool `json:"success"`
+}
+
+// GetSuccess returns the value of Success.
+func (s *InviteOrganizationForUserOK) GetSuccess() bool {
+	return s.Success
+}
+
+// SetSuccess sets the value of Success.
+func (s *InviteOrganizationForUserOK) SetSuccess(val bool) {
+	s.Success = val
+}
+
+func (*InviteOrganizationForUserOK) inviteOrganizationForUserRes() {}
+
+type InviteOrganizationForUserReq struct {
+	DisplayID string  `json:"displayID"`
+	Role      float64 `json:"role"`
+}
+
+// GetDisplayID returns the value of DisplayID.
+func (s *InviteOrganizationForUserReq) GetDisplayID() string {
+	return s.DisplayID
+}
+
+// GetRole returns the value of Role.
+func (s *InviteOrganizationForUserReq) GetRole() float64 {
+	return s.Role
+}
+
+// SetDisplayID sets the value of DisplayID.
+func (s *InviteOrganizationForUserReq) SetDisplayID(val string) {
+	s.DisplayID = val
+}
+
+// SetRole sets the value of Role.
+func (s *InviteOrganizationForUserReq) SetRole(val float64) {
+	s.Role = val
+}
+
+type InviteOrganizationInternalServerError struct{}
+
+func (*InviteOrganizationInternalServerError) inviteOrganizationRes() {}
+
+type InviteOrganizationOK struct{}
+
+func (*InviteOrganizationOK) inviteOrganizationRes() {}
+
+type InviteOrganizationReq struct {
+	Email string  `json:"email"`
+	Role  float64 `json:"role"`
+}
+
+// GetEmail returns the value of Email.
+func (s *InviteOrganizationReq) GetEmail() string {
+	return s.Email
+}
+
+// GetRole returns the value of Role.
+func (s *InviteOrganizationReq) GetRole() float64 {
+	return s.Role
+}
+
+// SetEmail sets the value of Email.
+func (s *InviteOrganizationReq) SetEmail(val string) {
+	s.Email = val
+}
+
+// SetRole sets the value of Role.
+func (s *InviteOrganizationReq) SetRole(val float64) {
+	s.Role = val
+}
+
+// 発行した組織APIキー.
+// Ref: #/components/schemas/IssuedOrganizationApiKey
+type IssuedOrganizationApiKey struct {
+	ApiKeyID string `json:"apiKeyID"`
+	Name     string `json:"name"`
+	// キーの先頭部分。キーを識別するために表示する.
+	KeyPrefix  string      `json:"keyPrefix"`
+	Scopes     []string    `json:"scopes"`
+	CreatedAt  time.Time   `json:"createdAt"`
+	ExpiresAt  OptDateTime `json:"expiresAt"`
+	LastUsedAt OptDateTime `json:"lastUsedAt"`
+	RevokedAt  OptDateTime `json:"revokedAt"`
+	// APIキー本体。発行時のみ返される.
+	Key string `json:"key"`
+}
+
+// GetApiKeyID returns the value of ApiKeyID.
+func (s *IssuedOrganizationApiKey) GetApiKeyID() string {
+	return s.ApiKeyID
+}
+
+// GetName returns the value of Name.
+func (s *IssuedOrganizationApiKey) GetName() string {
+	return s.Name
+}
+
+// GetKeyPrefix returns the value of KeyPrefix.
+func (s *IssuedOrganizationApiKey) GetKeyPrefix() string {
+	return s.KeyPrefix
+}
+
+// GetScopes returns the value of Scopes.
+func (s *IssuedOrganizationApiKey) GetScopes() []string {
+	return s.Scopes
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *IssuedOrganizationApiKey) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *IssuedOrganizationApiKey) GetExpiresAt() OptDateTime {
+	return s.ExpiresAt
+}
+
+// GetLastUsedAt returns the value of LastUsedAt.
+func (s *IssuedOrganizationApiKey) GetLastUsedAt() OptDateTime {
+	return s.LastUsedAt
+}
+
+// GetRevokedAt returns the value of RevokedAt.
+func (s *IssuedOrganizationApiKey) GetRevokedAt() OptDateTime {
+	return s.RevokedAt
+}
+
+// GetKey returns the value of Key.
+func (s *IssuedOrganizationApiKey) GetKey() string {
+	return s.Key
+}
+
+// SetApiKeyID sets the value of ApiKeyID.
+func (s *IssuedOrganizationApiKey) SetApiKeyID(val string) {
+	s.ApiKeyID = val
+}
+
+// SetName sets the value of Name.
+func (s *IssuedOrganizationApiKey) SetName(val string) {
+	s.Name = val
+}
+
+// SetKeyPrefix sets the value of KeyPrefix.
+func (s *IssuedOrganizationApiKey) SetKeyPrefix(val string) {
+	s.KeyPrefix = val
+}
+
+// SetScopes sets the value of Scopes.
+func (s *IssuedOrganizationApiKey) SetScopes(val []string) {
+	s.Scopes = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *IssuedOrganizationApiKey) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *IssuedOrganizationApiKey) SetExpiresAt(val OptDateTime) {
+	s.ExpiresAt = val
+}
+
+// SetLastUsedAt sets the value of LastUsedAt.
+func (s *IssuedOrganizationApiKey) SetLastUsedAt(val OptDateTime) {
+	s.LastUsedAt = val
+}
+
+// SetRevokedAt sets the value of RevokedAt.
+func (s *IssuedOrganizationApiKey) SetRevokedAt(val OptDateTime) {
+	s.RevokedAt = val
+}
+
+// SetKey sets the value of Key.
+func (s *IssuedOrganizationApiKey) SetKey(val string) {
+	s.Key = val
+}
+
+func (*IssuedOrganizationApiKey) createOrganizationApiKeyRes() {}
+
+// Ref: #/components/schemas/JsonWebKey
+type JsonWebKey struct {
+	// 鍵種別.
+	Kty string `json:"kty"`
+	// 鍵ID.
+	Kid string `json:"kid"`
+	// 用途.
+	Use string `json:"use"`
+	// 署名アルゴリズム.
+	Alg string `json:"alg"`
+	// 楕円曲線名 (EC).
+	Crv OptString `json:"crv"`
+	// X座標 (EC).
+	X OptString `json:"x"`
+	// Y座標 (EC).
+	Y OptString `json:"y"`
+	// モジュラス (RSA).
+	N OptString `json:"n"`
+	// 公開指数 (RSA).
+	E OptString `json:"e"`
+}
+
+// GetKty returns the value of Kty.
+func (s *JsonWebKey) GetKty() string {
+	return s.Kty
+}
+
+// GetKid returns the value of Kid.
+func (s *JsonWebKey) GetKid() string {
+	return s.Kid
+}
+
+// GetUse returns the value of Use.
+func (s *JsonWebKey) GetUse() string {
+	return s.Use
+}
+
+// GetAlg returns the value of Alg.
+func (s *JsonWebKey) GetAlg() string {
+	return s.Alg
+}
+
+// GetCrv returns the value of Crv.
+func (s *JsonWebKey) GetCrv() OptString {
+	return s.Crv
+}
+
+// GetX returns the value of X.
+func (s *JsonWebKey) GetX() OptString {
+	return s.X
+}
+
+// GetY returns the value of Y.
+func (s *JsonWebKey) GetY() OptString {
+	return s.Y
+}
+
+// GetN returns the value of N.
+func (s *JsonWebKey) GetN() OptString {
+	return s.N
+}
+
+// GetE returns the value of E.
+func (s *JsonWebKey) GetE() OptString {
+	return s.E
+}
+
+// SetKty sets the value of Kty.
+func (s *JsonWebKey) SetKty(val string) {
+	s.Kty = val
+}
+
+// SetKid sets the value of Kid.
+func (s *JsonWebKey) SetKid(val string) {
+	s.Kid = val
+}
+
+// SetUse sets the value of Use.
+func (s *JsonWebKey) SetUse(val string) {
+	s.Use = val
+}
+
+// SetAlg sets the value of Alg.
+func (s *JsonWebKey) SetAlg(val string) {
+	s.Alg = val
+}
+
+// SetCrv sets the value of Crv.
+func (s *JsonWebKey) SetCrv(val OptString) {
+	s.Crv = val
+}
+
+// SetX sets the value of X.
+func (s *JsonWebKey) SetX(val OptString) {
+	s.X = val
+}
+
+// SetY sets the value of Y.
+func (s *JsonWebKey) SetY(val OptString) {
+	s.Y = val
+}
+
+// SetN sets the value of N.
+func (s *JsonWebKey) SetN(val OptString) {
+	s.N = val
+}
+
+// SetE sets the value of E.
+func (s *JsonWebKey) SetE(val OptString) {
+	s.E = val
+}
+
+// Ref: #/components/schemas/JsonWebKeySet
+type JsonWebKeySet struct {
+	Keys []JsonWebKey `json:"keys"`
+}
+
+// GetKeys returns the value of Keys.
+func (s *JsonWebKeySet) GetKeys() []JsonWebKey {
+	return s.Keys
+}
+
+// SetKeys sets the value of Keys.
+func (s *JsonWebKeySet) SetKeys(val []JsonWebKey) {
+	s.Keys = val
+}
+
+func (*JsonWebKeySet) getJwksRes() {}
+
+type ListOwnershipTransfersBadRequest struct{}
+
+func (*ListOwnershipTransfersBadRequest) listOwnershipTransfersRes() {}
+
+type ListOwnershipTransfersForbidden struct{}
+
+func (*ListOwnershipTransfersForbidden) listOwnershipTransfersRes() {}
+
+type ListOwnershipTransfersInternalServerError struct{}
+
+func (*ListOwnershipTransfersInternalServerError) listOwnershipTransfersRes() {}
+
+type ListOwnershipTransfersOK struct {
+	Received []OwnershipTransfer `json:"received"`
+	Sent     []OwnershipTransfer `json:"sent"`
+}
+
+// GetReceived returns the value of Received.
+func (s *ListOwnershipTransfersOK) GetReceived() []OwnershipTransfer {
+	return s.Received
+}
+
+// GetSent returns the value of Sent.
+func (s *ListOwnershipTransfersOK) GetSent() []OwnershipTransfer {
+	return s.Sent
+}
+
+// SetReceived sets the value of Received.
+func (s *ListOwnershipTransfersOK) SetReceived(val []OwnershipTransfer) {
+	s.Received = val
+}
+
+// SetSent sets the value of Sent.
+func (s *ListOwnershipTransfersOK) SetSent(val []OwnershipTransfer) {
+	s.Sent = val
+}
+
+func (*ListOwnershipTransfersOK) listOwnershipTransfersRes() {}
+
+// Ref: #/components/schemas/Location
+type Location struct {
+	// 緯度.
+	Latitude OptFloat64 `json:"latitude"`
+	// 経度.
+	Longitude OptFloat64 `json:"longitude"`
+}
+
+// GetLatitude returns the value of Latitude.
+func (s *Location) GetLatitude() OptFloat64 {
+	return s.Latitude
+}
+
+// GetLongitude returns the value of Longitude.
+func (s *Location) GetLongitude() OptFloat64 {
+	return s.Longitude
+}
+
+// SetLatitude sets the value of Latitude.
+func (s *Location) SetLatitude(val OptFloat64) {
+	s.Latitude = val
+}
+
+// SetLongitude sets the value of Longitude.
+func (s *Location) SetLongitude(val OptFloat64) {
+	s.Longitude = val
+}
+
+// NewNilBool returns new NilBool with value set to v.
+func NewNilBool(v bool) NilBool {
+	return NilBool{
+		Value: v,
+	}
+}
+
+// NilBool is nullable bool.
+type NilBool struct {
+	Value bool
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilBool) SetTo(v bool) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o NilBool) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *NilBool) SetToNull() {
+	o.Null = true
+	var v bool
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilBool) Get() (v bool, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilFloat64 returns new NilFloat64 with value set to v.
+func NewNilFloat64(v float64) NilFloat64 {
+	return NilFloat64{
+		Value: v,
+	}
+}
+
+// NilFloat64 is nullable float64.
+type NilFloat64 struct {
+	Value float64
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilFloat64) SetTo(v float64) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o NilFloat64) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *NilFloat64) SetToNull() {
+	o.Null = true
+	var v float64
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilFloat64) Get() (v float64, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilInt returns new NilInt with value set to v.
+func NewNilInt(v int) NilInt {
+	return NilInt{
+		Value: v,
+	}
+}
+
+// NilInt is nullable int.
+type NilInt struct {
+	Value int
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilInt) SetTo(v int) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o NilInt) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *NilInt) SetToNull() {
+	o.Null = true
+	var v int
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilInt) Get() (v int, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilString returns new NilString with value set to v.
+func NewNilString(v string) NilString {
+	return NilString{
+		Value: v,
+	}
+}
+
+// NilString is nullable string.
+type NilString struct {
+	Value string
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilString) SetTo(v string) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o NilString) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *NilString) SetToNull() {
+	o.Null = true
+	var v string
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilString) Get() (v string, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// 通知設定.
+// Ref: #/components/schemas/NotificationPreferences
+type NotificationPreferences struct {
+	PushNotificationEnabled bool `json:"push_notification_enabled"`
+}
+
+// GetPushNotificationEnabled returns the value of PushNotificationEnabled.
+func (s *NotificationPreferences) GetPushNotificationEnabled() bool {
+	return s.PushNotificationEnabled
+}
+
+// SetPushNotificationEnabled sets the value of PushNotificationEnabled.
+func (s *NotificationPreferences) SetPushNotificationEnabled(val bool) {
+	s.PushNotificationEnabled = val
+}
+
+func (*NotificationPreferences) getNotificationPreferencesRes()    {}
+func (*NotificationPreferences) updateNotificationPreferencesRes() {}
+
+// Ref: #/components/schemas/OffsetPagination
+type OffsetPagination struct {
+	TotalCount int `json:"totalCount"`
+	Offset     int `json:"offset"`
+	Limit      int `json:"limit"`
+}
+
+// GetTotalCount returns the value of TotalCount.
+func (s *OffsetPagination) GetTotalCount() int {
+	return s.TotalCount
+}
+
+// GetOffset returns the value of Offset.
+func (s *OffsetPagination) GetOffset() int {
+	return s.Offset
+}
+
+// GetLimit returns the value of Limit.
+func (s *OffsetPagination) GetLimit() int {
+	return s.Limit
+}
+
+// SetTotalCount sets the value of TotalCount.
+func (s *OffsetPagination) SetTotalCount(val int) {
+	s.TotalCount = val
+}
+
+// SetOffset sets the value of Offset.
+func (s *OffsetPagination) SetOffset(val int) {
+	s.Offset = val
+}
+
+// SetLimit sets the value of Limit.
+func (s *OffsetPagination) SetLimit(val int) {
+	s.Limit = val
+}
+
+// Ref: #/components/schemas/Opinion
+type Opinion struct {
+	// 意見ID.
+	ID    string    `json:"id"`
+	Title OptString `json:"title"`
+	// 意見のテキスト.
+	Content string `json:"content"`
+	// 親の意見ID。ルートならば無し.
+	ParentID OptString `json:"parentID"`
+	// 意見投稿主の意見。ルート意見の場合はここには何も入らない.
+	VoteType OptNilVoteType `json:"voteType"`
+	// 画像が返る場合もある.
+	PictureURL OptNilString `json:"pictureURL"`
+	// 参考文献URL.
+	ReferenceURL OptString `json:"referenceURL"`
+	PostedAt     string    `json:"postedAt"`
+	IsDeleted    bool      `json:"isDeleted"`
+	// 投稿者が最後に編集した日時。編集されていなければ無し.
+	EditedAt OptNilString `json:"editedAt"`
+}
+
+// GetID returns the value of ID.
+func (s *Opinion) GetID() string {
+	return s.ID
+}
+
+// GetTitle returns the value of Title.
+func (s *Opinion) GetTitle() OptString {
+	return s.Title
+}
+
+// GetContent returns the value of Content.
+func (s *Opinion) GetContent() string {
+	return s.Content
+}
+
+// GetParentID returns the value of ParentID.
+func (s *Opinion) GetParentID() OptString {
+	return s.ParentID
+}
+
+// GetVoteType returns the value of VoteType.
+func (s *Opinion) GetVoteType() OptNilVoteType {
+	return s.VoteType
+}
+
+// GetPictureURL returns the value of PictureURL.
+func (s *Opinion) GetPictureURL() OptNilString {
+	return s.PictureURL
+}
+
+// GetReferenceURL returns the value of ReferenceURL.
+func (s *Opinion) GetReferenceURL() OptString {
+	return s.ReferenceURL
+}
+
+// GetPostedAt returns the value of PostedAt.
+func (s *Opinion) GetPostedAt() string {
+	return s.PostedAt
+}
+
+// GetIsDeleted returns the value of IsDeleted.
+func (s *Opinion) GetIsDeleted() bool {
+	return s.IsDeleted
+}
+
+// GetEditedAt returns the value of EditedAt.
+func (s *Opinion) GetEditedAt() OptNilString {
+	return s.EditedAt
+}
+
+// SetID sets the value of ID.
+func (s *Opinion) SetID(val string) {
+	s.ID = val
+}
+
+// SetTitle sets the value of Title.
+func (s *Opinion) SetTitle(val OptString) {
+	s.Title = val
+}
+
+// SetContent sets the value of Content.
+func (s *Opinion) SetContent(val string) {
+	s.Content = val
+}
+
+// SetParentID sets the value of ParentID.
+func (s *Opinion) SetParentID(val OptString) {
+	s.ParentID = val
+}
+
+// SetVoteType sets the value of VoteType.
+func (s *Opinion) SetVoteType(val OptNilVoteType) {
+	s.VoteType = val
+}
+
+// SetPictureURL sets the value of PictureURL.
+func (s *Opinion) SetPictureURL(val OptNilString) {
+	s.PictureURL = val
+}
+
+// SetReferenceURL sets the value of ReferenceURL.
+func (s *Opinion) SetReferenceURL(val OptString) {
+	s.ReferenceURL = val
+}
+
+// SetPostedAt sets the value of PostedAt.
+func (s *Opinion) SetPostedAt(val string) {
+	s.PostedAt = val
+}
+
+// SetIsDeleted sets the value of IsDeleted.
+func (s *Opinion) SetIsDeleted(val bool) {
+	s.IsDeleted = val
+}
+
+// SetEditedAt sets the value of EditedAt.
+func (s *Opinion) SetEditedAt(val OptNilString) {
+	s.EditedAt = val
+}
+
+func (*Opinion) postOpinionPost2Res() {}
+
+// 自分の投票とグループの投票の一致度.
+// Ref: #/components/schemas/OpinionAlignment
+type OpinionAlignment struct {
+	Opinion    Opinion                    `json:"opinion"`
+	User       User                       `json:"user"`
+	MyVoteType OpinionAlignmentMyVoteType `json:"myVoteType"`
+	// 自分の投票を除いたグループの投票数.
+	Group GroupAgreement `json:"group"`
+	// グループが自分と同じ投票をする確率。投票が少ないグループで極端にならないよう平滑化する.
+	Alignment float64 `json:"alignment"`
+}
+
+// GetOpinion returns the value of Opinion.
+func (s *OpinionAlignment) GetOpinion() Opinion {
+	return s.Opinion
+}
+
+// GetUser returns the value of User.
+func (s *OpinionAlignment) GetUser() User {
+	return s.User
+}
+
+// GetMyVoteType returns the value of MyVoteType.
+func (s *OpinionAlignment) GetMyVoteType() OpinionAlignmentMyVoteType {
+	return s.MyVoteType
+}
+
+// GetGroup returns the value of Group.
+func (s *OpinionAlignment) GetGroup() GroupAgreement {
+	return s.Group
+}
+
+// GetAlignment returns the value of Alignment.
+func (s *OpinionAlignment) GetAlignment() float64 {
+	return s.Alignment
+}
+
+// SetOpinion sets the value of Opinion.
+func (s *OpinionAlignment) SetOpinion(val Opinion) {
+	s.Opinion = val
+}
+
+// SetUser sets the value of User.
+func (s *OpinionAlignment) SetUser(val User) {
+	s.User = val
+}
+
+// SetMyVoteType sets the value of MyVoteType.
+func (s *OpinionAlignment) SetMyVoteType(val OpinionAlignmentMyVoteType) {
+	s.MyVoteType = val
+}
+
+// SetGroup sets the value of Group.
+func (s *OpinionAlignment) SetGroup(val GroupAgreement) {
+	s.Group = val
+}
+
+// SetAlignment sets the value of Alignment.
+func (s *OpinionAlignment) SetAlignment(val float64) {
+	s.Alignment = val
+}
+
+type OpinionAlignmentMyVoteType string
+
+const (
+	OpinionAlignmentMyVoteTypeAgree    OpinionAlignmentMyVoteType = "agree"
+	OpinionAlignmentMyVoteTypeDisagree OpinionAlignmentMyVoteType = "disagree"
+)
+
+// AllValues returns all OpinionAlignmentMyVoteType values.
+func (OpinionAlignmentMyVoteType) AllValues() []OpinionAlignmentMyVoteType {
+	return []OpinionAlignmentMyVoteType{
+		OpinionAlignmentMyVoteTypeAgree,
+		OpinionAlignmentMyVoteTypeDisagree,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s OpinionAlignmentMyVoteType) MarshalText() ([]byte, error) {
+	switch s {
+	case OpinionAlignmentMyVoteTypeAgree:
+		return []byte(s), nil
+	case OpinionAlignmentMyVoteTypeDisagree:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *OpinionAlignmentMyVoteType) UnmarshalText(data []byte) error {
+	switch OpinionAlignmentMyVoteType(data) {
+	case OpinionAlignmentMyVoteTypeAgree:
+		*s = OpinionAlignmentMyVoteTypeAgree
+		return nil
+	case OpinionAlignmentMyVoteTypeDisagree:
+		*s = OpinionAlignmentMyVoteTypeDisagree
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type OpinionComments2BadRequest struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// GetCode returns the value of Code.
+func (s *OpinionComments2BadRequest) GetCode() string {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *OpinionComments2BadRequest) GetMessage() string {
+	return s.Message
+}
+
+// SetCode sets the value of Code.
+func (s *OpinionComments2BadRequest) SetCode(val string) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *OpinionComments2BadRequest) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*OpinionComments2BadRequest) opinionComments2Res() {}
+
+type OpinionComments2InternalServerError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// GetCode returns the value of Code.
+func (s *OpinionComments2InternalServerError) GetCode() string {
+	return s.Code
+}
+
+// GetMessage returns the value of Message.
+func (s *OpinionComments2InternalServerError) GetMessage() string {
+	return s.Message
+}
+
+// SetCode sets the value of Code.
+func (s *OpinionComments2InternalServerError) SetCode(val string) {
+	s.Code = val
+}
+
+// SetMessage sets the value of Message.
+func (s *OpinionComments2InternalServerError) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*OpinionComments2InternalServerError) opinionComments2Res() {}
+
+type OpinionComments2OK struct {
+	Opinions []OpinionWithVote `json:"opinions"`
+}
+
+// GetOpinions returns the value of Opinions.
+func (s *OpinionComments2OK) GetOpinions() []OpinionWithVote {
+	return s.Opinions
+}
+
+// SetOpinions sets the value of Opinions.
+func (s *OpinionComments2OK) SetOpinions(val []OpinionWithVote) {
+	s.Opinions = val
+}
+
+func (*OpinionComments2OK) opinionComments2Res() {}
+
+// 意見ごとのグループ間の合意度と対立度.
+// Ref: #/components/schemas/OpinionConsensus
+type OpinionConsensus struct {
+	Opinion Opinion `json:"opinion"`
+	User    User    `json:"user"`
+	// 全グループの賛成確率の積。全てのグループが賛成しているほど1に近い.
+	Consensus float64 `json:"consensus"`
+	// グループ間の賛成確率の最大と最小の差。グループで賛否が分かれているほど1に近い.
+	Divisiveness float64          `json:"divisiveness"`
+	Groups       []GroupAgreement `json:"groups"`
+}
+
+// GetOpinion returns the value of Opinion.
+func (s *OpinionConsensus) GetOpinion() Opinion {
+	return s.Opinion
+}
+
+// GetUser returns the value of User.
+func (s *OpinionConsensus) GetUser() User {
+	return s.User
+}
+
+// GetConsensus returns the value of Consensus.
+func (s *OpinionConsensus) GetConsensus() float64 {
+	return s.Consensus
+}
+
+// GetDivisiveness returns the value of Divisiveness.
+func (s *OpinionConsensus) GetDivisiveness() float64 {
+	return s.Divisiveness
+}
+
+// GetGroups returns the value of Groups.
+func (s *OpinionConsensus) GetGroups() []GroupAgreement {
+	return s.Groups
+}
+
+// SetOpinion sets the value of Opinion.
+func (s *OpinionConsensus) SetOpinion(val Opinion) {
+	s.Opinion = val
+}
+
+// SetUser sets the value of User.
+func (s *OpinionConsensus) SetUser(val User) {
+	s.User = val
+}
+
+// SetConsensus sets the value of Consensus.
+func (s *OpinionConsensus) SetConsensus(val float64) {
+	s.Consensus = val
+}
+
+// SetDivisiveness sets the value of Divisiveness.
+func (s *OpinionConsensus) SetDivisiveness(val float64) {
+	s.Divisiveness = val
+}
+
+// SetGroups sets the value of Groups.
+func (s *OpinionConsensus) SetGroups(val []GroupAgreement) {
+	s.Groups = val
+}
+
+// Ref: #/components/schemas/OpinionGroupRatio
+type OpinionGroupRatio struct {
+	AgreeCount    int                  `json:"agreeCount"`
+	DisagreeCount int                  `json:"disagreeCount"`
+	PassCount     int                  `json:"passCount"`
+	GroupID       int                  `json:"groupID"`
+	GroupName     string               `json:"groupName"`
+	Weighted      OptWeightedVoteRates `json:"weighted"`
+}
+
+// GetAgreeCount returns the value of AgreeCount.
+func (s *OpinionGroupRatio) GetAgreeCount() int {
+	return s.AgreeCount
+}
+
+// GetDisagreeCount returns the value of DisagreeCount.
+func (s *OpinionGroupRatio) GetDisagreeCount() int {
+	return s.DisagreeCount
+}
+
+// GetPassCount returns the value of PassCount.
+func (s *OpinionGroupRatio) GetPassCount() int {
+	return s.PassCount
+}
+
+// GetGroupID returns the value of GroupID.
+func (s *OpinionGroupRatio) GetGroupID() int {
+	return s.GroupID
+}
+
+// GetGroupName returns the value of GroupName.
+func (s *OpinionGroupRatio) GetGroupName() string {
+	return s.GroupName
+}
+
+// GetWeighted returns the value of Weighted.
+func (s *OpinionGroupRatio) GetWeighted() OptWeightedVoteRates {
+	return s.Weighted
+}
+
+// SetAgreeCount sets the value of AgreeCount.
+func (s *OpinionGroupRatio) SetAgreeCount(val int) {
+	s.AgreeCount = val
+}
+
+// SetDisagreeCount sets the value of DisagreeCount.
+func (s *OpinionGroupRatio) SetDisagreeCount(val int) {
+	s.DisagreeCount = val
+}
+
+// SetPassCount sets the value of PassCount.
+func (s *OpinionGroupRatio) SetPassCount(val int) {
+	s.PassCount = val
+}
+
+// SetGroupID sets the value of GroupID.
+func (s *OpinionGroupRatio) SetGroupID(val int) {
+	s.GroupID = val
+}
+
+// SetGroupName sets the value of GroupName.
+func (s *OpinionGroupRatio) SetGroupName(val string) {
+	s.GroupName = val
+}
+
+// SetWeighted sets the value of Weighted.
+func (s *OpinionGroupRatio) SetWeighted(val OptWeightedVoteRates) {
+	s.Weighted = val
+}
+
+// 意見の版.
+// Ref: #/components/schemas/OpinionRevision
+type OpinionRevision struct {
+	// 版番号.
+	Revision     int32        `json:"revision"`
+	Title        OptNilString `json:"title"`
+	Content      string       `json:"content"`
+	ReferenceURL OptNilString `json:"referenceURL"`
+	// 編集された時点での投稿者以外の投票数。現在の内容では無し.
+	VoteCount OptNilInt32 `json:"voteCount"`
+	// 編集時にこの版への投票をリセットしたか.
+	VotesReset bool `json:"votesReset"`
+	// この版の内容になった日時.
+	CreatedAt string `json:"createdAt"`
+	// 次の版に編集された日時。現在の内容では無し.
+	ReplacedAt OptNilString `json:"replacedAt"`
+}
+
+// GetRevision returns the value of Revision.
+func (s *OpinionRevision) GetRevision() int32 {
+	return s.Revision
+}
+
+// GetTitle returns the value of Title.
+func (s *OpinionRevision) GetTitle() OptNilString {
+	return s.Title
+}
+
+// GetContent returns the value of Content.
+func (s *OpinionRevision) GetContent() string {
+	return s.Content
+}
+
+// GetReferenceURL returns the value of ReferenceURL.
+func (s *OpinionRevision) GetReferenceURL() OptNilString {
+	return s.ReferenceURL
+}
+
+// GetVoteCount returns the value of VoteCount.
+func (s *OpinionRevision) GetVoteCount() OptNilInt32 {
+	return s.VoteCount
+}
+
+// GetVotesReset returns the value of VotesReset.
+func (s *OpinionRevision) GetVotesReset() bool {
+	return s.VotesReset
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *OpinionRevision) GetCreatedAt() string {
+	return s.CreatedAt
+}
+
+// GetReplacedAt returns the value of ReplacedAt.
+func (s *OpinionRevision) GetReplacedAt() OptNilString {
+	return s.ReplacedAt
+}
+
+// SetRevision sets the value of Revision.
+func (s *OpinionRevision) SetRevision(val int32) {
+	s.Revision = val
+}
+
+// SetTitle sets the value of Title.
+func (s *OpinionRevision) SetTitle(val OptNilString) {
+	s.Title = val
+}
+
+// SetContent sets the value of Content.
+func (s *OpinionRevision) SetContent(val string) {
+	s.Content = val
+}
+
+// SetReferenceURL sets the value of ReferenceURL.
+func (s *OpinionRevision) SetReferenceURL(val OptNilString) {
+	s.ReferenceURL = val
+}
+
+// SetVoteCount sets the value of VoteCount.
+func (s *OpinionRevision) SetVoteCount(val OptNilInt32) {
+	s.VoteCount = val
+}
+
+// SetVotesReset sets the value of VotesReset.
+func (s *OpinionRevision) SetVotesReset(val bool) {
+	s.VotesReset = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *OpinionRevision) SetCreatedAt(val string) {
+	s.CreatedAt = val
+}
+
+// SetReplacedAt sets the value of ReplacedAt.
+func (s *OpinionRevision) SetReplacedAt(val OptNilString) {
+	s.ReplacedAt = val
+}
+
+// まだ投票していない、同じグループのメンバーが賛成している意見.
+// Ref: #/components/schemas/OpinionSuggestion
+type OpinionSuggestion struct {
+	Opinion Opinion `json:"opinion"`
+	User    User    `json:"user"`
+	// 同じグループの投票数.
+	Group GroupAgreement `json:"group"`
+}
+
+// GetOpinion returns the value of Opinion.
+func (s *OpinionSuggestion) GetOpinion() Opinion {
+	return s.Opinion
+}
+
+// GetUser returns the value of User.
+func (s *OpinionSuggestion) GetUser() User {
+	return s.User
+}
+
+// GetGroup returns the value of Group.
+func (s *OpinionSuggestion) GetGroup() GroupAgreement {
+	return s.Group
+}
+
+// SetOpinion sets the value of Opinion.
+func (s *OpinionSuggestion) SetOpinion(val Opinion) {
+	s.Opinion = val
+}
+
+// SetUser sets the value of User.
+func (s *OpinionSuggestion) SetUser(val User) {
+	s.User = val
+}
+
+// SetGroup sets the value of Group.
+func (s *OpinionSuggestion) SetGroup(val GroupAgreement) {
+	s.Group = val
+}
+
+// 意見ごとの投票の変化.
+// Ref: #/components/schemas/OpinionVoteShift
+type OpinionVoteShift struct {
+	OpinionID string `json:"opinionID"`
+	// 投票が変更された回数.
+	ChangeCount int `json:"changeCount"`
+	// 投票を変更したユーザー数。元の投票に戻したユーザーも含む.
+	ChangedUserCount int         `json:"changedUserCount"`
+	Shifts           []VoteShift `json:"shifts"`
+}
+
+// GetOpinionID returns the value of OpinionID.
+func (s *OpinionVoteShift) GetOpinionID() string {
+	return s.OpinionID
+}
+
+// GetChangeCount returns the value of ChangeCount.
+func (s *OpinionVoteShift) GetChangeCount() int {
+	return s.ChangeCount
+}
+
+// GetChangedUserCount returns the value of ChangedUserCount.
+func (s *OpinionVoteShift) GetChangedUserCount() int {
+	return s.ChangedUserCount
+}
+
+// GetShifts returns the value of Shifts.
+func (s *OpinionVoteShift) GetShifts() []VoteShift {
+	return s.Shifts
+}
+
+// SetOpinionID sets the value of OpinionID.
+func (s *OpinionVoteShift) SetOpinionID(val string) {
+	s.OpinionID = val
+}
+
+// SetChangeCount sets the value of ChangeCount.
+func (s *OpinionVoteShift) SetChangeCount(val int) {
+	s.ChangeCount = val
+}
+
+// SetChangedUserCount sets the value of ChangedUserCount.
+func (s *OpinionVoteShift) SetChangedUserCount(val int) {
+	s.ChangedUserCount = val
+}
+
+// SetShifts sets the value of Shifts.
+func (s *OpinionVoteShift) SetShifts(val []VoteShift) {
+	s.Shifts = val
+}
+
+// 意見とリプライ数と投票情報を含むレスポンス.
+// Ref: #/components/schemas/OpinionWithReplyAndVote
+type OpinionWithReplyAndVote struct {
+	Opinion    Opinion        `json:"opinion"`
+	User       User           `json:"user"`
+	ReplyCount int            `json:"replyCount"`
+	MyVoteType OptNilVoteType `json:"myVoteType"`
+}
+
+// GetOpinion returns the value of Opinion.
+func (s *OpinionWithReplyAndVote) GetOpinion() Opinion {
+	return s.Opinion
+}
+
+// GetUser returns the value of User.
+func (s *OpinionWithReplyAndVote) GetUser() User {
+	return s.User
+}
+
+// GetReplyCount returns the value of ReplyCount.
+func (s *OpinionWithReplyAndVote) GetReplyCount() int {
+	return s.ReplyCount
+}
+
+// GetMyVoteType returns the value of MyVoteType.
+func (s *OpinionWithReplyAndVote) GetMyVoteType() OptNilVoteType {
+	return s.MyVoteType
+}
+
+// SetOpinion sets the value of Opinion.
+func (s *OpinionWithReplyAndVote) SetOpinion(val Opinion) {
+	s.Opinion = val
+}
+
+// SetUser sets the value of User.
+func (s *OpinionWithReplyAndVote) SetUser(val User) {
+	s.User = val
+}
+
+// SetReplyCount sets the value of ReplyCount.
+func (s *OpinionWithReplyAndVote) SetReplyCount(val int) {
+	s.ReplyCount = val
+}
+
+// SetMyVoteType sets the value of MyVoteType.
+func (s *OpinionWithReplyAndVote) SetMyVoteType(val OptNilVoteType) {
+	s.MyVoteType = val
+}
+
+// 意見とリプライ数を含むレスポンス.
+// Ref: #/components/schemas/OpinionWithReplyCount
+type OpinionWithReplyCount struct {
+	Opinion    Opinion `json:"opinion"`
+	User       User    `json:"user"`
+	ReplyCount int     `json:"replyCount"`
+}
+
+// GetOpinion returns the value of Opinion.
+func (s *OpinionWithReplyCount) GetOpinion() Opinion {
+	return s.Opinion
+}
+
+// GetUser returns the value of User.
+func (s *OpinionWithReplyCount) GetUser() User {
+	return s.User
+}
+
+// GetReplyCount returns the value of ReplyCount.
+func (s *OpinionWithReplyCount) GetReplyCount() int {
+	return s.ReplyCount
+}
+
+// SetOpinion sets the value of Opinion.
+func (s *OpinionWithReplyCount) SetOpinion(val Opinion) {
+	s.Opinion = val
+}
+
+// SetUser sets the value of User.
+func (s *OpinionWithReplyCount) SetUser(val User) {
+	s.User = val
+}
+
+// SetReplyCount sets the value of ReplyCount.
+func (s *OpinionWithReplyCount) SetReplyCount(val int) {
+	s.ReplyCount = val
+}
+
+// 意見と投票情報を含むレスポンス.
+// Ref: #/components/schemas/OpinionWithVote
+type OpinionWithVote struct {
+	Opinion    Opinion        `json:"opinion"`
+	User       User           `json:"user"`
+	MyVoteType OptNilVoteType `json:"myVoteType"`
+}
+
+// GetOpinion returns the value of Opinion.
+func (s *OpinionWithVote) GetOpinion() Opinion {
+	return s.Opinion
+}
+
+// GetUser returns the value of User.
+func (s *OpinionWithVote) GetUser() User {
+	return s.User
+}
+
+// GetMyVoteType returns the value of MyVoteType.
+func (s *OpinionWithVote) GetMyVoteType() OptNilVoteType {
+	return s.MyVoteType
+}
+
+// SetOpinion sets the value of Opinion.
+func (s *OpinionWithVote) SetOpinion(val Opinion) {
+	s.Opinion = val
+}
+
+// SetUser sets the value of User.
+func (s *OpinionWithVote) SetUser(val User) {
+	s.User = val
+}
+
+// SetMyVoteType sets the value of MyVoteType.
+func (s *OpinionWithVote) SetMyVoteType(val OptNilVoteType) {
+	s.MyVoteType = val
+}
+
+func (*OpinionWithVote) getOpinionDetail2Res() {}
+
+type OpinionsHistoryBadRequest struct{}
+
+func (*OpinionsHistoryBadRequest) opinionsHistoryRes() {}
+
+type OpinionsHistoryInternalServerError struct{}
+
+func (*OpinionsHistoryInternalServerError) opinionsHistoryRes() {}
+
+type OpinionsHistoryOK struct {
+	Opinions   []OpinionWithReplyCount     `json:"opinions"`
+	Pagination OpinionsHistoryOKPagination `json:"pagination"`
+}
+
+// GetOpinions returns the value of Opinions.
+func (s *OpinionsHistoryOK) GetOpinions() []OpinionWithReplyCount {
+	return s.Opinions
+}
+
+// GetPagination returns the value of Pagination.
+func (s *OpinionsHistoryOK) GetPagination() OpinionsHistoryOKPagination {
+	return s.Pagination
+}
+
+// SetOpinions sets the value of Opinions.
+func (s *OpinionsHistoryOK) SetOpinions(val []OpinionWithReplyCount) {
+	s.Opinions = val
+}
+
+// SetPagination sets the value of Pagination.
+func (s *OpinionsHistoryOK) SetPagination(val OpinionsHistoryOKPagination) {
+	s.Pagination = val
+}
+
+func (*OpinionsHistoryOK) opinionsHistoryRes() {}
+
+type OpinionsHistoryOKPagination struct {
+	TotalCount int `json:"totalCount"`
+}
+
+// GetTotalCount returns the value of TotalCount.
+func (s *OpinionsHistoryOKPagination) GetTotalCount() int {
+	return s.TotalCount
+}
+
+// SetTotalCount sets the value of TotalCount.
+func (s *OpinionsHistoryOKPagination) SetTotalCount(val int) {
+	s.TotalCount = val
+}
+
+type OpinionsHistorySort string
+
+const (
+	OpinionsHistorySortLatest      OpinionsHistorySort = "latest"
+	OpinionsHistorySortMostReplies OpinionsHistorySort = "mostReplies"
+	OpinionsHistorySortOldest      OpinionsHistorySort = "oldest"
+)
+
+// AllValues returns all OpinionsHistorySort values.
+func (OpinionsHistorySort) AllValues() []OpinionsHistorySort {
+	return []OpinionsHistorySort{
+		OpinionsHistorySortLatest,
+		OpinionsHistorySortMostReplies,
+		OpinionsHistorySortOldest,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s OpinionsHistorySort) MarshalText() ([]byte, error) {
+	switch s {
+	case OpinionsHistorySortLatest:
+		return []byte(s), nil
+	case OpinionsHistorySortMostReplies:
+		return []byte(s), nil
+	case OpinionsHistorySortOldest:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *OpinionsHistorySort) UnmarshalText(data []byte) error {
+	switch OpinionsHistorySort(data) {
+	case OpinionsHistorySortLatest:
+		*s = OpinionsHistorySortLatest
+		return nil
+	case OpinionsHistorySortMostReplies:
+		*s = OpinionsHistorySortMostReplies
+		return nil
+	case OpinionsHistorySortOldest:
+		*s = OpinionsHistorySortOldest
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// NewOptBatchVoteResultError returns new OptBatchVoteResultError with value set to v.
+func NewOptBatchVoteResultError(v BatchVoteResultError) OptBatchVoteResultError {
+	return OptBatchVoteResultError{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBatchVoteResultError is optional BatchVoteResultError.
+type OptBatchVoteResultError struct {
+	Value BatchVoteResultError
+	Set   bool
+}
+
+// IsSet returns true if OptBatchVoteResultError was set.
+func (o OptBatchVoteResultError) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBatchVoteResultError) Reset() {
+	var v BatchVoteResultError
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBatchVoteResultError) SetTo(v BatchVoteResultError) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBatchVoteResultError) Get() (v BatchVoteResultError, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBatchVoteResultError) Or(d BatchVoteResultError) BatchVoteResultError {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDate returns new OptDate with value set to v.
+func NewOptDate(v time.Time) OptDate {
+	return OptDate{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDate is optional time.Time.
+type OptDate struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDate was set.
+func (o OptDate) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDate) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDate) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDate) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDate) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDemographicDimension returns new OptDemographicDimension with value set to v.
+func NewOptDemographicDimension(v DemographicDimension) OptDemographicDimension {
+	return OptDemographicDimension{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDemographicDimension is optional DemographicDimension.
+type OptDemographicDimension struct {
+	Value DemographicDimension
+	Set   bool
+}
+
+// IsSet returns true if OptDemographicDimension was set.
+func (o OptDemographicDimension) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDemographicDimension) Reset() {
+	var v DemographicDimension
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDemographicDimension) SetTo(v DemographicDimension) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDemographicDimension) Get() (v DemographicDimension, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDemographicDimension) Or(d DemographicDimension) DemographicDimension {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFloat64 returns new OptFloat64 with value set to v.
+func NewOptFloat64(v float64) OptFloat64 {
+	return OptFloat64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFloat64 is optional float64.
+type OptFloat64 struct {
+	Value float64
+	Set   bool
+}
+
+// IsSet returns true if OptFloat64 was set.
+func (o OptFloat64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFloat64) Reset() {
+	var v float64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFloat64) SetTo(v float64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFloat64) Get() (v float64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptGetOrganizationStatsBucket returns new OptGetOrganizationStatsBucket with value set to v.
+func NewOptGetOrganizationStatsBucket(v GetOrganizationStatsBucket) OptGetOrganizationStatsBucket {
+	return OptGetOrganizationStatsBucket{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptGetOrganizationStatsBucket is optional GetOrganizationStatsBucket.
+type OptGetOrganizationStatsBucket struct {
+	Value GetOrganizationStatsBucket
+	Set   bool
+}
+
+// IsSet returns true if OptGetOrganizationStatsBucket was set.
+func (o OptGetOrganizationStatsBucket) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptGetOrganizationStatsBucket) Reset() {
+	var v GetOrganizationStatsBucket
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptGetOrganizationStatsBucket) SetTo(v GetOrganizationStatsBucket) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptGetOrganizationStatsBucket) Get() (v GetOrganizationStatsBucket, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptGetOrganizationStatsBucket) Or(d GetOrganizationStatsBucket) GetOrganizationStatsBucket {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptGetOrganizationTalkSessionsStatus returns new OptGetOrganizationTalkSessionsStatus with value set to v.
+func NewOptGetOrganizationTalkSessionsStatus(v GetOrganizationTalkSessionsStatus) OptGetOrganizationTalkSessionsStatus {
+	return OptGetOrganizationTalkSessionsStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptGetOrganizationTalkSessionsStatus is optional GetOrganizationTalkSessionsStatus.
+type OptGetOrganizationTalkSessionsStatus struct {
+	Value GetOrganizationTalkSessionsStatus
+	Set   bool
+}
+
+// IsSet returns true if OptGetOrganizationTalkSessionsStatus was set.
+func (o OptGetOrganizationTalkSessionsStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptGetOrganizationTalkSessionsStatus) Reset() {
+	var v GetOrganizationTalkSessionsStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptGetOrganizationTalkSessionsStatus) SetTo(v GetOrganizationTalkSessionsStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptGetOrganizationTalkSessionsStatus) Get() (v GetOrganizationTalkSessionsStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptGetOrganizationTalkSessionsStatus) Or(d GetOrganizationTalkSessionsStatus) GetOrganizationTalkSessionsStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptGetReportsForTalkSessionStatus returns new OptGetReportsForTalkSessionStatus with value set to v.
+func NewOptGetReportsForTalkSessionStatus(v GetReportsForTalkSessionStatus) OptGetReportsForTalkSessionStatus {
+	return OptGetReportsForTalkSessionStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptGetReportsForTalkSessionStatus is optional GetReportsForTalkSessionStatus.
+type OptGetReportsForTalkSessionStatus struct {
+	Value GetReportsForTalkSessionStatus
+	Set   bool
+}
+
+// IsSet returns true if OptGetReportsForTalkSessionStatus was set.
+func (o OptGetReportsForTalkSessionStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptGetReportsForTalkSessionStatus) Reset() {
+	var v GetReportsForTalkSessionStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptGetReportsForTalkSessionStatus) SetTo(v GetReportsForTalkSessionStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptGetReportsForTalkSessionStatus) Get() (v GetReportsForTalkSessionStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptGetReportsForTalkSessionStatus) Or(d GetReportsForTalkSessionStatus) GetReportsForTalkSessionStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptGetTalkSessionListManageStatus returns new OptGetTalkSessionListManageStatus with value set to v.
+func NewOptGetTalkSessionListManageStatus(v GetTalkSessionListManageStatus) OptGetTalkSessionListManageStatus {
+	return OptGetTalkSessionListManageStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptGetTalkSessionListManageStatus is optional GetTalkSessionListManageStatus.
+type OptGetTalkSessionListManageStatus struct {
+	Value GetTalkSessionListManageStatus
+	Set   bool
+}
+
+// IsSet returns true if OptGetTalkSessionListManageStatus was set.
+func (o OptGetTalkSessionListManageStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptGetTalkSessionListManageStatus) Reset() {
+	var v GetTalkSessionListManageStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptGetTalkSessionListManageStatus) SetTo(v GetTalkSessionListManageStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptGetTalkSessionListManageStatus) Get() (v GetTalkSessionListManageStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptGetTalkSessionListManageStatus) Or(d GetTalkSessionListManageStatus) GetTalkSessionListManageStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptGetTalkSessionListSortKey returns new OptGetTalkSessionListSortKey with value set to v.
+func NewOptGetTalkSessionListSortKey(v GetTalkSessionListSortKey) OptGetTalkSessionListSortKey {
+	return OptGetTalkSessionListSortKey{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptGetTalkSessionListSortKey is optional GetTalkSessionListSortKey.
+type OptGetTalkSessionListSortKey struct {
+	Value GetTalkSessionListSortKey
+	Set   bool
+}
+
+// IsSet returns true if OptGetTalkSessionListSortKey was set.
+func (o OptGetTalkSessionListSortKey) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptGetTalkSessionListSortKey) Reset() {
+	var v GetTalkSessionListSortKey
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptGetTalkSessionListSortKey) SetTo(v GetTalkSessionListSortKey) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptGetTalkSessionListSortKey) Get() (v GetTalkSessionListSortKey, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptGetTalkSessionListSortKey) Or(d GetTalkSessionListSortKey) GetTalkSessionListSortKey {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptGetUserListManageOrder returns new OptGetUserListManageOrder with value set to v.
+func NewOptGetUserListManageOrder(v GetUserListManageOrder) OptGetUserListManageOrder {
+	return OptGetUserListManageOrder{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptGetUserListManageOrder is optional GetUserListManageOrder.
+type OptGetUserListManageOrder struct {
+	Value GetUserListManageOrder
+	Set   bool
+}
+
+// IsSet returns true if OptGetUserListManageOrder was set.
+func (o OptGetUserListManageOrder) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptGetUserListManageOrder) Reset() {
+	var v GetUserListManageOrder
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptGetUserListManageOrder) SetTo(v GetUserListManageOrder) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptGetUserListManageOrder) Get() (v GetUserListManageOrder, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptGetUserListManageOrder) Or(d GetUserListManageOrder) GetUserListManageOrder {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptGetUserListManageOrderBy returns new OptGetUserListManageOrderBy with value set to v.
+func NewOptGetUserListManageOrderBy(v GetUserListManageOrderBy) OptGetUserListManageOrderBy {
+	return OptGetUserListManageOrderBy{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptGetUserListManageOrderBy is optional GetUserListManageOrderBy.
+type OptGetUserListManageOrderBy struct {
+	Value GetUserListManageOrderBy
+	Set   bool
+}
+
+// IsSet returns true if OptGetUserListManageOrderBy was set.
+func (o OptGetUserListManageOrderBy) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptGetUserListManageOrderBy) Reset() {
+	var v GetUserListManageOrderBy
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptGetUserListManageOrderBy) SetTo(v GetUserListManageOrderBy) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptGetUserListManageOrderBy) Get() (v GetUserListManageOrderBy, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptGetUserListManageOrderBy) Or(d GetUserListManageOrderBy) GetUserListManageOrderBy {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt32 returns new OptInt32 with value set to v.
+func NewOptInt32(v int32) OptInt32 {
+	return OptInt32{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt32 is optional int32.
+type OptInt32 struct {
+	Value int32
+	Set   bool
+}
+
+// IsSet returns true if OptInt32 was set.
+func (o OptInt32) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt32) Reset() {
+	var v int32
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt32) SetTo(v int32) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt32) Get() (v int32, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt32) Or(d int32) int32 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptLocation returns new OptLocation with value set to v.
+func NewOptLocation(v Location) OptLocation {
+	return OptLocation{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptLocation is optional Location.
+type OptLocation struct {
+	Value Location
+	Set   bool
+}
+
+// IsSet returns true if OptLocation was set.
+func (o OptLocation) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptLocation) Reset() {
+	var v Location
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptLocation) SetTo(v Location) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptLocation) Get() (v Location, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptLocation) Or(d Location) Location {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptMultipartFile returns new OptMultipartFile with value set to v.
+func NewOptMultipartFile(v ht.MultipartFile) OptMultipartFile {
+	return OptMultipartFile{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptMultipartFile is optional ht.MultipartFile.
+type OptMultipartFile struct {
+	Value ht.MultipartFile
+	Set   bool
+}
+
+// IsSet returns true if OptMultipartFile was set.
+func (o OptMultipartFile) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptMultipartFile) Reset() {
+	var v ht.MultipartFile
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptMultipartFile) SetTo(v ht.MultipartFile) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptMultipartFile) Get() (v ht.MultipartFile, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptMultipartFile) Or(d ht.MultipartFile) ht.MultipartFile {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilBool returns new OptNilBool with value set to v.
+func NewOptNilBool(v bool) OptNilBool {
+	return OptNilBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilBool is optional nullable bool.
+type OptNilBool struct {
+	Value bool
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilBool was set.
+func (o OptNilBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilBool) SetTo(v bool) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilBool) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilBool) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v bool
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilBool) Get() (v bool, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilFloat64 returns new OptNilFloat64 with value set to v.
+func NewOptNilFloat64(v float64) OptNilFloat64 {
+	return OptNilFloat64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilFloat64 is optional nullable float64.
+type OptNilFloat64 struct {
+	Value float64
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilFloat64 was set.
+func (o OptNilFloat64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilFloat64) Reset() {
+	var v float64
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilFloat64) SetTo(v float64) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilFloat64) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilFloat64) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v float64
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilFloat64) Get() (v float64, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilGetOpenedTalkSessionStatus returns new OptNilGetOpenedTalkSessionStatus with value set to v.
+func NewOptNilGetOpenedTalkSessionStatus(v GetOpenedTalkSessionStatus) OptNilGetOpenedTalkSessionStatus {
+	return OptNilGetOpenedTalkSessionStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilGetOpenedTalkSessionStatus is optional nullable GetOpenedTalkSessionStatus.
+type OptNilGetOpenedTalkSessionStatus struct {
+	Value GetOpenedTalkSessionStatus
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilGetOpenedTalkSessionStatus was set.
+func (o OptNilGetOpenedTalkSessionStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilGetOpenedTalkSessionStatus) Reset() {
+	var v GetOpenedTalkSessionStatus
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilGetOpenedTalkSessionStatus) SetTo(v GetOpenedTalkSessionStatus) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilGetOpenedTalkSessionStatus) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilGetOpenedTalkSessionStatus) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v GetOpenedTalkSessionStatus
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilGetOpenedTalkSessionStatus) Get() (v GetOpenedTalkSessionStatus, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilGetOpenedTalkSessionStatus) Or(d GetOpenedTalkSessionStatus) GetOpenedTalkSessionStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilGetOpinionsForTalkSessionSort returns new OptNilGetOpinionsForTalkSessionSort with value set to v.
+func NewOptNilGetOpinionsForTalkSessionSort(v GetOpinionsForTalkSessionSort) OptNilGetOpinionsForTalkSessionSort {
+	return OptNilGetOpinionsForTalkSessionSort{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilGetOpinionsForTalkSessionSort is optional nullable GetOpinionsForTalkSessionSort.
+type OptNilGetOpinionsForTalkSessionSort struct {
+	Value GetOpinionsForTalkSessionSort
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilGetOpinionsForTalkSessionSort was set.
+func (o OptNilGetOpinionsForTalkSessionSort) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilGetOpinionsForTalkSessionSort) Reset() {
+	var v GetOpinionsForTalkSessionSort
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilGetOpinionsForTalkSessionSort) SetTo(v GetOpinionsForTalkSessionSort) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilGetOpinionsForTalkSessionSort) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilGetOpinionsForTalkSessionSort) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v GetOpinionsForTalkSessionSort
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilGetOpinionsForTalkSessionSort) Get() (v GetOpinionsForTalkSessionSort, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilGetOpinionsForTalkSessionSort) Or(d GetOpinionsForTalkSessionSort) GetOpinionsForTalkSessionSort {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilGetTalkSessionListStatus returns new OptNilGetTalkSessionListStatus with value set to v.
+func NewOptNilGetTalkSessionListStatus(v GetTalkSessionListStatus) OptNilGetTalkSessionListStatus {
+	return OptNilGetTalkSessionListStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilGetTalkSessionListStatus is optional nullable GetTalkSessionListStatus.
+type OptNilGetTalkSessionListStatus struct {
+	Value GetTalkSessionListStatus
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilGetTalkSessionListStatus was set.
+func (o OptNilGetTalkSessionListStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilGetTalkSessionListStatus) Reset() {
+	var v GetTalkSessionListStatus
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilGetTalkSessionListStatus) SetTo(v GetTalkSessionListStatus) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilGetTalkSessionListStatus) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilGetTalkSessionListStatus) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v GetTalkSessionListStatus
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilGetTalkSessionListStatus) Get() (v GetTalkSessionListStatus, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilGetTalkSessionListStatus) Or(d GetTalkSessionListStatus) GetTalkSessionListStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilGetUserTalkSessionsStatus returns new OptNilGetUserTalkSessionsStatus with value set to v.
+func NewOptNilGetUserTalkSessionsStatus(v GetUserTalkSessionsStatus) OptNilGetUserTalkSessionsStatus {
+	return OptNilGetUserTalkSessionsStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilGetUserTalkSessionsStatus is optional nullable GetUserTalkSessionsStatus.
+type OptNilGetUserTalkSessionsStatus struct {
+	Value GetUserTalkSessionsStatus
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilGetUserTalkSessionsStatus was set.
+func (o OptNilGetUserTalkSessionsStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilGetUserTalkSessionsStatus) Reset() {
+	var v GetUserTalkSessionsStatus
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilGetUserTalkSessionsStatus) SetTo(v GetUserTalkSessionsStatus) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilGetUserTalkSessionsStatus) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilGetUserTalkSessionsStatus) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v GetUserTalkSessionsStatus
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilGetUserTalkSessionsStatus) Get() (v GetUserTalkSessionsStatus, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilGetUserTalkSessionsStatus) Or(d GetUserTalkSessionsStatus) GetUserTalkSessionsStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilInt returns new OptNilInt with value set to v.
+func NewOptNilInt(v int) OptNilInt {
+	return OptNilInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilInt is optional nullable int.
+type OptNilInt struct {
+	Value int
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilInt was set.
+func (o OptNilInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilInt) SetTo(v int) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilInt) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilInt) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v int
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilInt) Get() (v int, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilInt32 returns new OptNilInt32 with value set to v.
+func NewOptNilInt32(v int32) OptNilInt32 {
+	return OptNilInt32{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilInt32 is optional nullable int32.
+type OptNilInt32 struct {
+	Value int32
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilInt32 was set.
+func (o OptNilInt32) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilInt32) Reset() {
+	var v int32
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilInt32) SetTo(v int32) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilInt32) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilInt32) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v int32
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilInt32) Get() (v int32, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilInt32) Or(d int32) int32 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilOpinionsHistorySort returns new OptNilOpinionsHistorySort with value set to v.
+func NewOptNilOpinionsHistorySort(v OpinionsHistorySort) OptNilOpinionsHistorySort {
+	return OptNilOpinionsHistorySort{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilOpinionsHistorySort is optional nullable OpinionsHistorySort.
+type OptNilOpinionsHistorySort struct {
+	Value OpinionsHistorySort
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilOpinionsHistorySort was set.
+func (o OptNilOpinionsHistorySort) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilOpinionsHistorySort) Reset() {
+	var v OpinionsHistorySort
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilOpinionsHistorySort) SetTo(v OpinionsHistorySort) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilOpinionsHistorySort) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilOpinionsHistorySort) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v OpinionsHistorySort
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilOpinionsHistorySort) Get() (v OpinionsHistorySort, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilOpinionsHistorySort) Or(d OpinionsHistorySort) OpinionsHistorySort {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilOrganizationAlias returns new OptNilOrganizationAlias with value set to v.
+func NewOptNilOrganizationAlias(v OrganizationAlias) OptNilOrganizationAlias {
+	return OptNilOrganizationAlias{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilOrganizationAlias is optional nullable OrganizationAlias.
+type OptNilOrganizationAlias struct {
+	Value OrganizationAlias
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilOrganizationAlias was set.
+func (o OptNilOrganizationAlias) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilOrganizationAlias) Reset() {
+	var v OrganizationAlias
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilOrganizationAlias) SetTo(v OrganizationAlias) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilOrganizationAlias) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilOrganizationAlias) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v OrganizationAlias
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilOrganizationAlias) Get() (v OrganizationAlias, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilOrganizationAlias) Or(d OrganizationAlias) OrganizationAlias {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilSessionsHistoryStatus returns new OptNilSessionsHistoryStatus with value set to v.
+func NewOptNilSessionsHistoryStatus(v SessionsHistoryStatus) OptNilSessionsHistoryStatus {
+	return OptNilSessionsHistoryStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilSessionsHistoryStatus is optional nullable SessionsHistoryStatus.
+type OptNilSessionsHistoryStatus struct {
+	Value SessionsHistoryStatus
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilSessionsHistoryStatus was set.
+func (o OptNilSessionsHistoryStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilSessionsHistoryStatus) Reset() {
+	var v SessionsHistoryStatus
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilSessionsHistoryStatus) SetTo(v SessionsHistoryStatus) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilSessionsHistoryStatus) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilSessionsHistoryStatus) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v SessionsHistoryStatus
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilSessionsHistoryStatus) Get() (v SessionsHistoryStatus, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilSessionsHistoryStatus) Or(d SessionsHistoryStatus) SessionsHistoryStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilString returns new OptNilString with value set to v.
+func NewOptNilString(v string) OptNilString {
+	return OptNilString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilString is optional nullable string.
+type OptNilString struct {
+	Value string
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilString was set.
+func (o OptNilString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilString) SetTo(v string) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilString) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilString) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v string
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilString) Get() (v string, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilVoteType returns new OptNilVoteType with value set to v.
+func NewOptNilVoteType(v VoteType) OptNilVoteType {
+	return OptNilVoteType{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilVoteType is optional nullable VoteType.
+type OptNilVoteType struct {
+	Value VoteType
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilVoteType was set.
+func (o OptNilVoteType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilVoteType) Reset() {
+	var v VoteType
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilVoteType) SetTo(v VoteType) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilVoteType) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilVoteType) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v VoteType
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilVoteType) Get() (v VoteType, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilVoteType) Or(d VoteType) VoteType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptOrganization returns new OptOrganization with value set to v.
+func NewOptOrganization(v Organization) OptOrganization {
+	return OptOrganization{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptOrganization is optional Organization.
+type OptOrganization struct {
+	Value Organization
+	Set   bool
+}
+
+// IsSet returns true if OptOrganization was set.
+func (o OptOrganization) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptOrganization) Reset() {
+	var v Organization
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptOrganization) SetTo(v Organization) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptOrganization) Get() (v Organization, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptOrganization) Or(d Organization) Organization {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptOrganizationInvitation returns new OptOrganizationInvitation with value set to v.
+func NewOptOrganizationInvitation(v OrganizationInvitation) OptOrganizationInvitation {
+	return OptOrganizationInvitation{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptOrganizationInvitation is optional OrganizationInvitation.
+type OptOrganizationInvitation struct {
+	Value OrganizationInvitation
+	Set   bool
+}
+
+// IsSet returns true if OptOrganizationInvitation was set.
+func (o OptOrganizationInvitation) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptOrganizationInvitation) Reset() {
+	var v OrganizationInvitation
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptOrganizationInvitation) SetTo(v OrganizationInvitation) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptOrganizationInvitation) Get() (v OrganizationInvitation, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptOrganizationInvitation) Or(d OrganizationInvitation) OrganizationInvitation {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptReportSections returns new OptReportSections with value set to v.
+func NewOptReportSections(v ReportSections) OptReportSections {
+	return OptReportSections{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptReportSections is optional ReportSections.
+type OptReportSections struct {
+	Value ReportSections
+	Set   bool
+}
+
+// IsSet returns true if OptReportSections was set.
+func (o OptReportSections) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptReportSections) Reset() {
+	var v ReportSections
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptReportSections) SetTo(v ReportSections) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptReportSections) Get() (v ReportSections, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptReportSections) Or(d ReportSections) ReportSections {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptNilGetOpenedTalkSessionStatus) Or(d GetOpenedTalkSessionStatus) GetOpenedTalkSessionStatus {
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptURI returns new OptURI with value set to v.
+func NewOptURI(v url.URL) OptURI {
+	return OptURI{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptURI is optional url.URL.
+type OptURI struct {
+	Value url.URL
+	Set   bool
+}
+
+// IsSet returns true if OptURI was set.
+func (o OptURI) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptURI) Reset() {
+	var v url.URL
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptURI) SetTo(v url.URL) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptURI) Get() (v url.URL, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptURI) Or(d url.URL) url.URL {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUserGroupPosition returns new OptUserGroupPosition with value set to v.
+func NewOptUserGroupPosition(v UserGroupPosition) OptUserGroupPosition {
+	return OptUserGroupPosition{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUserGroupPosition is optional UserGroupPosition.
+type OptUserGroupPosition struct {
+	Value UserGroupPosition
+	Set   bool
+}
+
+// IsSet returns true if OptUserGroupPosition was set.
+func (o OptUserGroupPosition) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUserGroupPosition) Reset() {
+	var v UserGroupPosition
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUserGroupPosition) SetTo(v UserGroupPosition) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUserGroupPosition) Get() (v UserGroupPosition, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUserGroupPosition) Or(d UserGroupPosition) UserGroupPosition {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptWeightedVoteRates returns new OptWeightedVoteRates with value set to v.
+func NewOptWeightedVoteRates(v WeightedVoteRates) OptWeightedVoteRates {
+	return OptWeightedVoteRates{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptWeightedVoteRates is optional WeightedVoteRates.
+type OptWeightedVoteRates struct {
+	Value WeightedVoteRates
+	Set   bool
+}
+
+// IsSet returns true if OptWeightedVoteRates was set.
+func (o OptWeightedVoteRates) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptWeightedVoteRates) Reset() {
+	var v WeightedVoteRates
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptWeightedVoteRates) SetTo(v WeightedVoteRates) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptWeightedVoteRates) Get() (v WeightedVoteRates, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptWeightedVoteRates) Or(d WeightedVoteRates) WeightedVoteRates {
 	if v, ok := o.Get(); ok {
 		return v
 	}
-	return d
+	return d
+}
+
+// Ref: #/components/schemas/Organization
+type Organization struct {
+	// 組織ID.
+	ID string `json:"ID"`
+	// 組織名.
+	Name string `json:"name"`
+	// 組織コード.
+	Code string `json:"code"`
+	// 組織アイコンURL.
+	IconURL OptNilString `json:"iconURL"`
+	// 組織のタイプ.
+	Type int `json:"type"`
+	// ロールの名前.
+	RoleName string `json:"roleName"`
+	// ロール.
+	Role int `json:"role"`
+	// 親組織ID（最上位の組織の場合はnull）.
+	ParentID OptNilString `json:"parentID"`
+}
+
+// GetID returns the value of ID.
+func (s *Organization) GetID() string {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *Organization) GetName() string {
+	return s.Name
+}
+
+// GetCode returns the value of Code.
+func (s *Organization) GetCode() string {
+	return s.Code
+}
+
+// GetIconURL returns the value of IconURL.
+func (s *Organization) GetIconURL() OptNilString {
+	return s.IconURL
+}
+
+// GetType returns the value of Type.
+func (s *Organization) GetType() int {
+	return s.Type
+}
+
+// GetRoleName returns the value of RoleName.
+func (s *Organization) GetRoleName() string {
+	return s.RoleName
+}
+
+// GetRole returns the value of Role.
+func (s *Organization) GetRole() int {
+	return s.Role
+}
+
+// GetParentID returns the value of ParentID.
+func (s *Organization) GetParentID() OptNilString {
+	return s.ParentID
+}
+
+// SetID sets the value of ID.
+func (s *Organization) SetID(val string) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *Organization) SetName(val string) {
+	s.Name = val
+}
+
+// SetCode sets the value of Code.
+func (s *Organization) SetCode(val string) {
+	s.Code = val
+}
+
+// SetIconURL sets the value of IconURL.
+func (s *Organization) SetIconURL(val OptNilString) {
+	s.IconURL = val
+}
+
+// SetType sets the value of Type.
+func (s *Organization) SetType(val int) {
+	s.Type = val
+}
+
+// SetRoleName sets the value of RoleName.
+func (s *Organization) SetRoleName(val string) {
+	s.RoleName = val
+}
+
+// SetRole sets the value of Role.
+func (s *Organization) SetRole(val int) {
+	s.Role = val
+}
+
+// SetParentID sets the value of ParentID.
+func (s *Organization) SetParentID(val OptNilString) {
+	s.ParentID = val
+}
+
+func (*Organization) acceptOrganizationInvitationRes() {}
+func (*Organization) updateOrganizationParentRes()     {}
+
+// 組織エイリアス.
+// Ref: #/components/schemas/OrganizationAlias
+type OrganizationAlias struct {
+	AliasID   string       `json:"aliasID"`
+	AliasName string       `json:"aliasName"`
+	CreatedAt OptNilString `json:"createdAt"`
+}
+
+// GetAliasID returns the value of AliasID.
+func (s *OrganizationAlias) GetAliasID() string {
+	return s.AliasID
+}
+
+// GetAliasName returns the value of AliasName.
+func (s *OrganizationAlias) GetAliasName() string {
+	return s.AliasName
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *OrganizationAlias) GetCreatedAt() OptNilString {
+	return s.CreatedAt
+}
+
+// SetAliasID sets the value of AliasID.
+func (s *OrganizationAlias) SetAliasID(val string) {
+	s.AliasID = val
+}
+
+// SetAliasName sets the value of AliasName.
+func (s *OrganizationAlias) SetAliasName(val string) {
+	s.AliasName = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *OrganizationAlias) SetCreatedAt(val OptNilString) {
+	s.CreatedAt = val
+}
+
+func (*OrganizationAlias) createOrganizationAliasRes() {}
+
+// 組織APIキー.
+// Ref: #/components/schemas/OrganizationApiKey
+type OrganizationApiKey struct {
+	ApiKeyID string `json:"apiKeyID"`
+	Name     string `json:"name"`
+	// キーの先頭部分。キーを識別するために表示する.
+	KeyPrefix  string      `json:"keyPrefix"`
+	Scopes     []string    `json:"scopes"`
+	CreatedAt  time.Time   `json:"createdAt"`
+	ExpiresAt  OptDateTime `json:"expiresAt"`
+	LastUsedAt OptDateTime `json:"lastUsedAt"`
+	RevokedAt  OptDateTime `json:"revokedAt"`
+}
+
+// GetApiKeyID returns the value of ApiKeyID.
+func (s *OrganizationApiKey) GetApiKeyID() string {
+	return s.ApiKeyID
+}
+
+// GetName returns the value of Name.
+func (s *OrganizationApiKey) GetName() string {
+	return s.Name
+}
+
+// GetKeyPrefix returns the value of KeyPrefix.
+func (s *OrganizationApiKey) GetKeyPrefix() string {
+	return s.KeyPrefix
+}
+
+// GetScopes returns the value of Scopes.
+func (s *OrganizationApiKey) GetScopes() []string {
+	return s.Scopes
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *OrganizationApiKey) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *OrganizationApiKey) GetExpiresAt() OptDateTime {
+	return s.ExpiresAt
+}
+
+// GetLastUsedAt returns the value of LastUsedAt.
+func (s *OrganizationApiKey) GetLastUsedAt() OptDateTime {
+	return s.LastUsedAt
+}
+
+// GetRevokedAt returns the value of RevokedAt.
+func (s *OrganizationApiKey) GetRevokedAt() OptDateTime {
+	return s.RevokedAt
+}
+
+// SetApiKeyID sets the value of ApiKeyID.
+func (s *OrganizationApiKey) SetApiKeyID(val string) {
+	s.ApiKeyID = val
+}
+
+// SetName sets the value of Name.
+func (s *OrganizationApiKey) SetName(val string) {
+	s.Name = val
+}
+
+// SetKeyPrefix sets the value of KeyPrefix.
+func (s *OrganizationApiKey) SetKeyPrefix(val string) {
+	s.KeyPrefix = val
+}
+
+// SetScopes sets the value of Scopes.
+func (s *OrganizationApiKey) SetScopes(val []string) {
+	s.Scopes = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *OrganizationApiKey) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *OrganizationApiKey) SetExpiresAt(val OptDateTime) {
+	s.ExpiresAt = val
+}
+
+// SetLastUsedAt sets the value of LastUsedAt.
+func (s *OrganizationApiKey) SetLastUsedAt(val OptDateTime) {
+	s.LastUsedAt = val
+}
+
+// SetRevokedAt sets the value of RevokedAt.
+func (s *OrganizationApiKey) SetRevokedAt(val OptDateTime) {
+	s.RevokedAt = val
+}
+
+type OrganizationApiKeyAuth struct {
+	APIKey string
+	Roles  []string
+}
+
+// GetAPIKey returns the value of APIKey.
+func (s *OrganizationApiKeyAuth) GetAPIKey() string {
+	return s.APIKey
+}
+
+// GetRoles returns the value of Roles.
+func (s *OrganizationApiKeyAuth) GetRoles() []string {
+	return s.Roles
+}
+
+// SetAPIKey sets the value of APIKey.
+func (s *OrganizationApiKeyAuth) SetAPIKey(val string) {
+	s.APIKey = val
+}
+
+// SetRoles sets the value of Roles.
+func (s *OrganizationApiKeyAuth) SetRoles(val []string) {
+	s.Roles = val
+}
+
+// 組織の監査ログ.
+// Ref: #/components/schemas/OrganizationAuditLog
+type OrganizationAuditLog struct {
+	AuditLogID string `json:"auditLogID"`
+	// 操作したユーザーのID.
+	ActorID string `json:"actorID"`
+	// 操作の種類（organization.updated、organization.user_role_changed など）.
+	Action string `json:"action"`
+	// 操作対象の種類（organization, alias, user, invitation, api_key, talksession）.
+	TargetType string                       `json:"targetType"`
+	TargetID   string                       `json:"targetID"`
+	Changes    []OrganizationAuditLogChange `json:"changes"`
+	CreatedAt  time.Time                    `json:"createdAt"`
+}
+
+// GetAuditLogID returns the value of AuditLogID.
+func (s *OrganizationAuditLog) GetAuditLogID() string {
+	return s.AuditLogID
+}
+
+// GetActorID returns the value of ActorID.
+func (s *OrganizationAuditLog) GetActorID() string {
+	return s.ActorID
+}
+
+// GetAction returns the value of Action.
+func (s *OrganizationAuditLog) GetAction() string {
+	return s.Action
+}
+
+// GetTargetType returns the value of TargetType.
+func (s *OrganizationAuditLog) GetTargetType() string {
+	return s.TargetType
+}
+
+// GetTargetID returns the value of TargetID.
+func (s *OrganizationAuditLog) GetTargetID() string {
+	return s.TargetID
+}
+
+// GetChanges returns the value of Changes.
+func (s *OrganizationAuditLog) GetChanges() []OrganizationAuditLogChange {
+	return s.Changes
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *OrganizationAuditLog) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetAuditLogID sets the value of AuditLogID.
+func (s *OrganizationAuditLog) SetAuditLogID(val string) {
+	s.AuditLogID = val
+}
+
+// SetActorID sets the value of ActorID.
+func (s *OrganizationAuditLog) SetActorID(val string) {
+	s.ActorID = val
+}
+
+// SetAction sets the value of Action.
+func (s *OrganizationAuditLog) SetAction(val string) {
+	s.Action = val
+}
+
+// SetTargetType sets the value of TargetType.
+func (s *OrganizationAuditLog) SetTargetType(val string) {
+	s.TargetType = val
+}
+
+// SetTargetID sets the value of TargetID.
+func (s *OrganizationAuditLog) SetTargetID(val string) {
+	s.TargetID = val
+}
+
+// SetChanges sets the value of Changes.
+func (s *OrganizationAuditLog) SetChanges(val []OrganizationAuditLogChange) {
+	s.Changes = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *OrganizationAuditLog) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// 監査ログの項目ごとの変更前後の値。作成時のbefore、削除時のafterはnull.
+// Ref: #/components/schemas/OrganizationAuditLogChange
+type OrganizationAuditLogChange struct {
+	Field  string `json:"field"`
+	Before jx.Raw `json:"before"`
+	After  jx.Raw `json:"after"`
+}
+
+// GetField returns the value of Field.
+func (s *OrganizationAuditLogChange) GetField() string {
+	return s.Field
+}
+
+// GetBefore returns the value of Before.
+func (s *OrganizationAuditLogChange) GetBefore() jx.Raw {
+	return s.Before
+}
+
+// GetAfter returns the value of After.
+func (s *OrganizationAuditLogChange) GetAfter() jx.Raw {
+	return s.After
+}
+
+// SetField sets the value of Field.
+func (s *OrganizationAuditLogChange) SetField(val string) {
+	s.Field = val
+}
+
+// SetBefore sets the value of Before.
+func (s *OrganizationAuditLogChange) SetBefore(val jx.Raw) {
+	s.Before = val
+}
+
+// SetAfter sets the value of After.
+func (s *OrganizationAuditLogChange) SetAfter(val jx.Raw) {
+	s.After = val
+}
+
+// 組織への招待.
+// Ref: #/components/schemas/OrganizationInvitation
+type OrganizationInvitation struct {
+	InvitationID string `json:"invitationID"`
+	Email        string `json:"email"`
+	Role         int    `json:"role"`
+	RoleName     string `json:"roleName"`
+	// 招待の状態
+	// - pending: 未承諾
+	// - accepted: 承諾済み
+	// - revoked: 取り消し済み
+	// - expired: 期限切れ.
+	Status    OrganizationInvitationStatus `json:"status"`
+	ExpiresAt time.Time                    `json:"expiresAt"`
+	// 招待メールを送信した回数.
+	SendCount  int         `json:"sendCount"`
+	LastSentAt time.Time   `json:"lastSentAt"`
+	AcceptedAt OptDateTime `json:"acceptedAt"`
+	CreatedAt  time.Time   `json:"createdAt"`
+}
+
+// GetInvitationID returns the value of InvitationID.
+func (s *OrganizationInvitation) GetInvitationID() string {
+	return s.InvitationID
+}
+
+// GetEmail returns the value of Email.
+func (s *OrganizationInvitation) GetEmail() string {
+	return s.Email
+}
+
+// GetRole returns the value of Role.
+func (s *OrganizationInvitation) GetRole() int {
+	return s.Role
+}
+
+// GetRoleName returns the value of RoleName.
+func (s *OrganizationInvitation) GetRoleName() string {
+	return s.RoleName
+}
+
+// GetStatus returns the value of Status.
+func (s *OrganizationInvitation) GetStatus() OrganizationInvitationStatus {
+	return s.Status
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *OrganizationInvitation) GetExpiresAt() time.Time {
+	return s.ExpiresAt
+}
+
+// GetSendCount returns the value of SendCount.
+func (s *OrganizationInvitation) GetSendCount() int {
+	return s.SendCount
+}
+
+// GetLastSentAt returns the value of LastSentAt.
+func (s *OrganizationInvitation) GetLastSentAt() time.Time {
+	return s.LastSentAt
+}
+
+// GetAcceptedAt returns the value of AcceptedAt.
+func (s *OrganizationInvitation) GetAcceptedAt() OptDateTime {
+	return s.AcceptedAt
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *OrganizationInvitation) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetInvitationID sets the value of InvitationID.
+func (s *OrganizationInvitation) SetInvitationID(val string) {
+	s.InvitationID = val
+}
+
+// SetEmail sets the value of Email.
+func (s *OrganizationInvitation) SetEmail(val string) {
+	s.Email = val
+}
+
+// SetRole sets the value of Role.
+func (s *OrganizationInvitation) SetRole(val int) {
+	s.Role = val
+}
+
+// SetRoleName sets the value of RoleName.
+func (s *OrganizationInvitation) SetRoleName(val string) {
+	s.RoleName = val
+}
+
+// SetStatus sets the value of Status.
+func (s *OrganizationInvitation) SetStatus(val OrganizationInvitationStatus) {
+	s.Status = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *OrganizationInvitation) SetExpiresAt(val time.Time) {
+	s.ExpiresAt = val
+}
+
+// SetSendCount sets the value of SendCount.
+func (s *OrganizationInvitation) SetSendCount(val int) {
+	s.SendCount = val
+}
+
+// SetLastSentAt sets the value of LastSentAt.
+func (s *OrganizationInvitation) SetLastSentAt(val time.Time) {
+	s.LastSentAt = val
+}
+
+// SetAcceptedAt sets the value of AcceptedAt.
+func (s *OrganizationInvitation) SetAcceptedAt(val OptDateTime) {
+	s.AcceptedAt = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *OrganizationInvitation) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+func (*OrganizationInvitation) createOrganizationInvitationRes() {}
+func (*OrganizationInvitation) resendOrganizationInvitationRes() {}
+
+// CSVによる一括招待の各行の結果.
+// Ref: #/components/schemas/OrganizationInvitationBulkResult
+type OrganizationInvitationBulkResult struct {
+	// CSVの行番号（1始まり）.
+	Line       int                       `json:"line"`
+	Email      string                    `json:"email"`
+	Success    bool                      `json:"success"`
+	Invitation OptOrganizationInvitation `json:"invitation"`
+	// 失敗した場合の理由.
+	Message OptString `json:"message"`
+}
+
+// GetLine returns the value of Line.
+func (s *OrganizationInvitationBulkResult) GetLine() int {
+	return s.Line
+}
+
+// GetEmail returns the value of Email.
+func (s *OrganizationInvitationBulkResult) GetEmail() string {
+	return s.Email
+}
+
+// GetSuccess returns the value of Success.
+func (s *OrganizationInvitationBulkResult) GetSuccess() bool {
+	return s.Success
+}
+
+// GetInvitation returns the value of Invitation.
+func (s *OrganizationInvitationBulkResult) GetInvitation() OptOrganizationInvitation {
+	return s.Invitation
+}
+
+// GetMessage returns the value of Message.
+func (s *OrganizationInvitationBulkResult) GetMessage() OptString {
+	return s.Message
+}
+
+// SetLine sets the value of Line.
+func (s *OrganizationInvitationBulkResult) SetLine(val int) {
+	s.Line = val
+}
+
+// SetEmail sets the value of Email.
+func (s *OrganizationInvitationBulkResult) SetEmail(val string) {
+	s.Email = val
+}
+
+// SetSuccess sets the value of Success.
+func (s *OrganizationInvitationBulkResult) SetSuccess(val bool) {
+	s.Success = val
+}
+
+// SetInvitation sets the value of Invitation.
+func (s *OrganizationInvitationBulkResult) SetInvitation(val OptOrganizationInvitation) {
+	s.Invitation = val
+}
+
+// SetMessage sets the value of Message.
+func (s *OrganizationInvitationBulkResult) SetMessage(val OptString) {
+	s.Message = val
+}
+
+// 招待の状態
+// - pending: 未承諾
+// - accepted: 承諾済み
+// - revoked: 取り消し済み
+// - expired: 期限切れ.
+type OrganizationInvitationStatus string
+
+const (
+	OrganizationInvitationStatusPending  OrganizationInvitationStatus = "pending"
+	OrganizationInvitationStatusAccepted OrganizationInvitationStatus = "accepted"
+	OrganizationInvitationStatusRevoked  OrganizationInvitationStatus = "revoked"
+	OrganizationInvitationStatusExpired  OrganizationInvitationStatus = "expired"
+)
+
+// AllValues returns all OrganizationInvitationStatus values.
+func (OrganizationInvitationStatus) AllValues() []OrganizationInvitationStatus {
+	return []OrganizationInvitationStatus{
+		OrganizationInvitationStatusPending,
+		OrganizationInvitationStatusAccepted,
+		OrganizationInvitationStatusRevoked,
+		OrganizationInvitationStatusExpired,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s OrganizationInvitationStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case OrganizationInvitationStatusPending:
+		return []byte(s), nil
+	case OrganizationInvitationStatusAccepted:
+		return []byte(s), nil
+	case OrganizationInvitationStatusRevoked:
+		return []byte(s), nil
+	case OrganizationInvitationStatusExpired:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *OrganizationInvitationStatus) UnmarshalText(data []byte) error {
+	switch OrganizationInvitationStatus(data) {
+	case OrganizationInvitationStatusPending:
+		*s = OrganizationInvitationStatusPending
+		return nil
+	case OrganizationInvitationStatusAccepted:
+		*s = OrganizationInvitationStatusAccepted
+		return nil
+	case OrganizationInvitationStatusRevoked:
+		*s = OrganizationInvitationStatusRevoked
+		return nil
+	case OrganizationInvitationStatusExpired:
+		*s = OrganizationInvitationStatusExpired
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// 組織のレポート生成の指示.
+// Ref: #/components/schemas/OrganizationReportPrompt
+type OrganizationReportPrompt struct {
+	// LLMの役割。空の場合はdefaultSystemPromptを使う.
+	SystemPrompt string `json:"systemPrompt"`
+	// 文体や重視する観点など、組織ごとに追加する指示.
+	Instructions string `json:"instructions"`
+	// 既定のLLMの役割.
+	DefaultSystemPrompt string `json:"defaultSystemPrompt"`
+	// 最終更新日時。設定していない場合は省略.
+	UpdatedAt OptDateTime `json:"updatedAt"`
+}
+
+// GetSystemPrompt returns the value of SystemPrompt.
+func (s *OrganizationReportPrompt) GetSystemPrompt() string {
+	return s.SystemPrompt
+}
+
+// GetInstructions returns the value of Instructions.
+func (s *OrganizationReportPrompt) GetInstructions() string {
+	return s.Instructions
+}
+
+// GetDefaultSystemPrompt returns the value of DefaultSystemPrompt.
+func (s *OrganizationReportPrompt) GetDefaultSystemPrompt() string {
+	return s.DefaultSystemPrompt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *OrganizationReportPrompt) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
+}
+
+// SetSystemPrompt sets the value of SystemPrompt.
+func (s *OrganizationReportPrompt) SetSystemPrompt(val string) {
+	s.SystemPrompt = val
+}
+
+// SetInstructions sets the value of Instructions.
+func (s *OrganizationReportPrompt) SetInstructions(val string) {
+	s.Instructions = val
+}
+
+// SetDefaultSystemPrompt sets the value of DefaultSystemPrompt.
+func (s *OrganizationReportPrompt) SetDefaultSystemPrompt(val string) {
+	s.DefaultSystemPrompt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *OrganizationReportPrompt) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
+}
+
+func (*OrganizationReportPrompt) getOrganizationReportPromptRes()    {}
+func (*OrganizationReportPrompt) updateOrganizationReportPromptRes() {}
+
+// 組織のダッシュボード.
+// Ref: #/components/schemas/OrganizationStats
+type OrganizationStats struct {
+	Bucket OrganizationStatsBucket `json:"bucket"`
+	// 集計開始日（この日を含む）.
+	Since time.Time `json:"since"`
+	// 集計終了日（この日を含まない）.
+	Until   time.Time                 `json:"until"`
+	Totals  OrganizationStatsCounts   `json:"totals"`
+	Periods []OrganizationStatsPeriod `json:"periods"`
+}
+
+// GetBucket returns the value of Bucket.
+func (s *OrganizationStats) GetBucket() OrganizationStatsBucket {
+	return s.Bucket
+}
+
+// GetSince returns the value of Since.
+func (s *OrganizationStats) GetSince() time.Time {
+	return s.Since
+}
+
+// GetUntil returns the value of Until.
+func (s *OrganizationStats) GetUntil() time.Time {
+	return s.Until
+}
+
+// GetTotals returns the value of Totals.
+func (s *OrganizationStats) GetTotals() OrganizationStatsCounts {
+	return s.Totals
+}
+
+// GetPeriods returns the value of Periods.
+func (s *OrganizationStats) GetPeriods() []OrganizationStatsPeriod {
+	return s.Periods
+}
+
+// SetBucket sets the value of Bucket.
+func (s *OrganizationStats) SetBucket(val OrganizationStatsBucket) {
+	s.Bucket = val
+}
+
+// SetSince sets the value of Since.
+func (s *OrganizationStats) SetSince(val time.Time) {
+	s.Since = val
+}
+
+// SetUntil sets the value of Until.
+func (s *OrganizationStats) SetUntil(val time.Time) {
+	s.Until = val
+}
+
+// SetTotals sets the value of Totals.
+func (s *OrganizationStats) SetTotals(val OrganizationStatsCounts) {
+	s.Totals = val
+}
+
+// SetPeriods sets the value of Periods.
+func (s *OrganizationStats) SetPeriods(val []OrganizationStatsPeriod) {
+	s.Periods = val
+}
+
+func (*OrganizationStats) getOrganizationStatsRes() {}
+
+type OrganizationStatsBucket string
+
+const (
+	OrganizationStatsBucketDay   OrganizationStatsBucket = "day"
+	OrganizationStatsBucketWeek  OrganizationStatsBucket = "week"
+	OrganizationStatsBucketMonth OrganizationStatsBucket = "month"
+)
+
+// AllValues returns all OrganizationStatsBucket values.
+func (OrganizationStatsBucket) AllValues() []OrganizationStatsBucket {
+	return []OrganizationStatsBucket{
+		OrganizationStatsBucketDay,
+		OrganizationStatsBucketWeek,
+		OrganizationStatsBucketMonth,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s OrganizationStatsBucket) MarshalText() ([]byte, error) {
+	switch s {
+	case OrganizationStatsBucketDay:
+		return []byte(s), nil
+	case OrganizationStatsBucketWeek:
+		return []byte(s), nil
+	case OrganizationStatsBucketMonth:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *OrganizationStatsBucket) UnmarshalText(data []byte) error {
+	switch OrganizationStatsBucket(data) {
+	case OrganizationStatsBucketDay:
+		*s = OrganizationStatsBucketDay
+		return nil
+	case OrganizationStatsBucketWeek:
+		*s = OrganizationStatsBucketWeek
+		return nil
+	case OrganizationStatsBucketMonth:
+		*s = OrganizationStatsBucketMonth
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// 組織の集計値.
+// Ref: #/components/schemas/OrganizationStatsCounts
+type OrganizationStatsCounts struct {
+	// 作成されたセッション数.
+	TalkSessionCount int `json:"talkSessionCount"`
+	// 参加者数（意見の投稿または投票をしたユーザー数）.
+	ParticipantCount int `json:"participantCount"`
+	OpinionCount     int `json:"opinionCount"`
+	VoteCount        int `json:"voteCount"`
+	// 参加時の同意の完了数.
+	ConsentCount int `json:"consentCount"`
+	// レポートへのフィードバック数.
+	ReportFeedbackCount int `json:"reportFeedbackCount"`
+}
+
+// GetTalkSessionCount returns the value of TalkSessionCount.
+func (s *OrganizationStatsCounts) GetTalkSessionCount() int {
+	return s.TalkSessionCount
+}
+
+// GetParticipantCount returns the value of ParticipantCount.
+func (s *OrganizationStatsCounts) GetParticipantCount() int {
+	return s.ParticipantCount
+}
+
+// GetOpinionCount returns the value of OpinionCount.
+func (s *OrganizationStatsCounts) GetOpinionCount() int {
+	return s.OpinionCount
+}
+
+// GetVoteCount returns the value of VoteCount.
+func (s *OrganizationStatsCounts) GetVoteCount() int {
+	return s.VoteCount
+}
+
+// GetConsentCount returns the value of ConsentCount.
+func (s *OrganizationStatsCounts) GetConsentCount() int {
+	return s.ConsentCount
+}
+
+// GetReportFeedbackCount returns the value of ReportFeedbackCount.
+func (s *OrganizationStatsCounts) GetReportFeedbackCount() int {
+	return s.ReportFeedbackCount
+}
+
+// SetTalkSessionCount sets the value of TalkSessionCount.
+func (s *OrganizationStatsCounts) SetTalkSessionCount(val int) {
+	s.TalkSessionCount = val
+}
+
+// SetParticipantCount sets the value of ParticipantCount.
+func (s *OrganizationStatsCounts) SetParticipantCount(val int) {
+	s.ParticipantCount = val
+}
+
+// SetOpinionCount sets the value of OpinionCount.
+func (s *OrganizationStatsCounts) SetOpinionCount(val int) {
+	s.OpinionCount = val
+}
+
+// SetVoteCount sets the value of VoteCount.
+func (s *OrganizationStatsCounts) SetVoteCount(val int) {
+	s.VoteCount = val
+}
+
+// SetConsentCount sets the value of ConsentCount.
+func (s *OrganizationStatsCounts) SetConsentCount(val int) {
+	s.ConsentCount = val
+}
+
+// SetReportFeedbackCount sets the value of ReportFeedbackCount.
+func (s *OrganizationStatsCounts) SetReportFeedbackCount(val int) {
+	s.ReportFeedbackCount = val
+}
+
+// 集計単位ごとの組織の集計値.
+// Ref: #/components/schemas/OrganizationStatsPeriod
+type OrganizationStatsPeriod struct {
+	// 集計単位の開始日.
+	Date time.Time `json:"date"`
+	// 作成されたセッション数.
+	TalkSessionCount int `json:"talkSessionCount"`
+	// 参加者数（意見の投稿または投票をしたユーザー数）.
+	ParticipantCount int `json:"participantCount"`
+	OpinionCount     int `json:"opinionCount"`
+	VoteCount        int `json:"voteCount"`
+	// 参加時の同意の完了数.
+	ConsentCount int `json:"consentCount"`
+	// レポートへのフィードバック数.
+	ReportFeedbackCount int `json:"reportFeedbackCount"`
+}
+
+// GetDate returns the value of Date.
+func (s *OrganizationStatsPeriod) GetDate() time.Time {
+	return s.Date
+}
+
+// GetTalkSessionCount returns the value of TalkSessionCount.
+func (s *OrganizationStatsPeriod) GetTalkSessionCount() int {
+	return s.TalkSessionCount
+}
+
+// GetParticipantCount returns the value of ParticipantCount.
+func (s *OrganizationStatsPeriod) GetParticipantCount() int {
+	return s.ParticipantCount
+}
+
+// GetOpinionCount returns the value of OpinionCount.
+func (s *OrganizationStatsPeriod) GetOpinionCount() int {
+	return s.OpinionCount
+}
+
+// GetVoteCount returns the value of VoteCount.
+func (s *OrganizationStatsPeriod) GetVoteCount() int {
+	return s.VoteCount
+}
+
+// GetConsentCount returns the value of ConsentCount.
+func (s *OrganizationStatsPeriod) GetConsentCount() int {
+	return s.ConsentCount
+}
+
+// GetReportFeedbackCount returns the value of ReportFeedbackCount.
+func (s *OrganizationStatsPeriod) GetReportFeedbackCount() int {
+	return s.ReportFeedbackCount
+}
+
+// SetDate sets the value of Date.
+func (s *OrganizationStatsPeriod) SetDate(val time.Time) {
+	s.Date = val
+}
+
+// SetTalkSessionCount sets the value of TalkSessionCount.
+func (s *OrganizationStatsPeriod) SetTalkSessionCount(val int) {
+	s.TalkSessionCount = val
+}
+
+// SetParticipantCount sets the value of ParticipantCount.
+func (s *OrganizationStatsPeriod) SetParticipantCount(val int) {
+	s.ParticipantCount = val
+}
+
+// SetOpinionCount sets the value of OpinionCount.
+func (s *OrganizationStatsPeriod) SetOpinionCount(val int) {
+	s.OpinionCount = val
+}
+
+// SetVoteCount sets the value of VoteCount.
+func (s *OrganizationStatsPeriod) SetVoteCount(val int) {
+	s.VoteCount = val
+}
+
+// SetConsentCount sets the value of ConsentCount.
+func (s *OrganizationStatsPeriod) SetConsentCount(val int) {
+	s.ConsentCount = val
+}
+
+// SetReportFeedbackCount sets the value of ReportFeedbackCount.
+func (s *OrganizationStatsPeriod) SetReportFeedbackCount(val int) {
+	s.ReportFeedbackCount = val
+}
+
+// 組織ユーザー.
+// Ref: #/components/schemas/OrganizationUser
+type OrganizationUser struct {
+	UserID      string       `json:"userID"`
+	DisplayID   string       `json:"displayID"`
+	DisplayName string       `json:"displayName"`
+	IconURL     OptNilString `json:"iconURL"`
+	Role        int          `json:"role"`
+	RoleName    string       `json:"roleName"`
+}
+
+// GetUserID returns the value of UserID.
+func (s *OrganizationUser) GetUserID() string {
+	return s.UserID
+}
+
+// GetDisplayID returns the value of DisplayID.
+func (s *OrganizationUser) GetDisplayID() string {
+	return s.DisplayID
+}
+
+// GetDisplayName returns the value of DisplayName.
+func (s *OrganizationUser) GetDisplayName() string {
+	return s.DisplayName
+}
+
+// GetIconURL returns the value of IconURL.
+func (s *OrganizationUser) GetIconURL() OptNilString {
+	return s.IconURL
+}
+
+// GetRole returns the value of Role.
+func (s *OrganizationUser) GetRole() int {
+	return s.Role
+}
+
+// GetRoleName returns the value of RoleName.
+func (s *OrganizationUser) GetRoleName() string {
+	return s.RoleName
+}
+
+// SetUserID sets the value of UserID.
+func (s *OrganizationUser) SetUserID(val string) {
+	s.UserID = val
+}
+
+// SetDisplayID sets the value of DisplayID.
+func (s *OrganizationUser) SetDisplayID(val string) {
+	s.DisplayID = val
+}
+
+// SetDisplayName sets the value of DisplayName.
+func (s *OrganizationUser) SetDisplayName(val string) {
+	s.DisplayName = val
+}
+
+// SetIconURL sets the value of IconURL.
+func (s *OrganizationUser) SetIconURL(val OptNilString) {
+	s.IconURL = val
+}
+
+// SetRole sets the value of Role.
+func (s *OrganizationUser) SetRole(val int) {
+	s.Role = val
+}
+
+// SetRoleName sets the value of RoleName.
+func (s *OrganizationUser) SetRoleName(val string) {
+	s.RoleName = val
+}
+
+// オーナー権限の譲渡依頼.
+// Ref: #/components/schemas/OwnershipTransfer
+type OwnershipTransfer struct {
+	TransferID       string                      `json:"transferID"`
+	TargetType       OwnershipTransferTargetType `json:"targetType"`
+	TalkSessionID    OptNilString                `json:"talkSessionID"`
+	OrganizationCode OptNilString                `json:"organizationCode"`
+	// セッションのテーマか組織名.
+	TargetName string                  `json:"targetName"`
+	FromUser   User                    `json:"fromUser"`
+	ToUser     User                    `json:"toUser"`
+	Status     OwnershipTransferStatus `json:"status"`
+	// 承諾期限.
+	ExpiresAt   string       `json:"expiresAt"`
+	RespondedAt OptNilString `json:"respondedAt"`
+	CreatedAt   string       `json:"createdAt"`
+}
+
+// GetTransferID returns the value of TransferID.
+func (s *OwnershipTransfer) GetTransferID() string {
+	return s.TransferID
+}
+
+// GetTargetType returns the value of TargetType.
+func (s *OwnershipTransfer) GetTargetType() OwnershipTransferTargetType {
+	return s.TargetType
 }
 
-// NewOptNilGetOpinionsForTalkSessionSort returns new OptNilGetOpinionsForTalkSessionSort with value set to v.
-func NewOptNilGetOpinionsForTalkSessionSort(v GetOpinionsForTalkSessionSort) OptNilGetOpinionsForTalkSessionSort {
-	return OptNilGetOpinionsForTalkSessionSort{
-		Value: v,
-		Set:   true,
-	}
+// GetTalkSessionID returns the value of TalkSessionID.
+func (s *OwnershipTransfer) GetTalkSessionID() OptNilString {
+	return s.TalkSessionID
 }
 
-// OptNilGetOpinionsForTalkSessionSort is optional nullable GetOpinionsForTalkSessionSort.
-type OptNilGetOpinionsForTalkSessionSort struct {
-	Value GetOpinionsForTalkSessionSort
-	Set   bool
-	Null  bool
+// GetOrganizationCode returns the value of OrganizationCode.
+func (s *OwnershipTransfer) GetOrganizationCode() OptNilString {
+	return s.OrganizationCode
 }
 
-// IsSet returns true if OptNilGetOpinionsForTalkSessionSort was set.
-func (o OptNilGetOpinionsForTalkSessionSort) IsSet() bool { return o.Set }
+// GetTargetName returns the value of TargetName.
+func (s *OwnershipTransfer) GetTargetName() string {
+	return s.TargetName
+}
 
-// Reset unsets value.
-func (o *OptNilGetOpinionsForTalkSessionSort) Reset() {
-	var v GetOpinionsForTalkSessionSort
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// GetFromUser returns the value of FromUser.
+func (s *OwnershipTransfer) GetFromUser() User {
+	return s.FromUser
 }
 
-// SetTo sets value to v.
-func (o *OptNilGetOpinionsForTalkSessionSort) SetTo(v GetOpinionsForTalkSessionSort) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// GetToUser returns the value of ToUser.
+func (s *OwnershipTransfer) GetToUser() User {
+	return s.ToUser
 }
 
-// IsNull returns true if value is Null.
-func (o OptNilGetOpinionsForTalkSessionSort) IsNull() bool { return o.Null }
+// GetStatus returns the value of Status.
+func (s *OwnershipTransfer) GetStatus() OwnershipTransferStatus {
+	return s.Status
+}
 
-// SetToNull sets value to null.
-func (o *OptNilGetOpinionsForTalkSessionSort) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v GetOpinionsForTalkSessionSort
-	o.Value = v
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *OwnershipTransfer) GetExpiresAt() string {
+	return s.ExpiresAt
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilGetOpinionsForTalkSessionSort) Get() (v GetOpinionsForTalkSessionSort, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetRespondedAt returns the value of RespondedAt.
+func (s *OwnershipTransfer) GetRespondedAt() OptNilString {
+	return s.RespondedAt
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilGetOpinionsForTalkSessionSort) Or(d GetOpinionsForTalkSessionSort) GetOpinionsForTalkSessionSort {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetCreatedAt returns the value of CreatedAt.
+func (s *OwnershipTransfer) GetCreatedAt() string {
+	return s.CreatedAt
 }
 
-// NewOptNilGetTalkSessionListStatus returns new OptNilGetTalkSessionListStatus with value set to v.
-func NewOptNilGetTalkSessionListStatus(v GetTalkSessionListStatus) OptNilGetTalkSessionListStatus {
-	return OptNilGetTalkSessionListStatus{
-		Value: v,
-		Set:   true,
-	}
+// SetTransferID sets the value of TransferID.
+func (s *OwnershipTransfer) SetTransferID(val string) {
+	s.TransferID = val
 }
 
-// OptNilGetTalkSessionListStatus is optional nullable GetTalkSessionListStatus.
-type OptNilGetTalkSessionListStatus struct {
-	Value GetTalkSessionListStatus
-	Set   bool
-	Null  bool
+// SetTargetType sets the value of TargetType.
+func (s *OwnershipTransfer) SetTargetType(val OwnershipTransferTargetType) {
+	s.TargetType = val
 }
 
-// IsSet returns true if OptNilGetTalkSessionListStatus was set.
-func (o OptNilGetTalkSessionListStatus) IsSet() bool { return o.Set }
+// SetTalkSessionID sets the value of TalkSessionID.
+func (s *OwnershipTransfer) SetTalkSessionID(val OptNilString) {
+	s.TalkSessionID = val
+}
 
-// Reset unsets value.
-func (o *OptNilGetTalkSessionListStatus) Reset() {
-	var v GetTalkSessionListStatus
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// SetOrganizationCode sets the value of OrganizationCode.
+func (s *OwnershipTransfer) SetOrganizationCode(val OptNilString) {
+	s.OrganizationCode = val
 }
 
-// SetTo sets value to v.
-func (o *OptNilGetTalkSessionListStatus) SetTo(v GetTalkSessionListStatus) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// SetTargetName sets the value of TargetName.
+func (s *OwnershipTransfer) SetTargetName(val string) {
+	s.TargetName = val
 }
 
-// IsNull returns true if value is Null.
-func (o OptNilGetTalkSessionListStatus) IsNull() bool { return o.Null }
+// SetFromUser sets the value of FromUser.
+func (s *OwnershipTransfer) SetFromUser(val User) {
+	s.FromUser = val
+}
 
-// SetToNull sets value to null.
-func (o *OptNilGetTalkSessionListStatus) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v GetTalkSessionListStatus
-	o.Value = v
+// SetToUser sets the value of ToUser.
+func (s *OwnershipTransfer) SetToUser(val User) {
+	s.ToUser = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilGetTalkSessionListStatus) Get() (v GetTalkSessionListStatus, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
+// SetStatus sets the value of Status.
+func (s *OwnershipTransfer) SetStatus(val OwnershipTransferStatus) {
+	s.Status = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *OwnershipTransfer) SetExpiresAt(val string) {
+	s.ExpiresAt = val
+}
+
+// SetRespondedAt sets the value of RespondedAt.
+func (s *OwnershipTransfer) SetRespondedAt(val OptNilString) {
+	s.RespondedAt = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *OwnershipTransfer) SetCreatedAt(val string) {
+	s.CreatedAt = val
+}
+
+func (*OwnershipTransfer) acceptOwnershipTransferRes()              {}
+func (*OwnershipTransfer) cancelOwnershipTransferRes()              {}
+func (*OwnershipTransfer) declineOwnershipTransferRes()             {}
+func (*OwnershipTransfer) requestOrganizationOwnershipTransferRes() {}
+func (*OwnershipTransfer) requestTalkSessionOwnershipTransferRes()  {}
+
+type OwnershipTransferStatus string
+
+const (
+	OwnershipTransferStatusPending   OwnershipTransferStatus = "pending"
+	OwnershipTransferStatusAccepted  OwnershipTransferStatus = "accepted"
+	OwnershipTransferStatusDeclined  OwnershipTransferStatus = "declined"
+	OwnershipTransferStatusCancelled OwnershipTransferStatus = "cancelled"
+	OwnershipTransferStatusExpired   OwnershipTransferStatus = "expired"
+)
+
+// AllValues returns all OwnershipTransferStatus values.
+func (OwnershipTransferStatus) AllValues() []OwnershipTransferStatus {
+	return []OwnershipTransferStatus{
+		OwnershipTransferStatusPending,
+		OwnershipTransferStatusAccepted,
+		OwnershipTransferStatusDeclined,
+		OwnershipTransferStatusCancelled,
+		OwnershipTransferStatusExpired,
 	}
-	return o.Value, true
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilGetTalkSessionListStatus) Or(d GetTalkSessionListStatus) GetTalkSessionListStatus {
-	if v, ok := o.Get(); ok {
-		return v
+// MarshalText implements encoding.TextMarshaler.
+func (s OwnershipTransferStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case OwnershipTransferStatusPending:
+		return []byte(s), nil
+	case OwnershipTransferStatusAccepted:
+		return []byte(s), nil
+	case OwnershipTransferStatusDeclined:
+		return []byte(s), nil
+	case OwnershipTransferStatusCancelled:
+		return []byte(s), nil
+	case OwnershipTransferStatusExpired:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
-	return d
 }
 
-// NewOptNilGetUserTalkSessionsStatus returns new OptNilGetUserTalkSessionsStatus with value set to v.
-func NewOptNilGetUserTalkSessionsStatus(v GetUserTalkSessionsStatus) OptNilGetUserTalkSessionsStatus {
-	return OptNilGetUserTalkSessionsStatus{
-		Value: v,
-		Set:   true,
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *OwnershipTransferStatus) UnmarshalText(data []byte) error {
+	switch OwnershipTransferStatus(data) {
+	case OwnershipTransferStatusPending:
+		*s = OwnershipTransferStatusPending
+		return nil
+	case OwnershipTransferStatusAccepted:
+		*s = OwnershipTransferStatusAccepted
+		return nil
+	case OwnershipTransferStatusDeclined:
+		*s = OwnershipTransferStatusDeclined
+		return nil
+	case OwnershipTransferStatusCancelled:
+		*s = OwnershipTransferStatusCancelled
+		return nil
+	case OwnershipTransferStatusExpired:
+		*s = OwnershipTransferStatusExpired
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// OptNilGetUserTalkSessionsStatus is optional nullable GetUserTalkSessionsStatus.
-type OptNilGetUserTalkSessionsStatus struct {
-	Value GetUserTalkSessionsStatus
-	Set   bool
-	Null  bool
+type OwnershipTransferTargetType string
+
+const (
+	OwnershipTransferTargetTypeTalksession  OwnershipTransferTargetType = "talksession"
+	OwnershipTransferTargetTypeOrganization OwnershipTransferTargetType = "organization"
+)
+
+// AllValues returns all OwnershipTransferTargetType values.
+func (OwnershipTransferTargetType) AllValues() []OwnershipTransferTargetType {
+	return []OwnershipTransferTargetType{
+		OwnershipTransferTargetTypeTalksession,
+		OwnershipTransferTargetTypeOrganization,
+	}
 }
 
-// IsSet returns true if OptNilGetUserTalkSessionsStatus was set.
-func (o OptNilGetUserTalkSessionsStatus) IsSet() bool { return o.Set }
+// MarshalText implements encoding.TextMarshaler.
+func (s OwnershipTransferTargetType) MarshalText() ([]byte, error) {
+	switch s {
+	case OwnershipTransferTargetTypeTalksession:
+		return []byte(s), nil
+	case OwnershipTransferTargetTypeOrganization:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
 
-// Reset unsets value.
-func (o *OptNilGetUserTalkSessionsStatus) Reset() {
-	var v GetUserTalkSessionsStatus
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *OwnershipTransferTargetType) UnmarshalText(data []byte) error {
+	switch OwnershipTransferTargetType(data) {
+	case OwnershipTransferTargetTypeTalksession:
+		*s = OwnershipTransferTargetTypeTalksession
+		return nil
+	case OwnershipTransferTargetTypeOrganization:
+		*s = OwnershipTransferTargetTypeOrganization
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// SetTo sets value to v.
-func (o *OptNilGetUserTalkSessionsStatus) SetTo(v GetUserTalkSessionsStatus) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+type PasswordLoginBadRequest struct{}
+
+func (*PasswordLoginBadRequest) passwordLoginRes() {}
+
+type PasswordLoginInternalServerError struct{}
+
+func (*PasswordLoginInternalServerError) passwordLoginRes() {}
+
+type PasswordLoginOK struct{}
+
+func (*PasswordLoginOK) passwordLoginRes() {}
+
+type PasswordLoginReq struct {
+	IdOrEmail string `json:"idOrEmail"`
+	Password  string `json:"password"`
 }
 
-// IsNull returns true if value is Null.
-func (o OptNilGetUserTalkSessionsStatus) IsNull() bool { return o.Null }
+// GetIdOrEmail returns the value of IdOrEmail.
+func (s *PasswordLoginReq) GetIdOrEmail() string {
+	return s.IdOrEmail
+}
 
-// SetToNull sets value to null.
-func (o *OptNilGetUserTalkSessionsStatus) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v GetUserTalkSessionsStatus
-	o.Value = v
+// GetPassword returns the value of Password.
+func (s *PasswordLoginReq) GetPassword() string {
+	return s.Password
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilGetUserTalkSessionsStatus) Get() (v GetUserTalkSessionsStatus, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetIdOrEmail sets the value of IdOrEmail.
+func (s *PasswordLoginReq) SetIdOrEmail(val string) {
+	s.IdOrEmail = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilGetUserTalkSessionsStatus) Or(d GetUserTalkSessionsStatus) GetUserTalkSessionsStatus {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetPassword sets the value of Password.
+func (s *PasswordLoginReq) SetPassword(val string) {
+	s.Password = val
 }
 
-// NewOptNilInt returns new OptNilInt with value set to v.
-func NewOptNilInt(v int) OptNilInt {
-	return OptNilInt{
-		Value: v,
-		Set:   true,
-	}
+type PasswordRegisterBadRequest struct{}
+
+func (*PasswordRegisterBadRequest) passwordRegisterRes() {}
+
+type PasswordRegisterInternalServerError struct{}
+
+func (*PasswordRegisterInternalServerError) passwordRegisterRes() {}
+
+type PasswordRegisterOK struct{}
+
+func (*PasswordRegisterOK) passwordRegisterRes() {}
+
+type PasswordRegisterReq struct {
+	Password string `json:"password"`
+	Email    string `json:"email"`
 }
 
-// OptNilInt is optional nullable int.
-type OptNilInt struct {
-	Value int
-	Set   bool
-	Null  bool
+// GetPassword returns the value of Password.
+func (s *PasswordRegisterReq) GetPassword() string {
+	return s.Password
 }
 
-// IsSet returns true if OptNilInt was set.
-func (o OptNilInt) IsSet() bool { return o.Set }
+// GetEmail returns the value of Email.
+func (s *PasswordRegisterReq) GetEmail() string {
+	return s.Email
+}
 
-// Reset unsets value.
-func (o *OptNilInt) Reset() {
-	var v int
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// SetPassword sets the value of Password.
+func (s *PasswordRegisterReq) SetPassword(val string) {
+	s.Password = val
 }
 
-// SetTo sets value to v.
-func (o *OptNilInt) SetTo(v int) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// SetEmail sets the value of Email.
+func (s *PasswordRegisterReq) SetEmail(val string) {
+	s.Email = val
 }
 
-// IsNull returns true if value is Null.
-func (o OptNilInt) IsNull() bool { return o.Null }
+type PolicyConsentBadRequest struct{}
 
-// SetToNull sets value to null.
-func (o *OptNilInt) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v int
-	o.Value = v
-}
+func (*PolicyConsentBadRequest) policyConsentRes() {}
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilInt) Get() (v int, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
-}
+type PolicyConsentInternalServerError struct{}
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilInt) Or(d int) int {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
-}
+func (*PolicyConsentInternalServerError) policyConsentRes() {}
 
-// NewOptNilOpinionsHistorySort returns new OptNilOpinionsHistorySort with value set to v.
-func NewOptNilOpinionsHistorySort(v OpinionsHistorySort) OptNilOpinionsHistorySort {
-	return OptNilOpinionsHistorySort{
-		Value: v,
-		Set:   true,
-	}
+type PolicyConsentReq struct {
+	PolicyVersion string `json:"policyVersion"`
 }
 
-// OptNilOpinionsHistorySort is optional nullable OpinionsHistorySort.
-type OptNilOpinionsHistorySort struct {
-	Value OpinionsHistorySort
-	Set   bool
-	Null  bool
+// GetPolicyVersion returns the value of PolicyVersion.
+func (s *PolicyConsentReq) GetPolicyVersion() string {
+	return s.PolicyVersion
 }
 
-// IsSet returns true if OptNilOpinionsHistorySort was set.
-func (o OptNilOpinionsHistorySort) IsSet() bool { return o.Set }
-
-// Reset unsets value.
-func (o *OptNilOpinionsHistorySort) Reset() {
-	var v OpinionsHistorySort
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// SetPolicyVersion sets the value of PolicyVersion.
+func (s *PolicyConsentReq) SetPolicyVersion(val string) {
+	s.PolicyVersion = val
 }
 
-// SetTo sets value to v.
-func (o *OptNilOpinionsHistorySort) SetTo(v OpinionsHistorySort) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// Ref: #/components/schemas/PolicyConsentStatus
+type PolicyConsentStatus struct {
+	// 最新ポリシーのバージョン.
+	PolicyVersion string `json:"policyVersion"`
+	// 同意した日時.
+	ConsentedAt OptNilString `json:"consentedAt"`
+	// 同意したか.
+	ConsentGiven bool `json:"consentGiven"`
 }
 
-// IsNull returns true if value is Null.
-func (o OptNilOpinionsHistorySort) IsNull() bool { return o.Null }
+// GetPolicyVersion returns the value of PolicyVersion.
+func (s *PolicyConsentStatus) GetPolicyVersion() string {
+	return s.PolicyVersion
+}
 
-// SetToNull sets value to null.
-func (o *OptNilOpinionsHistorySort) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v OpinionsHistorySort
-	o.Value = v
+// GetConsentedAt returns the value of ConsentedAt.
+func (s *PolicyConsentStatus) GetConsentedAt() OptNilString {
+	return s.ConsentedAt
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilOpinionsHistorySort) Get() (v OpinionsHistorySort, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetConsentGiven returns the value of ConsentGiven.
+func (s *PolicyConsentStatus) GetConsentGiven() bool {
+	return s.ConsentGiven
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilOpinionsHistorySort) Or(d OpinionsHistorySort) OpinionsHistorySort {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetPolicyVersion sets the value of PolicyVersion.
+func (s *PolicyConsentStatus) SetPolicyVersion(val string) {
+	s.PolicyVersion = val
 }
 
-// NewOptNilOrganizationAlias returns new OptNilOrganizationAlias with value set to v.
-func NewOptNilOrganizationAlias(v OrganizationAlias) OptNilOrganizationAlias {
-	return OptNilOrganizationAlias{
-		Value: v,
-		Set:   true,
-	}
+// SetConsentedAt sets the value of ConsentedAt.
+func (s *PolicyConsentStatus) SetConsentedAt(val OptNilString) {
+	s.ConsentedAt = val
 }
 
-// OptNilOrganizationAlias is optional nullable OrganizationAlias.
-type OptNilOrganizationAlias struct {
-	Value OrganizationAlias
-	Set   bool
-	Null  bool
+// SetConsentGiven sets the value of ConsentGiven.
+func (s *PolicyConsentStatus) SetConsentGiven(val bool) {
+	s.ConsentGiven = val
 }
 
-// IsSet returns true if OptNilOrganizationAlias was set.
-func (o OptNilOrganizationAlias) IsSet() bool { return o.Set }
+func (*PolicyConsentStatus) getPolicyConsentStatusRes() {}
+func (*PolicyConsentStatus) policyConsentRes()          {}
 
-// Reset unsets value.
-func (o *OptNilOrganizationAlias) Reset() {
-	var v OrganizationAlias
-	o.Value = v
-	o.Set = false
-	o.Null = false
-}
+type PostConclusionBadRequest struct{}
 
-// SetTo sets value to v.
-func (o *OptNilOrganizationAlias) SetTo(v OrganizationAlias) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
-}
+func (*PostConclusionBadRequest) postConclusionRes() {}
 
-// IsNull returns true if value is Null.
-func (o OptNilOrganizationAlias) IsNull() bool { return o.Null }
+type PostConclusionInternalServerError struct{}
 
-// SetToNull sets value to null.
-func (o *OptNilOrganizationAlias) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v OrganizationAlias
-	o.Value = v
-}
+func (*PostConclusionInternalServerError) postConclusionRes() {}
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilOrganizationAlias) Get() (v OrganizationAlias, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+type PostConclusionReq struct {
+	Content string `json:"content"`
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilOrganizationAlias) Or(d OrganizationAlias) OrganizationAlias {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetContent returns the value of Content.
+func (s *PostConclusionReq) GetContent() string {
+	return s.Content
 }
 
-// NewOptNilSessionsHistoryStatus returns new OptNilSessionsHistoryStatus with value set to v.
-func NewOptNilSessionsHistoryStatus(v SessionsHistoryStatus) OptNilSessionsHistoryStatus {
-	return OptNilSessionsHistoryStatus{
-		Value: v,
-		Set:   true,
-	}
+// SetContent sets the value of Content.
+func (s *PostConclusionReq) SetContent(val string) {
+	s.Content = val
 }
 
-// OptNilSessionsHistoryStatus is optional nullable SessionsHistoryStatus.
-type OptNilSessionsHistoryStatus struct {
-	Value SessionsHistoryStatus
-	Set   bool
-	Null  bool
-}
+type PostImageBadRequest struct{}
 
-// IsSet returns true if OptNilSessionsHistoryStatus was set.
-func (o OptNilSessionsHistoryStatus) IsSet() bool { return o.Set }
+func (*PostImageBadRequest) postImageRes() {}
 
-// Reset unsets value.
-func (o *OptNilSessionsHistoryStatus) Reset() {
-	var v SessionsHistoryStatus
-	o.Value = v
-	o.Set = false
-	o.Null = false
-}
+type PostImageInternalServerError struct{}
 
-// SetTo sets value to v.
-func (o *OptNilSessionsHistoryStatus) SetTo(v SessionsHistoryStatus) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+func (*PostImageInternalServerError) postImageRes() {}
+
+type PostImageOK struct {
+	URL string `json:"url"`
 }
 
-// IsNull returns true if value is Null.
-func (o OptNilSessionsHistoryStatus) IsNull() bool { return o.Null }
+// GetURL returns the value of URL.
+func (s *PostImageOK) GetURL() string {
+	return s.URL
+}
 
-// SetToNull sets value to null.
-func (o *OptNilSessionsHistoryStatus) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v SessionsHistoryStatus
-	o.Value = v
+// SetURL sets the value of URL.
+func (s *PostImageOK) SetURL(val string) {
+	s.URL = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilSessionsHistoryStatus) Get() (v SessionsHistoryStatus, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+func (*PostImageOK) postImageRes() {}
+
+type PostImageReq struct {
+	Image ht.MultipartFile `json:"image"`
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilSessionsHistoryStatus) Or(d SessionsHistoryStatus) SessionsHistoryStatus {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetImage returns the value of Image.
+func (s *PostImageReq) GetImage() ht.MultipartFile {
+	return s.Image
 }
 
-// NewOptNilString returns new OptNilString with value set to v.
-func NewOptNilString(v string) OptNilString {
-	return OptNilString{
-		Value: v,
-		Set:   true,
-	}
+// SetImage sets the value of Image.
+func (s *PostImageReq) SetImage(val ht.MultipartFile) {
+	s.Image = val
 }
 
-// OptNilString is optional nullable string.
-type OptNilString struct {
-	Value string
-	Set   bool
-	Null  bool
+type PostOpinionPost2BadRequest struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
 }
 
-// IsSet returns true if OptNilString was set.
-func (o OptNilString) IsSet() bool { return o.Set }
+// GetCode returns the value of Code.
+func (s *PostOpinionPost2BadRequest) GetCode() string {
+	return s.Code
+}
 
-// Reset unsets value.
-func (o *OptNilString) Reset() {
-	var v string
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// GetMessage returns the value of Message.
+func (s *PostOpinionPost2BadRequest) GetMessage() string {
+	return s.Message
 }
 
-// SetTo sets value to v.
-func (o *OptNilString) SetTo(v string) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// SetCode sets the value of Code.
+func (s *PostOpinionPost2BadRequest) SetCode(val string) {
+	s.Code = val
 }
 
-// IsNull returns true if value is Null.
-func (o OptNilString) IsNull() bool { return o.Null }
+// SetMessage sets the value of Message.
+func (s *PostOpinionPost2BadRequest) SetMessage(val string) {
+	s.Message = val
+}
 
-// SetToNull sets value to null.
-func (o *OptNilString) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v string
-	o.Value = v
+func (*PostOpinionPost2BadRequest) postOpinionPost2Res() {}
+
+type PostOpinionPost2InternalServerError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilString) Get() (v string, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetCode returns the value of Code.
+func (s *PostOpinionPost2InternalServerError) GetCode() string {
+	return s.Code
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilString) Or(d string) string {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetMessage returns the value of Message.
+func (s *PostOpinionPost2InternalServerError) GetMessage() string {
+	return s.Message
 }
 
-// NewOptNilVoteType returns new OptNilVoteType with value set to v.
-func NewOptNilVoteType(v VoteType) OptNilVoteType {
-	return OptNilVoteType{
-		Value: v,
-		Set:   true,
-	}
+// SetCode sets the value of Code.
+func (s *PostOpinionPost2InternalServerError) SetCode(val string) {
+	s.Code = val
 }
 
-// OptNilVoteType is optional nullable VoteType.
-type OptNilVoteType struct {
-	Value VoteType
-	Set   bool
-	Null  bool
+// SetMessage sets the value of Message.
+func (s *PostOpinionPost2InternalServerError) SetMessage(val string) {
+	s.Message = val
 }
 
-// IsSet returns true if OptNilVoteType was set.
-func (o OptNilVoteType) IsSet() bool { return o.Set }
+func (*PostOpinionPost2InternalServerError) postOpinionPost2Res() {}
 
-// Reset unsets value.
-func (o *OptNilVoteType) Reset() {
-	var v VoteType
-	o.Value = v
-	o.Set = false
-	o.Null = false
+type PostOpinionPost2Req struct {
+	TalkSessionID   OptString        `json:"talkSessionID"`
+	ParentOpinionID OptString        `json:"parentOpinionID"`
+	Title           OptString        `json:"title"`
+	OpinionContent  string           `json:"opinionContent"`
+	ReferenceURL    OptString        `json:"referenceURL"`
+	Picture         OptMultipartFile `json:"picture"`
+	IsSeed          OptNilBool       `json:"isSeed"`
 }
 
-// SetTo sets value to v.
-func (o *OptNilVoteType) SetTo(v VoteType) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// GetTalkSessionID returns the value of TalkSessionID.
+func (s *PostOpinionPost2Req) GetTalkSessionID() OptString {
+	return s.TalkSessionID
 }
 
-// IsNull returns true if value is Null.
-func (o OptNilVoteType) IsNull() bool { return o.Null }
+// GetParentOpinionID returns the value of ParentOpinionID.
+func (s *PostOpinionPost2Req) GetParentOpinionID() OptString {
+	return s.ParentOpinionID
+}
 
-// SetToNull sets value to null.
-func (o *OptNilVoteType) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v VoteType
-	o.Value = v
+// GetTitle returns the value of Title.
+func (s *PostOpinionPost2Req) GetTitle() OptString {
+	return s.Title
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilVoteType) Get() (v VoteType, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetOpinionContent returns the value of OpinionContent.
+func (s *PostOpinionPost2Req) GetOpinionContent() string {
+	return s.OpinionContent
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilVoteType) Or(d VoteType) VoteType {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetReferenceURL returns the value of ReferenceURL.
+func (s *PostOpinionPost2Req) GetReferenceURL() OptString {
+	return s.ReferenceURL
 }
 
-// NewOptOrganization returns new OptOrganization with value set to v.
-func NewOptOrganization(v Organization) OptOrganization {
-	return OptOrganization{
-		Value: v,
-		Set:   true,
-	}
+// GetPicture returns the value of Picture.
+func (s *PostOpinionPost2Req) GetPicture() OptMultipartFile {
+	return s.Picture
 }
 
-// OptOrganization is optional Organization.
-type OptOrganization struct {
-	Value Organization
-	Set   bool
+// GetIsSeed returns the value of IsSeed.
+func (s *PostOpinionPost2Req) GetIsSeed() OptNilBool {
+	return s.IsSeed
 }
 
-// IsSet returns true if OptOrganization was set.
-func (o OptOrganization) IsSet() bool { return o.Set }
+// SetTalkSessionID sets the value of TalkSessionID.
+func (s *PostOpinionPost2Req) SetTalkSessionID(val OptString) {
+	s.TalkSessionID = val
+}
 
-// Reset unsets value.
-func (o *OptOrganization) Reset() {
-	var v Organization
-	o.Value = v
-	o.Set = false
+// SetParentOpinionID sets the value of ParentOpinionID.
+func (s *PostOpinionPost2Req) SetParentOpinionID(val OptString) {
+	s.ParentOpinionID = val
 }
 
-// SetTo sets value to v.
-func (o *OptOrganization) SetTo(v Organization) {
-	o.Set = true
-	o.Value = v
+// SetTitle sets the value of Title.
+func (s *PostOpinionPost2Req) SetTitle(val OptString) {
+	s.Title = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptOrganization) Get() (v Organization, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetOpinionContent sets the value of OpinionContent.
+func (s *PostOpinionPost2Req) SetOpinionContent(val string) {
+	s.OpinionContent = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptOrganization) Or(d Organization) Organization {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetReferenceURL sets the value of ReferenceURL.
+func (s *PostOpinionPost2Req) SetReferenceURL(val OptString) {
+	s.ReferenceURL = val
 }
 
-// NewOptString returns new OptString with value set to v.
-func NewOptString(v string) OptString {
-	return OptString{
-		Value: v,
-		Set:   true,
-	}
+// SetPicture sets the value of Picture.
+func (s *PostOpinionPost2Req) SetPicture(val OptMultipartFile) {
+	s.Picture = val
 }
 
-// OptString is optional string.
-type OptString struct {
-	Value string
-	Set   bool
+// SetIsSeed sets the value of IsSeed.
+func (s *PostOpinionPost2Req) SetIsSeed(val OptNilBool) {
+	s.IsSeed = val
 }
 
-// IsSet returns true if OptString was set.
-func (o OptString) IsSet() bool { return o.Set }
+type PostTimeLineItemBadRequest struct{}
+
+func (*PostTimeLineItemBadRequest) postTimeLineItemRes() {}
+
+type PostTimeLineItemInternalServerError struct{}
+
+func (*PostTimeLineItemInternalServerError) postTimeLineItemRes() {}
 
-// Reset unsets value.
-func (o *OptString) Reset() {
-	var v string
-	o.Value = v
-	o.Set = false
+type PostTimeLineItemOK struct {
+	ActionItemID string `json:"actionItemID"`
+	Sequence     int    `json:"sequence"`
+	Content      string `json:"content"`
+	Status       string `json:"status"`
+	CreatedAt    string `json:"createdAt"`
+	UpdatedAt    string `json:"updatedAt"`
 }
 
-// SetTo sets value to v.
-func (o *OptString) SetTo(v string) {
-	o.Set = true
-	o.Value = v
+// GetActionItemID returns the value of ActionItemID.
+func (s *PostTimeLineItemOK) GetActionItemID() string {
+	return s.ActionItemID
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptString) Get() (v string, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetSequence returns the value of Sequence.
+func (s *PostTimeLineItemOK) GetSequence() int {
+	return s.Sequence
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptString) Or(d string) string {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetContent returns the value of Content.
+func (s *PostTimeLineItemOK) GetContent() string {
+	return s.Content
 }
 
-// NewOptURI returns new OptURI with value set to v.
-func NewOptURI(v url.URL) OptURI {
-	return OptURI{
-		Value: v,
-		Set:   true,
-	}
+// GetStatus returns the value of Status.
+func (s *PostTimeLineItemOK) GetStatus() string {
+	return s.Status
 }
 
-// OptURI is optional url.URL.
-type OptURI struct {
-	Value url.URL
-	Set   bool
+// GetCreatedAt returns the value of CreatedAt.
+func (s *PostTimeLineItemOK) GetCreatedAt() string {
+	return s.CreatedAt
 }
 
-// IsSet returns true if OptURI was set.
-func (o OptURI) IsSet() bool { return o.Set }
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *PostTimeLineItemOK) GetUpdatedAt() string {
+	return s.UpdatedAt
+}
 
-// Reset unsets value.
-func (o *OptURI) Reset() {
-	var v url.URL
-	o.Value = v
-	o.Set = false
+// SetActionItemID sets the value of ActionItemID.
+func (s *PostTimeLineItemOK) SetActionItemID(val string) {
+	s.ActionItemID = val
 }
 
-// SetTo sets value to v.
-func (o *OptURI) SetTo(v url.URL) {
-	o.Set = true
-	o.Value = v
+// SetSequence sets the value of Sequence.
+func (s *PostTimeLineItemOK) SetSequence(val int) {
+	s.Sequence = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptURI) Get() (v url.URL, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetContent sets the value of Content.
+func (s *PostTimeLineItemOK) SetContent(val string) {
+	s.Content = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptURI) Or(d url.URL) url.URL {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetStatus sets the value of Status.
+func (s *PostTimeLineItemOK) SetStatus(val string) {
+	s.Status = val
 }
 
-// NewOptUserGroupPosition returns new OptUserGroupPosition with value set to v.
-func NewOptUserGroupPosition(v UserGroupPosition) OptUserGroupPosition {
-	return OptUserGroupPosition{
-		Value: v,
-		Set:   true,
-	}
+// SetCreatedAt sets the value of CreatedAt.
+func (s *PostTimeLineItemOK) SetCreatedAt(val string) {
+	s.CreatedAt = val
 }
 
-// OptUserGroupPosition is optional UserGroupPosition.
-type OptUserGroupPosition struct {
-	Value UserGroupPosition
-	Set   bool
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *PostTimeLineItemOK) SetUpdatedAt(val string) {
+	s.UpdatedAt = val
 }
 
-// IsSet returns true if OptUserGroupPosition was set.
-func (o OptUserGroupPosition) IsSet() bool { return o.Set }
+func (*PostTimeLineItemOK) postTimeLineItemRes() {}
 
-// Reset unsets value.
-func (o *OptUserGroupPosition) Reset() {
-	var v UserGroupPosition
-	o.Value = v
-	o.Set = false
+type PostTimeLineItemReq struct {
+	Content            string    `json:"content"`
+	Status             string    `json:"status"`
+	ParentActionItemID OptString `json:"parentActionItemID"`
 }
 
-// SetTo sets value to v.
-func (o *OptUserGroupPosition) SetTo(v UserGroupPosition) {
-	o.Set = true
-	o.Value = v
+// GetContent returns the value of Content.
+func (s *PostTimeLineItemReq) GetContent() string {
+	return s.Content
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptUserGroupPosition) Get() (v UserGroupPosition, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetStatus returns the value of Status.
+func (s *PostTimeLineItemReq) GetStatus() string {
+	return s.Status
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptUserGroupPosition) Or(d UserGroupPosition) UserGroupPosition {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetParentActionItemID returns the value of ParentActionItemID.
+func (s *PostTimeLineItemReq) GetParentActionItemID() OptString {
+	return s.ParentActionItemID
 }
 
-// Ref: #/components/schemas/Organization
-type Organization struct {
-	// 組織ID.
-	ID string `json:"ID"`
-	// 組織名.
-	Name string `json:"name"`
-	// 組織コード.
-	Code string `json:"code"`
-	// 組織アイコンURL.
-	IconURL OptNilString `json:"iconURL"`
-	// 組織のタイプ.
-	Type int `json:"type"`
-	// ロールの名前.
-	RoleName string `json:"roleName"`
-	// ロール.
-	Role int `json:"role"`
+// SetContent sets the value of Content.
+func (s *PostTimeLineItemReq) SetContent(val string) {
+	s.Content = val
 }
 
-// GetID returns the value of ID.
-func (s *Organization) GetID() string {
-	return s.ID
+// SetStatus sets the value of Status.
+func (s *PostTimeLineItemReq) SetStatus(val string) {
+	s.Status = val
 }
 
-// GetName returns the value of Name.
-func (s *Organization) GetName() string {
-	return s.Name
+// SetParentActionItemID sets the value of ParentActionItemID.
+func (s *PostTimeLineItemReq) SetParentActionItemID(val OptString) {
+	s.ParentActionItemID = val
 }
 
-// GetCode returns the value of Code.
-func (s *Organization) GetCode() string {
-	return s.Code
+// Ref: #/components/schemas/PublishReportVersionRequest
+type PublishReportVersionRequest struct {
+	// 公開して固定するバージョン。省略した場合は固定を解除し、最新のバージョンを公開する.
+	VersionID OptString `json:"versionID"`
 }
 
-// GetIconURL returns the value of IconURL.
-func (s *Organization) GetIconURL() OptNilString {
-	return s.IconURL
+// GetVersionID returns the value of VersionID.
+func (s *PublishReportVersionRequest) GetVersionID() OptString {
+	return s.VersionID
 }
 
-// GetType returns the value of Type.
-func (s *Organization) GetType() int {
-	return s.Type
+// SetVersionID sets the value of VersionID.
+func (s *PublishReportVersionRequest) SetVersionID(val OptString) {
+	s.VersionID = val
 }
 
-// GetRoleName returns the value of RoleName.
-func (s *Organization) GetRoleName() string {
-	return s.RoleName
-}
+type PublishTalkSessionBadRequest struct{}
 
-// GetRole returns the value of Role.
-func (s *Organization) GetRole() int {
-	return s.Role
+func (*PublishTalkSessionBadRequest) publishTalkSessionRes() {}
+
+type PublishTalkSessionForbidden struct{}
+
+func (*PublishTalkSessionForbidden) publishTalkSessionRes() {}
+
+type PublishTalkSessionInternalServerError struct{}
+
+func (*PublishTalkSessionInternalServerError) publishTalkSessionRes() {}
+
+type ReactivateUserBadRequest struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
 }
 
-// SetID sets the value of ID.
-func (s *Organization) SetID(val string) {
-	s.ID = val
+// GetCode returns the value of Code.
+func (s *ReactivateUserBadRequest) GetCode() string {
+	return s.Code
 }
 
-// SetName sets the value of Name.
-func (s *Organization) SetName(val string) {
-	s.Name = val
+// GetMessage returns the value of Message.
+func (s *ReactivateUserBadRequest) GetMessage() string {
+	return s.Message
 }
 
 // SetCode sets the value of Code.
-func (s *Organization) SetCode(val string) {
+func (s *ReactivateUserBadRequest) SetCode(val string) {
 	s.Code = val
 }
 
-// SetIconURL sets the value of IconURL.
-func (s *Organization) SetIconURL(val OptNilString) {
-	s.IconURL = val
+// SetMessage sets the value of Message.
+func (s *ReactivateUserBadRequest) SetMessage(val string) {
+	s.Message = val
 }
 
-// SetType sets the value of Type.
-func (s *Organization) SetType(val int) {
-	s.Type = val
+func (*ReactivateUserBadRequest) reactivateUserRes() {}
+
+type ReactivateUserForbidden struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
 }
 
-// SetRoleName sets the value of RoleName.
-func (s *Organization) SetRoleName(val string) {
-	s.RoleName = val
+// GetCode returns the value of Code.
+func (s *ReactivateUserForbidden) GetCode() string {
+	return s.Code
 }
 
-// SetRole sets the value of Role.
-func (s *Organization) SetRole(val int) {
-	s.Role = val
+// GetMessage returns the value of Message.
+func (s *ReactivateUserForbidden) GetMessage() string {
+	return s.Message
 }
 
-// 組織エイリアス.
-// Ref: #/components/schemas/OrganizationAlias
-type OrganizationAlias struct {
-	AliasID   string       `json:"aliasID"`
-	AliasName string       `json:"aliasName"`
-	CreatedAt OptNilString `json:"createdAt"`
+// SetCode sets the value of Code.
+func (s *ReactivateUserForbidden) SetCode(val string) {
+	s.Code = val
 }
 
-// GetAliasID returns the value of AliasID.
-func (s *OrganizationAlias) GetAliasID() string {
-	return s.AliasID
+// SetMessage sets the value of Message.
+func (s *ReactivateUserForbidden) SetMessage(val string) {
+	s.Message = val
 }
 
-// GetAliasName returns the value of AliasName.
-func (s *OrganizationAlias) GetAliasName() string {
-	return s.AliasName
+func (*ReactivateUserForbidden) reactivateUserRes() {}
+
+type ReactivateUserInternalServerError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *OrganizationAlias) GetCreatedAt() OptNilString {
-	return s.CreatedAt
+// GetCode returns the value of Code.
+func (s *ReactivateUserInternalServerError) GetCode() string {
+	return s.Code
 }
 
-// SetAliasID sets the value of AliasID.
-func (s *OrganizationAlias) SetAliasID(val string) {
-	s.AliasID = val
+// GetMessage returns the value of Message.
+func (s *ReactivateUserInternalServerError) GetMessage() string {
+	return s.Message
 }
 
-// SetAliasName sets the value of AliasName.
-func (s *OrganizationAlias) SetAliasName(val string) {
-	s.AliasName = val
+// SetCode sets the value of Code.
+func (s *ReactivateUserInternalServerError) SetCode(val string) {
+	s.Code = val
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *OrganizationAlias) SetCreatedAt(val OptNilString) {
-	s.CreatedAt = val
+// SetMessage sets the value of Message.
+func (s *ReactivateUserInternalServerError) SetMessage(val string) {
+	s.Message = val
 }
 
-func (*OrganizationAlias) createOrganizationAliasRes() {}
+func (*ReactivateUserInternalServerError) reactivateUserRes() {}
 
-// 組織ユーザー.
-// Ref: #/components/schemas/OrganizationUser
-type OrganizationUser struct {
-	UserID      string       `json:"userID"`
-	DisplayID   string       `json:"displayID"`
-	DisplayName string       `json:"displayName"`
-	IconURL     OptNilString `json:"iconURL"`
-	Role        int          `json:"role"`
-	RoleName    string       `json:"roleName"`
+type ReactivateUserOK struct {
+	Message string `json:"message"`
+	User    User   `json:"user"`
 }
 
-// GetUserID returns the value of UserID.
-func (s *OrganizationUser) GetUserID() string {
-	return s.UserID
+// GetMessage returns the value of Message.
+func (s *ReactivateUserOK) GetMessage() string {
+	return s.Message
 }
 
-// GetDisplayID returns the value of DisplayID.
-func (s *OrganizationUser) GetDisplayID() string {
-	return s.DisplayID
+// GetUser returns the value of User.
+func (s *ReactivateUserOK) GetUser() User {
+	return s.User
 }
 
-// GetDisplayName returns the value of DisplayName.
-func (s *OrganizationUser) GetDisplayName() string {
-	return s.DisplayName
+// SetMessage sets the value of Message.
+func (s *ReactivateUserOK) SetMessage(val string) {
+	s.Message = val
 }
 
-// GetIconURL returns the value of IconURL.
-func (s *OrganizationUser) GetIconURL() OptNilString {
-	return s.IconURL
+// SetUser sets the value of User.
+func (s *ReactivateUserOK) SetUser(val User) {
+	s.User = val
 }
 
-// GetRole returns the value of Role.
-func (s *OrganizationUser) GetRole() int {
-	return s.Role
-}
+func (*ReactivateUserOK) reactivateUserRes() {}
 
-// GetRoleName returns the value of RoleName.
-func (s *OrganizationUser) GetRoleName() string {
-	return s.RoleName
+// 母集団の区分と割合.
+// Ref: #/components/schemas/ReferenceCategory
+type ReferenceCategory struct {
+	// 区分.
+	Category string `json:"category"`
+	// 母集団での割合.
+	Share float64 `json:"share"`
 }
 
-// SetUserID sets the value of UserID.
-func (s *OrganizationUser) SetUserID(val string) {
-	s.UserID = val
+// GetCategory returns the value of Category.
+func (s *ReferenceCategory) GetCategory() string {
+	return s.Category
 }
 
-// SetDisplayID sets the value of DisplayID.
-func (s *OrganizationUser) SetDisplayID(val string) {
-	s.DisplayID = val
+// GetShare returns the value of Share.
+func (s *ReferenceCategory) GetShare() float64 {
+	return s.Share
 }
 
-// SetDisplayName sets the value of DisplayName.
-func (s *OrganizationUser) SetDisplayName(val string) {
-	s.DisplayName = val
+// SetCategory sets the value of Category.
+func (s *ReferenceCategory) SetCategory(val string) {
+	s.Category = val
 }
 
-// SetIconURL sets the value of IconURL.
-func (s *OrganizationUser) SetIconURL(val OptNilString) {
-	s.IconURL = val
+// SetShare sets the value of Share.
+func (s *ReferenceCategory) SetShare(val float64) {
+	s.Share = val
 }
 
-// SetRole sets the value of Role.
-func (s *OrganizationUser) SetRole(val int) {
-	s.Role = val
+// 組織が登録した属性ごとの母集団の構成.
+// Ref: #/components/schemas/ReferenceDistribution
+type ReferenceDistribution struct {
+	Dimension DemographicDimension `json:"dimension"`
+	// 登録した順の区分。割合の合計は1.
+	Categories []ReferenceCategory `json:"categories"`
+	UpdatedAt  time.Time           `json:"updatedAt"`
 }
 
-// SetRoleName sets the value of RoleName.
-func (s *OrganizationUser) SetRoleName(val string) {
-	s.RoleName = val
+// GetDimension returns the value of Dimension.
+func (s *ReferenceDistribution) GetDimension() DemographicDimension {
+	return s.Dimension
 }
 
-type PasswordLoginBadRequest struct{}
-
-func (*PasswordLoginBadRequest) passwordLoginRes() {}
-
-type PasswordLoginInternalServerError struct{}
-
-func (*PasswordLoginInternalServerError) passwordLoginRes() {}
-
-type PasswordLoginOK struct{}
-
-func (*PasswordLoginOK) passwordLoginRes() {}
-
-type PasswordLoginReq struct {
-	IdOrEmail string `json:"idOrEmail"`
-	Password  string `json:"password"`
+// GetCategories returns the value of Categories.
+func (s *ReferenceDistribution) GetCategories() []ReferenceCategory {
+	return s.Categories
 }
 
-// GetIdOrEmail returns the value of IdOrEmail.
-func (s *PasswordLoginReq) GetIdOrEmail() string {
-	return s.IdOrEmail
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *ReferenceDistribution) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
 }
 
-// GetPassword returns the value of Password.
-func (s *PasswordLoginReq) GetPassword() string {
-	return s.Password
+// SetDimension sets the value of Dimension.
+func (s *ReferenceDistribution) SetDimension(val DemographicDimension) {
+	s.Dimension = val
 }
 
-// SetIdOrEmail sets the value of IdOrEmail.
-func (s *PasswordLoginReq) SetIdOrEmail(val string) {
-	s.IdOrEmail = val
+// SetCategories sets the value of Categories.
+func (s *ReferenceDistribution) SetCategories(val []ReferenceCategory) {
+	s.Categories = val
 }
 
-// SetPassword sets the value of Password.
-func (s *PasswordLoginReq) SetPassword(val string) {
-	s.Password = val
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *ReferenceDistribution) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
 }
 
-type PasswordRegisterBadRequest struct{}
+func (*ReferenceDistribution) updateOrganizationReferenceDemographicsRes() {}
 
-func (*PasswordRegisterBadRequest) passwordRegisterRes() {}
+// Ref: #/components/schemas/RegenerateRequest
+type RegenerateRequest struct {
+	// 再生成するタイプ.
+	Type RegenerateRequestType `json:"type"`
+}
 
-type PasswordRegisterInternalServerError struct{}
+// GetType returns the value of Type.
+func (s *RegenerateRequest) GetType() RegenerateRequestType {
+	return s.Type
+}
 
-func (*PasswordRegisterInternalServerError) passwordRegisterRes() {}
+// SetType sets the value of Type.
+func (s *RegenerateRequest) SetType(val RegenerateRequestType) {
+	s.Type = val
+}
 
-type PasswordRegisterOK struct{}
+// 再生成するタイプ.
+type RegenerateRequestType string
 
-func (*PasswordRegisterOK) passwordRegisterRes() {}
+const (
+	RegenerateRequestTypeReport RegenerateRequestType = "report"
+	RegenerateRequestTypeGroup  RegenerateRequestType = "group"
+	RegenerateRequestTypeImage  RegenerateRequestType = "image"
+)
 
-type PasswordRegisterReq struct {
-	Password string `json:"password"`
-	Email    string `json:"email"`
+// AllValues returns all RegenerateRequestType values.
+func (RegenerateRequestType) AllValues() []RegenerateRequestType {
+	return []RegenerateRequestType{
+		RegenerateRequestTypeReport,
+		RegenerateRequestTypeGroup,
+		RegenerateRequestTypeImage,
+	}
 }
 
-// GetPassword returns the value of Password.
-func (s *PasswordRegisterReq) GetPassword() string {
-	return s.Password
+// MarshalText implements encoding.TextMarshaler.
+func (s RegenerateRequestType) MarshalText() ([]byte, error) {
+	switch s {
+	case RegenerateRequestTypeReport:
+		return []byte(s), nil
+	case RegenerateRequestTypeGroup:
+		return []byte(s), nil
+	case RegenerateRequestTypeImage:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// GetEmail returns the value of Email.
-func (s *PasswordRegisterReq) GetEmail() string {
-	return s.Email
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *RegenerateRequestType) UnmarshalText(data []byte) error {
+	switch RegenerateRequestType(data) {
+	case RegenerateRequestTypeReport:
+		*s = RegenerateRequestTypeReport
+		return nil
+	case RegenerateRequestTypeGroup:
+		*s = RegenerateRequestTypeGroup
+		return nil
+	case RegenerateRequestTypeImage:
+		*s = RegenerateRequestTypeImage
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// SetPassword sets the value of Password.
-func (s *PasswordRegisterReq) SetPassword(val string) {
-	s.Password = val
+// Ref: #/components/schemas/RegenerateResponse
+type RegenerateResponse struct {
+	// ステータス.
+	Status string `json:"status"`
+	// メッセージ.
+	Message string `json:"message"`
+	// レポート・画像の生成ジョブのID。状況は
+	// /talksessions/{talkSessionID}/analysis/jobs/{jobID} で確認する.
+	JobID OptString `json:"jobID"`
 }
 
-// SetEmail sets the value of Email.
-func (s *PasswordRegisterReq) SetEmail(val string) {
-	s.Email = val
+// GetStatus returns the value of Status.
+func (s *RegenerateResponse) GetStatus() string {
+	return s.Status
 }
 
-type PolicyConsentBadRequest struct{}
-
-func (*PolicyConsentBadRequest) policyConsentRes() {}
-
-type PolicyConsentInternalServerError struct{}
+// GetMessage returns the value of Message.
+func (s *RegenerateResponse) GetMessage() string {
+	return s.Message
+}
 
-func (*PolicyConsentInternalServerError) policyConsentRes() {}
+// GetJobID returns the value of JobID.
+func (s *RegenerateResponse) GetJobID() OptString {
+	return s.JobID
+}
 
-type PolicyConsentReq struct {
-	PolicyVersion string `json:"policyVersion"`
+// SetStatus sets the value of Status.
+func (s *RegenerateResponse) SetStatus(val string) {
+	s.Status = val
 }
 
-// GetPolicyVersion returns the value of PolicyVersion.
-func (s *PolicyConsentReq) GetPolicyVersion() string {
-	return s.PolicyVersion
+// SetMessage sets the value of Message.
+func (s *RegenerateResponse) SetMessage(val string) {
+	s.Message = val
 }
 
-// SetPolicyVersion sets the value of PolicyVersion.
-func (s *PolicyConsentReq) SetPolicyVersion(val string) {
-	s.PolicyVersion = val
+// SetJobID sets the value of JobID.
+func (s *RegenerateResponse) SetJobID(val OptString) {
+	s.JobID = val
 }
 
-// Ref: #/components/schemas/PolicyConsentStatus
-type PolicyConsentStatus struct {
-	// 最新ポリシーのバージョン.
-	PolicyVersion string `json:"policyVersion"`
-	// 同意した日時.
-	ConsentedAt OptNilString `json:"consentedAt"`
-	// 同意したか.
-	ConsentGiven bool `json:"consentGiven"`
+type RegisterDeviceBadRequest struct{}
+
+func (*RegisterDeviceBadRequest) registerDeviceRes() {}
+
+type RegisterDeviceReq struct {
+	DeviceToken string                    `json:"device_token"`
+	Platform    RegisterDeviceReqPlatform `json:"platform"`
+	DeviceName  OptString                 `json:"device_name"`
+	AppVersion  OptString                 `json:"app_version"`
+	OsVersion   OptString                 `json:"os_version"`
 }
 
-// GetPolicyVersion returns the value of PolicyVersion.
-func (s *PolicyConsentStatus) GetPolicyVersion() string {
-	return s.PolicyVersion
+// GetDeviceToken returns the value of DeviceToken.
+func (s *RegisterDeviceReq) GetDeviceToken() string {
+	return s.DeviceToken
 }
 
-// GetConsentedAt returns the value of ConsentedAt.
-func (s *PolicyConsentStatus) GetConsentedAt() OptNilString {
-	return s.ConsentedAt
+// GetPlatform returns the value of Platform.
+func (s *RegisterDeviceReq) GetPlatform() RegisterDeviceReqPlatform {
+	return s.Platform
 }
 
-// GetConsentGiven returns the value of ConsentGiven.
-func (s *PolicyConsentStatus) GetConsentGiven() bool {
-	return s.ConsentGiven
+// GetDeviceName returns the value of DeviceName.
+func (s *RegisterDeviceReq) GetDeviceName() OptString {
+	return s.DeviceName
 }
 
-// SetPolicyVersion sets the value of PolicyVersion.
-func (s *PolicyConsentStatus) SetPolicyVersion(val string) {
-	s.PolicyVersion = val
+// GetAppVersion returns the value of AppVersion.
+func (s *RegisterDeviceReq) GetAppVersion() OptString {
+	return s.AppVersion
 }
 
-// SetConsentedAt sets the value of ConsentedAt.
-func (s *PolicyConsentStatus) SetConsentedAt(val OptNilString) {
-	s.ConsentedAt = val
+// GetOsVersion returns the value of OsVersion.
+func (s *RegisterDeviceReq) GetOsVersion() OptString {
+	return s.OsVersion
 }
 
-// SetConsentGiven sets the value of ConsentGiven.
-func (s *PolicyConsentStatus) SetConsentGiven(val bool) {
-	s.ConsentGiven = val
+// SetDeviceToken sets the value of DeviceToken.
+func (s *RegisterDeviceReq) SetDeviceToken(val string) {
+	s.DeviceToken = val
 }
 
-func (*PolicyConsentStatus) getPolicyConsentStatusRes() {}
-func (*PolicyConsentStatus) policyConsentRes()          {}
+// SetPlatform sets the value of Platform.
+func (s *RegisterDeviceReq) SetPlatform(val RegisterDeviceReqPlatform) {
+	s.Platform = val
+}
 
-type PostConclusionBadRequest struct{}
+// SetDeviceName sets the value of DeviceName.
+func (s *RegisterDeviceReq) SetDeviceName(val OptString) {
+	s.DeviceName = val
+}
 
-func (*PostConclusionBadRequest) postConclusionRes() {}
+// SetAppVersion sets the value of AppVersion.
+func (s *RegisterDeviceReq) SetAppVersion(val OptString) {
+	s.AppVersion = val
+}
 
-type PostConclusionInternalServerError struct{}
+// SetOsVersion sets the value of OsVersion.
+func (s *RegisterDeviceReq) SetOsVersion(val OptString) {
+	s.OsVersion = val
+}
 
-func (*PostConclusionInternalServerError) postConclusionRes() {}
+type RegisterDeviceReqPlatform string
 
-type PostConclusionReq struct {
-	Content string `json:"content"`
+const (
+	RegisterDeviceReqPlatformIos     RegisterDeviceReqPlatform = "ios"
+	RegisterDeviceReqPlatformAndroid RegisterDeviceReqPlatform = "android"
+	RegisterDeviceReqPlatformWeb     RegisterDeviceReqPlatform = "web"
+)
+
+// AllValues returns all RegisterDeviceReqPlatform values.
+func (RegisterDeviceReqPlatform) AllValues() []RegisterDeviceReqPlatform {
+	return []RegisterDeviceReqPlatform{
+		RegisterDeviceReqPlatformIos,
+		RegisterDeviceReqPlatformAndroid,
+		RegisterDeviceReqPlatformWeb,
+	}
 }
 
-// GetContent returns the value of Content.
-func (s *PostConclusionReq) GetContent() string {
-	return s.Content
+// MarshalText implements encoding.TextMarshaler.
+func (s RegisterDeviceReqPlatform) MarshalText() ([]byte, error) {
+	switch s {
+	case RegisterDeviceReqPlatformIos:
+		return []byte(s), nil
+	case RegisterDeviceReqPlatformAndroid:
+		return []byte(s), nil
+	case RegisterDeviceReqPlatformWeb:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetContent sets the value of Content.
-func (s *PostConclusionReq) SetContent(val string) {
-	s.Content = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *RegisterDeviceReqPlatform) UnmarshalText(data []byte) error {
+	switch RegisterDeviceReqPlatform(data) {
+	case RegisterDeviceReqPlatformIos:
+		*s = RegisterDeviceReqPlatformIos
+		return nil
+	case RegisterDeviceReqPlatformAndroid:
+		*s = RegisterDeviceReqPlatformAndroid
+		return nil
+	case RegisterDeviceReqPlatformWeb:
+		*s = RegisterDeviceReqPlatformWeb
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-type PostImageBadRequest struct{}
+type RegisterDeviceUnauthorized struct{}
 
-func (*PostImageBadRequest) postImageRes() {}
+func (*RegisterDeviceUnauthorized) registerDeviceRes() {}
 
-type PostImageInternalServerError struct{}
+type RemoveOrganizationUserBadRequest struct{}
 
-func (*PostImageInternalServerError) postImageRes() {}
+func (*RemoveOrganizationUserBadRequest) removeOrganizationUserRes() {}
 
-type PostImageOK struct {
-	URL string `json:"url"`
-}
+type RemoveOrganizationUserForbidden struct{}
 
-// GetURL returns the value of URL.
-func (s *PostImageOK) GetURL() string {
-	return s.URL
-}
+func (*RemoveOrganizationUserForbidden) removeOrganizationUserRes() {}
 
-// SetURL sets the value of URL.
-func (s *PostImageOK) SetURL(val string) {
-	s.URL = val
-}
+type RemoveOrganizationUserInternalServerError struct{}
 
-func (*PostImageOK) postImageRes() {}
+func (*RemoveOrganizationUserInternalServerError) removeOrganizationUserRes() {}
 
-type PostImageReq struct {
-	Image ht.MultipartFile `json:"image"`
-}
+type RemoveOrganizationUserNotFound struct{}
 
-// GetImage returns the value of Image.
-func (s *PostImageReq) GetImage() ht.MultipartFile {
-	return s.Image
-}
+func (*RemoveOrganizationUserNotFound) removeOrganizationUserRes() {}
 
-// SetImage sets the value of Image.
-func (s *PostImageReq) SetImage(val ht.MultipartFile) {
-	s.Image = val
-}
+type RemoveOrganizationUserOK struct{}
 
-type PostOpinionPost2BadRequest struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
-}
+func (*RemoveOrganizationUserOK) removeOrganizationUserRes() {}
 
-// GetCode returns the value of Code.
-func (s *PostOpinionPost2BadRequest) GetCode() string {
-	return s.Code
-}
+type RemoveTalkSessionCollaboratorBadRequest struct{}
 
-// GetMessage returns the value of Message.
-func (s *PostOpinionPost2BadRequest) GetMessage() string {
-	return s.Message
-}
+func (*RemoveTalkSessionCollaboratorBadRequest) removeTalkSessionCollaboratorRes() {}
 
-// SetCode sets the value of Code.
-func (s *PostOpinionPost2BadRequest) SetCode(val string) {
-	s.Code = val
-}
+type RemoveTalkSessionCollaboratorForbidden struct{}
 
-// SetMessage sets the value of Message.
-func (s *PostOpinionPost2BadRequest) SetMessage(val string) {
-	s.Message = val
-}
+func (*RemoveTalkSessionCollaboratorForbidden) removeTalkSessionCollaboratorRes() {}
 
-func (*PostOpinionPost2BadRequest) postOpinionPost2Res() {}
+type RemoveTalkSessionCollaboratorInternalServerError struct{}
 
-type PostOpinionPost2InternalServerError struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
-}
+func (*RemoveTalkSessionCollaboratorInternalServerError) removeTalkSessionCollaboratorRes() {}
 
-// GetCode returns the value of Code.
-func (s *PostOpinionPost2InternalServerError) GetCode() string {
-	return s.Code
-}
+type RemoveTalkSessionCollaboratorOK struct{}
 
-// GetMessage returns the value of Message.
-func (s *PostOpinionPost2InternalServerError) GetMessage() string {
-	return s.Message
+func (*RemoveTalkSessionCollaboratorOK) removeTalkSessionCollaboratorRes() {}
+
+// 通報解決アクション.
+// Ref: #/components/schemas/ReportAction
+type ReportAction string
+
+const (
+	ReportActionDeleted ReportAction = "deleted"
+	ReportActionHold    ReportAction = "hold"
+)
+
+// AllValues returns all ReportAction values.
+func (ReportAction) AllValues() []ReportAction {
+	return []ReportAction{
+		ReportActionDeleted,
+		ReportActionHold,
+	}
 }
 
-// SetCode sets the value of Code.
-func (s *PostOpinionPost2InternalServerError) SetCode(val string) {
-	s.Code = val
+// MarshalText implements encoding.TextMarshaler.
+func (s ReportAction) MarshalText() ([]byte, error) {
+	switch s {
+	case ReportActionDeleted:
+		return []byte(s), nil
+	case ReportActionHold:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetMessage sets the value of Message.
-func (s *PostOpinionPost2InternalServerError) SetMessage(val string) {
-	s.Message = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ReportAction) UnmarshalText(data []byte) error {
+	switch ReportAction(data) {
+	case ReportActionDeleted:
+		*s = ReportActionDeleted
+		return nil
+	case ReportActionHold:
+		*s = ReportActionHold
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-func (*PostOpinionPost2InternalServerError) postOpinionPost2Res() {}
+// Ref: #/components/schemas/ReportDetail
+type ReportDetail struct {
+	Opinion Opinion `json:"opinion"`
+	// 作成ユーザー.
+	User    User                      `json:"user"`
+	Status  ReportStatus              `json:"status"`
+	Reasons []ReportDetailReasonsItem `json:"reasons"`
+	// この意見が通報を受けた回数.
+	ReportCount int `json:"reportCount"`
+}
 
-type PostOpinionPost2Req struct {
-	TalkSessionID   OptString        `json:"talkSessionID"`
-	ParentOpinionID OptString        `json:"parentOpinionID"`
-	Title           OptString        `json:"title"`
-	OpinionContent  string           `json:"opinionContent"`
-	ReferenceURL    OptString        `json:"referenceURL"`
-	Picture         OptMultipartFile `json:"picture"`
-	IsSeed          OptNilBool       `json:"isSeed"`
+// GetOpinion returns the value of Opinion.
+func (s *ReportDetail) GetOpinion() Opinion {
+	return s.Opinion
 }
 
-// GetTalkSessionID returns the value of TalkSessionID.
-func (s *PostOpinionPost2Req) GetTalkSessionID() OptString {
-	return s.TalkSessionID
+// GetUser returns the value of User.
+func (s *ReportDetail) GetUser() User {
+	return s.User
 }
 
-// GetParentOpinionID returns the value of ParentOpinionID.
-func (s *PostOpinionPost2Req) GetParentOpinionID() OptString {
-	return s.ParentOpinionID
+// GetStatus returns the value of Status.
+func (s *ReportDetail) GetStatus() ReportStatus {
+	return s.Status
 }
 
-// GetTitle returns the value of Title.
-func (s *PostOpinionPost2Req) GetTitle() OptString {
-	return s.Title
+// GetReasons returns the value of Reasons.
+func (s *ReportDetail) GetReasons() []ReportDetailReasonsItem {
+	return s.Reasons
 }
 
-// GetOpinionContent returns the value of OpinionContent.
-func (s *PostOpinionPost2Req) GetOpinionContent() string {
-	return s.OpinionContent
+// GetReportCount returns the value of ReportCount.
+func (s *ReportDetail) GetReportCount() int {
+	return s.ReportCount
 }
 
-// GetReferenceURL returns the value of ReferenceURL.
-func (s *PostOpinionPost2Req) GetReferenceURL() OptString {
-	return s.ReferenceURL
+// SetOpinion sets the value of Opinion.
+func (s *ReportDetail) SetOpinion(val Opinion) {
+	s.Opinion = val
 }
 
-// GetPicture returns the value of Picture.
-func (s *PostOpinionPost2Req) GetPicture() OptMultipartFile {
-	return s.Picture
+// SetUser sets the value of User.
+func (s *ReportDetail) SetUser(val User) {
+	s.User = val
 }
 
-// GetIsSeed returns the value of IsSeed.
-func (s *PostOpinionPost2Req) GetIsSeed() OptNilBool {
-	return s.IsSeed
+// SetStatus sets the value of Status.
+func (s *ReportDetail) SetStatus(val ReportStatus) {
+	s.Status = val
 }
 
-// SetTalkSessionID sets the value of TalkSessionID.
-func (s *PostOpinionPost2Req) SetTalkSessionID(val OptString) {
-	s.TalkSessionID = val
+// SetReasons sets the value of Reasons.
+func (s *ReportDetail) SetReasons(val []ReportDetailReasonsItem) {
+	s.Reasons = val
 }
 
-// SetParentOpinionID sets the value of ParentOpinionID.
-func (s *PostOpinionPost2Req) SetParentOpinionID(val OptString) {
-	s.ParentOpinionID = val
+// SetReportCount sets the value of ReportCount.
+func (s *ReportDetail) SetReportCount(val int) {
+	s.ReportCount = val
 }
 
-// SetTitle sets the value of Title.
-func (s *PostOpinionPost2Req) SetTitle(val OptString) {
-	s.Title = val
+func (*ReportDetail) getOpinionReportsRes() {}
+
+type ReportDetailReasonsItem struct {
+	Reason  string       `json:"reason"`
+	Content OptNilString `json:"content"`
 }
 
-// SetOpinionContent sets the value of OpinionContent.
-func (s *PostOpinionPost2Req) SetOpinionContent(val string) {
-	s.OpinionContent = val
+// GetReason returns the value of Reason.
+func (s *ReportDetailReasonsItem) GetReason() string {
+	return s.Reason
 }
 
-// SetReferenceURL sets the value of ReferenceURL.
-func (s *PostOpinionPost2Req) SetReferenceURL(val OptString) {
-	s.ReferenceURL = val
+// GetContent returns the value of Content.
+func (s *ReportDetailReasonsItem) GetContent() OptNilString {
+	return s.Content
 }
 
-// SetPicture sets the value of Picture.
-func (s *PostOpinionPost2Req) SetPicture(val OptMultipartFile) {
-	s.Picture = val
+// SetReason sets the value of Reason.
+func (s *ReportDetailReasonsItem) SetReason(val string) {
+	s.Reason = val
 }
 
-// SetIsSeed sets the value of IsSeed.
-func (s *PostOpinionPost2Req) SetIsSeed(val OptNilBool) {
-	s.IsSeed = val
+// SetContent sets the value of Content.
+func (s *ReportDetailReasonsItem) SetContent(val OptNilString) {
+	s.Content = val
 }
 
-type PostTimeLineItemBadRequest struct{}
+type ReportOpinionBadRequest struct{}
 
-func (*PostTimeLineItemBadRequest) postTimeLineItemRes() {}
+func (*ReportOpinionBadRequest) reportOpinionRes() {}
 
-type PostTimeLineItemInternalServerError struct{}
+type ReportOpinionInternalServerError struct{}
 
-func (*PostTimeLineItemInternalServerError) postTimeLineItemRes() {}
+func (*ReportOpinionInternalServerError) reportOpinionRes() {}
 
-type PostTimeLineItemOK struct {
-	ActionItemID string `json:"actionItemID"`
-	Sequence     int    `json:"sequence"`
-	Content      string `json:"content"`
-	Status       string `json:"status"`
-	CreatedAt    string `json:"createdAt"`
-	UpdatedAt    string `json:"updatedAt"`
-}
+type ReportOpinionOK struct{}
 
-// GetActionItemID returns the value of ActionItemID.
-func (s *PostTimeLineItemOK) GetActionItemID() string {
-	return s.ActionItemID
+func (*ReportOpinionOK) reportOpinionRes() {}
+
+type ReportOpinionReq struct {
+	Reason  NilInt    `json:"reason"`
+	Content NilString `json:"content"`
 }
 
-// GetSequence returns the value of Sequence.
-func (s *PostTimeLineItemOK) GetSequence() int {
-	return s.Sequence
+// GetReason returns the value of Reason.
+func (s *ReportOpinionReq) GetReason() NilInt {
+	return s.Reason
 }
 
 // GetContent returns the value of Content.
-func (s *PostTimeLineItemOK) GetContent() string {
+func (s *ReportOpinionReq) GetContent() NilString {
 	return s.Content
 }
 
-// GetStatus returns the value of Status.
-func (s *PostTimeLineItemOK) GetStatus() string {
-	return s.Status
+// SetReason sets the value of Reason.
+func (s *ReportOpinionReq) SetReason(val NilInt) {
+	s.Reason = val
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *PostTimeLineItemOK) GetCreatedAt() string {
-	return s.CreatedAt
+// SetContent sets the value of Content.
+func (s *ReportOpinionReq) SetContent(val NilString) {
+	s.Content = val
 }
 
-// GetUpdatedAt returns the value of UpdatedAt.
-func (s *PostTimeLineItemOK) GetUpdatedAt() string {
-	return s.UpdatedAt
+// Ref: #/components/schemas/ReportPublicationForManage
+type ReportPublicationForManage struct {
+	// 公開しているバージョンのID.
+	VersionID string `json:"versionID"`
+	// 公開しているバージョンの番号.
+	Version int32 `json:"version"`
+	// 新しいバージョンが生成されても公開するバージョンを変えないかどうか.
+	Pinned bool `json:"pinned"`
+	// 公開日時.
+	PublishedAt time.Time `json:"publishedAt"`
 }
 
-// SetActionItemID sets the value of ActionItemID.
-func (s *PostTimeLineItemOK) SetActionItemID(val string) {
-	s.ActionItemID = val
+// GetVersionID returns the value of VersionID.
+func (s *ReportPublicationForManage) GetVersionID() string {
+	return s.VersionID
 }
 
-// SetSequence sets the value of Sequence.
-func (s *PostTimeLineItemOK) SetSequence(val int) {
-	s.Sequence = val
+// GetVersion returns the value of Version.
+func (s *ReportPublicationForManage) GetVersion() int32 {
+	return s.Version
 }
 
-// SetContent sets the value of Content.
-func (s *PostTimeLineItemOK) SetContent(val string) {
-	s.Content = val
+// GetPinned returns the value of Pinned.
+func (s *ReportPublicationForManage) GetPinned() bool {
+	return s.Pinned
 }
 
-// SetStatus sets the value of Status.
-func (s *PostTimeLineItemOK) SetStatus(val string) {
-	s.Status = val
+// GetPublishedAt returns the value of PublishedAt.
+func (s *ReportPublicationForManage) GetPublishedAt() time.Time {
+	return s.PublishedAt
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *PostTimeLineItemOK) SetCreatedAt(val string) {
-	s.CreatedAt = val
+// SetVersionID sets the value of VersionID.
+func (s *ReportPublicationForManage) SetVersionID(val string) {
+	s.VersionID = val
 }
 
-// SetUpdatedAt sets the value of UpdatedAt.
-func (s *PostTimeLineItemOK) SetUpdatedAt(val string) {
-	s.UpdatedAt = val
+// SetVersion sets the value of Version.
+func (s *ReportPublicationForManage) SetVersion(val int32) {
+	s.Version = val
 }
 
-func (*PostTimeLineItemOK) postTimeLineItemRes() {}
-
-type PostTimeLineItemReq struct {
-	Content            string    `json:"content"`
-	Status             string    `json:"status"`
-	ParentActionItemID OptString `json:"parentActionItemID"`
+// SetPinned sets the value of Pinned.
+func (s *ReportPublicationForManage) SetPinned(val bool) {
+	s.Pinned = val
 }
 
-// GetContent returns the value of Content.
-func (s *PostTimeLineItemReq) GetContent() string {
-	return s.Content
+// SetPublishedAt sets the value of PublishedAt.
+func (s *ReportPublicationForManage) SetPublishedAt(val time.Time) {
+	s.PublishedAt = val
 }
 
-// GetStatus returns the value of Status.
-func (s *PostTimeLineItemReq) GetStatus() string {
-	return s.Status
+// Ref: #/components/schemas/ReportReason
+type ReportReason struct {
+	// 1.
+	ReasonID int `json:"reasonID"`
+	// 不適切な内容.
+	Reason string `json:"reason"`
 }
 
-// GetParentActionItemID returns the value of ParentActionItemID.
-func (s *PostTimeLineItemReq) GetParentActionItemID() OptString {
-	return s.ParentActionItemID
+// GetReasonID returns the value of ReasonID.
+func (s *ReportReason) GetReasonID() int {
+	return s.ReasonID
 }
 
-// SetContent sets the value of Content.
-func (s *PostTimeLineItemReq) SetContent(val string) {
-	s.Content = val
+// GetReason returns the value of Reason.
+func (s *ReportReason) GetReason() string {
+	return s.Reason
 }
 
-// SetStatus sets the value of Status.
-func (s *PostTimeLineItemReq) SetStatus(val string) {
-	s.Status = val
+// SetReasonID sets the value of ReasonID.
+func (s *ReportReason) SetReasonID(val int) {
+	s.ReasonID = val
 }
 
-// SetParentActionItemID sets the value of ParentActionItemID.
-func (s *PostTimeLineItemReq) SetParentActionItemID(val OptString) {
-	s.ParentActionItemID = val
+// SetReason sets the value of Reason.
+func (s *ReportReason) SetReason(val string) {
+	s.Reason = val
 }
 
-type ReactivateUserBadRequest struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
+// 構造化されたレポート.
+// Ref: #/components/schemas/ReportSections
+type ReportSections struct {
+	Summary string `json:"summary"`
+	// グループごとの立場。グループID順.
+	GroupStances []GroupStance `json:"groupStances"`
+	// グループを越えて合意している点.
+	Consensus []string `json:"consensus"`
+	// 意見が分かれている、または議論が足りない論点.
+	OpenQuestions []string `json:"openQuestions"`
 }
 
-// GetCode returns the value of Code.
-func (s *ReactivateUserBadRequest) GetCode() string {
-	return s.Code
+// GetSummary returns the value of Summary.
+func (s *ReportSections) GetSummary() string {
+	return s.Summary
 }
 
-// GetMessage returns the value of Message.
-func (s *ReactivateUserBadRequest) GetMessage() string {
-	return s.Message
+// GetGroupStances returns the value of GroupStances.
+func (s *ReportSections) GetGroupStances() []GroupStance {
+	return s.GroupStances
 }
 
-// SetCode sets the value of Code.
-func (s *ReactivateUserBadRequest) SetCode(val string) {
-	s.Code = val
+// GetConsensus returns the value of Consensus.
+func (s *ReportSections) GetConsensus() []string {
+	return s.Consensus
 }
 
-// SetMessage sets the value of Message.
-func (s *ReactivateUserBadRequest) SetMessage(val string) {
-	s.Message = val
+// GetOpenQuestions returns the value of OpenQuestions.
+func (s *ReportSections) GetOpenQuestions() []string {
+	return s.OpenQuestions
 }
 
-func (*ReactivateUserBadRequest) reactivateUserRes() {}
-
-type ReactivateUserForbidden struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
+// SetSummary sets the value of Summary.
+func (s *ReportSections) SetSummary(val string) {
+	s.Summary = val
 }
 
-// GetCode returns the value of Code.
-func (s *ReactivateUserForbidden) GetCode() string {
-	return s.Code
+// SetGroupStances sets the value of GroupStances.
+func (s *ReportSections) SetGroupStances(val []GroupStance) {
+	s.GroupStances = val
 }
 
-// GetMessage returns the value of Message.
-func (s *ReactivateUserForbidden) GetMessage() string {
-	return s.Message
+// SetConsensus sets the value of Consensus.
+func (s *ReportSections) SetConsensus(val []string) {
+	s.Consensus = val
 }
 
-// SetCode sets the value of Code.
-func (s *ReactivateUserForbidden) SetCode(val string) {
-	s.Code = val
+// SetOpenQuestions sets the value of OpenQuestions.
+func (s *ReportSections) SetOpenQuestions(val []string) {
+	s.OpenQuestions = val
 }
 
-// SetMessage sets the value of Message.
-func (s *ReactivateUserForbidden) SetMessage(val string) {
-	s.Message = val
-}
+// 通報ステータス.
+// Ref: #/components/schemas/ReportStatus
+type ReportStatus string
 
-func (*ReactivateUserForbidden) reactivateUserRes() {}
+const (
+	ReportStatusUnsolved ReportStatus = "unsolved"
+	ReportStatusDeleted  ReportStatus = "deleted"
+	ReportStatusHold     ReportStatus = "hold"
+)
 
-type ReactivateUserInternalServerError struct {
-	Code    string `json:"code"`
-	Message string `json:"message"`
+// AllValues returns all ReportStatus values.
+func (ReportStatus) AllValues() []ReportStatus {
+	return []ReportStatus{
+		ReportStatusUnsolved,
+		ReportStatusDeleted,
+		ReportStatusHold,
+	}
 }
 
-// GetCode returns the value of Code.
-func (s *ReactivateUserInternalServerError) GetCode() string {
-	return s.Code
+// MarshalText implements encoding.TextMarshaler.
+func (s ReportStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case ReportStatusUnsolved:
+		return []byte(s), nil
+	case ReportStatusDeleted:
+		return []byte(s), nil
+	case ReportStatusHold:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ReportStatus) UnmarshalText(data []byte) error {
+	switch ReportStatus(data) {
+	case ReportStatusUnsolved:
+		*s = ReportStatusUnsolved
+		return nil
+	case ReportStatusDeleted:
+		*s = ReportStatusDeleted
+		return nil
+	case ReportStatusHold:
+		*s = ReportStatusHold
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// GetMessage returns the value of Message.
-func (s *ReactivateUserInternalServerError) GetMessage() string {
-	return s.Message
+// Ref: #/components/schemas/ReportVersionDiff
+type ReportVersionDiff struct {
+	// 比較元のバージョン.
+	From ReportVersionForManage `json:"from"`
+	// 比較先のバージョン.
+	To ReportVersionForManage `json:"to"`
+	// 行単位の差分.
+	Lines []ReportVersionDiffLine `json:"lines"`
 }
 
-// SetCode sets the value of Code.
-func (s *ReactivateUserInternalServerError) SetCode(val string) {
-	s.Code = val
+// GetFrom returns the value of From.
+func (s *ReportVersionDiff) GetFrom() ReportVersionForManage {
+	return s.From
 }
 
-// SetMessage sets the value of Message.
-func (s *ReactivateUserInternalServerError) SetMessage(val string) {
-	s.Message = val
+// GetTo returns the value of To.
+func (s *ReportVersionDiff) GetTo() ReportVersionForManage {
+	return s.To
 }
 
-func (*ReactivateUserInternalServerError) reactivateUserRes() {}
-
-type ReactivateUserOK struct {
-	Message string `json:"message"`
-	User    User   `json:"user"`
+// GetLines returns the value of Lines.
+func (s *ReportVersionDiff) GetLines() []ReportVersionDiffLine {
+	return s.Lines
 }
 
-// GetMessage returns the value of Message.
-func (s *ReactivateUserOK) GetMessage() string {
-	return s.Message
+// SetFrom sets the value of From.
+func (s *ReportVersionDiff) SetFrom(val ReportVersionForManage) {
+	s.From = val
 }
 
-// GetUser returns the value of User.
-func (s *ReactivateUserOK) GetUser() User {
-	return s.User
+// SetTo sets the value of To.
+func (s *ReportVersionDiff) SetTo(val ReportVersionForManage) {
+	s.To = val
 }
 
-// SetMessage sets the value of Message.
-func (s *ReactivateUserOK) SetMessage(val string) {
-	s.Message = val
+// SetLines sets the value of Lines.
+func (s *ReportVersionDiff) SetLines(val []ReportVersionDiffLine) {
+	s.Lines = val
 }
 
-// SetUser sets the value of User.
-func (s *ReactivateUserOK) SetUser(val User) {
-	s.User = val
+// Ref: #/components/schemas/ReportVersionDiffLine
+type ReportVersionDiffLine struct {
+	// Equal: 変更なし, insert: 追加, delete: 削除.
+	Op ReportVersionDiffLineOp `json:"op"`
+	// 行の内容.
+	Text string `json:"text"`
 }
 
-func (*ReactivateUserOK) reactivateUserRes() {}
+// GetOp returns the value of Op.
+func (s *ReportVersionDiffLine) GetOp() ReportVersionDiffLineOp {
+	return s.Op
+}
 
-// Ref: #/components/schemas/RegenerateRequest
-type RegenerateRequest struct {
-	// 再生成するタイプ.
-	Type RegenerateRequestType `json:"type"`
+// GetText returns the value of Text.
+func (s *ReportVersionDiffLine) GetText() string {
+	return s.Text
 }
 
-// GetType returns the value of Type.
-func (s *RegenerateRequest) GetType() RegenerateRequestType {
-	return s.Type
+// SetOp sets the value of Op.
+func (s *ReportVersionDiffLine) SetOp(val ReportVersionDiffLineOp) {
+	s.Op = val
 }
 
-// SetType sets the value of Type.
-func (s *RegenerateRequest) SetType(val RegenerateRequestType) {
-	s.Type = val
+// SetText sets the value of Text.
+func (s *ReportVersionDiffLine) SetText(val string) {
+	s.Text = val
 }
 
-// 再生成するタイプ.
-type RegenerateRequestType string
+// Equal: 変更なし, insert: 追加, delete: 削除.
+type ReportVersionDiffLineOp string
 
 const (
-	RegenerateRequestTypeReport RegenerateRequestType = "report"
-	RegenerateRequestTypeGroup  RegenerateRequestType = "group"
-	RegenerateRequestTypeImage  RegenerateRequestType = "image"
+	ReportVersionDiffLineOpEqual  ReportVersionDiffLineOp = "equal"
+	ReportVersionDiffLineOpInsert ReportVersionDiffLineOp = "insert"
+	ReportVersionDiffLineOpDelete ReportVersionDiffLineOp = "delete"
 )
 
-// AllValues returns all RegenerateRequestType values.
-func (RegenerateRequestType) AllValues() []RegenerateRequestType {
-	return []RegenerateRequestType{
-		RegenerateRequestTypeReport,
-		RegenerateRequestTypeGroup,
-		RegenerateRequestTypeImage,
+// AllValues returns all ReportVersionDiffLineOp values.
+func (ReportVersionDiffLineOp) AllValues() []ReportVersionDiffLineOp {
+	return []ReportVersionDiffLineOp{
+		ReportVersionDiffLineOpEqual,
+		ReportVersionDiffLineOpInsert,
+		ReportVersionDiffLineOpDelete,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s RegenerateRequestType) MarshalText() ([]byte, error) {
+func (s ReportVersionDiffLineOp) MarshalText() ([]byte, error) {
 	switch s {
-	case RegenerateRequestTypeReport:
+	case ReportVersionDiffLineOpEqual:
 		return []byte(s), nil
-	case RegenerateRequestTypeGroup:
+	case ReportVersionDiffLineOpInsert:
 		return []byte(s), nil
-	case RegenerateRequestTypeImage:
+	case ReportVersionDiffLineOpDelete:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -5504,409 +11175,311 @@ func (s RegenerateRequestType) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *RegenerateRequestType) UnmarshalText(data []byte) error {
-	switch RegenerateRequestType(data) {
-	case RegenerateRequestTypeReport:
-		*s = RegenerateRequestTypeReport
+func (s *ReportVersionDiffLineOp) UnmarshalText(data []byte) error {
+	switch ReportVersionDiffLineOp(data) {
+	case ReportVersionDiffLineOpEqual:
+		*s = ReportVersionDiffLineOpEqual
 		return nil
-	case RegenerateRequestTypeGroup:
-		*s = RegenerateRequestTypeGroup
+	case ReportVersionDiffLineOpInsert:
+		*s = ReportVersionDiffLineOpInsert
 		return nil
-	case RegenerateRequestTypeImage:
-		*s = RegenerateRequestTypeImage
+	case ReportVersionDiffLineOpDelete:
+		*s = ReportVersionDiffLineOpDelete
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// Ref: #/components/schemas/RegenerateResponse
-type RegenerateResponse struct {
-	// ステータス.
-	Status string `json:"status"`
-	// メッセージ.
-	Message string `json:"message"`
-}
-
-// GetStatus returns the value of Status.
-func (s *RegenerateResponse) GetStatus() string {
-	return s.Status
+// Ref: #/components/schemas/ReportVersionForManage
+type ReportVersionForManage struct {
+	// バージョンのID.
+	VersionID string `json:"versionID"`
+	// セッションごとに1から始まるバージョンの番号.
+	Version int32 `json:"version"`
+	// レポートを生成した実装.
+	Generator string `json:"generator"`
+	// 生成に使ったモデル.
+	Model OptString `json:"model"`
+	// 生成日時.
+	CreatedAt time.Time `json:"createdAt"`
+	// 良いと評価された数.
+	GoodCount int32 `json:"goodCount"`
+	// 悪いと評価された数.
+	BadCount int32 `json:"badCount"`
+	// 公開しているかどうか.
+	Published bool `json:"published"`
+	// 公開するバージョンとして固定されているかどうか.
+	Pinned bool `json:"pinned"`
 }
 
-// GetMessage returns the value of Message.
-func (s *RegenerateResponse) GetMessage() string {
-	return s.Message
+// GetVersionID returns the value of VersionID.
+func (s *ReportVersionForManage) GetVersionID() string {
+	return s.VersionID
 }
 
-// SetStatus sets the value of Status.
-func (s *RegenerateResponse) SetStatus(val string) {
-	s.Status = val
+// GetVersion returns the value of Version.
+func (s *ReportVersionForManage) GetVersion() int32 {
+	return s.Version
 }
 
-// SetMessage sets the value of Message.
-func (s *RegenerateResponse) SetMessage(val string) {
-	s.Message = val
+// GetGenerator returns the value of Generator.
+func (s *ReportVersionForManage) GetGenerator() string {
+	return s.Generator
 }
 
-type RegisterDeviceBadRequest struct{}
-
-func (*RegisterDeviceBadRequest) registerDeviceRes() {}
-
-type RegisterDeviceReq struct {
-	DeviceToken string                    `json:"device_token"`
-	Platform    RegisterDeviceReqPlatform `json:"platform"`
-	DeviceName  OptString                 `json:"device_name"`
-	AppVersion  OptString                 `json:"app_version"`
-	OsVersion   OptString                 `json:"os_version"`
+// GetModel returns the value of Model.
+func (s *ReportVersionForManage) GetModel() OptString {
+	return s.Model
 }
 
-// GetDeviceToken returns the value of DeviceToken.
-func (s *RegisterDeviceReq) GetDeviceToken() string {
-	return s.DeviceToken
+// GetCreatedAt returns the value of CreatedAt.
+func (s *ReportVersionForManage) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-// GetPlatform returns the value of Platform.
-func (s *RegisterDeviceReq) GetPlatform() RegisterDeviceReqPlatform {
-	return s.Platform
+// GetGoodCount returns the value of GoodCount.
+func (s *ReportVersionForManage) GetGoodCount() int32 {
+	return s.GoodCount
 }
 
-// GetDeviceName returns the value of DeviceName.
-func (s *RegisterDeviceReq) GetDeviceName() OptString {
-	return s.DeviceName
+// GetBadCount returns the value of BadCount.
+func (s *ReportVersionForManage) GetBadCount() int32 {
+	return s.BadCount
 }
 
-// GetAppVersion returns the value of AppVersion.
-func (s *RegisterDeviceReq) GetAppVersion() OptString {
-	return s.AppVersion
+// GetPublished returns the value of Published.
+func (s *ReportVersionForManage) GetPublished() bool {
+	return s.Published
 }
 
-// GetOsVersion returns the value of OsVersion.
-func (s *RegisterDeviceReq) GetOsVersion() OptString {
-	return s.OsVersion
+// GetPinned returns the value of Pinned.
+func (s *ReportVersionForManage) GetPinned() bool {
+	return s.Pinned
 }
 
-// SetDeviceToken sets the value of DeviceToken.
-func (s *RegisterDeviceReq) SetDeviceToken(val string) {
-	s.DeviceToken = val
+// SetVersionID sets the value of VersionID.
+func (s *ReportVersionForManage) SetVersionID(val string) {
+	s.VersionID = val
 }
 
-// SetPlatform sets the value of Platform.
-func (s *RegisterDeviceReq) SetPlatform(val RegisterDeviceReqPlatform) {
-	s.Platform = val
+// SetVersion sets the value of Version.
+func (s *ReportVersionForManage) SetVersion(val int32) {
+	s.Version = val
 }
 
-// SetDeviceName sets the value of DeviceName.
-func (s *RegisterDeviceReq) SetDeviceName(val OptString) {
-	s.DeviceName = val
+// SetGenerator sets the value of Generator.
+func (s *ReportVersionForManage) SetGenerator(val string) {
+	s.Generator = val
 }
 
-// SetAppVersion sets the value of AppVersion.
-func (s *RegisterDeviceReq) SetAppVersion(val OptString) {
-	s.AppVersion = val
+// SetModel sets the value of Model.
+func (s *ReportVersionForManage) SetModel(val OptString) {
+	s.Model = val
 }
 
-// SetOsVersion sets the value of OsVersion.
-func (s *RegisterDeviceReq) SetOsVersion(val OptString) {
-	s.OsVersion = val
+// SetCreatedAt sets the value of CreatedAt.
+func (s *ReportVersionForManage) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
 }
 
-type RegisterDeviceReqPlatform string
-
-const (
-	RegisterDeviceReqPlatformIos     RegisterDeviceReqPlatform = "ios"
-	RegisterDeviceReqPlatformAndroid RegisterDeviceReqPlatform = "android"
-	RegisterDeviceReqPlatformWeb     RegisterDeviceReqPlatform = "web"
-)
-
-// AllValues returns all RegisterDeviceReqPlatform values.
-func (RegisterDeviceReqPlatform) AllValues() []RegisterDeviceReqPlatform {
-	return []RegisterDeviceReqPlatform{
-		RegisterDeviceReqPlatformIos,
-		RegisterDeviceReqPlatformAndroid,
-		RegisterDeviceReqPlatformWeb,
-	}
+// SetGoodCount sets the value of GoodCount.
+func (s *ReportVersionForManage) SetGoodCount(val int32) {
+	s.GoodCount = val
 }
 
-// MarshalText implements encoding.TextMarshaler.
-func (s RegisterDeviceReqPlatform) MarshalText() ([]byte, error) {
-	switch s {
-	case RegisterDeviceReqPlatformIos:
-		return []byte(s), nil
-	case RegisterDeviceReqPlatformAndroid:
-		return []byte(s), nil
-	case RegisterDeviceReqPlatformWeb:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
+// SetBadCount sets the value of BadCount.
+func (s *ReportVersionForManage) SetBadCount(val int32) {
+	s.BadCount = val
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *RegisterDeviceReqPlatform) UnmarshalText(data []byte) error {
-	switch RegisterDeviceReqPlatform(data) {
-	case RegisterDeviceReqPlatformIos:
-		*s = RegisterDeviceReqPlatformIos
-		return nil
-	case RegisterDeviceReqPlatformAndroid:
-		*s = RegisterDeviceReqPlatformAndroid
-		return nil
-	case RegisterDeviceReqPlatformWeb:
-		*s = RegisterDeviceReqPlatformWeb
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
+// SetPublished sets the value of Published.
+func (s *ReportVersionForManage) SetPublished(val bool) {
+	s.Published = val
 }
 
-type RegisterDeviceUnauthorized struct{}
-
-func (*RegisterDeviceUnauthorized) registerDeviceRes() {}
-
-// 通報解決アクション.
-// Ref: #/components/schemas/ReportAction
-type ReportAction string
-
-const (
-	ReportActionDeleted ReportAction = "deleted"
-	ReportActionHold    ReportAction = "hold"
-)
-
-// AllValues returns all ReportAction values.
-func (ReportAction) AllValues() []ReportAction {
-	return []ReportAction{
-		ReportActionDeleted,
-		ReportActionHold,
-	}
+// SetPinned sets the value of Pinned.
+func (s *ReportVersionForManage) SetPinned(val bool) {
+	s.Pinned = val
 }
 
-// MarshalText implements encoding.TextMarshaler.
-func (s ReportAction) MarshalText() ([]byte, error) {
-	switch s {
-	case ReportActionDeleted:
-		return []byte(s), nil
-	case ReportActionHold:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
+// 参加者の構成が母集団とどれだけ離れているか.
+// Ref: #/components/schemas/Representativeness
+type Representativeness struct {
+	Dimension DemographicDimension `json:"dimension"`
+	// 母集団の区分を登録した順に並べ、母集団にない区分の参加者を最後に加える.
+	Categories []CategoryComparison `json:"categories"`
+	// 意見を投稿したか投票した参加者の数。属性を登録していない参加者も含む.
+	ParticipantCount int `json:"participantCount"`
+	// 属性を登録していない参加者の数.
+	UnknownCount int `json:"unknownCount"`
+	// 割合の差の絶対値の合計の半分。0で母集団と同じ構成、1で重ならない.
+	TotalVariationDistance float64 `json:"totalVariationDistance"`
+	// 割合の差が最も大きい区分での差の絶対値.
+	MaxDeviation float64 `json:"maxDeviation"`
+	// 母集団の区分に当てはまる参加者での適合度のχ²値.
+	ChiSquare float64 `json:"chiSquare"`
+	// Χ²値の自由度.
+	DegreesOfFreedom int `json:"degreesOfFreedom"`
+	// 参加者がいない区分の母集団での割合の合計。重みを付けても補えない.
+	UncoveredShare float64 `json:"uncoveredShare"`
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *ReportAction) UnmarshalText(data []byte) error {
-	switch ReportAction(data) {
-	case ReportActionDeleted:
-		*s = ReportActionDeleted
-		return nil
-	case ReportActionHold:
-		*s = ReportActionHold
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
+// GetDimension returns the value of Dimension.
+func (s *Representativeness) GetDimension() DemographicDimension {
+	return s.Dimension
 }
 
-// Ref: #/components/schemas/ReportDetail
-type ReportDetail struct {
-	Opinion Opinion `json:"opinion"`
-	// 作成ユーザー.
-	User    User                      `json:"user"`
-	Status  ReportStatus              `json:"status"`
-	Reasons []ReportDetailReasonsItem `json:"reasons"`
-	// この意見が通報を受けた回数.
-	ReportCount int `json:"reportCount"`
+// GetCategories returns the value of Categories.
+func (s *Representativeness) GetCategories() []CategoryComparison {
+	return s.Categories
 }
 
-// GetOpinion returns the value of Opinion.
-func (s *ReportDetail) GetOpinion() Opinion {
-	return s.Opinion
+// GetParticipantCount returns the value of ParticipantCount.
+func (s *Representativeness) GetParticipantCount() int {
+	return s.ParticipantCount
 }
 
-// GetUser returns the value of User.
-func (s *ReportDetail) GetUser() User {
-	return s.User
+// GetUnknownCount returns the value of UnknownCount.
+func (s *Representativeness) GetUnknownCount() int {
+	return s.UnknownCount
 }
 
-// GetStatus returns the value of Status.
-func (s *ReportDetail) GetStatus() ReportStatus {
-	return s.Status
+// GetTotalVariationDistance returns the value of TotalVariationDistance.
+func (s *Representativeness) GetTotalVariationDistance() float64 {
+	return s.TotalVariationDistance
 }
 
-// GetReasons returns the value of Reasons.
-func (s *ReportDetail) GetReasons() []ReportDetailReasonsItem {
-	return s.Reasons
+// GetMaxDeviation returns the value of MaxDeviation.
+func (s *Representativeness) GetMaxDeviation() float64 {
+	return s.MaxDeviation
 }
 
-// GetReportCount returns the value of ReportCount.
-func (s *ReportDetail) GetReportCount() int {
-	return s.ReportCount
+// GetChiSquare returns the value of ChiSquare.
+func (s *Representativeness) GetChiSquare() float64 {
+	return s.ChiSquare
 }
 
-// SetOpinion sets the value of Opinion.
-func (s *ReportDetail) SetOpinion(val Opinion) {
-	s.Opinion = val
+// GetDegreesOfFreedom returns the value of DegreesOfFreedom.
+func (s *Representativeness) GetDegreesOfFreedom() int {
+	return s.DegreesOfFreedom
 }
 
-// SetUser sets the value of User.
-func (s *ReportDetail) SetUser(val User) {
-	s.User = val
+// GetUncoveredShare returns the value of UncoveredShare.
+func (s *Representativeness) GetUncoveredShare() float64 {
+	return s.UncoveredShare
 }
 
-// SetStatus sets the value of Status.
-func (s *ReportDetail) SetStatus(val ReportStatus) {
-	s.Status = val
+// SetDimension sets the value of Dimension.
+func (s *Representativeness) SetDimension(val DemographicDimension) {
+	s.Dimension = val
 }
 
-// SetReasons sets the value of Reasons.
-func (s *ReportDetail) SetReasons(val []ReportDetailReasonsItem) {
-	s.Reasons = val
+// SetCategories sets the value of Categories.
+func (s *Representativeness) SetCategories(val []CategoryComparison) {
+	s.Categories = val
 }
 
-// SetReportCount sets the value of ReportCount.
-func (s *ReportDetail) SetReportCount(val int) {
-	s.ReportCount = val
+// SetParticipantCount sets the value of ParticipantCount.
+func (s *Representativeness) SetParticipantCount(val int) {
+	s.ParticipantCount = val
 }
 
-func (*ReportDetail) getOpinionReportsRes() {}
-
-type ReportDetailReasonsItem struct {
-	Reason  string       `json:"reason"`
-	Content OptNilString `json:"content"`
+// SetUnknownCount sets the value of UnknownCount.
+func (s *Representativeness) SetUnknownCount(val int) {
+	s.UnknownCount = val
 }
 
-// GetReason returns the value of Reason.
-func (s *ReportDetailReasonsItem) GetReason() string {
-	return s.Reason
+// SetTotalVariationDistance sets the value of TotalVariationDistance.
+func (s *Representativeness) SetTotalVariationDistance(val float64) {
+	s.TotalVariationDistance = val
 }
 
-// GetContent returns the value of Content.
-func (s *ReportDetailReasonsItem) GetContent() OptNilString {
-	return s.Content
+// SetMaxDeviation sets the value of MaxDeviation.
+func (s *Representativeness) SetMaxDeviation(val float64) {
+	s.MaxDeviation = val
 }
 
-// SetReason sets the value of Reason.
-func (s *ReportDetailReasonsItem) SetReason(val string) {
-	s.Reason = val
+// SetChiSquare sets the value of ChiSquare.
+func (s *Representativeness) SetChiSquare(val float64) {
+	s.ChiSquare = val
 }
 
-// SetContent sets the value of Content.
-func (s *ReportDetailReasonsItem) SetContent(val OptNilString) {
-	s.Content = val
+// SetDegreesOfFreedom sets the value of DegreesOfFreedom.
+func (s *Representativeness) SetDegreesOfFreedom(val int) {
+	s.DegreesOfFreedom = val
 }
 
-type ReportOpinionBadRequest struct{}
+// SetUncoveredShare sets the value of UncoveredShare.
+func (s *Representativeness) SetUncoveredShare(val float64) {
+	s.UncoveredShare = val
+}
 
-func (*ReportOpinionBadRequest) reportOpinionRes() {}
+type RequestOrganizationOwnershipTransferBadRequest struct{}
 
-type ReportOpinionInternalServerError struct{}
+func (*RequestOrganizationOwnershipTransferBadRequest) requestOrganizationOwnershipTransferRes() {}
 
-func (*ReportOpinionInternalServerError) reportOpinionRes() {}
+type RequestOrganizationOwnershipTransferForbidden struct{}
 
-type ReportOpinionOK struct{}
+func (*RequestOrganizationOwnershipTransferForbidden) requestOrganizationOwnershipTransferRes() {}
 
-func (*ReportOpinionOK) reportOpinionRes() {}
+type RequestOrganizationOwnershipTransferInternalServerError struct{}
 
-type ReportOpinionReq struct {
-	Reason  NilInt    `json:"reason"`
-	Content NilString `json:"content"`
+func (*RequestOrganizationOwnershipTransferInternalServerError) requestOrganizationOwnershipTransferRes() {
 }
 
-// GetReason returns the value of Reason.
-func (s *ReportOpinionReq) GetReason() NilInt {
-	return s.Reason
+type RequestOrganizationOwnershipTransferReq struct {
+	RecipientDisplayID string `json:"recipientDisplayID"`
 }
 
-// GetContent returns the value of Content.
-func (s *ReportOpinionReq) GetContent() NilString {
-	return s.Content
+// GetRecipientDisplayID returns the value of RecipientDisplayID.
+func (s *RequestOrganizationOwnershipTransferReq) GetRecipientDisplayID() string {
+	return s.RecipientDisplayID
 }
 
-// SetReason sets the value of Reason.
-func (s *ReportOpinionReq) SetReason(val NilInt) {
-	s.Reason = val
+// SetRecipientDisplayID sets the value of RecipientDisplayID.
+func (s *RequestOrganizationOwnershipTransferReq) SetRecipientDisplayID(val string) {
+	s.RecipientDisplayID = val
 }
 
-// SetContent sets the value of Content.
-func (s *ReportOpinionReq) SetContent(val NilString) {
-	s.Content = val
-}
+type RequestTalkSessionOwnershipTransferBadRequest struct{}
 
-// Ref: #/components/schemas/ReportReason
-type ReportReason struct {
-	// 1.
-	ReasonID int `json:"reasonID"`
-	// 不適切な内容.
-	Reason string `json:"reason"`
-}
+func (*RequestTalkSessionOwnershipTransferBadRequest) requestTalkSessionOwnershipTransferRes() {}
 
-// GetReasonID returns the value of ReasonID.
-func (s *ReportReason) GetReasonID() int {
-	return s.ReasonID
+type RequestTalkSessionOwnershipTransferForbidden struct{}
+
+func (*RequestTalkSessionOwnershipTransferForbidden) requestTalkSessionOwnershipTransferRes() {}
+
+type RequestTalkSessionOwnershipTransferInternalServerError struct{}
+
+func (*RequestTalkSessionOwnershipTransferInternalServerError) requestTalkSessionOwnershipTransferRes() {
 }
 
-// GetReason returns the value of Reason.
-func (s *ReportReason) GetReason() string {
-	return s.Reason
+type RequestTalkSessionOwnershipTransferReq struct {
+	RecipientDisplayID string `json:"recipientDisplayID"`
 }
 
-// SetReasonID sets the value of ReasonID.
-func (s *ReportReason) SetReasonID(val int) {
-	s.ReasonID = val
+// GetRecipientDisplayID returns the value of RecipientDisplayID.
+func (s *RequestTalkSessionOwnershipTransferReq) GetRecipientDisplayID() string {
+	return s.RecipientDisplayID
 }
 
-// SetReason sets the value of Reason.
-func (s *ReportReason) SetReason(val string) {
-	s.Reason = val
+// SetRecipientDisplayID sets the value of RecipientDisplayID.
+func (s *RequestTalkSessionOwnershipTransferReq) SetRecipientDisplayID(val string) {
+	s.RecipientDisplayID = val
 }
 
-// 通報ステータス.
-// Ref: #/components/schemas/ReportStatus
-type ReportStatus string
+type ResendOrganizationInvitationBadRequest struct{}
 
-const (
-	ReportStatusUnsolved ReportStatus = "unsolved"
-	ReportStatusDeleted  ReportStatus = "deleted"
-	ReportStatusHold     ReportStatus = "hold"
-)
+func (*ResendOrganizationInvitationBadRequest) resendOrganizationInvitationRes() {}
 
-// AllValues returns all ReportStatus values.
-func (ReportStatus) AllValues() []ReportStatus {
-	return []ReportStatus{
-		ReportStatusUnsolved,
-		ReportStatusDeleted,
-		ReportStatusHold,
-	}
-}
+type ResendOrganizationInvitationInternalServerError struct{}
 
-// MarshalText implements encoding.TextMarshaler.
-func (s ReportStatus) MarshalText() ([]byte, error) {
-	switch s {
-	case ReportStatusUnsolved:
-		return []byte(s), nil
-	case ReportStatusDeleted:
-		return []byte(s), nil
-	case ReportStatusHold:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
-}
+func (*ResendOrganizationInvitationInternalServerError) resendOrganizationInvitationRes() {}
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *ReportStatus) UnmarshalText(data []byte) error {
-	switch ReportStatus(data) {
-	case ReportStatusUnsolved:
-		*s = ReportStatusUnsolved
-		return nil
-	case ReportStatusDeleted:
-		*s = ReportStatusDeleted
-		return nil
-	case ReportStatusHold:
-		*s = ReportStatusHold
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
-}
+type ResendOrganizationInvitationNotFound struct{}
+
+func (*ResendOrganizationInvitationNotFound) resendOrganizationInvitationRes() {}
 
 // Ref: #/components/schemas/Restriction
 type Restriction struct {
@@ -5946,6 +11519,38 @@ func (s *Restriction) SetDependsOn(val []string) {
 	s.DependsOn = val
 }
 
+type RevokeOrganizationApiKeyBadRequest struct{}
+
+func (*RevokeOrganizationApiKeyBadRequest) revokeOrganizationApiKeyRes() {}
+
+type RevokeOrganizationApiKeyInternalServerError struct{}
+
+func (*RevokeOrganizationApiKeyInternalServerError) revokeOrganizationApiKeyRes() {}
+
+type RevokeOrganizationApiKeyNotFound struct{}
+
+func (*RevokeOrganizationApiKeyNotFound) revokeOrganizationApiKeyRes() {}
+
+type RevokeOrganizationApiKeyOK struct{}
+
+func (*RevokeOrganizationApiKeyOK) revokeOrganizationApiKeyRes() {}
+
+type RevokeOrganizationInvitationBadRequest struct{}
+
+func (*RevokeOrganizationInvitationBadRequest) revokeOrganizationInvitationRes() {}
+
+type RevokeOrganizationInvitationInternalServerError struct{}
+
+func (*RevokeOrganizationInvitationInternalServerError) revokeOrganizationInvitationRes() {}
+
+type RevokeOrganizationInvitationNotFound struct{}
+
+func (*RevokeOrganizationInvitationNotFound) revokeOrganizationInvitationRes() {}
+
+type RevokeOrganizationInvitationOK struct{}
+
+func (*RevokeOrganizationInvitationOK) revokeOrganizationInvitationRes() {}
+
 type RevokeTokenBadRequest struct{}
 
 func (*RevokeTokenBadRequest) revokeTokenRes() {}
@@ -5971,6 +11576,32 @@ func (s *RevokeTokenNoContent) SetSetCookie(val []string) {
 
 func (*RevokeTokenNoContent) revokeTokenRes() {}
 
+type SaveTalkSessionTemplateBadRequest struct{}
+
+func (*SaveTalkSessionTemplateBadRequest) saveTalkSessionTemplateRes() {}
+
+type SaveTalkSessionTemplateForbidden struct{}
+
+func (*SaveTalkSessionTemplateForbidden) saveTalkSessionTemplateRes() {}
+
+type SaveTalkSessionTemplateInternalServerError struct{}
+
+func (*SaveTalkSessionTemplateInternalServerError) saveTalkSessionTemplateRes() {}
+
+type SaveTalkSessionTemplateReq struct {
+	Name string `json:"name"`
+}
+
+// GetName returns the value of Name.
+func (s *SaveTalkSessionTemplateReq) GetName() string {
+	return s.Name
+}
+
+// SetName sets the value of Name.
+func (s *SaveTalkSessionTemplateReq) SetName(val string) {
+	s.Name = val
+}
+
 type SendTestNotificationBadRequest struct{}
 
 func (*SendTestNotificationBadRequest) sendTestNotificationRes() {}
@@ -6092,37 +11723,236 @@ func (s *SessionsHistoryOKTalkSessionsItem) GetOpinionCount() int {
 	return s.OpinionCount
 }
 
-// SetTalkSession sets the value of TalkSession.
-func (s *SessionsHistoryOKTalkSessionsItem) SetTalkSession(val TalkSession) {
-	s.TalkSession = val
+// SetTalkSession sets the value of TalkSession.
+func (s *SessionsHistoryOKTalkSessionsItem) SetTalkSession(val TalkSession) {
+	s.TalkSession = val
+}
+
+// SetOpinionCount sets the value of OpinionCount.
+func (s *SessionsHistoryOKTalkSessionsItem) SetOpinionCount(val int) {
+	s.OpinionCount = val
+}
+
+type SessionsHistoryStatus string
+
+const (
+	SessionsHistoryStatusOpen     SessionsHistoryStatus = "open"
+	SessionsHistoryStatusFinished SessionsHistoryStatus = "finished"
+)
+
+// AllValues returns all SessionsHistoryStatus values.
+func (SessionsHistoryStatus) AllValues() []SessionsHistoryStatus {
+	return []SessionsHistoryStatus{
+		SessionsHistoryStatusOpen,
+		SessionsHistoryStatusFinished,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SessionsHistoryStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case SessionsHistoryStatusOpen:
+		return []byte(s), nil
+	case SessionsHistoryStatusFinished:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SessionsHistoryStatus) UnmarshalText(data []byte) error {
+	switch SessionsHistoryStatus(data) {
+	case SessionsHistoryStatusOpen:
+		*s = SessionsHistoryStatusOpen
+		return nil
+	case SessionsHistoryStatusFinished:
+		*s = SessionsHistoryStatusFinished
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type SetTalkSessionCollaboratorBadRequest struct{}
+
+func (*SetTalkSessionCollaboratorBadRequest) setTalkSessionCollaboratorRes() {}
+
+type SetTalkSessionCollaboratorForbidden struct{}
+
+func (*SetTalkSessionCollaboratorForbidden) setTalkSessionCollaboratorRes() {}
+
+type SetTalkSessionCollaboratorInternalServerError struct{}
+
+func (*SetTalkSessionCollaboratorInternalServerError) setTalkSessionCollaboratorRes() {}
+
+type SetTalkSessionCollaboratorReq struct {
+	Role SetTalkSessionCollaboratorReqRole `json:"role"`
+}
+
+// GetRole returns the value of Role.
+func (s *SetTalkSessionCollaboratorReq) GetRole() SetTalkSessionCollaboratorReqRole {
+	return s.Role
+}
+
+// SetRole sets the value of Role.
+func (s *SetTalkSessionCollaboratorReq) SetRole(val SetTalkSessionCollaboratorReqRole) {
+	s.Role = val
+}
+
+type SetTalkSessionCollaboratorReqRole string
+
+const (
+	SetTalkSessionCollaboratorReqRoleCoOwner     SetTalkSessionCollaboratorReqRole = "co_owner"
+	SetTalkSessionCollaboratorReqRoleFacilitator SetTalkSessionCollaboratorReqRole = "facilitator"
+	SetTalkSessionCollaboratorReqRoleModerator   SetTalkSessionCollaboratorReqRole = "moderator"
+	SetTalkSessionCollaboratorReqRoleViewer      SetTalkSessionCollaboratorReqRole = "viewer"
+)
+
+// AllValues returns all SetTalkSessionCollaboratorReqRole values.
+func (SetTalkSessionCollaboratorReqRole) AllValues() []SetTalkSessionCollaboratorReqRole {
+	return []SetTalkSessionCollaboratorReqRole{
+		SetTalkSessionCollaboratorReqRoleCoOwner,
+		SetTalkSessionCollaboratorReqRoleFacilitator,
+		SetTalkSessionCollaboratorReqRoleModerator,
+		SetTalkSessionCollaboratorReqRoleViewer,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SetTalkSessionCollaboratorReqRole) MarshalText() ([]byte, error) {
+	switch s {
+	case SetTalkSessionCollaboratorReqRoleCoOwner:
+		return []byte(s), nil
+	case SetTalkSessionCollaboratorReqRoleFacilitator:
+		return []byte(s), nil
+	case SetTalkSessionCollaboratorReqRoleModerator:
+		return []byte(s), nil
+	case SetTalkSessionCollaboratorReqRoleViewer:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SetTalkSessionCollaboratorReqRole) UnmarshalText(data []byte) error {
+	switch SetTalkSessionCollaboratorReqRole(data) {
+	case SetTalkSessionCollaboratorReqRoleCoOwner:
+		*s = SetTalkSessionCollaboratorReqRoleCoOwner
+		return nil
+	case SetTalkSessionCollaboratorReqRoleFacilitator:
+		*s = SetTalkSessionCollaboratorReqRoleFacilitator
+		return nil
+	case SetTalkSessionCollaboratorReqRoleModerator:
+		*s = SetTalkSessionCollaboratorReqRoleModerator
+		return nil
+	case SetTalkSessionCollaboratorReqRoleViewer:
+		*s = SetTalkSessionCollaboratorReqRoleViewer
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/SigningKeyForManage
+type SigningKeyForManage struct {
+	// 鍵ID.
+	KeyID string `json:"keyID"`
+	// 署名アルゴリズム.
+	Algorithm string `json:"algorithm"`
+	// 状態.
+	Status SigningKeyForManageStatus `json:"status"`
+	// 作成日時.
+	CreatedAt time.Time `json:"createdAt"`
+	// 失効日時.
+	RetiredAt OptDateTime `json:"retiredAt"`
+	// 検証可能期限.
+	VerifyUntil OptDateTime `json:"verifyUntil"`
+}
+
+// GetKeyID returns the value of KeyID.
+func (s *SigningKeyForManage) GetKeyID() string {
+	return s.KeyID
+}
+
+// GetAlgorithm returns the value of Algorithm.
+func (s *SigningKeyForManage) GetAlgorithm() string {
+	return s.Algorithm
+}
+
+// GetStatus returns the value of Status.
+func (s *SigningKeyForManage) GetStatus() SigningKeyForManageStatus {
+	return s.Status
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *SigningKeyForManage) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetRetiredAt returns the value of RetiredAt.
+func (s *SigningKeyForManage) GetRetiredAt() OptDateTime {
+	return s.RetiredAt
+}
+
+// GetVerifyUntil returns the value of VerifyUntil.
+func (s *SigningKeyForManage) GetVerifyUntil() OptDateTime {
+	return s.VerifyUntil
+}
+
+// SetKeyID sets the value of KeyID.
+func (s *SigningKeyForManage) SetKeyID(val string) {
+	s.KeyID = val
+}
+
+// SetAlgorithm sets the value of Algorithm.
+func (s *SigningKeyForManage) SetAlgorithm(val string) {
+	s.Algorithm = val
+}
+
+// SetStatus sets the value of Status.
+func (s *SigningKeyForManage) SetStatus(val SigningKeyForManageStatus) {
+	s.Status = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *SigningKeyForManage) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
 }
 
-// SetOpinionCount sets the value of OpinionCount.
-func (s *SessionsHistoryOKTalkSessionsItem) SetOpinionCount(val int) {
-	s.OpinionCount = val
+// SetRetiredAt sets the value of RetiredAt.
+func (s *SigningKeyForManage) SetRetiredAt(val OptDateTime) {
+	s.RetiredAt = val
 }
 
-type SessionsHistoryStatus string
+// SetVerifyUntil sets the value of VerifyUntil.
+func (s *SigningKeyForManage) SetVerifyUntil(val OptDateTime) {
+	s.VerifyUntil = val
+}
+
+// 状態.
+type SigningKeyForManageStatus string
 
 const (
-	SessionsHistoryStatusOpen     SessionsHistoryStatus = "open"
-	SessionsHistoryStatusFinished SessionsHistoryStatus = "finished"
+	SigningKeyForManageStatusActive  SigningKeyForManageStatus = "active"
+	SigningKeyForManageStatusRetired SigningKeyForManageStatus = "retired"
 )
 
-// AllValues returns all SessionsHistoryStatus values.
-func (SessionsHistoryStatus) AllValues() []SessionsHistoryStatus {
-	return []SessionsHistoryStatus{
-		SessionsHistoryStatusOpen,
-		SessionsHistoryStatusFinished,
+// AllValues returns all SigningKeyForManageStatus values.
+func (SigningKeyForManageStatus) AllValues() []SigningKeyForManageStatus {
+	return []SigningKeyForManageStatus{
+		SigningKeyForManageStatusActive,
+		SigningKeyForManageStatusRetired,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s SessionsHistoryStatus) MarshalText() ([]byte, error) {
+func (s SigningKeyForManageStatus) MarshalText() ([]byte, error) {
 	switch s {
-	case SessionsHistoryStatusOpen:
+	case SigningKeyForManageStatusActive:
 		return []byte(s), nil
-	case SessionsHistoryStatusFinished:
+	case SigningKeyForManageStatusRetired:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -6130,13 +11960,13 @@ func (s SessionsHistoryStatus) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *SessionsHistoryStatus) UnmarshalText(data []byte) error {
-	switch SessionsHistoryStatus(data) {
-	case SessionsHistoryStatusOpen:
-		*s = SessionsHistoryStatusOpen
+func (s *SigningKeyForManageStatus) UnmarshalText(data []byte) error {
+	switch SigningKeyForManageStatus(data) {
+	case SigningKeyForManageStatusActive:
+		*s = SigningKeyForManageStatusActive
 		return nil
-	case SessionsHistoryStatusFinished:
-		*s = SessionsHistoryStatusFinished
+	case SigningKeyForManageStatusRetired:
+		*s = SigningKeyForManageStatusRetired
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
@@ -6305,6 +12135,8 @@ type TalkSession struct {
 	HideReport bool `json:"hideReport"`
 	// トップに表示するかどうか.
 	HideTop OptNilBool `json:"hideTop"`
+	// 下書きかどうか。下書きはオーナー以外参加できない.
+	IsDraft OptBool `json:"isDraft"`
 }
 
 // GetID returns the value of ID.
@@ -6377,6 +12209,11 @@ func (s *TalkSession) GetHideTop() OptNilBool {
 	return s.HideTop
 }
 
+// GetIsDraft returns the value of IsDraft.
+func (s *TalkSession) GetIsDraft() OptBool {
+	return s.IsDraft
+}
+
 // SetID sets the value of ID.
 func (s *TalkSession) SetID(val string) {
 	s.ID = val
@@ -6447,9 +12284,17 @@ func (s *TalkSession) SetHideTop(val OptNilBool) {
 	s.HideTop = val
 }
 
-func (*TalkSession) editTalkSessionRes()      {}
-func (*TalkSession) getTalkSessionDetailRes() {}
-func (*TalkSession) initiateTalkSessionRes()  {}
+// SetIsDraft sets the value of IsDraft.
+func (s *TalkSession) SetIsDraft(val OptBool) {
+	s.IsDraft = val
+}
+
+func (*TalkSession) cloneTalkSessionRes()         {}
+func (*TalkSession) cloneTalkSessionTemplateRes() {}
+func (*TalkSession) editTalkSessionRes()          {}
+func (*TalkSession) getTalkSessionDetailRes()     {}
+func (*TalkSession) initiateTalkSessionRes()      {}
+func (*TalkSession) publishTalkSessionRes()       {}
 
 type TalkSessionAnalysisBadRequest struct {
 	Code    string `json:"code"`
@@ -6547,6 +12392,8 @@ type TalkSessionAnalysisOKGroupOpinionsItem struct {
 	GroupName string                                               `json:"groupName"`
 	GroupID   int                                                  `json:"groupID"`
 	Opinions  []TalkSessionAnalysisOKGroupOpinionsItemOpinionsItem `json:"opinions"`
+	// グループのメンバーが重要だと印を付けた数が多い順の意見.
+	ImportantOpinions []ImportantOpinion `json:"importantOpinions"`
 }
 
 // GetGroupName returns the value of GroupName.
@@ -6564,6 +12411,11 @@ func (s *TalkSessionAnalysisOKGroupOpinionsItem) GetOpinions() []TalkSessionAnal
 	return s.Opinions
 }
 
+// GetImportantOpinions returns the value of ImportantOpinions.
+func (s *TalkSessionAnalysisOKGroupOpinionsItem) GetImportantOpinions() []ImportantOpinion {
+	return s.ImportantOpinions
+}
+
 // SetGroupName sets the value of GroupName.
 func (s *TalkSessionAnalysisOKGroupOpinionsItem) SetGroupName(val string) {
 	s.GroupName = val
@@ -6579,6 +12431,11 @@ func (s *TalkSessionAnalysisOKGroupOpinionsItem) SetOpinions(val []TalkSessionAn
 	s.Opinions = val
 }
 
+// SetImportantOpinions sets the value of ImportantOpinions.
+func (s *TalkSessionAnalysisOKGroupOpinionsItem) SetImportantOpinions(val []ImportantOpinion) {
+	s.ImportantOpinions = val
+}
+
 type TalkSessionAnalysisOKGroupOpinionsItemOpinionsItem struct {
 	Opinion       Opinion `json:"opinion"`
 	User          User    `json:"user"`
@@ -6637,6 +12494,106 @@ func (s *TalkSessionAnalysisOKGroupOpinionsItemOpinionsItem) SetPassCount(val in
 	s.PassCount = val
 }
 
+// セッションの共同管理者
+// - co_owner:
+// セッションの編集・結論・タイムライン・通報対応・共同管理者の管理
+// - facilitator: 結論・タイムライン・通報対応
+// - moderator: 通報対応
+// - viewer: 閲覧のみ.
+// Ref: #/components/schemas/TalkSessionCollaborator
+type TalkSessionCollaborator struct {
+	User      User                        `json:"user"`
+	Role      TalkSessionCollaboratorRole `json:"role"`
+	CreatedAt string                      `json:"createdAt"`
+}
+
+// GetUser returns the value of User.
+func (s *TalkSessionCollaborator) GetUser() User {
+	return s.User
+}
+
+// GetRole returns the value of Role.
+func (s *TalkSessionCollaborator) GetRole() TalkSessionCollaboratorRole {
+	return s.Role
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *TalkSessionCollaborator) GetCreatedAt() string {
+	return s.CreatedAt
+}
+
+// SetUser sets the value of User.
+func (s *TalkSessionCollaborator) SetUser(val User) {
+	s.User = val
+}
+
+// SetRole sets the value of Role.
+func (s *TalkSessionCollaborator) SetRole(val TalkSessionCollaboratorRole) {
+	s.Role = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *TalkSessionCollaborator) SetCreatedAt(val string) {
+	s.CreatedAt = val
+}
+
+func (*TalkSessionCollaborator) setTalkSessionCollaboratorRes() {}
+
+type TalkSessionCollaboratorRole string
+
+const (
+	TalkSessionCollaboratorRoleCoOwner     TalkSessionCollaboratorRole = "co_owner"
+	TalkSessionCollaboratorRoleFacilitator TalkSessionCollaboratorRole = "facilitator"
+	TalkSessionCollaboratorRoleModerator   TalkSessionCollaboratorRole = "moderator"
+	TalkSessionCollaboratorRoleViewer      TalkSessionCollaboratorRole = "viewer"
+)
+
+// AllValues returns all TalkSessionCollaboratorRole values.
+func (TalkSessionCollaboratorRole) AllValues() []TalkSessionCollaboratorRole {
+	return []TalkSessionCollaboratorRole{
+		TalkSessionCollaboratorRoleCoOwner,
+		TalkSessionCollaboratorRoleFacilitator,
+		TalkSessionCollaboratorRoleModerator,
+		TalkSessionCollaboratorRoleViewer,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s TalkSessionCollaboratorRole) MarshalText() ([]byte, error) {
+	switch s {
+	case TalkSessionCollaboratorRoleCoOwner:
+		return []byte(s), nil
+	case TalkSessionCollaboratorRoleFacilitator:
+		return []byte(s), nil
+	case TalkSessionCollaboratorRoleModerator:
+		return []byte(s), nil
+	case TalkSessionCollaboratorRoleViewer:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TalkSessionCollaboratorRole) UnmarshalText(data []byte) error {
+	switch TalkSessionCollaboratorRole(data) {
+	case TalkSessionCollaboratorRoleCoOwner:
+		*s = TalkSessionCollaboratorRoleCoOwner
+		return nil
+	case TalkSessionCollaboratorRoleFacilitator:
+		*s = TalkSessionCollaboratorRoleFacilitator
+		return nil
+	case TalkSessionCollaboratorRoleModerator:
+		*s = TalkSessionCollaboratorRoleModerator
+		return nil
+	case TalkSessionCollaboratorRoleViewer:
+		*s = TalkSessionCollaboratorRoleViewer
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 // Ref: #/components/schemas/TalkSessionForManage
 type TalkSessionForManage struct {
 	TalkSessionID    string        `json:"talkSessionID"`
@@ -6942,19 +12899,290 @@ func (s *TalkSessionStats) SetOpinionCount(val int32) {
 	s.OpinionCount = val
 }
 
-// SetOpinionUserCount sets the value of OpinionUserCount.
-func (s *TalkSessionStats) SetOpinionUserCount(val int32) {
-	s.OpinionUserCount = val
+// SetOpinionUserCount sets the value of OpinionUserCount.
+func (s *TalkSessionStats) SetOpinionUserCount(val int32) {
+	s.OpinionUserCount = val
+}
+
+// SetVoteCount sets the value of VoteCount.
+func (s *TalkSessionStats) SetVoteCount(val int32) {
+	s.VoteCount = val
+}
+
+// SetVoteUserCount sets the value of VoteUserCount.
+func (s *TalkSessionStats) SetVoteUserCount(val int32) {
+	s.VoteUserCount = val
+}
+
+// 組織内で使い回すセッションのひな形。投票や参加者の情報は含まない.
+// Ref: #/components/schemas/TalkSessionTemplate
+type TalkSessionTemplate struct {
+	ID string `json:"id"`
+	// テンプレート名.
+	Name         string        `json:"name"`
+	Theme        string        `json:"theme"`
+	Description  OptNilString  `json:"description"`
+	ThumbnailURL OptNilString  `json:"thumbnailURL"`
+	City         OptNilString  `json:"city"`
+	Prefecture   OptNilString  `json:"prefecture"`
+	Restrictions []Restriction `json:"restrictions"`
+	HideTop      bool          `json:"hideTop"`
+	// 複製時に作成されるシード意見.
+	SeedOpinions []TalkSessionTemplateSeedOpinion `json:"seedOpinions"`
+	CreatedAt    string                           `json:"createdAt"`
+}
+
+// GetID returns the value of ID.
+func (s *TalkSessionTemplate) GetID() string {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *TalkSessionTemplate) GetName() string {
+	return s.Name
+}
+
+// GetTheme returns the value of Theme.
+func (s *TalkSessionTemplate) GetTheme() string {
+	return s.Theme
+}
+
+// GetDescription returns the value of Description.
+func (s *TalkSessionTemplate) GetDescription() OptNilString {
+	return s.Description
+}
+
+// GetThumbnailURL returns the value of ThumbnailURL.
+func (s *TalkSessionTemplate) GetThumbnailURL() OptNilString {
+	return s.ThumbnailURL
+}
+
+// GetCity returns the value of City.
+func (s *TalkSessionTemplate) GetCity() OptNilString {
+	return s.City
+}
+
+// GetPrefecture returns the value of Prefecture.
+func (s *TalkSessionTemplate) GetPrefecture() OptNilString {
+	return s.Prefecture
+}
+
+// GetRestrictions returns the value of Restrictions.
+func (s *TalkSessionTemplate) GetRestrictions() []Restriction {
+	return s.Restrictions
+}
+
+// GetHideTop returns the value of HideTop.
+func (s *TalkSessionTemplate) GetHideTop() bool {
+	return s.HideTop
+}
+
+// GetSeedOpinions returns the value of SeedOpinions.
+func (s *TalkSessionTemplate) GetSeedOpinions() []TalkSessionTemplateSeedOpinion {
+	return s.SeedOpinions
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *TalkSessionTemplate) GetCreatedAt() string {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *TalkSessionTemplate) SetID(val string) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *TalkSessionTemplate) SetName(val string) {
+	s.Name = val
+}
+
+// SetTheme sets the value of Theme.
+func (s *TalkSessionTemplate) SetTheme(val string) {
+	s.Theme = val
+}
+
+// SetDescription sets the value of Description.
+func (s *TalkSessionTemplate) SetDescription(val OptNilString) {
+	s.Description = val
+}
+
+// SetThumbnailURL sets the value of ThumbnailURL.
+func (s *TalkSessionTemplate) SetThumbnailURL(val OptNilString) {
+	s.ThumbnailURL = val
+}
+
+// SetCity sets the value of City.
+func (s *TalkSessionTemplate) SetCity(val OptNilString) {
+	s.City = val
+}
+
+// SetPrefecture sets the value of Prefecture.
+func (s *TalkSessionTemplate) SetPrefecture(val OptNilString) {
+	s.Prefecture = val
+}
+
+// SetRestrictions sets the value of Restrictions.
+func (s *TalkSessionTemplate) SetRestrictions(val []Restriction) {
+	s.Restrictions = val
+}
+
+// SetHideTop sets the value of HideTop.
+func (s *TalkSessionTemplate) SetHideTop(val bool) {
+	s.HideTop = val
+}
+
+// SetSeedOpinions sets the value of SeedOpinions.
+func (s *TalkSessionTemplate) SetSeedOpinions(val []TalkSessionTemplateSeedOpinion) {
+	s.SeedOpinions = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *TalkSessionTemplate) SetCreatedAt(val string) {
+	s.CreatedAt = val
+}
+
+func (*TalkSessionTemplate) saveTalkSessionTemplateRes() {}
+
+// Ref: #/components/schemas/TalkSessionTemplateSeedOpinion
+type TalkSessionTemplateSeedOpinion struct {
+	Title        OptNilString `json:"title"`
+	Content      string       `json:"content"`
+	ReferenceURL OptNilString `json:"referenceURL"`
+	PictureURL   OptNilString `json:"pictureURL"`
+}
+
+// GetTitle returns the value of Title.
+func (s *TalkSessionTemplateSeedOpinion) GetTitle() OptNilString {
+	return s.Title
+}
+
+// GetContent returns the value of Content.
+func (s *TalkSessionTemplateSeedOpinion) GetContent() string {
+	return s.Content
+}
+
+// GetReferenceURL returns the value of ReferenceURL.
+func (s *TalkSessionTemplateSeedOpinion) GetReferenceURL() OptNilString {
+	return s.ReferenceURL
+}
+
+// GetPictureURL returns the value of PictureURL.
+func (s *TalkSessionTemplateSeedOpinion) GetPictureURL() OptNilString {
+	return s.PictureURL
+}
+
+// SetTitle sets the value of Title.
+func (s *TalkSessionTemplateSeedOpinion) SetTitle(val OptNilString) {
+	s.Title = val
+}
+
+// SetContent sets the value of Content.
+func (s *TalkSessionTemplateSeedOpinion) SetContent(val string) {
+	s.Content = val
+}
+
+// SetReferenceURL sets the value of ReferenceURL.
+func (s *TalkSessionTemplateSeedOpinion) SetReferenceURL(val OptNilString) {
+	s.ReferenceURL = val
+}
+
+// SetPictureURL sets the value of PictureURL.
+func (s *TalkSessionTemplateSeedOpinion) SetPictureURL(val OptNilString) {
+	s.PictureURL = val
+}
+
+// ワードクラウドの単語の集計.
+// Ref: #/components/schemas/TermFrequencies
+type TermFrequencies struct {
+	// 集計から描画したワードクラウドの画像.
+	WordcloudURL string `json:"wordcloudURL"`
+	// セッション全体で出現回数が多い順の単語.
+	Overall []TermScore `json:"overall"`
+	// グループごとの特徴的な単語。グループID順.
+	Groups      []GroupTerms `json:"groups"`
+	GeneratedAt string       `json:"generatedAt"`
+}
+
+// GetWordcloudURL returns the value of WordcloudURL.
+func (s *TermFrequencies) GetWordcloudURL() string {
+	return s.WordcloudURL
+}
+
+// GetOverall returns the value of Overall.
+func (s *TermFrequencies) GetOverall() []TermScore {
+	return s.Overall
+}
+
+// GetGroups returns the value of Groups.
+func (s *TermFrequencies) GetGroups() []GroupTerms {
+	return s.Groups
+}
+
+// GetGeneratedAt returns the value of GeneratedAt.
+func (s *TermFrequencies) GetGeneratedAt() string {
+	return s.GeneratedAt
+}
+
+// SetWordcloudURL sets the value of WordcloudURL.
+func (s *TermFrequencies) SetWordcloudURL(val string) {
+	s.WordcloudURL = val
+}
+
+// SetOverall sets the value of Overall.
+func (s *TermFrequencies) SetOverall(val []TermScore) {
+	s.Overall = val
+}
+
+// SetGroups sets the value of Groups.
+func (s *TermFrequencies) SetGroups(val []GroupTerms) {
+	s.Groups = val
+}
+
+// SetGeneratedAt sets the value of GeneratedAt.
+func (s *TermFrequencies) SetGeneratedAt(val string) {
+	s.GeneratedAt = val
+}
+
+func (*TermFrequencies) getTermFrequenciesRes() {}
+
+// 単語の出現回数と重要度.
+// Ref: #/components/schemas/TermScore
+type TermScore struct {
+	Term  string `json:"term"`
+	Count int    `json:"count"`
+	// セッション全体では最も多い単語を1とした出現回数の割合、グループではTF-IDF.
+	Score float64 `json:"score"`
+}
+
+// GetTerm returns the value of Term.
+func (s *TermScore) GetTerm() string {
+	return s.Term
+}
+
+// GetCount returns the value of Count.
+func (s *TermScore) GetCount() int {
+	return s.Count
+}
+
+// GetScore returns the value of Score.
+func (s *TermScore) GetScore() float64 {
+	return s.Score
+}
+
+// SetTerm sets the value of Term.
+func (s *TermScore) SetTerm(val string) {
+	s.Term = val
 }
 
-// SetVoteCount sets the value of VoteCount.
-func (s *TalkSessionStats) SetVoteCount(val int32) {
-	s.VoteCount = val
+// SetCount sets the value of Count.
+func (s *TermScore) SetCount(val int) {
+	s.Count = val
 }
 
-// SetVoteUserCount sets the value of VoteUserCount.
-func (s *TalkSessionStats) SetVoteUserCount(val int32) {
-	s.VoteUserCount = val
+// SetScore sets the value of Score.
+func (s *TermScore) SetScore(val float64) {
+	s.Score = val
 }
 
 type TestBadRequest struct{}
@@ -7278,6 +13506,164 @@ func (s *TokenClaim) SetOrganizationID(val OptNilString) {
 
 func (*TokenClaim) getTokenInfoRes() {}
 
+// 本文の単語が似ている意見のまとまり.
+// Ref: #/components/schemas/Topic
+type Topic struct {
+	TopicID int `json:"topicID"`
+	// トピックで重要な順の単語。トピックのラベルに使う.
+	Terms []string `json:"terms"`
+	// トピックに属する意見の数。opinionsはこのうちopinionLimit・opinionOffsetで指定された範囲.
+	OpinionCount int `json:"opinionCount"`
+	// トピックの意見への投票数の合計.
+	AgreeCount    int `json:"agreeCount"`
+	DisagreeCount int `json:"disagreeCount"`
+	PassCount     int `json:"passCount"`
+	// グループごとの投票数の合計。グループID順.
+	Groups []GroupAgreement `json:"groups"`
+	// 投票が多い順の意見.
+	Opinions []TopicOpinion `json:"opinions"`
+}
+
+// GetTopicID returns the value of TopicID.
+func (s *Topic) GetTopicID() int {
+	return s.TopicID
+}
+
+// GetTerms returns the value of Terms.
+func (s *Topic) GetTerms() []string {
+	return s.Terms
+}
+
+// GetOpinionCount returns the value of OpinionCount.
+func (s *Topic) GetOpinionCount() int {
+	return s.OpinionCount
+}
+
+// GetAgreeCount returns the value of AgreeCount.
+func (s *Topic) GetAgreeCount() int {
+	return s.AgreeCount
+}
+
+// GetDisagreeCount returns the value of DisagreeCount.
+func (s *Topic) GetDisagreeCount() int {
+	return s.DisagreeCount
+}
+
+// GetPassCount returns the value of PassCount.
+func (s *Topic) GetPassCount() int {
+	return s.PassCount
+}
+
+// GetGroups returns the value of Groups.
+func (s *Topic) GetGroups() []GroupAgreement {
+	return s.Groups
+}
+
+// GetOpinions returns the value of Opinions.
+func (s *Topic) GetOpinions() []TopicOpinion {
+	return s.Opinions
+}
+
+// SetTopicID sets the value of TopicID.
+func (s *Topic) SetTopicID(val int) {
+	s.TopicID = val
+}
+
+// SetTerms sets the value of Terms.
+func (s *Topic) SetTerms(val []string) {
+	s.Terms = val
+}
+
+// SetOpinionCount sets the value of OpinionCount.
+func (s *Topic) SetOpinionCount(val int) {
+	s.OpinionCount = val
+}
+
+// SetAgreeCount sets the value of AgreeCount.
+func (s *Topic) SetAgreeCount(val int) {
+	s.AgreeCount = val
+}
+
+// SetDisagreeCount sets the value of DisagreeCount.
+func (s *Topic) SetDisagreeCount(val int) {
+	s.DisagreeCount = val
+}
+
+// SetPassCount sets the value of PassCount.
+func (s *Topic) SetPassCount(val int) {
+	s.PassCount = val
+}
+
+// SetGroups sets the value of Groups.
+func (s *Topic) SetGroups(val []GroupAgreement) {
+	s.Groups = val
+}
+
+// SetOpinions sets the value of Opinions.
+func (s *Topic) SetOpinions(val []TopicOpinion) {
+	s.Opinions = val
+}
+
+// トピックに属する意見と投票数.
+// Ref: #/components/schemas/TopicOpinion
+type TopicOpinion struct {
+	Opinion       Opinion `json:"opinion"`
+	User          User    `json:"user"`
+	AgreeCount    int     `json:"agreeCount"`
+	DisagreeCount int     `json:"disagreeCount"`
+	PassCount     int     `json:"passCount"`
+}
+
+// GetOpinion returns the value of Opinion.
+func (s *TopicOpinion) GetOpinion() Opinion {
+	return s.Opinion
+}
+
+// GetUser returns the value of User.
+func (s *TopicOpinion) GetUser() User {
+	return s.User
+}
+
+// GetAgreeCount returns the value of AgreeCount.
+func (s *TopicOpinion) GetAgreeCount() int {
+	return s.AgreeCount
+}
+
+// GetDisagreeCount returns the value of DisagreeCount.
+func (s *TopicOpinion) GetDisagreeCount() int {
+	return s.DisagreeCount
+}
+
+// GetPassCount returns the value of PassCount.
+func (s *TopicOpinion) GetPassCount() int {
+	return s.PassCount
+}
+
+// SetOpinion sets the value of Opinion.
+func (s *TopicOpinion) SetOpinion(val Opinion) {
+	s.Opinion = val
+}
+
+// SetUser sets the value of User.
+func (s *TopicOpinion) SetUser(val User) {
+	s.User = val
+}
+
+// SetAgreeCount sets the value of AgreeCount.
+func (s *TopicOpinion) SetAgreeCount(val int) {
+	s.AgreeCount = val
+}
+
+// SetDisagreeCount sets the value of DisagreeCount.
+func (s *TopicOpinion) SetDisagreeCount(val int) {
+	s.DisagreeCount = val
+}
+
+// SetPassCount sets the value of PassCount.
+func (s *TopicOpinion) SetPassCount(val int) {
+	s.PassCount = val
+}
+
 type UpdateNotificationPreferencesBadRequest struct{}
 
 func (*UpdateNotificationPreferencesBadRequest) updateNotificationPreferencesRes() {}
@@ -7312,6 +13698,112 @@ type UpdateOrganizationOK struct{}
 
 func (*UpdateOrganizationOK) updateOrganizationRes() {}
 
+type UpdateOrganizationParentBadRequest struct{}
+
+func (*UpdateOrganizationParentBadRequest) updateOrganizationParentRes() {}
+
+type UpdateOrganizationParentForbidden struct{}
+
+func (*UpdateOrganizationParentForbidden) updateOrganizationParentRes() {}
+
+type UpdateOrganizationParentInternalServerError struct{}
+
+func (*UpdateOrganizationParentInternalServerError) updateOrganizationParentRes() {}
+
+type UpdateOrganizationParentReq struct {
+	// 親組織の組織コード.
+	ParentCode OptString `json:"parentCode"`
+}
+
+// GetParentCode returns the value of ParentCode.
+func (s *UpdateOrganizationParentReq) GetParentCode() OptString {
+	return s.ParentCode
+}
+
+// SetParentCode sets the value of ParentCode.
+func (s *UpdateOrganizationParentReq) SetParentCode(val OptString) {
+	s.ParentCode = val
+}
+
+type UpdateOrganizationReferenceDemographicsBadRequest struct{}
+
+func (*UpdateOrganizationReferenceDemographicsBadRequest) updateOrganizationReferenceDemographicsRes() {
+}
+
+type UpdateOrganizationReferenceDemographicsForbidden struct{}
+
+func (*UpdateOrganizationReferenceDemographicsForbidden) updateOrganizationReferenceDemographicsRes() {
+}
+
+type UpdateOrganizationReferenceDemographicsInternalServerError struct{}
+
+func (*UpdateOrganizationReferenceDemographicsInternalServerError) updateOrganizationReferenceDemographicsRes() {
+}
+
+type UpdateOrganizationReferenceDemographicsReq struct {
+	Dimension DemographicDimension `json:"dimension"`
+	File      ht.MultipartFile     `json:"file"`
+}
+
+// GetDimension returns the value of Dimension.
+func (s *UpdateOrganizationReferenceDemographicsReq) GetDimension() DemographicDimension {
+	return s.Dimension
+}
+
+// GetFile returns the value of File.
+func (s *UpdateOrganizationReferenceDemographicsReq) GetFile() ht.MultipartFile {
+	return s.File
+}
+
+// SetDimension sets the value of Dimension.
+func (s *UpdateOrganizationReferenceDemographicsReq) SetDimension(val DemographicDimension) {
+	s.Dimension = val
+}
+
+// SetFile sets the value of File.
+func (s *UpdateOrganizationReferenceDemographicsReq) SetFile(val ht.MultipartFile) {
+	s.File = val
+}
+
+type UpdateOrganizationReportPromptBadRequest struct{}
+
+func (*UpdateOrganizationReportPromptBadRequest) updateOrganizationReportPromptRes() {}
+
+type UpdateOrganizationReportPromptForbidden struct{}
+
+func (*UpdateOrganizationReportPromptForbidden) updateOrganizationReportPromptRes() {}
+
+type UpdateOrganizationReportPromptInternalServerError struct{}
+
+func (*UpdateOrganizationReportPromptInternalServerError) updateOrganizationReportPromptRes() {}
+
+type UpdateOrganizationReportPromptReq struct {
+	// LLMの役割。空の場合は既定の指示を使う.
+	SystemPrompt OptString `json:"systemPrompt"`
+	// 文体や重視する観点など、組織ごとに追加する指示.
+	Instructions OptString `json:"instructions"`
+}
+
+// GetSystemPrompt returns the value of SystemPrompt.
+func (s *UpdateOrganizationReportPromptReq) GetSystemPrompt() OptString {
+	return s.SystemPrompt
+}
+
+// GetInstructions returns the value of Instructions.
+func (s *UpdateOrganizationReportPromptReq) GetInstructions() OptString {
+	return s.Instructions
+}
+
+// SetSystemPrompt sets the value of SystemPrompt.
+func (s *UpdateOrganizationReportPromptReq) SetSystemPrompt(val OptString) {
+	s.SystemPrompt = val
+}
+
+// SetInstructions sets the value of Instructions.
+func (s *UpdateOrganizationReportPromptReq) SetInstructions(val OptString) {
+	s.Instructions = val
+}
+
 type UpdateOrganizationReq struct {
 	// 組織名.
 	Name string `json:"name"`
@@ -7905,6 +14397,8 @@ func (*Vote2OKApplicationJSON) vote2Res() {}
 
 type Vote2Req struct {
 	VoteStatus string `json:"voteStatus"`
+	// 重要な意見として印を付けるか。省略した場合は変更しない.
+	IsImportant OptBool `json:"isImportant"`
 }
 
 // GetVoteStatus returns the value of VoteStatus.
@@ -7912,11 +14406,172 @@ func (s *Vote2Req) GetVoteStatus() string {
 	return s.VoteStatus
 }
 
+// GetIsImportant returns the value of IsImportant.
+func (s *Vote2Req) GetIsImportant() OptBool {
+	return s.IsImportant
+}
+
 // SetVoteStatus sets the value of VoteStatus.
 func (s *Vote2Req) SetVoteStatus(val string) {
 	s.VoteStatus = val
 }
 
+// SetIsImportant sets the value of IsImportant.
+func (s *Vote2Req) SetIsImportant(val OptBool) {
+	s.IsImportant = val
+}
+
+// 最初の投票から最後の投票への変化.
+// Ref: #/components/schemas/VoteShift
+type VoteShift struct {
+	// 最初の投票.
+	From VoteShiftFrom `json:"from"`
+	// 最後の投票.
+	To VoteShiftTo `json:"to"`
+	// 変化したユーザー数.
+	UserCount int `json:"userCount"`
+	// 返信に投票してから変化したユーザー数.
+	AfterRepliesUserCount int `json:"afterRepliesUserCount"`
+}
+
+// GetFrom returns the value of From.
+func (s *VoteShift) GetFrom() VoteShiftFrom {
+	return s.From
+}
+
+// GetTo returns the value of To.
+func (s *VoteShift) GetTo() VoteShiftTo {
+	return s.To
+}
+
+// GetUserCount returns the value of UserCount.
+func (s *VoteShift) GetUserCount() int {
+	return s.UserCount
+}
+
+// GetAfterRepliesUserCount returns the value of AfterRepliesUserCount.
+func (s *VoteShift) GetAfterRepliesUserCount() int {
+	return s.AfterRepliesUserCount
+}
+
+// SetFrom sets the value of From.
+func (s *VoteShift) SetFrom(val VoteShiftFrom) {
+	s.From = val
+}
+
+// SetTo sets the value of To.
+func (s *VoteShift) SetTo(val VoteShiftTo) {
+	s.To = val
+}
+
+// SetUserCount sets the value of UserCount.
+func (s *VoteShift) SetUserCount(val int) {
+	s.UserCount = val
+}
+
+// SetAfterRepliesUserCount sets the value of AfterRepliesUserCount.
+func (s *VoteShift) SetAfterRepliesUserCount(val int) {
+	s.AfterRepliesUserCount = val
+}
+
+// 最初の投票.
+type VoteShiftFrom string
+
+const (
+	VoteShiftFromAgree    VoteShiftFrom = "agree"
+	VoteShiftFromDisagree VoteShiftFrom = "disagree"
+	VoteShiftFromPass     VoteShiftFrom = "pass"
+)
+
+// AllValues returns all VoteShiftFrom values.
+func (VoteShiftFrom) AllValues() []VoteShiftFrom {
+	return []VoteShiftFrom{
+		VoteShiftFromAgree,
+		VoteShiftFromDisagree,
+		VoteShiftFromPass,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s VoteShiftFrom) MarshalText() ([]byte, error) {
+	switch s {
+	case VoteShiftFromAgree:
+		return []byte(s), nil
+	case VoteShiftFromDisagree:
+		return []byte(s), nil
+	case VoteShiftFromPass:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *VoteShiftFrom) UnmarshalText(data []byte) error {
+	switch VoteShiftFrom(data) {
+	case VoteShiftFromAgree:
+		*s = VoteShiftFromAgree
+		return nil
+	case VoteShiftFromDisagree:
+		*s = VoteShiftFromDisagree
+		return nil
+	case VoteShiftFromPass:
+		*s = VoteShiftFromPass
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// 最後の投票.
+type VoteShiftTo string
+
+const (
+	VoteShiftToAgree    VoteShiftTo = "agree"
+	VoteShiftToDisagree VoteShiftTo = "disagree"
+	VoteShiftToPass     VoteShiftTo = "pass"
+)
+
+// AllValues returns all VoteShiftTo values.
+func (VoteShiftTo) AllValues() []VoteShiftTo {
+	return []VoteShiftTo{
+		VoteShiftToAgree,
+		VoteShiftToDisagree,
+		VoteShiftToPass,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s VoteShiftTo) MarshalText() ([]byte, error) {
+	switch s {
+	case VoteShiftToAgree:
+		return []byte(s), nil
+	case VoteShiftToDisagree:
+		return []byte(s), nil
+	case VoteShiftToPass:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *VoteShiftTo) UnmarshalText(data []byte) error {
+	switch VoteShiftTo(data) {
+	case VoteShiftToAgree:
+		*s = VoteShiftToAgree
+		return nil
+	case VoteShiftToDisagree:
+		*s = VoteShiftToDisagree
+		return nil
+	case VoteShiftToPass:
+		*s = VoteShiftToPass
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 // 投票タイプ.
 // Ref: #/components/schemas/VoteType
 type VoteType string
@@ -7967,6 +14622,55 @@ func (s *VoteType) UnmarshalText(data []byte) error {
 	}
 }
 
+// 参加者の構成を母集団に合わせる重みを付けた投票の割合。属性を登録していない参加者の重みは1.
+// Ref: #/components/schemas/WeightedVoteRates
+type WeightedVoteRates struct {
+	Dimension    DemographicDimension `json:"dimension"`
+	AgreeRate    float64              `json:"agreeRate"`
+	DisagreeRate float64              `json:"disagreeRate"`
+	PassRate     float64              `json:"passRate"`
+}
+
+// GetDimension returns the value of Dimension.
+func (s *WeightedVoteRates) GetDimension() DemographicDimension {
+	return s.Dimension
+}
+
+// GetAgreeRate returns the value of AgreeRate.
+func (s *WeightedVoteRates) GetAgreeRate() float64 {
+	return s.AgreeRate
+}
+
+// GetDisagreeRate returns the value of DisagreeRate.
+func (s *WeightedVoteRates) GetDisagreeRate() float64 {
+	return s.DisagreeRate
+}
+
+// GetPassRate returns the value of PassRate.
+func (s *WeightedVoteRates) GetPassRate() float64 {
+	return s.PassRate
+}
+
+// SetDimension sets the value of Dimension.
+func (s *WeightedVoteRates) SetDimension(val DemographicDimension) {
+	s.Dimension = val
+}
+
+// SetAgreeRate sets the value of AgreeRate.
+func (s *WeightedVoteRates) SetAgreeRate(val float64) {
+	s.AgreeRate = val
+}
+
+// SetDisagreeRate sets the value of DisagreeRate.
+func (s *WeightedVoteRates) SetDisagreeRate(val float64) {
+	s.DisagreeRate = val
+}
+
+// SetPassRate sets the value of PassRate.
+func (s *WeightedVoteRates) SetPassRate(val float64) {
+	s.PassRate = val
+}
+
 type WithdrawUserBadRequest struct {
 	Code    string `json:"code"`
 	Message string `json:"message"`
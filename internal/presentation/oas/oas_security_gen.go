@@ -17,6 +17,9 @@ type SecurityHandler interface {
 	// HandleCookieAuth handles CookieAuth security.
 	// Cookie-based authentication using JWT tokens stored in secure HTTP-only cookies.
 	HandleCookieAuth(ctx context.Context, operationName OperationName, t CookieAuth) (context.Context, error)
+	// HandleOrganizationApiKeyAuth handles OrganizationApiKeyAuth security.
+	// Organization API key for server-to-server automation.
+	HandleOrganizationApiKeyAuth(ctx context.Context, operationName OperationName, t OrganizationApiKeyAuth) (context.Context, error)
 }
 
 func findAuthorization(h http.Header, prefix string) (string, bool) {
@@ -35,61 +38,110 @@ func findAuthorization(h http.Header, prefix string) (string, bool) {
 }
 
 var operationRolesCookieAuth = map[string][]string{
-	ApplyFeedbackToReportOperation:         []string{},
-	AuthAccountDetachOperation:             []string{},
-	ChangePasswordOperation:                []string{},
-	CheckDeviceExistsOperation:             []string{},
-	ConsentTalkSessionOperation:            []string{},
-	CreateOrganizationAliasOperation:       []string{},
-	DeleteDeviceOperation:                  []string{},
-	DeleteOrganizationAliasOperation:       []string{},
-	EditTalkSessionOperation:               []string{},
-	EditTimeLineOperation:                  []string{},
-	EstablishOrganizationOperation:         []string{},
-	EstablishUserOperation:                 []string{},
-	GetAnalysisReportManageOperation:       []string{},
-	GetDevicesOperation:                    []string{},
-	GetNotificationPreferencesOperation:    []string{},
-	GetOpenedTalkSessionOperation:          []string{},
-	GetOpinionReportsOperation:             []string{},
-	GetOrganizationAliasesOperation:        []string{},
-	GetOrganizationUsersOperation:          []string{},
-	GetOrganizationsOperation:              []string{},
-	GetReportsForTalkSessionOperation:      []string{},
-	GetTalkSessionListManageOperation:      []string{},
-	GetTalkSessionManageOperation:          []string{},
-	GetTalkSessionReportCountOperation:     []string{},
-	GetTokenInfoOperation:                  []string{},
-	GetUserInfoOperation:                   []string{},
-	GetUserListManageOperation:             []string{},
-	GetUserStatsListManageOperation:        []string{},
-	GetUserStatsTotalManageOperation:       []string{},
-	GetVapidKeyOperation:                   []string{},
-	HasConsentOperation:                    []string{},
-	InitiateTalkSessionOperation:           []string{},
-	InviteOrganizationOperation:            []string{},
-	InviteOrganizationForUserOperation:     []string{},
-	ManageRegenerateManageOperation:        []string{},
-	OpinionsHistoryOperation:               []string{},
-	PolicyConsentOperation:                 []string{},
-	PostConclusionOperation:                []string{},
-	PostImageOperation:                     []string{},
-	PostOpinionPost2Operation:              []string{},
-	PostTimeLineItemOperation:              []string{},
-	ReactivateUserOperation:                []string{},
-	RegisterDeviceOperation:                []string{},
-	ReportOpinionOperation:                 []string{},
-	RevokeTokenOperation:                   []string{},
-	SendTestNotificationOperation:          []string{},
-	SessionsHistoryOperation:               []string{},
-	SolveOpinionReportOperation:            []string{},
-	SwitchOrganizationOperation:            []string{},
-	ToggleReportVisibilityManageOperation:  []string{},
-	UpdateNotificationPreferencesOperation: []string{},
-	UpdateOrganizationOperation:            []string{},
-	UpdateUserProfileOperation:             []string{},
-	Vote2Operation:                         []string{},
-	WithdrawUserOperation:                  []string{},
+	AcceptOrganizationInvitationOperation:            []string{},
+	AcceptOwnershipTransferOperation:                 []string{},
+	ApplyFeedbackToReportOperation:                   []string{},
+	AuthAccountDetachOperation:                       []string{},
+	BatchVoteOperation:                               []string{},
+	BulkCreateOrganizationInvitationsOperation:       []string{},
+	BulkTransferTalkSessionsOperation:                []string{},
+	CancelOwnershipTransferOperation:                 []string{},
+	ChangeOrganizationUserRoleOperation:              []string{},
+	ChangePasswordOperation:                          []string{},
+	CheckDeviceExistsOperation:                       []string{},
+	CloneTalkSessionOperation:                        []string{},
+	CloneTalkSessionTemplateOperation:                []string{},
+	ConsentTalkSessionOperation:                      []string{},
+	CreateOrganizationAliasOperation:                 []string{},
+	CreateOrganizationApiKeyOperation:                []string{},
+	CreateOrganizationInvitationOperation:            []string{},
+	DeclineOwnershipTransferOperation:                []string{},
+	DeleteDeviceOperation:                            []string{},
+	DeleteOpinionOperation:                           []string{},
+	DeleteOrganizationAliasOperation:                 []string{},
+	DeleteTalkSessionTemplateOperation:               []string{},
+	DownloadOrganizationAuditLogsOperation:           []string{},
+	EditOpinionOperation:                             []string{},
+	EditTalkSessionOperation:                         []string{},
+	EditTimeLineOperation:                            []string{},
+	EstablishOrganizationOperation:                   []string{},
+	EstablishUserOperation:                           []string{},
+	GetAnalysisReportManageOperation:                 []string{},
+	GetDevicesOperation:                              []string{},
+	GetMyAnalysisPositionOperation:                   []string{},
+	GetNotificationPreferencesOperation:              []string{},
+	GetOpenedTalkSessionOperation:                    []string{},
+	GetOpinionAnalysisOperation:                      []string{},
+	GetOpinionReportsOperation:                       []string{},
+	GetOpinionRevisionsOperation:                     []string{},
+	GetOrganizationAliasesOperation:                  []string{},
+	GetOrganizationApiKeysOperation:                  []string{},
+	GetOrganizationAuditLogsOperation:                []string{},
+	GetOrganizationChildrenOperation:                 []string{},
+	GetOrganizationInvitationsOperation:              []string{},
+	GetOrganizationReferenceDemographicsOperation:    []string{},
+	GetOrganizationReportPromptOperation:             []string{},
+	GetOrganizationStatsOperation:                    []string{},
+	GetOrganizationTalkSessionsOperation:             []string{},
+	GetOrganizationUsersOperation:                    []string{},
+	GetOrganizationsOperation:                        []string{},
+	GetReportVersionDiffManageOperation:              []string{},
+	GetReportVersionsManageOperation:                 []string{},
+	GetReportsForTalkSessionOperation:                []string{},
+	GetSigningKeysManageOperation:                    []string{},
+	GetTalkSessionCollaboratorsOperation:             []string{},
+	GetTalkSessionListManageOperation:                []string{},
+	GetTalkSessionManageOperation:                    []string{},
+	GetTalkSessionReportCountOperation:               []string{},
+	GetTalkSessionRepresentativenessOperation:        []string{},
+	GetTalkSessionTemplatesOperation:                 []string{},
+	GetTokenInfoOperation:                            []string{},
+	GetUserInfoOperation:                             []string{},
+	GetUserListManageOperation:                       []string{},
+	GetUserStatsListManageOperation:                  []string{},
+	GetUserStatsTotalManageOperation:                 []string{},
+	GetVapidKeyOperation:                             []string{},
+	HasConsentOperation:                              []string{},
+	InitiateTalkSessionOperation:                     []string{},
+	InviteOrganizationOperation:                      []string{},
+	InviteOrganizationForUserOperation:               []string{},
+	ListOwnershipTransfersOperation:                  []string{},
+	ManageRegenerateManageOperation:                  []string{},
+	OpinionsHistoryOperation:                         []string{},
+	PolicyConsentOperation:                           []string{},
+	PostConclusionOperation:                          []string{},
+	PostImageOperation:                               []string{},
+	PostOpinionPost2Operation:                        []string{},
+	PostTimeLineItemOperation:                        []string{},
+	PublishReportVersionManageOperation:              []string{},
+	PublishTalkSessionOperation:                      []string{},
+	ReactivateUserOperation:                          []string{},
+	RegisterDeviceOperation:                          []string{},
+	RemoveOrganizationUserOperation:                  []string{},
+	RemoveTalkSessionCollaboratorOperation:           []string{},
+	ReportOpinionOperation:                           []string{},
+	RequestOrganizationOwnershipTransferOperation:    []string{},
+	RequestTalkSessionOwnershipTransferOperation:     []string{},
+	ResendOrganizationInvitationOperation:            []string{},
+	RevokeOrganizationApiKeyOperation:                []string{},
+	RevokeOrganizationInvitationOperation:            []string{},
+	RevokeTokenOperation:                             []string{},
+	RotateSigningKeyManageOperation:                  []string{},
+	SaveTalkSessionTemplateOperation:                 []string{},
+	SendTestNotificationOperation:                    []string{},
+	SessionsHistoryOperation:                         []string{},
+	SetTalkSessionCollaboratorOperation:              []string{},
+	SolveOpinionReportOperation:                      []string{},
+	SwitchOrganizationOperation:                      []string{},
+	ToggleReportVisibilityManageOperation:            []string{},
+	UpdateNotificationPreferencesOperation:           []string{},
+	UpdateOrganizationOperation:                      []string{},
+	UpdateOrganizationParentOperation:                []string{},
+	UpdateOrganizationReferenceDemographicsOperation: []string{},
+	UpdateOrganizationReportPromptOperation:          []string{},
+	UpdateUserProfileOperation:                       []string{},
+	Vote2Operation:                                   []string{},
+	WithdrawUserOperation:                            []string{},
 }
 
 func (s *Server) securityCookieAuth(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
@@ -114,3 +166,127 @@ func (s *Server) securityCookieAuth(ctx context.Context, operationName Operation
 	}
 	return rctx, true, err
 }
+
+var operationRolesOrganizationApiKeyAuth = map[string][]string{
+	AcceptOrganizationInvitationOperation:            []string{},
+	AcceptOwnershipTransferOperation:                 []string{},
+	ApplyFeedbackToReportOperation:                   []string{},
+	AuthAccountDetachOperation:                       []string{},
+	BatchVoteOperation:                               []string{},
+	BulkCreateOrganizationInvitationsOperation:       []string{},
+	BulkTransferTalkSessionsOperation:                []string{},
+	CancelOwnershipTransferOperation:                 []string{},
+	ChangeOrganizationUserRoleOperation:              []string{},
+	ChangePasswordOperation:                          []string{},
+	CheckDeviceExistsOperation:                       []string{},
+	CloneTalkSessionOperation:                        []string{},
+	CloneTalkSessionTemplateOperation:                []string{},
+	ConsentTalkSessionOperation:                      []string{},
+	CreateOrganizationAliasOperation:                 []string{},
+	CreateOrganizationApiKeyOperation:                []string{},
+	CreateOrganizationInvitationOperation:            []string{},
+	DeclineOwnershipTransferOperation:                []string{},
+	DeleteDeviceOperation:                            []string{},
+	DeleteOpinionOperation:                           []string{},
+	DeleteOrganizationAliasOperation:                 []string{},
+	DeleteTalkSessionTemplateOperation:               []string{},
+	DownloadOrganizationAuditLogsOperation:           []string{},
+	EditOpinionOperation:                             []string{},
+	EditTalkSessionOperation:                         []string{},
+	EditTimeLineOperation:                            []string{},
+	EstablishOrganizationOperation:                   []string{},
+	EstablishUserOperation:                           []string{},
+	GetAnalysisReportManageOperation:                 []string{},
+	GetDevicesOperation:                              []string{},
+	GetMyAnalysisPositionOperation:                   []string{},
+	GetNotificationPreferencesOperation:              []string{},
+	GetOpenedTalkSessionOperation:                    []string{},
+	GetOpinionReportsOperation:                       []string{},
+	GetOpinionRevisionsOperation:                     []string{},
+	GetOrganizationAliasesOperation:                  []string{},
+	GetOrganizationApiKeysOperation:                  []string{},
+	GetOrganizationAuditLogsOperation:                []string{},
+	GetOrganizationChildrenOperation:                 []string{},
+	GetOrganizationInvitationsOperation:              []string{},
+	GetOrganizationReferenceDemographicsOperation:    []string{},
+	GetOrganizationReportPromptOperation:             []string{},
+	GetOrganizationStatsOperation:                    []string{},
+	GetOrganizationTalkSessionsOperation:             []string{},
+	GetOrganizationUsersOperation:                    []string{},
+	GetOrganizationsOperation:                        []string{},
+	GetReportVersionDiffManageOperation:              []string{},
+	GetReportVersionsManageOperation:                 []string{},
+	GetReportsForTalkSessionOperation:                []string{},
+	GetSigningKeysManageOperation:                    []string{},
+	GetTalkSessionCollaboratorsOperation:             []string{},
+	GetTalkSessionListManageOperation:                []string{},
+	GetTalkSessionManageOperation:                    []string{},
+	GetTalkSessionReportCountOperation:               []string{},
+	GetTalkSessionRepresentativenessOperation:        []string{},
+	GetTalkSessionTemplatesOperation:                 []string{},
+	GetTokenInfoOperation:                            []string{},
+	GetUserInfoOperation:                             []string{},
+	GetUserListManageOperation:                       []string{},
+	GetUserStatsListManageOperation:                  []string{},
+	GetUserStatsTotalManageOperation:                 []string{},
+	GetVapidKeyOperation:                             []string{},
+	HasConsentOperation:                              []string{},
+	InitiateTalkSessionOperation:                     []string{},
+	InviteOrganizationOperation:                      []string{},
+	InviteOrganizationForUserOperation:               []string{},
+	ListOwnershipTransfersOperation:                  []string{},
+	ManageRegenerateManageOperation:                  []string{},
+	OpinionsHistoryOperation:                         []string{},
+	PolicyConsentOperation:                           []string{},
+	PostConclusionOperation:                          []string{},
+	PostImageOperation:                               []string{},
+	PostOpinionPost2Operation:                        []string{},
+	PostTimeLineItemOperation:                        []string{},
+	PublishReportVersionManageOperation:              []string{},
+	PublishTalkSessionOperation:                      []string{},
+	ReactivateUserOperation:                          []string{},
+	RegisterDeviceOperation:                          []string{},
+	RemoveOrganizationUserOperation:                  []string{},
+	RemoveTalkSessionCollaboratorOperation:           []string{},
+	ReportOpinionOperation:                           []string{},
+	RequestOrganizationOwnershipTransferOperation:    []string{},
+	RequestTalkSessionOwnershipTransferOperation:     []string{},
+	ResendOrganizationInvitationOperation:            []string{},
+	RevokeOrganizationApiKeyOperation:                []string{},
+	RevokeOrganizationInvitationOperation:            []string{},
+	RevokeTokenOperation:                             []string{},
+	RotateSigningKeyManageOperation:                  []string{},
+	SaveTalkSessionTemplateOperation:                 []string{},
+	SendTestNotificationOperation:                    []string{},
+	SessionsHistoryOperation:                         []string{},
+	SetTalkSessionCollaboratorOperation:              []string{},
+	SolveOpinionReportOperation:                      []string{},
+	SwitchOrganizationOperation:                      []string{},
+	ToggleReportVisibilityManageOperation:            []string{},
+	UpdateNotificationPreferencesOperation:           []string{},
+	UpdateOrganizationOperation:                      []string{},
+	UpdateOrganizationParentOperation:                []string{},
+	UpdateOrganizationReferenceDemographicsOperation: []string{},
+	UpdateOrganizationReportPromptOperation:          []string{},
+	UpdateUserProfileOperation:                       []string{},
+	Vote2Operation:                                   []string{},
+	WithdrawUserOperation:                            []string{},
+}
+
+func (s *Server) securityOrganizationApiKeyAuth(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
+	var t OrganizationApiKeyAuth
+	const parameterName = "X-API-Key"
+	value := req.Header.Get(parameterName)
+	if value == "" {
+		return ctx, false, nil
+	}
+	t.APIKey = value
+	t.Roles = operationRolesOrganizationApiKeyAuth[operationName]
+	rctx, err := s.sec.HandleOrganizationApiKeyAuth(ctx, operationName, t)
+	if errors.Is(err, ogenerrors.ErrSkipServerSecurity) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	return rctx, true, err
+}
@@ -16,6 +16,7 @@ type Handler interface {
 	NotificationsHandler
 	OpinionHandler
 	OrganizationHandler
+	OwnershipHandler
 	PolicyHandler
 	TalkSessionHandler
 	TestHandler
@@ -34,6 +35,74 @@ type AnalysisHandler interface {
 	//
 	// POST /report/feedback
 	ApplyFeedbackToReport(ctx context.Context, req *ApplyFeedbackToReportReq) (ApplyFeedbackToReportRes, error)
+	// GetAnalysisJob implements getAnalysisJob operation.
+	//
+	// 非同期に実行しているレポート・ワードクラウドの生成ジョブの状況を返す。
+	// 失敗したジョブは上限まで間隔を空けて再実行する.
+	//
+	// GET /talksessions/{talkSessionID}/analysis/jobs/{jobID}
+	GetAnalysisJob(ctx context.Context, params GetAnalysisJobParams) (GetAnalysisJobRes, error)
+	// GetAnalysisSnapshot implements getAnalysisSnapshot operation.
+	//
+	// スナップショット時点のグループ分け、ポジション、代表意見を返す.
+	//
+	// GET /talksessions/{talkSessionID}/analysis/snapshots/{snapshotID}
+	GetAnalysisSnapshot(ctx context.Context, params GetAnalysisSnapshotParams) (GetAnalysisSnapshotRes, error)
+	// GetAnalysisSnapshotDiff implements getAnalysisSnapshotDiff operation.
+	//
+	// 2つのスナップショットを比べ、グループが変わったユーザーを返す.
+	//
+	// GET /talksessions/{talkSessionID}/analysis/snapshots/diff
+	GetAnalysisSnapshotDiff(ctx context.Context, params GetAnalysisSnapshotDiffParams) (GetAnalysisSnapshotDiffRes, error)
+	// GetAnalysisSnapshots implements getAnalysisSnapshots operation.
+	//
+	// 分析のたびに残したグループ分けと代表意見のスナップショットを古い順に返す.
+	//
+	// GET /talksessions/{talkSessionID}/analysis/snapshots
+	GetAnalysisSnapshots(ctx context.Context, params GetAnalysisSnapshotsParams) (GetAnalysisSnapshotsRes, error)
+	// GetAnalysisStatus implements getAnalysisStatus operation.
+	//
+	// 投票・意見の投稿に応じた分析の再計算の状況を返す。
+	// 最後の分析以降の投票が一定数に達するか、最初の投票・意見から一定時間が経つと再計算する.
+	//
+	// GET /talksessions/{talkSessionID}/analysis/status
+	GetAnalysisStatus(ctx context.Context, params GetAnalysisStatusParams) (GetAnalysisStatusRes, error)
+	// GetConsensus implements getConsensus operation.
+	//
+	// 意見ごとにグループの賛成確率を求め、全てのグループが賛成している意見と、グループで賛否が分かれている意見を返す。
+	// 賛成確率は投票が少ないグループで極端にならないよう平滑化する.
+	//
+	// GET /talksessions/{talkSessionID}/analysis/consensus
+	GetConsensus(ctx context.Context, params GetConsensusParams) (GetConsensusRes, error)
+	// GetMyAnalysisPosition implements getMyAnalysisPosition operation.
+	//
+	// ログインしているユーザーの分析結果での位置を返す。
+	// 所属するグループ、各グループの中心までの距離、グループと投票が最も一致・食い違っている意見、
+	// まだ投票していない意見のうち同じグループのメンバーが賛成している意見を含む.
+	//
+	// GET /talksessions/{talkSessionID}/analysis/me
+	GetMyAnalysisPosition(ctx context.Context, params GetMyAnalysisPositionParams) (GetMyAnalysisPositionRes, error)
+	// GetTermFrequencies implements getTermFrequencies operation.
+	//
+	// 意見を形態素解析して集計した単語を返す。ワードクラウドをアプリケーション内で生成した場合のみ集計がある。
+	// グループごとの単語は、グループの意見をまとめて1つの文書とみなしたTF-IDFで並べる.
+	//
+	// GET /talksessions/{talkSessionID}/analysis/terms
+	GetTermFrequencies(ctx context.Context, params GetTermFrequenciesParams) (GetTermFrequenciesRes, error)
+	// GetTopics implements getTopics operation.
+	//
+	// 意見の本文をTF-IDFで重み付けしてk-meansでトピックに分け、トピックごとの投票数を返す。
+	// 投票によるグループ分けとは別に、どのような話題の意見があるかを示す.
+	//
+	// GET /talksessions/{talkSessionID}/topics
+	GetTopics(ctx context.Context, params GetTopicsParams) (GetTopicsRes, error)
+	// GetVoteShifts implements getVoteShifts operation.
+	//
+	// 投票の変更履歴から、ユーザーごとに最初の投票と最後の投票を比べた変化を集計する。
+	// 最後の投票より前にその意見への返信に投票していれば、返信を読んでから変化したとみなす.
+	//
+	// GET /talksessions/{talkSessionID}/analysis/vote-shifts
+	GetVoteShifts(ctx context.Context, params GetVoteShiftsParams) (GetVoteShiftsRes, error)
 }
 
 // AuthHandler handles operations described by OpenAPI v3 specification.
@@ -64,6 +133,12 @@ type AuthHandler interface {
 	//
 	// GET /auth/dev/login
 	DevAuthorize(ctx context.Context, params DevAuthorizeParams) (DevAuthorizeRes, error)
+	// GetJwks implements getJwks operation.
+	//
+	// トークン検証用の公開鍵一覧.
+	//
+	// GET /.well-known/jwks.json
+	GetJwks(ctx context.Context) (GetJwksRes, error)
 	// GetTokenInfo implements getTokenInfo operation.
 	//
 	// JWTの内容を返してくれる.
@@ -134,6 +209,18 @@ type ManageHandler interface {
 	//
 	// GET /v1/manage/talksessions/{talkSessionID}/analysis/report
 	GetAnalysisReportManage(ctx context.Context, params GetAnalysisReportManageParams) (*AnalysisReportResponse, error)
+	// GetReportVersionDiffManage implements getReportVersionDiffManage operation.
+	//
+	// GET /v1/manage/talksessions/{talkSessionID}/analysis/report/versions/diff
+	GetReportVersionDiffManage(ctx context.Context, params GetReportVersionDiffManageParams) (*ReportVersionDiff, error)
+	// GetReportVersionsManage implements getReportVersionsManage operation.
+	//
+	// GET /v1/manage/talksessions/{talkSessionID}/analysis/report/versions
+	GetReportVersionsManage(ctx context.Context, params GetReportVersionsManageParams) ([]ReportVersionForManage, error)
+	// GetSigningKeysManage implements getSigningKeysManage operation.
+	//
+	// GET /v1/manage/auth/signing-keys
+	GetSigningKeysManage(ctx context.Context) ([]SigningKeyForManage, error)
 	// GetTalkSessionListManage implements getTalkSessionListManage operation.
 	//
 	// GET /v1/manage/talksessions/list
@@ -158,6 +245,14 @@ type ManageHandler interface {
 	//
 	// POST /v1/manage/talksessions/{talkSessionID}/analysis/regenerate
 	ManageRegenerateManage(ctx context.Context, req *RegenerateRequest, params ManageRegenerateManageParams) (*RegenerateResponse, error)
+	// PublishReportVersionManage implements publishReportVersionManage operation.
+	//
+	// POST /v1/manage/talksessions/{talkSessionID}/analysis/report/publish
+	PublishReportVersionManage(ctx context.Context, req *PublishReportVersionRequest, params PublishReportVersionManageParams) (*ReportPublicationForManage, error)
+	// RotateSigningKeyManage implements rotateSigningKeyManage operation.
+	//
+	// POST /v1/manage/auth/signing-keys/rotate
+	RotateSigningKeyManage(ctx context.Context) (*SigningKeyForManage, error)
 	// ToggleReportVisibilityManage implements toggleReportVisibilityManage operation.
 	//
 	// POST /v1/manage/talksessions/{talkSessionID}/analysis/report
@@ -222,9 +317,24 @@ type NotificationsHandler interface {
 //
 // x-ogen-operation-group: Opinion
 type OpinionHandler interface {
+	// DeleteOpinion implements deleteOpinion operation.
+	//
+	// 投稿者のみ削除できる。削除した意見は「削除されました」と表示される.
+	//
+	// DELETE /opinions/{opinionID}
+	DeleteOpinion(ctx context.Context, params DeleteOpinionParams) (DeleteOpinionRes, error)
+	// EditOpinion implements editOpinion operation.
+	//
+	// 投稿者のみ編集できる。投稿してから一定期間内で、投稿者以外の投票が一定数以下の場合に限る。
+	// 内容が大きく変わった場合は、投稿者以外の投票をリセットする。.
+	//
+	// PUT /opinions/{opinionID}
+	EditOpinion(ctx context.Context, req *EditOpinionReq, params EditOpinionParams) (EditOpinionRes, error)
 	// GetOpinionAnalysis implements getOpinionAnalysis operation.
 	//
-	// 意見に投票したグループごとの割合.
+	// WeightByを指定すると、セッションを作成した組織が登録した母集団の構成に合わせて重みを付けた割合も返す。
+	// 重みはその属性でのセッションの参加者全体の構成から求める。
+	// 参加者の属性を使うため、weightByの指定はセッションを作成した組織のメンバーに限る.
 	//
 	// GET /opinions/{opinionID}/analysis
 	GetOpinionAnalysis(ctx context.Context, params GetOpinionAnalysisParams) (GetOpinionAnalysisRes, error)
@@ -246,6 +356,12 @@ type OpinionHandler interface {
 	//
 	// GET /opinions/{opinionID}/reports
 	GetOpinionReports(ctx context.Context, params GetOpinionReportsParams) (GetOpinionReportsRes, error)
+	// GetOpinionRevisions implements getOpinionRevisions operation.
+	//
+	// 投稿者と、セッションのオーナー・通報に対応できる共同管理者のみ取得できる。削除された意見の内容も返す.
+	//
+	// GET /opinions/{opinionID}/revisions
+	GetOpinionRevisions(ctx context.Context, params GetOpinionRevisionsParams) (GetOpinionRevisionsRes, error)
 	// GetOpinionsForTalkSession implements getOpinionsForTalkSession operation.
 	//
 	// セッションに対する意見一覧.
@@ -291,18 +407,92 @@ type OpinionHandler interface {
 //
 // x-ogen-operation-group: Organization
 type OrganizationHandler interface {
+	// AcceptOrganizationInvitation implements acceptOrganizationInvitation operation.
+	//
+	// 招待メールに記載されたトークンで招待を承諾し、組織に参加する。
+	// 招待されたメールアドレスのアカウントでログインしている必要がある。.
+	//
+	// POST /organizations/invitations/accept
+	AcceptOrganizationInvitation(ctx context.Context, req *AcceptOrganizationInvitationReq) (AcceptOrganizationInvitationRes, error)
+	// BulkCreateOrganizationInvitations implements bulkCreateOrganizationInvitations operation.
+	//
+	// CSVファイルで一括招待する。
+	// 1列目にメールアドレス、2列目にロール（数値またはロール名。省略時はメンバー）を指定する。
+	// 1行目が「email」または「メールアドレス」の場合はヘッダーとして読み飛ばす。
+	// 最大500行まで。失敗した行があっても残りの行は処理され、行ごとの結果を返す。.
+	//
+	// POST /organizations/invitations/bulk
+	BulkCreateOrganizationInvitations(ctx context.Context, req *BulkCreateOrganizationInvitationsReq) (BulkCreateOrganizationInvitationsRes, error)
+	// ChangeOrganizationUserRole implements changeOrganizationUserRole operation.
+	//
+	// 組織ユーザーのロールを変更する。
+	// 組織のAdmin以上のユーザーが実行可能で、自分と同じかそれ以下のロールのユーザーを、自分と同じかそれ以下のロールにのみ変更できる。
+	// 最後のオーナーは降格できない。
+	// 変更されたユーザーは組織アカウントから一度ログアウトされる。
+	// Role
+	// - 10: SuperAdmin
+	// - 20: Owner
+	// - 30: Admin
+	// - 40: Member.
+	//
+	// PUT /organizations/users/{userID}/role
+	ChangeOrganizationUserRole(ctx context.Context, req *ChangeOrganizationUserRoleReq, params ChangeOrganizationUserRoleParams) (ChangeOrganizationUserRoleRes, error)
+	// CloneTalkSessionTemplate implements cloneTalkSessionTemplate operation.
+	//
+	// テンプレートから下書きのセッションを作成する。組織のメンバーであれば誰でも使える.
+	//
+	// POST /organizations/{code}/talksession-templates/{templateID}/clone
+	CloneTalkSessionTemplate(ctx context.Context, req *CloneTalkSessionTemplateReq, params CloneTalkSessionTemplateParams) (CloneTalkSessionTemplateRes, error)
 	// CreateOrganizationAlias implements createOrganizationAlias operation.
 	//
 	// 組織エイリアス作成.
 	//
 	// POST /organizations/aliases
 	CreateOrganizationAlias(ctx context.Context, req *CreateOrganizationAliasReq) (CreateOrganizationAliasRes, error)
+	// CreateOrganizationApiKey implements createOrganizationApiKey operation.
+	//
+	// サーバー間連携用のAPIキーを発行する。
+	// 発行したキーはこのレスポンスでのみ返されるため、安全な場所に保管してください。
+	// APIキーは`X-API-Key`ヘッダーに指定して使用します。
+	// scopesには以下を指定できます。
+	// - `sessions:read` セッションの閲覧
+	// - `sessions:write` セッションの作成・編集
+	// - `results:export` 分析結果・レポートの取得.
+	//
+	// POST /organizations/api-keys
+	CreateOrganizationApiKey(ctx context.Context, req *CreateOrganizationApiKeyReq) (CreateOrganizationApiKeyRes, error)
+	// CreateOrganizationInvitation implements createOrganizationInvitation operation.
+	//
+	// 招待リンクをメールで送信する。
+	// 招待されたユーザーはリンクからログイン（未登録の場合は登録）した後、招待を承諾することで組織に参加できる。
+	// 招待リンクの有効期限は7日間。
+	// 自分と同じかそれ以下のロールでのみ招待できる。
+	// Role
+	// - 20: Owner
+	// - 30: Admin
+	// - 40: Member.
+	//
+	// POST /organizations/invitations
+	CreateOrganizationInvitation(ctx context.Context, req *CreateOrganizationInvitationReq) (CreateOrganizationInvitationRes, error)
 	// DeleteOrganizationAlias implements deleteOrganizationAlias operation.
 	//
 	// 組織エイリアス削除.
 	//
 	// DELETE /organizations/aliases/{aliasID}
 	DeleteOrganizationAlias(ctx context.Context, params DeleteOrganizationAliasParams) (DeleteOrganizationAliasRes, error)
+	// DeleteTalkSessionTemplate implements deleteTalkSessionTemplate operation.
+	//
+	// テンプレートの作成者か組織の管理者のみ削除できる.
+	//
+	// DELETE /organizations/{code}/talksession-templates/{templateID}
+	DeleteTalkSessionTemplate(ctx context.Context, params DeleteTalkSessionTemplateParams) (DeleteTalkSessionTemplateRes, error)
+	// DownloadOrganizationAuditLogs implements downloadOrganizationAuditLogs operation.
+	//
+	// 条件に一致する監査ログをすべてCSV（UTF-8 BOM付き）で出力する。
+	// 変更した項目ごとに1行出力する。.
+	//
+	// GET /organizations/audit-logs/csv
+	DownloadOrganizationAuditLogs(ctx context.Context, params DownloadOrganizationAuditLogsParams) (DownloadOrganizationAuditLogsRes, error)
 	// EstablishOrganization implements establishOrganization operation.
 	//
 	// 組織を作成できる。
@@ -316,6 +506,61 @@ type OrganizationHandler interface {
 	//
 	// GET /organizations/aliases
 	GetOrganizationAliases(ctx context.Context) (GetOrganizationAliasesRes, error)
+	// GetOrganizationApiKeys implements getOrganizationApiKeys operation.
+	//
+	// 組織APIキー一覧取得.
+	//
+	// GET /organizations/api-keys
+	GetOrganizationApiKeys(ctx context.Context) (GetOrganizationApiKeysRes, error)
+	// GetOrganizationAuditLogs implements getOrganizationAuditLogs operation.
+	//
+	// 組織の監査ログを新しい順に取得する。オーナー以上の権限が必要。
+	// 運営（ことひろ組織のスーパー管理者）はorganizationIDを指定して他の組織の監査ログを取得できる。
+	// sinceは指定時刻を含み、untilは指定時刻を含まない。.
+	//
+	// GET /organizations/audit-logs
+	GetOrganizationAuditLogs(ctx context.Context, params GetOrganizationAuditLogsParams) (GetOrganizationAuditLogsRes, error)
+	// GetOrganizationChildren implements getOrganizationChildren operation.
+	//
+	// 直下の子組織を取得する。
+	// 現在の組織またはその子孫の組織を指定できる。.
+	//
+	// GET /organizations/{code}/children
+	GetOrganizationChildren(ctx context.Context, params GetOrganizationChildrenParams) (GetOrganizationChildrenRes, error)
+	// GetOrganizationInvitations implements getOrganizationInvitations operation.
+	//
+	// 組織への招待一覧取得.
+	//
+	// GET /organizations/invitations
+	GetOrganizationInvitations(ctx context.Context) (GetOrganizationInvitationsRes, error)
+	// GetOrganizationReferenceDemographics implements getOrganizationReferenceDemographics operation.
+	//
+	// 参加者の代表性を確かめるために組織が登録した、属性ごとの母集団の構成を取得する。管理者以上の権限が必要.
+	//
+	// GET /organizations/{code}/reference-demographics
+	GetOrganizationReferenceDemographics(ctx context.Context, params GetOrganizationReferenceDemographicsParams) (GetOrganizationReferenceDemographicsRes, error)
+	// GetOrganizationReportPrompt implements getOrganizationReportPrompt operation.
+	//
+	// レポートをLLMで生成する際の組織の指示を取得する。管理者以上の権限が必要.
+	//
+	// GET /organizations/{code}/report-prompt
+	GetOrganizationReportPrompt(ctx context.Context, params GetOrganizationReportPromptParams) (GetOrganizationReportPromptRes, error)
+	// GetOrganizationStats implements getOrganizationStats operation.
+	//
+	// 組織のセッション・参加者・意見・投票・同意・レポートへのフィードバックの件数を集計する。
+	// bucketで日・週（月曜日始まり）・月単位に集計し、sinceとuntilを省略した場合は直近30日・12週・12ヶ月を返す。
+	// オーナー以上の権限が必要で、現在の組織またはその子孫の組織を指定できる。.
+	//
+	// GET /organizations/{code}/stats
+	GetOrganizationStats(ctx context.Context, params GetOrganizationStatsParams) (GetOrganizationStatsRes, error)
+	// GetOrganizationTalkSessions implements getOrganizationTalkSessions operation.
+	//
+	// 組織のセッションを新しい順に取得する。
+	// includeDescendantsを指定すると子孫の組織のセッションもまとめて取得する。
+	// 現在の組織またはその子孫の組織を指定できる。.
+	//
+	// GET /organizations/{code}/talksessions
+	GetOrganizationTalkSessions(ctx context.Context, params GetOrganizationTalkSessionsParams) (GetOrganizationTalkSessionsRes, error)
 	// GetOrganizationUsers implements getOrganizationUsers operation.
 	//
 	// 現在の組織のユーザー一覧取得.
@@ -328,6 +573,19 @@ type OrganizationHandler interface {
 	//
 	// GET /organizations
 	GetOrganizations(ctx context.Context) (GetOrganizationsRes, error)
+	// GetTalkSessionRepresentativeness implements getTalkSessionRepresentativeness operation.
+	//
+	// 組織のセッションの参加者の構成を、組織が登録した母集団の構成と属性ごとに比べる。管理者以上の権限が必要。
+	// 参加者は意見を投稿したか投票したユーザーで、属性は登録時のものを使う.
+	//
+	// GET /organizations/{code}/talksessions/{talkSessionID}/representativeness
+	GetTalkSessionRepresentativeness(ctx context.Context, params GetTalkSessionRepresentativenessParams) (GetTalkSessionRepresentativenessRes, error)
+	// GetTalkSessionTemplates implements getTalkSessionTemplates operation.
+	//
+	// 組織のセッションテンプレート一覧.
+	//
+	// GET /organizations/{code}/talksession-templates
+	GetTalkSessionTemplates(ctx context.Context, params GetTalkSessionTemplatesParams) (GetTalkSessionTemplatesRes, error)
 	// InviteOrganization implements inviteOrganization operation.
 	//
 	// Role
@@ -344,6 +602,33 @@ type OrganizationHandler interface {
 	//
 	// POST /organizations/invite_user
 	InviteOrganizationForUser(ctx context.Context, req *InviteOrganizationForUserReq) (InviteOrganizationForUserRes, error)
+	// RemoveOrganizationUser implements removeOrganizationUser operation.
+	//
+	// 組織からユーザーを削除する。
+	// ロール変更と同じ権限ルールが適用され、最後のオーナーは削除できない。
+	// 削除されたユーザーの組織アカウントでのセッションは無効化される。.
+	//
+	// DELETE /organizations/users/{userID}
+	RemoveOrganizationUser(ctx context.Context, params RemoveOrganizationUserParams) (RemoveOrganizationUserRes, error)
+	// ResendOrganizationInvitation implements resendOrganizationInvitation operation.
+	//
+	// 招待リンクを再発行してメールを再送する。
+	// 以前に送信したリンクは使えなくなり、有効期限は再送時から7日間に延長される。.
+	//
+	// POST /organizations/invitations/{invitationID}/resend
+	ResendOrganizationInvitation(ctx context.Context, params ResendOrganizationInvitationParams) (ResendOrganizationInvitationRes, error)
+	// RevokeOrganizationApiKey implements revokeOrganizationApiKey operation.
+	//
+	// 組織APIキー失効.
+	//
+	// DELETE /organizations/api-keys/{apiKeyID}
+	RevokeOrganizationApiKey(ctx context.Context, params RevokeOrganizationApiKeyParams) (RevokeOrganizationApiKeyRes, error)
+	// RevokeOrganizationInvitation implements revokeOrganizationInvitation operation.
+	//
+	// 組織への招待の取り消し.
+	//
+	// DELETE /organizations/invitations/{invitationID}
+	RevokeOrganizationInvitation(ctx context.Context, params RevokeOrganizationInvitationParams) (RevokeOrganizationInvitationRes, error)
 	// SwitchOrganization implements switchOrganization operation.
 	//
 	// 組織切り替え.
@@ -357,6 +642,30 @@ type OrganizationHandler interface {
 	//
 	// PUT /organizations/{code}
 	UpdateOrganization(ctx context.Context, req *UpdateOrganizationReq, params UpdateOrganizationParams) (UpdateOrganizationRes, error)
+	// UpdateOrganizationParent implements updateOrganizationParent operation.
+	//
+	// 組織の親組織を設定する。parentCodeを省略すると親子関係を解除する。
+	// 対象の組織・現在の親組織・新しい親組織のすべてでオーナー以上の権限が必要。
+	// 親組織のロールは子孫の組織にも継承される。.
+	//
+	// PUT /organizations/{code}/parent
+	UpdateOrganizationParent(ctx context.Context, req *UpdateOrganizationParentReq, params UpdateOrganizationParentParams) (UpdateOrganizationParentRes, error)
+	// UpdateOrganizationReferenceDemographics implements updateOrganizationReferenceDemographics operation.
+	//
+	// 属性の母集団の構成をCSVで登録する。登録済みの場合は置き換える。管理者以上の権限が必要。
+	// CSVは1列目に区分、2列目に人数または割合（「12.
+	// 5%」のような百分率も可）を指定する。
+	// 1行目の2列目が数値でない場合はヘッダーとして読み飛ばす.
+	//
+	// PUT /organizations/{code}/reference-demographics
+	UpdateOrganizationReferenceDemographics(ctx context.Context, req *UpdateOrganizationReferenceDemographicsReq, params UpdateOrganizationReferenceDemographicsParams) (UpdateOrganizationReferenceDemographicsRes, error)
+	// UpdateOrganizationReportPrompt implements updateOrganizationReportPrompt operation.
+	//
+	// レポートをLLMで生成する際の組織の指示を更新する。管理者以上の権限が必要。
+	// 組織のセッションのレポートを次に生成するときから使われる.
+	//
+	// PUT /organizations/{code}/report-prompt
+	UpdateOrganizationReportPrompt(ctx context.Context, req *UpdateOrganizationReportPromptReq, params UpdateOrganizationReportPromptParams) (UpdateOrganizationReportPromptRes, error)
 	// ValidateOrganizationCode implements validateOrganizationCode operation.
 	//
 	// 組織コード検証.
@@ -365,6 +674,54 @@ type OrganizationHandler interface {
 	ValidateOrganizationCode(ctx context.Context, params ValidateOrganizationCodeParams) (ValidateOrganizationCodeRes, error)
 }
 
+// OwnershipHandler handles operations described by OpenAPI v3 specification.
+//
+// x-ogen-operation-group: Ownership
+type OwnershipHandler interface {
+	// AcceptOwnershipTransfer implements acceptOwnershipTransfer operation.
+	//
+	// 譲渡先のユーザーのみ承諾できる。承諾するとオーナーが変更される.
+	//
+	// POST /ownership-transfers/{transferID}/accept
+	AcceptOwnershipTransfer(ctx context.Context, params AcceptOwnershipTransferParams) (AcceptOwnershipTransferRes, error)
+	// BulkTransferTalkSessions implements bulkTransferTalkSessions operation.
+	//
+	// 指定したメンバーが組織内でオーナーのセッションを、まとめて別のメンバーに譲渡依頼する。組織のオーナー以上のみ実行できる。未承諾の譲渡依頼があるセッションはスキップする.
+	//
+	// POST /organizations/{code}/ownership-transfers/bulk
+	BulkTransferTalkSessions(ctx context.Context, req *BulkTransferTalkSessionsReq, params BulkTransferTalkSessionsParams) (BulkTransferTalkSessionsRes, error)
+	// CancelOwnershipTransfer implements cancelOwnershipTransfer operation.
+	//
+	// 依頼者か譲渡元のユーザーのみ取り消せる.
+	//
+	// POST /ownership-transfers/{transferID}/cancel
+	CancelOwnershipTransfer(ctx context.Context, params CancelOwnershipTransferParams) (CancelOwnershipTransferRes, error)
+	// DeclineOwnershipTransfer implements declineOwnershipTransfer operation.
+	//
+	// 譲渡先のユーザーのみ辞退できる.
+	//
+	// POST /ownership-transfers/{transferID}/decline
+	DeclineOwnershipTransfer(ctx context.Context, params DeclineOwnershipTransferParams) (DeclineOwnershipTransferRes, error)
+	// ListOwnershipTransfers implements listOwnershipTransfers operation.
+	//
+	// 自分宛ての譲渡依頼と、自分が依頼した・自分が譲渡元の譲渡依頼を返す.
+	//
+	// GET /ownership-transfers
+	ListOwnershipTransfers(ctx context.Context) (ListOwnershipTransfersRes, error)
+	// RequestOrganizationOwnershipTransfer implements requestOrganizationOwnershipTransfer operation.
+	//
+	// 組織のオーナーか運営のみ依頼できる。譲渡先は組織に直接所属するメンバーに限る.
+	//
+	// POST /organizations/{code}/ownership-transfers
+	RequestOrganizationOwnershipTransfer(ctx context.Context, req *RequestOrganizationOwnershipTransferReq, params RequestOrganizationOwnershipTransferParams) (RequestOrganizationOwnershipTransferRes, error)
+	// RequestTalkSessionOwnershipTransfer implements requestTalkSessionOwnershipTransfer operation.
+	//
+	// セッションのオーナーか組織の管理者以上のみ依頼できる。組織のセッションは組織のメンバーにのみ譲渡できる.
+	//
+	// POST /talksessions/{talkSessionID}/ownership-transfers
+	RequestTalkSessionOwnershipTransfer(ctx context.Context, req *RequestTalkSessionOwnershipTransferReq, params RequestTalkSessionOwnershipTransferParams) (RequestTalkSessionOwnershipTransferRes, error)
+}
+
 // PolicyHandler handles operations described by OpenAPI v3 specification.
 //
 // x-ogen-operation-group: Policy
@@ -387,6 +744,13 @@ type PolicyHandler interface {
 //
 // x-ogen-operation-group: TalkSession
 type TalkSessionHandler interface {
+	// CloneTalkSession implements cloneTalkSession operation.
+	//
+	// セッションを複製して下書きを作成する。参加制限・シード意見・サムネイルは引き継ぎ、投票や参加者の情報は引き継がない。
+	// 下書きはpublishするまでオーナー以外参加できない。.
+	//
+	// POST /talksessions/{talkSessionID}/clone
+	CloneTalkSession(ctx context.Context, req *CloneTalkSessionReq, params CloneTalkSessionParams) (CloneTalkSessionRes, error)
 	// ConsentTalkSession implements consentTalkSession operation.
 	//
 	// セッションへの同意.
@@ -417,6 +781,12 @@ type TalkSessionHandler interface {
 	//
 	// GET /talksessions/{talkSessionID}/reports
 	GetReportsForTalkSession(ctx context.Context, params GetReportsForTalkSessionParams) (GetReportsForTalkSessionRes, error)
+	// GetTalkSessionCollaborators implements getTalkSessionCollaborators operation.
+	//
+	// セッションのオーナーと共同管理者の一覧を返す。オーナーと共同管理者のみ取得できる.
+	//
+	// GET /talksessions/{talkSessionID}/collaborators
+	GetTalkSessionCollaborators(ctx context.Context, params GetTalkSessionCollaboratorsParams) (GetTalkSessionCollaboratorsRes, error)
 	// GetTalkSessionDetail implements getTalkSessionDetail operation.
 	//
 	// トークセッションの詳細.
@@ -485,6 +855,32 @@ type TalkSessionHandler interface {
 	//
 	// POST /talksessions/{talkSessionID}/conclusion
 	PostConclusion(ctx context.Context, req *PostConclusionReq, params PostConclusionParams) (PostConclusionRes, error)
+	// PublishTalkSession implements publishTalkSession operation.
+	//
+	// 下書きのセッションを公開する。オーナーと共同オーナーのみ公開できる.
+	//
+	// POST /talksessions/{talkSessionID}/publish
+	PublishTalkSession(ctx context.Context, params PublishTalkSessionParams) (PublishTalkSessionRes, error)
+	// RemoveTalkSessionCollaborator implements removeTalkSessionCollaborator operation.
+	//
+	// 共同管理者を外す。オーナーと共同オーナーの他、共同管理者自身も外れることができる.
+	//
+	// DELETE /talksessions/{talkSessionID}/collaborators/{displayID}
+	RemoveTalkSessionCollaborator(ctx context.Context, params RemoveTalkSessionCollaboratorParams) (RemoveTalkSessionCollaboratorRes, error)
+	// SaveTalkSessionTemplate implements saveTalkSessionTemplate operation.
+	//
+	// セッションの参加制限・説明・シード意見・サムネイルなどを組織のテンプレートとして保存する。
+	// セッションのオーナーか、セッションが属する組織の管理者のみ保存できる。.
+	//
+	// POST /talksessions/{talkSessionID}/templates
+	SaveTalkSessionTemplate(ctx context.Context, req *SaveTalkSessionTemplateReq, params SaveTalkSessionTemplateParams) (SaveTalkSessionTemplateRes, error)
+	// SetTalkSessionCollaborator implements setTalkSessionCollaborator operation.
+	//
+	// 共同管理者を追加する。既に共同管理者の場合はロールを変更する。
+	// オーナーと共同オーナーのみ実行できる。.
+	//
+	// PUT /talksessions/{talkSessionID}/collaborators/{displayID}
+	SetTalkSessionCollaborator(ctx context.Context, req *SetTalkSessionCollaboratorReq, params SetTalkSessionCollaboratorParams) (SetTalkSessionCollaboratorRes, error)
 	// TalkSessionAnalysis implements talkSessionAnalysis operation.
 	//
 	// 分析結果一覧.
@@ -587,6 +983,13 @@ type UserHandler interface {
 //
 // x-ogen-operation-group: Vote
 type VoteHandler interface {
+	// BatchVote implements batchVote operation.
+	//
+	// オフラインの端末でまとめた投票を1つのトランザクションで反映する。投票は端末で投票した順に反映する。
+	// 同じ冪等キーで再送された投票は反映せず、処理済みの結果を返す。投票できなかった投票は投票ごとの結果で返し、他の投票は反映する.
+	//
+	// POST /talksessions/{talkSessionID}/votes:batch
+	BatchVote(ctx context.Context, req *BatchVoteReq, params BatchVoteParams) (BatchVoteRes, error)
 	// Vote2 implements vote2 operation.
 	//
 	// 意思表明API.
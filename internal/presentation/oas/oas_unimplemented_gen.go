@@ -13,6 +13,25 @@ type UnimplementedHandler struct{}
 
 var _ Handler = UnimplementedHandler{}
 
+// AcceptOrganizationInvitation implements acceptOrganizationInvitation operation.
+//
+// 招待メールに記載されたトークンで招待を承諾し、組織に参加する。
+// 招待されたメールアドレスのアカウントでログインしている必要がある。.
+//
+// POST /organizations/invitations/accept
+func (UnimplementedHandler) AcceptOrganizationInvitation(ctx context.Context, req *AcceptOrganizationInvitationReq) (r AcceptOrganizationInvitationRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AcceptOwnershipTransfer implements acceptOwnershipTransfer operation.
+//
+// 譲渡先のユーザーのみ承諾できる。承諾するとオーナーが変更される.
+//
+// POST /ownership-transfers/{transferID}/accept
+func (UnimplementedHandler) AcceptOwnershipTransfer(ctx context.Context, params AcceptOwnershipTransferParams) (r AcceptOwnershipTransferRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ApplyFeedbackToReport implements applyFeedbackToReport operation.
 //
 // セッションのレポートにフィードバックを適用する.
@@ -40,6 +59,63 @@ func (UnimplementedHandler) Authorize(ctx context.Context, params AuthorizeParam
 	return r, ht.ErrNotImplemented
 }
 
+// BatchVote implements batchVote operation.
+//
+// オフラインの端末でまとめた投票を1つのトランザクションで反映する。投票は端末で投票した順に反映する。
+// 同じ冪等キーで再送された投票は反映せず、処理済みの結果を返す。投票できなかった投票は投票ごとの結果で返し、他の投票は反映する.
+//
+// POST /talksessions/{talkSessionID}/votes:batch
+func (UnimplementedHandler) BatchVote(ctx context.Context, req *BatchVoteReq, params BatchVoteParams) (r BatchVoteRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// BulkCreateOrganizationInvitations implements bulkCreateOrganizationInvitations operation.
+//
+// CSVファイルで一括招待する。
+// 1列目にメールアドレス、2列目にロール（数値またはロール名。省略時はメンバー）を指定する。
+// 1行目が「email」または「メールアドレス」の場合はヘッダーとして読み飛ばす。
+// 最大500行まで。失敗した行があっても残りの行は処理され、行ごとの結果を返す。.
+//
+// POST /organizations/invitations/bulk
+func (UnimplementedHandler) BulkCreateOrganizationInvitations(ctx context.Context, req *BulkCreateOrganizationInvitationsReq) (r BulkCreateOrganizationInvitationsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// BulkTransferTalkSessions implements bulkTransferTalkSessions operation.
+//
+// 指定したメンバーが組織内でオーナーのセッションを、まとめて別のメンバーに譲渡依頼する。組織のオーナー以上のみ実行できる。未承諾の譲渡依頼があるセッションはスキップする.
+//
+// POST /organizations/{code}/ownership-transfers/bulk
+func (UnimplementedHandler) BulkTransferTalkSessions(ctx context.Context, req *BulkTransferTalkSessionsReq, params BulkTransferTalkSessionsParams) (r BulkTransferTalkSessionsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CancelOwnershipTransfer implements cancelOwnershipTransfer operation.
+//
+// 依頼者か譲渡元のユーザーのみ取り消せる.
+//
+// POST /ownership-transfers/{transferID}/cancel
+func (UnimplementedHandler) CancelOwnershipTransfer(ctx context.Context, params CancelOwnershipTransferParams) (r CancelOwnershipTransferRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ChangeOrganizationUserRole implements changeOrganizationUserRole operation.
+//
+// 組織ユーザーのロールを変更する。
+// 組織のAdmin以上のユーザーが実行可能で、自分と同じかそれ以下のロールのユーザーを、自分と同じかそれ以下のロールにのみ変更できる。
+// 最後のオーナーは降格できない。
+// 変更されたユーザーは組織アカウントから一度ログアウトされる。
+// Role
+// - 10: SuperAdmin
+// - 20: Owner
+// - 30: Admin
+// - 40: Member.
+//
+// PUT /organizations/users/{userID}/role
+func (UnimplementedHandler) ChangeOrganizationUserRole(ctx context.Context, req *ChangeOrganizationUserRoleReq, params ChangeOrganizationUserRoleParams) (r ChangeOrganizationUserRoleRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ChangePassword implements changePassword operation.
 //
 // パスワード変更.
@@ -58,6 +134,25 @@ func (UnimplementedHandler) CheckDeviceExists(ctx context.Context, params CheckD
 	return r, ht.ErrNotImplemented
 }
 
+// CloneTalkSession implements cloneTalkSession operation.
+//
+// セッションを複製して下書きを作成する。参加制限・シード意見・サムネイルは引き継ぎ、投票や参加者の情報は引き継がない。
+// 下書きはpublishするまでオーナー以外参加できない。.
+//
+// POST /talksessions/{talkSessionID}/clone
+func (UnimplementedHandler) CloneTalkSession(ctx context.Context, req *CloneTalkSessionReq, params CloneTalkSessionParams) (r CloneTalkSessionRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CloneTalkSessionTemplate implements cloneTalkSessionTemplate operation.
+//
+// テンプレートから下書きのセッションを作成する。組織のメンバーであれば誰でも使える.
+//
+// POST /organizations/{code}/talksession-templates/{templateID}/clone
+func (UnimplementedHandler) CloneTalkSessionTemplate(ctx context.Context, req *CloneTalkSessionTemplateReq, params CloneTalkSessionTemplateParams) (r CloneTalkSessionTemplateRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ConsentTalkSession implements consentTalkSession operation.
 //
 // セッションへの同意.
@@ -76,6 +171,46 @@ func (UnimplementedHandler) CreateOrganizationAlias(ctx context.Context, req *Cr
 	return r, ht.ErrNotImplemented
 }
 
+// CreateOrganizationApiKey implements createOrganizationApiKey operation.
+//
+// サーバー間連携用のAPIキーを発行する。
+// 発行したキーはこのレスポンスでのみ返されるため、安全な場所に保管してください。
+// APIキーは`X-API-Key`ヘッダーに指定して使用します。
+// scopesには以下を指定できます。
+// - `sessions:read` セッションの閲覧
+// - `sessions:write` セッションの作成・編集
+// - `results:export` 分析結果・レポートの取得.
+//
+// POST /organizations/api-keys
+func (UnimplementedHandler) CreateOrganizationApiKey(ctx context.Context, req *CreateOrganizationApiKeyReq) (r CreateOrganizationApiKeyRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CreateOrganizationInvitation implements createOrganizationInvitation operation.
+//
+// 招待リンクをメールで送信する。
+// 招待されたユーザーはリンクからログイン（未登録の場合は登録）した後、招待を承諾することで組織に参加できる。
+// 招待リンクの有効期限は7日間。
+// 自分と同じかそれ以下のロールでのみ招待できる。
+// Role
+// - 20: Owner
+// - 30: Admin
+// - 40: Member.
+//
+// POST /organizations/invitations
+func (UnimplementedHandler) CreateOrganizationInvitation(ctx context.Context, req *CreateOrganizationInvitationReq) (r CreateOrganizationInvitationRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeclineOwnershipTransfer implements declineOwnershipTransfer operation.
+//
+// 譲渡先のユーザーのみ辞退できる.
+//
+// POST /ownership-transfers/{transferID}/decline
+func (UnimplementedHandler) DeclineOwnershipTransfer(ctx context.Context, params DeclineOwnershipTransferParams) (r DeclineOwnershipTransferRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // DeleteDevice implements deleteDevice operation.
 //
 // デバイス削除.
@@ -85,6 +220,15 @@ func (UnimplementedHandler) DeleteDevice(ctx context.Context, params DeleteDevic
 	return r, ht.ErrNotImplemented
 }
 
+// DeleteOpinion implements deleteOpinion operation.
+//
+// 投稿者のみ削除できる。削除した意見は「削除されました」と表示される.
+//
+// DELETE /opinions/{opinionID}
+func (UnimplementedHandler) DeleteOpinion(ctx context.Context, params DeleteOpinionParams) (r DeleteOpinionRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // DeleteOrganizationAlias implements deleteOrganizationAlias operation.
 //
 // 組織エイリアス削除.
@@ -94,6 +238,15 @@ func (UnimplementedHandler) DeleteOrganizationAlias(ctx context.Context, params
 	return r, ht.ErrNotImplemented
 }
 
+// DeleteTalkSessionTemplate implements deleteTalkSessionTemplate operation.
+//
+// テンプレートの作成者か組織の管理者のみ削除できる.
+//
+// DELETE /organizations/{code}/talksession-templates/{templateID}
+func (UnimplementedHandler) DeleteTalkSessionTemplate(ctx context.Context, params DeleteTalkSessionTemplateParams) (r DeleteTalkSessionTemplateRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // DevAuthorize implements devAuthorize operation.
 //
 // 開発用登録/ログイン.
@@ -103,6 +256,16 @@ func (UnimplementedHandler) DevAuthorize(ctx context.Context, params DevAuthoriz
 	return r, ht.ErrNotImplemented
 }
 
+// DownloadOrganizationAuditLogs implements downloadOrganizationAuditLogs operation.
+//
+// 条件に一致する監査ログをすべてCSV（UTF-8 BOM付き）で出力する。
+// 変更した項目ごとに1行出力する。.
+//
+// GET /organizations/audit-logs/csv
+func (UnimplementedHandler) DownloadOrganizationAuditLogs(ctx context.Context, params DownloadOrganizationAuditLogsParams) (r DownloadOrganizationAuditLogsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // DummyInit implements dummyInit operation.
 //
 // Init dummy.
@@ -112,6 +275,16 @@ func (UnimplementedHandler) DummyInit(ctx context.Context, req *DummyInitReq) (r
 	return r, ht.ErrNotImplemented
 }
 
+// EditOpinion implements editOpinion operation.
+//
+// 投稿者のみ編集できる。投稿してから一定期間内で、投稿者以外の投票が一定数以下の場合に限る。
+// 内容が大きく変わった場合は、投稿者以外の投票をリセットする。.
+//
+// PUT /opinions/{opinionID}
+func (UnimplementedHandler) EditOpinion(ctx context.Context, req *EditOpinionReq, params EditOpinionParams) (r EditOpinionRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // EditTalkSession implements editTalkSession operation.
 //
 // セッション編集.
@@ -149,6 +322,16 @@ func (UnimplementedHandler) EstablishUser(ctx context.Context, req *EstablishUse
 	return r, ht.ErrNotImplemented
 }
 
+// GetAnalysisJob implements getAnalysisJob operation.
+//
+// 非同期に実行しているレポート・ワードクラウドの生成ジョブの状況を返す。
+// 失敗したジョブは上限まで間隔を空けて再実行する.
+//
+// GET /talksessions/{talkSessionID}/analysis/jobs/{jobID}
+func (UnimplementedHandler) GetAnalysisJob(ctx context.Context, params GetAnalysisJobParams) (r GetAnalysisJobRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetAnalysisReportManage implements getAnalysisReportManage operation.
 //
 // GET /v1/manage/talksessions/{talkSessionID}/analysis/report
@@ -156,6 +339,43 @@ func (UnimplementedHandler) GetAnalysisReportManage(ctx context.Context, params
 	return r, ht.ErrNotImplemented
 }
 
+// GetAnalysisSnapshot implements getAnalysisSnapshot operation.
+//
+// スナップショット時点のグループ分け、ポジション、代表意見を返す.
+//
+// GET /talksessions/{talkSessionID}/analysis/snapshots/{snapshotID}
+func (UnimplementedHandler) GetAnalysisSnapshot(ctx context.Context, params GetAnalysisSnapshotParams) (r GetAnalysisSnapshotRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetAnalysisSnapshotDiff implements getAnalysisSnapshotDiff operation.
+//
+// 2つのスナップショットを比べ、グループが変わったユーザーを返す.
+//
+// GET /talksessions/{talkSessionID}/analysis/snapshots/diff
+func (UnimplementedHandler) GetAnalysisSnapshotDiff(ctx context.Context, params GetAnalysisSnapshotDiffParams) (r GetAnalysisSnapshotDiffRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetAnalysisSnapshots implements getAnalysisSnapshots operation.
+//
+// 分析のたびに残したグループ分けと代表意見のスナップショットを古い順に返す.
+//
+// GET /talksessions/{talkSessionID}/analysis/snapshots
+func (UnimplementedHandler) GetAnalysisSnapshots(ctx context.Context, params GetAnalysisSnapshotsParams) (r GetAnalysisSnapshotsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetAnalysisStatus implements getAnalysisStatus operation.
+//
+// 投票・意見の投稿に応じた分析の再計算の状況を返す。
+// 最後の分析以降の投票が一定数に達するか、最初の投票・意見から一定時間が経つと再計算する.
+//
+// GET /talksessions/{talkSessionID}/analysis/status
+func (UnimplementedHandler) GetAnalysisStatus(ctx context.Context, params GetAnalysisStatusParams) (r GetAnalysisStatusRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetConclusion implements getConclusion operation.
 //
 // 結論取得.
@@ -165,6 +385,16 @@ func (UnimplementedHandler) GetConclusion(ctx context.Context, params GetConclus
 	return r, ht.ErrNotImplemented
 }
 
+// GetConsensus implements getConsensus operation.
+//
+// 意見ごとにグループの賛成確率を求め、全てのグループが賛成している意見と、グループで賛否が分かれている意見を返す。
+// 賛成確率は投票が少ないグループで極端にならないよう平滑化する.
+//
+// GET /talksessions/{talkSessionID}/analysis/consensus
+func (UnimplementedHandler) GetConsensus(ctx context.Context, params GetConsensusParams) (r GetConsensusRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetDevices implements getDevices operation.
 //
 // デバイス一覧取得.
@@ -174,6 +404,26 @@ func (UnimplementedHandler) GetDevices(ctx context.Context) (r GetDevicesRes, _
 	return r, ht.ErrNotImplemented
 }
 
+// GetJwks implements getJwks operation.
+//
+// トークン検証用の公開鍵一覧.
+//
+// GET /.well-known/jwks.json
+func (UnimplementedHandler) GetJwks(ctx context.Context) (r GetJwksRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetMyAnalysisPosition implements getMyAnalysisPosition operation.
+//
+// ログインしているユーザーの分析結果での位置を返す。
+// 所属するグループ、各グループの中心までの距離、グループと投票が最も一致・食い違っている意見、
+// まだ投票していない意見のうち同じグループのメンバーが賛成している意見を含む.
+//
+// GET /talksessions/{talkSessionID}/analysis/me
+func (UnimplementedHandler) GetMyAnalysisPosition(ctx context.Context, params GetMyAnalysisPositionParams) (r GetMyAnalysisPositionRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetNotificationPreferences implements getNotificationPreferences operation.
 //
 // 通知設定取得.
@@ -194,7 +444,9 @@ func (UnimplementedHandler) GetOpenedTalkSession(ctx context.Context, params Get
 
 // GetOpinionAnalysis implements getOpinionAnalysis operation.
 //
-// 意見に投票したグループごとの割合.
+// WeightByを指定すると、セッションを作成した組織が登録した母集団の構成に合わせて重みを付けた割合も返す。
+// 重みはその属性でのセッションの参加者全体の構成から求める。
+// 参加者の属性を使うため、weightByの指定はセッションを作成した組織のメンバーに限る.
 //
 // GET /opinions/{opinionID}/analysis
 func (UnimplementedHandler) GetOpinionAnalysis(ctx context.Context, params GetOpinionAnalysisParams) (r GetOpinionAnalysisRes, _ error) {
@@ -228,6 +480,15 @@ func (UnimplementedHandler) GetOpinionReports(ctx context.Context, params GetOpi
 	return r, ht.ErrNotImplemented
 }
 
+// GetOpinionRevisions implements getOpinionRevisions operation.
+//
+// 投稿者と、セッションのオーナー・通報に対応できる共同管理者のみ取得できる。削除された意見の内容も返す.
+//
+// GET /opinions/{opinionID}/revisions
+func (UnimplementedHandler) GetOpinionRevisions(ctx context.Context, params GetOpinionRevisionsParams) (r GetOpinionRevisionsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetOpinionsForTalkSession implements getOpinionsForTalkSession operation.
 //
 // セッションに対する意見一覧.
@@ -246,6 +507,85 @@ func (UnimplementedHandler) GetOrganizationAliases(ctx context.Context) (r GetOr
 	return r, ht.ErrNotImplemented
 }
 
+// GetOrganizationApiKeys implements getOrganizationApiKeys operation.
+//
+// 組織APIキー一覧取得.
+//
+// GET /organizations/api-keys
+func (UnimplementedHandler) GetOrganizationApiKeys(ctx context.Context) (r GetOrganizationApiKeysRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetOrganizationAuditLogs implements getOrganizationAuditLogs operation.
+//
+// 組織の監査ログを新しい順に取得する。オーナー以上の権限が必要。
+// 運営（ことひろ組織のスーパー管理者）はorganizationIDを指定して他の組織の監査ログを取得できる。
+// sinceは指定時刻を含み、untilは指定時刻を含まない。.
+//
+// GET /organizations/audit-logs
+func (UnimplementedHandler) GetOrganizationAuditLogs(ctx context.Context, params GetOrganizationAuditLogsParams) (r GetOrganizationAuditLogsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetOrganizationChildren implements getOrganizationChildren operation.
+//
+// 直下の子組織を取得する。
+// 現在の組織またはその子孫の組織を指定できる。.
+//
+// GET /organizations/{code}/children
+func (UnimplementedHandler) GetOrganizationChildren(ctx context.Context, params GetOrganizationChildrenParams) (r GetOrganizationChildrenRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetOrganizationInvitations implements getOrganizationInvitations operation.
+//
+// 組織への招待一覧取得.
+//
+// GET /organizations/invitations
+func (UnimplementedHandler) GetOrganizationInvitations(ctx context.Context) (r GetOrganizationInvitationsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetOrganizationReferenceDemographics implements getOrganizationReferenceDemographics operation.
+//
+// 参加者の代表性を確かめるために組織が登録した、属性ごとの母集団の構成を取得する。管理者以上の権限が必要.
+//
+// GET /organizations/{code}/reference-demographics
+func (UnimplementedHandler) GetOrganizationReferenceDemographics(ctx context.Context, params GetOrganizationReferenceDemographicsParams) (r GetOrganizationReferenceDemographicsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetOrganizationReportPrompt implements getOrganizationReportPrompt operation.
+//
+// レポートをLLMで生成する際の組織の指示を取得する。管理者以上の権限が必要.
+//
+// GET /organizations/{code}/report-prompt
+func (UnimplementedHandler) GetOrganizationReportPrompt(ctx context.Context, params GetOrganizationReportPromptParams) (r GetOrganizationReportPromptRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetOrganizationStats implements getOrganizationStats operation.
+//
+// 組織のセッション・参加者・意見・投票・同意・レポートへのフィードバックの件数を集計する。
+// bucketで日・週（月曜日始まり）・月単位に集計し、sinceとuntilを省略した場合は直近30日・12週・12ヶ月を返す。
+// オーナー以上の権限が必要で、現在の組織またはその子孫の組織を指定できる。.
+//
+// GET /organizations/{code}/stats
+func (UnimplementedHandler) GetOrganizationStats(ctx context.Context, params GetOrganizationStatsParams) (r GetOrganizationStatsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetOrganizationTalkSessions implements getOrganizationTalkSessions operation.
+//
+// 組織のセッションを新しい順に取得する。
+// includeDescendantsを指定すると子孫の組織のセッションもまとめて取得する。
+// 現在の組織またはその子孫の組織を指定できる。.
+//
+// GET /organizations/{code}/talksessions
+func (UnimplementedHandler) GetOrganizationTalkSessions(ctx context.Context, params GetOrganizationTalkSessionsParams) (r GetOrganizationTalkSessionsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetOrganizationUsers implements getOrganizationUsers operation.
 //
 // 現在の組織のユーザー一覧取得.
@@ -273,6 +613,20 @@ func (UnimplementedHandler) GetPolicyConsentStatus(ctx context.Context) (r GetPo
 	return r, ht.ErrNotImplemented
 }
 
+// GetReportVersionDiffManage implements getReportVersionDiffManage operation.
+//
+// GET /v1/manage/talksessions/{talkSessionID}/analysis/report/versions/diff
+func (UnimplementedHandler) GetReportVersionDiffManage(ctx context.Context, params GetReportVersionDiffManageParams) (r *ReportVersionDiff, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetReportVersionsManage implements getReportVersionsManage operation.
+//
+// GET /v1/manage/talksessions/{talkSessionID}/analysis/report/versions
+func (UnimplementedHandler) GetReportVersionsManage(ctx context.Context, params GetReportVersionsManageParams) (r []ReportVersionForManage, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetReportsForTalkSession implements getReportsForTalkSession operation.
 //
 // 通報一覧.
@@ -282,6 +636,22 @@ func (UnimplementedHandler) GetReportsForTalkSession(ctx context.Context, params
 	return r, ht.ErrNotImplemented
 }
 
+// GetSigningKeysManage implements getSigningKeysManage operation.
+//
+// GET /v1/manage/auth/signing-keys
+func (UnimplementedHandler) GetSigningKeysManage(ctx context.Context) (r []SigningKeyForManage, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetTalkSessionCollaborators implements getTalkSessionCollaborators operation.
+//
+// セッションのオーナーと共同管理者の一覧を返す。オーナーと共同管理者のみ取得できる.
+//
+// GET /talksessions/{talkSessionID}/collaborators
+func (UnimplementedHandler) GetTalkSessionCollaborators(ctx context.Context, params GetTalkSessionCollaboratorsParams) (r GetTalkSessionCollaboratorsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetTalkSessionDetail implements getTalkSessionDetail operation.
 //
 // トークセッションの詳細.
@@ -332,6 +702,16 @@ func (UnimplementedHandler) GetTalkSessionReportCount(ctx context.Context, param
 	return r, ht.ErrNotImplemented
 }
 
+// GetTalkSessionRepresentativeness implements getTalkSessionRepresentativeness operation.
+//
+// 組織のセッションの参加者の構成を、組織が登録した母集団の構成と属性ごとに比べる。管理者以上の権限が必要。
+// 参加者は意見を投稿したか投票したユーザーで、属性は登録時のものを使う.
+//
+// GET /organizations/{code}/talksessions/{talkSessionID}/representativeness
+func (UnimplementedHandler) GetTalkSessionRepresentativeness(ctx context.Context, params GetTalkSessionRepresentativenessParams) (r GetTalkSessionRepresentativenessRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetTalkSessionRestrictionKeys implements getTalkSessionRestrictionKeys operation.
 //
 // セッションの投稿制限に使用できるキーの一覧を返す.
@@ -350,6 +730,25 @@ func (UnimplementedHandler) GetTalkSessionRestrictionSatisfied(ctx context.Conte
 	return r, ht.ErrNotImplemented
 }
 
+// GetTalkSessionTemplates implements getTalkSessionTemplates operation.
+//
+// 組織のセッションテンプレート一覧.
+//
+// GET /organizations/{code}/talksession-templates
+func (UnimplementedHandler) GetTalkSessionTemplates(ctx context.Context, params GetTalkSessionTemplatesParams) (r GetTalkSessionTemplatesRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetTermFrequencies implements getTermFrequencies operation.
+//
+// 意見を形態素解析して集計した単語を返す。ワードクラウドをアプリケーション内で生成した場合のみ集計がある。
+// グループごとの単語は、グループの意見をまとめて1つの文書とみなしたTF-IDFで並べる.
+//
+// GET /talksessions/{talkSessionID}/analysis/terms
+func (UnimplementedHandler) GetTermFrequencies(ctx context.Context, params GetTermFrequenciesParams) (r GetTermFrequenciesRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetTimeLine implements getTimeLine operation.
 //
 // タイムラインはセッション終了後にセッション作成者が設定できるその後の予定を知らせるもの.
@@ -368,6 +767,16 @@ func (UnimplementedHandler) GetTokenInfo(ctx context.Context) (r GetTokenInfoRes
 	return r, ht.ErrNotImplemented
 }
 
+// GetTopics implements getTopics operation.
+//
+// 意見の本文をTF-IDFで重み付けしてk-meansでトピックに分け、トピックごとの投票数を返す。
+// 投票によるグループ分けとは別に、どのような話題の意見があるかを示す.
+//
+// GET /talksessions/{talkSessionID}/topics
+func (UnimplementedHandler) GetTopics(ctx context.Context, params GetTopicsParams) (r GetTopicsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetUserByDisplayID implements getUserByDisplayID operation.
 //
 // 表示IDからユーザー情報の取得.
@@ -425,6 +834,16 @@ func (UnimplementedHandler) GetVapidKey(ctx context.Context) (r *GetVapidKeyOK,
 	return r, ht.ErrNotImplemented
 }
 
+// GetVoteShifts implements getVoteShifts operation.
+//
+// 投票の変更履歴から、ユーザーごとに最初の投票と最後の投票を比べた変化を集計する。
+// 最後の投票より前にその意見への返信に投票していれば、返信を読んでから変化したとみなす.
+//
+// GET /talksessions/{talkSessionID}/analysis/vote-shifts
+func (UnimplementedHandler) GetVoteShifts(ctx context.Context, params GetVoteShiftsParams) (r GetVoteShiftsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // HandleAuthCallback implements handleAuthCallback operation.
 //
 // Auth Callback.
@@ -490,6 +909,15 @@ func (UnimplementedHandler) InviteOrganizationForUser(ctx context.Context, req *
 	return r, ht.ErrNotImplemented
 }
 
+// ListOwnershipTransfers implements listOwnershipTransfers operation.
+//
+// 自分宛ての譲渡依頼と、自分が依頼した・自分が譲渡元の譲渡依頼を返す.
+//
+// GET /ownership-transfers
+func (UnimplementedHandler) ListOwnershipTransfers(ctx context.Context) (r ListOwnershipTransfersRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ManageRegenerateManage implements manageRegenerateManage operation.
 //
 // POST /v1/manage/talksessions/{talkSessionID}/analysis/regenerate
@@ -581,6 +1009,22 @@ func (UnimplementedHandler) PostTimeLineItem(ctx context.Context, req *PostTimeL
 	return r, ht.ErrNotImplemented
 }
 
+// PublishReportVersionManage implements publishReportVersionManage operation.
+//
+// POST /v1/manage/talksessions/{talkSessionID}/analysis/report/publish
+func (UnimplementedHandler) PublishReportVersionManage(ctx context.Context, req *PublishReportVersionRequest, params PublishReportVersionManageParams) (r *ReportPublicationForManage, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// PublishTalkSession implements publishTalkSession operation.
+//
+// 下書きのセッションを公開する。オーナーと共同オーナーのみ公開できる.
+//
+// POST /talksessions/{talkSessionID}/publish
+func (UnimplementedHandler) PublishTalkSession(ctx context.Context, params PublishTalkSessionParams) (r PublishTalkSessionRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ReactivateUser implements reactivateUser operation.
 //
 // 退会ユーザーの復活.
@@ -599,6 +1043,26 @@ func (UnimplementedHandler) RegisterDevice(ctx context.Context, req *RegisterDev
 	return r, ht.ErrNotImplemented
 }
 
+// RemoveOrganizationUser implements removeOrganizationUser operation.
+//
+// 組織からユーザーを削除する。
+// ロール変更と同じ権限ルールが適用され、最後のオーナーは削除できない。
+// 削除されたユーザーの組織アカウントでのセッションは無効化される。.
+//
+// DELETE /organizations/users/{userID}
+func (UnimplementedHandler) RemoveOrganizationUser(ctx context.Context, params RemoveOrganizationUserParams) (r RemoveOrganizationUserRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RemoveTalkSessionCollaborator implements removeTalkSessionCollaborator operation.
+//
+// 共同管理者を外す。オーナーと共同オーナーの他、共同管理者自身も外れることができる.
+//
+// DELETE /talksessions/{talkSessionID}/collaborators/{displayID}
+func (UnimplementedHandler) RemoveTalkSessionCollaborator(ctx context.Context, params RemoveTalkSessionCollaboratorParams) (r RemoveTalkSessionCollaboratorRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // ReportOpinion implements reportOpinion operation.
 //
 // 意見通報API.
@@ -608,6 +1072,52 @@ func (UnimplementedHandler) ReportOpinion(ctx context.Context, req *ReportOpinio
 	return r, ht.ErrNotImplemented
 }
 
+// RequestOrganizationOwnershipTransfer implements requestOrganizationOwnershipTransfer operation.
+//
+// 組織のオーナーか運営のみ依頼できる。譲渡先は組織に直接所属するメンバーに限る.
+//
+// POST /organizations/{code}/ownership-transfers
+func (UnimplementedHandler) RequestOrganizationOwnershipTransfer(ctx context.Context, req *RequestOrganizationOwnershipTransferReq, params RequestOrganizationOwnershipTransferParams) (r RequestOrganizationOwnershipTransferRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RequestTalkSessionOwnershipTransfer implements requestTalkSessionOwnershipTransfer operation.
+//
+// セッションのオーナーか組織の管理者以上のみ依頼できる。組織のセッションは組織のメンバーにのみ譲渡できる.
+//
+// POST /talksessions/{talkSessionID}/ownership-transfers
+func (UnimplementedHandler) RequestTalkSessionOwnershipTransfer(ctx context.Context, req *RequestTalkSessionOwnershipTransferReq, params RequestTalkSessionOwnershipTransferParams) (r RequestTalkSessionOwnershipTransferRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ResendOrganizationInvitation implements resendOrganizationInvitation operation.
+//
+// 招待リンクを再発行してメールを再送する。
+// 以前に送信したリンクは使えなくなり、有効期限は再送時から7日間に延長される。.
+//
+// POST /organizations/invitations/{invitationID}/resend
+func (UnimplementedHandler) ResendOrganizationInvitation(ctx context.Context, params ResendOrganizationInvitationParams) (r ResendOrganizationInvitationRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RevokeOrganizationApiKey implements revokeOrganizationApiKey operation.
+//
+// 組織APIキー失効.
+//
+// DELETE /organizations/api-keys/{apiKeyID}
+func (UnimplementedHandler) RevokeOrganizationApiKey(ctx context.Context, params RevokeOrganizationApiKeyParams) (r RevokeOrganizationApiKeyRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RevokeOrganizationInvitation implements revokeOrganizationInvitation operation.
+//
+// 組織への招待の取り消し.
+//
+// DELETE /organizations/invitations/{invitationID}
+func (UnimplementedHandler) RevokeOrganizationInvitation(ctx context.Context, params RevokeOrganizationInvitationParams) (r RevokeOrganizationInvitationRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // RevokeToken implements revokeToken operation.
 //
 // トークンを失効（ログアウト）.
@@ -617,6 +1127,23 @@ func (UnimplementedHandler) RevokeToken(ctx context.Context) (r RevokeTokenRes,
 	return r, ht.ErrNotImplemented
 }
 
+// RotateSigningKeyManage implements rotateSigningKeyManage operation.
+//
+// POST /v1/manage/auth/signing-keys/rotate
+func (UnimplementedHandler) RotateSigningKeyManage(ctx context.Context) (r *SigningKeyForManage, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// SaveTalkSessionTemplate implements saveTalkSessionTemplate operation.
+//
+// セッションの参加制限・説明・シード意見・サムネイルなどを組織のテンプレートとして保存する。
+// セッションのオーナーか、セッションが属する組織の管理者のみ保存できる。.
+//
+// POST /talksessions/{talkSessionID}/templates
+func (UnimplementedHandler) SaveTalkSessionTemplate(ctx context.Context, req *SaveTalkSessionTemplateReq, params SaveTalkSessionTemplateParams) (r SaveTalkSessionTemplateRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // SendTestNotification implements sendTestNotification operation.
 //
 // テスト通知送信.
@@ -635,6 +1162,16 @@ func (UnimplementedHandler) SessionsHistory(ctx context.Context, params Sessions
 	return r, ht.ErrNotImplemented
 }
 
+// SetTalkSessionCollaborator implements setTalkSessionCollaborator operation.
+//
+// 共同管理者を追加する。既に共同管理者の場合はロールを変更する。
+// オーナーと共同オーナーのみ実行できる。.
+//
+// PUT /talksessions/{talkSessionID}/collaborators/{displayID}
+func (UnimplementedHandler) SetTalkSessionCollaborator(ctx context.Context, req *SetTalkSessionCollaboratorReq, params SetTalkSessionCollaboratorParams) (r SetTalkSessionCollaboratorRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // SolveOpinionReport implements solveOpinionReport operation.
 //
 // 通報を解決.
@@ -707,6 +1244,39 @@ func (UnimplementedHandler) UpdateOrganization(ctx context.Context, req *UpdateO
 	return r, ht.ErrNotImplemented
 }
 
+// UpdateOrganizationParent implements updateOrganizationParent operation.
+//
+// 組織の親組織を設定する。parentCodeを省略すると親子関係を解除する。
+// 対象の組織・現在の親組織・新しい親組織のすべてでオーナー以上の権限が必要。
+// 親組織のロールは子孫の組織にも継承される。.
+//
+// PUT /organizations/{code}/parent
+func (UnimplementedHandler) UpdateOrganizationParent(ctx context.Context, req *UpdateOrganizationParentReq, params UpdateOrganizationParentParams) (r UpdateOrganizationParentRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateOrganizationReferenceDemographics implements updateOrganizationReferenceDemographics operation.
+//
+// 属性の母集団の構成をCSVで登録する。登録済みの場合は置き換える。管理者以上の権限が必要。
+// CSVは1列目に区分、2列目に人数または割合（「12.
+// 5%」のような百分率も可）を指定する。
+// 1行目の2列目が数値でない場合はヘッダーとして読み飛ばす.
+//
+// PUT /organizations/{code}/reference-demographics
+func (UnimplementedHandler) UpdateOrganizationReferenceDemographics(ctx context.Context, req *UpdateOrganizationReferenceDemographicsReq, params UpdateOrganizationReferenceDemographicsParams) (r UpdateOrganizationReferenceDemographicsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UpdateOrganizationReportPrompt implements updateOrganizationReportPrompt operation.
+//
+// レポートをLLMで生成する際の組織の指示を更新する。管理者以上の権限が必要。
+// 組織のセッションのレポートを次に生成するときから使われる.
+//
+// PUT /organizations/{code}/report-prompt
+func (UnimplementedHandler) UpdateOrganizationReportPrompt(ctx context.Context, req *UpdateOrganizationReportPromptReq, params UpdateOrganizationReportPromptParams) (r UpdateOrganizationReportPromptRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // UpdateUserProfile implements updateUserProfile operation.
 //
 // ユーザー情報の変更.
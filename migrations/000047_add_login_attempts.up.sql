@@ -0,0 +1,29 @@
+-- パスワードログインの試行履歴（監査用）
+CREATE TABLE login_attempts (
+    login_attempt_id UUID PRIMARY KEY,
+    user_id UUID REFERENCES users(user_id) ON DELETE SET NULL,
+    ip_address INET,
+    user_agent TEXT,
+    succeeded BOOLEAN NOT NULL,
+    failure_reason VARCHAR(30) CHECK (failure_reason IN ('invalid_credentials', 'account_locked', 'ip_locked')),
+    attempted_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_login_attempts_user_id ON login_attempts(user_id, attempted_at DESC);
+CREATE INDEX idx_login_attempts_ip_address ON login_attempts(ip_address, attempted_at DESC);
+
+-- アカウント単位・IP単位のログイン失敗カウンタとロック状態
+CREATE TABLE login_lockouts (
+    scope VARCHAR(10) NOT NULL CHECK (scope IN ('account', 'ip')),
+    lock_key TEXT NOT NULL,
+    failure_count INTEGER NOT NULL DEFAULT 0,
+    lockout_count INTEGER NOT NULL DEFAULT 0,
+    last_failed_at TIMESTAMP WITH TIME ZONE,
+    locked_until TIMESTAMP WITH TIME ZONE,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (scope, lock_key)
+);
+
+COMMENT ON TABLE login_attempts IS 'パスワードログインの試行履歴';
+COMMENT ON TABLE login_lockouts IS 'ログイン失敗回数と指数的ロックアウトの状態';
+COMMENT ON COLUMN login_lockouts.lockout_count IS '連続してロックされた回数。ロック時間の算出に使用し、ログイン成功でリセットされる';
@@ -0,0 +1,18 @@
+-- トークン署名鍵。kidで識別し、ローテーション後も猶予期間中は検証に使用する
+CREATE TABLE token_signing_keys (
+    key_id VARCHAR(64) PRIMARY KEY,
+    algorithm VARCHAR(10) NOT NULL CHECK (algorithm IN ('ES256', 'RS256')),
+    -- 秘密鍵はアプリケーション側で暗号化したPKCS#8 PEMを保存する
+    encrypted_private_key TEXT NOT NULL,
+    public_key TEXT NOT NULL,
+    status VARCHAR(10) NOT NULL CHECK (status IN ('active', 'retired')),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    retired_at TIMESTAMP WITH TIME ZONE,
+    verify_until TIMESTAMP WITH TIME ZONE
+);
+
+-- 署名に使用する鍵は常に1つ
+CREATE UNIQUE INDEX idx_token_signing_keys_active ON token_signing_keys(status) WHERE status = 'active';
+
+COMMENT ON TABLE token_signing_keys IS 'トークン署名鍵。JWKSとして公開鍵を公開する';
+COMMENT ON COLUMN token_signing_keys.verify_until IS 'ローテーション後、この日時まではこの鍵で署名されたトークンを検証できる';
@@ -0,0 +1,23 @@
+-- 組織単位のAPIキー（サーバー間連携用）
+CREATE TABLE organization_api_keys (
+    api_key_id UUID PRIMARY KEY,
+    organization_id UUID NOT NULL REFERENCES organizations(organization_id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    key_prefix VARCHAR(16) NOT NULL,
+    key_hash VARCHAR(64) NOT NULL,
+    scopes TEXT[] NOT NULL,
+    created_by UUID NOT NULL REFERENCES users(user_id),
+    expires_at TIMESTAMP WITH TIME ZONE,
+    last_used_at TIMESTAMP WITH TIME ZONE,
+    revoked_at TIMESTAMP WITH TIME ZONE,
+    revoked_by UUID REFERENCES users(user_id),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT unique_organization_api_key_hash UNIQUE (key_hash)
+);
+
+CREATE INDEX idx_organization_api_keys_org_id ON organization_api_keys(organization_id, created_at DESC);
+
+COMMENT ON TABLE organization_api_keys IS '組織のAPIキー。キー本体は保存せずハッシュのみ保持する';
+COMMENT ON COLUMN organization_api_keys.key_prefix IS '一覧表示用のキー先頭部分';
+COMMENT ON COLUMN organization_api_keys.scopes IS '許可されたスコープ (sessions:read, sessions:write, results:export)';
@@ -0,0 +1,32 @@
+-- 組織への招待（招待リンクの状態管理）
+CREATE TABLE organization_invitations (
+    invitation_id UUID PRIMARY KEY,
+    organization_id UUID NOT NULL REFERENCES organizations(organization_id) ON DELETE CASCADE,
+    email TEXT NOT NULL,
+    email_hash VARCHAR(255) NOT NULL,
+    role INTEGER NOT NULL,
+    token_hash VARCHAR(64) NOT NULL,
+    status VARCHAR(16) NOT NULL DEFAULT 'pending',
+    invited_by UUID NOT NULL REFERENCES users(user_id),
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    send_count INTEGER NOT NULL DEFAULT 1,
+    last_sent_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    accepted_by UUID REFERENCES users(user_id),
+    accepted_at TIMESTAMP WITH TIME ZONE,
+    revoked_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT unique_organization_invitation_token_hash UNIQUE (token_hash),
+    CONSTRAINT check_organization_invitation_status CHECK (status IN ('pending', 'accepted', 'revoked'))
+);
+
+-- 同じメールアドレスへの未承諾の招待は組織ごとに1件まで
+CREATE UNIQUE INDEX idx_organization_invitations_pending_email
+    ON organization_invitations(organization_id, email_hash)
+    WHERE status = 'pending';
+CREATE INDEX idx_organization_invitations_org_id ON organization_invitations(organization_id, created_at DESC);
+
+COMMENT ON TABLE organization_invitations IS '組織への招待。トークンは保存せずハッシュのみ保持する';
+COMMENT ON COLUMN organization_invitations.email IS '暗号化された招待先メールアドレス';
+COMMENT ON COLUMN organization_invitations.email_hash IS '招待先メールアドレスのハッシュ（重複確認・承諾時の照合用）';
+COMMENT ON COLUMN organization_invitations.status IS 'pending: 未承諾, accepted: 承諾済み, revoked: 取り消し済み。期限切れはexpires_atで判定する';
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS organization_audit_logs_append_only ON organization_audit_logs;
+DROP FUNCTION IF EXISTS prevent_organization_audit_log_modification();
+DROP TABLE IF EXISTS organization_audit_logs;
@@ -0,0 +1,30 @@
+-- 組織の管理操作の監査ログ（追記のみ）
+CREATE TABLE organization_audit_logs (
+    audit_log_id UUID PRIMARY KEY,
+    organization_id UUID NOT NULL REFERENCES organizations(organization_id),
+    actor_id UUID NOT NULL REFERENCES users(user_id),
+    action VARCHAR(64) NOT NULL,
+    target_type VARCHAR(32) NOT NULL,
+    target_id VARCHAR(255) NOT NULL,
+    changes JSONB NOT NULL DEFAULT '{}'::jsonb,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_organization_audit_logs_org_id ON organization_audit_logs(organization_id, created_at DESC);
+CREATE INDEX idx_organization_audit_logs_actor_id ON organization_audit_logs(organization_id, actor_id, created_at DESC);
+
+-- 監査ログは改ざんできないよう更新・削除を禁止する
+CREATE FUNCTION prevent_organization_audit_log_modification() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'organization_audit_logs is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER organization_audit_logs_append_only
+    BEFORE UPDATE OR DELETE ON organization_audit_logs
+    FOR EACH ROW EXECUTE FUNCTION prevent_organization_audit_log_modification();
+
+COMMENT ON TABLE organization_audit_logs IS '組織の管理操作の監査ログ。追記のみで更新・削除はできない';
+COMMENT ON COLUMN organization_audit_logs.action IS '操作の種類（organization.updated など）';
+COMMENT ON COLUMN organization_audit_logs.target_type IS '操作対象の種類（organization, user, talksession など）';
+COMMENT ON COLUMN organization_audit_logs.changes IS '変更前後の差分。{"項目名": {"before": 変更前, "after": 変更後}}';
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_organizations_parent_organization_id;
+
+ALTER TABLE organizations
+    DROP CONSTRAINT IF EXISTS organizations_parent_not_self,
+    DROP COLUMN IF EXISTS parent_organization_id;
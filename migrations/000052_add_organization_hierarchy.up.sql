@@ -0,0 +1,6 @@
+-- 組織の親子関係（例: 都道府県 > 市区町村）
+ALTER TABLE organizations
+    ADD COLUMN parent_organization_id UUID REFERENCES organizations(organization_id),
+    ADD CONSTRAINT organizations_parent_not_self CHECK (parent_organization_id <> organization_id);
+
+CREATE INDEX idx_organizations_parent_organization_id ON organizations(parent_organization_id);
@@ -0,0 +1,16 @@
+DROP TRIGGER IF EXISTS report_feedback_organization_stats ON report_feedback;
+DROP TRIGGER IF EXISTS talksession_consents_organization_stats ON talksession_consents;
+DROP TRIGGER IF EXISTS votes_organization_stats ON votes;
+DROP TRIGGER IF EXISTS opinions_organization_stats ON opinions;
+DROP TRIGGER IF EXISTS talk_sessions_organization_stats ON talk_sessions;
+
+DROP FUNCTION IF EXISTS organization_stats_on_report_feedback();
+DROP FUNCTION IF EXISTS organization_stats_on_consent();
+DROP FUNCTION IF EXISTS organization_stats_on_vote();
+DROP FUNCTION IF EXISTS organization_stats_on_opinion();
+DROP FUNCTION IF EXISTS organization_stats_on_talk_session();
+DROP FUNCTION IF EXISTS record_organization_daily_participant(UUID, DATE, UUID);
+DROP FUNCTION IF EXISTS increment_organization_daily_stat(UUID, DATE, TEXT);
+
+DROP TABLE IF EXISTS organization_daily_participants;
+DROP TABLE IF EXISTS organization_daily_stats;
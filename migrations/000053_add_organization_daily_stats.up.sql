@@ -0,0 +1,159 @@
+-- 組織ダッシュボード用の日次集計。元テーブルへの追加時にトリガーで逐次更新する
+CREATE TABLE organization_daily_stats (
+    organization_id UUID NOT NULL REFERENCES organizations(organization_id),
+    stat_date DATE NOT NULL,
+    talk_sessions_created INTEGER NOT NULL DEFAULT 0,
+    opinions INTEGER NOT NULL DEFAULT 0,
+    votes INTEGER NOT NULL DEFAULT 0,
+    consents INTEGER NOT NULL DEFAULT 0,
+    report_feedbacks INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (organization_id, stat_date)
+);
+
+-- 参加者（意見の投稿または投票をしたユーザー）の日次一覧
+-- 週・月単位でも重複なく数えられるよう、件数ではなくユーザー単位で保持する
+CREATE TABLE organization_daily_participants (
+    organization_id UUID NOT NULL REFERENCES organizations(organization_id),
+    stat_date DATE NOT NULL,
+    user_id UUID NOT NULL,
+    PRIMARY KEY (organization_id, stat_date, user_id)
+);
+
+CREATE FUNCTION increment_organization_daily_stat(p_organization_id UUID, p_stat_date DATE, p_column TEXT) RETURNS VOID AS $$
+BEGIN
+    IF p_organization_id IS NULL THEN
+        RETURN;
+    END IF;
+    EXECUTE format(
+        'INSERT INTO organization_daily_stats (organization_id, stat_date, %1$I) VALUES ($1, $2, 1)
+         ON CONFLICT (organization_id, stat_date) DO UPDATE SET %1$I = organization_daily_stats.%1$I + 1',
+        p_column
+    ) USING p_organization_id, p_stat_date;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE FUNCTION record_organization_daily_participant(p_organization_id UUID, p_stat_date DATE, p_user_id UUID) RETURNS VOID AS $$
+BEGIN
+    IF p_organization_id IS NULL THEN
+        RETURN;
+    END IF;
+    INSERT INTO organization_daily_participants (organization_id, stat_date, user_id)
+    VALUES (p_organization_id, p_stat_date, p_user_id)
+    ON CONFLICT DO NOTHING;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE FUNCTION organization_stats_on_talk_session() RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM increment_organization_daily_stat(NEW.organization_id, NEW.created_at::date, 'talk_sessions_created');
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE FUNCTION organization_stats_on_opinion() RETURNS TRIGGER AS $$
+DECLARE
+    v_organization_id UUID;
+BEGIN
+    SELECT organization_id INTO v_organization_id FROM talk_sessions WHERE talk_session_id = NEW.talk_session_id;
+    PERFORM increment_organization_daily_stat(v_organization_id, NEW.created_at::date, 'opinions');
+    PERFORM record_organization_daily_participant(v_organization_id, NEW.created_at::date, NEW.user_id);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE FUNCTION organization_stats_on_vote() RETURNS TRIGGER AS $$
+DECLARE
+    v_organization_id UUID;
+BEGIN
+    SELECT organization_id INTO v_organization_id FROM talk_sessions WHERE talk_session_id = NEW.talk_session_id;
+    PERFORM increment_organization_daily_stat(v_organization_id, NEW.created_at::date, 'votes');
+    PERFORM record_organization_daily_participant(v_organization_id, NEW.created_at::date, NEW.user_id);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE FUNCTION organization_stats_on_consent() RETURNS TRIGGER AS $$
+DECLARE
+    v_organization_id UUID;
+BEGIN
+    SELECT organization_id INTO v_organization_id FROM talk_sessions WHERE talk_session_id = NEW.talksession_id;
+    PERFORM increment_organization_daily_stat(v_organization_id, NEW.consented_at::date, 'consents');
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE FUNCTION organization_stats_on_report_feedback() RETURNS TRIGGER AS $$
+DECLARE
+    v_organization_id UUID;
+BEGIN
+    -- talk_session_report_history_idにはレポート履歴のIDではなくセッションのIDが入っている場合もある
+    SELECT talk_sessions.organization_id INTO v_organization_id
+    FROM talk_sessions
+    WHERE talk_sessions.talk_session_id = COALESCE(
+        (
+            SELECT talk_session_report_histories.talk_session_id
+            FROM talk_session_report_histories
+            WHERE talk_session_report_histories.talk_session_report_history_id = NEW.talk_session_report_history_id
+        ),
+        NEW.talk_session_report_history_id
+    );
+    PERFORM increment_organization_daily_stat(v_organization_id, NEW.created_at::date, 'report_feedbacks');
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER talk_sessions_organization_stats
+    AFTER INSERT ON talk_sessions
+    FOR EACH ROW EXECUTE FUNCTION organization_stats_on_talk_session();
+CREATE TRIGGER opinions_organization_stats
+    AFTER INSERT ON opinions
+    FOR EACH ROW EXECUTE FUNCTION organization_stats_on_opinion();
+CREATE TRIGGER votes_organization_stats
+    AFTER INSERT ON votes
+    FOR EACH ROW EXECUTE FUNCTION organization_stats_on_vote();
+CREATE TRIGGER talksession_consents_organization_stats
+    AFTER INSERT ON talksession_consents
+    FOR EACH ROW EXECUTE FUNCTION organization_stats_on_consent();
+CREATE TRIGGER report_feedback_organization_stats
+    AFTER INSERT ON report_feedback
+    FOR EACH ROW EXECUTE FUNCTION organization_stats_on_report_feedback();
+
+-- 既存データから集計を作成する
+INSERT INTO organization_daily_stats (organization_id, stat_date, talk_sessions_created, opinions, votes, consents, report_feedbacks)
+SELECT organization_id, stat_date, SUM(talk_sessions_created), SUM(opinions), SUM(votes), SUM(consents), SUM(report_feedbacks)
+FROM (
+    SELECT ts.organization_id, ts.created_at::date AS stat_date, 1 AS talk_sessions_created, 0 AS opinions, 0 AS votes, 0 AS consents, 0 AS report_feedbacks
+    FROM talk_sessions ts
+    WHERE ts.organization_id IS NOT NULL
+    UNION ALL
+    SELECT ts.organization_id, o.created_at::date, 0, 1, 0, 0, 0
+    FROM opinions o JOIN talk_sessions ts ON ts.talk_session_id = o.talk_session_id
+    WHERE ts.organization_id IS NOT NULL
+    UNION ALL
+    SELECT ts.organization_id, v.created_at::date, 0, 0, 1, 0, 0
+    FROM votes v JOIN talk_sessions ts ON ts.talk_session_id = v.talk_session_id
+    WHERE ts.organization_id IS NOT NULL
+    UNION ALL
+    SELECT ts.organization_id, c.consented_at::date, 0, 0, 0, 1, 0
+    FROM talksession_consents c JOIN talk_sessions ts ON ts.talk_session_id = c.talksession_id
+    WHERE ts.organization_id IS NOT NULL
+    UNION ALL
+    SELECT ts.organization_id, rf.created_at::date, 0, 0, 0, 0, 1
+    FROM report_feedback rf
+    LEFT JOIN talk_session_report_histories h ON h.talk_session_report_history_id = rf.talk_session_report_history_id
+    JOIN talk_sessions ts ON ts.talk_session_id = COALESCE(h.talk_session_id, rf.talk_session_report_history_id)
+    WHERE ts.organization_id IS NOT NULL
+) AS src
+GROUP BY organization_id, stat_date;
+
+INSERT INTO organization_daily_participants (organization_id, stat_date, user_id)
+SELECT ts.organization_id, o.created_at::date, o.user_id
+FROM opinions o JOIN talk_sessions ts ON ts.talk_session_id = o.talk_session_id
+WHERE ts.organization_id IS NOT NULL
+UNION
+SELECT ts.organization_id, v.created_at::date, v.user_id
+FROM votes v JOIN talk_sessions ts ON ts.talk_session_id = v.talk_session_id
+WHERE ts.organization_id IS NOT NULL;
+
+COMMENT ON TABLE organization_daily_stats IS '組織ごとの日次集計。トリガーで逐次更新される';
+COMMENT ON TABLE organization_daily_participants IS '組織ごとの日次の参加者。期間内の参加者数を重複なく数えるために使う';
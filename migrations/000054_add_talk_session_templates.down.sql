@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS talk_session_templates;
+ALTER TABLE talk_sessions DROP COLUMN IF EXISTS is_draft;
@@ -0,0 +1,27 @@
+-- 下書きのセッション。公開するまで一覧に表示せず、オーナー以外は参加できない
+ALTER TABLE talk_sessions ADD COLUMN is_draft BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- 組織内で使い回すセッションのひな形
+CREATE TABLE talk_session_templates (
+    template_id UUID PRIMARY KEY,
+    organization_id UUID NOT NULL REFERENCES organizations(organization_id) ON DELETE CASCADE,
+    organization_alias_id UUID REFERENCES organization_aliases(alias_id),
+    name VARCHAR(100) NOT NULL,
+    theme TEXT NOT NULL,
+    description TEXT,
+    thumbnail_url TEXT,
+    city TEXT,
+    prefecture TEXT,
+    restrictions JSONB,
+    hide_top BOOLEAN NOT NULL DEFAULT FALSE,
+    seed_opinions JSONB NOT NULL DEFAULT '[]'::jsonb,
+    created_by UUID NOT NULL REFERENCES users(user_id),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX idx_talk_session_templates_org_id ON talk_session_templates(organization_id, created_at DESC);
+
+COMMENT ON COLUMN talk_sessions.is_draft IS '下書きかどうか。複製したセッションは下書きとして作成される';
+COMMENT ON TABLE talk_session_templates IS '組織のセッションテンプレート。参加制限・シード意見・サムネイルなどを保持し、投票や参加者の情報は含まない';
+COMMENT ON COLUMN talk_session_templates.seed_opinions IS 'シード意見の一覧（title, content, reference_url, picture_url）';
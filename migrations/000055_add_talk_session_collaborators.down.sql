@@ -0,0 +1 @@
+DROP TABLE IF EXISTS talk_session_collaborators;
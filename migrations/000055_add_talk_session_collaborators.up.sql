@@ -0,0 +1,15 @@
+-- セッションごとの共同管理者。オーナー以外のユーザーにロールを付与してセッションの運営を分担する
+CREATE TABLE talk_session_collaborators (
+    talk_session_id UUID NOT NULL REFERENCES talk_sessions(talk_session_id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    role VARCHAR(20) NOT NULL CHECK (role IN ('co_owner', 'facilitator', 'moderator', 'viewer')),
+    added_by UUID NOT NULL REFERENCES users(user_id),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (talk_session_id, user_id)
+);
+
+CREATE INDEX idx_talk_session_collaborators_user_id ON talk_session_collaborators(user_id);
+
+COMMENT ON TABLE talk_session_collaborators IS 'セッションの共同管理者';
+COMMENT ON COLUMN talk_session_collaborators.role IS 'co_owner: 編集・結論・タイムライン・共同管理者の管理, facilitator: 結論・タイムライン, moderator: 通報の対応, viewer: 閲覧のみ';
@@ -0,0 +1,30 @@
+-- セッション・組織のオーナー権限の譲渡依頼。譲渡先のユーザーが承諾した時点でオーナーを変更する
+CREATE TABLE ownership_transfers (
+    transfer_id UUID PRIMARY KEY,
+    target_type VARCHAR(20) NOT NULL CHECK (target_type IN ('talksession', 'organization')),
+    talk_session_id UUID REFERENCES talk_sessions(talk_session_id) ON DELETE CASCADE,
+    organization_id UUID REFERENCES organizations(organization_id) ON DELETE CASCADE,
+    from_user_id UUID NOT NULL REFERENCES users(user_id),
+    to_user_id UUID NOT NULL REFERENCES users(user_id),
+    requested_by UUID NOT NULL REFERENCES users(user_id),
+    status VARCHAR(16) NOT NULL DEFAULT 'pending',
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    responded_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT check_ownership_transfer_status CHECK (status IN ('pending', 'accepted', 'declined', 'cancelled')),
+    CONSTRAINT check_ownership_transfer_target CHECK (
+        (target_type = 'talksession' AND talk_session_id IS NOT NULL) OR
+        (target_type = 'organization' AND talk_session_id IS NULL AND organization_id IS NOT NULL)
+    )
+);
+
+CREATE INDEX idx_ownership_transfers_talk_session_id ON ownership_transfers(talk_session_id) WHERE status = 'pending';
+CREATE INDEX idx_ownership_transfers_organization_id ON ownership_transfers(organization_id) WHERE status = 'pending';
+CREATE INDEX idx_ownership_transfers_to_user_id ON ownership_transfers(to_user_id, created_at DESC);
+CREATE INDEX idx_ownership_transfers_from_user_id ON ownership_transfers(from_user_id, created_at DESC);
+
+COMMENT ON TABLE ownership_transfers IS 'オーナー権限の譲渡依頼。承諾・辞退・取り消しの履歴としても残す';
+COMMENT ON COLUMN ownership_transfers.organization_id IS '譲渡する組織。セッションの譲渡ではセッションが所属する組織';
+COMMENT ON COLUMN ownership_transfers.requested_by IS '譲渡を依頼したユーザー。退職者のセッションを一括で譲渡する場合は組織の管理者になる';
+COMMENT ON COLUMN ownership_transfers.status IS 'pending: 未承諾, accepted: 承諾済み, declined: 辞退, cancelled: 取り消し。期限切れはexpires_atで判定する';
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS opinion_revisions;
+
+ALTER TABLE opinions
+    DROP COLUMN IF EXISTS deleted_at,
+    DROP COLUMN IF EXISTS edited_at,
+    DROP COLUMN IF EXISTS revision;
@@ -0,0 +1,29 @@
+-- 投稿者による意見の編集・削除
+ALTER TABLE opinions
+    ADD COLUMN revision INT NOT NULL DEFAULT 1,
+    ADD COLUMN edited_at TIMESTAMP,
+    ADD COLUMN deleted_at TIMESTAMP;
+
+COMMENT ON COLUMN opinions.revision IS '編集のたびに1ずつ増える版番号';
+COMMENT ON COLUMN opinions.edited_at IS '最後に編集された日時';
+COMMENT ON COLUMN opinions.deleted_at IS '投稿者が削除した日時。運営による削除は通報のステータスで管理する';
+
+-- 編集前の意見の内容。編集のたびに直前の版を残す
+CREATE TABLE opinion_revisions (
+    opinion_id UUID NOT NULL REFERENCES opinions(opinion_id) ON DELETE CASCADE,
+    revision INT NOT NULL,
+    title VARCHAR,
+    content VARCHAR NOT NULL,
+    reference_url VARCHAR,
+    vote_count INT NOT NULL DEFAULT 0,
+    votes_reset BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL,
+    replaced_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (opinion_id, revision)
+);
+
+COMMENT ON TABLE opinion_revisions IS '意見の編集履歴。opinionsには常に最新の版が入る';
+COMMENT ON COLUMN opinion_revisions.vote_count IS '編集された時点での投稿者以外の投票数';
+COMMENT ON COLUMN opinion_revisions.votes_reset IS '内容が大きく変わったため、この版への投票をリセットしたか';
+COMMENT ON COLUMN opinion_revisions.created_at IS 'この版の内容になった日時';
+COMMENT ON COLUMN opinion_revisions.replaced_at IS '次の版に編集された日時';
@@ -0,0 +1,3 @@
+DROP TRIGGER IF EXISTS vote_events_append_only ON vote_events;
+DROP FUNCTION IF EXISTS prevent_vote_event_modification();
+DROP TABLE IF EXISTS vote_events;
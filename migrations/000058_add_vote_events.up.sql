@@ -0,0 +1,37 @@
+-- 投票の変更履歴（追記のみ）
+-- votes は最新の投票のみを持つため、意見の変化を分析できるよう全ての変更を残す
+CREATE TABLE vote_events (
+    vote_event_id UUID PRIMARY KEY,
+    vote_id UUID NOT NULL,
+    opinion_id UUID NOT NULL REFERENCES opinions(opinion_id),
+    talk_session_id UUID NOT NULL REFERENCES talk_sessions(talk_session_id),
+    user_id UUID NOT NULL REFERENCES users(user_id),
+    previous_vote_type SMALLINT NOT NULL DEFAULT 0,
+    vote_type SMALLINT NOT NULL,
+    reason VARCHAR(32) NOT NULL,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_vote_events_talk_session_id ON vote_events(talk_session_id, created_at);
+CREATE INDEX idx_vote_events_user_id_opinion_id ON vote_events(user_id, opinion_id, created_at);
+
+-- 既存の投票は最初の投票として記録する
+INSERT INTO vote_events (vote_event_id, vote_id, opinion_id, talk_session_id, user_id, previous_vote_type, vote_type, reason, created_at)
+SELECT gen_random_uuid(), vote_id, opinion_id, talk_session_id, user_id, 0, vote_type, 'voted', created_at
+FROM votes;
+
+-- 履歴は改ざんできないよう更新・削除を禁止する
+CREATE FUNCTION prevent_vote_event_modification() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'vote_events is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER vote_events_append_only
+    BEFORE UPDATE OR DELETE ON vote_events
+    FOR EACH ROW EXECUTE FUNCTION prevent_vote_event_modification();
+
+COMMENT ON TABLE vote_events IS '投票の変更履歴。追記のみで更新・削除はできない';
+COMMENT ON COLUMN vote_events.previous_vote_type IS '変更前の投票。初めての投票では0（未投票）';
+COMMENT ON COLUMN vote_events.vote_type IS '変更後の投票。投票が取り消された場合は0（未投票）';
+COMMENT ON COLUMN vote_events.reason IS '変更の理由（voted: ユーザーの投票, opinion_edited: 意見の編集による投票のリセット）';
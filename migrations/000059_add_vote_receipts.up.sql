@@ -0,0 +1,17 @@
+-- 一括投票の冪等キーごとの処理結果
+-- オフラインの端末が同じ投票を再送しても、一度だけ反映されるようにする
+CREATE TABLE vote_receipts (
+    user_id UUID NOT NULL REFERENCES users(user_id),
+    idempotency_key VARCHAR(255) NOT NULL,
+    opinion_id UUID NOT NULL,
+    vote_type SMALLINT NOT NULL,
+    error_code VARCHAR(32),
+    error_message VARCHAR,
+    client_voted_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (user_id, idempotency_key)
+);
+
+COMMENT ON TABLE vote_receipts IS '一括投票の冪等キーごとの処理結果';
+COMMENT ON COLUMN vote_receipts.error_code IS '投票できなかった場合のエラーコード。反映された場合はNULL';
+COMMENT ON COLUMN vote_receipts.client_voted_at IS '端末で投票した日時';
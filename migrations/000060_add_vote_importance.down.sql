@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_votes_talk_session_id_important;
+ALTER TABLE votes DROP COLUMN IF EXISTS is_important;
@@ -0,0 +1,6 @@
+-- 賛成・反対とは別に、参加者が自分にとって重要な意見に付ける印
+ALTER TABLE votes ADD COLUMN is_important BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX idx_votes_talk_session_id_important ON votes(talk_session_id, opinion_id) WHERE is_important;
+
+COMMENT ON COLUMN votes.is_important IS '投票したユーザーにとって重要な意見か。投票の種類とは独立して付けられる';
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS analysis_snapshot_representative_opinions;
+DROP TABLE IF EXISTS analysis_snapshot_users;
+DROP TABLE IF EXISTS analysis_snapshots;
@@ -0,0 +1,36 @@
+-- 分析結果のスナップショット
+-- user_group_info と representative_opinions は分析のたびに上書きされるため、グループの変化を追えるよう分析結果を残す
+CREATE TABLE analysis_snapshots (
+    analysis_snapshot_id UUID PRIMARY KEY,
+    talk_session_id UUID NOT NULL REFERENCES talk_sessions(talk_session_id),
+    fingerprint VARCHAR(32) NOT NULL,
+    user_count INT NOT NULL,
+    group_count INT NOT NULL,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_analysis_snapshots_talk_session_id ON analysis_snapshots(talk_session_id, created_at);
+
+CREATE TABLE analysis_snapshot_users (
+    analysis_snapshot_id UUID NOT NULL REFERENCES analysis_snapshots(analysis_snapshot_id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(user_id),
+    group_id INT NOT NULL,
+    pos_x FLOAT NOT NULL,
+    pos_y FLOAT NOT NULL,
+    perimeter_index INT,
+    PRIMARY KEY (analysis_snapshot_id, user_id)
+);
+
+CREATE TABLE analysis_snapshot_representative_opinions (
+    analysis_snapshot_id UUID NOT NULL REFERENCES analysis_snapshots(analysis_snapshot_id) ON DELETE CASCADE,
+    opinion_id UUID NOT NULL REFERENCES opinions(opinion_id),
+    group_id INT NOT NULL,
+    rank INT NOT NULL,
+    agree_count INT NOT NULL DEFAULT 0,
+    disagree_count INT NOT NULL DEFAULT 0,
+    pass_count INT NOT NULL DEFAULT 0,
+    PRIMARY KEY (analysis_snapshot_id, opinion_id, group_id)
+);
+
+COMMENT ON TABLE analysis_snapshots IS '分析結果のスナップショット。分析のたびにグループ分けと代表意見を残す';
+COMMENT ON COLUMN analysis_snapshots.fingerprint IS 'グループ分けと代表意見のハッシュ。前回のスナップショットから変わっていない場合は保存しない';
@@ -0,0 +1,18 @@
+-- 投票・意見の投稿に応じて分析を再計算するためのスケジュール
+CREATE TABLE analysis_schedules (
+    talk_session_id UUID PRIMARY KEY REFERENCES talk_sessions(talk_session_id),
+    pending_vote_count INT NOT NULL DEFAULT 0,
+    pending_opinion_count INT NOT NULL DEFAULT 0,
+    pending_since TIMESTAMP,
+    running_since TIMESTAMP,
+    last_completed_at TIMESTAMP,
+    last_failed_at TIMESTAMP,
+    last_failure_reason TEXT,
+    updated_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_analysis_schedules_pending_since ON analysis_schedules(pending_since) WHERE pending_since IS NOT NULL;
+
+COMMENT ON TABLE analysis_schedules IS '分析の再計算のスケジュール。最後の分析以降の投票・意見の数を数える';
+COMMENT ON COLUMN analysis_schedules.pending_since IS '最後の分析以降で最初に投票・意見があった日時。分析を待っている活動がなければNULL';
+COMMENT ON COLUMN analysis_schedules.running_since IS '実行中の分析を開始した日時。実行中でなければNULL';
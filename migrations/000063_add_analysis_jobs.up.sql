@@ -0,0 +1,24 @@
+-- レポート・ワードクラウドの生成を非同期に実行するためのジョブ
+CREATE TABLE analysis_jobs (
+    analysis_job_id UUID PRIMARY KEY,
+    talk_session_id UUID NOT NULL REFERENCES talk_sessions(talk_session_id),
+    job_type VARCHAR(20) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT,
+    run_after TIMESTAMP NOT NULL,
+    started_at TIMESTAMP,
+    finished_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL
+);
+
+-- 同じセッション・種類のジョブは待機中・実行中のものを1つだけにする
+CREATE UNIQUE INDEX idx_analysis_jobs_active ON analysis_jobs(talk_session_id, job_type) WHERE status IN ('queued', 'running');
+CREATE INDEX idx_analysis_jobs_runnable ON analysis_jobs(run_after) WHERE status IN ('queued', 'running');
+
+COMMENT ON TABLE analysis_jobs IS 'レポート・ワードクラウドの生成ジョブ';
+COMMENT ON COLUMN analysis_jobs.job_type IS 'report: レポート, wordcloud: ワードクラウド';
+COMMENT ON COLUMN analysis_jobs.status IS 'queued: 待機中, running: 実行中, succeeded: 成功, failed: 失敗';
+COMMENT ON COLUMN analysis_jobs.attempts IS '実行した回数。失敗した場合は上限まで再実行する';
+COMMENT ON COLUMN analysis_jobs.run_after IS 'この日時以降に実行する。再実行の間隔を空けるのに使う';
@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS talk_session_report_publications;
+DROP INDEX IF EXISTS idx_talk_session_report_histories_version;
+ALTER TABLE talk_session_report_histories
+    DROP COLUMN IF EXISTS version,
+    DROP COLUMN IF EXISTS generator,
+    DROP COLUMN IF EXISTS model;
@@ -0,0 +1,61 @@
+-- 生成されたレポートをバージョンとして残す
+ALTER TABLE talk_session_report_histories
+    ADD COLUMN version INT,
+    ADD COLUMN generator VARCHAR(50) NOT NULL DEFAULT 'analysis-api',
+    ADD COLUMN model VARCHAR(100);
+
+UPDATE talk_session_report_histories
+SET version = numbered.version
+FROM (
+    SELECT
+        talk_session_report_history_id,
+        ROW_NUMBER() OVER (PARTITION BY talk_session_id ORDER BY created_at, talk_session_report_history_id) AS version
+    FROM talk_session_report_histories
+) numbered
+WHERE talk_session_report_histories.talk_session_report_history_id = numbered.talk_session_report_history_id;
+
+ALTER TABLE talk_session_report_histories ALTER COLUMN version SET NOT NULL;
+CREATE UNIQUE INDEX idx_talk_session_report_histories_version ON talk_session_report_histories(talk_session_id, version);
+
+-- 履歴に残っていない現在のレポートを最新のバージョンとして残す
+INSERT INTO talk_session_report_histories (talk_session_report_history_id, talk_session_id, report, created_at, version)
+SELECT gen_random_uuid(), talk_session_reports.talk_session_id, talk_session_reports.report, talk_session_reports.updated_at, COALESCE(latest.version, 0) + 1
+FROM talk_session_reports
+LEFT JOIN LATERAL (
+    SELECT h.version, h.report
+    FROM talk_session_report_histories h
+    WHERE h.talk_session_id = talk_session_reports.talk_session_id
+    ORDER BY h.version DESC
+    LIMIT 1
+) latest ON TRUE
+WHERE latest.report IS DISTINCT FROM talk_session_reports.report;
+
+COMMENT ON COLUMN talk_session_report_histories.version IS 'セッションごとに1から始まるバージョン番号';
+COMMENT ON COLUMN talk_session_report_histories.generator IS 'レポートを生成した実装';
+COMMENT ON COLUMN talk_session_report_histories.model IS '生成に使ったモデル。分からない場合はNULL';
+
+-- 公開するレポートのバージョン
+CREATE TABLE talk_session_report_publications (
+    talk_session_id UUID PRIMARY KEY REFERENCES talk_sessions(talk_session_id),
+    talk_session_report_history_id UUID NOT NULL REFERENCES talk_session_report_histories(talk_session_report_history_id),
+    pinned BOOLEAN NOT NULL DEFAULT FALSE,
+    published_by UUID REFERENCES users(user_id),
+    published_at TIMESTAMP NOT NULL
+);
+
+COMMENT ON TABLE talk_session_report_publications IS '公開するレポートのバージョン';
+COMMENT ON COLUMN talk_session_report_publications.pinned IS 'TRUEの場合、新しいバージョンが生成されても公開するバージョンを変えない';
+COMMENT ON COLUMN talk_session_report_publications.published_by IS '管理者が公開するバージョンを選んだ場合の操作者。自動で切り替えた場合はNULL';
+
+-- 既存のセッションは最新のバージョンを公開する
+INSERT INTO talk_session_report_publications (talk_session_id, talk_session_report_history_id, published_at)
+SELECT DISTINCT ON (h.talk_session_id) h.talk_session_id, h.talk_session_report_history_id, h.created_at
+FROM talk_session_report_histories h
+JOIN talk_sessions ON talk_sessions.talk_session_id = h.talk_session_id
+ORDER BY h.talk_session_id, h.version DESC;
+
+-- セッションのIDで記録されていたフィードバックを公開中のバージョンに付け替える
+UPDATE report_feedback
+SET talk_session_report_history_id = talk_session_report_publications.talk_session_report_history_id
+FROM talk_session_report_publications
+WHERE report_feedback.talk_session_report_history_id = talk_session_report_publications.talk_session_id;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS organization_report_prompts;
+ALTER TABLE talk_session_report_histories DROP COLUMN IF EXISTS sections;
+ALTER TABLE talk_session_reports DROP COLUMN IF EXISTS sections;
@@ -0,0 +1,19 @@
+-- LLMで生成したレポートの構造化された内容
+ALTER TABLE talk_session_reports ADD COLUMN sections JSONB;
+ALTER TABLE talk_session_report_histories ADD COLUMN sections JSONB;
+
+COMMENT ON COLUMN talk_session_reports.sections IS '要約・グループごとの立場・合意点・残された論点。分析APIで生成した場合はNULL';
+COMMENT ON COLUMN talk_session_report_histories.sections IS '要約・グループごとの立場・合意点・残された論点。分析APIで生成した場合はNULL';
+
+-- 組織ごとのレポート生成の指示
+CREATE TABLE organization_report_prompts (
+    organization_id UUID PRIMARY KEY REFERENCES organizations(organization_id),
+    system_prompt TEXT NOT NULL DEFAULT '',
+    instructions TEXT NOT NULL DEFAULT '',
+    updated_by UUID REFERENCES users(user_id),
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+COMMENT ON TABLE organization_report_prompts IS '組織ごとのレポート生成の指示';
+COMMENT ON COLUMN organization_report_prompts.system_prompt IS 'LLMの役割。空の場合は既定の指示を使う';
+COMMENT ON COLUMN organization_report_prompts.instructions IS '文体や重視する観点など、組織ごとに追加する指示';
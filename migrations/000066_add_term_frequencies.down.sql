@@ -0,0 +1,2 @@
+ALTER TABLE talk_session_generated_images ALTER COLUMN tsnc_url DROP DEFAULT;
+ALTER TABLE talk_session_generated_images DROP COLUMN IF EXISTS term_frequencies;
@@ -0,0 +1,6 @@
+-- アプリケーション内で生成したワードクラウドの単語の集計
+ALTER TABLE talk_session_generated_images ADD COLUMN term_frequencies JSONB;
+-- アプリケーション内ではt-SNEの画像を生成しない
+ALTER TABLE talk_session_generated_images ALTER COLUMN tsnc_url SET DEFAULT '';
+
+COMMENT ON COLUMN talk_session_generated_images.term_frequencies IS 'セッション全体の出現回数とグループごとのTF-IDF。分析APIで生成した場合はNULL';
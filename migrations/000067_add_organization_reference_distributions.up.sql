@@ -0,0 +1,13 @@
+-- 参加者の代表性を確かめるため、組織が登録する母集団の構成
+CREATE TABLE organization_reference_distributions (
+    organization_id UUID NOT NULL REFERENCES organizations(organization_id),
+    dimension VARCHAR(20) NOT NULL,
+    categories JSONB NOT NULL,
+    updated_by UUID REFERENCES users(user_id),
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (organization_id, dimension)
+);
+
+COMMENT ON TABLE organization_reference_distributions IS '組織が国勢調査などから登録した母集団の構成';
+COMMENT ON COLUMN organization_reference_distributions.dimension IS '属性。age_band・gender・prefecture・city';
+COMMENT ON COLUMN organization_reference_distributions.categories IS '区分と割合の配列。割合の合計は1';
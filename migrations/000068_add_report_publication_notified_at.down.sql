@@ -0,0 +1 @@
+ALTER TABLE talk_session_report_publications DROP COLUMN IF EXISTS notified_at;
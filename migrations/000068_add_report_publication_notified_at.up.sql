@@ -0,0 +1,4 @@
+-- 公開するバージョンが変わるたびに参加者へ通知しすぎないよう、最後に通知した日時を残す
+ALTER TABLE talk_session_report_publications ADD COLUMN notified_at TIMESTAMP;
+
+COMMENT ON COLUMN talk_session_report_publications.notified_at IS '公開するバージョンが変わったことを参加者に最後に通知した日時。通知していなければNULL';
@@ -0,0 +1,112 @@
+DROP TRIGGER IF EXISTS talk_sessions_organization_stats_delete ON talk_sessions;
+DROP TRIGGER IF EXISTS talk_sessions_organization_stats ON talk_sessions;
+DROP TRIGGER IF EXISTS opinions_organization_stats ON opinions;
+DROP TRIGGER IF EXISTS votes_organization_stats ON votes;
+DROP TRIGGER IF EXISTS talksession_consents_organization_stats ON talksession_consents;
+DROP TRIGGER IF EXISTS report_feedback_organization_stats ON report_feedback;
+
+CREATE FUNCTION increment_organization_daily_stat(p_organization_id UUID, p_stat_date DATE, p_column TEXT) RETURNS VOID AS $$
+BEGIN
+    IF p_organization_id IS NULL THEN
+        RETURN;
+    END IF;
+    EXECUTE format(
+        'INSERT INTO organization_daily_stats (organization_id, stat_date, %1$I) VALUES ($1, $2, 1)
+         ON CONFLICT (organization_id, stat_date) DO UPDATE SET %1$I = organization_daily_stats.%1$I + 1',
+        p_column
+    ) USING p_organization_id, p_stat_date;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION organization_stats_on_talk_session() RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM increment_organization_daily_stat(NEW.organization_id, NEW.created_at::date, 'talk_sessions_created');
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION organization_stats_on_opinion() RETURNS TRIGGER AS $$
+DECLARE
+    v_organization_id UUID;
+BEGIN
+    SELECT organization_id INTO v_organization_id FROM talk_sessions WHERE talk_session_id = NEW.talk_session_id;
+    PERFORM increment_organization_daily_stat(v_organization_id, NEW.created_at::date, 'opinions');
+    PERFORM record_organization_daily_participant(v_organization_id, NEW.created_at::date, NEW.user_id);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION organization_stats_on_vote() RETURNS TRIGGER AS $$
+DECLARE
+    v_organization_id UUID;
+BEGIN
+    SELECT organization_id INTO v_organization_id FROM talk_sessions WHERE talk_session_id = NEW.talk_session_id;
+    PERFORM increment_organization_daily_stat(v_organization_id, NEW.created_at::date, 'votes');
+    PERFORM record_organization_daily_participant(v_organization_id, NEW.created_at::date, NEW.user_id);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION organization_stats_on_consent() RETURNS TRIGGER AS $$
+DECLARE
+    v_organization_id UUID;
+BEGIN
+    SELECT organization_id INTO v_organization_id FROM talk_sessions WHERE talk_session_id = NEW.talksession_id;
+    PERFORM increment_organization_daily_stat(v_organization_id, NEW.consented_at::date, 'consents');
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION organization_stats_on_report_feedback() RETURNS TRIGGER AS $$
+DECLARE
+    v_organization_id UUID;
+BEGIN
+    -- talk_session_report_history_idにはレポート履歴のIDではなくセッションのIDが入っている場合もある
+    SELECT talk_sessions.organization_id INTO v_organization_id
+    FROM talk_sessions
+    WHERE talk_sessions.talk_session_id = COALESCE(
+        (
+            SELECT talk_session_report_histories.talk_session_id
+            FROM talk_session_report_histories
+            WHERE talk_session_report_histories.talk_session_report_history_id = NEW.talk_session_report_history_id
+        ),
+        NEW.talk_session_report_history_id
+    );
+    PERFORM increment_organization_daily_stat(v_organization_id, NEW.created_at::date, 'report_feedbacks');
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER talk_sessions_organization_stats
+    AFTER INSERT ON talk_sessions
+    FOR EACH ROW EXECUTE FUNCTION organization_stats_on_talk_session();
+CREATE TRIGGER opinions_organization_stats
+    AFTER INSERT ON opinions
+    FOR EACH ROW EXECUTE FUNCTION organization_stats_on_opinion();
+CREATE TRIGGER votes_organization_stats
+    AFTER INSERT ON votes
+    FOR EACH ROW EXECUTE FUNCTION organization_stats_on_vote();
+CREATE TRIGGER talksession_consents_organization_stats
+    AFTER INSERT ON talksession_consents
+    FOR EACH ROW EXECUTE FUNCTION organization_stats_on_consent();
+CREATE TRIGGER report_feedback_organization_stats
+    AFTER INSERT ON report_feedback
+    FOR EACH ROW EXECUTE FUNCTION organization_stats_on_report_feedback();
+
+DROP FUNCTION IF EXISTS move_talk_session_organization_stats(UUID, UUID, UUID);
+DROP FUNCTION IF EXISTS report_feedback_talk_session_id(UUID);
+DROP FUNCTION IF EXISTS refresh_organization_daily_participant(UUID, DATE, UUID);
+DROP FUNCTION IF EXISTS add_organization_daily_stat(UUID, DATE, TEXT, INTEGER);
+DROP FUNCTION IF EXISTS organization_stat_date(TIMESTAMP);
+DROP FUNCTION IF EXISTS organization_stat_date(TIMESTAMPTZ);
+
+ALTER TABLE organization_daily_stats
+    DROP CONSTRAINT organization_daily_stats_organization_id_fkey,
+    ADD CONSTRAINT organization_daily_stats_organization_id_fkey
+        FOREIGN KEY (organization_id) REFERENCES organizations(organization_id);
+ALTER TABLE organization_daily_participants
+    DROP CONSTRAINT organization_daily_participants_organization_id_fkey,
+    ADD CONSTRAINT organization_daily_participants_organization_id_fkey
+        FOREIGN KEY (organization_id) REFERENCES organizations(organization_id);
+
+COMMENT ON TABLE organization_daily_stats IS '組織ごとの日次集計。トリガーで逐次更新される';
@@ -0,0 +1,333 @@
+-- 組織の日次集計を日本時間の日付で区切り、削除やセッションの組織の変更にも追従させる
+
+ALTER TABLE organization_daily_stats
+    DROP CONSTRAINT organization_daily_stats_organization_id_fkey,
+    ADD CONSTRAINT organization_daily_stats_organization_id_fkey
+        FOREIGN KEY (organization_id) REFERENCES organizations(organization_id) ON DELETE CASCADE;
+ALTER TABLE organization_daily_participants
+    DROP CONSTRAINT organization_daily_participants_organization_id_fkey,
+    ADD CONSTRAINT organization_daily_participants_organization_id_fkey
+        FOREIGN KEY (organization_id) REFERENCES organizations(organization_id) ON DELETE CASCADE;
+
+-- TIMESTAMPの列はUTCで保存されているため、日本時間に変換してから日付にする
+CREATE FUNCTION organization_stat_date(p_at TIMESTAMP) RETURNS DATE AS $$
+    SELECT (p_at AT TIME ZONE 'UTC' AT TIME ZONE 'Asia/Tokyo')::date;
+$$ LANGUAGE sql IMMUTABLE;
+
+CREATE FUNCTION organization_stat_date(p_at TIMESTAMPTZ) RETURNS DATE AS $$
+    SELECT (p_at AT TIME ZONE 'Asia/Tokyo')::date;
+$$ LANGUAGE sql IMMUTABLE;
+
+CREATE FUNCTION add_organization_daily_stat(p_organization_id UUID, p_stat_date DATE, p_column TEXT, p_delta INTEGER) RETURNS VOID AS $$
+BEGIN
+    IF p_organization_id IS NULL OR p_delta = 0 THEN
+        RETURN;
+    END IF;
+    EXECUTE format(
+        'INSERT INTO organization_daily_stats (organization_id, stat_date, %1$I) VALUES ($1, $2, $3)
+         ON CONFLICT (organization_id, stat_date) DO UPDATE SET %1$I = organization_daily_stats.%1$I + $3',
+        p_column
+    ) USING p_organization_id, p_stat_date, p_delta;
+END;
+$$ LANGUAGE plpgsql;
+
+-- その日に組織内で削除されていない意見の投稿または投票が残っていれば参加者として残し、なければ取り除く
+CREATE FUNCTION refresh_organization_daily_participant(p_organization_id UUID, p_stat_date DATE, p_user_id UUID) RETURNS VOID AS $$
+BEGIN
+    IF p_organization_id IS NULL THEN
+        RETURN;
+    END IF;
+    IF EXISTS (
+        SELECT 1 FROM opinions o JOIN talk_sessions ts ON ts.talk_session_id = o.talk_session_id
+        WHERE ts.organization_id = p_organization_id
+            AND o.user_id = p_user_id
+            AND o.deleted_at IS NULL
+            AND organization_stat_date(o.created_at) = p_stat_date
+    ) OR EXISTS (
+        SELECT 1 FROM votes v JOIN talk_sessions ts ON ts.talk_session_id = v.talk_session_id
+        WHERE ts.organization_id = p_organization_id
+            AND v.user_id = p_user_id
+            AND organization_stat_date(v.created_at) = p_stat_date
+    ) THEN
+        PERFORM record_organization_daily_participant(p_organization_id, p_stat_date, p_user_id);
+    ELSE
+        DELETE FROM organization_daily_participants
+        WHERE organization_id = p_organization_id AND stat_date = p_stat_date AND user_id = p_user_id;
+    END IF;
+END;
+$$ LANGUAGE plpgsql;
+
+-- talk_session_report_history_idにはレポート履歴のIDではなくセッションのIDが入っている場合もある
+CREATE FUNCTION report_feedback_talk_session_id(p_talk_session_report_history_id UUID) RETURNS UUID AS $$
+    SELECT COALESCE(
+        (
+            SELECT talk_session_report_histories.talk_session_id
+            FROM talk_session_report_histories
+            WHERE talk_session_report_histories.talk_session_report_history_id = p_talk_session_report_history_id
+        ),
+        p_talk_session_report_history_id
+    );
+$$ LANGUAGE sql STABLE;
+
+-- セッションの組織が変わったときに、そのセッション分の集計を移し替える。削除時はp_new_organization_idをNULLにして差し引くだけにする
+CREATE FUNCTION move_talk_session_organization_stats(p_talk_session_id UUID, p_old_organization_id UUID, p_new_organization_id UUID) RETURNS VOID AS $$
+DECLARE
+    r RECORD;
+BEGIN
+    FOR r IN
+        SELECT stat_date,
+            SUM(talk_sessions_created)::int AS talk_sessions_created,
+            SUM(opinions)::int AS opinions,
+            SUM(votes)::int AS votes,
+            SUM(consents)::int AS consents,
+            SUM(report_feedbacks)::int AS report_feedbacks
+        FROM (
+            SELECT organization_stat_date(ts.created_at) AS stat_date, 1 AS talk_sessions_created, 0 AS opinions, 0 AS votes, 0 AS consents, 0 AS report_feedbacks
+            FROM talk_sessions ts WHERE ts.talk_session_id = p_talk_session_id
+            UNION ALL
+            SELECT organization_stat_date(o.created_at), 0, 1, 0, 0, 0
+            FROM opinions o WHERE o.talk_session_id = p_talk_session_id AND o.deleted_at IS NULL
+            UNION ALL
+            SELECT organization_stat_date(v.created_at), 0, 0, 1, 0, 0
+            FROM votes v WHERE v.talk_session_id = p_talk_session_id
+            UNION ALL
+            SELECT organization_stat_date(c.consented_at), 0, 0, 0, 1, 0
+            FROM talksession_consents c WHERE c.talksession_id = p_talk_session_id
+            UNION ALL
+            SELECT organization_stat_date(rf.created_at), 0, 0, 0, 0, 1
+            FROM report_feedback rf
+            WHERE report_feedback_talk_session_id(rf.talk_session_report_history_id) = p_talk_session_id
+        ) AS src
+        GROUP BY stat_date
+    LOOP
+        PERFORM add_organization_daily_stat(p_old_organization_id, r.stat_date, 'talk_sessions_created', -r.talk_sessions_created);
+        PERFORM add_organization_daily_stat(p_old_organization_id, r.stat_date, 'opinions', -r.opinions);
+        PERFORM add_organization_daily_stat(p_old_organization_id, r.stat_date, 'votes', -r.votes);
+        PERFORM add_organization_daily_stat(p_old_organization_id, r.stat_date, 'consents', -r.consents);
+        PERFORM add_organization_daily_stat(p_old_organization_id, r.stat_date, 'report_feedbacks', -r.report_feedbacks);
+        PERFORM add_organization_daily_stat(p_new_organization_id, r.stat_date, 'talk_sessions_created', r.talk_sessions_created);
+        PERFORM add_organization_daily_stat(p_new_organization_id, r.stat_date, 'opinions', r.opinions);
+        PERFORM add_organization_daily_stat(p_new_organization_id, r.stat_date, 'votes', r.votes);
+        PERFORM add_organization_daily_stat(p_new_organization_id, r.stat_date, 'consents', r.consents);
+        PERFORM add_organization_daily_stat(p_new_organization_id, r.stat_date, 'report_feedbacks', r.report_feedbacks);
+    END LOOP;
+
+    IF p_new_organization_id IS NOT NULL THEN
+        INSERT INTO organization_daily_participants (organization_id, stat_date, user_id)
+        SELECT p_new_organization_id, organization_stat_date(o.created_at), o.user_id
+        FROM opinions o WHERE o.talk_session_id = p_talk_session_id AND o.deleted_at IS NULL
+        UNION
+        SELECT p_new_organization_id, organization_stat_date(v.created_at), v.user_id
+        FROM votes v WHERE v.talk_session_id = p_talk_session_id
+        ON CONFLICT DO NOTHING;
+    END IF;
+
+    -- 元の組織の他のセッションで参加していなければ参加者から取り除く
+    IF p_old_organization_id IS NOT NULL THEN
+        DELETE FROM organization_daily_participants p
+        USING (
+            SELECT organization_stat_date(o.created_at) AS stat_date, o.user_id
+            FROM opinions o WHERE o.talk_session_id = p_talk_session_id
+            UNION
+            SELECT organization_stat_date(v.created_at), v.user_id
+            FROM votes v WHERE v.talk_session_id = p_talk_session_id
+        ) AS moved
+        WHERE p.organization_id = p_old_organization_id
+            AND p.stat_date = moved.stat_date
+            AND p.user_id = moved.user_id
+            AND NOT EXISTS (
+                SELECT 1 FROM opinions o JOIN talk_sessions ts ON ts.talk_session_id = o.talk_session_id
+                WHERE ts.organization_id = p_old_organization_id
+                    AND ts.talk_session_id <> p_talk_session_id
+                    AND o.user_id = p.user_id
+                    AND o.deleted_at IS NULL
+                    AND organization_stat_date(o.created_at) = p.stat_date
+            )
+            AND NOT EXISTS (
+                SELECT 1 FROM votes v JOIN talk_sessions ts ON ts.talk_session_id = v.talk_session_id
+                WHERE ts.organization_id = p_old_organization_id
+                    AND ts.talk_session_id <> p_talk_session_id
+                    AND v.user_id = p.user_id
+                    AND organization_stat_date(v.created_at) = p.stat_date
+            );
+    END IF;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION organization_stats_on_talk_session() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        PERFORM add_organization_daily_stat(NEW.organization_id, organization_stat_date(NEW.created_at), 'talk_sessions_created', 1);
+        RETURN NEW;
+    ELSIF TG_OP = 'UPDATE' THEN
+        IF NEW.organization_id IS DISTINCT FROM OLD.organization_id THEN
+            PERFORM move_talk_session_organization_stats(NEW.talk_session_id, OLD.organization_id, NEW.organization_id);
+        END IF;
+        RETURN NEW;
+    END IF;
+    -- 削除前に呼び出し、連鎖して削除される意見や投票の分もまとめて差し引く
+    PERFORM move_talk_session_organization_stats(OLD.talk_session_id, OLD.organization_id, NULL);
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION organization_stats_on_opinion() RETURNS TRIGGER AS $$
+DECLARE
+    v_organization_id UUID;
+    v_stat_date DATE;
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        IF NEW.deleted_at IS NOT NULL THEN
+            RETURN NEW;
+        END IF;
+        SELECT organization_id INTO v_organization_id FROM talk_sessions WHERE talk_session_id = NEW.talk_session_id;
+        v_stat_date := organization_stat_date(NEW.created_at);
+        PERFORM add_organization_daily_stat(v_organization_id, v_stat_date, 'opinions', 1);
+        PERFORM record_organization_daily_participant(v_organization_id, v_stat_date, NEW.user_id);
+        RETURN NEW;
+    ELSIF TG_OP = 'UPDATE' THEN
+        IF (OLD.deleted_at IS NULL) = (NEW.deleted_at IS NULL) THEN
+            RETURN NEW;
+        END IF;
+        SELECT organization_id INTO v_organization_id FROM talk_sessions WHERE talk_session_id = NEW.talk_session_id;
+        v_stat_date := organization_stat_date(NEW.created_at);
+        PERFORM add_organization_daily_stat(
+            v_organization_id, v_stat_date, 'opinions',
+            CASE WHEN NEW.deleted_at IS NULL THEN 1 ELSE -1 END
+        );
+        PERFORM refresh_organization_daily_participant(v_organization_id, v_stat_date, NEW.user_id);
+        RETURN NEW;
+    END IF;
+
+    IF OLD.deleted_at IS NOT NULL THEN
+        RETURN OLD;
+    END IF;
+    SELECT organization_id INTO v_organization_id FROM talk_sessions WHERE talk_session_id = OLD.talk_session_id;
+    v_stat_date := organization_stat_date(OLD.created_at);
+    PERFORM add_organization_daily_stat(v_organization_id, v_stat_date, 'opinions', -1);
+    PERFORM refresh_organization_daily_participant(v_organization_id, v_stat_date, OLD.user_id);
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION organization_stats_on_vote() RETURNS TRIGGER AS $$
+DECLARE
+    v_organization_id UUID;
+    v_stat_date DATE;
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        SELECT organization_id INTO v_organization_id FROM talk_sessions WHERE talk_session_id = NEW.talk_session_id;
+        v_stat_date := organization_stat_date(NEW.created_at);
+        PERFORM add_organization_daily_stat(v_organization_id, v_stat_date, 'votes', 1);
+        PERFORM record_organization_daily_participant(v_organization_id, v_stat_date, NEW.user_id);
+        RETURN NEW;
+    END IF;
+
+    SELECT organization_id INTO v_organization_id FROM talk_sessions WHERE talk_session_id = OLD.talk_session_id;
+    v_stat_date := organization_stat_date(OLD.created_at);
+    PERFORM add_organization_daily_stat(v_organization_id, v_stat_date, 'votes', -1);
+    PERFORM refresh_organization_daily_participant(v_organization_id, v_stat_date, OLD.user_id);
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION organization_stats_on_consent() RETURNS TRIGGER AS $$
+DECLARE
+    v_organization_id UUID;
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        SELECT organization_id INTO v_organization_id FROM talk_sessions WHERE talk_session_id = NEW.talksession_id;
+        PERFORM add_organization_daily_stat(v_organization_id, organization_stat_date(NEW.consented_at), 'consents', 1);
+        RETURN NEW;
+    END IF;
+
+    SELECT organization_id INTO v_organization_id FROM talk_sessions WHERE talk_session_id = OLD.talksession_id;
+    PERFORM add_organization_daily_stat(v_organization_id, organization_stat_date(OLD.consented_at), 'consents', -1);
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION organization_stats_on_report_feedback() RETURNS TRIGGER AS $$
+DECLARE
+    v_organization_id UUID;
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        SELECT organization_id INTO v_organization_id FROM talk_sessions
+        WHERE talk_session_id = report_feedback_talk_session_id(NEW.talk_session_report_history_id);
+        PERFORM add_organization_daily_stat(v_organization_id, organization_stat_date(NEW.created_at), 'report_feedbacks', 1);
+        RETURN NEW;
+    END IF;
+
+    SELECT organization_id INTO v_organization_id FROM talk_sessions
+    WHERE talk_session_id = report_feedback_talk_session_id(OLD.talk_session_report_history_id);
+    PERFORM add_organization_daily_stat(v_organization_id, organization_stat_date(OLD.created_at), 'report_feedbacks', -1);
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER talk_sessions_organization_stats ON talk_sessions;
+DROP TRIGGER opinions_organization_stats ON opinions;
+DROP TRIGGER votes_organization_stats ON votes;
+DROP TRIGGER talksession_consents_organization_stats ON talksession_consents;
+DROP TRIGGER report_feedback_organization_stats ON report_feedback;
+DROP FUNCTION increment_organization_daily_stat(UUID, DATE, TEXT);
+
+CREATE TRIGGER talk_sessions_organization_stats
+    AFTER INSERT OR UPDATE OF organization_id ON talk_sessions
+    FOR EACH ROW EXECUTE FUNCTION organization_stats_on_talk_session();
+CREATE TRIGGER talk_sessions_organization_stats_delete
+    BEFORE DELETE ON talk_sessions
+    FOR EACH ROW EXECUTE FUNCTION organization_stats_on_talk_session();
+CREATE TRIGGER opinions_organization_stats
+    AFTER INSERT OR UPDATE OF deleted_at OR DELETE ON opinions
+    FOR EACH ROW EXECUTE FUNCTION organization_stats_on_opinion();
+CREATE TRIGGER votes_organization_stats
+    AFTER INSERT OR DELETE ON votes
+    FOR EACH ROW EXECUTE FUNCTION organization_stats_on_vote();
+CREATE TRIGGER talksession_consents_organization_stats
+    AFTER INSERT OR DELETE ON talksession_consents
+    FOR EACH ROW EXECUTE FUNCTION organization_stats_on_consent();
+CREATE TRIGGER report_feedback_organization_stats
+    AFTER INSERT OR DELETE ON report_feedback
+    FOR EACH ROW EXECUTE FUNCTION organization_stats_on_report_feedback();
+
+-- 日本時間の日付、削除済みの意見を除いた集計で作り直す
+DELETE FROM organization_daily_stats;
+DELETE FROM organization_daily_participants;
+
+INSERT INTO organization_daily_stats (organization_id, stat_date, talk_sessions_created, opinions, votes, consents, report_feedbacks)
+SELECT organization_id, stat_date, SUM(talk_sessions_created), SUM(opinions), SUM(votes), SUM(consents), SUM(report_feedbacks)
+FROM (
+    SELECT ts.organization_id, organization_stat_date(ts.created_at) AS stat_date, 1 AS talk_sessions_created, 0 AS opinions, 0 AS votes, 0 AS consents, 0 AS report_feedbacks
+    FROM talk_sessions ts
+    WHERE ts.organization_id IS NOT NULL
+    UNION ALL
+    SELECT ts.organization_id, organization_stat_date(o.created_at), 0, 1, 0, 0, 0
+    FROM opinions o JOIN talk_sessions ts ON ts.talk_session_id = o.talk_session_id
+    WHERE ts.organization_id IS NOT NULL AND o.deleted_at IS NULL
+    UNION ALL
+    SELECT ts.organization_id, organization_stat_date(v.created_at), 0, 0, 1, 0, 0
+    FROM votes v JOIN talk_sessions ts ON ts.talk_session_id = v.talk_session_id
+    WHERE ts.organization_id IS NOT NULL
+    UNION ALL
+    SELECT ts.organization_id, organization_stat_date(c.consented_at), 0, 0, 0, 1, 0
+    FROM talksession_consents c JOIN talk_sessions ts ON ts.talk_session_id = c.talksession_id
+    WHERE ts.organization_id IS NOT NULL
+    UNION ALL
+    SELECT ts.organization_id, organization_stat_date(rf.created_at), 0, 0, 0, 0, 1
+    FROM report_feedback rf
+    JOIN talk_sessions ts ON ts.talk_session_id = report_feedback_talk_session_id(rf.talk_session_report_history_id)
+    WHERE ts.organization_id IS NOT NULL
+) AS src
+GROUP BY organization_id, stat_date;
+
+INSERT INTO organization_daily_participants (organization_id, stat_date, user_id)
+SELECT ts.organization_id, organization_stat_date(o.created_at), o.user_id
+FROM opinions o JOIN talk_sessions ts ON ts.talk_session_id = o.talk_session_id
+WHERE ts.organization_id IS NOT NULL AND o.deleted_at IS NULL
+UNION
+SELECT ts.organization_id, organization_stat_date(v.created_at), v.user_id
+FROM votes v JOIN talk_sessions ts ON ts.talk_session_id = v.talk_session_id
+WHERE ts.organization_id IS NOT NULL;
+
+COMMENT ON TABLE organization_daily_stats IS '組織ごとの日本時間での日次集計。トリガーで逐次更新される';
@@ -2,8 +2,13 @@ package http_utils
 
 import (
 	"context"
-	"go.opentelemetry.io/otel"
+	"fmt"
+	"net"
 	"net/http"
+	"net/netip"
+	"strings"
+
+	"go.opentelemetry.io/otel"
 )
 
 type requestContextKey string
@@ -36,3 +41,78 @@ func GetHTTPResponse(ctx context.Context) http.ResponseWriter {
 
 	return ctx.Value(HTTPResponseContextKey).(http.ResponseWriter)
 }
+
+// ParseTrustedProxies 信頼するプロキシのIPアドレスまたはCIDRを解釈する
+func ParseTrustedProxies(entries []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(entries))
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if strings.Contains(entry, "/") {
+			prefix, err := netip.ParsePrefix(entry)
+			if err != nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q: %w", entry, err)
+			}
+			prefixes = append(prefixes, prefix.Masked())
+			continue
+		}
+		addr, err := netip.ParseAddr(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", entry, err)
+		}
+		addr = addr.Unmap()
+		prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+	}
+	return prefixes, nil
+}
+
+// GetClientIP クライアントのIPアドレスを取得する。
+// X-Forwarded-Forはクライアントが自由に書き換えられるため、RemoteAddrが信頼するプロキシの場合のみ右から辿り、
+// 信頼するプロキシでない最初のアドレスを返す
+func GetClientIP(req *http.Request, trustedProxies []netip.Prefix) string {
+	if req == nil {
+		return ""
+	}
+
+	remote := req.RemoteAddr
+	if host, _, err := net.SplitHostPort(req.RemoteAddr); err == nil {
+		remote = host
+	}
+	if !isTrustedProxy(remote, trustedProxies) {
+		return remote
+	}
+
+	var hops []string
+	for _, forwarded := range req.Header.Values("X-Forwarded-For") {
+		for hop := range strings.SplitSeq(forwarded, ",") {
+			hops = append(hops, strings.TrimSpace(hop))
+		}
+	}
+	for i := len(hops) - 1; i >= 0; i-- {
+		if _, err := netip.ParseAddr(hops[i]); err != nil {
+			// 形式が壊れている場合はそれより左を信用しない
+			return remote
+		}
+		if !isTrustedProxy(hops[i], trustedProxies) {
+			return hops[i]
+		}
+		remote = hops[i]
+	}
+	return remote
+}
+
+func isTrustedProxy(ip string, trustedProxies []netip.Prefix) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+	for _, prefix := range trustedProxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
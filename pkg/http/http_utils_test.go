@@ -0,0 +1,94 @@
+package http_utils
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetClientIP(t *testing.T) {
+	trusted, err := ParseTrustedProxies([]string{"10.0.0.0/8", "192.0.2.1"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		remoteAddr string
+		forwarded  []string
+		trusted    bool
+		want       string
+	}{
+		{
+			name:       "信頼するプロキシがない場合はX-Forwarded-Forを無視する",
+			remoteAddr: "203.0.113.5:1234",
+			forwarded:  []string{"198.51.100.1"},
+			want:       "203.0.113.5",
+		},
+		{
+			name:       "信頼しない接続元からのX-Forwarded-Forは偽装できる値として無視する",
+			remoteAddr: "203.0.113.5:1234",
+			forwarded:  []string{"198.51.100.1"},
+			trusted:    true,
+			want:       "203.0.113.5",
+		},
+		{
+			name:       "信頼するプロキシ経由ではプロキシが追加したアドレスを使う",
+			remoteAddr: "10.0.0.2:1234",
+			forwarded:  []string{"203.0.113.5"},
+			trusted:    true,
+			want:       "203.0.113.5",
+		},
+		{
+			name:       "クライアントが先頭に付けたアドレスは使わない",
+			remoteAddr: "10.0.0.2:1234",
+			forwarded:  []string{"198.51.100.1, 203.0.113.5"},
+			trusted:    true,
+			want:       "203.0.113.5",
+		},
+		{
+			name:       "複数の信頼するプロキシを右から辿る",
+			remoteAddr: "10.0.0.2:1234",
+			forwarded:  []string{"198.51.100.1, 203.0.113.5", "192.0.2.1, 10.0.0.3"},
+			trusted:    true,
+			want:       "203.0.113.5",
+		},
+		{
+			name:       "形式が壊れたアドレスより左は使わない",
+			remoteAddr: "10.0.0.2:1234",
+			forwarded:  []string{"198.51.100.1, unknown"},
+			trusted:    true,
+			want:       "10.0.0.2",
+		},
+		{
+			name:       "X-Forwarded-Forがない場合は接続元を使う",
+			remoteAddr: "10.0.0.2:1234",
+			trusted:    true,
+			want:       "10.0.0.2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			req.RemoteAddr = tt.remoteAddr
+			for _, f := range tt.forwarded {
+				req.Header.Add("X-Forwarded-For", f)
+			}
+			proxies := trusted
+			if !tt.trusted {
+				proxies = nil
+			}
+			assert.Equal(t, tt.want, GetClientIP(req, proxies))
+		})
+	}
+}
+
+func TestParseTrustedProxies(t *testing.T) {
+	prefixes, err := ParseTrustedProxies([]string{" 10.0.0.0/8 ", "", "::1"})
+	require.NoError(t, err)
+	assert.Len(t, prefixes, 2)
+
+	_, err = ParseTrustedProxies([]string{"not-an-ip"})
+	assert.Error(t, err)
+}